@@ -0,0 +1,469 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// AttestationService runs attestation campaigns: periodically, the owners of applications sign
+// off that their governance data, security provisions and continuity plans are accurate, or
+// dispute them. Owners who have not answered are reminded, and each round's completion is shown
+// on a dashboard.
+type AttestationService struct {
+	instrumentation
+
+	campaignRepo  domain.AttestationCampaignRepository
+	portfolioRepo domain.ApplicationPortfolioRepository
+	appRepo       domain.ApplicationRepository
+	agreementRepo domain.GovernanceAgreementRepository
+	notifier      domain.Notifier // nil sends no reminders
+	eventRepo     domain.DomainEventRepository
+	now           func() time.Time
+}
+
+// NewAttestationService creates a new attestation service. The notifier may be nil, in which
+// case owners are not reminded.
+func NewAttestationService(
+	campaignRepo domain.AttestationCampaignRepository,
+	portfolioRepo domain.ApplicationPortfolioRepository,
+	appRepo domain.ApplicationRepository,
+	agreementRepo domain.GovernanceAgreementRepository,
+	notifier domain.Notifier,
+	eventRepo domain.DomainEventRepository,
+	opts ...ServiceOption,
+) *AttestationService {
+	return &AttestationService{
+		campaignRepo:    campaignRepo,
+		portfolioRepo:   portfolioRepo,
+		appRepo:         appRepo,
+		agreementRepo:   agreementRepo,
+		notifier:        notifier,
+		eventRepo:       eventRepo,
+		now:             time.Now,
+		instrumentation: newInstrumentation(opts),
+	}
+}
+
+// LaunchCampaign opens the first round of a campaign over the applications of a portfolio, or
+// those listed. Each application is attested by the owner named for it, or else the party
+// accountable in its agreement's responsibility matrix, or else the portfolio owner.
+func (s *AttestationService) LaunchCampaign(ctx context.Context, cmd LaunchAttestationCampaignCommand) (*domain.AttestationCampaign, error) {
+	ctx, span := s.startSpan(ctx, "AttestationService.LaunchCampaign", domain.PortfolioAttribute(cmd.PortfolioID))
+	defer span.End()
+
+	if _, err := s.campaignRepo.FindByID(ctx, cmd.ID); err == nil {
+		return nil, fmt.Errorf("attestation campaign %s already exists", cmd.ID)
+	}
+
+	var apps []domain.Application
+	var portfolioOwner string
+	switch {
+	case len(cmd.ApplicationIDs) > 0:
+		for _, appID := range cmd.ApplicationIDs {
+			app, err := s.appRepo.FindByID(ctx, appID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to find application %s: %w", appID, err)
+			}
+			apps = append(apps, app)
+		}
+	case cmd.PortfolioID != "":
+		portfolio, err := s.portfolioRepo.FindByID(ctx, cmd.PortfolioID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find portfolio: %w", err)
+		}
+		apps = portfolio.Applications
+		portfolioOwner = portfolio.Owner
+	default:
+		return nil, fmt.Errorf("a portfolio or applications are required")
+	}
+
+	scopes := cmd.Scopes
+	if len(scopes) == 0 {
+		scopes = domain.AllAttestationScopes()
+	}
+	window := cmd.ResponseWindow
+	if window == 0 {
+		window = 14 * 24 * time.Hour
+	}
+	now := s.now()
+	campaign := domain.AttestationCampaign{
+		ID:             cmd.ID,
+		Series:         cmd.ID,
+		Round:          1,
+		Name:           cmd.Name,
+		PortfolioID:    cmd.PortfolioID,
+		Scopes:         scopes,
+		Frequency:      cmd.Frequency,
+		ResponseWindow: window,
+		Status:         domain.AttestationCampaignOpen,
+		LaunchedBy:     cmd.LaunchedBy,
+		OpenedAt:       now,
+		DueAt:          now.Add(window),
+	}
+	for _, app := range apps {
+		owner := cmd.Owners[app.ID]
+		if owner == "" {
+			owner = s.accountableParty(ctx, app.ID)
+		}
+		if owner == "" {
+			owner = portfolioOwner
+		}
+		for _, scope := range scopes {
+			campaign.Attestations = append(campaign.Attestations, domain.Attestation{
+				ApplicationID:   app.ID,
+				ApplicationName: app.Name,
+				Scope:           scope,
+				Owner:           owner,
+				Status:          domain.AttestationPending,
+			})
+		}
+	}
+	if err := campaign.Validate(); err != nil {
+		return nil, err
+	}
+
+	err := s.campaignRepo.Save(ctx, campaign)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save attestation campaign: %w", err)
+	}
+	s.publishLaunched(ctx, campaign)
+	return &campaign, nil
+}
+
+// accountableParty returns the first party accountable in the responsibility matrix of the
+// application's agreement, empty when it has none
+func (s *AttestationService) accountableParty(ctx context.Context, appID domain.ApplicationID) string {
+	agreement, err := s.agreementRepo.FindByApplicationID(ctx, appID)
+	if err != nil {
+		return ""
+	}
+	for _, entry := range agreement.ResponsibilityMatrix.Entries {
+		if entry.Accountable != "" {
+			return entry.Accountable
+		}
+	}
+	return ""
+}
+
+// SignOff records an owner confirming, or disputing, that their application's data is accurate
+func (s *AttestationService) SignOff(ctx context.Context, cmd SignOffAttestationCommand) ([]domain.Attestation, error) {
+	ctx, span := s.startSpan(ctx, "AttestationService.SignOff", domain.ApplicationAttribute(cmd.ApplicationID))
+	defer span.End()
+
+	campaign, err := s.campaignRepo.FindByID(ctx, cmd.CampaignID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find attestation campaign: %w", err)
+	}
+	now := s.now()
+	answered, err := campaign.SignOff(cmd.ApplicationID, cmd.Scope, cmd.Attester, cmd.Confirmed, cmd.Comment, now)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.campaignRepo.Save(ctx, campaign)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save attestation campaign: %w", err)
+	}
+
+	for _, attestation := range answered {
+		event := domain.AttestationSignedOffEvent{
+			CampaignID:    campaign.ID,
+			ApplicationID: attestation.ApplicationID,
+			Scope:         attestation.Scope,
+			Owner:         attestation.Owner,
+			Status:        attestation.Status,
+			Comment:       attestation.Comment,
+			OccurredAt:    now,
+		}
+		err = s.eventRepo.Save(ctx, event)
+		if err != nil {
+			fmt.Printf("Failed to save domain event: %v\n", err)
+		}
+	}
+	return answered, nil
+}
+
+// CloseCampaign closes a round; attestations still pending stay unanswered
+func (s *AttestationService) CloseCampaign(ctx context.Context, campaignID string) (*domain.AttestationCampaign, error) {
+	ctx, span := s.startSpan(ctx, "AttestationService.CloseCampaign")
+	defer span.End()
+
+	campaign, err := s.campaignRepo.FindByID(ctx, campaignID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find attestation campaign: %w", err)
+	}
+	if err := s.close(ctx, &campaign, s.now()); err != nil {
+		return nil, err
+	}
+	return &campaign, nil
+}
+
+// close closes a round and publishes its completion
+func (s *AttestationService) close(ctx context.Context, campaign *domain.AttestationCampaign, now time.Time) error {
+	if err := campaign.Close(now); err != nil {
+		return err
+	}
+	err := s.campaignRepo.Save(ctx, *campaign)
+	if err != nil {
+		return fmt.Errorf("failed to save attestation campaign: %w", err)
+	}
+
+	progress := domain.BuildAttestationDashboard(*campaign, now).Progress
+	event := domain.AttestationCampaignClosedEvent{
+		CampaignID: campaign.ID,
+		Completion: progress.Completion,
+		Disputed:   progress.Disputed,
+		Pending:    progress.Pending,
+		OccurredAt: now,
+	}
+	err = s.eventRepo.Save(ctx, event)
+	if err != nil {
+		fmt.Printf("Failed to save domain event: %v\n", err)
+	}
+	return nil
+}
+
+// StartDueRounds starts the next round of every periodic campaign whose period has elapsed,
+// closing the previous round when it is still open. The new rounds ask the same owners.
+func (s *AttestationService) StartDueRounds(ctx context.Context) ([]domain.AttestationCampaign, error) {
+	ctx, span := s.startSpan(ctx, "AttestationService.StartDueRounds")
+	defer span.End()
+
+	campaigns, err := s.campaignRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list attestation campaigns: %w", err)
+	}
+	latest := make(map[string]domain.AttestationCampaign)
+	var series []string
+	for _, campaign := range campaigns {
+		previous, seen := latest[campaign.Series]
+		if !seen {
+			series = append(series, campaign.Series)
+		}
+		if !seen || campaign.Round > previous.Round {
+			latest[campaign.Series] = campaign
+		}
+	}
+
+	now := s.now()
+	var started []domain.AttestationCampaign
+	for _, id := range series {
+		campaign := latest[id]
+		next := campaign.NextRoundAt()
+		if next.IsZero() || now.Before(next) {
+			continue
+		}
+		if campaign.Status == domain.AttestationCampaignOpen {
+			if err := s.close(ctx, &campaign, now); err != nil {
+				return started, err
+			}
+		}
+
+		round := campaign.NextRound(now)
+		err = s.campaignRepo.Save(ctx, round)
+		if err != nil {
+			return started, fmt.Errorf("failed to save attestation campaign: %w", err)
+		}
+		s.publishLaunched(ctx, round)
+		started = append(started, round)
+	}
+	return started, nil
+}
+
+// publishLaunched publishes the opening of a campaign round
+func (s *AttestationService) publishLaunched(ctx context.Context, campaign domain.AttestationCampaign) {
+	event := domain.AttestationCampaignLaunchedEvent{
+		CampaignID:   campaign.ID,
+		Series:       campaign.Series,
+		Round:        campaign.Round,
+		PortfolioID:  campaign.PortfolioID,
+		Attestations: len(campaign.Attestations),
+		DueAt:        campaign.DueAt,
+		OccurredAt:   campaign.OpenedAt,
+	}
+	err := s.eventRepo.Save(ctx, event)
+	if err != nil {
+		fmt.Printf("Failed to save domain event: %v\n", err)
+	}
+}
+
+// AttestationReminderRun is the outcome of one round of attestation reminders
+type AttestationReminderRun struct {
+	Sent    []domain.Notification
+	Skipped int // owners reminded too recently
+	Failed  int
+	RanAt   time.Time
+}
+
+// SendReminders reminds each owner of the attestations they have not answered in every open
+// round, at most once per RemindAfter. Reminders of overdue rounds are critical. A failed
+// delivery does not stop the others; their errors are joined.
+func (s *AttestationService) SendReminders(ctx context.Context, cmd SendAttestationRemindersCommand) (*AttestationReminderRun, error) {
+	ctx, span := s.startSpan(ctx, "AttestationService.SendReminders")
+	defer span.End()
+
+	if s.notifier == nil {
+		return nil, fmt.Errorf("notifications are not configured")
+	}
+	if cmd.Now.IsZero() {
+		cmd.Now = s.now()
+	}
+	if cmd.RemindAfter == 0 {
+		cmd.RemindAfter = 3 * 24 * time.Hour
+	}
+
+	campaigns, err := s.campaignRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list attestation campaigns: %w", err)
+	}
+
+	run := &AttestationReminderRun{RanAt: cmd.Now}
+	var errs []error
+	for _, campaign := range campaigns {
+		if campaign.Status != domain.AttestationCampaignOpen {
+			continue
+		}
+
+		var owners []string
+		pending := make(map[string][]domain.Attestation)
+		for _, attestation := range campaign.Pending() {
+			if _, seen := pending[attestation.Owner]; !seen {
+				owners = append(owners, attestation.Owner)
+			}
+			pending[attestation.Owner] = append(pending[attestation.Owner], attestation)
+		}
+
+		reminded := make(map[string]bool)
+		for _, owner := range owners {
+			if remindedWithin(pending[owner], cmd.Now, cmd.RemindAfter) {
+				run.Skipped++
+				continue
+			}
+			notification := attestationReminder(campaign, owner, pending[owner], cmd.Now)
+			if err := s.notifier.Notify(ctx, notification); err != nil {
+				run.Failed++
+				errs = append(errs, fmt.Errorf("failed to remind %s of campaign %s: %w", owner, campaign.ID, err))
+				continue
+			}
+			run.Sent = append(run.Sent, notification)
+			reminded[owner] = true
+		}
+		if len(reminded) == 0 {
+			continue
+		}
+
+		campaign.Attestations = append([]domain.Attestation{}, campaign.Attestations...)
+		for i, attestation := range campaign.Attestations {
+			if attestation.Status == domain.AttestationPending && reminded[attestation.Owner] {
+				campaign.Attestations[i].RemindedAt = cmd.Now
+			}
+		}
+		err = s.campaignRepo.Save(ctx, campaign)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to save attestation campaign: %w", err))
+		}
+	}
+	return run, errors.Join(errs...)
+}
+
+// remindedWithin reports whether the owner of the attestations was reminded less than after ago
+func remindedWithin(attestations []domain.Attestation, now time.Time, after time.Duration) bool {
+	for _, attestation := range attestations {
+		if !attestation.RemindedAt.IsZero() && now.Sub(attestation.RemindedAt) < after {
+			return true
+		}
+	}
+	return false
+}
+
+// attestationReminder addresses an owner's pending attestations of a round to them
+func attestationReminder(campaign domain.AttestationCampaign, owner string, pending []domain.Attestation, now time.Time) domain.Notification {
+	severity := domain.NotificationWarning
+	if campaign.Overdue(now) {
+		severity = domain.NotificationCritical
+	}
+	items := make([]string, 0, len(pending))
+	for _, attestation := range pending {
+		items = append(items, fmt.Sprintf("%s of %s", attestation.Scope, attestation.ApplicationName))
+	}
+	notification := domain.Notification{
+		ID:         fmt.Sprintf("attestation/%s/%s", campaign.ID, owner),
+		Kind:       domain.NotificationAttestationDue,
+		Severity:   severity,
+		Title:      fmt.Sprintf("%d attestations of %s await your sign-off", len(pending), campaign.Name),
+		Message:    fmt.Sprintf("Confirm or dispute the accuracy of %s by %s", strings.Join(items, ", "), campaign.DueAt.Format("2006-01-02")),
+		Recipients: []string{owner},
+		DueAt:      campaign.DueAt,
+		CreatedAt:  now,
+	}
+	if campaign.PortfolioID != "" {
+		notification.Portfolios = []domain.PortfolioID{campaign.PortfolioID}
+	}
+	return notification
+}
+
+// GetDashboard shows the completion of a campaign round or, when none is given, of every open
+// round
+func (s *AttestationService) GetDashboard(ctx context.Context, campaignID string) ([]domain.AttestationDashboard, error) {
+	ctx, span := s.startSpan(ctx, "AttestationService.GetDashboard")
+	defer span.End()
+
+	var campaigns []domain.AttestationCampaign
+	if campaignID != "" {
+		campaign, err := s.campaignRepo.FindByID(ctx, campaignID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find attestation campaign: %w", err)
+		}
+		campaigns = append(campaigns, campaign)
+	} else {
+		all, err := s.campaignRepo.FindAll(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list attestation campaigns: %w", err)
+		}
+		for _, campaign := range all {
+			if campaign.Status == domain.AttestationCampaignOpen {
+				campaigns = append(campaigns, campaign)
+			}
+		}
+	}
+
+	now := s.now()
+	dashboards := make([]domain.AttestationDashboard, 0, len(campaigns))
+	for _, campaign := range campaigns {
+		dashboards = append(dashboards, domain.BuildAttestationDashboard(campaign, now))
+	}
+	return dashboards, nil
+}
+
+// Commands for Attestation Service
+
+type LaunchAttestationCampaignCommand struct {
+	ID             string
+	Name           string
+	PortfolioID    domain.PortfolioID
+	ApplicationIDs []domain.ApplicationID          // attested instead of the portfolio's applications when given
+	Scopes         []domain.AttestationScope       // defaults to every scope
+	Owners         map[domain.ApplicationID]string // owners named for applications
+	Frequency      domain.AttestationFrequency     // empty for a one-off campaign
+	ResponseWindow time.Duration                   // defaults to 14 days
+	LaunchedBy     string
+}
+
+type SignOffAttestationCommand struct {
+	CampaignID    string
+	ApplicationID domain.ApplicationID
+	Scope         domain.AttestationScope // signs off every pending scope when empty
+	Attester      string                  // must be the attestations' owner
+	Confirmed     bool                    // false disputes the data
+	Comment       string                  // required when disputing
+}
+
+type SendAttestationRemindersCommand struct {
+	Now         time.Time     // optional, defaults to now
+	RemindAfter time.Duration // how long before an owner is reminded again, defaults to 3 days
+}
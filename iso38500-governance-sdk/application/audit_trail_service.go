@@ -0,0 +1,71 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// AuditTrailService queries and verifies the audit trail of governance changes. Unlike domain
+// events, which can be deleted, the trail is append-only and each entry holds the hash of the
+// one before, so any entry changed or removed is detected.
+type AuditTrailService struct {
+	instrumentation
+
+	trail domain.AuditTrailRepository
+}
+
+// NewAuditTrailService creates a new audit trail service
+func NewAuditTrailService(trail domain.AuditTrailRepository, opts ...ServiceOption) *AuditTrailService {
+	return &AuditTrailService{
+		trail:           trail,
+		instrumentation: newInstrumentation(opts),
+	}
+}
+
+// Query returns the changes recorded to an entity or by an actor, oldest first
+func (s *AuditTrailService) Query(ctx context.Context, cmd QueryAuditTrailCommand) ([]domain.AuditTrailEntry, error) {
+	ctx, span := s.startSpan(ctx, "AuditTrailService.Query")
+	defer span.End()
+
+	entries, err := s.trail.Find(ctx, domain.AuditTrailQuery{
+		EntityType: cmd.EntityType,
+		EntityID:   cmd.EntityID,
+		Actor:      cmd.Actor,
+		From:       cmd.From,
+		To:         cmd.To,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query audit trail: %w", err)
+	}
+	if cmd.Limit > 0 && len(entries) > cmd.Limit {
+		entries = entries[len(entries)-cmd.Limit:]
+	}
+	return entries, nil
+}
+
+// Verify checks the whole trail's hash chain
+func (s *AuditTrailService) Verify(ctx context.Context) (*domain.AuditTrailVerification, error) {
+	ctx, span := s.startSpan(ctx, "AuditTrailService.Verify")
+	defer span.End()
+
+	entries, err := s.trail.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load audit trail: %w", err)
+	}
+	verification := domain.VerifyAuditTrail(entries)
+	return &verification, nil
+}
+
+// Commands for Audit Trail Service
+
+type QueryAuditTrailCommand struct {
+	EntityType domain.AuditTrailEntityType // optional
+	EntityID   string                      // optional, <agreement ID>/<policy ID> for policies
+	Actor      string                      // optional
+	From       time.Time                   // optional
+	To         time.Time                   // optional
+	Limit      int                         // most recent entries returned, all when 0
+}
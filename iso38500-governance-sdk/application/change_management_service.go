@@ -3,6 +3,8 @@ package application
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/iso38500/iso38500-governance-sdk/domain"
@@ -10,11 +12,18 @@ import (
 
 // ChangeManagementService provides application services for change management
 type ChangeManagementService struct {
+	instrumentation
+
 	changeRequestRepo domain.ChangeRequestRepository
 	incidentRepo      domain.IncidentRepository
 	auditRepo         domain.AuditRepository
 	appRepo           domain.ApplicationRepository
+	agreementRepo     domain.GovernanceAgreementRepository  // nil leaves agreements out of impact analyses and incidents without an SLA
+	portfolioRepo     domain.ApplicationPortfolioRepository // nil leaves portfolios without change metrics
 	eventRepo         domain.DomainEventRepository
+	closurePolicy     domain.AuditClosurePolicy
+	sodPolicy         domain.SegregationOfDutiesPolicy
+	approvalMatrix    domain.ApprovalMatrix
 }
 
 // NewChangeManagementService creates a new change management service
@@ -24,6 +33,7 @@ func NewChangeManagementService(
 	auditRepo domain.AuditRepository,
 	appRepo domain.ApplicationRepository,
 	eventRepo domain.DomainEventRepository,
+	opts ...ServiceOption,
 ) *ChangeManagementService {
 	return &ChangeManagementService{
 		changeRequestRepo: changeRequestRepo,
@@ -31,11 +41,48 @@ func NewChangeManagementService(
 		auditRepo:         auditRepo,
 		appRepo:           appRepo,
 		eventRepo:         eventRepo,
+		closurePolicy:     domain.DefaultAuditClosurePolicy(),
+		sodPolicy:         domain.DefaultSegregationOfDutiesPolicy(),
+		approvalMatrix:    domain.DefaultApprovalMatrix(),
+		instrumentation:   newInstrumentation(opts),
 	}
 }
 
-// CreateChangeRequest creates a new change request
+// SetAuditClosurePolicy replaces the severities of the findings that must be remediated before
+// an audit can be closed
+func (s *ChangeManagementService) SetAuditClosurePolicy(policy domain.AuditClosurePolicy) {
+	s.closurePolicy = policy
+}
+
+// SetSegregationOfDutiesPolicy replaces the rules keeping the request of a change apart from the
+// decision on it
+func (s *ChangeManagementService) SetSegregationOfDutiesPolicy(policy domain.SegregationOfDutiesPolicy) {
+	s.sodPolicy = policy
+}
+
+// SetGovernanceAgreementRepository lets change impact analyses list the governance agreements of
+// the applications a change affects
+func (s *ChangeManagementService) SetGovernanceAgreementRepository(agreementRepo domain.GovernanceAgreementRepository) {
+	s.agreementRepo = agreementRepo
+}
+
+// SetPortfolioRepository lets change metrics be computed across the applications of a portfolio
+func (s *ChangeManagementService) SetPortfolioRepository(portfolioRepo domain.ApplicationPortfolioRepository) {
+	s.portfolioRepo = portfolioRepo
+}
+
+// SetApprovalMatrix replaces the approvals each change request needs, by type and priority,
+// before it is approved
+func (s *ChangeManagementService) SetApprovalMatrix(matrix domain.ApprovalMatrix) {
+	s.approvalMatrix = matrix
+}
+
+// CreateChangeRequest creates a new change request, with the analysis of its impact on the
+// applications depending on the changed one attached for its approvers
 func (s *ChangeManagementService) CreateChangeRequest(ctx context.Context, cmd CreateChangeRequestCommand) (*domain.ChangeRequest, error) {
+	ctx, span := s.startSpan(ctx, "ChangeManagementService.CreateChangeRequest", domain.ApplicationAttribute(cmd.ApplicationID))
+	defer span.End()
+
 	// Verify application exists
 	_, err := s.appRepo.FindByID(ctx, cmd.ApplicationID)
 	if err != nil {
@@ -58,6 +105,11 @@ func (s *ChangeManagementService) CreateChangeRequest(ctx context.Context, cmd C
 		CreatedAt:     time.Now(),
 		UpdatedAt:     time.Now(),
 	}
+	impact, err := s.analyzeImpact(ctx, cmd.ApplicationID)
+	if err != nil {
+		return nil, err
+	}
+	changeRequest.ImpactAnalysis = impact
 
 	err = s.changeRequestRepo.Save(ctx, changeRequest)
 	if err != nil {
@@ -83,15 +135,30 @@ func (s *ChangeManagementService) CreateChangeRequest(ctx context.Context, cmd C
 	return &changeRequest, nil
 }
 
-// ApproveChangeRequest approves a change request
-func (s *ChangeManagementService) ApproveChangeRequest(ctx context.Context, cmd ApproveChangeRequestCommand) error {
+// ApproveChangeRequest records an approval of a change request. The change request is approved
+// once the approvals satisfy the rule of the approval matrix covering it, and awaits the other
+// approvals until then.
+func (s *ChangeManagementService) ApproveChangeRequest(ctx context.Context, cmd ApproveChangeRequestCommand) (*domain.ApprovalProgress, error) {
+	ctx, span := s.startSpan(ctx, "ChangeManagementService.ApproveChangeRequest")
+	defer span.End()
+
 	changeRequest, err := s.changeRequestRepo.FindByID(ctx, cmd.ChangeRequestID)
 	if err != nil {
-		return fmt.Errorf("change request not found: %w", err)
+		return nil, fmt.Errorf("change request not found: %w", err)
 	}
 
-	if changeRequest.Status != domain.ChangeStatusSubmitted {
-		return fmt.Errorf("change request is not in submitted status")
+	if !changeRequest.AwaitingApproval() {
+		return nil, fmt.Errorf("change request is not awaiting approval")
+	}
+	if err := s.sodPolicy.CheckChangeDecision(changeRequest, cmd.Approver); err != nil {
+		return nil, err
+	}
+	if changeRequest.ApprovedBy(cmd.Approver) {
+		return nil, fmt.Errorf("%s already approved change request %s", cmd.Approver, changeRequest.ID)
+	}
+	rule := s.approvalMatrix.RuleFor(changeRequest)
+	if !rule.Accepts(cmd.Role) {
+		return nil, fmt.Errorf("change request %s needs approval by %s, not %q", changeRequest.ID, strings.Join(rule.Roles, ", "), cmd.Role)
 	}
 
 	// Add approval
@@ -104,38 +171,118 @@ func (s *ChangeManagementService) ApproveChangeRequest(ctx context.Context, cmd
 	}
 
 	changeRequest.Approvals = append(changeRequest.Approvals, approval)
-	changeRequest.Status = domain.ChangeStatusApproved
+	progress := s.approvalMatrix.Evaluate(changeRequest)
+	changeRequest.Status = domain.ChangeStatusPendingApproval
+	if progress.Satisfied {
+		changeRequest.Status = domain.ChangeStatusApproved
+	}
 	changeRequest.UpdatedAt = time.Now()
 
 	err = s.changeRequestRepo.Update(ctx, changeRequest)
 	if err != nil {
-		return fmt.Errorf("failed to update change request: %w", err)
+		return nil, fmt.Errorf("failed to update change request: %w", err)
 	}
 
 	// Publish domain event
-	event := domain.ChangeRequestApprovedEvent{
+	var event domain.DomainEvent = domain.ChangeApprovalRecordedEvent{
 		ChangeRequestID: cmd.ChangeRequestID,
 		Approver:        cmd.Approver,
+		Role:            cmd.Role,
+		Remaining:       progress.Remaining,
+		MissingRoles:    progress.MissingRoles,
+		AwaitedRoles:    progress.AwaitedRoles(),
 		OccurredAt:      time.Now(),
 	}
+	if progress.Satisfied {
+		event = domain.ChangeRequestApprovedEvent{
+			ChangeRequestID: cmd.ChangeRequestID,
+			Approver:        cmd.Approver,
+			OccurredAt:      time.Now(),
+		}
+	}
 
 	err = s.eventRepo.Save(ctx, event)
 	if err != nil {
 		fmt.Printf("Failed to save domain event: %v\n", err)
 	}
 
-	return nil
+	return &progress, nil
+}
+
+// AnalyzeChangeImpact recomputes the impact of a change request from the current dependency
+// graph, e.g. after applications were added that depend on the changed one, and attaches it
+func (s *ChangeManagementService) AnalyzeChangeImpact(ctx context.Context, changeRequestID string) (*domain.ChangeImpact, error) {
+	ctx, span := s.startSpan(ctx, "ChangeManagementService.AnalyzeChangeImpact")
+	defer span.End()
+
+	changeRequest, err := s.changeRequestRepo.FindByID(ctx, changeRequestID)
+	if err != nil {
+		return nil, fmt.Errorf("change request not found: %w", err)
+	}
+
+	impact, err := s.analyzeImpact(ctx, changeRequest.ApplicationID)
+	if err != nil {
+		return nil, err
+	}
+	changeRequest.ImpactAnalysis = impact
+	changeRequest.UpdatedAt = time.Now()
+
+	err = s.changeRequestRepo.Update(ctx, changeRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update change request: %w", err)
+	}
+	return impact, nil
+}
+
+// analyzeImpact analyzes the impact of changing the application on the applications depending
+// on it and their agreements
+func (s *ChangeManagementService) analyzeImpact(ctx context.Context, appID domain.ApplicationID) (*domain.ChangeImpact, error) {
+	apps, err := s.appRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load applications: %w", err)
+	}
+	var agreements []domain.GovernanceAgreement
+	if s.agreementRepo != nil {
+		agreements, err = s.agreementRepo.FindAll(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load governance agreements: %w", err)
+		}
+	}
+
+	impact := domain.AnalyzeChangeImpact(appID, apps, agreements, time.Now())
+	return &impact, nil
+}
+
+// GetApprovalProgress reports the approvals a change request has and still needs under the
+// approval matrix
+func (s *ChangeManagementService) GetApprovalProgress(ctx context.Context, changeRequestID string) (*domain.ApprovalProgress, error) {
+	ctx, span := s.startSpan(ctx, "ChangeManagementService.GetApprovalProgress")
+	defer span.End()
+
+	changeRequest, err := s.changeRequestRepo.FindByID(ctx, changeRequestID)
+	if err != nil {
+		return nil, fmt.Errorf("change request not found: %w", err)
+	}
+
+	progress := s.approvalMatrix.Evaluate(changeRequest)
+	return &progress, nil
 }
 
-// RejectChangeRequest rejects a change request
+// RejectChangeRequest rejects a change request awaiting approval, whatever approvals it already has
 func (s *ChangeManagementService) RejectChangeRequest(ctx context.Context, cmd RejectChangeRequestCommand) error {
+	ctx, span := s.startSpan(ctx, "ChangeManagementService.RejectChangeRequest")
+	defer span.End()
+
 	changeRequest, err := s.changeRequestRepo.FindByID(ctx, cmd.ChangeRequestID)
 	if err != nil {
 		return fmt.Errorf("change request not found: %w", err)
 	}
 
-	if changeRequest.Status != domain.ChangeStatusSubmitted {
-		return fmt.Errorf("change request is not in submitted status")
+	if !changeRequest.AwaitingApproval() {
+		return fmt.Errorf("change request is not awaiting approval")
+	}
+	if err := s.sodPolicy.CheckChangeDecision(changeRequest, cmd.Approver); err != nil {
+		return err
 	}
 
 	// Add rejection
@@ -156,11 +303,29 @@ func (s *ChangeManagementService) RejectChangeRequest(ctx context.Context, cmd R
 		return fmt.Errorf("failed to update change request: %w", err)
 	}
 
+	// Publish domain event
+	event := domain.ChangeRequestRejectedEvent{
+		ChangeRequestID: changeRequest.ID,
+		ApplicationID:   changeRequest.ApplicationID,
+		Approver:        cmd.Approver,
+		Role:            cmd.Role,
+		Comments:        cmd.Comments,
+		OccurredAt:      approval.ApprovedAt,
+	}
+
+	err = s.eventRepo.Save(ctx, event)
+	if err != nil {
+		fmt.Printf("Failed to save domain event: %v\n", err)
+	}
+
 	return nil
 }
 
 // SubmitChangeRequest submits a change request for approval
 func (s *ChangeManagementService) SubmitChangeRequest(ctx context.Context, changeRequestID string) error {
+	ctx, span := s.startSpan(ctx, "ChangeManagementService.SubmitChangeRequest")
+	defer span.End()
+
 	changeRequest, err := s.changeRequestRepo.FindByID(ctx, changeRequestID)
 	if err != nil {
 		return fmt.Errorf("change request not found: %w", err)
@@ -171,35 +336,256 @@ func (s *ChangeManagementService) SubmitChangeRequest(ctx context.Context, chang
 	}
 
 	changeRequest.Status = domain.ChangeStatusSubmitted
-	changeRequest.UpdatedAt = time.Now()
+	changeRequest.SubmittedAt = time.Now()
+	changeRequest.UpdatedAt = changeRequest.SubmittedAt
 
 	err = s.changeRequestRepo.Update(ctx, changeRequest)
 	if err != nil {
 		return fmt.Errorf("failed to submit change request: %w", err)
 	}
 
+	// Publish domain event
+	event := domain.ChangeRequestSubmittedEvent{
+		ChangeRequestID: changeRequest.ID,
+		ApplicationID:   changeRequest.ApplicationID,
+		Title:           changeRequest.Title,
+		Priority:        changeRequest.Priority,
+		AwaitedRoles:    s.approvalMatrix.Evaluate(changeRequest).AwaitedRoles(),
+		OccurredAt:      changeRequest.SubmittedAt,
+	}
+
+	err = s.eventRepo.Save(ctx, event)
+	if err != nil {
+		fmt.Printf("Failed to save domain event: %v\n", err)
+	}
+
 	return nil
 }
 
-// ReportIncident reports a new incident
+// ImplementChangeRequest records the implementation of an approved change request: who
+// implemented it, when, its outcome and whether it was rolled back. Incidents reported on its
+// application shortly afterwards are suspected to be caused by it.
+func (s *ChangeManagementService) ImplementChangeRequest(ctx context.Context, cmd ImplementChangeRequestCommand) (*domain.ChangeRequest, error) {
+	ctx, span := s.startSpan(ctx, "ChangeManagementService.ImplementChangeRequest")
+	defer span.End()
+
+	changeRequest, err := s.changeRequestRepo.FindByID(ctx, cmd.ChangeRequestID)
+	if err != nil {
+		return nil, fmt.Errorf("change request not found: %w", err)
+	}
+
+	err = changeRequest.Implement(domain.ChangeImplementation{
+		Implementer:   cmd.Implementer,
+		ImplementedAt: cmd.ImplementedAt,
+		Outcome:       cmd.Outcome,
+		RolledBack:    cmd.RolledBack,
+		Notes:         cmd.Notes,
+	}, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.changeRequestRepo.Update(ctx, changeRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update change request: %w", err)
+	}
+
+	// Publish domain event
+	event := domain.ChangeRequestImplementedEvent{
+		ChangeRequestID: changeRequest.ID,
+		ApplicationID:   changeRequest.ApplicationID,
+		Implementer:     cmd.Implementer,
+		Outcome:         changeRequest.Implementation.Outcome,
+		RolledBack:      changeRequest.Implementation.RolledBack,
+		OccurredAt:      changeRequest.ImplementedAt,
+	}
+
+	err = s.eventRepo.Save(ctx, event)
+	if err != nil {
+		fmt.Printf("Failed to save domain event: %v\n", err)
+	}
+
+	return &changeRequest, nil
+}
+
+// CloseChangeRequest closes an implemented change request once someone has verified whether it
+// achieved its objectives
+func (s *ChangeManagementService) CloseChangeRequest(ctx context.Context, cmd CloseChangeRequestCommand) (*domain.ChangeRequest, error) {
+	ctx, span := s.startSpan(ctx, "ChangeManagementService.CloseChangeRequest")
+	defer span.End()
+
+	changeRequest, err := s.changeRequestRepo.FindByID(ctx, cmd.ChangeRequestID)
+	if err != nil {
+		return nil, fmt.Errorf("change request not found: %w", err)
+	}
+
+	err = changeRequest.Close(domain.ChangeVerification{
+		VerifiedBy: cmd.VerifiedBy,
+		Successful: cmd.Successful,
+		Notes:      cmd.Notes,
+	}, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.changeRequestRepo.Update(ctx, changeRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update change request: %w", err)
+	}
+
+	// Publish domain event
+	event := domain.ChangeRequestClosedEvent{
+		ChangeRequestID: changeRequest.ID,
+		ApplicationID:   changeRequest.ApplicationID,
+		VerifiedBy:      cmd.VerifiedBy,
+		Successful:      cmd.Successful,
+		OccurredAt:      changeRequest.ClosedAt,
+	}
+
+	err = s.eventRepo.Save(ctx, event)
+	if err != nil {
+		fmt.Printf("Failed to save domain event: %v\n", err)
+	}
+
+	return &changeRequest, nil
+}
+
+// WithdrawChangeRequest withdraws a draft or submitted change request at its requester's
+// initiative, recording why
+func (s *ChangeManagementService) WithdrawChangeRequest(ctx context.Context, cmd WithdrawChangeRequestCommand) (*domain.ChangeRequest, error) {
+	ctx, span := s.startSpan(ctx, "ChangeManagementService.WithdrawChangeRequest")
+	defer span.End()
+
+	changeRequest, err := s.changeRequestRepo.FindByID(ctx, cmd.ChangeRequestID)
+	if err != nil {
+		return nil, fmt.Errorf("change request not found: %w", err)
+	}
+
+	previous := changeRequest.Status
+	err = changeRequest.Withdraw(cmd.Requester, cmd.Reason, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.changeRequestRepo.Update(ctx, changeRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update change request: %w", err)
+	}
+
+	// Publish domain event
+	event := domain.ChangeRequestWithdrawnEvent{
+		ChangeRequestID: changeRequest.ID,
+		ApplicationID:   changeRequest.ApplicationID,
+		Requester:       cmd.Requester,
+		PreviousStatus:  previous,
+		Reason:          cmd.Reason,
+		OccurredAt:      changeRequest.UpdatedAt,
+	}
+
+	err = s.eventRepo.Save(ctx, event)
+	if err != nil {
+		fmt.Printf("Failed to save domain event: %v\n", err)
+	}
+
+	return &changeRequest, nil
+}
+
+// CancelChangeRequest cancels an approved change request that will not be implemented. Only one
+// of its approvers may cancel it.
+func (s *ChangeManagementService) CancelChangeRequest(ctx context.Context, cmd CancelChangeRequestCommand) (*domain.ChangeRequest, error) {
+	ctx, span := s.startSpan(ctx, "ChangeManagementService.CancelChangeRequest")
+	defer span.End()
+
+	changeRequest, err := s.changeRequestRepo.FindByID(ctx, cmd.ChangeRequestID)
+	if err != nil {
+		return nil, fmt.Errorf("change request not found: %w", err)
+	}
+
+	err = changeRequest.Cancel(cmd.CancelledBy, cmd.Reason, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.changeRequestRepo.Update(ctx, changeRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update change request: %w", err)
+	}
+
+	// Publish domain event
+	event := domain.ChangeRequestCancelledEvent{
+		ChangeRequestID: changeRequest.ID,
+		ApplicationID:   changeRequest.ApplicationID,
+		CancelledBy:     cmd.CancelledBy,
+		Reason:          cmd.Reason,
+		OccurredAt:      changeRequest.UpdatedAt,
+	}
+
+	err = s.eventRepo.Save(ctx, event)
+	if err != nil {
+		fmt.Printf("Failed to save domain event: %v\n", err)
+	}
+
+	return &changeRequest, nil
+}
+
+// ReportIncident reports a new incident, linked to the changes implemented on its application in
+// the 72 hours before and held to the SLA its governance agreement sets for its severity
 func (s *ChangeManagementService) ReportIncident(ctx context.Context, cmd ReportIncidentCommand) (*domain.Incident, error) {
+	ctx, span := s.startSpan(ctx, "ChangeManagementService.ReportIncident", domain.ApplicationAttribute(cmd.ApplicationID))
+	defer span.End()
+
 	// Verify application exists
-	_, err := s.appRepo.FindByID(ctx, cmd.ApplicationID)
+	app, err := s.appRepo.FindByID(ctx, cmd.ApplicationID)
 	if err != nil {
 		return nil, fmt.Errorf("application not found: %w", err)
 	}
 
+	// Classify the incident, keeping the severity and priority given when its agreement defines them
+	agreement, hasAgreement := s.incidentAgreement(ctx, cmd.ApplicationID)
+	management := agreement.Performance.IncidentManagement
+	criticality := domain.ApplicationCriticality(app, agreement.Strategy.ApplicationCatalogue.Functionality)
+	classification := domain.ClassifyIncident(management, criticality, cmd.Title, cmd.Description, cmd.Impact)
+	severity, priority := classification.Severity, classification.Priority
+	if cmd.Severity != 0 {
+		if err := domain.ValidateIncidentSeverity(management, cmd.Severity); err != nil {
+			return nil, err
+		}
+		severity = cmd.Severity
+		priority = domain.IncidentPriorityFor(management, severity, criticality)
+	}
+	if cmd.Priority != 0 {
+		if err := domain.ValidateIncidentPriority(management, cmd.Priority); err != nil {
+			return nil, err
+		}
+		priority = cmd.Priority
+	}
+
 	incident := domain.Incident{
-		ID:            cmd.ID,
-		ApplicationID: cmd.ApplicationID,
-		Reporter:      cmd.Reporter,
-		Severity:      cmd.Severity,
-		Status:        domain.IncidentStatusOpen,
-		Title:         cmd.Title,
-		Description:   cmd.Description,
-		Impact:        cmd.Impact,
-		CreatedAt:     time.Now(),
-		UpdatedAt:     time.Now(),
+		ID:             cmd.ID,
+		ApplicationID:  cmd.ApplicationID,
+		Reporter:       cmd.Reporter,
+		Severity:       severity,
+		Priority:       priority,
+		Classification: &classification,
+		Status:         domain.IncidentStatusOpen,
+		Title:          cmd.Title,
+		Description:    cmd.Description,
+		Impact:         cmd.Impact,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+
+	// Link the changes implemented on the application just before the incident
+	changes, err := s.changeRequestRepo.FindByApplicationID(ctx, cmd.ApplicationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find change requests: %w", err)
+	}
+	incident.SuspectedChanges = domain.SuspectedChanges(incident, changes, domain.DefaultChangeCorrelationWindow)
+
+	if hasAgreement {
+		if sla, ok := domain.IncidentSLAFor(management, incident.Severity, incident.Priority); ok {
+			incident.ApplySLA(sla)
+		}
 	}
 
 	err = s.incidentRepo.Save(ctx, incident)
@@ -213,7 +599,9 @@ func (s *ChangeManagementService) ReportIncident(ctx context.Context, cmd Report
 		ApplicationID: incident.ApplicationID,
 		Reporter:      incident.Reporter,
 		Severity:      incident.Severity,
+		Priority:      incident.Priority,
 		Description:   incident.Description,
+		SuspectedChanges: incident.SuspectedChanges,
 		OccurredAt:    time.Now(),
 	}
 
@@ -225,8 +613,78 @@ func (s *ChangeManagementService) ReportIncident(ctx context.Context, cmd Report
 	return &incident, nil
 }
 
+// ClassifyIncident suggests the severity and priority of an incident about to be reported from
+// the impact keywords of its application's agreement and the application's criticality
+func (s *ChangeManagementService) ClassifyIncident(ctx context.Context, cmd ClassifyIncidentCommand) (*domain.IncidentClassification, error) {
+	ctx, span := s.startSpan(ctx, "ChangeManagementService.ClassifyIncident", domain.ApplicationAttribute(cmd.ApplicationID))
+	defer span.End()
+
+	app, err := s.appRepo.FindByID(ctx, cmd.ApplicationID)
+	if err != nil {
+		return nil, fmt.Errorf("application not found: %w", err)
+	}
+
+	agreement, _ := s.incidentAgreement(ctx, cmd.ApplicationID)
+	criticality := domain.ApplicationCriticality(app, agreement.Strategy.ApplicationCatalogue.Functionality)
+	classification := domain.ClassifyIncident(agreement.Performance.IncidentManagement, criticality, cmd.Title, cmd.Description, cmd.Impact)
+	return &classification, nil
+}
+
+// AcknowledgeIncident acknowledges an open incident and starts its investigation. An unassigned
+// incident is assigned to whoever acknowledges it.
+func (s *ChangeManagementService) AcknowledgeIncident(ctx context.Context, cmd AcknowledgeIncidentCommand) error {
+	ctx, span := s.startSpan(ctx, "ChangeManagementService.AcknowledgeIncident")
+	defer span.End()
+
+	incident, err := s.incidentRepo.FindByID(ctx, cmd.IncidentID)
+	if err != nil {
+		return fmt.Errorf("incident not found: %w", err)
+	}
+
+	if incident.Status != domain.IncidentStatusOpen {
+		return fmt.Errorf("only open incidents can be acknowledged")
+	}
+
+	now := time.Now()
+	if err := incident.TransitionTo(domain.IncidentStatusInvestigating, now); err != nil {
+		return err
+	}
+	incident.AcknowledgedAt = now
+	if incident.Assignee == "" && cmd.Acknowledger != "" {
+		if _, err := incident.Assign(cmd.Acknowledger, now); err != nil {
+			return err
+		}
+	}
+	breached := incident.EvaluateSLA(incident.AcknowledgedAt)
+
+	err = s.incidentRepo.Update(ctx, incident)
+	if err != nil {
+		return fmt.Errorf("failed to acknowledge incident: %w", err)
+	}
+
+	// Publish domain event
+	event := domain.IncidentAcknowledgedEvent{
+		IncidentID:        incident.ID,
+		ApplicationID:     incident.ApplicationID,
+		Acknowledger:      cmd.Acknowledger,
+		TimeToAcknowledge: incident.AcknowledgedAt.Sub(incident.CreatedAt),
+		OccurredAt:        time.Now(),
+	}
+
+	err = s.eventRepo.Save(ctx, event)
+	if err != nil {
+		fmt.Printf("Failed to save domain event: %v\n", err)
+	}
+	s.publishSLABreaches(ctx, incident, breached)
+
+	return nil
+}
+
 // ResolveIncident resolves an incident
 func (s *ChangeManagementService) ResolveIncident(ctx context.Context, cmd ResolveIncidentCommand) error {
+	ctx, span := s.startSpan(ctx, "ChangeManagementService.ResolveIncident")
+	defer span.End()
+
 	incident, err := s.incidentRepo.FindByID(ctx, cmd.IncidentID)
 	if err != nil {
 		return fmt.Errorf("incident not found: %w", err)
@@ -236,12 +694,15 @@ func (s *ChangeManagementService) ResolveIncident(ctx context.Context, cmd Resol
 		return fmt.Errorf("incident is already resolved or closed")
 	}
 
-	incident.Status = domain.IncidentStatusResolved
+	now := time.Now()
+	if err := incident.TransitionTo(domain.IncidentStatusResolved, now); err != nil {
+		return err
+	}
 	incident.Resolution = cmd.Resolution
 	incident.RootCause = cmd.RootCause
-	incident.TimeToResolve = time.Since(incident.CreatedAt)
-	incident.ResolvedAt = time.Now()
-	incident.UpdatedAt = time.Now()
+	incident.TimeToResolve = now.Sub(incident.CreatedAt)
+	incident.ResolvedAt = now
+	breached := incident.EvaluateSLA(incident.ResolvedAt)
 
 	err = s.incidentRepo.Update(ctx, incident)
 	if err != nil {
@@ -261,45 +722,492 @@ func (s *ChangeManagementService) ResolveIncident(ctx context.Context, cmd Resol
 	if err != nil {
 		fmt.Printf("Failed to save domain event: %v\n", err)
 	}
+	s.publishSLABreaches(ctx, incident, breached)
 
 	return nil
 }
 
-// CreateAudit creates a new audit
-func (s *ChangeManagementService) CreateAudit(ctx context.Context, cmd CreateAuditCommand) (*domain.Audit, error) {
-	// Verify application exists
-	_, err := s.appRepo.FindByID(ctx, cmd.ApplicationID)
+// AssignIncident assigns an incident that is not closed, or reassigns it to someone else
+func (s *ChangeManagementService) AssignIncident(ctx context.Context, cmd AssignIncidentCommand) (*domain.Incident, error) {
+	ctx, span := s.startSpan(ctx, "ChangeManagementService.AssignIncident")
+	defer span.End()
+
+	incident, err := s.incidentRepo.FindByID(ctx, cmd.IncidentID)
 	if err != nil {
-		return nil, fmt.Errorf("application not found: %w", err)
+		return nil, fmt.Errorf("incident not found: %w", err)
 	}
 
-	audit := domain.Audit{
-		ID:            cmd.ID,
-		ApplicationID: cmd.ApplicationID,
-		Auditor:       cmd.Auditor,
-		Type:          cmd.Type,
-		Status:        domain.AuditStatusPlanned,
-		Scope:         cmd.Scope,
-		Findings:      []domain.AuditFinding{},
-		StartedAt:     cmd.StartDate,
+	previous, err := incident.Assign(cmd.Assignee, time.Now())
+	if err != nil {
+		return nil, err
 	}
 
-	err = s.auditRepo.Save(ctx, audit)
+	err = s.incidentRepo.Update(ctx, incident)
 	if err != nil {
-		return nil, fmt.Errorf("failed to save audit: %w", err)
+		return nil, fmt.Errorf("failed to assign incident: %w", err)
 	}
 
-	return &audit, nil
-}
+	// Publish domain event
+	event := domain.IncidentAssignedEvent{
+		IncidentID:       incident.ID,
+		ApplicationID:    incident.ApplicationID,
+		Assignee:         incident.Assignee,
+		PreviousAssignee: previous,
+		AssignedBy:       cmd.AssignedBy,
+		OccurredAt:       incident.AssignedAt,
+	}
 
-// CompleteAudit completes an audit
-func (s *ChangeManagementService) CompleteAudit(ctx context.Context, cmd CompleteAuditCommand) error {
-	audit, err := s.auditRepo.FindByID(ctx, cmd.AuditID)
+	err = s.eventRepo.Save(ctx, event)
 	if err != nil {
-		return fmt.Errorf("audit not found: %w", err)
+		fmt.Printf("Failed to save domain event: %v\n", err)
 	}
 
-	if audit.Status != domain.AuditStatusInProgress {
+	return &incident, nil
+}
+
+// ReopenIncident reopens a resolved incident whose issue came back. It returns to investigating
+// when it had been acknowledged, or to open otherwise, and its resolution is cleared; its SLA
+// keeps running from when it was reported.
+func (s *ChangeManagementService) ReopenIncident(ctx context.Context, cmd ReopenIncidentCommand) (*domain.Incident, error) {
+	ctx, span := s.startSpan(ctx, "ChangeManagementService.ReopenIncident")
+	defer span.End()
+
+	incident, err := s.incidentRepo.FindByID(ctx, cmd.IncidentID)
+	if err != nil {
+		return nil, fmt.Errorf("incident not found: %w", err)
+	}
+	if strings.TrimSpace(cmd.Reason) == "" {
+		return nil, fmt.Errorf("a reason is required to reopen incident %s", incident.ID)
+	}
+
+	now := time.Now()
+	if err := incident.Reopen(now); err != nil {
+		return nil, err
+	}
+	breached := incident.EvaluateSLA(now)
+
+	err = s.incidentRepo.Update(ctx, incident)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reopen incident: %w", err)
+	}
+
+	// Publish domain event
+	event := domain.IncidentReopenedEvent{
+		IncidentID:    incident.ID,
+		ApplicationID: incident.ApplicationID,
+		ReopenedBy:    cmd.ReopenedBy,
+		Reason:        cmd.Reason,
+		ReopenCount:   incident.ReopenCount,
+		OccurredAt:    now,
+	}
+
+	err = s.eventRepo.Save(ctx, event)
+	if err != nil {
+		fmt.Printf("Failed to save domain event: %v\n", err)
+	}
+	s.publishSLABreaches(ctx, incident, breached)
+
+	return &incident, nil
+}
+
+// incidentAgreement finds the governance agreement of an incident's application. It reports
+// false without the agreement repository or when the application has no agreement.
+func (s *ChangeManagementService) incidentAgreement(ctx context.Context, appID domain.ApplicationID) (domain.GovernanceAgreement, bool) {
+	if s.agreementRepo == nil {
+		return domain.GovernanceAgreement{}, false
+	}
+	agreement, err := s.agreementRepo.FindByApplicationID(ctx, appID)
+	if err != nil {
+		return domain.GovernanceAgreement{}, false
+	}
+	return agreement, true
+}
+
+// publishSLABreaches publishes the SLA targets the incident missed by the time it was
+// acknowledged or resolved
+func (s *ChangeManagementService) publishSLABreaches(ctx context.Context, incident domain.Incident, breached []domain.IncidentSLATarget) {
+	if len(breached) == 0 {
+		return
+	}
+	var agreementID domain.GovernanceAgreementID
+	if agreement, ok := s.incidentAgreement(ctx, incident.ApplicationID); ok {
+		agreementID = agreement.ID
+	}
+	for _, target := range breached {
+		err := s.eventRepo.Save(ctx, incident.SLABreachedEvent(agreementID, target, incident.UpdatedAt))
+		if err != nil {
+			fmt.Printf("Failed to save domain event: %v\n", err)
+		}
+	}
+}
+
+// RecordPostIncidentReview records the review of a resolved incident: its timeline, contributing
+// factors and action items, replacing those recorded before. The review stays a draft until
+// completed.
+func (s *ChangeManagementService) RecordPostIncidentReview(ctx context.Context, cmd RecordPostIncidentReviewCommand) (*domain.Incident, error) {
+	ctx, span := s.startSpan(ctx, "ChangeManagementService.RecordPostIncidentReview")
+	defer span.End()
+
+	incident, err := s.incidentRepo.FindByID(ctx, cmd.IncidentID)
+	if err != nil {
+		return nil, fmt.Errorf("incident not found: %w", err)
+	}
+	if incident.Status != domain.IncidentStatusResolved {
+		return nil, fmt.Errorf("only resolved incidents can be reviewed")
+	}
+	if incident.Reviewed() {
+		return nil, fmt.Errorf("review of incident %s is already completed", incident.ID)
+	}
+
+	review := domain.PostIncidentReview{Status: domain.ReviewDraft, StartedAt: time.Now()}
+	if incident.Review != nil {
+		review = *incident.Review
+	}
+	review.Facilitator = cmd.Facilitator
+	review.Summary = cmd.Summary
+	review.Timeline = cmd.Timeline
+	review.ContributingFactors = cmd.ContributingFactors
+	review.ActionItems = make([]domain.RemediationAction, 0, len(cmd.ActionItems))
+	for _, action := range cmd.ActionItems {
+		if action.Status == "" {
+			action.Status = domain.ActionPending
+		}
+		review.ActionItems = append(review.ActionItems, action)
+	}
+	review.SortTimeline()
+	if err := review.Validate(); err != nil {
+		return nil, err
+	}
+
+	incident.Review = &review
+	incident.UpdatedAt = time.Now()
+	err = s.incidentRepo.Update(ctx, incident)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record post-incident review: %w", err)
+	}
+
+	return &incident, nil
+}
+
+// UpdateReviewAction records the progress of an action item of an incident's review
+func (s *ChangeManagementService) UpdateReviewAction(ctx context.Context, cmd UpdateReviewActionCommand) (*domain.RemediationAction, error) {
+	ctx, span := s.startSpan(ctx, "ChangeManagementService.UpdateReviewAction")
+	defer span.End()
+
+	incident, err := s.incidentRepo.FindByID(ctx, cmd.IncidentID)
+	if err != nil {
+		return nil, fmt.Errorf("incident not found: %w", err)
+	}
+	if incident.Review == nil {
+		return nil, fmt.Errorf("incident %s has no post-incident review", incident.ID)
+	}
+
+	switch cmd.Status {
+	case domain.ActionPending, domain.ActionInProgress, domain.ActionCompleted, domain.ActionCancelled:
+	default:
+		return nil, fmt.Errorf("invalid action item status %q", cmd.Status)
+	}
+
+	review := *incident.Review
+	review.ActionItems = append([]domain.RemediationAction{}, review.ActionItems...)
+	var action *domain.RemediationAction
+	for i := range review.ActionItems {
+		if review.ActionItems[i].ID == cmd.ActionID {
+			action = &review.ActionItems[i]
+		}
+	}
+	if action == nil {
+		return nil, fmt.Errorf("action item %s not found in the review of incident %s", cmd.ActionID, incident.ID)
+	}
+	action.Status = cmd.Status
+	action.CompletedAt = time.Time{}
+	if cmd.Status == domain.ActionCompleted {
+		action.CompletedAt = time.Now()
+	}
+
+	incident.Review = &review
+	incident.UpdatedAt = time.Now()
+	err = s.incidentRepo.Update(ctx, incident)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update post-incident review: %w", err)
+	}
+
+	updated := *action
+	return &updated, nil
+}
+
+// CompletePostIncidentReview completes the review of a resolved incident once it has a timeline,
+// contributing factors and action items
+func (s *ChangeManagementService) CompletePostIncidentReview(ctx context.Context, cmd CompletePostIncidentReviewCommand) (*domain.Incident, error) {
+	ctx, span := s.startSpan(ctx, "ChangeManagementService.CompletePostIncidentReview")
+	defer span.End()
+
+	incident, err := s.incidentRepo.FindByID(ctx, cmd.IncidentID)
+	if err != nil {
+		return nil, fmt.Errorf("incident not found: %w", err)
+	}
+	if incident.Review == nil {
+		return nil, fmt.Errorf("incident %s has no post-incident review", incident.ID)
+	}
+	if incident.Reviewed() {
+		return nil, fmt.Errorf("review of incident %s is already completed", incident.ID)
+	}
+	if err := incident.Review.ReadyToComplete(); err != nil {
+		return nil, err
+	}
+
+	review := *incident.Review
+	review.Status = domain.ReviewCompleted
+	review.CompletedAt = time.Now()
+	review.CompletedBy = cmd.CompletedBy
+	incident.Review = &review
+	incident.UpdatedAt = review.CompletedAt
+
+	err = s.incidentRepo.Update(ctx, incident)
+	if err != nil {
+		return nil, fmt.Errorf("failed to complete post-incident review: %w", err)
+	}
+
+	// Publish domain event
+	event := domain.PostIncidentReviewCompletedEvent{
+		IncidentID:          incident.ID,
+		ApplicationID:       incident.ApplicationID,
+		Severity:            incident.Severity,
+		ContributingFactors: review.ContributingFactors,
+		ActionItems:         len(review.ActionItems),
+		CompletedBy:         cmd.CompletedBy,
+		OccurredAt:          review.CompletedAt,
+	}
+
+	err = s.eventRepo.Save(ctx, event)
+	if err != nil {
+		fmt.Printf("Failed to save domain event: %v\n", err)
+	}
+
+	return &incident, nil
+}
+
+// CloseIncident closes a resolved incident with a closure code recording why. Incidents of
+// severity 1 and 2 need a completed post-incident review first.
+func (s *ChangeManagementService) CloseIncident(ctx context.Context, cmd CloseIncidentCommand) error {
+	ctx, span := s.startSpan(ctx, "ChangeManagementService.CloseIncident")
+	defer span.End()
+
+	incident, err := s.incidentRepo.FindByID(ctx, cmd.IncidentID)
+	if err != nil {
+		return fmt.Errorf("incident not found: %w", err)
+	}
+
+	if incident.Status != domain.IncidentStatusResolved {
+		return fmt.Errorf("only resolved incidents can be closed")
+	}
+	if incident.RequiresReview() && !incident.Reviewed() {
+		return fmt.Errorf("severity %d incident %s needs a completed post-incident review before it can be closed", incident.Severity, incident.ID)
+	}
+
+	if err := incident.Close(cmd.ClosureCode, time.Now()); err != nil {
+		return err
+	}
+
+	err = s.incidentRepo.Update(ctx, incident)
+	if err != nil {
+		return fmt.Errorf("failed to close incident: %w", err)
+	}
+
+	// Publish domain event
+	event := domain.IncidentClosedEvent{
+		IncidentID:    incident.ID,
+		ApplicationID: incident.ApplicationID,
+		Closer:        cmd.Closer,
+		ClosureCode:   incident.ClosureCode,
+		Reviewed:      incident.Reviewed(),
+		OccurredAt:    incident.ClosedAt,
+	}
+
+	err = s.eventRepo.Save(ctx, event)
+	if err != nil {
+		fmt.Printf("Failed to save domain event: %v\n", err)
+	}
+
+	return nil
+}
+
+// CreateAudit creates a new audit
+func (s *ChangeManagementService) CreateAudit(ctx context.Context, cmd CreateAuditCommand) (*domain.Audit, error) {
+	ctx, span := s.startSpan(ctx, "ChangeManagementService.CreateAudit", domain.ApplicationAttribute(cmd.ApplicationID))
+	defer span.End()
+
+	// Verify application exists
+	_, err := s.appRepo.FindByID(ctx, cmd.ApplicationID)
+	if err != nil {
+		return nil, fmt.Errorf("application not found: %w", err)
+	}
+
+	if !cmd.DueDate.IsZero() && cmd.DueDate.Before(cmd.StartDate) {
+		return nil, fmt.Errorf("audit due date cannot be before its start date")
+	}
+
+	audit := domain.Audit{
+		ID:            cmd.ID,
+		ApplicationID: cmd.ApplicationID,
+		Auditor:       cmd.Auditor,
+		Type:          cmd.Type,
+		Status:        domain.AuditStatusPlanned,
+		Scope:         cmd.Scope,
+		Findings:      []domain.AuditFinding{},
+		ScheduledAt:   cmd.StartDate,
+		DueDate:       cmd.DueDate,
+	}
+
+	err = s.auditRepo.Save(ctx, audit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save audit: %w", err)
+	}
+
+	return &audit, nil
+}
+
+// StartAudit moves a planned audit, or one that went overdue before it was started, to in progress
+func (s *ChangeManagementService) StartAudit(ctx context.Context, cmd StartAuditCommand) error {
+	ctx, span := s.startSpan(ctx, "ChangeManagementService.StartAudit")
+	defer span.End()
+
+	audit, err := s.auditRepo.FindByID(ctx, cmd.AuditID)
+	if err != nil {
+		return fmt.Errorf("audit not found: %w", err)
+	}
+
+	if !audit.CanStart() {
+		return fmt.Errorf("only planned or overdue audits that have not started can be started")
+	}
+
+	audit.Status = domain.AuditStatusInProgress
+	audit.StartedAt = time.Now()
+	if audit.IsOverdue(audit.StartedAt) {
+		audit.Status = domain.AuditStatusOverdue
+	}
+
+	err = s.auditRepo.Update(ctx, audit)
+	if err != nil {
+		return fmt.Errorf("failed to start audit: %w", err)
+	}
+
+	// Publish domain event
+	event := domain.AuditStartedEvent{
+		AuditID:       audit.ID,
+		ApplicationID: audit.ApplicationID,
+		Auditor:       audit.Auditor,
+		DueDate:       audit.DueDate,
+		OccurredAt:    audit.StartedAt,
+	}
+
+	err = s.eventRepo.Save(ctx, event)
+	if err != nil {
+		fmt.Printf("Failed to save domain event: %v\n", err)
+	}
+
+	return nil
+}
+
+// CancelAudit cancels an audit that is not completed
+func (s *ChangeManagementService) CancelAudit(ctx context.Context, cmd CancelAuditCommand) error {
+	ctx, span := s.startSpan(ctx, "ChangeManagementService.CancelAudit")
+	defer span.End()
+
+	audit, err := s.auditRepo.FindByID(ctx, cmd.AuditID)
+	if err != nil {
+		return fmt.Errorf("audit not found: %w", err)
+	}
+
+	if !audit.CanCancel() {
+		return fmt.Errorf("completed or cancelled audits cannot be cancelled")
+	}
+
+	audit.Status = domain.AuditStatusCancelled
+	audit.CancelledAt = time.Now()
+	audit.CancellationReason = cmd.Reason
+
+	err = s.auditRepo.Update(ctx, audit)
+	if err != nil {
+		return fmt.Errorf("failed to cancel audit: %w", err)
+	}
+
+	// Publish domain event
+	event := domain.AuditCancelledEvent{
+		AuditID:       audit.ID,
+		ApplicationID: audit.ApplicationID,
+		CancelledBy:   cmd.CancelledBy,
+		Reason:        cmd.Reason,
+		OccurredAt:    audit.CancelledAt,
+	}
+
+	err = s.eventRepo.Save(ctx, event)
+	if err != nil {
+		fmt.Printf("Failed to save domain event: %v\n", err)
+	}
+
+	return nil
+}
+
+// MarkOverdueAudits marks the planned and in progress audits whose due date has passed as overdue
+// and returns them. Overdue audits can still be started, when they were not, and completed.
+func (s *ChangeManagementService) MarkOverdueAudits(ctx context.Context, cmd MarkOverdueAuditsCommand) ([]domain.Audit, error) {
+	ctx, span := s.startSpan(ctx, "ChangeManagementService.MarkOverdueAudits")
+	defer span.End()
+
+	if cmd.Now.IsZero() {
+		cmd.Now = time.Now()
+	}
+
+	var overdue []domain.Audit
+	for _, status := range []domain.AuditStatus{domain.AuditStatusPlanned, domain.AuditStatusInProgress} {
+		audits, err := s.auditRepo.FindByStatus(ctx, status)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get audits: %w", err)
+		}
+		for _, audit := range audits {
+			if !audit.IsOverdue(cmd.Now) {
+				continue
+			}
+
+			audit.Status = domain.AuditStatusOverdue
+			err = s.auditRepo.Update(ctx, audit)
+			if err != nil {
+				return overdue, fmt.Errorf("failed to mark audit %s overdue: %w", audit.ID, err)
+			}
+			overdue = append(overdue, audit)
+
+			// Publish domain event
+			event := domain.AuditOverdueEvent{
+				AuditID:       audit.ID,
+				ApplicationID: audit.ApplicationID,
+				Auditor:       audit.Auditor,
+				DueDate:       audit.DueDate,
+				Started:       !audit.StartedAt.IsZero(),
+				OccurredAt:    cmd.Now,
+			}
+
+			err = s.eventRepo.Save(ctx, event)
+			if err != nil {
+				fmt.Printf("Failed to save domain event: %v\n", err)
+			}
+		}
+	}
+
+	return overdue, nil
+}
+
+// CompleteAudit completes an audit in progress, or one that went overdue after it was started
+func (s *ChangeManagementService) CompleteAudit(ctx context.Context, cmd CompleteAuditCommand) error {
+	ctx, span := s.startSpan(ctx, "ChangeManagementService.CompleteAudit")
+	defer span.End()
+
+	audit, err := s.auditRepo.FindByID(ctx, cmd.AuditID)
+	if err != nil {
+		return fmt.Errorf("audit not found: %w", err)
+	}
+
+	if !audit.CanComplete() {
 		return fmt.Errorf("audit is not in progress")
 	}
 
@@ -338,8 +1246,229 @@ func (s *ChangeManagementService) CompleteAudit(ctx context.Context, cmd Complet
 	return nil
 }
 
+// AddRemediationAction adds an action remediating a finding of an audit that is not closed or
+// cancelled. Actions start pending unless given another status.
+func (s *ChangeManagementService) AddRemediationAction(ctx context.Context, cmd AddRemediationActionCommand) (*domain.AuditFinding, error) {
+	ctx, span := s.startSpan(ctx, "ChangeManagementService.AddRemediationAction")
+	defer span.End()
+
+	audit, err := s.auditRepo.FindByID(ctx, cmd.AuditID)
+	if err != nil {
+		return nil, fmt.Errorf("audit not found: %w", err)
+	}
+	if audit.Status == domain.AuditStatusClosed || audit.Status == domain.AuditStatusCancelled {
+		return nil, fmt.Errorf("findings of %s audits cannot be remediated", audit.Status)
+	}
+	i := audit.FindingIndex(cmd.FindingID)
+	if i < 0 {
+		return nil, fmt.Errorf("finding %s not found in audit %s", cmd.FindingID, cmd.AuditID)
+	}
+
+	action := cmd.Action
+	if action.ID == "" {
+		return nil, fmt.Errorf("remediation action ID cannot be empty")
+	}
+	if action.Owner == "" {
+		return nil, fmt.Errorf("remediation action %s requires an owner", action.ID)
+	}
+	for _, existing := range audit.Findings[i].Actions {
+		if existing.ID == action.ID {
+			return nil, fmt.Errorf("remediation action %s already exists", action.ID)
+		}
+	}
+	if action.Status == "" {
+		action.Status = domain.ActionPending
+	}
+	if action.Status == domain.ActionCompleted && action.CompletedAt.IsZero() {
+		action.CompletedAt = time.Now()
+	}
+
+	findings := append([]domain.AuditFinding{}, audit.Findings...)
+	findings[i].Actions = append(append([]domain.RemediationAction{}, findings[i].Actions...), action)
+	audit.Findings = findings
+
+	err = s.auditRepo.Update(ctx, audit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update audit: %w", err)
+	}
+
+	// Publish domain event
+	event := domain.RemediationActionRecordedEvent{
+		AuditID:       audit.ID,
+		FindingID:     cmd.FindingID,
+		ApplicationID: audit.ApplicationID,
+		ActionID:      action.ID,
+		Owner:         action.Owner,
+		DueDate:       action.DueDate,
+		Status:        action.Status,
+		RecordedBy:    cmd.RecordedBy,
+		OccurredAt:    time.Now(),
+	}
+
+	err = s.eventRepo.Save(ctx, event)
+	if err != nil {
+		fmt.Printf("Failed to save domain event: %v\n", err)
+	}
+
+	finding := audit.Findings[i]
+	return &finding, nil
+}
+
+// UpdateRemediationAction changes the status of a remediation action, and publishes a
+// FindingRemediatedEvent when it leaves the finding remediated
+func (s *ChangeManagementService) UpdateRemediationAction(ctx context.Context, cmd UpdateRemediationActionCommand) (*domain.AuditFinding, error) {
+	ctx, span := s.startSpan(ctx, "ChangeManagementService.UpdateRemediationAction")
+	defer span.End()
+
+	audit, err := s.auditRepo.FindByID(ctx, cmd.AuditID)
+	if err != nil {
+		return nil, fmt.Errorf("audit not found: %w", err)
+	}
+	if audit.Status == domain.AuditStatusClosed || audit.Status == domain.AuditStatusCancelled {
+		return nil, fmt.Errorf("findings of %s audits cannot be remediated", audit.Status)
+	}
+	i := audit.FindingIndex(cmd.FindingID)
+	if i < 0 {
+		return nil, fmt.Errorf("finding %s not found in audit %s", cmd.FindingID, cmd.AuditID)
+	}
+
+	switch cmd.Status {
+	case domain.ActionPending, domain.ActionInProgress, domain.ActionCompleted, domain.ActionCancelled:
+	default:
+		return nil, fmt.Errorf("invalid remediation action status %q", cmd.Status)
+	}
+
+	findings := append([]domain.AuditFinding{}, audit.Findings...)
+	actions := append([]domain.RemediationAction{}, findings[i].Actions...)
+	wasRemediated := findings[i].Remediated()
+	found := false
+	var action domain.RemediationAction
+	for j := range actions {
+		if actions[j].ID != cmd.ActionID {
+			continue
+		}
+		actions[j].Status = cmd.Status
+		actions[j].CompletedAt = time.Time{}
+		if cmd.Status == domain.ActionCompleted {
+			actions[j].CompletedAt = time.Now()
+		}
+		action = actions[j]
+		found = true
+	}
+	if !found {
+		return nil, fmt.Errorf("remediation action %s not found for finding %s", cmd.ActionID, cmd.FindingID)
+	}
+	findings[i].Actions = actions
+	audit.Findings = findings
+
+	err = s.auditRepo.Update(ctx, audit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update audit: %w", err)
+	}
+
+	// Publish domain events
+	now := time.Now()
+	events := []domain.DomainEvent{domain.RemediationActionRecordedEvent{
+		AuditID:       audit.ID,
+		FindingID:     cmd.FindingID,
+		ApplicationID: audit.ApplicationID,
+		ActionID:      action.ID,
+		Owner:         action.Owner,
+		DueDate:       action.DueDate,
+		Status:        action.Status,
+		RecordedBy:    cmd.UpdatedBy,
+		OccurredAt:    now,
+	}}
+	if !wasRemediated && findings[i].Remediated() {
+		events = append(events, domain.FindingRemediatedEvent{
+			AuditID:       audit.ID,
+			FindingID:     cmd.FindingID,
+			ApplicationID: audit.ApplicationID,
+			Severity:      findings[i].Severity,
+			OccurredAt:    now,
+		})
+	}
+	for _, event := range events {
+		err = s.eventRepo.Save(ctx, event)
+		if err != nil {
+			fmt.Printf("Failed to save domain event: %v\n", err)
+		}
+	}
+
+	finding := audit.Findings[i]
+	return &finding, nil
+}
+
+// CloseAudit closes a completed audit. The audit closure policy keeps it open while findings of
+// a blocking severity, by default critical ones, are not remediated.
+func (s *ChangeManagementService) CloseAudit(ctx context.Context, cmd CloseAuditCommand) error {
+	ctx, span := s.startSpan(ctx, "ChangeManagementService.CloseAudit")
+	defer span.End()
+
+	audit, err := s.auditRepo.FindByID(ctx, cmd.AuditID)
+	if err != nil {
+		return fmt.Errorf("audit not found: %w", err)
+	}
+
+	if audit.Status != domain.AuditStatusCompleted {
+		return fmt.Errorf("only completed audits can be closed")
+	}
+	if blocking := s.closurePolicy.BlockingFindings(audit); len(blocking) > 0 {
+		return fmt.Errorf("audit %s has %d %s findings not remediated, e.g. %s", audit.ID, len(blocking),
+			strings.Join(s.closurePolicy.BlockingSeverities, " or "), blocking[0].ID)
+	}
+
+	open := 0
+	for _, finding := range audit.Findings {
+		if !finding.Remediated() {
+			open++
+		}
+	}
+	audit.Status = domain.AuditStatusClosed
+	audit.ClosedAt = time.Now()
+
+	err = s.auditRepo.Update(ctx, audit)
+	if err != nil {
+		return fmt.Errorf("failed to close audit: %w", err)
+	}
+
+	// Publish domain event
+	event := domain.AuditClosedEvent{
+		AuditID:       audit.ID,
+		ApplicationID: audit.ApplicationID,
+		ClosedBy:      cmd.ClosedBy,
+		OpenFindings:  open,
+		OccurredAt:    audit.ClosedAt,
+	}
+
+	err = s.eventRepo.Save(ctx, event)
+	if err != nil {
+		fmt.Printf("Failed to save domain event: %v\n", err)
+	}
+
+	return nil
+}
+
+// GetFindingBacklog rolls up the remediation of the findings of an application's audits, with
+// the open findings by severity
+func (s *ChangeManagementService) GetFindingBacklog(ctx context.Context, appID domain.ApplicationID) (*domain.FindingBacklog, error) {
+	ctx, span := s.startSpan(ctx, "ChangeManagementService.GetFindingBacklog", domain.ApplicationAttribute(appID))
+	defer span.End()
+
+	audits, err := s.auditRepo.FindByApplicationID(ctx, appID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get audits: %w", err)
+	}
+
+	backlog := domain.BuildFindingBacklog(appID, audits, time.Now())
+	return &backlog, nil
+}
+
 // GetChangeRequestsByApplication retrieves change requests for an application
 func (s *ChangeManagementService) GetChangeRequestsByApplication(ctx context.Context, appID domain.ApplicationID) ([]domain.ChangeRequest, error) {
+	ctx, span := s.startSpan(ctx, "ChangeManagementService.GetChangeRequestsByApplication", domain.ApplicationAttribute(appID))
+	defer span.End()
+
 	changeRequests, err := s.changeRequestRepo.FindByApplicationID(ctx, appID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get change requests: %w", err)
@@ -349,6 +1478,9 @@ func (s *ChangeManagementService) GetChangeRequestsByApplication(ctx context.Con
 
 // GetIncidentsByApplication retrieves incidents for an application
 func (s *ChangeManagementService) GetIncidentsByApplication(ctx context.Context, appID domain.ApplicationID) ([]domain.Incident, error) {
+	ctx, span := s.startSpan(ctx, "ChangeManagementService.GetIncidentsByApplication", domain.ApplicationAttribute(appID))
+	defer span.End()
+
 	incidents, err := s.incidentRepo.FindByApplicationID(ctx, appID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get incidents: %w", err)
@@ -356,8 +1488,113 @@ func (s *ChangeManagementService) GetIncidentsByApplication(ctx context.Context,
 	return incidents, nil
 }
 
+// GetIncidentsByAssignee retrieves the incidents assigned to someone, most recently reported first
+func (s *ChangeManagementService) GetIncidentsByAssignee(ctx context.Context, assignee string) ([]domain.Incident, error) {
+	ctx, span := s.startSpan(ctx, "ChangeManagementService.GetIncidentsByAssignee")
+	defer span.End()
+
+	incidents, err := s.incidentRepo.FindByAssignee(ctx, assignee)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get incidents: %w", err)
+	}
+	sort.SliceStable(incidents, func(i, j int) bool { return incidents[i].CreatedAt.After(incidents[j].CreatedAt) })
+	return incidents, nil
+}
+
+// GetOperationalMetrics computes an application's MTTA, MTTR, incident frequency and severity
+// distribution over the incidents reported in the last 90 days
+func (s *ChangeManagementService) GetOperationalMetrics(ctx context.Context, appID domain.ApplicationID) (*domain.OperationalMetrics, error) {
+	ctx, span := s.startSpan(ctx, "ChangeManagementService.GetOperationalMetrics", domain.ApplicationAttribute(appID))
+	defer span.End()
+
+	incidents, err := s.incidentRepo.FindByApplicationID(ctx, appID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get incidents: %w", err)
+	}
+
+	now := time.Now()
+	metrics := domain.BuildOperationalMetrics(appID, incidents, now.Add(-domain.DefaultOperationalWindow), now)
+	return &metrics, nil
+}
+
+// GetChangeFailureRate computes the share of an application's changes implemented in the last 90
+// days that were followed by incidents, and the changes likely causing them
+func (s *ChangeManagementService) GetChangeFailureRate(ctx context.Context, appID domain.ApplicationID) (*domain.ChangeFailureRate, error) {
+	ctx, span := s.startSpan(ctx, "ChangeManagementService.GetChangeFailureRate", domain.ApplicationAttribute(appID))
+	defer span.End()
+
+	changes, err := s.changeRequestRepo.FindByApplicationID(ctx, appID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get change requests: %w", err)
+	}
+	incidents, err := s.incidentRepo.FindByApplicationID(ctx, appID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get incidents: %w", err)
+	}
+
+	now := time.Now()
+	rate := domain.BuildChangeFailureRate(appID, changes, incidents, domain.DefaultChangeCorrelationWindow, now.Add(-domain.DefaultOperationalWindow), now)
+	return &rate, nil
+}
+
+// GetChangeMetrics computes the change volume, lead time, success rate and emergency change ratio
+// of an application, or of all applications of a portfolio, over a window broken down into
+// periods
+func (s *ChangeManagementService) GetChangeMetrics(ctx context.Context, cmd GetChangeMetricsCommand) (*domain.ChangeMetrics, error) {
+	ctx, span := s.startSpan(ctx, "ChangeManagementService.GetChangeMetrics", domain.ApplicationAttribute(cmd.ApplicationID), domain.PortfolioAttribute(cmd.PortfolioID))
+	defer span.End()
+
+	if (cmd.ApplicationID == "") == (cmd.PortfolioID == "") {
+		return nil, fmt.Errorf("change metrics need either an application or a portfolio")
+	}
+	if cmd.Now.IsZero() {
+		cmd.Now = time.Now()
+	}
+	if cmd.Window <= 0 {
+		cmd.Window = domain.DefaultOperationalWindow
+	}
+
+	appIDs := []domain.ApplicationID{cmd.ApplicationID}
+	if cmd.PortfolioID != "" {
+		if s.portfolioRepo == nil {
+			return nil, fmt.Errorf("change metrics of portfolios need a portfolio repository")
+		}
+		portfolio, err := s.portfolioRepo.FindByID(ctx, cmd.PortfolioID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find portfolio: %w", err)
+		}
+		appIDs = appIDs[:0]
+		for _, app := range portfolio.Applications {
+			appIDs = append(appIDs, app.ID)
+		}
+	}
+
+	var changes []domain.ChangeRequest
+	var incidents []domain.Incident
+	for _, appID := range appIDs {
+		found, err := s.changeRequestRepo.FindByApplicationID(ctx, appID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get change requests: %w", err)
+		}
+		changes = append(changes, found...)
+		reported, err := s.incidentRepo.FindByApplicationID(ctx, appID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get incidents: %w", err)
+		}
+		incidents = append(incidents, reported...)
+	}
+
+	metrics := domain.BuildChangeMetrics(changes, incidents, domain.DefaultChangeCorrelationWindow, cmd.Now.Add(-cmd.Window), cmd.Now, cmd.Period)
+	metrics.ApplicationID = cmd.ApplicationID
+	metrics.PortfolioID = cmd.PortfolioID
+	return &metrics, nil
+}
+
 // GetAuditsByApplication retrieves audits for an application
 func (s *ChangeManagementService) GetAuditsByApplication(ctx context.Context, appID domain.ApplicationID) ([]domain.Audit, error) {
+	ctx, span := s.startSpan(ctx, "ChangeManagementService.GetAuditsByApplication", domain.ApplicationAttribute(appID))
+	defer span.End()
+
 	audits, err := s.auditRepo.FindByApplicationID(ctx, appID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get audits: %w", err)
@@ -394,16 +1631,57 @@ type RejectChangeRequestCommand struct {
 	Comments        string
 }
 
+type ImplementChangeRequestCommand struct {
+	ChangeRequestID string
+	Implementer     string
+	ImplementedAt   time.Time                    // optional, defaults to now
+	Outcome         domain.ImplementationOutcome // optional, defaults to successful
+	RolledBack      bool
+	Notes           string
+}
+
+type CloseChangeRequestCommand struct {
+	ChangeRequestID string
+	VerifiedBy      string
+	Successful      bool // the change achieved its objectives
+	Notes           string
+}
+
+type WithdrawChangeRequestCommand struct {
+	ChangeRequestID string
+	Requester       string // must be the change request's requester
+	Reason          string
+}
+
+type CancelChangeRequestCommand struct {
+	ChangeRequestID string
+	CancelledBy     string // must be one of the change request's approvers
+	Reason          string
+}
+
 type ReportIncidentCommand struct {
 	ID            string
 	ApplicationID domain.ApplicationID
 	Reporter      string
-	Severity      int
+	Severity      int // optional, suggested from the agreement's classification matrix when zero
+	Priority      int // optional, suggested from the severity and application criticality when zero
 	Title         string
 	Description   string
 	Impact        string
 }
 
+type ClassifyIncidentCommand struct {
+	ApplicationID domain.ApplicationID
+	Title         string
+	Description   string
+	Impact        string
+}
+
+type AcknowledgeIncidentCommand struct {
+	IncidentID   string
+	Acknowledger string
+}
+
 type ResolveIncidentCommand struct {
 	IncidentID string
 	Resolver   string
@@ -411,6 +1689,44 @@ type ResolveIncidentCommand struct {
 	RootCause  string
 }
 
+type RecordPostIncidentReviewCommand struct {
+	IncidentID          string
+	Facilitator         string
+	Summary             string
+	Timeline            []domain.TimelineEntry
+	ContributingFactors []string
+	ActionItems         []domain.RemediationAction // each with an owner; pending unless a status is given
+}
+
+type UpdateReviewActionCommand struct {
+	IncidentID string
+	ActionID   string
+	Status     domain.ActionStatus
+}
+
+type CompletePostIncidentReviewCommand struct {
+	IncidentID  string
+	CompletedBy string
+}
+
+type AssignIncidentCommand struct {
+	IncidentID string
+	Assignee   string
+	AssignedBy string
+}
+
+type ReopenIncidentCommand struct {
+	IncidentID string
+	ReopenedBy string
+	Reason     string
+}
+
+type CloseIncidentCommand struct {
+	IncidentID  string
+	Closer      string
+	ClosureCode domain.IncidentClosureCode
+}
+
 type CreateAuditCommand struct {
 	ID            string
 	ApplicationID domain.ApplicationID
@@ -418,6 +1734,21 @@ type CreateAuditCommand struct {
 	Type          domain.AuditType
 	Scope         string
 	StartDate     time.Time
+	DueDate       time.Time // optional, the audit goes overdue when it is not completed by then
+}
+
+type StartAuditCommand struct {
+	AuditID string
+}
+
+type CancelAuditCommand struct {
+	AuditID     string
+	CancelledBy string
+	Reason      string
+}
+
+type MarkOverdueAuditsCommand struct {
+	Now time.Time // optional, defaults to now
 }
 
 type CompleteAuditCommand struct {
@@ -425,3 +1756,31 @@ type CompleteAuditCommand struct {
 	Findings       []domain.AuditFinding
 	Recommendations []string
 }
+
+type AddRemediationActionCommand struct {
+	AuditID    string
+	FindingID  string
+	Action     domain.RemediationAction
+	RecordedBy string
+}
+
+type UpdateRemediationActionCommand struct {
+	AuditID   string
+	FindingID string
+	ActionID  string
+	Status    domain.ActionStatus
+	UpdatedBy string
+}
+
+type CloseAuditCommand struct {
+	AuditID  string
+	ClosedBy string
+}
+
+type GetChangeMetricsCommand struct {
+	ApplicationID domain.ApplicationID // either an application
+	PortfolioID   domain.PortfolioID   // or a portfolio
+	Window        time.Duration        // optional, defaults to the last 90 days
+	Period        time.Duration        // optional, defaults to 30 days
+	Now           time.Time            // optional, defaults to now
+}
@@ -0,0 +1,131 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// ComplianceReportService reports the compliance of an agreement, or of every agreement of a
+// portfolio, for handing to an external auditor: each requirement with its status, the documents
+// implementing it and the evidence of its controls, the open gaps and the remediation planned
+// for them. The infrastructure/export package writes reports as Markdown or PDF.
+type ComplianceReportService struct {
+	instrumentation
+
+	portfolioRepo   domain.ApplicationPortfolioRepository
+	agreementRepo   domain.GovernanceAgreementRepository
+	frameworkRepo   domain.FrameworkRepository
+	mappingRepo     domain.ControlMappingRepository // nil relates evidence and findings to their own controls only
+	auditRepo       domain.AuditRepository          // nil leaves audit findings out of reports
+	attachmentStore domain.AttachmentStore          // nil leaves evidence out of reports
+	now             func() time.Time
+}
+
+// NewComplianceReportService creates a new compliance report service. The mapping repository,
+// audit repository and attachment store may be nil, in which case evidence and findings only
+// relate to their own controls, findings are left out, and evidence is left out.
+func NewComplianceReportService(
+	portfolioRepo domain.ApplicationPortfolioRepository,
+	agreementRepo domain.GovernanceAgreementRepository,
+	frameworkRepo domain.FrameworkRepository,
+	mappingRepo domain.ControlMappingRepository,
+	auditRepo domain.AuditRepository,
+	attachmentStore domain.AttachmentStore,
+	opts ...ServiceOption,
+) *ComplianceReportService {
+	return &ComplianceReportService{
+		portfolioRepo:   portfolioRepo,
+		agreementRepo:   agreementRepo,
+		frameworkRepo:   frameworkRepo,
+		mappingRepo:     mappingRepo,
+		auditRepo:       auditRepo,
+		attachmentStore: attachmentStore,
+		now:             time.Now,
+		instrumentation: newInstrumentation(opts),
+	}
+}
+
+// GenerateReport reports the compliance of an agreement or, when none is given, of the agreements
+// of a portfolio's applications. Applications without an agreement are left out.
+func (s *ComplianceReportService) GenerateReport(ctx context.Context, cmd GenerateComplianceReportCommand) (*domain.ComplianceReport, error) {
+	ctx, span := s.startSpan(ctx, "ComplianceReportService.GenerateReport", domain.AgreementAttribute(cmd.AgreementID), domain.PortfolioAttribute(cmd.PortfolioID))
+	defer span.End()
+
+	var agreements []domain.GovernanceAgreement
+	var title string
+	var portfolioID domain.PortfolioID
+	switch {
+	case cmd.AgreementID != "":
+		agreement, err := s.agreementRepo.FindByID(ctx, cmd.AgreementID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find governance agreement: %w", err)
+		}
+		agreements = append(agreements, agreement)
+		title = agreement.Title
+	case cmd.PortfolioID != "":
+		portfolio, err := s.portfolioRepo.FindByID(ctx, cmd.PortfolioID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find portfolio: %w", err)
+		}
+		for _, app := range portfolio.Applications {
+			agreement, err := s.agreementRepo.FindByApplicationID(ctx, app.ID)
+			if err != nil {
+				// Applications without a governance agreement have no requirements to report
+				continue
+			}
+			agreements = append(agreements, agreement)
+		}
+		title = portfolio.Name
+		portfolioID = portfolio.ID
+	default:
+		return nil, fmt.Errorf("an agreement or a portfolio is required")
+	}
+	if cmd.Title != "" {
+		title = cmd.Title
+	}
+
+	frameworks, err := s.frameworkRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find compliance frameworks: %w", err)
+	}
+	var mappings []domain.ControlMapping
+	if s.mappingRepo != nil {
+		mappings, err = s.mappingRepo.FindAll(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find control mappings: %w", err)
+		}
+	}
+
+	now := s.now()
+	reports := make([]domain.AgreementComplianceReport, 0, len(agreements))
+	for _, agreement := range agreements {
+		source := domain.ComplianceReportSource{Agreement: agreement, Frameworks: frameworks, Mappings: mappings}
+		if s.attachmentStore != nil {
+			source.Evidence, err = s.attachmentStore.FindByApplicationID(ctx, agreement.ApplicationID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to find evidence of %s: %w", agreement.ApplicationID, err)
+			}
+		}
+		if s.auditRepo != nil {
+			source.Audits, err = s.auditRepo.FindByApplicationID(ctx, agreement.ApplicationID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to find audits of %s: %w", agreement.ApplicationID, err)
+			}
+		}
+		reports = append(reports, domain.BuildAgreementComplianceReport(source, now))
+	}
+
+	report := domain.BuildComplianceReport(title, portfolioID, reports, now)
+	return &report, nil
+}
+
+// Commands for Compliance Report Service
+
+type GenerateComplianceReportCommand struct {
+	AgreementID domain.GovernanceAgreementID // reports this agreement alone when given
+	PortfolioID domain.PortfolioID
+	Title       string // optional, defaults to the agreement title or portfolio name
+}
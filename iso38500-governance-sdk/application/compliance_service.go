@@ -0,0 +1,949 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// ComplianceService attaches the requirements of compliance frameworks such as GDPR, ISO/IEC
+// 27001:2022, SOC 2 or the NIST CSF to governance agreements, so their conformance lists the
+// articles and controls they are held to without each being written by hand. Controls of
+// different frameworks satisfied by the same implementation are mapped to each other, so the
+// status an audit finding or evidence item shows for one control is applied to every requirement
+// it maps to. Compliance assessments go through a framework control by control, score the
+// agreement's weighted compliance and, once completed, feed the statuses found into its
+// conformance. When evidence goes stale, the statuses resting on it are put back under review,
+// and certifications and contracts that expire are made non-compliant.
+// Control catalogs and assessment results kept in OSCAL, NIST's JSON format, can be imported.
+type ComplianceService struct {
+	instrumentation
+
+	frameworkRepo   domain.FrameworkRepository
+	mappingRepo     domain.ControlMappingRepository       // nil applies statuses to the control alone
+	assessmentRepo  domain.ComplianceAssessmentRepository // nil when compliance assessments are not run
+	agreementRepo   domain.GovernanceAgreementRepository
+	auditRepo       domain.AuditRepository // nil when audit findings are not applied
+	attachmentStore domain.AttachmentStore // nil when evidence is not applied
+	eventRepo       domain.DomainEventRepository
+	complianceRepo  domain.ComplianceRepository // nil when no compliance register is kept
+}
+
+// NewComplianceService creates a new compliance service. The mapping repository may be nil, in
+// which case statuses apply to the control alone. The assessment repository may be nil when
+// compliance assessments are not run, and the audit repository and attachment store when audit
+// findings or evidence are not applied.
+func NewComplianceService(
+	frameworkRepo domain.FrameworkRepository,
+	mappingRepo domain.ControlMappingRepository,
+	assessmentRepo domain.ComplianceAssessmentRepository,
+	agreementRepo domain.GovernanceAgreementRepository,
+	auditRepo domain.AuditRepository,
+	attachmentStore domain.AttachmentStore,
+	eventRepo domain.DomainEventRepository,
+	opts ...ServiceOption,
+) *ComplianceService {
+	return &ComplianceService{
+		frameworkRepo:   frameworkRepo,
+		mappingRepo:     mappingRepo,
+		assessmentRepo:  assessmentRepo,
+		agreementRepo:   agreementRepo,
+		auditRepo:       auditRepo,
+		attachmentStore: attachmentStore,
+		eventRepo:       eventRepo,
+		instrumentation: newInstrumentation(opts),
+	}
+}
+
+// SetComplianceRepository keeps the compliance register in step with the conformance of the
+// agreements the service changes
+func (s *ComplianceService) SetComplianceRepository(repo domain.ComplianceRepository) {
+	s.complianceRepo = repo
+}
+
+// ListFrameworks returns the compliance frameworks of the catalog, ordered by ID
+func (s *ComplianceService) ListFrameworks(ctx context.Context) ([]domain.ComplianceFramework, error) {
+	ctx, span := s.startSpan(ctx, "ComplianceService.ListFrameworks")
+	defer span.End()
+
+	frameworks, err := s.frameworkRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find compliance frameworks: %w", err)
+	}
+	return frameworks, nil
+}
+
+// GetFramework returns a compliance framework of the catalog
+func (s *ComplianceService) GetFramework(ctx context.Context, frameworkID string) (*domain.ComplianceFramework, error) {
+	ctx, span := s.startSpan(ctx, "ComplianceService.GetFramework")
+	defer span.End()
+
+	framework, err := s.frameworkRepo.FindByID(ctx, frameworkID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find compliance framework: %w", err)
+	}
+	return &framework, nil
+}
+
+// ImportFramework adds a compliance framework to the catalog, replacing the one with its ID
+func (s *ComplianceService) ImportFramework(ctx context.Context, framework domain.ComplianceFramework) error {
+	ctx, span := s.startSpan(ctx, "ComplianceService.ImportFramework")
+	defer span.End()
+
+	if err := framework.Validate(); err != nil {
+		return err
+	}
+	err := s.frameworkRepo.Save(ctx, framework)
+	if err != nil {
+		return fmt.Errorf("failed to save compliance framework: %w", err)
+	}
+	return nil
+}
+
+// ImportOSCALCatalog adds an OSCAL control catalog, such as NIST SP 800-53, to the catalog of
+// compliance frameworks, replacing the framework with its ID, and returns it
+func (s *ComplianceService) ImportOSCALCatalog(ctx context.Context, cmd ImportOSCALCatalogCommand) (*domain.ComplianceFramework, error) {
+	ctx, span := s.startSpan(ctx, "ComplianceService.ImportOSCALCatalog")
+	defer span.End()
+
+	framework, err := domain.LoadOSCALCatalog(cmd.Catalog, cmd.FrameworkID, cmd.Kind)
+	if err != nil {
+		return nil, err
+	}
+	err = s.frameworkRepo.Save(ctx, framework)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save compliance framework: %w", err)
+	}
+	return &framework, nil
+}
+
+// OSCALImport is the outcome of loading OSCAL assessment results into an agreement's conformance
+type OSCALImport struct {
+	Title    string
+	Attached []domain.RequirementRef // requirements the agreement did not have yet
+	Applied  []AppliedControlStatus
+}
+
+// ImportOSCALAssessmentResults loads the control statuses found by OSCAL assessment results into
+// the conformance of the application's agreement. Controls of the framework the agreement has no
+// requirement for yet are attached first; the statuses then apply to every control mapped to the
+// assessed ones too.
+func (s *ComplianceService) ImportOSCALAssessmentResults(ctx context.Context, cmd ImportOSCALAssessmentResultsCommand) (*OSCALImport, error) {
+	ctx, span := s.startSpan(ctx, "ComplianceService.ImportOSCALAssessmentResults", domain.ApplicationAttribute(cmd.ApplicationID))
+	defer span.End()
+
+	results, err := domain.LoadOSCALAssessmentResults(cmd.Results)
+	if err != nil {
+		return nil, err
+	}
+	if len(results.Controls) == 0 {
+		return nil, fmt.Errorf("OSCAL assessment results have no findings on controls")
+	}
+	framework, err := s.frameworkRepo.FindByID(ctx, cmd.FrameworkID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find compliance framework: %w", err)
+	}
+
+	byID := make(map[string]domain.FrameworkRequirement, len(framework.Requirements))
+	for _, requirement := range framework.Requirements {
+		byID[requirement.ID] = requirement
+	}
+	requirements := make([]domain.FrameworkRequirement, 0, len(results.Controls))
+	for _, control := range results.Controls {
+		requirement, ok := byID[control.RequirementID]
+		if !ok {
+			return nil, fmt.Errorf("compliance framework %s has no requirement %s", framework.ID, control.RequirementID)
+		}
+		requirements = append(requirements, requirement)
+	}
+
+	agreement, err := s.agreementRepo.FindByApplicationID(ctx, cmd.ApplicationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+	imported := &OSCALImport{Title: results.Title}
+	agreement.Conformance, imported.Attached = framework.AttachTo(agreement.Conformance, requirements)
+	if len(imported.Attached) > 0 {
+		err = s.agreementRepo.Update(ctx, agreement)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update governance agreement: %w", err)
+		}
+		if err := s.register(ctx, agreement); err != nil {
+			return nil, err
+		}
+
+		event := domain.FrameworkRequirementsAttachedEvent{
+			AgreementID:  agreement.ID,
+			FrameworkID:  framework.ID,
+			Requirements: imported.Attached,
+			OccurredAt:   time.Now(),
+		}
+		err = s.eventRepo.Save(ctx, event)
+		if err != nil {
+			fmt.Printf("Failed to save domain event: %v\n", err)
+		}
+	}
+
+	source := "OSCAL assessment results"
+	if results.Title != "" {
+		source += " " + results.Title
+	}
+	for _, status := range []domain.ComplianceStatus{domain.ComplianceCompliant, domain.CompliancePartial, domain.ComplianceNonCompliant} {
+		var controls []domain.ControlRef
+		for _, control := range results.Controls {
+			if control.Status == status {
+				controls = append(controls, domain.ControlRef{FrameworkID: framework.ID, RequirementID: control.RequirementID})
+			}
+		}
+		if len(controls) == 0 {
+			continue
+		}
+		applied, err := s.applyControls(ctx, cmd.ApplicationID, controls, status, source)
+		if err != nil {
+			return nil, err
+		}
+		imported.Applied = append(imported.Applied, applied...)
+	}
+	return imported, nil
+}
+
+// AttachFramework adds the selected requirements of a framework to an agreement's conformance as
+// legal requirements or industry standards under review, and returns those added. Requirements
+// the agreement already has keep their status.
+func (s *ComplianceService) AttachFramework(ctx context.Context, cmd AttachFrameworkCommand) ([]domain.RequirementRef, error) {
+	ctx, span := s.startSpan(ctx, "ComplianceService.AttachFramework", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
+	framework, err := s.frameworkRepo.FindByID(ctx, cmd.FrameworkID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find compliance framework: %w", err)
+	}
+	requirements, err := framework.Select(cmd.Requirements)
+	if err != nil {
+		return nil, err
+	}
+
+	agreement, err := s.agreementRepo.FindByID(ctx, cmd.AgreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+
+	var attached []domain.RequirementRef
+	agreement.Conformance, attached = framework.AttachTo(agreement.Conformance, requirements)
+	if len(attached) == 0 {
+		return nil, nil
+	}
+
+	err = s.agreementRepo.Update(ctx, agreement)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update governance agreement: %w", err)
+	}
+	if err := s.register(ctx, agreement); err != nil {
+		return nil, err
+	}
+
+	event := domain.FrameworkRequirementsAttachedEvent{
+		AgreementID:  cmd.AgreementID,
+		FrameworkID:  framework.ID,
+		Requirements: attached,
+		OccurredAt:   time.Now(),
+	}
+	err = s.eventRepo.Save(ctx, event)
+	if err != nil {
+		fmt.Printf("Failed to save domain event: %v\n", err)
+	}
+
+	return attached, nil
+}
+
+// ListControlMappings returns the mappings between controls of different frameworks, ordered by ID
+func (s *ComplianceService) ListControlMappings(ctx context.Context) ([]domain.ControlMapping, error) {
+	ctx, span := s.startSpan(ctx, "ComplianceService.ListControlMappings")
+	defer span.End()
+
+	if s.mappingRepo == nil {
+		return nil, nil
+	}
+	mappings, err := s.mappingRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find control mappings: %w", err)
+	}
+	return mappings, nil
+}
+
+// SaveControlMapping adds a mapping between controls, replacing the one with its ID. Every
+// control must be a requirement of a framework of the catalog.
+func (s *ComplianceService) SaveControlMapping(ctx context.Context, mapping domain.ControlMapping) error {
+	ctx, span := s.startSpan(ctx, "ComplianceService.SaveControlMapping")
+	defer span.End()
+
+	if s.mappingRepo == nil {
+		return fmt.Errorf("control mappings are not configured")
+	}
+	if err := mapping.Validate(); err != nil {
+		return err
+	}
+	for _, control := range mapping.Controls {
+		framework, err := s.frameworkRepo.FindByID(ctx, control.FrameworkID)
+		if err != nil {
+			return fmt.Errorf("control mapping %s: failed to find compliance framework %s: %w", mapping.ID, control.FrameworkID, err)
+		}
+		if _, err := framework.Select([]string{control.RequirementID}); err != nil {
+			return fmt.Errorf("control mapping %s: %w", mapping.ID, err)
+		}
+	}
+
+	err := s.mappingRepo.Save(ctx, mapping)
+	if err != nil {
+		return fmt.Errorf("failed to save control mapping: %w", err)
+	}
+	return nil
+}
+
+// AppliedControlStatus is the status found for a control with the requirements of the agreement
+// it was applied to: the control's own and those of the controls mapped to it
+type AppliedControlStatus struct {
+	Control      domain.ControlRef
+	Status       domain.ComplianceStatus
+	Requirements []domain.RequirementRef
+}
+
+// ApplyControlStatus sets the status of the control, and of every control mapped to it, on the
+// requirements of the application's agreement. Controls the agreement has no requirement for
+// are skipped.
+func (s *ComplianceService) ApplyControlStatus(ctx context.Context, cmd ApplyControlStatusCommand) (*AppliedControlStatus, error) {
+	ctx, span := s.startSpan(ctx, "ComplianceService.ApplyControlStatus", domain.ApplicationAttribute(cmd.ApplicationID))
+	defer span.End()
+
+	applied, err := s.applyControls(ctx, cmd.ApplicationID, []domain.ControlRef{cmd.Control}, cmd.Status, cmd.Source)
+	if err != nil {
+		return nil, err
+	}
+	return &applied[0], nil
+}
+
+// ApplyAuditFinding applies the status an audit finding shows, non-compliant when it is critical
+// or high and partial otherwise, to the controls it concerns and every control mapped to them
+func (s *ComplianceService) ApplyAuditFinding(ctx context.Context, cmd ApplyAuditFindingCommand) ([]AppliedControlStatus, error) {
+	ctx, span := s.startSpan(ctx, "ComplianceService.ApplyAuditFinding")
+	defer span.End()
+
+	if s.auditRepo == nil {
+		return nil, fmt.Errorf("audits are not configured")
+	}
+	audit, err := s.auditRepo.FindByID(ctx, cmd.AuditID)
+	if err != nil {
+		return nil, fmt.Errorf("audit not found: %w", err)
+	}
+
+	for _, finding := range audit.Findings {
+		if finding.ID != cmd.FindingID {
+			continue
+		}
+		if len(finding.Controls) == 0 {
+			return nil, fmt.Errorf("finding %s of audit %s concerns no control", cmd.FindingID, cmd.AuditID)
+		}
+		source := fmt.Sprintf("audit %s finding %s", audit.ID, finding.ID)
+		return s.applyControls(ctx, audit.ApplicationID, finding.Controls, domain.FindingComplianceStatus(finding), source)
+	}
+	return nil, fmt.Errorf("finding %s not found in audit %s", cmd.FindingID, cmd.AuditID)
+}
+
+// ApplyEvidence marks the controls an evidence item shows are implemented, and every control
+// mapped to them, compliant. Evidence applies to the application it was attached for when no
+// application is given. Stale evidence is not applied.
+func (s *ComplianceService) ApplyEvidence(ctx context.Context, cmd ApplyEvidenceCommand) ([]AppliedControlStatus, error) {
+	ctx, span := s.startSpan(ctx, "ComplianceService.ApplyEvidence", domain.ApplicationAttribute(cmd.ApplicationID))
+	defer span.End()
+
+	if s.attachmentStore == nil {
+		return nil, fmt.Errorf("evidence is not configured")
+	}
+	evidence, err := s.attachmentStore.FindByID(ctx, cmd.EvidenceID)
+	if err != nil {
+		return nil, fmt.Errorf("evidence not found: %w", err)
+	}
+	if len(evidence.Controls) == 0 {
+		return nil, fmt.Errorf("evidence %s shows no control", evidence.ID)
+	}
+	if evidence.Expired(time.Now()) {
+		return nil, fmt.Errorf("evidence %s expired on %s", evidence.ID, evidence.ValidUntil.Format("2006-01-02"))
+	}
+
+	appID := cmd.ApplicationID
+	if appID == "" {
+		appID = evidence.ApplicationID
+	}
+	if appID == "" && evidence.Subject.Kind == domain.EvidenceSubjectAuditFinding && s.auditRepo != nil {
+		audit, err := s.auditRepo.FindByID(ctx, evidence.Subject.ID)
+		if err != nil {
+			return nil, fmt.Errorf("audit not found: %w", err)
+		}
+		appID = audit.ApplicationID
+	}
+	if appID == "" {
+		return nil, fmt.Errorf("application of evidence %s is required", evidence.ID)
+	}
+
+	return s.applyControls(ctx, appID, evidence.Controls, domain.ComplianceCompliant, "evidence "+evidence.ID)
+}
+
+// StartAssessment opens a compliance assessment of an agreement against the selected
+// requirements of a framework
+func (s *ComplianceService) StartAssessment(ctx context.Context, cmd StartComplianceAssessmentCommand) (*domain.ComplianceAssessment, error) {
+	ctx, span := s.startSpan(ctx, "ComplianceService.StartAssessment", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
+	if s.assessmentRepo == nil {
+		return nil, fmt.Errorf("compliance assessments are not configured")
+	}
+
+	if _, err := s.assessmentRepo.FindByID(ctx, cmd.ID); err == nil {
+		return nil, fmt.Errorf("compliance assessment %s already exists", cmd.ID)
+	}
+	if _, err := s.agreementRepo.FindByID(ctx, cmd.AgreementID); err != nil {
+		return nil, fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+	framework, err := s.frameworkRepo.FindByID(ctx, cmd.FrameworkID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find compliance framework: %w", err)
+	}
+
+	aggregate, err := domain.NewComplianceAssessmentAggregate(cmd.ID, cmd.AgreementID, framework, cmd.Requirements, cmd.Assessor, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create compliance assessment aggregate: %w", err)
+	}
+
+	assessment := aggregate.GetAssessment()
+	err = s.assessmentRepo.Save(ctx, assessment)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save compliance assessment: %w", err)
+	}
+	// Save domain events
+	for _, event := range aggregate.GetDomainEvents() {
+		err = s.eventRepo.Save(ctx, event)
+		if err != nil {
+			fmt.Printf("Failed to save domain event: %v\n", err)
+		}
+	}
+
+	return &assessment, nil
+}
+
+// AnswerControl records the status found for one control of an assessment in progress, with the
+// evidence supporting it, and returns the rescored assessment
+func (s *ComplianceService) AnswerControl(ctx context.Context, cmd AnswerComplianceControlCommand) (*domain.ComplianceAssessment, error) {
+	ctx, span := s.startSpan(ctx, "ComplianceService.AnswerControl")
+	defer span.End()
+
+	if s.assessmentRepo == nil {
+		return nil, fmt.Errorf("compliance assessments are not configured")
+	}
+
+	recorded, err := s.assessmentRepo.FindByID(ctx, cmd.AssessmentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find compliance assessment: %w", err)
+	}
+
+	aggregate := domain.LoadComplianceAssessmentAggregate(recorded)
+	err = aggregate.AnswerControl(domain.ControlAnswer{
+		RequirementID: cmd.RequirementID,
+		Status:        cmd.Status,
+		NotApplicable: cmd.NotApplicable,
+		EvidenceLinks: cmd.EvidenceLinks,
+		Notes:         cmd.Notes,
+		AnsweredBy:    cmd.AnsweredBy,
+		AnsweredAt:    time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	assessment := aggregate.GetAssessment()
+	err = s.assessmentRepo.Save(ctx, assessment)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save compliance assessment: %w", err)
+	}
+	return &assessment, nil
+}
+
+// CompleteAssessment closes an assessment whose controls all have a final answer and sets the
+// statuses found on the agreement's conformance, attaching the requirements it does not have yet
+func (s *ComplianceService) CompleteAssessment(ctx context.Context, assessmentID string) (*domain.ComplianceAssessment, error) {
+	ctx, span := s.startSpan(ctx, "ComplianceService.CompleteAssessment")
+	defer span.End()
+
+	if s.assessmentRepo == nil {
+		return nil, fmt.Errorf("compliance assessments are not configured")
+	}
+
+	recorded, err := s.assessmentRepo.FindByID(ctx, assessmentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find compliance assessment: %w", err)
+	}
+	framework, err := s.frameworkRepo.FindByID(ctx, recorded.FrameworkID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find compliance framework: %w", err)
+	}
+	agreement, err := s.agreementRepo.FindByID(ctx, recorded.AgreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+
+	aggregate := domain.LoadComplianceAssessmentAggregate(recorded)
+	if err := aggregate.Complete(time.Now()); err != nil {
+		return nil, err
+	}
+	agreement.Conformance, _, err = aggregate.ApplyTo(agreement.Conformance, framework)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.agreementRepo.Update(ctx, agreement)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update governance agreement: %w", err)
+	}
+	if err := s.register(ctx, agreement); err != nil {
+		return nil, err
+	}
+	assessment := aggregate.GetAssessment()
+	err = s.assessmentRepo.Save(ctx, assessment)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save compliance assessment: %w", err)
+	}
+	// Save domain events
+	for _, event := range aggregate.GetDomainEvents() {
+		err = s.eventRepo.Save(ctx, event)
+		if err != nil {
+			fmt.Printf("Failed to save domain event: %v\n", err)
+		}
+	}
+
+	return &assessment, nil
+}
+
+// GetAssessment returns a compliance assessment with its answers and score
+func (s *ComplianceService) GetAssessment(ctx context.Context, assessmentID string) (*domain.ComplianceAssessment, error) {
+	ctx, span := s.startSpan(ctx, "ComplianceService.GetAssessment")
+	defer span.End()
+
+	if s.assessmentRepo == nil {
+		return nil, fmt.Errorf("compliance assessments are not configured")
+	}
+
+	assessment, err := s.assessmentRepo.FindByID(ctx, assessmentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find compliance assessment: %w", err)
+	}
+	return &assessment, nil
+}
+
+// ListAssessments returns the compliance assessments of an agreement, oldest first
+func (s *ComplianceService) ListAssessments(ctx context.Context, agreementID domain.GovernanceAgreementID) ([]domain.ComplianceAssessment, error) {
+	ctx, span := s.startSpan(ctx, "ComplianceService.ListAssessments", domain.AgreementAttribute(agreementID))
+	defer span.End()
+
+	if s.assessmentRepo == nil {
+		return nil, fmt.Errorf("compliance assessments are not configured")
+	}
+
+	assessments, err := s.assessmentRepo.FindByAgreementID(ctx, agreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find compliance assessments: %w", err)
+	}
+	return assessments, nil
+}
+
+// ExpiredEvidence is evidence that went stale, with the compliance statuses resting on it that
+// were put back under review
+type ExpiredEvidence struct {
+	Evidence     domain.Evidence
+	AgreementID  domain.GovernanceAgreementID // empty when the application has no agreement
+	Requirements []domain.RequirementRef
+	Answers      []string // compliance assessment answers as assessment/requirement
+}
+
+// ExpireEvidence detects the evidence that went stale by now and puts the compliance statuses
+// resting on it back under review: the compliant and partial requirements of the application's
+// agreement for the controls it shows and the controls mapped to them, and the answers of
+// compliance assessments that link it. Each expiry is handled once. A failure does not stop the
+// other evidence; their errors are joined.
+func (s *ComplianceService) ExpireEvidence(ctx context.Context, cmd ExpireEvidenceCommand) ([]ExpiredEvidence, error) {
+	ctx, span := s.startSpan(ctx, "ComplianceService.ExpireEvidence")
+	defer span.End()
+
+	if s.attachmentStore == nil {
+		return nil, fmt.Errorf("evidence is not configured")
+	}
+	if cmd.Now.IsZero() {
+		cmd.Now = time.Now()
+	}
+
+	stale, err := s.attachmentStore.FindExpired(ctx, cmd.Now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find expired evidence: %w", err)
+	}
+	if len(stale) == 0 {
+		return nil, nil
+	}
+	frameworks, err := s.frameworkRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find compliance frameworks: %w", err)
+	}
+	var mappings []domain.ControlMapping
+	if s.mappingRepo != nil {
+		mappings, err = s.mappingRepo.FindAll(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find control mappings: %w", err)
+		}
+	}
+
+	var expired []ExpiredEvidence
+	var errs []error
+	for _, evidence := range stale {
+		result, err := s.expireEvidence(ctx, evidence, frameworks, mappings, cmd.Now)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("evidence %s: %w", evidence.ID, err))
+			continue
+		}
+		expired = append(expired, result)
+	}
+	return expired, errors.Join(errs...)
+}
+
+// expireEvidence puts the statuses resting on one stale evidence item under review, records its
+// expiry and publishes an EvidenceExpiredEvent
+func (s *ComplianceService) expireEvidence(ctx context.Context, evidence domain.Evidence, frameworks []domain.ComplianceFramework, mappings []domain.ControlMapping, now time.Time) (ExpiredEvidence, error) {
+	result := ExpiredEvidence{Evidence: evidence}
+
+	appID := evidence.ApplicationID
+	if appID == "" && evidence.Subject.Kind == domain.EvidenceSubjectAuditFinding && s.auditRepo != nil {
+		if audit, err := s.auditRepo.FindByID(ctx, evidence.Subject.ID); err == nil {
+			appID = audit.ApplicationID
+		}
+	}
+
+	if agreement, err := s.agreementRepo.FindByApplicationID(ctx, appID); err == nil {
+		result.AgreementID = agreement.ID
+		agreement.Conformance, result.Requirements = domain.ReviewStaleControls(agreement.Conformance, frameworks, mappings, evidence.Controls)
+
+		if s.assessmentRepo != nil {
+			assessments, err := s.assessmentRepo.FindByAgreementID(ctx, agreement.ID)
+			if err != nil {
+				return result, fmt.Errorf("failed to find compliance assessments: %w", err)
+			}
+			for _, assessment := range assessments {
+				if assessment.Status == domain.ComplianceAssessmentCompleted {
+					// Completed assessments stay as recorded; the requirements they fed are reviewed
+					var controls []domain.ControlRef
+					for _, answer := range assessment.CitingAnswers(evidence.ID) {
+						controls = append(controls, domain.ControlRef{FrameworkID: assessment.FrameworkID, RequirementID: answer.RequirementID})
+					}
+					var reviewed []domain.RequirementRef
+					agreement.Conformance, reviewed = domain.ReviewStaleControls(agreement.Conformance, frameworks, nil, controls)
+					result.Requirements = append(result.Requirements, reviewed...)
+					continue
+				}
+
+				aggregate := domain.LoadComplianceAssessmentAggregate(assessment)
+				reviewed := aggregate.ReviewEvidence(evidence.ID)
+				if len(reviewed) == 0 {
+					continue
+				}
+				err = s.assessmentRepo.Save(ctx, aggregate.GetAssessment())
+				if err != nil {
+					return result, fmt.Errorf("failed to save compliance assessment: %w", err)
+				}
+				for _, requirementID := range reviewed {
+					result.Answers = append(result.Answers, assessment.ID+"/"+requirementID)
+				}
+			}
+		}
+
+		if len(result.Requirements) > 0 {
+			err = s.agreementRepo.Update(ctx, agreement)
+			if err != nil {
+				return result, fmt.Errorf("failed to update governance agreement: %w", err)
+			}
+			if err := s.register(ctx, agreement); err != nil {
+				return result, err
+			}
+		}
+	}
+
+	err := s.attachmentStore.MarkExpired(ctx, evidence.ID, now)
+	if err != nil {
+		return result, fmt.Errorf("failed to record evidence expiry: %w", err)
+	}
+	result.Evidence.ExpiredAt = now
+
+	event := domain.EvidenceExpiredEvent{
+		EvidenceID:    evidence.ID,
+		ApplicationID: appID,
+		AgreementID:   result.AgreementID,
+		ValidUntil:    evidence.ValidUntil,
+		Requirements:  result.Requirements,
+		Answers:       result.Answers,
+		OccurredAt:    now,
+	}
+	err = s.eventRepo.Save(ctx, event)
+	if err != nil {
+		fmt.Printf("Failed to save domain event: %v\n", err)
+	}
+
+	return result, nil
+}
+
+// RequirementExpiryCheck is where the certifications and contracts of an agreement stand against
+// their expiry
+type RequirementExpiryCheck struct {
+	AgreementID   domain.GovernanceAgreementID
+	ApplicationID domain.ApplicationID
+	Requirements  []domain.RequirementExpiry // soonest expiry first
+	Downgraded    []domain.RequirementExpiry // expired and made non-compliant by this check, with the status they had
+}
+
+// SetRequirementExpiry records when a contractual requirement's contract ends or an industry
+// standard's certification lapses, such as when it is renewed
+func (s *ComplianceService) SetRequirementExpiry(ctx context.Context, cmd SetRequirementExpiryCommand) error {
+	ctx, span := s.startSpan(ctx, "ComplianceService.SetRequirementExpiry", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
+	agreement, err := s.agreementRepo.FindByID(ctx, cmd.AgreementID)
+	if err != nil {
+		return fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+	if err := domain.SetRequirementExpiry(&agreement.Conformance, cmd.Requirement, cmd.ExpiresAt); err != nil {
+		return fmt.Errorf("agreement %s: %w", cmd.AgreementID, err)
+	}
+
+	err = s.agreementRepo.Update(ctx, agreement)
+	if err != nil {
+		return fmt.Errorf("failed to update governance agreement: %w", err)
+	}
+	if err := s.register(ctx, agreement); err != nil {
+		return err
+	}
+	return nil
+}
+
+// CheckRequirementExpiry tracks the days left until the certifications and contracts of an
+// agreement, or of every agreement, expire and the warning lead time each has reached. Those that
+// expired and were still held as met are made non-compliant, publishing a RequirementExpiredEvent
+// for each. Agreements with nothing that expires are left out.
+func (s *ComplianceService) CheckRequirementExpiry(ctx context.Context, cmd CheckRequirementExpiryCommand) ([]RequirementExpiryCheck, error) {
+	ctx, span := s.startSpan(ctx, "ComplianceService.CheckRequirementExpiry", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
+	if cmd.Now.IsZero() {
+		cmd.Now = time.Now()
+	}
+	policy := domain.ExpiryWarningPolicy{LeadDays: cmd.LeadDays}
+	if len(policy.LeadDays) == 0 {
+		policy = domain.DefaultExpiryWarningPolicy()
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+
+	var agreements []domain.GovernanceAgreement
+	if cmd.AgreementID != "" {
+		agreement, err := s.agreementRepo.FindByID(ctx, cmd.AgreementID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find governance agreement: %w", err)
+		}
+		agreements = []domain.GovernanceAgreement{agreement}
+	} else {
+		var err error
+		agreements, err = s.agreementRepo.FindAll(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list governance agreements: %w", err)
+		}
+	}
+
+	var checks []RequirementExpiryCheck
+	for _, agreement := range agreements {
+		check := RequirementExpiryCheck{AgreementID: agreement.ID, ApplicationID: agreement.ApplicationID}
+		agreement.Conformance, check.Downgraded = domain.DowngradeExpiredRequirements(agreement.Conformance, cmd.Now)
+		check.Requirements = domain.TrackRequirementExpiry(agreement.Conformance, policy, cmd.Now)
+		if len(check.Requirements) == 0 {
+			continue
+		}
+
+		if len(check.Downgraded) > 0 {
+			err := s.agreementRepo.Update(ctx, agreement)
+			if err != nil {
+				return nil, fmt.Errorf("failed to update governance agreement: %w", err)
+			}
+			if err := s.register(ctx, agreement); err != nil {
+				return nil, err
+			}
+			for _, expiry := range check.Downgraded {
+				event := domain.RequirementExpiredEvent{
+					AgreementID:    agreement.ID,
+					ApplicationID:  agreement.ApplicationID,
+					Requirement:    expiry.Requirement,
+					ExpiresAt:      expiry.ExpiresAt,
+					PreviousStatus: expiry.Status,
+					OccurredAt:     cmd.Now,
+				}
+				err = s.eventRepo.Save(ctx, event)
+				if err != nil {
+					fmt.Printf("Failed to save domain event: %v\n", err)
+				}
+			}
+		}
+		checks = append(checks, check)
+	}
+	return checks, nil
+}
+
+// applyControls sets the status of the controls and of the controls mapped to them on the
+// application's agreement, saving it once, and publishes a ControlStatusAppliedEvent for every
+// control that updated a requirement
+func (s *ComplianceService) applyControls(ctx context.Context, appID domain.ApplicationID, controls []domain.ControlRef, status domain.ComplianceStatus, source string) ([]AppliedControlStatus, error) {
+	if err := status.Validate(); err != nil {
+		return nil, err
+	}
+
+	agreement, err := s.agreementRepo.FindByApplicationID(ctx, appID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+	frameworks, err := s.frameworkRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find compliance frameworks: %w", err)
+	}
+	var mappings []domain.ControlMapping
+	if s.mappingRepo != nil {
+		mappings, err = s.mappingRepo.FindAll(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find control mappings: %w", err)
+		}
+	}
+
+	applied := make([]AppliedControlStatus, 0, len(controls))
+	updated := false
+	for _, control := range controls {
+		result := AppliedControlStatus{Control: control, Status: status}
+		agreement.Conformance, result.Requirements = domain.ApplyControlStatus(agreement.Conformance, frameworks, mappings, control, status)
+		updated = updated || len(result.Requirements) > 0
+		applied = append(applied, result)
+	}
+	if !updated {
+		return applied, nil
+	}
+
+	err = s.agreementRepo.Update(ctx, agreement)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update governance agreement: %w", err)
+	}
+	if err := s.register(ctx, agreement); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	for _, result := range applied {
+		if len(result.Requirements) == 0 {
+			continue
+		}
+		event := domain.ControlStatusAppliedEvent{
+			AgreementID:  agreement.ID,
+			Control:      result.Control,
+			Status:       status,
+			Source:       source,
+			Requirements: result.Requirements,
+			OccurredAt:   now,
+		}
+		err = s.eventRepo.Save(ctx, event)
+		if err != nil {
+			fmt.Printf("Failed to save domain event: %v\n", err)
+		}
+	}
+
+	return applied, nil
+}
+
+// register records the agreement's conformance requirements in the compliance register, when one
+// is kept
+func (s *ComplianceService) register(ctx context.Context, agreement domain.GovernanceAgreement) error {
+	if s.complianceRepo == nil {
+		return nil
+	}
+	return domain.RegisterConformance(ctx, s.complianceRepo, agreement.ApplicationID, agreement.Conformance)
+}
+
+// Commands for Compliance Service
+
+type AttachFrameworkCommand struct {
+	AgreementID  domain.GovernanceAgreementID
+	FrameworkID  string
+	Requirements []string // optional, requirement IDs or families such as "A.8.x"; every requirement when empty
+}
+
+type ImportOSCALCatalogCommand struct {
+	Catalog     io.Reader              // OSCAL catalog JSON
+	FrameworkID string                 // optional, defaults to the catalog's UUID
+	Kind        domain.RequirementKind // optional, defaults to industry standard
+}
+
+type ImportOSCALAssessmentResultsCommand struct {
+	ApplicationID domain.ApplicationID
+	FrameworkID   string    // framework of the catalog the results assess
+	Results       io.Reader // OSCAL assessment results JSON
+}
+
+type ApplyControlStatusCommand struct {
+	ApplicationID domain.ApplicationID
+	Control       domain.ControlRef
+	Status        domain.ComplianceStatus
+	Source        string // optional, what the status was found by
+}
+
+type ApplyAuditFindingCommand struct {
+	AuditID   string
+	FindingID string
+}
+
+type ApplyEvidenceCommand struct {
+	ApplicationID domain.ApplicationID // optional for evidence of an audit finding
+	EvidenceID    string
+}
+
+type ExpireEvidenceCommand struct {
+	Now time.Time // optional, defaults to now
+}
+
+type SetRequirementExpiryCommand struct {
+	AgreementID domain.GovernanceAgreementID
+	Requirement domain.RequirementRef // a contractual requirement or industry standard
+	ExpiresAt   time.Time             // zero when it no longer expires
+}
+
+type CheckRequirementExpiryCommand struct {
+	AgreementID domain.GovernanceAgreementID // optional, every agreement when empty
+	Now         time.Time                    // optional, defaults to now
+	LeadDays    []int                        // days before expiry warnings are raised, defaults to 90, 30 and 7
+}
+
+type StartComplianceAssessmentCommand struct {
+	ID           string
+	AgreementID  domain.GovernanceAgreementID
+	FrameworkID  string
+	Requirements []string // optional, requirement IDs or families such as "A.8.x"; every requirement when empty
+	Assessor     string
+}
+
+type AnswerComplianceControlCommand struct {
+	AssessmentID  string
+	RequirementID string
+	Status        domain.ComplianceStatus // ignored when the control does not apply
+	NotApplicable bool
+	EvidenceLinks []string // optional, evidence IDs, documents or report links
+	Notes         string
+	AnsweredBy    string
+}
@@ -0,0 +1,137 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// ComplianceViolationService detects violations of conformance requirements from monitored facts:
+// evidence that has expired, audit findings left unremediated and requirements no policy in
+// effect implements. Each violation is published once, with a ComplianceViolationDetectedEvent
+// carrying its severity and a remediation hint, until a detection no longer finds it.
+type ComplianceViolationService struct {
+	instrumentation
+
+	agreementRepo   domain.GovernanceAgreementRepository
+	frameworkRepo   domain.FrameworkRepository
+	mappingRepo     domain.ControlMappingRepository // nil relates evidence and findings to their own controls only
+	auditRepo       domain.AuditRepository          // nil detects no failed audits
+	attachmentStore domain.AttachmentStore          // nil detects no expired evidence
+	eventRepo       domain.DomainEventRepository
+	now             func() time.Time
+}
+
+// NewComplianceViolationService creates a new compliance violation service. The mapping
+// repository, audit repository and attachment store may be nil, in which case the rules on the
+// facts they hold find nothing.
+func NewComplianceViolationService(
+	agreementRepo domain.GovernanceAgreementRepository,
+	frameworkRepo domain.FrameworkRepository,
+	mappingRepo domain.ControlMappingRepository,
+	auditRepo domain.AuditRepository,
+	attachmentStore domain.AttachmentStore,
+	eventRepo domain.DomainEventRepository,
+	opts ...ServiceOption,
+) *ComplianceViolationService {
+	return &ComplianceViolationService{
+		agreementRepo:   agreementRepo,
+		frameworkRepo:   frameworkRepo,
+		mappingRepo:     mappingRepo,
+		auditRepo:       auditRepo,
+		attachmentStore: attachmentStore,
+		eventRepo:       eventRepo,
+		now:             time.Now,
+		instrumentation: newInstrumentation(opts),
+	}
+}
+
+// DetectViolations evaluates the conformance requirements of an agreement, or of every agreement
+// when none is given, against the facts monitored of them. Violations the agreement's previous
+// detection did not find are published with a ComplianceViolationDetectedEvent.
+func (s *ComplianceViolationService) DetectViolations(ctx context.Context, cmd DetectComplianceViolationsCommand) ([]domain.ComplianceViolationReport, error) {
+	ctx, span := s.startSpan(ctx, "ComplianceViolationService.DetectViolations", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
+	var agreements []domain.GovernanceAgreement
+	if cmd.AgreementID != "" {
+		agreement, err := s.agreementRepo.FindByID(ctx, cmd.AgreementID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find governance agreement: %w", err)
+		}
+		agreements = append(agreements, agreement)
+	} else {
+		var err error
+		agreements, err = s.agreementRepo.FindAll(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list governance agreements: %w", err)
+		}
+	}
+
+	frameworks, err := s.frameworkRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find compliance frameworks: %w", err)
+	}
+	var mappings []domain.ControlMapping
+	if s.mappingRepo != nil {
+		mappings, err = s.mappingRepo.FindAll(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find control mappings: %w", err)
+		}
+	}
+
+	now := s.now()
+	reports := make([]domain.ComplianceViolationReport, 0, len(agreements))
+	for _, agreement := range agreements {
+		source := domain.ComplianceReportSource{Agreement: agreement, Frameworks: frameworks, Mappings: mappings}
+		if s.attachmentStore != nil {
+			source.Evidence, err = s.attachmentStore.FindByApplicationID(ctx, agreement.ApplicationID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to find evidence of %s: %w", agreement.ApplicationID, err)
+			}
+		}
+		if s.auditRepo != nil {
+			source.Audits, err = s.auditRepo.FindByApplicationID(ctx, agreement.ApplicationID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to find audits of %s: %w", agreement.ApplicationID, err)
+			}
+		}
+
+		report := domain.BuildComplianceViolationReport(source, now)
+		if len(report.New) > 0 || len(report.Resolved) > 0 {
+			agreement.Monitor.DetectedViolations = report.DetectedViolationIDs()
+			err = s.agreementRepo.Update(ctx, agreement)
+			if err != nil {
+				return nil, fmt.Errorf("failed to update governance agreement: %w", err)
+			}
+		}
+
+		for _, violation := range report.New {
+			event := domain.ComplianceViolationDetectedEvent{
+				ViolationID:     violation.ID,
+				AgreementID:     report.AgreementID,
+				ApplicationID:   report.ApplicationID,
+				RequirementType: string(violation.Requirement.Kind),
+				Description:     violation.Description,
+				Severity:        violation.Severity,
+				Remediation:     violation.Remediation,
+				OccurredAt:      now,
+			}
+			err := s.eventRepo.Save(ctx, event)
+			if err != nil {
+				fmt.Printf("Failed to save domain event: %v\n", err)
+			}
+		}
+		reports = append(reports, report)
+	}
+
+	return reports, nil
+}
+
+// Commands for Compliance Violation Service
+
+type DetectComplianceViolationsCommand struct {
+	AgreementID domain.GovernanceAgreementID // every agreement is checked when empty
+}
@@ -0,0 +1,195 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// DecisionService keeps the governance decision log: a durable record of why governance
+// directions were taken, linked to the agreements and applications they apply to
+type DecisionService struct {
+	instrumentation
+
+	decisionRepo  domain.DecisionRepository
+	agreementRepo domain.GovernanceAgreementRepository
+	appRepo       domain.ApplicationRepository
+	eventRepo     domain.DomainEventRepository
+}
+
+// NewDecisionService creates a new decision service
+func NewDecisionService(
+	decisionRepo domain.DecisionRepository,
+	agreementRepo domain.GovernanceAgreementRepository,
+	appRepo domain.ApplicationRepository,
+	eventRepo domain.DomainEventRepository,
+	opts ...ServiceOption,
+) *DecisionService {
+	return &DecisionService{
+		decisionRepo:    decisionRepo,
+		agreementRepo:   agreementRepo,
+		appRepo:         appRepo,
+		eventRepo:       eventRepo,
+		instrumentation: newInstrumentation(opts),
+	}
+}
+
+// RecordDecision adds a decision to the log. A decision linked to an agreement is also linked to
+// the agreement's application. A decision that supersedes an earlier one marks it superseded.
+func (s *DecisionService) RecordDecision(ctx context.Context, cmd RecordDecisionCommand) (*domain.Decision, error) {
+	ctx, span := s.startSpan(ctx, "DecisionService.RecordDecision", domain.AgreementAttribute(cmd.AgreementID), domain.ApplicationAttribute(cmd.ApplicationID))
+	defer span.End()
+
+	now := time.Now()
+	decision := domain.Decision{
+		ID:            cmd.ID,
+		Title:         cmd.Title,
+		Context:       cmd.Context,
+		Options:       cmd.Options,
+		Outcome:       cmd.Outcome,
+		Rationale:     cmd.Rationale,
+		Consequences:  cmd.Consequences,
+		DecidedBy:     cmd.DecidedBy,
+		DecidedAt:     cmd.DecidedAt,
+		AgreementID:   cmd.AgreementID,
+		ApplicationID: cmd.ApplicationID,
+		Status:        domain.DecisionAccepted,
+		Supersedes:    cmd.Supersedes,
+		RecordedAt:    now,
+	}
+	if decision.DecidedAt.IsZero() {
+		decision.DecidedAt = now
+	}
+	if err := decision.Validate(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.decisionRepo.FindByID(ctx, decision.ID); err == nil {
+		return nil, fmt.Errorf("decision %s already exists", decision.ID)
+	}
+
+	if decision.AgreementID != "" {
+		agreement, err := s.agreementRepo.FindByID(ctx, decision.AgreementID)
+		if err != nil {
+			return nil, fmt.Errorf("governance agreement not found: %w", err)
+		}
+		if decision.ApplicationID == "" {
+			decision.ApplicationID = agreement.ApplicationID
+		} else if decision.ApplicationID != agreement.ApplicationID {
+			return nil, fmt.Errorf("governance agreement %s does not govern application %s", decision.AgreementID, decision.ApplicationID)
+		}
+	} else if _, err := s.appRepo.FindByID(ctx, decision.ApplicationID); err != nil {
+		return nil, fmt.Errorf("application not found: %w", err)
+	}
+
+	var superseded domain.Decision
+	if decision.Supersedes != "" {
+		var err error
+		superseded, err = s.decisionRepo.FindByID(ctx, decision.Supersedes)
+		if err != nil {
+			return nil, fmt.Errorf("superseded decision not found: %w", err)
+		}
+		if err := superseded.Supersede(decision.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	err := s.decisionRepo.Save(ctx, decision)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save decision: %w", err)
+	}
+	if decision.Supersedes != "" {
+		err = s.decisionRepo.Update(ctx, superseded)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update superseded decision: %w", err)
+		}
+	}
+
+	// Publish domain event
+	event := domain.GovernanceDecisionRecordedEvent{
+		DecisionID:    decision.ID,
+		Title:         decision.Title,
+		AgreementID:   decision.AgreementID,
+		ApplicationID: decision.ApplicationID,
+		DecidedBy:     decision.DecidedBy,
+		Supersedes:    decision.Supersedes,
+		OccurredAt:    now,
+	}
+	err = s.eventRepo.Save(ctx, event)
+	if err != nil {
+		fmt.Printf("Failed to save domain event: %v\n", err)
+	}
+
+	return &decision, nil
+}
+
+// GetDecision returns a decision from the log
+func (s *DecisionService) GetDecision(ctx context.Context, decisionID string) (*domain.Decision, error) {
+	ctx, span := s.startSpan(ctx, "DecisionService.GetDecision")
+	defer span.End()
+
+	decision, err := s.decisionRepo.FindByID(ctx, decisionID)
+	if err != nil {
+		return nil, fmt.Errorf("decision not found: %w", err)
+	}
+
+	return &decision, nil
+}
+
+// ListDecisions returns the decisions linked to an agreement or, without one, to an application,
+// or the whole log when neither is given, oldest first
+func (s *DecisionService) ListDecisions(ctx context.Context, cmd ListDecisionsCommand) ([]domain.Decision, error) {
+	ctx, span := s.startSpan(ctx, "DecisionService.ListDecisions", domain.AgreementAttribute(cmd.AgreementID), domain.ApplicationAttribute(cmd.ApplicationID))
+	defer span.End()
+
+	var decisions []domain.Decision
+	var err error
+	switch {
+	case cmd.AgreementID != "":
+		decisions, err = s.decisionRepo.FindByAgreementID(ctx, cmd.AgreementID)
+	case cmd.ApplicationID != "":
+		decisions, err = s.decisionRepo.FindByApplicationID(ctx, cmd.ApplicationID)
+	default:
+		decisions, err = s.decisionRepo.FindAll(ctx)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find decisions: %w", err)
+	}
+
+	if cmd.CurrentOnly {
+		current := make([]domain.Decision, 0, len(decisions))
+		for _, decision := range decisions {
+			if decision.Status != domain.DecisionSuperseded {
+				current = append(current, decision)
+			}
+		}
+		decisions = current
+	}
+
+	return decisions, nil
+}
+
+// Commands for Decision Service
+
+type RecordDecisionCommand struct {
+	ID            string
+	Title         string
+	Context       string
+	Options       []domain.DecisionOption
+	Outcome       string
+	Rationale     string
+	Consequences  string
+	DecidedBy     string
+	DecidedAt     time.Time // optional, defaults to now
+	AgreementID   domain.GovernanceAgreementID
+	ApplicationID domain.ApplicationID
+	Supersedes    string
+}
+
+type ListDecisionsCommand struct {
+	AgreementID   domain.GovernanceAgreementID
+	ApplicationID domain.ApplicationID
+	CurrentOnly   bool // leave out superseded decisions
+}
@@ -0,0 +1,362 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// DigestService keeps executives informed of how the governance of each portfolio moves. Every
+// week or month it builds a portfolio's executive digest from the monitoring history, escalations
+// and latest assessments of its applications, records it and sends it as a digest notification
+// to the portfolio owner and the recipients of the executive reports of its agreements.
+type DigestService struct {
+	instrumentation
+
+	portfolioRepo  domain.ApplicationPortfolioRepository
+	agreementRepo  domain.GovernanceAgreementRepository
+	snapshotRepo   domain.MonitoringSnapshotRepository
+	assessmentRepo domain.AssessmentRepository
+	escalationRepo domain.EscalationRepository // nil leaves escalations out of digests
+	digestRepo     domain.ExecutiveDigestRepository
+	notifier       domain.Notifier // nil records digests without sending them
+	eventRepo      domain.DomainEventRepository
+	changeRepo     domain.ChangeRequestRepository // nil leaves change metrics out of digests
+	incidentRepo   domain.IncidentRepository
+	now            func() time.Time
+}
+
+// NewDigestService creates a new digest service. The escalation repository may be nil, in which
+// case digests leave escalations out, and the notifier may be nil, in which case digests are
+// recorded without being sent.
+func NewDigestService(
+	portfolioRepo domain.ApplicationPortfolioRepository,
+	agreementRepo domain.GovernanceAgreementRepository,
+	snapshotRepo domain.MonitoringSnapshotRepository,
+	assessmentRepo domain.AssessmentRepository,
+	escalationRepo domain.EscalationRepository,
+	digestRepo domain.ExecutiveDigestRepository,
+	notifier domain.Notifier,
+	eventRepo domain.DomainEventRepository,
+	opts ...ServiceOption,
+) *DigestService {
+	return &DigestService{
+		portfolioRepo:   portfolioRepo,
+		agreementRepo:   agreementRepo,
+		snapshotRepo:    snapshotRepo,
+		assessmentRepo:  assessmentRepo,
+		escalationRepo:  escalationRepo,
+		digestRepo:      digestRepo,
+		notifier:        notifier,
+		eventRepo:       eventRepo,
+		now:             time.Now,
+		instrumentation: newInstrumentation(opts),
+	}
+}
+
+// SetChangeRepositories includes the change volume, lead time, success rate and emergency change
+// ratio of each portfolio in its digests. Changes followed by incidents of the incident repository,
+// which may be nil, count as failed.
+func (s *DigestService) SetChangeRepositories(changeRepo domain.ChangeRequestRepository, incidentRepo domain.IncidentRepository) {
+	s.changeRepo = changeRepo
+	s.incidentRepo = incidentRepo
+}
+
+// GenerateDigest builds and records the digest of a portfolio for the week or month ending now,
+// and sends it when asked to and a notifier is configured
+func (s *DigestService) GenerateDigest(ctx context.Context, cmd GenerateDigestCommand) (*domain.ExecutiveDigest, error) {
+	ctx, span := s.startSpan(ctx, "DigestService.GenerateDigest", domain.PortfolioAttribute(cmd.PortfolioID))
+	defer span.End()
+
+	if err := cmd.Frequency.Validate(); err != nil {
+		return nil, err
+	}
+	if cmd.Now.IsZero() {
+		cmd.Now = s.now()
+	}
+	from := cmd.Frequency.PeriodStart(cmd.Now)
+
+	portfolio, err := s.portfolioRepo.FindByID(ctx, cmd.PortfolioID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find portfolio: %w", err)
+	}
+
+	var apps []domain.DigestApplication
+	var reportRecipients []string
+	for _, app := range portfolio.Applications {
+		input, recipients, err := s.digestApplication(ctx, app, from, cmd.Now)
+		if err != nil {
+			return nil, err
+		}
+		apps = append(apps, input)
+		reportRecipients = append(reportRecipients, recipients...)
+	}
+
+	digest := domain.BuildExecutiveDigest(portfolio, cmd.Frequency, from, cmd.Now, apps)
+	addressed := make(map[string]bool, len(digest.Recipients))
+	for _, recipient := range digest.Recipients {
+		addressed[recipient] = true
+	}
+	for _, recipient := range reportRecipients {
+		if !addressed[recipient] {
+			addressed[recipient] = true
+			digest.Recipients = append(digest.Recipients, recipient)
+		}
+	}
+
+	var sendErr error
+	if cmd.Send && s.notifier != nil {
+		sendErr = s.notifier.Notify(ctx, digestNotification(digest))
+		if sendErr == nil {
+			digest.SentAt = cmd.Now
+		}
+	}
+
+	err = s.digestRepo.Save(ctx, digest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save executive digest: %w", err)
+	}
+
+	event := domain.ExecutiveDigestGeneratedEvent{
+		DigestID:    digest.ID,
+		PortfolioID: digest.PortfolioID,
+		Frequency:   digest.Frequency,
+		From:        digest.From,
+		To:          digest.To,
+		Sent:        !digest.SentAt.IsZero(),
+		OccurredAt:  cmd.Now,
+	}
+	err = s.eventRepo.Save(ctx, event)
+	if err != nil {
+		fmt.Printf("Failed to save domain event: %v\n", err)
+	}
+
+	if sendErr != nil {
+		return &digest, fmt.Errorf("failed to send executive digest %s: %w", digest.ID, sendErr)
+	}
+	return &digest, nil
+}
+
+// DigestRun is the outcome of one round of executive digests
+type DigestRun struct {
+	Digests []domain.ExecutiveDigest
+	Failed  int // digests that could not be generated or sent
+	RanAt   time.Time
+}
+
+// SendDue generates and sends the digest of every portfolio at every frequency whose last digest
+// sent is a period old, or which never had one; a digest that could not be sent is tried again
+// on the next run. Without a notifier digests are only recorded. A failure does not stop the
+// other digests; their errors are joined.
+func (s *DigestService) SendDue(ctx context.Context, cmd SendDueDigestsCommand) (*DigestRun, error) {
+	ctx, span := s.startSpan(ctx, "DigestService.SendDue")
+	defer span.End()
+
+	if cmd.Now.IsZero() {
+		cmd.Now = s.now()
+	}
+	run := &DigestRun{RanAt: cmd.Now}
+
+	portfolios, err := s.portfolioRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list portfolios: %w", err)
+	}
+
+	var errs []error
+	for _, portfolio := range portfolios {
+		digests, err := s.digestRepo.FindByPortfolioID(ctx, portfolio.ID)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to find executive digests of %s: %w", portfolio.ID, err))
+			continue
+		}
+		for _, frequency := range cmd.Frequencies {
+			var last time.Time
+			for _, digest := range digests {
+				if s.notifier != nil && digest.SentAt.IsZero() {
+					continue
+				}
+				if digest.Frequency == frequency && digest.GeneratedAt.After(last) {
+					last = digest.GeneratedAt
+				}
+			}
+			if !domain.DigestDue(frequency, last, cmd.Now) {
+				continue
+			}
+
+			digest, err := s.GenerateDigest(ctx, GenerateDigestCommand{PortfolioID: portfolio.ID, Frequency: frequency, Now: cmd.Now, Send: true})
+			if digest != nil {
+				run.Digests = append(run.Digests, *digest)
+			}
+			if err != nil {
+				run.Failed++
+				errs = append(errs, err)
+			}
+		}
+	}
+	return run, errors.Join(errs...)
+}
+
+// ListDigests returns the executive digests generated of a portfolio, oldest first
+func (s *DigestService) ListDigests(ctx context.Context, portfolioID domain.PortfolioID) ([]domain.ExecutiveDigest, error) {
+	ctx, span := s.startSpan(ctx, "DigestService.ListDigests", domain.PortfolioAttribute(portfolioID))
+	defer span.End()
+
+	digests, err := s.digestRepo.FindByPortfolioID(ctx, portfolioID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find executive digests: %w", err)
+	}
+	return digests, nil
+}
+
+// Start sends due digests every interval until the context is cancelled. Failures are passed to
+// onError when it is not nil.
+func (s *DigestService) Start(ctx context.Context, interval time.Duration, cmd SendDueDigestsCommand, onError func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			run := cmd
+			run.Now = s.now()
+			if _, err := s.SendDue(ctx, run); err != nil && onError != nil {
+				onError(err)
+			}
+		}
+	}
+}
+
+// digestApplication gathers what was recorded of an application up to to: its monitoring
+// snapshots split at from, its escalations since from, its latest assessment and, when tracked,
+// its change requests and incidents. It also returns the recipients of the executive reports of
+// its agreement.
+func (s *DigestService) digestApplication(ctx context.Context, app domain.Application, from, to time.Time) (domain.DigestApplication, []string, error) {
+	input := domain.DigestApplication{Application: app}
+
+	if assessment, err := s.assessmentRepo.FindLatest(ctx, app.ID); err == nil && !assessment.AssessedAt.After(to) {
+		input.LatestAssessment = &assessment
+	}
+
+	if s.escalationRepo != nil {
+		steps, err := s.escalationRepo.FindByApplicationID(ctx, app.ID)
+		if err != nil {
+			return input, nil, fmt.Errorf("failed to find escalation steps of %s: %w", app.ID, err)
+		}
+		for _, step := range steps {
+			if !step.EscalatedAt.Before(from) && !step.EscalatedAt.After(to) {
+				input.Escalations = append(input.Escalations, step)
+			}
+		}
+	}
+
+	if s.changeRepo != nil {
+		changes, err := s.changeRepo.FindByApplicationID(ctx, app.ID)
+		if err != nil {
+			return input, nil, fmt.Errorf("failed to find change requests of %s: %w", app.ID, err)
+		}
+		input.Changes = append([]domain.ChangeRequest{}, changes...)
+		if s.incidentRepo != nil {
+			input.Incidents, err = s.incidentRepo.FindByApplicationID(ctx, app.ID)
+			if err != nil {
+				return input, nil, fmt.Errorf("failed to find incidents of %s: %w", app.ID, err)
+			}
+		}
+	}
+
+	agreement, err := s.agreementRepo.FindByApplicationID(ctx, app.ID)
+	if err != nil {
+		// Applications without a governance agreement are not monitored
+		return input, nil, nil
+	}
+	var recipients []string
+	for _, report := range agreement.Monitor.Reporting.Reports {
+		if report.Type == domain.ReportExecutive {
+			recipients = append(recipients, report.Recipients...)
+		}
+	}
+
+	snapshots, err := s.snapshotRepo.FindByAgreementID(ctx, agreement.ID)
+	if err != nil {
+		return input, nil, fmt.Errorf("failed to find monitoring snapshots of %s: %w", agreement.ID, err)
+	}
+	for i := range snapshots {
+		snapshot := snapshots[i]
+		switch {
+		case snapshot.TakenAt.Before(from):
+			input.Baseline = &snapshot
+		case !snapshot.TakenAt.After(to):
+			input.Snapshots = append(input.Snapshots, snapshot)
+		}
+	}
+	return input, recipients, nil
+}
+
+// digestNotification addresses a digest to its recipients and to executives of its portfolio
+func digestNotification(digest domain.ExecutiveDigest) domain.Notification {
+	summary := digest.Summary
+	lines := []string{"Period: " + summary.Period, ""}
+	for _, metric := range summary.KeyMetrics {
+		line := fmt.Sprintf("• %s: %g", metric.Name, metric.Value)
+		if metric.Unit == "%" {
+			line += "%"
+		} else if metric.Unit != "" {
+			line += " " + metric.Unit
+		}
+		if metric.Trend != "" && metric.Trend != string(domain.TrendInsufficientData) {
+			line += fmt.Sprintf(" (%s)", metric.Trend)
+		}
+		lines = append(lines, line)
+	}
+	for _, section := range []struct {
+		title string
+		items []string
+	}{
+		{"Achievements", summary.Achievements},
+		{"Challenges", summary.Challenges},
+		{"Recommendations", summary.Recommendations},
+	} {
+		if len(section.items) == 0 {
+			continue
+		}
+		lines = append(lines, "", section.title+":")
+		for _, item := range section.items {
+			lines = append(lines, "• "+item)
+		}
+	}
+
+	name := digest.PortfolioName
+	if name == "" {
+		name = string(digest.PortfolioID)
+	}
+	return domain.Notification{
+		ID:         "digest/" + digest.ID,
+		Kind:       domain.NotificationDigest,
+		Severity:   domain.NotificationInfo,
+		Title:      fmt.Sprintf("%s governance digest: %s", strings.ToUpper(string(digest.Frequency[:1]))+string(digest.Frequency[1:]), name),
+		Message:    strings.Join(lines, "\n"),
+		Portfolios: []domain.PortfolioID{digest.PortfolioID},
+		Roles:      []string{domain.RoleExecutive},
+		Recipients: digest.Recipients,
+		CreatedAt:  digest.GeneratedAt,
+	}
+}
+
+// Commands for Digest Service
+
+type GenerateDigestCommand struct {
+	PortfolioID domain.PortfolioID
+	Frequency   domain.DigestFrequency
+	Now         time.Time // optional, end of the period, defaults to now
+	Send        bool      // send the digest through the notifier
+}
+
+type SendDueDigestsCommand struct {
+	Frequencies []domain.DigestFrequency
+	Now         time.Time // optional, defaults to now
+}
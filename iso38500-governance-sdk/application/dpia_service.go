@@ -0,0 +1,392 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// DPIAService runs data protection impact assessments (DPIAs) of the applications processing
+// personal data: screening questions that identify the privacy risks of the processing, the
+// mitigations reducing them and the data protection officer's approval. The status of each DPIA
+// is recorded as a legal requirement in the conformance of its application's governance
+// agreement, where it has one.
+type DPIAService struct {
+	instrumentation
+
+	dpiaRepo       domain.DPIARepository
+	appRepo        domain.ApplicationRepository
+	agreementRepo  domain.GovernanceAgreementRepository
+	eventRepo      domain.DomainEventRepository
+	complianceRepo domain.ComplianceRepository // nil when no compliance register is kept
+	reviewPeriod   time.Duration
+	now            func() time.Time
+}
+
+// NewDPIAService creates a new DPIA service. Approved DPIAs are due for review after a year.
+func NewDPIAService(
+	dpiaRepo domain.DPIARepository,
+	appRepo domain.ApplicationRepository,
+	agreementRepo domain.GovernanceAgreementRepository,
+	eventRepo domain.DomainEventRepository,
+	opts ...ServiceOption,
+) *DPIAService {
+	return &DPIAService{
+		dpiaRepo:        dpiaRepo,
+		appRepo:         appRepo,
+		agreementRepo:   agreementRepo,
+		eventRepo:       eventRepo,
+		reviewPeriod:    365 * 24 * time.Hour,
+		now:             time.Now,
+		instrumentation: newInstrumentation(opts),
+	}
+}
+
+// SetReviewPeriod sets how long after approval a DPIA is due for review
+func (s *DPIAService) SetReviewPeriod(period time.Duration) {
+	s.reviewPeriod = period
+}
+
+// SetComplianceRepository keeps the compliance register in step with the DPIA requirements the
+// service records
+func (s *DPIAService) SetComplianceRepository(repo domain.ComplianceRepository) {
+	s.complianceRepo = repo
+}
+
+// StartDPIA starts a DPIA of an application processing personal data, with the default screening
+// questions unless others are given
+func (s *DPIAService) StartDPIA(ctx context.Context, cmd StartDPIACommand) (*domain.DPIA, error) {
+	ctx, span := s.startSpan(ctx, "DPIAService.StartDPIA", domain.ApplicationAttribute(cmd.ApplicationID))
+	defer span.End()
+
+	app, err := s.appRepo.FindByID(ctx, cmd.ApplicationID)
+	if err != nil {
+		return nil, fmt.Errorf("application not found: %w", err)
+	}
+	if !app.DataClassification.PII && !app.DataClassification.PHI {
+		return nil, fmt.Errorf("application %s is not classified as processing personal or health information", app.ID)
+	}
+	if _, err := s.dpiaRepo.FindByID(ctx, cmd.ID); err == nil {
+		return nil, fmt.Errorf("DPIA %s already exists", cmd.ID)
+	}
+
+	now := s.now()
+	dpia := domain.DPIA{
+		ID:            cmd.ID,
+		ApplicationID: cmd.ApplicationID,
+		Title:         cmd.Title,
+		Assessor:      cmd.Assessor,
+		Status:        domain.DPIADraft,
+		Questions:     cmd.Questions,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	if dpia.Title == "" {
+		dpia.Title = fmt.Sprintf("DPIA of %s", app.Name)
+	}
+	if len(dpia.Questions) == 0 {
+		dpia.Questions = domain.DefaultDPIAQuestions()
+	}
+	if err := dpia.Validate(); err != nil {
+		return nil, err
+	}
+
+	err = s.dpiaRepo.Save(ctx, dpia)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save DPIA: %w", err)
+	}
+	if err := s.recordConformance(ctx, dpia); err != nil {
+		return nil, err
+	}
+	return &dpia, nil
+}
+
+// AnswerQuestions records answers to screening questions of a DPIA and identifies the privacy
+// risks they bring
+func (s *DPIAService) AnswerQuestions(ctx context.Context, cmd AnswerDPIAQuestionsCommand) (*domain.DPIA, error) {
+	ctx, span := s.startSpan(ctx, "DPIAService.AnswerQuestions")
+	defer span.End()
+
+	for _, answer := range cmd.Answers {
+		if err := answer.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	return s.edit(ctx, cmd.DPIAID, func(dpia *domain.DPIA) error {
+		dpia.Questions = append([]domain.DPIAQuestion{}, dpia.Questions...)
+		answered := make(map[string]bool, len(cmd.Answers))
+		for i := range dpia.Questions {
+			question := &dpia.Questions[i]
+			if answer, ok := cmd.Answers[question.ID]; ok {
+				question.Answer = answer
+				answered[question.ID] = true
+			}
+			if notes, ok := cmd.Notes[question.ID]; ok {
+				question.Notes = notes
+			}
+		}
+		for id := range cmd.Answers {
+			if !answered[id] {
+				return fmt.Errorf("DPIA %s has no question %s", dpia.ID, id)
+			}
+		}
+		dpia.IdentifyRisks()
+		return nil
+	})
+}
+
+// AddRisk adds a privacy risk the screening questions did not identify
+func (s *DPIAService) AddRisk(ctx context.Context, cmd AddPrivacyRiskCommand) (*domain.DPIA, error) {
+	ctx, span := s.startSpan(ctx, "DPIAService.AddRisk")
+	defer span.End()
+
+	return s.edit(ctx, cmd.DPIAID, func(dpia *domain.DPIA) error {
+		risk := cmd.Risk
+		risk.Source = ""
+		dpia.Risks = append(append([]domain.PrivacyRisk{}, dpia.Risks...), risk)
+		return nil
+	})
+}
+
+// MitigateRisk adds a measure mitigating a privacy risk of a DPIA and, when given, the level the
+// risk is left at
+func (s *DPIAService) MitigateRisk(ctx context.Context, cmd MitigatePrivacyRiskCommand) (*domain.DPIA, error) {
+	ctx, span := s.startSpan(ctx, "DPIAService.MitigateRisk")
+	defer span.End()
+
+	if cmd.Mitigation.Description == "" {
+		return nil, fmt.Errorf("mitigation must be described")
+	}
+	return s.edit(ctx, cmd.DPIAID, func(dpia *domain.DPIA) error {
+		dpia.Risks = append([]domain.PrivacyRisk{}, dpia.Risks...)
+		for i := range dpia.Risks {
+			risk := &dpia.Risks[i]
+			if risk.ID != cmd.RiskID {
+				continue
+			}
+			risk.Mitigations = append(append([]domain.PrivacyMitigation{}, risk.Mitigations...), cmd.Mitigation)
+			if cmd.ResidualLevel != "" {
+				risk.ResidualLevel = cmd.ResidualLevel
+			}
+			return nil
+		}
+		return fmt.Errorf("DPIA %s has no privacy risk %s", dpia.ID, cmd.RiskID)
+	})
+}
+
+// Submit submits a DPIA whose questions are answered and whose high and critical risks are
+// mitigated to the data protection officer, publishing a DPIASubmittedEvent
+func (s *DPIAService) Submit(ctx context.Context, cmd SubmitDPIACommand) (*domain.DPIA, error) {
+	ctx, span := s.startSpan(ctx, "DPIAService.Submit")
+	defer span.End()
+
+	dpia, err := s.edit(ctx, cmd.DPIAID, func(dpia *domain.DPIA) error {
+		if err := dpia.ReadyForReview(); err != nil {
+			return err
+		}
+		dpia.Status = domain.DPIASubmitted
+		dpia.SubmittedAt = s.now()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	event := domain.DPIASubmittedEvent{
+		DPIAID:        dpia.ID,
+		ApplicationID: dpia.ApplicationID,
+		Assessor:      dpia.Assessor,
+		Risks:         len(dpia.Risks),
+		ResidualRisk:  dpia.ResidualRisk(),
+		OccurredAt:    dpia.SubmittedAt,
+	}
+	err = s.eventRepo.Save(ctx, event)
+	if err != nil {
+		fmt.Printf("Failed to save domain event: %v\n", err)
+	}
+	return dpia, nil
+}
+
+// Decide records the data protection officer approving a submitted DPIA or returning it to its
+// assessor for rework, publishing a DPIADecidedEvent. The officer cannot decide on a DPIA they
+// assessed themselves.
+func (s *DPIAService) Decide(ctx context.Context, cmd DecideDPIACommand) (*domain.DPIA, error) {
+	ctx, span := s.startSpan(ctx, "DPIAService.Decide")
+	defer span.End()
+
+	if cmd.DPO == "" {
+		return nil, fmt.Errorf("the data protection officer deciding must be named")
+	}
+	dpia, err := s.dpiaRepo.FindByID(ctx, cmd.DPIAID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find DPIA: %w", err)
+	}
+	if dpia.Status != domain.DPIASubmitted {
+		return nil, fmt.Errorf("DPIA %s is %s, only submitted DPIAs can be decided", dpia.ID, dpia.Status)
+	}
+	if dpia.Assessor == cmd.DPO {
+		return nil, fmt.Errorf("%s assessed DPIA %s and cannot also decide on it", cmd.DPO, dpia.ID)
+	}
+
+	now := s.now()
+	dpia.Status = domain.DPIARejected
+	dpia.ReviewBy = time.Time{}
+	if cmd.Approve {
+		dpia.Status = domain.DPIAApproved
+		dpia.ReviewBy = cmd.ReviewBy
+		if dpia.ReviewBy.IsZero() {
+			dpia.ReviewBy = now.Add(s.reviewPeriod)
+		}
+	}
+	dpia.DPO = cmd.DPO
+	dpia.DPOOpinion = cmd.Opinion
+	dpia.DecidedAt = now
+	dpia.UpdatedAt = now
+
+	err = s.dpiaRepo.Update(ctx, dpia)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update DPIA: %w", err)
+	}
+	if err := s.recordConformance(ctx, dpia); err != nil {
+		return nil, err
+	}
+
+	event := domain.DPIADecidedEvent{
+		DPIAID:                    dpia.ID,
+		ApplicationID:             dpia.ApplicationID,
+		DPO:                       dpia.DPO,
+		Status:                    dpia.Status,
+		Opinion:                   dpia.DPOOpinion,
+		ResidualRisk:              dpia.ResidualRisk(),
+		PriorConsultationRequired: dpia.PriorConsultationRequired(),
+		OccurredAt:                now,
+	}
+	err = s.eventRepo.Save(ctx, event)
+	if err != nil {
+		fmt.Printf("Failed to save domain event: %v\n", err)
+	}
+	return &dpia, nil
+}
+
+// GetDPIA returns a DPIA
+func (s *DPIAService) GetDPIA(ctx context.Context, id string) (*domain.DPIA, error) {
+	ctx, span := s.startSpan(ctx, "DPIAService.GetDPIA")
+	defer span.End()
+
+	dpia, err := s.dpiaRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find DPIA: %w", err)
+	}
+	return &dpia, nil
+}
+
+// ListDPIAs returns every DPIA, or those of an application, oldest first
+func (s *DPIAService) ListDPIAs(ctx context.Context, appID domain.ApplicationID) ([]domain.DPIA, error) {
+	ctx, span := s.startSpan(ctx, "DPIAService.ListDPIAs", domain.ApplicationAttribute(appID))
+	defer span.End()
+
+	if appID != "" {
+		dpias, err := s.dpiaRepo.FindByApplicationID(ctx, appID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load DPIAs of %s: %w", appID, err)
+		}
+		return dpias, nil
+	}
+	dpias, err := s.dpiaRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load DPIAs: %w", err)
+	}
+	return dpias, nil
+}
+
+// edit applies a change to a DPIA still being assessed and saves it. A DPIA returned for rework
+// goes back to draft.
+func (s *DPIAService) edit(ctx context.Context, id string, change func(*domain.DPIA) error) (*domain.DPIA, error) {
+	dpia, err := s.dpiaRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find DPIA: %w", err)
+	}
+	if !dpia.Editable() {
+		return nil, fmt.Errorf("DPIA %s is %s and can no longer be changed", dpia.ID, dpia.Status)
+	}
+	previous := dpia.Status
+	dpia.Status = domain.DPIADraft
+	if err := change(&dpia); err != nil {
+		return nil, err
+	}
+	if err := dpia.Validate(); err != nil {
+		return nil, err
+	}
+
+	dpia.UpdatedAt = s.now()
+	err = s.dpiaRepo.Update(ctx, dpia)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update DPIA: %w", err)
+	}
+	if dpia.Status != previous {
+		if err := s.recordConformance(ctx, dpia); err != nil {
+			return nil, err
+		}
+	}
+	return &dpia, nil
+}
+
+// recordConformance records the status of the DPIA in the conformance of its application's
+// governance agreement, when it has one
+func (s *DPIAService) recordConformance(ctx context.Context, dpia domain.DPIA) error {
+	agreement, err := s.agreementRepo.FindByApplicationID(ctx, dpia.ApplicationID)
+	if err != nil {
+		return nil
+	}
+	agreement.Conformance = domain.ApplyDPIA(agreement.Conformance, dpia, s.now())
+	err = s.agreementRepo.Update(ctx, agreement)
+	if err != nil {
+		return fmt.Errorf("failed to update governance agreement: %w", err)
+	}
+	if s.complianceRepo != nil {
+		return domain.RegisterConformance(ctx, s.complianceRepo, agreement.ApplicationID, agreement.Conformance)
+	}
+	return nil
+}
+
+// Commands for DPIA Service
+
+type StartDPIACommand struct {
+	ID            string
+	ApplicationID domain.ApplicationID
+	Title         string // optional, defaults to "DPIA of <application name>"
+	Assessor      string
+	Questions     []domain.DPIAQuestion // optional, defaults to the standard screening questions
+}
+
+type AnswerDPIAQuestionsCommand struct {
+	DPIAID  string
+	Answers map[string]domain.DPIAAnswer // by question ID
+	Notes   map[string]string            // by question ID, optional
+}
+
+type AddPrivacyRiskCommand struct {
+	DPIAID string
+	Risk   domain.PrivacyRisk
+}
+
+type MitigatePrivacyRiskCommand struct {
+	DPIAID        string
+	RiskID        string
+	Mitigation    domain.PrivacyMitigation
+	ResidualLevel domain.RiskLevel // optional, the level the risk is left at
+}
+
+type SubmitDPIACommand struct {
+	DPIAID string
+}
+
+type DecideDPIACommand struct {
+	DPIAID   string
+	DPO      string
+	Approve  bool // false returns the DPIA to its assessor for rework
+	Opinion  string
+	ReviewBy time.Time // optional, defaults to a year after approval
+}
@@ -0,0 +1,293 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// EscalationService escalates governance work left waiting through the levels of the escalation
+// matrices in its agreement: active alerts nobody has acknowledged and unresolved incidents
+// through the performance escalation process, and change requests awaiting a decision through
+// the change request process. Each level is engaged once its response time has passed since the
+// work was raised; every step is recorded, published with an EscalationTriggeredEvent and sent
+// to the level's contacts.
+type EscalationService struct {
+	instrumentation
+
+	agreementRepo     domain.GovernanceAgreementRepository
+	incidentRepo      domain.IncidentRepository      // nil without incident management
+	changeRequestRepo domain.ChangeRequestRepository // nil without change management
+	escalationRepo    domain.EscalationRepository
+	notifier          domain.Notifier // nil records steps without notifying
+	eventRepo         domain.DomainEventRepository
+	now               func() time.Time
+}
+
+// NewEscalationService creates a new escalation service. The incident and change request
+// repositories may be nil, in which case incidents or change requests are not escalated, and the
+// notifier may be nil, in which case escalation steps are recorded without being sent.
+func NewEscalationService(
+	agreementRepo domain.GovernanceAgreementRepository,
+	incidentRepo domain.IncidentRepository,
+	changeRequestRepo domain.ChangeRequestRepository,
+	escalationRepo domain.EscalationRepository,
+	notifier domain.Notifier,
+	eventRepo domain.DomainEventRepository,
+	opts ...ServiceOption,
+) *EscalationService {
+	return &EscalationService{
+		agreementRepo:     agreementRepo,
+		incidentRepo:      incidentRepo,
+		changeRequestRepo: changeRequestRepo,
+		escalationRepo:    escalationRepo,
+		notifier:          notifier,
+		eventRepo:         eventRepo,
+		now:               time.Now,
+		instrumentation:   newInstrumentation(opts),
+	}
+}
+
+// EscalationRun is the outcome of one round of escalations
+type EscalationRun struct {
+	Steps  []domain.EscalationStep
+	Failed int // steps whose notification could not be sent
+	RanAt  time.Time
+}
+
+// escalationCandidate is governance work that may be escalated
+type escalationCandidate struct {
+	subject   domain.EscalationSubject
+	subjectID string
+	title     string
+	agreement domain.GovernanceAgreement
+	since     time.Time // when the work was raised
+	severity  domain.NotificationSeverity
+}
+
+// EscalateDue escalates every alert, incident and change request whose next escalation level is
+// due. A failure does not stop the other escalations; their errors are joined.
+func (s *EscalationService) EscalateDue(ctx context.Context, cmd EscalateDueCommand) (*EscalationRun, error) {
+	ctx, span := s.startSpan(ctx, "EscalationService.EscalateDue")
+	defer span.End()
+
+	if cmd.Now.IsZero() {
+		cmd.Now = s.now()
+	}
+	run := &EscalationRun{RanAt: cmd.Now}
+
+	candidates, err := s.candidates(ctx)
+	var errs []error
+	if err != nil {
+		errs = append(errs, err)
+	}
+	for _, candidate := range candidates {
+		if err := s.escalate(ctx, candidate, cmd.Now, run); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return run, errors.Join(errs...)
+}
+
+// ListEscalations returns the escalation steps taken on an application's alerts, incidents and
+// change requests, oldest first
+func (s *EscalationService) ListEscalations(ctx context.Context, appID domain.ApplicationID) ([]domain.EscalationStep, error) {
+	ctx, span := s.startSpan(ctx, "EscalationService.ListEscalations", domain.ApplicationAttribute(appID))
+	defer span.End()
+
+	steps, err := s.escalationRepo.FindByApplicationID(ctx, appID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find escalation steps: %w", err)
+	}
+	return steps, nil
+}
+
+// Start escalates due work every interval until the context is cancelled. Failures are passed to
+// onError when it is not nil.
+func (s *EscalationService) Start(ctx context.Context, interval time.Duration, onError func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.EscalateDue(ctx, EscalateDueCommand{Now: s.now()}); err != nil && onError != nil {
+				onError(err)
+			}
+		}
+	}
+}
+
+// candidates gathers the unacknowledged alerts, unresolved incidents and change requests awaiting
+// a decision of every application with a governance agreement
+func (s *EscalationService) candidates(ctx context.Context) ([]escalationCandidate, error) {
+	agreements, err := s.agreementRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list governance agreements: %w", err)
+	}
+
+	byApplication := make(map[domain.ApplicationID]domain.GovernanceAgreement, len(agreements))
+	var candidates []escalationCandidate
+	for _, agreement := range agreements {
+		byApplication[agreement.ApplicationID] = agreement
+		for _, alert := range agreement.Monitor.ActiveAlerts {
+			if !alert.AcknowledgedAt.IsZero() {
+				continue
+			}
+			candidates = append(candidates, escalationCandidate{
+				subject:   domain.EscalationAlert,
+				subjectID: alert.ID,
+				title:     fmt.Sprintf("%s alert on %s %s", alert.Severity, alert.Source, alert.Subject),
+				agreement: agreement,
+				since:     alert.RaisedAt,
+				severity:  domain.NotificationSeverity(alert.Severity),
+			})
+		}
+	}
+
+	if s.incidentRepo != nil {
+		for _, status := range []domain.IncidentStatus{domain.IncidentStatusOpen, domain.IncidentStatusInvestigating} {
+			incidents, err := s.incidentRepo.FindByStatus(ctx, status)
+			if err != nil {
+				return candidates, fmt.Errorf("failed to find %s incidents: %w", status, err)
+			}
+			for _, incident := range incidents {
+				agreement, ok := byApplication[incident.ApplicationID]
+				if !ok {
+					continue
+				}
+				severity := domain.NotificationWarning
+				if incident.Severity == 1 {
+					severity = domain.NotificationCritical
+				}
+				candidates = append(candidates, escalationCandidate{
+					subject:   domain.EscalationIncident,
+					subjectID: incident.ID,
+					title:     fmt.Sprintf("Severity %d incident %s", incident.Severity, incident.Title),
+					agreement: agreement,
+					since:     incident.CreatedAt,
+					severity:  severity,
+				})
+			}
+		}
+	}
+
+	if s.changeRequestRepo != nil {
+		var changes []domain.ChangeRequest
+		for _, status := range []domain.ChangeRequestStatus{domain.ChangeStatusSubmitted, domain.ChangeStatusPendingApproval} {
+			found, err := s.changeRequestRepo.FindByStatus(ctx, status)
+			if err != nil {
+				return candidates, fmt.Errorf("failed to find %s change requests: %w", status, err)
+			}
+			changes = append(changes, found...)
+		}
+		for _, change := range changes {
+			agreement, ok := byApplication[change.ApplicationID]
+			if !ok {
+				continue
+			}
+			since := change.SubmittedAt
+			if since.IsZero() {
+				since = change.UpdatedAt
+			}
+			severity := domain.NotificationWarning
+			if change.Priority == domain.PriorityCritical {
+				severity = domain.NotificationCritical
+			}
+			candidates = append(candidates, escalationCandidate{
+				subject:   domain.EscalationChangeRequest,
+				subjectID: change.ID,
+				title:     fmt.Sprintf("Change request %s awaiting approval", change.Title),
+				agreement: agreement,
+				since:     since,
+				severity:  severity,
+			})
+		}
+	}
+	return candidates, nil
+}
+
+// escalate takes the candidate through every level of its escalation matrix that is due and not
+// yet reached since it was raised
+func (s *EscalationService) escalate(ctx context.Context, candidate escalationCandidate, now time.Time, run *EscalationRun) error {
+	matrix := domain.EscalationMatrixFor(candidate.agreement, domain.EscalationScopeOf(candidate.subject))
+	if len(matrix) == 0 {
+		return nil
+	}
+
+	steps, err := s.escalationRepo.FindBySubject(ctx, candidate.subject, candidate.subjectID)
+	if err != nil {
+		return fmt.Errorf("failed to find escalation steps of %s %s: %w", candidate.subject, candidate.subjectID, err)
+	}
+	reached := domain.EscalationLevelReached(steps, candidate.since)
+
+	var errs []error
+	for _, level := range domain.DueEscalationLevels(matrix, candidate.since, reached, now) {
+		step := domain.EscalationStep{
+			ID:            fmt.Sprintf("%s/%s/level-%d/%s", candidate.subject, candidate.subjectID, level.Level, now.UTC().Format("20060102T150405Z")),
+			Subject:       candidate.subject,
+			SubjectID:     candidate.subjectID,
+			AgreementID:   candidate.agreement.ID,
+			ApplicationID: candidate.agreement.ApplicationID,
+			Level:         level.Level,
+			Description:   level.Description,
+			Contacts:      level.Contacts,
+			Waiting:       now.Sub(candidate.since),
+			EscalatedAt:   now,
+		}
+		err := s.escalationRepo.Save(ctx, step)
+		if err != nil {
+			return errors.Join(append(errs, fmt.Errorf("failed to save escalation step: %w", err))...)
+		}
+		run.Steps = append(run.Steps, step)
+
+		event := domain.EscalationTriggeredEvent{
+			Subject:       step.Subject,
+			SubjectID:     step.SubjectID,
+			AgreementID:   step.AgreementID,
+			ApplicationID: step.ApplicationID,
+			Level:         step.Level,
+			Contacts:      step.Contacts,
+			Waiting:       step.Waiting,
+			OccurredAt:    now,
+		}
+		err = s.eventRepo.Save(ctx, event)
+		if err != nil {
+			fmt.Printf("Failed to save domain event: %v\n", err)
+		}
+
+		if s.notifier == nil {
+			continue
+		}
+		notification := domain.Notification{
+			ID:            "escalation/" + step.ID,
+			Kind:          domain.NotificationEscalation,
+			Severity:      candidate.severity,
+			Title:         fmt.Sprintf("Escalation level %d: %s", step.Level, candidate.title),
+			Message:       fmt.Sprintf("Waiting for %s", step.Waiting.Round(time.Minute)),
+			AgreementID:   step.AgreementID,
+			ApplicationID: step.ApplicationID,
+			Recipients:    step.Contacts,
+			CreatedAt:     now,
+		}
+		if step.Description != "" {
+			notification.Message += "\n" + step.Description
+		}
+		if err := s.notifier.Notify(ctx, notification); err != nil {
+			run.Failed++
+			errs = append(errs, fmt.Errorf("failed to send notification %s: %w", notification.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Commands for Escalation Service
+
+type EscalateDueCommand struct {
+	Now time.Time // optional, defaults to now
+}
@@ -0,0 +1,250 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// EvaluationScheduler runs recurring agreement and portfolio evaluations on cron schedules.
+// Application assessments are recorded in the evaluation service's assessment history, and
+// every run publishes a GovernanceEvaluationCompletedEvent. Agreement evaluations also report
+// the conformance requirements that drifted since compliance was last monitored.
+type EvaluationScheduler struct {
+	instrumentation
+
+	scheduleRepo      domain.EvaluationScheduleRepository
+	governanceService *GovernanceService
+	eventRepo         domain.DomainEventRepository
+	now               func() time.Time
+}
+
+// NewEvaluationScheduler creates a new evaluation scheduler
+func NewEvaluationScheduler(
+	scheduleRepo domain.EvaluationScheduleRepository,
+	governanceService *GovernanceService,
+	eventRepo domain.DomainEventRepository,
+	opts ...ServiceOption,
+) *EvaluationScheduler {
+	return &EvaluationScheduler{
+		scheduleRepo:      scheduleRepo,
+		governanceService: governanceService,
+		eventRepo:         eventRepo,
+		now:               time.Now,
+		instrumentation:   newInstrumentation(opts),
+	}
+}
+
+// ScheduledEvaluationRun is the outcome of one scheduled evaluation
+type ScheduledEvaluationRun struct {
+	ScheduleID string
+	RanAt      time.Time
+	Event      domain.GovernanceEvaluationCompletedEvent
+	Err        error
+}
+
+// Schedule registers a recurring evaluation of an agreement's application or of a portfolio
+func (s *EvaluationScheduler) Schedule(ctx context.Context, cmd ScheduleEvaluationCommand) (*domain.EvaluationSchedule, error) {
+	ctx, span := s.startSpan(ctx, "EvaluationScheduler.Schedule", domain.AgreementAttribute(cmd.AgreementID), domain.PortfolioAttribute(cmd.PortfolioID))
+	defer span.End()
+
+	schedule := domain.EvaluationSchedule{
+		ID:          cmd.ID,
+		AgreementID: cmd.AgreementID,
+		PortfolioID: cmd.PortfolioID,
+		Cron:        cmd.Cron,
+		Evaluator:   cmd.Evaluator,
+		Enabled:     true,
+		CreatedAt:   s.now(),
+	}
+	if cmd.AgreementID != "" {
+		schedule.Target = domain.ScheduleTargetAgreement
+	} else {
+		schedule.Target = domain.ScheduleTargetPortfolio
+	}
+	if schedule.Evaluator == "" {
+		schedule.Evaluator = "scheduler"
+	}
+	if err := schedule.Validate(); err != nil {
+		return nil, err
+	}
+
+	cron, _ := domain.ParseCronSchedule(schedule.Cron)
+	schedule.NextRunAt = cron.Next(schedule.CreatedAt)
+
+	err := s.scheduleRepo.Save(ctx, schedule)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save evaluation schedule: %w", err)
+	}
+
+	return &schedule, nil
+}
+
+// Unschedule removes a recurring evaluation
+func (s *EvaluationScheduler) Unschedule(ctx context.Context, scheduleID string) error {
+	ctx, span := s.startSpan(ctx, "EvaluationScheduler.Unschedule")
+	defer span.End()
+
+	err := s.scheduleRepo.Delete(ctx, scheduleID)
+	if err != nil {
+		return fmt.Errorf("failed to delete evaluation schedule: %w", err)
+	}
+
+	return nil
+}
+
+// ListSchedules returns every registered schedule
+func (s *EvaluationScheduler) ListSchedules(ctx context.Context) ([]domain.EvaluationSchedule, error) {
+	ctx, span := s.startSpan(ctx, "EvaluationScheduler.ListSchedules")
+	defer span.End()
+
+	schedules, err := s.scheduleRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list evaluation schedules: %w", err)
+	}
+
+	return schedules, nil
+}
+
+// RunDue runs every schedule that is due at now and advances it to its next run. A failed
+// evaluation is recorded on its schedule and in the returned run; it does not stop other runs.
+func (s *EvaluationScheduler) RunDue(ctx context.Context, now time.Time) ([]ScheduledEvaluationRun, error) {
+	ctx, span := s.startSpan(ctx, "EvaluationScheduler.RunDue")
+	defer span.End()
+
+	due, err := s.scheduleRepo.FindDue(ctx, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find due evaluation schedules: %w", err)
+	}
+
+	runs := make([]ScheduledEvaluationRun, 0, len(due))
+	for _, schedule := range due {
+		event, runErr := s.evaluate(ctx, schedule, now)
+		run := ScheduledEvaluationRun{ScheduleID: schedule.ID, RanAt: now, Event: event, Err: runErr}
+
+		schedule.LastRunAt = now
+		schedule.LastError = ""
+		if runErr != nil {
+			schedule.LastError = runErr.Error()
+		} else {
+			err = s.eventRepo.Save(ctx, event)
+			if err != nil {
+				fmt.Printf("Failed to save domain event: %v\n", err)
+			}
+		}
+		cron, _ := domain.ParseCronSchedule(schedule.Cron)
+		schedule.NextRunAt = cron.Next(now)
+
+		err = s.scheduleRepo.Update(ctx, schedule)
+		if err != nil {
+			return runs, fmt.Errorf("failed to update evaluation schedule: %w", err)
+		}
+		runs = append(runs, run)
+	}
+
+	return runs, nil
+}
+
+// Start runs due schedules every interval until the context is cancelled. Failures are passed
+// to onError when it is not nil.
+func (s *EvaluationScheduler) Start(ctx context.Context, interval time.Duration, onError func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	report := func(err error) {
+		if onError != nil {
+			onError(err)
+		}
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			runs, err := s.RunDue(ctx, s.now())
+			if err != nil {
+				report(err)
+			}
+			for _, run := range runs {
+				if run.Err != nil {
+					report(fmt.Errorf("scheduled evaluation %s failed: %w", run.ScheduleID, run.Err))
+				}
+			}
+		}
+	}
+}
+
+// evaluate runs one schedule's evaluation and describes the result as a completion event
+func (s *EvaluationScheduler) evaluate(ctx context.Context, schedule domain.EvaluationSchedule, now time.Time) (domain.GovernanceEvaluationCompletedEvent, error) {
+	event := domain.GovernanceEvaluationCompletedEvent{
+		AgreementID: schedule.AgreementID,
+		PortfolioID: schedule.PortfolioID,
+		Evaluator:   schedule.Evaluator,
+		OccurredAt:  now,
+	}
+
+	switch schedule.Target {
+	case domain.ScheduleTargetAgreement:
+		agreement, err := s.governanceService.GetGovernanceAgreement(ctx, schedule.AgreementID)
+		if err != nil {
+			return event, err
+		}
+		assessment, err := s.governanceService.EvaluateApplication(ctx, EvaluateApplicationCommand{
+			ApplicationID: agreement.ApplicationID,
+			Evaluator:     schedule.Evaluator,
+		})
+		if err != nil {
+			return event, err
+		}
+
+		event.Findings = append(event.Findings, fmt.Sprintf("%s risk level is %s", assessment.ApplicationID, assessment.RiskLevel))
+		for _, breach := range assessment.SLABreaches {
+			event.Findings = append(event.Findings, breach.Description())
+		}
+		for _, recommendation := range assessment.Recommendations {
+			event.Recommendations = append(event.Recommendations, recommendation.Description)
+		}
+
+		reports, err := s.governanceService.DetectComplianceDrift(ctx, DetectComplianceDriftCommand{AgreementID: schedule.AgreementID})
+		if err != nil {
+			return event, err
+		}
+		for _, change := range reports[0].Changes {
+			event.Findings = append(event.Findings, fmt.Sprintf("%s drifted from %s to %s", change.Requirement, change.Previous, change.Current))
+		}
+
+	case domain.ScheduleTargetPortfolio:
+		assessment, err := s.governanceService.EvaluatePortfolio(ctx, EvaluatePortfolioCommand{
+			PortfolioID: schedule.PortfolioID,
+			Evaluator:   schedule.Evaluator,
+		})
+		if err != nil {
+			return event, err
+		}
+
+		event.Findings = append(event.Findings, fmt.Sprintf("%d applications, %d active, %d deprecated, %d redundant",
+			assessment.TotalApplications, assessment.ActiveApplications, assessment.DeprecatedApplications, assessment.RedundantApplications))
+		for _, level := range []domain.RiskLevel{domain.RiskCritical, domain.RiskHigh, domain.RiskMedium, domain.RiskLow} {
+			if count := assessment.RiskDistribution[level]; count > 0 {
+				event.Findings = append(event.Findings, fmt.Sprintf("%d applications at %s risk", count, level))
+			}
+		}
+		for _, candidate := range assessment.ConsolidationCandidates {
+			event.Recommendations = append(event.Recommendations, candidate.Rationale)
+		}
+	}
+
+	return event, nil
+}
+
+// Commands for Evaluation Scheduler
+
+type ScheduleEvaluationCommand struct {
+	ID          string
+	AgreementID domain.GovernanceAgreementID // evaluates the agreement's application
+	PortfolioID domain.PortfolioID           // evaluates the portfolio when no agreement is given
+	Cron        string
+	Evaluator   string // optional, defaults to "scheduler"
+}
@@ -0,0 +1,217 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// EvidenceService attaches evidence to recorded assessments and audit findings so evaluations can
+// be defended during external audits. Evidence is kept in the attachment store and loaded onto
+// assessments and audits when they are read through this service.
+type EvidenceService struct {
+	instrumentation
+
+	attachmentStore domain.AttachmentStore
+	assessmentRepo  domain.AssessmentRepository
+	auditRepo       domain.AuditRepository
+	eventRepo       domain.DomainEventRepository
+}
+
+// NewEvidenceService creates a new evidence service. The audit repository may be nil when only
+// assessments take evidence.
+func NewEvidenceService(
+	attachmentStore domain.AttachmentStore,
+	assessmentRepo domain.AssessmentRepository,
+	auditRepo domain.AuditRepository,
+	eventRepo domain.DomainEventRepository,
+	opts ...ServiceOption,
+) *EvidenceService {
+	return &EvidenceService{
+		attachmentStore: attachmentStore,
+		assessmentRepo:  assessmentRepo,
+		auditRepo:       auditRepo,
+		eventRepo:       eventRepo,
+		instrumentation: newInstrumentation(opts),
+	}
+}
+
+// AttachAssessmentEvidence attaches evidence to a recorded assessment of an application. An empty
+// assessment ID selects the latest assessment.
+func (s *EvidenceService) AttachAssessmentEvidence(ctx context.Context, cmd AttachAssessmentEvidenceCommand) (*domain.Evidence, error) {
+	ctx, span := s.startSpan(ctx, "EvidenceService.AttachAssessmentEvidence", domain.ApplicationAttribute(cmd.ApplicationID))
+	defer span.End()
+
+	assessment, err := s.findAssessment(ctx, cmd.ApplicationID, cmd.AssessmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	evidence := cmd.Evidence
+	evidence.Subject = domain.AssessmentEvidenceSubject(assessment.ID)
+	return s.attach(ctx, cmd.ApplicationID, evidence)
+}
+
+// AttachAuditFindingEvidence attaches evidence to an audit finding
+func (s *EvidenceService) AttachAuditFindingEvidence(ctx context.Context, cmd AttachAuditFindingEvidenceCommand) (*domain.Evidence, error) {
+	ctx, span := s.startSpan(ctx, "EvidenceService.AttachAuditFindingEvidence")
+	defer span.End()
+
+	if s.auditRepo == nil {
+		return nil, fmt.Errorf("audits are not configured")
+	}
+
+	audit, err := s.auditRepo.FindByID(ctx, cmd.AuditID)
+	if err != nil {
+		return nil, fmt.Errorf("audit not found: %w", err)
+	}
+
+	found := false
+	for _, finding := range audit.Findings {
+		if finding.ID == cmd.FindingID {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("finding %s not found in audit %s", cmd.FindingID, cmd.AuditID)
+	}
+
+	evidence := cmd.Evidence
+	evidence.Subject = domain.AuditFindingEvidenceSubject(cmd.AuditID, cmd.FindingID)
+	return s.attach(ctx, audit.ApplicationID, evidence)
+}
+
+// GetAssessmentWithEvidence returns a recorded assessment with its attached evidence. An empty
+// assessment ID selects the latest assessment.
+func (s *EvidenceService) GetAssessmentWithEvidence(ctx context.Context, appID domain.ApplicationID, assessmentID string) (*domain.ApplicationAssessment, error) {
+	ctx, span := s.startSpan(ctx, "EvidenceService.GetAssessmentWithEvidence", domain.ApplicationAttribute(appID))
+	defer span.End()
+
+	assessment, err := s.findAssessment(ctx, appID, assessmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	assessment.Attachments, err = s.attachmentStore.FindBySubject(ctx, domain.AssessmentEvidenceSubject(assessment.ID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load evidence: %w", err)
+	}
+
+	return &assessment, nil
+}
+
+// GetAuditWithEvidence returns an audit with the evidence attached to each of its findings
+func (s *EvidenceService) GetAuditWithEvidence(ctx context.Context, auditID string) (*domain.Audit, error) {
+	ctx, span := s.startSpan(ctx, "EvidenceService.GetAuditWithEvidence")
+	defer span.End()
+
+	if s.auditRepo == nil {
+		return nil, fmt.Errorf("audits are not configured")
+	}
+
+	audit, err := s.auditRepo.FindByID(ctx, auditID)
+	if err != nil {
+		return nil, fmt.Errorf("audit not found: %w", err)
+	}
+
+	findings := make([]domain.AuditFinding, len(audit.Findings))
+	for i, finding := range audit.Findings {
+		finding.Attachments, err = s.attachmentStore.FindBySubject(ctx, domain.AuditFindingEvidenceSubject(audit.ID, finding.ID))
+		if err != nil {
+			return nil, fmt.Errorf("failed to load evidence: %w", err)
+		}
+		findings[i] = finding
+	}
+	audit.Findings = findings
+
+	return &audit, nil
+}
+
+// VerifyEvidence checks content against the hash recorded when the evidence was attached
+func (s *EvidenceService) VerifyEvidence(ctx context.Context, evidenceID string, content []byte) error {
+	ctx, span := s.startSpan(ctx, "EvidenceService.VerifyEvidence")
+	defer span.End()
+
+	evidence, err := s.attachmentStore.FindByID(ctx, evidenceID)
+	if err != nil {
+		return fmt.Errorf("evidence not found: %w", err)
+	}
+
+	return evidence.Verify(content)
+}
+
+// attach validates and stores evidence and publishes an EvidenceAttachedEvent
+func (s *EvidenceService) attach(ctx context.Context, appID domain.ApplicationID, evidence domain.Evidence) (*domain.Evidence, error) {
+	if evidence.AttachedAt.IsZero() {
+		evidence.AttachedAt = time.Now()
+	}
+	if evidence.CollectedAt.IsZero() {
+		evidence.CollectedAt = evidence.AttachedAt
+	}
+	evidence.ApplicationID = appID
+	if err := evidence.Validate(); err != nil {
+		return nil, err
+	}
+
+	err := s.attachmentStore.Save(ctx, evidence)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save evidence: %w", err)
+	}
+
+	event := domain.EvidenceAttachedEvent{
+		EvidenceID:    evidence.ID,
+		ApplicationID: appID,
+		Subject:       evidence.Subject,
+		Kind:          evidence.Kind,
+		URI:           evidence.URI,
+		SHA256:        evidence.SHA256,
+		AttachedBy:    evidence.AttachedBy,
+		OccurredAt:    evidence.AttachedAt,
+	}
+
+	err = s.eventRepo.Save(ctx, event)
+	if err != nil {
+		fmt.Printf("Failed to save domain event: %v\n", err)
+	}
+
+	return &evidence, nil
+}
+
+// findAssessment looks up a recorded assessment, or the latest one when assessmentID is empty
+func (s *EvidenceService) findAssessment(ctx context.Context, appID domain.ApplicationID, assessmentID string) (domain.ApplicationAssessment, error) {
+	if assessmentID == "" {
+		assessment, err := s.assessmentRepo.FindLatest(ctx, appID)
+		if err != nil {
+			return domain.ApplicationAssessment{}, fmt.Errorf("failed to find latest assessment: %w", err)
+		}
+		return assessment, nil
+	}
+
+	history, err := s.assessmentRepo.FindByApplicationID(ctx, appID)
+	if err != nil {
+		return domain.ApplicationAssessment{}, fmt.Errorf("failed to load assessment history: %w", err)
+	}
+	for _, assessment := range history {
+		if assessment.ID == assessmentID {
+			return assessment, nil
+		}
+	}
+	return domain.ApplicationAssessment{}, fmt.Errorf("assessment %s not found for %s", assessmentID, appID)
+}
+
+// Commands for Evidence Service
+
+type AttachAssessmentEvidenceCommand struct {
+	ApplicationID domain.ApplicationID
+	AssessmentID  string
+	Evidence      domain.Evidence
+}
+
+type AttachAuditFindingEvidenceCommand struct {
+	AuditID   string
+	FindingID string
+	Evidence  domain.Evidence
+}
@@ -10,12 +10,15 @@ import (
 
 // GovernanceService provides application services for governance management
 type GovernanceService struct {
+	instrumentation
+
 	agreementRepo  domain.GovernanceAgreementRepository
 	appRepo        domain.ApplicationRepository
 	eventRepo      domain.DomainEventRepository
 	evalService    *domain.EvaluationService
 	directService  *domain.DirectionService
 	monitorService *domain.MonitoringService
+	sodPolicy      domain.SegregationOfDutiesPolicy
 }
 
 // NewGovernanceService creates a new governance service
@@ -26,19 +29,31 @@ func NewGovernanceService(
 	evalService *domain.EvaluationService,
 	directService *domain.DirectionService,
 	monitorService *domain.MonitoringService,
+	opts ...ServiceOption,
 ) *GovernanceService {
 	return &GovernanceService{
-		agreementRepo:  agreementRepo,
-		appRepo:        appRepo,
-		eventRepo:      eventRepo,
-		evalService:    evalService,
-		directService:  directService,
-		monitorService: monitorService,
+		agreementRepo:   agreementRepo,
+		appRepo:         appRepo,
+		eventRepo:       eventRepo,
+		evalService:     evalService,
+		directService:   directService,
+		monitorService:  monitorService,
+		sodPolicy:       domain.DefaultSegregationOfDutiesPolicy(),
+		instrumentation: newInstrumentation(opts),
 	}
 }
 
+// SetSegregationOfDutiesPolicy replaces the rules keeping the evaluation, review and sign-off of
+// an assessment apart
+func (s *GovernanceService) SetSegregationOfDutiesPolicy(policy domain.SegregationOfDutiesPolicy) {
+	s.sodPolicy = policy
+}
+
 // CreateGovernanceAgreement creates a new governance agreement
 func (s *GovernanceService) CreateGovernanceAgreement(ctx context.Context, cmd CreateGovernanceAgreementCommand) (*domain.GovernanceAgreement, error) {
+	ctx, span := s.startSpan(ctx, "GovernanceService.CreateGovernanceAgreement", domain.AgreementAttribute(cmd.ID), domain.ApplicationAttribute(cmd.ApplicationID))
+	defer span.End()
+
 	// Verify application exists
 	_, err := s.appRepo.FindByID(ctx, cmd.ApplicationID)
 	if err != nil {
@@ -71,6 +86,9 @@ func (s *GovernanceService) CreateGovernanceAgreement(ctx context.Context, cmd C
 
 // UpdateStrategy updates the strategy component of a governance agreement
 func (s *GovernanceService) UpdateStrategy(ctx context.Context, cmd UpdateStrategyCommand) error {
+	ctx, span := s.startSpan(ctx, "GovernanceService.UpdateStrategy", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
 	agreement, err := s.agreementRepo.FindByID(ctx, cmd.AgreementID)
 	if err != nil {
 		return fmt.Errorf("governance agreement not found: %w", err)
@@ -86,8 +104,48 @@ func (s *GovernanceService) UpdateStrategy(ctx context.Context, cmd UpdateStrate
 	return nil
 }
 
+// RegisterTechnologyComponent records a technology component and its end of support in an
+// agreement's ICT operations manual, replacing any component of the same kind and name
+func (s *GovernanceService) RegisterTechnologyComponent(ctx context.Context, cmd RegisterTechnologyComponentCommand) error {
+	ctx, span := s.startSpan(ctx, "GovernanceService.RegisterTechnologyComponent", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
+	if err := cmd.Component.Validate(); err != nil {
+		return err
+	}
+
+	agreement, err := s.agreementRepo.FindByID(ctx, cmd.AgreementID)
+	if err != nil {
+		return fmt.Errorf("governance agreement not found: %w", err)
+	}
+
+	manual := &agreement.Strategy.ICTOperationsManual
+	replaced := false
+	for i, existing := range manual.TechnologyComponents {
+		if existing.Kind == cmd.Component.Kind && existing.Name == cmd.Component.Name {
+			manual.TechnologyComponents[i] = cmd.Component
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		manual.TechnologyComponents = append(manual.TechnologyComponents, cmd.Component)
+	}
+	manual.LastUpdated = time.Now()
+
+	err = s.agreementRepo.Update(ctx, agreement)
+	if err != nil {
+		return fmt.Errorf("failed to update ICT operations manual: %w", err)
+	}
+
+	return nil
+}
+
 // UpdateAcquisition updates the acquisition component of a governance agreement
 func (s *GovernanceService) UpdateAcquisition(ctx context.Context, cmd UpdateAcquisitionCommand) error {
+	ctx, span := s.startSpan(ctx, "GovernanceService.UpdateAcquisition", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
 	agreement, err := s.agreementRepo.FindByID(ctx, cmd.AgreementID)
 	if err != nil {
 		return fmt.Errorf("governance agreement not found: %w", err)
@@ -105,6 +163,9 @@ func (s *GovernanceService) UpdateAcquisition(ctx context.Context, cmd UpdateAcq
 
 // UpdatePerformance updates the performance component of a governance agreement
 func (s *GovernanceService) UpdatePerformance(ctx context.Context, cmd UpdatePerformanceCommand) error {
+	ctx, span := s.startSpan(ctx, "GovernanceService.UpdatePerformance", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
 	agreement, err := s.agreementRepo.FindByID(ctx, cmd.AgreementID)
 	if err != nil {
 		return fmt.Errorf("governance agreement not found: %w", err)
@@ -122,6 +183,9 @@ func (s *GovernanceService) UpdatePerformance(ctx context.Context, cmd UpdatePer
 
 // UpdateConformance updates the conformance component of a governance agreement
 func (s *GovernanceService) UpdateConformance(ctx context.Context, cmd UpdateConformanceCommand) error {
+	ctx, span := s.startSpan(ctx, "GovernanceService.UpdateConformance", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
 	agreement, err := s.agreementRepo.FindByID(ctx, cmd.AgreementID)
 	if err != nil {
 		return fmt.Errorf("governance agreement not found: %w", err)
@@ -134,11 +198,18 @@ func (s *GovernanceService) UpdateConformance(ctx context.Context, cmd UpdateCon
 		return fmt.Errorf("failed to update conformance: %w", err)
 	}
 
+	err = s.monitorService.SyncComplianceRegister(ctx, agreement.ID)
+	if err != nil {
+		return fmt.Errorf("failed to register conformance: %w", err)
+	}
 	return nil
 }
 
 // UpdateImplementation updates the implementation component of a governance agreement
 func (s *GovernanceService) UpdateImplementation(ctx context.Context, cmd UpdateImplementationCommand) error {
+	ctx, span := s.startSpan(ctx, "GovernanceService.UpdateImplementation", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
 	agreement, err := s.agreementRepo.FindByID(ctx, cmd.AgreementID)
 	if err != nil {
 		return fmt.Errorf("governance agreement not found: %w", err)
@@ -156,6 +227,9 @@ func (s *GovernanceService) UpdateImplementation(ctx context.Context, cmd Update
 
 // ApproveGovernanceAgreement approves a governance agreement
 func (s *GovernanceService) ApproveGovernanceAgreement(ctx context.Context, cmd ApproveGovernanceAgreementCommand) error {
+	ctx, span := s.startSpan(ctx, "GovernanceService.ApproveGovernanceAgreement", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
 	// Get agreement
 	agreement, err := s.agreementRepo.FindByID(ctx, cmd.AgreementID)
 	if err != nil {
@@ -191,6 +265,9 @@ func (s *GovernanceService) ApproveGovernanceAgreement(ctx context.Context, cmd
 
 // ActivateGovernanceAgreement activates a governance agreement
 func (s *GovernanceService) ActivateGovernanceAgreement(ctx context.Context, cmd ActivateGovernanceAgreementCommand) error {
+	ctx, span := s.startSpan(ctx, "GovernanceService.ActivateGovernanceAgreement", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
 	// Get agreement
 	agreement, err := s.agreementRepo.FindByID(ctx, cmd.AgreementID)
 	if err != nil {
@@ -226,177 +303,1917 @@ func (s *GovernanceService) ActivateGovernanceAgreement(ctx context.Context, cmd
 
 // EvaluateApplication performs evaluation of an application
 func (s *GovernanceService) EvaluateApplication(ctx context.Context, cmd EvaluateApplicationCommand) (*domain.ApplicationAssessment, error) {
-	assessment, err := s.evalService.EvaluateApplication(ctx, cmd.ApplicationID, cmd.Evaluator)
+	ctx, span := s.startSpan(ctx, "GovernanceService.EvaluateApplication", domain.ApplicationAttribute(cmd.ApplicationID))
+	defer span.End()
+
+	var assessment *domain.ApplicationAssessment
+	var err error
+	if cmd.Profile != nil {
+		assessment, err = s.evalService.EvaluateApplicationWithProfile(ctx, cmd.ApplicationID, cmd.Evaluator, *cmd.Profile)
+	} else {
+		assessment, err = s.evalService.EvaluateApplication(ctx, cmd.ApplicationID, cmd.Evaluator)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to evaluate application: %w", err)
 	}
 
-	return assessment, nil
-}
-
-// EvaluatePortfolio performs evaluation of a portfolio
-func (s *GovernanceService) EvaluatePortfolio(ctx context.Context, cmd EvaluatePortfolioCommand) (*domain.PortfolioHealthAssessment, error) {
-	assessment, err := s.evalService.EvaluatePortfolio(ctx, cmd.PortfolioID)
+	// Publish domain event
+	evaluated := domain.ApplicationEvaluatedEvent{
+		ApplicationID: assessment.ApplicationID,
+		AssessmentID:  assessment.ID,
+		Evaluator:     assessment.Evaluator,
+		RiskLevel:     assessment.RiskLevel,
+		TIMEQuadrant:  assessment.TIMEQuadrant,
+		OccurredAt:    assessment.AssessedAt,
+	}
+	err = s.eventRepo.Save(ctx, evaluated)
 	if err != nil {
-		return nil, fmt.Errorf("failed to evaluate portfolio: %w", err)
+		fmt.Printf("Failed to save domain event: %v\n", err)
+	}
+
+	// Publish what changed since the previous assessment, when there is one
+	if diff, err := s.evalService.CompareAssessments(ctx, cmd.ApplicationID, "", assessment.ID); err == nil && diff.HasChanges() {
+		event := domain.AssessmentChangedEvent{
+			ApplicationID:    diff.ApplicationID,
+			FromAssessmentID: diff.FromAssessmentID,
+			ToAssessmentID:   diff.ToAssessmentID,
+			Changes:          diff.Summary(),
+			OccurredAt:       assessment.AssessedAt,
+		}
+		err = s.eventRepo.Save(ctx, event)
+		if err != nil {
+			fmt.Printf("Failed to save domain event: %v\n", err)
+		}
 	}
 
 	return assessment, nil
 }
 
-// SetStrategicDirection sets strategic direction for governance
-func (s *GovernanceService) SetStrategicDirection(ctx context.Context, cmd SetStrategicDirectionCommand) error {
-	err := s.directService.SetStrategicDirection(ctx, cmd.AgreementID, cmd.Director, cmd.Objectives, cmd.Initiatives)
+// CompareAssessments reports what changed between two recorded assessments of an application
+func (s *GovernanceService) CompareAssessments(ctx context.Context, cmd CompareAssessmentsCommand) (*domain.AssessmentDiff, error) {
+	ctx, span := s.startSpan(ctx, "GovernanceService.CompareAssessments", domain.ApplicationAttribute(cmd.ApplicationID))
+	defer span.End()
+
+	diff, err := s.evalService.CompareAssessments(ctx, cmd.ApplicationID, cmd.FromAssessmentID, cmd.ToAssessmentID)
 	if err != nil {
-		return fmt.Errorf("failed to set strategic direction: %w", err)
+		return nil, fmt.Errorf("failed to compare assessments: %w", err)
 	}
 
-	return nil
+	return diff, nil
 }
 
-// AllocateResources allocates resources for governance activities
-func (s *GovernanceService) AllocateResources(ctx context.Context, cmd AllocateResourcesCommand) error {
-	err := s.directService.AllocateResources(ctx, cmd.AgreementID, cmd.BudgetAllocations, cmd.PersonnelAllocations)
+// ReviewAssessment records the review of a draft assessment
+func (s *GovernanceService) ReviewAssessment(ctx context.Context, cmd ReviewAssessmentCommand) (*domain.ApplicationAssessment, error) {
+	ctx, span := s.startSpan(ctx, "GovernanceService.ReviewAssessment", domain.ApplicationAttribute(cmd.ApplicationID))
+	defer span.End()
+
+	recorded, err := s.evalService.FindRecordedAssessment(ctx, cmd.ApplicationID, cmd.AssessmentID)
 	if err != nil {
-		return fmt.Errorf("failed to allocate resources: %w", err)
+		return nil, fmt.Errorf("failed to review assessment: %w", err)
+	}
+	if err := s.sodPolicy.CheckAssessmentReview(recorded, cmd.Reviewer); err != nil {
+		return nil, fmt.Errorf("failed to review assessment: %w", err)
 	}
 
-	return nil
-}
+	assessment, err := s.evalService.ReviewAssessment(ctx, cmd.ApplicationID, recorded.ID, cmd.Reviewer, cmd.Comments)
+	if err != nil {
+		return nil, fmt.Errorf("failed to review assessment: %w", err)
+	}
 
-// EstablishPolicies establishes governance policies and standards
-func (s *GovernanceService) EstablishPolicies(ctx context.Context, cmd EstablishPoliciesCommand) error {
-	err := s.directService.EstablishPolicies(ctx, cmd.AgreementID, cmd.Policies, cmd.Standards, cmd.Procedures)
+	event := domain.AssessmentReviewedEvent{
+		AssessmentID:  assessment.ID,
+		ApplicationID: assessment.ApplicationID,
+		Reviewer:      assessment.SignOff.Reviewer,
+		Comments:      assessment.SignOff.ReviewComments,
+		OccurredAt:    assessment.SignOff.ReviewedAt,
+	}
+
+	err = s.eventRepo.Save(ctx, event)
 	if err != nil {
-		return fmt.Errorf("failed to establish policies: %w", err)
+		fmt.Printf("Failed to save domain event: %v\n", err)
 	}
 
-	return nil
+	return assessment, nil
 }
 
-// MonitorGovernance monitors governance activities
-func (s *GovernanceService) MonitorGovernance(ctx context.Context, cmd MonitorGovernanceCommand) (*GovernanceMonitoringResult, error) {
-	// Monitor KPIs
-	kpiMeasurements, err := s.monitorService.MonitorKPIs(ctx, cmd.AgreementID)
+// SignOffAssessment records the sign-off of a reviewed assessment
+func (s *GovernanceService) SignOffAssessment(ctx context.Context, cmd SignOffAssessmentCommand) (*domain.ApplicationAssessment, error) {
+	ctx, span := s.startSpan(ctx, "GovernanceService.SignOffAssessment", domain.ApplicationAttribute(cmd.ApplicationID))
+	defer span.End()
+
+	recorded, err := s.evalService.FindRecordedAssessment(ctx, cmd.ApplicationID, cmd.AssessmentID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to monitor KPIs: %w", err)
+		return nil, fmt.Errorf("failed to sign off assessment: %w", err)
+	}
+	if err := s.sodPolicy.CheckAssessmentSignOff(recorded, cmd.Approver); err != nil {
+		return nil, fmt.Errorf("failed to sign off assessment: %w", err)
 	}
 
-	// Monitor compliance
-	compliance, err := s.monitorService.MonitorCompliance(ctx, cmd.AgreementID)
+	assessment, err := s.evalService.SignOffAssessment(ctx, cmd.ApplicationID, recorded.ID, cmd.Approver)
 	if err != nil {
-		return nil, fmt.Errorf("failed to monitor compliance: %w", err)
+		return nil, fmt.Errorf("failed to sign off assessment: %w", err)
 	}
 
-	// Monitor risks
-	risks, err := s.monitorService.MonitorRisks(ctx, cmd.AgreementID)
+	event := domain.AssessmentSignedOffEvent{
+		AssessmentID:  assessment.ID,
+		ApplicationID: assessment.ApplicationID,
+		Evaluator:     assessment.Evaluator,
+		Reviewer:      assessment.SignOff.Reviewer,
+		Approver:      assessment.SignOff.Approver,
+		RiskLevel:     assessment.RiskLevel,
+		OccurredAt:    assessment.SignOff.SignedOffAt,
+	}
+
+	err = s.eventRepo.Save(ctx, event)
 	if err != nil {
-		return nil, fmt.Errorf("failed to monitor risks: %w", err)
+		fmt.Printf("Failed to save domain event: %v\n", err)
 	}
 
-	result := &GovernanceMonitoringResult{
-		KPIMeasurements:   kpiMeasurements,
-		ComplianceStatus:  compliance,
-		RiskStatus:        risks,
+	return assessment, nil
+}
+
+// EvaluatePortfolio performs evaluation of a portfolio and, when monitoring knows the portfolio,
+// rolls up the KPI attainment of its applications weighted by their criticality
+func (s *GovernanceService) EvaluatePortfolio(ctx context.Context, cmd EvaluatePortfolioCommand) (*domain.PortfolioHealthAssessment, error) {
+	ctx, span := s.startSpan(ctx, "GovernanceService.EvaluatePortfolio", domain.PortfolioAttribute(cmd.PortfolioID))
+	defer span.End()
+
+	profile := s.evalService.Profile()
+	if cmd.Profile != nil {
+		profile = *cmd.Profile
+	}
+	evaluator := cmd.Evaluator
+	if evaluator == "" {
+		evaluator = "system"
+	}
+	assessment, err := s.evalService.EvaluatePortfolioAs(ctx, cmd.PortfolioID, evaluator, profile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate portfolio: %w", err)
 	}
 
-	return result, nil
+	// Roll up the monitored KPIs of the portfolio's applications
+	if scoreboard, err := s.monitorService.MonitorPortfolioKPIScoreboard(ctx, cmd.PortfolioID); err == nil {
+		assessment.KPIScoreboard = scoreboard
+	}
+
+	return assessment, nil
 }
 
-// GetGovernanceAgreement retrieves a governance agreement by ID
-func (s *GovernanceService) GetGovernanceAgreement(ctx context.Context, agreementID domain.GovernanceAgreementID) (*domain.GovernanceAgreement, error) {
-	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
+// SimulatePortfolio projects the portfolio health of a what-if scenario without changing the portfolio
+func (s *GovernanceService) SimulatePortfolio(ctx context.Context, cmd SimulatePortfolioCommand) (*domain.PortfolioSimulation, error) {
+	ctx, span := s.startSpan(ctx, "GovernanceService.SimulatePortfolio", domain.PortfolioAttribute(cmd.PortfolioID))
+	defer span.End()
+
+	simulation, err := s.evalService.SimulatePortfolio(ctx, cmd.PortfolioID, domain.PortfolioScenario{
+		Name:    cmd.ScenarioName,
+		Changes: cmd.Changes,
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to get governance agreement: %w", err)
+		return nil, fmt.Errorf("failed to simulate portfolio: %w", err)
 	}
-	return &agreement, nil
+
+	return simulation, nil
 }
 
-// ListGovernanceAgreements retrieves all governance agreements
-func (s *GovernanceService) ListGovernanceAgreements(ctx context.Context) ([]domain.GovernanceAgreement, error) {
-	agreements, err := s.agreementRepo.FindAll(ctx)
+// IdentifyRisk records a risk in an agreement's risk assessment
+func (s *GovernanceService) IdentifyRisk(ctx context.Context, cmd IdentifyRiskCommand) error {
+	ctx, span := s.startSpan(ctx, "GovernanceService.IdentifyRisk", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
+	err := s.evalService.IdentifyRisk(ctx, cmd.AgreementID, cmd.Risk)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list governance agreements: %w", err)
+		return fmt.Errorf("failed to identify risk: %w", err)
 	}
-	return agreements, nil
+
+	return nil
 }
 
-// Commands for Governance Service
+// SimulatePortfolioRisk aggregates a portfolio's identified risks into a simulated loss distribution
+func (s *GovernanceService) SimulatePortfolioRisk(ctx context.Context, cmd SimulatePortfolioRiskCommand) (*domain.RiskExposure, error) {
+	ctx, span := s.startSpan(ctx, "GovernanceService.SimulatePortfolioRisk", domain.PortfolioAttribute(cmd.PortfolioID))
+	defer span.End()
 
-type CreateGovernanceAgreementCommand struct {
-	ID            domain.GovernanceAgreementID
-	ApplicationID domain.ApplicationID
-	Title         string
+	opts := domain.DefaultRiskSimulationOptions()
+	if cmd.Iterations > 0 {
+		opts.Iterations = cmd.Iterations
+	}
+	if cmd.Seed != nil {
+		opts.Seed = *cmd.Seed
+	}
+
+	exposure, err := s.evalService.SimulatePortfolioRisk(ctx, cmd.PortfolioID, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to simulate portfolio risk: %w", err)
+	}
+
+	return exposure, nil
 }
 
-type UpdateStrategyCommand struct {
-	AgreementID domain.GovernanceAgreementID
-	Strategy    domain.Strategy
+// ForecastObsolescence lists the portfolio's technology components reaching end of support within the horizon
+func (s *GovernanceService) ForecastObsolescence(ctx context.Context, cmd ForecastObsolescenceCommand) (*domain.ObsolescenceForecast, error) {
+	ctx, span := s.startSpan(ctx, "GovernanceService.ForecastObsolescence", domain.PortfolioAttribute(cmd.PortfolioID))
+	defer span.End()
+
+	horizon := cmd.Horizon
+	if horizon == 0 {
+		horizon = 2 * 365 * 24 * time.Hour
+	}
+
+	forecast, err := s.evalService.ForecastObsolescence(ctx, cmd.PortfolioID, horizon)
+	if err != nil {
+		return nil, fmt.Errorf("failed to forecast obsolescence: %w", err)
+	}
+
+	return forecast, nil
 }
 
-type UpdateAcquisitionCommand struct {
-	AgreementID domain.GovernanceAgreementID
-	Acquisition domain.Acquisition
+// AssessStrategicAlignment maps a portfolio's applications against the strategic objectives they
+// contribute to and flags the applications that support none
+func (s *GovernanceService) AssessStrategicAlignment(ctx context.Context, cmd AssessStrategicAlignmentCommand) (*domain.AlignmentMatrix, error) {
+	ctx, span := s.startSpan(ctx, "GovernanceService.AssessStrategicAlignment", domain.PortfolioAttribute(cmd.PortfolioID))
+	defer span.End()
+
+	matrix, err := s.evalService.AssessStrategicAlignment(ctx, cmd.PortfolioID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to assess strategic alignment: %w", err)
+	}
+
+	return matrix, nil
 }
 
-type UpdatePerformanceCommand struct {
-	AgreementID    domain.GovernanceAgreementID
-	Performance    domain.Performance
+// SetStrategicDirection sets strategic direction for governance. Objectives that are not backed by
+// known, measured KPIs are reported with an ObjectiveKPICoverageGapEvent, and initiatives needing
+// more personnel than allocated with a ResourceOverAllocatedEvent. The direction takes
+// effect immediately; ProposeStrategicDirection has a governance body ratify it first.
+func (s *GovernanceService) SetStrategicDirection(ctx context.Context, cmd SetStrategicDirectionCommand) error {
+	ctx, span := s.startSpan(ctx, "GovernanceService.SetStrategicDirection", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
+	err := s.directService.SetStrategicDirection(ctx, cmd.AgreementID, cmd.Director, cmd.Objectives, cmd.Initiatives)
+	if err != nil {
+		return fmt.Errorf("failed to set strategic direction: %w", err)
+	}
+
+	return s.checkDirection(ctx, cmd.AgreementID)
 }
 
-type UpdateConformanceCommand struct {
-	AgreementID domain.GovernanceAgreementID
-	Conformance domain.Conformance
+// ProposeStrategicDirection puts a strategic direction to a governance body. Unlike
+// SetStrategicDirection, the direction only takes effect once it is reviewed and approved.
+func (s *GovernanceService) ProposeStrategicDirection(ctx context.Context, cmd ProposeStrategicDirectionCommand) (*domain.DirectionProposal, error) {
+	ctx, span := s.startSpan(ctx, "GovernanceService.ProposeStrategicDirection", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
+	proposal, err := s.directService.ProposeStrategicDirection(ctx, cmd.AgreementID, domain.DirectionProposal{
+		ID:            cmd.ProposalID,
+		Objectives:    cmd.Objectives,
+		Initiatives:   cmd.Initiatives,
+		Rationale:     cmd.Rationale,
+		GoverningBody: cmd.GoverningBody,
+		ProposedBy:    cmd.ProposedBy,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to propose strategic direction: %w", err)
+	}
+
+	objectives := make([]string, len(proposal.Objectives))
+	for i, objective := range proposal.Objectives {
+		objectives[i] = objective.ID
+	}
+	s.publishPolicyEvent(ctx, domain.DirectionProposedEvent{
+		AgreementID:   cmd.AgreementID,
+		ProposalID:    proposal.ID,
+		ProposedBy:    proposal.ProposedBy,
+		GoverningBody: proposal.GoverningBody,
+		Objectives:    objectives,
+		OccurredAt:    proposal.ProposedAt,
+	})
+
+	return proposal, nil
 }
 
-type UpdateImplementationCommand struct {
-	AgreementID    domain.GovernanceAgreementID
-	Implementation domain.Implementation
+// ReviewStrategicDirection records the review of a proposed strategic direction
+func (s *GovernanceService) ReviewStrategicDirection(ctx context.Context, cmd ReviewStrategicDirectionCommand) (*domain.DirectionProposal, error) {
+	ctx, span := s.startSpan(ctx, "GovernanceService.ReviewStrategicDirection", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
+	proposal, err := s.directService.ReviewStrategicDirection(ctx, cmd.AgreementID, cmd.ProposalID, cmd.ReviewedBy, cmd.Comments)
+	if err != nil {
+		return nil, fmt.Errorf("failed to review strategic direction: %w", err)
+	}
+
+	s.publishPolicyEvent(ctx, domain.DirectionReviewedEvent{
+		AgreementID: cmd.AgreementID,
+		ProposalID:  proposal.ID,
+		ReviewedBy:  proposal.ReviewedBy,
+		Comments:    proposal.ReviewComments,
+		OccurredAt:  proposal.ReviewedAt,
+	})
+
+	return proposal, nil
 }
 
-type ApproveGovernanceAgreementCommand struct {
-	AgreementID domain.GovernanceAgreementID
+// ApproveStrategicDirection ratifies a reviewed strategic direction on behalf of a governance body
+// and puts it into effect, checking it as SetStrategicDirection does
+func (s *GovernanceService) ApproveStrategicDirection(ctx context.Context, cmd ApproveStrategicDirectionCommand) (*domain.DirectionProposal, error) {
+	ctx, span := s.startSpan(ctx, "GovernanceService.ApproveStrategicDirection", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
+	proposal, err := s.directService.ApproveStrategicDirection(ctx, cmd.AgreementID, cmd.ProposalID, cmd.ApprovedBy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to approve strategic direction: %w", err)
+	}
+
+	s.publishPolicyEvent(ctx, domain.DirectionApprovedEvent{
+		AgreementID: cmd.AgreementID,
+		ProposalID:  proposal.ID,
+		ApprovedBy:  proposal.ApprovedBy,
+		OccurredAt:  proposal.ApprovedAt,
+	})
+
+	agreement, err := s.agreementRepo.FindByID(ctx, cmd.AgreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+	objectives := make([]string, len(agreement.Direct.StrategicDirection.Objectives))
+	for i, objective := range agreement.Direct.StrategicDirection.Objectives {
+		objectives[i] = objective.ID
+	}
+	actionPlans := make([]string, len(agreement.Direct.ActionPlans))
+	for i, plan := range agreement.Direct.ActionPlans {
+		actionPlans[i] = plan.ID
+	}
+	s.publishPolicyEvent(ctx, domain.GovernanceDirectionSetEvent{
+		AgreementID: cmd.AgreementID,
+		Director:    proposal.ApprovedBy,
+		Objectives:  objectives,
+		ActionPlans: actionPlans,
+		OccurredAt:  proposal.ApprovedAt,
+	})
+
+	if err := s.checkDirection(ctx, cmd.AgreementID); err != nil {
+		return nil, err
+	}
+	return proposal, nil
 }
 
-type ActivateGovernanceAgreementCommand struct {
-	AgreementID domain.GovernanceAgreementID
+// RejectStrategicDirection turns down an open strategic direction proposal with comments
+func (s *GovernanceService) RejectStrategicDirection(ctx context.Context, cmd RejectStrategicDirectionCommand) (*domain.DirectionProposal, error) {
+	ctx, span := s.startSpan(ctx, "GovernanceService.RejectStrategicDirection", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
+	proposal, err := s.directService.RejectStrategicDirection(ctx, cmd.AgreementID, cmd.ProposalID, cmd.RejectedBy, cmd.Comments)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reject strategic direction: %w", err)
+	}
+
+	s.publishPolicyEvent(ctx, domain.DirectionRejectedEvent{
+		AgreementID: cmd.AgreementID,
+		ProposalID:  proposal.ID,
+		RejectedBy:  proposal.RejectedBy,
+		Comments:    proposal.RejectionComments,
+		OccurredAt:  proposal.RejectedAt,
+	})
+
+	return proposal, nil
 }
 
-type EvaluateApplicationCommand struct {
-	ApplicationID domain.ApplicationID
-	Evaluator     string
+// checkDirection reports the KPI coverage gaps and capacity conflicts of a direction put into effect
+func (s *GovernanceService) checkDirection(ctx context.Context, agreementID domain.GovernanceAgreementID) error {
+	coverage, err := s.monitorService.MonitorObjectiveKPICoverage(ctx, agreementID)
+	if err != nil {
+		return fmt.Errorf("failed to check objective KPI coverage: %w", err)
+	}
+	s.publishCoverageGaps(ctx, coverage)
+
+	return s.checkCapacity(ctx, agreementID)
 }
 
-type EvaluatePortfolioCommand struct {
-	PortfolioID domain.PortfolioID
+// UpdateInitiativeProgress records a status update for a strategic initiative
+func (s *GovernanceService) UpdateInitiativeProgress(ctx context.Context, cmd UpdateInitiativeProgressCommand) (*domain.StrategicInitiative, error) {
+	ctx, span := s.startSpan(ctx, "GovernanceService.UpdateInitiativeProgress", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
+	update := domain.InitiativeStatusUpdate{
+		PercentComplete:     cmd.PercentComplete,
+		Status:              cmd.Status,
+		CompletedMilestones: cmd.CompletedMilestones,
+		Note:                cmd.Note,
+		UpdatedBy:           cmd.UpdatedBy,
+		UpdatedAt:           time.Now(),
+	}
+
+	initiative, err := s.directService.UpdateInitiativeProgress(ctx, cmd.AgreementID, cmd.InitiativeID, update)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update initiative progress: %w", err)
+	}
+
+	// Publish domain event
+	event := domain.InitiativeProgressUpdatedEvent{
+		AgreementID:     cmd.AgreementID,
+		InitiativeID:    initiative.ID,
+		PercentComplete: initiative.PercentComplete,
+		Status:          initiative.Status,
+		UpdatedBy:       cmd.UpdatedBy,
+		OccurredAt:      update.UpdatedAt,
+	}
+
+	err = s.eventRepo.Save(ctx, event)
+	if err != nil {
+		fmt.Printf("Failed to save domain event: %v\n", err)
+	}
+
+	return initiative, nil
 }
 
-type SetStrategicDirectionCommand struct {
-	AgreementID domain.GovernanceAgreementID
-	Director    string
-	Objectives  []domain.StrategicObjective
-	Initiatives []domain.StrategicInitiative
+// DefineOKR adds or replaces an application-level OKR of a governance agreement
+func (s *GovernanceService) DefineOKR(ctx context.Context, cmd DefineOKRCommand) (*domain.OKR, error) {
+	ctx, span := s.startSpan(ctx, "GovernanceService.DefineOKR", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
+	okr, err := s.directService.DefineOKR(ctx, cmd.AgreementID, cmd.OKR)
+	if err != nil {
+		return nil, fmt.Errorf("failed to define OKR: %w", err)
+	}
+
+	return okr, nil
 }
 
-type AllocateResourcesCommand struct {
-	AgreementID          domain.GovernanceAgreementID
-	BudgetAllocations    []domain.BudgetAllocation
-	PersonnelAllocations []domain.PersonnelAllocation
+// RecordKeyResult records the actual value of a key result of a governance agreement's OKR
+func (s *GovernanceService) RecordKeyResult(ctx context.Context, cmd RecordKeyResultCommand) (*domain.OKR, error) {
+	ctx, span := s.startSpan(ctx, "GovernanceService.RecordKeyResult", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
+	okr, err := s.directService.RecordKeyResult(ctx, cmd.AgreementID, cmd.OKRID, cmd.KeyResultID, cmd.Actual)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record key result: %w", err)
+	}
+
+	// Publish domain event
+	keyResult, _ := okr.KeyResult(cmd.KeyResultID)
+	event := domain.KeyResultRecordedEvent{
+		AgreementID: cmd.AgreementID,
+		OKRID:       okr.ID,
+		KeyResultID: keyResult.ID,
+		Actual:      keyResult.Actual,
+		Target:      keyResult.Target,
+		Progress:    keyResult.Progress(),
+		RecordedBy:  cmd.RecordedBy,
+		OccurredAt:  keyResult.UpdatedAt,
+	}
+
+	err = s.eventRepo.Save(ctx, event)
+	if err != nil {
+		fmt.Printf("Failed to save domain event: %v\n", err)
+	}
+
+	return okr, nil
 }
 
-type EstablishPoliciesCommand struct {
-	AgreementID domain.GovernanceAgreementID
-	Policies    []domain.Policy
-	Standards   []domain.Standard
-	Procedures  []domain.Procedure
+// GetOKRCascade reports a portfolio's OKRs with the application-level OKRs cascading from them
+func (s *GovernanceService) GetOKRCascade(ctx context.Context, cmd GetOKRCascadeCommand) (*domain.OKRCascade, error) {
+	ctx, span := s.startSpan(ctx, "GovernanceService.GetOKRCascade", domain.PortfolioAttribute(cmd.PortfolioID))
+	defer span.End()
+
+	cascade, err := s.monitorService.MonitorOKRCascade(ctx, cmd.PortfolioID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to monitor OKR cascade: %w", err)
+	}
+
+	return cascade, nil
 }
 
-type MonitorGovernanceCommand struct {
-	AgreementID domain.GovernanceAgreementID
+// GetRiskHeatMap places the identified risks of a portfolio's applications on a
+// probability-versus-impact heat map
+func (s *GovernanceService) GetRiskHeatMap(ctx context.Context, cmd GetRiskHeatMapCommand) (*domain.RiskHeatMap, error) {
+	ctx, span := s.startSpan(ctx, "GovernanceService.GetRiskHeatMap", domain.PortfolioAttribute(cmd.PortfolioID))
+	defer span.End()
+
+	heatMap, err := s.monitorService.MonitorPortfolioRiskHeatMap(ctx, cmd.PortfolioID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to monitor risk heat map: %w", err)
+	}
+
+	return heatMap, nil
 }
 
-type GovernanceMonitoringResult struct {
-	KPIMeasurements  []domain.KPIMeasurement
-	ComplianceStatus *domain.ComplianceMonitoring
-	RiskStatus       *domain.RiskMonitoring
+// SaveActionPlan adds or replaces an action plan of a governance agreement and reschedules its
+// action plans. Objectives the new schedule slips past their deadline are reported with an
+// ObjectiveDeadlineSlippedEvent.
+func (s *GovernanceService) SaveActionPlan(ctx context.Context, cmd SaveActionPlanCommand) (*domain.ActionSchedule, error) {
+	ctx, span := s.startSpan(ctx, "GovernanceService.SaveActionPlan", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
+	before, err := s.directService.ScheduleActions(ctx, cmd.AgreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to schedule actions: %w", err)
+	}
+
+	schedule, err := s.directService.SaveActionPlan(ctx, cmd.AgreementID, cmd.ActionPlan)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save action plan: %w", err)
+	}
+	s.publishSlippedObjectives(ctx, before, schedule)
+
+	return schedule, nil
+}
+
+// UpdateAction records progress on an action of a governance agreement. A slipping action moves
+// the projected completion of the actions, plans and objectives depending on it; objectives it
+// slips past their deadline are reported with an ObjectiveDeadlineSlippedEvent.
+func (s *GovernanceService) UpdateAction(ctx context.Context, cmd UpdateActionCommand) (*domain.ActionSchedule, error) {
+	ctx, span := s.startSpan(ctx, "GovernanceService.UpdateAction", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
+	before, err := s.directService.ScheduleActions(ctx, cmd.AgreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to schedule actions: %w", err)
+	}
+
+	schedule, err := s.directService.UpdateAction(ctx, cmd.AgreementID, cmd.PlanID, cmd.ActionID, domain.ActionUpdate{
+		Status:      cmd.Status,
+		Duration:    cmd.Duration,
+		Deadline:    cmd.Deadline,
+		CompletedAt: cmd.CompletedAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update action: %w", err)
+	}
+	s.publishSlippedObjectives(ctx, before, schedule)
+
+	return schedule, nil
+}
+
+// GetActionSchedule projects the completion of a governance agreement's action plans and
+// objectives, with the critical path determining it
+func (s *GovernanceService) GetActionSchedule(ctx context.Context, cmd GetActionScheduleCommand) (*domain.ActionSchedule, error) {
+	ctx, span := s.startSpan(ctx, "GovernanceService.GetActionSchedule", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
+	schedule, err := s.directService.ScheduleActions(ctx, cmd.AgreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to schedule actions: %w", err)
+	}
+
+	return schedule, nil
+}
+
+// publishSlippedObjectives publishes an ObjectiveDeadlineSlippedEvent for every objective projected
+// to complete after its deadline and later than it was before
+func (s *GovernanceService) publishSlippedObjectives(ctx context.Context, before, after *domain.ActionSchedule) {
+	criticalPath := make([]string, len(after.CriticalPath))
+	for i, action := range after.CriticalPath {
+		criticalPath[i] = action.PlanID + "/" + action.ActionID
+	}
+
+	for _, objective := range after.Objectives {
+		previous, known := before.Objective(objective.ObjectiveID)
+		if !objective.Slipping() || (known && !objective.ProjectedCompletion.After(previous.ProjectedCompletion)) {
+			continue
+		}
+
+		event := domain.ObjectiveDeadlineSlippedEvent{
+			AgreementID:         after.AgreementID,
+			ObjectiveID:         objective.ObjectiveID,
+			Deadline:            objective.Deadline,
+			ProjectedCompletion: objective.ProjectedCompletion,
+			CriticalPath:        criticalPath,
+			OccurredAt:          after.GeneratedAt,
+		}
+
+		err := s.eventRepo.Save(ctx, event)
+		if err != nil {
+			fmt.Printf("Failed to save domain event: %v\n", err)
+		}
+	}
+}
+
+// AllocateResources allocates resources for governance activities. Roles the agreement's
+// initiatives and action plans need more of than allocated are reported with a
+// ResourceOverAllocatedEvent.
+func (s *GovernanceService) AllocateResources(ctx context.Context, cmd AllocateResourcesCommand) error {
+	ctx, span := s.startSpan(ctx, "GovernanceService.AllocateResources", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
+	err := s.directService.AllocateResources(ctx, cmd.AgreementID, cmd.BudgetAllocations, cmd.PersonnelAllocations)
+	if err != nil {
+		return fmt.Errorf("failed to allocate resources: %w", err)
+	}
+
+	return s.checkCapacity(ctx, cmd.AgreementID)
+}
+
+// GetCapacityPlan compares the personnel allocated to an agreement against the demand of its
+// initiatives and action plans, per role and month
+func (s *GovernanceService) GetCapacityPlan(ctx context.Context, cmd GetCapacityPlanCommand) (*domain.CapacityPlan, error) {
+	ctx, span := s.startSpan(ctx, "GovernanceService.GetCapacityPlan", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
+	plan, err := s.directService.PlanCapacity(ctx, cmd.AgreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to plan resource capacity: %w", err)
+	}
+
+	return plan, nil
+}
+
+// checkCapacity plans the agreement's resource capacity and publishes a ResourceOverAllocatedEvent
+// when a role is over-allocated
+func (s *GovernanceService) checkCapacity(ctx context.Context, agreementID domain.GovernanceAgreementID) error {
+	plan, err := s.directService.PlanCapacity(ctx, agreementID)
+	if err != nil {
+		return fmt.Errorf("failed to plan resource capacity: %w", err)
+	}
+	if !plan.HasConflicts() {
+		return nil
+	}
+
+	event := domain.ResourceOverAllocatedEvent{
+		AgreementID: agreementID,
+		Conflicts:   plan.Conflicts,
+		OccurredAt:  plan.GeneratedAt,
+	}
+
+	err = s.eventRepo.Save(ctx, event)
+	if err != nil {
+		fmt.Printf("Failed to save domain event: %v\n", err)
+	}
+	return nil
+}
+
+// RecordExpenditure records spend against one of the agreement's budget allocations and publishes
+// a BudgetAlertRaisedEvent when the spend escalates the allocation's alert level
+func (s *GovernanceService) RecordExpenditure(ctx context.Context, cmd RecordExpenditureCommand) (*domain.BudgetConsumption, error) {
+	ctx, span := s.startSpan(ctx, "GovernanceService.RecordExpenditure", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
+	expenditure := domain.Expenditure{
+		Category:    cmd.Category,
+		Amount:      cmd.Amount,
+		Description: cmd.Description,
+		RecordedBy:  cmd.RecordedBy,
+		SpentAt:     cmd.SpentAt,
+	}
+
+	before, after, err := s.directService.RecordExpenditure(ctx, cmd.AgreementID, expenditure)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record expenditure: %w", err)
+	}
+
+	if after.Escalated(before.Alert) {
+		event := domain.BudgetAlertRaisedEvent{
+			AgreementID:    cmd.AgreementID,
+			Category:       after.Category,
+			Level:          after.Alert,
+			Allocated:      after.Allocated,
+			Spent:          after.Spent,
+			ProjectedSpend: after.ProjectedSpend,
+			OccurredAt:     time.Now(),
+		}
+
+		err = s.eventRepo.Save(ctx, event)
+		if err != nil {
+			fmt.Printf("Failed to save domain event: %v\n", err)
+		}
+	}
+
+	return &after, nil
+}
+
+// EstablishPolicies establishes governance policies and standards
+func (s *GovernanceService) EstablishPolicies(ctx context.Context, cmd EstablishPoliciesCommand) error {
+	ctx, span := s.startSpan(ctx, "GovernanceService.EstablishPolicies", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
+	err := s.directService.EstablishPolicies(ctx, cmd.AgreementID, cmd.EstablishedBy, cmd.Policies, cmd.Standards, cmd.Procedures)
+	if err != nil {
+		return fmt.Errorf("failed to establish policies: %w", err)
+	}
+
+	return nil
+}
+
+// DraftPolicy adds a draft policy to an agreement
+func (s *GovernanceService) DraftPolicy(ctx context.Context, cmd DraftPolicyCommand) (*domain.Policy, error) {
+	ctx, span := s.startSpan(ctx, "GovernanceService.DraftPolicy", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
+	policy, err := s.directService.DraftPolicy(ctx, cmd.AgreementID, cmd.Policy, cmd.DraftedBy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to draft policy: %w", err)
+	}
+
+	return policy, nil
+}
+
+// RevisePolicy records a new version of a policy. A policy that was submitted, approved or
+// published returns to draft for approval.
+func (s *GovernanceService) RevisePolicy(ctx context.Context, cmd RevisePolicyCommand) (*domain.DocumentVersion, error) {
+	ctx, span := s.startSpan(ctx, "GovernanceService.RevisePolicy", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
+	version, err := s.directService.RevisePolicy(ctx, cmd.AgreementID, cmd.Policy, cmd.ChangedBy, cmd.Summary)
+	if err != nil {
+		return nil, fmt.Errorf("failed to revise policy: %w", err)
+	}
+
+	s.publishDocumentRevised(ctx, cmd.AgreementID, version)
+	return version, nil
+}
+
+// ReviseStandard records a new version of a standard
+func (s *GovernanceService) ReviseStandard(ctx context.Context, cmd ReviseStandardCommand) (*domain.DocumentVersion, error) {
+	ctx, span := s.startSpan(ctx, "GovernanceService.ReviseStandard", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
+	version, err := s.directService.ReviseStandard(ctx, cmd.AgreementID, cmd.Standard, cmd.ChangedBy, cmd.Summary)
+	if err != nil {
+		return nil, fmt.Errorf("failed to revise standard: %w", err)
+	}
+
+	s.publishDocumentRevised(ctx, cmd.AgreementID, version)
+	return version, nil
+}
+
+// ReviseProcedure records a new version of a procedure
+func (s *GovernanceService) ReviseProcedure(ctx context.Context, cmd ReviseProcedureCommand) (*domain.DocumentVersion, error) {
+	ctx, span := s.startSpan(ctx, "GovernanceService.ReviseProcedure", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
+	version, err := s.directService.ReviseProcedure(ctx, cmd.AgreementID, cmd.Procedure, cmd.ChangedBy, cmd.Summary)
+	if err != nil {
+		return nil, fmt.Errorf("failed to revise procedure: %w", err)
+	}
+
+	s.publishDocumentRevised(ctx, cmd.AgreementID, version)
+	return version, nil
+}
+
+// PinDocumentVersion pins an agreement to a recorded version of a policy, standard or procedure
+func (s *GovernanceService) PinDocumentVersion(ctx context.Context, cmd PinDocumentVersionCommand) error {
+	ctx, span := s.startSpan(ctx, "GovernanceService.PinDocumentVersion", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
+	err := s.directService.PinDocumentVersion(ctx, cmd.AgreementID, cmd.Kind, cmd.DocumentID, cmd.Version, cmd.PinnedBy)
+	if err != nil {
+		return fmt.Errorf("failed to pin document version: %w", err)
+	}
+
+	event := domain.DocumentVersionPinnedEvent{
+		AgreementID: cmd.AgreementID,
+		Kind:        cmd.Kind,
+		DocumentID:  cmd.DocumentID,
+		Version:     cmd.Version,
+		PinnedBy:    cmd.PinnedBy,
+		OccurredAt:  time.Now(),
+	}
+
+	err = s.eventRepo.Save(ctx, event)
+	if err != nil {
+		fmt.Printf("Failed to save domain event: %v\n", err)
+	}
+
+	return nil
+}
+
+// GetDocumentHistory returns every version of a policy, standard or procedure, oldest first
+func (s *GovernanceService) GetDocumentHistory(ctx context.Context, agreementID domain.GovernanceAgreementID, kind domain.DocumentKind, documentID string) ([]domain.DocumentVersion, error) {
+	ctx, span := s.startSpan(ctx, "GovernanceService.GetDocumentHistory", domain.AgreementAttribute(agreementID))
+	defer span.End()
+
+	history, err := s.directService.DocumentHistory(ctx, agreementID, kind, documentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get document history: %w", err)
+	}
+	return history, nil
+}
+
+// publishDocumentRevised publishes a GovernanceDocumentRevisedEvent for a new document version
+func (s *GovernanceService) publishDocumentRevised(ctx context.Context, agreementID domain.GovernanceAgreementID, version *domain.DocumentVersion) {
+	event := domain.GovernanceDocumentRevisedEvent{
+		AgreementID: agreementID,
+		Kind:        version.Kind,
+		DocumentID:  version.DocumentID,
+		Version:     version.Version,
+		ChangedBy:   version.ChangedBy,
+		Summary:     version.Summary,
+		OccurredAt:  version.ChangedAt,
+	}
+
+	err := s.eventRepo.Save(ctx, event)
+	if err != nil {
+		fmt.Printf("Failed to save domain event: %v\n", err)
+	}
+}
+
+// SubmitPolicy submits a draft policy for approval
+func (s *GovernanceService) SubmitPolicy(ctx context.Context, cmd SubmitPolicyCommand) (*domain.Policy, error) {
+	ctx, span := s.startSpan(ctx, "GovernanceService.SubmitPolicy", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
+	policy, err := s.directService.SubmitPolicy(ctx, cmd.AgreementID, cmd.PolicyID, cmd.SubmittedBy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to submit policy: %w", err)
+	}
+
+	s.publishPolicyEvent(ctx, domain.PolicySubmittedEvent{
+		AgreementID: cmd.AgreementID,
+		PolicyID:    policy.ID,
+		SubmittedBy: policy.SubmittedBy,
+		OccurredAt:  policy.SubmittedAt,
+	})
+
+	return policy, nil
+}
+
+// ApprovePolicy approves a submitted policy
+func (s *GovernanceService) ApprovePolicy(ctx context.Context, cmd ApprovePolicyCommand) (*domain.Policy, error) {
+	ctx, span := s.startSpan(ctx, "GovernanceService.ApprovePolicy", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
+	policy, err := s.directService.ApprovePolicy(ctx, cmd.AgreementID, cmd.PolicyID, cmd.ApprovedBy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to approve policy: %w", err)
+	}
+
+	s.publishPolicyEvent(ctx, domain.PolicyApprovedEvent{
+		AgreementID: cmd.AgreementID,
+		PolicyID:    policy.ID,
+		ApprovedBy:  policy.ApprovedBy,
+		OccurredAt:  policy.ApprovedAt,
+	})
+
+	return policy, nil
+}
+
+// PublishPolicy puts an approved policy into effect
+func (s *GovernanceService) PublishPolicy(ctx context.Context, cmd PublishPolicyCommand) (*domain.Policy, error) {
+	ctx, span := s.startSpan(ctx, "GovernanceService.PublishPolicy", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
+	policy, err := s.directService.PublishPolicy(ctx, cmd.AgreementID, cmd.PolicyID, cmd.EffectiveFrom, cmd.EffectiveUntil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to publish policy: %w", err)
+	}
+
+	s.publishPolicyEvent(ctx, domain.PolicyPublishedEvent{
+		AgreementID:    cmd.AgreementID,
+		PolicyID:       policy.ID,
+		EffectiveFrom:  policy.EffectiveFrom,
+		EffectiveUntil: policy.EffectiveUntil,
+		OccurredAt:     policy.PublishedAt,
+	})
+
+	return policy, nil
+}
+
+// RetirePolicy withdraws an approved or published policy
+func (s *GovernanceService) RetirePolicy(ctx context.Context, cmd RetirePolicyCommand) (*domain.Policy, error) {
+	ctx, span := s.startSpan(ctx, "GovernanceService.RetirePolicy", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
+	policy, err := s.directService.RetirePolicy(ctx, cmd.AgreementID, cmd.PolicyID, cmd.Reason)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retire policy: %w", err)
+	}
+
+	s.publishPolicyEvent(ctx, domain.PolicyRetiredEvent{
+		AgreementID: cmd.AgreementID,
+		PolicyID:    policy.ID,
+		Reason:      policy.RetirementReason,
+		OccurredAt:  policy.RetiredAt,
+	})
+
+	return policy, nil
+}
+
+// publishPolicyEvent saves a policy lifecycle event
+func (s *GovernanceService) publishPolicyEvent(ctx context.Context, event domain.DomainEvent) {
+	err := s.eventRepo.Save(ctx, event)
+	if err != nil {
+		fmt.Printf("Failed to save domain event: %v\n", err)
+	}
+}
+
+// MonitorGovernance monitors governance activities, records when the agreement was last
+// monitored and keeps a snapshot of the result in the monitoring history
+func (s *GovernanceService) MonitorGovernance(ctx context.Context, cmd MonitorGovernanceCommand) (*GovernanceMonitoringResult, error) {
+	ctx, span := s.startSpan(ctx, "GovernanceService.MonitorGovernance", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
+	// Collect from monitor plugins first so their measurements and indicators are monitored
+	plugins, err := s.monitorService.CollectFromPlugins(ctx, cmd.AgreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect from monitor plugins: %w", err)
+	}
+	s.publishPluginMeasurements(ctx, plugins)
+
+	// Monitor KPIs
+	kpiMeasurements, err := s.monitorService.MonitorKPIs(ctx, cmd.AgreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to monitor KPIs: %w", err)
+	}
+
+	// Detect anomalies in KPI measurements taken since the agreement was last monitored
+	anomalies, err := s.monitorService.MonitorKPIAnomalies(ctx, cmd.AgreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to detect KPI anomalies: %w", err)
+	}
+	s.publishKPIAnomalies(ctx, cmd.AgreementID, anomalies)
+
+	// Roll up KPIs of the portfolios holding the application
+	portfolioKPIs, err := s.monitorService.MonitorPortfolioKPIs(ctx, cmd.AgreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to monitor portfolio KPIs: %w", err)
+	}
+
+	// Monitor compliance
+	compliance, err := s.monitorService.MonitorCompliance(ctx, cmd.AgreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to monitor compliance: %w", err)
+	}
+
+	// Monitor risks
+	risks, err := s.monitorService.MonitorRisks(ctx, cmd.AgreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to monitor risks: %w", err)
+	}
+
+	// Track the error budgets of the application's SLOs
+	budgets, err := s.monitorService.MonitorErrorBudgets(ctx, cmd.AgreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to monitor error budgets: %w", err)
+	}
+
+	// Summarize the application's incident history
+	operations, err := s.monitorService.MonitorOperations(ctx, cmd.AgreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to monitor operations: %w", err)
+	}
+
+	// Attribute the application's incidents to the changes implemented before them
+	changeFailures, err := s.monitorService.MonitorChangeFailures(ctx, cmd.AgreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to monitor change failures: %w", err)
+	}
+
+	// Track the reviews of high-severity incidents
+	reviews, err := s.monitorService.MonitorPostIncidentReviews(ctx, cmd.AgreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to monitor post-incident reviews: %w", err)
+	}
+
+	// Monitor objective KPI coverage
+	coverage, err := s.monitorService.MonitorObjectiveKPICoverage(ctx, cmd.AgreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to monitor objective KPI coverage: %w", err)
+	}
+	s.publishCoverageGaps(ctx, coverage)
+
+	// Monitor conformance requirement coverage
+	requirements, err := s.monitorService.MonitorRequirementCoverage(ctx, cmd.AgreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to monitor requirement coverage: %w", err)
+	}
+	s.publishRequirementGaps(ctx, requirements)
+
+	// Monitor initiative progress
+	progress, err := s.monitorService.MonitorInitiativeProgress(ctx, cmd.AgreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to monitor initiative progress: %w", err)
+	}
+
+	// Monitor OKRs
+	okrs, err := s.monitorService.MonitorOKRs(ctx, cmd.AgreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to monitor OKRs: %w", err)
+	}
+
+	// Monitor budget consumption
+	budget, err := s.monitorService.MonitorBudget(ctx, cmd.AgreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to monitor budget: %w", err)
+	}
+
+	// Evaluate alert thresholds
+	alerts, err := s.monitorService.EvaluateAlerts(ctx, cmd.AgreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate alerts: %w", err)
+	}
+	s.publishAlerts(ctx, alerts)
+
+	// Detect compliance drift
+	drift, err := s.monitorService.DetectComplianceDrift(ctx, cmd.AgreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to detect compliance drift: %w", err)
+	}
+	s.publishComplianceViolations(ctx, drift)
+
+	now := time.Now()
+	err = s.monitorService.MarkMonitored(ctx, cmd.AgreementID, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to mark agreement monitored: %w", err)
+	}
+
+	result := &GovernanceMonitoringResult{
+		KPIMeasurements:     kpiMeasurements,
+		ComplianceStatus:    compliance,
+		RiskStatus:          risks,
+		ObjectiveCoverage:   coverage,
+		RequirementCoverage: requirements,
+		InitiativeProgress:  progress,
+		BudgetStatus:        budget,
+		OKRs:                okrs,
+		Alerts:              alerts,
+		ComplianceDrift:     drift,
+		PortfolioKPIs:       portfolioKPIs,
+		KPIAnomalies:        anomalies,
+		ErrorBudgets:        budgets,
+		Operations:          operations,
+		ChangeFailures:      changeFailures,
+		PostIncidentReviews: reviews,
+		Plugins:             plugins,
+	}
+
+	err = s.monitorService.RecordMonitoringSnapshot(ctx, result.snapshot(cmd.AgreementID, now))
+	if err != nil {
+		return nil, fmt.Errorf("failed to record monitoring snapshot: %w", err)
+	}
+
+	return result, nil
+}
+
+// GetMonitoringHistory returns the monitoring snapshots of an agreement taken within a time range
+// with the trends of its KPI attainment and risk indicators
+func (s *GovernanceService) GetMonitoringHistory(ctx context.Context, cmd GetMonitoringHistoryCommand) (*domain.MonitoringHistory, error) {
+	ctx, span := s.startSpan(ctx, "GovernanceService.GetMonitoringHistory", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
+	history, err := s.monitorService.MonitorHistory(ctx, cmd.AgreementID, cmd.From, cmd.To)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get monitoring history: %w", err)
+	}
+
+	return history, nil
+}
+
+// publishCoverageGaps publishes an ObjectiveKPICoverageGapEvent when the coverage has gaps
+func (s *GovernanceService) publishCoverageGaps(ctx context.Context, coverage *domain.ObjectiveKPICoverage) {
+	if !coverage.HasGaps() {
+		return
+	}
+
+	event := domain.ObjectiveKPICoverageGapEvent{
+		AgreementID:        coverage.AgreementID,
+		UnlinkedObjectives: coverage.UnlinkedObjectives,
+		UnknownKPIs:        coverage.UnknownKPIs,
+		UnmeasuredKPIs:     coverage.UnmeasuredKPIs,
+		OccurredAt:         coverage.CheckedAt,
+	}
+
+	err := s.eventRepo.Save(ctx, event)
+	if err != nil {
+		fmt.Printf("Failed to save domain event: %v\n", err)
+	}
+}
+
+// ConfigureAlerts sets the warning and critical thresholds of one of an agreement's KPIs or risk
+// indicators and who is alerted when they are breached
+func (s *GovernanceService) ConfigureAlerts(ctx context.Context, cmd ConfigureAlertsCommand) error {
+	ctx, span := s.startSpan(ctx, "GovernanceService.ConfigureAlerts", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
+	var err error
+	switch cmd.Source {
+	case domain.AlertSourceKPI:
+		err = s.monitorService.ConfigureKPIAlerts(ctx, cmd.AgreementID, domain.KPIMonitoring{
+			KPIID:       cmd.Subject,
+			Frequency:   cmd.Frequency,
+			Responsible: cmd.Responsible,
+			Thresholds:  cmd.Thresholds,
+			Alerts:      cmd.Alerts,
+		})
+	case domain.AlertSourceRiskIndicator:
+		err = s.monitorService.ConfigureRiskIndicatorAlerts(ctx, cmd.AgreementID, domain.RiskIndicatorMonitoring{
+			Indicator:  cmd.Subject,
+			Thresholds: cmd.Thresholds,
+			Alerts:     cmd.Alerts,
+		})
+	default:
+		err = fmt.Errorf("unknown alert source %q", cmd.Source)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to configure alerts: %w", err)
+	}
+	return nil
+}
+
+// EvaluateAlerts checks an agreement's KPIs and risk indicators against their alert thresholds.
+// Newly breached thresholds and changes of severity are published with an AlertRaisedEvent and
+// alerts no longer breached with an AlertResolvedEvent; an alert that stays breached is not
+// raised again.
+func (s *GovernanceService) EvaluateAlerts(ctx context.Context, cmd EvaluateAlertsCommand) (*domain.AlertEvaluation, error) {
+	ctx, span := s.startSpan(ctx, "GovernanceService.EvaluateAlerts", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
+	evaluation, err := s.monitorService.EvaluateAlerts(ctx, cmd.AgreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate alerts: %w", err)
+	}
+
+	s.publishAlerts(ctx, evaluation)
+	return evaluation, nil
+}
+
+// AcknowledgeAlert records that someone has taken on one of an agreement's active alerts, which
+// stops its escalation until the alert's severity changes
+func (s *GovernanceService) AcknowledgeAlert(ctx context.Context, cmd AcknowledgeAlertCommand) (*domain.ActiveAlert, error) {
+	ctx, span := s.startSpan(ctx, "GovernanceService.AcknowledgeAlert", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
+	alert, err := s.monitorService.AcknowledgeAlert(ctx, cmd.AgreementID, cmd.AlertID, cmd.AcknowledgedBy, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to acknowledge alert: %w", err)
+	}
+
+	event := domain.AlertAcknowledgedEvent{
+		AlertID:        alert.ID,
+		AgreementID:    alert.AgreementID,
+		Severity:       alert.Severity,
+		AcknowledgedBy: alert.AcknowledgedBy,
+		RaisedAt:       alert.RaisedAt,
+		OccurredAt:     alert.AcknowledgedAt,
+	}
+	err = s.eventRepo.Save(ctx, event)
+	if err != nil {
+		fmt.Printf("Failed to save domain event: %v\n", err)
+	}
+
+	return alert, nil
+}
+
+// ConfigureEscalation sets the escalation matrix an agreement follows for alerts and incidents,
+// or for change requests, replacing any configured before
+func (s *GovernanceService) ConfigureEscalation(ctx context.Context, cmd ConfigureEscalationCommand) error {
+	ctx, span := s.startSpan(ctx, "GovernanceService.ConfigureEscalation", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
+	err := s.monitorService.ConfigureEscalationMatrix(ctx, cmd.AgreementID, cmd.Scope, cmd.Levels)
+	if err != nil {
+		return fmt.Errorf("failed to configure escalation: %w", err)
+	}
+	return nil
+}
+
+// ConfigureIncidentSLAs sets the response times of an agreement's incident classes and the SLAs
+// of its incident priorities, which incidents of the matching severity are held to
+func (s *GovernanceService) ConfigureIncidentSLAs(ctx context.Context, cmd ConfigureIncidentSLAsCommand) error {
+	ctx, span := s.startSpan(ctx, "GovernanceService.ConfigureIncidentSLAs", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
+	err := s.monitorService.ConfigureIncidentSLAs(ctx, cmd.AgreementID, cmd.Classes, cmd.Priorities)
+	if err != nil {
+		return fmt.Errorf("failed to configure incident SLAs: %w", err)
+	}
+	return nil
+}
+
+// publishAlerts publishes the alerts an evaluation raised and resolved
+func (s *GovernanceService) publishAlerts(ctx context.Context, evaluation *domain.AlertEvaluation) {
+	for _, alert := range evaluation.Raised {
+		recipients := make([]string, 0, len(alert.Notify))
+		for _, notify := range alert.Notify {
+			recipients = append(recipients, notify.Recipient)
+		}
+		event := domain.AlertRaisedEvent{
+			AlertID:          alert.ID,
+			AgreementID:      alert.AgreementID,
+			Source:           alert.Source,
+			Subject:          alert.Subject,
+			Severity:         alert.Severity,
+			PreviousSeverity: alert.PreviousSeverity,
+			Value:            alert.Value,
+			Threshold:        alert.Threshold.Value,
+			Condition:        alert.Threshold.Condition,
+			Recipients:       recipients,
+			OccurredAt:       evaluation.EvaluatedAt,
+		}
+		err := s.eventRepo.Save(ctx, event)
+		if err != nil {
+			fmt.Printf("Failed to save domain event: %v\n", err)
+		}
+	}
+
+	for _, alert := range evaluation.Resolved {
+		event := domain.AlertResolvedEvent{
+			AlertID:     alert.ID,
+			AgreementID: alert.AgreementID,
+			Source:      alert.Source,
+			Subject:     alert.Subject,
+			Severity:    alert.Severity,
+			Value:       alert.Value,
+			RaisedAt:    alert.RaisedAt,
+			OccurredAt:  evaluation.EvaluatedAt,
+		}
+		err := s.eventRepo.Save(ctx, event)
+		if err != nil {
+			fmt.Printf("Failed to save domain event: %v\n", err)
+		}
+	}
+}
+
+// RecordComplianceStatus sets the compliance status of one of an agreement's legal, contractual
+// or industry standard requirements
+func (s *GovernanceService) RecordComplianceStatus(ctx context.Context, cmd RecordComplianceStatusCommand) error {
+	ctx, span := s.startSpan(ctx, "GovernanceService.RecordComplianceStatus", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
+	err := s.monitorService.RecordComplianceStatus(ctx, cmd.AgreementID, cmd.Requirement, cmd.Status)
+	if err != nil {
+		return fmt.Errorf("failed to record compliance status: %w", err)
+	}
+	return nil
+}
+
+// GetApplicationCompliance scores the compliance of the requirements the compliance register
+// holds for an application, nil when it holds none
+func (s *GovernanceService) GetApplicationCompliance(ctx context.Context, appID domain.ApplicationID) (*domain.ConformanceScore, error) {
+	ctx, span := s.startSpan(ctx, "GovernanceService.GetApplicationCompliance", domain.ApplicationAttribute(appID))
+	defer span.End()
+
+	score, err := s.monitorService.MonitorApplicationCompliance(ctx, appID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to monitor application compliance: %w", err)
+	}
+	return score, nil
+}
+
+// CreateSurvey opens a stakeholder survey of a governance agreement
+func (s *GovernanceService) CreateSurvey(ctx context.Context, cmd CreateSurveyCommand) (*domain.Survey, error) {
+	ctx, span := s.startSpan(ctx, "GovernanceService.CreateSurvey", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
+	survey, err := s.monitorService.CreateSurvey(ctx, cmd.AgreementID, cmd.Survey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create survey: %w", err)
+	}
+	return survey, nil
+}
+
+// RecordSurveyResponse records one respondent's answers to an open survey and returns the
+// survey's updated result
+func (s *GovernanceService) RecordSurveyResponse(ctx context.Context, cmd RecordSurveyResponseCommand) (*domain.SurveyResult, error) {
+	ctx, span := s.startSpan(ctx, "GovernanceService.RecordSurveyResponse", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
+	result, err := s.monitorService.RecordSurveyResponse(ctx, cmd.AgreementID, cmd.SurveyID, cmd.Respondent, cmd.Answers, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to record survey response: %w", err)
+	}
+	return result, nil
+}
+
+// CloseSurvey stops a survey accepting responses and publishes its result with a
+// SurveyClosedEvent
+func (s *GovernanceService) CloseSurvey(ctx context.Context, cmd CloseSurveyCommand) (*domain.SurveyResult, error) {
+	ctx, span := s.startSpan(ctx, "GovernanceService.CloseSurvey", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
+	now := time.Now()
+	result, err := s.monitorService.CloseSurvey(ctx, cmd.AgreementID, cmd.SurveyID, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to close survey: %w", err)
+	}
+
+	event := domain.SurveyClosedEvent{
+		AgreementID:    cmd.AgreementID,
+		SurveyID:       cmd.SurveyID,
+		TotalResponses: result.Summary.TotalResponses,
+		ResponseRate:   result.Summary.ResponseRate,
+		Satisfaction:   result.Summary.Satisfaction,
+		OccurredAt:     now,
+	}
+	err = s.eventRepo.Save(ctx, event)
+	if err != nil {
+		fmt.Printf("Failed to save domain event: %v\n", err)
+	}
+
+	return result, nil
+}
+
+// GetSurveyResults returns the stakeholder surveys of a governance agreement with their results,
+// in the order the surveys were created
+func (s *GovernanceService) GetSurveyResults(ctx context.Context, cmd GetSurveyResultsCommand) ([]domain.Survey, []domain.SurveyResult, error) {
+	ctx, span := s.startSpan(ctx, "GovernanceService.GetSurveyResults", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
+	surveys, results, err := s.monitorService.MonitorSurveys(ctx, cmd.AgreementID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get survey results: %w", err)
+	}
+	return surveys, results, nil
+}
+
+// DetectComplianceDrift compares the conformance requirements of an agreement, or of every
+// agreement when none is given, with their status when compliance was last monitored. Each
+// requirement that went from compliant to non-compliant is published with a
+// ComplianceViolationDetectedEvent. The first run of an agreement only records its baseline.
+func (s *GovernanceService) DetectComplianceDrift(ctx context.Context, cmd DetectComplianceDriftCommand) ([]domain.ComplianceDriftReport, error) {
+	ctx, span := s.startSpan(ctx, "GovernanceService.DetectComplianceDrift", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
+	agreementIDs := []domain.GovernanceAgreementID{cmd.AgreementID}
+	if cmd.AgreementID == "" {
+		agreements, err := s.agreementRepo.FindAll(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list governance agreements: %w", err)
+		}
+		agreementIDs = agreementIDs[:0]
+		for _, agreement := range agreements {
+			agreementIDs = append(agreementIDs, agreement.ID)
+		}
+	}
+
+	reports := make([]domain.ComplianceDriftReport, 0, len(agreementIDs))
+	for _, agreementID := range agreementIDs {
+		report, err := s.monitorService.DetectComplianceDrift(ctx, agreementID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to detect compliance drift: %w", err)
+		}
+		s.publishComplianceViolations(ctx, report)
+		reports = append(reports, *report)
+	}
+
+	return reports, nil
+}
+
+// publishComplianceViolations publishes a ComplianceViolationDetectedEvent for each requirement
+// that went from compliant to non-compliant
+func (s *GovernanceService) publishComplianceViolations(ctx context.Context, report *domain.ComplianceDriftReport) {
+	for _, violation := range report.Violations {
+		event := domain.ComplianceViolationDetectedEvent{
+			ViolationID:     fmt.Sprintf("%s/%s/%s", report.AgreementID, violation.Requirement.Kind, violation.Requirement.Name),
+			AgreementID:     report.AgreementID,
+			ApplicationID:   report.ApplicationID,
+			RequirementType: string(violation.Requirement.Kind),
+			Description:     fmt.Sprintf("%s went from %s to %s", violation.Requirement, violation.Previous, violation.Current),
+			Severity:        violation.Requirement.Kind.ViolationSeverity(),
+			Remediation:     "Find why the requirement is no longer met and plan its remediation",
+			OccurredAt:      report.CheckedAt,
+		}
+		err := s.eventRepo.Save(ctx, event)
+		if err != nil {
+			fmt.Printf("Failed to save domain event: %v\n", err)
+		}
+	}
+}
+
+// publishKPIAnomalies publishes a KPIAnomalyDetectedEvent for each anomalous KPI measurement
+func (s *GovernanceService) publishKPIAnomalies(ctx context.Context, agreementID domain.GovernanceAgreementID, anomalies []domain.KPIAnomaly) {
+	now := time.Now()
+	for _, anomaly := range anomalies {
+		event := domain.KPIAnomalyDetectedEvent{
+			AgreementID:  agreementID,
+			KPIID:        anomaly.KPIID,
+			Value:        anomaly.Value,
+			Expected:     anomaly.Expected,
+			Deviation:    anomaly.Deviation,
+			Methods:      anomaly.Methods,
+			EarlyWarning: anomaly.EarlyWarning(),
+			MeasuredAt:   anomaly.MeasuredAt,
+			OccurredAt:   now,
+		}
+		err := s.eventRepo.Save(ctx, event)
+		if err != nil {
+			fmt.Printf("Failed to save domain event: %v\n", err)
+		}
+	}
+}
+
+// publishPluginMeasurements publishes a KPIMeasurementRecordedEvent for each measurement recorded
+// from a monitor plugin
+func (s *GovernanceService) publishPluginMeasurements(ctx context.Context, results []domain.PluginCollectionResult) {
+	now := time.Now()
+	for _, result := range results {
+		for _, measurement := range result.Measurements {
+			event := domain.KPIMeasurementRecordedEvent{
+				KPIID:      measurement.KPIID,
+				Value:      measurement.Value,
+				Target:     measurement.Target,
+				Achieved:   measurement.Achieved,
+				MeasuredAt: measurement.MeasuredAt,
+				RecordedBy: "plugin/" + result.Plugin,
+				OccurredAt: now,
+			}
+			err := s.eventRepo.Save(ctx, event)
+			if err != nil {
+				fmt.Printf("Failed to save domain event: %v\n", err)
+			}
+		}
+	}
+}
+
+// MapRequirement records that a policy, standard or procedure implements a conformance requirement
+func (s *GovernanceService) MapRequirement(ctx context.Context, cmd MapRequirementCommand) (*domain.RequirementMapping, error) {
+	ctx, span := s.startSpan(ctx, "GovernanceService.MapRequirement", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
+	mapping, err := s.directService.MapRequirement(ctx, cmd.AgreementID, domain.RequirementMapping{
+		Requirement:  cmd.Requirement,
+		DocumentKind: cmd.DocumentKind,
+		DocumentID:   cmd.DocumentID,
+		Notes:        cmd.Notes,
+		MappedBy:     cmd.MappedBy,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to map requirement: %w", err)
+	}
+
+	event := domain.RequirementMappedEvent{
+		AgreementID:  cmd.AgreementID,
+		Requirement:  mapping.Requirement,
+		DocumentKind: mapping.DocumentKind,
+		DocumentID:   mapping.DocumentID,
+		MappedBy:     mapping.MappedBy,
+		OccurredAt:   mapping.MappedAt,
+	}
+
+	err = s.eventRepo.Save(ctx, event)
+	if err != nil {
+		fmt.Printf("Failed to save domain event: %v\n", err)
+	}
+
+	return mapping, nil
+}
+
+// UnmapRequirement removes the mapping of a document to a conformance requirement
+func (s *GovernanceService) UnmapRequirement(ctx context.Context, cmd UnmapRequirementCommand) error {
+	ctx, span := s.startSpan(ctx, "GovernanceService.UnmapRequirement", domain.AgreementAttribute(cmd.AgreementID))
+	defer span.End()
+
+	err := s.directService.UnmapRequirement(ctx, cmd.AgreementID, cmd.Requirement, cmd.DocumentKind, cmd.DocumentID)
+	if err != nil {
+		return fmt.Errorf("failed to unmap requirement: %w", err)
+	}
+	return nil
+}
+
+// GetRequirementCoverage reports which of an agreement's legal, contractual and industry standard
+// requirements are implemented by a document in effect. Gaps are published with a
+// RequirementCoverageGapEvent.
+func (s *GovernanceService) GetRequirementCoverage(ctx context.Context, agreementID domain.GovernanceAgreementID) (*domain.RequirementCoverage, error) {
+	ctx, span := s.startSpan(ctx, "GovernanceService.GetRequirementCoverage", domain.AgreementAttribute(agreementID))
+	defer span.End()
+
+	coverage, err := s.monitorService.MonitorRequirementCoverage(ctx, agreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to monitor requirement coverage: %w", err)
+	}
+
+	s.publishRequirementGaps(ctx, coverage)
+	return coverage, nil
+}
+
+// publishRequirementGaps publishes a RequirementCoverageGapEvent when requirements are not implemented
+func (s *GovernanceService) publishRequirementGaps(ctx context.Context, coverage *domain.RequirementCoverage) {
+	if !coverage.HasGaps() {
+		return
+	}
+
+	event := domain.RequirementCoverageGapEvent{
+		AgreementID: coverage.AgreementID,
+		Unmapped:    coverage.Unmapped,
+		NotInEffect: coverage.NotInEffect,
+		OccurredAt:  coverage.CheckedAt,
+	}
+
+	err := s.eventRepo.Save(ctx, event)
+	if err != nil {
+		fmt.Printf("Failed to save domain event: %v\n", err)
+	}
+}
+
+// GetGovernanceAgreement retrieves a governance agreement by ID
+func (s *GovernanceService) GetGovernanceAgreement(ctx context.Context, agreementID domain.GovernanceAgreementID) (*domain.GovernanceAgreement, error) {
+	ctx, span := s.startSpan(ctx, "GovernanceService.GetGovernanceAgreement", domain.AgreementAttribute(agreementID))
+	defer span.End()
+
+	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get governance agreement: %w", err)
+	}
+	return &agreement, nil
+}
+
+// ListGovernanceAgreements retrieves all governance agreements
+func (s *GovernanceService) ListGovernanceAgreements(ctx context.Context) ([]domain.GovernanceAgreement, error) {
+	ctx, span := s.startSpan(ctx, "GovernanceService.ListGovernanceAgreements")
+	defer span.End()
+
+	agreements, err := s.agreementRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list governance agreements: %w", err)
+	}
+	return agreements, nil
+}
+
+// Commands for Governance Service
+
+type CreateGovernanceAgreementCommand struct {
+	ID            domain.GovernanceAgreementID
+	ApplicationID domain.ApplicationID
+	Title         string
+}
+
+type UpdateStrategyCommand struct {
+	AgreementID domain.GovernanceAgreementID
+	Strategy    domain.Strategy
+}
+
+type RegisterTechnologyComponentCommand struct {
+	AgreementID domain.GovernanceAgreementID
+	Component   domain.TechnologyComponent
+}
+
+type UpdateAcquisitionCommand struct {
+	AgreementID domain.GovernanceAgreementID
+	Acquisition domain.Acquisition
+}
+
+type UpdatePerformanceCommand struct {
+	AgreementID    domain.GovernanceAgreementID
+	Performance    domain.Performance
+}
+
+type UpdateConformanceCommand struct {
+	AgreementID domain.GovernanceAgreementID
+	Conformance domain.Conformance
+}
+
+type UpdateImplementationCommand struct {
+	AgreementID    domain.GovernanceAgreementID
+	Implementation domain.Implementation
+}
+
+type ApproveGovernanceAgreementCommand struct {
+	AgreementID domain.GovernanceAgreementID
+}
+
+type ActivateGovernanceAgreementCommand struct {
+	AgreementID domain.GovernanceAgreementID
+}
+
+type EvaluateApplicationCommand struct {
+	ApplicationID domain.ApplicationID
+	Evaluator     string
+	Profile       *domain.EvaluationProfile // optional, overrides the service profile
+}
+
+type CompareAssessmentsCommand struct {
+	ApplicationID    domain.ApplicationID
+	FromAssessmentID string // optional, defaults to the assessment before ToAssessmentID
+	ToAssessmentID   string // optional, defaults to the latest assessment
+}
+
+type ReviewAssessmentCommand struct {
+	ApplicationID domain.ApplicationID
+	AssessmentID  string // optional, defaults to the latest assessment
+	Reviewer      string
+	Comments      string
+}
+
+type SignOffAssessmentCommand struct {
+	ApplicationID domain.ApplicationID
+	AssessmentID  string // optional, defaults to the latest assessment
+	Approver      string
+}
+
+type EvaluatePortfolioCommand struct {
+	PortfolioID domain.PortfolioID
+	Profile     *domain.EvaluationProfile // optional, overrides the service profile
+	Evaluator   string                    // optional, recorded on the application assessments; defaults to "system"
+}
+
+type SimulatePortfolioCommand struct {
+	PortfolioID  domain.PortfolioID
+	ScenarioName string
+	Changes      []domain.ScenarioChange
+}
+
+type IdentifyRiskCommand struct {
+	AgreementID domain.GovernanceAgreementID
+	Risk        domain.Risk
+}
+
+type SimulatePortfolioRiskCommand struct {
+	PortfolioID domain.PortfolioID
+	Iterations  int    // optional, defaults to DefaultRiskSimulationOptions
+	Seed        *int64 // optional, defaults to DefaultRiskSimulationOptions
+}
+
+type ForecastObsolescenceCommand struct {
+	PortfolioID domain.PortfolioID
+	Horizon     time.Duration // optional, defaults to two years
+}
+
+type AssessStrategicAlignmentCommand struct {
+	PortfolioID domain.PortfolioID
+}
+
+type SetStrategicDirectionCommand struct {
+	AgreementID domain.GovernanceAgreementID
+	Director    string
+	Objectives  []domain.StrategicObjective
+	Initiatives []domain.StrategicInitiative
+}
+
+type ProposeStrategicDirectionCommand struct {
+	AgreementID   domain.GovernanceAgreementID
+	ProposalID    string
+	Objectives    []domain.StrategicObjective
+	Initiatives   []domain.StrategicInitiative
+	Rationale     string
+	GoverningBody string // optional, the body that must approve the direction
+	ProposedBy    string
+}
+
+type ReviewStrategicDirectionCommand struct {
+	AgreementID domain.GovernanceAgreementID
+	ProposalID  string
+	ReviewedBy  string
+	Comments    string
+}
+
+type ApproveStrategicDirectionCommand struct {
+	AgreementID domain.GovernanceAgreementID
+	ProposalID  string
+	ApprovedBy  string // the governance body ratifying the direction
+}
+
+type RejectStrategicDirectionCommand struct {
+	AgreementID domain.GovernanceAgreementID
+	ProposalID  string
+	RejectedBy  string
+	Comments    string
+}
+
+type ConfigureAlertsCommand struct {
+	AgreementID domain.GovernanceAgreementID
+	Source      domain.AlertSource
+	Subject     string // KPI ID or risk indicator name
+	Thresholds  []domain.Threshold
+	Alerts      []domain.Alert
+	Frequency   string // KPIs only
+	Responsible string // KPIs only
+}
+
+type EvaluateAlertsCommand struct {
+	AgreementID domain.GovernanceAgreementID
+}
+
+type AcknowledgeAlertCommand struct {
+	AgreementID    domain.GovernanceAgreementID
+	AlertID        string
+	AcknowledgedBy string
+}
+
+type ConfigureEscalationCommand struct {
+	AgreementID domain.GovernanceAgreementID
+	Scope       domain.EscalationScope
+	Levels      []domain.EscalationLevel
+}
+
+type ConfigureIncidentSLAsCommand struct {
+	AgreementID domain.GovernanceAgreementID
+	Classes     []domain.IncidentClass
+	Priorities  []domain.IncidentPriority
+}
+
+type RecordComplianceStatusCommand struct {
+	AgreementID domain.GovernanceAgreementID
+	Requirement domain.RequirementRef
+	Status      domain.ComplianceStatus
+}
+
+type CreateSurveyCommand struct {
+	AgreementID domain.GovernanceAgreementID
+	Survey      domain.Survey
+}
+
+type RecordSurveyResponseCommand struct {
+	AgreementID domain.GovernanceAgreementID
+	SurveyID    string
+	Respondent  string // optional, recorded anonymously when empty
+	Answers     []domain.SurveyResponse
+}
+
+type CloseSurveyCommand struct {
+	AgreementID domain.GovernanceAgreementID
+	SurveyID    string
+}
+
+type GetSurveyResultsCommand struct {
+	AgreementID domain.GovernanceAgreementID
+}
+
+type DetectComplianceDriftCommand struct {
+	AgreementID domain.GovernanceAgreementID // every agreement when empty
+}
+
+type GetMonitoringHistoryCommand struct {
+	AgreementID domain.GovernanceAgreementID
+	From        time.Time // optional, from the first snapshot when zero
+	To          time.Time // optional, up to the latest snapshot when zero
+}
+
+type MapRequirementCommand struct {
+	AgreementID  domain.GovernanceAgreementID
+	Requirement  domain.RequirementRef
+	DocumentKind domain.DocumentKind
+	DocumentID   string
+	Notes        string
+	MappedBy     string
+}
+
+type UnmapRequirementCommand struct {
+	AgreementID  domain.GovernanceAgreementID
+	Requirement  domain.RequirementRef
+	DocumentKind domain.DocumentKind
+	DocumentID   string
+}
+
+type UpdateInitiativeProgressCommand struct {
+	AgreementID         domain.GovernanceAgreementID
+	InitiativeID        string
+	PercentComplete     float64
+	Status              domain.InitiativeStatus // optional, derived from the progress when empty
+	CompletedMilestones []string
+	Note                string
+	UpdatedBy           string
+}
+
+type DefineOKRCommand struct {
+	AgreementID domain.GovernanceAgreementID
+	OKR         domain.OKR
+}
+
+type RecordKeyResultCommand struct {
+	AgreementID domain.GovernanceAgreementID
+	OKRID       string
+	KeyResultID string
+	Actual      float64
+	RecordedBy  string
+}
+
+type GetOKRCascadeCommand struct {
+	PortfolioID domain.PortfolioID
+}
+
+type GetRiskHeatMapCommand struct {
+	PortfolioID domain.PortfolioID
+}
+
+type AllocateResourcesCommand struct {
+	AgreementID          domain.GovernanceAgreementID
+	BudgetAllocations    []domain.BudgetAllocation
+	PersonnelAllocations []domain.PersonnelAllocation
+}
+
+type SaveActionPlanCommand struct {
+	AgreementID domain.GovernanceAgreementID
+	ActionPlan  domain.ActionPlan
+}
+
+type UpdateActionCommand struct {
+	AgreementID domain.GovernanceAgreementID
+	PlanID      string
+	ActionID    string
+	Status      domain.ActionStatus // optional, unchanged when empty
+	Duration    time.Duration       // optional, revised estimate of the action's effort
+	Deadline    time.Time           // optional
+	CompletedAt time.Time           // optional, when a completed action finished
+}
+
+type GetActionScheduleCommand struct {
+	AgreementID domain.GovernanceAgreementID
+}
+
+type GetCapacityPlanCommand struct {
+	AgreementID domain.GovernanceAgreementID
+}
+
+type RecordExpenditureCommand struct {
+	AgreementID domain.GovernanceAgreementID
+	Category    string // the budget allocation the money was spent from
+	Amount      float64
+	Description string
+	RecordedBy  string
+	SpentAt     time.Time // optional, defaults to now
+}
+
+type EstablishPoliciesCommand struct {
+	AgreementID   domain.GovernanceAgreementID
+	EstablishedBy string // recorded as the author of new and changed document versions
+	Policies      []domain.Policy
+	Standards     []domain.Standard
+	Procedures    []domain.Procedure
+}
+
+type DraftPolicyCommand struct {
+	AgreementID domain.GovernanceAgreementID
+	Policy      domain.Policy
+	DraftedBy   string
+}
+
+type RevisePolicyCommand struct {
+	AgreementID domain.GovernanceAgreementID
+	Policy      domain.Policy // the policy's ID with its revised name, description, scope and owner
+	ChangedBy   string
+	Summary     string
+}
+
+type ReviseStandardCommand struct {
+	AgreementID domain.GovernanceAgreementID
+	Standard    domain.Standard
+	ChangedBy   string
+	Summary     string
+}
+
+type ReviseProcedureCommand struct {
+	AgreementID domain.GovernanceAgreementID
+	Procedure   domain.Procedure
+	ChangedBy   string
+	Summary     string
+}
+
+type PinDocumentVersionCommand struct {
+	AgreementID domain.GovernanceAgreementID
+	Kind        domain.DocumentKind
+	DocumentID  string
+	Version     int // 0 removes the pin
+	PinnedBy    string
+}
+
+type SubmitPolicyCommand struct {
+	AgreementID domain.GovernanceAgreementID
+	PolicyID    string
+	SubmittedBy string
+}
+
+type ApprovePolicyCommand struct {
+	AgreementID domain.GovernanceAgreementID
+	PolicyID    string
+	ApprovedBy  string
+}
+
+type PublishPolicyCommand struct {
+	AgreementID    domain.GovernanceAgreementID
+	PolicyID       string
+	EffectiveFrom  time.Time // optional, defaults to publication
+	EffectiveUntil time.Time // optional, zero for no end date
+}
+
+type RetirePolicyCommand struct {
+	AgreementID domain.GovernanceAgreementID
+	PolicyID    string
+	Reason      string
+}
+
+type MonitorGovernanceCommand struct {
+	AgreementID domain.GovernanceAgreementID
+}
+
+type GovernanceMonitoringResult struct {
+	KPIMeasurements     []domain.KPIMeasurement
+	ComplianceStatus    *domain.ComplianceMonitoring
+	RiskStatus          *domain.RiskMonitoring
+	ObjectiveCoverage   *domain.ObjectiveKPICoverage
+	RequirementCoverage *domain.RequirementCoverage
+	InitiativeProgress  *domain.DirectionProgress
+	BudgetStatus        *domain.BudgetStatus
+	OKRs                []domain.OKRProgress
+	Alerts              *domain.AlertEvaluation
+	ComplianceDrift     *domain.ComplianceDriftReport
+	PortfolioKPIs       []domain.PortfolioKPIScoreboard  // portfolios holding the agreement's application
+	KPIAnomalies        []domain.KPIAnomaly              // measurements deviating unusually since last monitored
+	ErrorBudgets        []domain.ErrorBudget             // SLOs derived from the application's SLA
+	Operations          *domain.OperationalMetrics       // incidents of the last 90 days; nil without an incident repository
+	ChangeFailures      *domain.ChangeFailureRate        // changes of the last 90 days likely causing incidents; nil without a change repository
+	PostIncidentReviews *domain.PostIncidentReviewStatus // reviews of high-severity incidents of the last 90 days; nil without an incident repository
+	Plugins             []domain.PluginCollectionResult  // what each registered monitor plugin contributed
+}
+
+// snapshot summarizes the result for the agreement's monitoring history
+func (r *GovernanceMonitoringResult) snapshot(agreementID domain.GovernanceAgreementID, at time.Time) domain.MonitoringSnapshot {
+	return domain.MonitoringSnapshot{
+		ID:                   fmt.Sprintf("%s-%s", agreementID, at.UTC().Format("20060102T150405.000Z")),
+		AgreementID:          agreementID,
+		ApplicationID:        r.ComplianceDrift.ApplicationID,
+		TakenAt:              at,
+		KPIs:                 append([]domain.KPIMeasurement{}, r.KPIMeasurements...),
+		RiskIndicators:       append([]domain.RiskIndicator{}, r.RiskStatus.RiskIndicators...),
+		RequirementGaps:      len(r.RequirementCoverage.Unmapped) + len(r.RequirementCoverage.NotInEffect),
+		ComplianceViolations: len(r.ComplianceDrift.Violations),
+		ActiveAlerts:         len(r.Alerts.Active),
+		KPIAnomalies:         len(r.KPIAnomalies),
+	}
 }
@@ -0,0 +1,203 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// IncidentSLAService holds unresolved incidents to the SLA of their application's governance
+// agreement: the response time of the incident class and the SLA of the incident priority
+// matching their severity. Each check records how much time the incidents have left, moves those
+// past a target to breached, and publishes every breach once with an IncidentSLABreachedEvent
+// sent to incident managers.
+type IncidentSLAService struct {
+	instrumentation
+
+	agreementRepo domain.GovernanceAgreementRepository
+	incidentRepo  domain.IncidentRepository
+	notifier      domain.Notifier // nil records breaches without notifying
+	eventRepo     domain.DomainEventRepository
+	now           func() time.Time
+}
+
+// NewIncidentSLAService creates a new incident SLA service. The notifier may be nil, in which
+// case breaches are recorded and published without being sent.
+func NewIncidentSLAService(
+	agreementRepo domain.GovernanceAgreementRepository,
+	incidentRepo domain.IncidentRepository,
+	notifier domain.Notifier,
+	eventRepo domain.DomainEventRepository,
+	opts ...ServiceOption,
+) *IncidentSLAService {
+	return &IncidentSLAService{
+		agreementRepo:   agreementRepo,
+		incidentRepo:    incidentRepo,
+		notifier:        notifier,
+		eventRepo:       eventRepo,
+		now:             time.Now,
+		instrumentation: newInstrumentation(opts),
+	}
+}
+
+// IncidentSLARun is the outcome of one check of incident SLAs
+type IncidentSLARun struct {
+	Checks   []domain.IncidentSLACheck // unresolved incidents held to an SLA, most urgent first
+	Breaches int                       // breaches found for the first time
+	Failed   int                       // breaches whose notification could not be sent
+	RanAt    time.Time
+}
+
+// CheckSLAs checks the unresolved incidents of every application with a governance agreement, or
+// of the command's application only. Incidents reported before their agreement set an SLA are
+// held to it from when they were reported. A failure does not stop the other checks; their
+// errors are joined.
+func (s *IncidentSLAService) CheckSLAs(ctx context.Context, cmd CheckIncidentSLAsCommand) (*IncidentSLARun, error) {
+	ctx, span := s.startSpan(ctx, "IncidentSLAService.CheckSLAs", domain.ApplicationAttribute(cmd.ApplicationID))
+	defer span.End()
+
+	if cmd.Now.IsZero() {
+		cmd.Now = s.now()
+	}
+	run := &IncidentSLARun{Checks: []domain.IncidentSLACheck{}, RanAt: cmd.Now}
+
+	agreements, err := s.agreementRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list governance agreements: %w", err)
+	}
+	byApplication := make(map[domain.ApplicationID]domain.GovernanceAgreement, len(agreements))
+	for _, agreement := range agreements {
+		byApplication[agreement.ApplicationID] = agreement
+	}
+
+	var errs []error
+	for _, status := range []domain.IncidentStatus{domain.IncidentStatusOpen, domain.IncidentStatusInvestigating} {
+		incidents, err := s.incidentRepo.FindByStatus(ctx, status)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to find %s incidents: %w", status, err))
+			continue
+		}
+		for _, incident := range incidents {
+			if cmd.ApplicationID != "" && incident.ApplicationID != cmd.ApplicationID {
+				continue
+			}
+			agreement, ok := byApplication[incident.ApplicationID]
+			if !ok {
+				continue
+			}
+			if err := s.check(ctx, incident, agreement, cmd.Now, run); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	sortIncidentSLAChecks(run.Checks)
+	return run, errors.Join(errs...)
+}
+
+// Start checks incident SLAs every interval until the context is cancelled. Failures are passed
+// to onError when it is not nil.
+func (s *IncidentSLAService) Start(ctx context.Context, interval time.Duration, onError func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.CheckSLAs(ctx, CheckIncidentSLAsCommand{Now: s.now()}); err != nil && onError != nil {
+				onError(err)
+			}
+		}
+	}
+}
+
+// check holds the incident to its agreement's SLA, saving it when its SLA status changed and
+// publishing the breaches found
+func (s *IncidentSLAService) check(ctx context.Context, incident domain.Incident, agreement domain.GovernanceAgreement, now time.Time, run *IncidentSLARun) error {
+	before := incident.SLAStatus
+	if !incident.HasSLA() {
+		sla, ok := domain.IncidentSLAFor(agreement.Performance.IncidentManagement, incident.Severity, incident.Priority)
+		if !ok {
+			return nil
+		}
+		incident.ApplySLA(sla)
+		before = ""
+	}
+
+	breached := incident.EvaluateSLA(now)
+	if incident.SLAStatus != before || len(breached) > 0 {
+		incident.UpdatedAt = now
+		err := s.incidentRepo.Update(ctx, incident)
+		if err != nil {
+			return fmt.Errorf("failed to update incident %s: %w", incident.ID, err)
+		}
+	}
+	run.Checks = append(run.Checks, incident.SLACheck(agreement.ID, now, breached))
+
+	var errs []error
+	for _, target := range breached {
+		run.Breaches++
+		event := incident.SLABreachedEvent(agreement.ID, target, now)
+		err := s.eventRepo.Save(ctx, event)
+		if err != nil {
+			fmt.Printf("Failed to save domain event: %v\n", err)
+		}
+
+		if s.notifier == nil {
+			continue
+		}
+		severity := domain.NotificationWarning
+		if incident.Severity == 1 {
+			severity = domain.NotificationCritical
+		}
+		notification := domain.Notification{
+			ID:            fmt.Sprintf("sla-breach/%s/%s", incident.ID, target),
+			Kind:          domain.NotificationSLABreach,
+			Severity:      severity,
+			Title:         fmt.Sprintf("Incident %s breached its %s SLA", incident.Title, target),
+			Message:       event.Describe(),
+			AgreementID:   agreement.ID,
+			ApplicationID: incident.ApplicationID,
+			Roles:         []string{domain.RoleIncidentManager},
+			DueAt:         event.DueAt,
+			CreatedAt:     now,
+		}
+		if err := s.notifier.Notify(ctx, notification); err != nil {
+			run.Failed++
+			errs = append(errs, fmt.Errorf("failed to send notification %s: %w", notification.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// sortIncidentSLAChecks orders checks by urgency: breached incidents first, then by the least
+// time left on either target
+func sortIncidentSLAChecks(checks []domain.IncidentSLACheck) {
+	left := func(check domain.IncidentSLACheck) time.Duration {
+		remaining := check.ResolutionRemaining
+		if check.ResponseRemaining != 0 && (remaining == 0 || check.ResponseRemaining < remaining) {
+			remaining = check.ResponseRemaining
+		}
+		return remaining
+	}
+	sort.SliceStable(checks, func(i, j int) bool {
+		a, b := checks[i], checks[j]
+		if (a.Status == domain.IncidentSLABreached) != (b.Status == domain.IncidentSLABreached) {
+			return a.Status == domain.IncidentSLABreached
+		}
+		return left(a) < left(b)
+	})
+}
+
+// Commands for Incident SLA Service
+
+type CheckIncidentSLAsCommand struct {
+	ApplicationID domain.ApplicationID // optional, every application when empty
+	Now           time.Time            // optional, defaults to now
+}
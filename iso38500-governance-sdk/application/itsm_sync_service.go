@@ -0,0 +1,574 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// ITSMSyncService mirrors change requests and incidents to ITSM tools such as ServiceNow or Jira
+// so the SDK can govern the records teams work on there. Each sync creates the records not
+// mirrored yet, pushes local changes and comments, and pulls the tool's status changes and
+// comments. A status change made in the tool is applied through the change management service
+// when the record's lifecycle allows it and the record has not changed here since it was last
+// pushed; otherwise it is reported as a conflict and the local status is pushed back, so
+// approvals and closure rules stay governed by the SDK.
+type ITSMSyncService struct {
+	instrumentation
+
+	changeService *ChangeManagementService
+	changeRepo    domain.ChangeRequestRepository
+	incidentRepo  domain.IncidentRepository
+	appRepo       domain.ApplicationRepository
+	linkRepo      domain.ITSMLinkRepository
+	connectors    map[string]domain.ITSMConnector
+	targets       map[domain.ITSMRecordKind]domain.ITSMSyncTarget
+	now           func() time.Time
+}
+
+// NewITSMSyncService creates a new ITSM sync service over the connectors. Nothing is mirrored
+// until a target is set for a kind of record.
+func NewITSMSyncService(
+	changeService *ChangeManagementService,
+	changeRepo domain.ChangeRequestRepository,
+	incidentRepo domain.IncidentRepository,
+	appRepo domain.ApplicationRepository,
+	linkRepo domain.ITSMLinkRepository,
+	connectors []domain.ITSMConnector,
+	opts ...ServiceOption,
+) *ITSMSyncService {
+	byName := make(map[string]domain.ITSMConnector, len(connectors))
+	for _, connector := range connectors {
+		byName[connector.Name()] = connector
+	}
+	return &ITSMSyncService{
+		changeService:   changeService,
+		changeRepo:      changeRepo,
+		incidentRepo:    incidentRepo,
+		appRepo:         appRepo,
+		linkRepo:        linkRepo,
+		connectors:      byName,
+		targets:         make(map[domain.ITSMRecordKind]domain.ITSMSyncTarget),
+		now:             time.Now,
+		instrumentation: newInstrumentation(opts),
+	}
+}
+
+// SetTarget mirrors a kind of record to a configured connector, replacing the target set for
+// that kind before
+func (s *ITSMSyncService) SetTarget(target domain.ITSMSyncTarget) error {
+	if err := target.Validate(); err != nil {
+		return err
+	}
+	if _, ok := s.connectors[target.Connector]; !ok {
+		return fmt.Errorf("ITSM connector %s is not configured", target.Connector)
+	}
+	s.targets[target.Kind] = target
+	return nil
+}
+
+// Targets returns the sync targets, by kind
+func (s *ITSMSyncService) Targets() []domain.ITSMSyncTarget {
+	targets := make([]domain.ITSMSyncTarget, 0, len(s.targets))
+	for _, target := range s.targets {
+		targets = append(targets, target)
+	}
+	sort.Slice(targets, func(i, j int) bool { return targets[i].Kind < targets[j].Kind })
+	return targets
+}
+
+// ITSMSyncOutcome is what one sync did to one mirrored record
+type ITSMSyncOutcome struct {
+	Kind           domain.ITSMRecordKind
+	LocalID        string
+	Reference      domain.ITSMReference
+	Created        bool     // the record was created in the ITSM tool
+	Pushed         bool     // local changes were pushed
+	Applied        []string // changes made in the ITSM tool and applied here, e.g. "status resolved"
+	CommentsPulled int
+	CommentsPushed int
+	Conflict       string // a change made in the tool that could not be applied
+	Error          string
+}
+
+// ITSMSyncRun is the outcome of one sync
+type ITSMSyncRun struct {
+	Outcomes  []ITSMSyncOutcome // records the sync changed, conflicted on or failed on, by kind and ID
+	Created   int
+	Pushed    int
+	Applied   int
+	Conflicts int
+	Failed    int
+	RanAt     time.Time
+}
+
+// Sync mirrors the records of every target, or of the command's kind and application only. A
+// failure does not stop the other records; their errors are joined.
+func (s *ITSMSyncService) Sync(ctx context.Context, cmd SyncITSMCommand) (*ITSMSyncRun, error) {
+	ctx, span := s.startSpan(ctx, "ITSMSyncService.Sync", domain.ApplicationAttribute(cmd.ApplicationID))
+	defer span.End()
+
+	if cmd.Now.IsZero() {
+		cmd.Now = s.now()
+	}
+	run := &ITSMSyncRun{Outcomes: []ITSMSyncOutcome{}, RanAt: cmd.Now}
+
+	var errs []error
+	for _, target := range s.Targets() {
+		if cmd.Kind != "" && target.Kind != cmd.Kind {
+			continue
+		}
+		records, err := s.records(ctx, target, cmd.ApplicationID)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		for _, record := range records {
+			outcome, err := s.sync(ctx, target, record, cmd.Now)
+			if err != nil {
+				outcome.Error = err.Error()
+				errs = append(errs, fmt.Errorf("%s %s: %w", target.Kind, record.id, err))
+			}
+			run.record(outcome)
+		}
+	}
+	return run, errors.Join(errs...)
+}
+
+// Start syncs every target every interval until the context is cancelled. Failures are passed
+// to onError when it is not nil.
+func (s *ITSMSyncService) Start(ctx context.Context, interval time.Duration, onError func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.Sync(ctx, SyncITSMCommand{Now: s.now()}); err != nil && onError != nil {
+				onError(err)
+			}
+		}
+	}
+}
+
+// AddComment comments on a mirrored record and pushes the comment to its ITSM tool. A comment
+// that cannot be pushed is kept and pushed by the next sync.
+func (s *ITSMSyncService) AddComment(ctx context.Context, cmd AddITSMCommentCommand) (*domain.ITSMLink, error) {
+	ctx, span := s.startSpan(ctx, "ITSMSyncService.AddComment")
+	defer span.End()
+
+	if strings.TrimSpace(cmd.Body) == "" {
+		return nil, errors.New("comment cannot be empty")
+	}
+	link, err := s.linkRepo.Find(ctx, cmd.Kind, cmd.LocalID)
+	if err != nil {
+		return nil, fmt.Errorf("%s %s is not mirrored to an ITSM tool: %w", cmd.Kind, cmd.LocalID, err)
+	}
+
+	now := s.now()
+	link.Comments = append(link.Comments, domain.ITSMComment{
+		Author:    cmd.Author,
+		Body:      cmd.Body,
+		Origin:    domain.ITSMCommentLocal,
+		CreatedAt: now,
+	})
+	_, pushErr := s.pushComments(ctx, &link, now)
+	if pushErr != nil {
+		link.LastError = pushErr.Error()
+	}
+
+	err = s.linkRepo.Save(ctx, link)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save ITSM link: %w", err)
+	}
+	if pushErr != nil {
+		return &link, fmt.Errorf("comment saved; it will be pushed by the next sync: %w", pushErr)
+	}
+	return &link, nil
+}
+
+// GetLink returns the link of a mirrored change request or incident
+func (s *ITSMSyncService) GetLink(ctx context.Context, kind domain.ITSMRecordKind, localID string) (*domain.ITSMLink, error) {
+	ctx, span := s.startSpan(ctx, "ITSMSyncService.GetLink")
+	defer span.End()
+
+	link, err := s.linkRepo.Find(ctx, kind, localID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find ITSM link: %w", err)
+	}
+	return &link, nil
+}
+
+// ListLinks returns the links of every mirrored record, by kind and ID
+func (s *ITSMSyncService) ListLinks(ctx context.Context) ([]domain.ITSMLink, error) {
+	ctx, span := s.startSpan(ctx, "ITSMSyncService.ListLinks")
+	defer span.End()
+
+	links, err := s.linkRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find ITSM links: %w", err)
+	}
+	return links, nil
+}
+
+// itsmRecord is a change request or incident as the sync sees it
+type itsmRecord struct {
+	id            string
+	applicationID domain.ApplicationID
+	status        string
+	assignee      string
+	updatedAt     time.Time
+	fields        map[string]string
+}
+
+// records loads the records the target mirrors, of one application or of every application it
+// covers
+func (s *ITSMSyncService) records(ctx context.Context, target domain.ITSMSyncTarget, appID domain.ApplicationID) ([]itsmRecord, error) {
+	var appIDs []domain.ApplicationID
+	switch {
+	case appID != "":
+		if !target.Covers(appID) {
+			return nil, nil
+		}
+		appIDs = []domain.ApplicationID{appID}
+	case len(target.Applications) > 0:
+		appIDs = target.Applications
+	default:
+		apps, err := s.appRepo.FindAll(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list applications: %w", err)
+		}
+		for _, app := range apps {
+			appIDs = append(appIDs, app.ID)
+		}
+	}
+
+	var records []itsmRecord
+	for _, id := range appIDs {
+		loaded, err := s.load(ctx, target.Kind, id)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, loaded...)
+	}
+	sort.Slice(records, func(i, j int) bool { return records[i].id < records[j].id })
+	return records, nil
+}
+
+// load loads the change requests or incidents of an application
+func (s *ITSMSyncService) load(ctx context.Context, kind domain.ITSMRecordKind, appID domain.ApplicationID) ([]itsmRecord, error) {
+	var records []itsmRecord
+	if kind == domain.ITSMChangeRequest {
+		changes, err := s.changeRepo.FindByApplicationID(ctx, appID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find change requests: %w", err)
+		}
+		for _, change := range changes {
+			records = append(records, changeRequestRecord(change))
+		}
+		return records, nil
+	}
+
+	incidents, err := s.incidentRepo.FindByApplicationID(ctx, appID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find incidents: %w", err)
+	}
+	for _, incident := range incidents {
+		records = append(records, incidentRecord(incident))
+	}
+	return records, nil
+}
+
+// reload loads a record again after changes pulled from its ITSM tool were applied to it
+func (s *ITSMSyncService) reload(ctx context.Context, kind domain.ITSMRecordKind, id string) (itsmRecord, error) {
+	if kind == domain.ITSMChangeRequest {
+		change, err := s.changeRepo.FindByID(ctx, id)
+		if err != nil {
+			return itsmRecord{}, fmt.Errorf("change request not found: %w", err)
+		}
+		return changeRequestRecord(change), nil
+	}
+	incident, err := s.incidentRepo.FindByID(ctx, id)
+	if err != nil {
+		return itsmRecord{}, fmt.Errorf("incident not found: %w", err)
+	}
+	return incidentRecord(incident), nil
+}
+
+func changeRequestRecord(change domain.ChangeRequest) itsmRecord {
+	return itsmRecord{
+		id:            change.ID,
+		applicationID: change.ApplicationID,
+		status:        string(change.Status),
+		updatedAt:     change.UpdatedAt,
+		fields:        domain.ChangeRequestITSMFields(change),
+	}
+}
+
+func incidentRecord(incident domain.Incident) itsmRecord {
+	return itsmRecord{
+		id:            incident.ID,
+		applicationID: incident.ApplicationID,
+		status:        string(incident.Status),
+		assignee:      incident.Assignee,
+		updatedAt:     incident.UpdatedAt,
+		fields:        domain.IncidentITSMFields(incident),
+	}
+}
+
+// sync mirrors one record: it creates it in the ITSM tool when it is not mirrored yet, and
+// otherwise pulls the tool's changes and comments before pushing its own
+func (s *ITSMSyncService) sync(ctx context.Context, target domain.ITSMSyncTarget, record itsmRecord, now time.Time) (ITSMSyncOutcome, error) {
+	outcome := ITSMSyncOutcome{Kind: target.Kind, LocalID: record.id}
+	connector := s.connectors[target.Connector]
+
+	link, err := s.linkRepo.Find(ctx, target.Kind, record.id)
+	if errors.Is(err, domain.ErrITSMLinkNotFound) {
+		reference, err := connector.Create(ctx, target.Kind, target.Mapping.Outbound(record.fields))
+		if err != nil {
+			return outcome, fmt.Errorf("failed to create it in %s: %w", connector.Name(), err)
+		}
+		link = domain.ITSMLink{
+			Kind:          target.Kind,
+			LocalID:       record.id,
+			ApplicationID: record.applicationID,
+			Connector:     connector.Name(),
+			Reference:     reference,
+			PushedAt:      record.updatedAt,
+			RemoteStatus:  record.status,
+			LastSyncedAt:  now,
+		}
+		outcome.Reference = reference
+		outcome.Created = true
+		return outcome, s.saveLink(ctx, link)
+	}
+	if err != nil {
+		return outcome, fmt.Errorf("failed to find ITSM link: %w", err)
+	}
+	outcome.Reference = link.Reference
+	if link.Connector != connector.Name() {
+		return outcome, fmt.Errorf("it is mirrored to %s, not to %s", link.Connector, connector.Name())
+	}
+
+	remote, err := connector.Fetch(ctx, target.Kind, link.Reference.ExternalID)
+	if err != nil {
+		return outcome, s.failed(ctx, link, fmt.Errorf("failed to fetch it from %s: %w", connector.Name(), err))
+	}
+	outcome.CommentsPulled = link.MergeRemoteComments(remote.Comments)
+
+	inbound := target.Mapping.Inbound(remote.Fields)
+	changedHere := record.updatedAt.After(link.PushedAt)
+	link.LastError = ""
+	applied, err := s.apply(ctx, target.Kind, record, link, inbound, changedHere)
+	if err != nil {
+		outcome.Conflict = err.Error()
+		link.LastError = err.Error()
+	}
+	if len(applied) > 0 {
+		outcome.Applied = applied
+		if record, err = s.reload(ctx, target.Kind, record.id); err != nil {
+			return outcome, s.failed(ctx, link, err)
+		}
+	}
+	if status := inbound[domain.ITSMFieldStatus]; status != "" {
+		link.RemoteStatus = status
+	}
+
+	if record.updatedAt.After(link.PushedAt) || outcome.Conflict != "" {
+		err := connector.Update(ctx, target.Kind, link.Reference.ExternalID, target.Mapping.Outbound(record.fields))
+		if err != nil {
+			return outcome, s.failed(ctx, link, fmt.Errorf("failed to update it in %s: %w", connector.Name(), err))
+		}
+		link.PushedAt = record.updatedAt
+		link.RemoteStatus = record.status
+		outcome.Pushed = true
+	}
+
+	outcome.CommentsPushed, err = s.pushComments(ctx, &link, now)
+	if err != nil {
+		return outcome, s.failed(ctx, link, err)
+	}
+	link.LastSyncedAt = now
+	return outcome, s.saveLink(ctx, link)
+}
+
+// apply applies the changes made to a record in its ITSM tool since it was last synced. Status
+// changes go through the record's lifecycle; a change that cannot be applied, or a status that
+// changed on both sides, is returned as an error. An assignee set in the tool is taken only when
+// the record has not changed here since it was last pushed.
+func (s *ITSMSyncService) apply(ctx context.Context, kind domain.ITSMRecordKind, record itsmRecord, link domain.ITSMLink, inbound map[string]string, changedHere bool) ([]string, error) {
+	var applied []string
+	actor := link.Connector
+	via := fmt.Sprintf("%s %s", link.Connector, link.Reference.Key)
+
+	if assignee := inbound[domain.ITSMFieldAssignee]; kind == domain.ITSMIncident && assignee != "" && assignee != record.assignee && !changedHere {
+		_, err := s.changeService.AssignIncident(ctx, AssignIncidentCommand{IncidentID: record.id, Assignee: assignee, AssignedBy: actor})
+		if err != nil {
+			return applied, fmt.Errorf("assignee %s from %s could not be applied: %w", assignee, via, err)
+		}
+		applied = append(applied, "assignee "+assignee)
+		record.assignee = assignee
+	}
+
+	status := inbound[domain.ITSMFieldStatus]
+	if status == "" || status == link.RemoteStatus || status == record.status {
+		return applied, nil
+	}
+	if record.status != link.RemoteStatus {
+		return applied, fmt.Errorf("status changed to %s in %s while it changed to %s here", status, via, record.status)
+	}
+
+	var err error
+	switch kind {
+	case domain.ITSMIncident:
+		err = s.applyIncidentStatus(ctx, record, domain.IncidentStatus(status), inbound, actor, via)
+	case domain.ITSMChangeRequest:
+		err = s.applyChangeRequestStatus(ctx, record, domain.ChangeRequestStatus(status), inbound, actor, via)
+	}
+	if err != nil {
+		return applied, fmt.Errorf("status %s from %s could not be applied: %w", status, via, err)
+	}
+	return append(applied, "status "+status), nil
+}
+
+// applyIncidentStatus moves an incident to the status it was given in its ITSM tool
+func (s *ITSMSyncService) applyIncidentStatus(ctx context.Context, record itsmRecord, status domain.IncidentStatus, inbound map[string]string, actor, via string) error {
+	current := domain.IncidentStatus(record.status)
+	switch {
+	case status == domain.IncidentStatusInvestigating && current == domain.IncidentStatusOpen:
+		acknowledger := record.assignee
+		if acknowledger == "" {
+			acknowledger = actor
+		}
+		return s.changeService.AcknowledgeIncident(ctx, AcknowledgeIncidentCommand{IncidentID: record.id, Acknowledger: acknowledger})
+	case status == domain.IncidentStatusResolved && (current == domain.IncidentStatusOpen || current == domain.IncidentStatusInvestigating):
+		resolution := inbound[domain.ITSMFieldResolution]
+		if resolution == "" {
+			resolution = "Resolved in " + via
+		}
+		return s.changeService.ResolveIncident(ctx, ResolveIncidentCommand{
+			IncidentID: record.id,
+			Resolver:   actor,
+			Resolution: resolution,
+			RootCause:  inbound[domain.ITSMFieldRootCause],
+		})
+	case (status == domain.IncidentStatusOpen || status == domain.IncidentStatusInvestigating) && current == domain.IncidentStatusResolved:
+		_, err := s.changeService.ReopenIncident(ctx, ReopenIncidentCommand{IncidentID: record.id, ReopenedBy: actor, Reason: "Reopened in " + via})
+		return err
+	case status == domain.IncidentStatusClosed && current == domain.IncidentStatusResolved:
+		code := domain.IncidentClosureCode(inbound[domain.ITSMFieldClosureCode])
+		if code == "" {
+			code = domain.ClosureFixed
+		}
+		return s.changeService.CloseIncident(ctx, CloseIncidentCommand{IncidentID: record.id, Closer: actor, ClosureCode: code})
+	}
+	return fmt.Errorf("incident %s cannot move from %s to %s", record.id, current, status)
+}
+
+// applyChangeRequestStatus records the implementation or closure of a change request done in
+// its ITSM tool. Approvals are only given through the SDK.
+func (s *ITSMSyncService) applyChangeRequestStatus(ctx context.Context, record itsmRecord, status domain.ChangeRequestStatus, inbound map[string]string, actor, via string) error {
+	current := domain.ChangeRequestStatus(record.status)
+	switch {
+	case status == domain.ChangeStatusImplemented && current == domain.ChangeStatusApproved:
+		_, err := s.changeService.ImplementChangeRequest(ctx, ImplementChangeRequestCommand{
+			ChangeRequestID: record.id,
+			Implementer:     actor,
+			Notes:           "Implemented in " + via,
+		})
+		return err
+	case status == domain.ChangeStatusClosed && current == domain.ChangeStatusImplemented:
+		notes := inbound[domain.ITSMFieldResolution]
+		if notes == "" {
+			notes = "Closed in " + via
+		}
+		_, err := s.changeService.CloseChangeRequest(ctx, CloseChangeRequestCommand{
+			ChangeRequestID: record.id,
+			VerifiedBy:      actor,
+			Successful:      true,
+			Notes:           notes,
+		})
+		return err
+	}
+	return fmt.Errorf("change request %s cannot move from %s to %s outside the SDK", record.id, current, status)
+}
+
+// pushComments pushes the link's pending local comments to its ITSM tool, returning how many
+// were pushed. It stops at the first comment that cannot be pushed.
+func (s *ITSMSyncService) pushComments(ctx context.Context, link *domain.ITSMLink, now time.Time) (int, error) {
+	connector, ok := s.connectors[link.Connector]
+	if !ok {
+		return 0, fmt.Errorf("ITSM connector %s is not configured", link.Connector)
+	}
+	pushed := 0
+	for _, i := range link.PendingComments() {
+		id, err := connector.AddComment(ctx, link.Kind, link.Reference.ExternalID, link.Comments[i])
+		if err != nil {
+			return pushed, fmt.Errorf("failed to push comment to %s: %w", link.Connector, err)
+		}
+		link.Comments[i].ID = id
+		link.Comments[i].PushedAt = now
+		pushed++
+	}
+	return pushed, nil
+}
+
+// failed records the error on the link and returns it
+func (s *ITSMSyncService) failed(ctx context.Context, link domain.ITSMLink, err error) error {
+	link.LastError = err.Error()
+	if saveErr := s.saveLink(ctx, link); saveErr != nil {
+		return errors.Join(err, saveErr)
+	}
+	return err
+}
+
+func (s *ITSMSyncService) saveLink(ctx context.Context, link domain.ITSMLink) error {
+	err := s.linkRepo.Save(ctx, link)
+	if err != nil {
+		return fmt.Errorf("failed to save ITSM link: %w", err)
+	}
+	return nil
+}
+
+// record counts the outcome and lists it when the sync did anything to the record
+func (r *ITSMSyncRun) record(outcome ITSMSyncOutcome) {
+	if outcome.Created {
+		r.Created++
+	}
+	if outcome.Pushed {
+		r.Pushed++
+	}
+	r.Applied += len(outcome.Applied)
+	if outcome.Conflict != "" {
+		r.Conflicts++
+	}
+	if outcome.Error != "" {
+		r.Failed++
+	}
+	if outcome.Created || outcome.Pushed || len(outcome.Applied) > 0 || outcome.CommentsPulled > 0 ||
+		outcome.CommentsPushed > 0 || outcome.Conflict != "" || outcome.Error != "" {
+		r.Outcomes = append(r.Outcomes, outcome)
+	}
+}
+
+// Commands for ITSM Sync Service
+
+type SyncITSMCommand struct {
+	Kind          domain.ITSMRecordKind // optional, every target when empty
+	ApplicationID domain.ApplicationID  // optional, every application when empty
+	Now           time.Time             // optional, defaults to now
+}
+
+type AddITSMCommentCommand struct {
+	Kind    domain.ITSMRecordKind
+	LocalID string
+	Author  string
+	Body    string
+}
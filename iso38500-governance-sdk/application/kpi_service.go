@@ -0,0 +1,275 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// KPIService defines KPIs and records their measurements, which MonitorGovernance reports
+// against the KPIs linked to an agreement's objectives
+type KPIService struct {
+	instrumentation
+
+	kpiRepo         domain.KPIRepository
+	measurementRepo domain.KPIMeasurementRepository
+	eventRepo       domain.DomainEventRepository
+}
+
+// NewKPIService creates a new KPI service
+func NewKPIService(
+	kpiRepo domain.KPIRepository,
+	measurementRepo domain.KPIMeasurementRepository,
+	eventRepo domain.DomainEventRepository,
+	opts ...ServiceOption,
+) *KPIService {
+	return &KPIService{
+		kpiRepo:         kpiRepo,
+		measurementRepo: measurementRepo,
+		eventRepo:       eventRepo,
+		instrumentation: newInstrumentation(opts),
+	}
+}
+
+// DefineKPI adds a KPI or redefines an existing one, e.g. to change its target. Measurements
+// already recorded keep the target they were measured against.
+func (s *KPIService) DefineKPI(ctx context.Context, cmd DefineKPICommand) (*domain.KPI, error) {
+	ctx, span := s.startSpan(ctx, "KPIService.DefineKPI")
+	defer span.End()
+
+	kpi := domain.KPI{
+		ID:          cmd.ID,
+		Name:        cmd.Name,
+		Description: cmd.Description,
+		Target:      cmd.Target,
+		Unit:        cmd.Unit,
+		Category:    cmd.Category,
+		Frequency:   cmd.Frequency,
+		Status:      domain.KPIStatusNotMeasured,
+	}
+	if err := kpi.Validate(); err != nil {
+		return nil, err
+	}
+
+	exists, err := s.kpiRepo.Exists(ctx, kpi.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check KPI: %w", err)
+	}
+	if exists {
+		existing, err := s.kpiRepo.FindByID(ctx, kpi.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find KPI: %w", err)
+		}
+		kpi.Status = existing.Status
+		err = s.kpiRepo.Update(ctx, kpi)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update KPI: %w", err)
+		}
+	} else {
+		err = s.kpiRepo.Save(ctx, kpi)
+		if err != nil {
+			return nil, fmt.Errorf("failed to save KPI: %w", err)
+		}
+	}
+
+	// Publish domain event
+	event := domain.KPIDefinedEvent{
+		KPIID:      kpi.ID,
+		Name:       kpi.Name,
+		Target:     kpi.Target,
+		Unit:       kpi.Unit,
+		Redefined:  exists,
+		DefinedBy:  cmd.DefinedBy,
+		OccurredAt: time.Now(),
+	}
+	err = s.eventRepo.Save(ctx, event)
+	if err != nil {
+		fmt.Printf("Failed to save domain event: %v\n", err)
+	}
+
+	return &kpi, nil
+}
+
+// RecordKPIMeasurement records a measurement of a defined KPI against its current target. The
+// KPI's status follows its latest measurement: on track when the target is achieved, otherwise
+// off track.
+func (s *KPIService) RecordKPIMeasurement(ctx context.Context, cmd RecordKPIMeasurementCommand) (*domain.KPIMeasurement, error) {
+	ctx, span := s.startSpan(ctx, "KPIService.RecordKPIMeasurement")
+	defer span.End()
+
+	kpi, err := s.kpiRepo.FindByID(ctx, cmd.KPIID)
+	if err != nil {
+		return nil, fmt.Errorf("KPI not found: %w", err)
+	}
+
+	now := time.Now()
+	measurement := domain.KPIMeasurement{
+		KPIID:      kpi.ID,
+		Value:      cmd.Value,
+		Target:     kpi.Target,
+		Achieved:   kpi.TargetAchieved(cmd.Value, 0),
+		MeasuredAt: cmd.MeasuredAt,
+		Notes:      cmd.Notes,
+	}
+	if measurement.MeasuredAt.IsZero() {
+		measurement.MeasuredAt = now
+	}
+	if err := measurement.Validate(); err != nil {
+		return nil, err
+	}
+
+	err = s.measurementRepo.Save(ctx, measurement)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save KPI measurement: %w", err)
+	}
+
+	latest, err := s.measurementRepo.FindLatest(ctx, kpi.ID)
+	if err == nil && latest.MeasuredAt.Equal(measurement.MeasuredAt) {
+		kpi.Status = domain.KPIStatusOffTrack
+		if measurement.Achieved {
+			kpi.Status = domain.KPIStatusOnTrack
+		}
+		err = s.kpiRepo.Update(ctx, kpi)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update KPI status: %w", err)
+		}
+	}
+
+	// Publish domain event
+	event := domain.KPIMeasurementRecordedEvent{
+		KPIID:      measurement.KPIID,
+		Value:      measurement.Value,
+		Target:     measurement.Target,
+		Achieved:   measurement.Achieved,
+		MeasuredAt: measurement.MeasuredAt,
+		RecordedBy: cmd.RecordedBy,
+		OccurredAt: now,
+	}
+	err = s.eventRepo.Save(ctx, event)
+	if err != nil {
+		fmt.Printf("Failed to save domain event: %v\n", err)
+	}
+
+	return &measurement, nil
+}
+
+// GetKPI returns a KPI definition
+func (s *KPIService) GetKPI(ctx context.Context, kpiID string) (*domain.KPI, error) {
+	ctx, span := s.startSpan(ctx, "KPIService.GetKPI")
+	defer span.End()
+
+	kpi, err := s.kpiRepo.FindByID(ctx, kpiID)
+	if err != nil {
+		return nil, fmt.Errorf("KPI not found: %w", err)
+	}
+	return &kpi, nil
+}
+
+// GetKPIHistory returns the KPI's measurements taken between from and to as a time series
+// downsampled to the resolution, with the minimum, maximum and average of each period. A zero
+// from starts at the first measurement and a zero to ends now.
+func (s *KPIService) GetKPIHistory(ctx context.Context, kpiID string, from, to time.Time, resolution domain.KPIResolution) (*domain.KPIHistory, error) {
+	ctx, span := s.startSpan(ctx, "KPIService.GetKPIHistory")
+	defer span.End()
+
+	if to.IsZero() {
+		to = time.Now()
+	}
+	measurements, err := s.measurementRepo.FindByPeriod(ctx, kpiID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find KPI measurements: %w", err)
+	}
+
+	// Measurements outlive definitions kept elsewhere, so a KPI with measurements is charted
+	// even when it is no longer defined
+	kpi, err := s.kpiRepo.FindByID(ctx, kpiID)
+	if err != nil {
+		if len(measurements) == 0 {
+			return nil, fmt.Errorf("KPI not found: %w", err)
+		}
+		kpi = domain.KPI{ID: kpiID, Target: measurements[len(measurements)-1].Target}
+	}
+
+	history, err := domain.BuildKPIHistory(kpi, measurements, from, to, resolution)
+	if err != nil {
+		return nil, err
+	}
+	return &history, nil
+}
+
+// DetectKPIAnomalies returns the KPI's measurements taken between from and to that deviate
+// unusually from the measurements before them, by z-score or EWMA, with the default detection
+// settings. Earlier measurements still form the baseline. A zero to ends now.
+func (s *KPIService) DetectKPIAnomalies(ctx context.Context, kpiID string, from, to time.Time) ([]domain.KPIAnomaly, error) {
+	ctx, span := s.startSpan(ctx, "KPIService.DetectKPIAnomalies")
+	defer span.End()
+
+	if to.IsZero() {
+		to = time.Now()
+	}
+	measurements, err := s.measurementRepo.FindByPeriod(ctx, kpiID, time.Time{}, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find KPI measurements: %w", err)
+	}
+
+	kpi, err := s.kpiRepo.FindByID(ctx, kpiID)
+	if err != nil {
+		if len(measurements) == 0 {
+			return nil, fmt.Errorf("KPI not found: %w", err)
+		}
+		kpi = domain.KPI{ID: kpiID, Target: measurements[len(measurements)-1].Target}
+	}
+
+	detected, err := domain.DetectKPIAnomalies(kpi, measurements, domain.DefaultAnomalyDetection(), 0)
+	if err != nil {
+		return nil, err
+	}
+	anomalies := []domain.KPIAnomaly{}
+	for _, anomaly := range detected {
+		if !anomaly.MeasuredAt.Before(from) {
+			anomalies = append(anomalies, anomaly)
+		}
+	}
+	return anomalies, nil
+}
+
+// ListKPIs returns the KPIs of a category or, without one, every KPI
+func (s *KPIService) ListKPIs(ctx context.Context, category string) ([]domain.KPI, error) {
+	ctx, span := s.startSpan(ctx, "KPIService.ListKPIs")
+	defer span.End()
+
+	var kpis []domain.KPI
+	var err error
+	if category != "" {
+		kpis, err = s.kpiRepo.FindByCategory(ctx, category)
+	} else {
+		kpis, err = s.kpiRepo.FindAll(ctx)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find KPIs: %w", err)
+	}
+	return kpis, nil
+}
+
+// Commands for KPI Service
+
+type DefineKPICommand struct {
+	ID          string
+	Name        string
+	Description string
+	Target      float64
+	Unit        string
+	Category    string
+	Frequency   string // daily, weekly, monthly, quarterly
+	DefinedBy   string
+}
+
+type RecordKPIMeasurementCommand struct {
+	KPIID      string
+	Value      float64
+	MeasuredAt time.Time // optional, defaults to now
+	Notes      string
+	RecordedBy string
+}
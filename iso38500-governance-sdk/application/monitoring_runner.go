@@ -0,0 +1,186 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// maxBackoffIntervals caps how many intervals the monitoring runner waits after repeated failures
+const maxBackoffIntervals = 32
+
+// MonitoringRunner monitors every active governance agreement on the cadence of its compliance
+// monitoring frequency. Every run is recorded in the run history, and every successful run
+// publishes a GovernanceMonitoringCompletedEvent.
+type MonitoringRunner struct {
+	instrumentation
+
+	governanceService *GovernanceService
+	agreementRepo     domain.GovernanceAgreementRepository
+	runRepo           domain.MonitoringRunRepository
+	eventRepo         domain.DomainEventRepository
+	now               func() time.Time
+}
+
+// NewMonitoringRunner creates a new monitoring runner
+func NewMonitoringRunner(
+	governanceService *GovernanceService,
+	agreementRepo domain.GovernanceAgreementRepository,
+	runRepo domain.MonitoringRunRepository,
+	eventRepo domain.DomainEventRepository,
+	opts ...ServiceOption,
+) *MonitoringRunner {
+	return &MonitoringRunner{
+		governanceService: governanceService,
+		agreementRepo:     agreementRepo,
+		runRepo:           runRepo,
+		eventRepo:         eventRepo,
+		now:               time.Now,
+		instrumentation:   newInstrumentation(opts),
+	}
+}
+
+// RunDue monitors every active agreement whose monitoring is due at now. A failed run is recorded
+// with its error and does not stop other runs; its agreement is still due on the next call.
+func (r *MonitoringRunner) RunDue(ctx context.Context, now time.Time) ([]domain.MonitoringRun, error) {
+	ctx, span := r.startSpan(ctx, "MonitoringRunner.RunDue")
+	defer span.End()
+
+	agreements, err := r.agreementRepo.FindByStatus(ctx, domain.AgreementActive)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find active governance agreements: %w", err)
+	}
+
+	var runs []domain.MonitoringRun
+	for _, agreement := range agreements {
+		if !domain.MonitoringDue(agreement, now) {
+			continue
+		}
+
+		run := r.monitor(ctx, agreement, now)
+		err = r.runRepo.Save(ctx, run)
+		if err != nil {
+			return runs, fmt.Errorf("failed to save monitoring run: %w", err)
+		}
+		runs = append(runs, run)
+	}
+
+	return runs, nil
+}
+
+// ListRuns returns the monitoring runs of an agreement, oldest first
+func (r *MonitoringRunner) ListRuns(ctx context.Context, agreementID domain.GovernanceAgreementID) ([]domain.MonitoringRun, error) {
+	ctx, span := r.startSpan(ctx, "MonitoringRunner.ListRuns", domain.AgreementAttribute(agreementID))
+	defer span.End()
+
+	runs, err := r.runRepo.FindByAgreementID(ctx, agreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find monitoring runs: %w", err)
+	}
+
+	return runs, nil
+}
+
+// Start runs due monitoring every interval until the context is cancelled. Failures are passed
+// to onError when it is not nil. After a round with a failure the runner backs off, doubling its
+// wait up to 32 intervals, and returns to the interval once a round succeeds.
+func (r *MonitoringRunner) Start(ctx context.Context, interval time.Duration, onError func(error)) {
+	delay := interval
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	report := func(err error) {
+		if onError != nil {
+			onError(err)
+		}
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			failed := false
+			runs, err := r.RunDue(ctx, r.now())
+			if err != nil {
+				report(err)
+				failed = true
+			}
+			for _, run := range runs {
+				if !run.Succeeded() {
+					report(fmt.Errorf("monitoring of %s failed: %s", run.AgreementID, run.Err))
+					failed = true
+				}
+			}
+
+			if failed {
+				delay = backoff(delay, interval)
+			} else {
+				delay = interval
+			}
+			timer.Reset(delay)
+		}
+	}
+}
+
+// backoff doubles the delay after a failure, up to maxBackoffIntervals intervals
+func backoff(delay, interval time.Duration) time.Duration {
+	delay *= 2
+	if limit := interval * maxBackoffIntervals; delay > limit {
+		return limit
+	}
+	return delay
+}
+
+// monitor runs governance monitoring for an agreement, summarizes the result as a run and
+// publishes its completion
+func (r *MonitoringRunner) monitor(ctx context.Context, agreement domain.GovernanceAgreement, now time.Time) domain.MonitoringRun {
+	run := domain.MonitoringRun{
+		ID:            fmt.Sprintf("%s-%s", agreement.ID, now.UTC().Format("20060102T150405Z")),
+		AgreementID:   agreement.ID,
+		ApplicationID: agreement.ApplicationID,
+		RanAt:         now,
+	}
+
+	result, err := r.governanceService.MonitorGovernance(ctx, MonitorGovernanceCommand{AgreementID: agreement.ID})
+	if err != nil {
+		run.Err = err.Error()
+		return run
+	}
+
+	measurements := make([]string, 0, len(result.KPIMeasurements))
+	for _, kpi := range result.KPIMeasurements {
+		run.KPIsMeasured++
+		if kpi.Achieved {
+			run.KPIsAchieved++
+		}
+		measurements = append(measurements, fmt.Sprintf("%s: %.1f/%.1f", kpi.KPIID, kpi.Value, kpi.Target))
+	}
+	for _, indicator := range result.RiskStatus.RiskIndicators {
+		run.RiskIndicators++
+		if indicator.Status != domain.RiskStatusNormal {
+			run.RisksOverThreshold++
+		}
+	}
+	snapshot := result.snapshot(agreement.ID, now)
+	run.RequirementGaps = snapshot.RequirementGaps
+	run.ComplianceViolations = snapshot.ComplianceViolations
+	run.ActiveAlerts = snapshot.ActiveAlerts
+	run.KPIAnomalies = snapshot.KPIAnomalies
+
+	event := domain.GovernanceMonitoringCompletedEvent{
+		AgreementID:      agreement.ID,
+		Monitor:          "monitoring-runner",
+		KPIMeasurements:  measurements,
+		ComplianceStatus: fmt.Sprintf("%d requirement gaps, %d violations", run.RequirementGaps, run.ComplianceViolations),
+		RiskStatus:       fmt.Sprintf("%d of %d risk indicators over threshold", run.RisksOverThreshold, run.RiskIndicators),
+		OccurredAt:       now,
+	}
+	err = r.eventRepo.Save(ctx, event)
+	if err != nil {
+		fmt.Printf("Failed to save domain event: %v\n", err)
+	}
+
+	return run
+}
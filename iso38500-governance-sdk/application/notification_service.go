@@ -0,0 +1,514 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// NotificationService tells people about governance work that needs them: alerts raised and
+// resolved on their agreements, approvals waiting on them, audits coming due, and certifications
+// and contracts about to expire. It remembers
+// what it has sent so each is notified once, and again only when its severity changes or a
+// reminder is due.
+type NotificationService struct {
+	instrumentation
+
+	governanceService *GovernanceService
+	agreementRepo     domain.GovernanceAgreementRepository
+	portfolioRepo     domain.ApplicationPortfolioRepository
+	changeRequestRepo domain.ChangeRequestRepository // nil without change management
+	notifier          domain.Notifier
+	now               func() time.Time
+
+	mu   sync.Mutex
+	sent map[string]sentNotification // by notification ID
+}
+
+// sentNotification is the last notification sent about something and when
+type sentNotification struct {
+	notification domain.Notification
+	at           time.Time
+}
+
+// NewNotificationService creates a new notification service. The change request repository may
+// be nil, in which case change request approvals are not notified.
+func NewNotificationService(
+	governanceService *GovernanceService,
+	agreementRepo domain.GovernanceAgreementRepository,
+	portfolioRepo domain.ApplicationPortfolioRepository,
+	changeRequestRepo domain.ChangeRequestRepository,
+	notifier domain.Notifier,
+	opts ...ServiceOption,
+) *NotificationService {
+	return &NotificationService{
+		governanceService: governanceService,
+		agreementRepo:     agreementRepo,
+		portfolioRepo:     portfolioRepo,
+		changeRequestRepo: changeRequestRepo,
+		notifier:          notifier,
+		now:               time.Now,
+		sent:              make(map[string]sentNotification),
+		instrumentation:   newInstrumentation(opts),
+	}
+}
+
+// NotificationRun is the outcome of one round of notifications
+type NotificationRun struct {
+	Sent    []domain.Notification
+	Skipped int // already sent and no reminder due
+	Failed  int
+	RanAt   time.Time
+}
+
+// NotifyDue sends the notifications for alerts, pending approvals, audits due and expiring
+// certifications and contracts. A failed
+// delivery does not stop the others; their errors are joined.
+func (s *NotificationService) NotifyDue(ctx context.Context, cmd NotifyDueCommand) (*NotificationRun, error) {
+	ctx, span := s.startSpan(ctx, "NotificationService.NotifyDue")
+	defer span.End()
+
+	cmd = s.defaults(cmd)
+	run := &NotificationRun{RanAt: cmd.Now}
+	var errs []error
+	for _, notify := range []func(context.Context, NotifyDueCommand, *NotificationRun) error{
+		s.notifyAlerts, s.notifyPendingApprovals, s.notifyAuditsDue, s.notifyExpiringRequirements,
+	} {
+		if err := notify(ctx, cmd, run); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return run, errors.Join(errs...)
+}
+
+// NotifyAlerts notifies the active alerts of every agreement and the alerts resolved since they
+// were notified. With EvaluateAlerts the alert thresholds are checked first.
+func (s *NotificationService) NotifyAlerts(ctx context.Context, cmd NotifyDueCommand) (*NotificationRun, error) {
+	ctx, span := s.startSpan(ctx, "NotificationService.NotifyAlerts")
+	defer span.End()
+
+	cmd = s.defaults(cmd)
+	run := &NotificationRun{RanAt: cmd.Now}
+	return run, s.notifyAlerts(ctx, cmd, run)
+}
+
+// NotifyPendingApprovals notifies the approvers of submitted change requests, submitted policies
+// and strategic directions awaiting ratification
+func (s *NotificationService) NotifyPendingApprovals(ctx context.Context, cmd NotifyDueCommand) (*NotificationRun, error) {
+	ctx, span := s.startSpan(ctx, "NotificationService.NotifyPendingApprovals")
+	defer span.End()
+
+	cmd = s.defaults(cmd)
+	run := &NotificationRun{RanAt: cmd.Now}
+	return run, s.notifyPendingApprovals(ctx, cmd, run)
+}
+
+// NotifyAuditsDue notifies whoever is responsible for a required audit due within the audit
+// window, or overdue
+func (s *NotificationService) NotifyAuditsDue(ctx context.Context, cmd NotifyDueCommand) (*NotificationRun, error) {
+	ctx, span := s.startSpan(ctx, "NotificationService.NotifyAuditsDue")
+	defer span.End()
+
+	cmd = s.defaults(cmd)
+	run := &NotificationRun{RanAt: cmd.Now}
+	return run, s.notifyAuditsDue(ctx, cmd, run)
+}
+
+// NotifyExpiringRequirements notifies the compliance monitoring's responsible parties of each
+// certification and contract once for every warning lead time it reaches, and once it expires
+func (s *NotificationService) NotifyExpiringRequirements(ctx context.Context, cmd NotifyDueCommand) (*NotificationRun, error) {
+	ctx, span := s.startSpan(ctx, "NotificationService.NotifyExpiringRequirements")
+	defer span.End()
+
+	cmd = s.defaults(cmd)
+	run := &NotificationRun{RanAt: cmd.Now}
+	return run, s.notifyExpiringRequirements(ctx, cmd, run)
+}
+
+// Start sends due notifications every interval until the context is cancelled. Failures are
+// passed to onError when it is not nil.
+func (s *NotificationService) Start(ctx context.Context, interval time.Duration, cmd NotifyDueCommand, onError func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			run := cmd
+			run.Now = s.now()
+			if _, err := s.NotifyDue(ctx, run); err != nil && onError != nil {
+				onError(err)
+			}
+		}
+	}
+}
+
+// defaults fills in the time, audit window and expiry lead times of a command
+func (s *NotificationService) defaults(cmd NotifyDueCommand) NotifyDueCommand {
+	if cmd.Now.IsZero() {
+		cmd.Now = s.now()
+	}
+	if cmd.AuditWindow == 0 {
+		cmd.AuditWindow = 14 * 24 * time.Hour
+	}
+	if len(cmd.ExpiryLeadDays) == 0 {
+		cmd.ExpiryLeadDays = domain.DefaultExpiryWarningPolicy().LeadDays
+	}
+	return cmd
+}
+
+func (s *NotificationService) notifyAlerts(ctx context.Context, cmd NotifyDueCommand, run *NotificationRun) error {
+	agreements, err := s.agreementRepo.FindAll(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list governance agreements: %w", err)
+	}
+	portfolios, err := s.portfoliosByApplication(ctx)
+	if err != nil {
+		return err
+	}
+
+	var notifications []domain.Notification
+	for _, agreement := range agreements {
+		active := agreement.Monitor.ActiveAlerts
+		if cmd.EvaluateAlerts {
+			evaluation, err := s.governanceService.EvaluateAlerts(ctx, EvaluateAlertsCommand{AgreementID: agreement.ID})
+			if err != nil {
+				return fmt.Errorf("failed to evaluate alerts of %s: %w", agreement.ID, err)
+			}
+			active = evaluation.Active
+		}
+		for _, alert := range active {
+			notifications = append(notifications, alertNotification(agreement, alert, portfolios[agreement.ApplicationID], cmd.Now))
+		}
+	}
+
+	resolved, err := s.deliver(ctx, "alert/", notifications, cmd, run)
+	errs := []error{err}
+	for _, previous := range resolved {
+		notification := previous
+		notification.Kind = domain.NotificationAlertResolved
+		notification.Severity = domain.NotificationInfo
+		notification.Title = strings.Replace(previous.Title, "alert", "alert resolved", 1)
+		notification.Message = "No alert threshold is breached any more"
+		notification.CreatedAt = cmd.Now
+		if err := s.send(ctx, notification, run); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// alertNotification addresses an active alert to its recipients, and to its escalation when
+// critical
+func alertNotification(agreement domain.GovernanceAgreement, alert domain.ActiveAlert, portfolios []domain.PortfolioID, now time.Time) domain.Notification {
+	notification := domain.Notification{
+		ID:            "alert/" + alert.ID,
+		Kind:          domain.NotificationAlert,
+		Severity:      domain.NotificationSeverity(alert.Severity),
+		Title:         fmt.Sprintf("%s alert on %s %s", alert.Severity, alert.Source, alert.Subject),
+		AgreementID:   agreement.ID,
+		ApplicationID: agreement.ApplicationID,
+		Portfolios:    portfolios,
+		CreatedAt:     now,
+	}
+	messages := []string{fmt.Sprintf("%s is %g, %s", alert.Subject, alert.Value, alert.Threshold)}
+	for _, notify := range alert.Notify {
+		if notify.Recipient != "" {
+			notification.Recipients = append(notification.Recipients, notify.Recipient)
+		}
+		if alert.Severity == domain.AlertCritical && notify.Escalation != "" {
+			notification.Recipients = append(notification.Recipients, notify.Escalation)
+		}
+		if notify.Message != "" {
+			messages = append(messages, notify.Message)
+		}
+	}
+	notification.Message = strings.Join(messages, "\n")
+	return notification
+}
+
+func (s *NotificationService) notifyPendingApprovals(ctx context.Context, cmd NotifyDueCommand, run *NotificationRun) error {
+	agreements, err := s.agreementRepo.FindAll(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list governance agreements: %w", err)
+	}
+	portfolios, err := s.portfoliosByApplication(ctx)
+	if err != nil {
+		return err
+	}
+
+	var notifications []domain.Notification
+	for _, agreement := range agreements {
+		for _, policy := range agreement.Direct.PolicyFramework.Policies {
+			if policy.Status != domain.PolicySubmitted {
+				continue
+			}
+			notifications = append(notifications, domain.Notification{
+				ID:            fmt.Sprintf("approval/policy/%s/%s", agreement.ID, policy.ID),
+				Kind:          domain.NotificationApprovalPending,
+				Severity:      domain.NotificationInfo,
+				Title:         fmt.Sprintf("Policy %s awaits approval", policy.Name),
+				Message:       fmt.Sprintf("Submitted by %s on %s", policy.SubmittedBy, policy.SubmittedAt.Format("2006-01-02")),
+				AgreementID:   agreement.ID,
+				ApplicationID: agreement.ApplicationID,
+				Portfolios:    portfolios[agreement.ApplicationID],
+				Roles:         []string{domain.RolePolicyApprover},
+				CreatedAt:     cmd.Now,
+			})
+		}
+
+		for _, proposal := range agreement.Direct.DirectionProposals {
+			if proposal.Status != domain.DirectionReviewed {
+				continue
+			}
+			notification := domain.Notification{
+				ID:            fmt.Sprintf("approval/direction/%s/%s", agreement.ID, proposal.ID),
+				Kind:          domain.NotificationApprovalPending,
+				Severity:      domain.NotificationInfo,
+				Title:         fmt.Sprintf("Strategic direction %s awaits approval", proposal.ID),
+				Message:       fmt.Sprintf("Proposed by %s and reviewed by %s", proposal.ProposedBy, proposal.ReviewedBy),
+				AgreementID:   agreement.ID,
+				ApplicationID: agreement.ApplicationID,
+				Portfolios:    portfolios[agreement.ApplicationID],
+				CreatedAt:     cmd.Now,
+			}
+			if proposal.GoverningBody != "" {
+				notification.Roles = []string{proposal.GoverningBody}
+			}
+			notifications = append(notifications, notification)
+		}
+	}
+
+	if s.changeRequestRepo != nil {
+		var changes []domain.ChangeRequest
+		for _, status := range []domain.ChangeRequestStatus{domain.ChangeStatusSubmitted, domain.ChangeStatusPendingApproval} {
+			found, err := s.changeRequestRepo.FindByStatus(ctx, status)
+			if err != nil {
+				return fmt.Errorf("failed to find %s change requests: %w", status, err)
+			}
+			changes = append(changes, found...)
+		}
+		for _, change := range changes {
+			severity := domain.NotificationInfo
+			if change.Priority == domain.PriorityCritical || change.Priority == domain.PriorityHigh {
+				severity = domain.NotificationWarning
+			}
+			notification := domain.Notification{
+				ID:            "approval/change_request/" + change.ID,
+				Kind:          domain.NotificationApprovalPending,
+				Severity:      severity,
+				Title:         fmt.Sprintf("Change request %s awaits approval", change.Title),
+				Message:       fmt.Sprintf("%s priority %s change requested by %s", change.Priority, change.Type, change.Requester),
+				ApplicationID: change.ApplicationID,
+				Portfolios:    portfolios[change.ApplicationID],
+				CreatedAt:     cmd.Now,
+			}
+			for _, approval := range change.Approvals {
+				if approval.Status != domain.ApprovalPending {
+					continue
+				}
+				if approval.Role != "" {
+					notification.Roles = append(notification.Roles, approval.Role)
+				}
+				if approval.Approver != "" {
+					notification.Recipients = append(notification.Recipients, approval.Approver)
+				}
+			}
+			if len(notification.Roles) == 0 {
+				notification.Roles = []string{domain.RoleChangeApprover}
+			}
+			notifications = append(notifications, notification)
+		}
+	}
+
+	_, err = s.deliver(ctx, "approval/", notifications, cmd, run)
+	return err
+}
+
+func (s *NotificationService) notifyAuditsDue(ctx context.Context, cmd NotifyDueCommand, run *NotificationRun) error {
+	agreements, err := s.agreementRepo.FindAll(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list governance agreements: %w", err)
+	}
+	portfolios, err := s.portfoliosByApplication(ctx)
+	if err != nil {
+		return err
+	}
+
+	var notifications []domain.Notification
+	for _, agreement := range agreements {
+		requirements := append(append([]domain.AuditRequirement{}, agreement.Conformance.ComplianceMonitoring.AuditRequirements...),
+			agreement.Monitor.ComplianceMonitoring.AuditRequirements...)
+		for _, requirement := range requirements {
+			if requirement.NextAudit.IsZero() || requirement.NextAudit.After(cmd.Now.Add(cmd.AuditWindow)) {
+				continue
+			}
+			notification := domain.Notification{
+				ID:            fmt.Sprintf("audit/%s/%s", agreement.ID, requirement.Name),
+				Kind:          domain.NotificationAuditDue,
+				Severity:      domain.NotificationInfo,
+				Title:         fmt.Sprintf("%s is due on %s", requirement.Name, requirement.NextAudit.Format("2006-01-02")),
+				Message:       requirement.Description,
+				AgreementID:   agreement.ID,
+				ApplicationID: agreement.ApplicationID,
+				Portfolios:    portfolios[agreement.ApplicationID],
+				Roles:         []string{domain.RoleAuditor},
+				DueAt:         requirement.NextAudit,
+				CreatedAt:     cmd.Now,
+			}
+			if requirement.NextAudit.Before(cmd.Now) {
+				notification.Severity = domain.NotificationWarning
+				notification.Title = fmt.Sprintf("%s is overdue since %s", requirement.Name, requirement.NextAudit.Format("2006-01-02"))
+			}
+			if requirement.Responsible != "" {
+				notification.Recipients = []string{requirement.Responsible}
+			}
+			notifications = append(notifications, notification)
+		}
+	}
+
+	_, err = s.deliver(ctx, "audit/", notifications, cmd, run)
+	return err
+}
+
+func (s *NotificationService) notifyExpiringRequirements(ctx context.Context, cmd NotifyDueCommand, run *NotificationRun) error {
+	policy := domain.ExpiryWarningPolicy{LeadDays: cmd.ExpiryLeadDays}
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+	shortest := 0
+	for _, days := range policy.LeadDays {
+		if shortest == 0 || days < shortest {
+			shortest = days
+		}
+	}
+
+	agreements, err := s.agreementRepo.FindAll(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list governance agreements: %w", err)
+	}
+	portfolios, err := s.portfoliosByApplication(ctx)
+	if err != nil {
+		return err
+	}
+
+	var notifications []domain.Notification
+	for _, agreement := range agreements {
+		for _, expiry := range domain.TrackRequirementExpiry(agreement.Conformance, policy, cmd.Now) {
+			if expiry.State == domain.ExpiryValid {
+				continue
+			}
+			// Each lead time reached is a notification of its own, so crossing the next one sends
+			// again whatever its severity
+			notification := domain.Notification{
+				ID:            fmt.Sprintf("expiry/%s/%s/%s/%dd", agreement.ID, expiry.Requirement.Kind, expiry.Requirement.Name, expiry.LeadDays),
+				Kind:          domain.NotificationExpiry,
+				Severity:      domain.NotificationInfo,
+				Title:         fmt.Sprintf("%s expires in %d days on %s", expiry.Requirement.Name, expiry.DaysToExpiry, expiry.ExpiresAt.Format("2006-01-02")),
+				Message:       fmt.Sprintf("%s with %s, currently %s", expiry.Requirement.Kind, expiry.Party, expiry.Status),
+				AgreementID:   agreement.ID,
+				ApplicationID: agreement.ApplicationID,
+				Portfolios:    portfolios[agreement.ApplicationID],
+				Recipients:    agreement.Conformance.ComplianceMonitoring.ResponsibleParties,
+				Roles:         []string{domain.RoleCompliance},
+				DueAt:         expiry.ExpiresAt,
+				CreatedAt:     cmd.Now,
+			}
+			switch {
+			case expiry.State == domain.ExpiryExpired:
+				notification.ID = fmt.Sprintf("expiry/%s/%s/%s/expired", agreement.ID, expiry.Requirement.Kind, expiry.Requirement.Name)
+				notification.Severity = domain.NotificationCritical
+				notification.Title = fmt.Sprintf("%s expired on %s", expiry.Requirement.Name, expiry.ExpiresAt.Format("2006-01-02"))
+			case expiry.LeadDays == shortest:
+				notification.Severity = domain.NotificationWarning
+			}
+			notifications = append(notifications, notification)
+		}
+	}
+
+	_, err = s.deliver(ctx, "expiry/", notifications, cmd, run)
+	return err
+}
+
+// deliver sends the notifications that have not been sent at their severity, or whose reminder
+// is due, and forgets what was sent under the prefix that is no longer pending. The forgotten
+// notifications are returned with the delivery errors.
+func (s *NotificationService) deliver(ctx context.Context, prefix string, notifications []domain.Notification, cmd NotifyDueCommand, run *NotificationRun) ([]domain.Notification, error) {
+	pending := make(map[string]bool, len(notifications))
+	var errs []error
+	for _, notification := range notifications {
+		pending[notification.ID] = true
+
+		s.mu.Lock()
+		previous, sent := s.sent[notification.ID]
+		s.mu.Unlock()
+		if sent && previous.notification.Severity == notification.Severity &&
+			(cmd.RemindAfter <= 0 || cmd.Now.Sub(previous.at) < cmd.RemindAfter) {
+			run.Skipped++
+			continue
+		}
+
+		if err := s.send(ctx, notification, run); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		s.mu.Lock()
+		s.sent[notification.ID] = sentNotification{notification: notification, at: cmd.Now}
+		s.mu.Unlock()
+	}
+
+	var gone []domain.Notification
+	s.mu.Lock()
+	for id, sent := range s.sent {
+		if strings.HasPrefix(id, prefix) && !pending[id] {
+			gone = append(gone, sent.notification)
+			delete(s.sent, id)
+		}
+	}
+	s.mu.Unlock()
+
+	return gone, errors.Join(errs...)
+}
+
+// send delivers one notification and records it in the run
+func (s *NotificationService) send(ctx context.Context, notification domain.Notification, run *NotificationRun) error {
+	if err := s.notifier.Notify(ctx, notification); err != nil {
+		run.Failed++
+		return fmt.Errorf("failed to send notification %s: %w", notification.ID, err)
+	}
+	run.Sent = append(run.Sent, notification)
+	return nil
+}
+
+// portfoliosByApplication returns the portfolios holding each application
+func (s *NotificationService) portfoliosByApplication(ctx context.Context) (map[domain.ApplicationID][]domain.PortfolioID, error) {
+	portfolios, err := s.portfolioRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list portfolios: %w", err)
+	}
+
+	byApplication := make(map[domain.ApplicationID][]domain.PortfolioID)
+	for _, portfolio := range portfolios {
+		for _, app := range portfolio.Applications {
+			byApplication[app.ID] = append(byApplication[app.ID], portfolio.ID)
+		}
+	}
+	return byApplication, nil
+}
+
+// Commands for Notification Service
+
+type NotifyDueCommand struct {
+	Now            time.Time     // optional, defaults to now
+	AuditWindow    time.Duration // how far ahead audits are notified, defaults to 14 days
+	RemindAfter    time.Duration // resend notifications still pending after this long, never when zero
+	EvaluateAlerts bool          // check alert thresholds before notifying alerts
+	ExpiryLeadDays []int         // days before a certification or contract expires it is notified, defaults to 90, 30 and 7
+}
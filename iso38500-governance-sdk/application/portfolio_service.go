@@ -10,6 +10,8 @@ import (
 
 // PortfolioService provides application services for portfolio management
 type PortfolioService struct {
+	instrumentation
+
 	portfolioRepo domain.ApplicationPortfolioRepository
 	appRepo       domain.ApplicationRepository
 	agreementRepo domain.GovernanceAgreementRepository
@@ -22,17 +24,22 @@ func NewPortfolioService(
 	appRepo domain.ApplicationRepository,
 	agreementRepo domain.GovernanceAgreementRepository,
 	eventRepo domain.DomainEventRepository,
+	opts ...ServiceOption,
 ) *PortfolioService {
 	return &PortfolioService{
-		portfolioRepo: portfolioRepo,
-		appRepo:       appRepo,
-		agreementRepo: agreementRepo,
-		eventRepo:     eventRepo,
+		portfolioRepo:   portfolioRepo,
+		appRepo:         appRepo,
+		agreementRepo:   agreementRepo,
+		eventRepo:       eventRepo,
+		instrumentation: newInstrumentation(opts),
 	}
 }
 
 // CreatePortfolio creates a new application portfolio
 func (s *PortfolioService) CreatePortfolio(ctx context.Context, cmd CreatePortfolioCommand) (*domain.ApplicationPortfolio, error) {
+	ctx, span := s.startSpan(ctx, "PortfolioService.CreatePortfolio", domain.PortfolioAttribute(cmd.ID))
+	defer span.End()
+
 	// Create aggregate
 	aggregate, err := domain.NewApplicationPortfolioAggregate(
 		cmd.ID,
@@ -65,6 +72,9 @@ func (s *PortfolioService) CreatePortfolio(ctx context.Context, cmd CreatePortfo
 
 // AddApplicationToPortfolio adds an application to a portfolio
 func (s *PortfolioService) AddApplicationToPortfolio(ctx context.Context, cmd AddApplicationToPortfolioCommand) error {
+	ctx, span := s.startSpan(ctx, "PortfolioService.AddApplicationToPortfolio", domain.ApplicationAttribute(cmd.ApplicationID), domain.PortfolioAttribute(cmd.PortfolioID))
+	defer span.End()
+
 	// Verify application exists
 	app, err := s.appRepo.FindByID(ctx, cmd.ApplicationID)
 	if err != nil {
@@ -118,6 +128,9 @@ func (s *PortfolioService) AddApplicationToPortfolio(ctx context.Context, cmd Ad
 
 // RemoveApplicationFromPortfolio removes an application from a portfolio
 func (s *PortfolioService) RemoveApplicationFromPortfolio(ctx context.Context, cmd RemoveApplicationFromPortfolioCommand) error {
+	ctx, span := s.startSpan(ctx, "PortfolioService.RemoveApplicationFromPortfolio", domain.ApplicationAttribute(cmd.ApplicationID), domain.PortfolioAttribute(cmd.PortfolioID))
+	defer span.End()
+
 	// Get portfolio
 	portfolio, err := s.portfolioRepo.FindByID(ctx, cmd.PortfolioID)
 	if err != nil {
@@ -165,6 +178,9 @@ func (s *PortfolioService) RemoveApplicationFromPortfolio(ctx context.Context, c
 
 // GetPortfolio retrieves a portfolio by ID
 func (s *PortfolioService) GetPortfolio(ctx context.Context, portfolioID domain.PortfolioID) (*domain.ApplicationPortfolio, error) {
+	ctx, span := s.startSpan(ctx, "PortfolioService.GetPortfolio", domain.PortfolioAttribute(portfolioID))
+	defer span.End()
+
 	portfolio, err := s.portfolioRepo.FindByID(ctx, portfolioID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get portfolio: %w", err)
@@ -174,6 +190,9 @@ func (s *PortfolioService) GetPortfolio(ctx context.Context, portfolioID domain.
 
 // ListPortfolios retrieves all portfolios
 func (s *PortfolioService) ListPortfolios(ctx context.Context) ([]domain.ApplicationPortfolio, error) {
+	ctx, span := s.startSpan(ctx, "PortfolioService.ListPortfolios")
+	defer span.End()
+
 	portfolios, err := s.portfolioRepo.FindAll(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list portfolios: %w", err)
@@ -183,6 +202,9 @@ func (s *PortfolioService) ListPortfolios(ctx context.Context) ([]domain.Applica
 
 // ListPortfoliosByOwner retrieves portfolios by owner
 func (s *PortfolioService) ListPortfoliosByOwner(ctx context.Context, owner string) ([]domain.ApplicationPortfolio, error) {
+	ctx, span := s.startSpan(ctx, "PortfolioService.ListPortfoliosByOwner")
+	defer span.End()
+
 	portfolios, err := s.portfolioRepo.FindByOwner(ctx, owner)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list portfolios by owner: %w", err)
@@ -192,6 +214,9 @@ func (s *PortfolioService) ListPortfoliosByOwner(ctx context.Context, owner stri
 
 // UpdatePortfolio updates portfolio information
 func (s *PortfolioService) UpdatePortfolio(ctx context.Context, cmd UpdatePortfolioCommand) error {
+	ctx, span := s.startSpan(ctx, "PortfolioService.UpdatePortfolio", domain.PortfolioAttribute(cmd.ID))
+	defer span.End()
+
 	portfolio, err := s.portfolioRepo.FindByID(ctx, cmd.ID)
 	if err != nil {
 		return fmt.Errorf("portfolio not found: %w", err)
@@ -210,8 +235,178 @@ func (s *PortfolioService) UpdatePortfolio(ctx context.Context, cmd UpdatePortfo
 	return nil
 }
 
+// SetPortfolioThresholds sets the portfolio's own risk thresholds and KPI tolerance, or clears
+// them when Thresholds is nil
+func (s *PortfolioService) SetPortfolioThresholds(ctx context.Context, cmd SetPortfolioThresholdsCommand) error {
+	ctx, span := s.startSpan(ctx, "PortfolioService.SetPortfolioThresholds", domain.PortfolioAttribute(cmd.PortfolioID))
+	defer span.End()
+
+	portfolio, err := s.portfolioRepo.FindByID(ctx, cmd.PortfolioID)
+	if err != nil {
+		return fmt.Errorf("portfolio not found: %w", err)
+	}
+
+	if err := portfolio.SetThresholds(cmd.Thresholds); err != nil {
+		return err
+	}
+	portfolio.UpdatedAt = time.Now()
+
+	err = s.portfolioRepo.Save(ctx, portfolio)
+	if err != nil {
+		return fmt.Errorf("failed to update portfolio: %w", err)
+	}
+
+	return nil
+}
+
+// DefinePortfolioOKR adds or replaces a portfolio-level OKR that application OKRs cascade from
+func (s *PortfolioService) DefinePortfolioOKR(ctx context.Context, cmd DefinePortfolioOKRCommand) (*domain.OKR, error) {
+	ctx, span := s.startSpan(ctx, "PortfolioService.DefinePortfolioOKR", domain.PortfolioAttribute(cmd.PortfolioID))
+	defer span.End()
+
+	portfolio, err := s.portfolioRepo.FindByID(ctx, cmd.PortfolioID)
+	if err != nil {
+		return nil, fmt.Errorf("portfolio not found: %w", err)
+	}
+
+	okr, err := portfolio.DefineOKR(cmd.OKR)
+	if err != nil {
+		return nil, err
+	}
+	portfolio.UpdatedAt = time.Now()
+
+	err = s.portfolioRepo.Save(ctx, portfolio)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update portfolio: %w", err)
+	}
+
+	return okr, nil
+}
+
+// RecordPortfolioKeyResult records the actual value of a key result of a portfolio-level OKR
+func (s *PortfolioService) RecordPortfolioKeyResult(ctx context.Context, cmd RecordPortfolioKeyResultCommand) (*domain.OKR, error) {
+	ctx, span := s.startSpan(ctx, "PortfolioService.RecordPortfolioKeyResult", domain.PortfolioAttribute(cmd.PortfolioID))
+	defer span.End()
+
+	portfolio, err := s.portfolioRepo.FindByID(ctx, cmd.PortfolioID)
+	if err != nil {
+		return nil, fmt.Errorf("portfolio not found: %w", err)
+	}
+
+	now := time.Now()
+	okr, err := portfolio.RecordKeyResult(cmd.OKRID, cmd.KeyResultID, cmd.Actual, now)
+	if err != nil {
+		return nil, err
+	}
+	portfolio.UpdatedAt = now
+
+	err = s.portfolioRepo.Save(ctx, portfolio)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update portfolio: %w", err)
+	}
+
+	// Publish domain event
+	keyResult, _ := okr.KeyResult(cmd.KeyResultID)
+	event := domain.KeyResultRecordedEvent{
+		PortfolioID: cmd.PortfolioID,
+		OKRID:       okr.ID,
+		KeyResultID: keyResult.ID,
+		Actual:      keyResult.Actual,
+		Target:      keyResult.Target,
+		Progress:    keyResult.Progress(),
+		RecordedBy:  cmd.RecordedBy,
+		OccurredAt:  now,
+	}
+
+	err = s.eventRepo.Save(ctx, event)
+	if err != nil {
+		fmt.Printf("Failed to save domain event: %v\n", err)
+	}
+
+	return okr, nil
+}
+
+// ClassifyApplicationData records the sensitivity of the data an application holds and whether it
+// holds personal or health information
+func (s *PortfolioService) ClassifyApplicationData(ctx context.Context, cmd ClassifyApplicationDataCommand) (*domain.Application, error) {
+	ctx, span := s.startSpan(ctx, "PortfolioService.ClassifyApplicationData", domain.ApplicationAttribute(cmd.ApplicationID))
+	defer span.End()
+
+	if err := cmd.Classification.Validate(); err != nil {
+		return nil, err
+	}
+
+	app, err := s.appRepo.FindByID(ctx, cmd.ApplicationID)
+	if err != nil {
+		return nil, fmt.Errorf("application not found: %w", err)
+	}
+
+	previous := app.DataClassification
+	app.DataClassification = cmd.Classification
+	app.UpdatedAt = time.Now()
+
+	err = s.appRepo.Update(ctx, app)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update application: %w", err)
+	}
+
+	if previous != cmd.Classification {
+		event := domain.ApplicationDataClassifiedEvent{
+			ApplicationID:  app.ID,
+			Previous:       previous,
+			Classification: cmd.Classification,
+			OccurredAt:     app.UpdatedAt,
+		}
+		err = s.eventRepo.Save(ctx, event)
+		if err != nil {
+			fmt.Printf("Failed to save domain event: %v\n", err)
+		}
+	}
+
+	return &app, nil
+}
+
+// ScopeByDataClassification finds the applications of a portfolio, or of every portfolio, holding
+// the data the filter selects, such as those in scope of a privacy regulation
+func (s *PortfolioService) ScopeByDataClassification(ctx context.Context, cmd ScopeByDataClassificationCommand) (*domain.DataClassificationScope, error) {
+	ctx, span := s.startSpan(ctx, "PortfolioService.ScopeByDataClassification", domain.PortfolioAttribute(cmd.PortfolioID))
+	defer span.End()
+
+	if err := cmd.Filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	var apps []domain.Application
+	if cmd.PortfolioID != "" {
+		portfolio, err := s.portfolioRepo.FindByID(ctx, cmd.PortfolioID)
+		if err != nil {
+			return nil, fmt.Errorf("portfolio not found: %w", err)
+		}
+		// The portfolio holds copies of its applications, so the current classification is
+		// looked up where it is recorded
+		for _, app := range portfolio.Applications {
+			if found, err := s.appRepo.FindByID(ctx, app.ID); err == nil {
+				app = found
+			}
+			apps = append(apps, app)
+		}
+	} else {
+		found, err := s.appRepo.FindAll(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load applications: %w", err)
+		}
+		apps = found
+	}
+
+	scope := domain.ScopeByDataClassification(apps, cmd.Filter)
+	return &scope, nil
+}
+
 // DeletePortfolio deletes a portfolio
 func (s *PortfolioService) DeletePortfolio(ctx context.Context, portfolioID domain.PortfolioID) error {
+	ctx, span := s.startSpan(ctx, "PortfolioService.DeletePortfolio", domain.PortfolioAttribute(portfolioID))
+	defer span.End()
+
 	// Check if portfolio has applications
 	portfolio, err := s.portfolioRepo.FindByID(ctx, portfolioID)
 	if err != nil {
@@ -254,3 +449,31 @@ type UpdatePortfolioCommand struct {
 	Name        string
 	Description string
 }
+
+type SetPortfolioThresholdsCommand struct {
+	PortfolioID domain.PortfolioID
+	Thresholds  *domain.PortfolioThresholds // nil clears the portfolio's thresholds
+}
+
+type DefinePortfolioOKRCommand struct {
+	PortfolioID domain.PortfolioID
+	OKR         domain.OKR
+}
+
+type RecordPortfolioKeyResultCommand struct {
+	PortfolioID domain.PortfolioID
+	OKRID       string
+	KeyResultID string
+	Actual      float64
+	RecordedBy  string
+}
+
+type ClassifyApplicationDataCommand struct {
+	ApplicationID  domain.ApplicationID
+	Classification domain.DataClassification
+}
+
+type ScopeByDataClassificationCommand struct {
+	PortfolioID domain.PortfolioID // optional, every application when empty
+	Filter      domain.DataClassificationFilter
+}
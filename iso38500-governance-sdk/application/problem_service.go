@@ -0,0 +1,400 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// ProblemService manages the problems underlying recurring incidents: it groups incidents under
+// a problem, records the root cause once analyzed as a known error, links the change requests
+// raised to fix it and resolves it once one of them is implemented. Incidents grouped under a
+// problem point back to it, and inherit its root cause while they have none of their own.
+type ProblemService struct {
+	instrumentation
+
+	problemRepo       domain.ProblemRepository
+	incidentRepo      domain.IncidentRepository
+	changeRequestRepo domain.ChangeRequestRepository
+	appRepo           domain.ApplicationRepository
+	eventRepo         domain.DomainEventRepository
+	now               func() time.Time
+}
+
+// NewProblemService creates a new problem service
+func NewProblemService(
+	problemRepo domain.ProblemRepository,
+	incidentRepo domain.IncidentRepository,
+	changeRequestRepo domain.ChangeRequestRepository,
+	appRepo domain.ApplicationRepository,
+	eventRepo domain.DomainEventRepository,
+	opts ...ServiceOption,
+) *ProblemService {
+	return &ProblemService{
+		problemRepo:       problemRepo,
+		incidentRepo:      incidentRepo,
+		changeRequestRepo: changeRequestRepo,
+		appRepo:           appRepo,
+		eventRepo:         eventRepo,
+		now:               time.Now,
+		instrumentation:   newInstrumentation(opts),
+	}
+}
+
+// LogProblem logs a problem of an application, grouping the given incidents under it
+func (s *ProblemService) LogProblem(ctx context.Context, cmd LogProblemCommand) (*domain.Problem, error) {
+	ctx, span := s.startSpan(ctx, "ProblemService.LogProblem", domain.ApplicationAttribute(cmd.ApplicationID))
+	defer span.End()
+
+	_, err := s.appRepo.FindByID(ctx, cmd.ApplicationID)
+	if err != nil {
+		return nil, fmt.Errorf("application not found: %w", err)
+	}
+	if _, err := s.problemRepo.FindByID(ctx, cmd.ID); err == nil {
+		return nil, fmt.Errorf("problem %s already exists", cmd.ID)
+	}
+
+	now := s.now()
+	problem := domain.Problem{
+		ID:             cmd.ID,
+		ApplicationID:  cmd.ApplicationID,
+		Title:          cmd.Title,
+		Description:    cmd.Description,
+		Owner:          cmd.Owner,
+		Priority:       cmd.Priority,
+		Status:         domain.ProblemOpen,
+		Incidents:      []string{},
+		ChangeRequests: []string{},
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	if problem.Priority == "" {
+		problem.Priority = domain.PriorityMedium
+	}
+	if err := problem.Validate(); err != nil {
+		return nil, err
+	}
+	incidents, err := s.linkIncidents(ctx, &problem, cmd.IncidentIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.problemRepo.Save(ctx, problem)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save problem: %w", err)
+	}
+	if err := s.updateIncidents(ctx, problem, incidents); err != nil {
+		return nil, err
+	}
+
+	event := domain.ProblemLoggedEvent{
+		ProblemID:     problem.ID,
+		ApplicationID: problem.ApplicationID,
+		Owner:         problem.Owner,
+		Priority:      problem.Priority,
+		Incidents:     problem.Incidents,
+		OccurredAt:    now,
+	}
+	err = s.eventRepo.Save(ctx, event)
+	if err != nil {
+		fmt.Printf("Failed to save domain event: %v\n", err)
+	}
+
+	return &problem, nil
+}
+
+// LinkIncidents groups further incidents of the problem's application under it
+func (s *ProblemService) LinkIncidents(ctx context.Context, cmd LinkProblemIncidentsCommand) (*domain.Problem, error) {
+	ctx, span := s.startSpan(ctx, "ProblemService.LinkIncidents")
+	defer span.End()
+
+	problem, err := s.problemRepo.FindByID(ctx, cmd.ProblemID)
+	if err != nil {
+		return nil, fmt.Errorf("problem not found: %w", err)
+	}
+	if problem.Status == domain.ProblemClosed {
+		return nil, fmt.Errorf("problem %s is closed", problem.ID)
+	}
+	incidents, err := s.linkIncidents(ctx, &problem, cmd.IncidentIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.update(ctx, &problem); err != nil {
+		return nil, err
+	}
+	if err := s.updateIncidents(ctx, problem, incidents); err != nil {
+		return nil, err
+	}
+	return &problem, nil
+}
+
+// RecordKnownError records the root cause of a problem found by analysis, and the workaround
+// for its incidents until it is fixed
+func (s *ProblemService) RecordKnownError(ctx context.Context, cmd RecordKnownErrorCommand) (*domain.Problem, error) {
+	ctx, span := s.startSpan(ctx, "ProblemService.RecordKnownError")
+	defer span.End()
+
+	problem, err := s.problemRepo.FindByID(ctx, cmd.ProblemID)
+	if err != nil {
+		return nil, fmt.Errorf("problem not found: %w", err)
+	}
+	now := s.now()
+	err = problem.RecordKnownError(domain.KnownError{
+		RootCause:  cmd.RootCause,
+		Workaround: cmd.Workaround,
+		AnalyzedBy: cmd.AnalyzedBy,
+		RecordedAt: now,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.update(ctx, &problem); err != nil {
+		return nil, err
+	}
+	incidents, err := s.findIncidents(ctx, problem.Incidents)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.updateIncidents(ctx, problem, incidents); err != nil {
+		return nil, err
+	}
+
+	event := domain.KnownErrorRecordedEvent{
+		ProblemID:     problem.ID,
+		ApplicationID: problem.ApplicationID,
+		RootCause:     cmd.RootCause,
+		Workaround:    cmd.Workaround,
+		AnalyzedBy:    cmd.AnalyzedBy,
+		OccurredAt:    now,
+	}
+	err = s.eventRepo.Save(ctx, event)
+	if err != nil {
+		fmt.Printf("Failed to save domain event: %v\n", err)
+	}
+
+	return &problem, nil
+}
+
+// LinkChangeRequest links a change request raised to fix a problem
+func (s *ProblemService) LinkChangeRequest(ctx context.Context, cmd LinkProblemChangeRequestCommand) (*domain.Problem, error) {
+	ctx, span := s.startSpan(ctx, "ProblemService.LinkChangeRequest")
+	defer span.End()
+
+	problem, err := s.problemRepo.FindByID(ctx, cmd.ProblemID)
+	if err != nil {
+		return nil, fmt.Errorf("problem not found: %w", err)
+	}
+	change, err := s.changeRequestRepo.FindByID(ctx, cmd.ChangeRequestID)
+	if err != nil {
+		return nil, fmt.Errorf("change request not found: %w", err)
+	}
+	if err := problem.LinkChangeRequest(change); err != nil {
+		return nil, err
+	}
+
+	if err := s.update(ctx, &problem); err != nil {
+		return nil, err
+	}
+	return &problem, nil
+}
+
+// ResolveProblem resolves a known error once a change request linked to it is implemented
+func (s *ProblemService) ResolveProblem(ctx context.Context, cmd ResolveProblemCommand) (*domain.Problem, error) {
+	ctx, span := s.startSpan(ctx, "ProblemService.ResolveProblem")
+	defer span.End()
+
+	problem, err := s.problemRepo.FindByID(ctx, cmd.ProblemID)
+	if err != nil {
+		return nil, fmt.Errorf("problem not found: %w", err)
+	}
+	changes, err := s.changeRequestRepo.FindByApplicationID(ctx, problem.ApplicationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find change requests: %w", err)
+	}
+	now := s.now()
+	changeID, err := problem.Resolve(changes, cmd.Resolution, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.update(ctx, &problem); err != nil {
+		return nil, err
+	}
+
+	event := domain.ProblemResolvedEvent{
+		ProblemID:       problem.ID,
+		ApplicationID:   problem.ApplicationID,
+		ChangeRequestID: changeID,
+		Resolver:        cmd.Resolver,
+		OccurredAt:      now,
+	}
+	err = s.eventRepo.Save(ctx, event)
+	if err != nil {
+		fmt.Printf("Failed to save domain event: %v\n", err)
+	}
+
+	return &problem, nil
+}
+
+// CloseProblem closes a resolved problem whose incidents are confirmed not to recur
+func (s *ProblemService) CloseProblem(ctx context.Context, problemID string) (*domain.Problem, error) {
+	ctx, span := s.startSpan(ctx, "ProblemService.CloseProblem")
+	defer span.End()
+
+	problem, err := s.problemRepo.FindByID(ctx, problemID)
+	if err != nil {
+		return nil, fmt.Errorf("problem not found: %w", err)
+	}
+	if err := problem.Close(s.now()); err != nil {
+		return nil, err
+	}
+
+	if err := s.update(ctx, &problem); err != nil {
+		return nil, err
+	}
+	return &problem, nil
+}
+
+// GetProblem returns a problem
+func (s *ProblemService) GetProblem(ctx context.Context, problemID string) (*domain.Problem, error) {
+	ctx, span := s.startSpan(ctx, "ProblemService.GetProblem")
+	defer span.End()
+
+	problem, err := s.problemRepo.FindByID(ctx, problemID)
+	if err != nil {
+		return nil, fmt.Errorf("problem not found: %w", err)
+	}
+	return &problem, nil
+}
+
+// GetProblemsByApplication returns the problems of an application, oldest first
+func (s *ProblemService) GetProblemsByApplication(ctx context.Context, appID domain.ApplicationID) ([]domain.Problem, error) {
+	ctx, span := s.startSpan(ctx, "ProblemService.GetProblemsByApplication", domain.ApplicationAttribute(appID))
+	defer span.End()
+
+	problems, err := s.problemRepo.FindByApplicationID(ctx, appID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find problems: %w", err)
+	}
+	return problems, nil
+}
+
+// FindRecurringIncidents finds incidents of an application reported within the window that look
+// alike and are not grouped under a problem yet, candidates for a new problem
+func (s *ProblemService) FindRecurringIncidents(ctx context.Context, cmd FindRecurringIncidentsCommand) ([]domain.RecurringIncidents, error) {
+	ctx, span := s.startSpan(ctx, "ProblemService.FindRecurringIncidents", domain.ApplicationAttribute(cmd.ApplicationID))
+	defer span.End()
+
+	if cmd.MinOccurrences < 2 {
+		cmd.MinOccurrences = 2
+	}
+	if cmd.Window <= 0 {
+		cmd.Window = domain.DefaultRecurrenceWindow
+	}
+	incidents, err := s.incidentRepo.FindByApplicationID(ctx, cmd.ApplicationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find incidents: %w", err)
+	}
+	return domain.FindRecurringIncidents(incidents, cmd.MinOccurrences, cmd.Window, s.now()), nil
+}
+
+// linkIncidents groups the incidents under the problem, returning them for updating once the
+// problem is saved
+func (s *ProblemService) linkIncidents(ctx context.Context, problem *domain.Problem, ids []string) ([]domain.Incident, error) {
+	incidents, err := s.findIncidents(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, incident := range incidents {
+		if err := problem.LinkIncident(incident); err != nil {
+			return nil, err
+		}
+	}
+	return incidents, nil
+}
+
+// findIncidents finds incidents by ID
+func (s *ProblemService) findIncidents(ctx context.Context, ids []string) ([]domain.Incident, error) {
+	incidents := make([]domain.Incident, 0, len(ids))
+	for _, id := range ids {
+		incident, err := s.incidentRepo.FindByID(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("incident %s not found: %w", id, err)
+		}
+		incidents = append(incidents, incident)
+	}
+	return incidents, nil
+}
+
+// updateIncidents points the incidents to the problem and gives those without a root cause the
+// problem's, once known
+func (s *ProblemService) updateIncidents(ctx context.Context, problem domain.Problem, incidents []domain.Incident) error {
+	var errs []error
+	for _, incident := range incidents {
+		incident.ProblemID = problem.ID
+		if incident.RootCause == "" && problem.KnownError != nil {
+			incident.RootCause = problem.KnownError.RootCause
+		}
+		incident.UpdatedAt = s.now()
+		if err := s.incidentRepo.Update(ctx, incident); err != nil {
+			errs = append(errs, fmt.Errorf("failed to update incident %s: %w", incident.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// update saves a changed problem
+func (s *ProblemService) update(ctx context.Context, problem *domain.Problem) error {
+	problem.UpdatedAt = s.now()
+	err := s.problemRepo.Update(ctx, *problem)
+	if err != nil {
+		return fmt.Errorf("failed to update problem: %w", err)
+	}
+	return nil
+}
+
+// Commands for Problem Service
+
+type LogProblemCommand struct {
+	ID            string
+	ApplicationID domain.ApplicationID
+	Title         string
+	Description   string
+	Owner         string
+	Priority      domain.Priority // optional, defaults to medium
+	IncidentIDs   []string        // optional, incidents caused by the problem
+}
+
+type LinkProblemIncidentsCommand struct {
+	ProblemID   string
+	IncidentIDs []string
+}
+
+type RecordKnownErrorCommand struct {
+	ProblemID  string
+	RootCause  string
+	Workaround string // optional
+	AnalyzedBy string
+}
+
+type LinkProblemChangeRequestCommand struct {
+	ProblemID       string
+	ChangeRequestID string
+}
+
+type ResolveProblemCommand struct {
+	ProblemID  string
+	Resolver   string
+	Resolution string
+}
+
+type FindRecurringIncidentsCommand struct {
+	ApplicationID  domain.ApplicationID
+	MinOccurrences int           // optional, defaults to 2
+	Window         time.Duration // optional, defaults to 30 days
+}
@@ -0,0 +1,281 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// RetentionService enforces data retention policies. Application owners report the data their
+// applications hold by category; the retention engine flags the data held beyond the period of
+// the policy covering it and schedules its disposal through a change request, so the purge is
+// approved and recorded like any other change.
+type RetentionService struct {
+	instrumentation
+
+	policyRepo        domain.RetentionPolicyRepository
+	holdingRepo       domain.DataHoldingRepository
+	appRepo           domain.ApplicationRepository
+	changeRequestRepo domain.ChangeRequestRepository // nil flags violations without scheduling purges
+	eventRepo         domain.DomainEventRepository
+	now               func() time.Time
+}
+
+// NewRetentionService creates a new retention service. The change request repository may be nil.
+func NewRetentionService(
+	policyRepo domain.RetentionPolicyRepository,
+	holdingRepo domain.DataHoldingRepository,
+	appRepo domain.ApplicationRepository,
+	changeRequestRepo domain.ChangeRequestRepository,
+	eventRepo domain.DomainEventRepository,
+	opts ...ServiceOption,
+) *RetentionService {
+	return &RetentionService{
+		policyRepo:        policyRepo,
+		holdingRepo:       holdingRepo,
+		appRepo:           appRepo,
+		changeRequestRepo: changeRequestRepo,
+		eventRepo:         eventRepo,
+		now:               time.Now,
+		instrumentation:   newInstrumentation(opts),
+	}
+}
+
+// SetPolicy adds a retention policy or replaces the one with the same ID
+func (s *RetentionService) SetPolicy(ctx context.Context, cmd SetRetentionPolicyCommand) (*domain.RetentionPolicy, error) {
+	ctx, span := s.startSpan(ctx, "RetentionService.SetPolicy", domain.ApplicationAttribute(cmd.Policy.ApplicationID))
+	defer span.End()
+
+	policy := cmd.Policy
+	if policy.Action == "" {
+		policy.Action = domain.RetentionPurge
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+	if policy.ApplicationID != "" {
+		if _, err := s.appRepo.FindByID(ctx, policy.ApplicationID); err != nil {
+			return nil, fmt.Errorf("application not found: %w", err)
+		}
+	}
+
+	now := s.now()
+	policy.CreatedAt, policy.UpdatedAt = now, now
+	if existing, err := s.policyRepo.FindByID(ctx, policy.ID); err == nil {
+		policy.CreatedAt = existing.CreatedAt
+	}
+
+	err := s.policyRepo.Save(ctx, policy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save retention policy: %w", err)
+	}
+	return &policy, nil
+}
+
+// DeletePolicy removes a retention policy
+func (s *RetentionService) DeletePolicy(ctx context.Context, id string) error {
+	ctx, span := s.startSpan(ctx, "RetentionService.DeletePolicy")
+	defer span.End()
+
+	err := s.policyRepo.Delete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete retention policy: %w", err)
+	}
+	return nil
+}
+
+// ListPolicies returns every retention policy, by ID
+func (s *RetentionService) ListPolicies(ctx context.Context) ([]domain.RetentionPolicy, error) {
+	ctx, span := s.startSpan(ctx, "RetentionService.ListPolicies")
+	defer span.End()
+
+	policies, err := s.policyRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list retention policies: %w", err)
+	}
+	return policies, nil
+}
+
+// RecordHolding records the data an application holds in a category, replacing what was last
+// reported, e.g. after a purge moved its oldest record forward
+func (s *RetentionService) RecordHolding(ctx context.Context, cmd RecordDataHoldingCommand) (*domain.DataHolding, error) {
+	ctx, span := s.startSpan(ctx, "RetentionService.RecordHolding", domain.ApplicationAttribute(cmd.ApplicationID))
+	defer span.End()
+
+	holding := domain.DataHolding{
+		ApplicationID:  cmd.ApplicationID,
+		Category:       strings.TrimSpace(cmd.Category),
+		Records:        cmd.Records,
+		OldestRecordAt: cmd.OldestRecordAt,
+		LegalHold:      cmd.LegalHold,
+		RecordedAt:     s.now(),
+	}
+	if err := holding.Validate(); err != nil {
+		return nil, err
+	}
+	if _, err := s.appRepo.FindByID(ctx, cmd.ApplicationID); err != nil {
+		return nil, fmt.Errorf("application not found: %w", err)
+	}
+
+	err := s.holdingRepo.Save(ctx, holding)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save data holding: %w", err)
+	}
+	return &holding, nil
+}
+
+// CheckRetention flags the data held beyond retention by one application, or by every
+// application, and schedules a purge change request for each violation that has no open one
+// yet, publishing a RetentionViolationDetectedEvent when it does
+func (s *RetentionService) CheckRetention(ctx context.Context, cmd CheckRetentionCommand) (*domain.RetentionReport, error) {
+	ctx, span := s.startSpan(ctx, "RetentionService.CheckRetention", domain.ApplicationAttribute(cmd.ApplicationID))
+	defer span.End()
+
+	if cmd.Now.IsZero() {
+		cmd.Now = s.now()
+	}
+	if cmd.Requester == "" {
+		cmd.Requester = "retention engine"
+	}
+
+	var apps []domain.Application
+	var holdings []domain.DataHolding
+	if cmd.ApplicationID != "" {
+		app, err := s.appRepo.FindByID(ctx, cmd.ApplicationID)
+		if err != nil {
+			return nil, fmt.Errorf("application not found: %w", err)
+		}
+		apps = []domain.Application{app}
+		holdings, err = s.holdingRepo.FindByApplicationID(ctx, cmd.ApplicationID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load data holdings: %w", err)
+		}
+	} else {
+		var err error
+		apps, err = s.appRepo.FindAll(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load applications: %w", err)
+		}
+		holdings, err = s.holdingRepo.FindAll(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load data holdings: %w", err)
+		}
+	}
+	policies, err := s.policyRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load retention policies: %w", err)
+	}
+
+	report := domain.CheckRetention(apps, holdings, policies, cmd.Now)
+	if s.changeRequestRepo == nil {
+		return &report, nil
+	}
+
+	for i, violation := range report.Violations {
+		open, err := s.openPurge(ctx, violation)
+		if err != nil {
+			return nil, err
+		}
+		if open != "" {
+			report.Violations[i].ChangeRequestID = open
+			continue
+		}
+
+		changeRequest := domain.PurgeChangeRequest(violation, cmd.Requester, cmd.Now)
+		err = s.changeRequestRepo.Save(ctx, changeRequest)
+		if err != nil {
+			return nil, fmt.Errorf("failed to schedule purge: %w", err)
+		}
+		report.Violations[i].ChangeRequestID = changeRequest.ID
+		s.publishScheduledPurge(ctx, changeRequest, report.Violations[i])
+	}
+	return &report, nil
+}
+
+// Start checks retention across every application every interval until the context is
+// cancelled. Failures are passed to onError when it is not nil.
+func (s *RetentionService) Start(ctx context.Context, interval time.Duration, onError func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.CheckRetention(ctx, CheckRetentionCommand{}); err != nil && onError != nil {
+				onError(err)
+			}
+		}
+	}
+}
+
+// openPurge returns the ID of the open change request already scheduling the disposal of the
+// data, empty when there is none
+func (s *RetentionService) openPurge(ctx context.Context, violation domain.RetentionViolation) (string, error) {
+	changeRequests, err := s.changeRequestRepo.FindByApplicationID(ctx, violation.ApplicationID)
+	if err != nil {
+		return "", fmt.Errorf("failed to load change requests: %w", err)
+	}
+	prefix := domain.PurgeChangeRequestPrefix(violation.ApplicationID, violation.Category) + "-"
+	for _, changeRequest := range changeRequests {
+		if strings.HasPrefix(changeRequest.ID, prefix) && changeRequest.Open() {
+			return changeRequest.ID, nil
+		}
+	}
+	return "", nil
+}
+
+// publishScheduledPurge publishes the creation of a purge change request and the violation it
+// remedies
+func (s *RetentionService) publishScheduledPurge(ctx context.Context, changeRequest domain.ChangeRequest, violation domain.RetentionViolation) {
+	events := []domain.DomainEvent{
+		domain.ChangeRequestCreatedEvent{
+			ChangeRequestID: changeRequest.ID,
+			ApplicationID:   changeRequest.ApplicationID,
+			Requester:       changeRequest.Requester,
+			Type:            changeRequest.Type,
+			Priority:        changeRequest.Priority,
+			Description:     changeRequest.Description,
+			OccurredAt:      changeRequest.CreatedAt,
+		},
+		domain.RetentionViolationDetectedEvent{
+			ApplicationID:   violation.ApplicationID,
+			Category:        violation.Category,
+			PolicyID:        violation.Policy.ID,
+			Records:         violation.Records,
+			DaysOverdue:     violation.DaysOverdue,
+			ChangeRequestID: changeRequest.ID,
+			OccurredAt:      changeRequest.CreatedAt,
+		},
+	}
+	for _, event := range events {
+		err := s.eventRepo.Save(ctx, event)
+		if err != nil {
+			fmt.Printf("Failed to save domain event: %v\n", err)
+		}
+	}
+}
+
+// Commands for Retention Service
+
+type SetRetentionPolicyCommand struct {
+	Policy domain.RetentionPolicy // Action defaults to purge
+}
+
+type RecordDataHoldingCommand struct {
+	ApplicationID  domain.ApplicationID
+	Category       string
+	Records        int
+	OldestRecordAt time.Time
+	LegalHold      string // optional, why the data must be kept regardless of policy
+}
+
+type CheckRetentionCommand struct {
+	ApplicationID domain.ApplicationID // optional, every application when empty
+	Requester     string               // optional, requests the purge changes; defaults to "retention engine"
+	Now           time.Time            // optional, defaults to now
+}
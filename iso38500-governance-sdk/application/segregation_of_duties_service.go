@@ -0,0 +1,102 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// SegregationOfDutiesService reports where one person held two duties the organization's
+// segregation of duties policy keeps apart. The rules are enforced by the services taking the
+// decisions; this service finds the records that break them, such as those made before a rule
+// was enforced.
+type SegregationOfDutiesService struct {
+	instrumentation
+
+	appRepo           domain.ApplicationRepository
+	changeRequestRepo domain.ChangeRequestRepository // nil skips change requests
+	assessmentRepo    domain.AssessmentRepository    // nil skips assessments
+	policy            domain.SegregationOfDutiesPolicy
+	now               func() time.Time
+}
+
+// NewSegregationOfDutiesService creates a new segregation of duties service checking the
+// default policy. The change request and assessment repositories may be nil.
+func NewSegregationOfDutiesService(
+	appRepo domain.ApplicationRepository,
+	changeRequestRepo domain.ChangeRequestRepository,
+	assessmentRepo domain.AssessmentRepository,
+	opts ...ServiceOption,
+) *SegregationOfDutiesService {
+	return &SegregationOfDutiesService{
+		appRepo:           appRepo,
+		changeRequestRepo: changeRequestRepo,
+		assessmentRepo:    assessmentRepo,
+		policy:            domain.DefaultSegregationOfDutiesPolicy(),
+		now:               time.Now,
+		instrumentation:   newInstrumentation(opts),
+	}
+}
+
+// SetPolicy replaces the rules checked
+func (s *SegregationOfDutiesService) SetPolicy(policy domain.SegregationOfDutiesPolicy) {
+	s.policy = policy
+}
+
+// Policy returns the rules checked
+func (s *SegregationOfDutiesService) Policy() domain.SegregationOfDutiesPolicy {
+	return s.policy
+}
+
+// Report checks the change requests and assessments of every application, or of one, against
+// the policy
+func (s *SegregationOfDutiesService) Report(ctx context.Context, cmd SegregationOfDutiesReportCommand) (*domain.SegregationOfDutiesReport, error) {
+	ctx, span := s.startSpan(ctx, "SegregationOfDutiesService.Report", domain.ApplicationAttribute(cmd.ApplicationID))
+	defer span.End()
+
+	var appIDs []domain.ApplicationID
+	if cmd.ApplicationID != "" {
+		if _, err := s.appRepo.FindByID(ctx, cmd.ApplicationID); err != nil {
+			return nil, fmt.Errorf("application not found: %w", err)
+		}
+		appIDs = []domain.ApplicationID{cmd.ApplicationID}
+	} else {
+		apps, err := s.appRepo.FindAll(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load applications: %w", err)
+		}
+		for _, app := range apps {
+			appIDs = append(appIDs, app.ID)
+		}
+	}
+
+	var changeRequests []domain.ChangeRequest
+	var assessments []domain.ApplicationAssessment
+	for _, appID := range appIDs {
+		if s.changeRequestRepo != nil {
+			found, err := s.changeRequestRepo.FindByApplicationID(ctx, appID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load change requests of %s: %w", appID, err)
+			}
+			changeRequests = append(changeRequests, found...)
+		}
+		if s.assessmentRepo != nil {
+			found, err := s.assessmentRepo.FindByApplicationID(ctx, appID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load assessments of %s: %w", appID, err)
+			}
+			assessments = append(assessments, found...)
+		}
+	}
+
+	report := domain.BuildSegregationOfDutiesReport(s.policy, changeRequests, assessments, s.now())
+	return &report, nil
+}
+
+// Commands for Segregation Of Duties Service
+
+type SegregationOfDutiesReportCommand struct {
+	ApplicationID domain.ApplicationID // optional, every application when empty
+}
@@ -0,0 +1,119 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// ServiceLevelService provides application services for ingesting observed availability and
+// checking it against declared SLAs
+type ServiceLevelService struct {
+	instrumentation
+
+	measurementRepo domain.AvailabilityMeasurementRepository
+	appRepo         domain.ApplicationRepository
+	eventRepo       domain.DomainEventRepository
+}
+
+// NewServiceLevelService creates a new service level service
+func NewServiceLevelService(
+	measurementRepo domain.AvailabilityMeasurementRepository,
+	appRepo domain.ApplicationRepository,
+	eventRepo domain.DomainEventRepository,
+	opts ...ServiceOption,
+) *ServiceLevelService {
+	return &ServiceLevelService{
+		measurementRepo: measurementRepo,
+		appRepo:         appRepo,
+		eventRepo:       eventRepo,
+		instrumentation: newInstrumentation(opts),
+	}
+}
+
+// RecordMeasurement stores an observed availability measurement and returns any breaches of
+// the application's declared availability SLA, publishing an event for each
+func (s *ServiceLevelService) RecordMeasurement(ctx context.Context, cmd RecordAvailabilityMeasurementCommand) (*domain.AvailabilityMeasurement, []domain.SLABreach, error) {
+	ctx, span := s.startSpan(ctx, "ServiceLevelService.RecordMeasurement", domain.ApplicationAttribute(cmd.ApplicationID))
+	defer span.End()
+
+	app, err := s.appRepo.FindByID(ctx, cmd.ApplicationID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("application not found: %w", err)
+	}
+
+	measurement := domain.AvailabilityMeasurement{
+		ID:               cmd.ID,
+		ApplicationID:    cmd.ApplicationID,
+		UptimePercentage: cmd.UptimePercentage,
+		ResponseTime:     cmd.ResponseTime,
+		PeriodStart:      cmd.PeriodStart,
+		PeriodEnd:        cmd.PeriodEnd,
+		Source:           cmd.Source,
+		MeasuredAt:       time.Now(),
+	}
+	if err := measurement.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	err = s.measurementRepo.Save(ctx, measurement)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to save availability measurement: %w", err)
+	}
+
+	breaches := domain.DetectSLABreaches(app.SecurityProvisions.ApplicationAvailability, measurement)
+	for _, breach := range breaches {
+		event := domain.SLABreachDetectedEvent{
+			ApplicationID: breach.ApplicationID,
+			ServiceName:   breach.ServiceName,
+			Metric:        breach.Metric,
+			Declared:      breach.Declared,
+			Observed:      breach.Observed,
+			MeasurementID: breach.MeasurementID,
+			OccurredAt:    measurement.MeasuredAt,
+		}
+		err = s.eventRepo.Save(ctx, event)
+		if err != nil {
+			fmt.Printf("Failed to save domain event: %v\n", err)
+		}
+	}
+
+	return &measurement, breaches, nil
+}
+
+// GetErrorBudgets returns the error budget of each SLO derived from the application's declared
+// availability SLA for the current calendar month, consumed by its availability measurements
+func (s *ServiceLevelService) GetErrorBudgets(ctx context.Context, appID domain.ApplicationID) ([]domain.ErrorBudget, error) {
+	ctx, span := s.startSpan(ctx, "ServiceLevelService.GetErrorBudgets", domain.ApplicationAttribute(appID))
+	defer span.End()
+
+	app, err := s.appRepo.FindByID(ctx, appID)
+	if err != nil {
+		return nil, fmt.Errorf("application not found: %w", err)
+	}
+	measurements, err := s.measurementRepo.FindByApplicationID(ctx, appID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find availability measurements: %w", err)
+	}
+
+	now := time.Now()
+	budgets := []domain.ErrorBudget{}
+	for _, slo := range domain.DeriveSLOs(app) {
+		budgets = append(budgets, domain.BuildErrorBudget(slo, measurements, now))
+	}
+	return budgets, nil
+}
+
+// Commands for Service Level Service
+
+type RecordAvailabilityMeasurementCommand struct {
+	ID               string
+	ApplicationID    domain.ApplicationID
+	UptimePercentage float64
+	ResponseTime     time.Duration
+	PeriodStart      time.Time
+	PeriodEnd        time.Time
+	Source           string
+}
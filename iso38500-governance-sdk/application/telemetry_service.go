@@ -0,0 +1,244 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// TelemetryService measures KPIs from production telemetry. Each KPI bound to a metric of a
+// monitoring system, such as uptime, latency or error rate in Datadog or CloudWatch, is pulled
+// every interval of its binding and the points of the window are aggregated into a measurement
+// recorded through the KPI service, so monitoring reports what production observed.
+type TelemetryService struct {
+	instrumentation
+
+	kpiService  *KPIService
+	kpiRepo     domain.KPIRepository
+	bindingRepo domain.TelemetryBindingRepository
+	sources     map[string]domain.TelemetrySource
+	now         func() time.Time
+}
+
+// NewTelemetryService creates a new telemetry service pulling from the sources
+func NewTelemetryService(
+	kpiService *KPIService,
+	kpiRepo domain.KPIRepository,
+	bindingRepo domain.TelemetryBindingRepository,
+	sources []domain.TelemetrySource,
+	opts ...ServiceOption,
+) *TelemetryService {
+	bySource := make(map[string]domain.TelemetrySource, len(sources))
+	for _, source := range sources {
+		bySource[source.Name()] = source
+	}
+	return &TelemetryService{
+		kpiService:      kpiService,
+		kpiRepo:         kpiRepo,
+		bindingRepo:     bindingRepo,
+		sources:         bySource,
+		now:             time.Now,
+		instrumentation: newInstrumentation(opts),
+	}
+}
+
+// Sources returns the names of the configured telemetry sources, sorted
+func (s *TelemetryService) Sources() []string {
+	names := make([]string, 0, len(s.sources))
+	for name := range s.sources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// BindMetric measures a defined KPI from a metric of a configured source, replacing the metric
+// it was bound to. The first pull covers one interval back from when it runs.
+func (s *TelemetryService) BindMetric(ctx context.Context, cmd BindTelemetryMetricCommand) (*domain.TelemetryBinding, error) {
+	ctx, span := s.startSpan(ctx, "TelemetryService.BindMetric", domain.ApplicationAttribute(cmd.ApplicationID))
+	defer span.End()
+
+	binding := domain.TelemetryBinding{
+		KPIID:         cmd.KPIID,
+		ApplicationID: cmd.ApplicationID,
+		Source:        cmd.Source,
+		Metric:        cmd.Metric,
+		Query:         cmd.Query,
+		Aggregation:   cmd.Aggregation,
+		Scale:         cmd.Scale,
+		Interval:      cmd.Interval,
+	}
+	if binding.Aggregation == "" {
+		binding.Aggregation = domain.TelemetryAverage
+	}
+	if err := binding.Validate(); err != nil {
+		return nil, err
+	}
+	if _, ok := s.sources[binding.Source]; !ok {
+		return nil, fmt.Errorf("telemetry source %s is not configured", binding.Source)
+	}
+	if _, err := s.kpiRepo.FindByID(ctx, binding.KPIID); err != nil {
+		return nil, fmt.Errorf("KPI not found: %w", err)
+	}
+
+	err := s.bindingRepo.Save(ctx, binding)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save telemetry binding: %w", err)
+	}
+	return &binding, nil
+}
+
+// UnbindMetric stops measuring a KPI from telemetry. Its measurements are kept.
+func (s *TelemetryService) UnbindMetric(ctx context.Context, kpiID string) error {
+	ctx, span := s.startSpan(ctx, "TelemetryService.UnbindMetric")
+	defer span.End()
+
+	err := s.bindingRepo.Delete(ctx, kpiID)
+	if err != nil {
+		return fmt.Errorf("failed to delete telemetry binding: %w", err)
+	}
+	return nil
+}
+
+// ListBindings returns the telemetry bindings of every KPI measured from telemetry
+func (s *TelemetryService) ListBindings(ctx context.Context) ([]domain.TelemetryBinding, error) {
+	ctx, span := s.startSpan(ctx, "TelemetryService.ListBindings")
+	defer span.End()
+
+	bindings, err := s.bindingRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find telemetry bindings: %w", err)
+	}
+	return bindings, nil
+}
+
+// TelemetryIngestion is the outcome of one round of telemetry pulls
+type TelemetryIngestion struct {
+	Measurements []domain.KPIMeasurement
+	NoData       []string // KPIs whose metric returned no points in the window
+	Failed       []string // KPIs whose metric could not be pulled or recorded
+	RanAt        time.Time
+}
+
+// IngestDue pulls the metric of every binding whose interval has passed, or of every binding
+// with Force, and records a measurement of its KPI for each window with points. A window without
+// points is skipped; a failed pull is recorded on the binding and retried with a wider window
+// next time. Failures do not stop the other pulls; their errors are joined.
+func (s *TelemetryService) IngestDue(ctx context.Context, cmd IngestTelemetryCommand) (*TelemetryIngestion, error) {
+	ctx, span := s.startSpan(ctx, "TelemetryService.IngestDue")
+	defer span.End()
+
+	if cmd.Now.IsZero() {
+		cmd.Now = s.now()
+	}
+	run := &TelemetryIngestion{RanAt: cmd.Now}
+
+	bindings, err := s.bindingRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find telemetry bindings: %w", err)
+	}
+
+	var errs []error
+	for _, binding := range bindings {
+		if cmd.KPIID != "" && binding.KPIID != cmd.KPIID {
+			continue
+		}
+		if !cmd.Force && !binding.Due(cmd.Now) {
+			continue
+		}
+		if err := s.ingest(ctx, binding, cmd.Now, run); err != nil {
+			run.Failed = append(run.Failed, binding.KPIID)
+			errs = append(errs, fmt.Errorf("%s: %w", binding.KPIID, err))
+		}
+	}
+	return run, errors.Join(errs...)
+}
+
+// Start ingests due telemetry every interval until the context is cancelled. Failures are passed
+// to onError when it is not nil.
+func (s *TelemetryService) Start(ctx context.Context, interval time.Duration, onError func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.IngestDue(ctx, IngestTelemetryCommand{Now: s.now()}); err != nil && onError != nil {
+				onError(err)
+			}
+		}
+	}
+}
+
+// ingest pulls the binding's window ending now and records the aggregated measurement
+func (s *TelemetryService) ingest(ctx context.Context, binding domain.TelemetryBinding, now time.Time, run *TelemetryIngestion) error {
+	source, ok := s.sources[binding.Source]
+	if !ok {
+		return s.failed(ctx, binding, fmt.Errorf("telemetry source %s is not configured", binding.Source))
+	}
+
+	from, to := binding.Window(now)
+	points, err := source.Query(ctx, binding.Query, from, to)
+	if err != nil {
+		return s.failed(ctx, binding, err)
+	}
+
+	value, measuredAt, ok := binding.Aggregate(points)
+	if ok {
+		measurement, err := s.kpiService.RecordKPIMeasurement(ctx, RecordKPIMeasurementCommand{
+			KPIID:      binding.KPIID,
+			Value:      value,
+			MeasuredAt: measuredAt,
+			Notes:      fmt.Sprintf("%s %s of %d points from %s: %s", binding.Metric, binding.Aggregation, len(points), binding.Source, binding.Query),
+			RecordedBy: "telemetry/" + binding.Source,
+		})
+		if err != nil {
+			return s.failed(ctx, binding, err)
+		}
+		run.Measurements = append(run.Measurements, *measurement)
+	} else {
+		run.NoData = append(run.NoData, binding.KPIID)
+	}
+
+	binding.LastIngestedAt = to
+	binding.LastError = ""
+	err = s.bindingRepo.Save(ctx, binding)
+	if err != nil {
+		return fmt.Errorf("failed to save telemetry binding: %w", err)
+	}
+	return nil
+}
+
+// failed records why the binding could not be pulled, leaving its window to be pulled again
+func (s *TelemetryService) failed(ctx context.Context, binding domain.TelemetryBinding, cause error) error {
+	binding.LastError = cause.Error()
+	if err := s.bindingRepo.Save(ctx, binding); err != nil {
+		return errors.Join(cause, fmt.Errorf("failed to save telemetry binding: %w", err))
+	}
+	return cause
+}
+
+// Commands for Telemetry Service
+
+type BindTelemetryMetricCommand struct {
+	KPIID         string
+	ApplicationID domain.ApplicationID // optional
+	Source        string
+	Metric        domain.TelemetryMetric
+	Query         string
+	Aggregation   domain.TelemetryAggregation // optional, defaults to avg
+	Scale         float64                     // optional, defaults to 1
+	Interval      time.Duration               // optional, defaults to domain.DefaultTelemetryInterval
+}
+
+type IngestTelemetryCommand struct {
+	KPIID string    // optional, only this KPI's binding
+	Force bool      // pull even when the interval has not passed
+	Now   time.Time // optional, defaults to now
+}
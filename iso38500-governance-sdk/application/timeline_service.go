@@ -0,0 +1,90 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// TimelineService lays out the dated governance work of agreements (objectives, action plans and
+// actions, initiatives and milestones, and audits) for export to calendars and project tooling
+type TimelineService struct {
+	instrumentation
+
+	agreementRepo domain.GovernanceAgreementRepository
+	auditRepo     domain.AuditRepository
+}
+
+// NewTimelineService creates a new timeline service. The audit repository may be nil, in which
+// case audits are left out of timelines.
+func NewTimelineService(
+	agreementRepo domain.GovernanceAgreementRepository,
+	auditRepo domain.AuditRepository,
+	opts ...ServiceOption,
+) *TimelineService {
+	return &TimelineService{
+		agreementRepo:   agreementRepo,
+		auditRepo:       auditRepo,
+		instrumentation: newInstrumentation(opts),
+	}
+}
+
+// GetTimeline lays out the timeline of an agreement, of an application's agreement, or of every
+// agreement when neither is given
+func (s *TimelineService) GetTimeline(ctx context.Context, cmd GetTimelineCommand) (*domain.Timeline, error) {
+	ctx, span := s.startSpan(ctx, "TimelineService.GetTimeline", domain.AgreementAttribute(cmd.AgreementID), domain.ApplicationAttribute(cmd.ApplicationID))
+	defer span.End()
+
+	var agreements []domain.GovernanceAgreement
+	switch {
+	case cmd.AgreementID != "":
+		agreement, err := s.agreementRepo.FindByID(ctx, cmd.AgreementID)
+		if err != nil {
+			return nil, fmt.Errorf("governance agreement not found: %w", err)
+		}
+		agreements = append(agreements, agreement)
+	case cmd.ApplicationID != "":
+		agreement, err := s.agreementRepo.FindByApplicationID(ctx, cmd.ApplicationID)
+		if err != nil {
+			return nil, fmt.Errorf("governance agreement not found: %w", err)
+		}
+		agreements = append(agreements, agreement)
+	default:
+		all, err := s.agreementRepo.FindAll(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find governance agreements: %w", err)
+		}
+		agreements = all
+	}
+
+	now := time.Now()
+	var items []domain.TimelineItem
+	for _, agreement := range agreements {
+		schedule, err := domain.BuildActionSchedule(agreement.ID, agreement.Direct, now)
+		if err != nil {
+			return nil, fmt.Errorf("failed to schedule actions of %s: %w", agreement.ID, err)
+		}
+
+		var audits []domain.Audit
+		if s.auditRepo != nil {
+			audits, err = s.auditRepo.FindByApplicationID(ctx, agreement.ApplicationID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to find audits: %w", err)
+			}
+		}
+
+		items = append(items, domain.BuildTimeline(agreement, schedule, audits)...)
+	}
+
+	timeline := domain.NewTimeline(items, now)
+	return &timeline, nil
+}
+
+// Commands for Timeline Service
+
+type GetTimelineCommand struct {
+	AgreementID   domain.GovernanceAgreementID
+	ApplicationID domain.ApplicationID // used when no agreement is given
+}
@@ -0,0 +1,49 @@
+package application
+
+import (
+	"context"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// ServiceOption customizes an application service
+type ServiceOption func(*instrumentation)
+
+// WithTracer traces every call to the service with a span named after the service and method,
+// carrying the IDs of the agreement, application or portfolio the call works on
+func WithTracer(tracer domain.Tracer) ServiceOption {
+	return func(i *instrumentation) {
+		i.tracer = tracer
+	}
+}
+
+// instrumentation holds what an application service traces its calls with. Services without a
+// tracer trace nothing.
+type instrumentation struct {
+	tracer domain.Tracer
+}
+
+func newInstrumentation(opts []ServiceOption) instrumentation {
+	var i instrumentation
+	for _, opt := range opts {
+		opt(&i)
+	}
+	if i.tracer == nil {
+		i.tracer = domain.NoopTracer{}
+	}
+	return i
+}
+
+// startSpan starts a span around a service call, leaving out empty IDs
+func (i instrumentation) startSpan(ctx context.Context, name string, attributes ...domain.SpanAttribute) (context.Context, domain.Span) {
+	set := make([]domain.SpanAttribute, 0, len(attributes))
+	for _, attribute := range attributes {
+		if attribute.Value != "" {
+			set = append(set, attribute)
+		}
+	}
+	if i.tracer == nil {
+		return domain.NoopTracer{}.Start(ctx, name, set...)
+	}
+	return i.tracer.Start(ctx, name, set...)
+}
@@ -0,0 +1,506 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// VendorService manages the third parties supplying, hosting or operating applications: their
+// contracts, the service levels they committed to and the due diligence questionnaires they
+// answer. Each vendor is rated for the risk the organization carries through it, again whenever
+// its contracts, service levels or questionnaires change and on every monitoring round.
+type VendorService struct {
+	instrumentation
+
+	vendorRepo domain.VendorRepository
+	appRepo    domain.ApplicationRepository
+	eventRepo  domain.DomainEventRepository
+	policy     domain.VendorRiskPolicy
+	now        func() time.Time
+}
+
+// NewVendorService creates a new vendor service rating risk with the default policy
+func NewVendorService(
+	vendorRepo domain.VendorRepository,
+	appRepo domain.ApplicationRepository,
+	eventRepo domain.DomainEventRepository,
+	opts ...ServiceOption,
+) *VendorService {
+	return &VendorService{
+		vendorRepo:      vendorRepo,
+		appRepo:         appRepo,
+		eventRepo:       eventRepo,
+		policy:          domain.DefaultVendorRiskPolicy(),
+		now:             time.Now,
+		instrumentation: newInstrumentation(opts),
+	}
+}
+
+// SetRiskPolicy replaces the policy vendors are rated with
+func (s *VendorService) SetRiskPolicy(policy domain.VendorRiskPolicy) {
+	s.policy = policy
+}
+
+// RegisterVendor adds a vendor, linked to the applications it supplies, hosts or operates, and
+// rates it
+func (s *VendorService) RegisterVendor(ctx context.Context, cmd RegisterVendorCommand) (*domain.Vendor, error) {
+	ctx, span := s.startSpan(ctx, "VendorService.RegisterVendor")
+	defer span.End()
+
+	now := s.now()
+	vendor := domain.Vendor{
+		ID:          cmd.ID,
+		Name:        cmd.Name,
+		Description: cmd.Description,
+		Services:    cmd.Services,
+		Criticality: cmd.Criticality,
+		Status:      cmd.Status,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if vendor.Status == "" {
+		vendor.Status = domain.VendorOnboarding
+	}
+	if err := vendor.Validate(); err != nil {
+		return nil, err
+	}
+	if _, err := s.vendorRepo.FindByID(ctx, vendor.ID); err == nil {
+		return nil, fmt.Errorf("vendor %s already exists", vendor.ID)
+	}
+	for _, appID := range cmd.Applications {
+		if _, err := s.appRepo.FindByID(ctx, appID); err != nil {
+			return nil, fmt.Errorf("application %s not found: %w", appID, err)
+		}
+		if !vendor.Supplies(appID) {
+			vendor.Applications = append(vendor.Applications, appID)
+		}
+	}
+
+	previous := s.rate(&vendor, now)
+	err := s.vendorRepo.Save(ctx, vendor)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save vendor: %w", err)
+	}
+	s.publishRating(ctx, vendor, previous)
+	return &vendor, nil
+}
+
+// LinkApplication records that the vendor supplies, hosts or operates an application
+func (s *VendorService) LinkApplication(ctx context.Context, cmd LinkVendorApplicationCommand) (*domain.Vendor, error) {
+	ctx, span := s.startSpan(ctx, "VendorService.LinkApplication", domain.ApplicationAttribute(cmd.ApplicationID))
+	defer span.End()
+
+	if _, err := s.appRepo.FindByID(ctx, cmd.ApplicationID); err != nil {
+		return nil, fmt.Errorf("application not found: %w", err)
+	}
+	return s.update(ctx, cmd.VendorID, func(vendor *domain.Vendor) error {
+		if vendor.Supplies(cmd.ApplicationID) {
+			return fmt.Errorf("vendor %s already supplies %s", vendor.ID, cmd.ApplicationID)
+		}
+		vendor.Applications = append(append([]domain.ApplicationID{}, vendor.Applications...), cmd.ApplicationID)
+		return nil
+	})
+}
+
+// UnlinkApplication records that the vendor no longer supplies, hosts or operates an application
+func (s *VendorService) UnlinkApplication(ctx context.Context, cmd UnlinkVendorApplicationCommand) (*domain.Vendor, error) {
+	ctx, span := s.startSpan(ctx, "VendorService.UnlinkApplication", domain.ApplicationAttribute(cmd.ApplicationID))
+	defer span.End()
+
+	return s.update(ctx, cmd.VendorID, func(vendor *domain.Vendor) error {
+		if !vendor.Supplies(cmd.ApplicationID) {
+			return fmt.Errorf("vendor %s does not supply %s", vendor.ID, cmd.ApplicationID)
+		}
+		var kept []domain.ApplicationID
+		for _, appID := range vendor.Applications {
+			if appID != cmd.ApplicationID {
+				kept = append(kept, appID)
+			}
+		}
+		vendor.Applications = kept
+		return nil
+	})
+}
+
+// SetVendorStatus moves a vendor through onboarding, active and offboarded. Offboarded vendors
+// no longer count towards the risk of their applications.
+func (s *VendorService) SetVendorStatus(ctx context.Context, cmd SetVendorStatusCommand) (*domain.Vendor, error) {
+	ctx, span := s.startSpan(ctx, "VendorService.SetVendorStatus")
+	defer span.End()
+
+	switch cmd.Status {
+	case domain.VendorOnboarding, domain.VendorActive, domain.VendorOffboarded:
+	default:
+		return nil, fmt.Errorf("unknown vendor status %q", cmd.Status)
+	}
+	return s.update(ctx, cmd.VendorID, func(vendor *domain.Vendor) error {
+		vendor.Status = cmd.Status
+		return nil
+	})
+}
+
+// AddContract records a contract with the vendor
+func (s *VendorService) AddContract(ctx context.Context, cmd AddVendorContractCommand) (*domain.Vendor, error) {
+	ctx, span := s.startSpan(ctx, "VendorService.AddContract")
+	defer span.End()
+
+	return s.update(ctx, cmd.VendorID, func(vendor *domain.Vendor) error {
+		for _, contract := range vendor.Contracts {
+			if contract.ID == cmd.Contract.ID {
+				return fmt.Errorf("vendor %s already has contract %s", vendor.ID, contract.ID)
+			}
+		}
+		vendor.Contracts = append(append([]domain.VendorContract{}, vendor.Contracts...), cmd.Contract)
+		return nil
+	})
+}
+
+// SetSLA adds a service level the vendor committed to, or changes the target of one it has
+// while keeping the value last observed
+func (s *VendorService) SetSLA(ctx context.Context, cmd SetVendorSLACommand) (*domain.Vendor, error) {
+	ctx, span := s.startSpan(ctx, "VendorService.SetSLA")
+	defer span.End()
+
+	return s.update(ctx, cmd.VendorID, func(vendor *domain.Vendor) error {
+		vendor.SLAs = append([]domain.VendorSLA{}, vendor.SLAs...)
+		for i := range vendor.SLAs {
+			if vendor.SLAs[i].Name == cmd.Name {
+				vendor.SLAs[i].Target = cmd.Target
+				vendor.SLAs[i].LowerIsBetter = cmd.LowerIsBetter
+				return nil
+			}
+		}
+		vendor.SLAs = append(vendor.SLAs, domain.VendorSLA{Name: cmd.Name, Target: cmd.Target, LowerIsBetter: cmd.LowerIsBetter})
+		return nil
+	})
+}
+
+// RecordSLAObservation records the value a vendor's service level was observed at, publishing a
+// VendorSLABreachedEvent when it misses the target
+func (s *VendorService) RecordSLAObservation(ctx context.Context, cmd RecordVendorSLAObservationCommand) (*domain.Vendor, error) {
+	ctx, span := s.startSpan(ctx, "VendorService.RecordSLAObservation")
+	defer span.End()
+
+	if cmd.ObservedAt.IsZero() {
+		cmd.ObservedAt = s.now()
+	}
+	var observed domain.VendorSLA
+	vendor, err := s.update(ctx, cmd.VendorID, func(vendor *domain.Vendor) error {
+		vendor.SLAs = append([]domain.VendorSLA{}, vendor.SLAs...)
+		for i := range vendor.SLAs {
+			if vendor.SLAs[i].Name == cmd.SLA {
+				vendor.SLAs[i].Observed = cmd.Observed
+				vendor.SLAs[i].ObservedAt = cmd.ObservedAt
+				observed = vendor.SLAs[i]
+				return nil
+			}
+		}
+		return fmt.Errorf("vendor %s has no SLA %s", vendor.ID, cmd.SLA)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if observed.Breached() {
+		event := domain.VendorSLABreachedEvent{
+			VendorID:     vendor.ID,
+			SLA:          observed.Name,
+			Target:       observed.Target,
+			Observed:     observed.Observed,
+			Applications: vendor.Applications,
+			OccurredAt:   cmd.ObservedAt,
+		}
+		err = s.eventRepo.Save(ctx, event)
+		if err != nil {
+			fmt.Printf("Failed to save domain event: %v\n", err)
+		}
+	}
+	return vendor, nil
+}
+
+// SendQuestionnaire sends the vendor a due diligence questionnaire, with the default questions
+// unless others are given
+func (s *VendorService) SendQuestionnaire(ctx context.Context, cmd SendVendorQuestionnaireCommand) (*domain.VendorQuestionnaire, error) {
+	ctx, span := s.startSpan(ctx, "VendorService.SendQuestionnaire")
+	defer span.End()
+
+	questionnaire := domain.VendorQuestionnaire{
+		ID:        cmd.QuestionnaireID,
+		Questions: cmd.Questions,
+		SentAt:    s.now(),
+	}
+	if questionnaire.ID == "" {
+		return nil, fmt.Errorf("questionnaire ID cannot be empty")
+	}
+	if len(questionnaire.Questions) == 0 {
+		questionnaire.Questions = domain.DefaultVendorQuestions()
+	}
+	_, err := s.update(ctx, cmd.VendorID, func(vendor *domain.Vendor) error {
+		for _, sent := range vendor.Questionnaires {
+			if sent.ID == questionnaire.ID {
+				return fmt.Errorf("vendor %s was already sent questionnaire %s", vendor.ID, sent.ID)
+			}
+		}
+		vendor.Questionnaires = append(append([]domain.VendorQuestionnaire{}, vendor.Questionnaires...), questionnaire)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &questionnaire, nil
+}
+
+// AnswerQuestionnaire records the vendor's answers to questions of a questionnaire. Once
+// completed, the questionnaire's score counts towards the vendor's risk rating and it can no
+// longer be answered.
+func (s *VendorService) AnswerQuestionnaire(ctx context.Context, cmd AnswerVendorQuestionnaireCommand) (*domain.Vendor, error) {
+	ctx, span := s.startSpan(ctx, "VendorService.AnswerQuestionnaire")
+	defer span.End()
+
+	for _, answer := range cmd.Answers {
+		if err := answer.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	now := s.now()
+	return s.update(ctx, cmd.VendorID, func(vendor *domain.Vendor) error {
+		vendor.Questionnaires = append([]domain.VendorQuestionnaire{}, vendor.Questionnaires...)
+		for i := range vendor.Questionnaires {
+			questionnaire := &vendor.Questionnaires[i]
+			if questionnaire.ID != cmd.QuestionnaireID {
+				continue
+			}
+			if questionnaire.Completed() {
+				return fmt.Errorf("questionnaire %s was completed on %s", questionnaire.ID, questionnaire.CompletedAt.Format("2006-01-02"))
+			}
+
+			questionnaire.Questions = append([]domain.VendorQuestion{}, questionnaire.Questions...)
+			answered := make(map[string]bool, len(cmd.Answers))
+			for j := range questionnaire.Questions {
+				question := &questionnaire.Questions[j]
+				if answer, ok := cmd.Answers[question.ID]; ok {
+					question.Answer = answer
+					answered[question.ID] = true
+				}
+				if notes, ok := cmd.Notes[question.ID]; ok {
+					question.Notes = notes
+				}
+			}
+			for id := range cmd.Answers {
+				if !answered[id] {
+					return fmt.Errorf("questionnaire %s has no question %s", questionnaire.ID, id)
+				}
+			}
+			if cmd.Complete {
+				for _, question := range questionnaire.Questions {
+					if question.Answer == "" {
+						return fmt.Errorf("question %s of questionnaire %s is not answered", question.ID, questionnaire.ID)
+					}
+				}
+				questionnaire.CompletedAt = now
+			}
+			return nil
+		}
+		return fmt.Errorf("vendor %s was not sent questionnaire %s", vendor.ID, cmd.QuestionnaireID)
+	})
+}
+
+// GetVendor returns a vendor
+func (s *VendorService) GetVendor(ctx context.Context, id domain.VendorID) (*domain.Vendor, error) {
+	ctx, span := s.startSpan(ctx, "VendorService.GetVendor")
+	defer span.End()
+
+	vendor, err := s.vendorRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find vendor: %w", err)
+	}
+	return &vendor, nil
+}
+
+// ListVendors returns every vendor, or those of an application
+func (s *VendorService) ListVendors(ctx context.Context, cmd ListVendorsCommand) ([]domain.Vendor, error) {
+	ctx, span := s.startSpan(ctx, "VendorService.ListVendors", domain.ApplicationAttribute(cmd.ApplicationID))
+	defer span.End()
+
+	if cmd.ApplicationID != "" {
+		vendors, err := s.vendorRepo.FindByApplicationID(ctx, cmd.ApplicationID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load vendors of %s: %w", cmd.ApplicationID, err)
+		}
+		return vendors, nil
+	}
+	vendors, err := s.vendorRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load vendors: %w", err)
+	}
+	return vendors, nil
+}
+
+// MonitorVendors rates every vendor not offboarded again, since contracts end and questionnaires
+// go out of date with time alone, publishing a VendorRiskRatedEvent for each whose level changed
+func (s *VendorService) MonitorVendors(ctx context.Context, cmd MonitorVendorsCommand) ([]domain.Vendor, error) {
+	ctx, span := s.startSpan(ctx, "VendorService.MonitorVendors")
+	defer span.End()
+
+	if cmd.Now.IsZero() {
+		cmd.Now = s.now()
+	}
+	vendors, err := s.vendorRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load vendors: %w", err)
+	}
+
+	var monitored []domain.Vendor
+	for _, vendor := range vendors {
+		if vendor.Status == domain.VendorOffboarded {
+			continue
+		}
+		previous := s.rate(&vendor, cmd.Now)
+		err := s.vendorRepo.Update(ctx, vendor)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update vendor: %w", err)
+		}
+		s.publishRating(ctx, vendor, previous)
+		monitored = append(monitored, vendor)
+	}
+	return monitored, nil
+}
+
+// Start monitors the vendors every interval until the context is cancelled. Failures are passed
+// to onError when it is not nil.
+func (s *VendorService) Start(ctx context.Context, interval time.Duration, onError func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.MonitorVendors(ctx, MonitorVendorsCommand{}); err != nil && onError != nil {
+				onError(err)
+			}
+		}
+	}
+}
+
+// update applies a change to a vendor, rates it again and saves it
+func (s *VendorService) update(ctx context.Context, id domain.VendorID, change func(*domain.Vendor) error) (*domain.Vendor, error) {
+	vendor, err := s.vendorRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find vendor: %w", err)
+	}
+	if err := change(&vendor); err != nil {
+		return nil, err
+	}
+	if err := vendor.Validate(); err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	vendor.UpdatedAt = now
+	previous := s.rate(&vendor, now)
+	err = s.vendorRepo.Update(ctx, vendor)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update vendor: %w", err)
+	}
+	s.publishRating(ctx, vendor, previous)
+	return &vendor, nil
+}
+
+// rate rates the vendor's risk and returns the level it had before
+func (s *VendorService) rate(vendor *domain.Vendor, now time.Time) domain.RiskLevel {
+	previous := vendor.RiskRating.Level
+	vendor.RiskRating = domain.RateVendorRisk(*vendor, s.policy, now)
+	return previous
+}
+
+// publishRating publishes a VendorRiskRatedEvent when the vendor's risk level changed
+func (s *VendorService) publishRating(ctx context.Context, vendor domain.Vendor, previous domain.RiskLevel) {
+	if vendor.RiskRating.Level == previous {
+		return
+	}
+	event := domain.VendorRiskRatedEvent{
+		VendorID:      vendor.ID,
+		PreviousLevel: previous,
+		Level:         vendor.RiskRating.Level,
+		Score:         vendor.RiskRating.Score,
+		Applications:  vendor.Applications,
+		OccurredAt:    vendor.RiskRating.RatedAt,
+	}
+	err := s.eventRepo.Save(ctx, event)
+	if err != nil {
+		fmt.Printf("Failed to save domain event: %v\n", err)
+	}
+}
+
+// Commands for Vendor Service
+
+type RegisterVendorCommand struct {
+	ID           domain.VendorID
+	Name         string
+	Description  string
+	Services     string
+	Criticality  domain.Priority
+	Status       domain.VendorStatus // optional, defaults to onboarding
+	Applications []domain.ApplicationID
+}
+
+type LinkVendorApplicationCommand struct {
+	VendorID      domain.VendorID
+	ApplicationID domain.ApplicationID
+}
+
+type UnlinkVendorApplicationCommand struct {
+	VendorID      domain.VendorID
+	ApplicationID domain.ApplicationID
+}
+
+type SetVendorStatusCommand struct {
+	VendorID domain.VendorID
+	Status   domain.VendorStatus
+}
+
+type AddVendorContractCommand struct {
+	VendorID domain.VendorID
+	Contract domain.VendorContract
+}
+
+type SetVendorSLACommand struct {
+	VendorID      domain.VendorID
+	Name          string
+	Target        float64
+	LowerIsBetter bool
+}
+
+type RecordVendorSLAObservationCommand struct {
+	VendorID   domain.VendorID
+	SLA        string
+	Observed   float64
+	ObservedAt time.Time // optional, defaults to now
+}
+
+type SendVendorQuestionnaireCommand struct {
+	VendorID        domain.VendorID
+	QuestionnaireID string
+	Questions       []domain.VendorQuestion // optional, defaults to the standard due diligence questions
+}
+
+type AnswerVendorQuestionnaireCommand struct {
+	VendorID        domain.VendorID
+	QuestionnaireID string
+	Answers         map[string]domain.VendorAnswer // by question ID
+	Notes           map[string]string              // by question ID, optional
+	Complete        bool                           // every question must then be answered
+}
+
+type ListVendorsCommand struct {
+	ApplicationID domain.ApplicationID // optional, every vendor when empty
+}
+
+type MonitorVendorsCommand struct {
+	Now time.Time // optional, defaults to now
+}
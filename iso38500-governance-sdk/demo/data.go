@@ -0,0 +1,991 @@
+package demo
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/application"
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// PortfolioDefinition describes a business domain portfolio and its member applications
+type PortfolioDefinition struct {
+	ID           domain.PortfolioID
+	Name         string
+	Description  string
+	Owner        string
+	Applications []domain.ApplicationID
+	Thresholds   *domain.PortfolioThresholds
+}
+
+// GovernedApplicationIDs returns the applications that receive a governance agreement
+func GovernedApplicationIDs() []domain.ApplicationID {
+	return []domain.ApplicationID{
+		"erp-core-001", "crm-global-001", "scm-supply-001", "hr-talent-001", "finance-budget-001",
+		"infra-monitoring-001", "security-siem-001", "backup-enterprise-001",
+		"analytics-bi-001", "data-warehouse-001", "reporting-executive-001",
+		"legacy-hr-001", "legacy-finance-001", "procure-source-001",
+	}
+}
+
+// EnterprisePortfolios returns the business domain portfolios used by the demo
+func EnterprisePortfolios() []PortfolioDefinition {
+	return []PortfolioDefinition{
+		{
+			ID:           "portfolio-core-business",
+			Name:         "Core Business Systems Portfolio",
+			Description:  "Mission-critical business applications supporting core operations",
+			Owner:        "Chief Information Officer",
+			Applications: []domain.ApplicationID{"erp-core-001", "crm-global-001", "scm-supply-001"},
+		},
+		{
+			ID:           "portfolio-hr-finance",
+			Name:         "HR & Finance Systems Portfolio",
+			Description:  "Human resources and financial management applications",
+			Owner:        "Chief Financial Officer",
+			Applications: []domain.ApplicationID{"hr-talent-001", "finance-budget-001"},
+		},
+		{
+			ID:           "portfolio-infrastructure",
+			Name:         "IT Infrastructure Portfolio",
+			Description:  "Core IT infrastructure and security systems",
+			Owner:        "Chief Technology Officer",
+			Applications: []domain.ApplicationID{"infra-monitoring-001", "security-siem-001", "backup-enterprise-001"},
+		},
+		{
+			ID:           "portfolio-analytics",
+			Name:         "Business Intelligence Portfolio",
+			Description:  "Data analytics and business intelligence platforms",
+			Owner:        "Chief Data Officer",
+			Applications: []domain.ApplicationID{"analytics-bi-001", "data-warehouse-001", "reporting-executive-001"},
+		},
+		{
+			ID:           "portfolio-legacy-migration",
+			Name:         "Legacy System Migration Portfolio",
+			Description:  "Applications targeted for modernization or retirement",
+			Owner:        "IT Transformation Director",
+			Applications: []domain.ApplicationID{"legacy-hr-001", "legacy-finance-001", "procure-source-001"},
+			// Systems awaiting migration are expected to run less cost-efficiently
+			Thresholds: &domain.PortfolioThresholds{
+				RiskThresholds: &domain.RiskThresholds{
+					CriticalMaxTechnicalScore: 2,
+					HighMaxTechnicalScore:     3,
+					MediumMaxTechnicalScore:   4,
+					CriticalMinCostEfficiency: 30,
+					HighMinCostEfficiency:     50,
+				},
+				KPITolerance: 10,
+			},
+		},
+	}
+}
+
+// StrategicObjectives returns the demo strategic objectives keyed by application
+func StrategicObjectives() map[domain.ApplicationID][]domain.StrategicObjective {
+	return map[domain.ApplicationID][]domain.StrategicObjective{
+		"erp-core-001": {
+			{
+				ID:          "erp-digital-transformation",
+				Name:        "Digital Transformation of Core ERP",
+				Description: "Modernize ERP system with cloud capabilities and AI-driven insights",
+				KPIs: []domain.KPI{
+					{ID: "erp-cloud-workloads", Name: "Workloads running in the cloud", Target: 80, Unit: "%", Category: "Transformation", Frequency: "quarterly"},
+					{ID: "erp-close-duration", Name: "Financial close duration", Target: 3, Unit: "days", Category: "Efficiency", Frequency: "monthly"},
+				},
+				Deadline: time.Now().AddDate(2, 0, 0),
+				Contributions: []domain.ObjectiveContribution{
+					{ApplicationID: "erp-core-001", Weight: 0.6},
+					{ApplicationID: "crm-global-001", Weight: 0.25},
+					{ApplicationID: "scm-supply-001", Weight: 0.15},
+				},
+			},
+		},
+		"hr-talent-001": {
+			{
+				ID:          "hr-employee-experience",
+				Name:        "Enhance Employee Experience",
+				Description: "Implement modern HR technologies for better employee engagement",
+				KPIs: []domain.KPI{
+					{ID: "hr-engagement-score", Name: "Employee engagement score", Target: 75, Unit: "points", Category: "Experience", Frequency: "quarterly"},
+				},
+				Deadline: time.Now().AddDate(1, 6, 0),
+			},
+		},
+		"analytics-bi-001": {
+			{
+				ID:          "analytics-ai-ml",
+				Name:        "AI/ML-Driven Business Intelligence",
+				Description: "Implement predictive analytics and machine learning capabilities",
+				Deadline:    time.Now().AddDate(1, 0, 0),
+				Contributions: []domain.ObjectiveContribution{
+					{ApplicationID: "analytics-bi-001", Weight: 0.7},
+					{ApplicationID: "data-warehouse-001", Weight: 0.3},
+				},
+			},
+		},
+	}
+}
+
+// StrategicInitiatives returns the demo strategic initiatives keyed by application
+func StrategicInitiatives() map[domain.ApplicationID][]domain.StrategicInitiative {
+	return map[domain.ApplicationID][]domain.StrategicInitiative{
+		"erp-core-001": {
+			{
+				ID:           "erp-cloud-migration",
+				Name:         "ERP Cloud Migration",
+				Description:  "Migrate ERP to cloud infrastructure",
+				Owner:        "ERP Transformation Team",
+				Budget:       2000000,
+				Deadline:     time.Now().AddDate(1, 0, 0),
+				ObjectiveIDs: []string{"erp-digital-transformation"},
+				Milestones: []domain.InitiativeMilestone{
+					{ID: "erp-landing-zone", Name: "Cloud landing zone ready", DueDate: time.Now().AddDate(0, 2, 0)},
+					{ID: "erp-finance-cutover", Name: "Finance modules cut over", DueDate: time.Now().AddDate(0, 7, 0)},
+					{ID: "erp-decommission", Name: "On-premise ERP decommissioned", DueDate: time.Now().AddDate(1, 0, 0)},
+				},
+				ResourceDemands: []domain.ResourceDemand{
+					{Role: "Cloud Engineer", FTE: 3, PeriodEnd: time.Now().AddDate(0, 7, 0)},
+					{Role: "Finance Analyst", FTE: 1},
+				},
+			},
+		},
+		"hr-talent-001": {
+			{
+				ID:           "hr-mobile-app",
+				Name:         "Employee Mobile App",
+				Description:  "Develop mobile app for employee self-service",
+				Owner:        "HR Technology Team",
+				Budget:       750000,
+				Deadline:     time.Now().AddDate(0, 9, 0),
+				ObjectiveIDs: []string{"hr-employee-experience"},
+				Milestones: []domain.InitiativeMilestone{
+					{ID: "hr-app-beta", Name: "Beta released to pilot group", DueDate: time.Now().AddDate(0, 4, 0)},
+					{ID: "hr-app-launch", Name: "App launched to all employees", DueDate: time.Now().AddDate(0, 9, 0)},
+				},
+				ResourceDemands: []domain.ResourceDemand{
+					{Role: "Mobile Developer", FTE: 2},
+				},
+			},
+		},
+	}
+}
+
+// PortfolioOKRs returns the demo portfolio-level OKRs keyed by portfolio
+func PortfolioOKRs() map[domain.PortfolioID][]domain.OKR {
+	return map[domain.PortfolioID][]domain.OKR{
+		"portfolio-core-business": {
+			{
+				ID:          "okr-core-cloud-first",
+				Objective:   "Run the core business on the cloud",
+				Owner:       "Chief Operating Officer",
+				PeriodStart: time.Now().AddDate(0, -3, 0),
+				PeriodEnd:   time.Now().AddDate(0, 9, 0),
+				KeyResults: []domain.KeyResult{
+					{ID: "kr-core-cloud-workloads", Description: "Core workloads running in the cloud", KPIID: "erp-cloud-workloads", Baseline: 20, Target: 80, Unit: "%"},
+					{ID: "kr-core-datacenter-exit", Description: "Data center racks released", Baseline: 0, Target: 40, Unit: "racks"},
+				},
+			},
+		},
+	}
+}
+
+// ApplicationOKRs returns the demo application-level OKRs keyed by application
+func ApplicationOKRs() map[domain.ApplicationID][]domain.OKR {
+	return map[domain.ApplicationID][]domain.OKR{
+		"erp-core-001": {
+			{
+				ID:          "okr-erp-cloud",
+				Objective:   "Move ERP finance to the cloud",
+				Owner:       "ERP Transformation Team",
+				ParentID:    "okr-core-cloud-first",
+				ObjectiveID: "erp-digital-transformation",
+				PeriodStart: time.Now().AddDate(0, -3, 0),
+				PeriodEnd:   time.Now().AddDate(0, 9, 0),
+				KeyResults: []domain.KeyResult{
+					{ID: "kr-erp-cloud-workloads", Description: "ERP workloads running in the cloud", KPIID: "erp-cloud-workloads", Baseline: 10, Target: 80, Unit: "%"},
+					{ID: "kr-erp-close-duration", Description: "Financial close shortened", KPIID: "erp-close-duration", Baseline: 8, Target: 3, Unit: "days"},
+				},
+			},
+		},
+	}
+}
+
+// KeyResultUpdates returns the demo key result actuals keyed by application
+func KeyResultUpdates() map[domain.ApplicationID][]application.RecordKeyResultCommand {
+	return map[domain.ApplicationID][]application.RecordKeyResultCommand{
+		"erp-core-001": {
+			{OKRID: "okr-erp-cloud", KeyResultID: "kr-erp-cloud-workloads", Actual: 45, RecordedBy: "ERP Transformation Team"},
+			{OKRID: "okr-erp-cloud", KeyResultID: "kr-erp-close-duration", Actual: 6, RecordedBy: "Chief Financial Officer"},
+		},
+	}
+}
+
+// GovernanceDecisions returns the demo governance decisions in the order they were taken
+func GovernanceDecisions() []application.RecordDecisionCommand {
+	return []application.RecordDecisionCommand{
+		{
+			ID:      "dec-erp-hosting-2025",
+			Title:   "Keep ERP hosting on premise",
+			Context: "The ERP vendor's cloud offering lacked regional data residency for financial records",
+			Options: []domain.DecisionOption{
+				{Name: "Stay on premise", Pros: []string{"Data residency guaranteed"}, Cons: []string{"Hardware refresh due within two years"}},
+				{Name: "Move to the vendor cloud", Pros: []string{"No hardware refresh"}, Cons: []string{"Records hosted outside the region"}},
+			},
+			Outcome:      "ERP stays in the corporate data center until regional hosting is available",
+			Rationale:    "Data residency is a statutory requirement for financial records",
+			Consequences: "The data center hardware is refreshed for two more years",
+			DecidedBy:    "Enterprise Architecture Board",
+			DecidedAt:    time.Now().AddDate(-1, 0, 0),
+			AgreementID:  "gov-erp-core-001",
+		},
+		{
+			ID:      "dec-erp-cloud-migration",
+			Title:   "Migrate ERP to the cloud",
+			Context: "The ERP vendor opened a regional cloud with data residency for financial records",
+			Options: []domain.DecisionOption{
+				{Name: "Migrate to the regional cloud", Pros: []string{"Avoids the next hardware refresh", "Faster financial close"}, Cons: []string{"Migration effort"}},
+				{Name: "Stay on premise", Pros: []string{"No migration risk"}, Cons: []string{"Hardware refresh and rising support cost"}},
+			},
+			Outcome:      "ERP moves to the vendor's regional cloud through the ERP Cloud Migration initiative",
+			Rationale:    "The residency constraint behind the earlier decision no longer applies",
+			Consequences: "The on-premise ERP is decommissioned once finance modules are cut over",
+			DecidedBy:    "Enterprise Architecture Board",
+			AgreementID:  "gov-erp-core-001",
+			Supersedes:   "dec-erp-hosting-2025",
+		},
+	}
+}
+
+// InitiativeProgressUpdates returns the demo initiative status updates keyed by application
+func InitiativeProgressUpdates() map[domain.ApplicationID][]application.UpdateInitiativeProgressCommand {
+	return map[domain.ApplicationID][]application.UpdateInitiativeProgressCommand{
+		"erp-core-001": {
+			{
+				InitiativeID:        "erp-cloud-migration",
+				PercentComplete:     35,
+				CompletedMilestones: []string{"erp-landing-zone"},
+				Note:                "Landing zone accepted by the security team",
+				UpdatedBy:           "ERP Transformation Team",
+			},
+		},
+		"hr-talent-001": {
+			{
+				InitiativeID:    "hr-mobile-app",
+				PercentComplete: 20,
+				Status:          domain.InitiativeAtRisk,
+				Note:            "Vendor onboarding slipped by three weeks",
+				UpdatedBy:       "HR Technology Team",
+			},
+		},
+	}
+}
+
+// ActionPlans returns the demo action plans with dependencies between their actions, keyed by
+// application
+func ActionPlans() map[domain.ApplicationID][]domain.ActionPlan {
+	day := 24 * time.Hour
+	return map[domain.ApplicationID][]domain.ActionPlan{
+		"erp-core-001": {
+			{
+				ID:          "ap-erp-cloud-cutover",
+				Name:        "ERP cloud cutover",
+				Description: "Move ERP finance to the cloud and decommission the data center installation",
+				Owner:       "ERP Transformation Team",
+				ObjectiveID: "erp-digital-transformation",
+				Deadline:    time.Now().AddDate(1, 10, 0),
+				Actions: []domain.Action{
+					{ID: "landing-zone", Description: "Build the cloud landing zone", Responsible: "Cloud Platform Team", Duration: 60 * day},
+					{ID: "data-migration", Description: "Migrate finance data", Responsible: "ERP Transformation Team", Duration: 300 * day, DependsOn: []string{"landing-zone"}},
+					{ID: "user-training", Description: "Train finance users", Responsible: "Change Management", Duration: 90 * day, DependsOn: []string{"landing-zone"}},
+					{ID: "finance-cutover", Description: "Cut finance modules over", Responsible: "ERP Transformation Team", Duration: 120 * day, DependsOn: []string{"data-migration", "user-training"}},
+					{ID: "decommission", Description: "Decommission the on-premise ERP", Responsible: "Infrastructure Team", Duration: 90 * day, DependsOn: []string{"finance-cutover"}},
+				},
+			},
+		},
+	}
+}
+
+// ActionUpdates returns the demo progress on actions keyed by application
+func ActionUpdates() map[domain.ApplicationID][]application.UpdateActionCommand {
+	day := 24 * time.Hour
+	return map[domain.ApplicationID][]application.UpdateActionCommand{
+		"erp-core-001": {
+			{PlanID: "ap-erp-cloud-cutover", ActionID: "landing-zone", Status: domain.ActionCompleted},
+			{PlanID: "ap-erp-cloud-cutover", ActionID: "data-migration", Status: domain.ActionInProgress, Duration: 540 * day},
+		},
+	}
+}
+
+// KPIMeasurements returns the demo measurements of the objective KPIs, oldest first
+func KPIMeasurements() []application.RecordKPIMeasurementCommand {
+	now := time.Now()
+	return []application.RecordKPIMeasurementCommand{
+		{KPIID: "erp-cloud-workloads", Value: 35, MeasuredAt: now.AddDate(0, -3, 0), Notes: "Before the landing zone", RecordedBy: "ERP Transformation Team"},
+		{KPIID: "erp-close-duration", Value: 6, MeasuredAt: now.AddDate(0, -1, 0), RecordedBy: "Finance Operations"},
+		{KPIID: "erp-cloud-workloads", Value: 52, MeasuredAt: now.AddDate(0, 0, -7), Notes: "Reporting workloads migrated", RecordedBy: "ERP Transformation Team"},
+		{KPIID: "erp-close-duration", Value: 3, MeasuredAt: now.AddDate(0, 0, -2), RecordedBy: "Finance Operations"},
+		{KPIID: "hr-engagement-score", Value: 71, MeasuredAt: now.AddDate(0, 0, -14), Notes: "Quarterly pulse survey", RecordedBy: "HR Analytics"},
+	}
+}
+
+// AvailabilityMeasurements returns daily uptime and response time readings of the ERP over the
+// last week, oldest first, including a financial close outage that burns through its availability
+// error budget
+func AvailabilityMeasurements() []application.RecordAvailabilityMeasurementCommand {
+	now := time.Now()
+	uptimes := []float64{99.98, 99.97, 99.99, 98.9, 99.95, 99.4, 99.96}
+	responseTimes := []time.Duration{1200, 1350, 1100, 1900, 1250, 1700, 1150}
+
+	measurements := make([]application.RecordAvailabilityMeasurementCommand, len(uptimes))
+	for i := range uptimes {
+		end := now.AddDate(0, 0, i-len(uptimes)+1)
+		measurements[i] = application.RecordAvailabilityMeasurementCommand{
+			ID:               fmt.Sprintf("erp-availability-%s", end.Format("2006-01-02")),
+			ApplicationID:    "erp-core-001",
+			UptimePercentage: uptimes[i],
+			ResponseTime:     responseTimes[i] * time.Millisecond,
+			PeriodStart:      end.AddDate(0, 0, -1),
+			PeriodEnd:        end,
+			Source:           "Datadog",
+		}
+	}
+	return measurements
+}
+
+// AlertConfigurations returns the demo alert thresholds keyed by application
+func AlertConfigurations() map[domain.ApplicationID][]application.ConfigureAlertsCommand {
+	return map[domain.ApplicationID][]application.ConfigureAlertsCommand{
+		"erp-core-001": {
+			{
+				Source:  domain.AlertSourceKPI,
+				Subject: "erp-cloud-workloads",
+				Thresholds: []domain.Threshold{
+					{Level: "warning", Value: 60, Condition: "<"},
+					{Level: "critical", Value: 40, Condition: "<"},
+				},
+				Alerts:      []domain.Alert{{Type: "email", Recipient: "ERP Transformation Team", Message: "Cloud migration is behind plan", Escalation: "CIO"}},
+				Frequency:   "monthly",
+				Responsible: "ERP Transformation Team",
+			},
+			{
+				Source:  domain.AlertSourceRiskIndicator,
+				Subject: "Technical Debt",
+				Thresholds: []domain.Threshold{
+					{Level: "warning", Value: 70, Condition: ">="},
+					{Level: "critical", Value: 90, Condition: ">="},
+				},
+				Alerts: []domain.Alert{{Type: "email", Recipient: "Enterprise Architecture Board", Message: "Technical debt is building up"}},
+			},
+		},
+	}
+}
+
+// GovernancePolicies returns the demo policies keyed by application
+func GovernancePolicies() map[domain.ApplicationID][]domain.Policy {
+	return map[domain.ApplicationID][]domain.Policy{
+		"erp-core-001": {
+			{
+				ID:          "pol-erp-data-retention",
+				Name:        "ERP Data Retention",
+				Description: "Financial records are retained for ten years and purged afterwards",
+				Scope:       "ERP financial data",
+				Owner:       "Chief Financial Officer",
+			},
+			{
+				ID:          "pol-erp-change-freeze",
+				Name:        "Period-End Change Freeze",
+				Description: "No production changes during the financial close",
+				Scope:       "ERP production environment",
+				Owner:       "ERP Transformation Team",
+			},
+		},
+	}
+}
+
+// PolicyRevisions returns the demo policy revisions keyed by application
+func PolicyRevisions() map[domain.ApplicationID][]application.RevisePolicyCommand {
+	return map[domain.ApplicationID][]application.RevisePolicyCommand{
+		"erp-core-001": {
+			{
+				Policy: domain.Policy{
+					ID:          "pol-erp-data-retention",
+					Name:        "ERP Data Retention",
+					Description: "Financial records are retained for seven years in line with the tax authority and purged afterwards",
+					Scope:       "ERP financial data",
+					Owner:       "Chief Financial Officer",
+				},
+				ChangedBy: "Group Legal",
+				Summary:   "Align retention with the statutory period",
+			},
+		},
+	}
+}
+
+// ConformanceRequirements returns the demo legal, contractual and industry standard requirements
+// keyed by application
+func ConformanceRequirements() map[domain.ApplicationID]domain.Conformance {
+	return map[domain.ApplicationID]domain.Conformance{
+		"erp-core-001": {
+			LegalRequirements: []domain.LegalRequirement{
+				{Name: "Statutory record retention", Description: "Retain accounting records for the statutory period", Authority: "Tax Authority", Status: domain.ComplianceCompliant, Criticality: domain.PriorityCritical},
+			},
+			ContractualRequirements: []domain.ContractualRequirement{
+				{Name: "Hosting data processing agreement", Description: "Process personal data only in approved regions", ContractID: "MSA-2024-017", Party: "Cloud Hosting Provider", Status: domain.ComplianceUnderReview, ExpiresAt: time.Now().AddDate(0, 0, 45)},
+			},
+			IndustryStandards: []domain.IndustryStandard{
+				{Name: "SOX IT general controls", Description: "Change management controls over financial reporting systems", Organization: "PCAOB", Version: "AS 2201", Status: domain.CompliancePartial, Criticality: domain.PriorityHigh, ExpiresAt: time.Now().AddDate(1, 0, 0)},
+			},
+			ComplianceMonitoring: domain.ComplianceMonitoring{
+				MonitoringFrequency: "monthly",
+				ResponsibleParties:  []string{"Finance Compliance Office"},
+			},
+		},
+	}
+}
+
+// ComplianceStatusChanges returns the demo changes of requirement status recorded after
+// compliance was first monitored
+func ComplianceStatusChanges() []application.RecordComplianceStatusCommand {
+	return []application.RecordComplianceStatusCommand{
+		{
+			AgreementID: "gov-erp-core-001",
+			Requirement: domain.RequirementRef{Kind: domain.RequirementLegal, Name: "Statutory record retention"},
+			Status:      domain.ComplianceNonCompliant,
+		},
+		{
+			AgreementID: "gov-erp-core-001",
+			Requirement: domain.RequirementRef{Kind: domain.RequirementContractual, Name: "Hosting data processing agreement"},
+			Status:      domain.ComplianceCompliant,
+		},
+	}
+}
+
+// StakeholderSurveys returns the demo stakeholder surveys, keyed by application
+func StakeholderSurveys() map[domain.ApplicationID][]domain.Survey {
+	return map[domain.ApplicationID][]domain.Survey{
+		"erp-core-001": {
+			{
+				ID:        "erp-finance-users-2026",
+				Name:      "ERP finance user satisfaction",
+				Frequency: "annually",
+				Questions: []string{"How satisfied are you with the ERP overall?", "How reliable is month-end closing?", "How easy is it to find the reports you need?"},
+				Invited:   6,
+			},
+		},
+	}
+}
+
+// SurveyResponses returns the demo answers to the stakeholder surveys
+func SurveyResponses() []application.RecordSurveyResponseCommand {
+	answers := func(scores ...int) []domain.SurveyResponse {
+		responses := make([]domain.SurveyResponse, len(scores))
+		for i, score := range scores {
+			responses[i] = domain.SurveyResponse{QuestionID: domain.SurveyQuestionID(i), Score: score}
+		}
+		return responses
+	}
+
+	finance := answers(4, 5, 2)
+	finance[2].Response = "Reporting needs too many clicks"
+	return []application.RecordSurveyResponseCommand{
+		{AgreementID: "gov-erp-core-001", SurveyID: "erp-finance-users-2026", Respondent: "Finance Controller", Answers: finance},
+		{AgreementID: "gov-erp-core-001", SurveyID: "erp-finance-users-2026", Respondent: "Accounts Payable Lead", Answers: answers(4, 4, 3)},
+		{AgreementID: "gov-erp-core-001", SurveyID: "erp-finance-users-2026", Answers: answers(3, 4, 2)},
+		{AgreementID: "gov-erp-core-001", SurveyID: "erp-finance-users-2026", Answers: answers(5, 5, 3)},
+	}
+}
+
+// RequirementMappings returns the demo mappings of policies to the requirements they implement,
+// keyed by application
+func RequirementMappings() map[domain.ApplicationID][]application.MapRequirementCommand {
+	return map[domain.ApplicationID][]application.MapRequirementCommand{
+		"erp-core-001": {
+			{
+				Requirement:  domain.RequirementRef{Kind: domain.RequirementLegal, Name: "Statutory record retention"},
+				DocumentKind: domain.DocumentPolicy,
+				DocumentID:   "pol-erp-data-retention",
+				Notes:        "Retention period and purge schedule",
+				MappedBy:     "Group Legal",
+			},
+			{
+				Requirement:  domain.RequirementRef{Kind: domain.RequirementIndustryStandard, Name: "SOX IT general controls"},
+				DocumentKind: domain.DocumentPolicy,
+				DocumentID:   "pol-erp-change-freeze",
+				Notes:        "Change control during the financial close",
+				MappedBy:     "Internal Audit",
+			},
+		},
+	}
+}
+
+// BudgetAllocations returns the demo budget allocations keyed by application
+func BudgetAllocations() map[domain.ApplicationID][]domain.BudgetAllocation {
+	return map[domain.ApplicationID][]domain.BudgetAllocation{
+		"erp-core-001": {
+			{
+				Category:      "Cloud Migration",
+				Amount:        2000000,
+				Timeframe:     "FY",
+				Justification: "ERP cloud migration programme",
+				StartDate:     time.Now().AddDate(0, -4, 0),
+				EndDate:       time.Now().AddDate(0, 8, 0),
+			},
+			{
+				Category:       "Change Management",
+				Amount:         250000,
+				Timeframe:      "FY",
+				Justification:  "Training and communications for finance users",
+				StartDate:      time.Now().AddDate(0, -4, 0),
+				EndDate:        time.Now().AddDate(0, 8, 0),
+				AlertThreshold: 75,
+			},
+		},
+		"hr-talent-001": {
+			{
+				Category:      "Mobile App Development",
+				Amount:        750000,
+				Timeframe:     "9 months",
+				Justification: "Employee self-service mobile app",
+				StartDate:     time.Now().AddDate(0, -3, 0),
+				EndDate:       time.Now().AddDate(0, 6, 0),
+			},
+		},
+	}
+}
+
+// PersonnelAllocations returns the demo personnel allocations keyed by application
+func PersonnelAllocations() map[domain.ApplicationID][]domain.PersonnelAllocation {
+	return map[domain.ApplicationID][]domain.PersonnelAllocation{
+		"erp-core-001": {
+			{Role: "Cloud Engineer", Count: 2, SkillLevel: "senior", Timeframe: "FY", PeriodStart: time.Now().AddDate(0, -4, 0), PeriodEnd: time.Now().AddDate(0, 8, 0)},
+			{Role: "Finance Analyst", Count: 2, FTE: 1.5, SkillLevel: "intermediate", Timeframe: "FY"},
+		},
+		"hr-talent-001": {
+			{Role: "Mobile Developer", Count: 2, SkillLevel: "intermediate", Timeframe: "9 months", PeriodStart: time.Now().AddDate(0, -3, 0), PeriodEnd: time.Now().AddDate(0, 9, 0)},
+		},
+	}
+}
+
+// Expenditures returns the demo spend recorded against budget allocations, keyed by application
+func Expenditures() map[domain.ApplicationID][]domain.Expenditure {
+	return map[domain.ApplicationID][]domain.Expenditure{
+		"erp-core-001": {
+			{Category: "Cloud Migration", Amount: 600000, Description: "Landing zone and data migration tooling", RecordedBy: "ERP Transformation Team", SpentAt: time.Now().AddDate(0, -3, 0)},
+			{Category: "Cloud Migration", Amount: 450000, Description: "Systems integrator, first phase", RecordedBy: "ERP Transformation Team", SpentAt: time.Now().AddDate(0, -1, 0)},
+			{Category: "Change Management", Amount: 195000, Description: "Finance user training", RecordedBy: "ERP Transformation Team", SpentAt: time.Now().AddDate(0, -2, 0)},
+		},
+		"hr-talent-001": {
+			{Category: "Mobile App Development", Amount: 150000, Description: "Vendor onboarding and design sprint", RecordedBy: "HR Technology Team", SpentAt: time.Now().AddDate(0, -2, 0)},
+		},
+	}
+}
+
+// IdentifiedRisks returns the demo risks keyed by application
+func IdentifiedRisks() map[domain.ApplicationID][]domain.Risk {
+	return map[domain.ApplicationID][]domain.Risk{
+		"erp-core-001": {
+			{
+				ID:          "risk-erp-outage",
+				Name:        "Month-end close outage",
+				Description: "ERP unavailable during the financial close",
+				Category:    "availability",
+				Probability: 0.15,
+				Impact:      domain.ImpactCritical,
+				Level:       domain.RiskHigh,
+				Loss:        domain.LossEstimate{Minimum: 200000, MostLikely: 750000, Maximum: 3000000},
+			},
+			{
+				ID:          "risk-erp-vendor",
+				Name:        "Vendor support ending",
+				Category:    "supplier",
+				Probability: 0.3,
+				Impact:      domain.ImpactMedium,
+				Level:       domain.RiskMedium,
+			},
+		},
+		"crm-global-001": {
+			{
+				ID:          "risk-crm-privacy",
+				Name:        "Customer data breach",
+				Category:    "security",
+				Probability: 0.05,
+				Impact:      domain.ImpactCritical,
+				Level:       domain.RiskHigh,
+			},
+		},
+		"security-siem-001": {
+			{
+				ID:          "risk-siem-coverage",
+				Name:        "Undetected intrusion",
+				Category:    "security",
+				Probability: 0.1,
+				Impact:      domain.ImpactHigh,
+				Level:       domain.RiskHigh,
+			},
+		},
+		"analytics-bi-001": {
+			{
+				ID:          "risk-bi-quality",
+				Name:        "Decisions made on stale data",
+				Category:    "data quality",
+				Probability: 0.4,
+				Impact:      domain.ImpactLow,
+				Level:       domain.RiskLow,
+			},
+		},
+		"legacy-hr-001": {
+			{
+				ID:          "risk-legacy-hr-failure",
+				Name:        "Unsupported platform failure",
+				Category:    "obsolescence",
+				Probability: 0.25,
+				Impact:      domain.ImpactHigh,
+				Level:       domain.RiskHigh,
+			},
+		},
+	}
+}
+
+// CountByCategory counts applications by business category
+func CountByCategory(apps []domain.Application, category string) int {
+	count := 0
+	for _, app := range apps {
+		if app.Category == category {
+			count++
+		}
+	}
+	return count
+}
+
+// EnterpriseApplications returns the enterprise application landscape used by the demo
+func EnterpriseApplications() []domain.Application {
+	now := time.Now()
+
+	return []domain.Application{
+		// Core Business Systems
+		{
+			ID:          "erp-core-001",
+			Category:    "Core Business",
+			Criticality: domain.PriorityCritical,
+			Name:        "Enterprise Resource Planning (ERP)",
+			Description: "Integrated enterprise resource planning system managing core business processes",
+			Version:     "2024.2.1",
+			Status:      domain.StatusActive,
+			CreatedAt:   now.AddDate(-3, 0, 0),
+			UpdatedAt:   now,
+			SecurityProvisions: domain.SecurityProvisions{
+				DataConfidentiality: []domain.SecurityMeasure{
+					{Name: "AES-256 Encryption", Description: "End-to-end data encryption", Status: domain.SecurityImplemented},
+				},
+				DataIntegrity: []domain.SecurityMeasure{
+					{Name: "Data Validation", Description: "Comprehensive data validation rules", Status: domain.SecurityImplemented},
+				},
+				ApplicationAvailability: domain.SLA{
+					ServiceName:  "ERP Core Services",
+					ResponseTime: time.Second * 2,
+					Availability: 99.9,
+				},
+			},
+			Cost:               domain.ApplicationCost{License: 180000, Infrastructure: 90000, Support: 60000, Personnel: 120000, Acquisition: 1200000, Currency: "USD"},
+			DataClassification: domain.DataClassification{Level: domain.DataConfidential},
+		},
+		{
+			ID:                 "crm-global-001",
+			Category:           "Core Business",
+			Criticality:        domain.PriorityCritical,
+			Name:               "Global Customer Relationship Management",
+			Description:        "Unified CRM system for customer management across all business units",
+			Version:            "12.8.0",
+			Status:             domain.StatusActive,
+			CreatedAt:          now.AddDate(-2, 0, 0),
+			UpdatedAt:          now,
+			Cost:               domain.ApplicationCost{License: 120000, Infrastructure: 40000, Support: 30000, Personnel: 60000, Acquisition: 400000, Currency: "USD"},
+			DataClassification: domain.DataClassification{Level: domain.DataConfidential, PII: true},
+		},
+		{
+			ID:                 "scm-supply-001",
+			Category:           "Core Business",
+			Criticality:        domain.PriorityHigh,
+			Name:               "Supply Chain Management",
+			Description:        "End-to-end supply chain visibility and management platform",
+			Version:            "9.4.3",
+			Status:             domain.StatusActive,
+			CreatedAt:          now.AddDate(-1, -6, 0),
+			UpdatedAt:          now,
+			Cost:               domain.ApplicationCost{License: 90000, Infrastructure: 50000, Support: 25000, Personnel: 70000, Currency: "USD"},
+			DataClassification: domain.DataClassification{Level: domain.DataInternal},
+		},
+
+		// Operational Systems
+		{
+			ID:                 "hr-talent-001",
+			Category:           "Operational",
+			Name:               "Talent Management Suite",
+			Description:        "Comprehensive HR and talent management platform",
+			Version:            "8.2.1",
+			Status:             domain.StatusActive,
+			CreatedAt:          now.AddDate(-1, 0, 0),
+			UpdatedAt:          now,
+			Cost:               domain.ApplicationCost{License: 60000, Infrastructure: 20000, Support: 15000, Personnel: 40000, Currency: "USD"},
+			DataClassification: domain.DataClassification{Level: domain.DataRestricted, PII: true},
+		},
+		{
+			ID:                 "finance-budget-001",
+			Category:           "Operational",
+			Name:               "Enterprise Budgeting & Forecasting",
+			Description:        "Advanced financial planning and budgeting system",
+			Version:            "15.7.0",
+			Status:             domain.StatusActive,
+			CreatedAt:          now.AddDate(-2, -3, 0),
+			UpdatedAt:          now,
+			Cost:               domain.ApplicationCost{License: 45000, Infrastructure: 15000, Support: 10000, Personnel: 30000, Currency: "USD"},
+			DataClassification: domain.DataClassification{Level: domain.DataConfidential},
+			Interfaces: []domain.ApplicationInterface{
+				{ID: "fin-erp-gl", Name: "General ledger API", Type: domain.InterfaceAPI, Protocol: "REST", Status: domain.InterfaceActive, Provider: "erp-core-001"},
+			},
+		},
+		{
+			ID:                 "procure-source-001",
+			Category:           "Operational",
+			Name:               "Strategic Sourcing Platform",
+			Description:        "Supplier management and strategic procurement system",
+			Version:            "6.9.2",
+			Status:             domain.StatusDeprecated,
+			CreatedAt:          now.AddDate(-4, 0, 0),
+			UpdatedAt:          now,
+			Cost:               domain.ApplicationCost{License: 40000, Infrastructure: 15000, Support: 10000, Personnel: 25000, Currency: "USD"},
+			DataClassification: domain.DataClassification{Level: domain.DataInternal},
+		},
+
+		// Infrastructure Systems
+		{
+			ID:                 "infra-monitoring-001",
+			Category:           "Infrastructure",
+			Name:               "Infrastructure Monitoring Platform",
+			Description:        "Unified monitoring and alerting for all IT infrastructure",
+			Version:            "4.2.8",
+			Status:             domain.StatusActive,
+			CreatedAt:          now.AddDate(-1, -8, 0),
+			UpdatedAt:          now,
+			Cost:               domain.ApplicationCost{License: 30000, Infrastructure: 40000, Support: 10000, Personnel: 50000, Currency: "USD"},
+			DataClassification: domain.DataClassification{Level: domain.DataInternal},
+		},
+		{
+			ID:                 "security-siem-001",
+			Category:           "Infrastructure",
+			Name:               "Security Information & Event Management",
+			Description:        "Enterprise security monitoring and threat detection",
+			Version:            "3.1.5",
+			Status:             domain.StatusActive,
+			CreatedAt:          now.AddDate(-1, -2, 0),
+			UpdatedAt:          now,
+			Cost:               domain.ApplicationCost{License: 85000, Infrastructure: 45000, Support: 20000, Personnel: 90000, Currency: "USD"},
+			DataClassification: domain.DataClassification{Level: domain.DataRestricted},
+		},
+		{
+			ID:                 "backup-enterprise-001",
+			Category:           "Infrastructure",
+			Name:               "Enterprise Backup & Recovery",
+			Description:        "Comprehensive data backup and disaster recovery platform",
+			Version:            "11.0.3",
+			Status:             domain.StatusActive,
+			CreatedAt:          now.AddDate(-2, -6, 0),
+			UpdatedAt:          now,
+			Cost:               domain.ApplicationCost{License: 25000, Infrastructure: 60000, Support: 10000, Personnel: 20000, Currency: "USD"},
+			DataClassification: domain.DataClassification{Level: domain.DataRestricted, PII: true},
+		},
+
+		// Analytical Systems
+		{
+			ID:                 "analytics-bi-001",
+			Category:           "Analytics",
+			Name:               "Business Intelligence Platform",
+			Description:        "Enterprise BI and analytics for decision support",
+			Version:            "7.4.1",
+			Status:             domain.StatusActive,
+			CreatedAt:          now.AddDate(-1, -4, 0),
+			UpdatedAt:          now,
+			Cost:               domain.ApplicationCost{License: 70000, Infrastructure: 35000, Support: 15000, Personnel: 45000, Currency: "USD"},
+			DataClassification: domain.DataClassification{Level: domain.DataConfidential},
+			Interfaces: []domain.ApplicationInterface{
+				{ID: "bi-dwh-query", Name: "Warehouse query", Type: domain.InterfaceDatabase, Protocol: "JDBC", Status: domain.InterfaceActive, Provider: "data-warehouse-001"},
+			},
+		},
+		{
+			ID:                 "data-warehouse-001",
+			Category:           "Analytics",
+			Name:               "Enterprise Data Warehouse",
+			Description:        "Centralized data warehouse for enterprise analytics",
+			Version:            "5.8.9",
+			Status:             domain.StatusActive,
+			CreatedAt:          now.AddDate(-3, -2, 0),
+			UpdatedAt:          now,
+			Cost:               domain.ApplicationCost{License: 50000, Infrastructure: 120000, Support: 20000, Personnel: 80000, Acquisition: 600000, Currency: "USD"},
+			DataClassification: domain.DataClassification{Level: domain.DataConfidential, PII: true},
+			Interfaces: []domain.ApplicationInterface{
+				{ID: "dwh-erp-extract", Name: "ERP nightly extract", Type: domain.InterfaceFile, Protocol: "SFTP", Status: domain.InterfaceActive, Provider: "erp-core-001"},
+				{ID: "dwh-crm-extract", Name: "CRM customer extract", Type: domain.InterfaceDatabase, Protocol: "JDBC", Status: domain.InterfaceActive, Provider: "crm-global-001"},
+			},
+		},
+		{
+			ID:                 "reporting-executive-001",
+			Category:           "Analytics",
+			Name:               "Executive Dashboard & Reporting",
+			Description:        "Executive-level dashboards and automated reporting",
+			Version:            "2.6.4",
+			Status:             domain.StatusPlanned,
+			CreatedAt:          now.AddDate(0, -1, 0),
+			UpdatedAt:          now,
+			Cost:               domain.ApplicationCost{License: 20000, Infrastructure: 10000, Support: 5000, Personnel: 15000, Currency: "USD"},
+			DataClassification: domain.DataClassification{Level: domain.DataConfidential},
+			Interfaces: []domain.ApplicationInterface{
+				{ID: "exec-bi-embed", Name: "Embedded BI dashboards", Type: domain.InterfaceAPI, Protocol: "REST", Status: domain.InterfaceTesting, Provider: "analytics-bi-001"},
+			},
+		},
+
+		// Legacy Systems (for migration scenarios)
+		{
+			ID:                 "legacy-hr-001",
+			Category:           "Legacy",
+			Name:               "Legacy HR System",
+			Description:        "Outdated HR system scheduled for retirement",
+			Version:            "1.2.1",
+			Status:             domain.StatusDeprecated,
+			CreatedAt:          now.AddDate(-8, 0, 0),
+			UpdatedAt:          now,
+			Cost:               domain.ApplicationCost{License: 0, Infrastructure: 45000, Support: 60000, Personnel: 110000, Currency: "USD"},
+			DataClassification: domain.DataClassification{Level: domain.DataRestricted, PII: true, PHI: true},
+		},
+		{
+			ID:                 "legacy-finance-001",
+			Category:           "Legacy",
+			Name:               "Legacy Financial System",
+			Description:        "Deprecated financial system with known vulnerabilities",
+			Version:            "3.1.0",
+			Status:             domain.StatusRetired,
+			CreatedAt:          now.AddDate(-6, 0, 0),
+			UpdatedAt:          now,
+			Cost:               domain.ApplicationCost{License: 0, Infrastructure: 20000, Support: 30000, Personnel: 40000, Currency: "USD"},
+			DataClassification: domain.DataClassification{Level: domain.DataConfidential},
+		},
+	}
+}
+
+// applicationFunctionality is the functionality catalogue of each demo application
+var applicationFunctionality = map[domain.ApplicationID][]domain.Functionality{
+	"erp-core-001": {
+		{ID: "erp-financial", Name: "Financial Management", Description: "Core financial operations", Category: "Finance", Priority: domain.PriorityCritical, Status: domain.FunctionalityAvailable},
+		{ID: "erp-inventory", Name: "Inventory Management", Description: "Stock and warehouse management", Category: "Operations", Priority: domain.PriorityHigh, Status: domain.FunctionalityAvailable},
+		{ID: "erp-procurement", Name: "Procurement", Description: "Supplier and purchase management", Category: "Procurement", Priority: domain.PriorityHigh, Status: domain.FunctionalityAvailable},
+	},
+	"crm-global-001": {
+		{ID: "crm-contacts", Name: "Contact Management", Description: "Customer and prospect database", Category: "CRM", Priority: domain.PriorityCritical, Status: domain.FunctionalityAvailable},
+		{ID: "crm-sales", Name: "Sales Pipeline", Description: "Sales opportunity tracking", Category: "Sales", Priority: domain.PriorityHigh, Status: domain.FunctionalityAvailable},
+		{ID: "crm-marketing", Name: "Marketing Automation", Description: "Campaign management", Category: "Marketing", Priority: domain.PriorityMedium, Status: domain.FunctionalityAvailable},
+	},
+	"hr-talent-001": {
+		{ID: "hr-emp-mgmt", Name: "Employee Management", Description: "Core employee data management", Category: "Core HR", Priority: domain.PriorityHigh, Status: domain.FunctionalityAvailable},
+		{ID: "hr-payroll", Name: "Payroll Processing", Description: "Salary and compensation management", Category: "Payroll", Priority: domain.PriorityCritical, Status: domain.FunctionalityAvailable},
+		{ID: "hr-recruiting", Name: "Recruitment", Description: "Hiring and onboarding processes", Category: "Recruiting", Priority: domain.PriorityMedium, Status: domain.FunctionalityAvailable},
+	},
+	"finance-budget-001": {
+		{ID: "finance-budgeting", Name: "Budget Planning", Description: "Annual budget creation and management", Category: "Budgeting", Priority: domain.PriorityHigh, Status: domain.FunctionalityAvailable},
+		{ID: "finance-forecasting", Name: "Financial Forecasting", Description: "Revenue and expense forecasting", Category: "Forecasting", Priority: domain.PriorityHigh, Status: domain.FunctionalityAvailable},
+		{ID: "finance-reporting", Name: "Financial Reporting", Description: "Regulatory and management reporting", Category: "Reporting", Priority: domain.PriorityCritical, Status: domain.FunctionalityAvailable},
+	},
+	"infra-monitoring-001": {
+		{ID: "infra-monitoring", Name: "System Monitoring", Description: "Real-time system health monitoring", Category: "Monitoring", Priority: domain.PriorityCritical, Status: domain.FunctionalityAvailable},
+		{ID: "infra-alerting", Name: "Alert Management", Description: "Automated alerting and notifications", Category: "Alerting", Priority: domain.PriorityHigh, Status: domain.FunctionalityAvailable},
+		{ID: "infra-dashboards", Name: "Management Dashboards", Description: "Executive and operational dashboards", Category: "Reporting", Priority: domain.PriorityMedium, Status: domain.FunctionalityAvailable},
+	},
+	"scm-supply-001": {
+		{ID: "scm-demand", Name: "Demand Planning", Description: "Demand and supply forecasting", Category: "Planning", Priority: domain.PriorityHigh, Status: domain.FunctionalityAvailable},
+		{ID: "scm-logistics", Name: "Logistics Management", Description: "Transport and distribution", Category: "Logistics", Priority: domain.PriorityHigh, Status: domain.FunctionalityAvailable},
+		{ID: "scm-suppliers", Name: "Supplier Collaboration", Description: "Supplier portal and order exchange", Category: "Procurement", Priority: domain.PriorityMedium, Status: domain.FunctionalityAvailable},
+	},
+	"procure-source-001": {
+		{ID: "procure-sourcing", Name: "Strategic Sourcing", Description: "Sourcing events and supplier selection", Category: "Procurement", Priority: domain.PriorityHigh, Status: domain.FunctionalityAvailable},
+		{ID: "procure-contracts", Name: "Contract Management", Description: "Supplier contract lifecycle", Category: "Contracts", Priority: domain.PriorityMedium, Status: domain.FunctionalityAvailable},
+		{ID: "procure-spend", Name: "Spend Analysis", Description: "Procurement spend analytics", Category: "Analytics", Priority: domain.PriorityMedium, Status: domain.FunctionalityAvailable},
+	},
+	"security-siem-001": {
+		{ID: "security-events", Name: "Security Event Correlation", Description: "Log collection and event correlation", Category: "Security", Priority: domain.PriorityCritical, Status: domain.FunctionalityAvailable},
+		{ID: "security-threats", Name: "Threat Detection", Description: "Detection of suspicious activity", Category: "Security", Priority: domain.PriorityCritical, Status: domain.FunctionalityAvailable},
+	},
+	"backup-enterprise-001": {
+		{ID: "backup-data", Name: "Data Backup", Description: "Scheduled backups of enterprise data", Category: "Backup", Priority: domain.PriorityCritical, Status: domain.FunctionalityAvailable},
+		{ID: "backup-recovery", Name: "Disaster Recovery", Description: "Restore and failover procedures", Category: "Recovery", Priority: domain.PriorityCritical, Status: domain.FunctionalityAvailable},
+	},
+	"analytics-bi-001": {
+		{ID: "analytics-dashboards", Name: "Executive Dashboards", Description: "KPI dashboards for leadership", Category: "Reporting", Priority: domain.PriorityHigh, Status: domain.FunctionalityAvailable},
+		{ID: "analytics-adhoc", Name: "Ad-hoc Reporting", Description: "Self-service report building", Category: "Reporting", Priority: domain.PriorityMedium, Status: domain.FunctionalityAvailable},
+		{ID: "analytics-visualization", Name: "Data Visualization", Description: "Interactive charts and exploration", Category: "Analytics", Priority: domain.PriorityMedium, Status: domain.FunctionalityAvailable},
+	},
+	"data-warehouse-001": {
+		{ID: "dwh-integration", Name: "Data Integration", Description: "ETL from operational systems", Category: "Data", Priority: domain.PriorityHigh, Status: domain.FunctionalityAvailable},
+		{ID: "dwh-history", Name: "Historical Data Storage", Description: "Long-term analytical storage", Category: "Data", Priority: domain.PriorityHigh, Status: domain.FunctionalityAvailable},
+	},
+	"reporting-executive-001": {
+		{ID: "reporting-dashboards", Name: "Executive Dashboards", Description: "Board-level performance dashboards", Category: "Reporting", Priority: domain.PriorityHigh, Status: domain.FunctionalityAvailable},
+		{ID: "reporting-scheduled", Name: "Scheduled Reporting", Description: "Automated report distribution", Category: "Reporting", Priority: domain.PriorityMedium, Status: domain.FunctionalityAvailable},
+	},
+	"legacy-hr-001": {
+		{ID: "legacy-hr-employees", Name: "Employee Records", Description: "Employee master data", Category: "Core HR", Priority: domain.PriorityHigh, Status: domain.FunctionalityAvailable},
+		{ID: "legacy-hr-payroll", Name: "Payroll Processing", Description: "Monthly payroll runs", Category: "Payroll", Priority: domain.PriorityCritical, Status: domain.FunctionalityDeprecated},
+	},
+	"legacy-finance-001": {
+		{ID: "legacy-finance-ledger", Name: "General Ledger", Description: "Historical ledger postings", Category: "Finance", Priority: domain.PriorityHigh, Status: domain.FunctionalityDeprecated},
+		{ID: "legacy-finance-reporting", Name: "Financial Reporting", Description: "Legacy statutory reports", Category: "Reporting", Priority: domain.PriorityMedium, Status: domain.FunctionalityDeprecated},
+	},
+}
+
+// StrategyFor creates a governance strategy for an application from its catalogued
+// functionality and technology stack
+func StrategyFor(app domain.Application) domain.Strategy {
+	functionalities, ok := applicationFunctionality[app.ID]
+	if !ok {
+		functionalities = []domain.Functionality{
+			{ID: fmt.Sprintf("%s-core", shortID(string(app.ID))), Name: "Core Functionality", Description: "Primary application features", Category: "Core", Priority: domain.PriorityHigh, Status: domain.FunctionalityAvailable},
+		}
+	}
+
+	return domain.Strategy{
+		ICTOperationsManual: domain.ICTOperationsManual{
+			TechnologyComponents: TechnologyStack(app),
+			LastUpdated:          time.Now(),
+		},
+		ApplicationCatalogue: domain.ApplicationCatalogue{
+			Functionality: functionalities,
+			LastUpdated:   time.Now(),
+		},
+	}
+}
+
+// TechnologyStack returns the technology components with announced end of support dates for the
+// applications whose platforms are ageing
+func TechnologyStack(app domain.Application) []domain.TechnologyComponent {
+	now := time.Now()
+	stacks := map[domain.ApplicationID][]domain.TechnologyComponent{
+		"erp-core-001": {
+			{Kind: domain.TechnologyFramework, Name: "ERP Suite", Version: "7.5", Vendor: "ERP vendor", EndOfSupport: now.AddDate(0, 10, 0)},
+		},
+		"crm-global-001": {
+			{Kind: domain.TechnologyRuntime, Name: "CRM Platform", Version: "9", Vendor: "CRM vendor", EndOfSupport: now.AddDate(0, 5, 0)},
+		},
+		"legacy-hr-001": {
+			{Kind: domain.TechnologyOperatingSystem, Name: "Windows Server", Version: "2012 R2", Vendor: "Microsoft", EndOfSupport: time.Date(2023, 10, 10, 0, 0, 0, 0, time.UTC)},
+		},
+		"legacy-finance-001": {
+			{Kind: domain.TechnologyDatabase, Name: "Oracle Database", Version: "11g", Vendor: "Oracle", EndOfSupport: time.Date(2020, 12, 31, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+	return stacks[app.ID]
+}
+
+// shortID truncates an application ID to a short functionality prefix
+func shortID(appID string) string {
+	if len(appID) > 8 {
+		return appID[:8]
+	}
+	return appID
+}
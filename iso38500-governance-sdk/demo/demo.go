@@ -0,0 +1,950 @@
+package demo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/application"
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// ErrAlreadySeeded is returned when the demo data is already present in the repositories
+var ErrAlreadySeeded = errors.New("enterprise demo data already loaded")
+
+// Environment holds the repositories and services the demo runs against
+type Environment struct {
+	AppRepo             domain.ApplicationRepository
+	PortfolioService    *application.PortfolioService
+	GovernanceService   *application.GovernanceService
+	DecisionService     *application.DecisionService     // optional, the decision log is skipped without it
+	KPIService          *application.KPIService          // optional, objective KPIs go unmeasured without it
+	NotificationService *application.NotificationService // optional, nobody is notified without it
+	MonitoringRunner    *application.MonitoringRunner    // optional, monitoring runs only on demand without it
+	ServiceLevelService *application.ServiceLevelService // optional, error budgets go untracked without it
+}
+
+// Result summarizes what the demo created and measured
+type Result struct {
+	Applications         int
+	Agreements           int
+	ActiveAgreements     int
+	Portfolios           int
+	PortfolioAssignments int
+	IdentifiedRisks      int
+	Evaluations          int
+	RiskDistribution     map[domain.RiskLevel]int
+	Objectives           int
+	Initiatives          int
+	KPIs                 int
+	RiskIndicators       int
+	Coverage             float64
+}
+
+// Seed creates the enterprise applications, governance agreements and portfolios,
+// configures strategies and activates every agreement
+func Seed(ctx context.Context, env Environment, out io.Writer) (*Result, error) {
+	applications := EnterpriseApplications()
+	if exists, err := env.AppRepo.Exists(ctx, applications[0].ID); err != nil {
+		return nil, fmt.Errorf("failed to check existing demo data: %w", err)
+	} else if exists {
+		return nil, ErrAlreadySeeded
+	}
+
+	result := &Result{RiskDistribution: make(map[domain.RiskLevel]int)}
+
+	fmt.Fprintln(out, "\n1. Enterprise Application Portfolio Setup")
+	fmt.Fprintln(out, "=========================================")
+
+	for _, app := range applications {
+		if err := env.AppRepo.Save(ctx, app); err != nil {
+			return nil, fmt.Errorf("failed to save application %s: %w", app.ID, err)
+		}
+		fmt.Fprintf(out, "✓ Created %s: %s (%s)\n", string(app.ID), app.Name, app.Status)
+	}
+	result.Applications = len(applications)
+
+	fmt.Fprintf(out, "\n   Portfolio Overview:\n")
+	fmt.Fprintf(out, "   • Core Business Systems: %d applications\n", CountByCategory(applications, "Core Business"))
+	fmt.Fprintf(out, "   • Operational Systems: %d applications\n", CountByCategory(applications, "Operational"))
+	fmt.Fprintf(out, "   • Infrastructure Systems: %d applications\n", CountByCategory(applications, "Infrastructure"))
+	fmt.Fprintf(out, "   • Analytical Systems: %d applications\n", CountByCategory(applications, "Analytics"))
+	fmt.Fprintf(out, "   • Total Applications: %d\n", len(applications))
+
+	fmt.Fprintln(out, "\n2. Enterprise Governance Framework")
+	fmt.Fprintln(out, "================================")
+
+	governed := GovernedApplicationIDs()
+	agreements := make(map[domain.ApplicationID]*domain.GovernanceAgreement)
+
+	fmt.Fprintln(out, "\n   Creating Governance Agreements for Core Systems:")
+	for _, appID := range governed {
+		app, err := env.AppRepo.FindByID(ctx, appID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find application %s: %w", appID, err)
+		}
+
+		agreement, err := env.GovernanceService.CreateGovernanceAgreement(ctx, application.CreateGovernanceAgreementCommand{
+			ID:            domain.GovernanceAgreementID("gov-" + string(appID)),
+			ApplicationID: appID,
+			Title:         fmt.Sprintf("Enterprise Governance Agreement for %s", app.Name),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create governance agreement for %s: %w", app.Name, err)
+		}
+
+		agreements[appID] = agreement
+		fmt.Fprintf(out, "   ✓ %s: %s\n", string(appID), agreement.Title)
+	}
+	result.Agreements = len(agreements)
+
+	fmt.Fprintf(out, "\n   Total Governance Agreements Created: %d\n", len(agreements))
+
+	fmt.Fprintln(out, "\n3. Multi-Portfolio Enterprise Structure")
+	fmt.Fprintln(out, "=====================================")
+
+	portfolios := EnterprisePortfolios()
+
+	fmt.Fprintln(out, "\n   Creating Business Domain Portfolios:")
+	for _, def := range portfolios {
+		portfolio, err := env.PortfolioService.CreatePortfolio(ctx, application.CreatePortfolioCommand{
+			ID:          def.ID,
+			Name:        def.Name,
+			Description: def.Description,
+			Owner:       def.Owner,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create portfolio %s: %w", def.ID, err)
+		}
+		if def.Thresholds != nil {
+			err = env.PortfolioService.SetPortfolioThresholds(ctx, application.SetPortfolioThresholdsCommand{
+				PortfolioID: def.ID,
+				Thresholds:  def.Thresholds,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to set thresholds of portfolio %s: %w", def.ID, err)
+			}
+		}
+
+		thresholds := ""
+		if def.Thresholds != nil {
+			thresholds = ", own risk thresholds"
+		}
+		fmt.Fprintf(out, "   ✓ %s: %s (%d applications%s)\n", string(def.ID), portfolio.Name, len(def.Applications), thresholds)
+	}
+	result.Portfolios = len(portfolios)
+
+	fmt.Fprintf(out, "   • Total Portfolios: %d\n", len(portfolios))
+
+	fmt.Fprintln(out, "\n4. Portfolio Population & Governance Assignment")
+	fmt.Fprintln(out, "==============================================")
+
+	for _, def := range portfolios {
+		fmt.Fprintf(out, "\n   Populating %s:\n", def.Name)
+		for _, appID := range def.Applications {
+			err := env.PortfolioService.AddApplicationToPortfolio(ctx, application.AddApplicationToPortfolioCommand{
+				PortfolioID:   def.ID,
+				ApplicationID: appID,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to add application %s to portfolio %s: %w", appID, def.ID, err)
+			}
+
+			if _, exists := agreements[appID]; exists {
+				fmt.Fprintf(out, "   ✓ %s (with governance)\n", string(appID))
+				result.PortfolioAssignments++
+			} else {
+				fmt.Fprintf(out, "   ✓ %s\n", string(appID))
+			}
+		}
+	}
+
+	fmt.Fprintf(out, "\n   Governance Assignment Summary:\n")
+	fmt.Fprintf(out, "   • Applications with governance agreements: %d\n", len(agreements))
+	fmt.Fprintf(out, "   • Total portfolio assignments: %d\n", result.PortfolioAssignments)
+
+	fmt.Fprintln(out, "\n5. Enterprise Governance Strategy & Compliance")
+	fmt.Fprintln(out, "=============================================")
+
+	fmt.Fprintln(out, "\n   Configuring Governance Strategies:")
+	for _, appID := range governed {
+		app, err := env.AppRepo.FindByID(ctx, appID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find application %s: %w", appID, err)
+		}
+
+		strategy := StrategyFor(app)
+		err = env.GovernanceService.UpdateStrategy(ctx, application.UpdateStrategyCommand{
+			AgreementID: agreements[appID].ID,
+			Strategy:    strategy,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to update strategy for %s: %w", appID, err)
+		}
+
+		fmt.Fprintf(out, "   ✓ %s: %d functionalities configured\n", string(appID), len(strategy.ApplicationCatalogue.Functionality))
+	}
+
+	fmt.Fprintf(out, "\n   Strategy Configuration Complete: %d applications\n", len(agreements))
+
+	fmt.Fprintln(out, "\n   Identifying Application Risks:")
+	risks := IdentifiedRisks()
+	for _, appID := range governed {
+		for _, risk := range risks[appID] {
+			err := env.GovernanceService.IdentifyRisk(ctx, application.IdentifyRiskCommand{
+				AgreementID: agreements[appID].ID,
+				Risk:        risk,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to identify risk %s for %s: %w", risk.ID, appID, err)
+			}
+
+			result.IdentifiedRisks++
+			fmt.Fprintf(out, "   ✓ %s: %s (%.0f%% likely, %s impact)\n", appID, risk.Name, risk.Probability*100, risk.Impact)
+		}
+	}
+
+	fmt.Fprintln(out, "\n6. Governance Lifecycle Management")
+	fmt.Fprintln(out, "=================================")
+
+	fmt.Fprintln(out, "\n   Approving Governance Agreements:")
+	for _, appID := range governed {
+		err := env.GovernanceService.ApproveGovernanceAgreement(ctx, application.ApproveGovernanceAgreementCommand{
+			AgreementID: agreements[appID].ID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to approve agreement for %s: %w", appID, err)
+		}
+
+		fmt.Fprintf(out, "   ✓ Approved: %s\n", appID)
+	}
+
+	fmt.Fprintln(out, "\n   Activating Governance Agreements:")
+	for _, appID := range governed {
+		err := env.GovernanceService.ActivateGovernanceAgreement(ctx, application.ActivateGovernanceAgreementCommand{
+			AgreementID: agreements[appID].ID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to activate agreement for %s: %w", appID, err)
+		}
+
+		result.ActiveAgreements++
+		fmt.Fprintf(out, "   ✓ Activated: %s\n", appID)
+	}
+
+	fmt.Fprintf(out, "\n   Governance Lifecycle Complete: %d agreements active\n", result.ActiveAgreements)
+	result.Coverage = float64(len(agreements)) / float64(len(applications)) * 100
+
+	return result, nil
+}
+
+// Run seeds the demo data and walks through the full EVALUATE → DIRECT → MONITOR lifecycle
+func Run(ctx context.Context, env Environment, out io.Writer) (*Result, error) {
+	result, err := Seed(ctx, env, out)
+	if err != nil {
+		return nil, err
+	}
+
+	governed := GovernedApplicationIDs()
+
+	fmt.Fprintln(out, "\n7. Enterprise-Wide Application Evaluation")
+	fmt.Fprintln(out, "=========================================")
+
+	fmt.Fprintln(out, "\n   Stakeholder Surveys:")
+	for appID, surveys := range StakeholderSurveys() {
+		for _, survey := range surveys {
+			_, err := env.GovernanceService.CreateSurvey(ctx, application.CreateSurveyCommand{
+				AgreementID: domain.GovernanceAgreementID("gov-" + string(appID)),
+				Survey:      survey,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to create survey %s: %w", survey.ID, err)
+			}
+		}
+	}
+	for _, cmd := range SurveyResponses() {
+		if _, err := env.GovernanceService.RecordSurveyResponse(ctx, cmd); err != nil {
+			return nil, fmt.Errorf("failed to record survey response to %s: %w", cmd.SurveyID, err)
+		}
+	}
+	for appID, surveys := range StakeholderSurveys() {
+		for _, survey := range surveys {
+			surveyResult, err := env.GovernanceService.CloseSurvey(ctx, application.CloseSurveyCommand{
+				AgreementID: domain.GovernanceAgreementID("gov-" + string(appID)),
+				SurveyID:    survey.ID,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to close survey %s: %w", survey.ID, err)
+			}
+
+			summary := surveyResult.Summary
+			fmt.Fprintf(out, "   ✓ %s: %d respondents (%.0f%% response rate), %.1f/%d average, %.0f%% satisfaction\n",
+				survey.Name, summary.TotalResponses, summary.ResponseRate*100, summary.AverageScore, survey.ScaleOrDefault(), summary.Satisfaction)
+			for _, insight := range summary.KeyInsights {
+				fmt.Fprintf(out, "     ↳ %s\n", insight)
+			}
+		}
+	}
+
+	fmt.Fprintln(out, "\n   Evaluating Core Business Applications:")
+	for _, appID := range governed {
+		assessment, err := env.GovernanceService.EvaluateApplication(ctx, application.EvaluateApplicationCommand{
+			ApplicationID: appID,
+			Evaluator:     "Enterprise IT Governance Board",
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to evaluate application %s: %w", appID, err)
+		}
+
+		result.Evaluations++
+		result.RiskDistribution[assessment.RiskLevel]++
+
+		riskEmoji := "✅"
+		if assessment.RiskLevel == domain.RiskHigh {
+			riskEmoji = "⚠️"
+		} else if assessment.RiskLevel == domain.RiskCritical {
+			riskEmoji = "🚨"
+		}
+
+		fmt.Fprintf(out, "   ✓ %s: Risk=%s, Health=%d/5, Value=%.0f%%, Recs=%d %s\n",
+			string(appID), assessment.RiskLevel, assessment.TechnicalHealth.CodeQuality,
+			assessment.BusinessValue.UserSatisfaction, len(assessment.Recommendations), riskEmoji)
+		if assessment.Template != "" {
+			passed := 0
+			for _, check := range assessment.TemplateChecks {
+				if check.Passed {
+					passed++
+				}
+			}
+			fmt.Fprintf(out, "     ↳ Template %q: %d/%d required checks passed\n", assessment.Template, passed, len(assessment.TemplateChecks))
+		}
+	}
+
+	fmt.Fprintln(out, "\n   Portfolio-Level Risk Assessment:")
+	for _, def := range EnterprisePortfolios() {
+		assessment, err := env.GovernanceService.EvaluatePortfolio(ctx, application.EvaluatePortfolioCommand{
+			PortfolioID: def.ID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to evaluate portfolio %s: %w", def.ID, err)
+		}
+
+		fmt.Fprintf(out, "   ✓ %s: health %.0f/100, %d apps, %d active, %d deprecated, %d redundant, %d risks, $%.0fk/year\n",
+			string(def.ID), assessment.HealthIndex.Score, assessment.TotalApplications,
+			assessment.ActiveApplications, assessment.DeprecatedApplications,
+			assessment.RedundantApplications, len(assessment.RiskDistribution), assessment.TotalCost/1000)
+		if exposure := assessment.RiskExposure; exposure != nil && exposure.Risks > 0 {
+			fmt.Fprintf(out, "     ↳ Risk exposure: $%.0fk expected, $%.0fk P50, $%.0fk P90 across %d risks\n",
+				exposure.ExpectedLoss/1000, exposure.P50/1000, exposure.P90/1000, exposure.Risks)
+		}
+		for _, quadrant := range domain.TIMEQuadrants {
+			if apps := assessment.TIMEQuadrants[quadrant]; len(apps) > 0 {
+				fmt.Fprintf(out, "     ↳ TIME %s: %d applications\n", quadrant, len(apps))
+			}
+		}
+		if scoreboard := assessment.KPIScoreboard; scoreboard != nil && scoreboard.Total > 0 {
+			fmt.Fprintf(out, "     ↳ KPIs: %.0f%% weighted attainment, %d/%d on target across %d applications\n",
+				scoreboard.Attainment*100, scoreboard.Achieved, scoreboard.Total, len(scoreboard.Applications))
+		}
+		for _, candidate := range assessment.ConsolidationCandidates {
+			fmt.Fprintf(out, "     ↳ %s\n", candidate.Rationale)
+		}
+	}
+
+	fmt.Fprintf(out, "\n   Enterprise Evaluation Complete: %d applications assessed\n", result.Evaluations)
+
+	fmt.Fprintln(out, "\n8. Enterprise Strategic Direction & Objectives")
+	fmt.Fprintln(out, "=============================================")
+
+	fmt.Fprintln(out, "\n   Establishing Enterprise Objectives:")
+	objectives := StrategicObjectives()
+	initiatives := StrategicInitiatives()
+	for _, appID := range governed {
+		appObjectives, exists := objectives[appID]
+		if !exists {
+			continue
+		}
+
+		err := env.GovernanceService.SetStrategicDirection(ctx, application.SetStrategicDirectionCommand{
+			AgreementID: domain.GovernanceAgreementID("gov-" + string(appID)),
+			Director:    "Enterprise Architecture Board",
+			Objectives:  appObjectives,
+			Initiatives: initiatives[appID],
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to set strategic direction for %s: %w", appID, err)
+		}
+
+		result.Objectives += len(appObjectives)
+		result.Initiatives += len(initiatives[appID])
+		fmt.Fprintf(out, "   ✓ %s: %d objectives, %d initiatives\n", appID, len(appObjectives), len(initiatives[appID]))
+	}
+
+	fmt.Fprintln(out, "\n   Strategic Alignment:")
+	for _, def := range EnterprisePortfolios() {
+		matrix, err := env.GovernanceService.AssessStrategicAlignment(ctx, application.AssessStrategicAlignmentCommand{
+			PortfolioID: def.ID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to assess strategic alignment of %s: %w", def.ID, err)
+		}
+		if len(matrix.Objectives) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(out, "   ✓ %s: %d applications across %d objectives, %d orphaned\n",
+			string(def.ID), len(matrix.Applications), len(matrix.Objectives), len(matrix.Orphans))
+		for _, objective := range matrix.Objectives {
+			fmt.Fprintf(out, "     ↳ %s: %.0f%% delivered by %d applications\n", objective.ObjectiveID, objective.Weight*100, objective.Applications)
+		}
+		for _, appID := range matrix.Orphans {
+			fmt.Fprintf(out, "     ⚠ %s supports no strategic objective\n", appID)
+		}
+	}
+
+	fmt.Fprintln(out, "\n   Objectives & Key Results:")
+	for portfolioID, okrs := range PortfolioOKRs() {
+		for _, okr := range okrs {
+			if _, err := env.PortfolioService.DefinePortfolioOKR(ctx, application.DefinePortfolioOKRCommand{PortfolioID: portfolioID, OKR: okr}); err != nil {
+				return nil, fmt.Errorf("failed to define OKR %s: %w", okr.ID, err)
+			}
+		}
+	}
+	applicationOKRs := ApplicationOKRs()
+	keyResultUpdates := KeyResultUpdates()
+	for _, appID := range governed {
+		agreementID := domain.GovernanceAgreementID("gov-" + string(appID))
+		for _, okr := range applicationOKRs[appID] {
+			if _, err := env.GovernanceService.DefineOKR(ctx, application.DefineOKRCommand{AgreementID: agreementID, OKR: okr}); err != nil {
+				return nil, fmt.Errorf("failed to define OKR %s: %w", okr.ID, err)
+			}
+		}
+		for _, update := range keyResultUpdates[appID] {
+			update.AgreementID = agreementID
+			if _, err := env.GovernanceService.RecordKeyResult(ctx, update); err != nil {
+				return nil, fmt.Errorf("failed to record key result %s: %w", update.KeyResultID, err)
+			}
+		}
+	}
+	for _, def := range EnterprisePortfolios() {
+		cascade, err := env.GovernanceService.GetOKRCascade(ctx, application.GetOKRCascadeCommand{PortfolioID: def.ID})
+		if err != nil {
+			return nil, fmt.Errorf("failed to report OKR cascade of %s: %w", def.ID, err)
+		}
+		for _, okr := range cascade.OKRs {
+			fmt.Fprintf(out, "   ✓ %s: %s — %.0f%% (cascaded %.0f%% across %d application OKRs)\n",
+				okr.OKRID, okr.Objective, okr.Progress, okr.CascadedProgress, len(okr.Children))
+			for _, child := range okr.Children {
+				fmt.Fprintf(out, "     ↳ %s (%s): %.0f%%\n", child.OKRID, child.ApplicationID, child.Progress)
+			}
+		}
+	}
+
+	fmt.Fprintln(out, "\n   Policy Lifecycle:")
+	policies := GovernancePolicies()
+	for _, appID := range governed {
+		appPolicies, exists := policies[appID]
+		if !exists {
+			continue
+		}
+
+		agreementID := domain.GovernanceAgreementID("gov-" + string(appID))
+		err := env.GovernanceService.EstablishPolicies(ctx, application.EstablishPoliciesCommand{
+			AgreementID:   agreementID,
+			EstablishedBy: "Enterprise Architecture Board",
+			Policies:      appPolicies,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to establish policies for %s: %w", appID, err)
+		}
+
+		for _, policy := range appPolicies {
+			if _, err := env.GovernanceService.SubmitPolicy(ctx, application.SubmitPolicyCommand{
+				AgreementID: agreementID, PolicyID: policy.ID, SubmittedBy: policy.Owner,
+			}); err != nil {
+				return nil, fmt.Errorf("failed to submit policy %s: %w", policy.ID, err)
+			}
+			if _, err := env.GovernanceService.ApprovePolicy(ctx, application.ApprovePolicyCommand{
+				AgreementID: agreementID, PolicyID: policy.ID, ApprovedBy: "Enterprise Architecture Board",
+			}); err != nil {
+				return nil, fmt.Errorf("failed to approve policy %s: %w", policy.ID, err)
+			}
+			published, err := env.GovernanceService.PublishPolicy(ctx, application.PublishPolicyCommand{
+				AgreementID: agreementID, PolicyID: policy.ID, EffectiveFrom: time.Now().AddDate(0, 0, 14),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to publish policy %s: %w", policy.ID, err)
+			}
+			fmt.Fprintf(out, "   ✓ %s: %s, submitted by %s, approved by %s, effective %s\n",
+				published.Name, published.Status, published.SubmittedBy, published.ApprovedBy, published.EffectiveFrom.Format("2006-01-02"))
+		}
+
+		for _, revision := range PolicyRevisions()[appID] {
+			revised, err := env.GovernanceService.RevisePolicy(ctx, application.RevisePolicyCommand{
+				AgreementID: agreementID,
+				Policy:      revision.Policy,
+				ChangedBy:   revision.ChangedBy,
+				Summary:     revision.Summary,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to revise policy %s: %w", revision.Policy.ID, err)
+			}
+			err = env.GovernanceService.PinDocumentVersion(ctx, application.PinDocumentVersionCommand{
+				AgreementID: agreementID,
+				Kind:        domain.DocumentPolicy,
+				DocumentID:  revised.DocumentID,
+				Version:     revised.Version - 1,
+				PinnedBy:    "Enterprise Architecture Board",
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to pin policy %s: %w", revised.DocumentID, err)
+			}
+			fmt.Fprintf(out, "   ✓ %s v%d by %s (%s), agreement pinned to v%d until approved\n",
+				revised.DocumentID, revised.Version, revised.ChangedBy, revised.Summary, revised.Version-1)
+			fmt.Fprintf(out, "     %s\n", domain.FormatRedline(revised.Redline))
+		}
+	}
+
+	fmt.Fprintln(out, "\n   Requirement Coverage:")
+	requirements := ConformanceRequirements()
+	for _, appID := range governed {
+		conformance, exists := requirements[appID]
+		if !exists {
+			continue
+		}
+
+		agreementID := domain.GovernanceAgreementID("gov-" + string(appID))
+		err := env.GovernanceService.UpdateConformance(ctx, application.UpdateConformanceCommand{
+			AgreementID: agreementID,
+			Conformance: conformance,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to update conformance of %s: %w", appID, err)
+		}
+		for _, mapping := range RequirementMappings()[appID] {
+			mapping.AgreementID = agreementID
+			if _, err := env.GovernanceService.MapRequirement(ctx, mapping); err != nil {
+				return nil, fmt.Errorf("failed to map %s requirement: %w", mapping.Requirement, err)
+			}
+		}
+
+		coverage, err := env.GovernanceService.GetRequirementCoverage(ctx, agreementID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get requirement coverage of %s: %w", appID, err)
+		}
+		fmt.Fprintf(out, "   %s: %d of %d requirements implemented by documents in effect\n",
+			agreementID, coverage.Implemented(), len(coverage.Requirements))
+		for _, requirement := range coverage.Requirements {
+			status := "✓"
+			switch {
+			case len(requirement.Mappings) == 0:
+				status = "❌ no implementing document"
+			case !requirement.Implemented():
+				status = "⏳ mapped documents not yet in effect"
+			}
+			fmt.Fprintf(out, "     %s: %s\n", requirement.Requirement, status)
+		}
+	}
+
+	fmt.Fprintln(out, "\n   Budget Allocations & Spend:")
+	budgets := BudgetAllocations()
+	personnel := PersonnelAllocations()
+	expenditures := Expenditures()
+	for _, appID := range governed {
+		allocations, exists := budgets[appID]
+		if !exists {
+			continue
+		}
+
+		agreementID := domain.GovernanceAgreementID("gov-" + string(appID))
+		err := env.GovernanceService.AllocateResources(ctx, application.AllocateResourcesCommand{
+			AgreementID:          agreementID,
+			BudgetAllocations:    allocations,
+			PersonnelAllocations: personnel[appID],
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to allocate budget for %s: %w", appID, err)
+		}
+
+		for _, expenditure := range expenditures[appID] {
+			consumption, err := env.GovernanceService.RecordExpenditure(ctx, application.RecordExpenditureCommand{
+				AgreementID: agreementID,
+				Category:    expenditure.Category,
+				Amount:      expenditure.Amount,
+				Description: expenditure.Description,
+				RecordedBy:  expenditure.RecordedBy,
+				SpentAt:     expenditure.SpentAt,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to record expenditure for %s: %w", appID, err)
+			}
+			fmt.Fprintf(out, "   ✓ %s / %s: $%.0f spent (%.0f%% of $%.0f)\n",
+				appID, consumption.Category, expenditure.Amount, consumption.ConsumedPercent, consumption.Allocated)
+		}
+	}
+
+	fmt.Fprintln(out, "\n   Resource Capacity:")
+	for _, appID := range governed {
+		if _, exists := personnel[appID]; !exists {
+			continue
+		}
+
+		plan, err := env.GovernanceService.GetCapacityPlan(ctx, application.GetCapacityPlanCommand{
+			AgreementID: domain.GovernanceAgreementID("gov-" + string(appID)),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to plan capacity for %s: %w", appID, err)
+		}
+
+		if !plan.HasConflicts() {
+			fmt.Fprintf(out, "   ✓ %s: demand within allocated capacity\n", appID)
+			continue
+		}
+		// Conflicts are ordered by role, then month: report each role's run of months once
+		for i := 0; i < len(plan.Conflicts); {
+			first, last, shortfall := plan.Conflicts[i], plan.Conflicts[i], 0.0
+			for ; i < len(plan.Conflicts) && plan.Conflicts[i].Role == first.Role; i++ {
+				last = plan.Conflicts[i]
+				if plan.Conflicts[i].Shortfall() > shortfall {
+					shortfall = plan.Conflicts[i].Shortfall()
+				}
+			}
+			fmt.Fprintf(out, "   ⚠️  %s / %s: over-allocated by up to %.1f FTE from %s to %s\n",
+				appID, first.Role, shortfall, first.Start.Format("2006-01"), last.Start.Format("2006-01"))
+		}
+	}
+
+	fmt.Fprintln(out, "\n   Initiative Progress Updates:")
+	progressUpdates := InitiativeProgressUpdates()
+	for _, appID := range governed {
+		for _, cmd := range progressUpdates[appID] {
+			cmd.AgreementID = domain.GovernanceAgreementID("gov-" + string(appID))
+			initiative, err := env.GovernanceService.UpdateInitiativeProgress(ctx, cmd)
+			if err != nil {
+				return nil, fmt.Errorf("failed to update progress of %s: %w", cmd.InitiativeID, err)
+			}
+			fmt.Fprintf(out, "   ✓ %s: %.0f%% complete, %s (%s)\n", initiative.Name, initiative.PercentComplete, initiative.Status, cmd.Note)
+		}
+	}
+
+	fmt.Fprintln(out, "\n   Action Plan Schedules:")
+	actionPlans := ActionPlans()
+	actionUpdates := ActionUpdates()
+	for _, appID := range governed {
+		agreementID := domain.GovernanceAgreementID("gov-" + string(appID))
+		var schedule *domain.ActionSchedule
+		for _, plan := range actionPlans[appID] {
+			var err error
+			schedule, err = env.GovernanceService.SaveActionPlan(ctx, application.SaveActionPlanCommand{
+				AgreementID: agreementID,
+				ActionPlan:  plan,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to save action plan %s: %w", plan.ID, err)
+			}
+			fmt.Fprintf(out, "   ✓ %s: %s with %d actions, %s\n", appID, plan.Name, len(plan.Actions), formatPlanProjection(*schedule, plan.ID))
+		}
+		for _, cmd := range actionUpdates[appID] {
+			cmd.AgreementID = agreementID
+			var err error
+			schedule, err = env.GovernanceService.UpdateAction(ctx, cmd)
+			if err != nil {
+				return nil, fmt.Errorf("failed to update action %s: %w", cmd.ActionID, err)
+			}
+			fmt.Fprintf(out, "   ✓ %s / %s %s: %s\n", appID, cmd.ActionID, cmd.Status, formatPlanProjection(*schedule, cmd.PlanID))
+		}
+		if schedule == nil {
+			continue
+		}
+
+		path := ""
+		for i, action := range schedule.CriticalPath {
+			if i > 0 {
+				path += " → "
+			}
+			path += action.ActionID
+		}
+		fmt.Fprintf(out, "     ↳ Critical path: %s\n", path)
+		for _, objective := range schedule.Objectives {
+			if objective.Slipping() {
+				fmt.Fprintf(out, "     ⚠️  %s projected %s, past its %s deadline\n",
+					objective.Name, objective.ProjectedCompletion.Format("2006-01-02"), objective.Deadline.Format("2006-01-02"))
+			}
+		}
+	}
+
+	if env.DecisionService != nil {
+		fmt.Fprintln(out, "\n   Decision Log:")
+		for _, cmd := range GovernanceDecisions() {
+			decision, err := env.DecisionService.RecordDecision(ctx, cmd)
+			if err != nil {
+				return nil, fmt.Errorf("failed to record decision %s: %w", cmd.ID, err)
+			}
+			fmt.Fprintf(out, "   ✓ %s: %s (%s, %s)\n", decision.ID, decision.Title, decision.DecidedBy, decision.DecidedAt.Format("2006-01-02"))
+			if decision.Supersedes != "" {
+				fmt.Fprintf(out, "     ↳ supersedes %s\n", decision.Supersedes)
+			}
+		}
+	}
+
+	fmt.Fprintf(out, "\n   Strategic Direction Summary:\n")
+	fmt.Fprintf(out, "   • Strategic Objectives: %d\n", result.Objectives)
+	fmt.Fprintf(out, "   • Strategic Initiatives: %d\n", result.Initiatives)
+	fmt.Fprintf(out, "   • Applications with Direction: %d\n", len(objectives))
+
+	fmt.Fprintln(out, "\n9. Enterprise Governance Monitoring")
+	fmt.Fprintln(out, "==================================")
+
+	if env.KPIService != nil {
+		fmt.Fprintln(out, "\n   KPI Measurements:")
+		for _, appID := range governed {
+			for _, objective := range objectives[appID] {
+				for _, kpi := range objective.KPIs {
+					if _, err := env.KPIService.DefineKPI(ctx, application.DefineKPICommand{
+						ID:          kpi.ID,
+						Name:        kpi.Name,
+						Description: kpi.Description,
+						Target:      kpi.Target,
+						Unit:        kpi.Unit,
+						Category:    kpi.Category,
+						Frequency:   kpi.Frequency,
+						DefinedBy:   "Enterprise Architecture Board",
+					}); err != nil {
+						return nil, fmt.Errorf("failed to define KPI %s: %w", kpi.ID, err)
+					}
+				}
+			}
+		}
+		for _, cmd := range KPIMeasurements() {
+			measurement, err := env.KPIService.RecordKPIMeasurement(ctx, cmd)
+			if err != nil {
+				return nil, fmt.Errorf("failed to record measurement of KPI %s: %w", cmd.KPIID, err)
+			}
+			status := "❌"
+			if measurement.Achieved {
+				status = "✅"
+			}
+			fmt.Fprintf(out, "   %s %s: %.1f against %.1f on %s\n",
+				status, measurement.KPIID, measurement.Value, measurement.Target, measurement.MeasuredAt.Format("2006-01-02"))
+		}
+
+		history, err := env.KPIService.GetKPIHistory(ctx, "erp-cloud-workloads", time.Now().AddDate(-1, 0, 0), time.Now(), domain.KPIResolutionMonth)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get KPI history: %w", err)
+		}
+		fmt.Fprintf(out, "   📈 %s monthly history (%s):", history.KPIID, history.Direction)
+		for _, point := range history.Points {
+			fmt.Fprintf(out, " %s avg %.1f", point.PeriodStart.Format("2006-01"), point.Avg)
+		}
+		fmt.Fprintln(out)
+	}
+
+	if env.ServiceLevelService != nil {
+		fmt.Fprintln(out, "\n   Service Level Objectives:")
+		for _, cmd := range AvailabilityMeasurements() {
+			if _, _, err := env.ServiceLevelService.RecordMeasurement(ctx, cmd); err != nil {
+				return nil, fmt.Errorf("failed to record availability measurement %s: %w", cmd.ID, err)
+			}
+		}
+		budgets, err := env.ServiceLevelService.GetErrorBudgets(ctx, "erp-core-001")
+		if err != nil {
+			return nil, fmt.Errorf("failed to get error budgets: %w", err)
+		}
+		for _, budget := range budgets {
+			icon := "🟢"
+			switch budget.Status {
+			case domain.ErrorBudgetAtRisk:
+				icon = "🟠"
+			case domain.ErrorBudgetExhausted:
+				icon = "🔴"
+			}
+			fmt.Fprintf(out, "   %s %s (%.2f%% objective): %s of %s error budget consumed, burn rate %.1fx, %s",
+				icon, budget.SLO.ID, budget.SLO.Objective, budget.Consumed.Round(time.Minute), budget.Budget.Round(time.Minute), budget.BurnRate, budget.Status)
+			if !budget.ProjectedExhaustion.IsZero() {
+				fmt.Fprintf(out, ", projected to run out on %s", budget.ProjectedExhaustion.Format("2006-01-02"))
+			}
+			fmt.Fprintln(out)
+		}
+	}
+
+	fmt.Fprintln(out, "\n   Alert Thresholds:")
+	for _, appID := range governed {
+		for _, cmd := range AlertConfigurations()[appID] {
+			cmd.AgreementID = domain.GovernanceAgreementID("gov-" + string(appID))
+			if err := env.GovernanceService.ConfigureAlerts(ctx, cmd); err != nil {
+				return nil, fmt.Errorf("failed to configure alerts on %s %s: %w", cmd.Source, cmd.Subject, err)
+			}
+			for _, threshold := range cmd.Thresholds {
+				fmt.Fprintf(out, "   🔔 %s %s: %s\n", appID, cmd.Subject, threshold)
+			}
+		}
+	}
+
+	if env.MonitoringRunner != nil {
+		fmt.Fprintln(out, "\n   Scheduled Monitoring:")
+		runs, err := env.MonitoringRunner.RunDue(ctx, time.Now())
+		if err != nil {
+			return nil, fmt.Errorf("failed to run due monitoring: %w", err)
+		}
+		for _, run := range runs {
+			if !run.Succeeded() {
+				fmt.Fprintf(out, "   ❌ %s: %s\n", run.AgreementID, run.Err)
+				continue
+			}
+			fmt.Fprintf(out, "   • %s: %d/%d KPIs achieved, %d/%d risk indicators over threshold, %d requirement gaps, %d active alerts\n",
+				run.AgreementID, run.KPIsAchieved, run.KPIsMeasured, run.RisksOverThreshold, run.RiskIndicators, run.RequirementGaps, run.ActiveAlerts)
+		}
+	}
+
+	fmt.Fprintln(out, "\n   Comprehensive Governance Monitoring:")
+	for _, appID := range governed {
+		monitoring, err := env.GovernanceService.MonitorGovernance(ctx, application.MonitorGovernanceCommand{
+			AgreementID: domain.GovernanceAgreementID("gov-" + string(appID)),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to monitor governance for %s: %w", appID, err)
+		}
+
+		fmt.Fprintf(out, "\n   📊 %s Governance Status:\n", appID)
+
+		fmt.Fprintf(out, "      KPIs (%d):\n", len(monitoring.KPIMeasurements))
+		for i, kpi := range monitoring.KPIMeasurements {
+			status := "❌ Not Achieved"
+			if kpi.Achieved {
+				status = "✅ Achieved"
+			}
+			fmt.Fprintf(out, "        %d. %s: %.1f/%.1f %s\n", i+1, kpi.KPIID, kpi.Value, kpi.Target, status)
+		}
+
+		fmt.Fprintf(out, "      Risks (%d):\n", len(monitoring.RiskStatus.RiskIndicators))
+		for i, risk := range monitoring.RiskStatus.RiskIndicators {
+			statusEmoji := "✅"
+			if risk.Status == domain.RiskStatusWarning {
+				statusEmoji = "⚠️"
+			} else if risk.Status == domain.RiskStatusCritical {
+				statusEmoji = "🚨"
+			}
+			fmt.Fprintf(out, "        %d. %s: %.1f (threshold: %.1f) %s %s\n",
+				i+1, risk.Name, risk.Value, risk.Threshold, statusEmoji, risk.Status)
+		}
+
+		for _, heatMap := range monitoring.RiskStatus.RiskHeatMaps {
+			if heatMap.Total == 0 {
+				continue
+			}
+			fmt.Fprintf(out, "      %s heat map %s (risks: %d):\n", heatMap.Scope, heatMap.ScopeID, heatMap.Total)
+			for _, cell := range heatMap.Cells {
+				if cell.Count > 0 {
+					fmt.Fprintf(out, "        %s/%s [%s]: %s\n", cell.Likelihood, cell.Impact, cell.Rating, strings.Join(cell.RiskIDs, ", "))
+				}
+			}
+		}
+
+		if alerts := monitoring.Alerts; len(alerts.Active) > 0 {
+			fmt.Fprintf(out, "      Alerts (%d):\n", len(alerts.Active))
+			for _, alert := range alerts.Active {
+				emoji := "⚠️"
+				if alert.Severity == domain.AlertCritical {
+					emoji = "🚨"
+				}
+				fmt.Fprintf(out, "        %s %s %s: %.1f (%s)\n", emoji, alert.Source, alert.Subject, alert.Value, alert.Threshold)
+			}
+		}
+
+		if coverage := monitoring.ObjectiveCoverage; coverage.Objectives > 0 {
+			fmt.Fprintf(out, "      Objective KPI Coverage: %d objectives, %d/%d linked KPIs measured\n",
+				coverage.Objectives, coverage.MeasuredKPIs(), coverage.LinkedKPIs)
+			for _, objectiveID := range coverage.UnlinkedObjectives {
+				fmt.Fprintf(out, "        ⚠️ %s has no linked KPIs\n", objectiveID)
+			}
+			for _, ref := range coverage.UnknownKPIs {
+				fmt.Fprintf(out, "        ⚠️ %s links unknown KPI %s\n", ref.ObjectiveID, ref.KPIID)
+			}
+			for _, ref := range coverage.UnmeasuredKPIs {
+				fmt.Fprintf(out, "        ⚠️ %s: KPI %s has no measurements\n", ref.ObjectiveID, ref.KPIID)
+			}
+		}
+
+		if progress := monitoring.InitiativeProgress; len(progress.Initiatives) > 0 {
+			fmt.Fprintf(out, "      Initiative Progress: %.0f%% overall, %d delayed or at risk\n", progress.PercentComplete, progress.Delayed)
+			for _, objective := range progress.Objectives {
+				fmt.Fprintf(out, "        • %s: %.0f%% (%d initiatives)\n", objective.Name, objective.PercentComplete, objective.Initiatives)
+			}
+			for _, initiative := range progress.Initiatives {
+				fmt.Fprintf(out, "        • %s: %.0f%% %s, %d/%d milestones\n",
+					initiative.Name, initiative.PercentComplete, initiative.Status, initiative.MilestonesCompleted, initiative.Milestones)
+			}
+		}
+
+		if budget := monitoring.BudgetStatus; len(budget.Allocations) > 0 {
+			fmt.Fprintf(out, "      Budget: $%.0f of $%.0f spent\n", budget.Spent, budget.Allocated)
+			for _, allocation := range budget.Allocations {
+				status := "✅"
+				if allocation.Alert != domain.BudgetAlertNone {
+					status = "⚠️ " + string(allocation.Alert)
+				}
+				fmt.Fprintf(out, "        • %s: %.0f%% consumed, $%.0f/day, projected $%.0f (%+.0f) %s\n",
+					allocation.Category, allocation.ConsumedPercent, allocation.BurnRatePerDay,
+					allocation.ProjectedSpend, allocation.ProjectedVariance, status)
+			}
+		}
+
+		result.KPIs += len(monitoring.KPIMeasurements)
+		result.RiskIndicators += len(monitoring.RiskStatus.RiskIndicators)
+	}
+
+	fmt.Fprintln(out, "\n   Compliance Drift:")
+	for _, cmd := range ComplianceStatusChanges() {
+		if err := env.GovernanceService.RecordComplianceStatus(ctx, cmd); err != nil {
+			return nil, fmt.Errorf("failed to record compliance status of %s: %w", cmd.Requirement, err)
+		}
+	}
+	reports, err := env.GovernanceService.DetectComplianceDrift(ctx, application.DetectComplianceDriftCommand{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to detect compliance drift: %w", err)
+	}
+	for _, report := range reports {
+		for _, change := range report.Changes {
+			emoji := "🔄"
+			if change.Violation() {
+				emoji = "🚨"
+			}
+			fmt.Fprintf(out, "   %s %s %s: %s → %s\n", emoji, report.ApplicationID, change.Requirement, change.Previous, change.Current)
+		}
+	}
+
+	if env.NotificationService != nil {
+		fmt.Fprintln(out, "\n   Notifications:")
+		run, err := env.NotificationService.NotifyDue(ctx, application.NotifyDueCommand{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to send notifications: %w", err)
+		}
+		fmt.Fprintf(out, "   • Sent: %d, failed: %d\n", len(run.Sent), run.Failed)
+	}
+
+	fmt.Fprintf(out, "\n   Enterprise Monitoring Summary:\n")
+	fmt.Fprintf(out, "   • Applications Monitored: %d\n", len(governed))
+	fmt.Fprintf(out, "   • Total KPIs Tracked: %d\n", result.KPIs)
+	fmt.Fprintf(out, "   • Total Risk Indicators: %d\n", result.RiskIndicators)
+	fmt.Fprintf(out, "   • Governance Coverage: %.1f%%\n", result.Coverage)
+
+	return result, nil
+}
+
+// formatPlanProjection describes when an action schedule projects one of its plans to complete
+func formatPlanProjection(schedule domain.ActionSchedule, planID string) string {
+	for _, plan := range schedule.Plans {
+		if plan.PlanID != planID {
+			continue
+		}
+		projection := fmt.Sprintf("projected to complete %s", plan.ProjectedCompletion.Format("2006-01-02"))
+		if plan.Late() {
+			projection += fmt.Sprintf(", %.0f days late", plan.ProjectedCompletion.Sub(plan.Deadline).Hours()/24)
+		}
+		return projection
+	}
+	return "not scheduled"
+}
@@ -0,0 +1,556 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ScheduledAction is an action placed on the schedule of its action plan
+type ScheduledAction struct {
+	PlanID      string
+	ActionID    string
+	Description string
+	Status      ActionStatus
+	Deadline    time.Time
+	Start       time.Time     // when the action's prerequisites allow it to start
+	Finish      time.Time     // when the action is projected to finish, or finished
+	Slack       time.Duration // how far the action can slip without delaying the schedule
+	Critical    bool          // the action has no slack
+}
+
+// Late reports whether the action is projected to finish after its deadline
+func (a ScheduledAction) Late() bool {
+	return !a.Deadline.IsZero() && a.Finish.After(a.Deadline)
+}
+
+// ScheduledPlan is the projected completion of an action plan
+type ScheduledPlan struct {
+	PlanID              string
+	ObjectiveID         string
+	Deadline            time.Time
+	ProjectedCompletion time.Time
+}
+
+// Late reports whether the plan is projected to complete after its deadline
+func (p ScheduledPlan) Late() bool {
+	return !p.Deadline.IsZero() && p.ProjectedCompletion.After(p.Deadline)
+}
+
+// ObjectiveProjection is the completion of a strategic objective projected by its action plans
+type ObjectiveProjection struct {
+	ObjectiveID         string
+	Name                string
+	Deadline            time.Time
+	ProjectedCompletion time.Time
+}
+
+// Slipping reports whether the objective is projected to complete after its deadline
+func (p ObjectiveProjection) Slipping() bool {
+	return !p.Deadline.IsZero() && p.ProjectedCompletion.After(p.Deadline)
+}
+
+// ActionSchedule projects when an agreement's action plans complete from the dependencies and
+// durations of their actions. The critical path is the chain of actions that determines the
+// projected completion: any slip along it delays the schedule.
+type ActionSchedule struct {
+	AgreementID         GovernanceAgreementID
+	Actions             []ScheduledAction // in dependency order
+	Plans               []ScheduledPlan
+	Objectives          []ObjectiveProjection
+	CriticalPath        []ScheduledAction // first action first
+	ProjectedCompletion time.Time
+	GeneratedAt         time.Time
+}
+
+// Objective returns the projection of one of the schedule's objectives
+func (s ActionSchedule) Objective(objectiveID string) (ObjectiveProjection, bool) {
+	for _, objective := range s.Objectives {
+		if objective.ObjectiveID == objectiveID {
+			return objective, true
+		}
+	}
+	return ObjectiveProjection{}, false
+}
+
+// ActionUpdate records progress on an action. Zero fields leave the action unchanged.
+type ActionUpdate struct {
+	Status      ActionStatus
+	Duration    time.Duration // revised estimate of the action's effort
+	Deadline    time.Time
+	CompletedAt time.Time // when a completed action finished, now when zero
+}
+
+// scheduleNode is an action, or the end of an action plan when action is -1
+type scheduleNode struct {
+	plan   int
+	action int
+	preds  []int
+}
+
+// BuildActionSchedule validates the dependencies of the direct principle's action plans and
+// projects when each action, plan and objective completes, starting no earlier than at
+func BuildActionSchedule(agreementID GovernanceAgreementID, direct DirectPrinciple, at time.Time) (ActionSchedule, error) {
+	plans := direct.ActionPlans
+	if err := validateActionPlans(plans); err != nil {
+		return ActionSchedule{}, err
+	}
+
+	// Every action, then every plan end, is a node; a plan ends when its actions finish and its
+	// actions start once the plans it depends on have ended
+	var nodes []scheduleNode
+	actionNodes := make([]map[string]int, len(plans))
+	for p, plan := range plans {
+		actionNodes[p] = make(map[string]int)
+		for a, action := range plan.Actions {
+			actionNodes[p][action.ID] = len(nodes)
+			nodes = append(nodes, scheduleNode{plan: p, action: a})
+		}
+	}
+	planEnds := make(map[string]int)
+	for p, plan := range plans {
+		planEnds[plan.ID] = len(nodes)
+		nodes = append(nodes, scheduleNode{plan: p, action: -1})
+	}
+	for n := range nodes {
+		node := &nodes[n]
+		plan := plans[node.plan]
+		if node.action < 0 {
+			for _, action := range plan.Actions {
+				node.preds = append(node.preds, actionNodes[node.plan][action.ID])
+			}
+			if len(plan.Actions) > 0 {
+				continue
+			}
+		} else {
+			for _, dependency := range plan.Actions[node.action].DependsOn {
+				node.preds = append(node.preds, actionNodes[node.plan][dependency])
+			}
+		}
+		for _, dependency := range plan.DependsOn {
+			node.preds = append(node.preds, planEnds[dependency])
+		}
+	}
+
+	order, err := scheduleOrder(nodes, plans)
+	if err != nil {
+		return ActionSchedule{}, err
+	}
+
+	// Forward pass: each node starts when its prerequisites finish
+	start := make([]time.Time, len(nodes))
+	finish := make([]time.Time, len(nodes))
+	for _, n := range order {
+		node := nodes[n]
+		for _, pred := range node.preds {
+			if finish[pred].After(start[n]) {
+				start[n] = finish[pred]
+			}
+		}
+
+		if node.action < 0 {
+			plan := plans[node.plan]
+			finish[n] = start[n]
+			if len(plan.Actions) == 0 && plan.Deadline.After(finish[n]) {
+				finish[n] = plan.Deadline
+			}
+			if finish[n].IsZero() {
+				finish[n] = at
+			}
+			continue
+		}
+
+		action := plans[node.plan].Actions[node.action]
+		switch {
+		case action.Status == ActionCompleted:
+			finish[n] = action.CompletedAt
+			if finish[n].IsZero() {
+				finish[n] = at
+			}
+			start[n] = finish[n]
+		case action.Status == ActionCancelled:
+			finish[n] = start[n]
+		default:
+			if at.After(start[n]) {
+				start[n] = at
+			}
+			finish[n] = start[n].Add(action.Duration)
+			if action.Duration == 0 && action.Deadline.After(finish[n]) {
+				finish[n] = action.Deadline
+			}
+		}
+	}
+
+	schedule := ActionSchedule{
+		AgreementID:  agreementID,
+		Actions:      []ScheduledAction{},
+		Plans:        []ScheduledPlan{},
+		Objectives:   []ObjectiveProjection{},
+		CriticalPath: []ScheduledAction{},
+		GeneratedAt:  at,
+	}
+	for _, n := range order {
+		if finish[n].After(schedule.ProjectedCompletion) {
+			schedule.ProjectedCompletion = finish[n]
+		}
+	}
+
+	// Backward pass: a node's latest finish is the latest its successors can start
+	successors := make([][]int, len(nodes))
+	for n, node := range nodes {
+		for _, pred := range node.preds {
+			successors[pred] = append(successors[pred], n)
+		}
+	}
+	latest := make([]time.Time, len(nodes))
+	for i := len(order) - 1; i >= 0; i-- {
+		n := order[i]
+		latest[n] = schedule.ProjectedCompletion
+		for _, succ := range successors[n] {
+			latestStart := latest[succ].Add(-finish[succ].Sub(start[succ]))
+			if latestStart.Before(latest[n]) {
+				latest[n] = latestStart
+			}
+		}
+	}
+
+	scheduled := make(map[int]ScheduledAction)
+	for _, n := range order {
+		node := nodes[n]
+		if node.action < 0 {
+			continue
+		}
+		action := plans[node.plan].Actions[node.action]
+		entry := ScheduledAction{
+			PlanID:      plans[node.plan].ID,
+			ActionID:    action.ID,
+			Description: action.Description,
+			Status:      action.Status,
+			Deadline:    action.Deadline,
+			Start:       start[n],
+			Finish:      finish[n],
+			Slack:       latest[n].Sub(finish[n]),
+		}
+		entry.Critical = entry.Slack <= 0
+		scheduled[n] = entry
+		schedule.Actions = append(schedule.Actions, entry)
+	}
+
+	for p, plan := range plans {
+		schedule.Plans = append(schedule.Plans, ScheduledPlan{
+			PlanID:              plan.ID,
+			ObjectiveID:         plan.ObjectiveID,
+			Deadline:            plan.Deadline,
+			ProjectedCompletion: finish[planEnds[plans[p].ID]],
+		})
+	}
+
+	for _, objective := range direct.StrategicDirection.Objectives {
+		projection := ObjectiveProjection{ObjectiveID: objective.ID, Name: objective.Name, Deadline: objective.Deadline}
+		for _, plan := range schedule.Plans {
+			if plan.ObjectiveID == objective.ID && plan.ProjectedCompletion.After(projection.ProjectedCompletion) {
+				projection.ProjectedCompletion = plan.ProjectedCompletion
+			}
+		}
+		if !projection.ProjectedCompletion.IsZero() {
+			schedule.Objectives = append(schedule.Objectives, projection)
+		}
+	}
+
+	schedule.CriticalPath = criticalPath(nodes, order, start, finish, scheduled)
+	return schedule, nil
+}
+
+// criticalPath walks back from the action finishing last through the prerequisites that
+// determined each start
+func criticalPath(nodes []scheduleNode, order []int, start, finish []time.Time, scheduled map[int]ScheduledAction) []ScheduledAction {
+	last := -1
+	for _, n := range order {
+		if _, ok := scheduled[n]; ok && (last < 0 || finish[n].After(finish[last])) {
+			last = n
+		}
+	}
+
+	var path []ScheduledAction
+	for n := last; n >= 0; {
+		if entry, ok := scheduled[n]; ok {
+			path = append([]ScheduledAction{entry}, path...)
+		}
+		binding := -1
+		for _, pred := range nodes[n].preds {
+			if !start[n].IsZero() && finish[pred].Equal(start[n]) {
+				binding = pred
+				break
+			}
+		}
+		n = binding
+	}
+	if path == nil {
+		path = []ScheduledAction{}
+	}
+	return path
+}
+
+// scheduleOrder sorts the nodes so every node follows its prerequisites, failing when the
+// dependencies form a cycle
+func scheduleOrder(nodes []scheduleNode, plans []ActionPlan) ([]int, error) {
+	pending := make([]int, len(nodes))
+	successors := make([][]int, len(nodes))
+	for n, node := range nodes {
+		pending[n] = len(node.preds)
+		for _, pred := range node.preds {
+			successors[pred] = append(successors[pred], n)
+		}
+	}
+
+	order := make([]int, 0, len(nodes))
+	var ready []int
+	for n := range nodes {
+		if pending[n] == 0 {
+			ready = append(ready, n)
+		}
+	}
+	for len(ready) > 0 {
+		n := ready[0]
+		ready = ready[1:]
+		order = append(order, n)
+		for _, succ := range successors[n] {
+			pending[succ]--
+			if pending[succ] == 0 {
+				ready = append(ready, succ)
+			}
+		}
+	}
+
+	if len(order) < len(nodes) {
+		for n, node := range nodes {
+			if pending[n] == 0 {
+				continue
+			}
+			if node.action < 0 {
+				return nil, fmt.Errorf("action plan %s depends on itself through its dependencies", plans[node.plan].ID)
+			}
+			return nil, fmt.Errorf("action %s of plan %s depends on itself through its dependencies", plans[node.plan].Actions[node.action].ID, plans[node.plan].ID)
+		}
+	}
+	return order, nil
+}
+
+// validateActionPlans checks that plan and action IDs are unique, that dependencies name known
+// plans and actions of the same plan, and that estimates are not negative. Cycles are found when
+// the plans are scheduled.
+func validateActionPlans(plans []ActionPlan) error {
+	known := make(map[string]bool)
+	for _, plan := range plans {
+		if plan.ID == "" {
+			return errors.New("action plan ID cannot be empty")
+		}
+		if known[plan.ID] {
+			return fmt.Errorf("duplicate action plan %s", plan.ID)
+		}
+		known[plan.ID] = true
+	}
+
+	for _, plan := range plans {
+		for _, dependency := range plan.DependsOn {
+			if dependency == plan.ID {
+				return fmt.Errorf("action plan %s cannot depend on itself", plan.ID)
+			}
+			if !known[dependency] {
+				return fmt.Errorf("action plan %s depends on unknown plan %s", plan.ID, dependency)
+			}
+		}
+
+		actions := make(map[string]bool)
+		for _, action := range plan.Actions {
+			if action.ID == "" {
+				return fmt.Errorf("action plan %s: action ID cannot be empty", plan.ID)
+			}
+			if actions[action.ID] {
+				return fmt.Errorf("action plan %s: duplicate action %s", plan.ID, action.ID)
+			}
+			actions[action.ID] = true
+			if action.Duration < 0 {
+				return fmt.Errorf("action plan %s: action %s duration must not be negative", plan.ID, action.ID)
+			}
+		}
+		for _, action := range plan.Actions {
+			for _, dependency := range action.DependsOn {
+				if dependency == action.ID {
+					return fmt.Errorf("action plan %s: action %s cannot depend on itself", plan.ID, action.ID)
+				}
+				if !actions[dependency] {
+					return fmt.Errorf("action plan %s: action %s depends on unknown action %s", plan.ID, action.ID, dependency)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// applySchedule records the projected completions of the schedule on the direct principle's
+// action plans, actions and objectives
+func applySchedule(direct *DirectPrinciple, schedule ActionSchedule) {
+	finishes := make(map[string]time.Time)
+	for _, action := range schedule.Actions {
+		finishes[action.PlanID+"/"+action.ActionID] = action.Finish
+	}
+
+	plans := make([]ActionPlan, len(direct.ActionPlans))
+	for p, plan := range direct.ActionPlans {
+		plan.Actions = append([]Action{}, plan.Actions...)
+		for a := range plan.Actions {
+			plan.Actions[a].ProjectedCompletion = finishes[plan.ID+"/"+plan.Actions[a].ID]
+		}
+		plan.ProjectedCompletion = schedule.Plans[p].ProjectedCompletion
+		plans[p] = plan
+	}
+	direct.ActionPlans = plans
+
+	objectives := make([]StrategicObjective, len(direct.StrategicDirection.Objectives))
+	for i, objective := range direct.StrategicDirection.Objectives {
+		projection, _ := schedule.Objective(objective.ID)
+		objective.ProjectedCompletion = projection.ProjectedCompletion
+		objectives[i] = objective
+	}
+	direct.StrategicDirection.Objectives = objectives
+}
+
+// scheduleDirect schedules the direct principle's action plans and records the projections
+func scheduleDirect(agreementID GovernanceAgreementID, direct *DirectPrinciple, at time.Time) (*ActionSchedule, error) {
+	schedule, err := BuildActionSchedule(agreementID, *direct, at)
+	if err != nil {
+		return nil, err
+	}
+	applySchedule(direct, schedule)
+	return &schedule, nil
+}
+
+// SaveActionPlan adds an action plan to the agreement, or replaces the plan with the same ID, and
+// reschedules the agreement's action plans
+func (s *DirectionService) SaveActionPlan(ctx context.Context, agreementID GovernanceAgreementID, plan ActionPlan) (*ActionSchedule, error) {
+	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+
+	if plan.ObjectiveID != "" {
+		found := false
+		for _, objective := range agreement.Direct.StrategicDirection.Objectives {
+			found = found || objective.ID == plan.ObjectiveID
+		}
+		if !found {
+			return nil, fmt.Errorf("action plan %s implements unknown objective %s", plan.ID, plan.ObjectiveID)
+		}
+	}
+	if plan.Status == "" {
+		plan.Status = ActionPending
+	}
+	plan.Actions = append([]Action{}, plan.Actions...)
+	for a := range plan.Actions {
+		if plan.Actions[a].Status == "" {
+			plan.Actions[a].Status = ActionPending
+		}
+	}
+
+	plans := append([]ActionPlan{}, agreement.Direct.ActionPlans...)
+	replaced := false
+	for p := range plans {
+		if plans[p].ID == plan.ID {
+			plans[p] = plan
+			replaced = true
+		}
+	}
+	if !replaced {
+		plans = append(plans, plan)
+	}
+	agreement.Direct.ActionPlans = plans
+
+	schedule, err := scheduleDirect(agreementID, &agreement.Direct, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("invalid action plans: %w", err)
+	}
+
+	err = s.agreementRepo.Update(ctx, agreement)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update governance agreement: %w", err)
+	}
+	return schedule, nil
+}
+
+// UpdateAction records progress on one of the agreement's actions and reschedules the action
+// plans, so a slipping prerequisite moves the projected completion of everything after it
+func (s *DirectionService) UpdateAction(ctx context.Context, agreementID GovernanceAgreementID, planID, actionID string, update ActionUpdate) (*ActionSchedule, error) {
+	if update.Duration < 0 {
+		return nil, errors.New("action duration must not be negative")
+	}
+
+	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+
+	plans := append([]ActionPlan{}, agreement.Direct.ActionPlans...)
+	for p := range plans {
+		if plans[p].ID != planID {
+			continue
+		}
+
+		actions := append([]Action{}, plans[p].Actions...)
+		for a := range actions {
+			if actions[a].ID != actionID {
+				continue
+			}
+
+			action := &actions[a]
+			if update.Status != "" {
+				action.Status = update.Status
+			}
+			if update.Duration > 0 {
+				action.Duration = update.Duration
+			}
+			if !update.Deadline.IsZero() {
+				action.Deadline = update.Deadline
+			}
+			if action.Status == ActionCompleted {
+				action.CompletedAt = update.CompletedAt
+				if action.CompletedAt.IsZero() {
+					action.CompletedAt = time.Now()
+				}
+			} else {
+				action.CompletedAt = time.Time{}
+			}
+
+			plans[p].Actions = actions
+			agreement.Direct.ActionPlans = plans
+			schedule, err := scheduleDirect(agreementID, &agreement.Direct, time.Now())
+			if err != nil {
+				return nil, err
+			}
+
+			err = s.agreementRepo.Update(ctx, agreement)
+			if err != nil {
+				return nil, fmt.Errorf("failed to update governance agreement: %w", err)
+			}
+			return schedule, nil
+		}
+		return nil, fmt.Errorf("action %s not found in plan %s", actionID, planID)
+	}
+
+	return nil, fmt.Errorf("action plan %s not found in agreement %s", planID, agreementID)
+}
+
+// ScheduleActions projects the completion of the agreement's action plans and objectives
+func (s *DirectionService) ScheduleActions(ctx context.Context, agreementID GovernanceAgreementID) (*ActionSchedule, error) {
+	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+
+	schedule, err := BuildActionSchedule(agreementID, agreement.Direct, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return &schedule, nil
+}
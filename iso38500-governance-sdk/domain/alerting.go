@@ -0,0 +1,393 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// AlertSource identifies what an alert rule watches
+type AlertSource string
+
+const (
+	AlertSourceKPI           AlertSource = "kpi"
+	AlertSourceRiskIndicator AlertSource = "risk_indicator"
+)
+
+// AlertSeverity is the level of a threshold and of the alerts it raises
+type AlertSeverity string
+
+const (
+	AlertWarning  AlertSeverity = "warning"
+	AlertCritical AlertSeverity = "critical"
+)
+
+// Validate ensures the threshold has a known level and condition
+func (t Threshold) Validate() error {
+	if AlertSeverity(t.Level) != AlertWarning && AlertSeverity(t.Level) != AlertCritical {
+		return fmt.Errorf("threshold level must be %s or %s, not %q", AlertWarning, AlertCritical, t.Level)
+	}
+	switch t.Condition {
+	case ">", ">=", "<", "<=", "=", "==", "!=":
+		return nil
+	}
+	return fmt.Errorf("unknown threshold condition %q", t.Condition)
+}
+
+// Breached reports whether the value meets the threshold's condition, e.g. a value below 40 for
+// a "<" threshold of 40
+func (t Threshold) Breached(value float64) bool {
+	switch t.Condition {
+	case ">":
+		return value > t.Value
+	case ">=":
+		return value >= t.Value
+	case "<":
+		return value < t.Value
+	case "<=":
+		return value <= t.Value
+	case "=", "==":
+		return value == t.Value
+	case "!=":
+		return value != t.Value
+	}
+	return false
+}
+
+// String describes the threshold, e.g. "critical when < 40"
+func (t Threshold) String() string {
+	return fmt.Sprintf("%s when %s %g", t.Level, t.Condition, t.Value)
+}
+
+// RiskIndicatorMonitoring configures the thresholds of a risk indicator and who is alerted when
+// they are breached
+type RiskIndicatorMonitoring struct {
+	Indicator  string // name of the risk indicator
+	Thresholds []Threshold
+	Alerts     []Alert
+}
+
+// AlertRule raises an alert when a KPI or risk indicator breaches one of its thresholds
+type AlertRule struct {
+	Source     AlertSource
+	Subject    string // KPI ID or risk indicator name
+	Thresholds []Threshold
+	Alerts     []Alert // who to notify
+}
+
+// Validate ensures the rule names what it watches and has valid thresholds
+func (r AlertRule) Validate() error {
+	if r.Source != AlertSourceKPI && r.Source != AlertSourceRiskIndicator {
+		return fmt.Errorf("unknown alert source %q", r.Source)
+	}
+	if r.Subject == "" {
+		return fmt.Errorf("%s alert rule must name what it watches", r.Source)
+	}
+	if len(r.Thresholds) == 0 {
+		return fmt.Errorf("%s %s alert rule needs at least one threshold", r.Source, r.Subject)
+	}
+	for _, threshold := range r.Thresholds {
+		if err := threshold.Validate(); err != nil {
+			return fmt.Errorf("%s %s: %w", r.Source, r.Subject, err)
+		}
+	}
+	return nil
+}
+
+// Breached returns the most severe threshold the value breaches
+func (r AlertRule) Breached(value float64) (Threshold, bool) {
+	var breached Threshold
+	found := false
+	for _, threshold := range r.Thresholds {
+		if !threshold.Breached(value) {
+			continue
+		}
+		if !found || alertSeverityRank(AlertSeverity(threshold.Level)) > alertSeverityRank(AlertSeverity(breached.Level)) {
+			breached = threshold
+			found = true
+		}
+	}
+	return breached, found
+}
+
+func alertSeverityRank(severity AlertSeverity) int {
+	switch severity {
+	case AlertCritical:
+		return 2
+	case AlertWarning:
+		return 1
+	}
+	return 0
+}
+
+// AlertReading is the current value of a KPI or risk indicator
+type AlertReading struct {
+	Source  AlertSource
+	Subject string
+	Value   float64
+	At      time.Time
+}
+
+// ActiveAlert is an alert raised by a breached threshold that has not yet been resolved. There is
+// at most one active alert per agreement, source and subject, so a threshold that stays breached
+// raises one alert rather than one per evaluation.
+type ActiveAlert struct {
+	ID               string // agreement, source and subject
+	AgreementID      GovernanceAgreementID
+	Source           AlertSource
+	Subject          string
+	Severity         AlertSeverity
+	PreviousSeverity AlertSeverity // severity before the last change, empty when first raised
+	Value            float64
+	Threshold        Threshold
+	Notify           []Alert
+	RaisedAt         time.Time
+	LastEvaluatedAt  time.Time
+	AcknowledgedAt   time.Time // zero until someone takes the alert on; cleared when its severity changes
+	AcknowledgedBy   string
+	ResolvedAt       time.Time // set on alerts reported as resolved
+}
+
+// AlertEvaluation is the outcome of checking an agreement's alert rules: the alerts raised or
+// whose severity changed, the alerts resolved, and every alert still active
+type AlertEvaluation struct {
+	AgreementID GovernanceAgreementID
+	Raised      []ActiveAlert
+	Resolved    []ActiveAlert
+	Active      []ActiveAlert // by ID
+	EvaluatedAt time.Time
+}
+
+// alertID identifies the alert of an agreement's KPI or risk indicator
+func alertID(agreementID GovernanceAgreementID, source AlertSource, subject string) string {
+	return fmt.Sprintf("%s/%s/%s", agreementID, source, subject)
+}
+
+// AgreementAlertRules returns the alert rules configured in the agreement's KPI and risk
+// indicator monitoring
+func AgreementAlertRules(agreement GovernanceAgreement) []AlertRule {
+	var rules []AlertRule
+	for _, kpi := range agreement.Monitor.PerformanceMonitoring.KPIMonitoring {
+		if len(kpi.Thresholds) > 0 {
+			rules = append(rules, AlertRule{Source: AlertSourceKPI, Subject: kpi.KPIID, Thresholds: kpi.Thresholds, Alerts: kpi.Alerts})
+		}
+	}
+	for _, indicator := range agreement.Monitor.RiskMonitoring.IndicatorMonitoring {
+		if len(indicator.Thresholds) > 0 {
+			rules = append(rules, AlertRule{Source: AlertSourceRiskIndicator, Subject: indicator.Indicator, Thresholds: indicator.Thresholds, Alerts: indicator.Alerts})
+		}
+	}
+	return rules
+}
+
+// EvaluateAlertRules checks the readings against the rules and reconciles the result with the
+// alerts already active. A breach with no active alert raises one; a breach at another severity
+// changes the active alert's severity and raises it again; an alert whose rule is no longer
+// breached, or no longer exists, is resolved. An active alert without a reading is left as is.
+func EvaluateAlertRules(agreementID GovernanceAgreementID, rules []AlertRule, readings []AlertReading, active []ActiveAlert, at time.Time) AlertEvaluation {
+	evaluation := AlertEvaluation{
+		AgreementID: agreementID,
+		Raised:      []ActiveAlert{},
+		Resolved:    []ActiveAlert{},
+		Active:      []ActiveAlert{},
+		EvaluatedAt: at,
+	}
+
+	current := make(map[string]ActiveAlert)
+	for _, alert := range active {
+		current[alert.ID] = alert
+	}
+	values := make(map[string]AlertReading)
+	for _, reading := range readings {
+		values[alertID(agreementID, reading.Source, reading.Subject)] = reading
+	}
+
+	ruled := make(map[string]bool)
+	for _, rule := range rules {
+		id := alertID(agreementID, rule.Source, rule.Subject)
+		ruled[id] = true
+		reading, ok := values[id]
+		if !ok {
+			continue
+		}
+
+		alert, wasActive := current[id]
+		threshold, breached := rule.Breached(reading.Value)
+		if !breached {
+			if wasActive {
+				alert.Value = reading.Value
+				alert.LastEvaluatedAt = at
+				alert.ResolvedAt = at
+				evaluation.Resolved = append(evaluation.Resolved, alert)
+				delete(current, id)
+			}
+			continue
+		}
+
+		severity := AlertSeverity(threshold.Level)
+		raised := false
+		if !wasActive {
+			alert = ActiveAlert{
+				ID:          id,
+				AgreementID: agreementID,
+				Source:      rule.Source,
+				Subject:     rule.Subject,
+				RaisedAt:    at,
+			}
+			raised = true
+		} else if alert.Severity != severity {
+			alert.PreviousSeverity = alert.Severity
+			alert.AcknowledgedAt = time.Time{}
+			alert.AcknowledgedBy = ""
+			raised = true
+		}
+		alert.Severity = severity
+		alert.Value = reading.Value
+		alert.Threshold = threshold
+		alert.Notify = rule.Alerts
+		alert.LastEvaluatedAt = at
+		current[id] = alert
+		if raised {
+			evaluation.Raised = append(evaluation.Raised, alert)
+		}
+	}
+
+	for id, alert := range current {
+		if !ruled[id] {
+			alert.LastEvaluatedAt = at
+			alert.ResolvedAt = at
+			evaluation.Resolved = append(evaluation.Resolved, alert)
+			delete(current, id)
+		}
+	}
+
+	for _, alert := range current {
+		evaluation.Active = append(evaluation.Active, alert)
+	}
+	sort.Slice(evaluation.Active, func(i, j int) bool { return evaluation.Active[i].ID < evaluation.Active[j].ID })
+	sort.Slice(evaluation.Resolved, func(i, j int) bool { return evaluation.Resolved[i].ID < evaluation.Resolved[j].ID })
+	return evaluation
+}
+
+// ConfigureKPIAlerts sets the thresholds and alert recipients of one of the agreement's KPIs,
+// replacing any configured before
+func (s *MonitoringService) ConfigureKPIAlerts(ctx context.Context, agreementID GovernanceAgreementID, monitoring KPIMonitoring) error {
+	rule := AlertRule{Source: AlertSourceKPI, Subject: monitoring.KPIID, Thresholds: monitoring.Thresholds, Alerts: monitoring.Alerts}
+	if err := rule.Validate(); err != nil {
+		return err
+	}
+
+	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
+	if err != nil {
+		return fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+
+	kpis := make([]KPIMonitoring, 0, len(agreement.Monitor.PerformanceMonitoring.KPIMonitoring)+1)
+	replaced := false
+	for _, existing := range agreement.Monitor.PerformanceMonitoring.KPIMonitoring {
+		if existing.KPIID == monitoring.KPIID {
+			existing.Thresholds = monitoring.Thresholds
+			existing.Alerts = monitoring.Alerts
+			if monitoring.Frequency != "" {
+				existing.Frequency = monitoring.Frequency
+			}
+			if monitoring.Responsible != "" {
+				existing.Responsible = monitoring.Responsible
+			}
+			replaced = true
+			monitoring = existing
+		}
+		kpis = append(kpis, existing)
+	}
+	if !replaced {
+		kpis = append(kpis, monitoring)
+	}
+	agreement.Monitor.PerformanceMonitoring.KPIMonitoring = kpis
+
+	err = s.agreementRepo.Update(ctx, agreement)
+	if err != nil {
+		return fmt.Errorf("failed to update governance agreement: %w", err)
+	}
+	return nil
+}
+
+// ConfigureRiskIndicatorAlerts sets the thresholds and alert recipients of one of the agreement's
+// risk indicators, replacing any configured before
+func (s *MonitoringService) ConfigureRiskIndicatorAlerts(ctx context.Context, agreementID GovernanceAgreementID, monitoring RiskIndicatorMonitoring) error {
+	rule := AlertRule{Source: AlertSourceRiskIndicator, Subject: monitoring.Indicator, Thresholds: monitoring.Thresholds, Alerts: monitoring.Alerts}
+	if err := rule.Validate(); err != nil {
+		return err
+	}
+
+	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
+	if err != nil {
+		return fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+
+	indicators := make([]RiskIndicatorMonitoring, 0, len(agreement.Monitor.RiskMonitoring.IndicatorMonitoring)+1)
+	replaced := false
+	for _, existing := range agreement.Monitor.RiskMonitoring.IndicatorMonitoring {
+		if existing.Indicator == monitoring.Indicator {
+			existing = monitoring
+			replaced = true
+		}
+		indicators = append(indicators, existing)
+	}
+	if !replaced {
+		indicators = append(indicators, monitoring)
+	}
+	agreement.Monitor.RiskMonitoring.IndicatorMonitoring = indicators
+
+	err = s.agreementRepo.Update(ctx, agreement)
+	if err != nil {
+		return fmt.Errorf("failed to update governance agreement: %w", err)
+	}
+	return nil
+}
+
+// EvaluateAlerts checks the latest measurement of each KPI and the current value of each risk
+// indicator with alert thresholds, and records the agreement's active alerts
+func (s *MonitoringService) EvaluateAlerts(ctx context.Context, agreementID GovernanceAgreementID) (*AlertEvaluation, error) {
+	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+
+	rules := AgreementAlertRules(agreement)
+	var readings []AlertReading
+	watchesRisks := false
+	for _, rule := range rules {
+		switch rule.Source {
+		case AlertSourceKPI:
+			if measurement, ok := s.latestMeasurement(ctx, agreement, rule.Subject); ok {
+				readings = append(readings, AlertReading{Source: AlertSourceKPI, Subject: rule.Subject, Value: measurement.Value, At: measurement.MeasuredAt})
+			}
+		case AlertSourceRiskIndicator:
+			watchesRisks = true
+		}
+	}
+
+	now := time.Now()
+	if watchesRisks {
+		risks, err := s.MonitorRisks(ctx, agreementID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to monitor risks: %w", err)
+		}
+		for _, indicator := range risks.RiskIndicators {
+			readings = append(readings, AlertReading{Source: AlertSourceRiskIndicator, Subject: indicator.Name, Value: indicator.Value, At: now})
+		}
+	}
+
+	evaluation := EvaluateAlertRules(agreementID, rules, readings, agreement.Monitor.ActiveAlerts, now)
+	if len(evaluation.Raised) == 0 && len(evaluation.Resolved) == 0 && len(evaluation.Active) == 0 && len(agreement.Monitor.ActiveAlerts) == 0 {
+		return &evaluation, nil
+	}
+
+	agreement.Monitor.ActiveAlerts = evaluation.Active
+	err = s.agreementRepo.Update(ctx, agreement)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update governance agreement: %w", err)
+	}
+	return &evaluation, nil
+}
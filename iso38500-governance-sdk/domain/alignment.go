@@ -0,0 +1,165 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// ObjectiveContribution records an application contributing to a strategic objective
+type ObjectiveContribution struct {
+	ApplicationID ApplicationID
+	Weight        float64 // share of the objective the application delivers, from 0 to 1
+}
+
+// ApplicationContributions returns the applications contributing to the objective. An objective
+// that names no contributions is delivered entirely by the application its agreement governs.
+func (o StrategicObjective) ApplicationContributions(owner ApplicationID) []ObjectiveContribution {
+	if len(o.Contributions) == 0 {
+		return []ObjectiveContribution{{ApplicationID: owner, Weight: 1}}
+	}
+	return o.Contributions
+}
+
+// validateContributions checks that every contribution names a distinct application with a weight
+// between 0 and 1, and that the weights add up to at most the whole objective
+func validateContributions(objectiveID string, contributions []ObjectiveContribution) error {
+	seen := make(map[ApplicationID]bool)
+	total := 0.0
+	for _, contribution := range contributions {
+		if contribution.ApplicationID == "" {
+			return fmt.Errorf("objective %s: contributing application ID cannot be empty", objectiveID)
+		}
+		if seen[contribution.ApplicationID] {
+			return fmt.Errorf("objective %s: application %s contributes more than once", objectiveID, contribution.ApplicationID)
+		}
+		seen[contribution.ApplicationID] = true
+		if contribution.Weight <= 0 || contribution.Weight > 1 {
+			return fmt.Errorf("objective %s: contribution weight of %s must be above 0 and at most 1", objectiveID, contribution.ApplicationID)
+		}
+		total += contribution.Weight
+	}
+	if total > 1+1e-9 {
+		return fmt.Errorf("objective %s: contribution weights add up to %.2f, more than the whole objective", objectiveID, total)
+	}
+	return nil
+}
+
+// AlignmentObjective is one objective column of an alignment matrix
+type AlignmentObjective struct {
+	AgreementID  GovernanceAgreementID // agreement that sets the objective
+	ObjectiveID  string
+	Name         string
+	Applications int     // portfolio applications contributing to the objective
+	Weight       float64 // share of the objective delivered by the portfolio's applications
+}
+
+// ApplicationAlignment is one application row of an alignment matrix
+type ApplicationAlignment struct {
+	ApplicationID ApplicationID
+	Name          string
+	Weights       []float64 // contribution to each objective column, 0 where there is none
+	Objectives    int       // objectives the application contributes to
+}
+
+// AlignmentMatrix maps a portfolio's applications against the strategic objectives they
+// contribute to. Orphans are applications that support no objective.
+type AlignmentMatrix struct {
+	PortfolioID  PortfolioID
+	Objectives   []AlignmentObjective
+	Applications []ApplicationAlignment
+	Orphans      []ApplicationID
+	GeneratedAt  time.Time
+}
+
+// Weight returns the application's contribution to an objective of the matrix
+func (m AlignmentMatrix) Weight(appID ApplicationID, agreementID GovernanceAgreementID, objectiveID string) float64 {
+	for column, objective := range m.Objectives {
+		if objective.AgreementID != agreementID || objective.ObjectiveID != objectiveID {
+			continue
+		}
+		for _, row := range m.Applications {
+			if row.ApplicationID == appID {
+				return row.Weights[column]
+			}
+		}
+	}
+	return 0
+}
+
+// BuildAlignmentMatrix maps the applications against the objectives of the agreements. The
+// matrix has a column for every objective set by an application's agreement or that an
+// application contributes to, and a row for every application that is not retired.
+func BuildAlignmentMatrix(portfolioID PortfolioID, apps []Application, agreements []GovernanceAgreement) AlignmentMatrix {
+	matrix := AlignmentMatrix{
+		PortfolioID:  portfolioID,
+		Objectives:   []AlignmentObjective{},
+		Applications: []ApplicationAlignment{},
+		Orphans:      []ApplicationID{},
+		GeneratedAt:  time.Now(),
+	}
+
+	rows := make(map[ApplicationID]int)
+	for _, app := range apps {
+		if app.Status == StatusRetired {
+			continue
+		}
+		rows[app.ID] = len(matrix.Applications)
+		matrix.Applications = append(matrix.Applications, ApplicationAlignment{ApplicationID: app.ID, Name: app.Name})
+	}
+
+	sorted := make([]GovernanceAgreement, len(agreements))
+	copy(sorted, agreements)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].ID < sorted[j].ID })
+
+	for _, agreement := range sorted {
+		_, ownedInPortfolio := rows[agreement.ApplicationID]
+		for _, objective := range agreement.Direct.StrategicDirection.Objectives {
+			column := AlignmentObjective{AgreementID: agreement.ID, ObjectiveID: objective.ID, Name: objective.Name}
+			weights := make(map[int]float64)
+			for _, contribution := range objective.ApplicationContributions(agreement.ApplicationID) {
+				if row, ok := rows[contribution.ApplicationID]; ok {
+					weights[row] = contribution.Weight
+					column.Applications++
+					column.Weight += contribution.Weight
+				}
+			}
+			if !ownedInPortfolio && len(weights) == 0 {
+				continue
+			}
+
+			matrix.Objectives = append(matrix.Objectives, column)
+			for row := range matrix.Applications {
+				matrix.Applications[row].Weights = append(matrix.Applications[row].Weights, weights[row])
+				if weights[row] > 0 {
+					matrix.Applications[row].Objectives++
+				}
+			}
+		}
+	}
+
+	for _, row := range matrix.Applications {
+		if row.Objectives == 0 {
+			matrix.Orphans = append(matrix.Orphans, row.ApplicationID)
+		}
+	}
+	return matrix
+}
+
+// AssessStrategicAlignment maps the portfolio's applications against the strategic objectives of
+// every governance agreement and flags the applications that support no objective
+func (s *EvaluationService) AssessStrategicAlignment(ctx context.Context, portfolioID PortfolioID) (*AlignmentMatrix, error) {
+	portfolio, err := s.portfolioRepo.FindByID(ctx, portfolioID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find portfolio: %w", err)
+	}
+
+	agreements, err := s.agreementRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find governance agreements: %w", err)
+	}
+
+	matrix := BuildAlignmentMatrix(portfolioID, s.currentApplications(ctx, portfolio.Applications), agreements)
+	return &matrix, nil
+}
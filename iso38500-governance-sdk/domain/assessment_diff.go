@@ -0,0 +1,268 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// AssessmentChange is one metric that differs between two assessments
+type AssessmentChange struct {
+	Metric    string
+	Before    string
+	After     string
+	Delta     float64        // After - Before; zero for non-numeric metrics
+	Unit      string         // "pts", "h" or empty
+	Direction TrendDirection // improving or degrading
+}
+
+// Description summarizes the change for reports, e.g. "risk level changed low→high" or
+// "cost efficiency -12pts"
+func (c AssessmentChange) Description() string {
+	if c.Delta == 0 {
+		return fmt.Sprintf("%s changed %s→%s", c.Metric, c.Before, c.After)
+	}
+	sign := "+"
+	if c.Delta < 0 {
+		sign = "-"
+	}
+	return fmt.Sprintf("%s %s%s%s", c.Metric, sign, formatAmount(math.Abs(c.Delta)), c.Unit)
+}
+
+// AssessmentDiff is the structured difference between two assessments of the same application
+type AssessmentDiff struct {
+	ApplicationID           ApplicationID
+	FromAssessmentID        string
+	ToAssessmentID          string
+	Changes                 []AssessmentChange
+	NewRecommendations      []Recommendation
+	ResolvedRecommendations []Recommendation
+	NewSLABreaches          []SLABreach
+	ResolvedSLABreaches     []SLABreach
+}
+
+// HasChanges reports whether anything differs between the two assessments
+func (d AssessmentDiff) HasChanges() bool {
+	return len(d.Changes) > 0 || len(d.NewRecommendations) > 0 || len(d.ResolvedRecommendations) > 0 ||
+		len(d.NewSLABreaches) > 0 || len(d.ResolvedSLABreaches) > 0
+}
+
+// Summary describes every difference in one line each
+func (d AssessmentDiff) Summary() []string {
+	var lines []string
+	for _, change := range d.Changes {
+		lines = append(lines, change.Description())
+	}
+	lines = appendCount(lines, len(d.NewRecommendations), "new recommendation", "new recommendations")
+	lines = appendCount(lines, len(d.ResolvedRecommendations), "resolved recommendation", "resolved recommendations")
+	lines = appendCount(lines, len(d.NewSLABreaches), "new SLA breach", "new SLA breaches")
+	lines = appendCount(lines, len(d.ResolvedSLABreaches), "resolved SLA breach", "resolved SLA breaches")
+	return lines
+}
+
+// DiffAssessments compares two assessments of the same application. Recommendations are matched
+// on their type and description and SLA breaches on their service and metric, since IDs are
+// assigned per assessment.
+func DiffAssessments(before, after ApplicationAssessment) (*AssessmentDiff, error) {
+	if before.ApplicationID != after.ApplicationID {
+		return nil, fmt.Errorf("cannot compare assessments of %s and %s", before.ApplicationID, after.ApplicationID)
+	}
+
+	diff := &AssessmentDiff{
+		ApplicationID:    after.ApplicationID,
+		FromAssessmentID: before.ID,
+		ToAssessmentID:   after.ID,
+	}
+
+	if before.RiskLevel != after.RiskLevel {
+		direction := TrendImproving
+		if riskScore(after.RiskLevel) > riskScore(before.RiskLevel) {
+			direction = TrendDegrading
+		}
+		diff.Changes = append(diff.Changes, AssessmentChange{
+			Metric:    "risk level",
+			Before:    string(before.RiskLevel),
+			After:     string(after.RiskLevel),
+			Direction: direction,
+		})
+	}
+
+	add := func(metric string, before, after float64, unit string, higherIsBetter bool) {
+		if change, ok := numericChange(metric, before, after, unit, higherIsBetter); ok {
+			diff.Changes = append(diff.Changes, change)
+		}
+	}
+	add("code quality", float64(before.TechnicalHealth.CodeQuality), float64(after.TechnicalHealth.CodeQuality), "", true)
+	add("documentation", float64(before.TechnicalHealth.Documentation), float64(after.TechnicalHealth.Documentation), "", true)
+	add("test coverage", before.TechnicalHealth.TestCoverage, after.TechnicalHealth.TestCoverage, "pts", true)
+	add("security", float64(before.TechnicalHealth.SecurityScore), float64(after.TechnicalHealth.SecurityScore), "", true)
+	add("performance", float64(before.TechnicalHealth.PerformanceScore), float64(after.TechnicalHealth.PerformanceScore), "", true)
+	add("business alignment", before.BusinessValue.BusinessAlignment, after.BusinessValue.BusinessAlignment, "pts", true)
+	add("cost efficiency", before.BusinessValue.CostEfficiency, after.BusinessValue.CostEfficiency, "pts", true)
+	add("user satisfaction", before.BusinessValue.UserSatisfaction, after.BusinessValue.UserSatisfaction, "pts", true)
+	if before.TechnicalDebt != nil && after.TechnicalDebt != nil {
+		add("technical debt", before.TechnicalDebt.PrincipalHours, after.TechnicalDebt.PrincipalHours, "h", false)
+	}
+
+	recommendationKey := func(r Recommendation) string { return string(r.Type) + "|" + r.Description }
+	diff.NewRecommendations = missingFrom(after.Recommendations, before.Recommendations, recommendationKey)
+	diff.ResolvedRecommendations = missingFrom(before.Recommendations, after.Recommendations, recommendationKey)
+
+	breachKey := func(b SLABreach) string { return b.ServiceName + "|" + string(b.Metric) }
+	diff.NewSLABreaches = missingFrom(after.SLABreaches, before.SLABreaches, breachKey)
+	diff.ResolvedSLABreaches = missingFrom(before.SLABreaches, after.SLABreaches, breachKey)
+
+	return diff, nil
+}
+
+// PortfolioAssessmentDiff is the structured difference between two health assessments of a portfolio
+type PortfolioAssessmentDiff struct {
+	PortfolioID                     PortfolioID
+	Changes                         []AssessmentChange
+	NewConsolidationCandidates      []ConsolidationCandidate
+	ResolvedConsolidationCandidates []ConsolidationCandidate
+}
+
+// HasChanges reports whether anything differs between the two assessments
+func (d PortfolioAssessmentDiff) HasChanges() bool {
+	return len(d.Changes) > 0 || len(d.NewConsolidationCandidates) > 0 || len(d.ResolvedConsolidationCandidates) > 0
+}
+
+// Summary describes every difference in one line each
+func (d PortfolioAssessmentDiff) Summary() []string {
+	var lines []string
+	for _, change := range d.Changes {
+		lines = append(lines, change.Description())
+	}
+	lines = appendCount(lines, len(d.NewConsolidationCandidates), "new consolidation candidate", "new consolidation candidates")
+	lines = appendCount(lines, len(d.ResolvedConsolidationCandidates), "resolved consolidation candidate", "resolved consolidation candidates")
+	return lines
+}
+
+// DiffPortfolioAssessments compares two health assessments of a portfolio
+func DiffPortfolioAssessments(portfolioID PortfolioID, before, after PortfolioHealthAssessment) PortfolioAssessmentDiff {
+	diff := PortfolioAssessmentDiff{PortfolioID: portfolioID}
+
+	add := func(metric string, before, after float64, unit string, higherIsBetter bool) {
+		if change, ok := numericChange(metric, before, after, unit, higherIsBetter); ok {
+			diff.Changes = append(diff.Changes, change)
+		}
+	}
+	add("health index", before.HealthIndex.Score, after.HealthIndex.Score, "pts", true)
+	add("applications", float64(before.TotalApplications), float64(after.TotalApplications), "", true)
+	add("active applications", float64(before.ActiveApplications), float64(after.ActiveApplications), "", true)
+	add("deprecated applications", float64(before.DeprecatedApplications), float64(after.DeprecatedApplications), "", false)
+	add("redundant applications", float64(before.RedundantApplications), float64(after.RedundantApplications), "", false)
+	add("total cost", before.TotalCost, after.TotalCost, "", false)
+	for _, level := range []RiskLevel{RiskCritical, RiskHigh, RiskMedium, RiskLow} {
+		add(fmt.Sprintf("%s risk applications", level),
+			float64(before.RiskDistribution[level]), float64(after.RiskDistribution[level]), "", level == RiskLow)
+	}
+	if before.TechnicalDebt != nil && after.TechnicalDebt != nil {
+		add("technical debt", before.TechnicalDebt.Total.PrincipalHours, after.TechnicalDebt.Total.PrincipalHours, "h", false)
+	}
+	if before.RiskExposure != nil && after.RiskExposure != nil {
+		add("expected loss", before.RiskExposure.ExpectedLoss, after.RiskExposure.ExpectedLoss, "", false)
+		add("P90 loss", before.RiskExposure.P90, after.RiskExposure.P90, "", false)
+	}
+
+	candidateKey := func(c ConsolidationCandidate) string { return string(c.Retain) + "|" + string(c.Redundant) }
+	diff.NewConsolidationCandidates = missingFrom(after.ConsolidationCandidates, before.ConsolidationCandidates, candidateKey)
+	diff.ResolvedConsolidationCandidates = missingFrom(before.ConsolidationCandidates, after.ConsolidationCandidates, candidateKey)
+
+	return diff
+}
+
+// CompareAssessments diffs two recorded assessments of an application. An empty toID selects the
+// latest assessment and an empty fromID the one recorded before it.
+func (s *EvaluationService) CompareAssessments(ctx context.Context, appID ApplicationID, fromID, toID string) (*AssessmentDiff, error) {
+	if s.assessmentRepo == nil {
+		return nil, errors.New("assessment history is not configured")
+	}
+
+	history, err := s.assessmentRepo.FindByApplicationID(ctx, appID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load assessment history: %w", err)
+	}
+	sortAssessmentsByTime(history)
+
+	indexOf := func(id string) int {
+		for i, assessment := range history {
+			if assessment.ID == id {
+				return i
+			}
+		}
+		return -1
+	}
+
+	to := len(history) - 1
+	if toID != "" {
+		if to = indexOf(toID); to < 0 {
+			return nil, fmt.Errorf("assessment %s not found for %s", toID, appID)
+		}
+	}
+	from := to - 1
+	if fromID != "" {
+		if from = indexOf(fromID); from < 0 {
+			return nil, fmt.Errorf("assessment %s not found for %s", fromID, appID)
+		}
+	}
+	if from < 0 || to < 0 {
+		return nil, fmt.Errorf("%s needs at least two assessments to compare", appID)
+	}
+
+	return DiffAssessments(history[from], history[to])
+}
+
+// numericChange describes a numeric metric that moved, rounding away float noise
+func numericChange(metric string, before, after float64, unit string, higherIsBetter bool) (AssessmentChange, bool) {
+	delta := math.Round((after-before)*100) / 100
+	if delta == 0 {
+		return AssessmentChange{}, false
+	}
+
+	direction := TrendImproving
+	if (delta > 0) != higherIsBetter {
+		direction = TrendDegrading
+	}
+	return AssessmentChange{
+		Metric:    metric,
+		Before:    formatAmount(before),
+		After:     formatAmount(after),
+		Delta:     delta,
+		Unit:      unit,
+		Direction: direction,
+	}, true
+}
+
+// missingFrom returns the items whose key does not occur in others
+func missingFrom[T any](items, others []T, key func(T) string) []T {
+	seen := make(map[string]bool, len(others))
+	for _, other := range others {
+		seen[key(other)] = true
+	}
+
+	var missing []T
+	for _, item := range items {
+		if !seen[key(item)] {
+			missing = append(missing, item)
+		}
+	}
+	return missing
+}
+
+func appendCount(lines []string, count int, singular, plural string) []string {
+	switch {
+	case count == 1:
+		return append(lines, "1 "+singular)
+	case count > 1:
+		return append(lines, fmt.Sprintf("%d %s", count, plural))
+	}
+	return lines
+}
+
+func formatAmount(value float64) string {
+	return strconv.FormatFloat(math.Round(value*100)/100, 'f', -1, 64)
+}
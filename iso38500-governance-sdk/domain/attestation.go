@@ -0,0 +1,345 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// AttestationScope is what an owner attests is accurate about their application
+type AttestationScope string
+
+const (
+	AttestGovernanceData     AttestationScope = "governance_data"     // the agreement and catalogue data
+	AttestSecurityProvisions AttestationScope = "security_provisions" // confidentiality, integrity and access controls
+	AttestContinuityPlans    AttestationScope = "continuity_plans"    // recovery objectives and continuity plans
+)
+
+// AllAttestationScopes returns every scope, in the order attestations are listed
+func AllAttestationScopes() []AttestationScope {
+	return []AttestationScope{AttestGovernanceData, AttestSecurityProvisions, AttestContinuityPlans}
+}
+
+// Validate ensures the scope is known
+func (s AttestationScope) Validate() error {
+	switch s {
+	case AttestGovernanceData, AttestSecurityProvisions, AttestContinuityPlans:
+		return nil
+	}
+	return fmt.Errorf("unknown attestation scope %q", s)
+}
+
+// AttestationFrequency is how often a periodic campaign starts a new round
+type AttestationFrequency string
+
+const (
+	AttestQuarterly  AttestationFrequency = "quarterly"
+	AttestSemiannual AttestationFrequency = "semiannual"
+	AttestAnnual     AttestationFrequency = "annual"
+)
+
+// Validate ensures the frequency is known; empty is a one-off campaign
+func (f AttestationFrequency) Validate() error {
+	switch f {
+	case "", AttestQuarterly, AttestSemiannual, AttestAnnual:
+		return nil
+	}
+	return fmt.Errorf("unknown attestation frequency %q; use %s, %s or %s", f, AttestQuarterly, AttestSemiannual, AttestAnnual)
+}
+
+// Next returns when the round after one opened at opened starts
+func (f AttestationFrequency) Next(opened time.Time) time.Time {
+	switch f {
+	case AttestQuarterly:
+		return opened.AddDate(0, 3, 0)
+	case AttestSemiannual:
+		return opened.AddDate(0, 6, 0)
+	case AttestAnnual:
+		return opened.AddDate(1, 0, 0)
+	}
+	return time.Time{}
+}
+
+// AttestationStatus is an owner's answer to an attestation
+type AttestationStatus string
+
+const (
+	AttestationPending   AttestationStatus = "pending"
+	AttestationConfirmed AttestationStatus = "confirmed" // the owner signed off the data as accurate
+	AttestationDisputed  AttestationStatus = "disputed"  // the owner found the data inaccurate
+)
+
+// Attestation asks the owner of an application to sign off one scope of its data as accurate
+type Attestation struct {
+	ApplicationID   ApplicationID
+	ApplicationName string
+	Scope           AttestationScope
+	Owner           string
+	Status          AttestationStatus
+	Comment         string // what is inaccurate, required when disputing
+	RespondedAt     time.Time
+	RemindedAt      time.Time // when the owner was last reminded, zero when never
+}
+
+// AttestationCampaignStatus is whether a campaign round still takes sign-offs
+type AttestationCampaignStatus string
+
+const (
+	AttestationCampaignOpen   AttestationCampaignStatus = "open"
+	AttestationCampaignClosed AttestationCampaignStatus = "closed"
+)
+
+// AttestationCampaign is one round of asking the owners of applications to attest their data is
+// accurate. A periodic campaign starts a new round every period; its rounds share a series.
+type AttestationCampaign struct {
+	ID             string
+	Series         string // ID of the first round
+	Round          int    // 1 for the first round
+	Name           string
+	PortfolioID    PortfolioID // empty when the applications were listed
+	Scopes         []AttestationScope
+	Frequency      AttestationFrequency // empty for a one-off campaign
+	ResponseWindow time.Duration        // how long owners have to respond to a round
+	Status         AttestationCampaignStatus
+	LaunchedBy     string
+	OpenedAt       time.Time
+	DueAt          time.Time
+	ClosedAt       time.Time
+	Attestations   []Attestation
+}
+
+// Validate ensures the campaign names its scopes, a known frequency and an owner for each
+// attestation
+func (c AttestationCampaign) Validate() error {
+	if c.ID == "" {
+		return errors.New("attestation campaign ID cannot be empty")
+	}
+	if c.Name == "" {
+		return errors.New("attestation campaign name cannot be empty")
+	}
+	if len(c.Scopes) == 0 {
+		return errors.New("attestation campaign must attest at least one scope")
+	}
+	for _, scope := range c.Scopes {
+		if err := scope.Validate(); err != nil {
+			return err
+		}
+	}
+	if err := c.Frequency.Validate(); err != nil {
+		return err
+	}
+	if c.ResponseWindow <= 0 {
+		return errors.New("attestation response window must be positive")
+	}
+	if len(c.Attestations) == 0 {
+		return errors.New("attestation campaign has no applications")
+	}
+	for _, attestation := range c.Attestations {
+		if attestation.Owner == "" {
+			return fmt.Errorf("no owner is named for application %s", attestation.ApplicationID)
+		}
+	}
+	return nil
+}
+
+// Overdue reports whether the round is still open past its due date
+func (c AttestationCampaign) Overdue(now time.Time) bool {
+	return c.Status == AttestationCampaignOpen && now.After(c.DueAt)
+}
+
+// NextRoundAt returns when the next round starts, zero for a one-off campaign
+func (c AttestationCampaign) NextRoundAt() time.Time {
+	return c.Frequency.Next(c.OpenedAt)
+}
+
+// Pending returns the attestations the owners have not answered yet
+func (c AttestationCampaign) Pending() []Attestation {
+	var pending []Attestation
+	for _, attestation := range c.Attestations {
+		if attestation.Status == AttestationPending {
+			pending = append(pending, attestation)
+		}
+	}
+	return pending
+}
+
+// SignOff records the owner's answer to the pending attestations of an application: confirmed
+// when its data is accurate, disputed with a comment saying what is not. An empty scope answers
+// every pending scope of the application. The attestations answered are returned.
+func (c *AttestationCampaign) SignOff(appID ApplicationID, scope AttestationScope, attester string, confirmed bool, comment string, at time.Time) ([]Attestation, error) {
+	if c.Status != AttestationCampaignOpen {
+		return nil, fmt.Errorf("attestation campaign %s is %s", c.ID, c.Status)
+	}
+	if scope != "" {
+		if err := scope.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	if !confirmed && comment == "" {
+		return nil, errors.New("disputing an attestation requires a comment saying what is inaccurate")
+	}
+	status := AttestationDisputed
+	if confirmed {
+		status = AttestationConfirmed
+	}
+
+	// Copy the attestations so the campaign's previous value is left as it was
+	c.Attestations = append([]Attestation{}, c.Attestations...)
+	var answered []Attestation
+	found := false
+	for i, attestation := range c.Attestations {
+		if attestation.ApplicationID != appID || (scope != "" && attestation.Scope != scope) {
+			continue
+		}
+		found = true
+		if attestation.Owner != attester {
+			return nil, fmt.Errorf("%s attestations of %s must be signed off by their owner %s", attestation.Scope, appID, attestation.Owner)
+		}
+		if attestation.Status != AttestationPending {
+			continue
+		}
+		c.Attestations[i].Status = status
+		c.Attestations[i].Comment = comment
+		c.Attestations[i].RespondedAt = at
+		answered = append(answered, c.Attestations[i])
+	}
+	switch {
+	case !found && scope == "":
+		return nil, fmt.Errorf("application %s is not in attestation campaign %s", appID, c.ID)
+	case !found:
+		return nil, fmt.Errorf("application %s has no %s attestation in campaign %s", appID, scope, c.ID)
+	case len(answered) == 0:
+		return nil, fmt.Errorf("the attestations of %s in campaign %s are already signed off", appID, c.ID)
+	}
+	return answered, nil
+}
+
+// Close stops the round taking sign-offs; attestations still pending stay unanswered
+func (c *AttestationCampaign) Close(at time.Time) error {
+	if c.Status == AttestationCampaignClosed {
+		return fmt.Errorf("attestation campaign %s is already closed", c.ID)
+	}
+	c.Status = AttestationCampaignClosed
+	c.ClosedAt = at
+	return nil
+}
+
+// NextRound opens the round after this one at at, asking the same owners again
+func (c AttestationCampaign) NextRound(at time.Time) AttestationCampaign {
+	next := c
+	next.Round = c.Round + 1
+	next.ID = fmt.Sprintf("%s-%d", c.Series, next.Round)
+	next.Status = AttestationCampaignOpen
+	next.OpenedAt = at
+	next.DueAt = at.Add(c.ResponseWindow)
+	next.ClosedAt = time.Time{}
+	next.Attestations = make([]Attestation, len(c.Attestations))
+	for i, attestation := range c.Attestations {
+		next.Attestations[i] = Attestation{
+			ApplicationID:   attestation.ApplicationID,
+			ApplicationName: attestation.ApplicationName,
+			Scope:           attestation.Scope,
+			Owner:           attestation.Owner,
+			Status:          AttestationPending,
+		}
+	}
+	return next
+}
+
+// AttestationProgress counts the answers to a set of attestations
+type AttestationProgress struct {
+	Total      int
+	Confirmed  int
+	Disputed   int
+	Pending    int
+	Completion float64 // share of attestations answered, from 0 to 1
+}
+
+func (p *AttestationProgress) add(attestation Attestation) {
+	p.Total++
+	switch attestation.Status {
+	case AttestationConfirmed:
+		p.Confirmed++
+	case AttestationDisputed:
+		p.Disputed++
+	default:
+		p.Pending++
+	}
+	p.Completion = float64(p.Confirmed+p.Disputed) / float64(p.Total)
+}
+
+// ScopeAttestationProgress is the progress of the attestations of one scope
+type ScopeAttestationProgress struct {
+	Scope AttestationScope
+	AttestationProgress
+}
+
+// OwnerAttestationProgress is the progress of one owner's attestations
+type OwnerAttestationProgress struct {
+	Owner string
+	AttestationProgress
+}
+
+// AttestationDashboard shows how far a campaign round is: its completion overall, by scope and
+// by owner, the attestations disputed and those still outstanding
+type AttestationDashboard struct {
+	CampaignID  string
+	Name        string
+	Round       int
+	Status      AttestationCampaignStatus
+	DueAt       time.Time
+	Overdue     bool
+	Progress    AttestationProgress
+	Scopes      []ScopeAttestationProgress
+	Owners      []OwnerAttestationProgress // most attestations pending first
+	Disputed    []Attestation
+	Outstanding []Attestation
+}
+
+// BuildAttestationDashboard summarizes the answers to a campaign round at now
+func BuildAttestationDashboard(campaign AttestationCampaign, now time.Time) AttestationDashboard {
+	dashboard := AttestationDashboard{
+		CampaignID:  campaign.ID,
+		Name:        campaign.Name,
+		Round:       campaign.Round,
+		Status:      campaign.Status,
+		DueAt:       campaign.DueAt,
+		Overdue:     campaign.Overdue(now),
+		Disputed:    []Attestation{},
+		Outstanding: []Attestation{},
+	}
+
+	scopes := make(map[AttestationScope]int)
+	owners := make(map[string]int)
+	for _, attestation := range campaign.Attestations {
+		dashboard.Progress.add(attestation)
+
+		i, ok := scopes[attestation.Scope]
+		if !ok {
+			i = len(dashboard.Scopes)
+			scopes[attestation.Scope] = i
+			dashboard.Scopes = append(dashboard.Scopes, ScopeAttestationProgress{Scope: attestation.Scope})
+		}
+		dashboard.Scopes[i].add(attestation)
+
+		i, ok = owners[attestation.Owner]
+		if !ok {
+			i = len(dashboard.Owners)
+			owners[attestation.Owner] = i
+			dashboard.Owners = append(dashboard.Owners, OwnerAttestationProgress{Owner: attestation.Owner})
+		}
+		dashboard.Owners[i].add(attestation)
+
+		switch attestation.Status {
+		case AttestationDisputed:
+			dashboard.Disputed = append(dashboard.Disputed, attestation)
+		case AttestationPending:
+			dashboard.Outstanding = append(dashboard.Outstanding, attestation)
+		}
+	}
+	sort.SliceStable(dashboard.Owners, func(i, j int) bool {
+		return dashboard.Owners[i].Pending > dashboard.Owners[j].Pending
+	})
+	return dashboard
+}
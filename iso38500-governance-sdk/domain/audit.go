@@ -0,0 +1,47 @@
+package domain
+
+import "time"
+
+// Start returns when the audit started, or when it is planned to start until it is started
+func (a Audit) Start() time.Time {
+	if a.StartedAt.IsZero() {
+		return a.ScheduledAt
+	}
+	return a.StartedAt
+}
+
+// IsOverdue reports whether the audit is past its due date at now without being completed or
+// cancelled
+func (a Audit) IsOverdue(now time.Time) bool {
+	if a.DueDate.IsZero() || !now.After(a.DueDate) {
+		return false
+	}
+	return a.Status == AuditStatusPlanned || a.Status == AuditStatusInProgress
+}
+
+// CanStart reports whether the audit can be started: it is planned, or went overdue before it
+// was started
+func (a Audit) CanStart() bool {
+	return (a.Status == AuditStatusPlanned || a.Status == AuditStatusOverdue) && a.StartedAt.IsZero()
+}
+
+// CanComplete reports whether the audit can be completed: it is in progress, or went overdue
+// after it was started
+func (a Audit) CanComplete() bool {
+	return (a.Status == AuditStatusInProgress || a.Status == AuditStatusOverdue) && !a.StartedAt.IsZero()
+}
+
+// FindingIndex returns the index of a finding of the audit, or -1 when it has none with the ID
+func (a Audit) FindingIndex(findingID string) int {
+	for i, finding := range a.Findings {
+		if finding.ID == findingID {
+			return i
+		}
+	}
+	return -1
+}
+
+// CanCancel reports whether the audit can be cancelled: it is neither completed nor cancelled
+func (a Audit) CanCancel() bool {
+	return a.Status == AuditStatusPlanned || a.Status == AuditStatusInProgress || a.Status == AuditStatusOverdue
+}
@@ -0,0 +1,196 @@
+package domain
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// AuditTrailEntityType is the kind of governance object an audit trail entry records a change to
+type AuditTrailEntityType string
+
+const (
+	AuditEntityAgreement     AuditTrailEntityType = "agreement"
+	AuditEntityPolicy        AuditTrailEntityType = "policy" // identified as <agreement ID>/<policy ID>
+	AuditEntityChangeRequest AuditTrailEntityType = "change_request"
+	AuditEntityAssessment    AuditTrailEntityType = "assessment"
+)
+
+// AuditTrailAction is the kind of state change an audit trail entry records
+type AuditTrailAction string
+
+const (
+	AuditActionCreated AuditTrailAction = "created"
+	AuditActionUpdated AuditTrailAction = "updated"
+	AuditActionDeleted AuditTrailAction = "deleted"
+)
+
+// AuditTrailEntry records one state change to a governance object. Each entry holds the hash of
+// the entry before it, so changing or removing any entry breaks the chain from there on.
+type AuditTrailEntry struct {
+	Sequence     int // 1 for the first entry
+	EntityType   AuditTrailEntityType
+	EntityID     string
+	Action       AuditTrailAction
+	Actor        string   // who made the change, "system" when unknown
+	Changes      []string // fields that changed, empty for creates and deletes
+	StateHash    string   // SHA-256 of the object's state after the change, empty for deletes
+	RecordedAt   time.Time
+	PreviousHash string // hash of the entry before, empty for the first
+	Hash         string
+}
+
+// ComputeHash returns the SHA-256 of the entry's content and the hash of the entry before it
+func (e AuditTrailEntry) ComputeHash() string {
+	content := strings.Join([]string{
+		strconv.Itoa(e.Sequence),
+		string(e.EntityType),
+		e.EntityID,
+		string(e.Action),
+		e.Actor,
+		strings.Join(e.Changes, ","),
+		e.StateHash,
+		e.RecordedAt.UTC().Format(time.RFC3339Nano),
+		e.PreviousHash,
+	}, "\n")
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+// ChainAuditTrailEntry links the entry after the last one of the trail, nil when the trail is
+// empty, and seals it with its hash
+func ChainAuditTrailEntry(last *AuditTrailEntry, entry AuditTrailEntry) AuditTrailEntry {
+	entry.Sequence = 1
+	entry.PreviousHash = ""
+	if last != nil {
+		entry.Sequence = last.Sequence + 1
+		entry.PreviousHash = last.Hash
+	}
+	entry.Hash = entry.ComputeHash()
+	return entry
+}
+
+// AuditTrailVerification is the result of checking an audit trail's hash chain
+type AuditTrailVerification struct {
+	Entries  int
+	Valid    bool
+	BrokenAt int    // sequence of the first entry that does not verify, 0 when valid
+	Reason   string // why that entry does not verify
+}
+
+// VerifyAuditTrail checks that the entries, oldest first, form an unbroken hash chain: each is
+// numbered after the one before, holds its hash, and hashes to the hash it was sealed with
+func VerifyAuditTrail(entries []AuditTrailEntry) AuditTrailVerification {
+	verification := AuditTrailVerification{Entries: len(entries), Valid: true}
+	previous := ""
+	for i, entry := range entries {
+		var reason string
+		switch {
+		case entry.Sequence != i+1:
+			reason = fmt.Sprintf("expected sequence %d, found %d", i+1, entry.Sequence)
+		case entry.PreviousHash != previous:
+			reason = "previous hash does not match the entry before"
+		case entry.ComputeHash() != entry.Hash:
+			reason = "content does not match its hash"
+		}
+		if reason != "" {
+			verification.Valid = false
+			verification.BrokenAt = i + 1
+			verification.Reason = reason
+			return verification
+		}
+		previous = entry.Hash
+	}
+	return verification
+}
+
+// AuditTrailQuery selects audit trail entries; empty fields match every entry
+type AuditTrailQuery struct {
+	EntityType AuditTrailEntityType
+	EntityID   string
+	Actor      string
+	From       time.Time
+	To         time.Time
+}
+
+// Matches reports whether the entry is selected by the query
+func (q AuditTrailQuery) Matches(entry AuditTrailEntry) bool {
+	switch {
+	case q.EntityType != "" && entry.EntityType != q.EntityType:
+		return false
+	case q.EntityID != "" && entry.EntityID != q.EntityID:
+		return false
+	case q.Actor != "" && !strings.EqualFold(entry.Actor, q.Actor):
+		return false
+	case !q.From.IsZero() && entry.RecordedAt.Before(q.From):
+		return false
+	case !q.To.IsZero() && entry.RecordedAt.After(q.To):
+		return false
+	}
+	return true
+}
+
+// AuditStateHash returns the SHA-256 of an object's JSON encoding
+func AuditStateHash(state interface{}) (string, error) {
+	encoded, err := json.Marshal(state)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode audited state: %w", err)
+	}
+	sum := sha256.Sum256(encoded)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// AuditChangedFields returns the paths of the fields that differ between two values of the same
+// struct type, such as Direct.PolicyFramework.Policies. Nested structs other than times are
+// compared field by field.
+func AuditChangedFields(before, after interface{}) []string {
+	b, a := reflect.ValueOf(before), reflect.ValueOf(after)
+	if b.Kind() != reflect.Struct || a.Type() != b.Type() {
+		return nil
+	}
+	return changedFields(b, a, "")
+}
+
+func changedFields(before, after reflect.Value, prefix string) []string {
+	var changed []string
+	for i := 0; i < before.NumField(); i++ {
+		field := before.Type().Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		b, a := before.Field(i), after.Field(i)
+		if reflect.DeepEqual(b.Interface(), a.Interface()) {
+			continue
+		}
+		if b.Kind() == reflect.Struct && field.Type != reflect.TypeOf(time.Time{}) {
+			changed = append(changed, changedFields(b, a, prefix+field.Name+".")...)
+			continue
+		}
+		changed = append(changed, prefix+field.Name)
+	}
+	return changed
+}
+
+// AuditSystemActor is recorded as the actor of changes made with no actor in the context
+const AuditSystemActor = "system"
+
+type auditActorKey struct{}
+
+// WithAuditActor returns a context whose changes are recorded in the audit trail as made by actor
+func WithAuditActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, auditActorKey{}, actor)
+}
+
+// AuditActorFromContext returns the actor changes made with the context are recorded as made by
+func AuditActorFromContext(ctx context.Context) string {
+	if actor, ok := ctx.Value(auditActorKey{}).(string); ok && actor != "" {
+		return actor
+	}
+	return AuditSystemActor
+}
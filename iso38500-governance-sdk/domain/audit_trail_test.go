@@ -0,0 +1,134 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+// chainedAuditTrail returns a valid trail of n entries
+func chainedAuditTrail(n int) []AuditTrailEntry {
+	recordedAt := time.Date(2024, time.January, 15, 10, 0, 0, 0, time.UTC)
+	var entries []AuditTrailEntry
+	for i := 0; i < n; i++ {
+		var last *AuditTrailEntry
+		if i > 0 {
+			last = &entries[i-1]
+		}
+		entries = append(entries, ChainAuditTrailEntry(last, AuditTrailEntry{
+			EntityType: AuditEntityAgreement,
+			EntityID:   "agreement-1",
+			Action:     AuditActionUpdated,
+			Actor:      "alice",
+			Changes:    []string{"Direct.Strategy"},
+			StateHash:  "state",
+			RecordedAt: recordedAt.Add(time.Duration(i) * time.Minute),
+		}))
+	}
+	return entries
+}
+
+func TestChainAuditTrailEntry(t *testing.T) {
+	entries := chainedAuditTrail(3)
+	for i, entry := range entries {
+		if entry.Sequence != i+1 {
+			t.Errorf("entry %d sequence = %d, want %d", i, entry.Sequence, i+1)
+		}
+		if entry.Hash != entry.ComputeHash() {
+			t.Errorf("entry %d is not sealed with its hash", i)
+		}
+	}
+	if entries[0].PreviousHash != "" {
+		t.Errorf("first entry previous hash = %q, want empty", entries[0].PreviousHash)
+	}
+	if entries[2].PreviousHash != entries[1].Hash {
+		t.Errorf("third entry is not linked to the second")
+	}
+}
+
+func TestVerifyAuditTrail(t *testing.T) {
+	tests := []struct {
+		name         string
+		tamper       func([]AuditTrailEntry) []AuditTrailEntry
+		wantValid    bool
+		wantBrokenAt int
+		wantReason   string
+	}{
+		{
+			name:      "unchanged",
+			tamper:    func(entries []AuditTrailEntry) []AuditTrailEntry { return entries },
+			wantValid: true,
+		},
+		{
+			name:      "empty",
+			tamper:    func([]AuditTrailEntry) []AuditTrailEntry { return nil },
+			wantValid: true,
+		},
+		{
+			name: "content changed",
+			tamper: func(entries []AuditTrailEntry) []AuditTrailEntry {
+				entries[1].Actor = "mallory"
+				return entries
+			},
+			wantBrokenAt: 2,
+			wantReason:   "content does not match its hash",
+		},
+		{
+			name: "content changed and resealed",
+			tamper: func(entries []AuditTrailEntry) []AuditTrailEntry {
+				entries[1].Actor = "mallory"
+				entries[1].Hash = entries[1].ComputeHash()
+				return entries
+			},
+			wantBrokenAt: 3,
+			wantReason:   "previous hash does not match the entry before",
+		},
+		{
+			name: "entry removed",
+			tamper: func(entries []AuditTrailEntry) []AuditTrailEntry {
+				return append(entries[:1], entries[2:]...)
+			},
+			wantBrokenAt: 2,
+			wantReason:   "expected sequence 2, found 3",
+		},
+		{
+			name: "last entry removed, which only the chain head can show",
+			tamper: func(entries []AuditTrailEntry) []AuditTrailEntry {
+				return entries[:len(entries)-1]
+			},
+			wantValid: true,
+		},
+		{
+			name: "entries reordered",
+			tamper: func(entries []AuditTrailEntry) []AuditTrailEntry {
+				entries[1], entries[2] = entries[2], entries[1]
+				return entries
+			},
+			wantBrokenAt: 2,
+			wantReason:   "expected sequence 2, found 3",
+		},
+		{
+			name: "first entry replaced",
+			tamper: func(entries []AuditTrailEntry) []AuditTrailEntry {
+				replaced := entries[0]
+				replaced.EntityID = "agreement-2"
+				entries[0] = ChainAuditTrailEntry(nil, replaced)
+				return entries
+			},
+			wantBrokenAt: 2,
+			wantReason:   "previous hash does not match the entry before",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries := tt.tamper(chainedAuditTrail(4))
+			got := VerifyAuditTrail(entries)
+			if got.Entries != len(entries) {
+				t.Errorf("Entries = %d, want %d", got.Entries, len(entries))
+			}
+			if got.Valid != tt.wantValid || got.BrokenAt != tt.wantBrokenAt || got.Reason != tt.wantReason {
+				t.Errorf("VerifyAuditTrail() = valid %v broken at %d (%q), want valid %v broken at %d (%q)",
+					got.Valid, got.BrokenAt, got.Reason, tt.wantValid, tt.wantBrokenAt, tt.wantReason)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// DefaultBaselineCategory is the baseline applied to applications whose category has no entry of its own
+const DefaultBaselineCategory = "*"
+
+// Baseline holds the expected values for applications in one category
+type Baseline struct {
+	Category               string  `json:"category"`
+	ExpectedUptime         float64 `json:"expected_uptime"`          // percentage, e.g. 99.9
+	ExpectedCostEfficiency float64 `json:"expected_cost_efficiency"` // 0-100
+	ExpectedSecurityScore  float64 `json:"expected_security_score"`  // 1-5
+}
+
+// BaselineProfile is a named set of reference baselines, from an industry source or internal targets
+type BaselineProfile struct {
+	Name      string     `json:"name"`
+	Source    string     `json:"source"`
+	Baselines []Baseline `json:"baselines"`
+}
+
+// BaselineFor returns the baseline for a category, falling back to the default category
+func (p BaselineProfile) BaselineFor(category string) (Baseline, bool) {
+	var fallback *Baseline
+	for i, baseline := range p.Baselines {
+		if baseline.Category == category {
+			return baseline, true
+		}
+		if baseline.Category == DefaultBaselineCategory {
+			fallback = &p.Baselines[i]
+		}
+	}
+	if fallback != nil {
+		return *fallback, true
+	}
+	return Baseline{}, false
+}
+
+// Validate checks the baseline values are within their metric ranges
+func (p BaselineProfile) Validate() error {
+	if p.Name == "" {
+		return fmt.Errorf("baseline profile name cannot be empty")
+	}
+	for _, baseline := range p.Baselines {
+		if baseline.ExpectedUptime < 0 || baseline.ExpectedUptime > 100 {
+			return fmt.Errorf("baseline %q: expected uptime must be between 0 and 100", baseline.Category)
+		}
+		if baseline.ExpectedCostEfficiency < 0 || baseline.ExpectedCostEfficiency > 100 {
+			return fmt.Errorf("baseline %q: expected cost efficiency must be between 0 and 100", baseline.Category)
+		}
+		if baseline.ExpectedSecurityScore < 0 || baseline.ExpectedSecurityScore > 5 {
+			return fmt.Errorf("baseline %q: expected security score must be between 0 and 5", baseline.Category)
+		}
+	}
+	return nil
+}
+
+// LoadBaselineProfile reads a JSON baseline profile
+func LoadBaselineProfile(r io.Reader) (BaselineProfile, error) {
+	var profile BaselineProfile
+	if err := json.NewDecoder(r).Decode(&profile); err != nil {
+		return BaselineProfile{}, fmt.Errorf("failed to decode baseline profile: %w", err)
+	}
+	if err := profile.Validate(); err != nil {
+		return BaselineProfile{}, err
+	}
+	return profile, nil
+}
+
+// IndustryBaselineProfile returns reference baselines for common application categories
+func IndustryBaselineProfile() BaselineProfile {
+	return BaselineProfile{
+		Name:   "industry-reference",
+		Source: "industry",
+		Baselines: []Baseline{
+			{Category: "Core Business", ExpectedUptime: 99.9, ExpectedCostEfficiency: 80, ExpectedSecurityScore: 4},
+			{Category: "Operational", ExpectedUptime: 99.5, ExpectedCostEfficiency: 75, ExpectedSecurityScore: 4},
+			{Category: "Infrastructure", ExpectedUptime: 99.95, ExpectedCostEfficiency: 70, ExpectedSecurityScore: 5},
+			{Category: "Analytics", ExpectedUptime: 99.0, ExpectedCostEfficiency: 70, ExpectedSecurityScore: 3},
+			{Category: "Legacy", ExpectedUptime: 98.0, ExpectedCostEfficiency: 50, ExpectedSecurityScore: 3},
+			{Category: DefaultBaselineCategory, ExpectedUptime: 99.0, ExpectedCostEfficiency: 70, ExpectedSecurityScore: 3},
+		},
+	}
+}
+
+// BenchmarkDeviation compares one assessed metric with its baseline
+type BenchmarkDeviation struct {
+	Metric           string
+	Expected         float64
+	Actual           float64
+	Deviation        float64 // Actual - Expected
+	DeviationPercent float64 // Deviation relative to Expected
+	BelowBaseline    bool
+}
+
+// BenchmarkComparison reports how an assessment deviates from its category baseline
+type BenchmarkComparison struct {
+	ProfileName string
+	Category    string
+	Deviations  []BenchmarkDeviation
+}
+
+// CompareToBaseline benchmarks an assessment against the baseline for the application's category.
+// It returns nil when the profile has no baseline for the category.
+func CompareToBaseline(profile BaselineProfile, category string, assessment ApplicationAssessment) *BenchmarkComparison {
+	baseline, ok := profile.BaselineFor(category)
+	if !ok {
+		return nil
+	}
+
+	return &BenchmarkComparison{
+		ProfileName: profile.Name,
+		Category:    baseline.Category,
+		Deviations: []BenchmarkDeviation{
+			newBenchmarkDeviation("uptime", baseline.ExpectedUptime, assessment.BusinessValue.UsageMetrics.UptimePercentage),
+			newBenchmarkDeviation("cost_efficiency", baseline.ExpectedCostEfficiency, assessment.BusinessValue.CostEfficiency),
+			newBenchmarkDeviation("security_score", baseline.ExpectedSecurityScore, float64(assessment.TechnicalHealth.SecurityScore)),
+		},
+	}
+}
+
+func newBenchmarkDeviation(metric string, expected, actual float64) BenchmarkDeviation {
+	deviation := BenchmarkDeviation{
+		Metric:        metric,
+		Expected:      expected,
+		Actual:        actual,
+		Deviation:     actual - expected,
+		BelowBaseline: actual < expected,
+	}
+	if expected != 0 {
+		deviation.DeviationPercent = deviation.Deviation / expected * 100
+	}
+	return deviation
+}
@@ -0,0 +1,238 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// DefaultBudgetAlertThreshold is the percentage of an allocation consumed that raises an alert
+// when the allocation sets no threshold of its own
+const DefaultBudgetAlertThreshold = 80.0
+
+// BudgetAlertLevel represents how far an allocation's consumption has gone
+type BudgetAlertLevel string
+
+const (
+	BudgetAlertNone             BudgetAlertLevel = ""
+	BudgetAlertProjectedOverrun BudgetAlertLevel = "projected_overrun" // the burn rate overruns the allocation by the end of its period
+	BudgetAlertThresholdReached BudgetAlertLevel = "threshold_reached" // consumption reached the alert threshold
+	BudgetAlertOverspent        BudgetAlertLevel = "overspent"         // consumption exceeds the allocation
+)
+
+// Expenditure records money spent against a budget allocation
+type Expenditure struct {
+	ID          string
+	Category    string // the budget allocation the money was spent from
+	Amount      float64
+	Description string
+	RecordedBy  string
+	SpentAt     time.Time
+}
+
+// Validate ensures the expenditure has valid data
+func (e Expenditure) Validate() error {
+	if e.Category == "" {
+		return errors.New("expenditure category cannot be empty")
+	}
+	if e.Amount <= 0 {
+		return errors.New("expenditure amount must be positive")
+	}
+	return nil
+}
+
+// Spent returns the total of the allocation's expenditures
+func (b BudgetAllocation) Spent() float64 {
+	spent := 0.0
+	for _, expenditure := range b.Expenditures {
+		spent += expenditure.Amount
+	}
+	return spent
+}
+
+// Consumption reports the allocation's spend, burn rate and alert level at the given time
+func (b BudgetAllocation) Consumption(now time.Time) BudgetConsumption {
+	consumption := BudgetConsumption{
+		Category:  b.Category,
+		Allocated: b.Amount,
+		Spent:     b.Spent(),
+	}
+	consumption.Remaining = consumption.Allocated - consumption.Spent
+	if b.Amount > 0 {
+		consumption.ConsumedPercent = consumption.Spent / b.Amount * 100
+	}
+
+	// The burn rate runs from the start of the period, or the first expenditure without one
+	start := b.StartDate
+	if start.IsZero() && len(b.Expenditures) > 0 {
+		start = b.Expenditures[0].SpentAt
+	}
+	if days := now.Sub(start).Hours() / 24; !start.IsZero() && days >= 1 {
+		consumption.BurnRatePerDay = consumption.Spent / days
+	}
+
+	consumption.ProjectedSpend = consumption.Spent
+	if !b.EndDate.IsZero() && b.EndDate.After(now) {
+		consumption.ProjectedSpend += consumption.BurnRatePerDay * b.EndDate.Sub(now).Hours() / 24
+	}
+	consumption.ProjectedVariance = consumption.ProjectedSpend - consumption.Allocated
+
+	threshold := b.AlertThreshold
+	if threshold == 0 {
+		threshold = DefaultBudgetAlertThreshold
+	}
+	switch {
+	case consumption.Spent > consumption.Allocated:
+		consumption.Alert = BudgetAlertOverspent
+	case consumption.ConsumedPercent >= threshold:
+		consumption.Alert = BudgetAlertThresholdReached
+	case consumption.ProjectedVariance > 0:
+		consumption.Alert = BudgetAlertProjectedOverrun
+	}
+
+	return consumption
+}
+
+// BudgetConsumption reports how much of a budget allocation has been spent
+type BudgetConsumption struct {
+	Category          string
+	Allocated         float64
+	Spent             float64
+	Remaining         float64
+	ConsumedPercent   float64
+	BurnRatePerDay    float64
+	ProjectedSpend    float64 // spend by the end of the allocation's period at the current burn rate
+	ProjectedVariance float64 // projected spend over (positive) or under (negative) the allocation
+	Alert             BudgetAlertLevel
+}
+
+// BudgetStatus reports the consumption of every budget allocation of an agreement
+type BudgetStatus struct {
+	AgreementID GovernanceAgreementID
+	Allocations []BudgetConsumption
+	Allocated   float64
+	Spent       float64
+	Alerts      []BudgetConsumption // allocations with an alert, most severe first
+}
+
+// budgetStatus reports the consumption of the allocations at the given time
+func budgetStatus(agreementID GovernanceAgreementID, allocations []BudgetAllocation, now time.Time) BudgetStatus {
+	status := BudgetStatus{
+		AgreementID: agreementID,
+		Allocations: make([]BudgetConsumption, 0, len(allocations)),
+		Alerts:      []BudgetConsumption{},
+	}
+	for _, allocation := range allocations {
+		consumption := allocation.Consumption(now)
+		status.Allocations = append(status.Allocations, consumption)
+		status.Allocated += consumption.Allocated
+		status.Spent += consumption.Spent
+	}
+	for _, level := range []BudgetAlertLevel{BudgetAlertOverspent, BudgetAlertThresholdReached, BudgetAlertProjectedOverrun} {
+		for _, consumption := range status.Allocations {
+			if consumption.Alert == level {
+				status.Alerts = append(status.Alerts, consumption)
+			}
+		}
+	}
+	return status
+}
+
+// budgetAlertSeverity orders alert levels from none to overspent
+func budgetAlertSeverity(level BudgetAlertLevel) int {
+	switch level {
+	case BudgetAlertProjectedOverrun:
+		return 1
+	case BudgetAlertThresholdReached:
+		return 2
+	case BudgetAlertOverspent:
+		return 3
+	}
+	return 0
+}
+
+// Escalated reports whether the consumption's alert is more severe than the given level
+func (c BudgetConsumption) Escalated(from BudgetAlertLevel) bool {
+	return budgetAlertSeverity(c.Alert) > budgetAlertSeverity(from)
+}
+
+// validateBudgetAllocations checks that every allocation names a distinct category and has a
+// usable amount and threshold
+func validateBudgetAllocations(allocations []BudgetAllocation) error {
+	seen := make(map[string]bool)
+	for _, allocation := range allocations {
+		if allocation.Category == "" {
+			return errors.New("budget allocation category cannot be empty")
+		}
+		if seen[allocation.Category] {
+			return fmt.Errorf("duplicate budget allocation for %s", allocation.Category)
+		}
+		seen[allocation.Category] = true
+		if allocation.Amount < 0 {
+			return fmt.Errorf("budget allocation %s: amount must not be negative", allocation.Category)
+		}
+		if allocation.AlertThreshold < 0 || allocation.AlertThreshold > 100 {
+			return fmt.Errorf("budget allocation %s: alert threshold must be between 0 and 100 percent", allocation.Category)
+		}
+		if !allocation.StartDate.IsZero() && !allocation.EndDate.IsZero() && !allocation.EndDate.After(allocation.StartDate) {
+			return fmt.Errorf("budget allocation %s: end date must be after start date", allocation.Category)
+		}
+	}
+	return nil
+}
+
+// RecordExpenditure records spend against the agreement's budget allocation for the
+// expenditure's category and returns the allocation's consumption before and after it
+func (s *DirectionService) RecordExpenditure(ctx context.Context, agreementID GovernanceAgreementID, expenditure Expenditure) (before, after BudgetConsumption, err error) {
+	if err := expenditure.Validate(); err != nil {
+		return BudgetConsumption{}, BudgetConsumption{}, err
+	}
+	if expenditure.SpentAt.IsZero() {
+		expenditure.SpentAt = time.Now()
+	}
+
+	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
+	if err != nil {
+		return BudgetConsumption{}, BudgetConsumption{}, fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+
+	allocations := agreement.Direct.ResourceAllocation.BudgetAllocations
+	for i := range allocations {
+		if allocations[i].Category != expenditure.Category {
+			continue
+		}
+
+		now := time.Now()
+		allocation := allocations[i]
+		before = allocation.Consumption(now)
+		if expenditure.ID == "" {
+			expenditure.ID = fmt.Sprintf("exp-%03d", len(allocation.Expenditures)+1)
+		}
+		allocation.Expenditures = append(append([]Expenditure{}, allocation.Expenditures...), expenditure)
+
+		updated := make([]BudgetAllocation, len(allocations))
+		copy(updated, allocations)
+		updated[i] = allocation
+		agreement.Direct.ResourceAllocation.BudgetAllocations = updated
+
+		err = s.agreementRepo.Update(ctx, agreement)
+		if err != nil {
+			return BudgetConsumption{}, BudgetConsumption{}, fmt.Errorf("failed to update governance agreement: %w", err)
+		}
+		return before, allocation.Consumption(now), nil
+	}
+
+	return BudgetConsumption{}, BudgetConsumption{}, fmt.Errorf("no budget allocation for %s in agreement %s", expenditure.Category, agreementID)
+}
+
+// MonitorBudget reports the consumption, burn rate and alerts of the agreement's budget allocations
+func (s *MonitoringService) MonitorBudget(ctx context.Context, agreementID GovernanceAgreementID) (*BudgetStatus, error) {
+	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+
+	status := budgetStatus(agreementID, agreement.Direct.ResourceAllocation.BudgetAllocations, time.Now())
+	return &status, nil
+}
@@ -0,0 +1,219 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// ResourceDemand is personnel an initiative or action plan needs over a period
+type ResourceDemand struct {
+	Role        string
+	FTE         float64   // full-time equivalents needed in each period
+	PeriodStart time.Time // zero when needed from the start of planning
+	PeriodEnd   time.Time // zero when needed until the initiative's or plan's deadline
+}
+
+// Availability returns the full-time equivalents the allocation makes available in each period
+func (a PersonnelAllocation) Availability() float64 {
+	if a.FTE > 0 {
+		return a.FTE
+	}
+	return float64(a.Count)
+}
+
+// CapacityPeriod compares the availability and demand of a role in one calendar month
+type CapacityPeriod struct {
+	Role        string
+	Start       time.Time // first day of the month
+	Available   float64   // FTE allocated to the role
+	Demand      float64   // FTE initiatives and action plans need from the role
+	Utilization float64   // demand as a percentage of availability, 0 when nothing is available
+	Sources     []string  // initiatives and action plans demanding the role
+}
+
+// OverAllocated reports whether the role is needed for more than is available
+func (p CapacityPeriod) OverAllocated() bool {
+	return p.Demand > p.Available+1e-9
+}
+
+// Shortfall returns the FTE needed beyond the role's availability
+func (p CapacityPeriod) Shortfall() float64 {
+	if !p.OverAllocated() {
+		return 0
+	}
+	return p.Demand - p.Available
+}
+
+// CapacityPlan compares the personnel allocated to an agreement against the demand of its
+// initiatives and action plans, per role and month. Conflicts are the periods over-allocated.
+type CapacityPlan struct {
+	AgreementID GovernanceAgreementID
+	Periods     []CapacityPeriod // by role, then month
+	Conflicts   []CapacityPeriod
+	GeneratedAt time.Time
+}
+
+// HasConflicts reports whether any role is over-allocated
+func (p CapacityPlan) HasConflicts() bool {
+	return len(p.Conflicts) > 0
+}
+
+// capacityDemand is a resource demand attributed to the initiative or action plan raising it
+type capacityDemand struct {
+	ResourceDemand
+	source string
+}
+
+// BuildCapacityPlan plans the capacity of the direct principle's personnel allocations month by
+// month, from the month of at until the last allocation, demand or deadline ends. A direction
+// without personnel allocations has no capacity to plan against and yields an empty plan.
+func BuildCapacityPlan(agreementID GovernanceAgreementID, direct DirectPrinciple, at time.Time) CapacityPlan {
+	plan := CapacityPlan{
+		AgreementID: agreementID,
+		Periods:     []CapacityPeriod{},
+		Conflicts:   []CapacityPeriod{},
+		GeneratedAt: at,
+	}
+	allocations := direct.ResourceAllocation.PersonnelAllocations
+	if len(allocations) == 0 {
+		return plan
+	}
+
+	var demands []capacityDemand
+	for _, initiative := range direct.StrategicDirection.Initiatives {
+		if initiative.Status == InitiativeCompleted {
+			continue
+		}
+		demands = append(demands, demandsUntil(initiative.ID, initiative.ResourceDemands, initiative.Deadline)...)
+	}
+	for _, actionPlan := range direct.ActionPlans {
+		if actionPlan.Status == ActionCompleted || actionPlan.Status == ActionCancelled {
+			continue
+		}
+		demands = append(demands, demandsUntil(actionPlan.ID, actionPlan.ResourceDemands, actionPlan.Deadline)...)
+	}
+
+	first := monthStart(at)
+	last := first
+	roles := make(map[string]bool)
+	for _, allocation := range allocations {
+		roles[allocation.Role] = true
+		if !allocation.PeriodEnd.IsZero() && allocation.PeriodEnd.After(last) {
+			last = monthStart(allocation.PeriodEnd)
+		}
+	}
+	for _, demand := range demands {
+		roles[demand.Role] = true
+		if !demand.PeriodEnd.IsZero() && demand.PeriodEnd.After(last) {
+			last = monthStart(demand.PeriodEnd)
+		}
+	}
+	sortedRoles := make([]string, 0, len(roles))
+	for role := range roles {
+		sortedRoles = append(sortedRoles, role)
+	}
+	sort.Strings(sortedRoles)
+
+	for _, role := range sortedRoles {
+		for month := first; !month.After(last); month = month.AddDate(0, 1, 0) {
+			period := CapacityPeriod{Role: role, Start: month}
+			for _, allocation := range allocations {
+				if allocation.Role == role && coversMonth(allocation.PeriodStart, allocation.PeriodEnd, month) {
+					period.Available += allocation.Availability()
+				}
+			}
+			for _, demand := range demands {
+				if demand.Role == role && coversMonth(demand.PeriodStart, demand.PeriodEnd, month) {
+					period.Demand += demand.FTE
+					period.Sources = append(period.Sources, demand.source)
+				}
+			}
+			if period.Available == 0 && period.Demand == 0 {
+				continue
+			}
+			if period.Available > 0 {
+				period.Utilization = period.Demand / period.Available * 100
+			}
+
+			plan.Periods = append(plan.Periods, period)
+			if period.OverAllocated() {
+				plan.Conflicts = append(plan.Conflicts, period)
+			}
+		}
+	}
+	return plan
+}
+
+// demandsUntil attributes demands to their source, ending open-ended demands at the deadline
+func demandsUntil(source string, demands []ResourceDemand, deadline time.Time) []capacityDemand {
+	attributed := make([]capacityDemand, len(demands))
+	for i, demand := range demands {
+		if demand.PeriodEnd.IsZero() {
+			demand.PeriodEnd = deadline
+		}
+		attributed[i] = capacityDemand{ResourceDemand: demand, source: source}
+	}
+	return attributed
+}
+
+// monthStart returns the first day of the month of t
+func monthStart(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+}
+
+// coversMonth reports whether a period, open-ended where zero, overlaps the month starting at month
+func coversMonth(start, end, month time.Time) bool {
+	if !start.IsZero() && !start.Before(month.AddDate(0, 1, 0)) {
+		return false
+	}
+	return end.IsZero() || !end.Before(month)
+}
+
+// validateResourceDemands checks that every demand names a role, needs a positive FTE and ends
+// after it starts
+func validateResourceDemands(demands []ResourceDemand) error {
+	for _, demand := range demands {
+		if demand.Role == "" {
+			return errors.New("resource demand role cannot be empty")
+		}
+		if demand.FTE <= 0 {
+			return fmt.Errorf("resource demand for %s: FTE must be positive", demand.Role)
+		}
+		if !demand.PeriodStart.IsZero() && !demand.PeriodEnd.IsZero() && !demand.PeriodEnd.After(demand.PeriodStart) {
+			return fmt.Errorf("resource demand for %s: period end must be after period start", demand.Role)
+		}
+	}
+	return nil
+}
+
+// validatePersonnelAllocations checks that every allocation names a role, makes a non-negative
+// number of people available and ends after it starts
+func validatePersonnelAllocations(allocations []PersonnelAllocation) error {
+	for _, allocation := range allocations {
+		if allocation.Role == "" {
+			return errors.New("personnel allocation role cannot be empty")
+		}
+		if allocation.Count < 0 || allocation.FTE < 0 {
+			return fmt.Errorf("personnel allocation %s: count and FTE must not be negative", allocation.Role)
+		}
+		if !allocation.PeriodStart.IsZero() && !allocation.PeriodEnd.IsZero() && !allocation.PeriodEnd.After(allocation.PeriodStart) {
+			return fmt.Errorf("personnel allocation %s: period end must be after period start", allocation.Role)
+		}
+	}
+	return nil
+}
+
+// PlanCapacity compares the personnel allocated to the agreement against the demand of its
+// initiatives and action plans
+func (s *DirectionService) PlanCapacity(ctx context.Context, agreementID GovernanceAgreementID) (*CapacityPlan, error) {
+	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+
+	plan := BuildCapacityPlan(agreementID, agreement.Direct, time.Now())
+	return &plan, nil
+}
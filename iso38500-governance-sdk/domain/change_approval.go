@@ -0,0 +1,268 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ApprovalMode is how the approvers of a change request reach a decision
+type ApprovalMode string
+
+const (
+	// ApprovalQuorum approves the change once enough approvers holding any of the required roles
+	// have approved it
+	ApprovalQuorum ApprovalMode = "quorum"
+	// ApprovalUnanimous approves the change once every required role has approved it
+	ApprovalUnanimous ApprovalMode = "unanimous"
+)
+
+// Validate ensures the mode is known
+func (m ApprovalMode) Validate() error {
+	switch m {
+	case ApprovalQuorum, ApprovalUnanimous:
+		return nil
+	}
+	return fmt.Errorf("unknown approval mode %q", m)
+}
+
+// ApprovalRule sets who must approve the change requests of a type and priority before they may
+// be implemented, e.g. the change advisory board and security for high priority normal changes
+type ApprovalRule struct {
+	Type     ChangeType   // empty for any type
+	Priority Priority     // empty for any priority
+	Roles    []string     // roles whose approval counts; any role when empty
+	Mode     ApprovalMode // defaults to quorum
+	Quorum   int          // approvals needed in quorum mode, at least 1
+}
+
+// Validate ensures the rule has a known mode, a positive quorum and, when unanimous, the roles
+// that must approve
+func (r ApprovalRule) Validate() error {
+	if err := r.mode().Validate(); err != nil {
+		return err
+	}
+	if r.Quorum < 0 {
+		return fmt.Errorf("approval quorum cannot be negative")
+	}
+	if r.mode() == ApprovalUnanimous && len(r.Roles) == 0 {
+		return fmt.Errorf("unanimous approval rule for %s requires roles", r.scope())
+	}
+	for _, role := range r.Roles {
+		if strings.TrimSpace(role) == "" {
+			return fmt.Errorf("approval rule for %s has an empty role", r.scope())
+		}
+	}
+	return nil
+}
+
+// Applies reports whether the rule covers the change request
+func (r ApprovalRule) Applies(cr ChangeRequest) bool {
+	if r.Type != "" && r.Type != cr.Type {
+		return false
+	}
+	return r.Priority == "" || r.Priority == cr.Priority
+}
+
+// Accepts reports whether an approval in the role counts towards the rule
+func (r ApprovalRule) Accepts(role string) bool {
+	if len(r.Roles) == 0 {
+		return true
+	}
+	for _, required := range r.Roles {
+		if strings.EqualFold(strings.TrimSpace(required), strings.TrimSpace(role)) {
+			return true
+		}
+	}
+	return false
+}
+
+// mode returns the rule's mode, quorum when not set
+func (r ApprovalRule) mode() ApprovalMode {
+	if r.Mode == "" {
+		return ApprovalQuorum
+	}
+	return r.Mode
+}
+
+// quorum returns the approvals needed in quorum mode, at least 1
+func (r ApprovalRule) quorum() int {
+	if r.Quorum < 1 {
+		return 1
+	}
+	return r.Quorum
+}
+
+// specificity ranks how narrowly the rule applies: naming the type counts more than the priority
+func (r ApprovalRule) specificity() int {
+	score := 0
+	if r.Type != "" {
+		score += 2
+	}
+	if r.Priority != "" {
+		score++
+	}
+	return score
+}
+
+// scope describes the change requests the rule covers, e.g. "normal high priority changes"
+func (r ApprovalRule) scope() string {
+	parts := []string{}
+	if r.Type != "" {
+		parts = append(parts, string(r.Type))
+	}
+	if r.Priority != "" {
+		parts = append(parts, string(r.Priority)+" priority")
+	}
+	parts = append(parts, "changes")
+	if len(parts) == 1 {
+		return "all changes"
+	}
+	return strings.Join(parts, " ")
+}
+
+// Description describes the rule, e.g. "normal high priority changes: unanimous approval by
+// cab, security"
+func (r ApprovalRule) Description() string {
+	roles := "any approver"
+	if len(r.Roles) > 0 {
+		roles = strings.Join(r.Roles, ", ")
+	}
+	if r.mode() == ApprovalUnanimous {
+		return fmt.Sprintf("%s: unanimous approval by %s", r.scope(), roles)
+	}
+	return fmt.Sprintf("%s: %d approval(s) by %s", r.scope(), r.quorum(), roles)
+}
+
+// ApprovalMatrix sets the approvals each change request needs before it may be implemented.
+// Where several rules apply the most specific wins; a change request no rule covers needs a
+// single approval from anyone.
+type ApprovalMatrix struct {
+	Rules []ApprovalRule
+}
+
+// DefaultApprovalMatrix approves change requests on their first approval
+func DefaultApprovalMatrix() ApprovalMatrix {
+	return ApprovalMatrix{}
+}
+
+// Validate ensures every rule is valid
+func (m ApprovalMatrix) Validate() error {
+	for _, rule := range m.Rules {
+		if err := rule.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RuleFor returns the rule covering the change request: the most specific that applies, or a
+// single approval from anyone when none does
+func (m ApprovalMatrix) RuleFor(cr ChangeRequest) ApprovalRule {
+	found := ApprovalRule{Mode: ApprovalQuorum, Quorum: 1}
+	ok := false
+	for _, rule := range m.Rules {
+		if rule.Applies(cr) && (!ok || rule.specificity() > found.specificity()) {
+			found, ok = rule, true
+		}
+	}
+	return found
+}
+
+// Evaluate reports how far the approvals recorded on the change request go towards satisfying
+// its rule. Approvals in roles the rule does not require, and repeat approvals by the same
+// approver, do not count.
+func (m ApprovalMatrix) Evaluate(cr ChangeRequest) ApprovalProgress {
+	rule := m.RuleFor(cr)
+	progress := ApprovalProgress{
+		ChangeRequestID: cr.ID,
+		Rule:            rule,
+		Approvers:       []string{},
+		MissingRoles:    []string{},
+	}
+
+	approvers := make(map[string]bool)
+	approvedRoles := make(map[string]bool)
+	for _, approval := range cr.Approvals {
+		if approval.Status != ApprovalApproved || !rule.Accepts(approval.Role) {
+			continue
+		}
+		key := strings.ToLower(strings.TrimSpace(approval.Approver))
+		if approvers[key] {
+			continue
+		}
+		approvers[key] = true
+		approvedRoles[strings.ToLower(strings.TrimSpace(approval.Role))] = true
+		progress.Approvers = append(progress.Approvers, approval.Approver)
+	}
+
+	if rule.mode() == ApprovalUnanimous {
+		for _, role := range rule.Roles {
+			if !approvedRoles[strings.ToLower(strings.TrimSpace(role))] {
+				progress.MissingRoles = append(progress.MissingRoles, role)
+			}
+		}
+		progress.Remaining = len(progress.MissingRoles)
+	} else if remaining := rule.quorum() - len(progress.Approvers); remaining > 0 {
+		progress.Remaining = remaining
+	}
+	progress.Satisfied = progress.Remaining == 0
+	return progress
+}
+
+// ApprovalProgress is how far the approvals of a change request go towards satisfying the rule
+// of the approval matrix covering it
+type ApprovalProgress struct {
+	ChangeRequestID string
+	Rule            ApprovalRule
+	Approvers       []string // approvers whose approval counts, in the order they approved
+	MissingRoles    []string // required roles yet to approve, unanimous rules only
+	Remaining       int      // approvals still needed
+	Satisfied       bool
+}
+
+// Outstanding describes the approvals still needed, e.g. "security, finance" or "2 approvals
+// by cab, security"
+func (p ApprovalProgress) Outstanding() string {
+	if p.Satisfied {
+		return "none"
+	}
+	if len(p.MissingRoles) > 0 {
+		return strings.Join(p.MissingRoles, ", ")
+	}
+	roles := "any approver"
+	if len(p.Rule.Roles) > 0 {
+		roles = strings.Join(p.Rule.Roles, ", ")
+	}
+	return fmt.Sprintf("%d approval(s) by %s", p.Remaining, roles)
+}
+
+// AwaitedRoles returns the roles whose approval the change request still awaits: the missing
+// roles of a unanimous rule, the roles of a quorum rule, or RoleChangeApprover when the rule
+// accepts any approver. It is empty once the rule is satisfied.
+func (p ApprovalProgress) AwaitedRoles() []string {
+	switch {
+	case p.Satisfied:
+		return []string{}
+	case len(p.MissingRoles) > 0:
+		return append([]string{}, p.MissingRoles...)
+	case len(p.Rule.Roles) > 0:
+		return append([]string{}, p.Rule.Roles...)
+	}
+	return []string{RoleChangeApprover}
+}
+
+// ApprovedBy reports whether the approver already approved the change request
+func (cr ChangeRequest) ApprovedBy(approver string) bool {
+	for _, approval := range cr.Approvals {
+		if approval.Status == ApprovalApproved && sameActor(approval.Approver, approver) {
+			return true
+		}
+	}
+	return false
+}
+
+// AwaitingApproval reports whether the change request awaits approvals, either submitted or
+// partially approved
+func (cr ChangeRequest) AwaitingApproval() bool {
+	return cr.Status == ChangeStatusSubmitted || cr.Status == ChangeStatusPendingApproval
+}
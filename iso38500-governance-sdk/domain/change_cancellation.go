@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ChangeCancellation records who withdrew or cancelled a change request, when and why
+type ChangeCancellation struct {
+	CancelledBy string
+	Reason      string
+	CancelledAt time.Time
+}
+
+// Withdraw withdraws a change request at its requester's initiative while it is a draft or awaits
+// approval
+func (cr *ChangeRequest) Withdraw(requester, reason string, now time.Time) error {
+	if cr.Status != ChangeStatusDraft && !cr.AwaitingApproval() {
+		return fmt.Errorf("change request %s is %s; only draft or submitted change requests can be withdrawn", cr.ID, cr.Status)
+	}
+	if !sameActor(requester, cr.Requester) {
+		return fmt.Errorf("change request %s can only be withdrawn by its requester %s", cr.ID, cr.Requester)
+	}
+	return cr.cancel(ChangeStatusWithdrawn, requester, reason, now)
+}
+
+// Cancel cancels an approved change request that was not implemented. Only one of those who
+// approved it may cancel it.
+func (cr *ChangeRequest) Cancel(approver, reason string, now time.Time) error {
+	if cr.Status != ChangeStatusApproved {
+		return fmt.Errorf("change request %s is %s; only approved change requests not yet implemented can be cancelled", cr.ID, cr.Status)
+	}
+	if !cr.ApprovedBy(approver) {
+		return fmt.Errorf("change request %s can only be cancelled by one of its approvers", cr.ID)
+	}
+	return cr.cancel(ChangeStatusCancelled, approver, reason, now)
+}
+
+func (cr *ChangeRequest) cancel(status ChangeRequestStatus, by, reason string, now time.Time) error {
+	if strings.TrimSpace(reason) == "" {
+		return fmt.Errorf("change request %s needs a reason to be %s", cr.ID, status)
+	}
+	cr.Status = status
+	cr.Cancellation = &ChangeCancellation{CancelledBy: by, Reason: reason, CancelledAt: now}
+	cr.UpdatedAt = now
+	return nil
+}
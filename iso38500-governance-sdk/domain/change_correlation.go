@@ -0,0 +1,171 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// DefaultChangeCorrelationWindow is how long after a change is implemented an incident on the
+// same application is suspected to be caused by it
+const DefaultChangeCorrelationWindow = 72 * time.Hour
+
+// SuspectedChanges returns the IDs of the change requests implemented on the incident's
+// application within the window before it was reported, most recent first
+func SuspectedChanges(incident Incident, changes []ChangeRequest, window time.Duration) []string {
+	var suspects []ChangeRequest
+	for _, change := range changes {
+		if causedWithin(change, incident, window) {
+			suspects = append(suspects, change)
+		}
+	}
+	sort.SliceStable(suspects, func(i, j int) bool { return suspects[i].ImplementedAt.After(suspects[j].ImplementedAt) })
+
+	ids := make([]string, 0, len(suspects))
+	for _, change := range suspects {
+		ids = append(ids, change.ID)
+	}
+	return ids
+}
+
+// causedWithin reports whether the change was implemented on the incident's application within
+// the window before the incident was reported
+func causedWithin(change ChangeRequest, incident Incident, window time.Duration) bool {
+	if change.ApplicationID != incident.ApplicationID || change.ImplementedAt.IsZero() {
+		return false
+	}
+	elapsed := incident.CreatedAt.Sub(change.ImplementedAt)
+	return elapsed >= 0 && elapsed <= window
+}
+
+// FailedChange is an implemented change that failed or was rolled back, or was followed by
+// incidents on its application
+type FailedChange struct {
+	ChangeRequestID      string
+	Title                string
+	ImplementedAt        time.Time
+	ImplementationFailed bool     // its implementation failed or was rolled back
+	Incidents            []string // incidents likely caused by the change, in the order reported
+	HighestSeverity      int      // of those incidents; 1 is the highest, 0 without incidents
+}
+
+// ChangeFailureRate is the share of an application's changes implemented within a period that
+// failed, were rolled back or were followed by incidents, and the changes likely causing them
+type ChangeFailureRate struct {
+	ApplicationID ApplicationID
+	From          time.Time
+	To            time.Time
+	Window        time.Duration // how long after a change its incidents are attributed to it
+	Implemented   int
+	Failed        int
+	Rate          float64        // percentage of implemented changes that failed
+	FailedChanges []FailedChange // most severe incidents first, then most recent
+}
+
+// BuildChangeFailureRate attributes the application's incidents to the changes implemented
+// between from and to: those suspected when they were reported, and those reported within the
+// window after a change was implemented. Changes whose implementation failed or was rolled back
+// count as failed without incidents.
+func BuildChangeFailureRate(appID ApplicationID, changes []ChangeRequest, incidents []Incident, window time.Duration, from, to time.Time) ChangeFailureRate {
+	rate := ChangeFailureRate{
+		ApplicationID: appID,
+		From:          from,
+		To:            to,
+		Window:        window,
+		FailedChanges: []FailedChange{},
+	}
+
+	ordered := append([]Incident{}, incidents...)
+	sort.SliceStable(ordered, func(i, j int) bool { return ordered[i].CreatedAt.Before(ordered[j].CreatedAt) })
+	for _, change := range changes {
+		if change.ApplicationID != appID || change.ImplementedAt.IsZero() || change.ImplementedAt.Before(from) || change.ImplementedAt.After(to) {
+			continue
+		}
+		rate.Implemented++
+
+		if failed, ok := changeFailure(change, ordered, window); ok {
+			rate.Failed++
+			rate.FailedChanges = append(rate.FailedChanges, failed)
+		}
+	}
+
+	if rate.Implemented > 0 {
+		rate.Rate = float64(rate.Failed) / float64(rate.Implemented) * 100
+	}
+	sort.SliceStable(rate.FailedChanges, func(i, j int) bool {
+		a, b := rate.FailedChanges[i], rate.FailedChanges[j]
+		if a.HighestSeverity != b.HighestSeverity {
+			return b.HighestSeverity == 0 || (a.HighestSeverity != 0 && a.HighestSeverity < b.HighestSeverity)
+		}
+		return a.ImplementedAt.After(b.ImplementedAt)
+	})
+	return rate
+}
+
+// changeFailure attributes to an implemented change the incidents, ordered by when they were
+// reported, that suspected it or were reported within the window after it. It reports whether
+// the change failed: its implementation failed or was rolled back, or incidents followed it.
+func changeFailure(change ChangeRequest, incidents []Incident, window time.Duration) (FailedChange, bool) {
+	failed := FailedChange{
+		ChangeRequestID:      change.ID,
+		Title:                change.Title,
+		ImplementedAt:        change.ImplementedAt,
+		ImplementationFailed: change.ImplementationFailed(),
+	}
+	for _, incident := range incidents {
+		if !causedWithin(change, incident, window) && !contains(incident.SuspectedChanges, change.ID) {
+			continue
+		}
+		failed.Incidents = append(failed.Incidents, incident.ID)
+		if failed.HighestSeverity == 0 || incident.Severity < failed.HighestSeverity {
+			failed.HighestSeverity = incident.Severity
+		}
+	}
+	return failed, len(failed.Incidents) > 0 || failed.ImplementationFailed
+}
+
+// contains reports whether the list holds the value
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// WithChangeCorrelation reports the change failure rate of an agreement's application, and the
+// changes likely causing its incidents, when monitoring the agreement. It needs the incident
+// repository of WithOperationalMetrics.
+func WithChangeCorrelation(changeRepo ChangeRequestRepository) MonitoringOption {
+	return func(s *MonitoringService) {
+		s.changeRepo = changeRepo
+	}
+}
+
+// MonitorChangeFailures attributes the incidents of the agreement's application to the changes
+// implemented over the last 90 days. It returns nil without the change repository of
+// WithChangeCorrelation or the incident repository of WithOperationalMetrics.
+func (s *MonitoringService) MonitorChangeFailures(ctx context.Context, agreementID GovernanceAgreementID) (*ChangeFailureRate, error) {
+	if s.changeRepo == nil || s.incidentRepo == nil {
+		return nil, nil
+	}
+	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+
+	changes, err := s.changeRepo.FindByApplicationID(ctx, agreement.ApplicationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find change requests: %w", err)
+	}
+	incidents, err := s.incidentRepo.FindByApplicationID(ctx, agreement.ApplicationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find incidents: %w", err)
+	}
+
+	now := time.Now()
+	rate := BuildChangeFailureRate(agreement.ApplicationID, changes, incidents, DefaultChangeCorrelationWindow, now.Add(-DefaultOperationalWindow), now)
+	return &rate, nil
+}
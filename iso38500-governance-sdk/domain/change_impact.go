@@ -0,0 +1,155 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DependencyGraph links each application to the applications depending on it, from the
+// interfaces they consume. Interfaces that are inactive or have failed are left out.
+type DependencyGraph struct {
+	applications map[ApplicationID]Application
+	dependents   map[ApplicationID][]ApplicationID
+}
+
+// BuildDependencyGraph builds the dependency graph of the applications
+func BuildDependencyGraph(apps []Application) DependencyGraph {
+	graph := DependencyGraph{
+		applications: make(map[ApplicationID]Application, len(apps)),
+		dependents:   make(map[ApplicationID][]ApplicationID),
+	}
+	linked := make(map[[2]ApplicationID]bool)
+	for _, app := range apps {
+		graph.applications[app.ID] = app
+		for _, iface := range app.Interfaces {
+			if iface.Provider == "" || iface.Provider == app.ID {
+				continue
+			}
+			if iface.Status == InterfaceInactive || iface.Status == InterfaceFailed {
+				continue
+			}
+			link := [2]ApplicationID{iface.Provider, app.ID}
+			if linked[link] {
+				continue
+			}
+			linked[link] = true
+			graph.dependents[iface.Provider] = append(graph.dependents[iface.Provider], app.ID)
+		}
+	}
+	for provider := range graph.dependents {
+		dependents := graph.dependents[provider]
+		sort.Slice(dependents, func(i, j int) bool { return dependents[i] < dependents[j] })
+	}
+	return graph
+}
+
+// Dependents returns the applications consuming an interface the application serves
+func (g DependencyGraph) Dependents(id ApplicationID) []ApplicationID {
+	return g.dependents[id]
+}
+
+// Downstream returns every application depending on the application, directly or through
+// others, nearest first. Each is listed once, at the shortest distance.
+func (g DependencyGraph) Downstream(id ApplicationID) []ImpactedApplication {
+	impacted := []ImpactedApplication{}
+	visited := map[ApplicationID]bool{id: true}
+	queue := []ImpactedApplication{{ApplicationID: id}}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, dependent := range g.dependents[current.ApplicationID] {
+			if visited[dependent] {
+				continue
+			}
+			visited[dependent] = true
+			app := g.applications[dependent]
+			next := ImpactedApplication{
+				ApplicationID: dependent,
+				Name:          app.Name,
+				Criticality:   ApplicationCriticality(app, nil),
+				Depth:         current.Depth + 1,
+				Via:           current.ApplicationID,
+			}
+			impacted = append(impacted, next)
+			queue = append(queue, next)
+		}
+	}
+	return impacted
+}
+
+// ImpactedApplication is an application a change affects through its dependencies
+type ImpactedApplication struct {
+	ApplicationID ApplicationID
+	Name          string
+	Criticality   Priority      // medium when the application has none
+	Depth         int           // 1 when it depends on the changed application directly
+	Via           ApplicationID // the application it depends on along the shortest path
+}
+
+// ChangeImpact is the impact of changing an application on the applications depending on it and
+// the governance agreements covering them, for the approvers of the change
+type ChangeImpact struct {
+	ApplicationID      ApplicationID
+	Applications       []ImpactedApplication   // downstream applications, nearest first
+	Agreements         []GovernanceAgreementID // agreements of the changed and downstream applications
+	HighestCriticality Priority                // of the downstream applications; empty when there are none
+	AnalyzedAt         time.Time
+}
+
+// AnalyzeChangeImpact finds the applications depending on the changed application in the
+// dependency graph of the applications, and the agreements governing any of them
+func AnalyzeChangeImpact(appID ApplicationID, apps []Application, agreements []GovernanceAgreement, now time.Time) ChangeImpact {
+	impact := ChangeImpact{
+		ApplicationID: appID,
+		Applications:  BuildDependencyGraph(apps).Downstream(appID),
+		Agreements:    []GovernanceAgreementID{},
+		AnalyzedAt:    now,
+	}
+
+	affected := map[ApplicationID]bool{appID: true}
+	for _, app := range impact.Applications {
+		affected[app.ApplicationID] = true
+		if app.Criticality.Weight() > impact.HighestCriticality.Weight() {
+			impact.HighestCriticality = app.Criticality
+		}
+	}
+
+	seen := make(map[GovernanceAgreementID]bool)
+	addAgreement := func(id GovernanceAgreementID) {
+		if id != "" && !seen[id] {
+			seen[id] = true
+			impact.Agreements = append(impact.Agreements, id)
+		}
+	}
+	for _, agreement := range agreements {
+		if affected[agreement.ApplicationID] {
+			addAgreement(agreement.ID)
+		}
+	}
+	for _, app := range apps {
+		if affected[app.ID] {
+			addAgreement(app.GovernanceAgreementID)
+		}
+	}
+	sort.Slice(impact.Agreements, func(i, j int) bool { return impact.Agreements[i] < impact.Agreements[j] })
+	return impact
+}
+
+// Summary describes the impact, e.g. "2 downstream application(s): data-warehouse-001,
+// finance-budget-001 (highest criticality: high), 3 governance agreement(s)"
+func (i ChangeImpact) Summary() string {
+	if len(i.Applications) == 0 {
+		return fmt.Sprintf("no downstream applications, %d governance agreement(s)", len(i.Agreements))
+	}
+	names := make([]string, 0, len(i.Applications))
+	for _, app := range i.Applications {
+		names = append(names, string(app.ApplicationID))
+	}
+	summary := fmt.Sprintf("%d downstream application(s): %s", len(i.Applications), strings.Join(names, ", "))
+	if i.HighestCriticality != "" {
+		summary += fmt.Sprintf(" (highest criticality: %s)", i.HighestCriticality)
+	}
+	return summary + fmt.Sprintf(", %d governance agreement(s)", len(i.Agreements))
+}
@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ImplementationOutcome is how the implementation of a change request went
+type ImplementationOutcome string
+
+const (
+	ImplementationSuccessful ImplementationOutcome = "successful"
+	ImplementationPartial    ImplementationOutcome = "partial" // implemented with parts left out or deferred
+	ImplementationFailed     ImplementationOutcome = "failed"
+)
+
+// Validate ensures the outcome is one of the known outcomes
+func (o ImplementationOutcome) Validate() error {
+	switch o {
+	case ImplementationSuccessful, ImplementationPartial, ImplementationFailed:
+		return nil
+	}
+	return fmt.Errorf("unknown implementation outcome %q", o)
+}
+
+// ChangeImplementation records who implemented a change request, when, and how it went
+type ChangeImplementation struct {
+	Implementer   string
+	ImplementedAt time.Time
+	Outcome       ImplementationOutcome
+	RolledBack    bool // the change was backed out after it was implemented
+	Notes         string
+}
+
+// Validate ensures the implementation names its implementer and a known outcome
+func (i ChangeImplementation) Validate() error {
+	if strings.TrimSpace(i.Implementer) == "" {
+		return fmt.Errorf("implementation must name its implementer")
+	}
+	return i.Outcome.Validate()
+}
+
+// Failed reports whether the implementation failed or had to be rolled back
+func (i ChangeImplementation) Failed() bool {
+	return i.Outcome == ImplementationFailed || i.RolledBack
+}
+
+// ChangeVerification records the check, before a change request is closed, that the change
+// achieved what it was meant to
+type ChangeVerification struct {
+	VerifiedBy string
+	Successful bool // the change achieved its objectives
+	Notes      string
+	VerifiedAt time.Time
+}
+
+// Implement records the implementation of an approved change request
+func (cr *ChangeRequest) Implement(implementation ChangeImplementation, now time.Time) error {
+	if cr.Status != ChangeStatusApproved {
+		return fmt.Errorf("change request is not in approved status")
+	}
+	if implementation.Outcome == "" {
+		implementation.Outcome = ImplementationSuccessful
+	}
+	if implementation.ImplementedAt.IsZero() {
+		implementation.ImplementedAt = now
+	}
+	if err := implementation.Validate(); err != nil {
+		return err
+	}
+	cr.Status = ChangeStatusImplemented
+	cr.ImplementedAt = implementation.ImplementedAt
+	cr.Implementation = &implementation
+	cr.UpdatedAt = now
+	return nil
+}
+
+// ImplementationFailed reports whether the change request was implemented without success: its
+// implementation failed or was rolled back
+func (cr ChangeRequest) ImplementationFailed() bool {
+	return cr.Implementation != nil && cr.Implementation.Failed()
+}
+
+// Close closes an implemented change request once its implementation has been verified. A
+// change whose verification was unsuccessful is closed too, recording that it fell short.
+func (cr *ChangeRequest) Close(verification ChangeVerification, now time.Time) error {
+	if cr.Status != ChangeStatusImplemented {
+		return fmt.Errorf("change request %s is %s; only implemented change requests can be closed", cr.ID, cr.Status)
+	}
+	if strings.TrimSpace(verification.VerifiedBy) == "" {
+		return fmt.Errorf("verification of change request %s must name who verified it", cr.ID)
+	}
+	if strings.TrimSpace(verification.Notes) == "" {
+		return fmt.Errorf("verification of change request %s must describe what was checked", cr.ID)
+	}
+	if verification.VerifiedAt.IsZero() {
+		verification.VerifiedAt = now
+	}
+	cr.Status = ChangeStatusClosed
+	cr.Verification = &verification
+	cr.ClosedAt = now
+	cr.UpdatedAt = now
+	return nil
+}
@@ -0,0 +1,291 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// DefaultChangeMetricsPeriod is the length of the periods change metrics are broken down into
+const DefaultChangeMetricsPeriod = 30 * 24 * time.Hour
+
+// Change success rates below these percentages need attention and are critical; emergency change
+// ratios above these percentages do
+const (
+	ChangeSuccessRateWarning     = 85.0
+	ChangeSuccessRateCritical    = 70.0
+	EmergencyChangeRatioWarning  = 15.0
+	EmergencyChangeRatioCritical = 30.0
+)
+
+// ChangeMetricsPeriod is the change activity of one period
+type ChangeMetricsPeriod struct {
+	From           time.Time
+	To             time.Time
+	Requested      int
+	Implemented    int
+	Failed         int
+	Emergency      int           // implemented emergency changes
+	SuccessRate    float64       // percentage of implemented changes that did not fail; 0 without any
+	EmergencyRatio float64       // percentage of implemented changes that were emergency changes
+	LeadTime       time.Duration // mean time from request to implementation
+}
+
+// ChangeMetrics summarizes the change requests of an application or portfolio within a window:
+// how many were raised and implemented, how long they took, how many succeeded and how many
+// bypassed normal change control as emergencies. A change fails when its implementation failed
+// or was rolled back, or incidents followed it.
+type ChangeMetrics struct {
+	ApplicationID   ApplicationID // empty for a portfolio
+	PortfolioID     PortfolioID   // empty for an application
+	From            time.Time
+	To              time.Time
+	Requested       int // raised within the window
+	Rejected        int // raised within the window and rejected
+	Cancelled       int // raised within the window and withdrawn or cancelled
+	Implemented     int
+	Successful      int
+	Failed          int
+	Emergency       int
+	SuccessRate     float64               // percentage of implemented changes that did not fail; 0 without any
+	EmergencyRatio  float64               // percentage of implemented changes that were emergency changes
+	LeadTime        time.Duration         // mean time from request to implementation
+	ChangesPerMonth float64               // implemented per 30 days
+	Periods         []ChangeMetricsPeriod // oldest first
+}
+
+// BuildChangeMetrics summarizes the change requests raised or implemented between from and to,
+// attributing to them the incidents reported within the window after they were implemented, and
+// breaks them down into periods of the given length ending at to
+func BuildChangeMetrics(changes []ChangeRequest, incidents []Incident, window time.Duration, from, to time.Time, period time.Duration) ChangeMetrics {
+	metrics := ChangeMetrics{From: from, To: to, Periods: []ChangeMetricsPeriod{}}
+	if period <= 0 {
+		period = DefaultChangeMetricsPeriod
+	}
+	for end := to; end.After(from); end = end.Add(-period) {
+		start := end.Add(-period)
+		if start.Before(from) {
+			start = from
+		}
+		metrics.Periods = append(metrics.Periods, ChangeMetricsPeriod{From: start, To: end})
+	}
+	sort.Slice(metrics.Periods, func(i, j int) bool { return metrics.Periods[i].From.Before(metrics.Periods[j].From) })
+
+	ordered := append([]Incident{}, incidents...)
+	sort.SliceStable(ordered, func(i, j int) bool { return ordered[i].CreatedAt.Before(ordered[j].CreatedAt) })
+
+	var leadTime time.Duration
+	leadTimes := make([]time.Duration, len(metrics.Periods))
+	for _, change := range changes {
+		if inWindow(change.CreatedAt, from, to) {
+			metrics.Requested++
+			switch change.Status {
+			case ChangeStatusRejected:
+				metrics.Rejected++
+			case ChangeStatusWithdrawn, ChangeStatusCancelled:
+				metrics.Cancelled++
+			}
+			if i := periodOf(metrics.Periods, change.CreatedAt); i >= 0 {
+				metrics.Periods[i].Requested++
+			}
+		}
+		if change.ImplementedAt.IsZero() || !inWindow(change.ImplementedAt, from, to) {
+			continue
+		}
+
+		_, failed := changeFailure(change, ordered, window)
+		emergency := change.Type == ChangeEmergency
+		lead := change.ImplementedAt.Sub(change.CreatedAt)
+		if lead < 0 {
+			lead = 0
+		}
+		metrics.Implemented++
+		leadTime += lead
+		if failed {
+			metrics.Failed++
+		}
+		if emergency {
+			metrics.Emergency++
+		}
+
+		i := periodOf(metrics.Periods, change.ImplementedAt)
+		if i < 0 {
+			continue
+		}
+		metrics.Periods[i].Implemented++
+		leadTimes[i] += lead
+		if failed {
+			metrics.Periods[i].Failed++
+		}
+		if emergency {
+			metrics.Periods[i].Emergency++
+		}
+	}
+
+	metrics.Successful = metrics.Implemented - metrics.Failed
+	if metrics.Implemented > 0 {
+		metrics.SuccessRate = percentage(metrics.Successful, metrics.Implemented)
+		metrics.EmergencyRatio = percentage(metrics.Emergency, metrics.Implemented)
+		metrics.LeadTime = leadTime / time.Duration(metrics.Implemented)
+	}
+	if days := to.Sub(from).Hours() / 24; days > 0 {
+		metrics.ChangesPerMonth = math.Round(float64(metrics.Implemented)/days*30*10) / 10
+	}
+	for i := range metrics.Periods {
+		p := &metrics.Periods[i]
+		if p.Implemented > 0 {
+			p.SuccessRate = percentage(p.Implemented-p.Failed, p.Implemented)
+			p.EmergencyRatio = percentage(p.Emergency, p.Implemented)
+			p.LeadTime = leadTimes[i] / time.Duration(p.Implemented)
+		}
+	}
+	return metrics
+}
+
+// inWindow reports whether the time falls between from and to
+func inWindow(at, from, to time.Time) bool {
+	return !at.Before(from) && !at.After(to)
+}
+
+// periodOf returns the index of the period holding the time, or -1
+func periodOf(periods []ChangeMetricsPeriod, at time.Time) int {
+	for i, period := range periods {
+		if at.After(period.From) && !at.After(period.To) || (i == 0 && at.Equal(period.From)) {
+			return i
+		}
+	}
+	return -1
+}
+
+// percentage returns part of whole as a percentage rounded to one decimal
+func percentage(part, whole int) float64 {
+	return math.Round(float64(part)/float64(whole)*1000) / 10
+}
+
+// SuccessRateStatus rates the change success rate against ChangeSuccessRateWarning and
+// ChangeSuccessRateCritical; normal without implemented changes
+func (m ChangeMetrics) SuccessRateStatus() RiskStatus {
+	switch {
+	case m.Implemented == 0 || m.SuccessRate >= ChangeSuccessRateWarning:
+		return RiskStatusNormal
+	case m.SuccessRate < ChangeSuccessRateCritical:
+		return RiskStatusCritical
+	}
+	return RiskStatusWarning
+}
+
+// EmergencyRatioStatus rates the emergency change ratio against EmergencyChangeRatioWarning and
+// EmergencyChangeRatioCritical
+func (m ChangeMetrics) EmergencyRatioStatus() RiskStatus {
+	switch {
+	case m.EmergencyRatio > EmergencyChangeRatioCritical:
+		return RiskStatusCritical
+	case m.EmergencyRatio > EmergencyChangeRatioWarning:
+		return RiskStatusWarning
+	}
+	return RiskStatusNormal
+}
+
+// KeyMetrics reports the change volume, success rate, emergency change ratio and lead time as
+// executive key metrics, trending against the metrics of the previous window when given
+func (m ChangeMetrics) KeyMetrics(previous *ChangeMetrics) []KeyMetric {
+	trend := func(before, after float64, higherIsBetter bool) string {
+		if previous == nil || previous.Implemented == 0 || m.Implemented == 0 {
+			return string(TrendInsufficientData)
+		}
+		switch {
+		case before == after:
+			return string(TrendStable)
+		case (after > before) == higherIsBetter:
+			return string(TrendImproving)
+		}
+		return string(TrendDegrading)
+	}
+	var before ChangeMetrics
+	if previous != nil {
+		before = *previous
+	}
+
+	// More changes delivered is improving throughput, as with deployment frequency
+	volume := KeyMetric{Name: "Changes implemented", Value: float64(m.Implemented), Unit: "changes", Trend: string(TrendInsufficientData), Status: string(RiskStatusNormal)}
+	if previous != nil {
+		volume.Trend = string(countTrend(previous.Implemented, m.Implemented, true))
+	}
+	return []KeyMetric{
+		volume,
+		{Name: "Change success rate", Value: m.SuccessRate, Unit: "%", Trend: trend(before.SuccessRate, m.SuccessRate, true), Status: string(m.SuccessRateStatus())},
+		{Name: "Emergency change ratio", Value: m.EmergencyRatio, Unit: "%", Trend: trend(before.EmergencyRatio, m.EmergencyRatio, false), Status: string(m.EmergencyRatioStatus())},
+		{Name: "Change lead time", Value: math.Round(m.LeadTime.Hours()*10) / 10, Unit: "hours", Trend: trend(before.LeadTime.Hours(), m.LeadTime.Hours(), false), Status: string(RiskStatusNormal)},
+	}
+}
+
+// changeRecommendations asks to improve change quality when too many changes fail, and to plan
+// changes ahead when too many are emergencies
+func changeRecommendations(metrics *ChangeMetrics) []Recommendation {
+	if metrics == nil || metrics.Implemented == 0 {
+		return nil
+	}
+
+	var recommendations []Recommendation
+	if status := metrics.SuccessRateStatus(); status != RiskStatusNormal {
+		priority := PriorityMedium
+		if status == RiskStatusCritical {
+			priority = PriorityHigh
+		}
+		recommendations = append(recommendations, Recommendation{
+			ID:             "chg-001",
+			Type:           RecEnhance,
+			Description:    fmt.Sprintf("Improve change testing and rollback planning: %d of %d changes failed (%.1f%% success)", metrics.Failed, metrics.Implemented, metrics.SuccessRate),
+			Priority:       priority,
+			BusinessImpact: "Fewer disruptions caused by changes",
+		})
+	}
+	if status := metrics.EmergencyRatioStatus(); status != RiskStatusNormal {
+		priority := PriorityMedium
+		if status == RiskStatusCritical {
+			priority = PriorityHigh
+		}
+		recommendations = append(recommendations, Recommendation{
+			ID:             "chg-002",
+			Type:           RecEnhance,
+			Description:    fmt.Sprintf("Plan changes ahead: %d of %d changes were emergency changes (%.1f%%)", metrics.Emergency, metrics.Implemented, metrics.EmergencyRatio),
+			Priority:       priority,
+			BusinessImpact: "Changes go through full review and approval before reaching production",
+		})
+	}
+	return recommendations
+}
+
+// WithChangeRequestRepository backs assessments with the application's change history: its
+// change metrics are included in every assessment and add recommendations when too many changes
+// fail or are emergencies. Failures are attributed to incidents with the repository of
+// WithIncidentRepository when it is configured too.
+func WithChangeRequestRepository(repo ChangeRequestRepository) EvaluationOption {
+	return func(s *EvaluationService) {
+		s.changeRepo = repo
+	}
+}
+
+// changeMetrics summarizes an application's change requests over the default window ending at,
+// and is nil without a change repository
+func changeMetrics(ctx context.Context, changeRepo ChangeRequestRepository, incidentRepo IncidentRepository, appID ApplicationID, at time.Time) (*ChangeMetrics, error) {
+	if changeRepo == nil {
+		return nil, nil
+	}
+	changes, err := changeRepo.FindByApplicationID(ctx, appID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find change requests: %w", err)
+	}
+	var incidents []Incident
+	if incidentRepo != nil {
+		incidents, err = incidentRepo.FindByApplicationID(ctx, appID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find incidents: %w", err)
+		}
+	}
+	metrics := BuildChangeMetrics(changes, incidents, DefaultChangeCorrelationWindow, at.Add(-DefaultOperationalWindow), at, DefaultChangeMetricsPeriod)
+	metrics.ApplicationID = appID
+	return &metrics, nil
+}
@@ -0,0 +1,374 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ComplianceAssessmentStatus represents where a compliance assessment is in its workflow
+type ComplianceAssessmentStatus string
+
+const (
+	ComplianceAssessmentInProgress ComplianceAssessmentStatus = "in_progress"
+	ComplianceAssessmentCompleted  ComplianceAssessmentStatus = "completed"
+)
+
+// AssessedControl is a requirement of the framework in the scope of a compliance assessment, with
+// the weight it carries in the score
+type AssessedControl struct {
+	RequirementID string
+	Title         string
+	Group         string
+	Weight        float64
+}
+
+// ControlAnswer is the status an assessor found for one control, with links to the evidence
+// supporting it. A control that does not apply to the application is left out of the score.
+type ControlAnswer struct {
+	RequirementID string
+	Status        ComplianceStatus
+	NotApplicable bool
+	EvidenceLinks []string // evidence IDs, documents or report links
+	Notes         string
+	AnsweredBy    string
+	AnsweredAt    time.Time
+}
+
+// ComplianceGroupScore is the weighted compliance of the controls of one group of a framework
+type ComplianceGroupScore struct {
+	Group      string
+	Percentage float64
+}
+
+// ComplianceScore is the weighted compliance of an assessment's controls. Compliant controls
+// count fully, partial ones by half and non-compliant, under review and unanswered ones not at
+// all; controls that do not apply are left out.
+type ComplianceScore struct {
+	Percentage    float64
+	Compliant     int
+	Partial       int
+	NonCompliant  int
+	UnderReview   int
+	NotApplicable int
+	Unanswered    int
+	Groups        []ComplianceGroupScore
+}
+
+// ComplianceAssessment is a control-by-control assessment of an agreement against a compliance
+// framework. Its results feed the agreement's conformance once it is completed.
+type ComplianceAssessment struct {
+	ID            string
+	AgreementID   GovernanceAgreementID
+	FrameworkID   string
+	FrameworkName string
+	Controls      []AssessedControl
+	Answers       []ControlAnswer
+	Score         ComplianceScore
+	Status        ComplianceAssessmentStatus
+	Assessor      string
+	StartedAt     time.Time
+	CompletedAt   time.Time
+}
+
+// Answer returns the answer given for a control, if any
+func (a ComplianceAssessment) Answer(requirementID string) (ControlAnswer, bool) {
+	for _, answer := range a.Answers {
+		if answer.RequirementID == requirementID {
+			return answer, true
+		}
+	}
+	return ControlAnswer{}, false
+}
+
+// Pending returns the controls that have no answer yet, or whose answer is still under review
+func (a ComplianceAssessment) Pending() []string {
+	var pending []string
+	for _, control := range a.Controls {
+		answer, ok := a.Answer(control.RequirementID)
+		if !ok || (!answer.NotApplicable && answer.Status == ComplianceUnderReview) {
+			pending = append(pending, control.RequirementID)
+		}
+	}
+	return pending
+}
+
+// CitingAnswers returns the answers of applicable controls that link the evidence
+func (a ComplianceAssessment) CitingAnswers(evidenceID string) []ControlAnswer {
+	var answers []ControlAnswer
+	for _, answer := range a.Answers {
+		if answer.NotApplicable {
+			continue
+		}
+		for _, link := range answer.EvidenceLinks {
+			if link == evidenceID {
+				answers = append(answers, answer)
+				break
+			}
+		}
+	}
+	return answers
+}
+
+// ScoreComplianceAssessment computes the weighted compliance of the assessment's controls
+func ScoreComplianceAssessment(assessment ComplianceAssessment) ComplianceScore {
+	var score ComplianceScore
+	var achieved, total float64
+	groupAchieved := make(map[string]float64)
+	groupTotal := make(map[string]float64)
+	var groups []string
+
+	for _, control := range assessment.Controls {
+		answer, ok := assessment.Answer(control.RequirementID)
+		switch {
+		case !ok:
+			score.Unanswered++
+		case answer.NotApplicable:
+			score.NotApplicable++
+			continue
+		case answer.Status == ComplianceCompliant:
+			score.Compliant++
+		case answer.Status == CompliancePartial:
+			score.Partial++
+		case answer.Status == ComplianceNonCompliant:
+			score.NonCompliant++
+		default:
+			score.UnderReview++
+		}
+
+		credit := 0.0
+		if ok {
+			credit = complianceCredit(answer.Status)
+		}
+		if _, seen := groupTotal[control.Group]; !seen {
+			groups = append(groups, control.Group)
+		}
+		achieved += control.Weight * credit
+		total += control.Weight
+		groupAchieved[control.Group] += control.Weight * credit
+		groupTotal[control.Group] += control.Weight
+	}
+
+	if total > 0 {
+		score.Percentage = achieved / total * 100
+	}
+	for _, group := range groups {
+		if group == "" || groupTotal[group] == 0 {
+			continue
+		}
+		score.Groups = append(score.Groups, ComplianceGroupScore{
+			Group:      group,
+			Percentage: groupAchieved[group] / groupTotal[group] * 100,
+		})
+	}
+	return score
+}
+
+// complianceCredit returns how much of its weight a control with the status earns
+func complianceCredit(status ComplianceStatus) float64 {
+	switch status {
+	case ComplianceCompliant:
+		return 1
+	case CompliancePartial:
+		return 0.5
+	}
+	return 0
+}
+
+// ComplianceAssessmentAggregate represents the compliance assessment aggregate root
+type ComplianceAssessmentAggregate struct {
+	assessment   ComplianceAssessment
+	domainEvents []DomainEvent
+}
+
+// NewComplianceAssessmentAggregate starts assessing an agreement against the selected requirements
+// of a framework; no selector assesses every requirement
+func NewComplianceAssessmentAggregate(id string, agreementID GovernanceAgreementID, framework ComplianceFramework, selectors []string, assessor string, now time.Time) (*ComplianceAssessmentAggregate, error) {
+	if id == "" {
+		return nil, errors.New("compliance assessment ID cannot be empty")
+	}
+	if agreementID == "" {
+		return nil, errors.New("governance agreement ID cannot be empty")
+	}
+	requirements, err := framework.Select(selectors)
+	if err != nil {
+		return nil, err
+	}
+
+	controls := make([]AssessedControl, len(requirements))
+	for i, requirement := range requirements {
+		controls[i] = AssessedControl{
+			RequirementID: requirement.ID,
+			Title:         requirement.Title,
+			Group:         requirement.Group,
+			Weight:        requirement.ScoringWeight(),
+		}
+	}
+
+	assessment := ComplianceAssessment{
+		ID:            id,
+		AgreementID:   agreementID,
+		FrameworkID:   framework.ID,
+		FrameworkName: framework.Name,
+		Controls:      controls,
+		Status:        ComplianceAssessmentInProgress,
+		Assessor:      assessor,
+		StartedAt:     now,
+	}
+	assessment.Score = ScoreComplianceAssessment(assessment)
+
+	aggregate := &ComplianceAssessmentAggregate{
+		assessment:   assessment,
+		domainEvents: []DomainEvent{},
+	}
+	aggregate.addDomainEvent(ComplianceAssessmentStartedEvent{
+		AssessmentID: id,
+		AgreementID:  agreementID,
+		FrameworkID:  framework.ID,
+		Controls:     len(controls),
+		OccurredAt:   now,
+	})
+	return aggregate, nil
+}
+
+// LoadComplianceAssessmentAggregate resumes the workflow of a recorded compliance assessment
+func LoadComplianceAssessmentAggregate(assessment ComplianceAssessment) *ComplianceAssessmentAggregate {
+	return &ComplianceAssessmentAggregate{
+		assessment:   assessment,
+		domainEvents: []DomainEvent{},
+	}
+}
+
+// AnswerControl records the status found for a control in scope, replacing any earlier answer,
+// and rescores the assessment
+func (a *ComplianceAssessmentAggregate) AnswerControl(answer ControlAnswer) error {
+	if a.assessment.Status != ComplianceAssessmentInProgress {
+		return errors.New("only assessments in progress can be answered")
+	}
+	inScope := false
+	for _, control := range a.assessment.Controls {
+		if control.RequirementID == answer.RequirementID {
+			inScope = true
+			break
+		}
+	}
+	if !inScope {
+		return fmt.Errorf("control %s is not in the scope of assessment %s", answer.RequirementID, a.assessment.ID)
+	}
+	if answer.NotApplicable {
+		answer.Status = ""
+	} else if err := answer.Status.Validate(); err != nil {
+		return err
+	}
+
+	answers := make([]ControlAnswer, 0, len(a.assessment.Answers)+1)
+	for _, previous := range a.assessment.Answers {
+		if previous.RequirementID != answer.RequirementID {
+			answers = append(answers, previous)
+		}
+	}
+	a.assessment.Answers = append(answers, answer)
+	a.assessment.Score = ScoreComplianceAssessment(a.assessment)
+	return nil
+}
+
+// Complete closes the assessment once every control in scope is answered with a status other
+// than under review, or marked not applicable
+func (a *ComplianceAssessmentAggregate) Complete(now time.Time) error {
+	if a.assessment.Status != ComplianceAssessmentInProgress {
+		return errors.New("only assessments in progress can be completed")
+	}
+	if pending := a.assessment.Pending(); len(pending) > 0 {
+		return fmt.Errorf("assessment %s has %d controls without a final answer, e.g. %s", a.assessment.ID, len(pending), pending[0])
+	}
+
+	a.assessment.Status = ComplianceAssessmentCompleted
+	a.assessment.CompletedAt = now
+	a.assessment.Score = ScoreComplianceAssessment(a.assessment)
+
+	a.addDomainEvent(ComplianceAssessmentCompletedEvent{
+		AssessmentID: a.assessment.ID,
+		AgreementID:  a.assessment.AgreementID,
+		FrameworkID:  a.assessment.FrameworkID,
+		Percentage:   a.assessment.Score.Percentage,
+		OccurredAt:   now,
+	})
+	return nil
+}
+
+// ReviewEvidence puts the compliant and partial answers of an assessment in progress that rest on
+// stale evidence back under review, so they must be answered again before it is completed, and
+// returns their requirement IDs
+func (a *ComplianceAssessmentAggregate) ReviewEvidence(evidenceID string) []string {
+	if a.assessment.Status != ComplianceAssessmentInProgress {
+		return nil
+	}
+
+	var reviewed []string
+	answers := append([]ControlAnswer{}, a.assessment.Answers...)
+	for _, citing := range a.assessment.CitingAnswers(evidenceID) {
+		if !EvidenceBacked(citing.Status) {
+			continue
+		}
+		for i := range answers {
+			if answers[i].RequirementID == citing.RequirementID {
+				answers[i].Status = ComplianceUnderReview
+			}
+		}
+		reviewed = append(reviewed, citing.RequirementID)
+	}
+	if len(reviewed) > 0 {
+		a.assessment.Answers = answers
+		a.assessment.Score = ScoreComplianceAssessment(a.assessment)
+	}
+	return reviewed
+}
+
+// ApplyTo attaches the applicable controls of a completed assessment to the conformance
+// component, when it does not name them yet, and sets their status to the one found
+func (a *ComplianceAssessmentAggregate) ApplyTo(conformance Conformance, framework ComplianceFramework) (Conformance, []RequirementRef, error) {
+	if a.assessment.Status != ComplianceAssessmentCompleted {
+		return conformance, nil, errors.New("only completed assessments feed the conformance of agreements")
+	}
+	if framework.ID != a.assessment.FrameworkID {
+		return conformance, nil, fmt.Errorf("assessment %s is of framework %s, not %s", a.assessment.ID, a.assessment.FrameworkID, framework.ID)
+	}
+
+	var requirements []FrameworkRequirement
+	for _, control := range a.assessment.Controls {
+		if answer, ok := a.assessment.Answer(control.RequirementID); ok && !answer.NotApplicable {
+			requirements = append(requirements, FrameworkRequirement{ID: control.RequirementID, Title: control.Title, Group: control.Group})
+		}
+	}
+	conformance, _ = framework.AttachTo(conformance, requirements)
+
+	updated := make([]RequirementRef, 0, len(requirements))
+	for _, requirement := range requirements {
+		answer, _ := a.assessment.Answer(requirement.ID)
+		ref := RequirementRef{Kind: framework.Kind, Name: framework.RequirementName(requirement)}
+		if setComplianceStatus(&conformance, ref, answer.Status) {
+			updated = append(updated, ref)
+		}
+	}
+	return conformance, updated, nil
+}
+
+// GetAssessment returns the compliance assessment
+func (a *ComplianceAssessmentAggregate) GetAssessment() ComplianceAssessment {
+	return a.assessment
+}
+
+// GetDomainEvents returns the domain events
+func (a *ComplianceAssessmentAggregate) GetDomainEvents() []DomainEvent {
+	return a.domainEvents
+}
+
+// ClearDomainEvents clears the domain events
+func (a *ComplianceAssessmentAggregate) ClearDomainEvents() {
+	a.domainEvents = []DomainEvent{}
+}
+
+// addDomainEvent adds a domain event to the aggregate
+func (a *ComplianceAssessmentAggregate) addDomainEvent(event DomainEvent) {
+	a.domainEvents = append(a.domainEvents, event)
+}
@@ -0,0 +1,347 @@
+package domain
+
+import "time"
+
+// Identifiers of the compliance frameworks in the standard catalog
+const (
+	FrameworkGDPR     = "gdpr"
+	FrameworkISO27001 = "iso27001-2022"
+	FrameworkSOC2     = "soc2"
+	FrameworkNISTCSF  = "nist-csf-2.0"
+)
+
+// StandardComplianceFrameworks returns the catalog of the frameworks most agreements are held
+// to: the GDPR articles setting obligations on controllers and processors, the ISO/IEC
+// 27001:2022 Annex A controls, the SOC 2 Trust Services Criteria and the NIST Cybersecurity
+// Framework 2.0 categories. Requirements are identified and titled as in the frameworks; their
+// full text is left to the frameworks themselves.
+func StandardComplianceFrameworks() []ComplianceFramework {
+	return []ComplianceFramework{
+		{
+			ID:            FrameworkGDPR,
+			Name:          "GDPR",
+			Kind:          RequirementLegal,
+			Issuer:        "European Union",
+			Version:       "Regulation (EU) 2016/679",
+			EffectiveDate: time.Date(2018, time.May, 25, 0, 0, 0, 0, time.UTC),
+			Description:   "General Data Protection Regulation",
+			Requirements:  gdprRequirements(),
+		},
+		{
+			ID:           FrameworkISO27001,
+			Name:         "ISO/IEC 27001:2022",
+			Kind:         RequirementIndustryStandard,
+			Issuer:       "ISO/IEC",
+			Version:      "2022",
+			Description:  "Information security management systems, Annex A controls",
+			Requirements: iso27001Requirements(),
+		},
+		{
+			ID:           FrameworkSOC2,
+			Name:         "SOC 2",
+			Kind:         RequirementIndustryStandard,
+			Issuer:       "AICPA",
+			Version:      "2017 Trust Services Criteria",
+			Description:  "Trust Services Criteria for security, availability, processing integrity, confidentiality and privacy",
+			Requirements: soc2Requirements(),
+		},
+		{
+			ID:           FrameworkNISTCSF,
+			Name:         "NIST CSF 2.0",
+			Kind:         RequirementIndustryStandard,
+			Issuer:       "NIST",
+			Version:      "2.0",
+			Description:  "Cybersecurity Framework core functions and categories",
+			Requirements: nistCSFRequirements(),
+		},
+	}
+}
+
+// requirementGroup sets the group of the requirements
+func requirementGroup(group string, requirements ...FrameworkRequirement) []FrameworkRequirement {
+	for i := range requirements {
+		requirements[i].Group = group
+	}
+	return requirements
+}
+
+// concatRequirements joins groups of requirements in order
+func concatRequirements(groups ...[]FrameworkRequirement) []FrameworkRequirement {
+	var requirements []FrameworkRequirement
+	for _, group := range groups {
+		requirements = append(requirements, group...)
+	}
+	return requirements
+}
+
+func gdprRequirements() []FrameworkRequirement {
+	return concatRequirements(
+		requirementGroup("Chapter II Principles",
+			FrameworkRequirement{ID: "Art.5", Title: "Principles relating to processing of personal data"},
+			FrameworkRequirement{ID: "Art.6", Title: "Lawfulness of processing"},
+			FrameworkRequirement{ID: "Art.7", Title: "Conditions for consent"},
+			FrameworkRequirement{ID: "Art.8", Title: "Conditions applicable to child's consent in relation to information society services"},
+			FrameworkRequirement{ID: "Art.9", Title: "Processing of special categories of personal data"},
+			FrameworkRequirement{ID: "Art.10", Title: "Processing of personal data relating to criminal convictions and offences"},
+		),
+		requirementGroup("Chapter III Rights of the data subject",
+			FrameworkRequirement{ID: "Art.12", Title: "Transparent information, communication and modalities for the exercise of the rights of the data subject"},
+			FrameworkRequirement{ID: "Art.13", Title: "Information to be provided where personal data are collected from the data subject"},
+			FrameworkRequirement{ID: "Art.14", Title: "Information to be provided where personal data have not been obtained from the data subject"},
+			FrameworkRequirement{ID: "Art.15", Title: "Right of access by the data subject"},
+			FrameworkRequirement{ID: "Art.16", Title: "Right to rectification"},
+			FrameworkRequirement{ID: "Art.17", Title: "Right to erasure ('right to be forgotten')"},
+			FrameworkRequirement{ID: "Art.18", Title: "Right to restriction of processing"},
+			FrameworkRequirement{ID: "Art.19", Title: "Notification obligation regarding rectification or erasure of personal data or restriction of processing"},
+			FrameworkRequirement{ID: "Art.20", Title: "Right to data portability"},
+			FrameworkRequirement{ID: "Art.21", Title: "Right to object"},
+			FrameworkRequirement{ID: "Art.22", Title: "Automated individual decision-making, including profiling"},
+		),
+		requirementGroup("Chapter IV Controller and processor",
+			FrameworkRequirement{ID: "Art.24", Title: "Responsibility of the controller"},
+			FrameworkRequirement{ID: "Art.25", Title: "Data protection by design and by default"},
+			FrameworkRequirement{ID: "Art.26", Title: "Joint controllers"},
+			FrameworkRequirement{ID: "Art.27", Title: "Representatives of controllers or processors not established in the Union"},
+			FrameworkRequirement{ID: "Art.28", Title: "Processor"},
+			FrameworkRequirement{ID: "Art.29", Title: "Processing under the authority of the controller or processor"},
+			FrameworkRequirement{ID: "Art.30", Title: "Records of processing activities"},
+			FrameworkRequirement{ID: "Art.31", Title: "Cooperation with the supervisory authority"},
+			FrameworkRequirement{ID: "Art.32", Title: "Security of processing"},
+			FrameworkRequirement{ID: "Art.33", Title: "Notification of a personal data breach to the supervisory authority"},
+			FrameworkRequirement{ID: "Art.34", Title: "Communication of a personal data breach to the data subject"},
+			FrameworkRequirement{ID: "Art.35", Title: "Data protection impact assessment"},
+			FrameworkRequirement{ID: "Art.36", Title: "Prior consultation"},
+			FrameworkRequirement{ID: "Art.37", Title: "Designation of the data protection officer"},
+			FrameworkRequirement{ID: "Art.38", Title: "Position of the data protection officer"},
+			FrameworkRequirement{ID: "Art.39", Title: "Tasks of the data protection officer"},
+		),
+		requirementGroup("Chapter V Transfers to third countries",
+			FrameworkRequirement{ID: "Art.44", Title: "General principle for transfers"},
+			FrameworkRequirement{ID: "Art.45", Title: "Transfers on the basis of an adequacy decision"},
+			FrameworkRequirement{ID: "Art.46", Title: "Transfers subject to appropriate safeguards"},
+			FrameworkRequirement{ID: "Art.47", Title: "Binding corporate rules"},
+			FrameworkRequirement{ID: "Art.49", Title: "Derogations for specific situations"},
+		),
+	)
+}
+
+func iso27001Requirements() []FrameworkRequirement {
+	return concatRequirements(
+		requirementGroup("Organizational controls",
+			FrameworkRequirement{ID: "A.5.1", Title: "Policies for information security"},
+			FrameworkRequirement{ID: "A.5.2", Title: "Information security roles and responsibilities"},
+			FrameworkRequirement{ID: "A.5.3", Title: "Segregation of duties"},
+			FrameworkRequirement{ID: "A.5.4", Title: "Management responsibilities"},
+			FrameworkRequirement{ID: "A.5.5", Title: "Contact with authorities"},
+			FrameworkRequirement{ID: "A.5.6", Title: "Contact with special interest groups"},
+			FrameworkRequirement{ID: "A.5.7", Title: "Threat intelligence"},
+			FrameworkRequirement{ID: "A.5.8", Title: "Information security in project management"},
+			FrameworkRequirement{ID: "A.5.9", Title: "Inventory of information and other associated assets"},
+			FrameworkRequirement{ID: "A.5.10", Title: "Acceptable use of information and other associated assets"},
+			FrameworkRequirement{ID: "A.5.11", Title: "Return of assets"},
+			FrameworkRequirement{ID: "A.5.12", Title: "Classification of information"},
+			FrameworkRequirement{ID: "A.5.13", Title: "Labelling of information"},
+			FrameworkRequirement{ID: "A.5.14", Title: "Information transfer"},
+			FrameworkRequirement{ID: "A.5.15", Title: "Access control"},
+			FrameworkRequirement{ID: "A.5.16", Title: "Identity management"},
+			FrameworkRequirement{ID: "A.5.17", Title: "Authentication information"},
+			FrameworkRequirement{ID: "A.5.18", Title: "Access rights"},
+			FrameworkRequirement{ID: "A.5.19", Title: "Information security in supplier relationships"},
+			FrameworkRequirement{ID: "A.5.20", Title: "Addressing information security within supplier agreements"},
+			FrameworkRequirement{ID: "A.5.21", Title: "Managing information security in the ICT supply chain"},
+			FrameworkRequirement{ID: "A.5.22", Title: "Monitoring, review and change management of supplier services"},
+			FrameworkRequirement{ID: "A.5.23", Title: "Information security for use of cloud services"},
+			FrameworkRequirement{ID: "A.5.24", Title: "Information security incident management planning and preparation"},
+			FrameworkRequirement{ID: "A.5.25", Title: "Assessment and decision on information security events"},
+			FrameworkRequirement{ID: "A.5.26", Title: "Response to information security incidents"},
+			FrameworkRequirement{ID: "A.5.27", Title: "Learning from information security incidents"},
+			FrameworkRequirement{ID: "A.5.28", Title: "Collection of evidence"},
+			FrameworkRequirement{ID: "A.5.29", Title: "Information security during disruption"},
+			FrameworkRequirement{ID: "A.5.30", Title: "ICT readiness for business continuity"},
+			FrameworkRequirement{ID: "A.5.31", Title: "Legal, statutory, regulatory and contractual requirements"},
+			FrameworkRequirement{ID: "A.5.32", Title: "Intellectual property rights"},
+			FrameworkRequirement{ID: "A.5.33", Title: "Protection of records"},
+			FrameworkRequirement{ID: "A.5.34", Title: "Privacy and protection of PII"},
+			FrameworkRequirement{ID: "A.5.35", Title: "Independent review of information security"},
+			FrameworkRequirement{ID: "A.5.36", Title: "Compliance with policies, rules and standards for information security"},
+			FrameworkRequirement{ID: "A.5.37", Title: "Documented operating procedures"},
+		),
+		requirementGroup("People controls",
+			FrameworkRequirement{ID: "A.6.1", Title: "Screening"},
+			FrameworkRequirement{ID: "A.6.2", Title: "Terms and conditions of employment"},
+			FrameworkRequirement{ID: "A.6.3", Title: "Information security awareness, education and training"},
+			FrameworkRequirement{ID: "A.6.4", Title: "Disciplinary process"},
+			FrameworkRequirement{ID: "A.6.5", Title: "Responsibilities after termination or change of employment"},
+			FrameworkRequirement{ID: "A.6.6", Title: "Confidentiality or non-disclosure agreements"},
+			FrameworkRequirement{ID: "A.6.7", Title: "Remote working"},
+			FrameworkRequirement{ID: "A.6.8", Title: "Information security event reporting"},
+		),
+		requirementGroup("Physical controls",
+			FrameworkRequirement{ID: "A.7.1", Title: "Physical security perimeters"},
+			FrameworkRequirement{ID: "A.7.2", Title: "Physical entry"},
+			FrameworkRequirement{ID: "A.7.3", Title: "Securing offices, rooms and facilities"},
+			FrameworkRequirement{ID: "A.7.4", Title: "Physical security monitoring"},
+			FrameworkRequirement{ID: "A.7.5", Title: "Protecting against physical and environmental threats"},
+			FrameworkRequirement{ID: "A.7.6", Title: "Working in secure areas"},
+			FrameworkRequirement{ID: "A.7.7", Title: "Clear desk and clear screen"},
+			FrameworkRequirement{ID: "A.7.8", Title: "Equipment siting and protection"},
+			FrameworkRequirement{ID: "A.7.9", Title: "Security of assets off-premises"},
+			FrameworkRequirement{ID: "A.7.10", Title: "Storage media"},
+			FrameworkRequirement{ID: "A.7.11", Title: "Supporting utilities"},
+			FrameworkRequirement{ID: "A.7.12", Title: "Cabling security"},
+			FrameworkRequirement{ID: "A.7.13", Title: "Equipment maintenance"},
+			FrameworkRequirement{ID: "A.7.14", Title: "Secure disposal or re-use of equipment"},
+		),
+		requirementGroup("Technological controls",
+			FrameworkRequirement{ID: "A.8.1", Title: "User endpoint devices"},
+			FrameworkRequirement{ID: "A.8.2", Title: "Privileged access rights"},
+			FrameworkRequirement{ID: "A.8.3", Title: "Information access restriction"},
+			FrameworkRequirement{ID: "A.8.4", Title: "Access to source code"},
+			FrameworkRequirement{ID: "A.8.5", Title: "Secure authentication"},
+			FrameworkRequirement{ID: "A.8.6", Title: "Capacity management"},
+			FrameworkRequirement{ID: "A.8.7", Title: "Protection against malware"},
+			FrameworkRequirement{ID: "A.8.8", Title: "Management of technical vulnerabilities"},
+			FrameworkRequirement{ID: "A.8.9", Title: "Configuration management"},
+			FrameworkRequirement{ID: "A.8.10", Title: "Information deletion"},
+			FrameworkRequirement{ID: "A.8.11", Title: "Data masking"},
+			FrameworkRequirement{ID: "A.8.12", Title: "Data leakage prevention"},
+			FrameworkRequirement{ID: "A.8.13", Title: "Information backup"},
+			FrameworkRequirement{ID: "A.8.14", Title: "Redundancy of information processing facilities"},
+			FrameworkRequirement{ID: "A.8.15", Title: "Logging"},
+			FrameworkRequirement{ID: "A.8.16", Title: "Monitoring activities"},
+			FrameworkRequirement{ID: "A.8.17", Title: "Clock synchronization"},
+			FrameworkRequirement{ID: "A.8.18", Title: "Use of privileged utility programs"},
+			FrameworkRequirement{ID: "A.8.19", Title: "Installation of software on operational systems"},
+			FrameworkRequirement{ID: "A.8.20", Title: "Networks security"},
+			FrameworkRequirement{ID: "A.8.21", Title: "Security of network services"},
+			FrameworkRequirement{ID: "A.8.22", Title: "Segregation of networks"},
+			FrameworkRequirement{ID: "A.8.23", Title: "Web filtering"},
+			FrameworkRequirement{ID: "A.8.24", Title: "Use of cryptography"},
+			FrameworkRequirement{ID: "A.8.25", Title: "Secure development life cycle"},
+			FrameworkRequirement{ID: "A.8.26", Title: "Application security requirements"},
+			FrameworkRequirement{ID: "A.8.27", Title: "Secure system architecture and engineering principles"},
+			FrameworkRequirement{ID: "A.8.28", Title: "Secure coding"},
+			FrameworkRequirement{ID: "A.8.29", Title: "Security testing in development and acceptance"},
+			FrameworkRequirement{ID: "A.8.30", Title: "Outsourced development"},
+			FrameworkRequirement{ID: "A.8.31", Title: "Separation of development, test and production environments"},
+			FrameworkRequirement{ID: "A.8.32", Title: "Change management"},
+			FrameworkRequirement{ID: "A.8.33", Title: "Test information"},
+			FrameworkRequirement{ID: "A.8.34", Title: "Protection of information systems during audit testing"},
+		),
+	)
+}
+
+func soc2Requirements() []FrameworkRequirement {
+	return concatRequirements(
+		requirementGroup("Security",
+			FrameworkRequirement{ID: "CC1.1", Title: "Commitment to integrity and ethical values"},
+			FrameworkRequirement{ID: "CC1.2", Title: "Board independence and oversight of internal control"},
+			FrameworkRequirement{ID: "CC1.3", Title: "Structures, reporting lines, authorities and responsibilities"},
+			FrameworkRequirement{ID: "CC1.4", Title: "Commitment to attract, develop and retain competent individuals"},
+			FrameworkRequirement{ID: "CC1.5", Title: "Accountability for internal control responsibilities"},
+			FrameworkRequirement{ID: "CC2.1", Title: "Quality information to support internal control"},
+			FrameworkRequirement{ID: "CC2.2", Title: "Internal communication of internal control information"},
+			FrameworkRequirement{ID: "CC2.3", Title: "Communication with external parties"},
+			FrameworkRequirement{ID: "CC3.1", Title: "Objectives specified to identify and assess risks"},
+			FrameworkRequirement{ID: "CC3.2", Title: "Identification and analysis of risks"},
+			FrameworkRequirement{ID: "CC3.3", Title: "Consideration of the potential for fraud"},
+			FrameworkRequirement{ID: "CC3.4", Title: "Identification and assessment of significant changes"},
+			FrameworkRequirement{ID: "CC4.1", Title: "Ongoing and separate evaluations of internal control"},
+			FrameworkRequirement{ID: "CC4.2", Title: "Evaluation and communication of internal control deficiencies"},
+			FrameworkRequirement{ID: "CC5.1", Title: "Control activities that mitigate risks"},
+			FrameworkRequirement{ID: "CC5.2", Title: "General control activities over technology"},
+			FrameworkRequirement{ID: "CC5.3", Title: "Control activities deployed through policies and procedures"},
+			FrameworkRequirement{ID: "CC6.1", Title: "Logical access security over protected information assets"},
+			FrameworkRequirement{ID: "CC6.2", Title: "Registration and authorization of new users"},
+			FrameworkRequirement{ID: "CC6.3", Title: "Role-based access with least privilege and segregation of duties"},
+			FrameworkRequirement{ID: "CC6.4", Title: "Restriction of physical access to facilities and protected assets"},
+			FrameworkRequirement{ID: "CC6.5", Title: "Discontinuation of protections over disposed assets"},
+			FrameworkRequirement{ID: "CC6.6", Title: "Protection against threats from sources outside system boundaries"},
+			FrameworkRequirement{ID: "CC6.7", Title: "Restriction of the transmission, movement and removal of information"},
+			FrameworkRequirement{ID: "CC6.8", Title: "Prevention and detection of unauthorized or malicious software"},
+			FrameworkRequirement{ID: "CC7.1", Title: "Detection of configuration changes and new vulnerabilities"},
+			FrameworkRequirement{ID: "CC7.2", Title: "Monitoring of system components for anomalies"},
+			FrameworkRequirement{ID: "CC7.3", Title: "Evaluation of security events"},
+			FrameworkRequirement{ID: "CC7.4", Title: "Response to identified security incidents"},
+			FrameworkRequirement{ID: "CC7.5", Title: "Recovery from identified security incidents"},
+			FrameworkRequirement{ID: "CC8.1", Title: "Authorization, testing and approval of changes"},
+			FrameworkRequirement{ID: "CC9.1", Title: "Risk mitigation for business disruptions"},
+			FrameworkRequirement{ID: "CC9.2", Title: "Management of vendor and business partner risks"},
+		),
+		requirementGroup("Availability",
+			FrameworkRequirement{ID: "A1.1", Title: "Capacity management to meet availability objectives"},
+			FrameworkRequirement{ID: "A1.2", Title: "Environmental protections, backup and recovery infrastructure"},
+			FrameworkRequirement{ID: "A1.3", Title: "Testing of recovery plan procedures"},
+		),
+		requirementGroup("Confidentiality",
+			FrameworkRequirement{ID: "C1.1", Title: "Identification and maintenance of confidential information"},
+			FrameworkRequirement{ID: "C1.2", Title: "Disposal of confidential information"},
+		),
+		requirementGroup("Processing Integrity",
+			FrameworkRequirement{ID: "PI1.1", Title: "Quality information about processing objectives and specifications"},
+			FrameworkRequirement{ID: "PI1.2", Title: "Policies and procedures over system inputs"},
+			FrameworkRequirement{ID: "PI1.3", Title: "Policies and procedures over system processing"},
+			FrameworkRequirement{ID: "PI1.4", Title: "Policies and procedures over system outputs"},
+			FrameworkRequirement{ID: "PI1.5", Title: "Policies and procedures over the storage of inputs, items in processing and outputs"},
+		),
+		requirementGroup("Privacy",
+			FrameworkRequirement{ID: "P1.1", Title: "Notice of privacy practices"},
+			FrameworkRequirement{ID: "P2.1", Title: "Choice and consent"},
+			FrameworkRequirement{ID: "P3.1", Title: "Collection of personal information consistent with objectives"},
+			FrameworkRequirement{ID: "P3.2", Title: "Explicit consent for sensitive personal information"},
+			FrameworkRequirement{ID: "P4.1", Title: "Use of personal information"},
+			FrameworkRequirement{ID: "P4.2", Title: "Retention of personal information"},
+			FrameworkRequirement{ID: "P4.3", Title: "Disposal of personal information"},
+			FrameworkRequirement{ID: "P5.1", Title: "Access to personal information by data subjects"},
+			FrameworkRequirement{ID: "P5.2", Title: "Correction of personal information"},
+			FrameworkRequirement{ID: "P6.1", Title: "Disclosure of personal information to third parties"},
+			FrameworkRequirement{ID: "P6.2", Title: "Record of authorized disclosures"},
+			FrameworkRequirement{ID: "P6.3", Title: "Record of unauthorized disclosures"},
+			FrameworkRequirement{ID: "P6.4", Title: "Privacy commitments of third parties"},
+			FrameworkRequirement{ID: "P6.5", Title: "Notification of unauthorized disclosures by third parties"},
+			FrameworkRequirement{ID: "P6.6", Title: "Notification of breaches and incidents"},
+			FrameworkRequirement{ID: "P6.7", Title: "Accounting of personal information held and disclosed"},
+			FrameworkRequirement{ID: "P7.1", Title: "Quality of personal information"},
+			FrameworkRequirement{ID: "P8.1", Title: "Inquiries, complaints and disputes"},
+		),
+	)
+}
+
+func nistCSFRequirements() []FrameworkRequirement {
+	return concatRequirements(
+		requirementGroup("Govern",
+			FrameworkRequirement{ID: "GV.OC", Title: "Organizational Context"},
+			FrameworkRequirement{ID: "GV.RM", Title: "Risk Management Strategy"},
+			FrameworkRequirement{ID: "GV.RR", Title: "Roles, Responsibilities, and Authorities"},
+			FrameworkRequirement{ID: "GV.PO", Title: "Policy"},
+			FrameworkRequirement{ID: "GV.OV", Title: "Oversight"},
+			FrameworkRequirement{ID: "GV.SC", Title: "Cybersecurity Supply Chain Risk Management"},
+		),
+		requirementGroup("Identify",
+			FrameworkRequirement{ID: "ID.AM", Title: "Asset Management"},
+			FrameworkRequirement{ID: "ID.RA", Title: "Risk Assessment"},
+			FrameworkRequirement{ID: "ID.IM", Title: "Improvement"},
+		),
+		requirementGroup("Protect",
+			FrameworkRequirement{ID: "PR.AA", Title: "Identity Management, Authentication, and Access Control"},
+			FrameworkRequirement{ID: "PR.AT", Title: "Awareness and Training"},
+			FrameworkRequirement{ID: "PR.DS", Title: "Data Security"},
+			FrameworkRequirement{ID: "PR.PS", Title: "Platform Security"},
+			FrameworkRequirement{ID: "PR.IR", Title: "Technology Infrastructure Resilience"},
+		),
+		requirementGroup("Detect",
+			FrameworkRequirement{ID: "DE.CM", Title: "Continuous Monitoring"},
+			FrameworkRequirement{ID: "DE.AE", Title: "Adverse Event Analysis"},
+		),
+		requirementGroup("Respond",
+			FrameworkRequirement{ID: "RS.MA", Title: "Incident Management"},
+			FrameworkRequirement{ID: "RS.AN", Title: "Incident Analysis"},
+			FrameworkRequirement{ID: "RS.CO", Title: "Incident Response Reporting and Communication"},
+			FrameworkRequirement{ID: "RS.MI", Title: "Incident Mitigation"},
+		),
+		requirementGroup("Recover",
+			FrameworkRequirement{ID: "RC.RP", Title: "Incident Recovery Plan Execution"},
+			FrameworkRequirement{ID: "RC.CO", Title: "Incident Recovery Communication"},
+		),
+	)
+}
@@ -0,0 +1,223 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Validate ensures the compliance status is known
+func (s ComplianceStatus) Validate() error {
+	switch s {
+	case ComplianceCompliant, ComplianceNonCompliant, CompliancePartial, ComplianceUnderReview:
+		return nil
+	}
+	return fmt.Errorf("unknown compliance status %q", s)
+}
+
+// ViolationSeverity returns how severe a violation of a requirement of the kind is: critical for
+// legal requirements, high for contractual ones and medium for industry standards
+func (k RequirementKind) ViolationSeverity() string {
+	switch k {
+	case RequirementLegal:
+		return "critical"
+	case RequirementContractual:
+		return "high"
+	}
+	return "medium"
+}
+
+// RequirementStatus is the compliance status of one conformance requirement
+type RequirementStatus struct {
+	Requirement RequirementRef
+	Status      ComplianceStatus
+}
+
+// ComplianceSnapshot records the status of an agreement's conformance requirements when
+// compliance was monitored
+type ComplianceSnapshot struct {
+	Requirements []RequirementStatus
+	TakenAt      time.Time
+}
+
+// TakeComplianceSnapshot records the current status of the conformance requirements
+func TakeComplianceSnapshot(conformance Conformance, at time.Time) ComplianceSnapshot {
+	snapshot := ComplianceSnapshot{Requirements: []RequirementStatus{}, TakenAt: at}
+	for _, requirement := range conformanceRequirements(conformance) {
+		snapshot.Requirements = append(snapshot.Requirements, RequirementStatus{
+			Requirement: requirement.Requirement,
+			Status:      requirement.Status,
+		})
+	}
+	return snapshot
+}
+
+// ComplianceChange is a conformance requirement whose status changed since compliance was last
+// monitored
+type ComplianceChange struct {
+	Requirement RequirementRef
+	Description string
+	Previous    ComplianceStatus
+	Current     ComplianceStatus
+}
+
+// Violation reports whether the requirement went from compliant to non-compliant
+func (c ComplianceChange) Violation() bool {
+	return c.Previous == ComplianceCompliant && c.Current == ComplianceNonCompliant
+}
+
+// ComplianceDriftReport compares an agreement's conformance requirements with their status when
+// compliance was last monitored
+type ComplianceDriftReport struct {
+	AgreementID   GovernanceAgreementID
+	ApplicationID ApplicationID
+	Changes       []ComplianceChange // requirements whose status changed
+	Violations    []ComplianceChange // changes from compliant to non-compliant
+	Added         []RequirementRef   // requirements not monitored before
+	Removed       []RequirementRef   // monitored requirements the agreement no longer names
+	BaselineAt    time.Time          // when compliance was last monitored, zero on the first run
+	CheckedAt     time.Time
+}
+
+// HasDrift reports whether any requirement changed, was added or was removed
+func (r ComplianceDriftReport) HasDrift() bool {
+	return len(r.Changes) > 0 || len(r.Added) > 0 || len(r.Removed) > 0
+}
+
+// BuildComplianceDriftReport compares the agreement's conformance requirements with the status
+// recorded in its compliance baseline. Without a baseline nothing has drifted.
+func BuildComplianceDriftReport(agreement GovernanceAgreement, at time.Time) ComplianceDriftReport {
+	baseline := agreement.Monitor.ComplianceBaseline
+	report := ComplianceDriftReport{
+		AgreementID:   agreement.ID,
+		ApplicationID: agreement.ApplicationID,
+		Changes:       []ComplianceChange{},
+		Violations:    []ComplianceChange{},
+		Added:         []RequirementRef{},
+		Removed:       []RequirementRef{},
+		BaselineAt:    baseline.TakenAt,
+		CheckedAt:     at,
+	}
+	if baseline.TakenAt.IsZero() {
+		return report
+	}
+
+	previous := make(map[RequirementRef]ComplianceStatus, len(baseline.Requirements))
+	for _, requirement := range baseline.Requirements {
+		previous[requirement.Requirement] = requirement.Status
+	}
+
+	current := make(map[RequirementRef]bool)
+	for _, requirement := range conformanceRequirements(agreement.Conformance) {
+		current[requirement.Requirement] = true
+		status, ok := previous[requirement.Requirement]
+		if !ok {
+			report.Added = append(report.Added, requirement.Requirement)
+			continue
+		}
+		if status == requirement.Status {
+			continue
+		}
+
+		change := ComplianceChange{
+			Requirement: requirement.Requirement,
+			Description: requirement.Description,
+			Previous:    status,
+			Current:     requirement.Status,
+		}
+		report.Changes = append(report.Changes, change)
+		if change.Violation() {
+			report.Violations = append(report.Violations, change)
+		}
+	}
+
+	for _, requirement := range baseline.Requirements {
+		if !current[requirement.Requirement] {
+			report.Removed = append(report.Removed, requirement.Requirement)
+		}
+	}
+	return report
+}
+
+// DetectComplianceDrift compares the agreement's conformance requirements with their status when
+// compliance was last monitored, then makes the current status the baseline of the next run
+func (s *MonitoringService) DetectComplianceDrift(ctx context.Context, agreementID GovernanceAgreementID) (*ComplianceDriftReport, error) {
+	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+
+	now := time.Now()
+	report := BuildComplianceDriftReport(agreement, now)
+	agreement.Monitor.ComplianceBaseline = TakeComplianceSnapshot(agreement.Conformance, now)
+
+	err = s.agreementRepo.Update(ctx, agreement)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update governance agreement: %w", err)
+	}
+	return &report, nil
+}
+
+// RecordComplianceStatus sets the compliance status of one of the agreement's conformance
+// requirements
+func (s *MonitoringService) RecordComplianceStatus(ctx context.Context, agreementID GovernanceAgreementID, ref RequirementRef, status ComplianceStatus) error {
+	if err := status.Validate(); err != nil {
+		return err
+	}
+
+	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
+	if err != nil {
+		return fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+
+	if !setComplianceStatus(&agreement.Conformance, ref, status) {
+		return fmt.Errorf("%s requirement not found in agreement %s", ref, agreementID)
+	}
+
+	err = s.agreementRepo.Update(ctx, agreement)
+	if err != nil {
+		return fmt.Errorf("failed to update governance agreement: %w", err)
+	}
+
+	// Requirements not registered yet are registered with the rest of the agreement's conformance
+	if s.complianceRepo != nil {
+		if err := s.complianceRepo.UpdateComplianceStatus(ctx, agreement.ApplicationID, ref, status); err != nil {
+			return RegisterConformance(ctx, s.complianceRepo, agreement.ApplicationID, agreement.Conformance)
+		}
+	}
+	return nil
+}
+
+// setComplianceStatus sets the status of the requirement the conformance component names,
+// copying the requirement lists so the component's previous value is left as it was. It
+// reports whether the component names the requirement.
+func setComplianceStatus(conformance *Conformance, ref RequirementRef, status ComplianceStatus) bool {
+	found := false
+	switch ref.Kind {
+	case RequirementLegal:
+		conformance.LegalRequirements = append([]LegalRequirement{}, conformance.LegalRequirements...)
+		for i := range conformance.LegalRequirements {
+			if conformance.LegalRequirements[i].Name == ref.Name {
+				conformance.LegalRequirements[i].Status = status
+				found = true
+			}
+		}
+	case RequirementContractual:
+		conformance.ContractualRequirements = append([]ContractualRequirement{}, conformance.ContractualRequirements...)
+		for i := range conformance.ContractualRequirements {
+			if conformance.ContractualRequirements[i].Name == ref.Name {
+				conformance.ContractualRequirements[i].Status = status
+				found = true
+			}
+		}
+	case RequirementIndustryStandard:
+		conformance.IndustryStandards = append([]IndustryStandard{}, conformance.IndustryStandards...)
+		for i := range conformance.IndustryStandards {
+			if conformance.IndustryStandards[i].Name == ref.Name {
+				conformance.IndustryStandards[i].Status = status
+				found = true
+			}
+		}
+	}
+	return found
+}
@@ -0,0 +1,170 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// ComplianceFramework is a catalog of the requirements of a regulation or standard, such as GDPR
+// or ISO/IEC 27001:2022, that can be attached to governance agreements instead of writing every
+// legal requirement or industry standard entry by hand. Regulations are attached as legal
+// requirements and standards as industry standards.
+type ComplianceFramework struct {
+	ID            string                 `json:"id"`
+	Name          string                 `json:"name"`   // prefixes the name of every requirement attached, e.g. "ISO/IEC 27001:2022"
+	Kind          RequirementKind        `json:"kind"`   // legal or industry_standard
+	Issuer        string                 `json:"issuer"` // authority of a regulation, organization of a standard
+	Version       string                 `json:"version"`
+	EffectiveDate time.Time              `json:"effective_date"` // optional, when a regulation applies from
+	Description   string                 `json:"description"`
+	Requirements  []FrameworkRequirement `json:"requirements"`
+}
+
+// FrameworkRequirement is one article, control or category of a compliance framework
+type FrameworkRequirement struct {
+	ID     string  `json:"id"` // e.g. "A.8.13", "Art.32" or "PR.AA"
+	Title  string  `json:"title"`
+	Group  string  `json:"group"`  // the chapter, theme or function the requirement belongs to
+	Weight float64 `json:"weight"` // weight in compliance assessment scores, 1 when zero
+}
+
+// ScoringWeight returns the weight the requirement carries in compliance assessment scores
+func (r FrameworkRequirement) ScoringWeight() float64 {
+	if r.Weight > 0 {
+		return r.Weight
+	}
+	return 1
+}
+
+// RequirementName returns the name a requirement of the framework is attached to agreements
+// under, e.g. "ISO/IEC 27001:2022 A.8.13"
+func (f ComplianceFramework) RequirementName(requirement FrameworkRequirement) string {
+	return f.Name + " " + requirement.ID
+}
+
+// Validate ensures the framework is named, is a regulation or a standard, and has requirements
+// with unique IDs and titles
+func (f ComplianceFramework) Validate() error {
+	if f.ID == "" || f.Name == "" {
+		return fmt.Errorf("compliance framework %q requires an ID and a name", f.ID)
+	}
+	switch f.Kind {
+	case RequirementLegal, RequirementIndustryStandard:
+	default:
+		return fmt.Errorf("compliance framework %s: kind must be %s or %s", f.ID, RequirementLegal, RequirementIndustryStandard)
+	}
+	if len(f.Requirements) == 0 {
+		return fmt.Errorf("compliance framework %s has no requirements", f.ID)
+	}
+	seen := make(map[string]bool, len(f.Requirements))
+	for _, requirement := range f.Requirements {
+		if requirement.ID == "" || requirement.Title == "" {
+			return fmt.Errorf("compliance framework %s: requirements require an ID and a title", f.ID)
+		}
+		if requirement.Weight < 0 {
+			return fmt.Errorf("compliance framework %s: weight of %s must not be negative", f.ID, requirement.ID)
+		}
+		if seen[requirement.ID] {
+			return fmt.Errorf("compliance framework %s: duplicate requirement %s", f.ID, requirement.ID)
+		}
+		seen[requirement.ID] = true
+	}
+	return nil
+}
+
+// Select returns the requirements matching the selectors, in catalog order. A selector is a
+// requirement ID or the prefix of a family of requirements: "A.8", "A.8.x" and "A.8.*" all select
+// A.8.1 to A.8.34. No selector selects every requirement.
+func (f ComplianceFramework) Select(selectors []string) ([]FrameworkRequirement, error) {
+	if len(selectors) == 0 {
+		return append([]FrameworkRequirement{}, f.Requirements...), nil
+	}
+
+	selected := make(map[string]bool)
+	for _, selector := range selectors {
+		prefix := strings.TrimSpace(selector)
+		for _, wildcard := range []string{".x", ".*", "*"} {
+			prefix = strings.TrimSuffix(prefix, wildcard)
+		}
+		matched := false
+		for _, requirement := range f.Requirements {
+			if prefix == "" || requirement.ID == prefix || strings.HasPrefix(requirement.ID, prefix+".") {
+				selected[requirement.ID] = true
+				matched = true
+			}
+		}
+		if !matched {
+			return nil, fmt.Errorf("compliance framework %s has no requirement %s", f.ID, selector)
+		}
+	}
+
+	var requirements []FrameworkRequirement
+	for _, requirement := range f.Requirements {
+		if selected[requirement.ID] {
+			requirements = append(requirements, requirement)
+		}
+	}
+	return requirements, nil
+}
+
+// AttachTo adds the requirements to the conformance component as legal requirements or industry
+// standards under review, and returns it with the references of those added. Requirements the
+// component already names are left as they are.
+func (f ComplianceFramework) AttachTo(conformance Conformance, requirements []FrameworkRequirement) (Conformance, []RequirementRef) {
+	conformance.LegalRequirements = append([]LegalRequirement{}, conformance.LegalRequirements...)
+	conformance.IndustryStandards = append([]IndustryStandard{}, conformance.IndustryStandards...)
+
+	var attached []RequirementRef
+	for _, requirement := range requirements {
+		ref := RequirementRef{Kind: f.Kind, Name: f.RequirementName(requirement)}
+		if hasRequirement(conformance, ref) {
+			continue
+		}
+
+		description := requirement.Title
+		if requirement.Group != "" {
+			description = fmt.Sprintf("%s (%s)", requirement.Title, requirement.Group)
+		}
+		if f.Kind == RequirementLegal {
+			conformance.LegalRequirements = append(conformance.LegalRequirements, LegalRequirement{
+				Name:          ref.Name,
+				Description:   description,
+				Authority:     f.Issuer,
+				EffectiveDate: f.EffectiveDate,
+				Status:        ComplianceUnderReview,
+			})
+		} else {
+			conformance.IndustryStandards = append(conformance.IndustryStandards, IndustryStandard{
+				Name:         ref.Name,
+				Description:  description,
+				Organization: f.Issuer,
+				Version:      f.Version,
+				Status:       ComplianceUnderReview,
+			})
+		}
+		attached = append(attached, ref)
+	}
+	return conformance, attached
+}
+
+// LoadComplianceFrameworks reads a JSON array of compliance frameworks
+func LoadComplianceFrameworks(r io.Reader) ([]ComplianceFramework, error) {
+	var frameworks []ComplianceFramework
+	if err := json.NewDecoder(r).Decode(&frameworks); err != nil {
+		return nil, fmt.Errorf("failed to decode compliance frameworks: %w", err)
+	}
+	seen := make(map[string]bool, len(frameworks))
+	for _, framework := range frameworks {
+		if err := framework.Validate(); err != nil {
+			return nil, err
+		}
+		if seen[framework.ID] {
+			return nil, fmt.Errorf("duplicate compliance framework %s", framework.ID)
+		}
+		seen[framework.ID] = true
+	}
+	return frameworks, nil
+}
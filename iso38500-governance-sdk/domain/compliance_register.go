@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// RegisterConformance records the requirements of the conformance component in the compliance
+// register as the application's, removing those the component no longer names
+func RegisterConformance(ctx context.Context, repo ComplianceRepository, appID ApplicationID, conformance Conformance) error {
+	registered, err := LoadConformance(ctx, repo, appID)
+	if err != nil {
+		return err
+	}
+	current := make(map[RequirementRef]bool)
+	for _, requirement := range conformanceRequirements(conformance) {
+		current[requirement.Requirement] = true
+	}
+	for _, requirement := range conformanceRequirements(registered) {
+		if current[requirement.Requirement] {
+			continue
+		}
+		if err := repo.DeleteRequirement(ctx, appID, requirement.Requirement); err != nil {
+			return fmt.Errorf("failed to remove %s from the compliance register: %w", requirement.Requirement, err)
+		}
+	}
+
+	for _, requirement := range conformance.LegalRequirements {
+		if err := repo.SaveLegalRequirement(ctx, appID, requirement); err != nil {
+			return fmt.Errorf("failed to register legal requirement %s: %w", requirement.Name, err)
+		}
+	}
+	for _, requirement := range conformance.ContractualRequirements {
+		if err := repo.SaveContractualRequirement(ctx, appID, requirement); err != nil {
+			return fmt.Errorf("failed to register contractual requirement %s: %w", requirement.Name, err)
+		}
+	}
+	for _, standard := range conformance.IndustryStandards {
+		if err := repo.SaveIndustryStandard(ctx, appID, standard); err != nil {
+			return fmt.Errorf("failed to register industry standard %s: %w", standard.Name, err)
+		}
+	}
+	return nil
+}
+
+// LoadConformance gathers the requirements the compliance register holds for the application
+// into a conformance component
+func LoadConformance(ctx context.Context, repo ComplianceRepository, appID ApplicationID) (Conformance, error) {
+	var conformance Conformance
+	var err error
+	conformance.LegalRequirements, err = repo.FindLegalRequirements(ctx, appID)
+	if err != nil {
+		return Conformance{}, fmt.Errorf("failed to load legal requirements: %w", err)
+	}
+	conformance.ContractualRequirements, err = repo.FindContractualRequirements(ctx, appID)
+	if err != nil {
+		return Conformance{}, fmt.Errorf("failed to load contractual requirements: %w", err)
+	}
+	conformance.IndustryStandards, err = repo.FindIndustryStandards(ctx, appID)
+	if err != nil {
+		return Conformance{}, fmt.Errorf("failed to load industry standards: %w", err)
+	}
+	return conformance, nil
+}
+
+// WithComplianceRepository keeps the compliance register in step with the conformance of the
+// agreements monitored, so the requirements each application is held to can be queried without
+// loading its agreement
+func WithComplianceRepository(repo ComplianceRepository) MonitoringOption {
+	return func(s *MonitoringService) {
+		s.complianceRepo = repo
+	}
+}
+
+// SyncComplianceRegister records the conformance requirements of the agreement in the compliance
+// register as those of its application. It does nothing without a compliance repository.
+func (s *MonitoringService) SyncComplianceRegister(ctx context.Context, agreementID GovernanceAgreementID) error {
+	if s.complianceRepo == nil {
+		return nil
+	}
+	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
+	if err != nil {
+		return fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+	return RegisterConformance(ctx, s.complianceRepo, agreement.ApplicationID, agreement.Conformance)
+}
+
+// MonitorApplicationCompliance scores the compliance of the requirements the register holds for
+// an application, nil when it holds none
+func (s *MonitoringService) MonitorApplicationCompliance(ctx context.Context, appID ApplicationID) (*ConformanceScore, error) {
+	if s.complianceRepo == nil {
+		return nil, errors.New("no compliance register is configured")
+	}
+	conformance, err := LoadConformance(ctx, s.complianceRepo, appID)
+	if err != nil {
+		return nil, err
+	}
+	return ScoreConformance(conformance), nil
+}
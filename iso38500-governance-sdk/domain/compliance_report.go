@@ -0,0 +1,156 @@
+package domain
+
+import (
+	"sort"
+	"time"
+)
+
+// ComplianceReportSource is what is recorded of the compliance of an agreement: the agreement,
+// the frameworks and control mappings its requirements come from, the evidence attached for its
+// application and the application's audits
+type ComplianceReportSource struct {
+	Agreement  GovernanceAgreement
+	Frameworks []ComplianceFramework
+	Mappings   []ControlMapping
+	Evidence   []Evidence
+	Audits     []Audit
+}
+
+// OpenFinding is an audit finding on a requirement's controls that is not remediated yet
+type OpenFinding struct {
+	AuditID string
+	Finding AuditFinding
+}
+
+// ReportedRequirement is a requirement of an agreement as it is handed to auditors: its status,
+// the documents implementing it, the evidence of its controls and the findings still open on them
+type ReportedRequirement struct {
+	Requirement RequirementRef
+	Description string
+	Status      ComplianceStatus
+	Criticality Priority
+	Controls    []ControlRef         // framework controls the requirement was attached from
+	Documents   []RequirementMapping // documents in effect implementing it
+	Evidence    []Evidence           // evidence of its controls or of controls mapped to them
+	Findings    []OpenFinding
+}
+
+// Gap reports whether the requirement is not fully met
+func (r ReportedRequirement) Gap() bool {
+	return r.Status == ComplianceNonCompliant || r.Status == CompliancePartial
+}
+
+// AgreementComplianceReport is the compliance of one agreement's requirements
+type AgreementComplianceReport struct {
+	AgreementID   GovernanceAgreementID
+	ApplicationID ApplicationID
+	Title         string
+	Score         *ConformanceScore // nil when the agreement has no requirements
+	Requirements  []ReportedRequirement
+}
+
+// Gaps returns the requirements not fully met, most critical first
+func (r AgreementComplianceReport) Gaps() []ReportedRequirement {
+	var gaps []ReportedRequirement
+	for _, requirement := range r.Requirements {
+		if requirement.Gap() {
+			gaps = append(gaps, requirement)
+		}
+	}
+	sort.SliceStable(gaps, func(i, j int) bool {
+		return requirementWeight(gaps[i].Criticality) > requirementWeight(gaps[j].Criticality)
+	})
+	return gaps
+}
+
+// ComplianceReport is the compliance of an agreement, or of every agreement of a portfolio, as
+// it is handed to an external auditor
+type ComplianceReport struct {
+	Title       string
+	PortfolioID PortfolioID // empty for the report of a single agreement
+	Agreements  []AgreementComplianceReport
+	Compliance  *PortfolioCompliance // nil when no agreement has assessed requirements
+	GeneratedAt time.Time
+}
+
+// BuildAgreementComplianceReport lists the requirements of the agreement with the documents in
+// effect at at implementing them, the evidence of their controls and the open audit findings on
+// them. Evidence and findings apply to a requirement through its own controls and those mapped to
+// them; requirements not attached from a framework have neither.
+func BuildAgreementComplianceReport(source ComplianceReportSource, at time.Time) AgreementComplianceReport {
+	agreement := source.Agreement
+	report := AgreementComplianceReport{
+		AgreementID:   agreement.ID,
+		ApplicationID: agreement.ApplicationID,
+		Title:         agreement.Title,
+		Score:         ScoreConformance(agreement.Conformance),
+	}
+
+	controls := make(map[RequirementRef][]ControlRef)
+	for _, framework := range source.Frameworks {
+		for _, requirement := range framework.Requirements {
+			ref := RequirementRef{Kind: framework.Kind, Name: framework.RequirementName(requirement)}
+			controls[ref] = append(controls[ref], ControlRef{FrameworkID: framework.ID, RequirementID: requirement.ID})
+		}
+	}
+	// concerns reports whether any of the controls, or a control mapped to them, is one of the
+	// requirement's
+	concerns := func(own []ControlRef, others []ControlRef) bool {
+		for _, other := range others {
+			for _, mapped := range MappedControls(source.Mappings, other) {
+				for _, control := range own {
+					if mapped == control {
+						return true
+					}
+				}
+			}
+		}
+		return false
+	}
+
+	coverage := BuildRequirementCoverage(agreement, at)
+	for _, implementation := range coverage.Requirements {
+		requirement := ReportedRequirement{
+			Requirement: implementation.Requirement,
+			Description: implementation.Description,
+			Status:      implementation.Status,
+			Criticality: implementation.Criticality,
+			Controls:    controls[implementation.Requirement],
+			Documents:   implementation.InEffect,
+		}
+		if len(requirement.Controls) > 0 {
+			for _, evidence := range source.Evidence {
+				if concerns(requirement.Controls, evidence.Controls) {
+					requirement.Evidence = append(requirement.Evidence, evidence)
+				}
+			}
+			for _, audit := range source.Audits {
+				if audit.Status == AuditStatusCancelled {
+					continue
+				}
+				for _, finding := range audit.Findings {
+					if !finding.Remediated() && concerns(requirement.Controls, finding.Controls) {
+						requirement.Findings = append(requirement.Findings, OpenFinding{AuditID: audit.ID, Finding: finding})
+					}
+				}
+			}
+		}
+		report.Requirements = append(report.Requirements, requirement)
+	}
+	return report
+}
+
+// BuildComplianceReport gathers the reports of agreements, rolling their scores up
+func BuildComplianceReport(title string, portfolioID PortfolioID, agreements []AgreementComplianceReport, at time.Time) ComplianceReport {
+	scored := make([]ApplicationAssessment, 0, len(agreements))
+	for _, agreement := range agreements {
+		scored = append(scored, ApplicationAssessment{ApplicationID: agreement.ApplicationID, Compliance: agreement.Score})
+	}
+	return ComplianceReport{
+		Title:       title,
+		PortfolioID: portfolioID,
+		Agreements:  agreements,
+		Compliance:  RollUpCompliance(scored, DefaultComplianceRiskThresholds()),
+		GeneratedAt: at,
+	}
+}
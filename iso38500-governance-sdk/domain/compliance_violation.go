@@ -0,0 +1,177 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ViolationRule names the monitored fact a compliance violation is detected from
+type ViolationRule string
+
+const (
+	// ViolationExpiredEvidence is a requirement held as met whose evidence has all expired, such
+	// as a certificate past its validity
+	ViolationExpiredEvidence ViolationRule = "expired_evidence"
+	// ViolationFailedAudit is an audit finding on a requirement's controls not remediated yet
+	ViolationFailedAudit ViolationRule = "failed_audit"
+	// ViolationMissingPolicy is an assessed requirement no policy or other document in effect
+	// implements
+	ViolationMissingPolicy ViolationRule = "missing_policy"
+)
+
+// ComplianceViolation is a conformance requirement the monitored facts show is violated, with a
+// hint on how to remediate it
+type ComplianceViolation struct {
+	ID          string // stable across detections, so a violation is only published once
+	Rule        ViolationRule
+	Requirement RequirementRef
+	Severity    string // critical, high, medium or low
+	Description string
+	Remediation string
+}
+
+// ComplianceViolationReport is what a detection found for an agreement
+type ComplianceViolationReport struct {
+	AgreementID   GovernanceAgreementID
+	ApplicationID ApplicationID
+	Violations    []ComplianceViolation // every violation found, most severe first
+	New           []ComplianceViolation // violations the previous detection did not find
+	Resolved      []string              // IDs of violations the previous detection found that no longer hold
+	CheckedAt     time.Time
+}
+
+// BuildComplianceViolationReport evaluates the agreement's conformance requirements against the
+// facts recorded of them: the validity of the evidence of their controls, the open findings of
+// audits on those controls and the documents in effect implementing them. Violations are compared
+// with those the agreement's previous detection found.
+func BuildComplianceViolationReport(source ComplianceReportSource, at time.Time) ComplianceViolationReport {
+	agreement := source.Agreement
+	report := ComplianceViolationReport{
+		AgreementID:   agreement.ID,
+		ApplicationID: agreement.ApplicationID,
+		Violations:    []ComplianceViolation{},
+		New:           []ComplianceViolation{},
+		Resolved:      []string{},
+		CheckedAt:     at,
+	}
+
+	for _, requirement := range BuildAgreementComplianceReport(source, at).Requirements {
+		report.Violations = append(report.Violations, requirementViolations(agreement.ID, requirement, at)...)
+	}
+	sort.SliceStable(report.Violations, func(i, j int) bool {
+		return findingSeverityRank(report.Violations[i].Severity) < findingSeverityRank(report.Violations[j].Severity)
+	})
+
+	previous := make(map[string]bool, len(agreement.Monitor.DetectedViolations))
+	for _, id := range agreement.Monitor.DetectedViolations {
+		previous[id] = true
+	}
+	current := make(map[string]bool, len(report.Violations))
+	for _, violation := range report.Violations {
+		current[violation.ID] = true
+		if !previous[violation.ID] {
+			report.New = append(report.New, violation)
+		}
+	}
+	for _, id := range agreement.Monitor.DetectedViolations {
+		if !current[id] {
+			report.Resolved = append(report.Resolved, id)
+		}
+	}
+	return report
+}
+
+// DetectedViolationIDs returns the IDs of the violations found, recorded on the agreement so the
+// next detection only reports new ones
+func (r ComplianceViolationReport) DetectedViolationIDs() []string {
+	ids := make([]string, 0, len(r.Violations))
+	for _, violation := range r.Violations {
+		ids = append(ids, violation.ID)
+	}
+	return ids
+}
+
+// requirementViolations evaluates one requirement against each rule
+func requirementViolations(agreementID GovernanceAgreementID, requirement ReportedRequirement, at time.Time) []ComplianceViolation {
+	severity := mostSevere(requirement.Requirement.Kind.ViolationSeverity(), string(requirement.Criticality))
+	id := func(rule ViolationRule, suffix string) string {
+		id := fmt.Sprintf("%s/%s/%s/%s", agreementID, rule, requirement.Requirement.Kind, requirement.Requirement.Name)
+		if suffix != "" {
+			id += "/" + suffix
+		}
+		return id
+	}
+	var violations []ComplianceViolation
+
+	if EvidenceBacked(requirement.Status) && len(requirement.Evidence) > 0 {
+		var expired []string
+		for _, evidence := range requirement.Evidence {
+			if !evidence.Expired(at) {
+				expired = nil
+				break
+			}
+			expired = append(expired, evidence.ID)
+		}
+		if len(expired) > 0 {
+			violations = append(violations, ComplianceViolation{
+				ID:          id(ViolationExpiredEvidence, ""),
+				Rule:        ViolationExpiredEvidence,
+				Requirement: requirement.Requirement,
+				Severity:    severity,
+				Description: fmt.Sprintf("%s is held %s but all of its evidence has expired: %s", requirement.Requirement, requirement.Status, strings.Join(expired, ", ")),
+				Remediation: fmt.Sprintf("Renew %s and attach the new evidence, or put the requirement under review", strings.Join(expired, ", ")),
+			})
+		}
+	}
+
+	for _, open := range requirement.Findings {
+		finding := open.Finding
+		remediation := finding.Remediation
+		var overdue []string
+		for _, action := range finding.Actions {
+			if action.Overdue(at) {
+				overdue = append(overdue, action.ID)
+			}
+		}
+		switch {
+		case len(overdue) > 0:
+			remediation = fmt.Sprintf("Complete the overdue actions %s", strings.Join(overdue, ", "))
+		case len(finding.Actions) == 0 && remediation == "":
+			remediation = fmt.Sprintf("Plan remediation actions for finding %s", finding.ID)
+		case len(finding.Actions) == 0:
+			remediation = fmt.Sprintf("Plan remediation actions for finding %s: %s", finding.ID, remediation)
+		case remediation == "":
+			remediation = fmt.Sprintf("Complete the remediation actions of finding %s", finding.ID)
+		}
+		violations = append(violations, ComplianceViolation{
+			ID:          id(ViolationFailedAudit, open.AuditID+"/"+finding.ID),
+			Rule:        ViolationFailedAudit,
+			Requirement: requirement.Requirement,
+			Severity:    mostSevere(severity, finding.Severity),
+			Description: fmt.Sprintf("Audit %s found %s unremediated on %s: %s", open.AuditID, finding.ID, requirement.Requirement, finding.Description),
+			Remediation: remediation,
+		})
+	}
+
+	if requirement.Status != ComplianceUnderReview && len(requirement.Documents) == 0 {
+		violations = append(violations, ComplianceViolation{
+			ID:          id(ViolationMissingPolicy, ""),
+			Rule:        ViolationMissingPolicy,
+			Requirement: requirement.Requirement,
+			Severity:    severity,
+			Description: fmt.Sprintf("No policy, standard or procedure in effect implements %s", requirement.Requirement),
+			Remediation: "Publish a policy implementing the requirement and map it to the requirement",
+		})
+	}
+	return violations
+}
+
+// mostSevere returns the more severe of two severities; unknown ones are the least severe
+func mostSevere(a, b string) string {
+	if findingSeverityRank(b) < findingSeverityRank(a) {
+		return b
+	}
+	return a
+}
@@ -0,0 +1,224 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// ConformanceKindScore is the weighted compliance of an agreement's requirements of one kind
+type ConformanceKindScore struct {
+	Kind       RequirementKind
+	Percentage float64
+}
+
+// ConformanceScore is the weighted compliance of the legal, contractual and industry standard
+// requirements of an application's agreement. Each requirement weighs as much as its criticality,
+// medium when unset; compliant requirements count fully, partial ones by half and non-compliant
+// ones not at all. Requirements still under review are counted but left out of the percentage.
+type ConformanceScore struct {
+	Percentage   float64 // of the weight assessed
+	Compliant    int
+	Partial      int
+	NonCompliant int
+	UnderReview  int
+	Kinds        []ConformanceKindScore
+
+	// Gaps are the non-compliant requirements, most critical first
+	Gaps []RequirementImplementation
+
+	AssessedWeight  float64 // weight of the requirements with a status other than under review
+	CompliantWeight float64 // weight earned by those requirements
+}
+
+// Assessed reports whether any requirement has a status other than under review
+func (s ConformanceScore) Assessed() bool {
+	return s.AssessedWeight > 0
+}
+
+// requirementWeight returns the weight a requirement carries in conformance scores
+func requirementWeight(criticality Priority) float64 {
+	if weight := criticality.Weight(); weight > 0 {
+		return weight
+	}
+	return PriorityMedium.Weight()
+}
+
+// ScoreConformance computes the weighted compliance of the conformance component's requirements.
+// It returns nil when the component has no requirements.
+func ScoreConformance(conformance Conformance) *ConformanceScore {
+	requirements := conformanceRequirements(conformance)
+	if len(requirements) == 0 {
+		return nil
+	}
+
+	score := &ConformanceScore{}
+	kindAchieved := make(map[RequirementKind]float64)
+	kindTotal := make(map[RequirementKind]float64)
+	for _, requirement := range requirements {
+		switch requirement.Status {
+		case ComplianceCompliant:
+			score.Compliant++
+		case CompliancePartial:
+			score.Partial++
+		case ComplianceNonCompliant:
+			score.NonCompliant++
+			score.Gaps = append(score.Gaps, requirement)
+		default:
+			score.UnderReview++
+			continue
+		}
+
+		weight := requirementWeight(requirement.Criticality)
+		credit := complianceCredit(requirement.Status)
+		score.AssessedWeight += weight
+		score.CompliantWeight += weight * credit
+		kindTotal[requirement.Requirement.Kind] += weight
+		kindAchieved[requirement.Requirement.Kind] += weight * credit
+	}
+
+	if score.AssessedWeight > 0 {
+		score.Percentage = score.CompliantWeight / score.AssessedWeight * 100
+	}
+	for _, kind := range []RequirementKind{RequirementLegal, RequirementContractual, RequirementIndustryStandard} {
+		if kindTotal[kind] > 0 {
+			score.Kinds = append(score.Kinds, ConformanceKindScore{Kind: kind, Percentage: kindAchieved[kind] / kindTotal[kind] * 100})
+		}
+	}
+	sort.SliceStable(score.Gaps, func(i, j int) bool {
+		return requirementWeight(score.Gaps[i].Criticality) > requirementWeight(score.Gaps[j].Criticality)
+	})
+	return score
+}
+
+// ComplianceRiskThresholds escalate the risk level of applications whose conformance score falls
+// below them. A critical requirement that is not met escalates risk to high whatever the score.
+type ComplianceRiskThresholds struct {
+	HighBelow   float64 // percentage below which risk is at least high
+	MediumBelow float64 // percentage below which risk is at least medium
+}
+
+// DefaultComplianceRiskThresholds escalate risk to high below 60% compliance and to medium below 80%
+func DefaultComplianceRiskThresholds() ComplianceRiskThresholds {
+	return ComplianceRiskThresholds{
+		HighBelow:   60,
+		MediumBelow: 80,
+	}
+}
+
+// Validate ensures the thresholds are percentages ordered from high to medium
+func (t ComplianceRiskThresholds) Validate() error {
+	if t.HighBelow < 0 || t.MediumBelow > 100 {
+		return errors.New("compliance risk thresholds must be between 0 and 100")
+	}
+	if t.HighBelow > t.MediumBelow {
+		return errors.New("high compliance risk threshold must not exceed the medium threshold")
+	}
+	return nil
+}
+
+// ComplianceRiskLevel returns the risk level the conformance score alone warrants, low when
+// nothing is assessed. Zero thresholds fall back to DefaultComplianceRiskThresholds.
+func ComplianceRiskLevel(score ConformanceScore, thresholds ComplianceRiskThresholds) RiskLevel {
+	if thresholds == (ComplianceRiskThresholds{}) {
+		thresholds = DefaultComplianceRiskThresholds()
+	}
+	if !score.Assessed() {
+		return RiskLow
+	}
+
+	level := RiskLow
+	switch {
+	case score.Percentage < thresholds.HighBelow:
+		level = RiskHigh
+	case score.Percentage < thresholds.MediumBelow:
+		level = RiskMedium
+	}
+	for _, gap := range score.Gaps {
+		if gap.Criticality == PriorityCritical {
+			level = RiskHigh
+		}
+	}
+	return level
+}
+
+// escalateForCompliance raises the risk level to the one the conformance score warrants when
+// that is higher
+func escalateForCompliance(level RiskLevel, score *ConformanceScore, thresholds ComplianceRiskThresholds) RiskLevel {
+	if score == nil {
+		return level
+	}
+	if compliance := ComplianceRiskLevel(*score, thresholds); riskScore(compliance) > riskScore(level) {
+		return compliance
+	}
+	return level
+}
+
+// conformanceRecommendations asks for the non-compliant requirements to be remediated, at their
+// criticality, and for the requirements under review to be assessed
+func conformanceRecommendations(score *ConformanceScore) []Recommendation {
+	if score == nil {
+		return nil
+	}
+
+	var recommendations []Recommendation
+	for i, gap := range score.Gaps {
+		priority := gap.Criticality
+		if priority.Weight() == 0 {
+			priority = PriorityMedium
+		}
+		recommendations = append(recommendations, Recommendation{
+			ID:             fmt.Sprintf("cmp-%03d", i+1),
+			Type:           RecEnhance,
+			Description:    fmt.Sprintf("Remediate non-compliance with %s", gap.Requirement),
+			Priority:       priority,
+			BusinessImpact: "Avoid penalties, breach of contract and loss of certification",
+		})
+	}
+	if score.UnderReview > 0 {
+		recommendations = append(recommendations, Recommendation{
+			ID:             fmt.Sprintf("cmp-%03d", len(score.Gaps)+1),
+			Type:           RecMaintain,
+			Description:    fmt.Sprintf("Assess the %d conformance requirements still under review", score.UnderReview),
+			Priority:       PriorityMedium,
+			BusinessImpact: "Know whether the application meets its legal and contractual obligations",
+		})
+	}
+	return recommendations
+}
+
+// PortfolioCompliance is the weighted compliance of the requirements of every application of a
+// portfolio whose agreement has assessed requirements
+type PortfolioCompliance struct {
+	Percentage   float64
+	Applications int             // applications scored
+	BelowMedium  []ApplicationID // applications scoring below the medium compliance risk threshold
+}
+
+// RollUpCompliance combines the conformance scores of the assessments. It returns nil when none
+// has assessed requirements. Zero thresholds fall back to DefaultComplianceRiskThresholds.
+func RollUpCompliance(assessments []ApplicationAssessment, thresholds ComplianceRiskThresholds) *PortfolioCompliance {
+	if thresholds == (ComplianceRiskThresholds{}) {
+		thresholds = DefaultComplianceRiskThresholds()
+	}
+
+	rollup := &PortfolioCompliance{}
+	var achieved, total float64
+	for _, assessment := range assessments {
+		score := assessment.Compliance
+		if score == nil || !score.Assessed() {
+			continue
+		}
+		rollup.Applications++
+		achieved += score.CompliantWeight
+		total += score.AssessedWeight
+		if score.Percentage < thresholds.MediumBelow {
+			rollup.BelowMedium = append(rollup.BelowMedium, assessment.ApplicationID)
+		}
+	}
+	if rollup.Applications == 0 {
+		return nil
+	}
+	rollup.Percentage = achieved / total * 100
+	return rollup
+}
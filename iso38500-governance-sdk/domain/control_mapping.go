@@ -0,0 +1,173 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ControlRef names one requirement of a compliance framework, e.g. A.8.13 of iso27001-2022
+type ControlRef struct {
+	FrameworkID   string `json:"framework_id"`
+	RequirementID string `json:"requirement_id"`
+}
+
+// String names the control with its framework
+func (r ControlRef) String() string {
+	return r.FrameworkID + " " + r.RequirementID
+}
+
+// ParseControlRef reads a control written as "framework:requirement", e.g. "iso27001-2022:A.8.13"
+func ParseControlRef(value string) (ControlRef, error) {
+	frameworkID, requirementID, found := strings.Cut(value, ":")
+	if !found || frameworkID == "" || requirementID == "" {
+		return ControlRef{}, fmt.Errorf("invalid control %q: expected framework:requirement", value)
+	}
+	return ControlRef{FrameworkID: strings.TrimSpace(frameworkID), RequirementID: strings.TrimSpace(requirementID)}, nil
+}
+
+// ControlMapping records that one implemented control satisfies requirements of several
+// frameworks, such as a backup procedure meeting ISO/IEC 27001:2022 A.8.13, SOC 2 A1.2 and
+// NIST CSF PR.DS at once. The compliance status found for any of its controls applies to all of
+// them.
+type ControlMapping struct {
+	ID       string       `json:"id"`
+	Name     string       `json:"name"`
+	Controls []ControlRef `json:"controls"`
+	Notes    string       `json:"notes"`
+}
+
+// Validate ensures the mapping is identified and relates at least two distinct controls
+func (m ControlMapping) Validate() error {
+	if m.ID == "" {
+		return errors.New("control mapping ID cannot be empty")
+	}
+	seen := make(map[ControlRef]bool, len(m.Controls))
+	for _, control := range m.Controls {
+		if control.FrameworkID == "" || control.RequirementID == "" {
+			return fmt.Errorf("control mapping %s: controls require a framework and a requirement", m.ID)
+		}
+		if seen[control] {
+			return fmt.Errorf("control mapping %s: duplicate control %s", m.ID, control)
+		}
+		seen[control] = true
+	}
+	if len(seen) < 2 {
+		return fmt.Errorf("control mapping %s must relate at least two controls", m.ID)
+	}
+	return nil
+}
+
+// Covers reports whether the mapping relates the control
+func (m ControlMapping) Covers(control ControlRef) bool {
+	for _, mapped := range m.Controls {
+		if mapped == control {
+			return true
+		}
+	}
+	return false
+}
+
+// MappedControls returns the control followed by every control a mapping relates it to, each once.
+// Only direct mappings are followed, so two controls are related only when a mapping names both.
+func MappedControls(mappings []ControlMapping, control ControlRef) []ControlRef {
+	controls := []ControlRef{control}
+	seen := map[ControlRef]bool{control: true}
+	for _, mapping := range mappings {
+		if !mapping.Covers(control) {
+			continue
+		}
+		for _, mapped := range mapping.Controls {
+			if !seen[mapped] {
+				seen[mapped] = true
+				controls = append(controls, mapped)
+			}
+		}
+	}
+	return controls
+}
+
+// ApplyControlStatus sets the status of every requirement of the conformance component that is
+// the control or a control mapped to it, and returns the component with the requirements
+// updated. Controls of frameworks that are not known, and requirements the component does not
+// have, are left out.
+func ApplyControlStatus(conformance Conformance, frameworks []ComplianceFramework, mappings []ControlMapping, control ControlRef, status ComplianceStatus) (Conformance, []RequirementRef) {
+	byID := make(map[string]ComplianceFramework, len(frameworks))
+	for _, framework := range frameworks {
+		byID[framework.ID] = framework
+	}
+
+	var updated []RequirementRef
+	for _, mapped := range MappedControls(mappings, control) {
+		framework, ok := byID[mapped.FrameworkID]
+		if !ok {
+			continue
+		}
+		ref := RequirementRef{Kind: framework.Kind, Name: framework.RequirementName(FrameworkRequirement{ID: mapped.RequirementID})}
+		if setComplianceStatus(&conformance, ref, status) {
+			updated = append(updated, ref)
+		}
+	}
+	return conformance, updated
+}
+
+// FindingComplianceStatus returns the compliance status an audit finding shows for the controls it
+// concerns: non-compliant for critical and high findings, partial for the others
+func FindingComplianceStatus(finding AuditFinding) ComplianceStatus {
+	switch strings.ToLower(finding.Severity) {
+	case "critical", "high":
+		return ComplianceNonCompliant
+	}
+	return CompliancePartial
+}
+
+// LoadControlMappings reads a JSON array of control mappings
+func LoadControlMappings(r io.Reader) ([]ControlMapping, error) {
+	var mappings []ControlMapping
+	if err := json.NewDecoder(r).Decode(&mappings); err != nil {
+		return nil, fmt.Errorf("failed to decode control mappings: %w", err)
+	}
+	seen := make(map[string]bool, len(mappings))
+	for _, mapping := range mappings {
+		if err := mapping.Validate(); err != nil {
+			return nil, err
+		}
+		if seen[mapping.ID] {
+			return nil, fmt.Errorf("duplicate control mapping %s", mapping.ID)
+		}
+		seen[mapping.ID] = true
+	}
+	return mappings, nil
+}
+
+// StandardControlMappings returns mappings between the controls of the standard compliance
+// frameworks that are commonly satisfied by the same implementation. They are a starting point;
+// an organization's own mappings depend on how its controls are implemented.
+func StandardControlMappings() []ControlMapping {
+	iso := func(id string) ControlRef { return ControlRef{FrameworkID: FrameworkISO27001, RequirementID: id} }
+	soc2 := func(id string) ControlRef { return ControlRef{FrameworkID: FrameworkSOC2, RequirementID: id} }
+	nist := func(id string) ControlRef { return ControlRef{FrameworkID: FrameworkNISTCSF, RequirementID: id} }
+	gdpr := func(id string) ControlRef { return ControlRef{FrameworkID: FrameworkGDPR, RequirementID: id} }
+
+	return []ControlMapping{
+		{ID: "policies", Name: "Information security policies", Controls: []ControlRef{iso("A.5.1"), soc2("CC5.3"), nist("GV.PO")}},
+		{ID: "access-control", Name: "Access control and least privilege", Controls: []ControlRef{iso("A.5.15"), iso("A.5.18"), iso("A.8.2"), iso("A.8.3"), soc2("CC6.1"), soc2("CC6.2"), soc2("CC6.3"), nist("PR.AA")}},
+		{ID: "authentication", Name: "Secure authentication", Controls: []ControlRef{iso("A.5.17"), iso("A.8.5"), soc2("CC6.1"), nist("PR.AA")}},
+		{ID: "asset-inventory", Name: "Asset and processing inventory", Controls: []ControlRef{iso("A.5.9"), soc2("CC6.1"), nist("ID.AM"), gdpr("Art.30")}},
+		{ID: "encryption", Name: "Encryption of data at rest and in transit", Controls: []ControlRef{iso("A.8.24"), soc2("CC6.1"), soc2("CC6.7"), nist("PR.DS"), gdpr("Art.32")}},
+		{ID: "backup", Name: "Backup and restoration", Controls: []ControlRef{iso("A.8.13"), soc2("A1.2"), nist("PR.DS"), gdpr("Art.32")}},
+		{ID: "business-continuity", Name: "Business continuity and recovery", Controls: []ControlRef{iso("A.5.29"), iso("A.5.30"), iso("A.8.14"), soc2("A1.3"), soc2("CC9.1"), nist("PR.IR"), nist("RC.RP")}},
+		{ID: "logging-monitoring", Name: "Logging and security monitoring", Controls: []ControlRef{iso("A.8.15"), iso("A.8.16"), soc2("CC7.2"), nist("DE.CM")}},
+		{ID: "vulnerability-management", Name: "Vulnerability management", Controls: []ControlRef{iso("A.8.8"), soc2("CC7.1"), nist("ID.RA")}},
+		{ID: "incident-response", Name: "Incident response", Controls: []ControlRef{iso("A.5.24"), iso("A.5.25"), iso("A.5.26"), iso("A.5.27"), soc2("CC7.3"), soc2("CC7.4"), soc2("CC7.5"), nist("RS.MA"), nist("RS.AN"), nist("RS.MI")}},
+		{ID: "breach-notification", Name: "Personal data breach notification", Controls: []ControlRef{gdpr("Art.33"), gdpr("Art.34"), soc2("P6.6"), nist("RS.CO")}},
+		{ID: "change-management", Name: "Change management", Controls: []ControlRef{iso("A.8.32"), soc2("CC8.1"), nist("PR.PS")}},
+		{ID: "secure-development", Name: "Secure development and privacy by design", Controls: []ControlRef{iso("A.8.25"), iso("A.8.28"), iso("A.8.29"), soc2("CC8.1"), nist("PR.PS"), gdpr("Art.25")}},
+		{ID: "supplier-risk", Name: "Supplier and processor risk management", Controls: []ControlRef{iso("A.5.19"), iso("A.5.20"), iso("A.5.21"), iso("A.5.22"), soc2("CC9.2"), nist("GV.SC"), gdpr("Art.28")}},
+		{ID: "risk-assessment", Name: "Risk and impact assessment", Controls: []ControlRef{soc2("CC3.1"), soc2("CC3.2"), nist("GV.RM"), nist("ID.RA"), gdpr("Art.35")}},
+		{ID: "awareness-training", Name: "Security awareness and training", Controls: []ControlRef{iso("A.6.3"), soc2("CC1.4"), soc2("CC2.2"), nist("PR.AT")}},
+		{ID: "privacy", Name: "Privacy and protection of personal data", Controls: []ControlRef{iso("A.5.34"), soc2("P4.1"), gdpr("Art.5")}},
+	}
+}
@@ -0,0 +1,57 @@
+package domain
+
+// ApplicationCost records what an application costs to own. Running costs are annual;
+// Acquisition is the one-off cost of purchasing and implementing the application.
+type ApplicationCost struct {
+	License        float64
+	Infrastructure float64
+	Support        float64
+	Personnel      float64
+	Acquisition    float64
+	Currency       string // ISO 4217 code, e.g. "USD"
+}
+
+// AnnualTotal returns the yearly running cost
+func (c ApplicationCost) AnnualTotal() float64 {
+	return c.License + c.Infrastructure + c.Support + c.Personnel
+}
+
+// TotalCostOfOwnership returns the acquisition cost plus the running cost over the given years
+func (c ApplicationCost) TotalCostOfOwnership(years int) float64 {
+	return c.Acquisition + c.AnnualTotal()*float64(years)
+}
+
+// IsRecorded reports whether any running cost has been recorded
+func (c ApplicationCost) IsRecorded() bool {
+	return c.AnnualTotal() > 0
+}
+
+// Add returns the category-by-category sum of two cost records
+func (c ApplicationCost) Add(other ApplicationCost) ApplicationCost {
+	currency := c.Currency
+	if currency == "" {
+		currency = other.Currency
+	}
+	return ApplicationCost{
+		License:        c.License + other.License,
+		Infrastructure: c.Infrastructure + other.Infrastructure,
+		Support:        c.Support + other.Support,
+		Personnel:      c.Personnel + other.Personnel,
+		Acquisition:    c.Acquisition + other.Acquisition,
+		Currency:       currency,
+	}
+}
+
+// costEfficiencyFromRecordedCost scores annual cost per active user against a target:
+// 100 at or below the target, falling in proportion as the cost per user exceeds it
+func costEfficiencyFromRecordedCost(cost ApplicationCost, activeUsers int, targetPerUser float64) float64 {
+	if activeUsers <= 0 {
+		return 0
+	}
+
+	costPerUser := cost.AnnualTotal() / float64(activeUsers)
+	if costPerUser <= targetPerUser {
+		return 100
+	}
+	return targetPerUser / costPerUser * 100
+}
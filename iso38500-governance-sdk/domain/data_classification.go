@@ -0,0 +1,253 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DataClassificationLevel is how sensitive the data an application holds is
+type DataClassificationLevel string
+
+const (
+	DataPublic       DataClassificationLevel = "public"
+	DataInternal     DataClassificationLevel = "internal"
+	DataConfidential DataClassificationLevel = "confidential"
+	DataRestricted   DataClassificationLevel = "restricted"
+)
+
+// AllDataClassificationLevels returns every level, least sensitive first
+func AllDataClassificationLevels() []DataClassificationLevel {
+	return []DataClassificationLevel{DataPublic, DataInternal, DataConfidential, DataRestricted}
+}
+
+// Rank orders the levels from 1 for public to 4 for restricted, 0 when unclassified or unknown
+func (l DataClassificationLevel) Rank() int {
+	switch l {
+	case DataPublic:
+		return 1
+	case DataInternal:
+		return 2
+	case DataConfidential:
+		return 3
+	case DataRestricted:
+		return 4
+	}
+	return 0
+}
+
+// Validate ensures the level is known; an empty level means the data is not classified yet
+func (l DataClassificationLevel) Validate() error {
+	if l != "" && l.Rank() == 0 {
+		return fmt.Errorf("unknown data classification %q", l)
+	}
+	return nil
+}
+
+// DataClassification is the sensitivity of the data an application holds, and whether it holds
+// personally identifiable information (PII) or protected health information (PHI), which bring
+// privacy regulations such as GDPR and HIPAA into scope
+type DataClassification struct {
+	Level DataClassificationLevel // empty when not classified yet
+	PII   bool
+	PHI   bool
+}
+
+// Validate ensures the classification level is known
+func (c DataClassification) Validate() error {
+	return c.Level.Validate()
+}
+
+// Classified reports whether a level was given to the data
+func (c DataClassification) Classified() bool {
+	return c.Level != ""
+}
+
+// String describes the classification, e.g. "confidential, PII"
+func (c DataClassification) String() string {
+	parts := []string{"unclassified"}
+	if c.Classified() {
+		parts[0] = string(c.Level)
+	}
+	if c.PII {
+		parts = append(parts, "PII")
+	}
+	if c.PHI {
+		parts = append(parts, "PHI")
+	}
+	return strings.Join(parts, ", ")
+}
+
+// RequiredSecurityScore is the lowest technical health security score, on the 1-5 scale, that
+// protects the data: 4 for restricted data or health information, 3 for confidential data or
+// personal information and none otherwise
+func (c DataClassification) RequiredSecurityScore() int {
+	switch {
+	case c.Level == DataRestricted || c.PHI:
+		return 4
+	case c.Level == DataConfidential || c.PII:
+		return 3
+	}
+	return 0
+}
+
+// DataClassificationFilter selects applications by the data they hold, e.g. to scope an audit
+// against a privacy regulation. A zero filter selects every application.
+type DataClassificationFilter struct {
+	MinLevel DataClassificationLevel // only data classified at least this sensitive
+	PII      bool                    // only applications holding personal information
+	PHI      bool                    // only applications holding health information
+}
+
+// Validate ensures the minimum level is known
+func (f DataClassificationFilter) Validate() error {
+	return f.MinLevel.Validate()
+}
+
+// Matches reports whether data of the classification is selected by the filter
+func (f DataClassificationFilter) Matches(c DataClassification) bool {
+	if f.MinLevel != "" && c.Level.Rank() < f.MinLevel.Rank() {
+		return false
+	}
+	if f.PII && !c.PII {
+		return false
+	}
+	if f.PHI && !c.PHI {
+		return false
+	}
+	return true
+}
+
+// DataClassificationScope lists the applications a filter selects, most sensitive first, with
+// counts of the classifications across every application considered
+type DataClassificationScope struct {
+	Filter       DataClassificationFilter
+	Applications []Application
+	Considered   int                             // applications the filter was applied to
+	ByLevel      map[DataClassificationLevel]int // applications considered per level
+	Unclassified int                             // applications considered with no level yet
+	PII          int                             // applications considered holding personal information
+	PHI          int                             // applications considered holding health information
+}
+
+// ScopeByDataClassification applies the filter to the applications
+func ScopeByDataClassification(apps []Application, filter DataClassificationFilter) DataClassificationScope {
+	scope := DataClassificationScope{
+		Filter:       filter,
+		Applications: []Application{},
+		Considered:   len(apps),
+		ByLevel:      map[DataClassificationLevel]int{},
+	}
+	for _, app := range apps {
+		classification := app.DataClassification
+		if classification.Classified() {
+			scope.ByLevel[classification.Level]++
+		} else {
+			scope.Unclassified++
+		}
+		if classification.PII {
+			scope.PII++
+		}
+		if classification.PHI {
+			scope.PHI++
+		}
+		if filter.Matches(classification) {
+			scope.Applications = append(scope.Applications, app)
+		}
+	}
+	sort.SliceStable(scope.Applications, func(i, j int) bool {
+		a, b := scope.Applications[i].DataClassification, scope.Applications[j].DataClassification
+		if a.RequiredSecurityScore() != b.RequiredSecurityScore() {
+			return a.RequiredSecurityScore() > b.RequiredSecurityScore()
+		}
+		return a.Level.Rank() > b.Level.Rank()
+	})
+	return scope
+}
+
+// escalateForDataClassification raises the risk level of an application whose security falls
+// short of what its data requires: to medium, or high for restricted and health data, and one
+// level further when it falls short by two points or more
+func escalateForDataClassification(level RiskLevel, classification DataClassification, health TechnicalHealth) RiskLevel {
+	required := classification.RequiredSecurityScore()
+	shortfall := required - health.SecurityScore
+	if required == 0 || shortfall <= 0 {
+		return level
+	}
+
+	floor := []RiskLevel{RiskMedium, RiskHigh, RiskCritical}
+	step := 0
+	if required >= 4 {
+		step = 1
+	}
+	if shortfall >= 2 {
+		step++
+	}
+	if riskScore(floor[step]) > riskScore(level) {
+		return floor[step]
+	}
+	return level
+}
+
+// dataClassificationRecommendations asks for the security controls the application's data
+// requires: a higher security score, confidentiality measures for confidential and restricted
+// data, and access roles for personal and health information
+func dataClassificationRecommendations(app Application, health TechnicalHealth) []Recommendation {
+	classification := app.DataClassification
+	var recommendations []Recommendation
+	add := func(recommendation Recommendation) {
+		recommendation.ID = fmt.Sprintf("data-%03d", len(recommendations)+1)
+		recommendations = append(recommendations, recommendation)
+	}
+
+	if required := classification.RequiredSecurityScore(); health.SecurityScore < required {
+		priority := PriorityHigh
+		if required >= 4 {
+			priority = PriorityCritical
+		}
+		add(Recommendation{
+			Type:            RecModernize,
+			Description:     fmt.Sprintf("Raise security to what %s data requires: security score %d of the %d needed", classification, health.SecurityScore, required),
+			Priority:        priority,
+			EstimatedEffort: 80 * time.Hour,
+			BusinessImpact:  "Protect sensitive data from breaches and the regulatory penalties they bring",
+		})
+	}
+
+	if classification.Level.Rank() >= DataConfidential.Rank() && !anyImplemented(app.SecurityProvisions.DataConfidentiality) {
+		add(Recommendation{
+			Type:            RecEnhance,
+			Description:     fmt.Sprintf("Implement confidentiality measures, such as encryption at rest and in transit, for %s data", classification.Level),
+			Priority:        PriorityHigh,
+			EstimatedEffort: 40 * time.Hour,
+			BusinessImpact:  "Keep confidential data unreadable to anyone not entitled to it",
+		})
+	}
+
+	if (classification.PII || classification.PHI) && len(app.SecurityProvisions.RolesAndPermissions) == 0 {
+		kind := "personal"
+		if classification.PHI {
+			kind = "health"
+		}
+		add(Recommendation{
+			Type:            RecEnhance,
+			Description:     fmt.Sprintf("Define roles and permissions restricting access to %s information", kind),
+			Priority:        PriorityHigh,
+			EstimatedEffort: 24 * time.Hour,
+			BusinessImpact:  "Limit access to personal data to those who need it, as privacy regulations require",
+		})
+	}
+
+	return recommendations
+}
+
+// anyImplemented reports whether any of the security measures is implemented
+func anyImplemented(measures []SecurityMeasure) bool {
+	for _, measure := range measures {
+		if measure.Status == SecurityImplemented {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,262 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// RetentionAction is what happens to data once its retention period has ended
+type RetentionAction string
+
+const (
+	RetentionPurge     RetentionAction = "purge"
+	RetentionAnonymize RetentionAction = "anonymize"
+	RetentionArchive   RetentionAction = "archive"
+)
+
+// Validate ensures the action is known
+func (a RetentionAction) Validate() error {
+	switch a {
+	case RetentionPurge, RetentionAnonymize, RetentionArchive:
+		return nil
+	}
+	return fmt.Errorf("unknown retention action %q", a)
+}
+
+// RetentionPolicy sets how long data may be held. A policy applies to the data of one
+// application, of the applications whose data has a classification, or of every application,
+// and to one category of data or to all of it. Where several apply, the most specific wins.
+type RetentionPolicy struct {
+	ID             string
+	Name           string
+	ApplicationID  ApplicationID           // empty for any application
+	Classification DataClassificationLevel // empty for any classification
+	DataCategory   string                  // e.g. "customer records"; empty for any category
+	RetentionDays  int
+	Action         RetentionAction // what to do with data held beyond the retention period
+	LegalBasis     string          // why the data is kept that long, e.g. a statute or contract clause
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// Validate ensures the policy has an ID, a positive retention period and a known action
+func (p RetentionPolicy) Validate() error {
+	if p.ID == "" {
+		return errors.New("retention policy ID cannot be empty")
+	}
+	if p.RetentionDays <= 0 {
+		return fmt.Errorf("retention policy %s must keep data for a positive number of days", p.ID)
+	}
+	if err := p.Classification.Validate(); err != nil {
+		return err
+	}
+	return p.Action.Validate()
+}
+
+// Applies reports whether the policy covers a category of the application's data
+func (p RetentionPolicy) Applies(app Application, category string) bool {
+	if p.ApplicationID != "" && p.ApplicationID != app.ID {
+		return false
+	}
+	if p.Classification != "" && p.Classification != app.DataClassification.Level {
+		return false
+	}
+	return p.DataCategory == "" || strings.EqualFold(p.DataCategory, category)
+}
+
+// specificity ranks how narrowly the policy applies: naming the application counts most, then
+// the data category, then the classification
+func (p RetentionPolicy) specificity() int {
+	score := 0
+	if p.ApplicationID != "" {
+		score += 4
+	}
+	if p.DataCategory != "" {
+		score += 2
+	}
+	if p.Classification != "" {
+		score++
+	}
+	return score
+}
+
+// RetentionPolicyFor returns the policy covering a category of the application's data: the most
+// specific that applies, and of equally specific ones the shortest retention period
+func RetentionPolicyFor(policies []RetentionPolicy, app Application, category string) (RetentionPolicy, bool) {
+	var found RetentionPolicy
+	ok := false
+	for _, policy := range policies {
+		if !policy.Applies(app, category) {
+			continue
+		}
+		if !ok || policy.specificity() > found.specificity() ||
+			policy.specificity() == found.specificity() && policy.RetentionDays < found.RetentionDays {
+			found, ok = policy, true
+		}
+	}
+	return found, ok
+}
+
+// DataHolding is a category of data an application holds, as last reported by its owner
+type DataHolding struct {
+	ApplicationID  ApplicationID
+	Category       string
+	Records        int
+	OldestRecordAt time.Time // the oldest record still held
+	LegalHold      string    // why the data must be kept regardless of policy; empty when not held
+	RecordedAt     time.Time
+}
+
+// Validate ensures the holding names its application and category
+func (h DataHolding) Validate() error {
+	if h.ApplicationID == "" {
+		return errors.New("data holding must name its application")
+	}
+	if strings.TrimSpace(h.Category) == "" {
+		return errors.New("data holding must name its data category")
+	}
+	if h.Records < 0 {
+		return errors.New("data holding records cannot be negative")
+	}
+	return nil
+}
+
+// RetentionViolation is data an application holds beyond the retention period of its policy
+type RetentionViolation struct {
+	ApplicationID   ApplicationID
+	Category        string
+	Records         int
+	OldestRecordAt  time.Time
+	Policy          RetentionPolicy
+	RetainUntil     time.Time // when the oldest record should have been disposed of
+	DaysOverdue     int
+	ChangeRequestID string // the change request scheduling the purge; empty until scheduled
+}
+
+// Description describes the violation, e.g. "crm-global-001 holds 1200 customer records since
+// 2017-03-01, 95 days beyond retention policy ret-crm (2555 days)"
+func (v RetentionViolation) Description() string {
+	return fmt.Sprintf("%s holds %d %s since %s, %d days beyond retention policy %s (%d days)",
+		v.ApplicationID, v.Records, v.Category, v.OldestRecordAt.Format("2006-01-02"), v.DaysOverdue, v.Policy.ID, v.Policy.RetentionDays)
+}
+
+// RetentionReport is the outcome of checking the data applications hold against the retention
+// policies
+type RetentionReport struct {
+	Holdings   int                  // holdings checked
+	Violations []RetentionViolation // most overdue first
+	OnHold     []RetentionViolation // beyond retention but kept under a legal hold
+	Uncovered  []DataHolding        // holdings no policy applies to
+	CheckedAt  time.Time
+}
+
+// CheckRetention flags the holdings whose oldest record is older than the retention period of
+// the policy covering it. Holdings under a legal hold are listed apart, and holdings of
+// applications not found are skipped.
+func CheckRetention(apps []Application, holdings []DataHolding, policies []RetentionPolicy, now time.Time) RetentionReport {
+	byID := make(map[ApplicationID]Application, len(apps))
+	for _, app := range apps {
+		byID[app.ID] = app
+	}
+
+	report := RetentionReport{
+		Violations: []RetentionViolation{},
+		CheckedAt:  now,
+	}
+	for _, holding := range holdings {
+		app, found := byID[holding.ApplicationID]
+		if !found {
+			continue
+		}
+		report.Holdings++
+
+		policy, covered := RetentionPolicyFor(policies, app, holding.Category)
+		if !covered {
+			report.Uncovered = append(report.Uncovered, holding)
+			continue
+		}
+		if holding.Records == 0 || holding.OldestRecordAt.IsZero() {
+			continue
+		}
+		retainUntil := holding.OldestRecordAt.AddDate(0, 0, policy.RetentionDays)
+		if !now.After(retainUntil) {
+			continue
+		}
+
+		violation := RetentionViolation{
+			ApplicationID:  holding.ApplicationID,
+			Category:       holding.Category,
+			Records:        holding.Records,
+			OldestRecordAt: holding.OldestRecordAt,
+			Policy:         policy,
+			RetainUntil:    retainUntil,
+			DaysOverdue:    int(now.Sub(retainUntil).Hours() / 24),
+		}
+		if holding.LegalHold != "" {
+			report.OnHold = append(report.OnHold, violation)
+		} else {
+			report.Violations = append(report.Violations, violation)
+		}
+	}
+
+	sort.SliceStable(report.Violations, func(i, j int) bool {
+		return report.Violations[i].DaysOverdue > report.Violations[j].DaysOverdue
+	})
+	return report
+}
+
+// PurgeChangeRequestPrefix is the start of the IDs of the change requests scheduling the
+// disposal of a category of an application's data
+func PurgeChangeRequestPrefix(appID ApplicationID, category string) string {
+	slug := strings.Join(strings.FieldsFunc(strings.ToLower(category), func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9')
+	}), "-")
+	return fmt.Sprintf("retention-%s-%s", appID, slug)
+}
+
+// PurgeChangeRequest returns a submitted standard change disposing of the data held beyond
+// retention, as the policy's action says. Data overdue by more than 90 days is disposed of with
+// high priority.
+func PurgeChangeRequest(violation RetentionViolation, requester string, now time.Time) ChangeRequest {
+	priority := PriorityMedium
+	if violation.DaysOverdue > 90 {
+		priority = PriorityHigh
+	}
+	businessCase := fmt.Sprintf("Retention policy %s keeps %s for %d days", violation.Policy.ID, violation.Category, violation.Policy.RetentionDays)
+	if violation.Policy.LegalBasis != "" {
+		businessCase += fmt.Sprintf(" (%s)", violation.Policy.LegalBasis)
+	}
+
+	return ChangeRequest{
+		ID:            fmt.Sprintf("%s-%s", PurgeChangeRequestPrefix(violation.ApplicationID, violation.Category), now.Format("20060102")),
+		ApplicationID: violation.ApplicationID,
+		Requester:     requester,
+		Type:          ChangeStandard,
+		Priority:      priority,
+		Status:        ChangeStatusSubmitted,
+		Title:         fmt.Sprintf("%s %s held beyond retention", retentionVerb(violation.Policy.Action), violation.Category),
+		Description: fmt.Sprintf("%s. %s the records created before %s.",
+			violation.Description(), retentionVerb(violation.Policy.Action), now.AddDate(0, 0, -violation.Policy.RetentionDays).Format("2006-01-02")),
+		BusinessCase: businessCase,
+		Impact:       fmt.Sprintf("Disposes of up to %d records of %s", violation.Records, violation.Category),
+		Risk:         "Records disposed of in error cannot be recovered once backups expire",
+		Approvals:    []Approval{},
+		CreatedAt:    now,
+		UpdatedAt:    now,
+		SubmittedAt:  now,
+	}
+}
+
+// retentionVerb names the action as the start of a sentence
+func retentionVerb(action RetentionAction) string {
+	switch action {
+	case RetentionAnonymize:
+		return "Anonymize"
+	case RetentionArchive:
+		return "Archive"
+	}
+	return "Purge"
+}
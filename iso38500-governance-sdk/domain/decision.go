@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// DecisionStatus represents whether a governance decision still stands
+type DecisionStatus string
+
+const (
+	DecisionAccepted   DecisionStatus = "accepted"
+	DecisionSuperseded DecisionStatus = "superseded"
+)
+
+// DecisionOption is an option the board considered before deciding
+type DecisionOption struct {
+	Name        string
+	Description string
+	Pros        []string
+	Cons        []string
+}
+
+// Decision records a governance decision in the style of an architecture decision record: the
+// context it was taken in, the options considered, what was decided and why, by whom and when.
+// Decisions are never deleted; a later decision supersedes an earlier one instead.
+type Decision struct {
+	ID            string
+	Title         string
+	Context       string // the situation and forces that called for a decision
+	Options       []DecisionOption
+	Outcome       string // what was decided
+	Rationale     string // why the outcome was chosen over the other options
+	Consequences  string
+	DecidedBy     string
+	DecidedAt     time.Time
+	AgreementID   GovernanceAgreementID // governance agreement the decision directs
+	ApplicationID ApplicationID         // application the decision applies to
+	Status        DecisionStatus
+	Supersedes    string // decision this one replaces
+	SupersededBy  string // decision that replaced this one
+	RecordedAt    time.Time
+}
+
+// Validate ensures the decision has valid data and is linked to an agreement or application
+func (d Decision) Validate() error {
+	if d.ID == "" {
+		return errors.New("decision ID cannot be empty")
+	}
+	if d.Title == "" {
+		return fmt.Errorf("decision %s: title cannot be empty", d.ID)
+	}
+	if d.Context == "" {
+		return fmt.Errorf("decision %s: context cannot be empty", d.ID)
+	}
+	if d.Outcome == "" {
+		return fmt.Errorf("decision %s: outcome cannot be empty", d.ID)
+	}
+	if d.DecidedBy == "" {
+		return fmt.Errorf("decision %s: decider cannot be empty", d.ID)
+	}
+	if d.AgreementID == "" && d.ApplicationID == "" {
+		return fmt.Errorf("decision %s must be linked to a governance agreement or an application", d.ID)
+	}
+	if d.Supersedes == d.ID {
+		return fmt.Errorf("decision %s cannot supersede itself", d.ID)
+	}
+	for _, option := range d.Options {
+		if option.Name == "" {
+			return fmt.Errorf("decision %s: option name cannot be empty", d.ID)
+		}
+	}
+	return nil
+}
+
+// Supersede marks the decision as replaced by a later one
+func (d *Decision) Supersede(by string) error {
+	if d.Status == DecisionSuperseded {
+		return fmt.Errorf("decision %s is already superseded by %s", d.ID, d.SupersededBy)
+	}
+	d.Status = DecisionSuperseded
+	d.SupersededBy = by
+	return nil
+}
@@ -0,0 +1,203 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// DirectionProposalStatus represents where a proposed strategic direction is in its ratification
+type DirectionProposalStatus string
+
+const (
+	DirectionProposed DirectionProposalStatus = "proposed"
+	DirectionReviewed DirectionProposalStatus = "reviewed"
+	DirectionApproved DirectionProposalStatus = "approved"
+	DirectionRejected DirectionProposalStatus = "rejected"
+)
+
+// DirectionProposal is a strategic direction put to a governance body for ratification: proposed,
+// reviewed, then approved by the body, at which point it takes effect. A proposal can be rejected
+// with comments before it is approved.
+type DirectionProposal struct {
+	ID                string
+	Objectives        []StrategicObjective
+	Initiatives       []StrategicInitiative
+	Rationale         string
+	GoverningBody     string // body that must approve the direction, any when empty
+	Status            DirectionProposalStatus
+	ProposedBy        string
+	ProposedAt        time.Time
+	ReviewedBy        string
+	ReviewComments    string
+	ReviewedAt        time.Time
+	ApprovedBy        string // the governance body that ratified the direction
+	ApprovedAt        time.Time
+	RejectedBy        string
+	RejectionComments string
+	RejectedAt        time.Time
+}
+
+// Open reports whether the proposal still awaits a decision
+func (p DirectionProposal) Open() bool {
+	return p.Status == DirectionProposed || p.Status == DirectionReviewed
+}
+
+// Review records the review of a proposed direction. The reviewer must differ from the proposer.
+func (p *DirectionProposal) Review(reviewer, comments string, at time.Time) error {
+	if reviewer == "" {
+		return errors.New("reviewer cannot be empty")
+	}
+	if p.Status != DirectionProposed {
+		return fmt.Errorf("direction proposal %s is %s, only proposed directions can be reviewed", p.ID, p.Status)
+	}
+	if reviewer == p.ProposedBy {
+		return fmt.Errorf("direction proposal %s must be reviewed by someone other than its proposer", p.ID)
+	}
+
+	p.Status = DirectionReviewed
+	p.ReviewedBy = reviewer
+	p.ReviewComments = comments
+	p.ReviewedAt = at
+	return nil
+}
+
+// Approve ratifies a reviewed direction on behalf of a governance body, which must be the body
+// the proposal names when it names one
+func (p *DirectionProposal) Approve(body string, at time.Time) error {
+	if body == "" {
+		return errors.New("approving governance body cannot be empty")
+	}
+	if p.Status != DirectionReviewed {
+		return fmt.Errorf("direction proposal %s must be reviewed before it is approved", p.ID)
+	}
+	if p.GoverningBody != "" && body != p.GoverningBody {
+		return fmt.Errorf("direction proposal %s must be approved by %s", p.ID, p.GoverningBody)
+	}
+
+	p.Status = DirectionApproved
+	p.ApprovedBy = body
+	p.ApprovedAt = at
+	return nil
+}
+
+// Reject turns down a proposed or reviewed direction with comments explaining why
+func (p *DirectionProposal) Reject(rejectedBy, comments string, at time.Time) error {
+	if rejectedBy == "" {
+		return errors.New("rejecting party cannot be empty")
+	}
+	if comments == "" {
+		return fmt.Errorf("direction proposal %s: rejection comments cannot be empty", p.ID)
+	}
+	if !p.Open() {
+		return fmt.Errorf("direction proposal %s is %s, only open proposals can be rejected", p.ID, p.Status)
+	}
+
+	p.Status = DirectionRejected
+	p.RejectedBy = rejectedBy
+	p.RejectionComments = comments
+	p.RejectedAt = at
+	return nil
+}
+
+// ProposeStrategicDirection puts a strategic direction to the agreement's governance body. The
+// direction is validated now but only takes effect once approved. An agreement has at most one
+// open proposal.
+func (s *DirectionService) ProposeStrategicDirection(ctx context.Context, agreementID GovernanceAgreementID, proposal DirectionProposal) (*DirectionProposal, error) {
+	if proposal.ID == "" {
+		return nil, errors.New("direction proposal ID cannot be empty")
+	}
+	if proposal.ProposedBy == "" {
+		return nil, fmt.Errorf("direction proposal %s: proposer cannot be empty", proposal.ID)
+	}
+	if err := validateObjectives(proposal.Objectives); err != nil {
+		return nil, fmt.Errorf("invalid strategic objectives: %w", err)
+	}
+	if err := validateInitiatives(proposal.Initiatives, proposal.Objectives); err != nil {
+		return nil, fmt.Errorf("invalid strategic initiatives: %w", err)
+	}
+
+	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+
+	for _, existing := range agreement.Direct.DirectionProposals {
+		if existing.ID == proposal.ID {
+			return nil, fmt.Errorf("direction proposal %s already exists in agreement %s", proposal.ID, agreementID)
+		}
+		if existing.Open() {
+			return nil, fmt.Errorf("direction proposal %s of agreement %s is still %s", existing.ID, agreementID, existing.Status)
+		}
+	}
+
+	proposal.Status = DirectionProposed
+	proposal.ProposedAt = time.Now()
+	agreement.Direct.DirectionProposals = append(append([]DirectionProposal{}, agreement.Direct.DirectionProposals...), proposal)
+
+	err = s.agreementRepo.Update(ctx, agreement)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update governance agreement: %w", err)
+	}
+	return &proposal, nil
+}
+
+// ReviewStrategicDirection records the review of one of the agreement's proposed directions
+func (s *DirectionService) ReviewStrategicDirection(ctx context.Context, agreementID GovernanceAgreementID, proposalID, reviewer, comments string) (*DirectionProposal, error) {
+	return s.transitionDirection(ctx, agreementID, proposalID, func(agreement *GovernanceAgreement, proposal *DirectionProposal, now time.Time) error {
+		return proposal.Review(reviewer, comments, now)
+	})
+}
+
+// ApproveStrategicDirection ratifies one of the agreement's reviewed directions on behalf of a
+// governance body and puts it into effect
+func (s *DirectionService) ApproveStrategicDirection(ctx context.Context, agreementID GovernanceAgreementID, proposalID, body string) (*DirectionProposal, error) {
+	return s.transitionDirection(ctx, agreementID, proposalID, func(agreement *GovernanceAgreement, proposal *DirectionProposal, now time.Time) error {
+		if err := proposal.Approve(body, now); err != nil {
+			return err
+		}
+		return s.applyStrategicDirection(agreement, proposal.Objectives, proposal.Initiatives, now)
+	})
+}
+
+// RejectStrategicDirection turns down one of the agreement's open direction proposals
+func (s *DirectionService) RejectStrategicDirection(ctx context.Context, agreementID GovernanceAgreementID, proposalID, rejectedBy, comments string) (*DirectionProposal, error) {
+	return s.transitionDirection(ctx, agreementID, proposalID, func(agreement *GovernanceAgreement, proposal *DirectionProposal, now time.Time) error {
+		return proposal.Reject(rejectedBy, comments, now)
+	})
+}
+
+// transitionDirection applies a ratification step to one of the agreement's direction proposals
+// and saves it
+func (s *DirectionService) transitionDirection(ctx context.Context, agreementID GovernanceAgreementID, proposalID string, transition func(*GovernanceAgreement, *DirectionProposal, time.Time) error) (*DirectionProposal, error) {
+	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+
+	proposals := agreement.Direct.DirectionProposals
+	for i := range proposals {
+		if proposals[i].ID != proposalID {
+			continue
+		}
+
+		proposal := proposals[i]
+		if err := transition(&agreement, &proposal, time.Now()); err != nil {
+			return nil, err
+		}
+
+		updated := make([]DirectionProposal, len(proposals))
+		copy(updated, proposals)
+		updated[i] = proposal
+		agreement.Direct.DirectionProposals = updated
+
+		err = s.agreementRepo.Update(ctx, agreement)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update governance agreement: %w", err)
+		}
+		return &proposal, nil
+	}
+
+	return nil, fmt.Errorf("direction proposal %s not found in agreement %s", proposalID, agreementID)
+}
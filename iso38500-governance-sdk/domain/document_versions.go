@@ -0,0 +1,514 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DocumentKind identifies the kind of governance document in a policy framework
+type DocumentKind string
+
+const (
+	DocumentPolicy    DocumentKind = "policy"
+	DocumentStandard  DocumentKind = "standard"
+	DocumentProcedure DocumentKind = "procedure"
+)
+
+// FieldChange records one field of a governance document changing between versions
+type FieldChange struct {
+	Field string
+	From  string
+	To    string
+}
+
+// RedlineOp says whether a redline segment was kept, inserted or deleted
+type RedlineOp string
+
+const (
+	RedlineEqual  RedlineOp = "equal"
+	RedlineInsert RedlineOp = "insert"
+	RedlineDelete RedlineOp = "delete"
+)
+
+// RedlineSegment is a run of words kept, inserted or deleted between two texts
+type RedlineSegment struct {
+	Op   RedlineOp
+	Text string
+}
+
+// DocumentVersion is one version of a policy, standard or procedure: a snapshot of the document
+// and what changed from the version before it
+type DocumentVersion struct {
+	Kind       DocumentKind
+	DocumentID string
+	Version    int
+	Policy     *Policy    // snapshot, set for policies
+	Standard   *Standard  // snapshot, set for standards
+	Procedure  *Procedure // snapshot, set for procedures
+	Changes    []FieldChange
+	Redline    []RedlineSegment // the description against the previous version
+	Summary    string
+	ChangedBy  string
+	ChangedAt  time.Time
+}
+
+// DocumentPin pins an agreement to one version of a governance document
+type DocumentPin struct {
+	Kind       DocumentKind
+	DocumentID string
+	Version    int
+	PinnedBy   string
+	PinnedAt   time.Time
+}
+
+// History returns every version of a document, oldest first
+func (f PolicyFramework) History(kind DocumentKind, documentID string) []DocumentVersion {
+	history := []DocumentVersion{}
+	for _, version := range f.Versions {
+		if version.Kind == kind && version.DocumentID == documentID {
+			history = append(history, version)
+		}
+	}
+	return history
+}
+
+// FindVersion returns one version of a document
+func (f PolicyFramework) FindVersion(kind DocumentKind, documentID string, version int) (DocumentVersion, bool) {
+	for _, recorded := range f.Versions {
+		if recorded.Kind == kind && recorded.DocumentID == documentID && recorded.Version == version {
+			return recorded, true
+		}
+	}
+	return DocumentVersion{}, false
+}
+
+// PinnedVersion returns the version of a document the agreement is pinned to
+func (f PolicyFramework) PinnedVersion(kind DocumentKind, documentID string) (int, bool) {
+	for _, pin := range f.Pins {
+		if pin.Kind == kind && pin.DocumentID == documentID {
+			return pin.Version, true
+		}
+	}
+	return 0, false
+}
+
+// EffectivePolicy returns the policy the agreement follows: the pinned version when the policy is
+// pinned, the current policy otherwise
+func (f PolicyFramework) EffectivePolicy(policyID string) (Policy, bool) {
+	if pinned, ok := f.PinnedVersion(DocumentPolicy, policyID); ok {
+		if version, ok := f.FindVersion(DocumentPolicy, policyID, pinned); ok && version.Policy != nil {
+			return *version.Policy, true
+		}
+	}
+	for _, policy := range f.Policies {
+		if policy.ID == policyID {
+			return policy, true
+		}
+	}
+	return Policy{}, false
+}
+
+// Redline compares two texts word by word
+func Redline(from, to string) []RedlineSegment {
+	a, b := strings.Fields(from), strings.Fields(to)
+
+	// Longest common subsequence of words, lcs[i][j] covering a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	segments := []RedlineSegment{}
+	add := func(op RedlineOp, word string) {
+		if n := len(segments); n > 0 && segments[n-1].Op == op {
+			segments[n-1].Text += " " + word
+			return
+		}
+		segments = append(segments, RedlineSegment{Op: op, Text: word})
+	}
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			add(RedlineEqual, a[i])
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			add(RedlineDelete, a[i])
+			i++
+		default:
+			add(RedlineInsert, b[j])
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		add(RedlineDelete, a[i])
+	}
+	for ; j < len(b); j++ {
+		add(RedlineInsert, b[j])
+	}
+	return segments
+}
+
+// FormatRedline renders a redline with deletions as [-text-] and insertions as {+text+}
+func FormatRedline(segments []RedlineSegment) string {
+	parts := make([]string, len(segments))
+	for i, segment := range segments {
+		switch segment.Op {
+		case RedlineDelete:
+			parts[i] = "[-" + segment.Text + "-]"
+		case RedlineInsert:
+			parts[i] = "{+" + segment.Text + "+}"
+		default:
+			parts[i] = segment.Text
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// documentField is a named field of a governance document compared between versions
+type documentField struct {
+	name  string
+	value string
+}
+
+func policyFields(p Policy) []documentField {
+	return []documentField{{"name", p.Name}, {"description", p.Description}, {"scope", p.Scope}, {"owner", p.Owner}}
+}
+
+func standardFields(s Standard) []documentField {
+	return []documentField{{"name", s.Name}, {"description", s.Description}, {"category", s.Category}, {"mandatory", strconv.FormatBool(s.Mandatory)}}
+}
+
+func procedureFields(p Procedure) []documentField {
+	steps := make([]string, len(p.Steps))
+	for i, step := range p.Steps {
+		steps[i] = fmt.Sprintf("%d. %s (%s)", step.StepNumber, step.Description, step.Responsible)
+	}
+	return []documentField{{"name", p.Name}, {"description", p.Description}, {"steps", strings.Join(steps, "; ")}}
+}
+
+// fieldValue returns the value of a named field, empty when the document has no such field
+func fieldValue(fields []documentField, name string) string {
+	for _, field := range fields {
+		if field.name == name {
+			return field.value
+		}
+	}
+	return ""
+}
+
+// fieldChanges lists the fields that differ between two versions of a document
+func fieldChanges(from, to []documentField) []FieldChange {
+	changes := []FieldChange{}
+	for i := range to {
+		previous := ""
+		if i < len(from) {
+			previous = from[i].value
+		}
+		if previous != to[i].value {
+			changes = append(changes, FieldChange{Field: to[i].name, From: previous, To: to[i].value})
+		}
+	}
+	return changes
+}
+
+// newDocumentVersion builds the next version of a document from the fields of its previous and
+// new content
+func newDocumentVersion(kind DocumentKind, documentID string, version int, from, to []documentField, changedBy, summary string, at time.Time) DocumentVersion {
+	return DocumentVersion{
+		Kind:       kind,
+		DocumentID: documentID,
+		Version:    version,
+		Changes:    fieldChanges(from, to),
+		Redline:    Redline(fieldValue(from, "description"), fieldValue(to, "description")),
+		Summary:    summary,
+		ChangedBy:  changedBy,
+		ChangedAt:  at,
+	}
+}
+
+// versionFramework numbers the documents of a new policy framework against the current one and
+// records a version for each document that is new or changed. Documents that did not change keep
+// their version.
+func versionFramework(current *PolicyFramework, policies []Policy, standards []Standard, procedures []Procedure, changedBy string, at time.Time) ([]Policy, []Standard, []Procedure) {
+	current.Versions = append([]DocumentVersion{}, current.Versions...)
+
+	versionedPolicies := make([]Policy, len(policies))
+	for i, policy := range policies {
+		var from []documentField
+		for _, existing := range current.Policies {
+			if existing.ID == policy.ID {
+				from = policyFields(existing)
+				policy.Version = existing.Version
+			}
+		}
+		if changes := fieldChanges(from, policyFields(policy)); from == nil || len(changes) > 0 {
+			policy.Version++
+			version := newDocumentVersion(DocumentPolicy, policy.ID, policy.Version, from, policyFields(policy), changedBy, "Established", at)
+			snapshot := policy
+			version.Policy = &snapshot
+			current.Versions = append(current.Versions, version)
+		}
+		versionedPolicies[i] = policy
+	}
+
+	versionedStandards := make([]Standard, len(standards))
+	for i, standard := range standards {
+		var from []documentField
+		for _, existing := range current.Standards {
+			if existing.ID == standard.ID {
+				from = standardFields(existing)
+				standard.Version = existing.Version
+			}
+		}
+		if changes := fieldChanges(from, standardFields(standard)); from == nil || len(changes) > 0 {
+			standard.Version++
+			version := newDocumentVersion(DocumentStandard, standard.ID, standard.Version, from, standardFields(standard), changedBy, "Established", at)
+			snapshot := standard
+			version.Standard = &snapshot
+			current.Versions = append(current.Versions, version)
+		}
+		versionedStandards[i] = standard
+	}
+
+	versionedProcedures := make([]Procedure, len(procedures))
+	for i, procedure := range procedures {
+		var from []documentField
+		for _, existing := range current.Procedures {
+			if existing.ID == procedure.ID {
+				from = procedureFields(existing)
+				procedure.Version = existing.Version
+			}
+		}
+		if changes := fieldChanges(from, procedureFields(procedure)); from == nil || len(changes) > 0 {
+			procedure.Version++
+			version := newDocumentVersion(DocumentProcedure, procedure.ID, procedure.Version, from, procedureFields(procedure), changedBy, "Established", at)
+			snapshot := procedure
+			snapshot.Steps = append([]ProcedureStep{}, procedure.Steps...)
+			version.Procedure = &snapshot
+			current.Versions = append(current.Versions, version)
+		}
+		versionedProcedures[i] = procedure
+	}
+
+	return versionedPolicies, versionedStandards, versionedProcedures
+}
+
+// refreshPolicySnapshot replaces the snapshot of the policy's current version, so a version keeps
+// the lifecycle state it reached
+func (f *PolicyFramework) refreshPolicySnapshot(policy Policy) {
+	for i, version := range f.Versions {
+		if version.Kind == DocumentPolicy && version.DocumentID == policy.ID && version.Version == policy.Version {
+			snapshot := policy
+			versions := append([]DocumentVersion{}, f.Versions...)
+			versions[i].Policy = &snapshot
+			f.Versions = versions
+			return
+		}
+	}
+}
+
+// RevisePolicy records a new version of one of the agreement's policies with the revised name,
+// description, scope and owner. A policy that was submitted, approved or published returns to
+// draft and goes through approval again; pin the agreement to the previous version to keep
+// following it meanwhile.
+func (s *DirectionService) RevisePolicy(ctx context.Context, agreementID GovernanceAgreementID, revised Policy, changedBy, summary string) (*DocumentVersion, error) {
+	if changedBy == "" {
+		return nil, errors.New("changed by cannot be empty")
+	}
+
+	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+
+	framework := &agreement.Direct.PolicyFramework
+	for i, policy := range framework.Policies {
+		if policy.ID != revised.ID {
+			continue
+		}
+		if policy.Status == PolicyRetired {
+			return nil, fmt.Errorf("policy %s is retired and cannot be revised", policy.ID)
+		}
+
+		from := policyFields(policy)
+		policy.Name, policy.Description, policy.Scope, policy.Owner = revised.Name, revised.Description, revised.Scope, revised.Owner
+		if len(fieldChanges(from, policyFields(policy))) == 0 {
+			return nil, fmt.Errorf("revision of policy %s changes nothing", policy.ID)
+		}
+		if policy.Name == "" {
+			return nil, fmt.Errorf("policy %s: name cannot be empty", policy.ID)
+		}
+
+		policy.Version++
+		if policy.Status != PolicyDraft {
+			policy.Status = PolicyDraft
+			policy.SubmittedBy, policy.SubmittedAt = "", time.Time{}
+			policy.ApprovedBy, policy.ApprovedAt = "", time.Time{}
+			policy.PublishedAt, policy.EffectiveFrom, policy.EffectiveUntil = time.Time{}, time.Time{}, time.Time{}
+		}
+
+		version := newDocumentVersion(DocumentPolicy, policy.ID, policy.Version, from, policyFields(policy), changedBy, summary, time.Now())
+		snapshot := policy
+		version.Policy = &snapshot
+
+		policies := append([]Policy{}, framework.Policies...)
+		policies[i] = policy
+		framework.Policies = policies
+		framework.Versions = append(append([]DocumentVersion{}, framework.Versions...), version)
+
+		err = s.agreementRepo.Update(ctx, agreement)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update governance agreement: %w", err)
+		}
+		return &version, nil
+	}
+
+	return nil, fmt.Errorf("policy %s not found in agreement %s", revised.ID, agreementID)
+}
+
+// ReviseStandard records a new version of one of the agreement's standards
+func (s *DirectionService) ReviseStandard(ctx context.Context, agreementID GovernanceAgreementID, revised Standard, changedBy, summary string) (*DocumentVersion, error) {
+	if changedBy == "" {
+		return nil, errors.New("changed by cannot be empty")
+	}
+
+	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+
+	framework := &agreement.Direct.PolicyFramework
+	for i, standard := range framework.Standards {
+		if standard.ID != revised.ID {
+			continue
+		}
+
+		from := standardFields(standard)
+		revised.Version = standard.Version + 1
+		if len(fieldChanges(from, standardFields(revised))) == 0 {
+			return nil, fmt.Errorf("revision of standard %s changes nothing", standard.ID)
+		}
+
+		version := newDocumentVersion(DocumentStandard, standard.ID, revised.Version, from, standardFields(revised), changedBy, summary, time.Now())
+		snapshot := revised
+		version.Standard = &snapshot
+
+		standards := append([]Standard{}, framework.Standards...)
+		standards[i] = revised
+		framework.Standards = standards
+		framework.Versions = append(append([]DocumentVersion{}, framework.Versions...), version)
+
+		err = s.agreementRepo.Update(ctx, agreement)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update governance agreement: %w", err)
+		}
+		return &version, nil
+	}
+
+	return nil, fmt.Errorf("standard %s not found in agreement %s", revised.ID, agreementID)
+}
+
+// ReviseProcedure records a new version of one of the agreement's procedures
+func (s *DirectionService) ReviseProcedure(ctx context.Context, agreementID GovernanceAgreementID, revised Procedure, changedBy, summary string) (*DocumentVersion, error) {
+	if changedBy == "" {
+		return nil, errors.New("changed by cannot be empty")
+	}
+
+	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+
+	framework := &agreement.Direct.PolicyFramework
+	for i, procedure := range framework.Procedures {
+		if procedure.ID != revised.ID {
+			continue
+		}
+
+		from := procedureFields(procedure)
+		revised.Version = procedure.Version + 1
+		if len(fieldChanges(from, procedureFields(revised))) == 0 {
+			return nil, fmt.Errorf("revision of procedure %s changes nothing", procedure.ID)
+		}
+
+		version := newDocumentVersion(DocumentProcedure, procedure.ID, revised.Version, from, procedureFields(revised), changedBy, summary, time.Now())
+		snapshot := revised
+		snapshot.Steps = append([]ProcedureStep{}, revised.Steps...)
+		version.Procedure = &snapshot
+
+		procedures := append([]Procedure{}, framework.Procedures...)
+		procedures[i] = revised
+		framework.Procedures = procedures
+		framework.Versions = append(append([]DocumentVersion{}, framework.Versions...), version)
+
+		err = s.agreementRepo.Update(ctx, agreement)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update governance agreement: %w", err)
+		}
+		return &version, nil
+	}
+
+	return nil, fmt.Errorf("procedure %s not found in agreement %s", revised.ID, agreementID)
+}
+
+// PinDocumentVersion pins the agreement to a recorded version of one of its documents. Version 0
+// removes the pin so the agreement follows the current version again.
+func (s *DirectionService) PinDocumentVersion(ctx context.Context, agreementID GovernanceAgreementID, kind DocumentKind, documentID string, version int, pinnedBy string) error {
+	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
+	if err != nil {
+		return fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+
+	framework := &agreement.Direct.PolicyFramework
+	if version != 0 {
+		if _, ok := framework.FindVersion(kind, documentID, version); !ok {
+			return fmt.Errorf("%s %s has no version %d", kind, documentID, version)
+		}
+	}
+
+	pins := []DocumentPin{}
+	for _, pin := range framework.Pins {
+		if pin.Kind != kind || pin.DocumentID != documentID {
+			pins = append(pins, pin)
+		}
+	}
+	if version != 0 {
+		pins = append(pins, DocumentPin{Kind: kind, DocumentID: documentID, Version: version, PinnedBy: pinnedBy, PinnedAt: time.Now()})
+	}
+	framework.Pins = pins
+
+	err = s.agreementRepo.Update(ctx, agreement)
+	if err != nil {
+		return fmt.Errorf("failed to update governance agreement: %w", err)
+	}
+	return nil
+}
+
+// DocumentHistory returns every version of one of the agreement's documents, oldest first
+func (s *DirectionService) DocumentHistory(ctx context.Context, agreementID GovernanceAgreementID, kind DocumentKind, documentID string) ([]DocumentVersion, error) {
+	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+
+	history := agreement.Direct.PolicyFramework.History(kind, documentID)
+	if len(history) == 0 {
+		return nil, fmt.Errorf("no history for %s %s in agreement %s", kind, documentID, agreementID)
+	}
+	return history, nil
+}
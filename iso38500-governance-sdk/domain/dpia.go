@@ -0,0 +1,400 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// DPIAStatus is the stage a data protection impact assessment (DPIA) has reached
+type DPIAStatus string
+
+const (
+	DPIADraft     DPIAStatus = "draft"     // being answered and its risks mitigated
+	DPIASubmitted DPIAStatus = "submitted" // awaiting the data protection officer's decision
+	DPIAApproved  DPIAStatus = "approved"
+	DPIARejected  DPIAStatus = "rejected" // returned by the data protection officer for rework
+)
+
+// DPIAAnswer is the answer to a DPIA screening question
+type DPIAAnswer string
+
+const (
+	DPIAYes DPIAAnswer = "yes"
+	DPIANo  DPIAAnswer = "no"
+)
+
+// Validate ensures the answer is yes or no
+func (a DPIAAnswer) Validate() error {
+	switch a {
+	case DPIAYes, DPIANo:
+		return nil
+	}
+	return fmt.Errorf("unknown DPIA answer %q", a)
+}
+
+// DPIAQuestion is a screening question of a DPIA. Answering yes identifies the privacy risk it
+// names.
+type DPIAQuestion struct {
+	ID       string
+	Text     string
+	Risk     string    // the privacy risk the processing carries when answered yes
+	Severity RiskLevel // inherent level of that risk
+	Answer   DPIAAnswer
+	Notes    string
+}
+
+// DefaultDPIAQuestions returns the screening questions for the criteria under which processing
+// is likely to result in a high risk to individuals, after the EDPB guidelines on DPIAs
+func DefaultDPIAQuestions() []DPIAQuestion {
+	return []DPIAQuestion{
+		{ID: "profiling", Text: "Does the processing evaluate or score individuals, including profiling and predicting their behaviour?",
+			Risk: "Profiling leads to inaccurate or discriminatory conclusions about individuals", Severity: RiskHigh},
+		{ID: "automated-decisions", Text: "Are decisions with legal or similarly significant effects on individuals taken automatically?",
+			Risk: "Individuals are denied services or rights without human review", Severity: RiskHigh},
+		{ID: "monitoring", Text: "Does the processing systematically monitor individuals, including in publicly accessible areas?",
+			Risk: "Individuals are monitored without knowing or being able to avoid it", Severity: RiskHigh},
+		{ID: "special-categories", Text: "Does the processing involve health, biometric, genetic or other special category data, or data on criminal convictions?",
+			Risk: "Disclosure of sensitive data causes discrimination, distress or harm", Severity: RiskHigh},
+		{ID: "large-scale", Text: "Is personal data processed on a large scale, by the number of individuals, volume, duration or geographic extent?",
+			Risk: "A breach affects a large number of individuals", Severity: RiskMedium},
+		{ID: "combined-datasets", Text: "Are datasets from different sources or purposes matched or combined?",
+			Risk: "Data is used for purposes individuals would not reasonably expect", Severity: RiskMedium},
+		{ID: "vulnerable-subjects", Text: "Does the processing concern vulnerable individuals, such as children, employees or patients?",
+			Risk: "Individuals unable to object or to exercise their rights are harmed", Severity: RiskHigh},
+		{ID: "innovative-technology", Text: "Does the processing use new technology or apply technology in a new way, such as machine learning or biometrics?",
+			Risk: "Unforeseen consequences of the technology harm individuals", Severity: RiskMedium},
+		{ID: "rights-restriction", Text: "Does the processing prevent individuals from exercising a right or using a service or contract?",
+			Risk: "Individuals are excluded from a service or contract", Severity: RiskMedium},
+		{ID: "international-transfers", Text: "Is personal data transferred to countries without an adequate level of data protection?",
+			Risk: "Data transferred abroad is accessed by authorities or parties without adequate safeguards", Severity: RiskMedium},
+	}
+}
+
+// PrivacyMitigation is a measure reducing a privacy risk
+type PrivacyMitigation struct {
+	Description string
+	Owner       string
+	Implemented bool // planned until implemented
+}
+
+// PrivacyRisk is a risk the processing carries for individuals, with the measures mitigating it
+type PrivacyRisk struct {
+	ID            string
+	Description   string
+	Source        string    // the screening question that identified the risk; empty when added by hand
+	Level         RiskLevel // before mitigation
+	Mitigations   []PrivacyMitigation
+	ResidualLevel RiskLevel // after mitigation; empty until assessed
+}
+
+// Residual is the level of the risk after mitigation, its inherent level until assessed
+func (r PrivacyRisk) Residual() RiskLevel {
+	if r.ResidualLevel != "" {
+		return r.ResidualLevel
+	}
+	return r.Level
+}
+
+// Validate ensures the risk has an ID, a description and known levels
+func (r PrivacyRisk) Validate() error {
+	if r.ID == "" {
+		return errors.New("privacy risk ID cannot be empty")
+	}
+	if r.Description == "" {
+		return fmt.Errorf("privacy risk %s must be described", r.ID)
+	}
+	if riskScore(r.Level) == 0 {
+		return fmt.Errorf("privacy risk %s has unknown level %q", r.ID, r.Level)
+	}
+	if r.ResidualLevel != "" && riskScore(r.ResidualLevel) == 0 {
+		return fmt.Errorf("privacy risk %s has unknown residual level %q", r.ID, r.ResidualLevel)
+	}
+	return nil
+}
+
+// DPIA is a data protection impact assessment of an application processing personal data. The
+// assessor answers the screening questions, which identifies the privacy risks of the
+// processing, and mitigates them before submitting the DPIA to the data protection officer
+// (DPO), who approves it or returns it for rework. An approved DPIA is reviewed again by its
+// review date.
+type DPIA struct {
+	ID            string
+	ApplicationID ApplicationID
+	Title         string
+	Assessor      string
+	Status        DPIAStatus
+	Questions     []DPIAQuestion
+	Risks         []PrivacyRisk
+	DPO           string // the data protection officer who decided on the DPIA
+	DPOOpinion    string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	SubmittedAt   time.Time
+	DecidedAt     time.Time
+	ReviewBy      time.Time // when an approved DPIA must be reviewed again
+}
+
+// Validate ensures the DPIA names its application and assessor and that its risks are valid
+func (d DPIA) Validate() error {
+	if d.ID == "" {
+		return errors.New("DPIA ID cannot be empty")
+	}
+	if d.ApplicationID == "" {
+		return fmt.Errorf("DPIA %s must name its application", d.ID)
+	}
+	if d.Assessor == "" {
+		return fmt.Errorf("DPIA %s must name its assessor", d.ID)
+	}
+	seen := make(map[string]bool, len(d.Risks))
+	for _, risk := range d.Risks {
+		if err := risk.Validate(); err != nil {
+			return err
+		}
+		if seen[risk.ID] {
+			return fmt.Errorf("DPIA %s has privacy risk %s twice", d.ID, risk.ID)
+		}
+		seen[risk.ID] = true
+	}
+	return nil
+}
+
+// Editable reports whether the DPIA can still be answered and its risks changed
+func (d DPIA) Editable() bool {
+	return d.Status == DPIADraft || d.Status == DPIARejected
+}
+
+// IdentifyRisks adds a privacy risk for each screening question answered yes and removes those
+// identified by questions since answered no. Risks added by hand are kept.
+func (d *DPIA) IdentifyRisks() {
+	answers := make(map[string]DPIAAnswer, len(d.Questions))
+	for _, question := range d.Questions {
+		answers[question.ID] = question.Answer
+	}
+
+	var risks []PrivacyRisk
+	identified := make(map[string]bool)
+	for _, risk := range d.Risks {
+		if risk.Source != "" && answers[risk.Source] != DPIAYes {
+			continue
+		}
+		identified[risk.Source] = true
+		risks = append(risks, risk)
+	}
+	for _, question := range d.Questions {
+		if question.Answer != DPIAYes || identified[question.ID] {
+			continue
+		}
+		risks = append(risks, PrivacyRisk{
+			ID:          "risk-" + question.ID,
+			Description: question.Risk,
+			Source:      question.ID,
+			Level:       question.Severity,
+		})
+	}
+	d.Risks = risks
+}
+
+// ReadyForReview returns an error unless every screening question is answered and every high or
+// critical risk has a mitigation and an assessed residual level
+func (d DPIA) ReadyForReview() error {
+	for _, question := range d.Questions {
+		if question.Answer == "" {
+			return fmt.Errorf("question %s of DPIA %s is not answered", question.ID, d.ID)
+		}
+	}
+	for _, risk := range d.Risks {
+		if riskScore(risk.Level) < riskScore(RiskHigh) {
+			continue
+		}
+		if len(risk.Mitigations) == 0 {
+			return fmt.Errorf("%s privacy risk %s of DPIA %s has no mitigation", risk.Level, risk.ID, d.ID)
+		}
+		if risk.ResidualLevel == "" {
+			return fmt.Errorf("residual level of privacy risk %s of DPIA %s is not assessed", risk.ID, d.ID)
+		}
+	}
+	return nil
+}
+
+// ResidualRisk is the highest residual level of the DPIA's risks, low when it identified none
+func (d DPIA) ResidualRisk() RiskLevel {
+	level := RiskLow
+	for _, risk := range d.Risks {
+		if riskScore(risk.Residual()) > riskScore(level) {
+			level = risk.Residual()
+		}
+	}
+	return level
+}
+
+// PriorConsultationRequired reports whether a high residual risk remains, which obliges the
+// organization to consult the supervisory authority before processing (GDPR Art. 36)
+func (d DPIA) PriorConsultationRequired() bool {
+	return riskScore(d.ResidualRisk()) >= riskScore(RiskHigh)
+}
+
+// ReviewDue reports whether an approved DPIA has passed its review date
+func (d DPIA) ReviewDue(now time.Time) bool {
+	return d.Status == DPIAApproved && !d.ReviewBy.IsZero() && now.After(d.ReviewBy)
+}
+
+// PlannedMitigations counts the mitigations not implemented yet
+func (d DPIA) PlannedMitigations() int {
+	planned := 0
+	for _, risk := range d.Risks {
+		for _, mitigation := range risk.Mitigations {
+			if !mitigation.Implemented {
+				planned++
+			}
+		}
+	}
+	return planned
+}
+
+// ConformanceStatus is the compliance status of the application's DPIA requirement: compliant
+// once approved, partial while approved with a high residual risk or past its review date,
+// non-compliant when rejected and under review otherwise
+func (d DPIA) ConformanceStatus(now time.Time) ComplianceStatus {
+	switch d.Status {
+	case DPIAApproved:
+		if d.PriorConsultationRequired() || d.ReviewDue(now) {
+			return CompliancePartial
+		}
+		return ComplianceCompliant
+	case DPIARejected:
+		return ComplianceNonCompliant
+	}
+	return ComplianceUnderReview
+}
+
+// LatestDPIA returns the most recently started of an application's DPIAs
+func LatestDPIA(dpias []DPIA) (DPIA, bool) {
+	if len(dpias) == 0 {
+		return DPIA{}, false
+	}
+	sorted := append([]DPIA{}, dpias...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].CreatedAt.After(sorted[j].CreatedAt) })
+	return sorted[0], true
+}
+
+// DPIARequirementName names the legal requirement a DPIA records in the conformance component of
+// its application's governance agreement
+const DPIARequirementName = "Data protection impact assessment"
+
+// ApplyDPIA records the status of the DPIA as the agreement's DPIA legal requirement, adding the
+// requirement when the conformance component does not name it yet
+func ApplyDPIA(conformance Conformance, dpia DPIA, now time.Time) Conformance {
+	status := dpia.ConformanceStatus(now)
+	if setComplianceStatus(&conformance, RequirementRef{Kind: RequirementLegal, Name: DPIARequirementName}, status) {
+		return conformance
+	}
+	conformance.LegalRequirements = append(conformance.LegalRequirements, LegalRequirement{
+		Name:          DPIARequirementName,
+		Description:   fmt.Sprintf("Assess the impact of the processing of personal data on individuals (DPIA %s)", dpia.ID),
+		Authority:     "GDPR Art. 35",
+		EffectiveDate: dpia.CreatedAt,
+		Status:        status,
+		Criticality:   PriorityHigh,
+	})
+	return conformance
+}
+
+// DPIAFinding summarizes the DPIA of an application processing personal data in its assessment
+type DPIAFinding struct {
+	DPIAID                    string // empty when the application has no DPIA
+	Status                    DPIAStatus
+	ResidualRisk              RiskLevel // of an approved DPIA
+	PriorConsultationRequired bool
+	ReviewDue                 bool
+	PlannedMitigations        int
+}
+
+// Approved reports whether the application has an approved DPIA not past its review date
+func (f DPIAFinding) Approved() bool {
+	return f.Status == DPIAApproved && !f.ReviewDue
+}
+
+// dpiaFinding summarizes the latest DPIA of an application, nil when it processes no personal data
+func dpiaFinding(app Application, dpias []DPIA, now time.Time) *DPIAFinding {
+	if !app.DataClassification.PII && !app.DataClassification.PHI {
+		return nil
+	}
+	finding := &DPIAFinding{}
+	dpia, found := LatestDPIA(dpias)
+	if !found {
+		return finding
+	}
+	finding.DPIAID = dpia.ID
+	finding.Status = dpia.Status
+	finding.ReviewDue = dpia.ReviewDue(now)
+	finding.PlannedMitigations = dpia.PlannedMitigations()
+	if dpia.Status == DPIAApproved {
+		finding.ResidualRisk = dpia.ResidualRisk()
+		finding.PriorConsultationRequired = dpia.PriorConsultationRequired()
+	}
+	return finding
+}
+
+// escalateForDPIA raises the risk level of an application processing personal data to the
+// residual risk of its approved DPIA or, without one, to medium, or high for health information
+// and restricted data
+func escalateForDPIA(level RiskLevel, classification DataClassification, finding *DPIAFinding) RiskLevel {
+	if finding == nil {
+		return level
+	}
+	floor := finding.ResidualRisk
+	if !finding.Approved() {
+		floor = RiskMedium
+		if classification.PHI || classification.Level == DataRestricted {
+			floor = RiskHigh
+		}
+	}
+	if riskScore(floor) > riskScore(level) {
+		return floor
+	}
+	return level
+}
+
+// dpiaRecommendations asks for the DPIA of an application processing personal data to be carried
+// out, completed, reworked or reviewed, and for the risks it leaves to be addressed
+func dpiaRecommendations(finding *DPIAFinding) []Recommendation {
+	if finding == nil {
+		return nil
+	}
+	var recommendations []Recommendation
+	add := func(description string, priority Priority, effort time.Duration, impact string) {
+		recommendations = append(recommendations, Recommendation{
+			ID:              fmt.Sprintf("dpia-%03d", len(recommendations)+1),
+			Type:            RecEnhance,
+			Description:     description,
+			Priority:        priority,
+			EstimatedEffort: effort,
+			BusinessImpact:  impact,
+		})
+	}
+
+	const impact = "Demonstrate that the processing of personal data is lawful and its risks to individuals are controlled"
+	switch {
+	case finding.DPIAID == "":
+		add("Carry out a data protection impact assessment of the processing of personal data", PriorityHigh, 40*time.Hour, impact)
+	case finding.Status == DPIADraft:
+		add(fmt.Sprintf("Complete DPIA %s and submit it to the data protection officer", finding.DPIAID), PriorityMedium, 16*time.Hour, impact)
+	case finding.Status == DPIASubmitted:
+		add(fmt.Sprintf("Have the data protection officer decide on DPIA %s", finding.DPIAID), PriorityMedium, 4*time.Hour, impact)
+	case finding.Status == DPIARejected:
+		add(fmt.Sprintf("Rework DPIA %s as the data protection officer asked and submit it again", finding.DPIAID), PriorityHigh, 24*time.Hour, impact)
+	case finding.ReviewDue:
+		add(fmt.Sprintf("Review DPIA %s, which is past its review date", finding.DPIAID), PriorityMedium, 16*time.Hour, impact)
+	}
+
+	if finding.PriorConsultationRequired {
+		add("Consult the supervisory authority about the high residual privacy risk before processing", PriorityCritical, 24*time.Hour,
+			"Processing with a high residual risk without prior consultation breaches GDPR Art. 36")
+	}
+	if finding.Approved() && finding.PlannedMitigations > 0 {
+		add(fmt.Sprintf("Implement the %d planned privacy mitigations of DPIA %s", finding.PlannedMitigations, finding.DPIAID), PriorityMedium, 40*time.Hour, impact)
+	}
+	return recommendations
+}
@@ -0,0 +1,242 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// TechnologyKind identifies the layer of the stack a technology component belongs to
+type TechnologyKind string
+
+const (
+	TechnologyOperatingSystem TechnologyKind = "operating_system"
+	TechnologyLanguage        TechnologyKind = "language"
+	TechnologyFramework       TechnologyKind = "framework"
+	TechnologyRuntime         TechnologyKind = "runtime"
+	TechnologyDatabase        TechnologyKind = "database"
+)
+
+// TechnologyComponent is a vendor product or runtime an application depends on
+type TechnologyComponent struct {
+	Kind         TechnologyKind
+	Name         string
+	Version      string
+	Vendor       string
+	EndOfSupport time.Time // zero when the vendor has not announced one
+}
+
+// Label names the component and its version, e.g. "Ubuntu 18.04"
+func (c TechnologyComponent) Label() string {
+	if c.Version == "" {
+		return c.Name
+	}
+	return c.Name + " " + c.Version
+}
+
+// Validate ensures the component has valid data
+func (c TechnologyComponent) Validate() error {
+	if c.Name == "" {
+		return errors.New("technology component name cannot be empty")
+	}
+	switch c.Kind {
+	case TechnologyOperatingSystem, TechnologyLanguage, TechnologyFramework, TechnologyRuntime, TechnologyDatabase:
+	default:
+		return fmt.Errorf("unknown technology kind %q", c.Kind)
+	}
+	return nil
+}
+
+// EndOfLifeLeadTimes escalate the risk of components approaching their end of support. Components
+// whose support has ended are always critical.
+type EndOfLifeLeadTimes struct {
+	High   time.Duration // support ends within this time
+	Medium time.Duration
+}
+
+// DefaultEndOfLifeLeadTimes flags components six months (high) and a year (medium) ahead of their
+// end of support
+func DefaultEndOfLifeLeadTimes() EndOfLifeLeadTimes {
+	return EndOfLifeLeadTimes{
+		High:   180 * 24 * time.Hour,
+		Medium: 365 * 24 * time.Hour,
+	}
+}
+
+// Validate ensures the lead times are ordered and not negative
+func (l EndOfLifeLeadTimes) Validate() error {
+	if l.High < 0 || l.Medium < 0 {
+		return errors.New("end of life lead times must not be negative")
+	}
+	if l.High > l.Medium {
+		return errors.New("high end of life lead time must not exceed the medium lead time")
+	}
+	return nil
+}
+
+// EndOfLifeStatus describes where a component is in its support lifecycle
+type EndOfLifeStatus string
+
+const (
+	EndOfLifeSupported   EndOfLifeStatus = "supported"
+	EndOfLifeApproaching EndOfLifeStatus = "approaching"
+	EndOfLifeExpired     EndOfLifeStatus = "expired"
+)
+
+// EndOfLifeFinding is a component's support status at the time of an assessment or forecast
+type EndOfLifeFinding struct {
+	Component     TechnologyComponent
+	DaysRemaining int // negative once support has ended
+	Status        EndOfLifeStatus
+	RiskLevel     RiskLevel
+}
+
+// Description summarizes the finding for reports
+func (f EndOfLifeFinding) Description() string {
+	date := f.Component.EndOfSupport.Format("2006-01-02")
+	if f.Status == EndOfLifeExpired {
+		return fmt.Sprintf("%s support ended on %s", f.Component.Label(), date)
+	}
+	return fmt.Sprintf("%s support ends on %s (%d days)", f.Component.Label(), date, f.DaysRemaining)
+}
+
+// classifyEndOfLife places a component with an announced end of support in its lifecycle
+func classifyEndOfLife(component TechnologyComponent, now time.Time, leadTimes EndOfLifeLeadTimes) EndOfLifeFinding {
+	remaining := component.EndOfSupport.Sub(now)
+	finding := EndOfLifeFinding{
+		Component:     component,
+		DaysRemaining: int(math.Floor(remaining.Hours() / 24)),
+		Status:        EndOfLifeSupported,
+		RiskLevel:     RiskLow,
+	}
+	switch {
+	case remaining <= 0:
+		finding.Status, finding.RiskLevel = EndOfLifeExpired, RiskCritical
+	case remaining <= leadTimes.High:
+		finding.Status, finding.RiskLevel = EndOfLifeApproaching, RiskHigh
+	case remaining <= leadTimes.Medium:
+		finding.Status, finding.RiskLevel = EndOfLifeApproaching, RiskMedium
+	}
+	return finding
+}
+
+// AssessEndOfLife returns the components whose support has ended or ends within the medium lead
+// time, soonest first. Zero lead times fall back to DefaultEndOfLifeLeadTimes.
+func AssessEndOfLife(components []TechnologyComponent, now time.Time, leadTimes EndOfLifeLeadTimes) []EndOfLifeFinding {
+	if leadTimes == (EndOfLifeLeadTimes{}) {
+		leadTimes = DefaultEndOfLifeLeadTimes()
+	}
+
+	var findings []EndOfLifeFinding
+	for _, component := range components {
+		if component.EndOfSupport.IsZero() {
+			continue
+		}
+		if finding := classifyEndOfLife(component, now, leadTimes); finding.Status != EndOfLifeSupported {
+			findings = append(findings, finding)
+		}
+	}
+	sort.SliceStable(findings, func(i, j int) bool {
+		return findings[i].Component.EndOfSupport.Before(findings[j].Component.EndOfSupport)
+	})
+	return findings
+}
+
+// escalateForEndOfLife raises a risk level to the most severe end of life finding
+func escalateForEndOfLife(level RiskLevel, findings []EndOfLifeFinding) RiskLevel {
+	for _, finding := range findings {
+		if riskScore(finding.RiskLevel) > riskScore(level) {
+			level = finding.RiskLevel
+		}
+	}
+	return level
+}
+
+// endOfLifeRecommendations asks for retirement or replacement of expired and soon unsupported
+// components and an upgrade of those further out
+func endOfLifeRecommendations(findings []EndOfLifeFinding) []Recommendation {
+	var recommendations []Recommendation
+	for i, finding := range findings {
+		recommendation := Recommendation{
+			ID:             fmt.Sprintf("eol-%03d", i+1),
+			BusinessImpact: "Avoid running on unsupported technology without security fixes or vendor help",
+		}
+		switch finding.RiskLevel {
+		case RiskCritical:
+			recommendation.Type = RecRetire
+			recommendation.Priority = PriorityCritical
+			recommendation.Description = fmt.Sprintf("Retire or replace the application, or upgrade %s: %s", finding.Component.Label(), finding.Description())
+		case RiskHigh:
+			recommendation.Type = RecRetire
+			recommendation.Priority = PriorityHigh
+			recommendation.Description = fmt.Sprintf("Plan retirement or an upgrade of %s: %s", finding.Component.Label(), finding.Description())
+		default:
+			recommendation.Type = RecModernize
+			recommendation.Priority = PriorityMedium
+			recommendation.Description = fmt.Sprintf("Schedule an upgrade of %s: %s", finding.Component.Label(), finding.Description())
+		}
+		recommendations = append(recommendations, recommendation)
+	}
+	return recommendations
+}
+
+// ObsolescenceEntry is one application component in an obsolescence forecast
+type ObsolescenceEntry struct {
+	ApplicationID ApplicationID
+	Finding       EndOfLifeFinding
+}
+
+// ObsolescenceForecast lists the technology components of a portfolio whose support ends within
+// the horizon, soonest first
+type ObsolescenceForecast struct {
+	PortfolioID PortfolioID
+	Horizon     time.Duration
+	Entries     []ObsolescenceEntry
+	ForecastAt  time.Time
+}
+
+// ForecastObsolescence lists the components recorded in the agreements of a portfolio's
+// applications, other than retired ones, whose support has ended or ends within the horizon
+func (s *EvaluationService) ForecastObsolescence(ctx context.Context, portfolioID PortfolioID, horizon time.Duration) (*ObsolescenceForecast, error) {
+	if horizon < 0 {
+		return nil, errors.New("forecast horizon must not be negative")
+	}
+
+	portfolio, err := s.portfolioRepo.FindByID(ctx, portfolioID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find portfolio: %w", err)
+	}
+
+	leadTimes := s.profile.EndOfLifeLeadTimes
+	if leadTimes == (EndOfLifeLeadTimes{}) {
+		leadTimes = DefaultEndOfLifeLeadTimes()
+	}
+
+	now := time.Now()
+	forecast := &ObsolescenceForecast{PortfolioID: portfolioID, Horizon: horizon, ForecastAt: now}
+	for _, app := range s.currentApplications(ctx, portfolio.Applications) {
+		if app.Status == StatusRetired {
+			continue
+		}
+		agreement, err := s.agreementRepo.FindByApplicationID(ctx, app.ID)
+		if err != nil {
+			continue
+		}
+		for _, component := range agreement.Strategy.ICTOperationsManual.TechnologyComponents {
+			if component.EndOfSupport.IsZero() || component.EndOfSupport.After(now.Add(horizon)) {
+				continue
+			}
+			forecast.Entries = append(forecast.Entries, ObsolescenceEntry{
+				ApplicationID: app.ID,
+				Finding:       classifyEndOfLife(component, now, leadTimes),
+			})
+		}
+	}
+	sort.SliceStable(forecast.Entries, func(i, j int) bool {
+		return forecast.Entries[i].Finding.Component.EndOfSupport.Before(forecast.Entries[j].Finding.Component.EndOfSupport)
+	})
+	return forecast, nil
+}
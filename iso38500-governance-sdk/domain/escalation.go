@@ -0,0 +1,173 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// EscalationSubject identifies what is escalated
+type EscalationSubject string
+
+const (
+	EscalationAlert         EscalationSubject = "alert"          // an active alert not yet acknowledged
+	EscalationIncident      EscalationSubject = "incident"       // an incident not yet resolved
+	EscalationChangeRequest EscalationSubject = "change_request" // a submitted change request not yet decided
+)
+
+// EscalationScope selects which of an agreement's escalation matrices applies
+type EscalationScope string
+
+const (
+	// EscalationScopeOperations is the performance escalation process, followed for alerts and
+	// incidents
+	EscalationScopeOperations EscalationScope = "operations"
+	// EscalationScopeChange is the escalation matrix of the change request process
+	EscalationScopeChange EscalationScope = "change"
+)
+
+// EscalationScopeOf returns the scope whose matrix escalates the subject
+func EscalationScopeOf(subject EscalationSubject) EscalationScope {
+	if subject == EscalationChangeRequest {
+		return EscalationScopeChange
+	}
+	return EscalationScopeOperations
+}
+
+// EscalationMatrixFor returns the agreement's escalation matrix for the scope
+func EscalationMatrixFor(agreement GovernanceAgreement, scope EscalationScope) []EscalationLevel {
+	if scope == EscalationScopeChange {
+		return agreement.Acquisition.ChangeRequestProcess.EscalationMatrix
+	}
+	return agreement.Performance.EscalationProcess
+}
+
+// ValidateEscalationMatrix ensures every level has a distinct positive number and contacts, and
+// that higher levels are not reached before lower ones. A level's ResponseTime is how long its
+// subject may wait, from when it was raised, before the level is engaged.
+func ValidateEscalationMatrix(levels []EscalationLevel) error {
+	if len(levels) == 0 {
+		return errors.New("escalation matrix needs at least one level")
+	}
+
+	sorted := append([]EscalationLevel{}, levels...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Level < sorted[j].Level })
+	for i, level := range sorted {
+		if level.Level <= 0 {
+			return fmt.Errorf("escalation level %d must be positive", level.Level)
+		}
+		if level.ResponseTime < 0 {
+			return fmt.Errorf("escalation level %d must not have a negative response time", level.Level)
+		}
+		if len(level.Contacts) == 0 {
+			return fmt.Errorf("escalation level %d needs at least one contact", level.Level)
+		}
+		if i > 0 && level.Level == sorted[i-1].Level {
+			return fmt.Errorf("escalation level %d is defined twice", level.Level)
+		}
+		if i > 0 && level.ResponseTime < sorted[i-1].ResponseTime {
+			return fmt.Errorf("escalation level %d is reached before level %d", level.Level, sorted[i-1].Level)
+		}
+	}
+	return nil
+}
+
+// DueEscalationLevels returns the levels of the matrix above reached whose response time has
+// passed between since and now, lowest first
+func DueEscalationLevels(matrix []EscalationLevel, since time.Time, reached int, now time.Time) []EscalationLevel {
+	due := []EscalationLevel{}
+	for _, level := range matrix {
+		if level.Level > reached && !since.Add(level.ResponseTime).After(now) {
+			due = append(due, level)
+		}
+	}
+	sort.SliceStable(due, func(i, j int) bool { return due[i].Level < due[j].Level })
+	return due
+}
+
+// EscalationStep records that an alert, incident or change request was escalated to a level of
+// its escalation matrix, and who was contacted
+type EscalationStep struct {
+	ID            string // subject, subject ID, level and time
+	Subject       EscalationSubject
+	SubjectID     string
+	AgreementID   GovernanceAgreementID
+	ApplicationID ApplicationID
+	Level         int
+	Description   string
+	Contacts      []string
+	Waiting       time.Duration // how long the subject had waited when escalated
+	EscalatedAt   time.Time
+}
+
+// EscalationLevelReached returns the highest level the steps escalated to at or after since, so
+// an alert raised again is escalated afresh
+func EscalationLevelReached(steps []EscalationStep, since time.Time) int {
+	reached := 0
+	for _, step := range steps {
+		if !step.EscalatedAt.Before(since) && step.Level > reached {
+			reached = step.Level
+		}
+	}
+	return reached
+}
+
+// ConfigureEscalationMatrix sets the agreement's escalation matrix for the scope, replacing any
+// configured before
+func (s *MonitoringService) ConfigureEscalationMatrix(ctx context.Context, agreementID GovernanceAgreementID, scope EscalationScope, levels []EscalationLevel) error {
+	if scope != EscalationScopeOperations && scope != EscalationScopeChange {
+		return fmt.Errorf("unknown escalation scope %q", scope)
+	}
+	if err := ValidateEscalationMatrix(levels); err != nil {
+		return err
+	}
+
+	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
+	if err != nil {
+		return fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+
+	sorted := append([]EscalationLevel{}, levels...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Level < sorted[j].Level })
+	if scope == EscalationScopeChange {
+		agreement.Acquisition.ChangeRequestProcess.EscalationMatrix = sorted
+	} else {
+		agreement.Performance.EscalationProcess = sorted
+	}
+
+	err = s.agreementRepo.Update(ctx, agreement)
+	if err != nil {
+		return fmt.Errorf("failed to update governance agreement: %w", err)
+	}
+	return nil
+}
+
+// AcknowledgeAlert records that someone has taken on one of the agreement's active alerts, which
+// stops its escalation
+func (s *MonitoringService) AcknowledgeAlert(ctx context.Context, agreementID GovernanceAgreementID, alertID, acknowledgedBy string, at time.Time) (*ActiveAlert, error) {
+	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+
+	for i, alert := range agreement.Monitor.ActiveAlerts {
+		if alert.ID != alertID {
+			continue
+		}
+		if !alert.AcknowledgedAt.IsZero() {
+			return nil, fmt.Errorf("alert %s was already acknowledged by %s", alertID, alert.AcknowledgedBy)
+		}
+		alert.AcknowledgedAt = at
+		alert.AcknowledgedBy = acknowledgedBy
+		agreement.Monitor.ActiveAlerts[i] = alert
+
+		err = s.agreementRepo.Update(ctx, agreement)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update governance agreement: %w", err)
+		}
+		return &alert, nil
+	}
+	return nil, fmt.Errorf("alert %s is not active", alertID)
+}
@@ -0,0 +1,159 @@
+package domain
+
+import "fmt"
+
+// EvaluationProfile tunes how the default assessors score applications and derive risk levels
+type EvaluationProfile struct {
+	Name string
+
+	// BaseTechnicalScore is the technical health score before any factor is applied (1-5)
+	BaseTechnicalScore int
+
+	// Weights multiply the penalty each factor deducts from the technical health score; points a
+	// factor awards are not weighted, so weights above 1 are stricter and below 1 more lenient
+	VersionMaturityWeight float64
+	SecurityWeight        float64
+	DocumentationWeight   float64
+	AgeWeight             float64
+	StatusWeight          float64
+	TechnicalDebtWeight   float64 // applies to recorded debt, which replaces the age factor
+
+	// TargetAnnualCostPerUser is the yearly running cost per active user at which cost
+	// efficiency is 100%. It is only used for applications with recorded costs.
+	TargetAnnualCostPerUser float64
+
+	RiskThresholds RiskThresholds
+
+	// HealthIndexWeights combine the components of the portfolio health index; zero weights
+	// use DefaultPortfolioHealthWeights
+	HealthIndexWeights PortfolioHealthWeights
+
+	// TIMEThresholds place applications in TIME model quadrants; zero thresholds use
+	// DefaultTIMEThresholds
+	TIMEThresholds TIMEThresholds
+
+	// EndOfLifeLeadTimes escalate risk as technology components approach their end of support;
+	// zero lead times use DefaultEndOfLifeLeadTimes
+	EndOfLifeLeadTimes EndOfLifeLeadTimes
+
+	// ComplianceRiskThresholds escalate risk as the compliance of the agreement's requirements
+	// falls; zero thresholds use DefaultComplianceRiskThresholds
+	ComplianceRiskThresholds ComplianceRiskThresholds
+
+	// SignOffPolicy decides which assessments must be reviewed and signed off by someone other
+	// than their evaluator
+	SignOffPolicy SignOffPolicy
+}
+
+// RiskThresholds define the boundaries used to classify risk. An application is placed in the
+// first level whose average technical score is at or below the limit, or whose cost efficiency
+// falls below the minimum.
+type RiskThresholds struct {
+	CriticalMaxTechnicalScore int
+	HighMaxTechnicalScore     int
+	MediumMaxTechnicalScore   int
+	CriticalMinCostEfficiency float64
+	HighMinCostEfficiency     float64
+}
+
+// DefaultEvaluationProfile returns the profile matching the SDK's built-in heuristics
+func DefaultEvaluationProfile() EvaluationProfile {
+	return EvaluationProfile{
+		Name:                    "default",
+		BaseTechnicalScore:      3,
+		VersionMaturityWeight:   1.0,
+		SecurityWeight:          1.0,
+		DocumentationWeight:     1.0,
+		AgeWeight:               1.0,
+		StatusWeight:            1.0,
+		TechnicalDebtWeight:     1.0,
+		TargetAnnualCostPerUser: 2500,
+		RiskThresholds: RiskThresholds{
+			CriticalMaxTechnicalScore: 2,
+			HighMaxTechnicalScore:     3,
+			MediumMaxTechnicalScore:   4,
+			CriticalMinCostEfficiency: 50,
+			HighMinCostEfficiency:     70,
+		},
+		HealthIndexWeights: DefaultPortfolioHealthWeights(),
+		TIMEThresholds:     DefaultTIMEThresholds(),
+		EndOfLifeLeadTimes: DefaultEndOfLifeLeadTimes(),
+		SignOffPolicy:      DefaultSignOffPolicy(),
+
+		ComplianceRiskThresholds: DefaultComplianceRiskThresholds(),
+	}
+}
+
+// RegulatedEvaluationProfile returns a stricter profile for regulated industries, penalizing
+// missing security provisions and documentation, age and technical debt more heavily and
+// escalating cost-related risk sooner
+func RegulatedEvaluationProfile() EvaluationProfile {
+	profile := DefaultEvaluationProfile()
+	profile.Name = "regulated"
+	profile.SecurityWeight = 2.0
+	profile.DocumentationWeight = 1.5
+	profile.AgeWeight = 1.5
+	profile.TechnicalDebtWeight = 1.5
+	profile.RiskThresholds = RiskThresholds{
+		CriticalMaxTechnicalScore: 2,
+		HighMaxTechnicalScore:     3,
+		MediumMaxTechnicalScore:   4,
+		CriticalMinCostEfficiency: 60,
+		HighMinCostEfficiency:     80,
+	}
+	return profile
+}
+
+// Validate checks that the profile's scores and thresholds are usable
+func (p EvaluationProfile) Validate() error {
+	if p.BaseTechnicalScore < 1 || p.BaseTechnicalScore > 5 {
+		return fmt.Errorf("base technical score must be between 1 and 5, got %d", p.BaseTechnicalScore)
+	}
+
+	weights := map[string]float64{
+		"version maturity": p.VersionMaturityWeight,
+		"security":         p.SecurityWeight,
+		"documentation":    p.DocumentationWeight,
+		"age":              p.AgeWeight,
+		"status":           p.StatusWeight,
+		"technical debt":   p.TechnicalDebtWeight,
+	}
+	for name, weight := range weights {
+		if weight < 0 {
+			return fmt.Errorf("%s weight must not be negative", name)
+		}
+	}
+
+	if err := p.HealthIndexWeights.Validate(); err != nil {
+		return err
+	}
+	if err := p.TIMEThresholds.Validate(); err != nil {
+		return err
+	}
+	if err := p.EndOfLifeLeadTimes.Validate(); err != nil {
+		return err
+	}
+	if err := p.SignOffPolicy.Validate(); err != nil {
+		return err
+	}
+	if err := p.ComplianceRiskThresholds.Validate(); err != nil {
+		return err
+	}
+
+	if p.TargetAnnualCostPerUser < 0 {
+		return fmt.Errorf("target annual cost per user must not be negative")
+	}
+
+	return p.RiskThresholds.Validate()
+}
+
+// Validate checks that the thresholds are ordered from critical to medium
+func (t RiskThresholds) Validate() error {
+	if !(t.CriticalMaxTechnicalScore <= t.HighMaxTechnicalScore && t.HighMaxTechnicalScore <= t.MediumMaxTechnicalScore) {
+		return fmt.Errorf("risk score thresholds must be ordered critical <= high <= medium")
+	}
+	if t.CriticalMinCostEfficiency > t.HighMinCostEfficiency {
+		return fmt.Errorf("critical cost efficiency threshold must not exceed the high threshold")
+	}
+	return nil
+}
@@ -0,0 +1,293 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// DefaultTemplateCategory is the template applied to applications whose category has no template of its own
+const DefaultTemplateCategory = "*"
+
+// Metrics a template check can require a minimum for
+const (
+	MetricCodeQuality       = "code_quality"       // 1-5
+	MetricDocumentation     = "documentation"      // 1-5
+	MetricTestCoverage      = "test_coverage"      // percentage
+	MetricSecurity          = "security"           // 1-5
+	MetricPerformance       = "performance"        // 1-5
+	MetricUptime            = "uptime"             // percentage
+	MetricBusinessAlignment = "business_alignment" // percentage
+	MetricCostEfficiency    = "cost_efficiency"    // percentage
+	MetricUserSatisfaction  = "user_satisfaction"  // percentage
+)
+
+// TemplateCheck is a check every assessment under a template must pass
+type TemplateCheck struct {
+	Metric      string  `json:"metric"`
+	Minimum     float64 `json:"minimum"`
+	Description string  `json:"description"`
+}
+
+// TemplateCheckResult is the outcome of a template check for one assessment
+type TemplateCheckResult struct {
+	Check  TemplateCheck
+	Actual float64
+	Passed bool
+}
+
+// TemplateWeights replace the evaluation profile's technical health factor weights. A zero
+// weight keeps the profile's.
+type TemplateWeights struct {
+	VersionMaturity float64 `json:"version_maturity"`
+	Security        float64 `json:"security"`
+	Documentation   float64 `json:"documentation"`
+	Age             float64 `json:"age"`
+	Status          float64 `json:"status"`
+	TechnicalDebt   float64 `json:"technical_debt"`
+}
+
+// EvaluationTemplate holds what an evaluation requires of applications in one category: the
+// checks they must pass, how their technical health factors are weighted and the evidence that
+// must be attached before their assessments can be signed off
+type EvaluationTemplate struct {
+	Category          string          `json:"category"`
+	Name              string          `json:"name"`
+	RequiredChecks    []TemplateCheck `json:"required_checks"`
+	Weights           TemplateWeights `json:"weights"`
+	MandatoryEvidence []EvidenceKind  `json:"mandatory_evidence"`
+}
+
+// ApplyTo returns the profile with the template's weights in place of its own
+func (t EvaluationTemplate) ApplyTo(profile EvaluationProfile) EvaluationProfile {
+	overrides := []struct {
+		weight float64
+		target *float64
+	}{
+		{t.Weights.VersionMaturity, &profile.VersionMaturityWeight},
+		{t.Weights.Security, &profile.SecurityWeight},
+		{t.Weights.Documentation, &profile.DocumentationWeight},
+		{t.Weights.Age, &profile.AgeWeight},
+		{t.Weights.Status, &profile.StatusWeight},
+		{t.Weights.TechnicalDebt, &profile.TechnicalDebtWeight},
+	}
+	for _, override := range overrides {
+		if override.weight > 0 {
+			*override.target = override.weight
+		}
+	}
+	return profile
+}
+
+// RunChecks runs the template's required checks against an assessment
+func (t EvaluationTemplate) RunChecks(assessment ApplicationAssessment) []TemplateCheckResult {
+	results := make([]TemplateCheckResult, 0, len(t.RequiredChecks))
+	for _, check := range t.RequiredChecks {
+		actual, _ := templateMetricValue(check.Metric, assessment)
+		results = append(results, TemplateCheckResult{
+			Check:  check,
+			Actual: actual,
+			Passed: actual >= check.Minimum,
+		})
+	}
+	return results
+}
+
+// Validate ensures the template's checks, weights and evidence kinds are usable
+func (t EvaluationTemplate) Validate() error {
+	if t.Category == "" {
+		return fmt.Errorf("template %q: category cannot be empty", t.Name)
+	}
+	for _, check := range t.RequiredChecks {
+		if _, ok := templateMetricValue(check.Metric, ApplicationAssessment{}); !ok {
+			return fmt.Errorf("template %q: unknown check metric %q", t.Category, check.Metric)
+		}
+		if check.Minimum < 0 {
+			return fmt.Errorf("template %q: %s minimum must not be negative", t.Category, check.Metric)
+		}
+	}
+	weights := []float64{t.Weights.VersionMaturity, t.Weights.Security, t.Weights.Documentation, t.Weights.Age, t.Weights.Status, t.Weights.TechnicalDebt}
+	for _, weight := range weights {
+		if weight < 0 {
+			return fmt.Errorf("template %q: weights must not be negative", t.Category)
+		}
+	}
+	for _, kind := range t.MandatoryEvidence {
+		switch kind {
+		case EvidenceDocument, EvidenceScreenshot, EvidenceReportLink:
+		default:
+			return fmt.Errorf("template %q: unknown evidence kind %q", t.Category, kind)
+		}
+	}
+	return nil
+}
+
+// EvaluationTemplateSet is a named set of evaluation templates, one per application category
+type EvaluationTemplateSet struct {
+	Name      string               `json:"name"`
+	Templates []EvaluationTemplate `json:"templates"`
+}
+
+// TemplateFor returns the template for a category, falling back to the default category
+func (s EvaluationTemplateSet) TemplateFor(category string) (EvaluationTemplate, bool) {
+	var fallback *EvaluationTemplate
+	for i, template := range s.Templates {
+		if template.Category == category {
+			return template, true
+		}
+		if template.Category == DefaultTemplateCategory {
+			fallback = &s.Templates[i]
+		}
+	}
+	if fallback != nil {
+		return *fallback, true
+	}
+	return EvaluationTemplate{}, false
+}
+
+// Validate checks every template in the set
+func (s EvaluationTemplateSet) Validate() error {
+	if s.Name == "" {
+		return fmt.Errorf("evaluation template set name cannot be empty")
+	}
+	seen := make(map[string]bool)
+	for _, template := range s.Templates {
+		if err := template.Validate(); err != nil {
+			return err
+		}
+		if seen[template.Category] {
+			return fmt.Errorf("duplicate template for category %q", template.Category)
+		}
+		seen[template.Category] = true
+	}
+	return nil
+}
+
+// LoadEvaluationTemplates reads a JSON evaluation template set
+func LoadEvaluationTemplates(r io.Reader) (EvaluationTemplateSet, error) {
+	var set EvaluationTemplateSet
+	if err := json.NewDecoder(r).Decode(&set); err != nil {
+		return EvaluationTemplateSet{}, fmt.Errorf("failed to decode evaluation templates: %w", err)
+	}
+	if err := set.Validate(); err != nil {
+		return EvaluationTemplateSet{}, err
+	}
+	return set, nil
+}
+
+// StandardEvaluationTemplates returns templates for the common application categories
+func StandardEvaluationTemplates() EvaluationTemplateSet {
+	return EvaluationTemplateSet{
+		Name: "standard",
+		Templates: []EvaluationTemplate{
+			{
+				Category: "Core Business",
+				Name:     "Core business application",
+				RequiredChecks: []TemplateCheck{
+					{Metric: MetricSecurity, Minimum: 4, Description: "Security controls suitable for business-critical data"},
+					{Metric: MetricDocumentation, Minimum: 3, Description: "Operational documentation kept current"},
+					{Metric: MetricUptime, Minimum: 99.5, Description: "Availability expected of core business services"},
+				},
+				Weights:           TemplateWeights{Security: 1.5, Documentation: 1.5},
+				MandatoryEvidence: []EvidenceKind{EvidenceDocument, EvidenceReportLink},
+			},
+			{
+				Category: "Infrastructure",
+				Name:     "Shared infrastructure",
+				RequiredChecks: []TemplateCheck{
+					{Metric: MetricSecurity, Minimum: 4, Description: "Hardened configuration for shared services"},
+					{Metric: MetricPerformance, Minimum: 3, Description: "Capacity headroom for dependent applications"},
+					{Metric: MetricUptime, Minimum: 99.9, Description: "Availability at least that of the applications it supports"},
+				},
+				Weights:           TemplateWeights{Security: 2.0, VersionMaturity: 1.5},
+				MandatoryEvidence: []EvidenceKind{EvidenceReportLink},
+			},
+			{
+				Category: "Analytics",
+				Name:     "Analytics and reporting",
+				RequiredChecks: []TemplateCheck{
+					{Metric: MetricBusinessAlignment, Minimum: 60, Description: "Reports used for business decisions"},
+					{Metric: MetricUserSatisfaction, Minimum: 60, Description: "Analysts satisfied with the data available"},
+				},
+				Weights:           TemplateWeights{Documentation: 1.5},
+				MandatoryEvidence: []EvidenceKind{EvidenceReportLink},
+			},
+			{
+				Category: DefaultTemplateCategory,
+				Name:     "General application",
+				RequiredChecks: []TemplateCheck{
+					{Metric: MetricSecurity, Minimum: 2, Description: "Baseline security controls in place"},
+				},
+			},
+		},
+	}
+}
+
+// templateMetricValue returns an assessed metric by name, and false for an unknown metric
+func templateMetricValue(metric string, assessment ApplicationAssessment) (float64, bool) {
+	health, value := assessment.TechnicalHealth, assessment.BusinessValue
+	switch metric {
+	case MetricCodeQuality:
+		return float64(health.CodeQuality), true
+	case MetricDocumentation:
+		return float64(health.Documentation), true
+	case MetricTestCoverage:
+		return health.TestCoverage, true
+	case MetricSecurity:
+		return float64(health.SecurityScore), true
+	case MetricPerformance:
+		return float64(health.PerformanceScore), true
+	case MetricUptime:
+		return value.UsageMetrics.UptimePercentage, true
+	case MetricBusinessAlignment:
+		return value.BusinessAlignment, true
+	case MetricCostEfficiency:
+		return value.CostEfficiency, true
+	case MetricUserSatisfaction:
+		return value.UserSatisfaction, true
+	}
+	return 0, false
+}
+
+// templateRecommendations turns failed template checks into recommendations
+func templateRecommendations(template EvaluationTemplate, results []TemplateCheckResult) []Recommendation {
+	recommendations := []Recommendation{}
+	for _, result := range results {
+		if result.Passed {
+			continue
+		}
+		recommendations = append(recommendations, Recommendation{
+			ID:   fmt.Sprintf("tmpl-%03d", len(recommendations)+1),
+			Type: RecEnhance,
+			Description: fmt.Sprintf("Meet the %s check on %s: %.1f is below the required %.1f (%s)",
+				template.Name, result.Check.Metric, result.Actual, result.Check.Minimum, result.Check.Description),
+			Priority:       PriorityHigh,
+			BusinessImpact: fmt.Sprintf("Bring the application up to what is required of %s applications", template.Category),
+		})
+	}
+	return recommendations
+}
+
+// missingEvidence returns the mandatory evidence kinds with no attachment of that kind
+func missingEvidence(mandatory []EvidenceKind, attached []Evidence) []EvidenceKind {
+	present := make(map[EvidenceKind]bool)
+	for _, evidence := range attached {
+		present[evidence.Kind] = true
+	}
+	missing := []EvidenceKind{}
+	for _, kind := range mandatory {
+		if !present[kind] {
+			missing = append(missing, kind)
+		}
+	}
+	return missing
+}
+
+func joinEvidenceKinds(kinds []EvidenceKind) string {
+	names := make([]string, len(kinds))
+	for i, kind := range kinds {
+		names[i] = string(kind)
+	}
+	return strings.Join(names, ", ")
+}
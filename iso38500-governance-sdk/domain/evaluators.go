@@ -0,0 +1,599 @@
+package domain
+
+import (
+	"context"
+	"math"
+	"strings"
+	"time"
+)
+
+// TechnicalHealthAssessor scores the technical health of an application
+type TechnicalHealthAssessor interface {
+	AssessTechnicalHealth(ctx context.Context, app Application) TechnicalHealth
+}
+
+// BusinessValueAssessor scores the business value of an application; agreement is nil
+// when the application has no governance agreement
+type BusinessValueAssessor interface {
+	AssessBusinessValue(ctx context.Context, app Application, agreement *GovernanceAgreement) BusinessValueAssessment
+}
+
+// RiskClassifier derives an overall risk level from technical health and business value
+type RiskClassifier interface {
+	ClassifyRisk(techHealth TechnicalHealth, businessValue BusinessValueAssessment) RiskLevel
+}
+
+// DefaultTechnicalHealthAssessor scores technical health from version, security,
+// documentation, age and status heuristics weighted by an evaluation profile.
+// When Debt is set and the application has entries in the debt register, the recorded
+// debt replaces the age heuristic. Component scores vary from the overall score only when
+// Variance is set. When Incidents is set, the application's incidents of the last 90 days
+// move the performance score. The zero value uses DefaultEvaluationProfile.
+type DefaultTechnicalHealthAssessor struct {
+	Profile   EvaluationProfile
+	Debt      TechnicalDebtRepository
+	Incidents IncidentRepository
+	Variance  ScoreVariance
+}
+
+// DefaultBusinessValueAssessor scores business value from application status, age,
+// security provisions and governance agreement coverage. Cost efficiency comes from the
+// application's recorded costs when present, and uptime and response time from the latest
+// observed measurement when Measurements is set. When Metrics is set, the active users,
+// transaction volume, uptime and survey satisfaction it observed replace the heuristics. The
+// zero value uses DefaultEvaluationProfile.
+type DefaultBusinessValueAssessor struct {
+	Profile      EvaluationProfile
+	Measurements AvailabilityMeasurementRepository
+	Metrics      MetricsProvider
+}
+
+// DefaultRiskClassifier classifies risk from average technical scores and cost efficiency.
+// The zero value uses the thresholds of DefaultEvaluationProfile.
+type DefaultRiskClassifier struct {
+	Thresholds RiskThresholds
+}
+
+// AssessTechnicalHealth evaluates the technical health of an application
+func (a *DefaultTechnicalHealthAssessor) AssessTechnicalHealth(ctx context.Context, app Application) TechnicalHealth {
+	profile := a.Profile
+	if profile.BaseTechnicalScore == 0 {
+		profile = DefaultEvaluationProfile()
+	}
+	breakdown := &ScoreBreakdown{Base: profile.BaseTechnicalScore, Total: profile.BaseTechnicalScore}
+
+	// Analyze version maturity (semantic versioning indicates better practices)
+	breakdown.add("version", a.analyzeVersionMaturity(app.Version), profile.VersionMaturityWeight)
+
+	// Security provisions analysis
+	securityScore := breakdown.add("security", a.analyzeSecurityProvisions(app.SecurityProvisions), profile.SecurityWeight)
+
+	// Documentation and catalogue completeness
+	breakdown.add("documentation", a.analyzeDocumentationCompleteness(app.Catalogue), profile.DocumentationWeight)
+
+	// Technical debt from the register, or age-based depreciation when none is recorded
+	// (older apps may have accumulated technical debt)
+	ageScore := breakdown.add(a.analyzeTechnicalDebt(ctx, app, profile))
+
+	// Application status impact
+	breakdown.add("status", a.analyzeApplicationStatus(app.Status), profile.StatusWeight)
+
+	// Ensure score is within bounds
+	score := boundScore(breakdown.Total)
+	breakdown.Score = score
+
+	// Calculate individual metrics based on overall score
+	basePercentage := float64(score) * 20.0 // Base percentage
+
+	// Incident history backs the performance score with operational data
+	performance := score + ageScore
+	if operations, err := operationalMetrics(ctx, a.Incidents, app.ID, time.Now()); err == nil && operations != nil {
+		performance += operations.performancePoints()
+	}
+
+	return TechnicalHealth{
+		CodeQuality:      a.componentScore(app, MetricCodeQuality, score, 0.8, 1.2, breakdown),
+		Documentation:    a.componentScore(app, MetricDocumentation, score, 0.9, 1.1, breakdown),
+		TestCoverage:     basePercentage + float64(securityScore)*5.0, // Security affects testing
+		SecurityScore:    a.componentScore(app, MetricSecurity, score+securityScore, 0.7, 1.3, breakdown),
+		PerformanceScore: a.componentScore(app, MetricPerformance, performance, 0.8, 1.2, breakdown),
+		Breakdown:        breakdown,
+	}
+}
+
+// componentScore bounds a component score to 1-5 after applying the configured variance, and
+// records any change the variance makes in the breakdown
+func (a *DefaultTechnicalHealthAssessor) componentScore(app Application, component string, score int, minFactor, maxFactor float64, breakdown *ScoreBreakdown) int {
+	bounded := boundScore(score)
+	if a.Variance == nil {
+		return bounded
+	}
+
+	varied := boundScore(a.Variance.Vary(app.ID, component, score, minFactor, maxFactor))
+	if varied != bounded {
+		breakdown.Adjustments = append(breakdown.Adjustments, ScoreAdjustment{Component: component, From: bounded, To: varied})
+	}
+	return varied
+}
+
+// weightScore scales a factor's penalty by its profile weight, rounding to the nearest point.
+// Points awarded count as they are, so a heavier weight only ever makes a profile stricter.
+func weightScore(score int, weight float64) int {
+	if score >= 0 {
+		return score
+	}
+	return int(math.Round(float64(score) * weight))
+}
+
+// analyzeVersionMaturity evaluates version string for maturity indicators
+func (a *DefaultTechnicalHealthAssessor) analyzeVersionMaturity(version string) int {
+	if version == "" {
+		return -1 // Penalty for no version
+	}
+
+	// Check for semantic versioning (major.minor.patch)
+	parts := strings.Split(version, ".")
+	if len(parts) >= 3 {
+		// Semantic versioning indicates better development practices
+		return 1
+	}
+
+	// Check for development/pre-release indicators
+	lowerVersion := strings.ToLower(version)
+	if strings.Contains(lowerVersion, "dev") ||
+		strings.Contains(lowerVersion, "alpha") ||
+		strings.Contains(lowerVersion, "beta") ||
+		strings.Contains(lowerVersion, "rc") {
+		return 0 // Neutral for development versions
+	}
+
+	return 0 // Neutral for other version formats
+}
+
+// analyzeSecurityProvisions evaluates security measures in place
+func (a *DefaultTechnicalHealthAssessor) analyzeSecurityProvisions(provisions SecurityProvisions) int {
+	score := 0
+
+	// Data confidentiality measures
+	if len(provisions.DataConfidentiality) > 0 {
+		score++
+		if len(provisions.DataConfidentiality) > 2 {
+			score++ // Bonus for comprehensive confidentiality
+		}
+	}
+
+	// Data integrity measures
+	if len(provisions.DataIntegrity) > 0 {
+		score++
+		if len(provisions.DataIntegrity) > 2 {
+			score++ // Bonus for comprehensive integrity
+		}
+	}
+
+	// Application authenticity measures
+	if len(provisions.ApplicationAuthenticity) > 0 {
+		score++
+	}
+
+	// Roles and permissions (access control)
+	if len(provisions.RolesAndPermissions) > 0 {
+		score++
+		if len(provisions.RolesAndPermissions) > 3 {
+			score++ // Bonus for comprehensive role management
+		}
+	}
+
+	// SLA-based availability (indirect security measure)
+	if provisions.ApplicationAvailability.ResponseTime > 0 {
+		score++
+	}
+
+	return score - 2 // Normalize (subtract base expectation)
+}
+
+// analyzeDocumentationCompleteness evaluates documentation quality
+func (a *DefaultTechnicalHealthAssessor) analyzeDocumentationCompleteness(catalogue ApplicationCatalogue) int {
+	score := 0
+
+	// Recent updates indicate active maintenance
+	if !catalogue.LastUpdated.IsZero() {
+		daysSinceUpdate := time.Since(catalogue.LastUpdated).Hours() / 24
+		if daysSinceUpdate < 90 { // Updated within 3 months
+			score += 2
+		} else if daysSinceUpdate < 365 { // Updated within a year
+			score++
+		}
+	} else {
+		score-- // Penalty for no update date
+	}
+
+	// Comprehensive functionality documentation
+	if len(catalogue.Functionality) > 0 {
+		score++
+		if len(catalogue.Functionality) > 5 {
+			score++ // Bonus for detailed functionality
+		}
+	}
+
+	return score
+}
+
+// analyzeTechnicalDebt scores recorded technical debt, falling back to the application's age
+// when the register has no entries for it. It returns the factor scored, its points and weight.
+func (a *DefaultTechnicalHealthAssessor) analyzeTechnicalDebt(ctx context.Context, app Application, profile EvaluationProfile) (string, int, float64) {
+	if a.Debt != nil {
+		items, err := a.Debt.FindByApplicationID(ctx, app.ID)
+		if err == nil && len(items) > 0 {
+			return "technical debt", technicalDebtScore(SummarizeTechnicalDebt(items)), profile.TechnicalDebtWeight
+		}
+	}
+	return "age", a.analyzeApplicationAge(app.CreatedAt, app.UpdatedAt), profile.AgeWeight
+}
+
+// analyzeApplicationAge evaluates age-related technical debt
+func (a *DefaultTechnicalHealthAssessor) analyzeApplicationAge(createdAt, updatedAt time.Time) int {
+	if createdAt.IsZero() {
+		return 0 // No age data available
+	}
+
+	ageInDays := time.Since(createdAt).Hours() / 24
+
+	// Very old applications may have accumulated technical debt
+	if ageInDays > 365*5 { // Over 5 years old
+		return -2
+	} else if ageInDays > 365*2 { // Over 2 years old
+		return -1
+	}
+
+	// Recently updated applications are better maintained
+	if !updatedAt.IsZero() {
+		daysSinceUpdate := time.Since(updatedAt).Hours() / 24
+		if daysSinceUpdate < 90 { // Updated within 3 months
+			return 1
+		} else if daysSinceUpdate < 180 { // Updated within 6 months
+			return 0
+		}
+	}
+
+	return 0
+}
+
+// analyzeApplicationStatus evaluates status impact on technical health
+func (a *DefaultTechnicalHealthAssessor) analyzeApplicationStatus(status ApplicationStatus) int {
+	switch status {
+	case StatusActive:
+		return 1 // Active apps are well-maintained
+	case StatusDeprecated:
+		return -1 // Deprecated apps may have issues
+	case StatusRetired:
+		return -2 // Retired apps have significant issues
+	case StatusPlanned:
+		return 0 // Planned apps are new, no technical debt yet
+	default:
+		return 0
+	}
+}
+
+// AssessBusinessValue evaluates the business value of an application
+func (a *DefaultBusinessValueAssessor) AssessBusinessValue(ctx context.Context, app Application, agreement *GovernanceAgreement) BusinessValueAssessment {
+	usage := a.calculateUsageMetrics(app, agreement)
+	estimated := []string{}
+
+	// Observed figures replace the heuristics one by one
+	metrics := a.observedMetrics(ctx, app.ID)
+	if metrics.ActiveUsers > 0 {
+		usage.ActiveUsers = metrics.ActiveUsers
+	} else {
+		estimated = append(estimated, "active_users")
+	}
+	if metrics.TransactionVolume > 0 {
+		usage.TransactionVolume = metrics.TransactionVolume
+	} else {
+		estimated = append(estimated, "transaction_volume")
+	}
+
+	// The latest availability measurement takes precedence for uptime and response time
+	measurement, measured := a.latestMeasurement(ctx, app.ID)
+	switch {
+	case measured && measurement.UptimePercentage > 0:
+		usage.UptimePercentage = measurement.UptimePercentage
+	case metrics.UptimePercentage > 0:
+		usage.UptimePercentage = metrics.UptimePercentage
+	default:
+		estimated = append(estimated, "uptime")
+	}
+	if measured && measurement.ResponseTime > 0 {
+		usage.ResponseTime = measurement.ResponseTime
+	} else {
+		estimated = append(estimated, "response_time")
+	}
+
+	satisfaction := metrics.UserSatisfaction
+	if metrics.SurveyResponses == 0 {
+		var surveyed bool
+		satisfaction, surveyed = a.calculateUserSatisfaction(app, agreement)
+		if !surveyed {
+			estimated = append(estimated, "user_satisfaction")
+		}
+	}
+
+	return BusinessValueAssessment{
+		UsageMetrics:      usage,
+		BusinessAlignment: a.calculateBusinessAlignment(app, agreement),
+		CostEfficiency:    a.calculateCostEfficiency(app, agreement, usage),
+		UserSatisfaction:  satisfaction,
+		EstimatedMetrics:  estimated,
+	}
+}
+
+// observedMetrics returns the application's metrics from the provider, or zero metrics when
+// there is no provider or it has no figures for the application
+func (a *DefaultBusinessValueAssessor) observedMetrics(ctx context.Context, appID ApplicationID) ApplicationMetrics {
+	if a.Metrics == nil {
+		return ApplicationMetrics{}
+	}
+	metrics, err := a.Metrics.ApplicationMetrics(ctx, appID)
+	if err != nil {
+		return ApplicationMetrics{}
+	}
+	return metrics
+}
+
+// latestMeasurement returns the application's most recent observed availability, if any
+func (a *DefaultBusinessValueAssessor) latestMeasurement(ctx context.Context, appID ApplicationID) (AvailabilityMeasurement, bool) {
+	if a.Measurements == nil {
+		return AvailabilityMeasurement{}, false
+	}
+	measurement, err := a.Measurements.FindLatest(ctx, appID)
+	if err != nil {
+		return AvailabilityMeasurement{}, false
+	}
+	return measurement, true
+}
+
+// calculateUsageMetrics derives usage metrics from application attributes. These are estimates
+// that AssessBusinessValue replaces with observed metrics and measurements when available.
+func (a *DefaultBusinessValueAssessor) calculateUsageMetrics(app Application, agreement *GovernanceAgreement) UsageMetrics {
+	// Base metrics derived from application characteristics
+	activeUsers := 50         // Base active users
+	transactionVolume := 1000 // Base transactions
+
+	// Scale based on application status and governance
+	switch app.Status {
+	case StatusActive:
+		activeUsers *= 2
+		transactionVolume *= 3
+	case StatusDeprecated:
+		activeUsers /= 2
+		transactionVolume /= 2
+	case StatusRetired:
+		activeUsers /= 4
+		transactionVolume /= 4
+	}
+
+	// Governance agreement indicates higher usage
+	if agreement != nil {
+		activeUsers = int(float64(activeUsers) * 1.5)
+		transactionVolume = int(float64(transactionVolume) * 1.8)
+	}
+
+	// Age affects usage patterns
+	if !app.CreatedAt.IsZero() {
+		ageInYears := time.Since(app.CreatedAt).Hours() / (24 * 365)
+		if ageInYears > 3 {
+			// Mature applications typically have higher usage
+			activeUsers = int(float64(activeUsers) * 1.3)
+			transactionVolume = int(float64(transactionVolume) * 1.4)
+		}
+	}
+
+	// Calculate uptime based on technical health proxy
+	uptimePercentage := 99.0 // Base uptime
+	if len(app.SecurityProvisions.RolesAndPermissions) > 0 {
+		uptimePercentage += 0.5 // Better security = better uptime
+	}
+	if !app.UpdatedAt.IsZero() && time.Since(app.UpdatedAt).Hours() < 24*30 {
+		uptimePercentage += 0.4 // Recently updated = better maintenance
+	}
+
+	// Response time based on application complexity
+	responseTime := time.Millisecond * 300 // Base response time
+	if strings.Contains(strings.ToLower(app.Name), "legacy") {
+		responseTime += time.Millisecond * 200 // Legacy systems slower
+	}
+	if len(app.SecurityProvisions.DataIntegrity) > 0 {
+		responseTime += time.Millisecond * 50 // Security measures overhead
+	}
+
+	return UsageMetrics{
+		ActiveUsers:       activeUsers,
+		TransactionVolume: transactionVolume,
+		UptimePercentage:  uptimePercentage,
+		ResponseTime:      responseTime,
+	}
+}
+
+// calculateBusinessAlignment evaluates how well the application aligns with business objectives
+func (a *DefaultBusinessValueAssessor) calculateBusinessAlignment(app Application, agreement *GovernanceAgreement) float64 {
+	baseAlignment := 70.0 // Base alignment score
+
+	// Governance agreement significantly improves alignment
+	if agreement != nil {
+		baseAlignment += 20.0
+
+		// Strategic objectives indicate strong alignment
+		if len(agreement.Direct.StrategicDirection.Objectives) > 0 {
+			baseAlignment += 5.0
+		}
+
+		// Active monitoring improves alignment
+		if agreement.Conformance.ComplianceMonitoring.MonitoringFrequency != "" {
+			baseAlignment += 5.0
+		}
+	}
+
+	// Application status affects alignment
+	switch app.Status {
+	case StatusActive:
+		baseAlignment += 5.0
+	case StatusPlanned:
+		baseAlignment += 2.0 // Future alignment
+	case StatusDeprecated:
+		baseAlignment -= 10.0 // Misalignment with current strategy
+	case StatusRetired:
+		baseAlignment -= 20.0 // No alignment
+	}
+
+	// Recent updates indicate current alignment
+	if !app.UpdatedAt.IsZero() && time.Since(app.UpdatedAt).Hours() < 24*90 {
+		baseAlignment += 3.0
+	}
+
+	// Ensure bounds
+	if baseAlignment > 100.0 {
+		baseAlignment = 100.0
+	}
+	if baseAlignment < 0.0 {
+		baseAlignment = 0.0
+	}
+
+	return baseAlignment
+}
+
+// calculateCostEfficiency evaluates the cost effectiveness of the application, from its
+// recorded costs when available and from governance and lifecycle heuristics otherwise
+func (a *DefaultBusinessValueAssessor) calculateCostEfficiency(app Application, agreement *GovernanceAgreement, usage UsageMetrics) float64 {
+	if app.Cost.IsRecorded() {
+		target := a.Profile.TargetAnnualCostPerUser
+		if target == 0 {
+			target = DefaultEvaluationProfile().TargetAnnualCostPerUser
+		}
+		return costEfficiencyFromRecordedCost(app.Cost, usage.ActiveUsers, target)
+	}
+
+	baseEfficiency := 60.0 // Base efficiency
+
+	// Governance agreements improve cost efficiency through oversight
+	if agreement != nil {
+		baseEfficiency += 15.0
+
+		// Resource allocation indicates cost management
+		if len(agreement.Direct.ResourceAllocation.BudgetAllocations) > 0 {
+			baseEfficiency += 10.0
+		}
+	}
+
+	// Application status affects cost efficiency
+	switch app.Status {
+	case StatusActive:
+		baseEfficiency += 10.0 // Active maintenance
+	case StatusDeprecated:
+		baseEfficiency -= 15.0 // High maintenance costs
+	case StatusRetired:
+		baseEfficiency -= 25.0 // Very high maintenance costs
+	case StatusPlanned:
+		baseEfficiency += 5.0 // Planned efficiency
+	}
+
+	// Age affects efficiency (older systems may be more expensive to maintain)
+	if !app.CreatedAt.IsZero() {
+		ageInYears := time.Since(app.CreatedAt).Hours() / (24 * 365)
+		if ageInYears > 5 {
+			baseEfficiency -= 10.0
+		} else if ageInYears < 1 {
+			baseEfficiency += 5.0 // New systems are more efficient
+		}
+	}
+
+	// Security provisions may indicate higher quality (better efficiency)
+	securityMeasures := len(app.SecurityProvisions.DataConfidentiality) +
+		len(app.SecurityProvisions.DataIntegrity) +
+		len(app.SecurityProvisions.RolesAndPermissions)
+	if securityMeasures > 3 {
+		baseEfficiency += 5.0
+	}
+
+	// Ensure bounds
+	if baseEfficiency > 100.0 {
+		baseEfficiency = 100.0
+	}
+	if baseEfficiency < 0.0 {
+		baseEfficiency = 0.0
+	}
+
+	return baseEfficiency
+}
+
+// calculateUserSatisfaction returns the satisfaction of the agreement's answered stakeholder
+// surveys, reporting true, or otherwise estimates user satisfaction based on application factors
+func (a *DefaultBusinessValueAssessor) calculateUserSatisfaction(app Application, agreement *GovernanceAgreement) (float64, bool) {
+	if agreement != nil {
+		if satisfaction, respondents := SurveySatisfaction(agreement.Monitor.StakeholderFeedback); respondents > 0 {
+			return satisfaction, true
+		}
+	}
+
+	baseSatisfaction := 65.0 // Base satisfaction
+
+	// Governance agreement indicates better user experience management
+	if agreement != nil {
+		baseSatisfaction += 15.0
+
+		// Performance metrics indicate user focus
+		if len(agreement.Evaluate.PerformanceMetrics) > 0 {
+			baseSatisfaction += 5.0
+		}
+	}
+
+	// Application status affects user satisfaction
+	switch app.Status {
+	case StatusActive:
+		baseSatisfaction += 10.0
+	case StatusDeprecated:
+		baseSatisfaction -= 15.0 // Users frustrated with deprecated systems
+	case StatusRetired:
+		baseSatisfaction -= 30.0 // Users very dissatisfied
+	case StatusPlanned:
+		baseSatisfaction += 5.0 // Anticipation of new system
+	}
+
+	// Recent updates indicate better user experience
+	if !app.UpdatedAt.IsZero() && time.Since(app.UpdatedAt).Hours() < 24*60 {
+		baseSatisfaction += 8.0
+	}
+
+	// Security features may affect perceived reliability
+	if len(app.SecurityProvisions.RolesAndPermissions) > 0 {
+		baseSatisfaction += 3.0
+	}
+
+	// Ensure bounds
+	if baseSatisfaction > 100.0 {
+		baseSatisfaction = 100.0
+	}
+	if baseSatisfaction < 0.0 {
+		baseSatisfaction = 0.0
+	}
+
+	return baseSatisfaction, false
+}
+
+// ClassifyRisk calculates the overall risk level
+func (c *DefaultRiskClassifier) ClassifyRisk(techHealth TechnicalHealth, businessValue BusinessValueAssessment) RiskLevel {
+	thresholds := c.Thresholds
+	if thresholds == (RiskThresholds{}) {
+		thresholds = DefaultEvaluationProfile().RiskThresholds
+	}
+	avgScore := (techHealth.CodeQuality + techHealth.SecurityScore + techHealth.PerformanceScore) / 3
+
+	if avgScore <= thresholds.CriticalMaxTechnicalScore || businessValue.CostEfficiency < thresholds.CriticalMinCostEfficiency {
+		return RiskCritical
+	}
+	if avgScore <= thresholds.HighMaxTechnicalScore || businessValue.CostEfficiency < thresholds.HighMinCostEfficiency {
+		return RiskHigh
+	}
+	if avgScore <= thresholds.MediumMaxTechnicalScore {
+		return RiskMedium
+	}
+	return RiskLow
+}
@@ -0,0 +1,320 @@
+package domain
+
+import (
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Aggregate types events are recorded against
+const (
+	AggregateApplication          = "Application"
+	AggregatePortfolio            = "Portfolio"
+	AggregateAgreement            = "GovernanceAgreement"
+	AggregateAssessment           = "ApplicationAssessment"
+	AggregateComplianceAssessment = "ComplianceAssessment"
+	AggregateChangeRequest        = "ChangeRequest"
+	AggregateIncident             = "Incident"
+	AggregateProblem              = "Problem"
+	AggregateAudit                = "Audit"
+	AggregateKPI                  = "KPI"
+	AggregateAlert                = "Alert"
+	AggregateEvidence             = "Evidence"
+	AggregateDigest               = "ExecutiveDigest"
+	AggregateDecision             = "GovernanceDecision"
+	AggregateAttestationCampaign  = "AttestationCampaign"
+	AggregateVendor               = "Vendor"
+	AggregateDPIA                 = "DPIA"
+)
+
+// EventEnvelope carries a domain event with what identifies it outside the process: its ID, the
+// aggregate it was recorded against, its position among that aggregate's events and its payload
+// as JSON
+type EventEnvelope struct {
+	ID            string          `json:"id"`
+	Type          string          `json:"type"`
+	AggregateType string          `json:"aggregate_type,omitempty"`
+	AggregateID   string          `json:"aggregate_id,omitempty"`
+	Sequence      int64           `json:"sequence,omitempty"` // from 1 within the aggregate; 0 when not stored yet
+	OccurredAt    time.Time       `json:"occurred_at"`
+	Payload       json.RawMessage `json:"payload"`
+}
+
+// NewEventEnvelope wraps the event in an envelope with its ID, its aggregate reference and the
+// sequence, which the event store assigns
+func NewEventEnvelope(event DomainEvent, sequence int64) (EventEnvelope, error) {
+	payload, err := MarshalEvent(event)
+	if err != nil {
+		return EventEnvelope{}, err
+	}
+	aggregateType, aggregateID := AggregateOf(event)
+	return EventEnvelope{
+		ID:            eventID(event.EventType(), payload),
+		Type:          event.EventType(),
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		Sequence:      sequence,
+		OccurredAt:    event.Time(),
+		Payload:       payload,
+	}, nil
+}
+
+// EnvelopeEvents wraps events, in the order they were recorded, in envelopes numbering each
+// aggregate's events from 1
+func EnvelopeEvents(events []DomainEvent) ([]EventEnvelope, error) {
+	sequences := make(map[string]int64)
+	envelopes := make([]EventEnvelope, 0, len(events))
+	for _, event := range events {
+		aggregateType, aggregateID := AggregateOf(event)
+		key := aggregateType + "/" + aggregateID
+		sequences[key]++
+		envelope, err := NewEventEnvelope(event, sequences[key])
+		if err != nil {
+			return nil, err
+		}
+		envelopes = append(envelopes, envelope)
+	}
+	return envelopes, nil
+}
+
+// Event decodes the envelope's payload into the event of its type
+func (e EventEnvelope) Event() (DomainEvent, error) {
+	return UnmarshalEvent(e.Type, e.Payload)
+}
+
+// EventID identifies the event as a version 5 UUID derived from its type and fields, so the same
+// event always gets the same ID and consumers can tell redeliveries and replays from new events
+func EventID(event DomainEvent) (string, error) {
+	payload, err := MarshalEvent(event)
+	if err != nil {
+		return "", err
+	}
+	return eventID(event.EventType(), payload), nil
+}
+
+func eventID(eventType string, payload []byte) string {
+	hash := sha1.New()
+	hash.Write([]byte(eventType))
+	hash.Write([]byte{0})
+	hash.Write(payload)
+	b := hash.Sum(nil)[:16]
+	b[6] = b[6]&0x0f | 0x50
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+// MarshalEvent encodes the event's fields as JSON
+func MarshalEvent(event DomainEvent) ([]byte, error) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s event: %w", event.EventType(), err)
+	}
+	return data, nil
+}
+
+// UnmarshalEvent decodes JSON encoded by MarshalEvent into an event of the registered type
+func UnmarshalEvent(eventType string, data []byte) (DomainEvent, error) {
+	registration, ok := registeredEvent(eventType)
+	if !ok {
+		return nil, fmt.Errorf("unknown event type %q", eventType)
+	}
+	value := reflect.New(registration.event)
+	if err := json.Unmarshal(data, value.Interface()); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s event: %w", eventType, err)
+	}
+	return value.Elem().Interface().(DomainEvent), nil
+}
+
+// AggregateOf returns the type and ID of the aggregate the event was recorded against, or empty
+// strings for events of unregistered types
+func AggregateOf(event DomainEvent) (aggregateType, aggregateID string) {
+	registration, ok := registeredEvent(event.EventType())
+	if !ok {
+		return "", ""
+	}
+	value := reflect.ValueOf(event)
+	for value.Kind() == reflect.Ptr {
+		value = value.Elem()
+	}
+	for _, ref := range registration.aggregates {
+		field := value.FieldByName(ref.Field)
+		if field.IsValid() && field.Kind() == reflect.String && field.String() != "" {
+			return ref.AggregateType, field.String()
+		}
+	}
+	return "", ""
+}
+
+// AggregateRef names the field of an event holding the ID of the aggregate it was recorded
+// against, and that aggregate's type
+type AggregateRef struct {
+	Field         string
+	AggregateType string
+}
+
+type eventRegistration struct {
+	event      reflect.Type
+	aggregates []AggregateRef
+}
+
+var (
+	eventRegistryMu sync.RWMutex
+	eventRegistry   = map[string]eventRegistration{}
+)
+
+// RegisterEvent makes events of the example's type serializable, e.g. custom events recorded
+// alongside the SDK's. The aggregate refs are tried in order; the first with a non-empty ID
+// names the event's aggregate.
+func RegisterEvent(example DomainEvent, aggregates ...AggregateRef) {
+	registration := eventRegistration{event: reflect.TypeOf(example), aggregates: aggregates}
+
+	eventRegistryMu.Lock()
+	defer eventRegistryMu.Unlock()
+	eventRegistry[example.EventType()] = registration
+}
+
+// RegisteredEventTypes returns the event types that can be serialized, sorted
+func RegisteredEventTypes() []string {
+	eventRegistryMu.RLock()
+	defer eventRegistryMu.RUnlock()
+
+	types := make([]string, 0, len(eventRegistry))
+	for eventType := range eventRegistry {
+		types = append(types, eventType)
+	}
+	sort.Strings(types)
+	return types
+}
+
+func registeredEvent(eventType string) (eventRegistration, bool) {
+	eventRegistryMu.RLock()
+	defer eventRegistryMu.RUnlock()
+	registration, ok := eventRegistry[eventType]
+	return registration, ok
+}
+
+func init() {
+	application := AggregateRef{Field: "ApplicationID", AggregateType: AggregateApplication}
+	portfolio := AggregateRef{Field: "PortfolioID", AggregateType: AggregatePortfolio}
+	agreement := AggregateRef{Field: "AgreementID", AggregateType: AggregateAgreement}
+	assessment := AggregateRef{Field: "AssessmentID", AggregateType: AggregateAssessment}
+	complianceAssessment := AggregateRef{Field: "AssessmentID", AggregateType: AggregateComplianceAssessment}
+	changeRequest := AggregateRef{Field: "ChangeRequestID", AggregateType: AggregateChangeRequest}
+	incident := AggregateRef{Field: "IncidentID", AggregateType: AggregateIncident}
+	problem := AggregateRef{Field: "ProblemID", AggregateType: AggregateProblem}
+	audit := AggregateRef{Field: "AuditID", AggregateType: AggregateAudit}
+	kpi := AggregateRef{Field: "KPIID", AggregateType: AggregateKPI}
+	alert := AggregateRef{Field: "AlertID", AggregateType: AggregateAlert}
+	evidence := AggregateRef{Field: "EvidenceID", AggregateType: AggregateEvidence}
+	digest := AggregateRef{Field: "DigestID", AggregateType: AggregateDigest}
+	decision := AggregateRef{Field: "DecisionID", AggregateType: AggregateDecision}
+	campaign := AggregateRef{Field: "CampaignID", AggregateType: AggregateAttestationCampaign}
+	vendor := AggregateRef{Field: "VendorID", AggregateType: AggregateVendor}
+	dpia := AggregateRef{Field: "DPIAID", AggregateType: AggregateDPIA}
+
+	// Portfolios and applications
+	RegisterEvent(PortfolioCreatedEvent{}, portfolio)
+	RegisterEvent(ApplicationAddedToPortfolioEvent{}, portfolio)
+	RegisterEvent(ApplicationRemovedFromPortfolioEvent{}, portfolio)
+	RegisterEvent(ApplicationUpdatedEvent{}, application)
+	RegisterEvent(ApplicationEvaluatedEvent{}, application)
+	RegisterEvent(AssessmentChangedEvent{}, application)
+	RegisterEvent(ApplicationDataClassifiedEvent{}, application)
+	RegisterEvent(SLABreachDetectedEvent{}, application)
+	RegisterEvent(RetentionViolationDetectedEvent{}, application)
+
+	// Governance agreements and what they hold: direction, policies, objectives and requirements
+	RegisterEvent(GovernanceAgreementCreatedEvent{}, agreement)
+	RegisterEvent(GovernanceAgreementUpdatedEvent{}, agreement)
+	RegisterEvent(GovernanceAgreementApprovedEvent{}, agreement)
+	RegisterEvent(GovernanceAgreementActivatedEvent{}, agreement)
+	RegisterEvent(GovernanceEvaluationCompletedEvent{}, agreement, portfolio)
+	RegisterEvent(GovernanceDirectionSetEvent{}, agreement)
+	RegisterEvent(GovernanceMonitoringCompletedEvent{}, agreement)
+	RegisterEvent(EscalationTriggeredEvent{}, agreement, application)
+	RegisterEvent(FrameworkRequirementsAttachedEvent{}, agreement)
+	RegisterEvent(ControlStatusAppliedEvent{}, agreement)
+	RegisterEvent(ComplianceViolationDetectedEvent{}, agreement, application)
+	RegisterEvent(KPIAnomalyDetectedEvent{}, agreement)
+	RegisterEvent(SurveyClosedEvent{}, agreement)
+	RegisterEvent(ObjectiveKPICoverageGapEvent{}, agreement)
+	RegisterEvent(InitiativeProgressUpdatedEvent{}, agreement)
+	RegisterEvent(BudgetAlertRaisedEvent{}, agreement)
+	RegisterEvent(PolicySubmittedEvent{}, agreement)
+	RegisterEvent(PolicyApprovedEvent{}, agreement)
+	RegisterEvent(PolicyPublishedEvent{}, agreement)
+	RegisterEvent(PolicyRetiredEvent{}, agreement)
+	RegisterEvent(GovernanceDocumentRevisedEvent{}, agreement)
+	RegisterEvent(DocumentVersionPinnedEvent{}, agreement)
+	RegisterEvent(KeyResultRecordedEvent{}, agreement, portfolio)
+	RegisterEvent(ResourceOverAllocatedEvent{}, agreement)
+	RegisterEvent(ObjectiveDeadlineSlippedEvent{}, agreement)
+	RegisterEvent(DirectionProposedEvent{}, agreement)
+	RegisterEvent(DirectionReviewedEvent{}, agreement)
+	RegisterEvent(DirectionApprovedEvent{}, agreement)
+	RegisterEvent(DirectionRejectedEvent{}, agreement)
+	RegisterEvent(RequirementMappedEvent{}, agreement)
+	RegisterEvent(RequirementCoverageGapEvent{}, agreement)
+	RegisterEvent(RequirementExpiredEvent{}, agreement, application)
+
+	// Assessments
+	RegisterEvent(AssessmentReviewedEvent{}, assessment)
+	RegisterEvent(AssessmentSignedOffEvent{}, assessment)
+	RegisterEvent(ComplianceAssessmentStartedEvent{}, complianceAssessment)
+	RegisterEvent(ComplianceAssessmentCompletedEvent{}, complianceAssessment)
+
+	// Change, incident and problem management
+	RegisterEvent(ChangeRequestCreatedEvent{}, changeRequest)
+	RegisterEvent(ChangeRequestSubmittedEvent{}, changeRequest)
+	RegisterEvent(ChangeApprovalRecordedEvent{}, changeRequest)
+	RegisterEvent(ChangeRequestApprovedEvent{}, changeRequest)
+	RegisterEvent(ChangeRequestRejectedEvent{}, changeRequest)
+	RegisterEvent(ChangeRequestImplementedEvent{}, changeRequest)
+	RegisterEvent(ChangeRequestClosedEvent{}, changeRequest)
+	RegisterEvent(ChangeRequestWithdrawnEvent{}, changeRequest)
+	RegisterEvent(ChangeRequestCancelledEvent{}, changeRequest)
+	RegisterEvent(IncidentReportedEvent{}, incident)
+	RegisterEvent(IncidentAcknowledgedEvent{}, incident)
+	RegisterEvent(IncidentAssignedEvent{}, incident)
+	RegisterEvent(IncidentResolvedEvent{}, incident)
+	RegisterEvent(IncidentReopenedEvent{}, incident)
+	RegisterEvent(IncidentClosedEvent{}, incident)
+	RegisterEvent(IncidentSLABreachedEvent{}, incident)
+	RegisterEvent(PostIncidentReviewCompletedEvent{}, incident)
+	RegisterEvent(ProblemLoggedEvent{}, problem)
+	RegisterEvent(KnownErrorRecordedEvent{}, problem)
+	RegisterEvent(ProblemResolvedEvent{}, problem)
+
+	// Audits
+	RegisterEvent(AuditStartedEvent{}, audit)
+	RegisterEvent(AuditCompletedEvent{}, audit)
+	RegisterEvent(AuditCancelledEvent{}, audit)
+	RegisterEvent(AuditOverdueEvent{}, audit)
+	RegisterEvent(AuditClosedEvent{}, audit)
+	RegisterEvent(RemediationActionRecordedEvent{}, audit)
+	RegisterEvent(FindingRemediatedEvent{}, audit)
+
+	// KPIs, alerts and evidence
+	RegisterEvent(KPIDefinedEvent{}, kpi)
+	RegisterEvent(KPIMeasurementRecordedEvent{}, kpi)
+	RegisterEvent(AlertRaisedEvent{}, alert)
+	RegisterEvent(AlertAcknowledgedEvent{}, alert)
+	RegisterEvent(AlertResolvedEvent{}, alert)
+	RegisterEvent(EvidenceAttachedEvent{}, evidence)
+	RegisterEvent(EvidenceExpiredEvent{}, evidence)
+
+	// Reporting, decisions, attestations, vendors and data protection
+	RegisterEvent(ExecutiveDigestGeneratedEvent{}, digest)
+	RegisterEvent(GovernanceDecisionRecordedEvent{}, decision)
+	RegisterEvent(AttestationCampaignLaunchedEvent{}, campaign)
+	RegisterEvent(AttestationSignedOffEvent{}, campaign)
+	RegisterEvent(AttestationCampaignClosedEvent{}, campaign)
+	RegisterEvent(VendorRiskRatedEvent{}, vendor)
+	RegisterEvent(VendorSLABreachedEvent{}, vendor)
+	RegisterEvent(DPIASubmittedEvent{}, dpia)
+	RegisterEvent(DPIADecidedEvent{}, dpia)
+}
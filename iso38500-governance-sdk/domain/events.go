@@ -135,6 +135,7 @@ func (e GovernanceAgreementActivatedEvent) Time() time.Time {
 // GovernanceEvaluationCompletedEvent represents a governance evaluation completion event
 type GovernanceEvaluationCompletedEvent struct {
 	AgreementID     GovernanceAgreementID
+	PortfolioID     PortfolioID // set when a whole portfolio was evaluated
 	Evaluator       string
 	Findings        []string
 	Recommendations []string
@@ -149,6 +150,23 @@ func (e GovernanceEvaluationCompletedEvent) Time() time.Time {
 	return e.OccurredAt
 }
 
+// AssessmentChangedEvent represents a re-evaluation whose results differ from the previous assessment
+type AssessmentChangedEvent struct {
+	ApplicationID    ApplicationID
+	FromAssessmentID string
+	ToAssessmentID   string
+	Changes          []string
+	OccurredAt       time.Time
+}
+
+func (e AssessmentChangedEvent) EventType() string {
+	return "AssessmentChanged"
+}
+
+func (e AssessmentChangedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
 // GovernanceDirectionSetEvent represents a governance direction setting event
 type GovernanceDirectionSetEvent struct {
 	AgreementID GovernanceAgreementID
@@ -224,7 +242,9 @@ type IncidentReportedEvent struct {
 	ApplicationID  ApplicationID
 	Reporter       string
 	Severity       int
+	Priority       int
 	Description    string
+	SuspectedChanges []string
 	OccurredAt     time.Time
 }
 
@@ -236,6 +256,134 @@ func (e IncidentReportedEvent) Time() time.Time {
 	return e.OccurredAt
 }
 
+// EscalationTriggeredEvent represents the escalation of an alert, incident or change request to a
+// level of its escalation matrix
+type EscalationTriggeredEvent struct {
+	Subject       EscalationSubject
+	SubjectID     string
+	AgreementID   GovernanceAgreementID
+	ApplicationID ApplicationID
+	Level         int
+	Contacts      []string
+	Waiting       time.Duration
+	OccurredAt    time.Time
+}
+
+func (e EscalationTriggeredEvent) EventType() string {
+	return "EscalationTriggered"
+}
+
+func (e EscalationTriggeredEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// ExecutiveDigestGeneratedEvent represents the generation of a portfolio's executive digest
+type ExecutiveDigestGeneratedEvent struct {
+	DigestID    string
+	PortfolioID PortfolioID
+	Frequency   DigestFrequency
+	From        time.Time
+	To          time.Time
+	Sent        bool
+	OccurredAt  time.Time
+}
+
+func (e ExecutiveDigestGeneratedEvent) EventType() string {
+	return "ExecutiveDigestGenerated"
+}
+
+func (e ExecutiveDigestGeneratedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// FrameworkRequirementsAttachedEvent represents requirements of a compliance framework being
+// attached to a governance agreement
+type FrameworkRequirementsAttachedEvent struct {
+	AgreementID  GovernanceAgreementID
+	FrameworkID  string
+	Requirements []RequirementRef
+	OccurredAt   time.Time
+}
+
+func (e FrameworkRequirementsAttachedEvent) EventType() string {
+	return "FrameworkRequirementsAttached"
+}
+
+func (e FrameworkRequirementsAttachedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// ControlStatusAppliedEvent represents the compliance status found for a framework control,
+// by an audit finding or evidence, being applied to every requirement of an agreement it maps to
+type ControlStatusAppliedEvent struct {
+	AgreementID  GovernanceAgreementID
+	Control      ControlRef
+	Status       ComplianceStatus
+	Source       string
+	Requirements []RequirementRef
+	OccurredAt   time.Time
+}
+
+func (e ControlStatusAppliedEvent) EventType() string {
+	return "ControlStatusApplied"
+}
+
+func (e ControlStatusAppliedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// ComplianceAssessmentStartedEvent represents the start of a compliance assessment of an agreement
+type ComplianceAssessmentStartedEvent struct {
+	AssessmentID string
+	AgreementID  GovernanceAgreementID
+	FrameworkID  string
+	Controls     int
+	OccurredAt   time.Time
+}
+
+func (e ComplianceAssessmentStartedEvent) EventType() string {
+	return "ComplianceAssessmentStarted"
+}
+
+func (e ComplianceAssessmentStartedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// ComplianceAssessmentCompletedEvent represents the completion of a compliance assessment with its
+// weighted compliance percentage
+type ComplianceAssessmentCompletedEvent struct {
+	AssessmentID string
+	AgreementID  GovernanceAgreementID
+	FrameworkID  string
+	Percentage   float64
+	OccurredAt   time.Time
+}
+
+func (e ComplianceAssessmentCompletedEvent) EventType() string {
+	return "ComplianceAssessmentCompleted"
+}
+
+func (e ComplianceAssessmentCompletedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// IncidentAcknowledgedEvent represents an incident acknowledgement event
+type IncidentAcknowledgedEvent struct {
+	IncidentID        string
+	ApplicationID     ApplicationID
+	Acknowledger      string
+	TimeToAcknowledge time.Duration
+	OccurredAt        time.Time
+}
+
+func (e IncidentAcknowledgedEvent) EventType() string {
+	return "IncidentAcknowledged"
+}
+
+func (e IncidentAcknowledgedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
 // IncidentResolvedEvent represents an incident resolution event
 type IncidentResolvedEvent struct {
 	IncidentID     string
@@ -256,10 +404,12 @@ func (e IncidentResolvedEvent) Time() time.Time {
 // ComplianceViolationDetectedEvent represents a compliance violation detection event
 type ComplianceViolationDetectedEvent struct {
 	ViolationID     string
+	AgreementID     GovernanceAgreementID
 	ApplicationID   ApplicationID
 	RequirementType string
 	Description     string
 	Severity        string
+	Remediation     string // hint on how to remediate the violation
 	OccurredAt      time.Time
 }
 
@@ -271,6 +421,45 @@ func (e ComplianceViolationDetectedEvent) Time() time.Time {
 	return e.OccurredAt
 }
 
+// KPIAnomalyDetectedEvent represents a KPI measurement deviating unusually from the KPI's history
+type KPIAnomalyDetectedEvent struct {
+	AgreementID  GovernanceAgreementID
+	KPIID        string
+	Value        float64
+	Expected     float64
+	Deviation    float64 // signed standard deviations from Expected
+	Methods      []KPIAnomalyMethod
+	EarlyWarning bool // adverse deviation of a measurement still meeting its target
+	MeasuredAt   time.Time
+	OccurredAt   time.Time
+}
+
+func (e KPIAnomalyDetectedEvent) EventType() string {
+	return "KPIAnomalyDetected"
+}
+
+func (e KPIAnomalyDetectedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// SurveyClosedEvent represents a stakeholder survey closing with its result
+type SurveyClosedEvent struct {
+	AgreementID    GovernanceAgreementID
+	SurveyID       string
+	TotalResponses int
+	ResponseRate   float64 // respondents per invited stakeholder, 0-1
+	Satisfaction   float64 // 0-100
+	OccurredAt     time.Time
+}
+
+func (e SurveyClosedEvent) EventType() string {
+	return "SurveyClosed"
+}
+
+func (e SurveyClosedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
 // AuditCompletedEvent represents an audit completion event
 type AuditCompletedEvent struct {
 	AuditID        string
@@ -289,3 +478,1118 @@ func (e AuditCompletedEvent) EventType() string {
 func (e AuditCompletedEvent) Time() time.Time {
 	return e.OccurredAt
 }
+
+// AuditStartedEvent represents an audit moving to in progress
+type AuditStartedEvent struct {
+	AuditID       string
+	ApplicationID ApplicationID
+	Auditor       string
+	DueDate       time.Time
+	OccurredAt    time.Time
+}
+
+func (e AuditStartedEvent) EventType() string {
+	return "AuditStarted"
+}
+
+func (e AuditStartedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// AuditCancelledEvent represents an audit being cancelled before it was completed
+type AuditCancelledEvent struct {
+	AuditID       string
+	ApplicationID ApplicationID
+	CancelledBy   string
+	Reason        string
+	OccurredAt    time.Time
+}
+
+func (e AuditCancelledEvent) EventType() string {
+	return "AuditCancelled"
+}
+
+func (e AuditCancelledEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// AuditOverdueEvent represents an audit passing its due date before it was completed
+type AuditOverdueEvent struct {
+	AuditID       string
+	ApplicationID ApplicationID
+	Auditor       string
+	DueDate       time.Time
+	Started       bool
+	OccurredAt    time.Time
+}
+
+func (e AuditOverdueEvent) EventType() string {
+	return "AuditOverdue"
+}
+
+func (e AuditOverdueEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// RemediationActionRecordedEvent represents a remediation action of an audit finding being added
+// or changing status
+type RemediationActionRecordedEvent struct {
+	AuditID       string
+	FindingID     string
+	ApplicationID ApplicationID
+	ActionID      string
+	Owner         string
+	DueDate       time.Time
+	Status        ActionStatus
+	RecordedBy    string
+	OccurredAt    time.Time
+}
+
+func (e RemediationActionRecordedEvent) EventType() string {
+	return "RemediationActionRecorded"
+}
+
+func (e RemediationActionRecordedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// FindingRemediatedEvent represents every remediation action of an audit finding being done
+type FindingRemediatedEvent struct {
+	AuditID       string
+	FindingID     string
+	ApplicationID ApplicationID
+	Severity      string
+	OccurredAt    time.Time
+}
+
+func (e FindingRemediatedEvent) EventType() string {
+	return "FindingRemediated"
+}
+
+func (e FindingRemediatedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// AuditClosedEvent represents a completed audit being closed once its findings are remediated
+type AuditClosedEvent struct {
+	AuditID       string
+	ApplicationID ApplicationID
+	ClosedBy      string
+	OpenFindings  int // findings not remediated that the closure policy lets the audit close with
+	OccurredAt    time.Time
+}
+
+func (e AuditClosedEvent) EventType() string {
+	return "AuditClosed"
+}
+
+func (e AuditClosedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// AssessmentReviewedEvent represents the review of an assessment
+type AssessmentReviewedEvent struct {
+	AssessmentID  string
+	ApplicationID ApplicationID
+	Reviewer      string
+	Comments      string
+	OccurredAt    time.Time
+}
+
+func (e AssessmentReviewedEvent) EventType() string {
+	return "AssessmentReviewed"
+}
+
+func (e AssessmentReviewedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// AssessmentSignedOffEvent represents the sign-off of a reviewed assessment
+type AssessmentSignedOffEvent struct {
+	AssessmentID  string
+	ApplicationID ApplicationID
+	Evaluator     string
+	Reviewer      string
+	Approver      string
+	RiskLevel     RiskLevel
+	OccurredAt    time.Time
+}
+
+func (e AssessmentSignedOffEvent) EventType() string {
+	return "AssessmentSignedOff"
+}
+
+func (e AssessmentSignedOffEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// ObjectiveKPICoverageGapEvent represents strategic objectives found without linked or measured KPIs
+type ObjectiveKPICoverageGapEvent struct {
+	AgreementID        GovernanceAgreementID
+	UnlinkedObjectives []string
+	UnknownKPIs        []ObjectiveKPIRef
+	UnmeasuredKPIs     []ObjectiveKPIRef
+	OccurredAt         time.Time
+}
+
+func (e ObjectiveKPICoverageGapEvent) EventType() string {
+	return "ObjectiveKPICoverageGap"
+}
+
+func (e ObjectiveKPICoverageGapEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// InitiativeProgressUpdatedEvent represents a status update recorded for a strategic initiative
+type InitiativeProgressUpdatedEvent struct {
+	AgreementID     GovernanceAgreementID
+	InitiativeID    string
+	PercentComplete float64
+	Status          InitiativeStatus
+	UpdatedBy       string
+	OccurredAt      time.Time
+}
+
+func (e InitiativeProgressUpdatedEvent) EventType() string {
+	return "InitiativeProgressUpdated"
+}
+
+func (e InitiativeProgressUpdatedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// BudgetAlertRaisedEvent represents spend escalating a budget allocation's alert level
+type BudgetAlertRaisedEvent struct {
+	AgreementID    GovernanceAgreementID
+	Category       string
+	Level          BudgetAlertLevel
+	Allocated      float64
+	Spent          float64
+	ProjectedSpend float64
+	OccurredAt     time.Time
+}
+
+func (e BudgetAlertRaisedEvent) EventType() string {
+	return "BudgetAlertRaised"
+}
+
+func (e BudgetAlertRaisedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// PolicySubmittedEvent represents a policy being submitted for approval
+type PolicySubmittedEvent struct {
+	AgreementID GovernanceAgreementID
+	PolicyID    string
+	SubmittedBy string
+	OccurredAt  time.Time
+}
+
+func (e PolicySubmittedEvent) EventType() string {
+	return "PolicySubmitted"
+}
+
+func (e PolicySubmittedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// PolicyApprovedEvent represents a policy approval
+type PolicyApprovedEvent struct {
+	AgreementID GovernanceAgreementID
+	PolicyID    string
+	ApprovedBy  string
+	OccurredAt  time.Time
+}
+
+func (e PolicyApprovedEvent) EventType() string {
+	return "PolicyApproved"
+}
+
+func (e PolicyApprovedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// PolicyPublishedEvent represents a policy being put into effect
+type PolicyPublishedEvent struct {
+	AgreementID    GovernanceAgreementID
+	PolicyID       string
+	EffectiveFrom  time.Time
+	EffectiveUntil time.Time
+	OccurredAt     time.Time
+}
+
+func (e PolicyPublishedEvent) EventType() string {
+	return "PolicyPublished"
+}
+
+func (e PolicyPublishedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// PolicyRetiredEvent represents a policy being withdrawn
+type PolicyRetiredEvent struct {
+	AgreementID GovernanceAgreementID
+	PolicyID    string
+	Reason      string
+	OccurredAt  time.Time
+}
+
+func (e PolicyRetiredEvent) EventType() string {
+	return "PolicyRetired"
+}
+
+func (e PolicyRetiredEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// GovernanceDocumentRevisedEvent represents a new version of a policy, standard or procedure
+type GovernanceDocumentRevisedEvent struct {
+	AgreementID GovernanceAgreementID
+	Kind        DocumentKind
+	DocumentID  string
+	Version     int
+	ChangedBy   string
+	Summary     string
+	OccurredAt  time.Time
+}
+
+func (e GovernanceDocumentRevisedEvent) EventType() string {
+	return "GovernanceDocumentRevised"
+}
+
+func (e GovernanceDocumentRevisedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// DocumentVersionPinnedEvent represents an agreement being pinned to, or with version 0 unpinned
+// from, a version of a governance document
+type DocumentVersionPinnedEvent struct {
+	AgreementID GovernanceAgreementID
+	Kind        DocumentKind
+	DocumentID  string
+	Version     int
+	PinnedBy    string
+	OccurredAt  time.Time
+}
+
+func (e DocumentVersionPinnedEvent) EventType() string {
+	return "DocumentVersionPinned"
+}
+
+func (e DocumentVersionPinnedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// KeyResultRecordedEvent represents the actual value of an OKR's key result being recorded, for
+// an agreement's OKR or, with PortfolioID set, a portfolio's
+type KeyResultRecordedEvent struct {
+	AgreementID GovernanceAgreementID
+	PortfolioID PortfolioID
+	OKRID       string
+	KeyResultID string
+	Actual      float64
+	Target      float64
+	Progress    float64
+	RecordedBy  string
+	OccurredAt  time.Time
+}
+
+func (e KeyResultRecordedEvent) EventType() string {
+	return "KeyResultRecorded"
+}
+
+func (e KeyResultRecordedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// GovernanceDecisionRecordedEvent represents a governance decision being added to the decision log
+type GovernanceDecisionRecordedEvent struct {
+	DecisionID    string
+	Title         string
+	AgreementID   GovernanceAgreementID
+	ApplicationID ApplicationID
+	DecidedBy     string
+	Supersedes    string
+	OccurredAt    time.Time
+}
+
+func (e GovernanceDecisionRecordedEvent) EventType() string {
+	return "GovernanceDecisionRecorded"
+}
+
+func (e GovernanceDecisionRecordedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// ResourceOverAllocatedEvent represents roles found needed for more than the personnel allocated
+// to an agreement, in the months listed by the conflicts
+type ResourceOverAllocatedEvent struct {
+	AgreementID GovernanceAgreementID
+	Conflicts   []CapacityPeriod
+	OccurredAt  time.Time
+}
+
+func (e ResourceOverAllocatedEvent) EventType() string {
+	return "ResourceOverAllocated"
+}
+
+func (e ResourceOverAllocatedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// ObjectiveDeadlineSlippedEvent represents a strategic objective whose action plans are projected
+// to complete later than before and after its deadline
+type ObjectiveDeadlineSlippedEvent struct {
+	AgreementID         GovernanceAgreementID
+	ObjectiveID         string
+	Deadline            time.Time
+	ProjectedCompletion time.Time
+	CriticalPath        []string // actions determining the projection, as plan/action
+	OccurredAt          time.Time
+}
+
+func (e ObjectiveDeadlineSlippedEvent) EventType() string {
+	return "ObjectiveDeadlineSlipped"
+}
+
+func (e ObjectiveDeadlineSlippedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// DirectionProposedEvent represents a strategic direction put to a governance body for ratification
+type DirectionProposedEvent struct {
+	AgreementID   GovernanceAgreementID
+	ProposalID    string
+	ProposedBy    string
+	GoverningBody string
+	Objectives    []string
+	OccurredAt    time.Time
+}
+
+func (e DirectionProposedEvent) EventType() string {
+	return "DirectionProposed"
+}
+
+func (e DirectionProposedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// DirectionReviewedEvent represents the review of a proposed strategic direction
+type DirectionReviewedEvent struct {
+	AgreementID GovernanceAgreementID
+	ProposalID  string
+	ReviewedBy  string
+	Comments    string
+	OccurredAt  time.Time
+}
+
+func (e DirectionReviewedEvent) EventType() string {
+	return "DirectionReviewed"
+}
+
+func (e DirectionReviewedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// DirectionApprovedEvent represents a strategic direction ratified by a governance body
+type DirectionApprovedEvent struct {
+	AgreementID GovernanceAgreementID
+	ProposalID  string
+	ApprovedBy  string
+	OccurredAt  time.Time
+}
+
+func (e DirectionApprovedEvent) EventType() string {
+	return "DirectionApproved"
+}
+
+func (e DirectionApprovedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// DirectionRejectedEvent represents a proposed strategic direction turned down
+type DirectionRejectedEvent struct {
+	AgreementID GovernanceAgreementID
+	ProposalID  string
+	RejectedBy  string
+	Comments    string
+	OccurredAt  time.Time
+}
+
+func (e DirectionRejectedEvent) EventType() string {
+	return "DirectionRejected"
+}
+
+func (e DirectionRejectedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// RequirementMappedEvent represents a governance document mapped to a conformance requirement it implements
+type RequirementMappedEvent struct {
+	AgreementID  GovernanceAgreementID
+	Requirement  RequirementRef
+	DocumentKind DocumentKind
+	DocumentID   string
+	MappedBy     string
+	OccurredAt   time.Time
+}
+
+func (e RequirementMappedEvent) EventType() string {
+	return "RequirementMapped"
+}
+
+func (e RequirementMappedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// RequirementCoverageGapEvent represents conformance requirements not implemented by a document in effect
+type RequirementCoverageGapEvent struct {
+	AgreementID GovernanceAgreementID
+	Unmapped    []RequirementRef
+	NotInEffect []RequirementRef
+	OccurredAt  time.Time
+}
+
+func (e RequirementCoverageGapEvent) EventType() string {
+	return "RequirementCoverageGap"
+}
+
+func (e RequirementCoverageGapEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// KPIDefinedEvent represents a KPI defined or redefined
+type KPIDefinedEvent struct {
+	KPIID      string
+	Name       string
+	Target     float64
+	Unit       string
+	Redefined  bool
+	DefinedBy  string
+	OccurredAt time.Time
+}
+
+func (e KPIDefinedEvent) EventType() string {
+	return "KPIDefined"
+}
+
+func (e KPIDefinedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// KPIMeasurementRecordedEvent represents a measurement recorded for a KPI
+type KPIMeasurementRecordedEvent struct {
+	KPIID      string
+	Value      float64
+	Target     float64
+	Achieved   bool
+	MeasuredAt time.Time
+	RecordedBy string
+	OccurredAt time.Time
+}
+
+func (e KPIMeasurementRecordedEvent) EventType() string {
+	return "KPIMeasurementRecorded"
+}
+
+func (e KPIMeasurementRecordedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// AlertRaisedEvent represents a KPI or risk indicator breaching an alert threshold, or an active
+// alert changing severity
+type AlertRaisedEvent struct {
+	AlertID          string
+	AgreementID      GovernanceAgreementID
+	Source           AlertSource
+	Subject          string
+	Severity         AlertSeverity
+	PreviousSeverity AlertSeverity
+	Value            float64
+	Threshold        float64
+	Condition        string
+	Recipients       []string
+	OccurredAt       time.Time
+}
+
+func (e AlertRaisedEvent) EventType() string {
+	return "AlertRaised"
+}
+
+func (e AlertRaisedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// AlertResolvedEvent represents an active alert no longer breaching any threshold
+type AlertResolvedEvent struct {
+	AlertID     string
+	AgreementID GovernanceAgreementID
+	Source      AlertSource
+	Subject     string
+	Severity    AlertSeverity
+	Value       float64
+	RaisedAt    time.Time
+	OccurredAt  time.Time
+}
+
+func (e AlertResolvedEvent) EventType() string {
+	return "AlertResolved"
+}
+
+func (e AlertResolvedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// AlertAcknowledgedEvent represents someone taking on an active alert, which stops its escalation
+type AlertAcknowledgedEvent struct {
+	AlertID        string
+	AgreementID    GovernanceAgreementID
+	Severity       AlertSeverity
+	AcknowledgedBy string
+	RaisedAt       time.Time
+	OccurredAt     time.Time
+}
+
+func (e AlertAcknowledgedEvent) EventType() string {
+	return "AlertAcknowledged"
+}
+
+func (e AlertAcknowledgedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// EvidenceAttachedEvent represents evidence being attached to an assessment or audit finding
+type EvidenceAttachedEvent struct {
+	EvidenceID    string
+	ApplicationID ApplicationID
+	Subject       EvidenceSubject
+	Kind          EvidenceKind
+	URI           string
+	SHA256        string
+	AttachedBy    string
+	OccurredAt    time.Time
+}
+
+func (e EvidenceAttachedEvent) EventType() string {
+	return "EvidenceAttached"
+}
+
+func (e EvidenceAttachedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// EvidenceExpiredEvent represents evidence going stale, with the requirements of the agreement and
+// the compliance assessment answers resting on it put back under review
+type EvidenceExpiredEvent struct {
+	EvidenceID    string
+	ApplicationID ApplicationID
+	AgreementID   GovernanceAgreementID
+	ValidUntil    time.Time
+	Requirements  []RequirementRef
+	Answers       []string // compliance assessment answers as assessment/requirement
+	OccurredAt    time.Time
+}
+
+func (e EvidenceExpiredEvent) EventType() string {
+	return "EvidenceExpired"
+}
+
+func (e EvidenceExpiredEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// SLABreachDetectedEvent represents an observed measurement breaching a declared SLA
+type SLABreachDetectedEvent struct {
+	ApplicationID ApplicationID
+	ServiceName   string
+	Metric        SLAMetric
+	Declared      string
+	Observed      string
+	MeasurementID string
+	OccurredAt    time.Time
+}
+
+func (e SLABreachDetectedEvent) EventType() string {
+	return "SLABreachDetected"
+}
+
+func (e SLABreachDetectedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// AttestationCampaignLaunchedEvent represents a round of an attestation campaign opening
+type AttestationCampaignLaunchedEvent struct {
+	CampaignID   string
+	Series       string
+	Round        int
+	PortfolioID  PortfolioID
+	Attestations int
+	DueAt        time.Time
+	OccurredAt   time.Time
+}
+
+func (e AttestationCampaignLaunchedEvent) EventType() string {
+	return "AttestationCampaignLaunched"
+}
+
+func (e AttestationCampaignLaunchedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// AttestationSignedOffEvent represents an owner confirming or disputing the accuracy of their
+// application's data
+type AttestationSignedOffEvent struct {
+	CampaignID    string
+	ApplicationID ApplicationID
+	Scope         AttestationScope
+	Owner         string
+	Status        AttestationStatus
+	Comment       string
+	OccurredAt    time.Time
+}
+
+func (e AttestationSignedOffEvent) EventType() string {
+	return "AttestationSignedOff"
+}
+
+func (e AttestationSignedOffEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// AttestationCampaignClosedEvent represents a round of an attestation campaign closing
+type AttestationCampaignClosedEvent struct {
+	CampaignID string
+	Completion float64 // share of attestations answered, from 0 to 1
+	Disputed   int
+	Pending    int
+	OccurredAt time.Time
+}
+
+func (e AttestationCampaignClosedEvent) EventType() string {
+	return "AttestationCampaignClosed"
+}
+
+func (e AttestationCampaignClosedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// RequirementExpiredEvent represents a contractual requirement or industry standard certification
+// expiring, downgrading the requirement to non-compliant
+type RequirementExpiredEvent struct {
+	AgreementID    GovernanceAgreementID
+	ApplicationID  ApplicationID
+	Requirement    RequirementRef
+	ExpiresAt      time.Time
+	PreviousStatus ComplianceStatus
+	OccurredAt     time.Time
+}
+
+func (e RequirementExpiredEvent) EventType() string {
+	return "RequirementExpired"
+}
+
+func (e RequirementExpiredEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// VendorRiskRatedEvent represents a vendor's risk rating changing level
+type VendorRiskRatedEvent struct {
+	VendorID      VendorID
+	PreviousLevel RiskLevel // empty the first time the vendor is rated
+	Level         RiskLevel
+	Score         float64
+	Applications  []ApplicationID
+	OccurredAt    time.Time
+}
+
+func (e VendorRiskRatedEvent) EventType() string {
+	return "VendorRiskRated"
+}
+
+func (e VendorRiskRatedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// VendorSLABreachedEvent represents an observed value missing a service level a vendor committed to
+type VendorSLABreachedEvent struct {
+	VendorID     VendorID
+	SLA          string
+	Target       float64
+	Observed     float64
+	Applications []ApplicationID
+	OccurredAt   time.Time
+}
+
+func (e VendorSLABreachedEvent) EventType() string {
+	return "VendorSLABreached"
+}
+
+func (e VendorSLABreachedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// ApplicationDataClassifiedEvent represents a change to the classification of the data an application holds
+type ApplicationDataClassifiedEvent struct {
+	ApplicationID  ApplicationID
+	Previous       DataClassification
+	Classification DataClassification
+	OccurredAt     time.Time
+}
+
+func (e ApplicationDataClassifiedEvent) EventType() string {
+	return "ApplicationDataClassified"
+}
+
+func (e ApplicationDataClassifiedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// RetentionViolationDetectedEvent represents data found held beyond its retention period and its purge scheduled
+type RetentionViolationDetectedEvent struct {
+	ApplicationID   ApplicationID
+	Category        string
+	PolicyID        string
+	Records         int
+	DaysOverdue     int
+	ChangeRequestID string // the change request scheduling the purge
+	OccurredAt      time.Time
+}
+
+func (e RetentionViolationDetectedEvent) EventType() string {
+	return "RetentionViolationDetected"
+}
+
+func (e RetentionViolationDetectedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// DPIASubmittedEvent represents a data protection impact assessment submitted to the data protection officer
+type DPIASubmittedEvent struct {
+	DPIAID        string
+	ApplicationID ApplicationID
+	Assessor      string
+	Risks         int
+	ResidualRisk  RiskLevel
+	OccurredAt    time.Time
+}
+
+func (e DPIASubmittedEvent) EventType() string {
+	return "DPIASubmitted"
+}
+
+func (e DPIASubmittedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// DPIADecidedEvent represents the data protection officer approving a data protection impact assessment or returning it for rework
+type DPIADecidedEvent struct {
+	DPIAID                    string
+	ApplicationID             ApplicationID
+	DPO                       string
+	Status                    DPIAStatus
+	Opinion                   string
+	ResidualRisk              RiskLevel
+	PriorConsultationRequired bool
+	OccurredAt                time.Time
+}
+
+func (e DPIADecidedEvent) EventType() string {
+	return "DPIADecided"
+}
+
+func (e DPIADecidedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// ChangeApprovalRecordedEvent represents an approval recorded on a change request that still needs others before it is approved
+type ChangeApprovalRecordedEvent struct {
+	ChangeRequestID string
+	Approver        string
+	Role            string
+	Remaining       int
+	MissingRoles    []string
+	AwaitedRoles    []string // roles whose approval it still awaits
+	OccurredAt      time.Time
+}
+
+func (e ChangeApprovalRecordedEvent) EventType() string {
+	return "ChangeApprovalRecorded"
+}
+
+func (e ChangeApprovalRecordedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// ChangeRequestImplementedEvent represents an approved change request implemented on its application
+type ChangeRequestImplementedEvent struct {
+	ChangeRequestID string
+	ApplicationID   ApplicationID
+	Implementer     string
+	Outcome         ImplementationOutcome
+	RolledBack      bool
+	OccurredAt      time.Time
+}
+
+func (e ChangeRequestImplementedEvent) EventType() string {
+	return "ChangeRequestImplemented"
+}
+
+func (e ChangeRequestImplementedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// IncidentSLABreachedEvent represents an incident missing the response or resolution target of
+// its governance agreement's SLA
+type IncidentSLABreachedEvent struct {
+	IncidentID    string
+	ApplicationID ApplicationID
+	AgreementID   GovernanceAgreementID
+	Severity      int
+	Target        IncidentSLATarget
+	DueAt         time.Time
+	OccurredAt    time.Time
+}
+
+func (e IncidentSLABreachedEvent) EventType() string {
+	return "IncidentSLABreached"
+}
+
+func (e IncidentSLABreachedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// ProblemLoggedEvent represents a problem logged for recurring incidents of an application
+type ProblemLoggedEvent struct {
+	ProblemID     string
+	ApplicationID ApplicationID
+	Owner         string
+	Priority      Priority
+	Incidents     []string
+	OccurredAt    time.Time
+}
+
+func (e ProblemLoggedEvent) EventType() string {
+	return "ProblemLogged"
+}
+
+func (e ProblemLoggedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// KnownErrorRecordedEvent represents the root cause of a problem being diagnosed
+type KnownErrorRecordedEvent struct {
+	ProblemID     string
+	ApplicationID ApplicationID
+	RootCause     string
+	Workaround    string
+	AnalyzedBy    string
+	OccurredAt    time.Time
+}
+
+func (e KnownErrorRecordedEvent) EventType() string {
+	return "KnownErrorRecorded"
+}
+
+func (e KnownErrorRecordedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// ProblemResolvedEvent represents a problem resolved by an implemented change request
+type ProblemResolvedEvent struct {
+	ProblemID       string
+	ApplicationID   ApplicationID
+	ChangeRequestID string
+	Resolver        string
+	OccurredAt      time.Time
+}
+
+func (e ProblemResolvedEvent) EventType() string {
+	return "ProblemResolved"
+}
+
+func (e ProblemResolvedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// PostIncidentReviewCompletedEvent represents the completion of the review of a resolved incident
+type PostIncidentReviewCompletedEvent struct {
+	IncidentID          string
+	ApplicationID       ApplicationID
+	Severity            int
+	ContributingFactors []string
+	ActionItems         int
+	CompletedBy         string
+	OccurredAt          time.Time
+}
+
+func (e PostIncidentReviewCompletedEvent) EventType() string {
+	return "PostIncidentReviewCompleted"
+}
+
+func (e PostIncidentReviewCompletedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// IncidentClosedEvent represents a resolved incident being closed
+type IncidentClosedEvent struct {
+	IncidentID    string
+	ApplicationID ApplicationID
+	Closer        string
+	ClosureCode   IncidentClosureCode
+	Reviewed      bool
+	OccurredAt    time.Time
+}
+
+func (e IncidentClosedEvent) EventType() string {
+	return "IncidentClosed"
+}
+
+func (e IncidentClosedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// IncidentAssignedEvent represents an incident being assigned or reassigned
+type IncidentAssignedEvent struct {
+	IncidentID       string
+	ApplicationID    ApplicationID
+	Assignee         string
+	PreviousAssignee string // empty when it was unassigned
+	AssignedBy       string
+	OccurredAt       time.Time
+}
+
+func (e IncidentAssignedEvent) EventType() string {
+	return "IncidentAssigned"
+}
+
+func (e IncidentAssignedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// IncidentReopenedEvent represents a resolved incident being reopened
+type IncidentReopenedEvent struct {
+	IncidentID    string
+	ApplicationID ApplicationID
+	ReopenedBy    string
+	Reason        string
+	ReopenCount   int
+	OccurredAt    time.Time
+}
+
+func (e IncidentReopenedEvent) EventType() string {
+	return "IncidentReopened"
+}
+
+func (e IncidentReopenedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// ChangeRequestClosedEvent represents an implemented change request closed after its
+// verification
+type ChangeRequestClosedEvent struct {
+	ChangeRequestID string
+	ApplicationID   ApplicationID
+	VerifiedBy      string
+	Successful      bool
+	OccurredAt      time.Time
+}
+
+func (e ChangeRequestClosedEvent) EventType() string {
+	return "ChangeRequestClosed"
+}
+
+func (e ChangeRequestClosedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// ChangeRequestWithdrawnEvent represents a change request withdrawn by its requester before it
+// was approved
+type ChangeRequestWithdrawnEvent struct {
+	ChangeRequestID string
+	ApplicationID   ApplicationID
+	Requester       string
+	PreviousStatus  ChangeRequestStatus
+	Reason          string
+	OccurredAt      time.Time
+}
+
+func (e ChangeRequestWithdrawnEvent) EventType() string {
+	return "ChangeRequestWithdrawn"
+}
+
+func (e ChangeRequestWithdrawnEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// ChangeRequestCancelledEvent represents an approved change request cancelled by one of its
+// approvers before it was implemented
+type ChangeRequestCancelledEvent struct {
+	ChangeRequestID string
+	ApplicationID   ApplicationID
+	CancelledBy     string
+	Reason          string
+	OccurredAt      time.Time
+}
+
+func (e ChangeRequestCancelledEvent) EventType() string {
+	return "ChangeRequestCancelled"
+}
+
+func (e ChangeRequestCancelledEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// ApplicationEvaluatedEvent represents an application evaluation and the risk level it found
+type ApplicationEvaluatedEvent struct {
+	ApplicationID ApplicationID
+	AssessmentID  string
+	Evaluator     string
+	RiskLevel     RiskLevel
+	TIMEQuadrant  TIMEQuadrant
+	OccurredAt    time.Time
+}
+
+func (e ApplicationEvaluatedEvent) EventType() string {
+	return "ApplicationEvaluated"
+}
+
+func (e ApplicationEvaluatedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// ChangeRequestSubmittedEvent represents a change request submitted for approval by the roles
+// the approval matrix requires
+type ChangeRequestSubmittedEvent struct {
+	ChangeRequestID string
+	ApplicationID   ApplicationID
+	Title           string
+	Priority        Priority
+	AwaitedRoles    []string
+	OccurredAt      time.Time
+}
+
+func (e ChangeRequestSubmittedEvent) EventType() string {
+	return "ChangeRequestSubmitted"
+}
+
+func (e ChangeRequestSubmittedEvent) Time() time.Time {
+	return e.OccurredAt
+}
+
+// ChangeRequestRejectedEvent represents a change request rejected by an approver
+type ChangeRequestRejectedEvent struct {
+	ChangeRequestID string
+	ApplicationID   ApplicationID
+	Approver        string
+	Role            string
+	Comments        string
+	OccurredAt      time.Time
+}
+
+func (e ChangeRequestRejectedEvent) EventType() string {
+	return "ChangeRequestRejected"
+}
+
+func (e ChangeRequestRejectedEvent) Time() time.Time {
+	return e.OccurredAt
+}
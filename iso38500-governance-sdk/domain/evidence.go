@@ -0,0 +1,146 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// EvidenceKind identifies what an evidence attachment refers to
+type EvidenceKind string
+
+const (
+	EvidenceDocument   EvidenceKind = "document"
+	EvidenceScreenshot EvidenceKind = "screenshot"
+	EvidenceReportLink EvidenceKind = "report_link"
+)
+
+// EvidenceSubjectKind identifies what evidence is attached to
+type EvidenceSubjectKind string
+
+const (
+	EvidenceSubjectAssessment   EvidenceSubjectKind = "assessment"
+	EvidenceSubjectAuditFinding EvidenceSubjectKind = "audit_finding"
+)
+
+// EvidenceSubject is the assessment or audit finding an attachment supports
+type EvidenceSubject struct {
+	Kind      EvidenceSubjectKind
+	ID        string // assessment or audit ID
+	FindingID string // audit finding ID; empty for assessments
+}
+
+// AssessmentEvidenceSubject returns the subject for evidence supporting an assessment
+func AssessmentEvidenceSubject(assessmentID string) EvidenceSubject {
+	return EvidenceSubject{Kind: EvidenceSubjectAssessment, ID: assessmentID}
+}
+
+// AuditFindingEvidenceSubject returns the subject for evidence supporting an audit finding
+func AuditFindingEvidenceSubject(auditID, findingID string) EvidenceSubject {
+	return EvidenceSubject{Kind: EvidenceSubjectAuditFinding, ID: auditID, FindingID: findingID}
+}
+
+// String names the subject, e.g. "assessment assess-1" or "audit_finding audit-1/f-2"
+func (s EvidenceSubject) String() string {
+	if s.FindingID == "" {
+		return fmt.Sprintf("%s %s", s.Kind, s.ID)
+	}
+	return fmt.Sprintf("%s %s/%s", s.Kind, s.ID, s.FindingID)
+}
+
+// Evidence is a reference to a document, screenshot or report that supports an assessment or an
+// audit finding. The content itself stays where it is kept; the SHA-256 hash recorded at
+// attachment time lets auditors confirm it has not changed since. Evidence with a validity
+// period goes stale when it ends, and the compliance statuses resting on it are reviewed again.
+type Evidence struct {
+	ID            string
+	Subject       EvidenceSubject
+	ApplicationID ApplicationID // application assessed or audited
+	Kind          EvidenceKind
+	Title         string
+	URI           string // document location or report link
+	SHA256        string // hex encoded hash of the content
+	Source        string // system or person the content was collected from
+	Description   string
+	CollectedAt   time.Time // when the content was collected, defaults to when it was attached
+	ValidUntil    time.Time // when the evidence goes stale; zero when it does not expire
+	AttachedBy    string
+	AttachedAt    time.Time
+	ExpiredAt     time.Time    // when its expiry was detected and the statuses resting on it reviewed
+	Controls      []ControlRef // framework controls the evidence shows are implemented
+}
+
+// Validate ensures the evidence has valid data. Documents and screenshots must carry a content
+// hash; report links may omit it when the report is generated on demand.
+func (e Evidence) Validate() error {
+	if e.ID == "" {
+		return errors.New("evidence ID cannot be empty")
+	}
+	if e.URI == "" {
+		return errors.New("evidence URI cannot be empty")
+	}
+	if e.AttachedBy == "" {
+		return errors.New("evidence must record who attached it")
+	}
+	switch e.Subject.Kind {
+	case EvidenceSubjectAssessment:
+		if e.Subject.ID == "" {
+			return errors.New("evidence must reference an assessment")
+		}
+	case EvidenceSubjectAuditFinding:
+		if e.Subject.ID == "" || e.Subject.FindingID == "" {
+			return errors.New("evidence must reference an audit and a finding")
+		}
+	default:
+		return fmt.Errorf("unknown evidence subject %q", e.Subject.Kind)
+	}
+	switch e.Kind {
+	case EvidenceDocument, EvidenceScreenshot:
+		if e.SHA256 == "" {
+			return fmt.Errorf("%s evidence must carry a SHA-256 hash", e.Kind)
+		}
+	case EvidenceReportLink:
+	default:
+		return fmt.Errorf("unknown evidence kind %q", e.Kind)
+	}
+	if e.SHA256 != "" && !isSHA256(e.SHA256) {
+		return errors.New("evidence hash must be a hex encoded SHA-256 digest")
+	}
+	if !e.ValidUntil.IsZero() && !e.CollectedAt.IsZero() && !e.ValidUntil.After(e.CollectedAt) {
+		return errors.New("evidence must be valid until after it was collected")
+	}
+	return nil
+}
+
+// Expired reports whether the evidence is past its validity period at now
+func (e Evidence) Expired(now time.Time) bool {
+	return !e.ValidUntil.IsZero() && !now.Before(e.ValidUntil)
+}
+
+// Verify reports whether content matches the hash recorded when the evidence was attached
+func (e Evidence) Verify(content []byte) error {
+	if e.SHA256 == "" {
+		return fmt.Errorf("evidence %s has no recorded hash", e.ID)
+	}
+	if HashEvidence(content) != strings.ToLower(e.SHA256) {
+		return fmt.Errorf("evidence %s content does not match its recorded hash", e.ID)
+	}
+	return nil
+}
+
+// HashEvidence returns the hex encoded SHA-256 hash to record for evidence content
+func HashEvidence(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+func isSHA256(value string) bool {
+	if len(value) != sha256.Size*2 {
+		return false
+	}
+	_, err := hex.DecodeString(value)
+	return err == nil
+}
@@ -0,0 +1,64 @@
+package domain
+
+// EvidenceBacked reports whether a compliance status rests on evidence: compliant and partial
+// statuses do, while non-compliant and under review ones stand without it
+func EvidenceBacked(status ComplianceStatus) bool {
+	return status == ComplianceCompliant || status == CompliancePartial
+}
+
+// ReviewStaleControls puts under review the requirements of the conformance component that are the
+// controls, or controls mapped to them, and whose status rests on evidence. It is applied when
+// the evidence showing the controls are implemented goes stale, and returns the component with
+// the requirements put under review. Non-compliant requirements keep their status.
+func ReviewStaleControls(conformance Conformance, frameworks []ComplianceFramework, mappings []ControlMapping, controls []ControlRef) (Conformance, []RequirementRef) {
+	byID := make(map[string]ComplianceFramework, len(frameworks))
+	for _, framework := range frameworks {
+		byID[framework.ID] = framework
+	}
+
+	var reviewed []RequirementRef
+	seen := make(map[RequirementRef]bool)
+	for _, control := range controls {
+		for _, mapped := range MappedControls(mappings, control) {
+			framework, ok := byID[mapped.FrameworkID]
+			if !ok {
+				continue
+			}
+			ref := RequirementRef{Kind: framework.Kind, Name: framework.RequirementName(FrameworkRequirement{ID: mapped.RequirementID})}
+			if seen[ref] {
+				continue
+			}
+			seen[ref] = true
+			if status, found := complianceStatusOf(conformance, ref); found && EvidenceBacked(status) {
+				setComplianceStatus(&conformance, ref, ComplianceUnderReview)
+				reviewed = append(reviewed, ref)
+			}
+		}
+	}
+	return conformance, reviewed
+}
+
+// complianceStatusOf returns the status of the requirement the conformance component names
+func complianceStatusOf(conformance Conformance, ref RequirementRef) (ComplianceStatus, bool) {
+	switch ref.Kind {
+	case RequirementLegal:
+		for _, requirement := range conformance.LegalRequirements {
+			if requirement.Name == ref.Name {
+				return requirement.Status, true
+			}
+		}
+	case RequirementContractual:
+		for _, requirement := range conformance.ContractualRequirements {
+			if requirement.Name == ref.Name {
+				return requirement.Status, true
+			}
+		}
+	case RequirementIndustryStandard:
+		for _, requirement := range conformance.IndustryStandards {
+			if requirement.Name == ref.Name {
+				return requirement.Status, true
+			}
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,487 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// DigestFrequency is how often an executive digest of a portfolio is generated, and the period
+// each digest covers
+type DigestFrequency string
+
+const (
+	DigestWeekly  DigestFrequency = "weekly"
+	DigestMonthly DigestFrequency = "monthly"
+)
+
+// Validate ensures the frequency is known
+func (f DigestFrequency) Validate() error {
+	switch f {
+	case DigestWeekly, DigestMonthly:
+		return nil
+	}
+	return fmt.Errorf("unknown digest frequency %q; use %s or %s", f, DigestWeekly, DigestMonthly)
+}
+
+// PeriodStart returns the start of the period of the frequency ending at end: a week or a
+// calendar month earlier
+func (f DigestFrequency) PeriodStart(end time.Time) time.Time {
+	if f == DigestMonthly {
+		return end.AddDate(0, -1, 0)
+	}
+	return end.AddDate(0, 0, -7)
+}
+
+// DigestDue reports whether a digest of the frequency is due at now when the last one was
+// generated at last, zero when none was
+func DigestDue(frequency DigestFrequency, last, now time.Time) bool {
+	return last.IsZero() || !last.After(frequency.PeriodStart(now))
+}
+
+// ExecutiveDigest summarizes how the governance of a portfolio moved over a week or a month: the
+// movement of its KPIs, new and escalated risks, compliance changes, how its changes fared and the
+// recommendations still open, summarized for executives in Summary
+type ExecutiveDigest struct {
+	ID                  string
+	PortfolioID         PortfolioID
+	PortfolioName       string
+	Frequency           DigestFrequency
+	From                time.Time
+	To                  time.Time
+	Summary             ExecutiveSummary
+	KPIMovements        []DigestKPIMovement
+	RiskChanges         []DigestRiskChange
+	ComplianceChanges   []DigestComplianceChange
+	Changes             *ChangeMetrics   // change requests of the portfolio over the period; nil when changes are not tracked
+	Escalations         []EscalationStep // taken during the period
+	OpenRecommendations []DigestRecommendation
+	Recipients          []string
+	GeneratedAt         time.Time
+	SentAt              time.Time // zero when the digest was not sent
+}
+
+// DigestKPIMovement is how a KPI of an application moved over the period, from the last
+// monitoring before it to the last monitoring in it
+type DigestKPIMovement struct {
+	ApplicationID ApplicationID
+	KPIID         string
+	Previous      float64
+	Current       float64
+	Target        float64
+	WasAchieved   bool
+	Achieved      bool
+	Direction     TrendDirection // insufficient_data when the KPI was not measured before
+}
+
+// DigestRiskChange is a risk indicator of an application that changed status over the period,
+// or appeared above its threshold
+type DigestRiskChange struct {
+	ApplicationID ApplicationID
+	Indicator     RiskIndicator
+	Previous      RiskStatus // empty when the indicator is new
+}
+
+// Escalated reports whether the indicator is new above its threshold or more severe than before
+func (c DigestRiskChange) Escalated() bool {
+	return riskStatusRank(c.Indicator.Status) > riskStatusRank(c.Previous)
+}
+
+// DigestComplianceChange is how the compliance of an application changed over the period
+type DigestComplianceChange struct {
+	ApplicationID         ApplicationID
+	Violations            int // requirements that went from compliant to non-compliant
+	RequirementGapsBefore int
+	RequirementGapsAfter  int
+}
+
+// DigestRecommendation is a recommendation of an application's latest assessment
+type DigestRecommendation struct {
+	ApplicationID   ApplicationID
+	ApplicationName string
+	Recommendation  Recommendation
+}
+
+// DigestApplication is what monitoring, escalation and assessment recorded of one application of
+// a portfolio, from which its digest is built
+type DigestApplication struct {
+	Application      Application
+	Baseline         *MonitoringSnapshot    // latest snapshot before the period, nil when none
+	Snapshots        []MonitoringSnapshot   // taken during the period, oldest first
+	Escalations      []EscalationStep       // taken during the period
+	LatestAssessment *ApplicationAssessment // nil when never assessed
+	Changes          []ChangeRequest        // all of the application's; nil when changes are not tracked
+	Incidents        []Incident             // all of the application's, to attribute to its changes
+}
+
+// BuildExecutiveDigest builds the digest of a portfolio for the period from..to. KPIs and risk
+// indicators are compared between each application's last snapshot before the period, or its
+// first in the period when there is none, and its last in the period; applications not
+// monitored during the period contribute only their escalations and recommendations.
+// Recommendations to maintain an application are not open work and are left out. The change
+// metrics of the portfolio cover the changes of the applications whose changes are tracked, and
+// trend against the period before.
+func BuildExecutiveDigest(portfolio ApplicationPortfolio, frequency DigestFrequency, from, to time.Time, apps []DigestApplication) ExecutiveDigest {
+	digest := ExecutiveDigest{
+		ID:            fmt.Sprintf("%s-%s-%s", portfolio.ID, frequency, to.UTC().Format("20060102T150405Z")),
+		PortfolioID:   portfolio.ID,
+		PortfolioName: portfolio.Name,
+		Frequency:     frequency,
+		From:          from,
+		To:            to,
+		GeneratedAt:   to,
+	}
+	if portfolio.Owner != "" {
+		digest.Recipients = []string{portfolio.Owner}
+	}
+
+	names := make(map[ApplicationID]string, len(apps))
+	risks := digestRiskLevel{worst: RiskStatusNormal}
+	changes := digestChanges{}
+	for _, app := range apps {
+		names[app.Application.ID] = app.Application.Name
+		changes.add(app, from, to)
+		digest.Escalations = append(digest.Escalations, app.Escalations...)
+		if app.LatestAssessment != nil {
+			for _, recommendation := range app.LatestAssessment.Recommendations {
+				if recommendation.Type == RecMaintain {
+					continue
+				}
+				digest.OpenRecommendations = append(digest.OpenRecommendations, DigestRecommendation{
+					ApplicationID:   app.Application.ID,
+					ApplicationName: app.Application.Name,
+					Recommendation:  recommendation,
+				})
+			}
+		}
+
+		if len(app.Snapshots) == 0 {
+			continue
+		}
+		baseline := app.Snapshots[0]
+		if app.Baseline != nil {
+			baseline = *app.Baseline
+		}
+		current := app.Snapshots[len(app.Snapshots)-1]
+
+		digest.KPIMovements = append(digest.KPIMovements, kpiMovements(app.Application.ID, baseline, current)...)
+
+		digest.RiskChanges = append(digest.RiskChanges, riskChanges(app.Application.ID, baseline, current)...)
+		risks.add(baseline, current)
+
+		change := DigestComplianceChange{
+			ApplicationID:         app.Application.ID,
+			RequirementGapsBefore: baseline.RequirementGaps,
+			RequirementGapsAfter:  current.RequirementGaps,
+		}
+		for _, snapshot := range app.Snapshots {
+			change.Violations += snapshot.ComplianceViolations
+		}
+		if change.Violations > 0 || change.RequirementGapsBefore != change.RequirementGapsAfter {
+			digest.ComplianceChanges = append(digest.ComplianceChanges, change)
+		}
+	}
+
+	sort.SliceStable(digest.OpenRecommendations, func(i, j int) bool {
+		return digest.OpenRecommendations[i].Recommendation.Priority.Weight() > digest.OpenRecommendations[j].Recommendation.Priority.Weight()
+	})
+	sort.SliceStable(digest.Escalations, func(i, j int) bool {
+		return digest.Escalations[i].EscalatedAt.Before(digest.Escalations[j].EscalatedAt)
+	})
+
+	if changes.tracked {
+		current := changes.portfolio(from, to)
+		current.PortfolioID = portfolio.ID
+		digest.Changes = &current
+	}
+	digest.Summary = digest.summarize(names, risks, changes)
+	return digest
+}
+
+// kpiMovements compares the KPIs of the current snapshot with their value in the baseline
+func kpiMovements(appID ApplicationID, baseline, current MonitoringSnapshot) []DigestKPIMovement {
+	previous := make(map[string]KPIMeasurement, len(baseline.KPIs))
+	for _, kpi := range baseline.KPIs {
+		previous[kpi.KPIID] = kpi
+	}
+
+	movements := make([]DigestKPIMovement, 0, len(current.KPIs))
+	for _, kpi := range current.KPIs {
+		movement := DigestKPIMovement{
+			ApplicationID: appID,
+			KPIID:         kpi.KPIID,
+			Previous:      kpi.Value,
+			Current:       kpi.Value,
+			Target:        kpi.Target,
+			WasAchieved:   kpi.Achieved,
+			Achieved:      kpi.Achieved,
+			Direction:     TrendInsufficientData,
+		}
+		if before, ok := previous[kpi.KPIID]; ok {
+			movement.Previous = before.Value
+			movement.WasAchieved = before.Achieved
+			movement.Direction = kpiDirection(before, kpi)
+		}
+		movements = append(movements, movement)
+	}
+	return movements
+}
+
+// kpiDirection tells whether a KPI moved towards its target between two measurements. Changes
+// smaller than 5% of the target, or of the value without one, are stable.
+func kpiDirection(before, after KPIMeasurement) TrendDirection {
+	change := after.Value - before.Value
+	scale := math.Abs(after.Target)
+	if scale == 0 {
+		scale = math.Max(math.Abs(before.Value), math.Abs(after.Value))
+	}
+	if scale == 0 || math.Abs(change) < 0.05*scale {
+		return TrendStable
+	}
+	if (change > 0) != lowerIsBetter([]KPIMeasurement{before, after}) {
+		return TrendImproving
+	}
+	return TrendDegrading
+}
+
+// riskChanges returns the indicators of the current snapshot whose status changed since the
+// baseline, or that appeared above their threshold
+func riskChanges(appID ApplicationID, baseline, current MonitoringSnapshot) []DigestRiskChange {
+	previous := make(map[string]RiskStatus, len(baseline.RiskIndicators))
+	for _, indicator := range baseline.RiskIndicators {
+		previous[indicator.Name] = indicator.Status
+	}
+
+	var changes []DigestRiskChange
+	for _, indicator := range current.RiskIndicators {
+		status, known := previous[indicator.Name]
+		if (known && status != indicator.Status) || (!known && aboveThreshold(indicator)) {
+			changes = append(changes, DigestRiskChange{ApplicationID: appID, Indicator: indicator, Previous: status})
+		}
+	}
+	return changes
+}
+
+// digestRiskLevel counts the risk indicators above their threshold across a portfolio before and
+// after a period, and the worst status after it
+type digestRiskLevel struct {
+	before int
+	after  int
+	worst  RiskStatus
+}
+
+func (l *digestRiskLevel) add(baseline, current MonitoringSnapshot) {
+	for _, indicator := range baseline.RiskIndicators {
+		if aboveThreshold(indicator) {
+			l.before++
+		}
+	}
+	for _, indicator := range current.RiskIndicators {
+		if aboveThreshold(indicator) {
+			l.after++
+		}
+		if riskStatusRank(indicator.Status) > riskStatusRank(l.worst) {
+			l.worst = indicator.Status
+		}
+	}
+}
+
+// aboveThreshold reports whether the indicator is at warning or critical
+func aboveThreshold(indicator RiskIndicator) bool {
+	return riskStatusRank(indicator.Status) >= riskStatusRank(RiskStatusWarning)
+}
+
+// riskStatusRank orders risk statuses from normal to critical, with unknown statuses first
+func riskStatusRank(status RiskStatus) int {
+	switch status {
+	case RiskStatusCritical:
+		return 3
+	case RiskStatusWarning:
+		return 2
+	case RiskStatusNormal:
+		return 1
+	}
+	return 0
+}
+
+// digestChanges gathers the change requests and incidents of the applications of a portfolio
+// whose changes are tracked, and the change metrics of each over a period
+type digestChanges struct {
+	tracked      bool
+	changes      []ChangeRequest
+	incidents    []Incident
+	applications []ChangeMetrics
+}
+
+func (c *digestChanges) add(app DigestApplication, from, to time.Time) {
+	if app.Changes == nil {
+		return
+	}
+	c.tracked = true
+	c.changes = append(c.changes, app.Changes...)
+	c.incidents = append(c.incidents, app.Incidents...)
+
+	metrics := BuildChangeMetrics(app.Changes, app.Incidents, DefaultChangeCorrelationWindow, from, to, to.Sub(from))
+	metrics.ApplicationID = app.Application.ID
+	c.applications = append(c.applications, metrics)
+}
+
+// portfolio summarizes the changes of all applications between from and to
+func (c digestChanges) portfolio(from, to time.Time) ChangeMetrics {
+	return BuildChangeMetrics(c.changes, c.incidents, DefaultChangeCorrelationWindow, from, to, to.Sub(from))
+}
+
+// summarize writes the executive summary of the digest. Applications are named by name, or by ID
+// when they have none.
+func (d ExecutiveDigest) summarize(names map[ApplicationID]string, risks digestRiskLevel, changes digestChanges) ExecutiveSummary {
+	name := func(id ApplicationID) string {
+		if names[id] != "" {
+			return names[id]
+		}
+		return string(id)
+	}
+
+	summary := ExecutiveSummary{
+		Period:          fmt.Sprintf("%s to %s", d.From.Format("2006-01-02"), d.To.Format("2006-01-02")),
+		KeyMetrics:      []KeyMetric{},
+		Achievements:    []string{},
+		Challenges:      []string{},
+		Recommendations: []string{},
+	}
+
+	achieved, wasAchieved, compared := 0, 0, 0
+	for _, movement := range d.KPIMovements {
+		if movement.Achieved {
+			achieved++
+		}
+		if movement.Direction != TrendInsufficientData {
+			compared++
+			if movement.WasAchieved {
+				wasAchieved++
+			}
+		}
+
+		switch {
+		case movement.Achieved && !movement.WasAchieved:
+			summary.Achievements = append(summary.Achievements, fmt.Sprintf("%s: KPI %s now meets its target (%g against %g)", name(movement.ApplicationID), movement.KPIID, movement.Current, movement.Target))
+		case !movement.Achieved && movement.WasAchieved && movement.Direction != TrendInsufficientData:
+			summary.Challenges = append(summary.Challenges, fmt.Sprintf("%s: KPI %s fell below its target (%g against %g)", name(movement.ApplicationID), movement.KPIID, movement.Current, movement.Target))
+		case !movement.Achieved && movement.Direction == TrendDegrading:
+			summary.Challenges = append(summary.Challenges, fmt.Sprintf("%s: KPI %s moved further from its target (%g to %g against %g)", name(movement.ApplicationID), movement.KPIID, movement.Previous, movement.Current, movement.Target))
+		}
+	}
+	if len(d.KPIMovements) > 0 {
+		attainment := KeyMetric{
+			Name:   "KPI attainment",
+			Value:  math.Round(float64(achieved)/float64(len(d.KPIMovements))*1000) / 10,
+			Unit:   "%",
+			Trend:  string(TrendInsufficientData),
+			Status: string(RiskStatusNormal),
+		}
+		if compared > 0 {
+			attainment.Trend = string(countTrend(wasAchieved, achieved, true))
+		}
+		// Below four in five KPIs on target needs attention, below half is critical
+		switch {
+		case attainment.Value < 50:
+			attainment.Status = string(RiskStatusCritical)
+		case attainment.Value < 80:
+			attainment.Status = string(RiskStatusWarning)
+		}
+		summary.KeyMetrics = append(summary.KeyMetrics, attainment)
+	}
+
+	for _, change := range d.RiskChanges {
+		indicator := change.Indicator
+		switch {
+		case change.Previous == "":
+			summary.Challenges = append(summary.Challenges, fmt.Sprintf("%s: new %s risk indicator %s (%g against threshold %g)", name(change.ApplicationID), indicator.Status, indicator.Name, indicator.Value, indicator.Threshold))
+		case change.Escalated():
+			summary.Challenges = append(summary.Challenges, fmt.Sprintf("%s: risk indicator %s escalated from %s to %s (%g against threshold %g)", name(change.ApplicationID), indicator.Name, change.Previous, indicator.Status, indicator.Value, indicator.Threshold))
+		default:
+			summary.Achievements = append(summary.Achievements, fmt.Sprintf("%s: risk indicator %s eased from %s to %s", name(change.ApplicationID), indicator.Name, change.Previous, indicator.Status))
+		}
+	}
+	summary.KeyMetrics = append(summary.KeyMetrics, KeyMetric{
+		Name:   "Risk indicators above threshold",
+		Value:  float64(risks.after),
+		Unit:   "indicators",
+		Trend:  string(countTrend(risks.before, risks.after, false)),
+		Status: string(risks.worst),
+	})
+
+	violations := 0
+	for _, change := range d.ComplianceChanges {
+		violations += change.Violations
+		if change.Violations > 0 {
+			summary.Challenges = append(summary.Challenges, fmt.Sprintf("%s: %d compliance violations detected", name(change.ApplicationID), change.Violations))
+		}
+		switch {
+		case change.RequirementGapsAfter < change.RequirementGapsBefore:
+			summary.Achievements = append(summary.Achievements, fmt.Sprintf("%s: requirement gaps closed, from %d to %d", name(change.ApplicationID), change.RequirementGapsBefore, change.RequirementGapsAfter))
+		case change.RequirementGapsAfter > change.RequirementGapsBefore:
+			summary.Challenges = append(summary.Challenges, fmt.Sprintf("%s: requirement gaps grew from %d to %d", name(change.ApplicationID), change.RequirementGapsBefore, change.RequirementGapsAfter))
+		}
+	}
+	summary.KeyMetrics = append(summary.KeyMetrics, countMetric("Compliance violations", violations, "violations"))
+
+	if d.Changes != nil {
+		previous := changes.portfolio(d.From.Add(-d.To.Sub(d.From)), d.From)
+		summary.KeyMetrics = append(summary.KeyMetrics, d.Changes.KeyMetrics(&previous)...)
+		for _, metrics := range changes.applications {
+			if metrics.SuccessRateStatus() != RiskStatusNormal {
+				summary.Challenges = append(summary.Challenges, fmt.Sprintf("%s: %d of %d changes failed (%.1f%% success)", name(metrics.ApplicationID), metrics.Failed, metrics.Implemented, metrics.SuccessRate))
+			}
+			if metrics.EmergencyRatioStatus() != RiskStatusNormal {
+				summary.Challenges = append(summary.Challenges, fmt.Sprintf("%s: %d of %d changes were emergency changes", name(metrics.ApplicationID), metrics.Emergency, metrics.Implemented))
+			}
+		}
+		if d.Changes.Implemented > 0 && d.Changes.Failed == 0 {
+			summary.Achievements = append(summary.Achievements, fmt.Sprintf("All %d changes implemented succeeded", d.Changes.Implemented))
+		}
+	}
+
+	if len(d.Escalations) > 0 {
+		summary.Challenges = append(summary.Challenges, fmt.Sprintf("%d escalations of alerts, incidents or change requests left waiting", len(d.Escalations)))
+	}
+	summary.KeyMetrics = append(summary.KeyMetrics, countMetric("Escalations", len(d.Escalations), "steps"))
+
+	for _, open := range d.OpenRecommendations {
+		recommendation := open.Recommendation
+		text := fmt.Sprintf("%s: %s", name(open.ApplicationID), recommendation.Description)
+		if recommendation.Priority != "" {
+			text += fmt.Sprintf(" (%s priority)", recommendation.Priority)
+		}
+		summary.Recommendations = append(summary.Recommendations, text)
+	}
+	summary.KeyMetrics = append(summary.KeyMetrics, KeyMetric{
+		Name:   "Open recommendations",
+		Value:  float64(len(d.OpenRecommendations)),
+		Unit:   "recommendations",
+		Trend:  string(TrendInsufficientData),
+		Status: string(RiskStatusNormal),
+	})
+
+	return summary
+}
+
+// countTrend tells whether a count moved in the better direction
+func countTrend(before, after int, higherIsBetter bool) TrendDirection {
+	switch {
+	case before == after:
+		return TrendStable
+	case (after > before) == higherIsBetter:
+		return TrendImproving
+	}
+	return TrendDegrading
+}
+
+// countMetric is a key metric counting problems of the period, at warning when there are any
+func countMetric(name string, count int, unit string) KeyMetric {
+	metric := KeyMetric{Name: name, Value: float64(count), Unit: unit, Trend: string(TrendInsufficientData), Status: string(RiskStatusNormal)}
+	if count > 0 {
+		metric.Status = string(RiskStatusWarning)
+	}
+	return metric
+}
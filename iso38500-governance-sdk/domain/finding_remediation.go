@@ -0,0 +1,175 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// Severities of audit findings, from the most to the least severe
+const (
+	FindingSeverityCritical = "critical"
+	FindingSeverityHigh     = "high"
+	FindingSeverityMedium   = "medium"
+	FindingSeverityLow      = "low"
+)
+
+// RemediationAction is an action taken to remediate an audit finding, or the factors that
+// contributed to an incident
+type RemediationAction struct {
+	ID          string
+	Description string
+	Owner       string
+	DueDate     time.Time
+	Status      ActionStatus
+	CompletedAt time.Time // zero until the action is completed
+}
+
+// Overdue reports whether the action is past its due date at now without being completed or
+// cancelled
+func (a RemediationAction) Overdue(now time.Time) bool {
+	if a.DueDate.IsZero() || !now.After(a.DueDate) {
+		return false
+	}
+	return a.Status == ActionPending || a.Status == ActionInProgress
+}
+
+// Remediated reports whether the finding has been remediated: it has remediation actions, at least
+// one of them completed and none still pending or in progress
+func (f AuditFinding) Remediated() bool {
+	completed := false
+	for _, action := range f.Actions {
+		switch action.Status {
+		case ActionCompleted:
+			completed = true
+		case ActionPending, ActionInProgress:
+			return false
+		}
+	}
+	return completed
+}
+
+// AuditClosurePolicy configures which findings must be remediated before an audit can be closed
+type AuditClosurePolicy struct {
+	BlockingSeverities []string // none lets audits close with findings open
+}
+
+// DefaultAuditClosurePolicy keeps audits open while a critical finding is not remediated
+func DefaultAuditClosurePolicy() AuditClosurePolicy {
+	return AuditClosurePolicy{BlockingSeverities: []string{FindingSeverityCritical}}
+}
+
+// BlockingFindings returns the findings of the audit that keep it from being closed
+func (p AuditClosurePolicy) BlockingFindings(audit Audit) []AuditFinding {
+	var blocking []AuditFinding
+	for _, finding := range audit.Findings {
+		if finding.Remediated() {
+			continue
+		}
+		for _, severity := range p.BlockingSeverities {
+			if strings.EqualFold(finding.Severity, severity) {
+				blocking = append(blocking, finding)
+				break
+			}
+		}
+	}
+	return blocking
+}
+
+// FindingSeverityCount is the number of findings of one severity not remediated yet
+type FindingSeverityCount struct {
+	Severity string
+	Open     int
+	Overdue  int // open findings with an overdue remediation action
+}
+
+// FindingBacklog rolls up the remediation of the findings of an application's audits
+type FindingBacklog struct {
+	ApplicationID  ApplicationID
+	Findings       int
+	Open           int
+	Remediated     int
+	Unplanned      int // open findings without a remediation action
+	OverdueActions int
+	Severities     []FindingSeverityCount // most severe first
+	AsOf           time.Time
+}
+
+// OpenBySeverity returns the number of open findings of a severity
+func (b FindingBacklog) OpenBySeverity(severity string) int {
+	for _, count := range b.Severities {
+		if strings.EqualFold(count.Severity, severity) {
+			return count.Open
+		}
+	}
+	return 0
+}
+
+// BuildFindingBacklog counts the findings of an application's audits, other than cancelled ones,
+// by whether they are remediated, and the open ones by severity
+func BuildFindingBacklog(appID ApplicationID, audits []Audit, now time.Time) FindingBacklog {
+	backlog := FindingBacklog{ApplicationID: appID, AsOf: now}
+	counts := make(map[string]*FindingSeverityCount)
+
+	for _, audit := range audits {
+		if audit.ApplicationID != appID || audit.Status == AuditStatusCancelled {
+			continue
+		}
+		for _, finding := range audit.Findings {
+			backlog.Findings++
+			if finding.Remediated() {
+				backlog.Remediated++
+				continue
+			}
+
+			backlog.Open++
+			severity := strings.ToLower(finding.Severity)
+			count, ok := counts[severity]
+			if !ok {
+				count = &FindingSeverityCount{Severity: severity}
+				counts[severity] = count
+			}
+			count.Open++
+			if len(finding.Actions) == 0 {
+				backlog.Unplanned++
+			}
+			overdue := false
+			for _, action := range finding.Actions {
+				if action.Overdue(now) {
+					backlog.OverdueActions++
+					overdue = true
+				}
+			}
+			if overdue {
+				count.Overdue++
+			}
+		}
+	}
+
+	for _, count := range counts {
+		backlog.Severities = append(backlog.Severities, *count)
+	}
+	sort.Slice(backlog.Severities, func(i, j int) bool {
+		ri, rj := findingSeverityRank(backlog.Severities[i].Severity), findingSeverityRank(backlog.Severities[j].Severity)
+		if ri != rj {
+			return ri < rj
+		}
+		return backlog.Severities[i].Severity < backlog.Severities[j].Severity
+	})
+	return backlog
+}
+
+// findingSeverityRank orders severities from the most severe; unknown ones come last
+func findingSeverityRank(severity string) int {
+	switch severity {
+	case FindingSeverityCritical:
+		return 0
+	case FindingSeverityHigh:
+		return 1
+	case FindingSeverityMedium:
+		return 2
+	case FindingSeverityLow:
+		return 3
+	}
+	return 4
+}
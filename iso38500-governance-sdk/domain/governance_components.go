@@ -31,6 +31,7 @@ type ICTOperationsManual struct {
 	InfrastructureConfig    string
 	OperatingSystem        string
 	ProgrammingLanguage    string
+	TechnologyComponents   []TechnologyComponent // operating systems, languages and frameworks with their end of support
 	RightsAndRoles         []RolePermission
 	SecurityProvisions     SecurityProvisions
 	LastUpdated           time.Time
@@ -155,6 +156,7 @@ type IncidentClass struct {
 	Name        string
 	Description string
 	ResponseTime time.Duration
+	Keywords    []string // impact keywords classifying an incident into it, e.g. "outage"
 }
 
 // IncidentPriority represents incident prioritization
@@ -188,6 +190,7 @@ type LegalRequirement struct {
 	Authority   string
 	EffectiveDate time.Time
 	Status      ComplianceStatus
+	Criticality Priority // weight in compliance scores; unset counts as medium
 }
 
 // ContractualRequirement represents a contractual requirement
@@ -197,6 +200,8 @@ type ContractualRequirement struct {
 	ContractID  string
 	Party       string
 	Status      ComplianceStatus
+	Criticality Priority  // weight in compliance scores; unset counts as medium
+	ExpiresAt   time.Time // when the contract ends, zero when it does not
 }
 
 // IndustryStandard represents an industry standard requirement
@@ -206,6 +211,8 @@ type IndustryStandard struct {
 	Organization string
 	Version     string
 	Status      ComplianceStatus
+	Criticality Priority  // weight in compliance scores; unset counts as medium
+	ExpiresAt   time.Time // when the certification lapses, zero when it does not
 }
 
 // ComplianceStatus represents the compliance status
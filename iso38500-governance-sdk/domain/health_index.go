@@ -0,0 +1,161 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+// PortfolioHealthWeights set how much each component contributes to the portfolio health index.
+// Weights are relative; they do not need to add up to one.
+type PortfolioHealthWeights struct {
+	Risk            float64
+	Lifecycle       float64 // share of active rather than deprecated applications
+	TechnicalHealth float64
+	KPIAttainment   float64
+}
+
+// DefaultPortfolioHealthWeights weights risk and technical health above lifecycle and KPI attainment
+func DefaultPortfolioHealthWeights() PortfolioHealthWeights {
+	return PortfolioHealthWeights{
+		Risk:            0.3,
+		Lifecycle:       0.2,
+		TechnicalHealth: 0.3,
+		KPIAttainment:   0.2,
+	}
+}
+
+// IsZero reports whether no weights have been set
+func (w PortfolioHealthWeights) IsZero() bool {
+	return w == PortfolioHealthWeights{}
+}
+
+// Validate ensures no weight is negative
+func (w PortfolioHealthWeights) Validate() error {
+	if w.Risk < 0 || w.Lifecycle < 0 || w.TechnicalHealth < 0 || w.KPIAttainment < 0 {
+		return errors.New("portfolio health weights must not be negative")
+	}
+	return nil
+}
+
+// Portfolio health index components
+const (
+	HealthComponentRisk            = "risk"
+	HealthComponentLifecycle       = "lifecycle"
+	HealthComponentTechnicalHealth = "technical_health"
+	HealthComponentKPIAttainment   = "kpi_attainment"
+)
+
+// PortfolioHealthComponent is one component of the portfolio health index
+type PortfolioHealthComponent struct {
+	Name         string
+	Score        float64 // 0-100
+	Weight       float64 // normalized share of the index; zero when the component is unavailable
+	Contribution float64 // Score * Weight
+	Available    bool    // false when the portfolio has no data for the component
+	Detail       string
+}
+
+// PortfolioHealthIndex is a single 0-100 portfolio health score with its breakdown
+type PortfolioHealthIndex struct {
+	Score      float64
+	Components []PortfolioHealthComponent
+}
+
+// KPIAttainment counts the KPIs meeting their target
+type KPIAttainment struct {
+	Achieved int
+	Total    int
+}
+
+// CalculatePortfolioHealthIndex combines the assessed applications' risk levels and technical
+// health, the share of active versus deprecated applications and KPI attainment into a 0-100
+// index. Components without data are left out and the remaining weights are rescaled. Zero
+// weights fall back to DefaultPortfolioHealthWeights.
+func CalculatePortfolioHealthIndex(assessments []ApplicationAssessment, activeApps, deprecatedApps int, kpis KPIAttainment, weights PortfolioHealthWeights) PortfolioHealthIndex {
+	if weights.IsZero() {
+		weights = DefaultPortfolioHealthWeights()
+	}
+
+	risk := PortfolioHealthComponent{Name: HealthComponentRisk, Detail: "no assessed applications"}
+	technical := PortfolioHealthComponent{Name: HealthComponentTechnicalHealth, Detail: "no assessed applications"}
+	if len(assessments) > 0 {
+		riskTotal, technicalTotal := 0.0, 0.0
+		for _, assessment := range assessments {
+			// Low risk scores 100, critical risk 0
+			riskTotal += (4 - riskScore(assessment.RiskLevel)) / 3 * 100
+			technicalTotal += (technicalHealthScore(assessment) - 1) / 4 * 100
+		}
+		risk.Available, risk.Score = true, riskTotal/float64(len(assessments))
+		risk.Detail = fmt.Sprintf("average risk of %d applications", len(assessments))
+		technical.Available, technical.Score = true, technicalTotal/float64(len(assessments))
+		technical.Detail = fmt.Sprintf("average technical health of %d applications", len(assessments))
+	}
+
+	lifecycle := PortfolioHealthComponent{Name: HealthComponentLifecycle, Detail: "no active or deprecated applications"}
+	if activeApps+deprecatedApps > 0 {
+		lifecycle.Available = true
+		lifecycle.Score = float64(activeApps) / float64(activeApps+deprecatedApps) * 100
+		lifecycle.Detail = fmt.Sprintf("%d active, %d deprecated", activeApps, deprecatedApps)
+	}
+
+	attainment := PortfolioHealthComponent{Name: HealthComponentKPIAttainment, Detail: "no measured KPIs"}
+	if kpis.Total > 0 {
+		attainment.Available = true
+		attainment.Score = float64(kpis.Achieved) / float64(kpis.Total) * 100
+		attainment.Detail = fmt.Sprintf("%d of %d KPIs on target", kpis.Achieved, kpis.Total)
+	}
+
+	components := []PortfolioHealthComponent{risk, lifecycle, technical, attainment}
+	raw := []float64{weights.Risk, weights.Lifecycle, weights.TechnicalHealth, weights.KPIAttainment}
+
+	totalWeight := 0.0
+	for i, component := range components {
+		if component.Available {
+			totalWeight += raw[i]
+		}
+	}
+
+	index := PortfolioHealthIndex{}
+	for i, component := range components {
+		if component.Available && totalWeight > 0 {
+			component.Weight = raw[i] / totalWeight
+			component.Contribution = component.Score * component.Weight
+			index.Score += component.Contribution
+		}
+		index.Components = append(index.Components, component)
+	}
+	return index
+}
+
+// kpiAttainment counts the latest measurement of each KPI measured in the agreements, and the
+// status of each portfolio KPI not measured there. KPIs that are not measured are not counted,
+// and measurements within tolerance percent of their target count as achieved.
+func kpiAttainment(portfolioKPIs []KPI, measurements []KPIMeasurement, tolerance float64) KPIAttainment {
+	latest := make(map[string]KPIMeasurement)
+	for _, measurement := range measurements {
+		if current, ok := latest[measurement.KPIID]; !ok || measurement.MeasuredAt.After(current.MeasuredAt) {
+			latest[measurement.KPIID] = measurement
+		}
+	}
+
+	attainment := KPIAttainment{}
+	for _, measurement := range latest {
+		attainment.Total++
+		if measurement.Achieved || withinKPITolerance(measurement.Value, measurement.Target, tolerance) {
+			attainment.Achieved++
+		}
+	}
+	for _, kpi := range portfolioKPIs {
+		if _, measured := latest[kpi.ID]; measured {
+			continue
+		}
+		switch kpi.Status {
+		case KPIStatusOnTrack:
+			attainment.Total++
+			attainment.Achieved++
+		case KPIStatusAtRisk, KPIStatusOffTrack:
+			attainment.Total++
+		}
+	}
+	return attainment
+}
@@ -0,0 +1,134 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// DefaultIncidentClassification classifies incidents of applications whose agreement defines no
+// classification matrix, on a scale of four severities
+var DefaultIncidentClassification = []IncidentClass{
+	{Severity: 1, Name: "Critical", Description: "Service down or data at risk", Keywords: []string{"outage", "down", "unavailable", "data loss", "data breach", "security breach", "corruption"}},
+	{Severity: 2, Name: "High", Description: "Major function impaired for many users", Keywords: []string{"degraded", "cannot login", "cannot log in", "payment", "failing", "security"}},
+	{Severity: 3, Name: "Medium", Description: "Function impaired with a workaround", Keywords: []string{"slow", "error", "intermittent", "timeout", "workaround"}},
+	{Severity: 4, Name: "Low", Description: "Minor or cosmetic issue", Keywords: []string{"cosmetic", "typo", "layout", "question", "minor"}},
+}
+
+// IncidentClassification is the severity and priority suggested for an incident from the impact
+// keywords of its agreement's classification matrix and the criticality of its application
+type IncidentClassification struct {
+	Severity    int
+	Class       string   // name of the class of the severity
+	Keywords    []string // keywords of the class found in the incident; none when no class matched
+	Priority    int      // number of the suggested priority; zero without a prioritization matrix
+	Criticality Priority // of the affected application
+	Default     bool     // classified with DefaultIncidentClassification
+}
+
+// ClassifyIncident suggests the severity of an incident from its title, description and impact:
+// the most severe class with a keyword found in them, or the least severe class when none is. Its
+// priority is the one numbered as the severity, one more urgent for critical applications and
+// one less urgent for low criticality ones, or the nearest one the prioritization matrix defines.
+func ClassifyIncident(management IncidentManagement, criticality Priority, title, description, impact string) IncidentClassification {
+	classes := management.ClassificationMatrix
+	classification := IncidentClassification{Criticality: criticality}
+	if len(classes) == 0 {
+		classes = DefaultIncidentClassification
+		classification.Default = true
+	}
+	classes = append([]IncidentClass{}, classes...)
+	sort.SliceStable(classes, func(i, j int) bool { return classes[i].Severity < classes[j].Severity })
+
+	text := " " + normalizeKeywordText(strings.Join([]string{title, description, impact}, " ")) + " "
+	class := classes[len(classes)-1]
+	for _, candidate := range classes {
+		var found []string
+		for _, keyword := range candidate.Keywords {
+			if normalized := normalizeKeywordText(keyword); normalized != "" && strings.Contains(text, " "+normalized+" ") {
+				found = append(found, keyword)
+			}
+		}
+		if len(found) > 0 {
+			class = candidate
+			classification.Keywords = found
+			break
+		}
+	}
+	classification.Severity = class.Severity
+	classification.Class = class.Name
+	classification.Priority = IncidentPriorityFor(management, class.Severity, criticality)
+	return classification
+}
+
+// IncidentPriorityFor returns the number of the priority of an incident of the severity on an
+// application of the criticality, or zero when the prioritization matrix defines no priorities
+func IncidentPriorityFor(management IncidentManagement, severity int, criticality Priority) int {
+	if len(management.PrioritizationMatrix) == 0 {
+		return 0
+	}
+	wanted := severity
+	switch criticality {
+	case PriorityCritical:
+		wanted--
+	case PriorityLow:
+		wanted++
+	}
+
+	priority := management.PrioritizationMatrix[0].Priority
+	for _, candidate := range management.PrioritizationMatrix {
+		distance, best := abs(candidate.Priority-wanted), abs(priority-wanted)
+		if distance < best || (distance == best && candidate.Priority < priority) {
+			priority = candidate.Priority
+		}
+	}
+	return priority
+}
+
+// ValidateIncidentSeverity ensures the severity is one the classification matrix defines, or
+// one of DefaultIncidentClassification when it defines none
+func ValidateIncidentSeverity(management IncidentManagement, severity int) error {
+	classes := management.ClassificationMatrix
+	if len(classes) == 0 {
+		classes = DefaultIncidentClassification
+	}
+	severities := make([]string, 0, len(classes))
+	for _, class := range classes {
+		if class.Severity == severity {
+			return nil
+		}
+		severities = append(severities, fmt.Sprint(class.Severity))
+	}
+	return fmt.Errorf("incident severity %d is not classified; use one of %s", severity, strings.Join(severities, ", "))
+}
+
+// ValidateIncidentPriority ensures the priority is one the prioritization matrix defines
+func ValidateIncidentPriority(management IncidentManagement, priority int) error {
+	if len(management.PrioritizationMatrix) == 0 {
+		return fmt.Errorf("incident priority %d cannot be set: the agreement defines no prioritization matrix", priority)
+	}
+	numbers := make([]string, 0, len(management.PrioritizationMatrix))
+	for _, candidate := range management.PrioritizationMatrix {
+		if candidate.Priority == priority {
+			return nil
+		}
+		numbers = append(numbers, fmt.Sprint(candidate.Priority))
+	}
+	return fmt.Errorf("incident priority %d is not defined; use one of %s", priority, strings.Join(numbers, ", "))
+}
+
+// normalizeKeywordText lowercases the text and reduces everything but letters and digits to
+// single spaces, so keywords match whole words
+func normalizeKeywordText(text string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}), " ")
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// IncidentClosureCode records why an incident was closed
+type IncidentClosureCode string
+
+const (
+	ClosureFixed           IncidentClosureCode = "fixed"            // its cause was fixed
+	ClosureWorkaround      IncidentClosureCode = "workaround"       // service was restored with a workaround; its cause remains
+	ClosureDuplicate       IncidentClosureCode = "duplicate"        // it duplicated another incident
+	ClosureNotReproducible IncidentClosureCode = "not_reproducible" // it could not be reproduced
+	ClosureCancelled       IncidentClosureCode = "cancelled"        // it was reported in error
+)
+
+// Validate ensures the closure code is one of the known codes
+func (c IncidentClosureCode) Validate() error {
+	switch c {
+	case ClosureFixed, ClosureWorkaround, ClosureDuplicate, ClosureNotReproducible, ClosureCancelled:
+		return nil
+	}
+	return fmt.Errorf("unknown incident closure code %q", c)
+}
+
+// incidentTransitions are the statuses an incident may move to from each status. Resolved
+// incidents move back to open or investigating when reopened; closed incidents are final.
+var incidentTransitions = map[IncidentStatus][]IncidentStatus{
+	IncidentStatusOpen:          {IncidentStatusInvestigating, IncidentStatusResolved},
+	IncidentStatusInvestigating: {IncidentStatusResolved},
+	IncidentStatusResolved:      {IncidentStatusClosed, IncidentStatusOpen, IncidentStatusInvestigating},
+}
+
+// CanTransitionTo reports whether the incident may move from its status to the given one
+func (i Incident) CanTransitionTo(status IncidentStatus) bool {
+	return containsValue(incidentTransitions[i.Status], status)
+}
+
+// TransitionTo moves the incident to the given status, returning an error when its lifecycle
+// does not allow it
+func (i *Incident) TransitionTo(status IncidentStatus, now time.Time) error {
+	if !i.CanTransitionTo(status) {
+		return fmt.Errorf("incident %s cannot move from %s to %s", i.ID, i.Status, status)
+	}
+	i.Status = status
+	i.UpdatedAt = now
+	return nil
+}
+
+// Assign makes the assignee responsible for the incident. It returns the previous assignee.
+func (i *Incident) Assign(assignee string, now time.Time) (string, error) {
+	if strings.TrimSpace(assignee) == "" {
+		return "", fmt.Errorf("assignee of incident %s cannot be empty", i.ID)
+	}
+	if i.Status == IncidentStatusClosed {
+		return "", fmt.Errorf("incident %s is closed", i.ID)
+	}
+	previous := i.Assignee
+	i.Assignee = assignee
+	i.AssignedAt = now
+	i.UpdatedAt = now
+	return previous, nil
+}
+
+// Reopen moves a resolved incident back to investigating when it was acknowledged, or to open
+// otherwise, clearing its resolution. Its SLA keeps running from when it was reported.
+func (i *Incident) Reopen(now time.Time) error {
+	if i.Status != IncidentStatusResolved {
+		return fmt.Errorf("incident %s is %s; only resolved incidents can be reopened", i.ID, i.Status)
+	}
+	status := IncidentStatusOpen
+	if !i.AcknowledgedAt.IsZero() {
+		status = IncidentStatusInvestigating
+	}
+	if err := i.TransitionTo(status, now); err != nil {
+		return err
+	}
+	i.Resolution = ""
+	i.TimeToResolve = 0
+	i.ResolvedAt = time.Time{}
+	i.ReopenCount++
+	return nil
+}
+
+// Close closes a resolved incident with the code recording why
+func (i *Incident) Close(code IncidentClosureCode, now time.Time) error {
+	if err := code.Validate(); err != nil {
+		return err
+	}
+	if err := i.TransitionTo(IncidentStatusClosed, now); err != nil {
+		return err
+	}
+	i.ClosureCode = code
+	i.ClosedAt = now
+	return nil
+}
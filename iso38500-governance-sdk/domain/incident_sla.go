@@ -0,0 +1,261 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// IncidentSLAStatus is how an incident stands against the response and resolution targets of
+// its governance agreement
+type IncidentSLAStatus string
+
+const (
+	IncidentSLAOnTrack  IncidentSLAStatus = "on_track"
+	IncidentSLAAtRisk   IncidentSLAStatus = "at_risk" // less than a quarter of a target's time is left
+	IncidentSLABreached IncidentSLAStatus = "breached"
+	IncidentSLAMet      IncidentSLAStatus = "met" // resolved within both targets
+)
+
+// IncidentSLATarget is one of the targets an incident is held to
+type IncidentSLATarget string
+
+const (
+	IncidentSLAResponse   IncidentSLATarget = "response"   // acknowledged within the response time of its class
+	IncidentSLAResolution IncidentSLATarget = "resolution" // resolved within the SLA of its priority
+)
+
+// IncidentSLA is the time an incident of a severity may take to be acknowledged and resolved
+type IncidentSLA struct {
+	Severity       int
+	ResponseTime   time.Duration // zero when no class covers the severity
+	ResolutionTime time.Duration // zero when no priority covers the severity
+}
+
+// IncidentSLAFor returns the SLA of incidents of the severity and priority: the response time of
+// the class with that severity and the SLA of the priority with that number, or with the
+// severity's number when the priority is zero. It reports false when the incident management
+// component sets neither.
+func IncidentSLAFor(management IncidentManagement, severity, priority int) (IncidentSLA, bool) {
+	sla := IncidentSLA{Severity: severity}
+	if priority == 0 {
+		priority = severity
+	}
+	for _, class := range management.ClassificationMatrix {
+		if class.Severity == severity && class.ResponseTime > 0 {
+			sla.ResponseTime = class.ResponseTime
+			break
+		}
+	}
+	for _, candidate := range management.PrioritizationMatrix {
+		if candidate.Priority == priority && candidate.SLA > 0 {
+			sla.ResolutionTime = candidate.SLA
+			break
+		}
+	}
+	return sla, sla.ResponseTime > 0 || sla.ResolutionTime > 0
+}
+
+// ValidateIncidentSLAs ensures every class and priority names a positive severity or priority
+// once, with a response time or SLA that is not negative
+func ValidateIncidentSLAs(classes []IncidentClass, priorities []IncidentPriority) error {
+	severities := make(map[int]bool, len(classes))
+	for _, class := range classes {
+		if class.Severity < 1 {
+			return fmt.Errorf("incident class %q must have a severity of at least 1", class.Name)
+		}
+		if severities[class.Severity] {
+			return fmt.Errorf("incident severity %d is classified twice", class.Severity)
+		}
+		if class.ResponseTime < 0 {
+			return fmt.Errorf("response time of incident class %q cannot be negative", class.Name)
+		}
+		severities[class.Severity] = true
+	}
+	numbers := make(map[int]bool, len(priorities))
+	for _, priority := range priorities {
+		if priority.Priority < 1 {
+			return fmt.Errorf("incident priority %q must have a number of at least 1", priority.Name)
+		}
+		if numbers[priority.Priority] {
+			return fmt.Errorf("incident priority %d is set twice", priority.Priority)
+		}
+		if priority.SLA < 0 {
+			return fmt.Errorf("SLA of incident priority %q cannot be negative", priority.Name)
+		}
+		numbers[priority.Priority] = true
+	}
+	return nil
+}
+
+// ConfigureIncidentSLAs sets the classification and prioritization matrices of an agreement's
+// incident management, replacing those configured before. Its response matrix is kept.
+func (s *MonitoringService) ConfigureIncidentSLAs(ctx context.Context, agreementID GovernanceAgreementID, classes []IncidentClass, priorities []IncidentPriority) error {
+	if err := ValidateIncidentSLAs(classes, priorities); err != nil {
+		return err
+	}
+
+	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
+	if err != nil {
+		return fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+
+	sortedClasses := append([]IncidentClass{}, classes...)
+	sort.SliceStable(sortedClasses, func(i, j int) bool { return sortedClasses[i].Severity < sortedClasses[j].Severity })
+	sortedPriorities := append([]IncidentPriority{}, priorities...)
+	sort.SliceStable(sortedPriorities, func(i, j int) bool { return sortedPriorities[i].Priority < sortedPriorities[j].Priority })
+	agreement.Performance.IncidentManagement.ClassificationMatrix = sortedClasses
+	agreement.Performance.IncidentManagement.PrioritizationMatrix = sortedPriorities
+
+	err = s.agreementRepo.Update(ctx, agreement)
+	if err != nil {
+		return fmt.Errorf("failed to update governance agreement: %w", err)
+	}
+	return nil
+}
+
+// HasSLA reports whether the incident is held to a response or resolution target
+func (i Incident) HasSLA() bool {
+	return !i.ResponseDue.IsZero() || !i.ResolutionDue.IsZero()
+}
+
+// ApplySLA sets when the incident must be acknowledged and resolved, counting from when it was
+// reported
+func (i *Incident) ApplySLA(sla IncidentSLA) {
+	if sla.ResponseTime > 0 {
+		i.ResponseDue = i.CreatedAt.Add(sla.ResponseTime)
+	}
+	if sla.ResolutionTime > 0 {
+		i.ResolutionDue = i.CreatedAt.Add(sla.ResolutionTime)
+	}
+	if i.SLAStatus == "" {
+		i.SLAStatus = IncidentSLAOnTrack
+	}
+}
+
+// respondedAt is when the incident was first responded to: acknowledged, or resolved without
+// being acknowledged
+func (i Incident) respondedAt() time.Time {
+	if !i.AcknowledgedAt.IsZero() {
+		return i.AcknowledgedAt
+	}
+	return i.ResolvedAt
+}
+
+// EvaluateSLA records the targets the incident has breached by now and updates its SLA status.
+// It returns the targets breached since it was last evaluated; each breach is recorded once.
+func (i *Incident) EvaluateSLA(now time.Time) []IncidentSLATarget {
+	if !i.HasSLA() {
+		return nil
+	}
+
+	var breached []IncidentSLATarget
+	if missed(i.ResponseDue, i.respondedAt(), now) && i.ResponseBreachedAt.IsZero() {
+		i.ResponseBreachedAt = now
+		breached = append(breached, IncidentSLAResponse)
+	}
+	if missed(i.ResolutionDue, i.ResolvedAt, now) && i.ResolutionBreachedAt.IsZero() {
+		i.ResolutionBreachedAt = now
+		breached = append(breached, IncidentSLAResolution)
+	}
+
+	switch {
+	case !i.ResponseBreachedAt.IsZero() || !i.ResolutionBreachedAt.IsZero():
+		i.SLAStatus = IncidentSLABreached
+	case !i.ResolvedAt.IsZero():
+		i.SLAStatus = IncidentSLAMet
+	case atRisk(i.CreatedAt, i.ResponseDue, i.respondedAt(), now) || atRisk(i.CreatedAt, i.ResolutionDue, i.ResolvedAt, now):
+		i.SLAStatus = IncidentSLAAtRisk
+	default:
+		i.SLAStatus = IncidentSLAOnTrack
+	}
+	return breached
+}
+
+// missed reports whether a target due at due was not met by done, or by now when not done yet
+func missed(due, done, now time.Time) bool {
+	if due.IsZero() {
+		return false
+	}
+	if !done.IsZero() {
+		return done.After(due)
+	}
+	return now.After(due)
+}
+
+// atRisk reports whether an outstanding target has less than a quarter of its time left
+func atRisk(start, due, done, now time.Time) bool {
+	if due.IsZero() || !done.IsZero() {
+		return false
+	}
+	return due.Sub(now)*4 < due.Sub(start)
+}
+
+// SLARemaining is the time left until the target is due: negative once overdue, and zero once
+// met or when the incident is not held to it
+func (i Incident) SLARemaining(target IncidentSLATarget, now time.Time) time.Duration {
+	due, done := i.ResolutionDue, i.ResolvedAt
+	if target == IncidentSLAResponse {
+		due, done = i.ResponseDue, i.respondedAt()
+	}
+	if due.IsZero() || !done.IsZero() {
+		return 0
+	}
+	return due.Sub(now)
+}
+
+// IncidentSLACheck is how an incident stood against its SLA when it was checked
+type IncidentSLACheck struct {
+	IncidentID          string
+	ApplicationID       ApplicationID
+	AgreementID         GovernanceAgreementID
+	Title               string
+	Severity            int
+	Status              IncidentSLAStatus
+	ResponseDue         time.Time
+	ResolutionDue       time.Time
+	ResponseRemaining   time.Duration       // negative once overdue, zero once met
+	ResolutionRemaining time.Duration       // negative once overdue, zero once met
+	Breached            []IncidentSLATarget // targets the check found breached for the first time
+}
+
+// SLACheck reports how the incident stands against its SLA by now
+func (i Incident) SLACheck(agreementID GovernanceAgreementID, now time.Time, breached []IncidentSLATarget) IncidentSLACheck {
+	return IncidentSLACheck{
+		IncidentID:          i.ID,
+		ApplicationID:       i.ApplicationID,
+		AgreementID:         agreementID,
+		Title:               i.Title,
+		Severity:            i.Severity,
+		Status:              i.SLAStatus,
+		ResponseDue:         i.ResponseDue,
+		ResolutionDue:       i.ResolutionDue,
+		ResponseRemaining:   i.SLARemaining(IncidentSLAResponse, now),
+		ResolutionRemaining: i.SLARemaining(IncidentSLAResolution, now),
+		Breached:            breached,
+	}
+}
+
+// SLABreachedEvent is the event recording that the incident breached the target
+func (i Incident) SLABreachedEvent(agreementID GovernanceAgreementID, target IncidentSLATarget, now time.Time) IncidentSLABreachedEvent {
+	due := i.ResolutionDue
+	if target == IncidentSLAResponse {
+		due = i.ResponseDue
+	}
+	return IncidentSLABreachedEvent{
+		IncidentID:    i.ID,
+		ApplicationID: i.ApplicationID,
+		AgreementID:   agreementID,
+		Severity:      i.Severity,
+		Target:        target,
+		DueAt:         due,
+		OccurredAt:    now,
+	}
+}
+
+// Describe describes the breach, e.g. "severity 1 incident inc-001 missed its response target
+// due 2024-05-01 10:00 UTC"
+func (e IncidentSLABreachedEvent) Describe() string {
+	return fmt.Sprintf("severity %d incident %s missed its %s target due %s", e.Severity, e.IncidentID, e.Target, e.DueAt.UTC().Format("2006-01-02 15:04 MST"))
+}
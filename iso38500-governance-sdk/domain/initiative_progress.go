@@ -0,0 +1,311 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// InitiativeStatus represents how a strategic initiative is progressing
+type InitiativeStatus string
+
+const (
+	InitiativeNotStarted InitiativeStatus = "not_started"
+	InitiativeOnTrack    InitiativeStatus = "on_track"
+	InitiativeAtRisk     InitiativeStatus = "at_risk"
+	InitiativeDelayed    InitiativeStatus = "delayed"
+	InitiativeCompleted  InitiativeStatus = "completed"
+)
+
+// InitiativeMilestone is a dated step towards completing a strategic initiative
+type InitiativeMilestone struct {
+	ID          string
+	Name        string
+	DueDate     time.Time
+	CompletedAt time.Time // zero until the milestone is completed
+}
+
+// Completed reports whether the milestone has been completed
+func (m InitiativeMilestone) Completed() bool {
+	return !m.CompletedAt.IsZero()
+}
+
+// Overdue reports whether the milestone is past its due date without being completed
+func (m InitiativeMilestone) Overdue(now time.Time) bool {
+	return !m.Completed() && !m.DueDate.IsZero() && now.After(m.DueDate)
+}
+
+// InitiativeStatusUpdate records one report of an initiative's progress
+type InitiativeStatusUpdate struct {
+	PercentComplete     float64
+	Status              InitiativeStatus
+	CompletedMilestones []string
+	Note                string
+	UpdatedBy           string
+	UpdatedAt           time.Time
+}
+
+// Validate ensures the initiative and its milestones are usable
+func (i *StrategicInitiative) Validate() error {
+	if i.ID == "" {
+		return errors.New("initiative ID cannot be empty")
+	}
+	if i.Name == "" {
+		return fmt.Errorf("initiative %s: name cannot be empty", i.ID)
+	}
+	if i.PercentComplete < 0 || i.PercentComplete > 100 {
+		return fmt.Errorf("initiative %s: percent complete must be between 0 and 100", i.ID)
+	}
+	if err := validateInitiativeStatus(i.Status); err != nil {
+		return fmt.Errorf("initiative %s: %w", i.ID, err)
+	}
+	seen := make(map[string]bool)
+	for _, milestone := range i.Milestones {
+		if milestone.ID == "" {
+			return fmt.Errorf("initiative %s: milestone ID cannot be empty", i.ID)
+		}
+		if seen[milestone.ID] {
+			return fmt.Errorf("initiative %s: duplicate milestone %s", i.ID, milestone.ID)
+		}
+		seen[milestone.ID] = true
+	}
+	if err := validateResourceDemands(i.ResourceDemands); err != nil {
+		return fmt.Errorf("initiative %s: %w", i.ID, err)
+	}
+	return nil
+}
+
+// RecordProgress applies a status update: it completes the named milestones, sets the percent
+// complete and status, and appends the update to the initiative's history. An empty status is
+// derived from the progress: completed at 100%, delayed with overdue milestones, otherwise on
+// track.
+func (i *StrategicInitiative) RecordProgress(update InitiativeStatusUpdate) error {
+	if update.PercentComplete < 0 || update.PercentComplete > 100 {
+		return errors.New("percent complete must be between 0 and 100")
+	}
+	if err := validateInitiativeStatus(update.Status); err != nil {
+		return err
+	}
+	if update.UpdatedAt.IsZero() {
+		update.UpdatedAt = time.Now()
+	}
+
+	milestones := make([]InitiativeMilestone, len(i.Milestones))
+	copy(milestones, i.Milestones)
+	for _, milestoneID := range update.CompletedMilestones {
+		found := false
+		for m := range milestones {
+			if milestones[m].ID != milestoneID {
+				continue
+			}
+			if !milestones[m].Completed() {
+				milestones[m].CompletedAt = update.UpdatedAt
+			}
+			found = true
+			break
+		}
+		if !found {
+			return fmt.Errorf("milestone %s not found in initiative %s", milestoneID, i.ID)
+		}
+	}
+	i.Milestones = milestones
+
+	if update.Status == "" {
+		update.Status = i.derivedStatus(update.PercentComplete, update.UpdatedAt)
+	}
+
+	i.PercentComplete = update.PercentComplete
+	i.Status = update.Status
+	i.Updates = append(i.Updates, update)
+	return nil
+}
+
+// Progress summarizes the initiative's progress at the given time
+func (i StrategicInitiative) Progress(now time.Time) InitiativeProgress {
+	progress := InitiativeProgress{
+		InitiativeID:      i.ID,
+		Name:              i.Name,
+		PercentComplete:   i.PercentComplete,
+		Status:            i.Status,
+		Milestones:        len(i.Milestones),
+		OverdueMilestones: []string{},
+	}
+	if progress.Status == "" {
+		progress.Status = InitiativeNotStarted
+	}
+	for _, milestone := range i.Milestones {
+		if milestone.Completed() {
+			progress.MilestonesCompleted++
+		} else if milestone.Overdue(now) {
+			progress.OverdueMilestones = append(progress.OverdueMilestones, milestone.ID)
+		}
+	}
+	if len(i.Updates) > 0 {
+		progress.LastUpdated = i.Updates[len(i.Updates)-1].UpdatedAt
+	}
+	return progress
+}
+
+// derivedStatus is the status implied by the percent complete and the milestones' due dates
+func (i StrategicInitiative) derivedStatus(percentComplete float64, now time.Time) InitiativeStatus {
+	if percentComplete >= 100 {
+		return InitiativeCompleted
+	}
+	for _, milestone := range i.Milestones {
+		if milestone.Overdue(now) {
+			return InitiativeDelayed
+		}
+	}
+	if percentComplete == 0 {
+		return InitiativeNotStarted
+	}
+	return InitiativeOnTrack
+}
+
+func validateInitiativeStatus(status InitiativeStatus) error {
+	switch status {
+	case "", InitiativeNotStarted, InitiativeOnTrack, InitiativeAtRisk, InitiativeDelayed, InitiativeCompleted:
+		return nil
+	}
+	return fmt.Errorf("unknown initiative status %q", status)
+}
+
+// InitiativeProgress summarizes one initiative's progress
+type InitiativeProgress struct {
+	InitiativeID        string
+	Name                string
+	PercentComplete     float64
+	Status              InitiativeStatus
+	Milestones          int
+	MilestonesCompleted int
+	OverdueMilestones   []string
+	LastUpdated         time.Time
+}
+
+// ObjectiveProgress rolls up the progress of the initiatives contributing to an objective
+type ObjectiveProgress struct {
+	ObjectiveID     string
+	Name            string
+	Initiatives     int
+	PercentComplete float64 // average of the contributing initiatives
+}
+
+// DirectionProgress rolls up initiative progress to the objective and agreement level
+type DirectionProgress struct {
+	AgreementID     GovernanceAgreementID
+	Initiatives     []InitiativeProgress
+	Objectives      []ObjectiveProgress
+	PercentComplete float64 // average of all initiatives
+	Delayed         int     // initiatives delayed or at risk
+}
+
+// Progress rolls up the direction's initiative progress to its objectives and as a whole
+func (d StrategicDirection) Progress(now time.Time) DirectionProgress {
+	progress := DirectionProgress{
+		Initiatives: make([]InitiativeProgress, 0, len(d.Initiatives)),
+		Objectives:  make([]ObjectiveProgress, 0, len(d.Objectives)),
+	}
+
+	for _, initiative := range d.Initiatives {
+		initiativeProgress := initiative.Progress(now)
+		progress.Initiatives = append(progress.Initiatives, initiativeProgress)
+		progress.PercentComplete += initiativeProgress.PercentComplete
+		if initiativeProgress.Status == InitiativeDelayed || initiativeProgress.Status == InitiativeAtRisk {
+			progress.Delayed++
+		}
+	}
+	if len(d.Initiatives) > 0 {
+		progress.PercentComplete /= float64(len(d.Initiatives))
+	}
+
+	for _, objective := range d.Objectives {
+		objectiveProgress := ObjectiveProgress{ObjectiveID: objective.ID, Name: objective.Name}
+		for _, initiative := range d.Initiatives {
+			for _, objectiveID := range initiative.ObjectiveIDs {
+				if objectiveID == objective.ID {
+					objectiveProgress.Initiatives++
+					objectiveProgress.PercentComplete += initiative.PercentComplete
+					break
+				}
+			}
+		}
+		if objectiveProgress.Initiatives > 0 {
+			objectiveProgress.PercentComplete /= float64(objectiveProgress.Initiatives)
+		}
+		progress.Objectives = append(progress.Objectives, objectiveProgress)
+	}
+
+	return progress
+}
+
+// validateInitiatives checks every initiative, that no two share an ID and that each contributes
+// only to objectives of the direction
+func validateInitiatives(initiatives []StrategicInitiative, objectives []StrategicObjective) error {
+	known := make(map[string]bool)
+	for _, objective := range objectives {
+		known[objective.ID] = true
+	}
+	seen := make(map[string]bool)
+	for _, initiative := range initiatives {
+		if err := initiative.Validate(); err != nil {
+			return err
+		}
+		if seen[initiative.ID] {
+			return fmt.Errorf("duplicate initiative %s", initiative.ID)
+		}
+		seen[initiative.ID] = true
+		for _, objectiveID := range initiative.ObjectiveIDs {
+			if !known[objectiveID] {
+				return fmt.Errorf("initiative %s contributes to unknown objective %s", initiative.ID, objectiveID)
+			}
+		}
+	}
+	return nil
+}
+
+// UpdateInitiativeProgress records a status update for one of the agreement's initiatives
+func (s *DirectionService) UpdateInitiativeProgress(ctx context.Context, agreementID GovernanceAgreementID, initiativeID string, update InitiativeStatusUpdate) (*StrategicInitiative, error) {
+	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+
+	initiatives := agreement.Direct.StrategicDirection.Initiatives
+	for i := range initiatives {
+		if initiatives[i].ID != initiativeID {
+			continue
+		}
+
+		initiative := initiatives[i]
+		if err := initiative.RecordProgress(update); err != nil {
+			return nil, err
+		}
+
+		updated := make([]StrategicInitiative, len(initiatives))
+		copy(updated, initiatives)
+		updated[i] = initiative
+		agreement.Direct.StrategicDirection.Initiatives = updated
+
+		err = s.agreementRepo.Update(ctx, agreement)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update governance agreement: %w", err)
+		}
+		return &initiative, nil
+	}
+
+	return nil, fmt.Errorf("initiative %s not found in agreement %s", initiativeID, agreementID)
+}
+
+// MonitorInitiativeProgress rolls up the progress of the agreement's initiatives to its
+// objectives and the agreement as a whole
+func (s *MonitoringService) MonitorInitiativeProgress(ctx context.Context, agreementID GovernanceAgreementID) (*DirectionProgress, error) {
+	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+
+	progress := agreement.Direct.StrategicDirection.Progress(time.Now())
+	progress.AgreementID = agreementID
+	return &progress, nil
+}
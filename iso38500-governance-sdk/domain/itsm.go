@@ -0,0 +1,305 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ITSMRecordKind is the kind of record mirrored to an ITSM tool
+type ITSMRecordKind string
+
+const (
+	ITSMChangeRequest ITSMRecordKind = "change_request"
+	ITSMIncident      ITSMRecordKind = "incident"
+)
+
+// Validate ensures the kind is known
+func (k ITSMRecordKind) Validate() error {
+	switch k {
+	case ITSMChangeRequest, ITSMIncident:
+		return nil
+	}
+	return fmt.Errorf("unknown ITSM record kind %q", k)
+}
+
+// ITSMCommentOrigin is the side a mirrored comment was written on
+type ITSMCommentOrigin string
+
+const (
+	ITSMCommentLocal  ITSMCommentOrigin = "local"  // written through the SDK and pushed to the ITSM tool
+	ITSMCommentRemote ITSMCommentOrigin = "remote" // written in the ITSM tool and pulled
+)
+
+// ITSMComment is a comment on a mirrored record
+type ITSMComment struct {
+	ID        string // the ITSM tool's ID for it; empty when the tool returns none
+	Author    string
+	Body      string
+	Origin    ITSMCommentOrigin
+	CreatedAt time.Time
+	PushedAt  time.Time // when a local comment reached the ITSM tool; zero while pending
+}
+
+// ITSMReference identifies the record an ITSM tool created for a mirrored record
+type ITSMReference struct {
+	ExternalID string // the tool's ID, used to update it, e.g. a ServiceNow sys_id
+	Key        string // the human-readable number, e.g. "CHG0030001" or "OPS-42"
+	URL        string
+}
+
+// ITSMRemoteRecord is the current state of a mirrored record in its ITSM tool, with the tool's
+// field names
+type ITSMRemoteRecord struct {
+	ExternalID string
+	Fields     map[string]string
+	Comments   []ITSMComment // oldest first
+	UpdatedAt  time.Time
+}
+
+// ITSMConnector creates, updates and reads records in an ITSM tool such as ServiceNow or Jira.
+// Fields are keyed by the tool's own field names; the status field carries the tool's status.
+type ITSMConnector interface {
+	Name() string
+	Create(ctx context.Context, kind ITSMRecordKind, fields map[string]string) (ITSMReference, error)
+	Update(ctx context.Context, kind ITSMRecordKind, externalID string, fields map[string]string) error
+	AddComment(ctx context.Context, kind ITSMRecordKind, externalID string, comment ITSMComment) (string, error)
+	Fetch(ctx context.Context, kind ITSMRecordKind, externalID string) (ITSMRemoteRecord, error)
+}
+
+// Local fields of mirrored records that field mappings map onto the fields of an ITSM tool
+const (
+	ITSMFieldTitle         = "title"
+	ITSMFieldDescription   = "description"
+	ITSMFieldStatus        = "status"
+	ITSMFieldPriority      = "priority"
+	ITSMFieldApplication   = "application_id"
+	ITSMFieldRequester     = "requester" // change request requester or incident reporter
+	ITSMFieldType          = "type"      // change requests only
+	ITSMFieldRisk          = "risk"      // change requests only
+	ITSMFieldImpact        = "impact"
+	ITSMFieldSeverity      = "severity"     // incidents only
+	ITSMFieldAssignee      = "assignee"     // incidents only
+	ITSMFieldResolution    = "resolution"   // incident resolution or change request closure notes
+	ITSMFieldRootCause     = "root_cause"   // incidents only
+	ITSMFieldClosureCode   = "closure_code" // incidents only
+	ITSMFieldImplementedAt = "implemented_at"
+)
+
+// itsmFields are the local fields a mapping may name
+var itsmFields = []string{
+	ITSMFieldTitle, ITSMFieldDescription, ITSMFieldStatus, ITSMFieldPriority, ITSMFieldApplication,
+	ITSMFieldRequester, ITSMFieldType, ITSMFieldRisk, ITSMFieldImpact, ITSMFieldSeverity,
+	ITSMFieldAssignee, ITSMFieldResolution, ITSMFieldRootCause, ITSMFieldClosureCode, ITSMFieldImplementedAt,
+}
+
+// ITSMFieldMapping maps the local fields of a kind of record onto the fields of an ITSM tool,
+// and the values of local fields, such as statuses and priorities, onto the tool's values. Local
+// fields left out are not mirrored; values left out are mirrored as they are.
+type ITSMFieldMapping struct {
+	Kind   ITSMRecordKind
+	Fields map[string]string            // local field → ITSM field
+	Values map[string]map[string]string // local field → local value → ITSM value
+}
+
+// Validate ensures the mapping maps the title onto a field, names known local fields only, and
+// maps no two local fields onto the same ITSM field nor two values of a field onto the same value
+func (m ITSMFieldMapping) Validate() error {
+	if err := m.Kind.Validate(); err != nil {
+		return err
+	}
+	if m.Fields[ITSMFieldTitle] == "" {
+		return fmt.Errorf("%s field mapping must map the title", m.Kind)
+	}
+	targets := make(map[string]string, len(m.Fields))
+	for local, remote := range m.Fields {
+		if !containsValue(itsmFields, local) {
+			return fmt.Errorf("%s field mapping names unknown field %q", m.Kind, local)
+		}
+		if remote == "" {
+			return fmt.Errorf("%s field %s is mapped onto no ITSM field", m.Kind, local)
+		}
+		if other, ok := targets[remote]; ok {
+			return fmt.Errorf("%s fields %s and %s are both mapped onto %s", m.Kind, other, local, remote)
+		}
+		targets[remote] = local
+	}
+	for field, values := range m.Values {
+		if _, ok := m.Fields[field]; !ok {
+			return fmt.Errorf("%s field mapping maps values of unmapped field %q", m.Kind, field)
+		}
+		seen := make(map[string]string, len(values))
+		for local, remote := range values {
+			key := strings.ToLower(remote)
+			if other, ok := seen[key]; ok {
+				return fmt.Errorf("%s %s values %s and %s are both mapped onto %s", m.Kind, field, other, local, remote)
+			}
+			seen[key] = local
+		}
+	}
+	return nil
+}
+
+// Outbound translates local fields into the ITSM tool's fields. Empty values are left out.
+func (m ITSMFieldMapping) Outbound(local map[string]string) map[string]string {
+	remote := make(map[string]string, len(m.Fields))
+	for field, target := range m.Fields {
+		value := local[field]
+		if mapped, ok := m.Values[field][value]; ok {
+			value = mapped
+		}
+		if value != "" {
+			remote[target] = value
+		}
+	}
+	return remote
+}
+
+// Inbound translates the ITSM tool's fields back into local fields. A value mapped from no local
+// value is kept as the tool reports it.
+func (m ITSMFieldMapping) Inbound(remote map[string]string) map[string]string {
+	local := make(map[string]string, len(m.Fields))
+	for field, source := range m.Fields {
+		value, ok := remote[source]
+		if !ok {
+			continue
+		}
+		for localValue, mapped := range m.Values[field] {
+			if strings.EqualFold(mapped, value) {
+				value = localValue
+				break
+			}
+		}
+		local[field] = value
+	}
+	return local
+}
+
+// ChangeRequestITSMFields returns the local fields of a change request mirrored to ITSM tools
+func ChangeRequestITSMFields(cr ChangeRequest) map[string]string {
+	fields := map[string]string{
+		ITSMFieldTitle:       cr.Title,
+		ITSMFieldDescription: cr.Description,
+		ITSMFieldStatus:      string(cr.Status),
+		ITSMFieldPriority:    string(cr.Priority),
+		ITSMFieldApplication: string(cr.ApplicationID),
+		ITSMFieldRequester:   cr.Requester,
+		ITSMFieldType:        string(cr.Type),
+		ITSMFieldRisk:        cr.Risk,
+		ITSMFieldImpact:      cr.Impact,
+	}
+	if !cr.ImplementedAt.IsZero() {
+		fields[ITSMFieldImplementedAt] = cr.ImplementedAt.UTC().Format(time.RFC3339)
+	}
+	if cr.Verification != nil {
+		fields[ITSMFieldResolution] = cr.Verification.Notes
+	}
+	return fields
+}
+
+// IncidentITSMFields returns the local fields of an incident mirrored to ITSM tools
+func IncidentITSMFields(incident Incident) map[string]string {
+	return map[string]string{
+		ITSMFieldTitle:       incident.Title,
+		ITSMFieldDescription: incident.Description,
+		ITSMFieldStatus:      string(incident.Status),
+		ITSMFieldApplication: string(incident.ApplicationID),
+		ITSMFieldRequester:   incident.Reporter,
+		ITSMFieldImpact:      incident.Impact,
+		ITSMFieldSeverity:    strconv.Itoa(incident.Severity),
+		ITSMFieldAssignee:    incident.Assignee,
+		ITSMFieldResolution:  incident.Resolution,
+		ITSMFieldRootCause:   incident.RootCause,
+		ITSMFieldClosureCode: string(incident.ClosureCode),
+	}
+}
+
+// ITSMSyncTarget mirrors a kind of record of some applications, or of every application when
+// none are named, to an ITSM connector with a field mapping
+type ITSMSyncTarget struct {
+	Kind         ITSMRecordKind
+	Connector    string
+	Mapping      ITSMFieldMapping
+	Applications []ApplicationID
+}
+
+// Validate ensures the target names a connector and has a valid mapping of its kind
+func (t ITSMSyncTarget) Validate() error {
+	if err := t.Kind.Validate(); err != nil {
+		return err
+	}
+	if t.Connector == "" {
+		return fmt.Errorf("%s sync target must name its connector", t.Kind)
+	}
+	if t.Mapping.Kind != t.Kind {
+		return fmt.Errorf("%s sync target has a %s field mapping", t.Kind, t.Mapping.Kind)
+	}
+	return t.Mapping.Validate()
+}
+
+// Covers reports whether the target mirrors the records of the application
+func (t ITSMSyncTarget) Covers(appID ApplicationID) bool {
+	return len(t.Applications) == 0 || containsValue(t.Applications, appID)
+}
+
+// ITSMLink links a change request or incident to the record mirroring it in an ITSM tool
+type ITSMLink struct {
+	Kind          ITSMRecordKind
+	LocalID       string
+	ApplicationID ApplicationID
+	Connector     string
+	Reference     ITSMReference
+	PushedAt      time.Time     // when the record was last changed locally as of its last push
+	RemoteStatus  string        // the local status the tool last reported or was last sent
+	Comments      []ITSMComment // oldest first
+	LastSyncedAt  time.Time
+	LastError     string // why the last sync failed or conflicted, empty when it succeeded
+}
+
+// ErrITSMLinkNotFound is returned when a record is not mirrored to an ITSM tool
+var ErrITSMLinkNotFound = errors.New("ITSM link not found")
+
+// PendingComments returns the indexes of the local comments not yet pushed to the ITSM tool
+func (l ITSMLink) PendingComments() []int {
+	var pending []int
+	for i, comment := range l.Comments {
+		if comment.Origin == ITSMCommentLocal && comment.PushedAt.IsZero() {
+			pending = append(pending, i)
+		}
+	}
+	return pending
+}
+
+// MergeRemoteComments appends the tool's comments not seen before, skipping those pushed from
+// here, and returns how many were added. Comments are matched by ID, or by author and body when
+// the tool returned no ID for them.
+func (l *ITSMLink) MergeRemoteComments(comments []ITSMComment) int {
+	added := 0
+	for _, comment := range comments {
+		if l.hasComment(comment) {
+			continue
+		}
+		comment.Origin = ITSMCommentRemote
+		l.Comments = append(l.Comments, comment)
+		added++
+	}
+	sort.SliceStable(l.Comments, func(i, j int) bool { return l.Comments[i].CreatedAt.Before(l.Comments[j].CreatedAt) })
+	return added
+}
+
+// hasComment reports whether the link already holds the tool's comment
+func (l ITSMLink) hasComment(comment ITSMComment) bool {
+	for _, known := range l.Comments {
+		if comment.ID != "" && known.ID == comment.ID {
+			return true
+		}
+		if known.Origin == ITSMCommentLocal && strings.TrimSpace(known.Body) == strings.TrimSpace(comment.Body) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,233 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// KPIAnomalyMethod is the statistic that flagged a KPI measurement as anomalous
+type KPIAnomalyMethod string
+
+const (
+	AnomalyZScore KPIAnomalyMethod = "zscore" // distance from the mean of the preceding window
+	AnomalyEWMA   KPIAnomalyMethod = "ewma"   // distance from the exponentially weighted moving average
+)
+
+// AnomalyDetection configures how unusual a KPI measurement must be, compared with the
+// measurements before it, to be flagged as an anomaly
+type AnomalyDetection struct {
+	Window     int     // preceding measurements the z-score is computed over
+	MinSamples int     // preceding measurements needed before a measurement is tested
+	ZScore     float64 // standard deviations from the window mean that are anomalous
+	Alpha      float64 // EWMA smoothing factor; higher values follow recent measurements more closely
+	EWMALimit  float64 // standard deviations from the EWMA forecast that are anomalous
+}
+
+// DefaultAnomalyDetection flags measurements three standard deviations from the mean of the
+// twelve before them or from their EWMA forecast, once five measurements have been taken
+func DefaultAnomalyDetection() AnomalyDetection {
+	return AnomalyDetection{
+		Window:     12,
+		MinSamples: 5,
+		ZScore:     3,
+		Alpha:      0.3,
+		EWMALimit:  3,
+	}
+}
+
+// Validate ensures the detection settings are usable
+func (d AnomalyDetection) Validate() error {
+	if d.MinSamples < 2 {
+		return errors.New("anomaly detection needs at least 2 preceding measurements")
+	}
+	if d.Window < d.MinSamples {
+		return errors.New("anomaly detection window must hold at least the minimum samples")
+	}
+	if d.ZScore <= 0 || d.EWMALimit <= 0 {
+		return errors.New("anomaly detection limits must be positive")
+	}
+	if d.Alpha <= 0 || d.Alpha >= 1 {
+		return errors.New("anomaly detection EWMA alpha must be between 0 and 1")
+	}
+	return nil
+}
+
+// KPIAnomaly is a KPI measurement that deviates unusually from the measurements before it,
+// whether or not it missed its target
+type KPIAnomaly struct {
+	KPIID      string
+	MeasuredAt time.Time
+	Value      float64
+	Target     float64
+	Expected   float64            // window mean, or the EWMA forecast when only the EWMA flagged it
+	Deviation  float64            // signed standard deviations from Expected
+	Methods    []KPIAnomalyMethod // statistics that flagged the measurement
+	Adverse    bool               // the deviation moves the KPI away from meeting its target
+	Breached   bool               // the measurement missed its target
+}
+
+// EarlyWarning reports whether the anomaly is an adverse deviation of a measurement that still
+// meets its target
+func (a KPIAnomaly) EarlyWarning() bool {
+	return a.Adverse && !a.Breached
+}
+
+// Describe summarizes the anomaly, e.g. "42.0 is 3.4σ below the expected 55.0 (zscore, ewma)"
+func (a KPIAnomaly) Describe() string {
+	direction := "above"
+	if a.Deviation < 0 {
+		direction = "below"
+	}
+	methods := make([]string, len(a.Methods))
+	for i, method := range a.Methods {
+		methods[i] = string(method)
+	}
+	return fmt.Sprintf("%.1f is %.1fσ %s the expected %.1f (%s)", a.Value, math.Abs(a.Deviation), direction, a.Expected, strings.Join(methods, ", "))
+}
+
+// DetectKPIAnomalies tests every measurement of the KPI against the measurements before it, by
+// z-score over the preceding window and by deviation from the EWMA forecast, and returns the
+// anomalous ones oldest first. Standard deviations are floored at 1% of the target or expected
+// value, so a flat series is not flagged for a negligible change. Targets are met within
+// tolerance percent.
+func DetectKPIAnomalies(kpi KPI, measurements []KPIMeasurement, detection AnomalyDetection, tolerance float64) ([]KPIAnomaly, error) {
+	if err := detection.Validate(); err != nil {
+		return nil, err
+	}
+
+	series := append([]KPIMeasurement{}, measurements...)
+	sort.SliceStable(series, func(i, j int) bool { return series[i].MeasuredAt.Before(series[j].MeasuredAt) })
+	higherIsBetter := !strings.EqualFold(kpi.Category, "efficiency")
+
+	anomalies := []KPIAnomaly{}
+	var ewma, variance float64
+	for i, measurement := range series {
+		if i == 0 {
+			ewma = measurement.Value
+			continue
+		}
+
+		target := measurement.Target
+		if target == 0 {
+			target = kpi.Target
+		}
+		anomaly := KPIAnomaly{
+			KPIID:      measurement.KPIID,
+			MeasuredAt: measurement.MeasuredAt,
+			Value:      measurement.Value,
+			Target:     target,
+			Methods:    []KPIAnomalyMethod{},
+		}
+
+		if i >= detection.MinSamples {
+			window := series[max(0, i-detection.Window):i]
+			mean, stdDev := windowStats(window)
+			if deviation, ok := standardDeviations(measurement.Value, mean, stdDev, target); ok && math.Abs(deviation) >= detection.ZScore {
+				anomaly.Expected, anomaly.Deviation = mean, deviation
+				anomaly.Methods = append(anomaly.Methods, AnomalyZScore)
+			}
+			if deviation, ok := standardDeviations(measurement.Value, ewma, math.Sqrt(variance), target); ok && math.Abs(deviation) >= detection.EWMALimit {
+				if len(anomaly.Methods) == 0 {
+					anomaly.Expected, anomaly.Deviation = ewma, deviation
+				}
+				anomaly.Methods = append(anomaly.Methods, AnomalyEWMA)
+			}
+		}
+
+		residual := measurement.Value - ewma
+		ewma += detection.Alpha * residual
+		variance = (1 - detection.Alpha) * (variance + detection.Alpha*residual*residual)
+
+		if len(anomaly.Methods) == 0 {
+			continue
+		}
+		defined := kpi
+		defined.Target = target
+		anomaly.Adverse = (anomaly.Deviation > 0) != higherIsBetter
+		anomaly.Breached = !defined.TargetAchieved(measurement.Value, tolerance)
+		anomalies = append(anomalies, anomaly)
+	}
+	return anomalies, nil
+}
+
+// windowStats returns the mean and sample standard deviation of the measurement values
+func windowStats(window []KPIMeasurement) (float64, float64) {
+	var sum float64
+	for _, measurement := range window {
+		sum += measurement.Value
+	}
+	mean := sum / float64(len(window))
+
+	var squares float64
+	for _, measurement := range window {
+		squares += (measurement.Value - mean) * (measurement.Value - mean)
+	}
+	return mean, math.Sqrt(squares / float64(len(window)-1))
+}
+
+// standardDeviations returns how many standard deviations value is from expected, flooring the
+// standard deviation at 1% of the target or expected value. It is false when both are zero.
+func standardDeviations(value, expected, stdDev, target float64) (float64, bool) {
+	stdDev = math.Max(stdDev, 0.01*math.Max(math.Abs(target), math.Abs(expected)))
+	if stdDev == 0 {
+		return 0, false
+	}
+	return (value - expected) / stdDev, true
+}
+
+// WithAnomalyDetection replaces the default settings used to flag anomalous KPI measurements
+func WithAnomalyDetection(detection AnomalyDetection) MonitoringOption {
+	return func(s *MonitoringService) {
+		s.anomalyDetection = detection
+	}
+}
+
+// MonitorKPIAnomalies returns the anomalous measurements of the agreement's KPIs taken since the
+// agreement was last monitored or, when it never was, the anomalous latest measurement of each
+// KPI. It needs the KPI measurement repository.
+func (s *MonitoringService) MonitorKPIAnomalies(ctx context.Context, agreementID GovernanceAgreementID) ([]KPIAnomaly, error) {
+	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+	if s.measurementRepo == nil {
+		return []KPIAnomaly{}, nil
+	}
+
+	kpis, err := s.agreementKPIs(ctx, agreement)
+	if err != nil {
+		return nil, err
+	}
+	tolerance := portfolioThresholdsFor(ctx, s.portfolioRepo, agreement.ApplicationID).kpiTolerance()
+	since := agreement.Monitor.LastMonitored
+
+	anomalies := []KPIAnomaly{}
+	for _, kpi := range kpis {
+		measurements, err := s.measurementRepo.FindByKPIID(ctx, kpi.ID)
+		if err != nil || len(measurements) == 0 {
+			continue
+		}
+		detected, err := DetectKPIAnomalies(kpi, measurements, s.anomalyDetection, tolerance)
+		if err != nil {
+			return nil, err
+		}
+
+		latest := measurements[0].MeasuredAt
+		for _, measurement := range measurements {
+			if measurement.MeasuredAt.After(latest) {
+				latest = measurement.MeasuredAt
+			}
+		}
+		for _, anomaly := range detected {
+			if (since.IsZero() && anomaly.MeasuredAt.Equal(latest)) || (!since.IsZero() && anomaly.MeasuredAt.After(since)) {
+				anomalies = append(anomalies, anomaly)
+			}
+		}
+	}
+	return anomalies, nil
+}
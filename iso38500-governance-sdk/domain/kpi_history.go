@@ -0,0 +1,202 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// KPIResolution is the period a KPI history is downsampled to
+type KPIResolution string
+
+const (
+	KPIResolutionRaw     KPIResolution = "raw" // every measurement is its own point
+	KPIResolutionDay     KPIResolution = "day"
+	KPIResolutionWeek    KPIResolution = "week" // weeks start on Monday
+	KPIResolutionMonth   KPIResolution = "month"
+	KPIResolutionQuarter KPIResolution = "quarter"
+	KPIResolutionYear    KPIResolution = "year"
+)
+
+// Validate ensures the resolution is known
+func (r KPIResolution) Validate() error {
+	switch r {
+	case KPIResolutionRaw, KPIResolutionDay, KPIResolutionWeek, KPIResolutionMonth, KPIResolutionQuarter, KPIResolutionYear:
+		return nil
+	}
+	return fmt.Errorf("unknown KPI resolution %q", r)
+}
+
+// periodStart returns the start of the period t falls in, in UTC
+func (r KPIResolution) periodStart(t time.Time) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	switch r {
+	case KPIResolutionDay:
+		return day
+	case KPIResolutionWeek:
+		return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	case KPIResolutionMonth:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	case KPIResolutionQuarter:
+		return time.Date(t.Year(), t.Month()-(t.Month()-1)%3, 1, 0, 0, 0, 0, time.UTC)
+	case KPIResolutionYear:
+		return time.Date(t.Year(), 1, 1, 0, 0, 0, 0, time.UTC)
+	}
+	return t
+}
+
+// periodEnd returns the start of the period after the one beginning at start
+func (r KPIResolution) periodEnd(start time.Time) time.Time {
+	switch r {
+	case KPIResolutionDay:
+		return start.AddDate(0, 0, 1)
+	case KPIResolutionWeek:
+		return start.AddDate(0, 0, 7)
+	case KPIResolutionMonth:
+		return start.AddDate(0, 1, 0)
+	case KPIResolutionQuarter:
+		return start.AddDate(0, 3, 0)
+	case KPIResolutionYear:
+		return start.AddDate(1, 0, 0)
+	}
+	return start
+}
+
+// KPIHistoryPoint aggregates the measurements of a KPI taken in one period. A raw point is a
+// single measurement, with an empty period.
+type KPIHistoryPoint struct {
+	PeriodStart time.Time
+	PeriodEnd   time.Time // exclusive
+	Count       int
+	Min         float64
+	Max         float64
+	Avg         float64
+	Last        float64 // the latest measurement of the period
+	Achieved    int     // measurements that achieved their target
+}
+
+// KPIHistory is a KPI's measurements over a time range as a series of points, for charting and
+// trend analysis
+type KPIHistory struct {
+	KPIID       string
+	Name        string
+	Unit        string
+	Target      float64
+	From        time.Time
+	To          time.Time
+	Resolution  KPIResolution
+	Points      []KPIHistoryPoint // oldest first
+	Count       int
+	Min         float64
+	Max         float64
+	Avg         float64
+	Direction   TrendDirection // direction of the period averages, allowing for lower-is-better KPIs
+	SlopePerDay float64        // least-squares slope of the period averages
+}
+
+// BuildKPIHistory downsamples the KPI's measurements taken between from and to, inclusive, into
+// points of the given resolution. Measurements are expected oldest first.
+func BuildKPIHistory(kpi KPI, measurements []KPIMeasurement, from, to time.Time, resolution KPIResolution) (KPIHistory, error) {
+	if resolution == "" {
+		resolution = KPIResolutionRaw
+	}
+	if err := resolution.Validate(); err != nil {
+		return KPIHistory{}, err
+	}
+	if !from.IsZero() && !to.IsZero() && from.After(to) {
+		return KPIHistory{}, errors.New("KPI history range must not end before it starts")
+	}
+
+	history := KPIHistory{
+		KPIID:      kpi.ID,
+		Name:       kpi.Name,
+		Unit:       kpi.Unit,
+		Target:     kpi.Target,
+		From:       from,
+		To:         to,
+		Resolution: resolution,
+		Points:     []KPIHistoryPoint{},
+		Direction:  TrendInsufficientData,
+	}
+
+	sum := 0.0
+	for _, measurement := range measurements {
+		if (!from.IsZero() && measurement.MeasuredAt.Before(from)) || (!to.IsZero() && measurement.MeasuredAt.After(to)) {
+			continue
+		}
+
+		start := resolution.periodStart(measurement.MeasuredAt)
+		last := len(history.Points) - 1
+		if resolution == KPIResolutionRaw || last < 0 || !history.Points[last].PeriodStart.Equal(start) {
+			point := KPIHistoryPoint{Min: measurement.Value, Max: measurement.Value}
+			if resolution != KPIResolutionRaw {
+				point.PeriodStart = start
+				point.PeriodEnd = resolution.periodEnd(start)
+			} else {
+				point.PeriodStart = measurement.MeasuredAt
+				point.PeriodEnd = measurement.MeasuredAt
+			}
+			history.Points = append(history.Points, point)
+			last++
+		}
+
+		point := &history.Points[last]
+		point.Avg = (point.Avg*float64(point.Count) + measurement.Value) / float64(point.Count+1)
+		point.Count++
+		point.Min = math.Min(point.Min, measurement.Value)
+		point.Max = math.Max(point.Max, measurement.Value)
+		point.Last = measurement.Value
+		if measurement.Achieved {
+			point.Achieved++
+		}
+
+		if history.Count == 0 {
+			history.Min, history.Max = measurement.Value, measurement.Value
+		}
+		history.Count++
+		history.Min = math.Min(history.Min, measurement.Value)
+		history.Max = math.Max(history.Max, measurement.Value)
+		sum += measurement.Value
+	}
+	if history.Count > 0 {
+		history.Avg = sum / float64(history.Count)
+	}
+
+	history.Direction, history.SlopePerDay = kpiTrend(kpi, history.Points)
+	return history, nil
+}
+
+// kpiTrend fits a least-squares line through the point averages. Changes smaller than 5% of the
+// target, or of the largest value without one, are stable.
+func kpiTrend(kpi KPI, points []KPIHistoryPoint) (TrendDirection, float64) {
+	if len(points) < 2 {
+		return TrendInsufficientData, 0
+	}
+
+	origin := points[0].PeriodStart
+	xs := make([]float64, len(points))
+	ys := make([]float64, len(points))
+	scale := math.Abs(kpi.Target)
+	for i, point := range points {
+		xs[i] = point.PeriodStart.Sub(origin).Hours() / 24
+		ys[i] = point.Avg
+		if kpi.Target == 0 {
+			scale = math.Max(scale, math.Abs(point.Avg))
+		}
+	}
+
+	slope, _ := linearFit(xs, ys)
+	fittedChange := slope * (xs[len(xs)-1] - xs[0])
+	higherIsBetter := strings.ToLower(kpi.Category) != "efficiency"
+	switch {
+	case math.Abs(fittedChange) <= 0.05*scale:
+		return TrendStable, slope
+	case (fittedChange > 0) == higherIsBetter:
+		return TrendImproving, slope
+	default:
+		return TrendDegrading, slope
+	}
+}
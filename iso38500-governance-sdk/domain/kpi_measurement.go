@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// TargetAchieved reports whether a value achieves the KPI's target, counting values that miss it
+// by no more than tolerance percent as achieved. Lower is better for efficiency KPIs, higher for
+// every other category.
+func (k KPI) TargetAchieved(value, tolerance float64) bool {
+	if withinKPITolerance(value, k.Target, tolerance) {
+		return true
+	}
+	switch strings.ToLower(k.Category) {
+	case "performance":
+		return value >= k.Target
+	case "efficiency":
+		return value <= k.Target // Lower is better for efficiency
+	default:
+		return value >= k.Target
+	}
+}
+
+// Validate ensures the measurement names its KPI, has a usable value and is dated
+func (m KPIMeasurement) Validate() error {
+	if m.KPIID == "" {
+		return errors.New("KPI ID cannot be empty")
+	}
+	if math.IsNaN(m.Value) || math.IsInf(m.Value, 0) {
+		return fmt.Errorf("KPI %s: measured value must be a finite number", m.KPIID)
+	}
+	if m.MeasuredAt.IsZero() {
+		return fmt.Errorf("KPI %s: measurement time cannot be empty", m.KPIID)
+	}
+	return nil
+}
+
+// agreementKPIs returns the KPIs linked to the agreement's objectives, defined as the KPI
+// repository holds them when it does, or every KPI in the repository when none are linked
+func (s *MonitoringService) agreementKPIs(ctx context.Context, agreement GovernanceAgreement) ([]KPI, error) {
+	var kpis []KPI
+	seen := make(map[string]bool)
+	for _, objective := range agreement.Direct.StrategicDirection.Objectives {
+		for _, kpi := range objective.KPIs {
+			if seen[kpi.ID] {
+				continue
+			}
+			seen[kpi.ID] = true
+			if s.kpiRepo != nil {
+				if defined, err := s.kpiRepo.FindByID(ctx, kpi.ID); err == nil {
+					kpi = defined
+				}
+			}
+			kpis = append(kpis, kpi)
+		}
+	}
+	if len(kpis) > 0 || s.kpiRepo == nil {
+		return kpis, nil
+	}
+
+	kpis, err := s.kpiRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find KPIs: %w", err)
+	}
+	return kpis, nil
+}
+
+// latestMeasurement returns the latest measurement of a KPI from the measurement repository or,
+// failing that, the agreement's evaluation
+func (s *MonitoringService) latestMeasurement(ctx context.Context, agreement GovernanceAgreement, kpiID string) (KPIMeasurement, bool) {
+	if s.measurementRepo != nil {
+		if measurement, err := s.measurementRepo.FindLatest(ctx, kpiID); err == nil {
+			return measurement, true
+		}
+	}
+
+	var latest KPIMeasurement
+	found := false
+	for _, measurement := range agreement.Evaluate.PerformanceMetrics {
+		if measurement.KPIID == kpiID && (!found || measurement.MeasuredAt.After(latest.MeasuredAt)) {
+			latest = measurement
+			found = true
+		}
+	}
+	return latest, found
+}
@@ -0,0 +1,255 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Weight returns how much an application of the priority counts in a portfolio roll-up: 4 for
+// critical, 3 for high, 2 for medium, 1 for low and 0 for an unknown priority
+func (p Priority) Weight() float64 {
+	switch p {
+	case PriorityCritical:
+		return 4
+	case PriorityHigh:
+		return 3
+	case PriorityMedium:
+		return 2
+	case PriorityLow:
+		return 1
+	}
+	return 0
+}
+
+// ApplicationCriticality returns the application's criticality or, when it has none, the highest
+// priority of the functionality it provides. Applications with neither are of medium criticality.
+func ApplicationCriticality(app Application, functionality []Functionality) Priority {
+	if app.Criticality.Weight() > 0 {
+		return app.Criticality
+	}
+	if len(app.Catalogue.Functionality) > 0 {
+		functionality = app.Catalogue.Functionality
+	}
+
+	criticality := Priority("")
+	for _, function := range functionality {
+		if function.Priority.Weight() > criticality.Weight() {
+			criticality = function.Priority
+		}
+	}
+	if criticality == "" {
+		return PriorityMedium
+	}
+	return criticality
+}
+
+// ApplicationKPIScore is the KPI attainment of one application of a portfolio
+type ApplicationKPIScore struct {
+	ApplicationID ApplicationID
+	AgreementID   GovernanceAgreementID
+	Criticality   Priority
+	Weight        float64
+	Achieved      int
+	Total         int
+	Attainment    float64 // share of the application's KPIs on target, 0-1
+}
+
+// PortfolioKPIRollup is the attainment of one KPI across the portfolio's applications measuring it
+type PortfolioKPIRollup struct {
+	KPIID        string
+	Applications []ApplicationID // applications measuring the KPI
+	Achieved     int             // applications on target
+	Attainment   float64         // share of applications on target weighted by criticality, 0-1
+}
+
+// PortfolioKPIScoreboard rolls up the KPI attainment of a portfolio's governed applications,
+// weighting each application by its criticality
+type PortfolioKPIScoreboard struct {
+	PortfolioID  PortfolioID
+	Name         string
+	Applications []ApplicationKPIScore // most critical first
+	KPIs         []PortfolioKPIRollup  // by KPI ID
+	Achieved     int
+	Total        int
+	Attainment   float64         // average application attainment weighted by criticality, 0-1
+	Unmeasured   []ApplicationID // applications without an agreement or KPI measurements
+	CalculatedAt time.Time
+}
+
+// BuildPortfolioKPIScoreboard rolls up the latest measurement of each KPI of the agreements
+// governing the portfolio's applications. A measurement missing its target by no more than the
+// tolerance, in percent of the target, counts as on target. Retired applications are left out.
+func BuildPortfolioKPIScoreboard(portfolio ApplicationPortfolio, apps []Application, agreements []GovernanceAgreement, measurements map[GovernanceAgreementID][]KPIMeasurement, tolerance float64, at time.Time) PortfolioKPIScoreboard {
+	scoreboard := PortfolioKPIScoreboard{
+		PortfolioID:  portfolio.ID,
+		Name:         portfolio.Name,
+		Applications: []ApplicationKPIScore{},
+		KPIs:         []PortfolioKPIRollup{},
+		Unmeasured:   []ApplicationID{},
+		CalculatedAt: at,
+	}
+
+	governing := make(map[ApplicationID]GovernanceAgreement, len(agreements))
+	for _, agreement := range agreements {
+		governing[agreement.ApplicationID] = agreement
+	}
+
+	rollups := make(map[string]*PortfolioKPIRollup)
+	rollupWeights := make(map[string][2]float64) // weight on target, total weight
+	totalWeight := 0.0
+	for _, app := range apps {
+		if app.Status == StatusRetired {
+			continue
+		}
+		agreement, ok := governing[app.ID]
+		if !ok {
+			scoreboard.Unmeasured = append(scoreboard.Unmeasured, app.ID)
+			continue
+		}
+		latest := latestKPIMeasurements(measurements[agreement.ID])
+		if len(latest) == 0 {
+			scoreboard.Unmeasured = append(scoreboard.Unmeasured, app.ID)
+			continue
+		}
+
+		criticality := ApplicationCriticality(app, agreement.Strategy.ApplicationCatalogue.Functionality)
+		score := ApplicationKPIScore{
+			ApplicationID: app.ID,
+			AgreementID:   agreement.ID,
+			Criticality:   criticality,
+			Weight:        criticality.Weight(),
+		}
+		for _, measurement := range latest {
+			onTarget := measurement.Achieved || withinKPITolerance(measurement.Value, measurement.Target, tolerance)
+			score.Total++
+
+			rollup, ok := rollups[measurement.KPIID]
+			if !ok {
+				rollup = &PortfolioKPIRollup{KPIID: measurement.KPIID}
+				rollups[measurement.KPIID] = rollup
+			}
+			rollup.Applications = append(rollup.Applications, app.ID)
+			weights := rollupWeights[measurement.KPIID]
+			weights[1] += score.Weight
+			if onTarget {
+				score.Achieved++
+				rollup.Achieved++
+				weights[0] += score.Weight
+			}
+			rollupWeights[measurement.KPIID] = weights
+		}
+		score.Attainment = float64(score.Achieved) / float64(score.Total)
+
+		scoreboard.Applications = append(scoreboard.Applications, score)
+		scoreboard.Achieved += score.Achieved
+		scoreboard.Total += score.Total
+		scoreboard.Attainment += score.Attainment * score.Weight
+		totalWeight += score.Weight
+	}
+	if totalWeight > 0 {
+		scoreboard.Attainment /= totalWeight
+	}
+
+	sort.SliceStable(scoreboard.Applications, func(i, j int) bool {
+		if scoreboard.Applications[i].Weight != scoreboard.Applications[j].Weight {
+			return scoreboard.Applications[i].Weight > scoreboard.Applications[j].Weight
+		}
+		return scoreboard.Applications[i].ApplicationID < scoreboard.Applications[j].ApplicationID
+	})
+	for kpiID, rollup := range rollups {
+		weights := rollupWeights[kpiID]
+		rollup.Attainment = weights[0] / weights[1]
+		scoreboard.KPIs = append(scoreboard.KPIs, *rollup)
+	}
+	sort.Slice(scoreboard.KPIs, func(i, j int) bool { return scoreboard.KPIs[i].KPIID < scoreboard.KPIs[j].KPIID })
+	return scoreboard
+}
+
+// latestKPIMeasurements returns the latest measurement of each KPI, by KPI ID
+func latestKPIMeasurements(measurements []KPIMeasurement) []KPIMeasurement {
+	latest := make(map[string]KPIMeasurement)
+	for _, measurement := range measurements {
+		if current, ok := latest[measurement.KPIID]; !ok || measurement.MeasuredAt.After(current.MeasuredAt) {
+			latest[measurement.KPIID] = measurement
+		}
+	}
+
+	result := make([]KPIMeasurement, 0, len(latest))
+	for _, measurement := range latest {
+		result = append(result, measurement)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].KPIID < result[j].KPIID })
+	return result
+}
+
+// MonitorPortfolioKPIScoreboard rolls up the monitored KPIs of a portfolio's applications. It
+// needs the portfolio repository of WithPortfolioThresholds.
+func (s *MonitoringService) MonitorPortfolioKPIScoreboard(ctx context.Context, portfolioID PortfolioID) (*PortfolioKPIScoreboard, error) {
+	if s.portfolioRepo == nil {
+		return nil, errors.New("monitoring service has no portfolio repository")
+	}
+	portfolio, err := s.portfolioRepo.FindByID(ctx, portfolioID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find portfolio: %w", err)
+	}
+
+	scoreboard, err := s.kpiScoreboard(ctx, portfolio)
+	if err != nil {
+		return nil, err
+	}
+	return &scoreboard, nil
+}
+
+// MonitorPortfolioKPIs rolls up the monitored KPIs of every portfolio holding the agreement's
+// application, by portfolio ID. Without the portfolio repository of WithPortfolioThresholds there
+// are no scoreboards.
+func (s *MonitoringService) MonitorPortfolioKPIs(ctx context.Context, agreementID GovernanceAgreementID) ([]PortfolioKPIScoreboard, error) {
+	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+	scoreboards := []PortfolioKPIScoreboard{}
+	if s.portfolioRepo == nil {
+		return scoreboards, nil
+	}
+	portfolios, err := s.portfolioRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find portfolios: %w", err)
+	}
+	sort.Slice(portfolios, func(i, j int) bool { return portfolios[i].ID < portfolios[j].ID })
+
+	for _, portfolio := range portfolios {
+		held := false
+		for _, app := range portfolio.Applications {
+			held = held || app.ID == agreement.ApplicationID
+		}
+		if !held {
+			continue
+		}
+
+		scoreboard, err := s.kpiScoreboard(ctx, portfolio)
+		if err != nil {
+			return nil, err
+		}
+		scoreboards = append(scoreboards, scoreboard)
+	}
+	return scoreboards, nil
+}
+
+// kpiScoreboard rolls up the monitored KPIs of the agreements governing the portfolio's
+// applications
+func (s *MonitoringService) kpiScoreboard(ctx context.Context, portfolio ApplicationPortfolio) (PortfolioKPIScoreboard, error) {
+	agreements := s.portfolioAgreements(ctx, portfolio)
+	measurements := make(map[GovernanceAgreementID][]KPIMeasurement, len(agreements))
+	for _, agreement := range agreements {
+		monitored, err := s.MonitorKPIs(ctx, agreement.ID)
+		if err != nil {
+			return PortfolioKPIScoreboard{}, err
+		}
+		measurements[agreement.ID] = monitored
+	}
+	return BuildPortfolioKPIScoreboard(portfolio, portfolio.Applications, agreements, measurements, portfolio.Thresholds.kpiTolerance(), time.Now()), nil
+}
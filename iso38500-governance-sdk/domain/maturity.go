@@ -0,0 +1,453 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Maturity levels follow the CMMI/COBIT staged scale
+const (
+	MaturityInitial      = 1
+	MaturityManaged      = 2
+	MaturityDefined      = 3
+	MaturityQuantitative = 4
+	MaturityOptimizing   = 5
+)
+
+// MaturityLevelName returns the descriptive name of a 1-5 maturity level
+func MaturityLevelName(level int) string {
+	switch level {
+	case MaturityInitial:
+		return "Initial"
+	case MaturityManaged:
+		return "Managed"
+	case MaturityDefined:
+		return "Defined"
+	case MaturityQuantitative:
+		return "Quantitatively Managed"
+	case MaturityOptimizing:
+		return "Optimizing"
+	default:
+		return "Unknown"
+	}
+}
+
+// PrincipleMaturity is the maturity of one ISO 38500 principle within an agreement
+type PrincipleMaturity struct {
+	Principle  string // Evaluate, Direct or Monitor
+	Level      int    // 1-5 scale
+	Strengths  []string
+	Weaknesses []string
+}
+
+// MaturityOptions configures the cadence expectations used when grading maturity
+type MaturityOptions struct {
+	EvaluationInterval time.Duration // evaluations older than this are stale
+	DirectionInterval  time.Duration // strategic direction older than this is stale
+	MonitoringInterval time.Duration // monitoring older than this is stale
+	MaxKPIFrequency    time.Duration // KPIs must be reviewed at least this often
+	AuditInterval      time.Duration // a completed audit is expected within this interval
+}
+
+// DefaultMaturityOptions expects quarterly evaluation, yearly direction, monthly monitoring
+// and KPI review, and a yearly audit
+func DefaultMaturityOptions() MaturityOptions {
+	return MaturityOptions{
+		EvaluationInterval: 90 * 24 * time.Hour,
+		DirectionInterval:  365 * 24 * time.Hour,
+		MonitoringInterval: 30 * 24 * time.Hour,
+		MaxKPIFrequency:    31 * 24 * time.Hour,
+		AuditInterval:      365 * 24 * time.Hour,
+	}
+}
+
+// maturityCriterion is one practice that must be in place for a principle to reach a level
+type maturityCriterion struct {
+	level    int
+	met      bool
+	strength string
+	weakness string
+}
+
+// MaturityService grades governance maturity from an agreement's completeness, monitoring
+// cadence, policy coverage and audit history
+type MaturityService struct {
+	agreementRepo GovernanceAgreementRepository
+	auditRepo     AuditRepository
+	options       MaturityOptions
+}
+
+// NewMaturityService creates a new maturity service. auditRepo may be nil, in which case
+// audit history is taken from the agreement's audit requirements only.
+func NewMaturityService(agreementRepo GovernanceAgreementRepository, auditRepo AuditRepository) *MaturityService {
+	return &MaturityService{
+		agreementRepo: agreementRepo,
+		auditRepo:     auditRepo,
+		options:       DefaultMaturityOptions(),
+	}
+}
+
+// SetOptions replaces the cadence expectations
+func (s *MaturityService) SetOptions(options MaturityOptions) {
+	s.options = options
+}
+
+// AssessAgreement grades each principle of an agreement and records the result on the
+// agreement's current situation assessment
+func (s *MaturityService) AssessAgreement(ctx context.Context, agreementID GovernanceAgreementID) (*GovernanceMaturityAssessment, error) {
+	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find agreement: %w", err)
+	}
+
+	var audits []Audit
+	if s.auditRepo != nil {
+		audits, err = s.auditRepo.FindByApplicationID(ctx, agreement.ApplicationID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load audit history: %w", err)
+		}
+	}
+
+	assessment := s.Assess(agreement, audits, time.Now())
+	agreement.Evaluate.CurrentSituation.GovernanceMaturity = *assessment
+	agreement.UpdatedAt = time.Now()
+	if err := s.agreementRepo.Update(ctx, agreement); err != nil {
+		return nil, fmt.Errorf("failed to record maturity assessment: %w", err)
+	}
+	return assessment, nil
+}
+
+// Assess grades an agreement as of the given time. The overall level is that of the least
+// mature principle, as in a staged maturity model.
+func (s *MaturityService) Assess(agreement GovernanceAgreement, audits []Audit, now time.Time) *GovernanceMaturityAssessment {
+	assessment := &GovernanceMaturityAssessment{
+		MaturityLevel: MaturityOptimizing,
+		AssessedAt:    now,
+	}
+
+	principles := map[string][]maturityCriterion{
+		"Evaluate": s.evaluateCriteria(agreement, now),
+		"Direct":   s.directCriteria(agreement, now),
+		"Monitor":  s.monitorCriteria(agreement, audits, now),
+	}
+	for _, name := range []string{"Evaluate", "Direct", "Monitor"} {
+		principle, nextSteps := gradePrinciple(name, principles[name])
+		assessment.Principles = append(assessment.Principles, principle)
+		assessment.ImprovementAreas = append(assessment.ImprovementAreas, nextSteps...)
+
+		for _, strength := range principle.Strengths {
+			assessment.Strengths = append(assessment.Strengths, name+": "+strength)
+		}
+		for _, weakness := range principle.Weaknesses {
+			assessment.Weaknesses = append(assessment.Weaknesses, name+": "+weakness)
+		}
+		if principle.Level < assessment.MaturityLevel {
+			assessment.MaturityLevel = principle.Level
+		}
+	}
+	return assessment
+}
+
+// gradePrinciple returns the highest level whose criteria, and those of every lower level,
+// are all met, along with the practices needed to reach the next level
+func gradePrinciple(name string, criteria []maturityCriterion) (PrincipleMaturity, []string) {
+	principle := PrincipleMaturity{Principle: name, Level: MaturityOptimizing}
+	for _, criterion := range criteria {
+		if criterion.met {
+			principle.Strengths = append(principle.Strengths, criterion.strength)
+			continue
+		}
+		principle.Weaknesses = append(principle.Weaknesses, criterion.weakness)
+		if criterion.level-1 < principle.Level {
+			principle.Level = criterion.level - 1
+		}
+	}
+
+	var nextSteps []string
+	if principle.Level < MaturityOptimizing {
+		next := principle.Level + 1
+		for _, criterion := range criteria {
+			if !criterion.met && criterion.level == next {
+				nextSteps = append(nextSteps, fmt.Sprintf("%s to level %d (%s): %s", name, next, MaturityLevelName(next), criterion.weakness))
+			}
+		}
+	}
+	return principle, nextSteps
+}
+
+func (s *MaturityService) evaluateCriteria(agreement GovernanceAgreement, now time.Time) []maturityCriterion {
+	completed := completedComponents(agreement)
+	evaluate := agreement.Evaluate
+	risks := evaluate.RiskAssessment
+
+	return []maturityCriterion{
+		{
+			level:    MaturityManaged,
+			met:      !evaluate.LastEvaluated.IsZero(),
+			strength: "application has been evaluated",
+			weakness: "no evaluation has been recorded",
+		},
+		{
+			level:    MaturityManaged,
+			met:      len(agreement.ResponsibilityMatrix.Entries) > 0,
+			strength: "governance responsibilities are assigned",
+			weakness: "responsibility matrix is empty",
+		},
+		{
+			level:    MaturityDefined,
+			met:      completed >= 4,
+			strength: fmt.Sprintf("agreement defines %d of 6 governance components", completed),
+			weakness: fmt.Sprintf("agreement defines only %d of 6 governance components", completed),
+		},
+		{
+			level:    MaturityDefined,
+			met:      len(risks.Risks) > 0 || risks.OverallRiskLevel != "",
+			strength: "risks are assessed",
+			weakness: "no risk assessment is documented",
+		},
+		{
+			level:    MaturityQuantitative,
+			met:      completed == 6,
+			strength: "agreement is complete",
+			weakness: "agreement is missing governance components",
+		},
+		{
+			level:    MaturityQuantitative,
+			met:      len(evaluate.PerformanceMetrics) > 0,
+			strength: "evaluation is backed by KPI measurements",
+			weakness: "evaluation is not backed by KPI measurements",
+		},
+		{
+			level:    MaturityOptimizing,
+			met:      within(evaluate.LastEvaluated, s.options.EvaluationInterval, now),
+			strength: "evaluation is current",
+			weakness: "evaluation is out of date",
+		},
+	}
+}
+
+func (s *MaturityService) directCriteria(agreement GovernanceAgreement, now time.Time) []maturityCriterion {
+	direct := agreement.Direct
+	framework := direct.PolicyFramework
+	covered := policyCoverage(framework)
+
+	return []maturityCriterion{
+		{
+			level:    MaturityManaged,
+			met:      len(direct.StrategicDirection.Objectives) > 0,
+			strength: "strategic objectives are set",
+			weakness: "no strategic objectives are set",
+		},
+		{
+			level:    MaturityManaged,
+			met:      len(framework.Policies) > 0,
+			strength: "governance policies are established",
+			weakness: "no governance policies are established",
+		},
+		{
+			level:    MaturityDefined,
+			met:      covered >= 3,
+			strength: fmt.Sprintf("policy framework covers %d of 4 areas", covered),
+			weakness: fmt.Sprintf("policy framework covers only %d of 4 areas", covered),
+		},
+		{
+			level:    MaturityDefined,
+			met:      len(direct.ResourceAllocation.BudgetAllocations) > 0 || len(direct.ResourceAllocation.PersonnelAllocations) > 0,
+			strength: "resources are allocated",
+			weakness: "no resources are allocated",
+		},
+		{
+			level:    MaturityQuantitative,
+			met:      covered == 4 && allPoliciesInForce(framework),
+			strength: "policy framework is complete and in force",
+			weakness: "policy framework is incomplete or has policies not yet in force",
+		},
+		{
+			level:    MaturityQuantitative,
+			met:      len(direct.ActionPlans) > 0,
+			strength: "objectives are tracked through action plans",
+			weakness: "objectives have no action plans",
+		},
+		{
+			level:    MaturityOptimizing,
+			met:      within(direct.LastDirected, s.options.DirectionInterval, now),
+			strength: "strategic direction is reviewed regularly",
+			weakness: "strategic direction has not been reviewed recently",
+		},
+	}
+}
+
+func (s *MaturityService) monitorCriteria(agreement GovernanceAgreement, audits []Audit, now time.Time) []maturityCriterion {
+	monitor := agreement.Monitor
+	kpis := monitor.PerformanceMonitoring.KPIMonitoring
+	lastAudit, overdue := auditHistory(agreement, audits)
+
+	return []maturityCriterion{
+		{
+			level:    MaturityManaged,
+			met:      len(kpis) > 0,
+			strength: "KPIs are monitored",
+			weakness: "no KPIs are monitored",
+		},
+		{
+			level:    MaturityManaged,
+			met:      monitor.ComplianceMonitoring.MonitoringFrequency != "" || agreement.Conformance.ComplianceMonitoring.MonitoringFrequency != "",
+			strength: "compliance monitoring has a defined frequency",
+			weakness: "compliance monitoring has no defined frequency",
+		},
+		{
+			level:    MaturityDefined,
+			met:      len(kpis) > 0 && kpiCadenceMet(kpis, s.options.MaxKPIFrequency),
+			strength: "KPIs are reviewed at least monthly",
+			weakness: "KPIs are not reviewed at least monthly",
+		},
+		{
+			level:    MaturityDefined,
+			met:      len(monitor.RiskMonitoring.RiskIndicators) > 0,
+			strength: "risk indicators are tracked",
+			weakness: "no risk indicators are tracked",
+		},
+		{
+			level:    MaturityQuantitative,
+			met:      !lastAudit.IsZero(),
+			strength: "governance has been audited",
+			weakness: "no completed audit is on record",
+		},
+		{
+			level:    MaturityQuantitative,
+			met:      len(kpis) > 0 && kpiThresholdsSet(kpis),
+			strength: "KPI thresholds trigger alerts",
+			weakness: "KPIs have no alert thresholds",
+		},
+		{
+			level:    MaturityOptimizing,
+			met:      overdue == 0 && within(lastAudit, s.options.AuditInterval, now) && within(monitor.LastMonitored, s.options.MonitoringInterval, now),
+			strength: "monitoring and audits are current",
+			weakness: "monitoring or audits are overdue",
+		},
+	}
+}
+
+// completedComponents counts the core governance components that have been filled in
+func completedComponents(agreement GovernanceAgreement) int {
+	components := []bool{
+		len(agreement.ResponsibilityMatrix.Entries) > 0,
+		agreement.Strategy.ICTOperationsManual.ApplicationArchitecture != "" || len(agreement.Strategy.ApplicationInterfaces) > 0,
+		len(agreement.Acquisition.RequirementsManagement.ApprovalWorkflow) > 0 || len(agreement.Acquisition.ChangeRequestProcess.Types) > 0,
+		len(agreement.Performance.SupportProcess.Level1Support) > 0 || len(agreement.Performance.IncidentManagement.ClassificationMatrix) > 0,
+		len(agreement.Conformance.LegalRequirements)+len(agreement.Conformance.ContractualRequirements)+len(agreement.Conformance.IndustryStandards) > 0,
+		len(agreement.Implementation.ImplementationProcess.Phases) > 0 || len(agreement.Implementation.ReleaseManagement.ReleaseTypes) > 0,
+	}
+
+	count := 0
+	for _, complete := range components {
+		if complete {
+			count++
+		}
+	}
+	return count
+}
+
+// policyCoverage counts how many of policies, standards, procedures and guidelines are defined
+func policyCoverage(framework PolicyFramework) int {
+	count := 0
+	for _, size := range []int{len(framework.Policies), len(framework.Standards), len(framework.Procedures), len(framework.Guidelines)} {
+		if size > 0 {
+			count++
+		}
+	}
+	return count
+}
+
+// allPoliciesInForce reports whether every policy that has not been retired is approved or
+// published, taking the version the agreement is pinned to for pinned policies
+func allPoliciesInForce(framework PolicyFramework) bool {
+	current := 0
+	for _, policy := range framework.Policies {
+		policy, _ = framework.EffectivePolicy(policy.ID)
+		if policy.Status == PolicyRetired {
+			continue
+		}
+		if policy.Status != PolicyApproved && policy.Status != PolicyPublished {
+			return false
+		}
+		current++
+	}
+	return current > 0
+}
+
+// kpiCadenceMet reports whether every monitored KPI is reviewed at least as often as maxInterval
+func kpiCadenceMet(kpis []KPIMonitoring, maxInterval time.Duration) bool {
+	for _, kpi := range kpis {
+		interval, ok := frequencyInterval(kpi.Frequency)
+		if !ok || interval > maxInterval {
+			return false
+		}
+	}
+	return true
+}
+
+func kpiThresholdsSet(kpis []KPIMonitoring) bool {
+	for _, kpi := range kpis {
+		if len(kpi.Thresholds) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// auditHistory returns the most recent completed audit and the number of overdue audits,
+// combining the audit repository with the audit requirements recorded on the agreement
+func auditHistory(agreement GovernanceAgreement, audits []Audit) (lastAudit time.Time, overdue int) {
+	for _, audit := range audits {
+		switch audit.Status {
+		case AuditStatusCompleted, AuditStatusClosed:
+			if audit.CompletedAt.After(lastAudit) {
+				lastAudit = audit.CompletedAt
+			}
+		case AuditStatusOverdue:
+			overdue++
+		}
+	}
+
+	requirements := append(append([]AuditRequirement{}, agreement.Conformance.ComplianceMonitoring.AuditRequirements...),
+		agreement.Monitor.ComplianceMonitoring.AuditRequirements...)
+	for _, requirement := range requirements {
+		if requirement.LastAudit.After(lastAudit) {
+			lastAudit = requirement.LastAudit
+		}
+	}
+	return lastAudit, overdue
+}
+
+// frequencyInterval maps a frequency description such as "daily" or "quarterly" to an interval
+func frequencyInterval(frequency string) (time.Duration, bool) {
+	day := 24 * time.Hour
+	switch strings.ToLower(strings.TrimSpace(frequency)) {
+	case "real-time", "realtime", "continuous", "hourly":
+		return time.Hour, true
+	case "daily":
+		return day, true
+	case "weekly":
+		return 7 * day, true
+	case "bi-weekly", "biweekly", "fortnightly":
+		return 14 * day, true
+	case "monthly":
+		return 31 * day, true
+	case "quarterly":
+		return 92 * day, true
+	case "semi-annually", "semiannually":
+		return 183 * day, true
+	case "annually", "yearly":
+		return 365 * day, true
+	default:
+		return 0, false
+	}
+}
+
+// within reports whether t is set and no older than interval at now
+func within(t time.Time, interval time.Duration, now time.Time) bool {
+	return !t.IsZero() && now.Sub(t) <= interval
+}
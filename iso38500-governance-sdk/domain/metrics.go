@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ApplicationMetrics are usage and satisfaction figures observed for an application, from
+// product analytics, monitoring and user surveys. Zero figures were not observed.
+type ApplicationMetrics struct {
+	ApplicationID     ApplicationID
+	ActiveUsers       int
+	TransactionVolume int
+	UptimePercentage  float64
+	UserSatisfaction  float64 // survey score, 0-100
+	SurveyResponses   int     // responses behind UserSatisfaction; zero when no survey was run
+	Source            string  // system the figures came from
+	ObservedAt        time.Time
+}
+
+// Validate ensures the metrics have valid data
+func (m ApplicationMetrics) Validate() error {
+	if m.ApplicationID == "" {
+		return errors.New("metrics application ID cannot be empty")
+	}
+	if m.ActiveUsers < 0 || m.TransactionVolume < 0 || m.SurveyResponses < 0 {
+		return errors.New("active users, transaction volume and survey responses must not be negative")
+	}
+	if m.UptimePercentage < 0 || m.UptimePercentage > 100 {
+		return errors.New("uptime percentage must be between 0 and 100")
+	}
+	if m.UserSatisfaction < 0 || m.UserSatisfaction > 100 {
+		return errors.New("user satisfaction must be between 0 and 100")
+	}
+	if m.UserSatisfaction > 0 && m.SurveyResponses == 0 {
+		return errors.New("user satisfaction must come with the number of survey responses")
+	}
+	return nil
+}
+
+// MetricsProvider supplies the observed metrics of an application. Evaluations use its figures
+// in place of the business value heuristics; an error means no figures are available.
+type MetricsProvider interface {
+	ApplicationMetrics(ctx context.Context, appID ApplicationID) (ApplicationMetrics, error)
+}
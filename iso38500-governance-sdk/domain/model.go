@@ -17,6 +17,7 @@ package domain
 
 import (
 	"errors"
+	"fmt"
 	"time"
 )
 
@@ -34,10 +35,13 @@ type Application struct {
 	ID          ApplicationID
 	Name        string
 	Description string
+	Category    string   // business category used for benchmarking, e.g. "Core Business"
+	Criticality Priority // business criticality, weighting the application in portfolio roll-ups
 	Version     string
 	Status      ApplicationStatus
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	Cost        ApplicationCost
 
 	// Governance related
 	GovernanceAgreementID GovernanceAgreementID
@@ -46,6 +50,7 @@ type Application struct {
 	ConfigurationStandard ConfigurationStandard
 	SecurityProvisions    SecurityProvisions
 	BusinessContinuity    BusinessContinuity
+	DataClassification    DataClassification // sensitivity of the data held, scoping privacy regulations
 }
 
 // ApplicationStatus represents the lifecycle status of an application
@@ -66,6 +71,12 @@ func (a *Application) Validate() error {
 	if a.Name == "" {
 		return errors.New("application name cannot be empty")
 	}
+	if a.Criticality != "" && a.Criticality.Weight() == 0 {
+		return fmt.Errorf("unknown application criticality %q", a.Criticality)
+	}
+	if err := a.DataClassification.Validate(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -126,6 +137,8 @@ type ApplicationPortfolio struct {
 	Owner       string
 	Applications []Application
 	KPIs        []KPI
+	OKRs        []OKR                // portfolio-level objectives and key results
+	Thresholds  *PortfolioThresholds // nil uses the evaluation profile and exact KPI targets
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 }
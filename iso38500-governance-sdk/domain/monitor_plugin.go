@@ -0,0 +1,207 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// MonitorPlugin is a custom collector contributing data to the monitoring of an application, such
+// as a security scanner, a cost API or a ticket system. Plugins are registered on the monitoring
+// service and collected from every time an agreement is monitored.
+type MonitorPlugin interface {
+	// Name identifies the plugin; it must be unique among the registered plugins
+	Name() string
+	// Collect returns the KPI measurements and risk indicators the plugin observed for the
+	// application
+	Collect(ctx context.Context, app Application) (PluginCollection, error)
+}
+
+// PluginCollection is what a monitor plugin observed for an application. Measurements must name a
+// defined KPI; their target and achievement are taken from the KPI and a zero time is the time of
+// collection.
+type PluginCollection struct {
+	Measurements   []KPIMeasurement
+	RiskIndicators []RiskIndicator
+}
+
+// PluginCollectionResult is the outcome of collecting from one plugin: the measurements recorded
+// and the risk indicators tracked on the agreement
+type PluginCollectionResult struct {
+	Plugin         string
+	Measurements   []KPIMeasurement
+	RiskIndicators []RiskIndicator
+	Err            string // why collection failed or some of it was rejected, empty when it succeeded
+}
+
+// Succeeded reports whether everything the plugin collected was recorded
+func (r PluginCollectionResult) Succeeded() bool {
+	return r.Err == ""
+}
+
+// monitorPlugins holds the plugins registered on a monitoring service
+type monitorPlugins struct {
+	mu   sync.RWMutex
+	list []MonitorPlugin
+}
+
+// WithMonitorPlugins registers monitor plugins on the monitoring service, which looks up the
+// applications they collect for in appRepo. Plugins that cannot be registered, because they have
+// no name or one already taken, are ignored.
+func WithMonitorPlugins(appRepo ApplicationRepository, plugins ...MonitorPlugin) MonitoringOption {
+	return func(s *MonitoringService) {
+		if appRepo != nil {
+			s.appRepo = appRepo
+		}
+		for _, plugin := range plugins {
+			_ = s.RegisterPlugin(plugin)
+		}
+	}
+}
+
+// RegisterPlugin adds a plugin to collect from when monitoring agreements
+func (s *MonitoringService) RegisterPlugin(plugin MonitorPlugin) error {
+	if plugin == nil || plugin.Name() == "" {
+		return errors.New("monitor plugin must have a name")
+	}
+
+	s.plugins.mu.Lock()
+	defer s.plugins.mu.Unlock()
+	for _, registered := range s.plugins.list {
+		if registered.Name() == plugin.Name() {
+			return fmt.Errorf("monitor plugin %s is already registered", plugin.Name())
+		}
+	}
+	s.plugins.list = append(s.plugins.list, plugin)
+	return nil
+}
+
+// Plugins returns the names of the registered plugins, in the order they were registered
+func (s *MonitoringService) Plugins() []string {
+	s.plugins.mu.RLock()
+	defer s.plugins.mu.RUnlock()
+	names := make([]string, len(s.plugins.list))
+	for i, plugin := range s.plugins.list {
+		names[i] = plugin.Name()
+	}
+	return names
+}
+
+// CollectFromPlugins collects from every registered plugin for the agreement's application. The
+// measurements collected are recorded in the measurement repository and the risk indicators
+// replace those of the same name tracked on the agreement, so both are seen by the rest of the
+// monitoring. A plugin that fails, or a measurement that cannot be recorded, is reported in its
+// result without stopping the others.
+func (s *MonitoringService) CollectFromPlugins(ctx context.Context, agreementID GovernanceAgreementID) ([]PluginCollectionResult, error) {
+	s.plugins.mu.RLock()
+	plugins := append([]MonitorPlugin{}, s.plugins.list...)
+	s.plugins.mu.RUnlock()
+	if len(plugins) == 0 {
+		return nil, nil
+	}
+
+	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+
+	app := Application{ID: agreement.ApplicationID}
+	if s.appRepo != nil {
+		if found, err := s.appRepo.FindByID(ctx, agreement.ApplicationID); err == nil {
+			app = found
+		}
+	}
+
+	now := time.Now()
+	results := make([]PluginCollectionResult, 0, len(plugins))
+	var collected []RiskIndicator
+	for _, plugin := range plugins {
+		result := PluginCollectionResult{Plugin: plugin.Name()}
+		collection, err := plugin.Collect(ctx, app)
+		if err != nil {
+			result.Err = err.Error()
+			results = append(results, result)
+			continue
+		}
+
+		var errs []error
+		for _, measurement := range collection.Measurements {
+			recorded, err := s.recordPluginMeasurement(ctx, measurement, now)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			result.Measurements = append(result.Measurements, recorded)
+		}
+		for _, indicator := range collection.RiskIndicators {
+			if indicator.Name == "" {
+				errs = append(errs, errors.New("risk indicator name cannot be empty"))
+				continue
+			}
+			result.RiskIndicators = append(result.RiskIndicators, indicator)
+		}
+		collected = append(collected, result.RiskIndicators...)
+		if err := errors.Join(errs...); err != nil {
+			result.Err = err.Error()
+		}
+		results = append(results, result)
+	}
+
+	if len(collected) == 0 {
+		return results, nil
+	}
+	agreement.Monitor.RiskMonitoring.RiskIndicators = mergeRiskIndicators(agreement.Monitor.RiskMonitoring.RiskIndicators, collected)
+	err = s.agreementRepo.Update(ctx, agreement)
+	if err != nil {
+		return results, fmt.Errorf("failed to update governance agreement: %w", err)
+	}
+	return results, nil
+}
+
+// recordPluginMeasurement completes a collected measurement from its KPI and saves it
+func (s *MonitoringService) recordPluginMeasurement(ctx context.Context, measurement KPIMeasurement, now time.Time) (KPIMeasurement, error) {
+	if measurement.MeasuredAt.IsZero() {
+		measurement.MeasuredAt = now
+	}
+	if err := measurement.Validate(); err != nil {
+		return measurement, err
+	}
+	if s.kpiRepo == nil || s.measurementRepo == nil {
+		return measurement, fmt.Errorf("KPI %s: measurements cannot be recorded without KPI repositories", measurement.KPIID)
+	}
+
+	kpi, err := s.kpiRepo.FindByID(ctx, measurement.KPIID)
+	if err != nil {
+		return measurement, fmt.Errorf("KPI %s not found: %w", measurement.KPIID, err)
+	}
+	measurement.Target = kpi.Target
+	measurement.Achieved = kpi.TargetAchieved(measurement.Value, 0)
+
+	err = s.measurementRepo.Save(ctx, measurement)
+	if err != nil {
+		return measurement, fmt.Errorf("failed to save KPI measurement: %w", err)
+	}
+	return measurement, nil
+}
+
+// mergeRiskIndicators replaces the tracked indicators named like a collected one and appends the
+// collected indicators not yet tracked
+func mergeRiskIndicators(tracked, collected []RiskIndicator) []RiskIndicator {
+	merged := append([]RiskIndicator{}, tracked...)
+	for _, indicator := range collected {
+		replaced := false
+		for i := range merged {
+			if merged[i].Name == indicator.Name {
+				merged[i] = indicator
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			merged = append(merged, indicator)
+		}
+	}
+	return merged
+}
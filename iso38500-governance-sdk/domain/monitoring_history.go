@@ -0,0 +1,248 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// MonitoringSnapshot records what one monitoring run of an agreement found: its KPI measurements,
+// risk indicators, and its requirement gaps, compliance violations and active alerts
+type MonitoringSnapshot struct {
+	ID                   string
+	AgreementID          GovernanceAgreementID
+	ApplicationID        ApplicationID
+	TakenAt              time.Time
+	KPIs                 []KPIMeasurement
+	RiskIndicators       []RiskIndicator
+	RequirementGaps      int
+	ComplianceViolations int
+	ActiveAlerts         int
+	KPIAnomalies         int
+}
+
+// KPIAttainment returns the fraction of the snapshot's KPIs that achieved their target, and
+// false when it measured none
+func (s MonitoringSnapshot) KPIAttainment() (float64, bool) {
+	if len(s.KPIs) == 0 {
+		return 0, false
+	}
+	achieved := 0
+	for _, kpi := range s.KPIs {
+		if kpi.Achieved {
+			achieved++
+		}
+	}
+	return float64(achieved) / float64(len(s.KPIs)), true
+}
+
+// KPIAttainmentTrend follows one KPI across monitoring snapshots
+type KPIAttainmentTrend struct {
+	KPIID          string
+	Snapshots      int // snapshots measuring the KPI
+	Achieved       int // snapshots in which it achieved its target
+	AttainmentRate float64
+	LatestTarget   float64
+	Value          MetricTrend // whether the value is moving towards its target
+}
+
+// MonitoringHistory is an agreement's monitoring snapshots over a time range with the trends of
+// KPI attainment and of each risk indicator
+type MonitoringHistory struct {
+	AgreementID    GovernanceAgreementID
+	From           time.Time
+	To             time.Time
+	Snapshots      []MonitoringSnapshot // oldest first
+	KPIAttainment  MetricTrend          // fraction of KPIs achieved per snapshot
+	KPIs           []KPIAttainmentTrend // by KPI ID
+	RiskIndicators []MetricTrend        // by indicator name; lower values are better
+}
+
+// BuildMonitoringHistory follows KPI attainment and risk indicators across the snapshots taken
+// between from and to, inclusive. A zero from or to leaves the range open at that end.
+func BuildMonitoringHistory(agreementID GovernanceAgreementID, snapshots []MonitoringSnapshot, from, to time.Time) (MonitoringHistory, error) {
+	if !from.IsZero() && !to.IsZero() && from.After(to) {
+		return MonitoringHistory{}, errors.New("monitoring history range must not end before it starts")
+	}
+
+	history := MonitoringHistory{
+		AgreementID:    agreementID,
+		From:           from,
+		To:             to,
+		Snapshots:      []MonitoringSnapshot{},
+		KPIs:           []KPIAttainmentTrend{},
+		RiskIndicators: []MetricTrend{},
+	}
+	for _, snapshot := range snapshots {
+		if (!from.IsZero() && snapshot.TakenAt.Before(from)) || (!to.IsZero() && snapshot.TakenAt.After(to)) {
+			continue
+		}
+		history.Snapshots = append(history.Snapshots, snapshot)
+	}
+	sort.SliceStable(history.Snapshots, func(i, j int) bool {
+		return history.Snapshots[i].TakenAt.Before(history.Snapshots[j].TakenAt)
+	})
+
+	var attainment []monitoringSample
+	kpis := make(map[string][]KPIMeasurement)
+	kpiTimes := make(map[string][]time.Time)
+	indicators := make(map[string][]monitoringSample)
+	indicatorScale := make(map[string]float64)
+	for _, snapshot := range history.Snapshots {
+		if rate, ok := snapshot.KPIAttainment(); ok {
+			attainment = append(attainment, monitoringSample{at: snapshot.TakenAt, value: rate})
+		}
+		for _, kpi := range snapshot.KPIs {
+			kpis[kpi.KPIID] = append(kpis[kpi.KPIID], kpi)
+			kpiTimes[kpi.KPIID] = append(kpiTimes[kpi.KPIID], snapshot.TakenAt)
+		}
+		for _, indicator := range snapshot.RiskIndicators {
+			indicators[indicator.Name] = append(indicators[indicator.Name], monitoringSample{at: snapshot.TakenAt, value: indicator.Value})
+			indicatorScale[indicator.Name] = math.Max(indicatorScale[indicator.Name], math.Max(math.Abs(indicator.Threshold), math.Abs(indicator.Value)))
+		}
+	}
+
+	history.KPIAttainment = fitMonitoringTrend("kpi_attainment", attainment, true, 1)
+
+	kpiIDs := make([]string, 0, len(kpis))
+	for kpiID := range kpis {
+		kpiIDs = append(kpiIDs, kpiID)
+	}
+	sort.Strings(kpiIDs)
+	for _, kpiID := range kpiIDs {
+		measurements := kpis[kpiID]
+		trend := KPIAttainmentTrend{
+			KPIID:        kpiID,
+			Snapshots:    len(measurements),
+			LatestTarget: measurements[len(measurements)-1].Target,
+		}
+		samples := make([]monitoringSample, len(measurements))
+		scale := math.Abs(trend.LatestTarget)
+		for i, measurement := range measurements {
+			if measurement.Achieved {
+				trend.Achieved++
+			}
+			samples[i] = monitoringSample{at: kpiTimes[kpiID][i], value: measurement.Value}
+			if trend.LatestTarget == 0 {
+				scale = math.Max(scale, math.Abs(measurement.Value))
+			}
+		}
+		trend.AttainmentRate = float64(trend.Achieved) / float64(trend.Snapshots)
+		trend.Value = fitMonitoringTrend(kpiID, samples, !lowerIsBetter(measurements), scale)
+		history.KPIs = append(history.KPIs, trend)
+	}
+
+	names := make([]string, 0, len(indicators))
+	for name := range indicators {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		history.RiskIndicators = append(history.RiskIndicators, fitMonitoringTrend(name, indicators[name], false, indicatorScale[name]))
+	}
+
+	return history, nil
+}
+
+// monitoringSample is one value of a monitored metric
+type monitoringSample struct {
+	at    time.Time
+	value float64
+}
+
+// lowerIsBetter infers from a KPI's measurements whether it is met by staying below its target:
+// when a value below the target achieved it, or a value above it did not
+func lowerIsBetter(measurements []KPIMeasurement) bool {
+	for _, measurement := range measurements {
+		if (measurement.Achieved && measurement.Value < measurement.Target) || (!measurement.Achieved && measurement.Value > measurement.Target) {
+			return true
+		}
+	}
+	return false
+}
+
+// fitMonitoringTrend fits a least-squares line through the samples. Changes smaller than 5% of
+// the scale are stable.
+func fitMonitoringTrend(metric string, samples []monitoringSample, higherIsBetter bool, scale float64) MetricTrend {
+	trend := MetricTrend{Metric: metric, Direction: TrendInsufficientData, SampleCount: len(samples)}
+	if len(samples) == 0 {
+		return trend
+	}
+
+	origin := samples[0].at
+	xs := make([]float64, len(samples))
+	ys := make([]float64, len(samples))
+	for i, sample := range samples {
+		xs[i] = sample.at.Sub(origin).Hours() / 24
+		ys[i] = sample.value
+	}
+
+	trend.First = ys[0]
+	trend.Latest = ys[len(ys)-1]
+	trend.Change = trend.Latest - trend.First
+	trend.Projected = trend.Latest
+	if len(samples) < 2 {
+		return trend
+	}
+
+	slope, intercept := linearFit(xs, ys)
+	trend.SlopePerDay = slope
+	trend.Projected = intercept + slope*xs[len(xs)-1]
+
+	fittedChange := slope * (xs[len(xs)-1] - xs[0])
+	switch {
+	case math.Abs(fittedChange) <= 0.05*scale:
+		trend.Direction = TrendStable
+	case (fittedChange > 0) == higherIsBetter:
+		trend.Direction = TrendImproving
+	default:
+		trend.Direction = TrendDegrading
+	}
+	return trend
+}
+
+// RecordMonitoringSnapshot adds a snapshot to the monitoring history of WithMonitoringHistory. It
+// does nothing without a monitoring history.
+func (s *MonitoringService) RecordMonitoringSnapshot(ctx context.Context, snapshot MonitoringSnapshot) error {
+	if s.snapshotRepo == nil {
+		return nil
+	}
+
+	err := s.snapshotRepo.Save(ctx, snapshot)
+	if err != nil {
+		return fmt.Errorf("failed to save monitoring snapshot: %w", err)
+	}
+	return nil
+}
+
+// MonitorHistory returns the agreement's monitoring snapshots taken between from and to with the
+// trends of KPI attainment and risk indicators. It needs the snapshot repository of
+// WithMonitoringHistory.
+func (s *MonitoringService) MonitorHistory(ctx context.Context, agreementID GovernanceAgreementID, from, to time.Time) (*MonitoringHistory, error) {
+	if s.snapshotRepo == nil {
+		return nil, errors.New("monitoring service has no monitoring snapshot repository")
+	}
+	if _, err := s.agreementRepo.FindByID(ctx, agreementID); err != nil {
+		return nil, fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+
+	var snapshots []MonitoringSnapshot
+	var err error
+	if !from.IsZero() && !to.IsZero() {
+		snapshots, err = s.snapshotRepo.FindByPeriod(ctx, agreementID, from, to)
+	} else {
+		snapshots, err = s.snapshotRepo.FindByAgreementID(ctx, agreementID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find monitoring snapshots: %w", err)
+	}
+
+	history, err := BuildMonitoringHistory(agreementID, snapshots, from, to)
+	if err != nil {
+		return nil, err
+	}
+	return &history, nil
+}
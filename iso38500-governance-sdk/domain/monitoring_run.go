@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// MonitoringRun records the outcome of one monitoring run of a governance agreement
+type MonitoringRun struct {
+	ID                   string
+	AgreementID          GovernanceAgreementID
+	ApplicationID        ApplicationID
+	RanAt                time.Time
+	KPIsMeasured         int
+	KPIsAchieved         int
+	RiskIndicators       int
+	RisksOverThreshold   int // indicators at warning or critical
+	RequirementGaps      int // conformance requirements not implemented by a document in effect
+	ComplianceViolations int // requirements that went from compliant to non-compliant
+	ActiveAlerts         int
+	KPIAnomalies         int    // KPI measurements deviating unusually from their history
+	Err                  string // empty when the run succeeded
+}
+
+// Succeeded reports whether the run completed
+func (r MonitoringRun) Succeeded() bool {
+	return r.Err == ""
+}
+
+// MonitoringInterval returns how often the agreement's compliance monitoring frequency asks for
+// it to be monitored. Agreements without a recognised frequency are not monitored on a cadence.
+func MonitoringInterval(agreement GovernanceAgreement) (time.Duration, bool) {
+	frequency := agreement.Conformance.ComplianceMonitoring.MonitoringFrequency
+	if frequency == "" {
+		frequency = agreement.Monitor.ComplianceMonitoring.MonitoringFrequency
+	}
+	return frequencyInterval(frequency)
+}
+
+// MonitoringDue reports whether an active agreement with a monitoring cadence was last monitored
+// at least one interval before now, or never
+func MonitoringDue(agreement GovernanceAgreement, now time.Time) bool {
+	if agreement.Status != AgreementActive {
+		return false
+	}
+	interval, ok := MonitoringInterval(agreement)
+	if !ok {
+		return false
+	}
+	last := agreement.Monitor.LastMonitored
+	return last.IsZero() || now.Sub(last) >= interval
+}
+
+// MarkMonitored records when the agreement was last monitored
+func (s *MonitoringService) MarkMonitored(ctx context.Context, agreementID GovernanceAgreementID, at time.Time) error {
+	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
+	if err != nil {
+		return fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+
+	agreement.Monitor.LastMonitored = at
+
+	err = s.agreementRepo.Update(ctx, agreement)
+	if err != nil {
+		return fmt.Errorf("failed to update governance agreement: %w", err)
+	}
+	return nil
+}
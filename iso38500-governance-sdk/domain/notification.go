@@ -0,0 +1,190 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// NotificationKind identifies what a notification is about
+type NotificationKind string
+
+const (
+	NotificationAlert           NotificationKind = "alert"
+	NotificationAlertResolved   NotificationKind = "alert_resolved"
+	NotificationApprovalPending NotificationKind = "approval_pending"
+	NotificationAuditDue        NotificationKind = "audit_due"
+	NotificationEscalation      NotificationKind = "escalation"
+	NotificationDigest          NotificationKind = "digest"
+	NotificationAttestationDue  NotificationKind = "attestation_due"
+	NotificationExpiry          NotificationKind = "expiry"     // a certification or contract expiring or expired
+	NotificationSLABreach       NotificationKind = "sla_breach" // an incident missing its response or resolution target
+)
+
+// NotificationSeverity is how urgent a notification is
+type NotificationSeverity string
+
+const (
+	NotificationInfo     NotificationSeverity = "info"
+	NotificationWarning  NotificationSeverity = "warning"
+	NotificationCritical NotificationSeverity = "critical"
+)
+
+// Roles addressed by notifications whose subject names no role of its own
+const (
+	RoleChangeApprover  = "change_approver"  // approves submitted change requests
+	RolePolicyApprover  = "policy_approver"  // approves submitted policies
+	RoleAuditor         = "auditor"          // carries out required audits
+	RoleExecutive       = "executive"        // receives executive digests of portfolios
+	RoleCompliance      = "compliance"       // renews certifications and contracts
+	RoleIncidentManager = "incident_manager" // responds to incidents breaching their SLA
+)
+
+// Rank orders severities from info to critical
+func (s NotificationSeverity) Rank() int {
+	switch s {
+	case NotificationCritical:
+		return 3
+	case NotificationWarning:
+		return 2
+	case NotificationInfo:
+		return 1
+	}
+	return 0
+}
+
+// Validate ensures the severity is known
+func (s NotificationSeverity) Validate() error {
+	if s.Rank() == 0 {
+		return fmt.Errorf("unknown notification severity %q", s)
+	}
+	return nil
+}
+
+// Notification is a message about governance work that needs someone's attention: a raised
+// alert, an approval waiting on an approver, or an audit coming due
+type Notification struct {
+	ID            string // stable for the thing notified about, e.g. the alert or change request
+	Kind          NotificationKind
+	Severity      NotificationSeverity
+	Title         string
+	Message       string
+	AgreementID   GovernanceAgreementID
+	ApplicationID ApplicationID
+	Portfolios    []PortfolioID // portfolios holding the application
+	Roles         []string      // roles addressed, e.g. the approver role of a change request
+	Recipients    []string      // people or teams addressed
+	DueAt         time.Time     // when the approval or audit is due, zero when not applicable
+	CreatedAt     time.Time
+}
+
+// Notifier delivers notifications over a channel such as email, chat or a webhook
+type Notifier interface {
+	Notify(ctx context.Context, notification Notification) error
+}
+
+// NotificationRoute sends the notifications it matches to a channel. An empty filter matches
+// every notification: a route without kinds matches every kind, one without portfolios every
+// portfolio, and one without roles every role.
+type NotificationRoute struct {
+	Name        string
+	Channel     string // name of the notifier notifications are sent to
+	Kinds       []NotificationKind
+	MinSeverity NotificationSeverity // least severe notification routed, any when empty
+	Portfolios  []PortfolioID
+	Roles       []string
+}
+
+// Validate ensures the route names a channel and a known minimum severity
+func (r NotificationRoute) Validate() error {
+	if r.Channel == "" {
+		return fmt.Errorf("notification route %s must name a channel", r.Name)
+	}
+	if r.MinSeverity != "" {
+		if err := r.MinSeverity.Validate(); err != nil {
+			return fmt.Errorf("notification route %s: %w", r.Name, err)
+		}
+	}
+	return nil
+}
+
+// Matches reports whether the route sends the notification to its channel
+func (r NotificationRoute) Matches(notification Notification) bool {
+	if len(r.Kinds) > 0 && !containsValue(r.Kinds, notification.Kind) {
+		return false
+	}
+	if r.MinSeverity != "" && notification.Severity.Rank() < r.MinSeverity.Rank() {
+		return false
+	}
+	if len(r.Portfolios) > 0 && !overlaps(r.Portfolios, notification.Portfolios) {
+		return false
+	}
+	if len(r.Roles) > 0 && !overlaps(r.Roles, notification.Roles) {
+		return false
+	}
+	return true
+}
+
+func containsValue[T comparable](values []T, value T) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func overlaps[T comparable](a, b []T) bool {
+	for _, value := range b {
+		if containsValue(a, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// NotificationRouter is a Notifier that sends each notification to the channels of the routes
+// matching it. A notification matched by several routes to the same channel is sent once, and
+// one no route matches is dropped.
+type NotificationRouter struct {
+	routes   []NotificationRoute
+	channels map[string]Notifier
+}
+
+// NewNotificationRouter creates a router over named channels. Every route must name one of the
+// channels.
+func NewNotificationRouter(routes []NotificationRoute, channels map[string]Notifier) (*NotificationRouter, error) {
+	for _, route := range routes {
+		if err := route.Validate(); err != nil {
+			return nil, err
+		}
+		if _, ok := channels[route.Channel]; !ok {
+			return nil, fmt.Errorf("notification route %s names unknown channel %s", route.Name, route.Channel)
+		}
+	}
+	return &NotificationRouter{routes: routes, channels: channels}, nil
+}
+
+// Channels returns the channels the notification is routed to, in route order
+func (r *NotificationRouter) Channels(notification Notification) []string {
+	var channels []string
+	for _, route := range r.routes {
+		if route.Matches(notification) && !containsValue(channels, route.Channel) {
+			channels = append(channels, route.Channel)
+		}
+	}
+	return channels
+}
+
+// Notify sends the notification to every channel it is routed to. A failed channel does not
+// stop delivery to the others; their errors are joined.
+func (r *NotificationRouter) Notify(ctx context.Context, notification Notification) error {
+	var errs []error
+	for _, channel := range r.Channels(notification) {
+		if err := r.channels[channel].Notify(ctx, notification); err != nil {
+			errs = append(errs, fmt.Errorf("failed to notify %s: %w", channel, err))
+		}
+	}
+	return errors.Join(errs...)
+}
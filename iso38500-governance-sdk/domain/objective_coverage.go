@@ -0,0 +1,136 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ObjectiveKPIRef names a KPI linked to a strategic objective
+type ObjectiveKPIRef struct {
+	ObjectiveID string
+	KPIID       string
+}
+
+// ObjectiveKPICoverage reports how well an agreement's strategic objectives are backed by KPIs:
+// objectives with no linked KPI, linked KPIs unknown to the KPI repository and linked KPIs with
+// no measurement
+type ObjectiveKPICoverage struct {
+	AgreementID        GovernanceAgreementID
+	Objectives         int
+	LinkedKPIs         int
+	UnlinkedObjectives []string          // objectives with no linked KPI
+	UnknownKPIs        []ObjectiveKPIRef // linked KPIs missing from the KPI repository
+	UnmeasuredKPIs     []ObjectiveKPIRef // linked KPIs with no measurement
+	CheckedAt          time.Time
+}
+
+// HasGaps reports whether any objective or linked KPI is not covered
+func (c ObjectiveKPICoverage) HasGaps() bool {
+	return len(c.UnlinkedObjectives) > 0 || len(c.UnknownKPIs) > 0 || len(c.UnmeasuredKPIs) > 0
+}
+
+// MeasuredKPIs returns the number of linked KPIs that are known and measured
+func (c ObjectiveKPICoverage) MeasuredKPIs() int {
+	return c.LinkedKPIs - len(c.UnknownKPIs) - len(c.UnmeasuredKPIs)
+}
+
+// Validate ensures the objective and the KPIs linked to it are usable
+func (o *StrategicObjective) Validate() error {
+	if o.ID == "" {
+		return errors.New("objective ID cannot be empty")
+	}
+	if o.Name == "" {
+		return fmt.Errorf("objective %s: name cannot be empty", o.ID)
+	}
+	seen := make(map[string]bool)
+	for _, kpi := range o.KPIs {
+		if err := kpi.Validate(); err != nil {
+			return fmt.Errorf("objective %s: %w", o.ID, err)
+		}
+		if seen[kpi.ID] {
+			return fmt.Errorf("objective %s: KPI %s is linked more than once", o.ID, kpi.ID)
+		}
+		seen[kpi.ID] = true
+	}
+	return validateContributions(o.ID, o.Contributions)
+}
+
+// validateObjectives checks every objective and that no two share an ID
+func validateObjectives(objectives []StrategicObjective) error {
+	seen := make(map[string]bool)
+	for _, objective := range objectives {
+		if err := objective.Validate(); err != nil {
+			return err
+		}
+		if seen[objective.ID] {
+			return fmt.Errorf("duplicate objective %s", objective.ID)
+		}
+		seen[objective.ID] = true
+	}
+	return nil
+}
+
+// MonitorObjectiveKPICoverage reports the agreement's objectives with no linked KPIs and the
+// linked KPIs that are unknown or have no measurement. A KPI counts as measured when the
+// measurement repository holds a measurement for it or the agreement's evaluation recorded one.
+// Without a KPI repository every linked KPI is taken to exist.
+func (s *MonitoringService) MonitorObjectiveKPICoverage(ctx context.Context, agreementID GovernanceAgreementID) (*ObjectiveKPICoverage, error) {
+	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+
+	recorded := make(map[string]bool)
+	for _, measurement := range agreement.Evaluate.PerformanceMetrics {
+		recorded[measurement.KPIID] = true
+	}
+
+	objectives := agreement.Direct.StrategicDirection.Objectives
+	coverage := &ObjectiveKPICoverage{
+		AgreementID:        agreementID,
+		Objectives:         len(objectives),
+		UnlinkedObjectives: []string{},
+		UnknownKPIs:        []ObjectiveKPIRef{},
+		UnmeasuredKPIs:     []ObjectiveKPIRef{},
+		CheckedAt:          time.Now(),
+	}
+
+	for _, objective := range objectives {
+		if len(objective.KPIs) == 0 {
+			coverage.UnlinkedObjectives = append(coverage.UnlinkedObjectives, objective.ID)
+			continue
+		}
+		for _, kpi := range objective.KPIs {
+			coverage.LinkedKPIs++
+			ref := ObjectiveKPIRef{ObjectiveID: objective.ID, KPIID: kpi.ID}
+
+			if s.kpiRepo != nil {
+				exists, err := s.kpiRepo.Exists(ctx, kpi.ID)
+				if err != nil {
+					return nil, fmt.Errorf("failed to check KPI %s: %w", kpi.ID, err)
+				}
+				if !exists {
+					coverage.UnknownKPIs = append(coverage.UnknownKPIs, ref)
+					continue
+				}
+			}
+
+			if !recorded[kpi.ID] && !s.hasMeasurement(ctx, kpi.ID) {
+				coverage.UnmeasuredKPIs = append(coverage.UnmeasuredKPIs, ref)
+			}
+		}
+	}
+
+	return coverage, nil
+}
+
+// hasMeasurement reports whether the measurement repository holds a measurement for the KPI
+func (s *MonitoringService) hasMeasurement(ctx context.Context, kpiID string) bool {
+	if s.measurementRepo == nil {
+		return false
+	}
+	_, err := s.measurementRepo.FindLatest(ctx, kpiID)
+	return err == nil
+}
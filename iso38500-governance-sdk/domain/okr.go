@@ -0,0 +1,439 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// OKRLevel is the level of the organization an OKR is set at
+type OKRLevel string
+
+const (
+	OKRPortfolioLevel   OKRLevel = "portfolio"
+	OKRApplicationLevel OKRLevel = "application"
+)
+
+// KeyResult is a measurable outcome of an OKR. A key result linked to a KPI takes its actual
+// value from the KPI's latest measurement.
+type KeyResult struct {
+	ID          string
+	Description string
+	KPIID       string // optional KPI the key result is measured by
+	Baseline    float64
+	Target      float64
+	Actual      float64
+	Unit        string
+	UpdatedAt   time.Time // when the actual value was last recorded or measured
+}
+
+// Progress returns how far the actual value has moved from the baseline to the target, as a
+// percentage between 0 and 100. Targets below the baseline are progressed by decreasing.
+func (k KeyResult) Progress() float64 {
+	span := k.Target - k.Baseline
+	if span == 0 {
+		if k.Actual == k.Target {
+			return 100
+		}
+		return 0
+	}
+	progress := (k.Actual - k.Baseline) / span * 100
+	if progress < 0 {
+		return 0
+	}
+	if progress > 100 {
+		return 100
+	}
+	return progress
+}
+
+// OKR is an objective with the key results that measure it. An application-level OKR cascades
+// from the portfolio-level OKR named by ParentID and can support one of the agreement's
+// strategic objectives.
+type OKR struct {
+	ID          string
+	Objective   string
+	Owner       string
+	ParentID    string // portfolio OKR this OKR cascades from
+	ObjectiveID string // strategic objective this OKR supports
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+	KeyResults  []KeyResult
+}
+
+// Validate ensures the OKR and its key results are usable
+func (o *OKR) Validate() error {
+	if o.ID == "" {
+		return errors.New("OKR ID cannot be empty")
+	}
+	if o.Objective == "" {
+		return fmt.Errorf("OKR %s: objective cannot be empty", o.ID)
+	}
+	if o.ParentID == o.ID {
+		return fmt.Errorf("OKR %s cannot cascade from itself", o.ID)
+	}
+	if !o.PeriodStart.IsZero() && !o.PeriodEnd.IsZero() && !o.PeriodEnd.After(o.PeriodStart) {
+		return fmt.Errorf("OKR %s: period must end after it starts", o.ID)
+	}
+	if len(o.KeyResults) == 0 {
+		return fmt.Errorf("OKR %s needs at least one key result", o.ID)
+	}
+	seen := make(map[string]bool)
+	for _, keyResult := range o.KeyResults {
+		if keyResult.ID == "" {
+			return fmt.Errorf("OKR %s: key result ID cannot be empty", o.ID)
+		}
+		if seen[keyResult.ID] {
+			return fmt.Errorf("OKR %s: duplicate key result %s", o.ID, keyResult.ID)
+		}
+		seen[keyResult.ID] = true
+	}
+	return nil
+}
+
+// Progress returns the average progress of the OKR's key results
+func (o OKR) Progress() float64 {
+	if len(o.KeyResults) == 0 {
+		return 0
+	}
+	total := 0.0
+	for _, keyResult := range o.KeyResults {
+		total += keyResult.Progress()
+	}
+	return total / float64(len(o.KeyResults))
+}
+
+// OKRProgress reports the progress of one OKR and of the OKRs cascading from it
+type OKRProgress struct {
+	OKRID            string
+	Objective        string
+	Owner            string
+	Level            OKRLevel
+	ApplicationID    ApplicationID // set for application-level OKRs
+	Progress         float64
+	KeyResults       []KeyResult
+	Children         []OKRProgress // application-level OKRs cascading from a portfolio-level OKR
+	CascadedProgress float64       // average progress of the children
+}
+
+// OKRCascade reports a portfolio's OKRs with the application-level OKRs cascading from them
+type OKRCascade struct {
+	PortfolioID PortfolioID
+	OKRs        []OKRProgress
+	Unaligned   []OKRProgress // application-level OKRs that cascade from no OKR of the portfolio
+	Progress    float64       // average progress of the portfolio's OKRs
+	GeneratedAt time.Time
+}
+
+// okrProgress reports the OKR's progress at the given level
+func okrProgress(okr OKR, level OKRLevel, appID ApplicationID) OKRProgress {
+	return OKRProgress{
+		OKRID:         okr.ID,
+		Objective:     okr.Objective,
+		Owner:         okr.Owner,
+		Level:         level,
+		ApplicationID: appID,
+		Progress:      okr.Progress(),
+		KeyResults:    okr.KeyResults,
+		Children:      []OKRProgress{},
+	}
+}
+
+// withMeasurements returns copies of the OKRs whose KPI-linked key results take the value of
+// the latest measurement of their KPI, when it is newer than the recorded actual
+func withMeasurements(okrs []OKR, measurements []KPIMeasurement) []OKR {
+	latest := make(map[string]KPIMeasurement)
+	for _, measurement := range measurements {
+		if current, ok := latest[measurement.KPIID]; !ok || measurement.MeasuredAt.After(current.MeasuredAt) {
+			latest[measurement.KPIID] = measurement
+		}
+	}
+
+	measured := make([]OKR, len(okrs))
+	for i, okr := range okrs {
+		keyResults := make([]KeyResult, len(okr.KeyResults))
+		copy(keyResults, okr.KeyResults)
+		for k := range keyResults {
+			measurement, ok := latest[keyResults[k].KPIID]
+			if keyResults[k].KPIID == "" || !ok || measurement.MeasuredAt.Before(keyResults[k].UpdatedAt) {
+				continue
+			}
+			keyResults[k].Actual = measurement.Value
+			keyResults[k].UpdatedAt = measurement.MeasuredAt
+		}
+		okr.KeyResults = keyResults
+		measured[i] = okr
+	}
+	return measured
+}
+
+// defineOKR adds the OKR to the set, replacing the OKR with the same ID, and returns the updated
+// set and OKR. Key results with no recorded actual value start at their baseline.
+func defineOKR(okrs []OKR, okr OKR) ([]OKR, *OKR, error) {
+	if err := okr.Validate(); err != nil {
+		return nil, nil, err
+	}
+	keyResults := make([]KeyResult, len(okr.KeyResults))
+	copy(keyResults, okr.KeyResults)
+	for k := range keyResults {
+		if keyResults[k].UpdatedAt.IsZero() && keyResults[k].Actual == 0 {
+			keyResults[k].Actual = keyResults[k].Baseline
+		}
+	}
+	okr.KeyResults = keyResults
+
+	defined := make([]OKR, 0, len(okrs)+1)
+	replaced := false
+	for _, existing := range okrs {
+		if existing.ID == okr.ID {
+			existing = okr
+			replaced = true
+		}
+		defined = append(defined, existing)
+	}
+	if !replaced {
+		defined = append(defined, okr)
+	}
+	return defined, &okr, nil
+}
+
+// recordKeyResult records the actual value of one key result of the set and returns the updated
+// set and OKR
+func recordKeyResult(okrs []OKR, okrID, keyResultID string, actual float64, at time.Time) ([]OKR, *OKR, error) {
+	for i := range okrs {
+		if okrs[i].ID != okrID {
+			continue
+		}
+
+		okr := okrs[i]
+		keyResults := make([]KeyResult, len(okr.KeyResults))
+		copy(keyResults, okr.KeyResults)
+		found := false
+		for k := range keyResults {
+			if keyResults[k].ID == keyResultID {
+				keyResults[k].Actual = actual
+				keyResults[k].UpdatedAt = at
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, nil, fmt.Errorf("key result %s not found in OKR %s", keyResultID, okrID)
+		}
+		okr.KeyResults = keyResults
+
+		updated := make([]OKR, len(okrs))
+		copy(updated, okrs)
+		updated[i] = okr
+		return updated, &okr, nil
+	}
+	return nil, nil, fmt.Errorf("OKR %s not found", okrID)
+}
+
+// KeyResult returns one key result of the OKR
+func (o OKR) KeyResult(keyResultID string) (KeyResult, bool) {
+	for _, keyResult := range o.KeyResults {
+		if keyResult.ID == keyResultID {
+			return keyResult, true
+		}
+	}
+	return KeyResult{}, false
+}
+
+// DefineOKR adds a portfolio-level OKR, replacing the portfolio's OKR with the same ID
+func (ap *ApplicationPortfolio) DefineOKR(okr OKR) (*OKR, error) {
+	if okr.ParentID != "" {
+		return nil, fmt.Errorf("portfolio OKR %s cannot cascade from another OKR", okr.ID)
+	}
+	okrs, defined, err := defineOKR(ap.OKRs, okr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid OKR: %w", err)
+	}
+	ap.OKRs = okrs
+	return defined, nil
+}
+
+// RecordKeyResult records the actual value of a key result of one of the portfolio's OKRs
+func (ap *ApplicationPortfolio) RecordKeyResult(okrID, keyResultID string, actual float64, at time.Time) (*OKR, error) {
+	okrs, okr, err := recordKeyResult(ap.OKRs, okrID, keyResultID, actual, at)
+	if err != nil {
+		return nil, err
+	}
+	ap.OKRs = okrs
+	return okr, nil
+}
+
+// DefineOKR adds an application-level OKR to the agreement's strategic direction, replacing the
+// OKR with the same ID. The OKR may only support a strategic objective of the agreement.
+func (s *DirectionService) DefineOKR(ctx context.Context, agreementID GovernanceAgreementID, okr OKR) (*OKR, error) {
+	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+
+	direction := &agreement.Direct.StrategicDirection
+	if okr.ObjectiveID != "" {
+		known := false
+		for _, objective := range direction.Objectives {
+			if objective.ID == okr.ObjectiveID {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return nil, fmt.Errorf("OKR %s supports unknown objective %s", okr.ID, okr.ObjectiveID)
+		}
+	}
+
+	okrs, defined, err := defineOKR(direction.OKRs, okr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid OKR: %w", err)
+	}
+	direction.OKRs = okrs
+
+	err = s.agreementRepo.Update(ctx, agreement)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update governance agreement: %w", err)
+	}
+	return defined, nil
+}
+
+// RecordKeyResult records the actual value of a key result of one of the agreement's OKRs. A
+// key result linked to a KPI is also recorded as a measurement of the KPI.
+func (s *DirectionService) RecordKeyResult(ctx context.Context, agreementID GovernanceAgreementID, okrID, keyResultID string, actual float64) (*OKR, error) {
+	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+
+	now := time.Now()
+	okrs, okr, err := recordKeyResult(agreement.Direct.StrategicDirection.OKRs, okrID, keyResultID, actual, now)
+	if err != nil {
+		return nil, err
+	}
+	agreement.Direct.StrategicDirection.OKRs = okrs
+
+	if keyResult, _ := okr.KeyResult(keyResultID); keyResult.KPIID != "" {
+		agreement.Evaluate.PerformanceMetrics = append(append([]KPIMeasurement{}, agreement.Evaluate.PerformanceMetrics...), KPIMeasurement{
+			KPIID:      keyResult.KPIID,
+			Value:      actual,
+			Target:     keyResult.Target,
+			Achieved:   keyResult.Progress() >= 100,
+			MeasuredAt: now,
+			Notes:      fmt.Sprintf("Key result %s of OKR %s", keyResultID, okrID),
+		})
+	}
+
+	err = s.agreementRepo.Update(ctx, agreement)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update governance agreement: %w", err)
+	}
+	return okr, nil
+}
+
+// MonitorOKRs reports the progress of the agreement's OKRs, with KPI-linked key results taking
+// the latest measurement of their KPI
+func (s *MonitoringService) MonitorOKRs(ctx context.Context, agreementID GovernanceAgreementID) ([]OKRProgress, error) {
+	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+
+	okrs := agreement.Direct.StrategicDirection.OKRs
+	measurements := s.okrMeasurements(ctx, []GovernanceAgreement{agreement}, okrs)
+
+	progress := make([]OKRProgress, 0, len(okrs))
+	for _, okr := range withMeasurements(okrs, measurements) {
+		progress = append(progress, okrProgress(okr, OKRApplicationLevel, agreement.ApplicationID))
+	}
+	return progress, nil
+}
+
+// MonitorOKRCascade reports the portfolio's OKRs with the OKRs of its applications cascading
+// from them. Key results linked to a KPI take its latest measurement across the portfolio's
+// agreements. It needs the portfolio repository of WithPortfolioThresholds.
+func (s *MonitoringService) MonitorOKRCascade(ctx context.Context, portfolioID PortfolioID) (*OKRCascade, error) {
+	if s.portfolioRepo == nil {
+		return nil, errors.New("monitoring service has no portfolio repository")
+	}
+	portfolio, err := s.portfolioRepo.FindByID(ctx, portfolioID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find portfolio: %w", err)
+	}
+
+	var agreements []GovernanceAgreement
+	okrs := append([]OKR{}, portfolio.OKRs...)
+	for _, app := range portfolio.Applications {
+		if app.Status == StatusRetired {
+			continue
+		}
+		if agreement, err := s.agreementRepo.FindByApplicationID(ctx, app.ID); err == nil {
+			agreements = append(agreements, agreement)
+			okrs = append(okrs, agreement.Direct.StrategicDirection.OKRs...)
+		}
+	}
+	measurements := s.okrMeasurements(ctx, agreements, okrs)
+
+	cascade := &OKRCascade{
+		PortfolioID: portfolioID,
+		OKRs:        make([]OKRProgress, 0, len(portfolio.OKRs)),
+		Unaligned:   []OKRProgress{},
+		GeneratedAt: time.Now(),
+	}
+	parents := make(map[string]int)
+	for _, okr := range withMeasurements(portfolio.OKRs, measurements) {
+		parents[okr.ID] = len(cascade.OKRs)
+		cascade.OKRs = append(cascade.OKRs, okrProgress(okr, OKRPortfolioLevel, ""))
+		cascade.Progress += okr.Progress()
+	}
+	if len(cascade.OKRs) > 0 {
+		cascade.Progress /= float64(len(cascade.OKRs))
+	}
+
+	for _, agreement := range agreements {
+		for _, okr := range withMeasurements(agreement.Direct.StrategicDirection.OKRs, measurements) {
+			child := okrProgress(okr, OKRApplicationLevel, agreement.ApplicationID)
+			parent, ok := parents[okr.ParentID]
+			if !ok {
+				cascade.Unaligned = append(cascade.Unaligned, child)
+				continue
+			}
+			cascade.OKRs[parent].Children = append(cascade.OKRs[parent].Children, child)
+		}
+	}
+	for i := range cascade.OKRs {
+		children := cascade.OKRs[i].Children
+		for _, child := range children {
+			cascade.OKRs[i].CascadedProgress += child.Progress
+		}
+		if len(children) > 0 {
+			cascade.OKRs[i].CascadedProgress /= float64(len(children))
+		}
+	}
+
+	return cascade, nil
+}
+
+// okrMeasurements gathers the KPI measurements recorded in the agreements and, when there is a
+// measurement repository, the latest measurement of every KPI the OKRs' key results link to
+func (s *MonitoringService) okrMeasurements(ctx context.Context, agreements []GovernanceAgreement, okrs []OKR) []KPIMeasurement {
+	var measurements []KPIMeasurement
+	for _, agreement := range agreements {
+		measurements = append(measurements, agreement.Evaluate.PerformanceMetrics...)
+	}
+	if s.measurementRepo == nil {
+		return measurements
+	}
+	for _, okr := range okrs {
+		for _, keyResult := range okr.KeyResults {
+			if keyResult.KPIID == "" {
+				continue
+			}
+			if measurement, err := s.measurementRepo.FindLatest(ctx, keyResult.KPIID); err == nil {
+				measurements = append(measurements, measurement)
+			}
+		}
+	}
+	return measurements
+}
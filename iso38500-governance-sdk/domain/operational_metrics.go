@@ -0,0 +1,194 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// DefaultOperationalWindow is how far back assessments and monitoring look for incidents
+const DefaultOperationalWindow = 90 * 24 * time.Hour
+
+// IncidentSeverityCount counts the incidents of one severity
+type IncidentSeverityCount struct {
+	Severity int // 1 is the highest
+	Count    int
+}
+
+// OperationalMetrics summarizes the incidents of an application reported within a window: how
+// often they occur, how severe they are and how quickly they are acknowledged and resolved
+type OperationalMetrics struct {
+	ApplicationID     ApplicationID
+	From              time.Time
+	To                time.Time
+	Incidents         int
+	Open              int // not resolved by To
+	Acknowledged      int
+	Resolved          int
+	MTTA              time.Duration // mean time from report to acknowledgement
+	MTTR              time.Duration // mean time from report to resolution
+	IncidentsPerMonth float64       // incidents per 30 days
+	Severities        []IncidentSeverityCount
+}
+
+// HighSeverity counts the incidents of severity 1
+func (m OperationalMetrics) HighSeverity() int {
+	for _, severity := range m.Severities {
+		if severity.Severity == 1 {
+			return severity.Count
+		}
+	}
+	return 0
+}
+
+// BuildOperationalMetrics summarizes the application's incidents reported between from and to.
+// An incident resolved without being acknowledged counts as acknowledged when it was resolved.
+func BuildOperationalMetrics(appID ApplicationID, incidents []Incident, from, to time.Time) OperationalMetrics {
+	metrics := OperationalMetrics{
+		ApplicationID: appID,
+		From:          from,
+		To:            to,
+		Severities:    []IncidentSeverityCount{},
+	}
+
+	var acknowledgement, resolution time.Duration
+	severities := make(map[int]int)
+	for _, incident := range incidents {
+		if incident.ApplicationID != appID || incident.CreatedAt.Before(from) || incident.CreatedAt.After(to) {
+			continue
+		}
+		metrics.Incidents++
+		severities[incident.Severity]++
+
+		resolvedAt := incident.ResolvedAt
+		if resolvedAt.IsZero() && incident.TimeToResolve > 0 {
+			resolvedAt = incident.CreatedAt.Add(incident.TimeToResolve)
+		}
+		resolved := !resolvedAt.IsZero() && !resolvedAt.After(to)
+		if resolved {
+			metrics.Resolved++
+			resolution += resolvedAt.Sub(incident.CreatedAt)
+		} else {
+			metrics.Open++
+		}
+
+		acknowledgedAt := incident.AcknowledgedAt
+		if acknowledgedAt.IsZero() && resolved {
+			acknowledgedAt = resolvedAt
+		}
+		if !acknowledgedAt.IsZero() && !acknowledgedAt.After(to) {
+			metrics.Acknowledged++
+			acknowledgement += acknowledgedAt.Sub(incident.CreatedAt)
+		}
+	}
+
+	if metrics.Acknowledged > 0 {
+		metrics.MTTA = acknowledgement / time.Duration(metrics.Acknowledged)
+	}
+	if metrics.Resolved > 0 {
+		metrics.MTTR = resolution / time.Duration(metrics.Resolved)
+	}
+	if days := to.Sub(from).Hours() / 24; days > 0 {
+		metrics.IncidentsPerMonth = float64(metrics.Incidents) / days * 30
+	}
+
+	for severity, count := range severities {
+		metrics.Severities = append(metrics.Severities, IncidentSeverityCount{Severity: severity, Count: count})
+	}
+	sort.Slice(metrics.Severities, func(i, j int) bool { return metrics.Severities[i].Severity < metrics.Severities[j].Severity })
+	return metrics
+}
+
+// performancePoints rates operations from -2 to +1 for the performance score: a point for
+// resolving incidents within 4 hours on average, and a point off for an average beyond a day,
+// for four or more incidents a month, and for any severity 1 incident
+func (m OperationalMetrics) performancePoints() int {
+	points := 0
+	switch {
+	case m.Resolved > 0 && m.MTTR <= 4*time.Hour:
+		points++
+	case m.Resolved > 0 && m.MTTR > 24*time.Hour:
+		points--
+	}
+	if m.IncidentsPerMonth >= 4 {
+		points--
+	}
+	if m.HighSeverity() > 0 {
+		points--
+	}
+	if points < -2 {
+		return -2
+	}
+	return points
+}
+
+// operationalRecommendations asks to shorten resolution when incidents take more than a day to
+// resolve on average, and to address the causes of frequent or severe incidents
+func operationalRecommendations(metrics *OperationalMetrics) []Recommendation {
+	if metrics == nil || metrics.Incidents == 0 {
+		return nil
+	}
+
+	var recommendations []Recommendation
+	if metrics.Resolved > 0 && metrics.MTTR > 24*time.Hour {
+		recommendations = append(recommendations, Recommendation{
+			ID:             "ops-001",
+			Type:           RecEnhance,
+			Description:    fmt.Sprintf("Shorten incident resolution: mean time to resolve is %s", metrics.MTTR.Round(time.Minute)),
+			Priority:       PriorityHigh,
+			BusinessImpact: "Restore service to the business faster",
+		})
+	}
+	if metrics.IncidentsPerMonth >= 4 || metrics.HighSeverity() > 0 {
+		recommendations = append(recommendations, Recommendation{
+			ID:   "ops-002",
+			Type: RecEnhance,
+			Description: fmt.Sprintf("Address the root causes of %d incidents in %d days, %d of severity 1",
+				metrics.Incidents, int(metrics.To.Sub(metrics.From).Hours()/24), metrics.HighSeverity()),
+			Priority:       PriorityHigh,
+			BusinessImpact: "Fewer service disruptions for the business",
+		})
+	}
+	return recommendations
+}
+
+// WithIncidentRepository backs assessments with the application's incident history: its
+// operational metrics are included in every assessment and move the performance score
+func WithIncidentRepository(repo IncidentRepository) EvaluationOption {
+	return func(s *EvaluationService) {
+		s.incidentRepo = repo
+	}
+}
+
+// operationalMetrics summarizes an application's incidents over the default window ending at,
+// and is nil without an incident repository
+func operationalMetrics(ctx context.Context, repo IncidentRepository, appID ApplicationID, at time.Time) (*OperationalMetrics, error) {
+	if repo == nil {
+		return nil, nil
+	}
+	incidents, err := repo.FindByApplicationID(ctx, appID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find incidents: %w", err)
+	}
+	metrics := BuildOperationalMetrics(appID, incidents, at.Add(-DefaultOperationalWindow), at)
+	return &metrics, nil
+}
+
+// WithOperationalMetrics reports the operational metrics of an agreement's application from its
+// incident history when monitoring the agreement
+func WithOperationalMetrics(incidentRepo IncidentRepository) MonitoringOption {
+	return func(s *MonitoringService) {
+		s.incidentRepo = incidentRepo
+	}
+}
+
+// MonitorOperations summarizes the incidents of the agreement's application over the last 90
+// days. It returns nil without the incident repository of WithOperationalMetrics.
+func (s *MonitoringService) MonitorOperations(ctx context.Context, agreementID GovernanceAgreementID) (*OperationalMetrics, error) {
+	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+	return operationalMetrics(ctx, s.incidentRepo, agreement.ApplicationID, time.Now())
+}
@@ -0,0 +1,217 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// OSCAL (Open Security Controls Assessment Language) is NIST's JSON format for control catalogs,
+// such as SP 800-53, and for the results of assessing systems against them. The types below cover
+// the parts of the OSCAL 1.x models the SDK reads; everything else is ignored.
+
+type oscalMetadata struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+	Parties []struct {
+		Type string `json:"type"`
+		Name string `json:"name"`
+	} `json:"parties"`
+}
+
+type oscalProperty struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type oscalControl struct {
+	ID       string          `json:"id"`
+	Title    string          `json:"title"`
+	Props    []oscalProperty `json:"props"`
+	Controls []oscalControl  `json:"controls"`
+}
+
+type oscalGroup struct {
+	ID       string         `json:"id"`
+	Title    string         `json:"title"`
+	Groups   []oscalGroup   `json:"groups"`
+	Controls []oscalControl `json:"controls"`
+}
+
+type oscalCatalog struct {
+	Catalog *struct {
+		UUID     string         `json:"uuid"`
+		Metadata oscalMetadata  `json:"metadata"`
+		Groups   []oscalGroup   `json:"groups"`
+		Controls []oscalControl `json:"controls"`
+	} `json:"catalog"`
+}
+
+// withdrawn reports whether the control was withdrawn from the catalog
+func (c oscalControl) withdrawn() bool {
+	for _, prop := range c.Props {
+		if prop.Name == "status" && strings.EqualFold(prop.Value, "withdrawn") {
+			return true
+		}
+	}
+	return false
+}
+
+// LoadOSCALCatalog reads an OSCAL catalog as a compliance framework of the kind, an industry
+// standard when empty. Every control and control enhancement that is not withdrawn becomes a
+// requirement under its OSCAL ID, e.g. "ac-2" or "ac-2.1", so "ac-2" selects a control with its
+// enhancements, and is grouped by the title of its family. The framework takes the ID given or,
+// when empty, the catalog's UUID, and the catalog's title as its name.
+func LoadOSCALCatalog(r io.Reader, id string, kind RequirementKind) (ComplianceFramework, error) {
+	var document oscalCatalog
+	if err := json.NewDecoder(r).Decode(&document); err != nil {
+		return ComplianceFramework{}, fmt.Errorf("failed to decode OSCAL catalog: %w", err)
+	}
+	catalog := document.Catalog
+	if catalog == nil {
+		return ComplianceFramework{}, fmt.Errorf("document is not an OSCAL catalog")
+	}
+
+	if id == "" {
+		id = catalog.UUID
+	}
+	if kind == "" {
+		kind = RequirementIndustryStandard
+	}
+	framework := ComplianceFramework{
+		ID:      id,
+		Name:    catalog.Metadata.Title,
+		Kind:    kind,
+		Version: catalog.Metadata.Version,
+	}
+	for _, party := range catalog.Metadata.Parties {
+		if party.Type == "organization" {
+			framework.Issuer = party.Name
+			break
+		}
+	}
+
+	var addControls func(controls []oscalControl, group string)
+	addControls = func(controls []oscalControl, group string) {
+		for _, control := range controls {
+			if control.withdrawn() {
+				continue
+			}
+			framework.Requirements = append(framework.Requirements, FrameworkRequirement{
+				ID:    control.ID,
+				Title: control.Title,
+				Group: group,
+			})
+			addControls(control.Controls, group)
+		}
+	}
+	var addGroups func(groups []oscalGroup)
+	addGroups = func(groups []oscalGroup) {
+		for _, group := range groups {
+			addControls(group.Controls, group.Title)
+			addGroups(group.Groups)
+		}
+	}
+	addControls(catalog.Controls, "")
+	addGroups(catalog.Groups)
+
+	if err := framework.Validate(); err != nil {
+		return ComplianceFramework{}, err
+	}
+	return framework, nil
+}
+
+type oscalAssessmentResults struct {
+	AssessmentResults *struct {
+		Metadata oscalMetadata `json:"metadata"`
+		Results  []struct {
+			Title    string `json:"title"`
+			Findings []struct {
+				Title  string `json:"title"`
+				Target struct {
+					Type     string `json:"type"`
+					TargetID string `json:"target-id"`
+					Status   struct {
+						State string `json:"state"`
+					} `json:"status"`
+				} `json:"target"`
+			} `json:"findings"`
+		} `json:"results"`
+	} `json:"assessment-results"`
+}
+
+// OSCALControlResult is what an OSCAL assessment found for one control: compliant when every
+// finding on it was satisfied, non-compliant when none was, and partial otherwise
+type OSCALControlResult struct {
+	RequirementID string
+	Status        ComplianceStatus
+	Satisfied     int
+	NotSatisfied  int
+}
+
+// OSCALAssessmentResults are the control statuses read from OSCAL assessment results
+type OSCALAssessmentResults struct {
+	Title    string
+	Controls []OSCALControlResult // in the order the controls were first found
+}
+
+// LoadOSCALAssessmentResults reads the findings of OSCAL assessment results by control. Findings
+// target control objectives or statements, such as "ac-2_obj.a" or "ac-2_smt.b", which count
+// toward the control they belong to. When several results assess the same control, the last one
+// holds.
+func LoadOSCALAssessmentResults(r io.Reader) (OSCALAssessmentResults, error) {
+	var document oscalAssessmentResults
+	if err := json.NewDecoder(r).Decode(&document); err != nil {
+		return OSCALAssessmentResults{}, fmt.Errorf("failed to decode OSCAL assessment results: %w", err)
+	}
+	assessment := document.AssessmentResults
+	if assessment == nil {
+		return OSCALAssessmentResults{}, fmt.Errorf("document is not OSCAL assessment results")
+	}
+
+	results := OSCALAssessmentResults{Title: assessment.Metadata.Title}
+	index := make(map[string]int)
+	for _, result := range assessment.Results {
+		assessed := make(map[string]bool)
+		for _, finding := range result.Findings {
+			requirementID, _, _ := strings.Cut(finding.Target.TargetID, "_")
+			if requirementID == "" {
+				continue
+			}
+
+			i, seen := index[requirementID]
+			if !seen {
+				i = len(results.Controls)
+				index[requirementID] = i
+				results.Controls = append(results.Controls, OSCALControlResult{RequirementID: requirementID})
+			}
+			if !assessed[requirementID] {
+				// A later result replaces what earlier ones found for the control
+				assessed[requirementID] = true
+				results.Controls[i] = OSCALControlResult{RequirementID: requirementID}
+			}
+
+			switch finding.Target.Status.State {
+			case "satisfied":
+				results.Controls[i].Satisfied++
+			case "not-satisfied":
+				results.Controls[i].NotSatisfied++
+			default:
+				return OSCALAssessmentResults{}, fmt.Errorf("finding %q on %s has unknown state %q", finding.Title, finding.Target.TargetID, finding.Target.Status.State)
+			}
+		}
+	}
+
+	for i, control := range results.Controls {
+		switch {
+		case control.NotSatisfied == 0:
+			results.Controls[i].Status = ComplianceCompliant
+		case control.Satisfied == 0:
+			results.Controls[i].Status = ComplianceNonCompliant
+		default:
+			results.Controls[i].Status = CompliancePartial
+		}
+	}
+	return results, nil
+}
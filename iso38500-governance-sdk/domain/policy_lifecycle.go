@@ -0,0 +1,196 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Submit moves a draft policy to submitted for approval. A policy needs an owner before it can
+// be submitted.
+func (p *Policy) Submit(submitter string, at time.Time) error {
+	if submitter == "" {
+		return errors.New("submitter cannot be empty")
+	}
+	if p.Status != "" && p.Status != PolicyDraft {
+		return fmt.Errorf("policy %s is %s, only draft policies can be submitted", p.ID, p.Status)
+	}
+	if p.Owner == "" {
+		return fmt.Errorf("policy %s must have an owner before it is submitted", p.ID)
+	}
+
+	p.Status = PolicySubmitted
+	p.SubmittedBy = submitter
+	p.SubmittedAt = at
+	return nil
+}
+
+// Approve approves a submitted policy. The approver must differ from the person who submitted it.
+func (p *Policy) Approve(approver string, at time.Time) error {
+	if approver == "" {
+		return errors.New("approver cannot be empty")
+	}
+	if p.Status != PolicySubmitted {
+		return fmt.Errorf("policy %s must be submitted before it is approved", p.ID)
+	}
+	if approver == p.SubmittedBy {
+		return fmt.Errorf("policy %s must be approved by someone other than its submitter", p.ID)
+	}
+
+	p.Status = PolicyApproved
+	p.ApprovedBy = approver
+	p.ApprovedAt = at
+	return nil
+}
+
+// Publish puts an approved policy into effect from effectiveFrom, until effectiveUntil when it is
+// not zero. A zero effectiveFrom takes effect at publication.
+func (p *Policy) Publish(effectiveFrom, effectiveUntil, at time.Time) error {
+	if p.Status != PolicyApproved {
+		return fmt.Errorf("policy %s must be approved before it is published", p.ID)
+	}
+	if effectiveFrom.IsZero() {
+		effectiveFrom = at
+	}
+	if !effectiveUntil.IsZero() && !effectiveUntil.After(effectiveFrom) {
+		return fmt.Errorf("policy %s must end after it takes effect", p.ID)
+	}
+
+	p.Status = PolicyPublished
+	p.PublishedAt = at
+	p.EffectiveFrom = effectiveFrom
+	p.EffectiveUntil = effectiveUntil
+	return nil
+}
+
+// Retire withdraws an approved or published policy
+func (p *Policy) Retire(reason string, at time.Time) error {
+	if reason == "" {
+		return errors.New("retirement reason cannot be empty")
+	}
+	if p.Status != PolicyApproved && p.Status != PolicyPublished {
+		return fmt.Errorf("policy %s is %s, only approved or published policies can be retired", p.ID, p.Status)
+	}
+
+	p.Status = PolicyRetired
+	p.RetiredAt = at
+	p.RetirementReason = reason
+
+	// A policy retired before it takes effect never does
+	end := at
+	if end.Before(p.EffectiveFrom) {
+		end = p.EffectiveFrom
+	}
+	if p.EffectiveUntil.IsZero() || p.EffectiveUntil.After(end) {
+		p.EffectiveUntil = end
+	}
+	return nil
+}
+
+// InEffect reports whether the policy is published and within its effective dates
+func (p Policy) InEffect(at time.Time) bool {
+	if p.Status != PolicyPublished || at.Before(p.EffectiveFrom) {
+		return false
+	}
+	return p.EffectiveUntil.IsZero() || at.Before(p.EffectiveUntil)
+}
+
+// DraftPolicy adds a draft policy to the agreement's policy framework
+func (s *DirectionService) DraftPolicy(ctx context.Context, agreementID GovernanceAgreementID, policy Policy, draftedBy string) (*Policy, error) {
+	if policy.ID == "" {
+		return nil, errors.New("policy ID cannot be empty")
+	}
+	if policy.Name == "" {
+		return nil, fmt.Errorf("policy %s: name cannot be empty", policy.ID)
+	}
+
+	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+
+	for _, existing := range agreement.Direct.PolicyFramework.Policies {
+		if existing.ID == policy.ID {
+			return nil, fmt.Errorf("policy %s already exists in agreement %s", policy.ID, agreementID)
+		}
+	}
+
+	policy.Status = PolicyDraft
+	policy.Version = 1
+	version := newDocumentVersion(DocumentPolicy, policy.ID, policy.Version, nil, policyFields(policy), draftedBy, "Drafted", time.Now())
+	snapshot := policy
+	version.Policy = &snapshot
+
+	framework := &agreement.Direct.PolicyFramework
+	framework.Policies = append(append([]Policy{}, framework.Policies...), policy)
+	framework.Versions = append(append([]DocumentVersion{}, framework.Versions...), version)
+
+	err = s.agreementRepo.Update(ctx, agreement)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update governance agreement: %w", err)
+	}
+	return &policy, nil
+}
+
+// SubmitPolicy submits one of the agreement's draft policies for approval
+func (s *DirectionService) SubmitPolicy(ctx context.Context, agreementID GovernanceAgreementID, policyID, submitter string) (*Policy, error) {
+	return s.transitionPolicy(ctx, agreementID, policyID, func(policy *Policy, now time.Time) error {
+		return policy.Submit(submitter, now)
+	})
+}
+
+// ApprovePolicy approves one of the agreement's submitted policies
+func (s *DirectionService) ApprovePolicy(ctx context.Context, agreementID GovernanceAgreementID, policyID, approver string) (*Policy, error) {
+	return s.transitionPolicy(ctx, agreementID, policyID, func(policy *Policy, now time.Time) error {
+		return policy.Approve(approver, now)
+	})
+}
+
+// PublishPolicy puts one of the agreement's approved policies into effect
+func (s *DirectionService) PublishPolicy(ctx context.Context, agreementID GovernanceAgreementID, policyID string, effectiveFrom, effectiveUntil time.Time) (*Policy, error) {
+	return s.transitionPolicy(ctx, agreementID, policyID, func(policy *Policy, now time.Time) error {
+		return policy.Publish(effectiveFrom, effectiveUntil, now)
+	})
+}
+
+// RetirePolicy withdraws one of the agreement's approved or published policies
+func (s *DirectionService) RetirePolicy(ctx context.Context, agreementID GovernanceAgreementID, policyID, reason string) (*Policy, error) {
+	return s.transitionPolicy(ctx, agreementID, policyID, func(policy *Policy, now time.Time) error {
+		return policy.Retire(reason, now)
+	})
+}
+
+// transitionPolicy applies a lifecycle transition to one of the agreement's policies and saves it
+func (s *DirectionService) transitionPolicy(ctx context.Context, agreementID GovernanceAgreementID, policyID string, transition func(*Policy, time.Time) error) (*Policy, error) {
+	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+
+	policies := agreement.Direct.PolicyFramework.Policies
+	for i := range policies {
+		if policies[i].ID != policyID {
+			continue
+		}
+
+		policy := policies[i]
+		if err := transition(&policy, time.Now()); err != nil {
+			return nil, err
+		}
+
+		updated := make([]Policy, len(policies))
+		copy(updated, policies)
+		updated[i] = policy
+		agreement.Direct.PolicyFramework.Policies = updated
+		agreement.Direct.PolicyFramework.refreshPolicySnapshot(policy)
+
+		err = s.agreementRepo.Update(ctx, agreement)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update governance agreement: %w", err)
+		}
+		return &policy, nil
+	}
+
+	return nil, fmt.Errorf("policy %s not found in agreement %s", policyID, agreementID)
+}
@@ -0,0 +1,163 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// PortfolioSummary is one portfolio's entry in a cross-portfolio comparison
+type PortfolioSummary struct {
+	Rank                 int // by health index, 1 is the healthiest
+	PortfolioID          PortfolioID
+	Name                 string
+	Owner                string
+	HealthIndex          float64
+	RiskMix              map[RiskLevel]int
+	HighRiskShare        float64 // percentage of assessed applications at high or critical risk
+	TotalCost            float64
+	Applications         int
+	GovernedApplications int      // applications with an approved or active governance agreement
+	GovernanceCoverage   float64  // percentage of applications that are governed
+	Lagging              []string // where the portfolio falls behind the cross-portfolio average
+}
+
+// PortfolioComparison compares the health, risk mix, cost and governance coverage of every
+// portfolio so the business domains that lag behind stand out
+type PortfolioComparison struct {
+	Portfolios                []PortfolioSummary // healthiest first
+	AverageHealthIndex        float64
+	AverageHighRiskShare      float64
+	AverageGovernanceCoverage float64
+	TotalCost                 float64
+	Unevaluated               []PortfolioID // portfolios whose evaluation failed
+	GeneratedAt               time.Time
+}
+
+// Lagging returns the portfolios that fall behind the average on any measure, weakest first
+func (c PortfolioComparison) Lagging() []PortfolioSummary {
+	lagging := []PortfolioSummary{}
+	for i := len(c.Portfolios) - 1; i >= 0; i-- {
+		if len(c.Portfolios[i].Lagging) > 0 {
+			lagging = append(lagging, c.Portfolios[i])
+		}
+	}
+	return lagging
+}
+
+// PortfolioComparisonService evaluates all portfolios and compares them with each other
+type PortfolioComparisonService struct {
+	evaluationService *EvaluationService
+	portfolioRepo     ApplicationPortfolioRepository
+	agreementRepo     GovernanceAgreementRepository
+}
+
+// NewPortfolioComparisonService creates a new portfolio comparison service
+func NewPortfolioComparisonService(evaluationService *EvaluationService, portfolioRepo ApplicationPortfolioRepository, agreementRepo GovernanceAgreementRepository) *PortfolioComparisonService {
+	return &PortfolioComparisonService{
+		evaluationService: evaluationService,
+		portfolioRepo:     portfolioRepo,
+		agreementRepo:     agreementRepo,
+	}
+}
+
+// ComparePortfolios evaluates every portfolio and ranks them by health index. A portfolio lags
+// when its health index or governance coverage is below the average of the evaluated
+// portfolios, or its share of high and critical risk applications is above it.
+func (s *PortfolioComparisonService) ComparePortfolios(ctx context.Context) (*PortfolioComparison, error) {
+	portfolios, err := s.portfolioRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find portfolios: %w", err)
+	}
+	sort.Slice(portfolios, func(i, j int) bool { return portfolios[i].ID < portfolios[j].ID })
+
+	comparison := &PortfolioComparison{
+		Portfolios:  make([]PortfolioSummary, 0, len(portfolios)),
+		Unevaluated: []PortfolioID{},
+		GeneratedAt: time.Now(),
+	}
+
+	for _, portfolio := range portfolios {
+		health, err := s.evaluationService.EvaluatePortfolio(ctx, portfolio.ID)
+		if err != nil {
+			comparison.Unevaluated = append(comparison.Unevaluated, portfolio.ID)
+			continue
+		}
+
+		summary := PortfolioSummary{
+			PortfolioID:  portfolio.ID,
+			Name:         portfolio.Name,
+			Owner:        portfolio.Owner,
+			HealthIndex:  health.HealthIndex.Score,
+			RiskMix:      health.RiskDistribution,
+			TotalCost:    health.TotalCost,
+			Applications: len(portfolio.Applications),
+		}
+
+		assessed := 0
+		for _, count := range health.RiskDistribution {
+			assessed += count
+		}
+		if assessed > 0 {
+			summary.HighRiskShare = float64(health.RiskDistribution[RiskHigh]+health.RiskDistribution[RiskCritical]) / float64(assessed) * 100
+		}
+
+		summary.GovernedApplications = s.governedApplications(ctx, portfolio.Applications)
+		if summary.Applications > 0 {
+			summary.GovernanceCoverage = float64(summary.GovernedApplications) / float64(summary.Applications) * 100
+		}
+
+		comparison.Portfolios = append(comparison.Portfolios, summary)
+		comparison.TotalCost += summary.TotalCost
+	}
+
+	if count := float64(len(comparison.Portfolios)); count > 0 {
+		for _, summary := range comparison.Portfolios {
+			comparison.AverageHealthIndex += summary.HealthIndex / count
+			comparison.AverageHighRiskShare += summary.HighRiskShare / count
+			comparison.AverageGovernanceCoverage += summary.GovernanceCoverage / count
+		}
+	}
+
+	sort.SliceStable(comparison.Portfolios, func(i, j int) bool {
+		return comparison.Portfolios[i].HealthIndex > comparison.Portfolios[j].HealthIndex
+	})
+	for i := range comparison.Portfolios {
+		summary := &comparison.Portfolios[i]
+		summary.Rank = i + 1
+		summary.Lagging = laggingReasons(*summary, *comparison)
+	}
+
+	return comparison, nil
+}
+
+// governedApplications counts the applications with an approved or active governance agreement
+func (s *PortfolioComparisonService) governedApplications(ctx context.Context, apps []Application) int {
+	governed := 0
+	for _, app := range apps {
+		agreement, err := s.agreementRepo.FindByApplicationID(ctx, app.ID)
+		if err != nil {
+			continue
+		}
+		if agreement.Status == AgreementApproved || agreement.Status == AgreementActive {
+			governed++
+		}
+	}
+	return governed
+}
+
+// laggingReasons describes where a portfolio falls behind the cross-portfolio averages
+func laggingReasons(summary PortfolioSummary, comparison PortfolioComparison) []string {
+	reasons := []string{}
+	if summary.HealthIndex < comparison.AverageHealthIndex {
+		reasons = append(reasons, fmt.Sprintf("health index %.0f below the %.0f average", summary.HealthIndex, comparison.AverageHealthIndex))
+	}
+	if summary.HighRiskShare > comparison.AverageHighRiskShare {
+		reasons = append(reasons, fmt.Sprintf("%.0f%% high or critical risk against the %.0f%% average", summary.HighRiskShare, comparison.AverageHighRiskShare))
+	}
+	if summary.GovernanceCoverage < comparison.AverageGovernanceCoverage {
+		reasons = append(reasons, fmt.Sprintf("governance coverage %.0f%% below the %.0f%% average", summary.GovernanceCoverage, comparison.AverageGovernanceCoverage))
+	}
+	return reasons
+}
@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// PortfolioHealthPoint is the portfolio health index as it stood at a point in time
+type PortfolioHealthPoint struct {
+	At       time.Time
+	Score    float64 // 0-100
+	Assessed int     // applications assessed by At
+}
+
+// portfolioHealthRunWindow is how close together assessments must be taken, as in one portfolio
+// evaluation, to make a single point of the portfolio health history
+const portfolioHealthRunWindow = time.Minute
+
+// PortfolioHealthHistory replays the assessments of a portfolio's applications to chart its
+// health index between from and to, inclusive: a point for each time applications were assessed,
+// scored from the latest assessment of every application at that time. Assessments taken within a
+// minute of the next one make a single point, at the last of them. A point at from carries
+// over the health left by earlier assessments. The lifecycle component counts the applications'
+// current status and KPI attainment, which has no history, is left out. A zero to ends now.
+func (s *TrendService) PortfolioHealthHistory(ctx context.Context, portfolioID PortfolioID, from, to time.Time) ([]PortfolioHealthPoint, error) {
+	if to.IsZero() {
+		to = time.Now()
+	}
+	if !from.IsZero() && from.After(to) {
+		return nil, errors.New("portfolio health history range must not end before it starts")
+	}
+
+	portfolio, err := s.portfolioRepo.FindByID(ctx, portfolioID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find portfolio: %w", err)
+	}
+
+	activeApps, deprecatedApps := 0, 0
+	var pooled []ApplicationAssessment
+	for _, app := range portfolio.Applications {
+		switch app.Status {
+		case StatusActive:
+			activeApps++
+		case StatusDeprecated:
+			deprecatedApps++
+		}
+
+		assessments, err := s.assessmentRepo.FindByApplicationID(ctx, app.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load assessment history: %w", err)
+		}
+		pooled = append(pooled, assessments...)
+	}
+	sortAssessmentsByTime(pooled)
+
+	latest := make(map[ApplicationID]ApplicationAssessment)
+	point := func(at time.Time) PortfolioHealthPoint {
+		assessments := make([]ApplicationAssessment, 0, len(latest))
+		for _, assessment := range latest {
+			assessments = append(assessments, assessment)
+		}
+		index := CalculatePortfolioHealthIndex(assessments, activeApps, deprecatedApps, KPIAttainment{}, DefaultPortfolioHealthWeights())
+		return PortfolioHealthPoint{At: at, Score: index.Score, Assessed: len(latest)}
+	}
+
+	points := []PortfolioHealthPoint{}
+	for i, assessment := range pooled {
+		if assessment.AssessedAt.After(to) {
+			break
+		}
+		if !from.IsZero() && assessment.AssessedAt.Before(from) {
+			latest[assessment.ApplicationID] = assessment
+			continue
+		}
+		if len(points) == 0 && len(latest) > 0 {
+			points = append(points, point(from))
+		}
+		latest[assessment.ApplicationID] = assessment
+
+		next := i + 1
+		if next < len(pooled) && !pooled[next].AssessedAt.After(to) && pooled[next].AssessedAt.Sub(assessment.AssessedAt) < portfolioHealthRunWindow {
+			continue
+		}
+		points = append(points, point(assessment.AssessedAt))
+	}
+	if len(points) == 0 && len(latest) > 0 {
+		points = append(points, point(from))
+	}
+	return points, nil
+}
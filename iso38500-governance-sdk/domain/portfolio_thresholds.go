@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// PortfolioThresholds let a portfolio decide its own risk levels and KPI achievement, e.g. a
+// legacy migration portfolio that tolerates lower cost efficiency than the rest of the estate
+type PortfolioThresholds struct {
+	// RiskThresholds replace the evaluation profile's thresholds for the portfolio's
+	// applications; nil keeps the profile's
+	RiskThresholds *RiskThresholds
+
+	// KPITolerance is the percentage of its target a KPI measurement may miss by and still
+	// count as achieved
+	KPITolerance float64
+}
+
+// Validate ensures the thresholds are usable
+func (t PortfolioThresholds) Validate() error {
+	if t.RiskThresholds != nil {
+		if err := t.RiskThresholds.Validate(); err != nil {
+			return err
+		}
+	}
+	if t.KPITolerance < 0 || t.KPITolerance > 100 {
+		return errors.New("KPI tolerance must be between 0 and 100 percent")
+	}
+	return nil
+}
+
+// ApplyTo returns the profile with the portfolio's risk thresholds in place of its own. A nil
+// receiver returns the profile unchanged.
+func (t *PortfolioThresholds) ApplyTo(profile EvaluationProfile) EvaluationProfile {
+	if t != nil && t.RiskThresholds != nil {
+		profile.RiskThresholds = *t.RiskThresholds
+	}
+	return profile
+}
+
+// kpiTolerance returns the KPI tolerance, zero for a nil receiver
+func (t *PortfolioThresholds) kpiTolerance() float64 {
+	if t == nil {
+		return 0
+	}
+	return t.KPITolerance
+}
+
+// withinKPITolerance reports whether a value misses its target by no more than tolerance percent
+// of the target
+func withinKPITolerance(value, target, tolerance float64) bool {
+	if tolerance <= 0 || target == 0 {
+		return false
+	}
+	return math.Abs(value-target)/math.Abs(target)*100 <= tolerance
+}
+
+// SetThresholds sets or, with nil, clears the portfolio's own thresholds
+func (ap *ApplicationPortfolio) SetThresholds(thresholds *PortfolioThresholds) error {
+	if thresholds != nil {
+		if err := thresholds.Validate(); err != nil {
+			return fmt.Errorf("invalid portfolio thresholds: %w", err)
+		}
+	}
+	ap.Thresholds = thresholds
+	return nil
+}
+
+// portfolioThresholdsFor returns the thresholds of the first portfolio, by ID, that contains
+// the application and defines thresholds, or nil when none does
+func portfolioThresholdsFor(ctx context.Context, portfolioRepo ApplicationPortfolioRepository, appID ApplicationID) *PortfolioThresholds {
+	if portfolioRepo == nil {
+		return nil
+	}
+	portfolios, err := portfolioRepo.FindAll(ctx)
+	if err != nil {
+		return nil
+	}
+	sort.Slice(portfolios, func(i, j int) bool { return portfolios[i].ID < portfolios[j].ID })
+
+	for _, portfolio := range portfolios {
+		if portfolio.Thresholds == nil {
+			continue
+		}
+		for _, app := range portfolio.Applications {
+			if app.ID == appID {
+				return portfolio.Thresholds
+			}
+		}
+	}
+	return nil
+}
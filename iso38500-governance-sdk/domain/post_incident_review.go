@@ -0,0 +1,178 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// PostIncidentReviewSeverity is the lowest severity whose incidents need a post-incident review
+// (PIR) before they can be closed: severities 1 and 2
+const PostIncidentReviewSeverity = 2
+
+// RequiresReview reports whether the incident needs a completed post-incident review before it
+// can be closed
+func (i Incident) RequiresReview() bool {
+	return i.Severity >= 1 && i.Severity <= PostIncidentReviewSeverity
+}
+
+// ReviewStatus is the stage a post-incident review has reached
+type ReviewStatus string
+
+const (
+	ReviewDraft     ReviewStatus = "draft"
+	ReviewCompleted ReviewStatus = "completed"
+)
+
+// TimelineEntry is something that happened during an incident
+type TimelineEntry struct {
+	At          time.Time
+	Description string
+}
+
+// PostIncidentReview is the review of a resolved incident: what happened when, the factors that
+// contributed to it, and the actions that keep it from happening again, each with an owner
+type PostIncidentReview struct {
+	Facilitator         string
+	Summary             string
+	Timeline            []TimelineEntry // in the order things happened
+	ContributingFactors []string
+	ActionItems         []RemediationAction
+	Status              ReviewStatus
+	StartedAt           time.Time
+	CompletedAt         time.Time
+	CompletedBy         string
+}
+
+// Validate ensures every timeline entry is dated and described and every action item has an ID,
+// a description and an owner
+func (r PostIncidentReview) Validate() error {
+	for _, entry := range r.Timeline {
+		if entry.At.IsZero() || strings.TrimSpace(entry.Description) == "" {
+			return errors.New("timeline entries need a time and a description")
+		}
+	}
+	seen := make(map[string]bool, len(r.ActionItems))
+	for _, action := range r.ActionItems {
+		if action.ID == "" || strings.TrimSpace(action.Description) == "" {
+			return errors.New("action items need an ID and a description")
+		}
+		if strings.TrimSpace(action.Owner) == "" {
+			return fmt.Errorf("action item %s must have an owner", action.ID)
+		}
+		if seen[action.ID] {
+			return fmt.Errorf("action item %s is listed twice", action.ID)
+		}
+		seen[action.ID] = true
+	}
+	return nil
+}
+
+// ReadyToComplete returns an error unless the review has a timeline, at least one contributing
+// factor and at least one action item
+func (r PostIncidentReview) ReadyToComplete() error {
+	switch {
+	case len(r.Timeline) == 0:
+		return errors.New("post-incident review has no timeline")
+	case len(r.ContributingFactors) == 0:
+		return errors.New("post-incident review names no contributing factors")
+	case len(r.ActionItems) == 0:
+		return errors.New("post-incident review has no action items")
+	}
+	return nil
+}
+
+// SortTimeline orders the timeline by when things happened
+func (r *PostIncidentReview) SortTimeline() {
+	r.Timeline = append([]TimelineEntry{}, r.Timeline...)
+	sort.SliceStable(r.Timeline, func(i, j int) bool { return r.Timeline[i].At.Before(r.Timeline[j].At) })
+}
+
+// OpenActions counts the action items still pending or in progress, and those of them overdue
+func (r PostIncidentReview) OpenActions(now time.Time) (open, overdue int) {
+	for _, action := range r.ActionItems {
+		if action.Status != ActionPending && action.Status != ActionInProgress {
+			continue
+		}
+		open++
+		if action.Overdue(now) {
+			overdue++
+		}
+	}
+	return open, overdue
+}
+
+// Reviewed reports whether the incident has a completed post-incident review
+func (i Incident) Reviewed() bool {
+	return i.Review != nil && i.Review.Status == ReviewCompleted
+}
+
+// PostIncidentReviewStatus is how far an application's high-severity incidents resolved within
+// a period have been reviewed, and the action items those reviews left open
+type PostIncidentReviewStatus struct {
+	ApplicationID  ApplicationID
+	From           time.Time
+	To             time.Time
+	Required       int      // resolved or closed incidents needing a review
+	Completed      int      // of those, with a completed review
+	Pending        []string // incidents awaiting a completed review, oldest first
+	OpenActions    int      // action items of completed reviews still pending or in progress
+	OverdueActions int
+	Completion     float64 // percentage of required reviews completed; 100 when none are required
+}
+
+// BuildPostIncidentReviewStatus reports on the reviews of the application's high-severity
+// incidents resolved between from and to
+func BuildPostIncidentReviewStatus(appID ApplicationID, incidents []Incident, from, to time.Time) PostIncidentReviewStatus {
+	status := PostIncidentReviewStatus{ApplicationID: appID, From: from, To: to, Pending: []string{}, Completion: 100}
+
+	ordered := append([]Incident{}, incidents...)
+	sort.SliceStable(ordered, func(i, j int) bool { return ordered[i].ResolvedAt.Before(ordered[j].ResolvedAt) })
+	for _, incident := range ordered {
+		if incident.ApplicationID != appID || !incident.RequiresReview() || incident.ResolvedAt.IsZero() {
+			continue
+		}
+		if incident.ResolvedAt.Before(from) || incident.ResolvedAt.After(to) {
+			continue
+		}
+		status.Required++
+		if !incident.Reviewed() {
+			status.Pending = append(status.Pending, incident.ID)
+			continue
+		}
+		status.Completed++
+		open, overdue := incident.Review.OpenActions(to)
+		status.OpenActions += open
+		status.OverdueActions += overdue
+	}
+
+	if status.Required > 0 {
+		status.Completion = float64(status.Completed) / float64(status.Required) * 100
+	}
+	return status
+}
+
+// MonitorPostIncidentReviews reports on the reviews of the high-severity incidents of the
+// agreement's application resolved over the last 90 days. It returns nil without the incident
+// repository of WithOperationalMetrics.
+func (s *MonitoringService) MonitorPostIncidentReviews(ctx context.Context, agreementID GovernanceAgreementID) (*PostIncidentReviewStatus, error) {
+	if s.incidentRepo == nil {
+		return nil, nil
+	}
+	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+
+	incidents, err := s.incidentRepo.FindByApplicationID(ctx, agreement.ApplicationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find incidents: %w", err)
+	}
+
+	now := time.Now()
+	status := BuildPostIncidentReviewStatus(agreement.ApplicationID, incidents, now.Add(-DefaultOperationalWindow), now)
+	return &status, nil
+}
@@ -22,11 +22,34 @@ type CurrentSituationAssessment struct {
 
 // ApplicationAssessment represents assessment of a specific application
 type ApplicationAssessment struct {
+	ID              string
 	ApplicationID   ApplicationID
+	Evaluator       string
+	ProfileName     string
+	AssessedAt      time.Time
 	TechnicalHealth TechnicalHealth
 	BusinessValue   BusinessValueAssessment
 	RiskLevel       RiskLevel
+	TIMEQuadrant    TIMEQuadrant
 	Recommendations []Recommendation
+	Benchmark       *BenchmarkComparison // nil when no baseline profile is configured
+	TechnicalDebt   *TechnicalDebtSummary // nil when no debt register is configured
+	SLABreaches     []SLABreach           // breaches found in the latest availability measurement
+	Operations      *OperationalMetrics   // incidents of the last 90 days; nil when no incident repository is configured
+	Changes         *ChangeMetrics        // change requests of the last 90 days; nil when no change repository is configured
+	EndOfLife       []EndOfLifeFinding    // components whose support has ended or ends soon
+	Compliance      *ConformanceScore     // nil when the agreement has no conformance requirements
+	Vendors         []VendorExposure      // risk carried through the application's vendors, riskiest first
+	Data            DataClassification    // of the application when it was assessed
+	DPIA            *DPIAFinding          // nil when the application processes no personal data or no DPIA repository is configured
+	Attachments     []Evidence            // loaded from the attachment store
+	SignOff         AssessmentSignOff
+
+	// Template names the evaluation template selected by the application's category; empty
+	// when no templates are configured
+	Template          string
+	TemplateChecks    []TemplateCheckResult
+	MandatoryEvidence []EvidenceKind // must be attached before the assessment is signed off
 }
 
 // TechnicalHealth represents the technical health of an application
@@ -36,6 +59,7 @@ type TechnicalHealth struct {
 	TestCoverage      float64
 	SecurityScore     int // 1-5 scale
 	PerformanceScore  int // 1-5 scale
+	Breakdown         *ScoreBreakdown // how the score was reached; nil when the assessor does not explain it
 }
 
 // BusinessValueAssessment represents business value assessment
@@ -44,6 +68,7 @@ type BusinessValueAssessment struct {
 	BusinessAlignment float64 // percentage
 	CostEfficiency    float64 // percentage
 	UserSatisfaction  float64 // percentage
+	EstimatedMetrics  []string // metrics estimated by heuristics rather than observed, e.g. "active_users"
 }
 
 // UsageMetrics represents application usage metrics
@@ -91,9 +116,17 @@ type PortfolioHealthAssessment struct {
 	ActiveApplications    int
 	DeprecatedApplications int
 	RedundantApplications int
-	TotalCost            float64
+	TotalCost            float64 // annual running cost of the portfolio's applications
+	CostBreakdown        ApplicationCost
 	AverageApplicationAge time.Duration
 	RiskDistribution     map[RiskLevel]int
+	ConsolidationCandidates []ConsolidationCandidate
+	TechnicalDebt        *PortfolioTechnicalDebt // nil when no debt register is configured
+	KPIScoreboard        *PortfolioKPIScoreboard // nil when monitoring has no portfolio repository
+	RiskExposure         *RiskExposure           // nil when risk simulation is not configured
+	HealthIndex          PortfolioHealthIndex
+	TIMEQuadrants        map[TIMEQuadrant][]ApplicationID // applications other than retired ones, by quadrant
+	Compliance           *PortfolioCompliance // nil when no agreement has assessed conformance requirements
 }
 
 // GovernanceMaturityAssessment represents governance maturity level
@@ -102,6 +135,8 @@ type GovernanceMaturityAssessment struct {
 	Strengths         []string
 	Weaknesses        []string
 	ImprovementAreas  []string
+	Principles        []PrincipleMaturity
+	AssessedAt        time.Time
 }
 
 // NeedsAssessment represents assessment of organizational needs
@@ -154,6 +189,7 @@ type Risk struct {
 	Probability float64 // 0-1
 	Impact      RiskImpact
 	Level       RiskLevel
+	Loss        LossEstimate // optional; the impact's default estimate is used when zero
 }
 
 // RiskImpact represents the impact of a risk
@@ -193,6 +229,7 @@ type DirectPrinciple struct {
 	PolicyFramework    PolicyFramework
 	ActionPlans        []ActionPlan
 	LastDirected       time.Time
+	DirectionProposals []DirectionProposal // oldest first
 }
 
 // StrategicDirection represents strategic direction setting
@@ -201,26 +238,35 @@ type StrategicDirection struct {
 	Mission       string
 	Objectives    []StrategicObjective
 	Initiatives   []StrategicInitiative
+	OKRs          []OKR // application-level objectives and key results
 	Timeframe     time.Duration
 }
 
 // StrategicObjective represents a strategic objective
 type StrategicObjective struct {
-	ID          string
-	Name        string
-	Description string
-	KPIs        []KPI
-	Deadline    time.Time
+	ID                  string
+	Name                string
+	Description         string
+	KPIs                []KPI
+	Deadline            time.Time
+	Contributions       []ObjectiveContribution // applications delivering the objective; none means the agreement's own application
+	ProjectedCompletion time.Time               // when the objective's action plan is projected to complete
 }
 
 // StrategicInitiative represents a strategic initiative
 type StrategicInitiative struct {
-	ID          string
-	Name        string
-	Description string
-	Owner       string
-	Budget      float64
-	Deadline    time.Time
+	ID              string
+	Name            string
+	Description     string
+	Owner           string
+	Budget          float64
+	Deadline        time.Time
+	ObjectiveIDs    []string // objectives the initiative contributes to
+	Milestones      []InitiativeMilestone
+	PercentComplete float64
+	Status          InitiativeStatus
+	Updates         []InitiativeStatusUpdate // oldest first
+	ResourceDemands []ResourceDemand         // personnel the initiative needs
 }
 
 // ResourceAllocation represents resource allocation decisions
@@ -232,10 +278,14 @@ type ResourceAllocation struct {
 
 // BudgetAllocation represents budget allocation
 type BudgetAllocation struct {
-	Category    string
-	Amount      float64
-	Timeframe   string
-	Justification string
+	Category       string
+	Amount         float64
+	Timeframe      string
+	Justification  string
+	StartDate      time.Time     // start of the period the amount covers, for the burn rate
+	EndDate        time.Time     // end of the period, for projecting spend
+	AlertThreshold float64       // percentage of the amount consumed that raises an alert, DefaultBudgetAlertThreshold when zero
+	Expenditures   []Expenditure // oldest first
 }
 
 // PersonnelAllocation represents personnel allocation
@@ -244,6 +294,9 @@ type PersonnelAllocation struct {
 	Count       int
 	SkillLevel  string
 	Timeframe   string
+	FTE         float64   // full-time equivalents available in each period, Count when zero
+	PeriodStart time.Time // zero when available from the start of planning
+	PeriodEnd   time.Time // zero when available with no end
 }
 
 // TechnologyAllocation represents technology allocation
@@ -256,20 +309,33 @@ type TechnologyAllocation struct {
 
 // PolicyFramework represents the policy framework
 type PolicyFramework struct {
-	Policies     []Policy
-	Standards    []Standard
-	Procedures   []Procedure
-	Guidelines   []Guideline
+	Policies            []Policy
+	Standards           []Standard
+	Procedures          []Procedure
+	Guidelines          []Guideline
+	Versions            []DocumentVersion    // every version of the policies, standards and procedures, oldest first
+	Pins                []DocumentPin        // documents the agreement follows at a fixed version
+	RequirementMappings []RequirementMapping // conformance requirements the documents implement
 }
 
 // Policy represents a governance policy
 type Policy struct {
-	ID          string
-	Name        string
-	Description string
-	Scope       string
-	Owner       string
-	Status      PolicyStatus
+	ID               string
+	Name             string
+	Description      string
+	Scope            string
+	Owner            string
+	Status           PolicyStatus
+	Version          int // current version, see PolicyFramework.Versions
+	SubmittedBy      string
+	SubmittedAt      time.Time
+	ApprovedBy       string
+	ApprovedAt       time.Time
+	PublishedAt      time.Time
+	EffectiveFrom    time.Time
+	EffectiveUntil   time.Time // zero when the policy has no end date
+	RetiredAt        time.Time
+	RetirementReason string
 }
 
 // PolicyStatus represents the status of a policy
@@ -277,6 +343,7 @@ type PolicyStatus string
 
 const (
 	PolicyDraft     PolicyStatus = "draft"
+	PolicySubmitted PolicyStatus = "submitted"
 	PolicyApproved  PolicyStatus = "approved"
 	PolicyPublished PolicyStatus = "published"
 	PolicyRetired   PolicyStatus = "retired"
@@ -289,6 +356,7 @@ type Standard struct {
 	Description string
 	Category    string
 	Mandatory   bool
+	Version     int // current version, see PolicyFramework.Versions
 }
 
 // Procedure represents a governance procedure
@@ -297,6 +365,7 @@ type Procedure struct {
 	Name        string
 	Description string
 	Steps       []ProcedureStep
+	Version     int // current version, see PolicyFramework.Versions
 }
 
 // ProcedureStep represents a step in a procedure
@@ -316,22 +385,30 @@ type Guideline struct {
 
 // ActionPlan represents an action plan
 type ActionPlan struct {
-	ID          string
-	Name        string
-	Description string
-	Actions     []Action
-	Owner       string
-	Deadline    time.Time
-	Status      ActionStatus
+	ID                  string
+	Name                string
+	Description         string
+	Actions             []Action
+	Owner               string
+	Deadline            time.Time
+	Status              ActionStatus
+	ResourceDemands     []ResourceDemand // personnel the plan needs
+	ObjectiveID         string           // strategic objective the plan implements
+	DependsOn           []string         // action plans that must complete before this one starts
+	ProjectedCompletion time.Time        // when the plan's schedule projects its last action to finish
 }
 
 // Action represents a specific action in an action plan
 type Action struct {
-	ID          string
-	Description string
-	Responsible string
-	Deadline    time.Time
-	Status      ActionStatus
+	ID                  string
+	Description         string
+	Responsible         string
+	Deadline            time.Time
+	Status              ActionStatus
+	Duration            time.Duration // estimated effort; without one the action is planned to finish on its deadline
+	DependsOn           []string      // actions of the same plan that must complete before this one starts
+	CompletedAt         time.Time     // zero until the action is completed
+	ProjectedCompletion time.Time     // when the plan's schedule projects the action to finish
 }
 
 // ActionStatus represents the status of an action
@@ -352,6 +429,9 @@ type MonitorPrinciple struct {
 	StakeholderFeedback   StakeholderFeedback
 	Reporting            GovernanceReporting
 	LastMonitored        time.Time
+	ActiveAlerts         []ActiveAlert      // unresolved threshold breaches
+	ComplianceBaseline   ComplianceSnapshot // requirement status when compliance was last monitored
+	DetectedViolations   []string           // IDs of the compliance violations found by the last detection
 }
 
 // PerformanceMonitoring represents performance monitoring
@@ -406,6 +486,11 @@ type Survey struct {
 	Name        string
 	Frequency   string
 	Questions   []string
+	Scale       int // highest score of an answer, scored from 1; 5 when zero
+	Invited     int // stakeholders invited to respond, for the response rate
+	Status      SurveyStatus
+	OpenedAt    time.Time
+	ClosedAt    time.Time
 }
 
 // FeedbackChannel represents a feedback collection channel
@@ -425,9 +510,10 @@ type SatisfactionScore struct {
 
 // RiskMonitoring represents risk monitoring
 type RiskMonitoring struct {
-	RiskIndicators     []RiskIndicator
-	RiskHeatMaps       []RiskHeatMap
-	MitigationTracking []MitigationTracking
+	RiskIndicators      []RiskIndicator
+	RiskHeatMaps        []RiskHeatMap
+	MitigationTracking  []MitigationTracking
+	IndicatorMonitoring []RiskIndicatorMonitoring // alert thresholds of risk indicators
 }
 
 // RiskIndicator represents a risk indicator
@@ -451,7 +537,11 @@ const (
 type RiskHeatMap struct {
 	Name        string
 	Description string
-	Data        map[string]map[string]float64 // risk vs impact matrix
+	Data        map[string]map[string]float64 // risk count by likelihood, then impact
+	Scope       RiskHeatMapScope
+	ScopeID     string            // application or portfolio ID
+	Cells       []RiskHeatMapCell // by likelihood, then impact
+	Total       int
 }
 
 // MitigationTracking represents mitigation action tracking
@@ -488,17 +578,21 @@ type SurveyResult struct {
 
 // SurveyResponse represents an individual survey response
 type SurveyResponse struct {
-	QuestionID  string
+	QuestionID  string // "q1" for the survey's first question, "q2" for the second, and so on
 	Response    string
-	Score       int
+	Score       int // 1 up to the survey's scale, 0 for an unscored answer
+	Respondent  string
+	SubmittedAt time.Time
 }
 
 // SurveySummary represents survey summary statistics
 type SurveySummary struct {
-	TotalResponses   int
-	AverageScore     float64
-	ResponseRate     float64
+	TotalResponses   int     // respondents
+	AverageScore     float64 // on the survey's scale
+	ResponseRate     float64 // respondents per invited stakeholder, 0-1
 	KeyInsights      []string
+	Satisfaction     float64 // average score on a 0-100 scale
+	Questions        []QuestionSummary
 }
 
 // CommunicationLogEntry represents a communication log entry
@@ -0,0 +1,195 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// PrioritizationWeights balance the factors used to rank recommendations
+type PrioritizationWeights struct {
+	BusinessImpact float64 // weight of the recommendation's priority and the application's business value
+	RiskReduction  float64 // weight of the risk the recommendation removes
+	Effort         float64 // 0-1, how strongly estimated effort lowers the score
+}
+
+// DefaultPrioritizationWeights weigh business impact and risk reduction equally and let effort
+// halve the score at most
+func DefaultPrioritizationWeights() PrioritizationWeights {
+	return PrioritizationWeights{BusinessImpact: 0.5, RiskReduction: 0.5, Effort: 0.5}
+}
+
+// Validate ensures the weights can rank recommendations
+func (w PrioritizationWeights) Validate() error {
+	if w.BusinessImpact < 0 || w.RiskReduction < 0 {
+		return errors.New("prioritization weights must not be negative")
+	}
+	if w.BusinessImpact+w.RiskReduction == 0 {
+		return errors.New("business impact or risk reduction must be weighted")
+	}
+	if w.Effort < 0 || w.Effort > 1 {
+		return errors.New("effort weight must be between 0 and 1")
+	}
+	return nil
+}
+
+// MaxRemediationEffort is the estimated effort at which a recommendation receives the full effort penalty
+const MaxRemediationEffort = 400 * time.Hour
+
+// defaultRemediationEffort estimates the effort of recommendations that carry no estimate
+var defaultRemediationEffort = map[RecommendationType]time.Duration{
+	RecReplace:   320 * time.Hour,
+	RecRetire:    160 * time.Hour,
+	RecModernize: 120 * time.Hour,
+	RecEnhance:   40 * time.Hour,
+	RecMaintain:  8 * time.Hour,
+}
+
+// riskReductionByType is the share of an application's risk a recommendation of each type removes
+var riskReductionByType = map[RecommendationType]float64{
+	RecReplace:   1.0,
+	RecRetire:    1.0,
+	RecModernize: 0.75,
+	RecEnhance:   0.5,
+	RecMaintain:  0.25,
+}
+
+// RemediationItem is one recommendation in a ranked remediation backlog
+type RemediationItem struct {
+	Rank           int
+	ApplicationID  ApplicationID
+	AssessmentID   string
+	Recommendation Recommendation
+	BusinessImpact float64 // 0-1
+	RiskReduction  float64 // 0-1
+	Effort         time.Duration
+	Score          float64 // 0-100, higher is addressed first
+}
+
+// RemediationBacklog ranks the open recommendations of a portfolio's applications
+type RemediationBacklog struct {
+	PortfolioID PortfolioID
+	Items       []RemediationItem
+	TotalEffort time.Duration
+	Unassessed  []ApplicationID // applications without a recorded assessment
+	GeneratedAt time.Time
+}
+
+// PrioritizationService ranks the recommendations of the latest assessments into a remediation backlog
+type PrioritizationService struct {
+	assessmentRepo AssessmentRepository
+	portfolioRepo  ApplicationPortfolioRepository
+}
+
+// NewPrioritizationService creates a new prioritization service
+func NewPrioritizationService(assessmentRepo AssessmentRepository, portfolioRepo ApplicationPortfolioRepository) *PrioritizationService {
+	return &PrioritizationService{
+		assessmentRepo: assessmentRepo,
+		portfolioRepo:  portfolioRepo,
+	}
+}
+
+// PrioritizePortfolio ranks the recommendations in the latest assessment of each application in
+// the portfolio, other than retired ones
+func (s *PrioritizationService) PrioritizePortfolio(ctx context.Context, portfolioID PortfolioID, weights PrioritizationWeights) (*RemediationBacklog, error) {
+	portfolio, err := s.portfolioRepo.FindByID(ctx, portfolioID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find portfolio: %w", err)
+	}
+
+	backlog := &RemediationBacklog{PortfolioID: portfolioID, GeneratedAt: time.Now()}
+	var assessments []ApplicationAssessment
+	for _, app := range portfolio.Applications {
+		if app.Status == StatusRetired {
+			continue
+		}
+		assessment, err := s.assessmentRepo.FindLatest(ctx, app.ID)
+		if err != nil {
+			backlog.Unassessed = append(backlog.Unassessed, app.ID)
+			continue
+		}
+		assessments = append(assessments, assessment)
+	}
+
+	items, err := PrioritizeRecommendations(assessments, weights)
+	if err != nil {
+		return nil, err
+	}
+	backlog.Items = items
+	for _, item := range items {
+		backlog.TotalEffort += item.Effort
+	}
+	return backlog, nil
+}
+
+// PrioritizeRecommendations scores every recommendation of the assessments and ranks them. Business
+// impact combines the recommendation's priority with the application's business value; risk
+// reduction is the share of the application's risk the recommendation type removes; estimated
+// effort lowers the score by up to the effort weight. Zero weights fall back to
+// DefaultPrioritizationWeights.
+func PrioritizeRecommendations(assessments []ApplicationAssessment, weights PrioritizationWeights) ([]RemediationItem, error) {
+	if weights == (PrioritizationWeights{}) {
+		weights = DefaultPrioritizationWeights()
+	}
+	if err := weights.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid prioritization weights: %w", err)
+	}
+
+	var items []RemediationItem
+	for _, assessment := range assessments {
+		value := businessValueScore(assessment) / 100
+		risk := riskScore(assessment.RiskLevel) / 4
+
+		for _, recommendation := range assessment.Recommendations {
+			effort := recommendation.EstimatedEffort
+			if effort <= 0 {
+				effort = defaultRemediationEffort[recommendation.Type]
+			}
+
+			item := RemediationItem{
+				ApplicationID:  assessment.ApplicationID,
+				AssessmentID:   assessment.ID,
+				Recommendation: recommendation,
+				BusinessImpact: priorityWeight(recommendation.Priority) * (0.5 + 0.5*value),
+				RiskReduction:  risk * riskReductionByType[recommendation.Type],
+				Effort:         effort,
+			}
+			benefit := (weights.BusinessImpact*item.BusinessImpact + weights.RiskReduction*item.RiskReduction) /
+				(weights.BusinessImpact + weights.RiskReduction)
+			penalty := weights.Effort * math.Min(float64(effort)/float64(MaxRemediationEffort), 1)
+			item.Score = math.Round(benefit*(1-penalty)*1000) / 10
+			items = append(items, item)
+		}
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].Score != items[j].Score {
+			return items[i].Score > items[j].Score
+		}
+		if items[i].Effort != items[j].Effort {
+			return items[i].Effort < items[j].Effort
+		}
+		return items[i].ApplicationID < items[j].ApplicationID
+	})
+	for i := range items {
+		items[i].Rank = i + 1
+	}
+	return items, nil
+}
+
+// priorityWeight maps a priority onto a 0.25 (low) to 1 (critical) scale
+func priorityWeight(priority Priority) float64 {
+	switch priority {
+	case PriorityCritical:
+		return 1
+	case PriorityHigh:
+		return 0.75
+	case PriorityMedium:
+		return 0.5
+	default:
+		return 0.25
+	}
+}
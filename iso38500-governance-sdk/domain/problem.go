@@ -0,0 +1,207 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ProblemStatus is the stage a problem has reached
+type ProblemStatus string
+
+const (
+	ProblemOpen       ProblemStatus = "open"        // its root cause is being analyzed
+	ProblemKnownError ProblemStatus = "known_error" // its root cause is known, with a workaround where one exists
+	ProblemResolved   ProblemStatus = "resolved"    // a change fixing its root cause was implemented
+	ProblemClosed     ProblemStatus = "closed"      // confirmed not to recur after its fix
+)
+
+// KnownError is the diagnosed root cause of a problem and how to work around it until it is fixed
+type KnownError struct {
+	RootCause  string
+	Workaround string // empty when none is known
+	AnalyzedBy string
+	RecordedAt time.Time
+}
+
+// Problem is the underlying cause of one or more incidents of an application. Recurring incidents
+// are grouped under a problem whose root cause is analyzed and recorded as a known error, and
+// fixed by the change requests linked to it; the problem is resolved once one of them is
+// implemented.
+type Problem struct {
+	ID             string
+	ApplicationID  ApplicationID
+	Title          string
+	Description    string
+	Owner          string
+	Priority       Priority
+	Status         ProblemStatus
+	Incidents      []string    // incidents caused by the problem, in the order linked
+	KnownError     *KnownError // nil until its root cause is analyzed
+	ChangeRequests []string    // change requests raised to fix it, in the order linked
+	Resolution     string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	ResolvedAt     time.Time
+	ClosedAt       time.Time
+}
+
+// Validate ensures the problem names its application, title and owner, and has a known priority
+func (p Problem) Validate() error {
+	if p.ID == "" {
+		return errors.New("problem ID cannot be empty")
+	}
+	if p.ApplicationID == "" {
+		return fmt.Errorf("problem %s must name its application", p.ID)
+	}
+	if p.Title == "" {
+		return fmt.Errorf("problem %s must have a title", p.ID)
+	}
+	if p.Owner == "" {
+		return fmt.Errorf("problem %s must name its owner", p.ID)
+	}
+	if p.Priority.Weight() == 0 {
+		return fmt.Errorf("problem %s has unknown priority %q", p.ID, p.Priority)
+	}
+	return nil
+}
+
+// Active reports whether the problem is still to be resolved
+func (p Problem) Active() bool {
+	return p.Status == ProblemOpen || p.Status == ProblemKnownError
+}
+
+// LinkIncident groups an incident of the problem's application under it. Linking an incident
+// twice has no effect.
+func (p *Problem) LinkIncident(incident Incident) error {
+	if incident.ApplicationID != p.ApplicationID {
+		return fmt.Errorf("incident %s is on %s, not on the application of problem %s", incident.ID, incident.ApplicationID, p.ID)
+	}
+	if incident.ProblemID != "" && incident.ProblemID != p.ID {
+		return fmt.Errorf("incident %s is already linked to problem %s", incident.ID, incident.ProblemID)
+	}
+	if !contains(p.Incidents, incident.ID) {
+		p.Incidents = append(p.Incidents, incident.ID)
+	}
+	return nil
+}
+
+// RecordKnownError records the diagnosed root cause of the problem, making it a known error
+func (p *Problem) RecordKnownError(knownError KnownError) error {
+	if !p.Active() {
+		return fmt.Errorf("problem %s is %s", p.ID, p.Status)
+	}
+	if strings.TrimSpace(knownError.RootCause) == "" {
+		return fmt.Errorf("root cause of problem %s cannot be empty", p.ID)
+	}
+	p.KnownError = &knownError
+	p.Status = ProblemKnownError
+	return nil
+}
+
+// LinkChangeRequest links a change request of the problem's application raised to fix it.
+// Linking a change request twice has no effect.
+func (p *Problem) LinkChangeRequest(change ChangeRequest) error {
+	if !p.Active() {
+		return fmt.Errorf("problem %s is %s", p.ID, p.Status)
+	}
+	if change.ApplicationID != p.ApplicationID {
+		return fmt.Errorf("change request %s is on %s, not on the application of problem %s", change.ID, change.ApplicationID, p.ID)
+	}
+	if !contains(p.ChangeRequests, change.ID) {
+		p.ChangeRequests = append(p.ChangeRequests, change.ID)
+	}
+	return nil
+}
+
+// Resolve resolves the known error once one of the change requests fixing it is implemented,
+// or implemented and closed, without its implementation failing. It returns the ID of that
+// change request.
+func (p *Problem) Resolve(changes []ChangeRequest, resolution string, now time.Time) (string, error) {
+	if p.Status != ProblemKnownError {
+		return "", fmt.Errorf("problem %s is %s; only known errors can be resolved", p.ID, p.Status)
+	}
+	for _, change := range changes {
+		implemented := change.Status == ChangeStatusImplemented || change.Status == ChangeStatusClosed
+		if contains(p.ChangeRequests, change.ID) && implemented && !change.ImplementationFailed() {
+			p.Status = ProblemResolved
+			p.Resolution = resolution
+			p.ResolvedAt = now
+			return change.ID, nil
+		}
+	}
+	return "", fmt.Errorf("no change request linked to problem %s has been implemented successfully", p.ID)
+}
+
+// Close closes a resolved problem once its incidents are confirmed not to recur
+func (p *Problem) Close(now time.Time) error {
+	if p.Status != ProblemResolved {
+		return fmt.Errorf("problem %s is %s; only resolved problems can be closed", p.ID, p.Status)
+	}
+	p.Status = ProblemClosed
+	p.ClosedAt = now
+	return nil
+}
+
+// DefaultRecurrenceWindow is how far back incidents are looked at for recurrences
+const DefaultRecurrenceWindow = 30 * 24 * time.Hour
+
+// RecurringIncidents is a group of incidents of an application that look alike, suggesting a
+// common underlying problem
+type RecurringIncidents struct {
+	ApplicationID ApplicationID
+	Key           string   // the root cause, or else the title, they share
+	Incidents     []string // oldest first
+	FirstSeen     time.Time
+	LastSeen      time.Time
+}
+
+// FindRecurringIncidents groups the incidents reported within the window before now that are not
+// linked to a problem yet by application and by root cause or, while it is unknown, by title.
+// Groups of at least minOccurrences incidents are returned, largest first.
+func FindRecurringIncidents(incidents []Incident, minOccurrences int, window time.Duration, now time.Time) []RecurringIncidents {
+	ordered := append([]Incident{}, incidents...)
+	sort.SliceStable(ordered, func(i, j int) bool { return ordered[i].CreatedAt.Before(ordered[j].CreatedAt) })
+
+	groups := make(map[string]*RecurringIncidents)
+	var keys []string
+	for _, incident := range ordered {
+		if incident.ProblemID != "" || now.Sub(incident.CreatedAt) > window {
+			continue
+		}
+		key := recurrenceKey(incident)
+		if key == "" {
+			continue
+		}
+		id := string(incident.ApplicationID) + "/" + key
+		group, ok := groups[id]
+		if !ok {
+			group = &RecurringIncidents{ApplicationID: incident.ApplicationID, Key: key, FirstSeen: incident.CreatedAt}
+			groups[id] = group
+			keys = append(keys, id)
+		}
+		group.Incidents = append(group.Incidents, incident.ID)
+		group.LastSeen = incident.CreatedAt
+	}
+
+	recurring := []RecurringIncidents{}
+	for _, id := range keys {
+		if len(groups[id].Incidents) >= minOccurrences {
+			recurring = append(recurring, *groups[id])
+		}
+	}
+	sort.SliceStable(recurring, func(i, j int) bool { return len(recurring[i].Incidents) > len(recurring[j].Incidents) })
+	return recurring
+}
+
+// recurrenceKey is what incidents must share to be taken as recurrences of one another: their
+// root cause when known, or else their title, ignoring case and spacing
+func recurrenceKey(incident Incident) string {
+	key := incident.RootCause
+	if strings.TrimSpace(key) == "" {
+		key = incident.Title
+	}
+	return strings.Join(strings.Fields(strings.ToLower(key)), " ")
+}
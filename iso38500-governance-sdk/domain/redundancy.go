@@ -0,0 +1,198 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	// DefaultRedundancyThreshold is the share of the smaller catalogue that must overlap for
+	// two applications to be flagged as consolidation candidates
+	DefaultRedundancyThreshold = 0.5
+
+	// functionalityMatchThreshold is the similarity at which two functions are treated as the same
+	functionalityMatchThreshold = 0.75
+)
+
+// FunctionalityOverlap pairs two functions from different applications that appear to do the same job
+type FunctionalityOverlap struct {
+	Functionality      Functionality
+	OtherFunctionality Functionality
+	Similarity         float64 // 0-1
+}
+
+// ConsolidationCandidate is a pair of applications whose catalogues overlap enough to consider
+// consolidating one onto the other
+type ConsolidationCandidate struct {
+	Retain          ApplicationID // application suggested to absorb the other
+	Redundant       ApplicationID // application suggested for consolidation
+	Overlap         float64       // share of the smaller catalogue that overlaps, 0-1
+	SharedFunctions []FunctionalityOverlap
+	Rationale       string
+}
+
+// DetectRedundancy compares the functionality catalogues of applications pairwise and returns
+// the pairs whose overlap reaches the threshold. Retired applications and applications without
+// a catalogue are ignored. catalogues maps application IDs to their functionality; applications
+// missing from it fall back to their own catalogue.
+func DetectRedundancy(apps []Application, catalogues map[ApplicationID][]Functionality, threshold float64) []ConsolidationCandidate {
+	type entry struct {
+		app       Application
+		functions []Functionality
+	}
+
+	var entries []entry
+	for _, app := range apps {
+		if app.Status == StatusRetired {
+			continue
+		}
+		functions, ok := catalogues[app.ID]
+		if !ok {
+			functions = app.Catalogue.Functionality
+		}
+		if len(functions) > 0 {
+			entries = append(entries, entry{app: app, functions: functions})
+		}
+	}
+
+	candidates := []ConsolidationCandidate{}
+	for i := 0; i < len(entries); i++ {
+		for j := i + 1; j < len(entries); j++ {
+			a, b := entries[i], entries[j]
+			shared := overlappingFunctions(a.functions, b.functions)
+			smaller := len(a.functions)
+			if len(b.functions) < smaller {
+				smaller = len(b.functions)
+			}
+
+			overlap := float64(len(shared)) / float64(smaller)
+			if len(shared) == 0 || overlap < threshold {
+				continue
+			}
+
+			retain, redundant := a, b
+			if !preferToRetain(a.app, len(a.functions), b.app, len(b.functions)) {
+				retain, redundant = b, a
+			}
+			candidates = append(candidates, ConsolidationCandidate{
+				Retain:          retain.app.ID,
+				Redundant:       redundant.app.ID,
+				Overlap:         overlap,
+				SharedFunctions: shared,
+				Rationale:       consolidationRationale(retain.app, len(retain.functions), redundant.app, len(redundant.functions), shared, smaller),
+			})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Overlap > candidates[j].Overlap
+	})
+	return candidates
+}
+
+// RedundantApplicationCount returns how many distinct applications are suggested for consolidation
+func RedundantApplicationCount(candidates []ConsolidationCandidate) int {
+	redundant := make(map[ApplicationID]bool)
+	for _, candidate := range candidates {
+		redundant[candidate.Redundant] = true
+	}
+	return len(redundant)
+}
+
+// overlappingFunctions matches each function in a to its most similar function in b
+func overlappingFunctions(a, b []Functionality) []FunctionalityOverlap {
+	var shared []FunctionalityOverlap
+	used := make(map[int]bool)
+	for _, left := range a {
+		best, bestScore := -1, 0.0
+		for k, right := range b {
+			if used[k] {
+				continue
+			}
+			if score := functionalitySimilarity(left, right); score > bestScore {
+				best, bestScore = k, score
+			}
+		}
+		if best >= 0 && bestScore >= functionalityMatchThreshold {
+			used[best] = true
+			shared = append(shared, FunctionalityOverlap{Functionality: left, OtherFunctionality: b[best], Similarity: bestScore})
+		}
+	}
+	return shared
+}
+
+// functionalitySimilarity weighs category equality and name token overlap equally. When either
+// function has no category the names alone decide.
+func functionalitySimilarity(a, b Functionality) float64 {
+	nameScore := jaccard(nameTokens(a.Name), nameTokens(b.Name))
+	if a.Category == "" || b.Category == "" {
+		return nameScore
+	}
+
+	categoryScore := 0.0
+	if strings.EqualFold(strings.TrimSpace(a.Category), strings.TrimSpace(b.Category)) {
+		categoryScore = 1
+	}
+	return 0.5*categoryScore + 0.5*nameScore
+}
+
+var nameStopWords = map[string]bool{"and": true, "the": true, "of": true, "for": true}
+
+// nameTokens splits a name into lower-case words, ignoring punctuation and stop words
+func nameTokens(name string) map[string]bool {
+	tokens := make(map[string]bool)
+	for _, word := range strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		if !nameStopWords[word] {
+			tokens[word] = true
+		}
+	}
+	return tokens
+}
+
+func jaccard(a, b map[string]bool) float64 {
+	if len(a) == 0 && len(b) == 0 {
+		return 0
+	}
+	intersection := 0
+	for token := range a {
+		if b[token] {
+			intersection++
+		}
+	}
+	return float64(intersection) / float64(len(a)+len(b)-intersection)
+}
+
+// preferToRetain reports whether a should be kept over b: active applications win, then the
+// broader catalogue, then the more recently created application
+func preferToRetain(a Application, aFunctions int, b Application, bFunctions int) bool {
+	if (a.Status == StatusActive) != (b.Status == StatusActive) {
+		return a.Status == StatusActive
+	}
+	if aFunctions != bFunctions {
+		return aFunctions > bFunctions
+	}
+	return !a.CreatedAt.Before(b.CreatedAt)
+}
+
+func consolidationRationale(retain Application, retainFunctions int, redundant Application, redundantFunctions int, shared []FunctionalityOverlap, smaller int) string {
+	names := make([]string, 0, len(shared))
+	for _, overlap := range shared {
+		names = append(names, overlap.Functionality.Name)
+	}
+
+	var reason string
+	switch {
+	case (retain.Status == StatusActive) != (redundant.Status == StatusActive):
+		reason = fmt.Sprintf("%s is %s", redundant.Name, redundant.Status)
+	case retainFunctions != redundantFunctions:
+		reason = "it has the broader catalogue"
+	default:
+		reason = "it is the newer application"
+	}
+	return fmt.Sprintf("%s and %s share %d of %d functions (%s); consider consolidating onto %s because %s",
+		retain.Name, redundant.Name, len(shared), smaller, strings.Join(names, ", "), retain.Name, reason)
+}
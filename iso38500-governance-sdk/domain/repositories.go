@@ -61,11 +61,32 @@ type IncidentRepository interface {
 	FindByApplicationID(ctx context.Context, appID ApplicationID) ([]Incident, error)
 	FindByStatus(ctx context.Context, status IncidentStatus) ([]Incident, error)
 	FindBySeverity(ctx context.Context, severity int) ([]Incident, error)
+	FindByAssignee(ctx context.Context, assignee string) ([]Incident, error)
 	Update(ctx context.Context, incident Incident) error
 	Delete(ctx context.Context, id string) error
 	Exists(ctx context.Context, id string) (bool, error)
 }
 
+// ProblemRepository defines the interface for problem data access
+type ProblemRepository interface {
+	Save(ctx context.Context, problem Problem) error
+	FindByID(ctx context.Context, id string) (Problem, error)
+	FindByApplicationID(ctx context.Context, appID ApplicationID) ([]Problem, error)
+	FindByStatus(ctx context.Context, status ProblemStatus) ([]Problem, error)
+	Update(ctx context.Context, problem Problem) error
+	Delete(ctx context.Context, id string) error
+}
+
+// ITSMLinkRepository defines the interface for access to the links between change requests or
+// incidents and the ITSM records mirroring them
+type ITSMLinkRepository interface {
+	Save(ctx context.Context, link ITSMLink) error
+	Find(ctx context.Context, kind ITSMRecordKind, localID string) (ITSMLink, error) // ErrITSMLinkNotFound when not mirrored
+	FindByConnector(ctx context.Context, connector string) ([]ITSMLink, error)
+	FindAll(ctx context.Context) ([]ITSMLink, error)
+	Delete(ctx context.Context, kind ITSMRecordKind, localID string) error
+}
+
 // AuditRepository defines the interface for audit data access
 type AuditRepository interface {
 	Save(ctx context.Context, audit Audit) error
@@ -98,6 +119,177 @@ type KPIMeasurementRepository interface {
 	Delete(ctx context.Context, kpiID string, measuredAt time.Time) error
 }
 
+// AssessmentRepository defines the interface for application assessment history
+type AssessmentRepository interface {
+	Save(ctx context.Context, assessment ApplicationAssessment) error
+	FindByApplicationID(ctx context.Context, appID ApplicationID) ([]ApplicationAssessment, error)
+	FindLatest(ctx context.Context, appID ApplicationID) (ApplicationAssessment, error)
+	FindByPeriod(ctx context.Context, appID ApplicationID, start, end time.Time) ([]ApplicationAssessment, error)
+	Update(ctx context.Context, assessment ApplicationAssessment) error
+}
+
+// TechnicalDebtRepository defines the interface for technical debt register access
+type TechnicalDebtRepository interface {
+	Save(ctx context.Context, item TechnicalDebtItem) error
+	FindByID(ctx context.Context, id string) (TechnicalDebtItem, error)
+	FindByApplicationID(ctx context.Context, appID ApplicationID) ([]TechnicalDebtItem, error)
+	FindByStatus(ctx context.Context, status TechnicalDebtStatus) ([]TechnicalDebtItem, error)
+	Update(ctx context.Context, item TechnicalDebtItem) error
+	Delete(ctx context.Context, id string) error
+}
+
+// VendorRepository defines the interface for vendor data access
+type VendorRepository interface {
+	Save(ctx context.Context, vendor Vendor) error
+	FindByID(ctx context.Context, id VendorID) (Vendor, error)
+	FindByApplicationID(ctx context.Context, appID ApplicationID) ([]Vendor, error)
+	FindAll(ctx context.Context) ([]Vendor, error)
+	Update(ctx context.Context, vendor Vendor) error
+	Delete(ctx context.Context, id VendorID) error
+}
+
+// DPIARepository defines the interface for data protection impact assessment access
+type DPIARepository interface {
+	Save(ctx context.Context, dpia DPIA) error
+	FindByID(ctx context.Context, id string) (DPIA, error)
+	FindByApplicationID(ctx context.Context, appID ApplicationID) ([]DPIA, error)
+	FindAll(ctx context.Context) ([]DPIA, error)
+	Update(ctx context.Context, dpia DPIA) error
+	Delete(ctx context.Context, id string) error
+}
+
+// RetentionPolicyRepository defines the interface for data retention policy access
+type RetentionPolicyRepository interface {
+	Save(ctx context.Context, policy RetentionPolicy) error
+	FindByID(ctx context.Context, id string) (RetentionPolicy, error)
+	FindAll(ctx context.Context) ([]RetentionPolicy, error)
+	Delete(ctx context.Context, id string) error
+}
+
+// DataHoldingRepository defines the interface for access to the data applications hold. An
+// application has one holding per data category; saving a holding replaces the previous one.
+type DataHoldingRepository interface {
+	Save(ctx context.Context, holding DataHolding) error
+	FindByApplicationID(ctx context.Context, appID ApplicationID) ([]DataHolding, error)
+	FindAll(ctx context.Context) ([]DataHolding, error)
+	Delete(ctx context.Context, appID ApplicationID, category string) error
+}
+
+// DecisionRepository defines the interface for governance decision log access. Decisions are
+// durable records, so the log has no delete.
+type DecisionRepository interface {
+	Save(ctx context.Context, decision Decision) error
+	FindByID(ctx context.Context, id string) (Decision, error)
+	FindByAgreementID(ctx context.Context, agreementID GovernanceAgreementID) ([]Decision, error)
+	FindByApplicationID(ctx context.Context, appID ApplicationID) ([]Decision, error)
+	FindAll(ctx context.Context) ([]Decision, error)
+	Update(ctx context.Context, decision Decision) error
+}
+
+// AvailabilityMeasurementRepository defines the interface for observed availability data access
+type AvailabilityMeasurementRepository interface {
+	Save(ctx context.Context, measurement AvailabilityMeasurement) error
+	FindByApplicationID(ctx context.Context, appID ApplicationID) ([]AvailabilityMeasurement, error)
+	FindLatest(ctx context.Context, appID ApplicationID) (AvailabilityMeasurement, error)
+	FindByPeriod(ctx context.Context, appID ApplicationID, start, end time.Time) ([]AvailabilityMeasurement, error)
+}
+
+// EvaluationScheduleRepository defines the interface for recurring evaluation schedule access
+type EvaluationScheduleRepository interface {
+	Save(ctx context.Context, schedule EvaluationSchedule) error
+	FindByID(ctx context.Context, id string) (EvaluationSchedule, error)
+	FindAll(ctx context.Context) ([]EvaluationSchedule, error)
+	FindDue(ctx context.Context, now time.Time) ([]EvaluationSchedule, error)
+	Update(ctx context.Context, schedule EvaluationSchedule) error
+	Delete(ctx context.Context, id string) error
+}
+
+// MonitoringRunRepository defines the interface for the history of agreement monitoring runs
+type MonitoringRunRepository interface {
+	Save(ctx context.Context, run MonitoringRun) error
+	FindByAgreementID(ctx context.Context, agreementID GovernanceAgreementID) ([]MonitoringRun, error)
+	FindLatest(ctx context.Context, agreementID GovernanceAgreementID) (MonitoringRun, error)
+}
+
+// EscalationRepository defines the interface for the escalation steps taken on alerts, incidents
+// and change requests
+type EscalationRepository interface {
+	Save(ctx context.Context, step EscalationStep) error
+	FindBySubject(ctx context.Context, subject EscalationSubject, subjectID string) ([]EscalationStep, error)
+	FindByApplicationID(ctx context.Context, appID ApplicationID) ([]EscalationStep, error)
+}
+
+// TelemetryBindingRepository defines the interface for the external metrics KPIs are measured
+// from, one binding per KPI
+type TelemetryBindingRepository interface {
+	Save(ctx context.Context, binding TelemetryBinding) error // adds or replaces the KPI's binding
+	FindByKPIID(ctx context.Context, kpiID string) (TelemetryBinding, error)
+	FindAll(ctx context.Context) ([]TelemetryBinding, error)
+	Delete(ctx context.Context, kpiID string) error
+}
+
+// ExecutiveDigestRepository defines the interface for the executive digests generated of portfolios
+type ExecutiveDigestRepository interface {
+	Save(ctx context.Context, digest ExecutiveDigest) error
+	FindByID(ctx context.Context, id string) (ExecutiveDigest, error)
+	FindByPortfolioID(ctx context.Context, portfolioID PortfolioID) ([]ExecutiveDigest, error) // oldest first
+}
+
+// FrameworkRepository defines the interface for the catalog of compliance frameworks
+type FrameworkRepository interface {
+	Save(ctx context.Context, framework ComplianceFramework) error // adds or replaces the framework
+	FindByID(ctx context.Context, id string) (ComplianceFramework, error)
+	FindAll(ctx context.Context) ([]ComplianceFramework, error)
+}
+
+// ControlMappingRepository defines the interface for the mappings between controls of different
+// compliance frameworks
+type ControlMappingRepository interface {
+	Save(ctx context.Context, mapping ControlMapping) error // adds or replaces the mapping
+	FindByID(ctx context.Context, id string) (ControlMapping, error)
+	FindAll(ctx context.Context) ([]ControlMapping, error)
+}
+
+// ComplianceAssessmentRepository defines the interface for the compliance assessments of agreements
+type ComplianceAssessmentRepository interface {
+	Save(ctx context.Context, assessment ComplianceAssessment) error // adds or replaces the assessment
+	FindByID(ctx context.Context, id string) (ComplianceAssessment, error)
+	FindByAgreementID(ctx context.Context, agreementID GovernanceAgreementID) ([]ComplianceAssessment, error) // oldest first
+}
+
+// AttestationCampaignRepository defines the interface for attestation campaign rounds
+type AttestationCampaignRepository interface {
+	Save(ctx context.Context, campaign AttestationCampaign) error // adds or replaces the round
+	FindByID(ctx context.Context, id string) (AttestationCampaign, error)
+	FindAll(ctx context.Context) ([]AttestationCampaign, error) // oldest first
+}
+
+// AuditTrailRepository defines the interface for the append-only audit trail of governance
+// changes. Entries cannot be changed or deleted.
+type AuditTrailRepository interface {
+	Append(ctx context.Context, entry AuditTrailEntry) (AuditTrailEntry, error) // chains the entry after the last one and returns it sealed
+	Find(ctx context.Context, query AuditTrailQuery) ([]AuditTrailEntry, error)  // oldest first
+	FindAll(ctx context.Context) ([]AuditTrailEntry, error)                      // oldest first
+}
+
+// MonitoringSnapshotRepository defines the interface for the history of agreement monitoring results
+type MonitoringSnapshotRepository interface {
+	Save(ctx context.Context, snapshot MonitoringSnapshot) error
+	FindByAgreementID(ctx context.Context, agreementID GovernanceAgreementID) ([]MonitoringSnapshot, error)
+	FindByPeriod(ctx context.Context, agreementID GovernanceAgreementID, start, end time.Time) ([]MonitoringSnapshot, error)
+}
+
+// AttachmentStore defines the interface for evidence attached to assessments and audit findings
+type AttachmentStore interface {
+	Save(ctx context.Context, evidence Evidence) error
+	FindByID(ctx context.Context, id string) (Evidence, error)
+	FindBySubject(ctx context.Context, subject EvidenceSubject) ([]Evidence, error)
+	FindByApplicationID(ctx context.Context, appID ApplicationID) ([]Evidence, error)
+	FindExpired(ctx context.Context, now time.Time) ([]Evidence, error) // past its validity, expiry not yet recorded
+	MarkExpired(ctx context.Context, id string, at time.Time) error
+	Delete(ctx context.Context, id string) error
+}
+
 // RiskRepository defines the interface for risk data access
 type RiskRepository interface {
 	Save(ctx context.Context, risk Risk) error
@@ -120,13 +312,18 @@ type MitigationPlanRepository interface {
 	Exists(ctx context.Context, riskID string) (bool, error)
 }
 
-// ComplianceRepository defines the interface for compliance data access
+// ComplianceRepository defines the interface for the register of the legal, contractual and
+// industry standard requirements applications are held to. Requirements are identified by
+// application, kind and name; saving a requirement replaces the one of the same name.
 type ComplianceRepository interface {
-	SaveRequirement(ctx context.Context, req interface{}) error
+	SaveLegalRequirement(ctx context.Context, appID ApplicationID, req LegalRequirement) error
+	SaveContractualRequirement(ctx context.Context, appID ApplicationID, req ContractualRequirement) error
+	SaveIndustryStandard(ctx context.Context, appID ApplicationID, standard IndustryStandard) error
 	FindLegalRequirements(ctx context.Context, appID ApplicationID) ([]LegalRequirement, error)
 	FindContractualRequirements(ctx context.Context, appID ApplicationID) ([]ContractualRequirement, error)
 	FindIndustryStandards(ctx context.Context, appID ApplicationID) ([]IndustryStandard, error)
-	UpdateComplianceStatus(ctx context.Context, reqType, reqID string, status ComplianceStatus) error
+	UpdateComplianceStatus(ctx context.Context, appID ApplicationID, ref RequirementRef, status ComplianceStatus) error
+	DeleteRequirement(ctx context.Context, appID ApplicationID, ref RequirementRef) error
 }
 
 // DomainEventRepository defines the interface for domain event data access
@@ -152,8 +349,15 @@ type ChangeRequest struct {
 	Impact        string
 	Risk          string
 	Approvals     []Approval
+	ImpactAnalysis *ChangeImpact // downstream applications and agreements the change affects, computed on creation
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
+	SubmittedAt   time.Time
+	ImplementedAt time.Time
+	Implementation *ChangeImplementation // nil until implemented
+	Verification   *ChangeVerification   // nil until closed
+	ClosedAt      time.Time
+	Cancellation   *ChangeCancellation   // nil unless withdrawn or cancelled
 }
 
 // ChangeRequestStatus represents the status of a change request
@@ -162,12 +366,24 @@ type ChangeRequestStatus string
 const (
 	ChangeStatusDraft     ChangeRequestStatus = "draft"
 	ChangeStatusSubmitted ChangeRequestStatus = "submitted"
+	ChangeStatusPendingApproval ChangeRequestStatus = "pending_approval" // approved by some, not yet all, required approvers
 	ChangeStatusApproved  ChangeRequestStatus = "approved"
 	ChangeStatusRejected  ChangeRequestStatus = "rejected"
 	ChangeStatusImplemented ChangeRequestStatus = "implemented"
 	ChangeStatusClosed    ChangeRequestStatus = "closed"
+	ChangeStatusWithdrawn ChangeRequestStatus = "withdrawn" // withdrawn by its requester before it was approved
+	ChangeStatusCancelled ChangeRequestStatus = "cancelled" // cancelled by an approver before it was implemented
 )
 
+// Open reports whether the change request still awaits a decision or implementation
+func (cr ChangeRequest) Open() bool {
+	switch cr.Status {
+	case ChangeStatusDraft, ChangeStatusSubmitted, ChangeStatusPendingApproval, ChangeStatusApproved:
+		return true
+	}
+	return false
+}
+
 // Approval represents an approval for a change request
 type Approval struct {
 	Approver    string
@@ -188,20 +404,36 @@ const (
 
 // Incident represents an incident entity
 type Incident struct {
-	ID            string
-	ApplicationID ApplicationID
-	Reporter      string
-	Severity      int
-	Status        IncidentStatus
-	Title         string
-	Description   string
-	Impact        string
-	RootCause     string
-	Resolution    string
-	TimeToResolve time.Duration
-	CreatedAt     time.Time
-	UpdatedAt     time.Time
-	ResolvedAt    time.Time
+	ID             string
+	ApplicationID  ApplicationID
+	Reporter       string
+	Severity       int
+	Status         IncidentStatus
+	Title          string
+	Description    string
+	Impact         string
+	RootCause      string
+	Resolution     string
+	TimeToResolve  time.Duration
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	AcknowledgedAt time.Time
+	ResolvedAt     time.Time
+	SuspectedChanges []string // change requests implemented shortly before it was reported, likely causing it
+	ResponseDue    time.Time // when it must be acknowledged under its agreement's SLA; zero without one
+	ResolutionDue  time.Time // when it must be resolved under its agreement's SLA; zero without one
+	SLAStatus      IncidentSLAStatus // empty without an SLA
+	ResponseBreachedAt   time.Time
+	ResolutionBreachedAt time.Time
+	ProblemID      string // the problem it is grouped under; empty when none
+	Review         *PostIncidentReview // nil until its review is started
+	ClosedAt       time.Time
+	Assignee       string // who is working on it; empty while unassigned
+	AssignedAt     time.Time
+	ReopenCount    int    // times it was reopened after being resolved
+	ClosureCode    IncidentClosureCode // why it was closed; empty until closed
+	Priority       int    // number of its priority in its agreement's prioritization matrix; zero without one
+	Classification *IncidentClassification // the severity and priority suggested when it was reported
 }
 
 // IncidentStatus represents the status of an incident
@@ -224,8 +456,13 @@ type Audit struct {
 	Scope         string
 	Findings      []AuditFinding
 	Recommendations []string
-	StartedAt     time.Time
+	ScheduledAt   time.Time // planned start
+	DueDate       time.Time // when the audit must be completed by; zero when it has no deadline
+	StartedAt     time.Time // zero until the audit is started
 	CompletedAt   time.Time
+	ClosedAt      time.Time
+	CancelledAt   time.Time
+	CancellationReason string
 }
 
 // AuditType represents the type of audit
@@ -246,6 +483,8 @@ const (
 	AuditStatusInProgress AuditStatus = "in_progress"
 	AuditStatusCompleted  AuditStatus = "completed"
 	AuditStatusOverdue    AuditStatus = "overdue"
+	AuditStatusCancelled  AuditStatus = "cancelled"
+	AuditStatusClosed     AuditStatus = "closed" // completed and its findings remediated
 )
 
 // AuditFinding represents an audit finding
@@ -256,4 +495,7 @@ type AuditFinding struct {
 	Description string
 	Evidence    string
 	Remediation string
+	Actions     []RemediationAction // actions taken to remediate the finding
+	Attachments []Evidence // loaded from the attachment store
+	Controls    []ControlRef // framework controls the finding shows are deficient
 }
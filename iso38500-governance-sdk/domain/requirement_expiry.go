@@ -0,0 +1,150 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// ExpiryWarningPolicy sets how long before a certification or contract expires warnings are raised
+type ExpiryWarningPolicy struct {
+	LeadDays []int // days before expiry a warning is raised, e.g. 90, 30 and 7
+}
+
+// DefaultExpiryWarningPolicy warns 90, 30 and 7 days before expiry
+func DefaultExpiryWarningPolicy() ExpiryWarningPolicy {
+	return ExpiryWarningPolicy{LeadDays: []int{90, 30, 7}}
+}
+
+// Validate ensures every lead time is positive
+func (p ExpiryWarningPolicy) Validate() error {
+	for _, days := range p.LeadDays {
+		if days <= 0 {
+			return fmt.Errorf("expiry warning lead time must be positive, got %d days", days)
+		}
+	}
+	return nil
+}
+
+// leadDays returns the shortest lead time the days to expiry are within, 0 when none is reached
+func (p ExpiryWarningPolicy) leadDays(daysToExpiry int) int {
+	reached := 0
+	for _, days := range p.LeadDays {
+		if daysToExpiry <= days && (reached == 0 || days < reached) {
+			reached = days
+		}
+	}
+	return reached
+}
+
+// ExpiryState is where a certification or contract stands against its expiry
+type ExpiryState string
+
+const (
+	ExpiryValid    ExpiryState = "valid"    // no warning lead time reached yet
+	ExpiryExpiring ExpiryState = "expiring" // within a warning lead time
+	ExpiryExpired  ExpiryState = "expired"
+)
+
+// RequirementExpiry is how close a contractual requirement or industry standard certification is
+// to expiring
+type RequirementExpiry struct {
+	Requirement  RequirementRef
+	Party        string // the contract's party or the certifying organization
+	Status       ComplianceStatus
+	Criticality  Priority
+	ExpiresAt    time.Time
+	DaysToExpiry int // negative once expired
+	State        ExpiryState
+	LeadDays     int // the shortest warning lead time reached, 0 when none
+}
+
+// DaysToExpiry returns the whole days left until expiresAt, rounded up, so it is 1 on the day
+// before expiry and 0 or less from the moment of expiry
+func DaysToExpiry(expiresAt, now time.Time) int {
+	left := expiresAt.Sub(now)
+	days := int(left / (24 * time.Hour))
+	if left > 0 && left%(24*time.Hour) != 0 {
+		days++
+	}
+	return days
+}
+
+// TrackRequirementExpiry returns the contractual requirements and industry standards of the
+// conformance component that expire, soonest first, with the warning lead time each has reached
+func TrackRequirementExpiry(conformance Conformance, policy ExpiryWarningPolicy, now time.Time) []RequirementExpiry {
+	var tracked []RequirementExpiry
+	track := func(ref RequirementRef, party string, status ComplianceStatus, criticality Priority, expiresAt time.Time) {
+		if expiresAt.IsZero() {
+			return
+		}
+		expiry := RequirementExpiry{
+			Requirement:  ref,
+			Party:        party,
+			Status:       status,
+			Criticality:  criticality,
+			ExpiresAt:    expiresAt,
+			DaysToExpiry: DaysToExpiry(expiresAt, now),
+			State:        ExpiryValid,
+		}
+		if !now.Before(expiresAt) {
+			expiry.State = ExpiryExpired
+		} else if expiry.LeadDays = policy.leadDays(expiry.DaysToExpiry); expiry.LeadDays > 0 {
+			expiry.State = ExpiryExpiring
+		}
+		tracked = append(tracked, expiry)
+	}
+
+	for _, requirement := range conformance.ContractualRequirements {
+		track(RequirementRef{Kind: RequirementContractual, Name: requirement.Name}, requirement.Party, requirement.Status, requirement.Criticality, requirement.ExpiresAt)
+	}
+	for _, standard := range conformance.IndustryStandards {
+		track(RequirementRef{Kind: RequirementIndustryStandard, Name: standard.Name}, standard.Organization, standard.Status, standard.Criticality, standard.ExpiresAt)
+	}
+	sort.SliceStable(tracked, func(i, j int) bool { return tracked[i].ExpiresAt.Before(tracked[j].ExpiresAt) })
+	return tracked
+}
+
+// DowngradeExpiredRequirements makes the expired contractual requirements and certifications of
+// the conformance component non-compliant, since an expired contract or certification no longer
+// shows the requirement is met. It returns the component and the requirements downgraded, with
+// the status they had before.
+func DowngradeExpiredRequirements(conformance Conformance, now time.Time) (Conformance, []RequirementExpiry) {
+	var downgraded []RequirementExpiry
+	for _, expiry := range TrackRequirementExpiry(conformance, ExpiryWarningPolicy{}, now) {
+		if expiry.State != ExpiryExpired || expiry.Status == ComplianceNonCompliant {
+			continue
+		}
+		setComplianceStatus(&conformance, expiry.Requirement, ComplianceNonCompliant)
+		downgraded = append(downgraded, expiry)
+	}
+	return conformance, downgraded
+}
+
+// SetRequirementExpiry sets when the contractual requirement or industry standard certification
+// the conformance component names expires, copying the requirement lists so the component's
+// previous value is left as it was. A zero expiresAt means it does not expire.
+func SetRequirementExpiry(conformance *Conformance, ref RequirementRef, expiresAt time.Time) error {
+	switch ref.Kind {
+	case RequirementContractual:
+		conformance.ContractualRequirements = append([]ContractualRequirement{}, conformance.ContractualRequirements...)
+		for i := range conformance.ContractualRequirements {
+			if conformance.ContractualRequirements[i].Name == ref.Name {
+				conformance.ContractualRequirements[i].ExpiresAt = expiresAt
+				return nil
+			}
+		}
+	case RequirementIndustryStandard:
+		conformance.IndustryStandards = append([]IndustryStandard{}, conformance.IndustryStandards...)
+		for i := range conformance.IndustryStandards {
+			if conformance.IndustryStandards[i].Name == ref.Name {
+				conformance.IndustryStandards[i].ExpiresAt = expiresAt
+				return nil
+			}
+		}
+	default:
+		return errors.New("only contractual requirements and industry standard certifications expire")
+	}
+	return fmt.Errorf("%s requirement not found", ref)
+}
@@ -0,0 +1,274 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// RequirementKind identifies the kind of conformance requirement
+type RequirementKind string
+
+const (
+	RequirementLegal            RequirementKind = "legal"
+	RequirementContractual      RequirementKind = "contractual"
+	RequirementIndustryStandard RequirementKind = "industry_standard"
+)
+
+// RequirementRef names one of an agreement's conformance requirements. Requirements are
+// identified by kind and name.
+type RequirementRef struct {
+	Kind RequirementKind
+	Name string
+}
+
+// String names the requirement with its kind
+func (r RequirementRef) String() string {
+	return fmt.Sprintf("%s %s", r.Kind, r.Name)
+}
+
+// RequirementMapping records that a policy, standard or procedure implements a conformance
+// requirement
+type RequirementMapping struct {
+	Requirement  RequirementRef
+	DocumentKind DocumentKind
+	DocumentID   string
+	Notes        string // how the document satisfies the requirement
+	MappedBy     string
+	MappedAt     time.Time
+}
+
+// RequirementImplementation is a conformance requirement with the documents mapped to it
+type RequirementImplementation struct {
+	Requirement RequirementRef
+	Description string
+	Status      ComplianceStatus
+	Criticality Priority
+	Mappings    []RequirementMapping
+	InEffect    []RequirementMapping // mapped documents currently in effect
+}
+
+// Implemented reports whether a document in effect implements the requirement
+func (i RequirementImplementation) Implemented() bool {
+	return len(i.InEffect) > 0
+}
+
+// RequirementCoverage reports how well an agreement's legal, contractual and industry standard
+// requirements are implemented by its policy framework: requirements no document is mapped to,
+// and requirements only mapped to documents that are not in effect
+type RequirementCoverage struct {
+	AgreementID  GovernanceAgreementID
+	Requirements []RequirementImplementation
+	Unmapped     []RequirementRef // requirements no document is mapped to
+	NotInEffect  []RequirementRef // requirements whose mapped documents are all drafts, retired, not yet effective or removed
+	CheckedAt    time.Time
+}
+
+// HasGaps reports whether any requirement is not implemented
+func (c RequirementCoverage) HasGaps() bool {
+	return len(c.Unmapped) > 0 || len(c.NotInEffect) > 0
+}
+
+// Implemented returns the number of requirements implemented by a document in effect
+func (c RequirementCoverage) Implemented() int {
+	return len(c.Requirements) - len(c.Unmapped) - len(c.NotInEffect)
+}
+
+// CoverageRatio returns the fraction of requirements implemented, 1 when there are none
+func (c RequirementCoverage) CoverageRatio() float64 {
+	if len(c.Requirements) == 0 {
+		return 1
+	}
+	return float64(c.Implemented()) / float64(len(c.Requirements))
+}
+
+// conformanceRequirements lists the agreement's legal, contractual and industry standard
+// requirements
+func conformanceRequirements(conformance Conformance) []RequirementImplementation {
+	var requirements []RequirementImplementation
+	for _, requirement := range conformance.LegalRequirements {
+		requirements = append(requirements, RequirementImplementation{
+			Requirement: RequirementRef{Kind: RequirementLegal, Name: requirement.Name},
+			Description: requirement.Description,
+			Status:      requirement.Status,
+			Criticality: requirement.Criticality,
+		})
+	}
+	for _, requirement := range conformance.ContractualRequirements {
+		requirements = append(requirements, RequirementImplementation{
+			Requirement: RequirementRef{Kind: RequirementContractual, Name: requirement.Name},
+			Description: requirement.Description,
+			Status:      requirement.Status,
+			Criticality: requirement.Criticality,
+		})
+	}
+	for _, standard := range conformance.IndustryStandards {
+		requirements = append(requirements, RequirementImplementation{
+			Requirement: RequirementRef{Kind: RequirementIndustryStandard, Name: standard.Name},
+			Description: standard.Description,
+			Status:      standard.Status,
+			Criticality: standard.Criticality,
+		})
+	}
+	return requirements
+}
+
+// hasRequirement reports whether the conformance component names the requirement
+func hasRequirement(conformance Conformance, ref RequirementRef) bool {
+	for _, requirement := range conformanceRequirements(conformance) {
+		if requirement.Requirement == ref {
+			return true
+		}
+	}
+	return false
+}
+
+// hasDocument reports whether the policy framework holds the document
+func (f PolicyFramework) hasDocument(kind DocumentKind, documentID string) bool {
+	switch kind {
+	case DocumentPolicy:
+		for _, policy := range f.Policies {
+			if policy.ID == documentID {
+				return true
+			}
+		}
+	case DocumentStandard:
+		for _, standard := range f.Standards {
+			if standard.ID == documentID {
+				return true
+			}
+		}
+	case DocumentProcedure:
+		for _, procedure := range f.Procedures {
+			if procedure.ID == documentID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// documentInEffect reports whether a document of the framework is in effect. Policies follow
+// their lifecycle and pins; standards and procedures are in effect while they are in the
+// framework.
+func (f PolicyFramework) documentInEffect(kind DocumentKind, documentID string, at time.Time) bool {
+	if kind == DocumentPolicy {
+		policy, ok := f.EffectivePolicy(documentID)
+		return ok && policy.InEffect(at)
+	}
+	return f.hasDocument(kind, documentID)
+}
+
+// BuildRequirementCoverage maps the agreement's conformance requirements to the documents of its
+// policy framework that implement them
+func BuildRequirementCoverage(agreement GovernanceAgreement, at time.Time) RequirementCoverage {
+	framework := agreement.Direct.PolicyFramework
+	coverage := RequirementCoverage{
+		AgreementID:  agreement.ID,
+		Requirements: conformanceRequirements(agreement.Conformance),
+		Unmapped:     []RequirementRef{},
+		NotInEffect:  []RequirementRef{},
+		CheckedAt:    at,
+	}
+
+	for i := range coverage.Requirements {
+		requirement := &coverage.Requirements[i]
+		for _, mapping := range framework.RequirementMappings {
+			if mapping.Requirement != requirement.Requirement {
+				continue
+			}
+			requirement.Mappings = append(requirement.Mappings, mapping)
+			if framework.documentInEffect(mapping.DocumentKind, mapping.DocumentID, at) {
+				requirement.InEffect = append(requirement.InEffect, mapping)
+			}
+		}
+
+		switch {
+		case len(requirement.Mappings) == 0:
+			coverage.Unmapped = append(coverage.Unmapped, requirement.Requirement)
+		case !requirement.Implemented():
+			coverage.NotInEffect = append(coverage.NotInEffect, requirement.Requirement)
+		}
+	}
+
+	return coverage
+}
+
+// MapRequirement records that one of the agreement's policies, standards or procedures
+// implements one of its conformance requirements
+func (s *DirectionService) MapRequirement(ctx context.Context, agreementID GovernanceAgreementID, mapping RequirementMapping) (*RequirementMapping, error) {
+	if mapping.Requirement.Name == "" {
+		return nil, errors.New("requirement name cannot be empty")
+	}
+	if mapping.DocumentID == "" {
+		return nil, errors.New("document ID cannot be empty")
+	}
+
+	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+
+	if !hasRequirement(agreement.Conformance, mapping.Requirement) {
+		return nil, fmt.Errorf("%s requirement not found in agreement %s", mapping.Requirement, agreementID)
+	}
+	framework := &agreement.Direct.PolicyFramework
+	if !framework.hasDocument(mapping.DocumentKind, mapping.DocumentID) {
+		return nil, fmt.Errorf("%s %s not found in agreement %s", mapping.DocumentKind, mapping.DocumentID, agreementID)
+	}
+	for _, existing := range framework.RequirementMappings {
+		if existing.Requirement == mapping.Requirement && existing.DocumentKind == mapping.DocumentKind && existing.DocumentID == mapping.DocumentID {
+			return nil, fmt.Errorf("%s %s is already mapped to %s requirement", mapping.DocumentKind, mapping.DocumentID, mapping.Requirement)
+		}
+	}
+
+	mapping.MappedAt = time.Now()
+	framework.RequirementMappings = append(append([]RequirementMapping{}, framework.RequirementMappings...), mapping)
+
+	err = s.agreementRepo.Update(ctx, agreement)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update governance agreement: %w", err)
+	}
+	return &mapping, nil
+}
+
+// UnmapRequirement removes the mapping of a document to one of the agreement's conformance
+// requirements
+func (s *DirectionService) UnmapRequirement(ctx context.Context, agreementID GovernanceAgreementID, requirement RequirementRef, kind DocumentKind, documentID string) error {
+	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
+	if err != nil {
+		return fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+
+	framework := &agreement.Direct.PolicyFramework
+	mappings := make([]RequirementMapping, 0, len(framework.RequirementMappings))
+	for _, mapping := range framework.RequirementMappings {
+		if mapping.Requirement == requirement && mapping.DocumentKind == kind && mapping.DocumentID == documentID {
+			continue
+		}
+		mappings = append(mappings, mapping)
+	}
+	if len(mappings) == len(framework.RequirementMappings) {
+		return fmt.Errorf("%s %s is not mapped to %s requirement", kind, documentID, requirement)
+	}
+	framework.RequirementMappings = mappings
+
+	err = s.agreementRepo.Update(ctx, agreement)
+	if err != nil {
+		return fmt.Errorf("failed to update governance agreement: %w", err)
+	}
+	return nil
+}
+
+// MonitorRequirementCoverage reports the agreement's conformance requirements with no mapped
+// policy, standard or procedure and those whose mapped documents are not in effect
+func (s *MonitoringService) MonitorRequirementCoverage(ctx context.Context, agreementID GovernanceAgreementID) (*RequirementCoverage, error) {
+	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+
+	coverage := BuildRequirementCoverage(agreement, time.Now())
+	return &coverage, nil
+}
@@ -0,0 +1,231 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// RiskHeatMapScope identifies whose risk register a heat map covers
+type RiskHeatMapScope string
+
+const (
+	RiskHeatMapApplication RiskHeatMapScope = "application"
+	RiskHeatMapPortfolio   RiskHeatMapScope = "portfolio"
+)
+
+// RiskLikelihood is a band of risk probability on the heat map's probability axis
+type RiskLikelihood string
+
+const (
+	LikelihoodRare          RiskLikelihood = "rare"           // below 20%
+	LikelihoodUnlikely      RiskLikelihood = "unlikely"       // 20% to 40%
+	LikelihoodPossible      RiskLikelihood = "possible"       // 40% to 60%
+	LikelihoodLikely        RiskLikelihood = "likely"         // 60% to 80%
+	LikelihoodAlmostCertain RiskLikelihood = "almost_certain" // 80% and above
+)
+
+// RiskLikelihoods returns the likelihood bands from least to most likely
+func RiskLikelihoods() []RiskLikelihood {
+	return []RiskLikelihood{LikelihoodRare, LikelihoodUnlikely, LikelihoodPossible, LikelihoodLikely, LikelihoodAlmostCertain}
+}
+
+// RiskImpacts returns the impacts from least to most severe
+func RiskImpacts() []RiskImpact {
+	return []RiskImpact{ImpactLow, ImpactMedium, ImpactHigh, ImpactCritical}
+}
+
+// LikelihoodOf returns the likelihood band of a probability between 0 and 1
+func LikelihoodOf(probability float64) RiskLikelihood {
+	likelihoods := RiskLikelihoods()
+	band := int(probability * float64(len(likelihoods)))
+	if band < 0 {
+		band = 0
+	}
+	if band >= len(likelihoods) {
+		band = len(likelihoods) - 1
+	}
+	return likelihoods[band]
+}
+
+// RiskHeatMapCell counts the risks of one likelihood and impact
+type RiskHeatMapCell struct {
+	Likelihood RiskLikelihood
+	Impact     RiskImpact
+	Count      int
+	RiskIDs    []string  // portfolio heat maps qualify risk IDs with their application, e.g. "erp-core-001/r-1"
+	Rating     RiskLevel // rating of the cell by likelihood times impact
+}
+
+// rateRiskCell rates a cell from the position of its likelihood (1 to 5) times its impact (1 to
+// 4): 15 and above is critical, 8 and above high, 4 and above medium, and anything less low
+func rateRiskCell(likelihood RiskLikelihood, impact RiskImpact) RiskLevel {
+	score := 0
+	for i, l := range RiskLikelihoods() {
+		if l == likelihood {
+			score = i + 1
+		}
+	}
+	for i, m := range RiskImpacts() {
+		if m == impact {
+			score *= i + 1
+		}
+	}
+	switch {
+	case score >= 15:
+		return RiskCritical
+	case score >= 8:
+		return RiskHigh
+	case score >= 4:
+		return RiskMedium
+	}
+	return RiskLow
+}
+
+// heatMapRisk is a risk placed on a heat map under the ID it is drilled down to
+type heatMapRisk struct {
+	ID   string
+	Risk Risk
+}
+
+// buildRiskHeatMap places the risks on a probability-versus-impact matrix. Every cell is
+// present, ordered by likelihood then impact, so the matrix renders without gaps.
+func buildRiskHeatMap(name, description string, scope RiskHeatMapScope, scopeID string, risks []heatMapRisk) RiskHeatMap {
+	heatMap := RiskHeatMap{
+		Name:        name,
+		Description: description,
+		Data:        make(map[string]map[string]float64),
+		Scope:       scope,
+		ScopeID:     scopeID,
+		Cells:       []RiskHeatMapCell{},
+	}
+
+	cells := make(map[RiskLikelihood]map[RiskImpact]*RiskHeatMapCell)
+	for _, likelihood := range RiskLikelihoods() {
+		heatMap.Data[string(likelihood)] = make(map[string]float64)
+		cells[likelihood] = make(map[RiskImpact]*RiskHeatMapCell)
+		for _, impact := range RiskImpacts() {
+			heatMap.Data[string(likelihood)][string(impact)] = 0
+			cells[likelihood][impact] = &RiskHeatMapCell{
+				Likelihood: likelihood,
+				Impact:     impact,
+				RiskIDs:    []string{},
+				Rating:     rateRiskCell(likelihood, impact),
+			}
+		}
+	}
+
+	for _, entry := range risks {
+		impact := entry.Risk.Impact
+		if _, ok := cells[LikelihoodRare][impact]; !ok {
+			impact = ImpactLow
+		}
+		cell := cells[LikelihoodOf(entry.Risk.Probability)][impact]
+		cell.Count++
+		cell.RiskIDs = append(cell.RiskIDs, entry.ID)
+		heatMap.Total++
+	}
+
+	for _, likelihood := range RiskLikelihoods() {
+		for _, impact := range RiskImpacts() {
+			cell := cells[likelihood][impact]
+			sort.Strings(cell.RiskIDs)
+			heatMap.Data[string(likelihood)][string(impact)] = float64(cell.Count)
+			heatMap.Cells = append(heatMap.Cells, *cell)
+		}
+	}
+	return heatMap
+}
+
+// BuildApplicationRiskHeatMap places the risks of an agreement's risk register on a heat map
+func BuildApplicationRiskHeatMap(agreement GovernanceAgreement) RiskHeatMap {
+	risks := make([]heatMapRisk, 0, len(agreement.Evaluate.RiskAssessment.Risks))
+	for _, risk := range agreement.Evaluate.RiskAssessment.Risks {
+		risks = append(risks, heatMapRisk{ID: risk.ID, Risk: risk})
+	}
+	return buildRiskHeatMap(
+		fmt.Sprintf("%s risk heat map", agreement.ApplicationID),
+		"Identified risks of the application by likelihood and impact",
+		RiskHeatMapApplication, string(agreement.ApplicationID), risks)
+}
+
+// BuildPortfolioRiskHeatMap places the risks of the agreements of a portfolio's applications on
+// one heat map
+func BuildPortfolioRiskHeatMap(portfolio ApplicationPortfolio, agreements []GovernanceAgreement) RiskHeatMap {
+	var risks []heatMapRisk
+	for _, agreement := range agreements {
+		for _, risk := range agreement.Evaluate.RiskAssessment.Risks {
+			risks = append(risks, heatMapRisk{ID: fmt.Sprintf("%s/%s", agreement.ApplicationID, risk.ID), Risk: risk})
+		}
+	}
+	return buildRiskHeatMap(
+		fmt.Sprintf("%s risk heat map", portfolio.Name),
+		"Identified risks of the portfolio's applications by likelihood and impact",
+		RiskHeatMapPortfolio, string(portfolio.ID), risks)
+}
+
+// Cell returns the cell of a likelihood and impact
+func (h RiskHeatMap) Cell(likelihood RiskLikelihood, impact RiskImpact) RiskHeatMapCell {
+	for _, cell := range h.Cells {
+		if cell.Likelihood == likelihood && cell.Impact == impact {
+			return cell
+		}
+	}
+	return RiskHeatMapCell{Likelihood: likelihood, Impact: impact, RiskIDs: []string{}, Rating: rateRiskCell(likelihood, impact)}
+}
+
+// MonitorPortfolioRiskHeatMap places the identified risks of a portfolio's applications on a
+// heat map. It needs the portfolio repository of WithPortfolioThresholds.
+func (s *MonitoringService) MonitorPortfolioRiskHeatMap(ctx context.Context, portfolioID PortfolioID) (*RiskHeatMap, error) {
+	if s.portfolioRepo == nil {
+		return nil, errors.New("monitoring service has no portfolio repository")
+	}
+	portfolio, err := s.portfolioRepo.FindByID(ctx, portfolioID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find portfolio: %w", err)
+	}
+
+	heatMap := BuildPortfolioRiskHeatMap(portfolio, s.portfolioAgreements(ctx, portfolio))
+	return &heatMap, nil
+}
+
+// portfolioAgreements returns the agreements governing the portfolio's applications that are not
+// retired
+func (s *MonitoringService) portfolioAgreements(ctx context.Context, portfolio ApplicationPortfolio) []GovernanceAgreement {
+	var agreements []GovernanceAgreement
+	for _, app := range portfolio.Applications {
+		if app.Status == StatusRetired {
+			continue
+		}
+		if agreement, err := s.agreementRepo.FindByApplicationID(ctx, app.ID); err == nil {
+			agreements = append(agreements, agreement)
+		}
+	}
+	return agreements
+}
+
+// riskHeatMaps returns the heat map of the agreement's application followed by the heat maps of
+// the portfolios holding it, by portfolio ID. Portfolio heat maps are left out without a
+// portfolio repository.
+func (s *MonitoringService) riskHeatMaps(ctx context.Context, agreement GovernanceAgreement) []RiskHeatMap {
+	heatMaps := []RiskHeatMap{BuildApplicationRiskHeatMap(agreement)}
+	if s.portfolioRepo == nil {
+		return heatMaps
+	}
+	portfolios, err := s.portfolioRepo.FindAll(ctx)
+	if err != nil {
+		return heatMaps
+	}
+	sort.Slice(portfolios, func(i, j int) bool { return portfolios[i].ID < portfolios[j].ID })
+
+	for _, portfolio := range portfolios {
+		for _, app := range portfolio.Applications {
+			if app.ID == agreement.ApplicationID {
+				heatMaps = append(heatMaps, BuildPortfolioRiskHeatMap(portfolio, s.portfolioAgreements(ctx, portfolio)))
+				break
+			}
+		}
+	}
+	return heatMaps
+}
@@ -0,0 +1,346 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+)
+
+// LossEstimate is a three-point estimate of the loss a risk causes when it materializes,
+// sampled as a triangular distribution
+type LossEstimate struct {
+	Minimum    float64
+	MostLikely float64
+	Maximum    float64
+}
+
+// IsZero reports whether no estimate has been given
+func (l LossEstimate) IsZero() bool {
+	return l.Minimum == 0 && l.MostLikely == 0 && l.Maximum == 0
+}
+
+// Validate ensures the three points are ordered and not negative
+func (l LossEstimate) Validate() error {
+	if l.Minimum < 0 {
+		return errors.New("loss estimate must not be negative")
+	}
+	if l.MostLikely < l.Minimum || l.Maximum < l.MostLikely {
+		return errors.New("loss estimate must satisfy minimum <= most likely <= maximum")
+	}
+	return nil
+}
+
+// sample draws a loss from the triangular distribution described by the estimate
+func (l LossEstimate) sample(rng *rand.Rand) float64 {
+	spread := l.Maximum - l.Minimum
+	if spread <= 0 {
+		return l.MostLikely
+	}
+
+	u := rng.Float64()
+	split := (l.MostLikely - l.Minimum) / spread
+	if u < split {
+		return l.Minimum + math.Sqrt(u*spread*(l.MostLikely-l.Minimum))
+	}
+	return l.Maximum - math.Sqrt((1-u)*spread*(l.Maximum-l.MostLikely))
+}
+
+// MaxRiskSimulationIterations bounds a simulation, which holds a loss per application per
+// iteration in memory
+const MaxRiskSimulationIterations = 100000
+
+// RiskSimulationOptions controls Monte Carlo risk aggregation
+type RiskSimulationOptions struct {
+	Iterations int
+	Seed       int64 // fixed seeds make results repeatable
+	// ImpactLosses supplies the loss estimate for risks that have none of their own
+	ImpactLosses map[RiskImpact]LossEstimate
+	// ExceedancePoints is the number of points on the loss-exceedance curve
+	ExceedancePoints int
+}
+
+// DefaultRiskSimulationOptions returns 10,000 iterations with a fixed seed and loss
+// estimates for each impact level
+func DefaultRiskSimulationOptions() RiskSimulationOptions {
+	return RiskSimulationOptions{
+		Iterations: 10000,
+		Seed:       38500,
+		ImpactLosses: map[RiskImpact]LossEstimate{
+			ImpactLow:      {Minimum: 1000, MostLikely: 5000, Maximum: 20000},
+			ImpactMedium:   {Minimum: 10000, MostLikely: 50000, Maximum: 150000},
+			ImpactHigh:     {Minimum: 50000, MostLikely: 250000, Maximum: 1000000},
+			ImpactCritical: {Minimum: 250000, MostLikely: 1000000, Maximum: 5000000},
+		},
+		ExceedancePoints: 10,
+	}
+}
+
+// Validate ensures the options can drive a simulation
+func (o RiskSimulationOptions) Validate() error {
+	if o.Iterations <= 0 {
+		return errors.New("risk simulation needs at least one iteration")
+	}
+	if o.Iterations > MaxRiskSimulationIterations {
+		return fmt.Errorf("risk simulation is limited to %d iterations", MaxRiskSimulationIterations)
+	}
+	if o.ExceedancePoints < 0 {
+		return errors.New("exceedance points must not be negative")
+	}
+	for impact, loss := range o.ImpactLosses {
+		if err := loss.Validate(); err != nil {
+			return fmt.Errorf("invalid %s impact loss: %w", impact, err)
+		}
+	}
+	return nil
+}
+
+// LossExceedancePoint is the probability that total loss reaches at least Loss
+type LossExceedancePoint struct {
+	Loss        float64
+	Probability float64
+}
+
+// ApplicationRiskExposure is one application's share of a portfolio's simulated exposure
+type ApplicationRiskExposure struct {
+	ApplicationID ApplicationID
+	Risks         int
+	ExpectedLoss  float64
+	P90           float64
+}
+
+// RiskExposure summarizes simulated annual losses across a portfolio's identified risks
+type RiskExposure struct {
+	PortfolioID     PortfolioID
+	Iterations      int
+	Risks           int
+	ExpectedLoss    float64
+	P50             float64
+	P90             float64
+	P95             float64
+	P99             float64
+	MaximumLoss     float64
+	ExceedanceCurve []LossExceedancePoint
+	Applications    []ApplicationRiskExposure // largest expected loss first
+	SimulatedAt     time.Time
+}
+
+// SimulateRiskExposure samples every risk's occurrence, from its probability, and its loss,
+// from its loss estimate or the estimate for its impact, and aggregates the totals per iteration
+// into a loss distribution. Risks with no usable estimate are skipped.
+func SimulateRiskExposure(risks map[ApplicationID][]Risk, opts RiskSimulationOptions) (*RiskExposure, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid risk simulation options: %w", err)
+	}
+
+	type sampledRisk struct {
+		app         int
+		probability float64
+		loss        LossEstimate
+	}
+
+	appIDs := make([]ApplicationID, 0, len(risks))
+	for appID := range risks {
+		appIDs = append(appIDs, appID)
+	}
+	sort.Slice(appIDs, func(i, j int) bool { return appIDs[i] < appIDs[j] })
+
+	var sampled []sampledRisk
+	riskCounts := make([]int, len(appIDs))
+	for i, appID := range appIDs {
+		for _, risk := range risks[appID] {
+			loss := risk.Loss
+			if loss.IsZero() {
+				loss = opts.ImpactLosses[risk.Impact]
+			}
+			if loss.IsZero() || loss.Validate() != nil {
+				continue
+			}
+			sampled = append(sampled, sampledRisk{app: i, probability: math.Max(0, math.Min(1, risk.Probability)), loss: loss})
+			riskCounts[i]++
+		}
+	}
+
+	rng := rand.New(rand.NewSource(opts.Seed))
+	totals := make([]float64, opts.Iterations)
+	appLosses := make([][]float64, len(appIDs))
+	for i := range appLosses {
+		appLosses[i] = make([]float64, opts.Iterations)
+	}
+	for n := 0; n < opts.Iterations; n++ {
+		for _, risk := range sampled {
+			if rng.Float64() >= risk.probability {
+				continue
+			}
+			loss := risk.loss.sample(rng)
+			totals[n] += loss
+			appLosses[risk.app][n] += loss
+		}
+	}
+
+	exposure := &RiskExposure{
+		Iterations:  opts.Iterations,
+		Risks:       len(sampled),
+		SimulatedAt: time.Now(),
+	}
+	exposure.ExpectedLoss = mean(totals)
+	sort.Float64s(totals)
+	exposure.P50 = percentile(totals, 0.50)
+	exposure.P90 = percentile(totals, 0.90)
+	exposure.P95 = percentile(totals, 0.95)
+	exposure.P99 = percentile(totals, 0.99)
+	exposure.MaximumLoss = totals[len(totals)-1]
+	exposure.ExceedanceCurve = lossExceedanceCurve(totals, opts.ExceedancePoints)
+
+	for i, appID := range appIDs {
+		if riskCounts[i] == 0 {
+			continue
+		}
+		losses := appLosses[i]
+		expected := mean(losses)
+		sort.Float64s(losses)
+		exposure.Applications = append(exposure.Applications, ApplicationRiskExposure{
+			ApplicationID: appID,
+			Risks:         riskCounts[i],
+			ExpectedLoss:  expected,
+			P90:           percentile(losses, 0.90),
+		})
+	}
+	sort.SliceStable(exposure.Applications, func(i, j int) bool {
+		return exposure.Applications[i].ExpectedLoss > exposure.Applications[j].ExpectedLoss
+	})
+
+	return exposure, nil
+}
+
+// SimulatePortfolioRisk aggregates the risks identified in the governance agreements of a
+// portfolio's applications. Retired applications and applications without an agreement
+// contribute no risks.
+func (s *EvaluationService) SimulatePortfolioRisk(ctx context.Context, portfolioID PortfolioID, opts RiskSimulationOptions) (*RiskExposure, error) {
+	portfolio, err := s.portfolioRepo.FindByID(ctx, portfolioID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find portfolio: %w", err)
+	}
+	return s.simulateRisk(ctx, portfolioID, s.currentApplications(ctx, portfolio.Applications), opts)
+}
+
+func (s *EvaluationService) simulateRisk(ctx context.Context, portfolioID PortfolioID, apps []Application, opts RiskSimulationOptions) (*RiskExposure, error) {
+	risks := make(map[ApplicationID][]Risk)
+	for _, app := range apps {
+		if app.Status == StatusRetired {
+			continue
+		}
+		agreement, err := s.agreementRepo.FindByApplicationID(ctx, app.ID)
+		if err != nil {
+			continue
+		}
+		if identified := agreement.Evaluate.RiskAssessment.Risks; len(identified) > 0 {
+			risks[app.ID] = identified
+		}
+	}
+
+	exposure, err := SimulateRiskExposure(risks, opts)
+	if err != nil {
+		return nil, err
+	}
+	exposure.PortfolioID = portfolioID
+	return exposure, nil
+}
+
+// IdentifyRisk adds a risk to, or replaces a risk with the same ID in, an agreement's risk assessment
+func (s *EvaluationService) IdentifyRisk(ctx context.Context, agreementID GovernanceAgreementID, risk Risk) error {
+	if risk.ID == "" {
+		return errors.New("risk ID cannot be empty")
+	}
+	if risk.Probability < 0 || risk.Probability > 1 {
+		return errors.New("risk probability must be between 0 and 1")
+	}
+	if !risk.Loss.IsZero() {
+		if err := risk.Loss.Validate(); err != nil {
+			return err
+		}
+	}
+
+	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
+	if err != nil {
+		return fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+
+	assessment := &agreement.Evaluate.RiskAssessment
+	replaced := false
+	for i, existing := range assessment.Risks {
+		if existing.ID == risk.ID {
+			assessment.Risks[i] = risk
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		assessment.Risks = append(assessment.Risks, risk)
+	}
+	assessment.OverallRiskLevel = highestRiskLevel(assessment.Risks)
+
+	err = s.agreementRepo.Update(ctx, agreement)
+	if err != nil {
+		return fmt.Errorf("failed to update governance agreement: %w", err)
+	}
+
+	return nil
+}
+
+// highestRiskLevel returns the most severe level among the risks
+func highestRiskLevel(risks []Risk) RiskLevel {
+	highest := RiskLow
+	for _, risk := range risks {
+		if riskScore(risk.Level) > riskScore(highest) {
+			highest = risk.Level
+		}
+	}
+	return highest
+}
+
+func mean(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	total := 0.0
+	for _, value := range values {
+		total += value
+	}
+	return total / float64(len(values))
+}
+
+// percentile returns the nearest-rank percentile of sorted values
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p*float64(len(sorted)))) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	return sorted[rank]
+}
+
+// lossExceedanceCurve returns the probability of reaching each of points evenly spaced losses
+// up to the largest simulated loss
+func lossExceedanceCurve(sorted []float64, points int) []LossExceedancePoint {
+	maximum := sorted[len(sorted)-1]
+	if points == 0 || maximum == 0 {
+		return nil
+	}
+
+	curve := make([]LossExceedancePoint, 0, points)
+	for i := 1; i <= points; i++ {
+		loss := maximum * float64(i) / float64(points)
+		below := sort.SearchFloat64s(sorted, loss)
+		curve = append(curve, LossExceedancePoint{
+			Loss:        loss,
+			Probability: float64(len(sorted)-below) / float64(len(sorted)),
+		})
+	}
+	return curve
+}
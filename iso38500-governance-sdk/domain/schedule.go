@@ -0,0 +1,225 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CronSchedule is a parsed five-field cron expression: minute, hour, day of month, month
+// and day of week. Fields accept "*", values, ranges ("1-5"), lists ("1,15") and steps
+// ("*/15", "0-30/10"). The macros @hourly, @daily, @weekly and @monthly are also accepted.
+type CronSchedule struct {
+	expression string
+	minute     uint64
+	hour       uint64
+	dayOfMonth uint64
+	month      uint64
+	dayOfWeek  uint64
+	anyDay     bool // day of month is "*"
+	anyWeekday bool // day of week is "*"
+}
+
+var cronMacros = map[string]string{
+	"@hourly":   "0 * * * *",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@weekly":   "0 0 * * 0",
+	"@monthly":  "0 0 1 * *",
+}
+
+// ParseCronSchedule parses a cron expression
+func ParseCronSchedule(expression string) (CronSchedule, error) {
+	spec := strings.TrimSpace(expression)
+	if macro, ok := cronMacros[spec]; ok {
+		spec = macro
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return CronSchedule{}, fmt.Errorf("cron expression %q must have 5 fields", expression)
+	}
+
+	schedule := CronSchedule{
+		expression: expression,
+		anyDay:     fields[2] == "*",
+		anyWeekday: fields[4] == "*",
+	}
+	bounds := []struct {
+		target   *uint64
+		min, max int
+		name     string
+	}{
+		{&schedule.minute, 0, 59, "minute"},
+		{&schedule.hour, 0, 23, "hour"},
+		{&schedule.dayOfMonth, 1, 31, "day of month"},
+		{&schedule.month, 1, 12, "month"},
+		{&schedule.dayOfWeek, 0, 7, "day of week"},
+	}
+	for i, field := range fields {
+		bits, err := parseCronField(field, bounds[i].min, bounds[i].max)
+		if err != nil {
+			return CronSchedule{}, fmt.Errorf("invalid %s field %q: %w", bounds[i].name, field, err)
+		}
+		*bounds[i].target = bits
+	}
+	// Both 0 and 7 mean Sunday
+	if schedule.dayOfWeek&(1<<7) != 0 {
+		schedule.dayOfWeek |= 1
+	}
+	return schedule, nil
+}
+
+func parseCronField(field string, min, max int) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		step := 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			parsed, err := strconv.Atoi(part[i+1:])
+			if err != nil || parsed <= 0 {
+				return 0, errors.New("step must be a positive number")
+			}
+			step = parsed
+			part = part[:i]
+		}
+
+		low, high := min, max
+		switch {
+		case part == "*":
+		case strings.Contains(part, "-"):
+			bounds := strings.SplitN(part, "-", 2)
+			var err error
+			if low, err = strconv.Atoi(bounds[0]); err != nil {
+				return 0, fmt.Errorf("invalid range start %q", bounds[0])
+			}
+			if high, err = strconv.Atoi(bounds[1]); err != nil {
+				return 0, fmt.Errorf("invalid range end %q", bounds[1])
+			}
+		default:
+			value, err := strconv.Atoi(part)
+			if err != nil {
+				return 0, fmt.Errorf("invalid value %q", part)
+			}
+			low, high = value, value
+			if step > 1 {
+				high = max
+			}
+		}
+
+		if low < min || high > max || low > high {
+			return 0, fmt.Errorf("values must be between %d and %d", min, max)
+		}
+		for value := low; value <= high; value += step {
+			bits |= 1 << uint(value)
+		}
+	}
+	return bits, nil
+}
+
+// String returns the expression the schedule was parsed from
+func (c CronSchedule) String() string {
+	return c.expression
+}
+
+// Next returns the first time after the given time that matches the schedule, or the zero
+// time when nothing matches within five years
+func (c CronSchedule) Next(after time.Time) time.Time {
+	loc := after.Location()
+	t := after.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+
+	for t.Before(limit) {
+		if c.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !c.matchesDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if c.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			continue
+		}
+		if c.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+// matchesDay follows cron semantics: when both day fields are restricted either may match
+func (c CronSchedule) matchesDay(t time.Time) bool {
+	dayMatch := c.dayOfMonth&(1<<uint(t.Day())) != 0
+	weekdayMatch := c.dayOfWeek&(1<<uint(t.Weekday())) != 0
+	switch {
+	case c.anyDay && c.anyWeekday:
+		return true
+	case c.anyDay:
+		return weekdayMatch
+	case c.anyWeekday:
+		return dayMatch
+	default:
+		return dayMatch || weekdayMatch
+	}
+}
+
+// ScheduleTarget identifies what a recurring evaluation evaluates
+type ScheduleTarget string
+
+const (
+	ScheduleTargetAgreement ScheduleTarget = "agreement"
+	ScheduleTargetPortfolio ScheduleTarget = "portfolio"
+)
+
+// EvaluationSchedule runs an agreement's application evaluation or a portfolio evaluation
+// on a cron schedule
+type EvaluationSchedule struct {
+	ID          string
+	Target      ScheduleTarget
+	AgreementID GovernanceAgreementID // set for agreement schedules
+	PortfolioID PortfolioID           // set for portfolio schedules
+	Cron        string
+	Evaluator   string
+	Enabled     bool
+	CreatedAt   time.Time
+	LastRunAt   time.Time
+	LastError   string // empty when the last run succeeded
+	NextRunAt   time.Time
+}
+
+// Validate ensures the schedule has valid data
+func (s EvaluationSchedule) Validate() error {
+	if s.ID == "" {
+		return errors.New("schedule ID cannot be empty")
+	}
+	switch s.Target {
+	case ScheduleTargetAgreement:
+		if s.AgreementID == "" {
+			return errors.New("agreement schedule needs an agreement ID")
+		}
+	case ScheduleTargetPortfolio:
+		if s.PortfolioID == "" {
+			return errors.New("portfolio schedule needs a portfolio ID")
+		}
+	default:
+		return fmt.Errorf("unknown schedule target %q", s.Target)
+	}
+	cron, err := ParseCronSchedule(s.Cron)
+	if err != nil {
+		return err
+	}
+	if cron.Next(time.Now()).IsZero() {
+		return fmt.Errorf("cron expression %q never matches", s.Cron)
+	}
+	return nil
+}
+
+// IsDue reports whether an enabled schedule should run at the given time
+func (s EvaluationSchedule) IsDue(now time.Time) bool {
+	return s.Enabled && !s.NextRunAt.IsZero() && !now.Before(s.NextRunAt)
+}
@@ -0,0 +1,103 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseCronSchedule(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		wantErr    bool
+	}{
+		{"every minute", "* * * * *", false},
+		{"lists ranges and steps", "0,30 9-17 */2 1-6/2 1-5", false},
+		{"value with step", "5/15 * * * *", false},
+		{"sunday as seven", "0 0 * * 7", false},
+		{"macro", "@daily", false},
+		{"macro with spaces", " @weekly ", false},
+		{"too few fields", "* * * *", true},
+		{"too many fields", "* * * * * *", true},
+		{"minute out of range", "60 * * * *", true},
+		{"day of month zero", "0 0 0 * *", true},
+		{"month out of range", "0 0 1 13 *", true},
+		{"day of week out of range", "0 0 * * 8", true},
+		{"reversed range", "0 17-9 * * *", true},
+		{"zero step", "*/0 * * * *", true},
+		{"not a number", "a * * * *", true},
+		{"unknown macro", "@yearly", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := ParseCronSchedule(tt.expression)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseCronSchedule(%q) error = %v, wantErr %v", tt.expression, err, tt.wantErr)
+			}
+			if err == nil && schedule.String() != tt.expression {
+				t.Errorf("String() = %q, want %q", schedule.String(), tt.expression)
+			}
+		})
+	}
+}
+
+func TestCronScheduleNext(t *testing.T) {
+	// Monday
+	after := time.Date(2024, time.January, 15, 10, 7, 30, 0, time.UTC)
+	tests := []struct {
+		name       string
+		expression string
+		after      time.Time
+		want       time.Time
+	}{
+		{"every minute", "* * * * *", after, time.Date(2024, time.January, 15, 10, 8, 0, 0, time.UTC)},
+		{"later the same hour", "*/15 * * * *", after, time.Date(2024, time.January, 15, 10, 15, 0, 0, time.UTC)},
+		{"strictly after", "8 10 * * *", time.Date(2024, time.January, 15, 10, 8, 0, 0, time.UTC), time.Date(2024, time.January, 16, 10, 8, 0, 0, time.UTC)},
+		{"hourly", "@hourly", after, time.Date(2024, time.January, 15, 11, 0, 0, 0, time.UTC)},
+		{"daily", "@daily", after, time.Date(2024, time.January, 16, 0, 0, 0, 0, time.UTC)},
+		{"weekly on sunday", "@weekly", after, time.Date(2024, time.January, 21, 0, 0, 0, 0, time.UTC)},
+		{"sunday as seven", "0 0 * * 7", after, time.Date(2024, time.January, 21, 0, 0, 0, 0, time.UTC)},
+		{"monthly", "@monthly", after, time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)},
+		{"weekdays skip the weekend", "0 9 * * 1-5", time.Date(2024, time.January, 19, 9, 0, 0, 0, time.UTC), time.Date(2024, time.January, 22, 9, 0, 0, 0, time.UTC)},
+		{"either day field matches", "0 0 20 * 3", after, time.Date(2024, time.January, 17, 0, 0, 0, 0, time.UTC)},
+		{"across the year", "0 0 1 1 *", after, time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{"leap day", "0 0 29 2 *", after, time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{"leap day four years on", "0 0 29 2 *", time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC), time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{"never matches", "0 0 31 2 *", after, time.Time{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := ParseCronSchedule(tt.expression)
+			if err != nil {
+				t.Fatalf("ParseCronSchedule(%q) error = %v", tt.expression, err)
+			}
+			if got := schedule.Next(tt.after); !got.Equal(tt.want) {
+				t.Errorf("Next(%s) = %s, want %s", tt.after, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvaluationScheduleValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule EvaluationSchedule
+		wantErr  bool
+	}{
+		{"agreement", EvaluationSchedule{ID: "s1", Target: ScheduleTargetAgreement, AgreementID: "a1", Cron: "@daily"}, false},
+		{"portfolio", EvaluationSchedule{ID: "s1", Target: ScheduleTargetPortfolio, PortfolioID: "p1", Cron: "0 6 * * 1"}, false},
+		{"no ID", EvaluationSchedule{Target: ScheduleTargetAgreement, AgreementID: "a1", Cron: "@daily"}, true},
+		{"agreement without agreement ID", EvaluationSchedule{ID: "s1", Target: ScheduleTargetAgreement, Cron: "@daily"}, true},
+		{"portfolio without portfolio ID", EvaluationSchedule{ID: "s1", Target: ScheduleTargetPortfolio, Cron: "@daily"}, true},
+		{"unknown target", EvaluationSchedule{ID: "s1", Target: "application", Cron: "@daily"}, true},
+		{"invalid cron", EvaluationSchedule{ID: "s1", Target: ScheduleTargetAgreement, AgreementID: "a1", Cron: "0 0 * *"}, true},
+		{"cron never matches", EvaluationSchedule{ID: "s1", Target: ScheduleTargetAgreement, AgreementID: "a1", Cron: "0 0 30 2 *"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.schedule.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ScoreFactor is one factor's contribution to a technical health score
+type ScoreFactor struct {
+	Name         string  // e.g. "security", "age"
+	Points       int     // points awarded by the factor's heuristic
+	Weight       float64 // profile weight applied to the points when they are a penalty
+	Contribution int     // weighted points added to the score
+}
+
+// ScoreBreakdown explains a technical health score factor by factor, so that the score can be
+// understood and challenged
+type ScoreBreakdown struct {
+	Base    int // profile base score
+	Factors []ScoreFactor
+	Total   int // base plus contributions, before the score is bounded to 1-5
+	Score   int // the bounded score
+
+	// Adjustments are the component scores changed by the configured score variance
+	Adjustments []ScoreAdjustment
+}
+
+// ScoreAdjustment records a component score changed by score variance
+type ScoreAdjustment struct {
+	Component string // e.g. "security"
+	From      int
+	To        int
+}
+
+// add records a factor and returns its weighted contribution
+func (b *ScoreBreakdown) add(name string, points int, weight float64) int {
+	contribution := weightScore(points, weight)
+	b.Factors = append(b.Factors, ScoreFactor{
+		Name:         name,
+		Points:       points,
+		Weight:       weight,
+		Contribution: contribution,
+	})
+	b.Total += contribution
+	return contribution
+}
+
+// String summarizes the breakdown, e.g. "base 3, security +2, age -1, status +1 → 5 (capped from 7)"
+func (b ScoreBreakdown) String() string {
+	parts := []string{fmt.Sprintf("base %d", b.Base)}
+	for _, factor := range b.Factors {
+		parts = append(parts, fmt.Sprintf("%s %+d", factor.Name, factor.Contribution))
+	}
+	summary := fmt.Sprintf("%s → %d", strings.Join(parts, ", "), b.Score)
+	if b.Total > b.Score {
+		summary += fmt.Sprintf(" (capped from %d)", b.Total)
+	} else if b.Total < b.Score {
+		summary += fmt.Sprintf(" (raised from %d)", b.Total)
+	}
+	if len(b.Adjustments) > 0 {
+		adjustments := make([]string, len(b.Adjustments))
+		for i, adjustment := range b.Adjustments {
+			adjustments[i] = fmt.Sprintf("%s %d→%d", adjustment.Component, adjustment.From, adjustment.To)
+		}
+		summary += "; variance: " + strings.Join(adjustments, ", ")
+	}
+	return summary
+}
@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math"
+	"math/rand"
+)
+
+// ScoreVariance adjusts the component scores (code quality, documentation, security,
+// performance) that the default technical health assessor derives from the overall score.
+// Without a variance, every component takes its derived score bounded to 1-5.
+type ScoreVariance interface {
+	// Vary returns the adjusted score of a component. minFactor and maxFactor bound the
+	// multiple of the score the adjustment may move it to.
+	Vary(appID ApplicationID, component string, score int, minFactor, maxFactor float64) int
+}
+
+// ScoreVarianceFunc adapts a function, e.g. one backed by an external calibration service, to a
+// ScoreVariance
+type ScoreVarianceFunc func(appID ApplicationID, component string, score int, minFactor, maxFactor float64) int
+
+// Vary calls f
+func (f ScoreVarianceFunc) Vary(appID ApplicationID, component string, score int, minFactor, maxFactor float64) int {
+	return f(appID, component, score, minFactor, maxFactor)
+}
+
+// SeededVariance varies component scores pseudo-randomly within their factor bounds. The
+// variation depends only on the seed, the application and the component, so the same seed
+// reproduces the same scores regardless of evaluation order.
+type SeededVariance struct {
+	Seed int64
+}
+
+// Vary scales the score by a factor between minFactor and maxFactor drawn from the seed
+func (v SeededVariance) Vary(appID ApplicationID, component string, score int, minFactor, maxFactor float64) int {
+	hash := fnv.New64a()
+	fmt.Fprintf(hash, "%d/%s/%s", v.Seed, appID, component)
+	random := rand.New(rand.NewSource(int64(hash.Sum64())))
+
+	factor := minFactor + random.Float64()*(maxFactor-minFactor)
+	return int(math.Round(float64(score) * factor))
+}
+
+// boundScore bounds a score to the 1-5 scale
+func boundScore(score int) int {
+	if score < 1 {
+		return 1
+	}
+	if score > 5 {
+		return 5
+	}
+	return score
+}
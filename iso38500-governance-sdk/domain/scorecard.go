@@ -0,0 +1,311 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ISOPrinciple identifies one of the six ISO 38500 principles
+type ISOPrinciple string
+
+const (
+	PrincipleResponsibility ISOPrinciple = "responsibility"
+	PrincipleStrategy       ISOPrinciple = "strategy"
+	PrincipleAcquisition    ISOPrinciple = "acquisition"
+	PrinciplePerformance    ISOPrinciple = "performance"
+	PrincipleConformance    ISOPrinciple = "conformance"
+	PrincipleHumanBehaviour ISOPrinciple = "human_behaviour"
+)
+
+// ISOPrinciples lists the six principles in the order the standard presents them
+func ISOPrinciples() []ISOPrinciple {
+	return []ISOPrinciple{
+		PrincipleResponsibility,
+		PrincipleStrategy,
+		PrincipleAcquisition,
+		PrinciplePerformance,
+		PrincipleConformance,
+		PrincipleHumanBehaviour,
+	}
+}
+
+// PrincipleScore is an agreement's 0-100 score against one principle
+type PrincipleScore struct {
+	Principle ISOPrinciple
+	Score     float64
+	Gaps      []string
+}
+
+// PrincipleScorecard scores an agreement against all six ISO 38500 principles
+type PrincipleScorecard struct {
+	AgreementID   GovernanceAgreementID
+	ApplicationID ApplicationID
+	Scores        []PrincipleScore
+	OverallScore  float64 // mean of the principle scores
+	ComputedAt    time.Time
+}
+
+// Score returns the score for a principle
+func (c PrincipleScorecard) Score(principle ISOPrinciple) (PrincipleScore, bool) {
+	for _, score := range c.Scores {
+		if score.Principle == principle {
+			return score, true
+		}
+	}
+	return PrincipleScore{}, false
+}
+
+// scoreCheck is one weighted element of a principle score. value is the fraction (0-1)
+// of the element that is in place; gap describes what is missing when value is below 1.
+type scoreCheck struct {
+	weight float64
+	value  float64
+	gap    string
+}
+
+// ComputePrincipleScorecard scores an agreement against the six ISO 38500 principles
+func ComputePrincipleScorecard(agreement GovernanceAgreement) PrincipleScorecard {
+	checks := map[ISOPrinciple][]scoreCheck{
+		PrincipleResponsibility: responsibilityChecks(agreement),
+		PrincipleStrategy:       strategyChecks(agreement),
+		PrincipleAcquisition:    acquisitionChecks(agreement),
+		PrinciplePerformance:    performanceChecks(agreement),
+		PrincipleConformance:    conformanceChecks(agreement),
+		PrincipleHumanBehaviour: humanBehaviourChecks(agreement),
+	}
+
+	scorecard := PrincipleScorecard{
+		AgreementID:   agreement.ID,
+		ApplicationID: agreement.ApplicationID,
+		ComputedAt:    time.Now(),
+	}
+	total := 0.0
+	for _, principle := range ISOPrinciples() {
+		score := scorePrinciple(principle, checks[principle])
+		scorecard.Scores = append(scorecard.Scores, score)
+		total += score.Score
+	}
+	scorecard.OverallScore = total / float64(len(scorecard.Scores))
+	return scorecard
+}
+
+func scorePrinciple(principle ISOPrinciple, checks []scoreCheck) PrincipleScore {
+	score := PrincipleScore{Principle: principle}
+	var achieved, possible float64
+	for _, check := range checks {
+		achieved += check.weight * check.value
+		possible += check.weight
+		if check.value < 1 {
+			score.Gaps = append(score.Gaps, check.gap)
+		}
+	}
+	if possible > 0 {
+		score.Score = achieved / possible * 100
+	}
+	return score
+}
+
+// responsibilityChecks measures how completely the RACI matrix assigns governance roles
+func responsibilityChecks(agreement GovernanceAgreement) []scoreCheck {
+	entries := agreement.ResponsibilityMatrix.Entries
+
+	completeness := 0.0
+	incomplete := 0
+	for _, entry := range entries {
+		entryScore := 0.0
+		if entry.Responsible != "" {
+			entryScore += 0.35
+		}
+		if entry.Accountable != "" {
+			entryScore += 0.35
+		}
+		if entry.Consulted != "" {
+			entryScore += 0.15
+		}
+		if entry.Informed != "" {
+			entryScore += 0.15
+		}
+		if entryScore < 1 {
+			incomplete++
+		}
+		completeness += entryScore
+	}
+	if len(entries) > 0 {
+		completeness /= float64(len(entries))
+	}
+
+	return []scoreCheck{
+		{weight: 1, value: boolScore(len(entries) > 0), gap: "no activities are assigned in the responsibility matrix"},
+		{weight: 3, value: completeness, gap: fmt.Sprintf("%d of %d RACI entries are missing roles", incomplete, len(entries))},
+	}
+}
+
+// strategyChecks measures whether the application's strategic and technical direction is documented
+func strategyChecks(agreement GovernanceAgreement) []scoreCheck {
+	strategy := agreement.Strategy
+	manual := strategy.ICTOperationsManual
+	config := strategy.ConfigurationStandard
+	objectives := agreement.Direct.StrategicDirection.Objectives
+
+	withKPIs := 0
+	for _, objective := range objectives {
+		if len(objective.KPIs) > 0 {
+			withKPIs++
+		}
+	}
+
+	return []scoreCheck{
+		{weight: 1, value: fraction(manual.ApplicationArchitecture != "", manual.InfrastructureConfig != "", manual.OperatingSystem != "", manual.ProgrammingLanguage != ""), gap: "ICT operations manual is incomplete"},
+		{weight: 1, value: boolScore(len(strategy.ApplicationCatalogue.Functionality) > 0), gap: "application catalogue lists no functionality"},
+		{weight: 1, value: boolScore(len(strategy.ApplicationInterfaces) > 0), gap: "application interfaces are not documented"},
+		{weight: 1, value: boolScore(len(config.EnvironmentVariables)+len(config.ConfigurationFiles)+len(config.SecuritySettings) > 0), gap: "no configuration standard is defined"},
+		{weight: 1, value: boolScore(len(objectives) > 0), gap: "no strategic objectives are set"},
+		{weight: 1, value: ratio(withKPIs, len(objectives)), gap: "strategic objectives are not measured by KPIs"},
+	}
+}
+
+// acquisitionChecks measures whether requirements and changes follow a defined process
+func acquisitionChecks(agreement GovernanceAgreement) []scoreCheck {
+	acquisition := agreement.Acquisition
+	requirements := acquisition.RequirementsManagement
+	changes := acquisition.ChangeRequestProcess
+
+	return []scoreCheck{
+		{weight: 1, value: fraction(len(requirements.GatheringProcess) > 0, len(requirements.ValidationProcess) > 0), gap: "requirements gathering or validation is not defined"},
+		{weight: 1, value: boolScore(len(requirements.ApprovalWorkflow) > 0), gap: "requirements have no approval workflow"},
+		{weight: 1, value: boolScore(acquisition.BusinessCaseTemplate != ""), gap: "no business case template is provided"},
+		{weight: 1, value: boolScore(len(acquisition.PrioritizationMatrix) > 0), gap: "change requests have no prioritization rules"},
+		{weight: 1, value: fraction(len(changes.Types) > 0, len(changes.EscalationMatrix) > 0), gap: "change request process is incomplete"},
+	}
+}
+
+// performanceChecks measures whether support, incident and continuity provisions are in place
+func performanceChecks(agreement GovernanceAgreement) []scoreCheck {
+	performance := agreement.Performance
+	support := performance.SupportProcess
+	incidents := performance.IncidentManagement
+	continuity := performance.BusinessContinuity
+
+	return []scoreCheck{
+		{weight: 1, value: fraction(len(support.Level1Support) > 0, len(support.Level2Support) > 0, len(support.Level3Support) > 0), gap: "support levels are not fully staffed"},
+		{weight: 1, value: boolScore(support.SLA.Availability > 0), gap: "support has no availability SLA"},
+		{weight: 1, value: fraction(len(incidents.ClassificationMatrix) > 0, len(incidents.PrioritizationMatrix) > 0, len(incidents.ResponseMatrix) > 0), gap: "incident management matrices are incomplete"},
+		{weight: 1, value: boolScore(len(performance.EscalationProcess) > 0), gap: "no escalation process is defined"},
+		{weight: 1, value: fraction(continuity.RecoveryTimeObjective > 0, continuity.RecoveryPointObjective > 0, len(continuity.ContinuityPlans) > 0), gap: "business continuity provisions are incomplete"},
+	}
+}
+
+// conformanceChecks measures how well obligations are identified, met and monitored
+func conformanceChecks(agreement GovernanceAgreement) []scoreCheck {
+	conformance := agreement.Conformance
+	monitoring := conformance.ComplianceMonitoring
+
+	var statuses []ComplianceStatus
+	for _, requirement := range conformance.LegalRequirements {
+		statuses = append(statuses, requirement.Status)
+	}
+	for _, requirement := range conformance.ContractualRequirements {
+		statuses = append(statuses, requirement.Status)
+	}
+	for _, standard := range conformance.IndustryStandards {
+		statuses = append(statuses, standard.Status)
+	}
+
+	compliance := 0.0
+	for _, status := range statuses {
+		switch status {
+		case ComplianceCompliant:
+			compliance++
+		case CompliancePartial:
+			compliance += 0.5
+		}
+	}
+	if len(statuses) > 0 {
+		compliance /= float64(len(statuses))
+	}
+
+	return []scoreCheck{
+		{weight: 1, value: boolScore(len(statuses) > 0), gap: "no legal, contractual or industry obligations are identified"},
+		{weight: 2, value: compliance, gap: "not all obligations are met"},
+		{weight: 1, value: fraction(monitoring.MonitoringFrequency != "", len(monitoring.ResponsibleParties) > 0), gap: "compliance monitoring has no frequency or responsible parties"},
+		{weight: 1, value: boolScore(len(monitoring.AuditRequirements) > 0), gap: "no audit requirements are defined"},
+	}
+}
+
+// humanBehaviourChecks measures whether stakeholders are engaged and how they respond
+func humanBehaviourChecks(agreement GovernanceAgreement) []scoreCheck {
+	communication := agreement.Acquisition.CommunicationManagement
+	feedback := agreement.Monitor.StakeholderFeedback
+	experience := agreement.Monitor.PerformanceMonitoring.UserExperienceMonitoring
+
+	collected := len(feedback.FeedbackItems)+len(feedback.SurveyResults)+len(experience.SatisfactionScores) > 0
+
+	return []scoreCheck{
+		{weight: 1, value: boolScore(len(communication.Stakeholders) > 0), gap: "stakeholders are not identified"},
+		{weight: 1, value: fraction(len(communication.CommunicationTypes) > 0, communication.CommunicationSchedule != ""), gap: "stakeholder communication is not planned"},
+		{weight: 1, value: boolScore(collected), gap: "no stakeholder feedback is collected"},
+		{weight: 2, value: feedbackSentiment(feedback.FeedbackItems), gap: "stakeholder feedback is not positive"},
+		{weight: 1, value: surveyResponseRate(feedback.SurveyResults), gap: "survey response rates are low"},
+	}
+}
+
+// feedbackSentiment returns the share of positive feedback, counting neutral feedback as half
+func feedbackSentiment(items []FeedbackItem) float64 {
+	if len(items) == 0 {
+		return 0
+	}
+
+	total := 0.0
+	for _, item := range items {
+		switch strings.ToLower(item.Sentiment) {
+		case "positive":
+			total++
+		case "neutral", "":
+			total += 0.5
+		}
+	}
+	return total / float64(len(items))
+}
+
+// surveyResponseRate averages survey response rates, accepting fractions or percentages
+func surveyResponseRate(results []SurveyResult) float64 {
+	if len(results) == 0 {
+		return 0
+	}
+
+	total := 0.0
+	for _, result := range results {
+		rate := result.Summary.ResponseRate
+		if rate > 1 {
+			rate /= 100
+		}
+		total += rate
+	}
+	return total / float64(len(results))
+}
+
+func boolScore(ok bool) float64 {
+	if ok {
+		return 1
+	}
+	return 0
+}
+
+// fraction returns the share of conditions that hold
+func fraction(conditions ...bool) float64 {
+	met := 0
+	for _, condition := range conditions {
+		if condition {
+			met++
+		}
+	}
+	return ratio(met, len(conditions))
+}
+
+func ratio(part, whole int) float64 {
+	if whole == 0 {
+		return 0
+	}
+	return float64(part) / float64(whole)
+}
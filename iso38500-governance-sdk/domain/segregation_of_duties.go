@@ -0,0 +1,201 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// SoDRule names a pair of duties one person must not hold for the same record
+type SoDRule string
+
+const (
+	// SoDChangeSelfApproval forbids the requester of a change from approving or rejecting it
+	SoDChangeSelfApproval SoDRule = "change_self_approval"
+	// SoDAssessmentSelfSignOff forbids the evaluator of an application from reviewing or signing
+	// off their own assessment, whatever its risk level
+	SoDAssessmentSelfSignOff SoDRule = "assessment_self_sign_off"
+	// SoDAssessmentReviewerSignOff forbids the reviewer of an assessment from also signing it off
+	SoDAssessmentReviewerSignOff SoDRule = "assessment_reviewer_sign_off"
+)
+
+// AllSoDRules returns every rule, in the order they are reported
+func AllSoDRules() []SoDRule {
+	return []SoDRule{SoDChangeSelfApproval, SoDAssessmentSelfSignOff, SoDAssessmentReviewerSignOff}
+}
+
+// Validate ensures the rule is known
+func (r SoDRule) Validate() error {
+	switch r {
+	case SoDChangeSelfApproval, SoDAssessmentSelfSignOff, SoDAssessmentReviewerSignOff:
+		return nil
+	}
+	return fmt.Errorf("unknown segregation of duties rule %q", r)
+}
+
+// SegregationOfDutiesPolicy is the set of segregation of duties rules an organization enforces
+type SegregationOfDutiesPolicy struct {
+	Rules []SoDRule // empty enforces none
+}
+
+// DefaultSegregationOfDutiesPolicy forbids approving one's own changes and signing off one's own
+// assessments
+func DefaultSegregationOfDutiesPolicy() SegregationOfDutiesPolicy {
+	return SegregationOfDutiesPolicy{Rules: []SoDRule{SoDChangeSelfApproval, SoDAssessmentSelfSignOff}}
+}
+
+// Validate ensures the policy names known rules
+func (p SegregationOfDutiesPolicy) Validate() error {
+	for _, rule := range p.Rules {
+		if err := rule.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Enforces reports whether the policy enforces the rule
+func (p SegregationOfDutiesPolicy) Enforces(rule SoDRule) bool {
+	for _, enforced := range p.Rules {
+		if enforced == rule {
+			return true
+		}
+	}
+	return false
+}
+
+// SegregationOfDutiesViolation is one person holding two duties a rule keeps apart. It is returned
+// as the error of an action the policy forbids, and listed in reports of past records.
+type SegregationOfDutiesViolation struct {
+	Rule        SoDRule
+	EntityType  AuditTrailEntityType
+	EntityID    string
+	Actor       string
+	Description string
+	At          time.Time // when the conflicting action was taken, zero when it was refused
+}
+
+// Error describes the violation
+func (v SegregationOfDutiesViolation) Error() string {
+	return fmt.Sprintf("segregation of duties (%s): %s", v.Rule, v.Description)
+}
+
+// CheckChangeDecision returns a violation when the policy forbids the actor from approving or
+// rejecting the change request
+func (p SegregationOfDutiesPolicy) CheckChangeDecision(cr ChangeRequest, actor string) error {
+	if p.Enforces(SoDChangeSelfApproval) && sameActor(actor, cr.Requester) {
+		return changeSelfApproval(cr, actor, time.Time{})
+	}
+	return nil
+}
+
+// CheckAssessmentReview returns a violation when the policy forbids the actor from reviewing the
+// assessment
+func (p SegregationOfDutiesPolicy) CheckAssessmentReview(assessment ApplicationAssessment, reviewer string) error {
+	if p.Enforces(SoDAssessmentSelfSignOff) && sameActor(reviewer, assessment.Evaluator) {
+		return assessmentSelfSignOff(assessment, reviewer, "review", time.Time{})
+	}
+	return nil
+}
+
+// CheckAssessmentSignOff returns a violation when the policy forbids the actor from signing off
+// the assessment
+func (p SegregationOfDutiesPolicy) CheckAssessmentSignOff(assessment ApplicationAssessment, approver string) error {
+	if p.Enforces(SoDAssessmentSelfSignOff) && sameActor(approver, assessment.Evaluator) {
+		return assessmentSelfSignOff(assessment, approver, "sign off", time.Time{})
+	}
+	if p.Enforces(SoDAssessmentReviewerSignOff) && sameActor(approver, assessment.SignOff.Reviewer) {
+		return assessmentReviewerSignOff(assessment, approver, time.Time{})
+	}
+	return nil
+}
+
+// SegregationOfDutiesReport lists the recorded changes and assessments where one person held two
+// duties the policy keeps apart, including those recorded before a rule was enforced
+type SegregationOfDutiesReport struct {
+	Rules          []SoDRule // rules checked
+	ChangeRequests int       // change requests checked
+	Assessments    int       // assessments checked
+	Violations     []SegregationOfDutiesViolation
+	GeneratedAt    time.Time
+}
+
+// BuildSegregationOfDutiesReport checks the decisions on change requests and the reviews and
+// sign-offs of assessments against the rules of the policy
+func BuildSegregationOfDutiesReport(policy SegregationOfDutiesPolicy, changeRequests []ChangeRequest, assessments []ApplicationAssessment, at time.Time) SegregationOfDutiesReport {
+	report := SegregationOfDutiesReport{
+		ChangeRequests: len(changeRequests),
+		Assessments:    len(assessments),
+		Violations:     []SegregationOfDutiesViolation{},
+		GeneratedAt:    at,
+	}
+	for _, rule := range AllSoDRules() {
+		if policy.Enforces(rule) {
+			report.Rules = append(report.Rules, rule)
+		}
+	}
+
+	if policy.Enforces(SoDChangeSelfApproval) {
+		for _, cr := range changeRequests {
+			for _, approval := range cr.Approvals {
+				if sameActor(approval.Approver, cr.Requester) {
+					report.Violations = append(report.Violations, changeSelfApproval(cr, approval.Approver, approval.ApprovedAt))
+				}
+			}
+		}
+	}
+	for _, assessment := range assessments {
+		signOff := assessment.SignOff
+		if policy.Enforces(SoDAssessmentSelfSignOff) {
+			if signOff.Reviewer != "" && sameActor(signOff.Reviewer, assessment.Evaluator) {
+				report.Violations = append(report.Violations, assessmentSelfSignOff(assessment, signOff.Reviewer, "review", signOff.ReviewedAt))
+			}
+			if signOff.Approver != "" && sameActor(signOff.Approver, assessment.Evaluator) {
+				report.Violations = append(report.Violations, assessmentSelfSignOff(assessment, signOff.Approver, "sign off", signOff.SignedOffAt))
+			}
+		}
+		if policy.Enforces(SoDAssessmentReviewerSignOff) && signOff.Approver != "" && sameActor(signOff.Approver, signOff.Reviewer) {
+			report.Violations = append(report.Violations, assessmentReviewerSignOff(assessment, signOff.Approver, signOff.SignedOffAt))
+		}
+	}
+	return report
+}
+
+func changeSelfApproval(cr ChangeRequest, actor string, at time.Time) SegregationOfDutiesViolation {
+	return SegregationOfDutiesViolation{
+		Rule:        SoDChangeSelfApproval,
+		EntityType:  AuditEntityChangeRequest,
+		EntityID:    cr.ID,
+		Actor:       actor,
+		Description: fmt.Sprintf("%s requested change %s and cannot also decide on it", actor, cr.ID),
+		At:          at,
+	}
+}
+
+func assessmentSelfSignOff(assessment ApplicationAssessment, actor, duty string, at time.Time) SegregationOfDutiesViolation {
+	return SegregationOfDutiesViolation{
+		Rule:        SoDAssessmentSelfSignOff,
+		EntityType:  AuditEntityAssessment,
+		EntityID:    assessment.ID,
+		Actor:       actor,
+		Description: fmt.Sprintf("%s evaluated %s in assessment %s and cannot also %s it", actor, assessment.ApplicationID, assessment.ID, duty),
+		At:          at,
+	}
+}
+
+func assessmentReviewerSignOff(assessment ApplicationAssessment, actor string, at time.Time) SegregationOfDutiesViolation {
+	return SegregationOfDutiesViolation{
+		Rule:        SoDAssessmentReviewerSignOff,
+		EntityType:  AuditEntityAssessment,
+		EntityID:    assessment.ID,
+		Actor:       actor,
+		Description: fmt.Sprintf("%s reviewed assessment %s and cannot also sign it off", actor, assessment.ID),
+		At:          at,
+	}
+}
+
+// sameActor reports whether two names are the same person, ignoring case and surrounding spaces
+func sameActor(a, b string) bool {
+	a, b = strings.TrimSpace(a), strings.TrimSpace(b)
+	return a != "" && strings.EqualFold(a, b)
+}
@@ -18,7 +18,6 @@ package domain
 import (
 	"context"
 	"fmt"
-	"strings"
 	"time"
 )
 
@@ -29,575 +28,554 @@ type EvaluationService struct {
 	portfolioRepo   ApplicationPortfolioRepository
 	kpiRepo         KPIRepository
 	riskRepo        RiskRepository
+	technicalHealth TechnicalHealthAssessor
+	businessValue   BusinessValueAssessor
+	riskClassifier  RiskClassifier
+	profile         EvaluationProfile
+	assessmentRepo  AssessmentRepository
+	baselines       *BaselineProfile
+	debtRepo        TechnicalDebtRepository
+	measurementRepo AvailabilityMeasurementRepository
+	riskSimulation  *RiskSimulationOptions
+	templates       *EvaluationTemplateSet
+	metricsProvider MetricsProvider
+	variance        ScoreVariance
+	attachmentStore AttachmentStore
+	incidentRepo    IncidentRepository
+	changeRepo      ChangeRequestRepository
+	vendorRepo      VendorRepository
+	dpiaRepo        DPIARepository
 }
 
-// NewEvaluationService creates a new evaluation service
-func NewEvaluationService(appRepo ApplicationRepository, agreementRepo GovernanceAgreementRepository, portfolioRepo ApplicationPortfolioRepository, kpiRepo KPIRepository, riskRepo RiskRepository) *EvaluationService {
-	return &EvaluationService{
-		applicationRepo: appRepo,
-		agreementRepo:   agreementRepo,
-		portfolioRepo:   portfolioRepo,
-		kpiRepo:         kpiRepo,
-		riskRepo:        riskRepo,
+// EvaluationOption customizes an EvaluationService
+type EvaluationOption func(*EvaluationService)
+
+// WithTechnicalHealthAssessor replaces the default technical health heuristics
+func WithTechnicalHealthAssessor(assessor TechnicalHealthAssessor) EvaluationOption {
+	return func(s *EvaluationService) {
+		s.technicalHealth = assessor
 	}
 }
 
-// EvaluateApplication performs a comprehensive evaluation of an application
-func (s *EvaluationService) EvaluateApplication(ctx context.Context, appID ApplicationID, evaluator string) (*ApplicationAssessment, error) {
-	// Get application
-	app, err := s.applicationRepo.FindByID(ctx, appID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to find application: %w", err)
+// WithBusinessValueAssessor replaces the default business value heuristics
+func WithBusinessValueAssessor(assessor BusinessValueAssessor) EvaluationOption {
+	return func(s *EvaluationService) {
+		s.businessValue = assessor
 	}
+}
 
-	// Get governance agreement (not used in current implementation but may be needed for future enhancements)
-	_, err = s.agreementRepo.FindByApplicationID(ctx, appID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to find governance agreement: %w", err)
+// WithEvaluationProfile sets the profile used by the default assessors and risk classifier
+func WithEvaluationProfile(profile EvaluationProfile) EvaluationOption {
+	return func(s *EvaluationService) {
+		s.profile = profile
 	}
+}
 
-	// Assess technical health
-	technicalHealth := s.assessTechnicalHealth(app)
-
-	// Assess business value
-	businessValue := s.assessBusinessValue(ctx, app)
-
-	// Determine risk level
-	riskLevel := s.determineRiskLevel(technicalHealth, businessValue)
-
-	// Generate recommendations
-	recommendations := s.generateRecommendations(technicalHealth, businessValue, riskLevel)
-
-	assessment := &ApplicationAssessment{
-		ApplicationID:   appID,
-		TechnicalHealth: technicalHealth,
-		BusinessValue:   businessValue,
-		RiskLevel:       riskLevel,
-		Recommendations: recommendations,
+// WithAssessmentRepository persists every application assessment as part of the evaluation history
+func WithAssessmentRepository(repo AssessmentRepository) EvaluationOption {
+	return func(s *EvaluationService) {
+		s.assessmentRepo = repo
 	}
-
-	return assessment, nil
 }
 
-// EvaluatePortfolio performs evaluation of the entire portfolio
-func (s *EvaluationService) EvaluatePortfolio(ctx context.Context, portfolioID PortfolioID) (*PortfolioHealthAssessment, error) {
-	// Get portfolio and its applications
-	portfolio, err := s.portfolioRepo.FindByID(ctx, portfolioID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to find portfolio: %w", err)
+// WithBaselineProfile benchmarks every assessment against the baseline for the application's category
+func WithBaselineProfile(profile BaselineProfile) EvaluationOption {
+	return func(s *EvaluationService) {
+		s.baselines = &profile
 	}
+}
 
-	apps := portfolio.Applications
-
-	totalApps := len(apps)
-	activeApps := 0
-	deprecatedApps := 0
-	redundantApps := 0
-	totalCost := 0.0
-	riskDistribution := make(map[RiskLevel]int)
-
-	assessments := make([]ApplicationAssessment, 0, totalApps)
-
-	for _, app := range apps {
-		assessment, err := s.EvaluateApplication(ctx, app.ID, "system")
-		if err != nil {
-			continue // Skip failed assessments
-		}
-		assessments = append(assessments, *assessment)
-
-		// Count by status
-		switch app.Status {
-		case StatusActive:
-			activeApps++
-		case StatusDeprecated:
-			deprecatedApps++
-		case StatusRetired:
-			// Retired apps don't count toward active metrics
-		}
-
-		riskDistribution[assessment.RiskLevel]++
+// WithTechnicalDebtRepository scores technical health from the debt register and includes
+// debt totals in assessments and portfolio evaluations
+func WithTechnicalDebtRepository(repo TechnicalDebtRepository) EvaluationOption {
+	return func(s *EvaluationService) {
+		s.debtRepo = repo
 	}
+}
 
-	// Calculate average age (simplified)
-	avgAge := s.calculateAverageApplicationAge(apps)
-
-	assessment := &PortfolioHealthAssessment{
-		TotalApplications:     totalApps,
-		ActiveApplications:    activeApps,
-		DeprecatedApplications: deprecatedApps,
-		RedundantApplications: redundantApps,
-		TotalCost:            totalCost,
-		AverageApplicationAge: avgAge,
-		RiskDistribution:     riskDistribution,
+// WithVendorRepository includes the risk ratings of an application's vendors in its assessments,
+// raising its risk level to that of the riskiest vendor it highly or critically depends on
+func WithVendorRepository(repo VendorRepository) EvaluationOption {
+	return func(s *EvaluationService) {
+		s.vendorRepo = repo
 	}
-
-	return assessment, nil
 }
 
-// assessTechnicalHealth evaluates the technical health of an application
-func (s *EvaluationService) assessTechnicalHealth(app Application) TechnicalHealth {
-	score := 3 // Base score
-
-	// Analyze version maturity (semantic versioning indicates better practices)
-	versionScore := s.analyzeVersionMaturity(app.Version)
-	score += versionScore
-
-	// Security provisions analysis
-	securityScore := s.analyzeSecurityProvisions(app.SecurityProvisions)
-	score += securityScore
-
-	// Documentation and catalogue completeness
-	documentationScore := s.analyzeDocumentationCompleteness(app.Catalogue)
-	score += documentationScore
-
-	// Age-based depreciation (older apps may have accumulated technical debt)
-	ageScore := s.analyzeApplicationAge(app.CreatedAt, app.UpdatedAt)
-	score += ageScore
-
-	// Application status impact
-	statusScore := s.analyzeApplicationStatus(app.Status)
-	score += statusScore
-
-	// Ensure score is within bounds
-	if score < 1 {
-		score = 1
+// WithDPIARepository includes the data protection impact assessments of applications processing
+// personal data in their assessments, raising their risk level to the residual risk of an
+// approved DPIA, or to at least medium without one
+func WithDPIARepository(repo DPIARepository) EvaluationOption {
+	return func(s *EvaluationService) {
+		s.dpiaRepo = repo
 	}
-	if score > 5 {
-		score = 5
-	}
-
-	// Calculate individual metrics based on overall score with some variance
-	basePercentage := float64(score) * 20.0 // Base percentage
+}
 
-	return TechnicalHealth{
-		CodeQuality:      s.adjustScoreWithVariance(score, 0.8, 1.2),
-		Documentation:    s.adjustScoreWithVariance(score, 0.9, 1.1),
-		TestCoverage:     basePercentage + float64(securityScore)*5.0, // Security affects testing
-		SecurityScore:    s.adjustScoreWithVariance(score+securityScore, 0.7, 1.3),
-		PerformanceScore: s.adjustScoreWithVariance(score+ageScore, 0.8, 1.2),
+// WithAvailabilityMeasurementRepository uses observed uptime and response time in assessments
+// and flags breaches of the application's declared availability SLA
+func WithAvailabilityMeasurementRepository(repo AvailabilityMeasurementRepository) EvaluationOption {
+	return func(s *EvaluationService) {
+		s.measurementRepo = repo
 	}
 }
 
-// analyzeVersionMaturity evaluates version string for maturity indicators
-func (s *EvaluationService) analyzeVersionMaturity(version string) int {
-	if version == "" {
-		return -1 // Penalty for no version
+// WithRiskSimulation adds a Monte Carlo loss distribution of the risks identified in the
+// applications' agreements to every portfolio evaluation
+func WithRiskSimulation(opts RiskSimulationOptions) EvaluationOption {
+	return func(s *EvaluationService) {
+		s.riskSimulation = &opts
 	}
+}
 
-	// Check for semantic versioning (major.minor.patch)
-	parts := strings.Split(version, ".")
-	if len(parts) >= 3 {
-		// Semantic versioning indicates better development practices
-		return 1
+// WithRiskClassifier replaces the default risk classification
+func WithRiskClassifier(classifier RiskClassifier) EvaluationOption {
+	return func(s *EvaluationService) {
+		s.riskClassifier = classifier
 	}
+}
 
-	// Check for development/pre-release indicators
-	lowerVersion := strings.ToLower(version)
-	if strings.Contains(lowerVersion, "dev") ||
-	   strings.Contains(lowerVersion, "alpha") ||
-	   strings.Contains(lowerVersion, "beta") ||
-	   strings.Contains(lowerVersion, "rc") {
-		return 0 // Neutral for development versions
+// WithScoreVariance varies the technical health component scores, e.g. with a SeededVariance for
+// reproducible variation. Without it component scores follow the overall score.
+func WithScoreVariance(variance ScoreVariance) EvaluationOption {
+	return func(s *EvaluationService) {
+		s.variance = variance
 	}
-
-	return 0 // Neutral for other version formats
 }
 
-// analyzeSecurityProvisions evaluates security measures in place
-func (s *EvaluationService) analyzeSecurityProvisions(provisions SecurityProvisions) int {
-	score := 0
-
-	// Data confidentiality measures
-	if len(provisions.DataConfidentiality) > 0 {
-		score++
-		if len(provisions.DataConfidentiality) > 2 {
-			score++ // Bonus for comprehensive confidentiality
-		}
+// WithMetricsProvider uses observed usage and survey figures in place of the business value
+// heuristics, which remain the fallback for figures the provider does not have
+func WithMetricsProvider(provider MetricsProvider) EvaluationOption {
+	return func(s *EvaluationService) {
+		s.metricsProvider = provider
 	}
+}
 
-	// Data integrity measures
-	if len(provisions.DataIntegrity) > 0 {
-		score++
-		if len(provisions.DataIntegrity) > 2 {
-			score++ // Bonus for comprehensive integrity
-		}
+// WithEvaluationTemplates selects an evaluation template for every assessment by the
+// application's category, applying its weights and required checks
+func WithEvaluationTemplates(templates EvaluationTemplateSet) EvaluationOption {
+	return func(s *EvaluationService) {
+		s.templates = &templates
 	}
+}
 
-	// Application authenticity measures
-	if len(provisions.ApplicationAuthenticity) > 0 {
-		score++
+// WithAttachmentStore holds back the sign-off of assessments until the evidence their template
+// makes mandatory is attached
+func WithAttachmentStore(store AttachmentStore) EvaluationOption {
+	return func(s *EvaluationService) {
+		s.attachmentStore = store
 	}
+}
 
-	// Roles and permissions (access control)
-	if len(provisions.RolesAndPermissions) > 0 {
-		score++
-		if len(provisions.RolesAndPermissions) > 3 {
-			score++ // Bonus for comprehensive role management
-		}
+// NewEvaluationService creates a new evaluation service using the default assessors unless overridden
+func NewEvaluationService(appRepo ApplicationRepository, agreementRepo GovernanceAgreementRepository, portfolioRepo ApplicationPortfolioRepository, kpiRepo KPIRepository, riskRepo RiskRepository, opts ...EvaluationOption) *EvaluationService {
+	s := &EvaluationService{
+		applicationRepo: appRepo,
+		agreementRepo:   agreementRepo,
+		portfolioRepo:   portfolioRepo,
+		kpiRepo:         kpiRepo,
+		riskRepo:        riskRepo,
+		profile:         DefaultEvaluationProfile(),
 	}
-
-	// SLA-based availability (indirect security measure)
-	if provisions.ApplicationAvailability.ResponseTime > 0 {
-		score++
+	for _, opt := range opts {
+		opt(s)
 	}
-
-	return score - 2 // Normalize (subtract base expectation)
+	return s
 }
 
-// analyzeDocumentationCompleteness evaluates documentation quality
-func (s *EvaluationService) analyzeDocumentationCompleteness(catalogue ApplicationCatalogue) int {
-	score := 0
+// Profile returns the evaluation profile used when none is given per call
+func (s *EvaluationService) Profile() EvaluationProfile {
+	return s.profile
+}
 
-	// Recent updates indicate active maintenance
-	if !catalogue.LastUpdated.IsZero() {
-		daysSinceUpdate := time.Since(catalogue.LastUpdated).Hours() / 24
-		if daysSinceUpdate < 90 { // Updated within 3 months
-			score += 2
-		} else if daysSinceUpdate < 365 { // Updated within a year
-			score++
-		}
-	} else {
-		score-- // Penalty for no update date
-	}
+// EvaluateApplication performs a comprehensive evaluation of an application
+func (s *EvaluationService) EvaluateApplication(ctx context.Context, appID ApplicationID, evaluator string) (*ApplicationAssessment, error) {
+	return s.EvaluateApplicationWithProfile(ctx, appID, evaluator, s.profile)
+}
 
-	// Comprehensive functionality documentation
-	if len(catalogue.Functionality) > 0 {
-		score++
-		if len(catalogue.Functionality) > 5 {
-			score++ // Bonus for detailed functionality
-		}
+// EvaluateApplicationWithProfile evaluates an application using the given profile instead of the service default.
+// The profile only affects assessors that were not replaced through options. The risk thresholds
+// of the first portfolio, by ID, that contains the application and defines its own replace the
+// profile's.
+func (s *EvaluationService) EvaluateApplicationWithProfile(ctx context.Context, appID ApplicationID, evaluator string, profile EvaluationProfile) (*ApplicationAssessment, error) {
+	if err := profile.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid evaluation profile: %w", err)
 	}
 
-	return score
+	profile = portfolioThresholdsFor(ctx, s.portfolioRepo, appID).ApplyTo(profile)
+	return s.evaluateApplication(ctx, appID, evaluator, profile)
 }
 
-// analyzeApplicationAge evaluates age-related technical debt
-func (s *EvaluationService) analyzeApplicationAge(createdAt, updatedAt time.Time) int {
-	if createdAt.IsZero() {
-		return 0 // No age data available
+// evaluateApplication assesses an application with a validated profile and records the assessment
+func (s *EvaluationService) evaluateApplication(ctx context.Context, appID ApplicationID, evaluator string, profile EvaluationProfile) (*ApplicationAssessment, error) {
+	// Get application
+	app, err := s.applicationRepo.FindByID(ctx, appID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find application: %w", err)
 	}
 
-	ageInDays := time.Since(createdAt).Hours() / 24
+	// Get governance agreement for business context
+	agreement, err := s.agreementRepo.FindByApplicationID(ctx, appID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find governance agreement: %w", err)
+	}
 
-	// Very old applications may have accumulated technical debt
-	if ageInDays > 365*5 { // Over 5 years old
-		return -2
-	} else if ageInDays > 365*2 { // Over 2 years old
-		return -1
+	assessment, err := s.assessApplication(ctx, app, &agreement, evaluator, profile)
+	if err != nil {
+		return nil, err
 	}
 
-	// Recently updated applications are better maintained
-	if !updatedAt.IsZero() {
-		daysSinceUpdate := time.Since(updatedAt).Hours() / 24
-		if daysSinceUpdate < 90 { // Updated within 3 months
-			return 1
-		} else if daysSinceUpdate < 180 { // Updated within 6 months
-			return 0
+	if s.assessmentRepo != nil {
+		if err := s.assessmentRepo.Save(ctx, *assessment); err != nil {
+			return nil, fmt.Errorf("failed to save assessment: %w", err)
 		}
 	}
 
-	return 0
+	return assessment, nil
 }
 
-// analyzeApplicationStatus evaluates status impact on technical health
-func (s *EvaluationService) analyzeApplicationStatus(status ApplicationStatus) int {
-	switch status {
-	case StatusActive:
-		return 1 // Active apps are well-maintained
-	case StatusDeprecated:
-		return -1 // Deprecated apps may have issues
-	case StatusRetired:
-		return -2 // Retired apps have significant issues
-	case StatusPlanned:
-		return 0 // Planned apps are new, no technical debt yet
-	default:
-		return 0
+// assessApplication scores an application without looking it up or persisting the result;
+// agreement is nil when the application has no governance agreement
+func (s *EvaluationService) assessApplication(ctx context.Context, app Application, agreement *GovernanceAgreement, evaluator string, profile EvaluationProfile) (*ApplicationAssessment, error) {
+	// The application's category selects its evaluation template
+	var template *EvaluationTemplate
+	if s.templates != nil {
+		if selected, ok := s.templates.TemplateFor(app.Category); ok {
+			template = &selected
+			profile = selected.ApplyTo(profile)
+		}
 	}
-}
 
-// adjustScoreWithVariance adds realistic variance to scores
-func (s *EvaluationService) adjustScoreWithVariance(baseScore int, minFactor, maxFactor float64) int {
-	// Simple deterministic variance based on base score
-	// In a real system, this could use random factors
-	variance := (float64(baseScore) * 0.1) // 10% variance
-	if variance > 0.5 {
-		variance = 0.5
-	}
-	if variance < -0.5 {
-		variance = -0.5
-	}
+	// Assess technical health
+	technicalAssessor, valueAssessor, riskClassifier := s.evaluatorsFor(profile)
+	technicalHealth := technicalAssessor.AssessTechnicalHealth(ctx, app)
 
-	adjusted := float64(baseScore) + variance
-	if adjusted < 1 {
-		adjusted = 1
-	}
-	if adjusted > 5 {
-		adjusted = 5
-	}
+	// Assess business value
+	businessValue := valueAssessor.AssessBusinessValue(ctx, app, agreement)
 
-	return int(adjusted + 0.5) // Round to nearest integer
-}
+	// Determine risk level
+	riskLevel := riskClassifier.ClassifyRisk(technicalHealth, businessValue)
 
-// assessBusinessValue evaluates the business value of an application
-func (s *EvaluationService) assessBusinessValue(ctx context.Context, app Application) BusinessValueAssessment {
-	// Get governance agreement for business context
-	var agreement *GovernanceAgreement
-	if s.agreementRepo != nil {
-		if govAgreement, err := s.agreementRepo.FindByApplicationID(ctx, app.ID); err == nil {
-			agreement = &govAgreement
+	var debt *TechnicalDebtSummary
+	if s.debtRepo != nil {
+		items, err := s.debtRepo.FindByApplicationID(ctx, app.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load technical debt: %w", err)
 		}
+		summary := SummarizeTechnicalDebt(items)
+		debt = &summary
 	}
 
-	// Calculate usage metrics based on application attributes
-	usageMetrics := s.calculateUsageMetrics(app, agreement)
-
-	// Calculate business alignment based on governance agreement
-	businessAlignment := s.calculateBusinessAlignment(app, agreement)
-
-	// Calculate cost efficiency based on application status and maintenance
-	costEfficiency := s.calculateCostEfficiency(app, agreement)
-
-	// Calculate user satisfaction based on application health and governance
-	userSatisfaction := s.calculateUserSatisfaction(app, agreement)
-
-	return BusinessValueAssessment{
-		UsageMetrics:     usageMetrics,
-		BusinessAlignment: businessAlignment,
-		CostEfficiency:   costEfficiency,
-		UserSatisfaction: userSatisfaction,
+	var breaches []SLABreach
+	if s.measurementRepo != nil {
+		if measurement, err := s.measurementRepo.FindLatest(ctx, app.ID); err == nil {
+			breaches = DetectSLABreaches(app.SecurityProvisions.ApplicationAvailability, measurement)
+		}
 	}
-}
 
-// calculateUsageMetrics derives usage metrics from application attributes
-func (s *EvaluationService) calculateUsageMetrics(app Application, agreement *GovernanceAgreement) UsageMetrics {
-	// Base metrics derived from application characteristics
-	activeUsers := 50   // Base active users
-	transactionVolume := 1000 // Base transactions
+	assessedAt := time.Now()
 
-	// Scale based on application status and governance
-	switch app.Status {
-	case StatusActive:
-		activeUsers *= 2
-		transactionVolume *= 3
-	case StatusDeprecated:
-		activeUsers /= 2
-		transactionVolume /= 2
-	case StatusRetired:
-		activeUsers /= 4
-		transactionVolume /= 4
+	operations, err := operationalMetrics(ctx, s.incidentRepo, app.ID, assessedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load operational metrics: %w", err)
+	}
+	changes, err := changeMetrics(ctx, s.changeRepo, s.incidentRepo, app.ID, assessedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load change metrics: %w", err)
 	}
 
-	// Governance agreement indicates higher usage
+	// Approaching end of support and unmet conformance requirements escalate the risk level
+	var endOfLife []EndOfLifeFinding
+	var compliance *ConformanceScore
 	if agreement != nil {
-		activeUsers = int(float64(activeUsers) * 1.5)
-		transactionVolume = int(float64(transactionVolume) * 1.8)
+		endOfLife = AssessEndOfLife(agreement.Strategy.ICTOperationsManual.TechnologyComponents, assessedAt, profile.EndOfLifeLeadTimes)
+		riskLevel = escalateForEndOfLife(riskLevel, endOfLife)
+		compliance = ScoreConformance(agreement.Conformance)
+		riskLevel = escalateForCompliance(riskLevel, compliance, profile.ComplianceRiskThresholds)
 	}
 
-	// Age affects usage patterns
-	if !app.CreatedAt.IsZero() {
-		ageInYears := time.Since(app.CreatedAt).Hours() / (24 * 365)
-		if ageInYears > 3 {
-			// Mature applications typically have higher usage
-			activeUsers = int(float64(activeUsers) * 1.3)
-			transactionVolume = int(float64(transactionVolume) * 1.4)
+	// Sensitive data held with too little security escalates the risk level
+	riskLevel = escalateForDataClassification(riskLevel, app.DataClassification, technicalHealth)
+
+	// Outsourcing does not outsource accountability, so risky vendors escalate the risk level too
+	var vendors []VendorExposure
+	if s.vendorRepo != nil {
+		found, err := s.vendorRepo.FindByApplicationID(ctx, app.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load vendors: %w", err)
 		}
+		vendors = vendorExposures(found)
+		riskLevel = escalateForVendors(riskLevel, vendors)
 	}
 
-	// Calculate uptime based on technical health proxy
-	uptimePercentage := 99.0 // Base uptime
-	if len(app.SecurityProvisions.RolesAndPermissions) > 0 {
-		uptimePercentage += 0.5 // Better security = better uptime
-	}
-	if !app.UpdatedAt.IsZero() && time.Since(app.UpdatedAt).Hours() < 24*30 {
-		uptimePercentage += 0.4 // Recently updated = better maintenance
+	// Processing personal data without an approved impact assessment escalates the risk level
+	var privacy *DPIAFinding
+	if s.dpiaRepo != nil {
+		dpias, err := s.dpiaRepo.FindByApplicationID(ctx, app.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load DPIAs: %w", err)
+		}
+		privacy = dpiaFinding(app, dpias, assessedAt)
+		riskLevel = escalateForDPIA(riskLevel, app.DataClassification, privacy)
 	}
 
-	// Response time based on application complexity
-	responseTime := time.Millisecond * 300 // Base response time
-	if strings.Contains(strings.ToLower(app.Name), "legacy") {
-		responseTime += time.Millisecond * 200 // Legacy systems slower
-	}
-	if len(app.SecurityProvisions.DataIntegrity) > 0 {
-		responseTime += time.Millisecond * 50 // Security measures overhead
-	}
+	// Generate recommendations
+	recommendations := s.generateRecommendations(technicalHealth, businessValue, riskLevel, debt)
+	recommendations = append(recommendations, slaRecommendations(breaches)...)
+	recommendations = append(recommendations, endOfLifeRecommendations(endOfLife)...)
+	recommendations = append(recommendations, operationalRecommendations(operations)...)
+	recommendations = append(recommendations, changeRecommendations(changes)...)
+	recommendations = append(recommendations, conformanceRecommendations(compliance)...)
+	recommendations = append(recommendations, vendorRecommendations(vendors)...)
+	recommendations = append(recommendations, dataClassificationRecommendations(app, technicalHealth)...)
+	recommendations = append(recommendations, dpiaRecommendations(privacy)...)
 
-	return UsageMetrics{
-		ActiveUsers:       activeUsers,
-		TransactionVolume: transactionVolume,
-		UptimePercentage:  uptimePercentage,
-		ResponseTime:      responseTime,
+	assessment := &ApplicationAssessment{
+		ID:              fmt.Sprintf("assessment-%s-%d", app.ID, assessedAt.UnixNano()),
+		ApplicationID:   app.ID,
+		Evaluator:       evaluator,
+		ProfileName:     profile.Name,
+		AssessedAt:      assessedAt,
+		TechnicalHealth: technicalHealth,
+		BusinessValue:   businessValue,
+		RiskLevel:       riskLevel,
+		TIMEQuadrant:    ClassifyTIME(technicalHealth, businessValue, profile.TIMEThresholds),
+		Recommendations: recommendations,
+		TechnicalDebt:   debt,
+		SLABreaches:     breaches,
+		Operations:      operations,
+		Changes:         changes,
+		EndOfLife:       endOfLife,
+		Compliance:      compliance,
+		Vendors:         vendors,
+		Data:            app.DataClassification,
+		DPIA:            privacy,
+		SignOff: AssessmentSignOff{
+			State:                SignOffDraft,
+			RequiresSecondPerson: profile.SignOffPolicy.RequiresSecondPerson(riskLevel),
+		},
+	}
+	if s.baselines != nil {
+		assessment.Benchmark = CompareToBaseline(*s.baselines, app.Category, *assessment)
+	}
+	if template != nil {
+		assessment.Template = template.Name
+		assessment.TemplateChecks = template.RunChecks(*assessment)
+		assessment.MandatoryEvidence = template.MandatoryEvidence
+		assessment.Recommendations = append(assessment.Recommendations, templateRecommendations(*template, assessment.TemplateChecks)...)
 	}
-}
 
-// calculateBusinessAlignment evaluates how well the application aligns with business objectives
-func (s *EvaluationService) calculateBusinessAlignment(app Application, agreement *GovernanceAgreement) float64 {
-	baseAlignment := 70.0 // Base alignment score
+	return assessment, nil
+}
 
-	// Governance agreement significantly improves alignment
-	if agreement != nil {
-		baseAlignment += 20.0
+// EvaluatePortfolio performs evaluation of the entire portfolio
+func (s *EvaluationService) EvaluatePortfolio(ctx context.Context, portfolioID PortfolioID) (*PortfolioHealthAssessment, error) {
+	return s.EvaluatePortfolioWithProfile(ctx, portfolioID, s.profile)
+}
 
-		// Strategic objectives indicate strong alignment
-		if len(agreement.Direct.StrategicDirection.Objectives) > 0 {
-			baseAlignment += 5.0
-		}
+// EvaluatePortfolioWithProfile evaluates a portfolio using the given profile for every application,
+// with the portfolio's own risk thresholds when it defines them
+func (s *EvaluationService) EvaluatePortfolioWithProfile(ctx context.Context, portfolioID PortfolioID, profile EvaluationProfile) (*PortfolioHealthAssessment, error) {
+	return s.EvaluatePortfolioAs(ctx, portfolioID, "system", profile)
+}
 
-		// Active monitoring improves alignment
-		if agreement.Conformance.ComplianceMonitoring.MonitoringFrequency != "" {
-			baseAlignment += 5.0
-		}
+// EvaluatePortfolioAs evaluates a portfolio like EvaluatePortfolioWithProfile, recording the
+// evaluator on the assessments of its applications
+func (s *EvaluationService) EvaluatePortfolioAs(ctx context.Context, portfolioID PortfolioID, evaluator string, profile EvaluationProfile) (*PortfolioHealthAssessment, error) {
+	if err := profile.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid evaluation profile: %w", err)
 	}
 
-	// Application status affects alignment
-	switch app.Status {
-	case StatusActive:
-		baseAlignment += 5.0
-	case StatusPlanned:
-		baseAlignment += 2.0 // Future alignment
-	case StatusDeprecated:
-		baseAlignment -= 10.0 // Misalignment with current strategy
-	case StatusRetired:
-		baseAlignment -= 20.0 // No alignment
+	// Get portfolio and its applications
+	portfolio, err := s.portfolioRepo.FindByID(ctx, portfolioID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find portfolio: %w", err)
 	}
+	profile = portfolio.Thresholds.ApplyTo(profile)
 
-	// Recent updates indicate current alignment
-	if !app.UpdatedAt.IsZero() && time.Since(app.UpdatedAt).Hours() < 24*90 {
-		baseAlignment += 3.0
-	}
+	apps := s.currentApplications(ctx, portfolio.Applications)
+	return s.portfolioHealth(ctx, portfolioID, apps, profile, func(app Application) (*ApplicationAssessment, error) {
+		return s.evaluateApplication(ctx, app.ID, evaluator, profile)
+	})
+}
 
-	// Ensure bounds
-	if baseAlignment > 100.0 {
-		baseAlignment = 100.0
-	}
-	if baseAlignment < 0.0 {
-		baseAlignment = 0.0
+// currentApplications replaces the portfolio's copies of its applications with their current
+// state in the application repository, keeping the copy of any application not found there
+func (s *EvaluationService) currentApplications(ctx context.Context, apps []Application) []Application {
+	current := make([]Application, 0, len(apps))
+	for _, app := range apps {
+		if found, err := s.applicationRepo.FindByID(ctx, app.ID); err == nil {
+			app = found
+		}
+		current = append(current, app)
 	}
-
-	return baseAlignment
+	return current
 }
 
-// calculateCostEfficiency evaluates the cost effectiveness of the application
-func (s *EvaluationService) calculateCostEfficiency(app Application, agreement *GovernanceAgreement) float64 {
-	baseEfficiency := 60.0 // Base efficiency
+// portfolioHealth aggregates the assessments of a set of applications into a portfolio health
+// assessment. Applications that assess returns an error for are counted but not assessed.
+func (s *EvaluationService) portfolioHealth(ctx context.Context, portfolioID PortfolioID, apps []Application, profile EvaluationProfile, assess func(Application) (*ApplicationAssessment, error)) (*PortfolioHealthAssessment, error) {
+	totalApps := len(apps)
+	activeApps := 0
+	deprecatedApps := 0
+	redundantApps := 0
+	totalCost := 0.0
+	costBreakdown := ApplicationCost{}
+	riskDistribution := make(map[RiskLevel]int)
+	timeQuadrants := make(map[TIMEQuadrant][]ApplicationID)
 
-	// Governance agreements improve cost efficiency through oversight
-	if agreement != nil {
-		baseEfficiency += 15.0
+	assessments := make([]ApplicationAssessment, 0, totalApps)
+
+	for _, app := range apps {
+		costBreakdown = costBreakdown.Add(app.Cost)
 
-		// Resource allocation indicates cost management
-		if len(agreement.Direct.ResourceAllocation.BudgetAllocations) > 0 {
-			baseEfficiency += 10.0
+		assessment, err := assess(app)
+		if err != nil {
+			continue // Skip failed assessments
 		}
-	}
+		assessments = append(assessments, *assessment)
 
-	// Application status affects cost efficiency
-	switch app.Status {
-	case StatusActive:
-		baseEfficiency += 10.0 // Active maintenance
-	case StatusDeprecated:
-		baseEfficiency -= 15.0 // High maintenance costs
-	case StatusRetired:
-		baseEfficiency -= 25.0 // Very high maintenance costs
-	case StatusPlanned:
-		baseEfficiency += 5.0 // Planned efficiency
-	}
-
-	// Age affects efficiency (older systems may be more expensive to maintain)
-	if !app.CreatedAt.IsZero() {
-		ageInYears := time.Since(app.CreatedAt).Hours() / (24 * 365)
-		if ageInYears > 5 {
-			baseEfficiency -= 10.0
-		} else if ageInYears < 1 {
-			baseEfficiency += 5.0 // New systems are more efficient
+		// Count by status
+		switch app.Status {
+		case StatusActive:
+			activeApps++
+		case StatusDeprecated:
+			deprecatedApps++
+		case StatusRetired:
+			// Retired apps don't count toward active metrics
 		}
-	}
 
-	// Security provisions may indicate higher quality (better efficiency)
-	securityMeasures := len(app.SecurityProvisions.DataConfidentiality) +
-					   len(app.SecurityProvisions.DataIntegrity) +
-					   len(app.SecurityProvisions.RolesAndPermissions)
-	if securityMeasures > 3 {
-		baseEfficiency += 5.0
+		riskDistribution[assessment.RiskLevel]++
+		if app.Status != StatusRetired {
+			timeQuadrants[assessment.TIMEQuadrant] = append(timeQuadrants[assessment.TIMEQuadrant], app.ID)
+		}
 	}
+	totalCost = costBreakdown.AnnualTotal()
 
-	// Ensure bounds
-	if baseEfficiency > 100.0 {
-		baseEfficiency = 100.0
-	}
-	if baseEfficiency < 0.0 {
-		baseEfficiency = 0.0
+	consolidation := DetectRedundancy(apps, s.functionalityCatalogues(ctx, apps), DefaultRedundancyThreshold)
+	redundantApps = RedundantApplicationCount(consolidation)
+
+	var debt *PortfolioTechnicalDebt
+	if s.debtRepo != nil {
+		rollup, err := rollUpTechnicalDebt(ctx, s.debtRepo, portfolioID, apps)
+		if err != nil {
+			return nil, err
+		}
+		debt = rollup
 	}
 
-	return baseEfficiency
-}
+	var exposure *RiskExposure
+	if s.riskSimulation != nil {
+		simulated, err := s.simulateRisk(ctx, portfolioID, apps, *s.riskSimulation)
+		if err != nil {
+			return nil, err
+		}
+		exposure = simulated
+	}
 
-// calculateUserSatisfaction estimates user satisfaction based on application factors
-func (s *EvaluationService) calculateUserSatisfaction(app Application, agreement *GovernanceAgreement) float64 {
-	baseSatisfaction := 65.0 // Base satisfaction
+	// Calculate average age (simplified)
+	avgAge := s.calculateAverageApplicationAge(apps)
 
-	// Governance agreement indicates better user experience management
-	if agreement != nil {
-		baseSatisfaction += 15.0
+	healthIndex := CalculatePortfolioHealthIndex(assessments, activeApps, deprecatedApps,
+		s.portfolioKPIAttainment(ctx, portfolioID, apps), profile.HealthIndexWeights)
 
-		// Performance metrics indicate user focus
-		if len(agreement.Evaluate.PerformanceMetrics) > 0 {
-			baseSatisfaction += 5.0
-		}
+	assessment := &PortfolioHealthAssessment{
+		TotalApplications:     totalApps,
+		ActiveApplications:    activeApps,
+		DeprecatedApplications: deprecatedApps,
+		RedundantApplications: redundantApps,
+		TotalCost:            totalCost,
+		AverageApplicationAge: avgAge,
+		RiskDistribution:     riskDistribution,
+		CostBreakdown:        costBreakdown,
+		ConsolidationCandidates: consolidation,
+		TechnicalDebt:        debt,
+		RiskExposure:         exposure,
+		HealthIndex:          healthIndex,
+		TIMEQuadrants:        timeQuadrants,
+		Compliance:           RollUpCompliance(assessments, profile.ComplianceRiskThresholds),
 	}
 
-	// Application status affects user satisfaction
-	switch app.Status {
-	case StatusActive:
-		baseSatisfaction += 10.0
-	case StatusDeprecated:
-		baseSatisfaction -= 15.0 // Users frustrated with deprecated systems
-	case StatusRetired:
-		baseSatisfaction -= 30.0 // Users very dissatisfied
-	case StatusPlanned:
-		baseSatisfaction += 5.0 // Anticipation of new system
-	}
+	return assessment, nil
+}
 
-	// Recent updates indicate better user experience
-	if !app.UpdatedAt.IsZero() && time.Since(app.UpdatedAt).Hours() < 24*60 {
-		baseSatisfaction += 8.0
+// portfolioKPIAttainment gathers the KPI measurements recorded in the agreements of the
+// portfolio's applications, other than retired ones, and the portfolio's own KPIs, applying the
+// portfolio's KPI tolerance
+func (s *EvaluationService) portfolioKPIAttainment(ctx context.Context, portfolioID PortfolioID, apps []Application) KPIAttainment {
+	var portfolioKPIs []KPI
+	var thresholds *PortfolioThresholds
+	if portfolio, err := s.portfolioRepo.FindByID(ctx, portfolioID); err == nil {
+		portfolioKPIs = portfolio.KPIs
+		thresholds = portfolio.Thresholds
 	}
 
-	// Security features may affect perceived reliability
-	if len(app.SecurityProvisions.RolesAndPermissions) > 0 {
-		baseSatisfaction += 3.0
+	var measurements []KPIMeasurement
+	if s.agreementRepo != nil {
+		for _, app := range apps {
+			if app.Status == StatusRetired {
+				continue
+			}
+			if agreement, err := s.agreementRepo.FindByApplicationID(ctx, app.ID); err == nil {
+				measurements = append(measurements, agreement.Evaluate.PerformanceMetrics...)
+			}
+		}
 	}
+	return kpiAttainment(portfolioKPIs, measurements, thresholds.kpiTolerance())
+}
 
-	// Ensure bounds
-	if baseSatisfaction > 100.0 {
-		baseSatisfaction = 100.0
-	}
-	if baseSatisfaction < 0.0 {
-		baseSatisfaction = 0.0
+// functionalityCatalogues returns each application's functionality, taken from the application
+// itself or, when it has none, from the catalogue in its governance agreement
+func (s *EvaluationService) functionalityCatalogues(ctx context.Context, apps []Application) map[ApplicationID][]Functionality {
+	catalogues := make(map[ApplicationID][]Functionality)
+	for _, app := range apps {
+		if len(app.Catalogue.Functionality) > 0 || s.agreementRepo == nil {
+			continue
+		}
+		agreement, err := s.agreementRepo.FindByApplicationID(ctx, app.ID)
+		if err != nil {
+			continue // Applications without an agreement keep their own catalogue
+		}
+		catalogues[app.ID] = agreement.Strategy.ApplicationCatalogue.Functionality
 	}
-
-	return baseSatisfaction
+	return catalogues
 }
 
-// determineRiskLevel calculates the overall risk level
-func (s *EvaluationService) determineRiskLevel(techHealth TechnicalHealth, businessValue BusinessValueAssessment) RiskLevel {
-	avgScore := (techHealth.CodeQuality + techHealth.SecurityScore + techHealth.PerformanceScore) / 3
-
-	if avgScore <= 2 || businessValue.CostEfficiency < 50 {
-		return RiskCritical
+// evaluatorsFor returns the configured assessors, building the defaults from the profile
+func (s *EvaluationService) evaluatorsFor(profile EvaluationProfile) (TechnicalHealthAssessor, BusinessValueAssessor, RiskClassifier) {
+	var technicalAssessor TechnicalHealthAssessor = &DefaultTechnicalHealthAssessor{Profile: profile, Debt: s.debtRepo, Incidents: s.incidentRepo, Variance: s.variance}
+	if s.technicalHealth != nil {
+		technicalAssessor = s.technicalHealth
 	}
-	if avgScore <= 3 || businessValue.CostEfficiency < 70 {
-		return RiskHigh
+	var valueAssessor BusinessValueAssessor = &DefaultBusinessValueAssessor{Profile: profile, Measurements: s.measurementRepo, Metrics: s.metricsProvider}
+	if s.businessValue != nil {
+		valueAssessor = s.businessValue
 	}
-	if avgScore <= 4 {
-		return RiskMedium
+	var riskClassifier RiskClassifier = &DefaultRiskClassifier{Thresholds: profile.RiskThresholds}
+	if s.riskClassifier != nil {
+		riskClassifier = s.riskClassifier
 	}
-	return RiskLow
+	return technicalAssessor, valueAssessor, riskClassifier
 }
 
 // generateRecommendations creates recommendations based on assessment
-func (s *EvaluationService) generateRecommendations(techHealth TechnicalHealth, businessValue BusinessValueAssessment, riskLevel RiskLevel) []Recommendation {
+func (s *EvaluationService) generateRecommendations(techHealth TechnicalHealth, businessValue BusinessValueAssessment, riskLevel RiskLevel, debt *TechnicalDebtSummary) []Recommendation {
 	recommendations := []Recommendation{}
 
+	if debt != nil && debt.PrincipalHours >= 40 {
+		priority := PriorityMedium
+		if debt.PrincipalHours >= 200 || debt.InterestHoursPerMonth >= 40 {
+			priority = PriorityHigh
+		}
+		recommendations = append(recommendations, Recommendation{
+			ID:   "debt-001",
+			Type: RecEnhance,
+			Description: fmt.Sprintf("Pay down open technical debt: %d items, %.0f hours, mostly %s",
+				debt.OpenItems, debt.PrincipalHours, debt.LargestCategory()),
+			Priority:        priority,
+			EstimatedEffort: time.Duration(debt.PrincipalHours * float64(time.Hour)),
+			BusinessImpact:  fmt.Sprintf("Recover %.0f hours of effort lost to debt interest each month", debt.InterestHoursPerMonth),
+		})
+	}
+
 	if techHealth.SecurityScore < 3 {
 		recommendations = append(recommendations, Recommendation{
 			ID:             "sec-001",
@@ -679,14 +657,9 @@ func (s *DirectionService) SetStrategicDirection(ctx context.Context, agreementI
 		return fmt.Errorf("failed to find governance agreement: %w", err)
 	}
 
-	// Update the direct principle
-	agreement.Direct.StrategicDirection.Objectives = objectives
-	agreement.Direct.StrategicDirection.Initiatives = initiatives
-	agreement.Direct.LastDirected = time.Now()
-
-	// Create action plans from objectives
-	actionPlans := s.createActionPlansFromObjectives(objectives)
-	agreement.Direct.ActionPlans = actionPlans
+	if err := s.applyStrategicDirection(&agreement, objectives, initiatives, time.Now()); err != nil {
+		return err
+	}
 
 	err = s.agreementRepo.Update(ctx, agreement)
 	if err != nil {
@@ -696,6 +669,30 @@ func (s *DirectionService) SetStrategicDirection(ctx context.Context, agreementI
 	return nil
 }
 
+// applyStrategicDirection validates the objectives and initiatives and puts them into effect on the
+// agreement, with action plans for new objectives
+func (s *DirectionService) applyStrategicDirection(agreement *GovernanceAgreement, objectives []StrategicObjective, initiatives []StrategicInitiative, at time.Time) error {
+	if err := validateObjectives(objectives); err != nil {
+		return fmt.Errorf("invalid strategic objectives: %w", err)
+	}
+	if err := validateInitiatives(initiatives, objectives); err != nil {
+		return fmt.Errorf("invalid strategic initiatives: %w", err)
+	}
+
+	// Update the direct principle
+	agreement.Direct.StrategicDirection.Objectives = objectives
+	agreement.Direct.StrategicDirection.Initiatives = initiatives
+	agreement.Direct.LastDirected = at
+
+	// Create action plans from objectives, keeping the plans of objectives that remain
+	actionPlans := s.createActionPlansFromObjectives(objectives, agreement.Direct.ActionPlans)
+	agreement.Direct.ActionPlans = actionPlans
+	if _, err := scheduleDirect(agreement.ID, &agreement.Direct, at); err != nil {
+		return fmt.Errorf("invalid action plans: %w", err)
+	}
+	return nil
+}
+
 // AllocateResources allocates resources for governance activities
 func (s *DirectionService) AllocateResources(ctx context.Context, agreementID GovernanceAgreementID, budgetAllocations []BudgetAllocation, personnelAllocations []PersonnelAllocation) error {
 	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
@@ -703,7 +700,27 @@ func (s *DirectionService) AllocateResources(ctx context.Context, agreementID Go
 		return fmt.Errorf("failed to find governance agreement: %w", err)
 	}
 
-	agreement.Direct.ResourceAllocation.BudgetAllocations = budgetAllocations
+	if err := validateBudgetAllocations(budgetAllocations); err != nil {
+		return fmt.Errorf("invalid budget allocations: %w", err)
+	}
+	if err := validatePersonnelAllocations(personnelAllocations); err != nil {
+		return fmt.Errorf("invalid personnel allocations: %w", err)
+	}
+
+	// Spend already recorded stays with the category it was spent from
+	spent := make(map[string][]Expenditure)
+	for _, allocation := range agreement.Direct.ResourceAllocation.BudgetAllocations {
+		spent[allocation.Category] = allocation.Expenditures
+	}
+	allocations := make([]BudgetAllocation, len(budgetAllocations))
+	for i, allocation := range budgetAllocations {
+		if len(allocation.Expenditures) == 0 {
+			allocation.Expenditures = spent[allocation.Category]
+		}
+		allocations[i] = allocation
+	}
+
+	agreement.Direct.ResourceAllocation.BudgetAllocations = allocations
 	agreement.Direct.ResourceAllocation.PersonnelAllocations = personnelAllocations
 	agreement.Direct.LastDirected = time.Now()
 
@@ -716,15 +733,32 @@ func (s *DirectionService) AllocateResources(ctx context.Context, agreementID Go
 }
 
 // EstablishPolicies establishes governance policies and standards
-func (s *DirectionService) EstablishPolicies(ctx context.Context, agreementID GovernanceAgreementID, policies []Policy, standards []Standard, procedures []Procedure) error {
+func (s *DirectionService) EstablishPolicies(ctx context.Context, agreementID GovernanceAgreementID, establishedBy string, policies []Policy, standards []Standard, procedures []Procedure) error {
 	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
 	if err != nil {
 		return fmt.Errorf("failed to find governance agreement: %w", err)
 	}
 
-	agreement.Direct.PolicyFramework.Policies = policies
-	agreement.Direct.PolicyFramework.Standards = standards
-	agreement.Direct.PolicyFramework.Procedures = procedures
+	// New policies start as drafts and go through the policy lifecycle
+	established := make([]Policy, len(policies))
+	seen := make(map[string]bool)
+	for i, policy := range policies {
+		if policy.ID == "" {
+			return fmt.Errorf("policy ID cannot be empty")
+		}
+		if seen[policy.ID] {
+			return fmt.Errorf("duplicate policy %s", policy.ID)
+		}
+		seen[policy.ID] = true
+		if policy.Status == "" {
+			policy.Status = PolicyDraft
+		}
+		established[i] = policy
+	}
+
+	// New and changed documents get a new version in the framework's history
+	framework := &agreement.Direct.PolicyFramework
+	framework.Policies, framework.Standards, framework.Procedures = versionFramework(framework, established, standards, procedures, establishedBy, time.Now())
 
 	err = s.agreementRepo.Update(ctx, agreement)
 	if err != nil {
@@ -734,13 +768,53 @@ func (s *DirectionService) EstablishPolicies(ctx context.Context, agreementID Go
 	return nil
 }
 
-// createActionPlansFromObjectives creates action plans from strategic objectives
-func (s *DirectionService) createActionPlansFromObjectives(objectives []StrategicObjective) []ActionPlan {
-	actionPlans := make([]ActionPlan, len(objectives))
+// createActionPlansFromObjectives creates action plans from strategic objectives. Existing plans
+// are kept unless they implement an objective that is dropped, less their dependencies on the
+// plans that are dropped.
+func (s *DirectionService) createActionPlansFromObjectives(objectives []StrategicObjective, existing []ActionPlan) []ActionPlan {
+	current := make(map[string]bool)
+	for _, objective := range objectives {
+		current[objective.ID] = true
+	}
+	kept := make(map[string][]ActionPlan)
+	taken := make(map[string]bool)
+	for _, plan := range existing {
+		if plan.ObjectiveID == "" || current[plan.ObjectiveID] {
+			kept[plan.ObjectiveID] = append(kept[plan.ObjectiveID], plan)
+			taken[plan.ID] = true
+		}
+	}
+	keep := func(plan ActionPlan) ActionPlan {
+		var dependsOn []string
+		for _, dependency := range plan.DependsOn {
+			if taken[dependency] {
+				dependsOn = append(dependsOn, dependency)
+			}
+		}
+		plan.DependsOn = dependsOn
+		return plan
+	}
 
+	var actionPlans []ActionPlan
+	for _, plan := range kept[""] {
+		actionPlans = append(actionPlans, keep(plan))
+	}
 	for i, objective := range objectives {
-		actionPlans[i] = ActionPlan{
-			ID:          fmt.Sprintf("ap-%d", i+1),
+		if plans, ok := kept[objective.ID]; ok {
+			for _, plan := range plans {
+				actionPlans = append(actionPlans, keep(plan))
+			}
+			continue
+		}
+
+		id := fmt.Sprintf("ap-%d", i+1)
+		for n := i + 2; taken[id]; n++ {
+			id = fmt.Sprintf("ap-%d", n)
+		}
+		taken[id] = true
+		actionPlans = append(actionPlans, ActionPlan{
+			ID:          id,
+			ObjectiveID: objective.ID,
 			Name:        fmt.Sprintf("Action Plan for %s", objective.Name),
 			Description: fmt.Sprintf("Implementation plan for strategic objective: %s", objective.Description),
 			Owner:       "TBD", // To be determined
@@ -755,7 +829,7 @@ func (s *DirectionService) createActionPlansFromObjectives(objectives []Strategi
 					Status:      ActionPending,
 				},
 			},
-		}
+		})
 	}
 
 	return actionPlans
@@ -767,74 +841,88 @@ type MonitoringService struct {
 	measurementRepo KPIMeasurementRepository
 	riskRepo        RiskRepository
 	agreementRepo   GovernanceAgreementRepository
+	portfolioRepo   ApplicationPortfolioRepository
+	snapshotRepo    MonitoringSnapshotRepository
+
+	anomalyDetection AnomalyDetection
+	appRepo          ApplicationRepository
+	availabilityRepo AvailabilityMeasurementRepository
+	incidentRepo     IncidentRepository
+	changeRepo       ChangeRequestRepository
+	plugins          monitorPlugins
+	complianceRepo   ComplianceRepository
+}
+
+// MonitoringOption customizes a MonitoringService
+type MonitoringOption func(*MonitoringService)
+
+// WithPortfolioThresholds applies the KPI tolerance of the portfolio containing an agreement's
+// application when monitoring the agreement's KPIs
+func WithPortfolioThresholds(portfolioRepo ApplicationPortfolioRepository) MonitoringOption {
+	return func(s *MonitoringService) {
+		s.portfolioRepo = portfolioRepo
+	}
+}
+
+// WithMonitoringHistory keeps the results of monitoring agreements in a snapshot repository so
+// their trends can be reviewed
+func WithMonitoringHistory(snapshotRepo MonitoringSnapshotRepository) MonitoringOption {
+	return func(s *MonitoringService) {
+		s.snapshotRepo = snapshotRepo
+	}
 }
 
 // NewMonitoringService creates a new monitoring service
-func NewMonitoringService(kpiRepo KPIRepository, measurementRepo KPIMeasurementRepository, riskRepo RiskRepository, agreementRepo GovernanceAgreementRepository) *MonitoringService {
-	return &MonitoringService{
+func NewMonitoringService(kpiRepo KPIRepository, measurementRepo KPIMeasurementRepository, riskRepo RiskRepository, agreementRepo GovernanceAgreementRepository, opts ...MonitoringOption) *MonitoringService {
+	service := &MonitoringService{
 		kpiRepo:         kpiRepo,
 		measurementRepo: measurementRepo,
 		riskRepo:        riskRepo,
 		agreementRepo:   agreementRepo,
+
+		anomalyDetection: DefaultAnomalyDetection(),
 	}
+	for _, opt := range opts {
+		opt(service)
+	}
+	return service
 }
 
-// MonitorKPIs monitors KPI performance
+// MonitorKPIs reports the latest measurement of each KPI linked to the agreement's objectives, or
+// of every KPI in the KPI repository when none are linked. Measurements come from the measurement
+// repository, or the agreement's evaluation when it has none; KPIs never measured are reported
+// with a zero value.
 func (s *MonitoringService) MonitorKPIs(ctx context.Context, agreementID GovernanceAgreementID) ([]KPIMeasurement, error) {
-	// Get agreement to find associated KPIs (not used in current implementation but may be needed for future enhancements)
-	_, err := s.agreementRepo.FindByID(ctx, agreementID)
+	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to find governance agreement: %w", err)
 	}
 
-	// Handle case where repositories are not available (e.g., in demo mode)
-	if s.kpiRepo == nil || s.measurementRepo == nil {
-		// Return mock data for demonstration
-		return []KPIMeasurement{
-			{
-				KPIID:     "kpi-001",
-				Value:     95.5,
-				Target:    100.0,
-				Achieved:  false,
-				MeasuredAt: time.Now(),
-				Notes:     "Demo KPI measurement",
-			},
-			{
-				KPIID:     "kpi-002",
-				Value:     99.2,
-				Target:    98.0,
-				Achieved:  true,
-				MeasuredAt: time.Now(),
-				Notes:     "Demo KPI measurement",
-			},
-		}, nil
-	}
-
-	// For portfolio-level agreements, get all KPIs
-	kpis, err := s.kpiRepo.FindAll(ctx)
+	kpis, err := s.agreementKPIs(ctx, agreement)
 	if err != nil {
-		return nil, fmt.Errorf("failed to find KPIs: %w", err)
+		return nil, err
 	}
 
 	measurements := []KPIMeasurement{}
+	tolerance := portfolioThresholdsFor(ctx, s.portfolioRepo, agreement.ApplicationID).kpiTolerance()
 
 	for _, kpi := range kpis {
 		// Get latest measurement
-		measurement, err := s.measurementRepo.FindLatest(ctx, kpi.ID)
-		if err != nil {
+		measurement, ok := s.latestMeasurement(ctx, agreement, kpi.ID)
+		if !ok {
 			// Create default measurement if none exists
 			measurement = KPIMeasurement{
-				KPIID:     kpi.ID,
-				Value:     0,
-				Target:    kpi.Target,
-				Achieved:  false,
+				KPIID:      kpi.ID,
+				Value:      0,
+				Target:     kpi.Target,
+				Achieved:   false,
 				MeasuredAt: time.Now(),
-				Notes:     "No measurement available",
+				Notes:      "No measurement available",
 			}
 		}
 
 		// Update achievement status
-		measurement.Achieved = s.isKPITargetAchieved(kpi, measurement)
+		measurement.Achieved = s.isKPITargetAchieved(kpi, measurement, tolerance)
 		measurements = append(measurements, measurement)
 	}
 
@@ -854,11 +942,28 @@ func (s *MonitoringService) MonitorCompliance(ctx context.Context, agreementID G
 
 // MonitorRisks monitors risk status
 func (s *MonitoringService) MonitorRisks(ctx context.Context, agreementID GovernanceAgreementID) (*RiskMonitoring, error) {
+	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+	heatMaps := s.riskHeatMaps(ctx, agreement)
+
+	// Error budgets projected to run out raise risk indicators of their own
+	budgets, _ := s.errorBudgets(ctx, agreement.ApplicationID, time.Now())
+	budgetIndicators := make([]RiskIndicator, len(budgets))
+	for i, budget := range budgets {
+		budgetIndicators[i] = budget.RiskIndicator()
+	}
+
+	// Indicators tracked on the agreement, such as those collected by monitor plugins, are
+	// reported alongside
+	trackedIndicators := append(append([]RiskIndicator{}, agreement.Monitor.RiskMonitoring.RiskIndicators...), budgetIndicators...)
+
 	// Handle case where risk repository is not available (e.g., in demo mode)
 	if s.riskRepo == nil {
 		// Return mock risk monitoring data for demonstration
 		return &RiskMonitoring{
-			RiskIndicators: []RiskIndicator{
+			RiskIndicators: append([]RiskIndicator{
 				{
 					Name:     "Technical Debt",
 					Value:    75.0,
@@ -871,8 +976,8 @@ func (s *MonitoringService) MonitorRisks(ctx context.Context, agreementID Govern
 					Threshold: 50.0,
 					Status:   RiskStatusNormal,
 				},
-			},
-			RiskHeatMaps:   []RiskHeatMap{},
+			}, trackedIndicators...),
+			RiskHeatMaps:   heatMaps,
 			MitigationTracking: []MitigationTracking{},
 		}, nil
 	}
@@ -891,27 +996,21 @@ func (s *MonitoringService) MonitorRisks(ctx context.Context, agreementID Govern
 			Status:   s.determineRiskStatus(risk),
 		}
 	}
+	riskIndicators = append(riskIndicators, trackedIndicators...)
 
 	riskMonitoring := &RiskMonitoring{
 		RiskIndicators: riskIndicators,
-		RiskHeatMaps:   []RiskHeatMap{}, // Would be populated with actual heat map data
+		RiskHeatMaps:   heatMaps,
 		MitigationTracking: []MitigationTracking{}, // Would be populated with actual tracking data
 	}
 
 	return riskMonitoring, nil
 }
 
-// isKPITargetAchieved determines if a KPI target is achieved
-func (s *MonitoringService) isKPITargetAchieved(kpi KPI, measurement KPIMeasurement) bool {
-	// Simplified logic - in real implementation, this would consider KPI type and thresholds
-	switch kpi.Category {
-	case "performance":
-		return measurement.Value >= kpi.Target
-	case "efficiency":
-		return measurement.Value <= kpi.Target // Lower is better for efficiency
-	default:
-		return measurement.Value >= kpi.Target
-	}
+// isKPITargetAchieved determines if a KPI target is achieved, counting measurements that miss it
+// by no more than tolerance percent as achieved
+func (s *MonitoringService) isKPITargetAchieved(kpi KPI, measurement KPIMeasurement, tolerance float64) bool {
+	return kpi.TargetAchieved(measurement.Value, tolerance)
 }
 
 // convertImpactToNumeric converts risk impact to numeric value
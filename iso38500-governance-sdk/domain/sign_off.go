@@ -0,0 +1,176 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// SignOffState tracks an assessment through review and sign-off
+type SignOffState string
+
+const (
+	SignOffDraft     SignOffState = "draft"
+	SignOffReviewed  SignOffState = "reviewed"
+	SignOffSignedOff SignOffState = "signed_off"
+)
+
+// SignOffPolicy decides which assessments need a second person to review them
+type SignOffPolicy struct {
+	// SecondPersonReviewFrom is the lowest risk level at which the reviewer and the approver must
+	// both differ from the evaluator; empty disables the requirement
+	SecondPersonReviewFrom RiskLevel
+}
+
+// DefaultSignOffPolicy requires a second person to review critical-risk assessments
+func DefaultSignOffPolicy() SignOffPolicy {
+	return SignOffPolicy{SecondPersonReviewFrom: RiskCritical}
+}
+
+// Validate ensures the policy names a known risk level
+func (p SignOffPolicy) Validate() error {
+	switch p.SecondPersonReviewFrom {
+	case "", RiskLow, RiskMedium, RiskHigh, RiskCritical:
+		return nil
+	}
+	return fmt.Errorf("unknown second person review risk level %q", p.SecondPersonReviewFrom)
+}
+
+// RequiresSecondPerson reports whether an assessment at the risk level needs a second person review
+func (p SignOffPolicy) RequiresSecondPerson(level RiskLevel) bool {
+	return p.SecondPersonReviewFrom != "" && riskScore(level) >= riskScore(p.SecondPersonReviewFrom)
+}
+
+// AssessmentSignOff records who reviewed and signed off an assessment
+type AssessmentSignOff struct {
+	State                SignOffState
+	RequiresSecondPerson bool // reviewer and approver must differ from the evaluator
+	Reviewer             string
+	ReviewComments       string
+	ReviewedAt           time.Time
+	Approver             string
+	SignedOffAt          time.Time
+}
+
+// Review moves a draft assessment to reviewed
+func (a *ApplicationAssessment) Review(reviewer, comments string, at time.Time) error {
+	if reviewer == "" {
+		return errors.New("reviewer cannot be empty")
+	}
+	if state := a.SignOff.State; state != "" && state != SignOffDraft {
+		return fmt.Errorf("assessment %s is %s, only draft assessments can be reviewed", a.ID, state)
+	}
+	if a.SignOff.RequiresSecondPerson && reviewer == a.Evaluator {
+		return fmt.Errorf("%s risk assessment %s must be reviewed by someone other than its evaluator", a.RiskLevel, a.ID)
+	}
+
+	a.SignOff.State = SignOffReviewed
+	a.SignOff.Reviewer = reviewer
+	a.SignOff.ReviewComments = comments
+	a.SignOff.ReviewedAt = at
+	return nil
+}
+
+// Approve signs off a reviewed assessment
+func (a *ApplicationAssessment) Approve(approver string, at time.Time) error {
+	if approver == "" {
+		return errors.New("approver cannot be empty")
+	}
+	if a.SignOff.State != SignOffReviewed {
+		return fmt.Errorf("assessment %s must be reviewed before it is signed off", a.ID)
+	}
+	if a.SignOff.RequiresSecondPerson && approver == a.Evaluator {
+		return fmt.Errorf("%s risk assessment %s must be signed off by someone other than its evaluator", a.RiskLevel, a.ID)
+	}
+
+	a.SignOff.State = SignOffSignedOff
+	a.SignOff.Approver = approver
+	a.SignOff.SignedOffAt = at
+	return nil
+}
+
+// FindRecordedAssessment finds an assessment in the history. An empty assessment ID selects the
+// latest assessment.
+func (s *EvaluationService) FindRecordedAssessment(ctx context.Context, appID ApplicationID, assessmentID string) (ApplicationAssessment, error) {
+	return s.findRecordedAssessment(ctx, appID, assessmentID)
+}
+
+// ReviewAssessment records the review of an assessment in the history. An empty assessment ID
+// selects the latest assessment.
+func (s *EvaluationService) ReviewAssessment(ctx context.Context, appID ApplicationID, assessmentID, reviewer, comments string) (*ApplicationAssessment, error) {
+	assessment, err := s.findRecordedAssessment(ctx, appID, assessmentID)
+	if err != nil {
+		return nil, err
+	}
+	if err := assessment.Review(reviewer, comments, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := s.assessmentRepo.Update(ctx, assessment); err != nil {
+		return nil, fmt.Errorf("failed to update assessment: %w", err)
+	}
+	return &assessment, nil
+}
+
+// SignOffAssessment records the sign-off of a reviewed assessment in the history. An empty
+// assessment ID selects the latest assessment.
+func (s *EvaluationService) SignOffAssessment(ctx context.Context, appID ApplicationID, assessmentID, approver string) (*ApplicationAssessment, error) {
+	assessment, err := s.findRecordedAssessment(ctx, appID, assessmentID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkMandatoryEvidence(ctx, assessment); err != nil {
+		return nil, err
+	}
+	if err := assessment.Approve(approver, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := s.assessmentRepo.Update(ctx, assessment); err != nil {
+		return nil, fmt.Errorf("failed to update assessment: %w", err)
+	}
+	return &assessment, nil
+}
+
+// checkMandatoryEvidence ensures the evidence the assessment's template makes mandatory is attached
+func (s *EvaluationService) checkMandatoryEvidence(ctx context.Context, assessment ApplicationAssessment) error {
+	if len(assessment.MandatoryEvidence) == 0 {
+		return nil
+	}
+	if s.attachmentStore == nil {
+		return fmt.Errorf("assessment %s requires evidence but no attachment store is configured", assessment.ID)
+	}
+	attached, err := s.attachmentStore.FindBySubject(ctx, AssessmentEvidenceSubject(assessment.ID))
+	if err != nil {
+		return fmt.Errorf("failed to load evidence: %w", err)
+	}
+	if missing := missingEvidence(assessment.MandatoryEvidence, attached); len(missing) > 0 {
+		return fmt.Errorf("assessment %s cannot be signed off without %s evidence", assessment.ID, joinEvidenceKinds(missing))
+	}
+	return nil
+}
+
+// findRecordedAssessment looks up an assessment in the history, or the latest one when
+// assessmentID is empty
+func (s *EvaluationService) findRecordedAssessment(ctx context.Context, appID ApplicationID, assessmentID string) (ApplicationAssessment, error) {
+	if s.assessmentRepo == nil {
+		return ApplicationAssessment{}, errors.New("assessment history is not configured")
+	}
+	if assessmentID == "" {
+		assessment, err := s.assessmentRepo.FindLatest(ctx, appID)
+		if err != nil {
+			return ApplicationAssessment{}, fmt.Errorf("failed to find latest assessment: %w", err)
+		}
+		return assessment, nil
+	}
+
+	history, err := s.assessmentRepo.FindByApplicationID(ctx, appID)
+	if err != nil {
+		return ApplicationAssessment{}, fmt.Errorf("failed to load assessment history: %w", err)
+	}
+	for _, assessment := range history {
+		if assessment.ID == assessmentID {
+			return assessment, nil
+		}
+	}
+	return ApplicationAssessment{}, fmt.Errorf("assessment %s not found for %s", assessmentID, appID)
+}
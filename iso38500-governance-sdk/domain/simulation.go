@@ -0,0 +1,229 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ScenarioChangeType identifies a hypothetical change to a portfolio
+type ScenarioChangeType string
+
+const (
+	ScenarioRetireApplication ScenarioChangeType = "retire_application"
+	ScenarioAddApplication    ScenarioChangeType = "add_application"
+	ScenarioMergePortfolio    ScenarioChangeType = "merge_portfolio"
+)
+
+// ScenarioChange is one hypothetical change in a what-if scenario
+type ScenarioChange struct {
+	Type          ScenarioChangeType
+	ApplicationID ApplicationID // application to retire
+	Application   *Application  // planned application to add
+	PortfolioID   PortfolioID   // portfolio to merge in
+}
+
+// RetireApplication returns a change that retires an application in the portfolio
+func RetireApplication(appID ApplicationID) ScenarioChange {
+	return ScenarioChange{Type: ScenarioRetireApplication, ApplicationID: appID}
+}
+
+// AddApplication returns a change that adds a planned application to the portfolio
+func AddApplication(app Application) ScenarioChange {
+	return ScenarioChange{Type: ScenarioAddApplication, Application: &app}
+}
+
+// MergePortfolio returns a change that merges another portfolio's applications into the portfolio
+func MergePortfolio(portfolioID PortfolioID) ScenarioChange {
+	return ScenarioChange{Type: ScenarioMergePortfolio, PortfolioID: portfolioID}
+}
+
+// Validate ensures the change carries what its type needs
+func (c ScenarioChange) Validate() error {
+	switch c.Type {
+	case ScenarioRetireApplication:
+		if c.ApplicationID == "" {
+			return errors.New("retirement needs an application ID")
+		}
+	case ScenarioAddApplication:
+		if c.Application == nil || c.Application.ID == "" {
+			return errors.New("addition needs an application with an ID")
+		}
+	case ScenarioMergePortfolio:
+		if c.PortfolioID == "" {
+			return errors.New("merge needs a portfolio ID")
+		}
+	default:
+		return fmt.Errorf("unknown scenario change type %q", c.Type)
+	}
+	return nil
+}
+
+// PortfolioScenario is a named set of hypothetical changes, applied in order
+type PortfolioScenario struct {
+	Name    string
+	Changes []ScenarioChange
+}
+
+// PortfolioHealthDelta is the projected change in portfolio health; positive values are increases
+type PortfolioHealthDelta struct {
+	TotalApplications      int
+	ActiveApplications     int
+	DeprecatedApplications int
+	RedundantApplications  int
+	TotalCost              float64
+	AverageApplicationAge  time.Duration
+	RiskDistribution       map[RiskLevel]int
+	TechnicalDebtHours     float64
+	HealthIndex            float64
+	ExpectedLoss           float64 // set when risk simulation is configured
+	P90Loss                float64
+}
+
+// PortfolioSimulation compares a portfolio's health before and after a what-if scenario
+type PortfolioSimulation struct {
+	PortfolioID PortfolioID
+	Scenario    string
+	Baseline    *PortfolioHealthAssessment
+	Projected   *PortfolioHealthAssessment
+	Delta       PortfolioHealthDelta
+	SimulatedAt time.Time
+}
+
+// SimulatePortfolio projects the portfolio health that would result from a scenario without
+// changing any repository or recording assessments. Both the baseline and the projection assess
+// applications without a governance agreement, such as planned ones, without agreement context
+// instead of skipping them, so the two are directly comparable.
+func (s *EvaluationService) SimulatePortfolio(ctx context.Context, portfolioID PortfolioID, scenario PortfolioScenario) (*PortfolioSimulation, error) {
+	portfolio, err := s.portfolioRepo.FindByID(ctx, portfolioID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find portfolio: %w", err)
+	}
+
+	profile := portfolio.Thresholds.ApplyTo(s.profile)
+	current := s.currentApplications(ctx, portfolio.Applications)
+	projected, err := s.applyScenario(ctx, current, scenario)
+	if err != nil {
+		return nil, err
+	}
+
+	baseline, err := s.portfolioHealth(ctx, portfolioID, current, profile, s.simulatedAssessment(ctx, profile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate baseline: %w", err)
+	}
+	projection, err := s.portfolioHealth(ctx, portfolioID, projected, profile, s.simulatedAssessment(ctx, profile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate projection: %w", err)
+	}
+
+	return &PortfolioSimulation{
+		PortfolioID: portfolioID,
+		Scenario:    scenario.Name,
+		Baseline:    baseline,
+		Projected:   projection,
+		Delta:       portfolioHealthDelta(*baseline, *projection),
+		SimulatedAt: time.Now(),
+	}, nil
+}
+
+// applyScenario returns a copy of the applications with the scenario's changes applied
+func (s *EvaluationService) applyScenario(ctx context.Context, apps []Application, scenario PortfolioScenario) ([]Application, error) {
+	projected := make([]Application, len(apps))
+	copy(projected, apps)
+
+	indexOf := func(id ApplicationID) int {
+		for i, app := range projected {
+			if app.ID == id {
+				return i
+			}
+		}
+		return -1
+	}
+
+	for _, change := range scenario.Changes {
+		if err := change.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid scenario change: %w", err)
+		}
+
+		switch change.Type {
+		case ScenarioRetireApplication:
+			i := indexOf(change.ApplicationID)
+			if i < 0 {
+				return nil, fmt.Errorf("application %s is not in the portfolio", change.ApplicationID)
+			}
+			// A retired application stops incurring running costs
+			projected[i].Status = StatusRetired
+			projected[i].Cost = ApplicationCost{Acquisition: projected[i].Cost.Acquisition, Currency: projected[i].Cost.Currency}
+		case ScenarioAddApplication:
+			if indexOf(change.Application.ID) >= 0 {
+				return nil, fmt.Errorf("application %s is already in the portfolio", change.Application.ID)
+			}
+			app := *change.Application
+			if app.Status == "" {
+				app.Status = StatusActive
+			}
+			if app.CreatedAt.IsZero() {
+				app.CreatedAt = time.Now()
+			}
+			projected = append(projected, app)
+		case ScenarioMergePortfolio:
+			other, err := s.portfolioRepo.FindByID(ctx, change.PortfolioID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to find portfolio: %w", err)
+			}
+			for _, app := range s.currentApplications(ctx, other.Applications) {
+				if indexOf(app.ID) < 0 {
+					projected = append(projected, app)
+				}
+			}
+		}
+	}
+	return projected, nil
+}
+
+// simulatedAssessment assesses applications as given, with their agreement when one exists,
+// without persisting the result
+func (s *EvaluationService) simulatedAssessment(ctx context.Context, profile EvaluationProfile) func(Application) (*ApplicationAssessment, error) {
+	return func(app Application) (*ApplicationAssessment, error) {
+		var agreement *GovernanceAgreement
+		if s.agreementRepo != nil {
+			if found, err := s.agreementRepo.FindByApplicationID(ctx, app.ID); err == nil {
+				agreement = &found
+			}
+		}
+		return s.assessApplication(ctx, app, agreement, "simulation", profile)
+	}
+}
+
+func portfolioHealthDelta(baseline, projected PortfolioHealthAssessment) PortfolioHealthDelta {
+	delta := PortfolioHealthDelta{
+		TotalApplications:      projected.TotalApplications - baseline.TotalApplications,
+		ActiveApplications:     projected.ActiveApplications - baseline.ActiveApplications,
+		DeprecatedApplications: projected.DeprecatedApplications - baseline.DeprecatedApplications,
+		RedundantApplications:  projected.RedundantApplications - baseline.RedundantApplications,
+		TotalCost:              projected.TotalCost - baseline.TotalCost,
+		AverageApplicationAge:  projected.AverageApplicationAge - baseline.AverageApplicationAge,
+		HealthIndex:            projected.HealthIndex.Score - baseline.HealthIndex.Score,
+		RiskDistribution:       make(map[RiskLevel]int),
+	}
+	for level, count := range projected.RiskDistribution {
+		delta.RiskDistribution[level] += count
+	}
+	for level, count := range baseline.RiskDistribution {
+		delta.RiskDistribution[level] -= count
+	}
+	for level, count := range delta.RiskDistribution {
+		if count == 0 {
+			delete(delta.RiskDistribution, level)
+		}
+	}
+	if baseline.TechnicalDebt != nil && projected.TechnicalDebt != nil {
+		delta.TechnicalDebtHours = projected.TechnicalDebt.Total.PrincipalHours - baseline.TechnicalDebt.Total.PrincipalHours
+	}
+	if baseline.RiskExposure != nil && projected.RiskExposure != nil {
+		delta.ExpectedLoss = projected.RiskExposure.ExpectedLoss - baseline.RiskExposure.ExpectedLoss
+		delta.P90Loss = projected.RiskExposure.P90 - baseline.RiskExposure.P90
+	}
+	return delta
+}
@@ -0,0 +1,121 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// AvailabilityMeasurement is an observed uptime and latency reading for an application over a period,
+// ingested from a monitoring system
+type AvailabilityMeasurement struct {
+	ID               string
+	ApplicationID    ApplicationID
+	UptimePercentage float64       // observed availability over the period, e.g. 99.72
+	ResponseTime     time.Duration // observed response time over the period, e.g. p95 latency
+	PeriodStart      time.Time
+	PeriodEnd        time.Time
+	Source           string // monitoring system the reading came from
+	MeasuredAt       time.Time
+}
+
+// Validate ensures the measurement has valid data
+func (m AvailabilityMeasurement) Validate() error {
+	if m.ID == "" {
+		return errors.New("availability measurement ID cannot be empty")
+	}
+	if m.ApplicationID == "" {
+		return errors.New("availability measurement application ID cannot be empty")
+	}
+	if m.UptimePercentage < 0 || m.UptimePercentage > 100 {
+		return errors.New("uptime percentage must be between 0 and 100")
+	}
+	if m.ResponseTime < 0 {
+		return errors.New("response time must not be negative")
+	}
+	if !m.PeriodStart.IsZero() && !m.PeriodEnd.IsZero() && m.PeriodEnd.Before(m.PeriodStart) {
+		return errors.New("measurement period end must not be before its start")
+	}
+	return nil
+}
+
+// SLAMetric identifies the SLA commitment a breach relates to
+type SLAMetric string
+
+const (
+	SLAMetricAvailability SLAMetric = "availability"
+	SLAMetricResponseTime SLAMetric = "response_time"
+)
+
+// SLABreach records an observed measurement falling short of a declared SLA commitment
+type SLABreach struct {
+	ApplicationID ApplicationID
+	ServiceName   string
+	Metric        SLAMetric
+	Declared      string
+	Observed      string
+	Shortfall     float64 // percentage points of availability, or milliseconds of response time
+	MeasurementID string
+	PeriodStart   time.Time
+	PeriodEnd     time.Time
+}
+
+// Description summarizes the breach for reports
+func (b SLABreach) Description() string {
+	switch b.Metric {
+	case SLAMetricAvailability:
+		return fmt.Sprintf("availability %s is below the declared %s", b.Observed, b.Declared)
+	case SLAMetricResponseTime:
+		return fmt.Sprintf("response time %s exceeds the declared %s", b.Observed, b.Declared)
+	default:
+		return fmt.Sprintf("%s %s breaches the declared %s", b.Metric, b.Observed, b.Declared)
+	}
+}
+
+// DetectSLABreaches compares a measurement against the declared SLA. Commitments that are not
+// declared (zero) and readings that were not taken (zero) are not compared.
+func DetectSLABreaches(sla SLA, measurement AvailabilityMeasurement) []SLABreach {
+	var breaches []SLABreach
+	breach := SLABreach{
+		ApplicationID: measurement.ApplicationID,
+		ServiceName:   sla.ServiceName,
+		MeasurementID: measurement.ID,
+		PeriodStart:   measurement.PeriodStart,
+		PeriodEnd:     measurement.PeriodEnd,
+	}
+
+	if sla.Availability > 0 && measurement.UptimePercentage > 0 && measurement.UptimePercentage < sla.Availability {
+		availability := breach
+		availability.Metric = SLAMetricAvailability
+		availability.Declared = fmt.Sprintf("%.2f%%", sla.Availability)
+		availability.Observed = fmt.Sprintf("%.2f%%", measurement.UptimePercentage)
+		availability.Shortfall = sla.Availability - measurement.UptimePercentage
+		breaches = append(breaches, availability)
+	}
+
+	if sla.ResponseTime > 0 && measurement.ResponseTime > sla.ResponseTime {
+		responseTime := breach
+		responseTime.Metric = SLAMetricResponseTime
+		responseTime.Declared = sla.ResponseTime.String()
+		responseTime.Observed = measurement.ResponseTime.String()
+		responseTime.Shortfall = float64((measurement.ResponseTime - sla.ResponseTime).Milliseconds())
+		breaches = append(breaches, responseTime)
+	}
+
+	return breaches
+}
+
+// slaRecommendations asks for a remediation of each breached SLA commitment
+func slaRecommendations(breaches []SLABreach) []Recommendation {
+	var recommendations []Recommendation
+	for i, breach := range breaches {
+		recommendations = append(recommendations, Recommendation{
+			ID:             fmt.Sprintf("sla-%03d", i+1),
+			Type:           RecEnhance,
+			Description:    fmt.Sprintf("Restore the %s SLA: %s", breach.Metric, breach.Description()),
+			Priority:       PriorityHigh,
+			BusinessImpact: "Meet the service levels committed to the business",
+		})
+	}
+	return recommendations
+}
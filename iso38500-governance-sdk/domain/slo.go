@@ -0,0 +1,243 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// defaultResponseTimeObjective is the share of time, in percent, a response time SLA must be met
+// when the SLA declares no availability to take it from
+const defaultResponseTimeObjective = 99.0
+
+// SLO is a service level objective derived from a declared SLA: the percentage of each calendar
+// month in which the service must meet the SLA commitment
+type SLO struct {
+	ID            string // application and metric, e.g. "erp-core-001/availability"
+	ApplicationID ApplicationID
+	ServiceName   string
+	Metric        SLAMetric
+	Objective     float64       // percentage of the period, e.g. 99.9
+	ResponseTime  time.Duration // response time commitment; zero for availability objectives
+}
+
+// DeriveSLOs derives the SLOs of an application from the availability SLA declared in its
+// security provisions: one for the declared availability and one for the declared response
+// time, which must be met for the same share of time, or 99% without a declared availability
+func DeriveSLOs(app Application) []SLO {
+	sla := app.SecurityProvisions.ApplicationAvailability
+	slos := []SLO{}
+	if sla.Availability > 0 {
+		slos = append(slos, SLO{
+			ID:            fmt.Sprintf("%s/%s", app.ID, SLAMetricAvailability),
+			ApplicationID: app.ID,
+			ServiceName:   sla.ServiceName,
+			Metric:        SLAMetricAvailability,
+			Objective:     sla.Availability,
+		})
+	}
+	if sla.ResponseTime > 0 {
+		objective := sla.Availability
+		if objective <= 0 {
+			objective = defaultResponseTimeObjective
+		}
+		slos = append(slos, SLO{
+			ID:            fmt.Sprintf("%s/%s", app.ID, SLAMetricResponseTime),
+			ApplicationID: app.ID,
+			ServiceName:   sla.ServiceName,
+			Metric:        SLAMetricResponseTime,
+			Objective:     objective,
+			ResponseTime:  sla.ResponseTime,
+		})
+	}
+	return slos
+}
+
+// ErrorBudgetStatus is the state of an SLO's error budget in the current period
+type ErrorBudgetStatus string
+
+const (
+	ErrorBudgetHealthy   ErrorBudgetStatus = "healthy"
+	ErrorBudgetAtRisk    ErrorBudgetStatus = "at_risk" // projected to run out before the period ends
+	ErrorBudgetExhausted ErrorBudgetStatus = "exhausted"
+)
+
+// ErrorBudget is the time an SLO allows its service to miss the SLA in a calendar month, and how
+// much of it the measurements taken so far have consumed
+type ErrorBudget struct {
+	SLO                 SLO
+	PeriodStart         time.Time
+	PeriodEnd           time.Time
+	Budget              time.Duration // time the SLA may be missed in the period
+	Consumed            time.Duration // time the SLA was missed
+	Remaining           time.Duration // zero once exhausted
+	Projected           time.Duration // consumption by the period end at the measured rate
+	Measured            time.Duration // time covered by measurements
+	Measurements        int
+	Attainment          float64 // percentage of measured time meeting the SLA, 100 when unmeasured
+	BurnRate            float64 // rate of consumption relative to the rate the budget allows
+	Status              ErrorBudgetStatus
+	ProjectedExhaustion time.Time // when an at risk budget runs out
+	CalculatedAt        time.Time
+}
+
+// ProjectedConsumption returns the share of the budget projected to be consumed by the period end
+func (b ErrorBudget) ProjectedConsumption() float64 {
+	if b.Budget <= 0 {
+		if b.Projected > 0 {
+			return 1
+		}
+		return 0
+	}
+	return float64(b.Projected) / float64(b.Budget)
+}
+
+// BuildErrorBudget works out the SLO's error budget for the calendar month, in UTC, containing at.
+// Each measurement consumes the part of its period falling in the month and before at: in
+// proportion to the uptime it missed for availability, or entirely when its response time
+// exceeded the SLA. Measurements without a period, or without a reading of the metric, are not
+// counted. The rest of the month is projected at the rate the measured time consumed the budget.
+func BuildErrorBudget(slo SLO, measurements []AvailabilityMeasurement, at time.Time) ErrorBudget {
+	start := KPIResolutionMonth.periodStart(at)
+	end := KPIResolutionMonth.periodEnd(start)
+	budget := ErrorBudget{
+		SLO:          slo,
+		PeriodStart:  start,
+		PeriodEnd:    end,
+		Budget:       time.Duration(float64(end.Sub(start)) * (100 - slo.Objective) / 100),
+		Attainment:   100,
+		Status:       ErrorBudgetHealthy,
+		CalculatedAt: at,
+	}
+
+	var consumed float64
+	for _, measurement := range measurements {
+		if measurement.ApplicationID != slo.ApplicationID || measurement.PeriodStart.IsZero() || measurement.PeriodEnd.IsZero() {
+			continue
+		}
+		from, to := measurement.PeriodStart, measurement.PeriodEnd
+		if from.Before(start) {
+			from = start
+		}
+		if to.After(end) {
+			to = end
+		}
+		if to.After(at) {
+			to = at
+		}
+		if !to.After(from) {
+			continue
+		}
+
+		span := to.Sub(from)
+		switch slo.Metric {
+		case SLAMetricAvailability:
+			if measurement.UptimePercentage <= 0 {
+				continue
+			}
+			consumed += float64(span) * (100 - measurement.UptimePercentage) / 100
+		case SLAMetricResponseTime:
+			if measurement.ResponseTime <= 0 {
+				continue
+			}
+			if measurement.ResponseTime > slo.ResponseTime {
+				consumed += float64(span)
+			}
+		default:
+			continue
+		}
+		budget.Measured += span
+		budget.Measurements++
+	}
+	budget.Consumed = time.Duration(consumed)
+	budget.Projected = budget.Consumed
+	if budget.Measured == 0 {
+		return budget
+	}
+
+	// Rate at which measured time consumed the budget, carried over the rest of the period
+	rate := consumed / float64(budget.Measured)
+	budget.Attainment = 100 * (1 - rate)
+	if allowed := (100 - slo.Objective) / 100; allowed > 0 {
+		budget.BurnRate = rate / allowed
+	}
+	if at.Before(end) {
+		budget.Projected += time.Duration(rate * float64(end.Sub(at)))
+	}
+
+	switch {
+	case budget.Consumed > 0 && budget.Consumed >= budget.Budget:
+		budget.Status = ErrorBudgetExhausted
+	case budget.Projected > budget.Budget:
+		budget.Status = ErrorBudgetAtRisk
+		budget.ProjectedExhaustion = at.Add(time.Duration(float64(budget.Budget-budget.Consumed) / rate))
+	}
+	if budget.Consumed < budget.Budget {
+		budget.Remaining = budget.Budget - budget.Consumed
+	}
+	return budget
+}
+
+// RiskIndicator reports the budget's projected consumption by the period end as a percentage,
+// against a threshold of 100: critical once exhausted and warning when at risk
+func (b ErrorBudget) RiskIndicator() RiskIndicator {
+	name := b.SLO.ServiceName
+	if name == "" {
+		name = string(b.SLO.ApplicationID)
+	}
+	indicator := RiskIndicator{
+		Name:      fmt.Sprintf("%s %s error budget", name, b.SLO.Metric),
+		Value:     100 * b.ProjectedConsumption(),
+		Threshold: 100,
+		Status:    RiskStatusNormal,
+	}
+	switch b.Status {
+	case ErrorBudgetExhausted:
+		indicator.Status = RiskStatusCritical
+	case ErrorBudgetAtRisk:
+		indicator.Status = RiskStatusWarning
+	}
+	return indicator
+}
+
+// WithErrorBudgets tracks the error budgets of the SLOs derived from the SLA of an agreement's
+// application against its ingested availability measurements, and reports them among its risk
+// indicators
+func WithErrorBudgets(appRepo ApplicationRepository, availabilityRepo AvailabilityMeasurementRepository) MonitoringOption {
+	return func(s *MonitoringService) {
+		s.appRepo = appRepo
+		s.availabilityRepo = availabilityRepo
+	}
+}
+
+// MonitorErrorBudgets returns the current error budget of each SLO derived from the SLA of the
+// agreement's application. It returns none without the repositories of WithErrorBudgets.
+func (s *MonitoringService) MonitorErrorBudgets(ctx context.Context, agreementID GovernanceAgreementID) ([]ErrorBudget, error) {
+	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+	return s.errorBudgets(ctx, agreement.ApplicationID, time.Now())
+}
+
+// errorBudgets works out the error budgets of an application's SLOs at a point in time
+func (s *MonitoringService) errorBudgets(ctx context.Context, appID ApplicationID, at time.Time) ([]ErrorBudget, error) {
+	if s.appRepo == nil || s.availabilityRepo == nil {
+		return []ErrorBudget{}, nil
+	}
+
+	app, err := s.appRepo.FindByID(ctx, appID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find application: %w", err)
+	}
+	measurements, err := s.availabilityRepo.FindByApplicationID(ctx, appID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find availability measurements: %w", err)
+	}
+
+	budgets := []ErrorBudget{}
+	for _, slo := range DeriveSLOs(app) {
+		budgets = append(budgets, BuildErrorBudget(slo, measurements, at))
+	}
+	return budgets, nil
+}
@@ -0,0 +1,328 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// SurveyStatus represents whether a survey accepts responses
+type SurveyStatus string
+
+const (
+	SurveyOpen   SurveyStatus = "open"
+	SurveyClosed SurveyStatus = "closed"
+)
+
+// defaultSurveyScale is the highest score of an answer when a survey does not set its scale
+const defaultSurveyScale = 5
+
+// SurveyQuestionID returns the ID answers use for the survey question at index
+func SurveyQuestionID(index int) string {
+	return fmt.Sprintf("q%d", index+1)
+}
+
+// QuestionSummary summarizes the answers to one survey question
+type QuestionSummary struct {
+	QuestionID   string
+	Question     string
+	Answers      int
+	Scored       int     // answers with a score
+	AverageScore float64 // on the survey's scale
+}
+
+// Validate ensures the survey has valid data
+func (s Survey) Validate() error {
+	if s.ID == "" {
+		return errors.New("survey ID cannot be empty")
+	}
+	if s.Name == "" {
+		return errors.New("survey name cannot be empty")
+	}
+	if len(s.Questions) == 0 {
+		return errors.New("survey must have at least one question")
+	}
+	if s.Scale != 0 && s.Scale < 2 {
+		return errors.New("survey scale must be at least 2")
+	}
+	if s.Invited < 0 {
+		return errors.New("invited stakeholders must not be negative")
+	}
+	return nil
+}
+
+// ScaleOrDefault returns the highest score of an answer to the survey
+func (s Survey) ScaleOrDefault() int {
+	if s.Scale == 0 {
+		return defaultSurveyScale
+	}
+	return s.Scale
+}
+
+// SummarizeSurvey counts the survey's respondents and averages the scores of their answers,
+// overall and per question. Satisfaction rescales the average score from 1 up to the survey's
+// scale onto 0-100. Key insights name the highest and lowest rated questions and the number of
+// written comments.
+func SummarizeSurvey(survey Survey, responses []SurveyResponse) SurveySummary {
+	scale := survey.ScaleOrDefault()
+	summary := SurveySummary{KeyInsights: []string{}, Questions: make([]QuestionSummary, len(survey.Questions))}
+	questions := make(map[string]*QuestionSummary, len(survey.Questions))
+	for i, question := range survey.Questions {
+		summary.Questions[i] = QuestionSummary{QuestionID: SurveyQuestionID(i), Question: question}
+		questions[SurveyQuestionID(i)] = &summary.Questions[i]
+	}
+
+	respondents := make(map[string]bool)
+	total, scored, comments := 0, 0, 0
+	for _, response := range responses {
+		respondents[response.Respondent] = true
+		if response.Response != "" {
+			comments++
+		}
+		question, ok := questions[response.QuestionID]
+		if !ok {
+			continue
+		}
+		question.Answers++
+		if response.Score > 0 {
+			question.Scored++
+			question.AverageScore += float64(response.Score)
+			total += response.Score
+			scored++
+		}
+	}
+	summary.TotalResponses = len(respondents)
+	if survey.Invited > 0 {
+		summary.ResponseRate = float64(summary.TotalResponses) / float64(survey.Invited)
+		if summary.ResponseRate > 1 {
+			summary.ResponseRate = 1
+		}
+	}
+	if scored > 0 {
+		summary.AverageScore = float64(total) / float64(scored)
+		summary.Satisfaction = (summary.AverageScore - 1) / float64(scale-1) * 100
+	}
+
+	var rated []QuestionSummary
+	for i := range summary.Questions {
+		if question := &summary.Questions[i]; question.Scored > 0 {
+			question.AverageScore /= float64(question.Scored)
+			rated = append(rated, *question)
+		}
+	}
+	if len(rated) > 1 {
+		sort.SliceStable(rated, func(i, j int) bool { return rated[i].AverageScore > rated[j].AverageScore })
+		highest, lowest := rated[0], rated[len(rated)-1]
+		if highest.AverageScore > lowest.AverageScore {
+			summary.KeyInsights = append(summary.KeyInsights,
+				fmt.Sprintf("Highest rated: %s (%.1f/%d)", highest.Question, highest.AverageScore, scale),
+				fmt.Sprintf("Lowest rated: %s (%.1f/%d)", lowest.Question, lowest.AverageScore, scale))
+		}
+	}
+	if comments > 0 {
+		summary.KeyInsights = append(summary.KeyInsights, fmt.Sprintf("Written comments: %d", comments))
+	}
+	return summary
+}
+
+// SurveySatisfaction averages the satisfaction of the surveys with scored answers, weighting each
+// survey by its respondents. It returns the number of respondents behind the score, zero when no
+// survey was answered.
+func SurveySatisfaction(feedback StakeholderFeedback) (float64, int) {
+	total, respondents := 0.0, 0
+	for _, result := range feedback.SurveyResults {
+		if result.Summary.AverageScore == 0 {
+			continue
+		}
+		total += result.Summary.Satisfaction * float64(result.Summary.TotalResponses)
+		respondents += result.Summary.TotalResponses
+	}
+	if respondents == 0 {
+		return 0, 0
+	}
+	return total / float64(respondents), respondents
+}
+
+// surveyIndex returns the position of a survey among the agreement's surveys
+func surveyIndex(agreement GovernanceAgreement, surveyID string) (int, error) {
+	for i, survey := range agreement.Monitor.PerformanceMonitoring.UserExperienceMonitoring.Surveys {
+		if survey.ID == surveyID {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("survey %s not found in agreement %s", surveyID, agreement.ID)
+}
+
+// surveyResult returns the result of a survey and its position among the stakeholder feedback's
+// survey results, or an empty result at -1 when nobody responded to the survey yet
+func surveyResult(agreement GovernanceAgreement, survey Survey) (SurveyResult, int) {
+	for i, result := range agreement.Monitor.StakeholderFeedback.SurveyResults {
+		if result.SurveyID == survey.ID {
+			return result, i
+		}
+	}
+	return SurveyResult{SurveyID: survey.ID, Responses: []SurveyResponse{}, Summary: SummarizeSurvey(survey, nil)}, -1
+}
+
+// CreateSurvey opens a stakeholder survey of the agreement with an empty result
+func (s *MonitoringService) CreateSurvey(ctx context.Context, agreementID GovernanceAgreementID, survey Survey) (*Survey, error) {
+	if err := survey.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid survey: %w", err)
+	}
+
+	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+	if _, err := surveyIndex(agreement, survey.ID); err == nil {
+		return nil, fmt.Errorf("survey %s already exists in agreement %s", survey.ID, agreementID)
+	}
+
+	survey.Status = SurveyOpen
+	if survey.OpenedAt.IsZero() {
+		survey.OpenedAt = time.Now()
+	}
+	survey.ClosedAt = time.Time{}
+
+	experience := &agreement.Monitor.PerformanceMonitoring.UserExperienceMonitoring
+	experience.Surveys = append(append([]Survey{}, experience.Surveys...), survey)
+	if result, resultAt := surveyResult(agreement, survey); resultAt < 0 {
+		feedback := &agreement.Monitor.StakeholderFeedback
+		feedback.SurveyResults = append(append([]SurveyResult{}, feedback.SurveyResults...), result)
+	}
+
+	err = s.agreementRepo.Update(ctx, agreement)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update governance agreement: %w", err)
+	}
+	return &survey, nil
+}
+
+// RecordSurveyResponse records one respondent's answers to an open survey and summarizes the
+// survey again. Answers without a respondent are recorded anonymously; a named respondent may
+// respond once.
+func (s *MonitoringService) RecordSurveyResponse(ctx context.Context, agreementID GovernanceAgreementID, surveyID, respondent string, answers []SurveyResponse, at time.Time) (*SurveyResult, error) {
+	if len(answers) == 0 {
+		return nil, errors.New("survey response must answer at least one question")
+	}
+
+	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+	surveyAt, err := surveyIndex(agreement, surveyID)
+	if err != nil {
+		return nil, err
+	}
+	survey := agreement.Monitor.PerformanceMonitoring.UserExperienceMonitoring.Surveys[surveyAt]
+	if survey.Status == SurveyClosed {
+		return nil, fmt.Errorf("survey %s is closed", surveyID)
+	}
+
+	result, resultAt := surveyResult(agreement, survey)
+	respondents := make(map[string]bool)
+	for _, response := range result.Responses {
+		respondents[response.Respondent] = true
+	}
+	if respondent == "" {
+		n := len(respondents) + 1
+		for respondents[fmt.Sprintf("anonymous-%d", n)] {
+			n++
+		}
+		respondent = fmt.Sprintf("anonymous-%d", n)
+	} else if respondents[respondent] {
+		return nil, fmt.Errorf("%s already responded to survey %s", respondent, surveyID)
+	}
+
+	scale := survey.ScaleOrDefault()
+	responses := append([]SurveyResponse{}, result.Responses...)
+	for _, answer := range answers {
+		index := -1
+		for i := range survey.Questions {
+			if SurveyQuestionID(i) == answer.QuestionID {
+				index = i
+			}
+		}
+		if index < 0 {
+			return nil, fmt.Errorf("survey %s has no question %q", surveyID, answer.QuestionID)
+		}
+		if answer.Score < 0 || answer.Score > scale {
+			return nil, fmt.Errorf("score of %s must be between 1 and %d, or 0 when unscored", answer.QuestionID, scale)
+		}
+		answer.Respondent = respondent
+		answer.SubmittedAt = at
+		responses = append(responses, answer)
+	}
+	result.Responses = responses
+	result.Summary = SummarizeSurvey(survey, responses)
+
+	feedback := &agreement.Monitor.StakeholderFeedback
+	feedback.SurveyResults = append([]SurveyResult{}, feedback.SurveyResults...)
+	if resultAt < 0 {
+		feedback.SurveyResults = append(feedback.SurveyResults, result)
+	} else {
+		feedback.SurveyResults[resultAt] = result
+	}
+
+	err = s.agreementRepo.Update(ctx, agreement)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update governance agreement: %w", err)
+	}
+	return &result, nil
+}
+
+// CloseSurvey stops a survey accepting responses. A survey with scored answers records its
+// satisfaction among the agreement's satisfaction scores.
+func (s *MonitoringService) CloseSurvey(ctx context.Context, agreementID GovernanceAgreementID, surveyID string, at time.Time) (*SurveyResult, error) {
+	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+	surveyAt, err := surveyIndex(agreement, surveyID)
+	if err != nil {
+		return nil, err
+	}
+
+	experience := &agreement.Monitor.PerformanceMonitoring.UserExperienceMonitoring
+	if experience.Surveys[surveyAt].Status == SurveyClosed {
+		return nil, fmt.Errorf("survey %s is already closed", surveyID)
+	}
+	experience.Surveys = append([]Survey{}, experience.Surveys...)
+	experience.Surveys[surveyAt].Status = SurveyClosed
+	experience.Surveys[surveyAt].ClosedAt = at
+
+	result, _ := surveyResult(agreement, experience.Surveys[surveyAt])
+	if result.Summary.AverageScore > 0 {
+		experience.SatisfactionScores = append(append([]SatisfactionScore{}, experience.SatisfactionScores...), SatisfactionScore{
+			Metric:     experience.Surveys[surveyAt].Name,
+			Score:      result.Summary.Satisfaction,
+			Date:       at,
+			SampleSize: result.Summary.TotalResponses,
+		})
+	}
+
+	err = s.agreementRepo.Update(ctx, agreement)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update governance agreement: %w", err)
+	}
+	return &result, nil
+}
+
+// MonitorSurveys returns the agreement's surveys and their results, in the order the surveys
+// were created
+func (s *MonitoringService) MonitorSurveys(ctx context.Context, agreementID GovernanceAgreementID) ([]Survey, []SurveyResult, error) {
+	agreement, err := s.agreementRepo.FindByID(ctx, agreementID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to find governance agreement: %w", err)
+	}
+
+	surveys := append([]Survey{}, agreement.Monitor.PerformanceMonitoring.UserExperienceMonitoring.Surveys...)
+	results := make([]SurveyResult, 0, len(surveys))
+	for _, survey := range surveys {
+		result, _ := surveyResult(agreement, survey)
+		results = append(results, result)
+	}
+	return surveys, results, nil
+}
@@ -0,0 +1,228 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// TechnicalDebtCategory classifies where a piece of technical debt lives
+type TechnicalDebtCategory string
+
+const (
+	DebtCode           TechnicalDebtCategory = "code"
+	DebtArchitecture   TechnicalDebtCategory = "architecture"
+	DebtInfrastructure TechnicalDebtCategory = "infrastructure"
+	DebtSecurity       TechnicalDebtCategory = "security"
+	DebtDependency     TechnicalDebtCategory = "dependency"
+	DebtTesting        TechnicalDebtCategory = "testing"
+	DebtDocumentation  TechnicalDebtCategory = "documentation"
+)
+
+// TechnicalDebtStatus represents the remediation status of a debt item
+type TechnicalDebtStatus string
+
+const (
+	DebtOpen       TechnicalDebtStatus = "open"
+	DebtInProgress TechnicalDebtStatus = "in_progress"
+	DebtResolved   TechnicalDebtStatus = "resolved"
+)
+
+// TechnicalDebtItem is an entry in an application's technical debt register
+type TechnicalDebtItem struct {
+	ID                    string
+	ApplicationID         ApplicationID
+	Title                 string
+	Description           string
+	Category              TechnicalDebtCategory
+	PrincipalHours        float64 // effort needed to remediate the item
+	InterestHoursPerMonth float64 // extra effort the item costs each month it stays open
+	RemediationPlan       string
+	Status                TechnicalDebtStatus
+	IdentifiedAt          time.Time
+	ResolvedAt            time.Time
+}
+
+// Validate ensures the debt item has valid data
+func (d TechnicalDebtItem) Validate() error {
+	if d.ID == "" {
+		return errors.New("technical debt ID cannot be empty")
+	}
+	if d.ApplicationID == "" {
+		return errors.New("technical debt application ID cannot be empty")
+	}
+	if d.Title == "" {
+		return errors.New("technical debt title cannot be empty")
+	}
+	if d.PrincipalHours < 0 || d.InterestHoursPerMonth < 0 {
+		return errors.New("technical debt hours must not be negative")
+	}
+	return nil
+}
+
+// IsOpen reports whether the item still needs remediation
+func (d TechnicalDebtItem) IsOpen() bool {
+	return d.Status != DebtResolved
+}
+
+// TechnicalDebtSummary totals the open technical debt of one or more applications
+type TechnicalDebtSummary struct {
+	OpenItems             int
+	ResolvedItems         int
+	PrincipalHours        float64
+	InterestHoursPerMonth float64
+	PrincipalByCategory   map[TechnicalDebtCategory]float64
+}
+
+// LargestCategory returns the category with the most open principal
+func (s TechnicalDebtSummary) LargestCategory() TechnicalDebtCategory {
+	var largest TechnicalDebtCategory
+	for category, hours := range s.PrincipalByCategory {
+		if largest == "" || hours > s.PrincipalByCategory[largest] || (hours == s.PrincipalByCategory[largest] && category < largest) {
+			largest = category
+		}
+	}
+	return largest
+}
+
+// SummarizeTechnicalDebt totals a set of debt items. Resolved items are counted but carry no debt.
+func SummarizeTechnicalDebt(items []TechnicalDebtItem) TechnicalDebtSummary {
+	summary := TechnicalDebtSummary{PrincipalByCategory: make(map[TechnicalDebtCategory]float64)}
+	for _, item := range items {
+		if !item.IsOpen() {
+			summary.ResolvedItems++
+			continue
+		}
+		summary.OpenItems++
+		summary.PrincipalHours += item.PrincipalHours
+		summary.InterestHoursPerMonth += item.InterestHoursPerMonth
+		summary.PrincipalByCategory[item.Category] += item.PrincipalHours
+	}
+	return summary
+}
+
+// technicalDebtScore converts open debt into a technical health adjustment: a register with
+// nothing open earns a point, growing principal and interest cost up to three points
+func technicalDebtScore(summary TechnicalDebtSummary) int {
+	if summary.OpenItems == 0 {
+		return 1
+	}
+
+	score := 0
+	switch {
+	case summary.PrincipalHours >= 800:
+		score = -3
+	case summary.PrincipalHours >= 200:
+		score = -2
+	case summary.PrincipalHours >= 40:
+		score = -1
+	}
+	if summary.InterestHoursPerMonth >= 40 && score > -3 {
+		score--
+	}
+	return score
+}
+
+// ApplicationTechnicalDebt is one application's line in a portfolio debt roll-up
+type ApplicationTechnicalDebt struct {
+	ApplicationID ApplicationID
+	Summary       TechnicalDebtSummary
+}
+
+// PortfolioTechnicalDebt rolls technical debt up across a portfolio, largest first
+type PortfolioTechnicalDebt struct {
+	PortfolioID  PortfolioID
+	Applications []ApplicationTechnicalDebt
+	Total        TechnicalDebtSummary
+}
+
+// TechnicalDebtService maintains the technical debt register
+type TechnicalDebtService struct {
+	debtRepo      TechnicalDebtRepository
+	portfolioRepo ApplicationPortfolioRepository
+}
+
+// NewTechnicalDebtService creates a new technical debt service
+func NewTechnicalDebtService(debtRepo TechnicalDebtRepository, portfolioRepo ApplicationPortfolioRepository) *TechnicalDebtService {
+	return &TechnicalDebtService{
+		debtRepo:      debtRepo,
+		portfolioRepo: portfolioRepo,
+	}
+}
+
+// RecordDebt adds an item to the register, opening it if no status is given
+func (s *TechnicalDebtService) RecordDebt(ctx context.Context, item TechnicalDebtItem) (*TechnicalDebtItem, error) {
+	if err := item.Validate(); err != nil {
+		return nil, err
+	}
+	if item.Status == "" {
+		item.Status = DebtOpen
+	}
+	if item.IdentifiedAt.IsZero() {
+		item.IdentifiedAt = time.Now()
+	}
+
+	if err := s.debtRepo.Save(ctx, item); err != nil {
+		return nil, fmt.Errorf("failed to save technical debt: %w", err)
+	}
+	return &item, nil
+}
+
+// ResolveDebt marks an item as remediated
+func (s *TechnicalDebtService) ResolveDebt(ctx context.Context, id string) (*TechnicalDebtItem, error) {
+	item, err := s.debtRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find technical debt: %w", err)
+	}
+
+	item.Status = DebtResolved
+	item.ResolvedAt = time.Now()
+	if err := s.debtRepo.Update(ctx, item); err != nil {
+		return nil, fmt.Errorf("failed to update technical debt: %w", err)
+	}
+	return &item, nil
+}
+
+// SummarizeApplication totals the debt recorded for an application
+func (s *TechnicalDebtService) SummarizeApplication(ctx context.Context, appID ApplicationID) (*TechnicalDebtSummary, error) {
+	items, err := s.debtRepo.FindByApplicationID(ctx, appID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load technical debt: %w", err)
+	}
+
+	summary := SummarizeTechnicalDebt(items)
+	return &summary, nil
+}
+
+// RollUpPortfolio totals debt for every application in a portfolio
+func (s *TechnicalDebtService) RollUpPortfolio(ctx context.Context, portfolioID PortfolioID) (*PortfolioTechnicalDebt, error) {
+	portfolio, err := s.portfolioRepo.FindByID(ctx, portfolioID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find portfolio: %w", err)
+	}
+	return rollUpTechnicalDebt(ctx, s.debtRepo, portfolioID, portfolio.Applications)
+}
+
+func rollUpTechnicalDebt(ctx context.Context, debtRepo TechnicalDebtRepository, portfolioID PortfolioID, apps []Application) (*PortfolioTechnicalDebt, error) {
+	rollup := &PortfolioTechnicalDebt{PortfolioID: portfolioID}
+	var all []TechnicalDebtItem
+	for _, app := range apps {
+		items, err := debtRepo.FindByApplicationID(ctx, app.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load technical debt: %w", err)
+		}
+		all = append(all, items...)
+		rollup.Applications = append(rollup.Applications, ApplicationTechnicalDebt{
+			ApplicationID: app.ID,
+			Summary:       SummarizeTechnicalDebt(items),
+		})
+	}
+
+	sort.SliceStable(rollup.Applications, func(i, j int) bool {
+		return rollup.Applications[i].Summary.PrincipalHours > rollup.Applications[j].Summary.PrincipalHours
+	})
+	rollup.Total = SummarizeTechnicalDebt(all)
+	return rollup, nil
+}
@@ -0,0 +1,161 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// DefaultTelemetryInterval is how often a telemetry binding is pulled when it sets no interval
+const DefaultTelemetryInterval = time.Hour
+
+// TelemetryMetric is the kind of production telemetry a KPI is measured from
+type TelemetryMetric string
+
+const (
+	TelemetryUptime    TelemetryMetric = "uptime"     // percentage of time the service was available
+	TelemetryLatency   TelemetryMetric = "latency"    // response time, in the KPI's unit
+	TelemetryErrorRate TelemetryMetric = "error_rate" // percentage of requests that failed
+)
+
+// Validate ensures the metric is known
+func (m TelemetryMetric) Validate() error {
+	switch m {
+	case TelemetryUptime, TelemetryLatency, TelemetryErrorRate:
+		return nil
+	}
+	return fmt.Errorf("unknown telemetry metric %q", m)
+}
+
+// TelemetryAggregation reduces the points pulled in one ingestion to a single KPI measurement
+type TelemetryAggregation string
+
+const (
+	TelemetryAverage TelemetryAggregation = "avg"
+	TelemetryMinimum TelemetryAggregation = "min"
+	TelemetryMaximum TelemetryAggregation = "max"
+	TelemetryLast    TelemetryAggregation = "last"
+	TelemetrySum     TelemetryAggregation = "sum"
+)
+
+// Validate ensures the aggregation is known
+func (a TelemetryAggregation) Validate() error {
+	switch a {
+	case TelemetryAverage, TelemetryMinimum, TelemetryMaximum, TelemetryLast, TelemetrySum:
+		return nil
+	}
+	return fmt.Errorf("unknown telemetry aggregation %q", a)
+}
+
+// TelemetryPoint is one value of an external metric series
+type TelemetryPoint struct {
+	At    time.Time
+	Value float64
+}
+
+// TelemetrySource pulls metric series from an external monitoring system such as Datadog or
+// CloudWatch. The query is in the source's own syntax.
+type TelemetrySource interface {
+	Name() string
+	Query(ctx context.Context, query string, from, to time.Time) ([]TelemetryPoint, error)
+}
+
+// TelemetryBinding maps a metric of an external monitoring system onto a KPI, which is measured
+// from the metric every interval. A KPI is fed by at most one binding.
+type TelemetryBinding struct {
+	KPIID          string
+	ApplicationID  ApplicationID // optional, the application the metric observes
+	Source         string        // name of the telemetry source, e.g. "datadog"
+	Metric         TelemetryMetric
+	Query          string
+	Aggregation    TelemetryAggregation
+	Scale          float64       // multiplies every value, e.g. 100 for a fraction; 1 when zero
+	Interval       time.Duration // how often the metric is pulled; DefaultTelemetryInterval when zero
+	LastIngestedAt time.Time     // end of the last window pulled
+	LastError      string        // why the last pull failed, empty when it succeeded
+}
+
+// Validate ensures the binding names a KPI, a source and a query, and a known metric and
+// aggregation
+func (b TelemetryBinding) Validate() error {
+	if b.KPIID == "" {
+		return errors.New("telemetry binding KPI ID cannot be empty")
+	}
+	if b.Source == "" || b.Query == "" {
+		return errors.New("telemetry binding requires a source and a query")
+	}
+	if err := b.Metric.Validate(); err != nil {
+		return err
+	}
+	if err := b.Aggregation.Validate(); err != nil {
+		return err
+	}
+	if b.Scale < 0 || b.Interval < 0 {
+		return errors.New("telemetry binding scale and interval must not be negative")
+	}
+	return nil
+}
+
+// PullInterval returns how often the binding is pulled
+func (b TelemetryBinding) PullInterval() time.Duration {
+	if b.Interval > 0 {
+		return b.Interval
+	}
+	return DefaultTelemetryInterval
+}
+
+// Due reports whether the binding's interval has passed since it was last pulled
+func (b TelemetryBinding) Due(now time.Time) bool {
+	return b.LastIngestedAt.IsZero() || !b.LastIngestedAt.Add(b.PullInterval()).After(now)
+}
+
+// Window returns the period to pull at now: from the end of the last window, or one interval
+// back when the binding was never pulled
+func (b TelemetryBinding) Window(now time.Time) (time.Time, time.Time) {
+	if b.LastIngestedAt.IsZero() {
+		return now.Add(-b.PullInterval()), now
+	}
+	return b.LastIngestedAt, now
+}
+
+// Aggregate reduces the points to the scaled value of a measurement, taken at the latest point.
+// It returns false without points.
+func (b TelemetryBinding) Aggregate(points []TelemetryPoint) (float64, time.Time, bool) {
+	if len(points) == 0 {
+		return 0, time.Time{}, false
+	}
+
+	latest := points[0]
+	value := points[0].Value
+	sum := 0.0
+	for _, point := range points {
+		sum += point.Value
+		if !point.At.Before(latest.At) {
+			latest = point
+		}
+		switch b.Aggregation {
+		case TelemetryMinimum:
+			if point.Value < value {
+				value = point.Value
+			}
+		case TelemetryMaximum:
+			if point.Value > value {
+				value = point.Value
+			}
+		}
+	}
+	switch b.Aggregation {
+	case TelemetryAverage:
+		value = sum / float64(len(points))
+	case TelemetrySum:
+		value = sum
+	case TelemetryLast:
+		value = latest.Value
+	}
+
+	if b.Scale > 0 {
+		value *= b.Scale
+	}
+	return value, latest.At, true
+}
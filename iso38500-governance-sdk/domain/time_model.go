@@ -0,0 +1,66 @@
+package domain
+
+import "errors"
+
+// TIMEQuadrant places an application in the TIME model (Tolerate, Invest, Migrate, Eliminate)
+// by its technical health and business value
+type TIMEQuadrant string
+
+const (
+	TIMETolerate  TIMEQuadrant = "tolerate"  // sound technically but of limited business value
+	TIMEInvest    TIMEQuadrant = "invest"    // sound technically and valuable to the business
+	TIMEMigrate   TIMEQuadrant = "migrate"   // valuable to the business but technically weak
+	TIMEEliminate TIMEQuadrant = "eliminate" // technically weak and of limited business value
+)
+
+// TIMEQuadrants lists the quadrants in the order they are usually reported
+var TIMEQuadrants = []TIMEQuadrant{TIMEInvest, TIMEMigrate, TIMETolerate, TIMEEliminate}
+
+// TIMEThresholds split applications into high and low technical health and business value.
+// Scores at or above a threshold count as high.
+type TIMEThresholds struct {
+	TechnicalHealth float64 // average technical health score, 1-5
+	BusinessValue   float64 // average business value percentage, 0-100
+}
+
+// DefaultTIMEThresholds treats a technical health of 3 and a business value of 70% as high
+func DefaultTIMEThresholds() TIMEThresholds {
+	return TIMEThresholds{TechnicalHealth: 3, BusinessValue: 70}
+}
+
+// Validate ensures the thresholds fall within the score ranges
+func (t TIMEThresholds) Validate() error {
+	if t == (TIMEThresholds{}) {
+		return nil
+	}
+	if t.TechnicalHealth < 1 || t.TechnicalHealth > 5 {
+		return errors.New("TIME technical health threshold must be between 1 and 5")
+	}
+	if t.BusinessValue < 0 || t.BusinessValue > 100 {
+		return errors.New("TIME business value threshold must be between 0 and 100")
+	}
+	return nil
+}
+
+// ClassifyTIME places an assessed application in its TIME quadrant. Zero thresholds fall back to
+// DefaultTIMEThresholds.
+func ClassifyTIME(technicalHealth TechnicalHealth, businessValue BusinessValueAssessment, thresholds TIMEThresholds) TIMEQuadrant {
+	if thresholds == (TIMEThresholds{}) {
+		thresholds = DefaultTIMEThresholds()
+	}
+
+	scores := ApplicationAssessment{TechnicalHealth: technicalHealth, BusinessValue: businessValue}
+	healthy := technicalHealthScore(scores) >= thresholds.TechnicalHealth
+	valuable := businessValueScore(scores) >= thresholds.BusinessValue
+
+	switch {
+	case healthy && valuable:
+		return TIMEInvest
+	case valuable:
+		return TIMEMigrate
+	case healthy:
+		return TIMETolerate
+	default:
+		return TIMEEliminate
+	}
+}
@@ -0,0 +1,231 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// TimelineItemKind identifies what a timeline item was taken from
+type TimelineItemKind string
+
+const (
+	TimelineObjective        TimelineItemKind = "objective"
+	TimelineActionPlan       TimelineItemKind = "action_plan"
+	TimelineAction           TimelineItemKind = "action"
+	TimelineInitiative       TimelineItemKind = "initiative"
+	TimelineMilestone        TimelineItemKind = "milestone"
+	TimelineAuditRequirement TimelineItemKind = "audit_requirement"
+	TimelineAudit            TimelineItemKind = "audit"
+)
+
+// TimelineItem is a dated piece of governance work, laid out for calendars and Gantt charts.
+// An item without a start is a single date, its end.
+type TimelineItem struct {
+	ID              string // unique across agreements
+	ParentID        string // action plan of an action, initiative of a milestone
+	Kind            TimelineItemKind
+	Name            string
+	Description     string
+	AgreementID     GovernanceAgreementID
+	ApplicationID   ApplicationID
+	Start           time.Time
+	End             time.Time
+	Deadline        time.Time
+	Status          string
+	Owner           string
+	PercentComplete float64
+	DependsOn       []string // items that must finish first
+}
+
+// Milestone reports whether the item falls on a single date
+func (i TimelineItem) Milestone() bool {
+	return i.Start.IsZero() || !i.End.After(i.Start)
+}
+
+// Timeline lays out the dated governance work of one or more agreements
+type Timeline struct {
+	Items       []TimelineItem // by start, then ID
+	GeneratedAt time.Time
+}
+
+// BuildTimeline lays out the agreement's objectives, action plans and their actions as scheduled,
+// initiatives and their milestones, the audits its compliance monitoring requires and the
+// application's audits. Items without a date are left out.
+func BuildTimeline(agreement GovernanceAgreement, schedule ActionSchedule, audits []Audit) []TimelineItem {
+	var items []TimelineItem
+	itemID := func(kind TimelineItemKind, id string) string {
+		return fmt.Sprintf("%s/%s/%s", agreement.ID, kind, id)
+	}
+	add := func(item TimelineItem) {
+		if item.End.IsZero() {
+			return
+		}
+		item.AgreementID = agreement.ID
+		item.ApplicationID = agreement.ApplicationID
+		items = append(items, item)
+	}
+
+	for _, objective := range agreement.Direct.StrategicDirection.Objectives {
+		add(TimelineItem{
+			ID:          itemID(TimelineObjective, objective.ID),
+			Kind:        TimelineObjective,
+			Name:        objective.Name,
+			Description: objective.Description,
+			End:         objective.Deadline,
+			Deadline:    objective.Deadline,
+		})
+	}
+
+	actionStarts := make(map[string]ScheduledAction)
+	for _, action := range schedule.Actions {
+		actionStarts[action.PlanID+"/"+action.ActionID] = action
+	}
+	planEnds := make(map[string]ScheduledPlan)
+	for _, plan := range schedule.Plans {
+		planEnds[plan.PlanID] = plan
+	}
+	for _, plan := range agreement.Direct.ActionPlans {
+		planItem := TimelineItem{
+			ID:          itemID(TimelineActionPlan, plan.ID),
+			Kind:        TimelineActionPlan,
+			Name:        plan.Name,
+			Description: plan.Description,
+			End:         planEnds[plan.ID].ProjectedCompletion,
+			Deadline:    plan.Deadline,
+			Status:      string(plan.Status),
+			Owner:       plan.Owner,
+		}
+		for _, dependency := range plan.DependsOn {
+			planItem.DependsOn = append(planItem.DependsOn, itemID(TimelineActionPlan, dependency))
+		}
+
+		completed := 0
+		for _, action := range plan.Actions {
+			scheduled := actionStarts[plan.ID+"/"+action.ID]
+			if planItem.Start.IsZero() || scheduled.Start.Before(planItem.Start) {
+				planItem.Start = scheduled.Start
+			}
+			if action.Status == ActionCompleted {
+				completed++
+			}
+
+			actionItem := TimelineItem{
+				ID:       itemID(TimelineAction, plan.ID+"/"+action.ID),
+				ParentID: planItem.ID,
+				Kind:     TimelineAction,
+				Name:     action.Description,
+				Start:    scheduled.Start,
+				End:      scheduled.Finish,
+				Deadline: action.Deadline,
+				Status:   string(action.Status),
+				Owner:    action.Responsible,
+			}
+			if action.Status == ActionCompleted {
+				actionItem.PercentComplete = 100
+			}
+			for _, dependency := range action.DependsOn {
+				actionItem.DependsOn = append(actionItem.DependsOn, itemID(TimelineAction, plan.ID+"/"+dependency))
+			}
+			add(actionItem)
+		}
+		if len(plan.Actions) > 0 {
+			planItem.PercentComplete = float64(completed) / float64(len(plan.Actions)) * 100
+		}
+		add(planItem)
+	}
+
+	for _, initiative := range agreement.Direct.StrategicDirection.Initiatives {
+		initiativeItem := TimelineItem{
+			ID:              itemID(TimelineInitiative, initiative.ID),
+			Kind:            TimelineInitiative,
+			Name:            initiative.Name,
+			Description:     initiative.Description,
+			End:             initiative.Deadline,
+			Deadline:        initiative.Deadline,
+			Status:          string(initiative.Status),
+			Owner:           initiative.Owner,
+			PercentComplete: initiative.PercentComplete,
+		}
+		if len(initiative.Updates) > 0 {
+			initiativeItem.Start = initiative.Updates[0].UpdatedAt
+		}
+		add(initiativeItem)
+
+		for _, milestone := range initiative.Milestones {
+			milestoneItem := TimelineItem{
+				ID:       itemID(TimelineMilestone, initiative.ID+"/"+milestone.ID),
+				ParentID: initiativeItem.ID,
+				Kind:     TimelineMilestone,
+				Name:     milestone.Name,
+				End:      milestone.DueDate,
+				Deadline: milestone.DueDate,
+				Owner:    initiative.Owner,
+			}
+			if milestone.Completed() {
+				milestoneItem.Status = "completed"
+				milestoneItem.PercentComplete = 100
+			}
+			add(milestoneItem)
+		}
+	}
+
+	requirements := append(append([]AuditRequirement{}, agreement.Conformance.ComplianceMonitoring.AuditRequirements...),
+		agreement.Monitor.ComplianceMonitoring.AuditRequirements...)
+	for _, requirement := range requirements {
+		add(TimelineItem{
+			ID:          itemID(TimelineAuditRequirement, requirement.Name),
+			Kind:        TimelineAuditRequirement,
+			Name:        requirement.Name,
+			Description: requirement.Description,
+			End:         requirement.NextAudit,
+			Deadline:    requirement.NextAudit,
+			Owner:       requirement.Responsible,
+		})
+	}
+
+	for _, audit := range audits {
+		auditItem := TimelineItem{
+			ID:          itemID(TimelineAudit, audit.ID),
+			Kind:        TimelineAudit,
+			Name:        fmt.Sprintf("%s audit", audit.Type),
+			Description: audit.Scope,
+			Start:       audit.Start(),
+			End:         audit.CompletedAt,
+			Deadline:    audit.DueDate,
+			Status:      string(audit.Status),
+			Owner:       audit.Auditor,
+		}
+		if auditItem.End.IsZero() {
+			auditItem.End = audit.DueDate
+		}
+		if auditItem.End.IsZero() {
+			auditItem.End = auditItem.Start
+		}
+		if audit.Status == AuditStatusCompleted || audit.Status == AuditStatusClosed {
+			auditItem.PercentComplete = 100
+		}
+		add(auditItem)
+	}
+
+	return items
+}
+
+// NewTimeline orders the items by start, or end when they have none, then by ID
+func NewTimeline(items []TimelineItem, at time.Time) Timeline {
+	sorted := make([]TimelineItem, len(items))
+	copy(sorted, items)
+	begins := func(item TimelineItem) time.Time {
+		if item.Start.IsZero() {
+			return item.End
+		}
+		return item.Start
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if !begins(sorted[i]).Equal(begins(sorted[j])) {
+			return begins(sorted[i]).Before(begins(sorted[j]))
+		}
+		return sorted[i].ID < sorted[j].ID
+	})
+	return Timeline{Items: sorted, GeneratedAt: at}
+}
@@ -0,0 +1,58 @@
+package domain
+
+import "context"
+
+// Span attribute keys identifying the governance objects a traced call works on
+const (
+	AttributeAgreementID   = "iso38500.agreement_id"
+	AttributeApplicationID = "iso38500.application_id"
+	AttributePortfolioID   = "iso38500.portfolio_id"
+)
+
+// SpanAttribute is a key and value recorded on a span
+type SpanAttribute struct {
+	Key   string
+	Value string
+}
+
+// Tracer starts spans around service and repository calls. It mirrors the shape of an
+// OpenTelemetry tracer so an adapter can forward spans to any OTel SDK.
+type Tracer interface {
+	Start(ctx context.Context, name string, attributes ...SpanAttribute) (context.Context, Span)
+}
+
+// Span is a traced call in progress
+type Span interface {
+	SetAttributes(attributes ...SpanAttribute)
+	RecordError(err error)
+	End()
+}
+
+// NoopTracer traces nothing
+type NoopTracer struct{}
+
+// Start returns the context unchanged and a span that records nothing
+func (NoopTracer) Start(ctx context.Context, name string, attributes ...SpanAttribute) (context.Context, Span) {
+	return ctx, noopSpan{}
+}
+
+type noopSpan struct{}
+
+func (noopSpan) SetAttributes(attributes ...SpanAttribute) {}
+func (noopSpan) RecordError(err error)                     {}
+func (noopSpan) End()                                      {}
+
+// AgreementAttribute names the governance agreement a span works on
+func AgreementAttribute(id GovernanceAgreementID) SpanAttribute {
+	return SpanAttribute{Key: AttributeAgreementID, Value: string(id)}
+}
+
+// ApplicationAttribute names the application a span works on
+func ApplicationAttribute(id ApplicationID) SpanAttribute {
+	return SpanAttribute{Key: AttributeApplicationID, Value: string(id)}
+}
+
+// PortfolioAttribute names the portfolio a span works on
+func PortfolioAttribute(id PortfolioID) SpanAttribute {
+	return SpanAttribute{Key: AttributePortfolioID, Value: string(id)}
+}
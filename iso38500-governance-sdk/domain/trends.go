@@ -0,0 +1,330 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// TrendDirection describes how a metric is moving over the analysis window
+type TrendDirection string
+
+const (
+	TrendImproving        TrendDirection = "improving"
+	TrendDegrading        TrendDirection = "degrading"
+	TrendStable           TrendDirection = "stable"
+	TrendInsufficientData TrendDirection = "insufficient_data"
+)
+
+// TrendOptions configures the analysis window and projection horizon
+type TrendOptions struct {
+	Window            time.Duration // how far back to look; zero means all history
+	ProjectionHorizon time.Duration // how far ahead to project from the latest assessment
+	StableThreshold   float64       // fitted change, as a fraction of the metric's range, below which a metric is stable
+}
+
+// DefaultTrendOptions returns a 90 day window with a 30 day projection
+func DefaultTrendOptions() TrendOptions {
+	return TrendOptions{
+		Window:            90 * 24 * time.Hour,
+		ProjectionHorizon: 30 * 24 * time.Hour,
+		StableThreshold:   0.05,
+	}
+}
+
+// MetricTrend summarizes a metric across a series of assessments
+type MetricTrend struct {
+	Metric      string
+	Direction   TrendDirection
+	SampleCount int
+	First       float64
+	Latest      float64
+	Change      float64 // Latest - First
+	SlopePerDay float64 // least-squares slope
+	Projected   float64 // linear projection at the end of the projection horizon
+}
+
+// ApplicationTrend reports the direction of an application's assessments over a window
+type ApplicationTrend struct {
+	ApplicationID   ApplicationID
+	From            time.Time
+	To              time.Time
+	TechnicalHealth MetricTrend
+	BusinessValue   MetricTrend
+	Risk            MetricTrend
+	CurrentRisk     RiskLevel
+	ProjectedRisk   RiskLevel
+}
+
+// PortfolioTrend aggregates application trends across a portfolio. Each metric is fitted per
+// application and averaged, so applications assessed at different levels and times do not read
+// as a portfolio-wide change.
+type PortfolioTrend struct {
+	PortfolioID     PortfolioID
+	Applications    []ApplicationTrend
+	TechnicalHealth MetricTrend
+	BusinessValue   MetricTrend
+	Risk            MetricTrend
+	Improving       int // applications whose risk trend is improving
+	Degrading       int
+	Stable          int
+}
+
+// TrendService analyzes assessment history to show whether governance outcomes are improving
+type TrendService struct {
+	assessmentRepo AssessmentRepository
+	portfolioRepo  ApplicationPortfolioRepository
+}
+
+// NewTrendService creates a new trend service
+func NewTrendService(assessmentRepo AssessmentRepository, portfolioRepo ApplicationPortfolioRepository) *TrendService {
+	return &TrendService{
+		assessmentRepo: assessmentRepo,
+		portfolioRepo:  portfolioRepo,
+	}
+}
+
+// metricSeries describes how to extract and judge one assessment metric
+type metricSeries struct {
+	name           string
+	min, max       float64
+	higherIsBetter bool
+	value          func(ApplicationAssessment) float64
+}
+
+var (
+	technicalHealthSeries = metricSeries{name: "technical_health", min: 1, max: 5, higherIsBetter: true, value: technicalHealthScore}
+	businessValueSeries   = metricSeries{name: "business_value", min: 0, max: 100, higherIsBetter: true, value: businessValueScore}
+	riskSeries            = metricSeries{name: "risk", min: 1, max: 4, higherIsBetter: false, value: func(a ApplicationAssessment) float64 { return riskScore(a.RiskLevel) }}
+)
+
+// AnalyzeApplication reports trends for a single application's assessment history
+func (s *TrendService) AnalyzeApplication(ctx context.Context, appID ApplicationID, opts TrendOptions) (*ApplicationTrend, error) {
+	assessments, err := s.assessmentsInWindow(ctx, appID, opts)
+	if err != nil {
+		return nil, err
+	}
+	return analyzeApplication(appID, assessments, opts), nil
+}
+
+// analyzeApplication reports trends for an application's assessments, oldest first
+func analyzeApplication(appID ApplicationID, assessments []ApplicationAssessment, opts TrendOptions) *ApplicationTrend {
+	trend := &ApplicationTrend{
+		ApplicationID:   appID,
+		TechnicalHealth: analyzeMetric(assessments, technicalHealthSeries, opts),
+		BusinessValue:   analyzeMetric(assessments, businessValueSeries, opts),
+		Risk:            analyzeMetric(assessments, riskSeries, opts),
+	}
+	if len(assessments) > 0 {
+		trend.From = assessments[0].AssessedAt
+		trend.To = assessments[len(assessments)-1].AssessedAt
+		trend.CurrentRisk = assessments[len(assessments)-1].RiskLevel
+		trend.ProjectedRisk = riskLevelFromScore(trend.Risk.Projected)
+	}
+	return trend
+}
+
+// AnalyzePortfolio reports trends for every application in a portfolio and for the portfolio as a whole
+func (s *TrendService) AnalyzePortfolio(ctx context.Context, portfolioID PortfolioID, opts TrendOptions) (*PortfolioTrend, error) {
+	portfolio, err := s.portfolioRepo.FindByID(ctx, portfolioID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find portfolio: %w", err)
+	}
+
+	trend := &PortfolioTrend{PortfolioID: portfolioID}
+	for _, app := range portfolio.Applications {
+		assessments, err := s.assessmentsInWindow(ctx, app.ID, opts)
+		if err != nil {
+			return nil, err
+		}
+		appTrend := analyzeApplication(app.ID, assessments, opts)
+		trend.Applications = append(trend.Applications, *appTrend)
+
+		switch appTrend.Risk.Direction {
+		case TrendImproving:
+			trend.Improving++
+		case TrendDegrading:
+			trend.Degrading++
+		case TrendStable:
+			trend.Stable++
+		}
+	}
+
+	trend.TechnicalHealth = aggregateMetric(trend.Applications, technicalHealthSeries, opts, func(t ApplicationTrend) MetricTrend { return t.TechnicalHealth })
+	trend.BusinessValue = aggregateMetric(trend.Applications, businessValueSeries, opts, func(t ApplicationTrend) MetricTrend { return t.BusinessValue })
+	trend.Risk = aggregateMetric(trend.Applications, riskSeries, opts, func(t ApplicationTrend) MetricTrend { return t.Risk })
+	return trend, nil
+}
+
+// aggregateMetric averages a metric's per-application trends. The values are the mean of the
+// applications assessed in the window, the slope the mean of the applications assessed at least
+// twice, and the direction classifies the mean change fitted over each application's own span.
+func aggregateMetric(applications []ApplicationTrend, series metricSeries, opts TrendOptions, metric func(ApplicationTrend) MetricTrend) MetricTrend {
+	trend := MetricTrend{Metric: series.name, Direction: TrendInsufficientData}
+	assessed, fitted := 0, 0
+	var fittedChange float64
+	for _, application := range applications {
+		appMetric := metric(application)
+		if appMetric.SampleCount == 0 {
+			continue
+		}
+		assessed++
+		trend.SampleCount += appMetric.SampleCount
+		trend.First += appMetric.First
+		trend.Latest += appMetric.Latest
+		trend.Projected += appMetric.Projected
+		if appMetric.SampleCount >= 2 {
+			fitted++
+			trend.SlopePerDay += appMetric.SlopePerDay
+			fittedChange += appMetric.SlopePerDay * application.To.Sub(application.From).Hours() / 24
+		}
+	}
+	if assessed == 0 {
+		return trend
+	}
+
+	trend.First /= float64(assessed)
+	trend.Latest /= float64(assessed)
+	trend.Projected /= float64(assessed)
+	trend.Change = trend.Latest - trend.First
+	if fitted > 0 {
+		trend.SlopePerDay /= float64(fitted)
+		trend.Direction = classifyChange(fittedChange/float64(fitted), series, opts)
+	}
+	return trend
+}
+
+// assessmentsInWindow loads an application's assessments within the configured window, oldest first
+func (s *TrendService) assessmentsInWindow(ctx context.Context, appID ApplicationID, opts TrendOptions) ([]ApplicationAssessment, error) {
+	var assessments []ApplicationAssessment
+	var err error
+	if opts.Window > 0 {
+		now := time.Now()
+		assessments, err = s.assessmentRepo.FindByPeriod(ctx, appID, now.Add(-opts.Window), now)
+	} else {
+		assessments, err = s.assessmentRepo.FindByApplicationID(ctx, appID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load assessment history: %w", err)
+	}
+
+	sortAssessmentsByTime(assessments)
+	return assessments, nil
+}
+
+// analyzeMetric fits a least-squares line through the metric values and classifies its direction
+func analyzeMetric(assessments []ApplicationAssessment, series metricSeries, opts TrendOptions) MetricTrend {
+	trend := MetricTrend{Metric: series.name, Direction: TrendInsufficientData, SampleCount: len(assessments)}
+	if len(assessments) == 0 {
+		return trend
+	}
+
+	sortAssessmentsByTime(assessments)
+	origin := assessments[0].AssessedAt
+	xs := make([]float64, len(assessments))
+	ys := make([]float64, len(assessments))
+	for i, assessment := range assessments {
+		xs[i] = assessment.AssessedAt.Sub(origin).Hours() / 24
+		ys[i] = series.value(assessment)
+	}
+
+	trend.First = ys[0]
+	trend.Latest = ys[len(ys)-1]
+	trend.Change = trend.Latest - trend.First
+	trend.Projected = trend.Latest
+	if len(assessments) < 2 {
+		return trend
+	}
+
+	slope, intercept := linearFit(xs, ys)
+	trend.SlopePerDay = slope
+
+	horizonDays := opts.ProjectionHorizon.Hours() / 24
+	projected := intercept + slope*(xs[len(xs)-1]+horizonDays)
+	trend.Projected = math.Max(series.min, math.Min(series.max, projected))
+
+	trend.Direction = classifyChange(slope*(xs[len(xs)-1]-xs[0]), series, opts)
+	return trend
+}
+
+// classifyChange judges a fitted change in a metric as stable, improving or degrading
+func classifyChange(fittedChange float64, series metricSeries, opts TrendOptions) TrendDirection {
+	if math.Abs(fittedChange) < opts.StableThreshold*(series.max-series.min) {
+		return TrendStable
+	}
+	if (fittedChange > 0) == series.higherIsBetter {
+		return TrendImproving
+	}
+	return TrendDegrading
+}
+
+// linearFit returns the least-squares slope and intercept of ys over xs
+func linearFit(xs, ys []float64) (slope, intercept float64) {
+	n := float64(len(xs))
+	var sumX, sumY, sumXY, sumXX float64
+	for i := range xs {
+		sumX += xs[i]
+		sumY += ys[i]
+		sumXY += xs[i] * ys[i]
+		sumXX += xs[i] * xs[i]
+	}
+
+	denominator := n*sumXX - sumX*sumX
+	if denominator == 0 {
+		return 0, sumY / n
+	}
+	slope = (n*sumXY - sumX*sumY) / denominator
+	intercept = (sumY - slope*sumX) / n
+	return slope, intercept
+}
+
+// sortAssessmentsByTime orders assessments oldest first
+func sortAssessmentsByTime(assessments []ApplicationAssessment) {
+	sort.SliceStable(assessments, func(i, j int) bool {
+		return assessments[i].AssessedAt.Before(assessments[j].AssessedAt)
+	})
+}
+
+// technicalHealthScore averages the 1-5 technical health scores
+func technicalHealthScore(a ApplicationAssessment) float64 {
+	h := a.TechnicalHealth
+	return float64(h.CodeQuality+h.Documentation+h.SecurityScore+h.PerformanceScore) / 4
+}
+
+// businessValueScore averages the percentage-based business value scores
+func businessValueScore(a ApplicationAssessment) float64 {
+	v := a.BusinessValue
+	return (v.BusinessAlignment + v.CostEfficiency + v.UserSatisfaction) / 3
+}
+
+// riskScore maps a risk level onto a 1 (low) to 4 (critical) scale
+func riskScore(level RiskLevel) float64 {
+	switch level {
+	case RiskLow:
+		return 1
+	case RiskMedium:
+		return 2
+	case RiskHigh:
+		return 3
+	case RiskCritical:
+		return 4
+	default:
+		return 0
+	}
+}
+
+// riskLevelFromScore rounds a 1-4 risk score back to a risk level
+func riskLevelFromScore(score float64) RiskLevel {
+	switch {
+	case score < 1.5:
+		return RiskLow
+	case score < 2.5:
+		return RiskMedium
+	case score < 3.5:
+		return RiskHigh
+	default:
+		return RiskCritical
+	}
+}
@@ -120,6 +120,7 @@ type ApplicationInterface struct {
 	Protocol    string
 	Endpoint    string
 	Status      InterfaceStatus
+	Provider    ApplicationID // application serving the interface the application consumes; empty when it serves it or it is external
 }
 
 // InterfaceType represents the type of interface
@@ -0,0 +1,428 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// VendorID uniquely identifies a vendor
+type VendorID string
+
+// VendorStatus represents where a vendor stands in its relationship with the organization
+type VendorStatus string
+
+const (
+	VendorOnboarding VendorStatus = "onboarding"
+	VendorActive     VendorStatus = "active"
+	VendorOffboarded VendorStatus = "offboarded"
+)
+
+// Vendor is a third party supplying, hosting or operating applications for the organization.
+// Outsourcing an application does not outsource accountability for it, so the vendor's
+// contracts, service levels and due diligence are governed alongside the applications.
+type Vendor struct {
+	ID             VendorID
+	Name           string
+	Description    string
+	Services       string   // what the vendor provides, e.g. "SaaS ERP hosting"
+	Criticality    Priority // how much the organization depends on the vendor; unset counts as medium
+	Status         VendorStatus
+	Applications   []ApplicationID // applications the vendor supplies, hosts or operates
+	Contracts      []VendorContract
+	SLAs           []VendorSLA
+	Questionnaires []VendorQuestionnaire
+	RiskRating     VendorRiskRating // zero until the vendor is first rated
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// VendorContract is a contract with a vendor
+type VendorContract struct {
+	ID             string
+	Title          string
+	StartsAt       time.Time
+	EndsAt         time.Time // zero when the contract runs until terminated
+	AnnualValue    float64
+	DataProcessing bool // the vendor processes personal data under the contract
+}
+
+// InForce reports whether the contract applies at a time
+func (c VendorContract) InForce(at time.Time) bool {
+	return !at.Before(c.StartsAt) && (c.EndsAt.IsZero() || at.Before(c.EndsAt))
+}
+
+// VendorSLA is a service level a vendor committed to, with the latest value observed
+type VendorSLA struct {
+	Name          string  // e.g. "availability" or "incident response hours"
+	Target        float64 // committed value
+	LowerIsBetter bool    // the target is a maximum, such as a response time, rather than a minimum
+	Observed      float64
+	ObservedAt    time.Time // zero until the service level is first observed
+}
+
+// Breached reports whether the latest observed value misses the target
+func (s VendorSLA) Breached() bool {
+	if s.ObservedAt.IsZero() {
+		return false
+	}
+	if s.LowerIsBetter {
+		return s.Observed > s.Target
+	}
+	return s.Observed < s.Target
+}
+
+// VendorQuestionArea groups due diligence questions
+type VendorQuestionArea string
+
+const (
+	VendorAreaSecurity   VendorQuestionArea = "security"
+	VendorAreaPrivacy    VendorQuestionArea = "privacy"
+	VendorAreaContinuity VendorQuestionArea = "continuity"
+	VendorAreaCompliance VendorQuestionArea = "compliance"
+	VendorAreaFinancial  VendorQuestionArea = "financial"
+)
+
+// VendorAnswer is a vendor's answer to a due diligence question
+type VendorAnswer string
+
+const (
+	VendorAnswerYes           VendorAnswer = "yes"
+	VendorAnswerPartial       VendorAnswer = "partial"
+	VendorAnswerNo            VendorAnswer = "no"
+	VendorAnswerNotApplicable VendorAnswer = "not_applicable"
+)
+
+// Validate ensures the answer is known
+func (a VendorAnswer) Validate() error {
+	switch a {
+	case VendorAnswerYes, VendorAnswerPartial, VendorAnswerNo, VendorAnswerNotApplicable:
+		return nil
+	}
+	return fmt.Errorf("unknown vendor answer %q", a)
+}
+
+// VendorQuestion is a due diligence question put to a vendor
+type VendorQuestion struct {
+	ID     string
+	Area   VendorQuestionArea
+	Text   string
+	Weight float64 // unset counts as 1
+	Answer VendorAnswer
+	Notes  string
+}
+
+// VendorQuestionnaire is a due diligence questionnaire sent to a vendor
+type VendorQuestionnaire struct {
+	ID          string
+	Questions   []VendorQuestion
+	SentAt      time.Time
+	CompletedAt time.Time // zero while the vendor has not completed it
+}
+
+// Completed reports whether the vendor completed the questionnaire
+func (q VendorQuestionnaire) Completed() bool {
+	return !q.CompletedAt.IsZero()
+}
+
+// Score returns the weighted share of questions answered yes, as a percentage, with partial
+// answers counting half. Questions not applicable or not answered are left out.
+func (q VendorQuestionnaire) Score() float64 {
+	var earned, possible float64
+	for _, question := range q.Questions {
+		weight := question.Weight
+		if weight == 0 {
+			weight = 1
+		}
+		switch question.Answer {
+		case VendorAnswerYes:
+			earned += weight
+		case VendorAnswerPartial:
+			earned += weight / 2
+		case VendorAnswerNo:
+		default:
+			continue
+		}
+		possible += weight
+	}
+	if possible == 0 {
+		return 0
+	}
+	return earned / possible * 100
+}
+
+// DefaultVendorQuestions returns the standard due diligence questions sent to vendors
+func DefaultVendorQuestions() []VendorQuestion {
+	return []VendorQuestion{
+		{ID: "sec-1", Area: VendorAreaSecurity, Text: "Is an information security management system certified, e.g. to ISO/IEC 27001?", Weight: 2},
+		{ID: "sec-2", Area: VendorAreaSecurity, Text: "Are independent penetration tests run at least yearly and findings remediated?", Weight: 1},
+		{ID: "sec-3", Area: VendorAreaSecurity, Text: "Are security incidents affecting our data notified within 72 hours?", Weight: 2},
+		{ID: "prv-1", Area: VendorAreaPrivacy, Text: "Is personal data processed only in approved regions under a data processing agreement?", Weight: 2},
+		{ID: "cnt-1", Area: VendorAreaContinuity, Text: "Are business continuity and disaster recovery plans tested at least yearly?", Weight: 2},
+		{ID: "cnt-2", Area: VendorAreaContinuity, Text: "Can our data be exported in a usable format when the contract ends?", Weight: 1},
+		{ID: "cmp-1", Area: VendorAreaCompliance, Text: "Is an independent assurance report, e.g. SOC 2 Type II, available yearly?", Weight: 1},
+		{ID: "fin-1", Area: VendorAreaFinancial, Text: "Are audited financial statements of the last two years available?", Weight: 1},
+	}
+}
+
+// Validate ensures the vendor has valid data
+func (v Vendor) Validate() error {
+	if v.ID == "" {
+		return errors.New("vendor ID cannot be empty")
+	}
+	if v.Name == "" {
+		return errors.New("vendor name cannot be empty")
+	}
+	for _, contract := range v.Contracts {
+		if contract.ID == "" {
+			return errors.New("vendor contract ID cannot be empty")
+		}
+		if !contract.EndsAt.IsZero() && contract.EndsAt.Before(contract.StartsAt) {
+			return fmt.Errorf("vendor contract %s must not end before it starts", contract.ID)
+		}
+		if contract.AnnualValue < 0 {
+			return fmt.Errorf("vendor contract %s annual value must not be negative", contract.ID)
+		}
+	}
+	for _, sla := range v.SLAs {
+		if sla.Name == "" {
+			return errors.New("vendor SLA name cannot be empty")
+		}
+	}
+	for _, questionnaire := range v.Questionnaires {
+		for _, question := range questionnaire.Questions {
+			if question.Weight < 0 {
+				return fmt.Errorf("vendor question %s weight must not be negative", question.ID)
+			}
+			if question.Answer != "" {
+				if err := question.Answer.Validate(); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Supplies reports whether the vendor supplies, hosts or operates the application
+func (v Vendor) Supplies(appID ApplicationID) bool {
+	for _, id := range v.Applications {
+		if id == appID {
+			return true
+		}
+	}
+	return false
+}
+
+// LatestCompletedQuestionnaire returns the questionnaire the vendor completed last
+func (v Vendor) LatestCompletedQuestionnaire() (VendorQuestionnaire, bool) {
+	var latest VendorQuestionnaire
+	for _, questionnaire := range v.Questionnaires {
+		if questionnaire.Completed() && questionnaire.CompletedAt.After(latest.CompletedAt) {
+			latest = questionnaire
+		}
+	}
+	return latest, latest.Completed()
+}
+
+// VendorRiskRating is how much risk the organization carries through a vendor
+type VendorRiskRating struct {
+	Level   RiskLevel
+	Score   float64  // from 0, no risk, to 100
+	Factors []string // what raised the score
+	RatedAt time.Time
+}
+
+// VendorRiskPolicy sets how vendor risk is rated
+type VendorRiskPolicy struct {
+	QuestionnaireMaxAge time.Duration // due diligence older than this no longer counts
+	ContractNoticeDays  int           // contracts ending within this many days are flagged
+}
+
+// DefaultVendorRiskPolicy asks for due diligence yearly and flags contracts ending within 90 days
+func DefaultVendorRiskPolicy() VendorRiskPolicy {
+	return VendorRiskPolicy{QuestionnaireMaxAge: 365 * 24 * time.Hour, ContractNoticeDays: 90}
+}
+
+// RateVendorRisk rates the risk of a vendor from how critical it is to the organization, its
+// latest due diligence questionnaire, its service level breaches and its contracts. Criticality
+// contributes up to 40 points; a questionnaire scoring below full marks up to 40, or 30 when
+// there is no recent one; each breached service level 10, up to 20; and having no contract in
+// force 15, or one ending soon 5. A score of 70 or more is critical, 50 high and 30 medium.
+func RateVendorRisk(vendor Vendor, policy VendorRiskPolicy, now time.Time) VendorRiskRating {
+	rating := VendorRiskRating{RatedAt: now}
+	add := func(points float64, factor string) {
+		rating.Score += points
+		rating.Factors = append(rating.Factors, factor)
+	}
+
+	criticality := vendor.Criticality
+	if criticality.Weight() == 0 {
+		criticality = PriorityMedium
+	}
+	add(10*criticality.Weight(), fmt.Sprintf("%s criticality", criticality))
+
+	questionnaire, completed := vendor.LatestCompletedQuestionnaire()
+	switch {
+	case !completed:
+		add(30, "no completed due diligence questionnaire")
+	case policy.QuestionnaireMaxAge > 0 && now.Sub(questionnaire.CompletedAt) > policy.QuestionnaireMaxAge:
+		add(30, fmt.Sprintf("due diligence questionnaire %s completed on %s is out of date", questionnaire.ID, questionnaire.CompletedAt.Format("2006-01-02")))
+	default:
+		if score := questionnaire.Score(); score < 100 {
+			add((100-score)*0.4, fmt.Sprintf("due diligence questionnaire %s scored %.0f%%", questionnaire.ID, score))
+		}
+	}
+
+	breaches := 0
+	for _, sla := range vendor.SLAs {
+		if sla.Breached() && breaches < 2 {
+			breaches++
+			add(10, fmt.Sprintf("%s of %g misses the target of %g", sla.Name, sla.Observed, sla.Target))
+		}
+	}
+
+	var inForce []VendorContract
+	for _, contract := range vendor.Contracts {
+		if contract.InForce(now) {
+			inForce = append(inForce, contract)
+		}
+	}
+	if len(inForce) == 0 {
+		add(15, "no contract in force")
+	} else if policy.ContractNoticeDays > 0 {
+		notice := now.AddDate(0, 0, policy.ContractNoticeDays)
+		ending := true
+		for _, contract := range inForce {
+			if contract.EndsAt.IsZero() || contract.EndsAt.After(notice) {
+				ending = false
+			}
+		}
+		if ending {
+			sort.Slice(inForce, func(i, j int) bool { return inForce[i].EndsAt.After(inForce[j].EndsAt) })
+			add(5, fmt.Sprintf("contract %s ends on %s", inForce[0].ID, inForce[0].EndsAt.Format("2006-01-02")))
+		}
+	}
+
+	if rating.Score > 100 {
+		rating.Score = 100
+	}
+	switch {
+	case rating.Score >= 70:
+		rating.Level = RiskCritical
+	case rating.Score >= 50:
+		rating.Level = RiskHigh
+	case rating.Score >= 30:
+		rating.Level = RiskMedium
+	default:
+		rating.Level = RiskLow
+	}
+	return rating
+}
+
+// VendorExposure is the risk an application carries through one of its vendors
+type VendorExposure struct {
+	VendorID    VendorID
+	Name        string
+	Criticality Priority
+	RiskLevel   RiskLevel
+	Factors     []string
+}
+
+// vendorExposures lists the risk rating of each active vendor of an application, riskiest first
+func vendorExposures(vendors []Vendor) []VendorExposure {
+	var exposures []VendorExposure
+	for _, vendor := range vendors {
+		if vendor.Status == VendorOffboarded || vendor.RiskRating.Level == "" {
+			continue
+		}
+		exposures = append(exposures, VendorExposure{
+			VendorID:    vendor.ID,
+			Name:        vendor.Name,
+			Criticality: vendor.Criticality,
+			RiskLevel:   vendor.RiskRating.Level,
+			Factors:     vendor.RiskRating.Factors,
+		})
+	}
+	sort.SliceStable(exposures, func(i, j int) bool {
+		return riskScore(exposures[i].RiskLevel) > riskScore(exposures[j].RiskLevel)
+	})
+	return exposures
+}
+
+// escalateForVendors raises a risk level to that of the riskiest vendor the application depends
+// on highly or critically
+func escalateForVendors(level RiskLevel, exposures []VendorExposure) RiskLevel {
+	for _, exposure := range exposures {
+		if exposure.Criticality.Weight() >= PriorityHigh.Weight() && riskScore(exposure.RiskLevel) > riskScore(level) {
+			level = exposure.RiskLevel
+		}
+	}
+	return level
+}
+
+// vendorRecommendations asks for the risk of vendors rated high or critical to be reduced
+func vendorRecommendations(exposures []VendorExposure) []Recommendation {
+	var recommendations []Recommendation
+	for _, exposure := range exposures {
+		if riskScore(exposure.RiskLevel) < riskScore(RiskHigh) {
+			continue
+		}
+		priority := PriorityHigh
+		if exposure.RiskLevel == RiskCritical {
+			priority = PriorityCritical
+		}
+		recommendations = append(recommendations, Recommendation{
+			ID:             fmt.Sprintf("vnd-%03d", len(recommendations)+1),
+			Type:           RecEnhance,
+			Description:    fmt.Sprintf("Reduce the %s risk carried through vendor %s", exposure.RiskLevel, exposure.Name),
+			Priority:       priority,
+			BusinessImpact: "Keep outsourced services secure, available and under contract",
+		})
+	}
+	return recommendations
+}
+
+// vendorMonitorPlugin contributes the risk ratings of an application's vendors to its monitoring
+type vendorMonitorPlugin struct {
+	vendorRepo VendorRepository
+	policy     VendorRiskPolicy
+}
+
+// NewVendorMonitorPlugin returns a monitor plugin rating each vendor of a monitored application
+// as a risk indicator named "vendor risk: <vendor name>", with a threshold of 50, the score rated
+// high. A high rating is a warning and a critical one critical.
+func NewVendorMonitorPlugin(vendorRepo VendorRepository, policy VendorRiskPolicy) MonitorPlugin {
+	return &vendorMonitorPlugin{vendorRepo: vendorRepo, policy: policy}
+}
+
+func (p *vendorMonitorPlugin) Name() string {
+	return "vendors"
+}
+
+func (p *vendorMonitorPlugin) Collect(ctx context.Context, app Application) (PluginCollection, error) {
+	vendors, err := p.vendorRepo.FindByApplicationID(ctx, app.ID)
+	if err != nil {
+		return PluginCollection{}, fmt.Errorf("failed to load vendors: %w", err)
+	}
+
+	var collection PluginCollection
+	now := time.Now()
+	for _, vendor := range vendors {
+		if vendor.Status == VendorOffboarded {
+			continue
+		}
+		rating := RateVendorRisk(vendor, p.policy, now)
+		indicator := RiskIndicator{Name: "vendor risk: " + vendor.Name, Value: rating.Score, Threshold: 50, Status: RiskStatusNormal}
+		switch rating.Level {
+		case RiskCritical:
+			indicator.Status = RiskStatusCritical
+		case RiskHigh:
+			indicator.Status = RiskStatusWarning
+		}
+		collection.RiskIndicators = append(collection.RiskIndicators, indicator)
+	}
+	return collection, nil
+}
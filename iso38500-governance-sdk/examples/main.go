@@ -13,16 +13,18 @@
  * Commercial licensing available upon request.
  */
 
+
 package main
 
 import (
 	"context"
 	"fmt"
 	"log"
+	"os"
 	"strings"
-	"time"
 
 	"github.com/iso38500/iso38500-governance-sdk/application"
+	"github.com/iso38500/iso38500-governance-sdk/demo"
 	"github.com/iso38500/iso38500-governance-sdk/domain"
 	"github.com/iso38500/iso38500-governance-sdk/infrastructure/memory"
 )
@@ -36,458 +38,83 @@ func main() {
 	govRepo := memory.NewGovernanceAgreementRepositoryMemory()
 	portfolioRepo := memory.NewApplicationPortfolioRepositoryMemory()
 	eventRepo := memory.NewDomainEventRepositoryMemory()
+	assessmentRepo := memory.NewAssessmentRepositoryMemory()
+	kpiRepo := memory.NewKPIRepositoryMemory()
+	kpiMeasurementRepo := memory.NewKPIMeasurementRepositoryMemory()
+	availabilityRepo := memory.NewAvailabilityMeasurementRepositoryMemory()
 
 	// Initialize domain services
-	evalService := domain.NewEvaluationService(appRepo, govRepo, portfolioRepo, nil, nil)
+	evalService := domain.NewEvaluationService(appRepo, govRepo, portfolioRepo, nil, nil, domain.WithAssessmentRepository(assessmentRepo), domain.WithBaselineProfile(domain.IndustryBaselineProfile()), domain.WithEvaluationTemplates(domain.StandardEvaluationTemplates()), domain.WithRiskSimulation(domain.DefaultRiskSimulationOptions()))
 	directService := domain.NewDirectionService(govRepo)
-	monitorService := domain.NewMonitoringService(nil, nil, nil, govRepo)
+	monitorService := domain.NewMonitoringService(kpiRepo, kpiMeasurementRepo, nil, govRepo, domain.WithPortfolioThresholds(portfolioRepo), domain.WithMonitoringHistory(memory.NewMonitoringSnapshotRepositoryMemory()), domain.WithErrorBudgets(appRepo, availabilityRepo))
 
 	// Initialize application services
 	portfolioService := application.NewPortfolioService(portfolioRepo, appRepo, govRepo, eventRepo)
 	governanceService := application.NewGovernanceService(govRepo, appRepo, eventRepo, evalService, directService, monitorService)
+	decisionService := application.NewDecisionService(memory.NewDecisionRepositoryMemory(), govRepo, appRepo, eventRepo)
+	kpiService := application.NewKPIService(kpiRepo, kpiMeasurementRepo, eventRepo)
+
+	// Route critical alerts to the on-call channel and everything else to the governance channel
+	notifier, err := domain.NewNotificationRouter([]domain.NotificationRoute{
+		{Name: "on-call", Channel: "on-call", Kinds: []domain.NotificationKind{domain.NotificationAlert}, MinSeverity: domain.NotificationCritical},
+		{Name: "governance", Channel: "governance"},
+	}, map[string]domain.Notifier{
+		"on-call":    consoleNotifier{channel: "on-call"},
+		"governance": consoleNotifier{channel: "governance"},
+	})
+	if err != nil {
+		log.Fatalf("Failed to route notifications: %v", err)
+	}
+	notificationService := application.NewNotificationService(governanceService, govRepo, portfolioRepo, nil, notifier)
 
 	ctx := context.Background()
 
 	// Demo workflow
-	demoWorkflow(ctx, portfolioService, governanceService, appRepo, govRepo)
+	result, err := demo.Run(ctx, demo.Environment{
+		AppRepo:             appRepo,
+		PortfolioService:    portfolioService,
+		GovernanceService:   governanceService,
+		DecisionService:     decisionService,
+		KPIService:          kpiService,
+		NotificationService: notificationService,
+		MonitoringRunner:    application.NewMonitoringRunner(governanceService, govRepo, memory.NewMonitoringRunRepositoryMemory(), eventRepo),
+		ServiceLevelService: application.NewServiceLevelService(availabilityRepo, appRepo, eventRepo),
+	}, os.Stdout)
+	if err != nil {
+		log.Fatalf("Enterprise demo failed: %v", err)
+	}
+
+	printSummary(result)
 }
 
-func demoWorkflow(
-	ctx context.Context,
-	portfolioService *application.PortfolioService,
-	governanceService *application.GovernanceService,
-	appRepo *memory.ApplicationRepositoryMemory,
-	govRepo *memory.GovernanceAgreementRepositoryMemory,
-) {
-	fmt.Println("\n1. Enterprise Application Portfolio Setup")
-	fmt.Println("=========================================")
-
-	// Create comprehensive enterprise application portfolio
-	applications := createEnterpriseApplications()
-	for _, app := range applications {
-		appRepo.Save(ctx, app)
-		fmt.Printf("✓ Created %s: %s (%s)\n", string(app.ID), app.Name, app.Status)
-	}
-
-	fmt.Printf("\n   Portfolio Overview:\n")
-	fmt.Printf("   • Core Business Systems: %d applications\n", countByCategory(applications, "Core Business"))
-	fmt.Printf("   • Operational Systems: %d applications\n", countByCategory(applications, "Operational"))
-	fmt.Printf("   • Infrastructure Systems: %d applications\n", countByCategory(applications, "Infrastructure"))
-	fmt.Printf("   • Analytical Systems: %d applications\n", countByCategory(applications, "Analytics"))
-	fmt.Printf("   • Total Applications: %d\n", len(applications))
-
-	fmt.Println("\n2. Enterprise Governance Framework")
-	fmt.Println("================================")
-
-	// Create comprehensive governance agreements for all critical applications
-	coreApps := []string{
-		"erp-core-001", "crm-global-001", "scm-supply-001", "hr-talent-001", "finance-budget-001",
-		"infra-monitoring-001", "security-siem-001", "backup-enterprise-001",
-		"analytics-bi-001", "data-warehouse-001", "reporting-executive-001",
-		"legacy-hr-001", "legacy-finance-001", "procure-source-001",
-	}
-		governanceAgreements := make(map[domain.ApplicationID]*domain.GovernanceAgreement)
-
-	fmt.Println("\n   Creating Governance Agreements for Core Systems:")
-	for _, appIDStr := range coreApps {
-		appID := domain.ApplicationID(appIDStr)
-		app, _ := appRepo.FindByID(ctx, appID)
-
-		govCmd := application.CreateGovernanceAgreementCommand{
-			ID:            domain.GovernanceAgreementID("gov-" + appIDStr),
-			ApplicationID: appID,
-			Title:         fmt.Sprintf("Enterprise Governance Agreement for %s", app.Name),
-		}
-
-		agreement, err := governanceService.CreateGovernanceAgreement(ctx, govCmd)
-		if err != nil {
-			log.Fatalf("Failed to create governance agreement for %s: %v", app.Name, err)
-		}
-
-		governanceAgreements[appID] = agreement
-		fmt.Printf("   ✓ %s: %s\n", string(appID), agreement.Title)
-	}
-
-	fmt.Printf("\n   Total Governance Agreements Created: %d\n", len(governanceAgreements))
-
-	fmt.Println("\n3. Multi-Portfolio Enterprise Structure")
-	fmt.Println("=====================================")
-
-	// Create multiple portfolios for different business domains
-	portfolios := map[string]struct {
-		name        string
-		description string
-		owner       string
-		applications []string
-	}{
-		"portfolio-core-business": {
-			name:        "Core Business Systems Portfolio",
-			description: "Mission-critical business applications supporting core operations",
-			owner:       "Chief Information Officer",
-			applications: []string{"erp-core-001", "crm-global-001", "scm-supply-001"},
-		},
-		"portfolio-hr-finance": {
-			name:        "HR & Finance Systems Portfolio",
-			description: "Human resources and financial management applications",
-			owner:       "Chief Financial Officer",
-			applications: []string{"hr-talent-001", "finance-budget-001"},
-		},
-		"portfolio-infrastructure": {
-			name:        "IT Infrastructure Portfolio",
-			description: "Core IT infrastructure and security systems",
-			owner:       "Chief Technology Officer",
-			applications: []string{"infra-monitoring-001", "security-siem-001", "backup-enterprise-001"},
-		},
-		"portfolio-analytics": {
-			name:        "Business Intelligence Portfolio",
-			description: "Data analytics and business intelligence platforms",
-			owner:       "Chief Data Officer",
-			applications: []string{"analytics-bi-001", "data-warehouse-001", "reporting-executive-001"},
-		},
-		"portfolio-legacy-migration": {
-			name:        "Legacy System Migration Portfolio",
-			description: "Applications targeted for modernization or retirement",
-			owner:       "IT Transformation Director",
-			applications: []string{"legacy-hr-001", "legacy-finance-001", "procure-source-001"},
-		},
-	}
-
-	createdPortfolios := make(map[domain.PortfolioID]*domain.ApplicationPortfolio)
-
-	fmt.Println("\n   Creating Business Domain Portfolios:")
-	for portfolioIDStr, portfolioData := range portfolios {
-		portfolioID := domain.PortfolioID(portfolioIDStr)
-		portfolioCmd := application.CreatePortfolioCommand{
-			ID:          portfolioID,
-			Name:        portfolioData.name,
-			Description: portfolioData.description,
-			Owner:       portfolioData.owner,
-		}
-
-		portfolio, err := portfolioService.CreatePortfolio(ctx, portfolioCmd)
-		if err != nil {
-			log.Fatalf("Failed to create portfolio %s: %v", portfolioID, err)
-		}
-
-		createdPortfolios[portfolioID] = portfolio
-		fmt.Printf("   ✓ %s: %s (%d applications)\n", portfolioIDStr, portfolio.Name, len(portfolioData.applications))
-	}
-
-	fmt.Printf("\n   Portfolio Summary:\n")
-	fmt.Printf("   • Core Business Portfolio: %d applications\n", len(portfolios["portfolio-core-business"].applications))
-	fmt.Printf("   • HR & Finance Portfolio: %d applications\n", len(portfolios["portfolio-hr-finance"].applications))
-	fmt.Printf("   • Infrastructure Portfolio: %d applications\n", len(portfolios["portfolio-infrastructure"].applications))
-	fmt.Printf("   • Analytics Portfolio: %d applications\n", len(portfolios["portfolio-analytics"].applications))
-	fmt.Printf("   • Legacy Migration Portfolio: %d applications\n", len(portfolios["portfolio-legacy-migration"].applications))
-	fmt.Printf("   • Total Portfolios: %d\n", len(portfolios))
-
-	fmt.Println("\n4. Portfolio Population & Governance Assignment")
-	fmt.Println("==============================================")
-
-	// Populate portfolios with applications and assign governance
-	totalAssignments := 0
-	for portfolioID, portfolio := range createdPortfolios {
-		portfolioIDStr := string(portfolioID)
-		portfolioData := portfolios[portfolioIDStr]
-
-		fmt.Printf("\n   Populating %s:\n", portfolio.Name)
-		for _, appIDStr := range portfolioData.applications {
-			appID := domain.ApplicationID(appIDStr)
-			// Add application to portfolio
-			addCmd := application.AddApplicationToPortfolioCommand{
-				PortfolioID:   portfolioID,
-				ApplicationID: appID,
-			}
-
-			err := portfolioService.AddApplicationToPortfolio(ctx, addCmd)
-			if err != nil {
-				log.Fatalf("Failed to add application %s to portfolio %s: %v", string(appID), string(portfolioID), err)
-			}
-
-			// Check if governance agreement exists for this application
-			if _, exists := governanceAgreements[appID]; exists {
-				fmt.Printf("   ✓ %s (with governance)\n", string(appID))
-				totalAssignments++
-			} else {
-				fmt.Printf("   ✓ %s\n", string(appID))
-			}
-		}
-	}
-
-	fmt.Printf("\n   Governance Assignment Summary:\n")
-	fmt.Printf("   • Applications with governance agreements: %d\n", len(governanceAgreements))
-	fmt.Printf("   • Total portfolio assignments: %d\n", totalAssignments)
-
-	// Update portfolio population to use correct types
-	fmt.Println("\n   Populating portfolios with applications:")
-	for portfolioIDStr, portfolioData := range portfolios {
-		fmt.Printf("   • %s: %d applications\n", portfolioIDStr, len(portfolioData.applications))
-	}
-
-	fmt.Println("\n5. Enterprise Governance Strategy & Compliance")
-	fmt.Println("=============================================")
-
-	// Update governance strategies for all core applications
-	fmt.Println("\n   Configuring Governance Strategies:")
-	for appID, agreement := range governanceAgreements {
-		app, _ := appRepo.FindByID(ctx, appID)
-
-		// Create comprehensive strategy based on application type
-		strategy := createApplicationStrategy(string(appID), app)
-
-		updateStrategyCmd := application.UpdateStrategyCommand{
-			AgreementID: agreement.ID,
-			Strategy:    strategy,
-		}
-
-		err := governanceService.UpdateStrategy(ctx, updateStrategyCmd)
-		if err != nil {
-			log.Fatalf("Failed to update strategy for %s: %v", appID, err)
-		}
-
-		fmt.Printf("   ✓ %s: %d functionalities configured\n", string(appID), len(strategy.ApplicationCatalogue.Functionality))
-	}
-
-	fmt.Printf("\n   Strategy Configuration Complete: %d applications\n", len(governanceAgreements))
-
-	fmt.Println("\n6. Governance Lifecycle Management")
-	fmt.Println("=================================")
-
-	// Approve and activate all governance agreements
-	fmt.Println("\n   Approving Governance Agreements:")
-	for appID, agreement := range governanceAgreements {
-		approveCmd := application.ApproveGovernanceAgreementCommand{
-			AgreementID: agreement.ID,
-		}
-
-		err := governanceService.ApproveGovernanceAgreement(ctx, approveCmd)
-		if err != nil {
-			log.Fatalf("Failed to approve agreement for %s: %v", appID, err)
-		}
-
-		fmt.Printf("   ✓ Approved: %s\n", appID)
-	}
-
-	fmt.Println("\n   Activating Governance Agreements:")
-	for appID, agreement := range governanceAgreements {
-		activateCmd := application.ActivateGovernanceAgreementCommand{
-			AgreementID: agreement.ID,
-		}
-
-		err := governanceService.ActivateGovernanceAgreement(ctx, activateCmd)
-		if err != nil {
-			log.Fatalf("Failed to activate agreement for %s: %v", appID, err)
-		}
-
-		fmt.Printf("   ✓ Activated: %s\n", appID)
-	}
-
-	fmt.Printf("\n   Governance Lifecycle Complete: %d agreements active\n", len(governanceAgreements))
-
-	fmt.Println("\n7. Enterprise-Wide Application Evaluation")
-	fmt.Println("=========================================")
-
-	// Evaluate all critical applications
-	fmt.Println("\n   Evaluating Core Business Applications:")
-	evaluationResults := make(map[domain.ApplicationID]*domain.ApplicationAssessment)
-
-	for appID := range governanceAgreements {
-		evalCmd := application.EvaluateApplicationCommand{
-			ApplicationID: appID,
-			Evaluator:     "Enterprise IT Governance Board",
-		}
-
-		assessment, err := governanceService.EvaluateApplication(ctx, evalCmd)
-		if err != nil {
-			log.Fatalf("Failed to evaluate application %s: %v", string(appID), err)
-		}
-
-		evaluationResults[appID] = assessment
-
-		riskEmoji := "✅"
-		if assessment.RiskLevel == domain.RiskHigh {
-			riskEmoji = "⚠️"
-		} else if assessment.RiskLevel == domain.RiskCritical {
-			riskEmoji = "🚨"
-		}
-
-		fmt.Printf("   ✓ %s: Risk=%s, Health=%d/5, Value=%.0f%%, Recs=%d %s\n",
-			string(appID), assessment.RiskLevel, assessment.TechnicalHealth.CodeQuality,
-			assessment.BusinessValue.UserSatisfaction, len(assessment.Recommendations), riskEmoji)
-	}
-
-	// Portfolio-level evaluation
-	fmt.Println("\n   Portfolio-Level Risk Assessment:")
-	for portfolioID := range createdPortfolios {
-		evalPortfolioCmd := application.EvaluatePortfolioCommand{
-			PortfolioID: portfolioID,
-		}
-
-		portfolioAssessment, err := governanceService.EvaluatePortfolio(ctx, evalPortfolioCmd)
-		if err != nil {
-			log.Fatalf("Failed to evaluate portfolio %s: %v", string(portfolioID), err)
-		}
-
-		fmt.Printf("   ✓ %s: %d apps, %d active, %d deprecated, %d risks\n",
-			string(portfolioID), portfolioAssessment.TotalApplications,
-			portfolioAssessment.ActiveApplications, portfolioAssessment.DeprecatedApplications,
-			len(portfolioAssessment.RiskDistribution))
-	}
-
-	fmt.Printf("\n   Enterprise Evaluation Complete: %d applications assessed\n", len(evaluationResults))
-
-	fmt.Println("\n8. Enterprise Strategic Direction & Objectives")
-	fmt.Println("=============================================")
-
-	// Set strategic direction for key applications
-	fmt.Println("\n   Establishing Enterprise Objectives:")
-	strategicObjectives := map[string][]domain.StrategicObjective{
-		"erp-core-001": {
-			{
-				ID:          "erp-digital-transformation",
-				Name:        "Digital Transformation of Core ERP",
-				Description: "Modernize ERP system with cloud capabilities and AI-driven insights",
-				Deadline:    time.Now().AddDate(2, 0, 0),
-			},
-		},
-		"hr-talent-001": {
-			{
-				ID:          "hr-employee-experience",
-				Name:        "Enhance Employee Experience",
-				Description: "Implement modern HR technologies for better employee engagement",
-				Deadline:    time.Now().AddDate(1, 6, 0),
-			},
-		},
-		"analytics-bi-001": {
-			{
-				ID:          "analytics-ai-ml",
-				Name:        "AI/ML-Driven Business Intelligence",
-				Description: "Implement predictive analytics and machine learning capabilities",
-				Deadline:    time.Now().AddDate(1, 0, 0),
-			},
-		},
-	}
-
-	strategicInitiatives := map[string][]domain.StrategicInitiative{
-		"erp-core-001": {
-			{
-				ID:          "erp-cloud-migration",
-				Name:        "ERP Cloud Migration",
-				Description: "Migrate ERP to cloud infrastructure",
-				Owner:       "ERP Transformation Team",
-				Budget:      2000000,
-				Deadline:    time.Now().AddDate(1, 0, 0),
-			},
-		},
-		"hr-talent-001": {
-			{
-				ID:          "hr-mobile-app",
-				Name:        "Employee Mobile App",
-				Description: "Develop mobile app for employee self-service",
-				Owner:       "HR Technology Team",
-				Budget:      750000,
-				Deadline:    time.Now().AddDate(0, 9, 0),
-			},
-		},
-	}
-
-	totalObjectives := 0
-	totalInitiatives := 0
-
-	for appIDStr, objectives := range strategicObjectives {
-		appID := domain.ApplicationID(appIDStr)
-		if agreement, exists := governanceAgreements[appID]; exists {
-			directionCmd := application.SetStrategicDirectionCommand{
-				AgreementID: agreement.ID,
-				Director:    "Enterprise Architecture Board",
-				Objectives:  objectives,
-				Initiatives: strategicInitiatives[appIDStr],
-			}
-
-			err := governanceService.SetStrategicDirection(ctx, directionCmd)
-			if err != nil {
-				log.Fatalf("Failed to set strategic direction for %s: %v", appIDStr, err)
-			}
-
-			totalObjectives += len(objectives)
-			totalInitiatives += len(strategicInitiatives[appIDStr])
-			fmt.Printf("   ✓ %s: %d objectives, %d initiatives\n", appIDStr, len(objectives), len(strategicInitiatives[appIDStr]))
-		}
-	}
-
-	fmt.Printf("\n   Strategic Direction Summary:\n")
-	fmt.Printf("   • Strategic Objectives: %d\n", totalObjectives)
-	fmt.Printf("   • Strategic Initiatives: %d\n", totalInitiatives)
-	fmt.Printf("   • Applications with Direction: %d\n", len(strategicObjectives))
-
-	fmt.Println("\n9. Enterprise Governance Monitoring")
-	fmt.Println("==================================")
-
-	// Monitor governance across all critical applications
-	fmt.Println("\n   Comprehensive Governance Monitoring:")
-	totalKPIs := 0
-	totalRisks := 0
-
-	for appIDStr, agreement := range governanceAgreements {
-		monitorCmd := application.MonitorGovernanceCommand{
-			AgreementID: agreement.ID,
-		}
-
-		monitoringResult, err := governanceService.MonitorGovernance(ctx, monitorCmd)
-		if err != nil {
-			log.Fatalf("Failed to monitor governance for %s: %v", appIDStr, err)
-		}
-
-		fmt.Printf("\n   📊 %s Governance Status:\n", appIDStr)
-
-		// Display KPI results
-		fmt.Printf("      KPIs (%d):\n", len(monitoringResult.KPIMeasurements))
-		for i, kpi := range monitoringResult.KPIMeasurements {
-			status := "❌ Not Achieved"
-			if kpi.Achieved {
-				status = "✅ Achieved"
-			}
-			fmt.Printf("        %d. %s: %.1f/%.1f %s\n", i+1, kpi.KPIID, kpi.Value, kpi.Target, status)
-		}
-
-		// Display risk results
-		fmt.Printf("      Risks (%d):\n", len(monitoringResult.RiskStatus.RiskIndicators))
-		for i, risk := range monitoringResult.RiskStatus.RiskIndicators {
-			statusEmoji := "✅"
-			if risk.Status == domain.RiskStatusWarning {
-				statusEmoji = "⚠️"
-			} else if risk.Status == domain.RiskStatusCritical {
-				statusEmoji = "🚨"
-			}
-			fmt.Printf("        %d. %s: %.1f (threshold: %.1f) %s %s\n",
-				i+1, risk.Name, risk.Value, risk.Threshold, statusEmoji, risk.Status)
-		}
-
-		totalKPIs += len(monitoringResult.KPIMeasurements)
-		totalRisks += len(monitoringResult.RiskStatus.RiskIndicators)
-	}
+// consoleNotifier prints notifications in place of a Slack or email channel
+type consoleNotifier struct {
+	channel string
+}
 
-	fmt.Printf("\n   Enterprise Monitoring Summary:\n")
-	fmt.Printf("   • Applications Monitored: %d\n", len(governanceAgreements))
-	fmt.Printf("   • Total KPIs Tracked: %d\n", totalKPIs)
-	fmt.Printf("   • Total Risk Indicators: %d\n", totalRisks)
-	fmt.Printf("   • Governance Coverage: %.1f%%\n", float64(len(governanceAgreements))/15.0*100)
+func (n consoleNotifier) Notify(ctx context.Context, notification domain.Notification) error {
+	fmt.Printf("   📣 [%s] %s\n", n.channel, notification.Title)
+	return nil
+}
 
+// printSummary prints the closing summary of the demo run
+func printSummary(result *demo.Result) {
 	fmt.Println("\n🎉 Enterprise Governance Demo Completed Successfully!")
 	fmt.Println("=======================================================")
 
 	fmt.Println("\n📈 DEMONSTRATION SUMMARY:")
 	fmt.Println("=========================")
-	fmt.Println("✓ Enterprise Application Portfolio: 15 applications across 5 business domains")
-	fmt.Println("✓ Multi-Portfolio Structure: 5 specialized portfolios for different business units")
-	fmt.Println("✓ Comprehensive Governance Framework: 5 critical applications under governance")
-	fmt.Println("✓ Strategic Direction: 3 strategic objectives and 3 major initiatives")
-	fmt.Println("✓ Enterprise-Wide Monitoring: 10 KPIs and 10 risk indicators tracked")
+	fmt.Printf("✓ Enterprise Application Portfolio: %d applications\n", result.Applications)
+	fmt.Printf("✓ Multi-Portfolio Structure: %d specialized portfolios for different business units\n", result.Portfolios)
+	fmt.Printf("✓ Comprehensive Governance Framework: %d applications under active governance\n", result.ActiveAgreements)
+	fmt.Printf("✓ Risk Register: %d identified risks with simulated loss exposure\n", result.IdentifiedRisks)
+	fmt.Printf("✓ Strategic Direction: %d strategic objectives and %d major initiatives\n", result.Objectives, result.Initiatives)
+	fmt.Printf("✓ Enterprise-Wide Monitoring: %d KPIs and %d risk indicators tracked\n", result.KPIs, result.RiskIndicators)
 	fmt.Println("✓ ISO 38500 Compliance: Full EVALUATE → DIRECT → MONITOR lifecycle")
 
 	fmt.Println("\n🏛️  ISO 38500 GOVERNANCE PRINCIPLES DEMONSTRATED:")
 	fmt.Println("===================================================")
-	fmt.Println("• EVALUATE: Comprehensive assessment of 15 applications with risk analysis")
+	fmt.Printf("• EVALUATE: Comprehensive assessment of %d applications with risk analysis\n", result.Evaluations)
 	fmt.Println("• DIRECT: Strategic objectives and initiatives for digital transformation")
 	fmt.Println("• MONITOR: Real-time KPI tracking and risk monitoring across enterprise")
 
@@ -527,236 +154,3 @@ func demoWorkflow(
 	fmt.Println("🏆 ISO 38500 ENTERPRISE GOVERNANCE FRAMEWORK - IMPLEMENTATION COMPLETE")
 	fmt.Println(strings.Repeat("=", 70))
 }
-
-// createEnterpriseApplications creates a comprehensive set of enterprise applications
-func createEnterpriseApplications() []domain.Application {
-	now := time.Now()
-
-	return []domain.Application{
-		// Core Business Systems
-		{
-			ID:          "erp-core-001",
-			Name:        "Enterprise Resource Planning (ERP)",
-			Description: "Integrated enterprise resource planning system managing core business processes",
-			Version:     "2024.2.1",
-			Status:      domain.StatusActive,
-			CreatedAt:   now.AddDate(-3, 0, 0),
-			UpdatedAt:   now,
-			SecurityProvisions: domain.SecurityProvisions{
-				DataConfidentiality: []domain.SecurityMeasure{
-					{Name: "AES-256 Encryption", Description: "End-to-end data encryption", Status: domain.SecurityImplemented},
-				},
-				DataIntegrity: []domain.SecurityMeasure{
-					{Name: "Data Validation", Description: "Comprehensive data validation rules", Status: domain.SecurityImplemented},
-				},
-				ApplicationAvailability: domain.SLA{
-					ServiceName: "ERP Core Services",
-					ResponseTime: time.Second * 2,
-					Availability: 99.9,
-				},
-			},
-		},
-		{
-			ID:          "crm-global-001",
-			Name:        "Global Customer Relationship Management",
-			Description: "Unified CRM system for customer management across all business units",
-			Version:     "12.8.0",
-			Status:      domain.StatusActive,
-			CreatedAt:   now.AddDate(-2, 0, 0),
-			UpdatedAt:   now,
-		},
-		{
-			ID:          "scm-supply-001",
-			Name:        "Supply Chain Management",
-			Description: "End-to-end supply chain visibility and management platform",
-			Version:     "9.4.3",
-			Status:      domain.StatusActive,
-			CreatedAt:   now.AddDate(-1, -6, 0),
-			UpdatedAt:   now,
-		},
-
-		// Operational Systems
-		{
-			ID:          "hr-talent-001",
-			Name:        "Talent Management Suite",
-			Description: "Comprehensive HR and talent management platform",
-			Version:     "8.2.1",
-			Status:      domain.StatusActive,
-			CreatedAt:   now.AddDate(-1, 0, 0),
-			UpdatedAt:   now,
-		},
-		{
-			ID:          "finance-budget-001",
-			Name:        "Enterprise Budgeting & Forecasting",
-			Description: "Advanced financial planning and budgeting system",
-			Version:     "15.7.0",
-			Status:      domain.StatusActive,
-			CreatedAt:   now.AddDate(-2, -3, 0),
-			UpdatedAt:   now,
-		},
-		{
-			ID:          "procure-source-001",
-			Name:        "Strategic Sourcing Platform",
-			Description: "Supplier management and strategic procurement system",
-			Version:     "6.9.2",
-			Status:      domain.StatusDeprecated,
-			CreatedAt:   now.AddDate(-4, 0, 0),
-			UpdatedAt:   now,
-		},
-
-		// Infrastructure Systems
-		{
-			ID:          "infra-monitoring-001",
-			Name:        "Infrastructure Monitoring Platform",
-			Description: "Unified monitoring and alerting for all IT infrastructure",
-			Version:     "4.2.8",
-			Status:      domain.StatusActive,
-			CreatedAt:   now.AddDate(-1, -8, 0),
-			UpdatedAt:   now,
-		},
-		{
-			ID:          "security-siem-001",
-			Name:        "Security Information & Event Management",
-			Description: "Enterprise security monitoring and threat detection",
-			Version:     "3.1.5",
-			Status:      domain.StatusActive,
-			CreatedAt:   now.AddDate(-1, -2, 0),
-			UpdatedAt:   now,
-		},
-		{
-			ID:          "backup-enterprise-001",
-			Name:        "Enterprise Backup & Recovery",
-			Description: "Comprehensive data backup and disaster recovery platform",
-			Version:     "11.0.3",
-			Status:      domain.StatusActive,
-			CreatedAt:   now.AddDate(-2, -6, 0),
-			UpdatedAt:   now,
-		},
-
-		// Analytical Systems
-		{
-			ID:          "analytics-bi-001",
-			Name:        "Business Intelligence Platform",
-			Description: "Enterprise BI and analytics for decision support",
-			Version:     "7.4.1",
-			Status:      domain.StatusActive,
-			CreatedAt:   now.AddDate(-1, -4, 0),
-			UpdatedAt:   now,
-		},
-		{
-			ID:          "data-warehouse-001",
-			Name:        "Enterprise Data Warehouse",
-			Description: "Centralized data warehouse for enterprise analytics",
-			Version:     "5.8.9",
-			Status:      domain.StatusActive,
-			CreatedAt:   now.AddDate(-3, -2, 0),
-			UpdatedAt:   now,
-		},
-		{
-			ID:          "reporting-executive-001",
-			Name:        "Executive Dashboard & Reporting",
-			Description: "Executive-level dashboards and automated reporting",
-			Version:     "2.6.4",
-			Status:      domain.StatusPlanned,
-			CreatedAt:   now.AddDate(0, -1, 0),
-			UpdatedAt:   now,
-		},
-
-		// Legacy Systems (for migration scenarios)
-		{
-			ID:          "legacy-hr-001",
-			Name:        "Legacy HR System",
-			Description: "Outdated HR system scheduled for retirement",
-			Version:     "1.2.1",
-			Status:      domain.StatusDeprecated,
-			CreatedAt:   now.AddDate(-8, 0, 0),
-			UpdatedAt:   now,
-		},
-		{
-			ID:          "legacy-finance-001",
-			Name:        "Legacy Financial System",
-			Description: "Deprecated financial system with known vulnerabilities",
-			Version:     "3.1.0",
-			Status:      domain.StatusRetired,
-			CreatedAt:   now.AddDate(-6, 0, 0),
-			UpdatedAt:   now,
-		},
-	}
-}
-
-// countByCategory counts applications by category
-func countByCategory(apps []domain.Application, category string) int {
-	count := 0
-	for _, app := range apps {
-		if getCategoryFromID(string(app.ID)) == category {
-			count++
-		}
-	}
-	return count
-}
-
-// getCategoryFromID extracts category from application ID
-func getCategoryFromID(id string) string {
-	if id[:3] == "erp" || id[:3] == "crm" || id[:3] == "scm" {
-		return "Core Business"
-	}
-	if id[:2] == "hr" || id[:6] == "finance" || id[:8] == "procure" {
-		return "Operational"
-	}
-	if id[:5] == "infra" || id[:8] == "security" || id[:6] == "backup" {
-		return "Infrastructure"
-	}
-	if id[:8] == "analytics" || id[:4] == "data" || id[:9] == "reporting" {
-		return "Analytics"
-	}
-	return "Other"
-}
-
-// createApplicationStrategy creates a comprehensive governance strategy for an application
-func createApplicationStrategy(appID string, app domain.Application) domain.Strategy {
-	functionalities := []domain.Functionality{}
-
-	switch {
-	case appID[:3] == "erp":
-		functionalities = []domain.Functionality{
-			{ID: "erp-financial", Name: "Financial Management", Description: "Core financial operations", Category: "Finance", Priority: domain.PriorityCritical, Status: domain.FunctionalityAvailable},
-			{ID: "erp-inventory", Name: "Inventory Management", Description: "Stock and warehouse management", Category: "Operations", Priority: domain.PriorityHigh, Status: domain.FunctionalityAvailable},
-			{ID: "erp-procurement", Name: "Procurement", Description: "Supplier and purchase management", Category: "Procurement", Priority: domain.PriorityHigh, Status: domain.FunctionalityAvailable},
-		}
-	case appID[:3] == "crm":
-		functionalities = []domain.Functionality{
-			{ID: "crm-contacts", Name: "Contact Management", Description: "Customer and prospect database", Category: "CRM", Priority: domain.PriorityCritical, Status: domain.FunctionalityAvailable},
-			{ID: "crm-sales", Name: "Sales Pipeline", Description: "Sales opportunity tracking", Category: "Sales", Priority: domain.PriorityHigh, Status: domain.FunctionalityAvailable},
-			{ID: "crm-marketing", Name: "Marketing Automation", Description: "Campaign management", Category: "Marketing", Priority: domain.PriorityMedium, Status: domain.FunctionalityAvailable},
-		}
-	case appID[:2] == "hr":
-		functionalities = []domain.Functionality{
-			{ID: "hr-emp-mgmt", Name: "Employee Management", Description: "Core employee data management", Category: "Core HR", Priority: domain.PriorityHigh, Status: domain.FunctionalityAvailable},
-			{ID: "hr-payroll", Name: "Payroll Processing", Description: "Salary and compensation management", Category: "Payroll", Priority: domain.PriorityCritical, Status: domain.FunctionalityAvailable},
-			{ID: "hr-recruiting", Name: "Recruitment", Description: "Hiring and onboarding processes", Category: "Recruiting", Priority: domain.PriorityMedium, Status: domain.FunctionalityAvailable},
-		}
-	case appID[:6] == "finance":
-		functionalities = []domain.Functionality{
-			{ID: "finance-budgeting", Name: "Budget Planning", Description: "Annual budget creation and management", Category: "Budgeting", Priority: domain.PriorityHigh, Status: domain.FunctionalityAvailable},
-			{ID: "finance-forecasting", Name: "Financial Forecasting", Description: "Revenue and expense forecasting", Category: "Forecasting", Priority: domain.PriorityHigh, Status: domain.FunctionalityAvailable},
-			{ID: "finance-reporting", Name: "Financial Reporting", Description: "Regulatory and management reporting", Category: "Reporting", Priority: domain.PriorityCritical, Status: domain.FunctionalityAvailable},
-		}
-	case appID[:5] == "infra":
-		functionalities = []domain.Functionality{
-			{ID: "infra-monitoring", Name: "System Monitoring", Description: "Real-time system health monitoring", Category: "Monitoring", Priority: domain.PriorityCritical, Status: domain.FunctionalityAvailable},
-			{ID: "infra-alerting", Name: "Alert Management", Description: "Automated alerting and notifications", Category: "Alerting", Priority: domain.PriorityHigh, Status: domain.FunctionalityAvailable},
-			{ID: "infra-dashboards", Name: "Management Dashboards", Description: "Executive and operational dashboards", Category: "Reporting", Priority: domain.PriorityMedium, Status: domain.FunctionalityAvailable},
-		}
-	default:
-		functionalities = []domain.Functionality{
-			{ID: fmt.Sprintf("%s-core", appID[:8]), Name: "Core Functionality", Description: "Primary application features", Category: "Core", Priority: domain.PriorityHigh, Status: domain.FunctionalityAvailable},
-		}
-	}
-
-	return domain.Strategy{
-		ApplicationCatalogue: domain.ApplicationCatalogue{
-			Functionality: functionalities,
-			LastUpdated:   time.Now(),
-		},
-	}
-}
@@ -0,0 +1,210 @@
+// Package audittrail records every change made through the governance repositories in the
+// append-only, hash-chained audit trail. Each repository wraps another and records a change once
+// the wrapped repository has made it, as made by the actor in the context.
+package audittrail
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// recorder appends entries to the audit trail
+type recorder struct {
+	trail domain.AuditTrailRepository
+	now   func() time.Time
+}
+
+// record appends an entry for a change to an object, whose state after the change is state,
+// nil for deletes
+func (r recorder) record(ctx context.Context, entityType domain.AuditTrailEntityType, entityID string, action domain.AuditTrailAction, changes []string, state interface{}) error {
+	entry := domain.AuditTrailEntry{
+		EntityType: entityType,
+		EntityID:   entityID,
+		Action:     action,
+		Actor:      domain.AuditActorFromContext(ctx),
+		Changes:    changes,
+		RecordedAt: r.now(),
+	}
+	if state != nil {
+		hash, err := domain.AuditStateHash(state)
+		if err != nil {
+			return err
+		}
+		entry.StateHash = hash
+	}
+	if _, err := r.trail.Append(ctx, entry); err != nil {
+		return fmt.Errorf("failed to record %s %s %s in the audit trail: %w", entityType, entityID, action, err)
+	}
+	return nil
+}
+
+// governanceAgreementRepository records the changes to agreements and to their policies
+type governanceAgreementRepository struct {
+	domain.GovernanceAgreementRepository
+	recorder
+}
+
+// NewGovernanceAgreementRepository records every agreement saved, updated or deleted, and every
+// policy of an agreement added, changed or removed
+func NewGovernanceAgreementRepository(next domain.GovernanceAgreementRepository, trail domain.AuditTrailRepository) domain.GovernanceAgreementRepository {
+	return &governanceAgreementRepository{GovernanceAgreementRepository: next, recorder: recorder{trail: trail, now: time.Now}}
+}
+
+func (r *governanceAgreementRepository) Save(ctx context.Context, agreement domain.GovernanceAgreement) error {
+	if err := r.GovernanceAgreementRepository.Save(ctx, agreement); err != nil {
+		return err
+	}
+	if err := r.record(ctx, domain.AuditEntityAgreement, string(agreement.ID), domain.AuditActionCreated, nil, agreement); err != nil {
+		return err
+	}
+	return r.recordPolicies(ctx, agreement.ID, nil, agreement.Direct.PolicyFramework.Policies)
+}
+
+func (r *governanceAgreementRepository) Update(ctx context.Context, agreement domain.GovernanceAgreement) error {
+	// A missing agreement fails the update below, so the error is left to it
+	previous, findErr := r.GovernanceAgreementRepository.FindByID(ctx, agreement.ID)
+	if err := r.GovernanceAgreementRepository.Update(ctx, agreement); err != nil {
+		return err
+	}
+	if findErr != nil {
+		return r.record(ctx, domain.AuditEntityAgreement, string(agreement.ID), domain.AuditActionUpdated, nil, agreement)
+	}
+
+	changes := domain.AuditChangedFields(previous, agreement)
+	if len(changes) == 0 {
+		return nil
+	}
+	if err := r.record(ctx, domain.AuditEntityAgreement, string(agreement.ID), domain.AuditActionUpdated, changes, agreement); err != nil {
+		return err
+	}
+	return r.recordPolicies(ctx, agreement.ID, previous.Direct.PolicyFramework.Policies, agreement.Direct.PolicyFramework.Policies)
+}
+
+func (r *governanceAgreementRepository) Delete(ctx context.Context, id domain.GovernanceAgreementID) error {
+	previous, findErr := r.GovernanceAgreementRepository.FindByID(ctx, id)
+	if err := r.GovernanceAgreementRepository.Delete(ctx, id); err != nil {
+		return err
+	}
+	if err := r.record(ctx, domain.AuditEntityAgreement, string(id), domain.AuditActionDeleted, nil, nil); err != nil {
+		return err
+	}
+	if findErr != nil {
+		return nil
+	}
+	return r.recordPolicies(ctx, id, previous.Direct.PolicyFramework.Policies, nil)
+}
+
+// recordPolicies records the policies added, changed and removed between two versions of an
+// agreement's policy framework
+func (r *governanceAgreementRepository) recordPolicies(ctx context.Context, agreementID domain.GovernanceAgreementID, before, after []domain.Policy) error {
+	previous := make(map[string]domain.Policy, len(before))
+	for _, policy := range before {
+		previous[policy.ID] = policy
+	}
+	kept := make(map[string]bool, len(after))
+	for _, policy := range after {
+		kept[policy.ID] = true
+		id := string(agreementID) + "/" + policy.ID
+		old, ok := previous[policy.ID]
+		if !ok {
+			if err := r.record(ctx, domain.AuditEntityPolicy, id, domain.AuditActionCreated, nil, policy); err != nil {
+				return err
+			}
+			continue
+		}
+		if changes := domain.AuditChangedFields(old, policy); len(changes) > 0 {
+			if err := r.record(ctx, domain.AuditEntityPolicy, id, domain.AuditActionUpdated, changes, policy); err != nil {
+				return err
+			}
+		}
+	}
+	for _, policy := range before {
+		if !kept[policy.ID] {
+			if err := r.record(ctx, domain.AuditEntityPolicy, string(agreementID)+"/"+policy.ID, domain.AuditActionDeleted, nil, nil); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// changeRequestRepository records the changes to change requests
+type changeRequestRepository struct {
+	domain.ChangeRequestRepository
+	recorder
+}
+
+// NewChangeRequestRepository records every change request saved, updated or deleted
+func NewChangeRequestRepository(next domain.ChangeRequestRepository, trail domain.AuditTrailRepository) domain.ChangeRequestRepository {
+	return &changeRequestRepository{ChangeRequestRepository: next, recorder: recorder{trail: trail, now: time.Now}}
+}
+
+func (r *changeRequestRepository) Save(ctx context.Context, cr domain.ChangeRequest) error {
+	if err := r.ChangeRequestRepository.Save(ctx, cr); err != nil {
+		return err
+	}
+	return r.record(ctx, domain.AuditEntityChangeRequest, cr.ID, domain.AuditActionCreated, nil, cr)
+}
+
+func (r *changeRequestRepository) Update(ctx context.Context, cr domain.ChangeRequest) error {
+	previous, findErr := r.ChangeRequestRepository.FindByID(ctx, cr.ID)
+	if err := r.ChangeRequestRepository.Update(ctx, cr); err != nil {
+		return err
+	}
+	var changes []string
+	if findErr == nil {
+		if changes = domain.AuditChangedFields(previous, cr); len(changes) == 0 {
+			return nil
+		}
+	}
+	return r.record(ctx, domain.AuditEntityChangeRequest, cr.ID, domain.AuditActionUpdated, changes, cr)
+}
+
+func (r *changeRequestRepository) Delete(ctx context.Context, id string) error {
+	if err := r.ChangeRequestRepository.Delete(ctx, id); err != nil {
+		return err
+	}
+	return r.record(ctx, domain.AuditEntityChangeRequest, id, domain.AuditActionDeleted, nil, nil)
+}
+
+// assessmentRepository records the assessments saved and updated
+type assessmentRepository struct {
+	domain.AssessmentRepository
+	recorder
+}
+
+// NewAssessmentRepository records every assessment saved or updated
+func NewAssessmentRepository(next domain.AssessmentRepository, trail domain.AuditTrailRepository) domain.AssessmentRepository {
+	return &assessmentRepository{AssessmentRepository: next, recorder: recorder{trail: trail, now: time.Now}}
+}
+
+func (r *assessmentRepository) Save(ctx context.Context, assessment domain.ApplicationAssessment) error {
+	if err := r.AssessmentRepository.Save(ctx, assessment); err != nil {
+		return err
+	}
+	return r.record(ctx, domain.AuditEntityAssessment, assessment.ID, domain.AuditActionCreated, nil, assessment)
+}
+
+func (r *assessmentRepository) Update(ctx context.Context, assessment domain.ApplicationAssessment) error {
+	var previous *domain.ApplicationAssessment
+	if history, err := r.AssessmentRepository.FindByApplicationID(ctx, assessment.ApplicationID); err == nil {
+		for i := range history {
+			if history[i].ID == assessment.ID {
+				previous = &history[i]
+			}
+		}
+	}
+	if err := r.AssessmentRepository.Update(ctx, assessment); err != nil {
+		return err
+	}
+	var changes []string
+	if previous != nil {
+		if changes = domain.AuditChangedFields(*previous, assessment); len(changes) == 0 {
+			return nil
+		}
+	}
+	return r.record(ctx, domain.AuditEntityAssessment, assessment.ID, domain.AuditActionUpdated, changes, assessment)
+}
@@ -0,0 +1,399 @@
+// Package eventbus delivers the domain events services record to the handlers subscribed to
+// them, either as each event is saved or in the background through a bounded worker pool
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// AllEvents subscribes a handler to every event type
+const AllEvents = "*"
+
+// Handler handles a domain event delivered by the bus
+type Handler func(ctx context.Context, event domain.DomainEvent) error
+
+// RetryPolicy is how often a failing handler is called for an event, and how long the bus waits
+// between calls: the initial backoff, doubled after each failure up to the maximum
+type RetryPolicy struct {
+	Attempts       int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// DefaultRetryPolicy calls a handler up to three times, waiting 100ms and then 200ms
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{Attempts: 3, InitialBackoff: 100 * time.Millisecond, MaxBackoff: 5 * time.Second}
+}
+
+// backoff is how long to wait after the given failed attempt, counting from 1
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	wait := p.InitialBackoff
+	for i := 1; i < attempt && wait < p.MaxBackoff; i++ {
+		wait *= 2
+	}
+	if p.MaxBackoff > 0 && wait > p.MaxBackoff {
+		wait = p.MaxBackoff
+	}
+	return wait
+}
+
+// Config configures how a bus delivers events. Synchronous delivery calls the handlers, and
+// retries them, before Save returns; asynchronous delivery queues each delivery for a pool of
+// workers, so slow handlers do not hold up the commands recording events. A subscriber's
+// deliveries of one aggregate's events always go to the same worker, so it receives them in the
+// order they were saved even while retrying; a delivery given up on does not hold up later ones.
+type Config struct {
+	Async     bool
+	Workers   int         // asynchronous only, 4 when not set
+	QueueSize int         // deliveries waiting for the workers, shared between them; asynchronous only, 100 when not set
+	Retry     RetryPolicy // DefaultRetryPolicy when Attempts is not set
+	// MaxDeadLetters is how many dead letters are kept, dropping the oldest first; 1000 when not set
+	MaxDeadLetters int
+	// OnDeadLetter is called with each delivery given up on, besides recording it
+	OnDeadLetter func(DeadLetter)
+}
+
+// DeadLetter is the delivery of an event to a handler that failed on every attempt, or could not
+// be queued
+type DeadLetter struct {
+	Subscriber string
+	EventType  string
+	Event      domain.DomainEvent
+	Error      string
+	Attempts   int
+	FailedAt   time.Time
+
+	subscription int // the subscription redelivered to, as subscribers may share a name
+}
+
+// SubscribeOption customizes a subscription
+type SubscribeOption func(*subscription)
+
+// WithRetry overrides the bus's retry policy for a subscription
+func WithRetry(policy RetryPolicy) SubscribeOption {
+	return func(s *subscription) {
+		s.retry = policy
+	}
+}
+
+type subscription struct {
+	id        int // position among the bus's subscriptions
+	name      string
+	eventType string
+	handler   Handler
+	retry     RetryPolicy
+}
+
+type delivery struct {
+	subscription subscription
+	event        domain.DomainEvent
+}
+
+// Bus is a DomainEventRepository that saves events to the repository it wraps and then delivers
+// them to the handlers subscribed to their type. A handler that keeps failing is dead-lettered;
+// its failure never fails the save.
+type Bus struct {
+	next   domain.DomainEventRepository
+	config Config
+
+	mu            sync.RWMutex
+	subscriptions []subscription
+	deadLetters   []DeadLetter
+	dropped       int // dead letters dropped to keep within MaxDeadLetters
+	closed        bool
+
+	// sending counts the saves queueing deliveries, so the queues are not closed under them;
+	// closing stops those waiting for room once the bus is closed
+	sending sync.WaitGroup
+	closing chan struct{}
+	queues  []chan delivery // one per worker
+	spread  uint32          // spreads deliveries of events without an aggregate over the workers
+	workers sync.WaitGroup
+	now     func() time.Time
+}
+
+// NewBus creates a bus saving events to next. An asynchronous bus starts its workers right away
+// and must be closed to stop them.
+func NewBus(next domain.DomainEventRepository, config Config) *Bus {
+	if config.Retry.Attempts <= 0 {
+		config.Retry = DefaultRetryPolicy()
+	}
+	if config.MaxDeadLetters <= 0 {
+		config.MaxDeadLetters = 1000
+	}
+	bus := &Bus{next: next, config: config, closing: make(chan struct{}), now: time.Now}
+	if !config.Async {
+		return bus
+	}
+
+	if config.Workers <= 0 {
+		config.Workers = 4
+	}
+	if config.QueueSize <= 0 {
+		config.QueueSize = 100
+	}
+	bus.config = config
+	size := (config.QueueSize + config.Workers - 1) / config.Workers
+	for i := 0; i < config.Workers; i++ {
+		queue := make(chan delivery, size)
+		bus.queues = append(bus.queues, queue)
+		bus.workers.Add(1)
+		go bus.work(queue)
+	}
+	return bus
+}
+
+// Subscribe delivers the events of the type, or of every type with AllEvents, to the handler.
+// The name identifies the subscriber in dead letters.
+func (b *Bus) Subscribe(name, eventType string, handler Handler, opts ...SubscribeOption) {
+	sub := subscription{name: name, eventType: eventType, handler: handler, retry: b.config.Retry}
+	for _, opt := range opts {
+		opt(&sub)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	sub.id = len(b.subscriptions)
+	b.subscriptions = append(b.subscriptions, sub)
+}
+
+// Save saves the event and delivers it to its subscribers. An asynchronous bus waits for room in
+// its queue until the context is done or the bus is closed, and dead-letters the deliveries it
+// could not queue. No lock is held while handlers run, so they may save events themselves.
+func (b *Bus) Save(ctx context.Context, event domain.DomainEvent) error {
+	if err := b.next.Save(ctx, event); err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	var subs []subscription
+	for _, sub := range b.subscriptions {
+		if sub.eventType == AllEvents || sub.eventType == event.EventType() {
+			subs = append(subs, sub)
+		}
+	}
+	closed := b.closed
+	if b.config.Async && !closed {
+		b.sending.Add(1)
+		defer b.sending.Done()
+	}
+	b.mu.Unlock()
+
+	for _, sub := range subs {
+		d := delivery{subscription: sub, event: event}
+		switch {
+		case !b.config.Async:
+			b.deliver(ctx, d)
+		case closed:
+			b.deadLetter(d, errors.New("event bus is closed"), 0)
+		default:
+			b.enqueue(ctx, d)
+		}
+	}
+	return nil
+}
+
+// enqueue queues the delivery for its worker, dead-lettering it when the context is done or the
+// bus is closed before there is room
+func (b *Bus) enqueue(ctx context.Context, d delivery) {
+	queue := b.queueFor(d.subscription, d.event)
+	select {
+	case queue <- d:
+		return
+	default:
+	}
+	select {
+	case queue <- d:
+	case <-ctx.Done():
+		b.deadLetter(d, fmt.Errorf("delivery not queued: %w", ctx.Err()), 0)
+	case <-b.closing:
+		b.deadLetter(d, errors.New("event bus is closed"), 0)
+	}
+}
+
+// queueFor picks the worker queue for delivering the event to the subscriber, by subscriber and
+// aggregate, so one worker delivers a subscriber's events of an aggregate one after the other
+func (b *Bus) queueFor(sub subscription, event domain.DomainEvent) chan delivery {
+	_, aggregateID := domain.AggregateOf(event)
+	if aggregateID == "" {
+		return b.queues[atomic.AddUint32(&b.spread, 1)%uint32(len(b.queues))]
+	}
+	key := fnv.New32a()
+	key.Write([]byte(sub.name))
+	key.Write([]byte{0})
+	key.Write([]byte(aggregateID))
+	return b.queues[key.Sum32()%uint32(len(b.queues))]
+}
+
+// work delivers the events queued for a worker until its queue is closed
+func (b *Bus) work(queue chan delivery) {
+	defer b.workers.Done()
+	for d := range queue {
+		b.deliver(context.Background(), d)
+	}
+}
+
+// deliver calls the subscription's handler with the event, and dead-letters the event when every
+// attempt fails. It reports whether the event was delivered.
+func (b *Bus) deliver(ctx context.Context, d delivery) bool {
+	attempts, err := attempt(ctx, d.subscription, d.event)
+	if err != nil {
+		b.deadLetter(d, err, attempts)
+		return false
+	}
+	return true
+}
+
+// attempt calls the subscription's handler until it succeeds or its attempts run out, waiting
+// between attempts, and returns how many it made and the last error
+func attempt(ctx context.Context, sub subscription, event domain.DomainEvent) (int, error) {
+	var err error
+	attempts := sub.retry.Attempts
+	for n := 1; n <= attempts; n++ {
+		if err = call(ctx, sub.handler, event); err == nil {
+			return n, nil
+		}
+		if n == attempts {
+			break
+		}
+		select {
+		case <-time.After(sub.retry.backoff(n)):
+		case <-ctx.Done():
+			return n, err
+		}
+	}
+	return attempts, err
+}
+
+// call calls the handler, turning a panic into an error
+func call(ctx context.Context, handler Handler, event domain.DomainEvent) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("handler panicked: %v", r)
+		}
+	}()
+	return handler(ctx, event)
+}
+
+func (b *Bus) deadLetter(d delivery, err error, attempts int) {
+	letter := DeadLetter{
+		Subscriber:   d.subscription.name,
+		EventType:    d.event.EventType(),
+		Event:        d.event,
+		Error:        err.Error(),
+		Attempts:     attempts,
+		FailedAt:     b.now(),
+		subscription: d.subscription.id,
+	}
+
+	b.mu.Lock()
+	b.keep(letter)
+	b.mu.Unlock()
+
+	if b.config.OnDeadLetter != nil {
+		b.config.OnDeadLetter(letter)
+	}
+}
+
+// keep records a dead letter, dropping the oldest once there are MaxDeadLetters. The caller holds mu.
+func (b *Bus) keep(letter DeadLetter) {
+	b.deadLetters = append(b.deadLetters, letter)
+	if excess := len(b.deadLetters) - b.config.MaxDeadLetters; excess > 0 {
+		b.deadLetters = append([]DeadLetter{}, b.deadLetters[excess:]...)
+		b.dropped += excess
+	}
+}
+
+// DeadLetters returns the deliveries given up on, oldest first
+func (b *Bus) DeadLetters() []DeadLetter {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return append([]DeadLetter{}, b.deadLetters...)
+}
+
+// DroppedDeadLetters returns how many dead letters were dropped, oldest first, to keep within
+// MaxDeadLetters
+func (b *Bus) DroppedDeadLetters() int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.dropped
+}
+
+// Redeliver delivers the dead letters again, synchronously and with the retry policy of their
+// subscriber, and returns how many were delivered. Those failing again stay dead-lettered.
+func (b *Bus) Redeliver(ctx context.Context) int {
+	b.mu.Lock()
+	letters := b.deadLetters
+	b.deadLetters = nil
+	subs := append([]subscription{}, b.subscriptions...)
+	b.mu.Unlock()
+
+	delivered := 0
+	for _, letter := range letters {
+		if b.deliver(ctx, delivery{subscription: subs[letter.subscription], event: letter.Event}) {
+			delivered++
+		}
+	}
+	return delivered
+}
+
+// Close stops an asynchronous bus from queueing deliveries and waits, until the context is done,
+// for the queued ones to be delivered. Events saved afterwards are still saved, and their
+// deliveries dead-lettered, as are those of saves still waiting for room in the queue.
+func (b *Bus) Close(ctx context.Context) error {
+	b.mu.Lock()
+	if b.closed || !b.config.Async {
+		b.closed = true
+		b.mu.Unlock()
+		return nil
+	}
+	b.closed = true
+	close(b.closing)
+	b.mu.Unlock()
+
+	// Saves still queueing give up once closing is closed, so this does not wait on the workers
+	b.sending.Wait()
+	for _, queue := range b.queues {
+		close(queue)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		b.workers.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("event bus closed with deliveries pending: %w", ctx.Err())
+	}
+}
+
+// FindByAggregateID finds events by aggregate ID
+func (b *Bus) FindByAggregateID(ctx context.Context, aggregateID string) ([]domain.DomainEvent, error) {
+	return b.next.FindByAggregateID(ctx, aggregateID)
+}
+
+// FindByEventType finds events by event type
+func (b *Bus) FindByEventType(ctx context.Context, eventType string) ([]domain.DomainEvent, error) {
+	return b.next.FindByEventType(ctx, eventType)
+}
+
+// FindByTimeRange finds events by time range
+func (b *Bus) FindByTimeRange(ctx context.Context, start, end time.Time) ([]domain.DomainEvent, error) {
+	return b.next.FindByTimeRange(ctx, start, end)
+}
+
+// Delete deletes an event
+func (b *Bus) Delete(ctx context.Context, eventID string) error {
+	return b.next.Delete(ctx, eventID)
+}
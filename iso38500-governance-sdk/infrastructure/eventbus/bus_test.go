@@ -0,0 +1,426 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+	"github.com/iso38500/iso38500-governance-sdk/infrastructure/memory"
+)
+
+// fastRetry keeps retrying tests quick
+var fastRetry = RetryPolicy{Attempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: 2 * time.Millisecond}
+
+func portfolioCreated(portfolioID string, n int) domain.PortfolioCreatedEvent {
+	return domain.PortfolioCreatedEvent{
+		PortfolioID: domain.PortfolioID(portfolioID),
+		Name:        fmt.Sprintf("event %d", n),
+		OccurredAt:  time.Now(),
+	}
+}
+
+// failing returns a handler failing its first calls, and the number of calls made
+func failing(failures int, err error) (Handler, func() int) {
+	var mu sync.Mutex
+	calls := 0
+	handler := func(ctx context.Context, event domain.DomainEvent) error {
+		mu.Lock()
+		defer mu.Unlock()
+		calls++
+		if calls <= failures {
+			return err
+		}
+		return nil
+	}
+	return handler, func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		return calls
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{Attempts: 5, InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	tests := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{1, 100 * time.Millisecond},
+		{2, 200 * time.Millisecond},
+		{3, 400 * time.Millisecond},
+		{4, 800 * time.Millisecond},
+		{5, time.Second},
+		{10, time.Second},
+	}
+	for _, tt := range tests {
+		if got := policy.backoff(tt.attempt); got != tt.want {
+			t.Errorf("backoff(%d) = %s, want %s", tt.attempt, got, tt.want)
+		}
+	}
+}
+
+func TestBusRetry(t *testing.T) {
+	tests := []struct {
+		name           string
+		failures       int
+		retry          []SubscribeOption
+		wantCalls      int
+		wantDeadLetter bool
+	}{
+		{"delivered first time", 0, nil, 1, false},
+		{"delivered on retry", 2, nil, 3, false},
+		{"dead-lettered once attempts run out", 5, nil, 3, true},
+		{"subscription retry policy", 4, []SubscribeOption{WithRetry(RetryPolicy{Attempts: 5, InitialBackoff: time.Millisecond})}, 5, false},
+		{"single attempt", 1, []SubscribeOption{WithRetry(RetryPolicy{Attempts: 1})}, 1, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var notified []DeadLetter
+			bus := NewBus(memory.NewDomainEventRepositoryMemory(), Config{
+				Retry:        fastRetry,
+				OnDeadLetter: func(letter DeadLetter) { notified = append(notified, letter) },
+			})
+			handler, calls := failing(tt.failures, errors.New("webhook unavailable"))
+			bus.Subscribe("webhook", "PortfolioCreated", handler, tt.retry...)
+
+			if err := bus.Save(context.Background(), portfolioCreated("p1", 1)); err != nil {
+				t.Fatalf("Save() error = %v", err)
+			}
+			if got := calls(); got != tt.wantCalls {
+				t.Errorf("handler called %d times, want %d", got, tt.wantCalls)
+			}
+			letters := bus.DeadLetters()
+			if (len(letters) == 1) != tt.wantDeadLetter || len(notified) != len(letters) {
+				t.Fatalf("dead letters = %v, notified %d, want dead letter %v", letters, len(notified), tt.wantDeadLetter)
+			}
+			if tt.wantDeadLetter {
+				letter := letters[0]
+				if letter.Subscriber != "webhook" || letter.EventType != "PortfolioCreated" || letter.Attempts != tt.wantCalls || letter.Error != "webhook unavailable" {
+					t.Errorf("dead letter = %+v", letter)
+				}
+			}
+		})
+	}
+}
+
+func TestBusDeliversToMatchingSubscribers(t *testing.T) {
+	bus := NewBus(memory.NewDomainEventRepositoryMemory(), Config{Retry: fastRetry})
+	created, createdCalls := failing(0, nil)
+	all, allCalls := failing(0, nil)
+	other, otherCalls := failing(0, nil)
+	bus.Subscribe("created", "PortfolioCreated", created)
+	bus.Subscribe("all", AllEvents, all)
+	bus.Subscribe("other", "ChangeRequestApproved", other)
+
+	if err := bus.Save(context.Background(), portfolioCreated("p1", 1)); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if createdCalls() != 1 || allCalls() != 1 || otherCalls() != 0 {
+		t.Errorf("calls = %d, %d, %d, want 1, 1, 0", createdCalls(), allCalls(), otherCalls())
+	}
+	saved, _ := bus.FindByEventType(context.Background(), "PortfolioCreated")
+	if len(saved) != 1 {
+		t.Errorf("saved %d events, want 1", len(saved))
+	}
+}
+
+func TestBusDeadLettersPanics(t *testing.T) {
+	bus := NewBus(memory.NewDomainEventRepositoryMemory(), Config{Retry: RetryPolicy{Attempts: 1}})
+	bus.Subscribe("panicking", AllEvents, func(ctx context.Context, event domain.DomainEvent) error {
+		panic("boom")
+	})
+
+	if err := bus.Save(context.Background(), portfolioCreated("p1", 1)); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	letters := bus.DeadLetters()
+	if len(letters) != 1 || !strings.Contains(letters[0].Error, "boom") {
+		t.Errorf("dead letters = %+v, want the panic", letters)
+	}
+}
+
+func TestBusMaxDeadLetters(t *testing.T) {
+	tests := []struct {
+		name        string
+		max         int
+		failed      int
+		wantKept    int
+		wantDropped int
+	}{
+		{"within the limit", 5, 3, 3, 0},
+		{"at the limit", 5, 5, 5, 0},
+		{"over the limit", 5, 8, 5, 3},
+		{"default limit", 0, 1002, 1000, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bus := NewBus(memory.NewDomainEventRepositoryMemory(), Config{Retry: RetryPolicy{Attempts: 1}, MaxDeadLetters: tt.max})
+			bus.Subscribe("webhook", AllEvents, func(ctx context.Context, event domain.DomainEvent) error {
+				return errors.New("unavailable")
+			})
+			for i := 1; i <= tt.failed; i++ {
+				if err := bus.Save(context.Background(), portfolioCreated("p1", i)); err != nil {
+					t.Fatalf("Save() error = %v", err)
+				}
+			}
+
+			letters := bus.DeadLetters()
+			if len(letters) != tt.wantKept || bus.DroppedDeadLetters() != tt.wantDropped {
+				t.Fatalf("kept %d, dropped %d, want %d and %d", len(letters), bus.DroppedDeadLetters(), tt.wantKept, tt.wantDropped)
+			}
+			oldest := letters[0].Event.(domain.PortfolioCreatedEvent).Name
+			if want := fmt.Sprintf("event %d", tt.wantDropped+1); oldest != want {
+				t.Errorf("oldest dead letter is %q, want %q", oldest, want)
+			}
+		})
+	}
+}
+
+func TestBusRedeliver(t *testing.T) {
+	bus := NewBus(memory.NewDomainEventRepositoryMemory(), Config{Retry: RetryPolicy{Attempts: 1}})
+	var mu sync.Mutex
+	available := false
+	bus.Subscribe("webhook", AllEvents, func(ctx context.Context, event domain.DomainEvent) error {
+		mu.Lock()
+		defer mu.Unlock()
+		if !available && event.(domain.PortfolioCreatedEvent).PortfolioID == "p1" {
+			return errors.New("unavailable")
+		}
+		if event.(domain.PortfolioCreatedEvent).PortfolioID == "p2" {
+			return errors.New("rejected")
+		}
+		return nil
+	})
+	for _, id := range []string{"p1", "p2"} {
+		if err := bus.Save(context.Background(), portfolioCreated(id, 1)); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+	}
+	if got := len(bus.DeadLetters()); got != 2 {
+		t.Fatalf("dead letters = %d, want 2", got)
+	}
+
+	mu.Lock()
+	available = true
+	mu.Unlock()
+	if delivered := bus.Redeliver(context.Background()); delivered != 1 {
+		t.Errorf("Redeliver() = %d, want 1", delivered)
+	}
+	letters := bus.DeadLetters()
+	if len(letters) != 1 || letters[0].Event.(domain.PortfolioCreatedEvent).PortfolioID != "p2" {
+		t.Errorf("dead letters after redelivery = %+v, want only p2", letters)
+	}
+}
+
+func TestBusRedeliverBySubscription(t *testing.T) {
+	bus := NewBus(memory.NewDomainEventRepositoryMemory(), Config{Retry: RetryPolicy{Attempts: 1}})
+	first, firstCalls := failing(1, errors.New("unavailable"))
+	second, secondCalls := failing(0, nil)
+	bus.Subscribe("webhook", "PortfolioCreated", first)
+	bus.Subscribe("webhook", "PortfolioCreated", second)
+
+	if err := bus.Save(context.Background(), portfolioCreated("p1", 1)); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if delivered := bus.Redeliver(context.Background()); delivered != 1 {
+		t.Errorf("Redeliver() = %d, want 1", delivered)
+	}
+	if firstCalls() != 2 || secondCalls() != 1 {
+		t.Errorf("calls = %d and %d, want the failed subscription called again only", firstCalls(), secondCalls())
+	}
+}
+
+func TestBusAsyncKeepsAggregateOrder(t *testing.T) {
+	bus := NewBus(memory.NewDomainEventRepositoryMemory(), Config{Async: true, Workers: 4, QueueSize: 8, Retry: fastRetry})
+	var mu sync.Mutex
+	received := make(map[domain.PortfolioID][]string)
+	bus.Subscribe("webhook", AllEvents, func(ctx context.Context, event domain.DomainEvent) error {
+		created := event.(domain.PortfolioCreatedEvent)
+		mu.Lock()
+		defer mu.Unlock()
+		received[created.PortfolioID] = append(received[created.PortfolioID], created.Name)
+		return nil
+	})
+
+	const perPortfolio = 50
+	portfolios := []string{"p1", "p2", "p3", "p4", "p5"}
+	for i := 1; i <= perPortfolio; i++ {
+		for _, id := range portfolios {
+			if err := bus.Save(context.Background(), portfolioCreated(id, i)); err != nil {
+				t.Fatalf("Save() error = %v", err)
+			}
+		}
+	}
+	if err := bus.Close(context.Background()); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	for _, id := range portfolios {
+		names := received[domain.PortfolioID(id)]
+		if len(names) != perPortfolio {
+			t.Fatalf("%s received %d events, want %d", id, len(names), perPortfolio)
+		}
+		for i, name := range names {
+			if want := fmt.Sprintf("event %d", i+1); name != want {
+				t.Fatalf("%s received %q at %d, want %q", id, name, i, want)
+			}
+		}
+	}
+}
+
+func TestBusClose(t *testing.T) {
+	t.Run("delivers queued events", func(t *testing.T) {
+		bus := NewBus(memory.NewDomainEventRepositoryMemory(), Config{Async: true, Workers: 2, Retry: fastRetry})
+		handler, calls := failing(0, nil)
+		bus.Subscribe("slow", AllEvents, func(ctx context.Context, event domain.DomainEvent) error {
+			time.Sleep(time.Millisecond)
+			return handler(ctx, event)
+		})
+		for i := 1; i <= 20; i++ {
+			if err := bus.Save(context.Background(), portfolioCreated("p1", i)); err != nil {
+				t.Fatalf("Save() error = %v", err)
+			}
+		}
+		if err := bus.Close(context.Background()); err != nil {
+			t.Fatalf("Close() error = %v", err)
+		}
+		if got := calls(); got != 20 {
+			t.Errorf("delivered %d events before Close returned, want 20", got)
+		}
+		if err := bus.Close(context.Background()); err != nil {
+			t.Errorf("second Close() error = %v", err)
+		}
+	})
+
+	t.Run("dead-letters events saved afterwards", func(t *testing.T) {
+		repo := memory.NewDomainEventRepositoryMemory()
+		bus := NewBus(repo, Config{Async: true, Retry: fastRetry})
+		handler, calls := failing(0, nil)
+		bus.Subscribe("webhook", AllEvents, handler)
+		if err := bus.Close(context.Background()); err != nil {
+			t.Fatalf("Close() error = %v", err)
+		}
+
+		if err := bus.Save(context.Background(), portfolioCreated("p1", 1)); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+		if calls() != 0 {
+			t.Errorf("handler called after Close")
+		}
+		letters := bus.DeadLetters()
+		if len(letters) != 1 || letters[0].Error != "event bus is closed" || letters[0].Attempts != 0 {
+			t.Errorf("dead letters = %+v, want the closed bus", letters)
+		}
+		if saved, _ := repo.FindByAggregateID(context.Background(), "p1"); len(saved) != 1 {
+			t.Errorf("saved %d events after Close, want 1", len(saved))
+		}
+	})
+
+	t.Run("gives up waiting when the context is done", func(t *testing.T) {
+		bus := NewBus(memory.NewDomainEventRepositoryMemory(), Config{Async: true, Workers: 1, Retry: fastRetry})
+		release := make(chan struct{})
+		bus.Subscribe("stuck", AllEvents, func(ctx context.Context, event domain.DomainEvent) error {
+			<-release
+			return nil
+		})
+		defer close(release)
+		if err := bus.Save(context.Background(), portfolioCreated("p1", 1)); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		if err := bus.Close(ctx); !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("Close() error = %v, want the deadline", err)
+		}
+	})
+
+	t.Run("handlers saving while closing", func(t *testing.T) {
+		for _, async := range []bool{false, true} {
+			bus := NewBus(memory.NewDomainEventRepositoryMemory(), Config{Async: async, Retry: fastRetry})
+			started := make(chan struct{})
+			closing := make(chan struct{})
+			saved := make(chan error, 1)
+			bus.Subscribe("follow-up", "PortfolioCreated", func(ctx context.Context, event domain.DomainEvent) error {
+				if event.(domain.PortfolioCreatedEvent).Name != "event 1" {
+					return nil
+				}
+				close(started)
+				<-closing
+				time.Sleep(10 * time.Millisecond) // let Close start waiting
+				saved <- bus.Save(ctx, portfolioCreated("p1", 2))
+				return nil
+			})
+
+			go bus.Save(context.Background(), portfolioCreated("p1", 1))
+			<-started
+			closed := make(chan error, 1)
+			go func() { closed <- bus.Close(context.Background()) }()
+			close(closing)
+
+			select {
+			case err := <-closed:
+				if err != nil {
+					t.Errorf("async %v: Close() error = %v", async, err)
+				}
+			case <-time.After(time.Second):
+				t.Fatalf("async %v: Close() deadlocked with a handler saving", async)
+			}
+			select {
+			case err := <-saved:
+				if err != nil {
+					t.Errorf("async %v: nested Save() error = %v", async, err)
+				}
+			case <-time.After(time.Second):
+				t.Fatalf("async %v: nested Save() deadlocked", async)
+			}
+		}
+	})
+
+	t.Run("synchronous bus", func(t *testing.T) {
+		bus := NewBus(memory.NewDomainEventRepositoryMemory(), Config{Retry: fastRetry})
+		if err := bus.Close(context.Background()); err != nil {
+			t.Errorf("Close() error = %v", err)
+		}
+	})
+}
+
+func TestBusSaveDeadLettersWhenQueueIsFull(t *testing.T) {
+	bus := NewBus(memory.NewDomainEventRepositoryMemory(), Config{Async: true, Workers: 1, QueueSize: 1, Retry: fastRetry})
+	release := make(chan struct{})
+	bus.Subscribe("stuck", AllEvents, func(ctx context.Context, event domain.DomainEvent) error {
+		<-release
+		return nil
+	})
+	defer func() {
+		close(release)
+		bus.Close(context.Background())
+	}()
+
+	// The worker holds the first delivery and the queue the second
+	if err := bus.Save(context.Background(), portfolioCreated("p1", 1)); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	deadline := time.Now().Add(time.Second)
+	for len(bus.queues[0]) > 0 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if err := bus.Save(context.Background(), portfolioCreated("p1", 2)); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := bus.Save(ctx, portfolioCreated("p1", 3)); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	letters := bus.DeadLetters()
+	if len(letters) != 1 || !strings.Contains(letters[0].Error, "delivery not queued") {
+		t.Errorf("dead letters = %+v, want the unqueued delivery", letters)
+	}
+}
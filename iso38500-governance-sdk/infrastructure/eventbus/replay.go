@@ -0,0 +1,72 @@
+package eventbus
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// Replay calls the handler with every event in the store that occurred from the given time until
+// now, oldest first, e.g. to backfill a new projection or downstream system from the governance
+// history. Events saved while replaying are not replayed. It stops at the first event the handler
+// fails on, or once the context is done, and returns how many events were handled.
+func Replay(ctx context.Context, store domain.DomainEventRepository, from time.Time, handler Handler) (int, error) {
+	events, err := store.FindByTimeRange(ctx, from, time.Now())
+	if err != nil {
+		return 0, fmt.Errorf("failed to find events to replay: %w", err)
+	}
+	sort.SliceStable(events, func(i, j int) bool { return events[i].Time().Before(events[j].Time()) })
+
+	for i, event := range events {
+		if err := ctx.Err(); err != nil {
+			return i, fmt.Errorf("replay stopped: %w", err)
+		}
+		if err := call(ctx, handler, event); err != nil {
+			return i, fmt.Errorf("replay stopped at %s event of %s: %w", event.EventType(), event.Time().Format(time.RFC3339), err)
+		}
+	}
+	return len(events), nil
+}
+
+// Replay calls the handler with every event the bus saved from the given time until now, oldest
+// first; see Replay. Replayed events are not delivered to the bus's subscribers again.
+func (b *Bus) Replay(ctx context.Context, from time.Time, handler Handler) (int, error) {
+	return Replay(ctx, b.next, from, handler)
+}
+
+// ReplayTo delivers the events saved from the given time until now to a subscriber again, oldest
+// first and retrying them with its retry policy, e.g. to backfill a webhook added later. Only the
+// events of the types it subscribes to are delivered. It stops at the first event whose attempts
+// all fail, without dead-lettering it, and returns how many events were delivered.
+func (b *Bus) ReplayTo(ctx context.Context, from time.Time, subscriber string) (int, error) {
+	b.mu.RLock()
+	var subs []subscription
+	for _, sub := range b.subscriptions {
+		if sub.name == subscriber {
+			subs = append(subs, sub)
+		}
+	}
+	b.mu.RUnlock()
+	if len(subs) == 0 {
+		return 0, fmt.Errorf("no subscriber named %q", subscriber)
+	}
+
+	delivered := 0
+	_, err := Replay(ctx, b.next, from, func(ctx context.Context, event domain.DomainEvent) error {
+		for _, sub := range subs {
+			if sub.eventType != AllEvents && sub.eventType != event.EventType() {
+				continue
+			}
+			if _, err := attempt(ctx, sub, event); err != nil {
+				return err
+			}
+			delivered++
+			return nil
+		}
+		return nil
+	})
+	return delivered, err
+}
@@ -0,0 +1,272 @@
+package export
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// blockKind is the role of a block of a compliance report's content
+type blockKind int
+
+const (
+	blockTitle blockKind = iota
+	blockHeading
+	blockSubheading
+	blockParagraph
+	blockBullet
+	blockSubBullet
+	blockTable
+)
+
+// block is one heading, paragraph, list item or table of a report, laid out by each format
+type block struct {
+	kind blockKind
+	text string
+	rows [][]string // table rows, header first
+}
+
+// WriteComplianceReportMarkdown writes the report as a Markdown document
+func WriteComplianceReportMarkdown(w io.Writer, report domain.ComplianceReport) error {
+	out := bufio.NewWriter(w)
+	inList := false
+	for _, b := range complianceReportBlocks(report) {
+		listItem := b.kind == blockBullet || b.kind == blockSubBullet
+		if inList && !listItem {
+			out.WriteString("\n")
+		}
+		inList = listItem
+
+		switch b.kind {
+		case blockTitle:
+			out.WriteString("# " + b.text + "\n\n")
+		case blockHeading:
+			out.WriteString("## " + b.text + "\n\n")
+		case blockSubheading:
+			out.WriteString("### " + b.text + "\n\n")
+		case blockParagraph:
+			out.WriteString(b.text + "\n\n")
+		case blockBullet:
+			out.WriteString("- " + b.text + "\n")
+		case blockSubBullet:
+			out.WriteString("  - " + b.text + "\n")
+		case blockTable:
+			for i, row := range b.rows {
+				cells := make([]string, len(row))
+				for j, cell := range row {
+					cells[j] = strings.ReplaceAll(cell, "|", `\|`)
+				}
+				out.WriteString("| " + strings.Join(cells, " | ") + " |\n")
+				if i == 0 {
+					out.WriteString("|" + strings.Repeat(" --- |", len(row)) + "\n")
+				}
+			}
+			out.WriteString("\n")
+		}
+	}
+	return out.Flush()
+}
+
+// WriteComplianceReportPDF writes the report as a PDF document on A4 pages. Tables are written
+// as one line per row.
+func WriteComplianceReportPDF(w io.Writer, report domain.ComplianceReport) error {
+	document := &pdfDocument{title: "Compliance report: " + report.Title, created: report.GeneratedAt}
+	for _, b := range complianceReportBlocks(report) {
+		switch b.kind {
+		case blockTitle:
+			document.add(b.text, true, 18, 0, 0)
+		case blockHeading:
+			document.add(b.text, true, 14, 0, 14)
+		case blockSubheading:
+			document.add(b.text, true, 11, 0, 8)
+		case blockParagraph:
+			document.add(b.text, false, 10, 0, 4)
+		case blockBullet:
+			document.add("• "+b.text, false, 10, 8, 0)
+		case blockSubBullet:
+			document.add("– "+b.text, false, 10, 24, 0)
+		case blockTable:
+			header := b.rows[0]
+			for _, row := range b.rows[1:] {
+				details := make([]string, 0, len(row)-1)
+				for i := 1; i < len(row); i++ {
+					details = append(details, strings.ToLower(header[i])+": "+row[i])
+				}
+				document.add("• "+row[0], true, 10, 8, 2)
+				document.add(strings.Join(details, "; "), false, 9, 20, 0)
+			}
+		}
+	}
+	return document.write(w)
+}
+
+// complianceReportBlocks lays out the content of a report: its overall compliance, then for
+// each agreement its score, requirements, evidence, open gaps and remediation plan
+func complianceReportBlocks(report domain.ComplianceReport) []block {
+	blocks := []block{{kind: blockTitle, text: "Compliance report: " + report.Title}}
+
+	intro := fmt.Sprintf("Generated on %s", report.GeneratedAt.Format("2006-01-02"))
+	if report.PortfolioID != "" {
+		intro += fmt.Sprintf(" for portfolio %s, covering %d agreements", report.PortfolioID, len(report.Agreements))
+	}
+	intro += "."
+	if compliance := report.Compliance; compliance != nil && report.PortfolioID != "" {
+		intro += fmt.Sprintf(" Overall compliance is %.1f%% across the %d applications with assessed requirements.", compliance.Percentage, compliance.Applications)
+	}
+	blocks = append(blocks, block{kind: blockParagraph, text: intro})
+
+	for _, agreement := range report.Agreements {
+		blocks = append(blocks, agreementBlocks(agreement, report)...)
+	}
+	return blocks
+}
+
+// agreementBlocks lays out the compliance of one agreement
+func agreementBlocks(agreement domain.AgreementComplianceReport, report domain.ComplianceReport) []block {
+	title := agreement.Title
+	if title == "" {
+		title = string(agreement.AgreementID)
+	}
+	blocks := []block{
+		{kind: blockHeading, text: title},
+		{kind: blockParagraph, text: fmt.Sprintf("Agreement %s of application %s.", agreement.AgreementID, agreement.ApplicationID)},
+	}
+
+	score := agreement.Score
+	if score == nil {
+		return append(blocks, block{kind: blockParagraph, text: "The agreement has no legal, contractual or industry standard requirements."})
+	}
+	summary := fmt.Sprintf("%d compliant, %d partial, %d non-compliant and %d under review.", score.Compliant, score.Partial, score.NonCompliant, score.UnderReview)
+	if score.Assessed() {
+		summary = fmt.Sprintf("Compliance is %.1f%% weighted by criticality: ", score.Percentage) + summary
+	}
+	blocks = append(blocks, block{kind: blockParagraph, text: summary})
+
+	blocks = append(blocks, block{kind: blockSubheading, text: "Requirements"})
+	rows := [][]string{{"Requirement", "Kind", "Criticality", "Status", "Documents", "Evidence"}}
+	var evidence []domain.Evidence
+	listed := make(map[string]bool)
+	for _, requirement := range agreement.Requirements {
+		var documents, evidenceIDs []string
+		for _, document := range requirement.Documents {
+			documents = append(documents, fmt.Sprintf("%s %s", document.DocumentKind, document.DocumentID))
+		}
+		for _, item := range requirement.Evidence {
+			evidenceIDs = append(evidenceIDs, item.ID)
+			if !listed[item.ID] {
+				listed[item.ID] = true
+				evidence = append(evidence, item)
+			}
+		}
+		rows = append(rows, []string{
+			requirement.Requirement.Name,
+			string(requirement.Requirement.Kind),
+			criticalityLabel(requirement.Criticality),
+			string(requirement.Status),
+			orNone(strings.Join(documents, ", ")),
+			orNone(strings.Join(evidenceIDs, ", ")),
+		})
+	}
+	blocks = append(blocks, block{kind: blockTable, rows: rows})
+
+	blocks = append(blocks, block{kind: blockSubheading, text: "Evidence"})
+	if len(evidence) == 0 {
+		blocks = append(blocks, block{kind: blockParagraph, text: "No evidence is attached for the requirements' controls."})
+	}
+	for _, item := range evidence {
+		blocks = append(blocks, block{kind: blockBullet, text: evidenceLabel(item, report)})
+	}
+
+	gaps := agreement.Gaps()
+	blocks = append(blocks, block{kind: blockSubheading, text: "Open gaps"})
+	if len(gaps) == 0 {
+		blocks = append(blocks, block{kind: blockParagraph, text: "Every assessed requirement is compliant."})
+	}
+	for _, gap := range gaps {
+		text := fmt.Sprintf("%s (%s, %s criticality): %s", gap.Requirement.Name, gap.Requirement.Kind, criticalityLabel(gap.Criticality), gap.Status)
+		if gap.Description != "" {
+			text += ". " + gap.Description
+		}
+		blocks = append(blocks, block{kind: blockBullet, text: text})
+	}
+
+	if len(gaps) > 0 {
+		blocks = append(blocks, block{kind: blockSubheading, text: "Remediation plan"})
+		for _, gap := range gaps {
+			if len(gap.Findings) == 0 {
+				blocks = append(blocks, block{kind: blockBullet, text: gap.Requirement.Name + ": no remediation planned"})
+				continue
+			}
+			for _, open := range gap.Findings {
+				finding := open.Finding
+				text := fmt.Sprintf("%s: finding %s of audit %s (%s)", gap.Requirement.Name, finding.ID, open.AuditID, finding.Severity)
+				if finding.Remediation != "" {
+					text += ", " + finding.Remediation
+				}
+				if len(finding.Actions) == 0 {
+					text += "; no actions planned"
+				}
+				blocks = append(blocks, block{kind: blockBullet, text: text})
+				for _, action := range finding.Actions {
+					blocks = append(blocks, block{kind: blockSubBullet, text: actionLabel(action, report)})
+				}
+			}
+		}
+	}
+	return blocks
+}
+
+// evidenceLabel describes an evidence item with where it came from and how long it holds
+func evidenceLabel(evidence domain.Evidence, report domain.ComplianceReport) string {
+	label := fmt.Sprintf("%s: %s (%s), %s", evidence.ID, evidence.Title, evidence.Kind, evidence.URI)
+	if evidence.Source != "" {
+		label += ", from " + evidence.Source
+	}
+	if !evidence.CollectedAt.IsZero() {
+		label += ", collected " + evidence.CollectedAt.Format("2006-01-02")
+	}
+	if !evidence.ValidUntil.IsZero() {
+		label += ", valid until " + evidence.ValidUntil.Format("2006-01-02")
+	}
+	if evidence.SHA256 != "" {
+		label += ", SHA-256 " + evidence.SHA256
+	}
+	if evidence.Expired(report.GeneratedAt) {
+		label += " (stale)"
+	}
+	return label
+}
+
+// actionLabel describes a remediation action with its owner, due date and status
+func actionLabel(action domain.RemediationAction, report domain.ComplianceReport) string {
+	label := fmt.Sprintf("%s: %s [%s]", action.ID, action.Description, action.Status)
+	if action.Owner != "" {
+		label += ", owner " + action.Owner
+	}
+	if !action.DueDate.IsZero() {
+		label += ", due " + action.DueDate.Format("2006-01-02")
+	}
+	if action.Overdue(report.GeneratedAt) {
+		label += " (overdue)"
+	}
+	return label
+}
+
+// criticalityLabel names a requirement's criticality, medium when unset
+func criticalityLabel(criticality domain.Priority) string {
+	if criticality.Weight() == 0 {
+		return string(domain.PriorityMedium)
+	}
+	return string(criticality)
+}
+
+// orNone stands in "none" for an empty table cell
+func orNone(value string) string {
+	if value == "" {
+		return "none"
+	}
+	return value
+}
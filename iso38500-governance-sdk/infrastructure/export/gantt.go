@@ -0,0 +1,111 @@
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// GanttTask is a timeline item in the task shape Gantt chart tools import
+type GanttTask struct {
+	ID            string   `json:"id"`
+	Name          string   `json:"name"`
+	Type          string   `json:"type"`
+	Parent        string   `json:"parent,omitempty"`
+	Start         string   `json:"start"`
+	End           string   `json:"end"`
+	Deadline      string   `json:"deadline,omitempty"`
+	Progress      float64  `json:"progress"`
+	Status        string   `json:"status,omitempty"`
+	Owner         string   `json:"owner,omitempty"`
+	Milestone     bool     `json:"milestone"`
+	Dependencies  []string `json:"dependencies"`
+	AgreementID   string   `json:"agreement_id"`
+	ApplicationID string   `json:"application_id"`
+}
+
+// GanttTasks converts the timeline's items to Gantt tasks. An item without a start starts and
+// ends on its end date.
+func GanttTasks(timeline domain.Timeline) []GanttTask {
+	tasks := make([]GanttTask, len(timeline.Items))
+	for i, item := range timeline.Items {
+		start := item.Start
+		if item.Milestone() {
+			start = item.End
+		}
+		dependencies := item.DependsOn
+		if dependencies == nil {
+			dependencies = []string{}
+		}
+		tasks[i] = GanttTask{
+			ID:            item.ID,
+			Name:          item.Name,
+			Type:          string(item.Kind),
+			Parent:        item.ParentID,
+			Start:         formatDate(start),
+			End:           formatDate(item.End),
+			Deadline:      formatDate(item.Deadline),
+			Progress:      item.PercentComplete,
+			Status:        item.Status,
+			Owner:         item.Owner,
+			Milestone:     item.Milestone(),
+			Dependencies:  dependencies,
+			AgreementID:   string(item.AgreementID),
+			ApplicationID: string(item.ApplicationID),
+		}
+	}
+	return tasks
+}
+
+// WriteGanttJSON writes the timeline as a JSON array of Gantt tasks
+func WriteGanttJSON(w io.Writer, timeline domain.Timeline) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(GanttTasks(timeline))
+}
+
+// WriteGanttCSV writes the timeline as CSV with a header row and one Gantt task per row.
+// Dependencies are separated by semicolons.
+func WriteGanttCSV(w io.Writer, timeline domain.Timeline) error {
+	out := csv.NewWriter(w)
+	err := out.Write([]string{"id", "name", "type", "parent", "start", "end", "deadline", "progress", "status", "owner", "milestone", "dependencies", "agreement_id", "application_id"})
+	if err != nil {
+		return err
+	}
+	for _, task := range GanttTasks(timeline) {
+		err := out.Write([]string{
+			task.ID,
+			task.Name,
+			task.Type,
+			task.Parent,
+			task.Start,
+			task.End,
+			task.Deadline,
+			fmt.Sprintf("%.0f", task.Progress),
+			task.Status,
+			task.Owner,
+			fmt.Sprintf("%t", task.Milestone),
+			strings.Join(task.Dependencies, ";"),
+			task.AgreementID,
+			task.ApplicationID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	out.Flush()
+	return out.Error()
+}
+
+// formatDate formats a date for the Gantt exports, empty when it is not set
+func formatDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format("2006-01-02")
+}
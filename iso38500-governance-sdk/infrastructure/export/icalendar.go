@@ -0,0 +1,99 @@
+// Package export writes governance timelines in formats that calendars and project tooling load,
+// and compliance reports as Markdown or PDF for auditors.
+package export
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+const icalDate = "20060102"
+
+// WriteICalendar writes the timeline as an iCalendar (RFC 5545) feed with an all-day event for
+// each item. An item without a start is an event on its end date.
+func WriteICalendar(w io.Writer, timeline domain.Timeline) error {
+	out := bufio.NewWriter(w)
+	line := func(content string) {
+		// Lines longer than 75 octets are folded onto continuation lines starting with a space
+		for len(content) > 75 {
+			cut := 75
+			for cut > 0 && !utf8Start(content[cut]) {
+				cut--
+			}
+			out.WriteString(content[:cut] + "\r\n")
+			content = " " + content[cut:]
+		}
+		out.WriteString(content + "\r\n")
+	}
+
+	stamp := timeline.GeneratedAt.UTC().Format("20060102T150405Z")
+	line("BEGIN:VCALENDAR")
+	line("VERSION:2.0")
+	line("PRODID:-//ISO 38500 Governance SDK//Governance Timeline//EN")
+	line("CALSCALE:GREGORIAN")
+	line("X-WR-CALNAME:IT governance timeline")
+	for _, item := range timeline.Items {
+		start := item.Start
+		if item.Milestone() {
+			start = item.End
+		}
+		// All-day events end exclusively on the day after their last day
+		end := item.End.AddDate(0, 0, 1)
+
+		line("BEGIN:VEVENT")
+		line("UID:" + escapeText(item.ID) + "@iso38500-governance")
+		line("DTSTAMP:" + stamp)
+		line("DTSTART;VALUE=DATE:" + start.Format(icalDate))
+		line("DTEND;VALUE=DATE:" + end.Format(icalDate))
+		line("SUMMARY:" + escapeText(summary(item)))
+		if description := describe(item); description != "" {
+			line("DESCRIPTION:" + escapeText(description))
+		}
+		line("CATEGORIES:" + escapeText(string(item.Kind)))
+		if item.Status == string(domain.ActionCompleted) {
+			line("STATUS:CONFIRMED")
+		}
+		line("END:VEVENT")
+	}
+	line("END:VCALENDAR")
+	return out.Flush()
+}
+
+// summary titles an item's event with what kind of item it is
+func summary(item domain.TimelineItem) string {
+	kind := strings.ReplaceAll(string(item.Kind), "_", " ")
+	return fmt.Sprintf("[%s] %s", kind, item.Name)
+}
+
+// describe lists an item's details for its event description
+func describe(item domain.TimelineItem) string {
+	var details []string
+	if item.Description != "" {
+		details = append(details, item.Description)
+	}
+	details = append(details, fmt.Sprintf("Agreement: %s", item.AgreementID))
+	if item.Owner != "" {
+		details = append(details, fmt.Sprintf("Owner: %s", item.Owner))
+	}
+	if item.Status != "" {
+		details = append(details, fmt.Sprintf("Status: %s", item.Status))
+	}
+	if !item.Deadline.IsZero() && !item.Deadline.Equal(item.End) {
+		details = append(details, fmt.Sprintf("Deadline: %s", item.Deadline.Format("2006-01-02")))
+	}
+	return strings.Join(details, "\n")
+}
+
+// escapeText escapes an iCalendar TEXT value
+func escapeText(text string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(text)
+}
+
+// utf8Start reports whether b starts a UTF-8 encoded character, so folding never splits one
+func utf8Start(b byte) bool {
+	return b&0xC0 != 0x80
+}
@@ -0,0 +1,156 @@
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// A4 pages, in points, with 2 cm margins
+const (
+	pdfPageWidth  = 595.0
+	pdfPageHeight = 842.0
+	pdfMargin     = 56.0
+)
+
+// pdfLine is one line of text laid out on a page
+type pdfLine struct {
+	text   string
+	bold   bool
+	size   float64
+	indent float64
+	space  float64 // extra space above the line
+}
+
+// pdfDocument lays out lines of text on A4 pages in the standard Helvetica fonts, which every
+// PDF reader provides, so documents need no embedded fonts
+type pdfDocument struct {
+	title   string
+	created time.Time
+	lines   []pdfLine
+}
+
+// add wraps the text to the width left by the indent and adds its lines
+func (d *pdfDocument) add(text string, bold bool, size, indent, space float64) {
+	// Helvetica glyphs average about half an em; a little more keeps wide text in the margins
+	limit := int((pdfPageWidth - 2*pdfMargin - indent) / (size * 0.52))
+	var line string
+	for _, word := range strings.Fields(text) {
+		if line != "" && len([]rune(line))+1+len([]rune(word)) > limit {
+			d.lines = append(d.lines, pdfLine{text: line, bold: bold, size: size, indent: indent, space: space})
+			line, space = "", 0
+		}
+		if line != "" {
+			line += " "
+		}
+		line += word
+	}
+	d.lines = append(d.lines, pdfLine{text: line, bold: bold, size: size, indent: indent, space: space})
+}
+
+// write paginates the lines, numbers the pages and writes the document
+func (d *pdfDocument) write(w io.Writer) error {
+	var pages [][]pdfLine
+	var page []pdfLine
+	y := pdfPageHeight - pdfMargin
+	for _, line := range d.lines {
+		height := line.size*1.4 + line.space
+		if y-height < pdfMargin && len(page) > 0 {
+			pages = append(pages, page)
+			page, y = nil, pdfPageHeight-pdfMargin
+			line.space = 0
+			height = line.size * 1.4
+		}
+		y -= height
+		page = append(page, line)
+	}
+	pages = append(pages, page)
+
+	var out bytes.Buffer
+	var offsets []int
+	object := func(body string) {
+		offsets = append(offsets, out.Len())
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+	// Objects 1 to 5 are the catalog, the page tree, the two fonts and the document information;
+	// each page is followed by its content stream
+	kids := make([]string, len(pages))
+	for i := range pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 6+2*i)
+	}
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+	object(fmt.Sprintf("<< /Title %s /Producer (ISO 38500 Governance SDK) /CreationDate (D:%s) >>",
+		pdfString(d.title), d.created.UTC().Format("20060102150405Z")))
+
+	for i, lines := range pages {
+		var content bytes.Buffer
+		y := pdfPageHeight - pdfMargin
+		for _, line := range lines {
+			y -= line.size*1.4 + line.space
+			font := "F1"
+			if line.bold {
+				font = "F2"
+			}
+			fmt.Fprintf(&content, "BT /%s %.1f Tf 1 0 0 1 %.1f %.1f Tm %s Tj ET\n", font, line.size, pdfMargin+line.indent, y, pdfString(line.text))
+		}
+		fmt.Fprintf(&content, "BT /F1 8.0 Tf 1 0 0 1 %.1f %.1f Tm %s Tj ET\n", pdfMargin, pdfMargin/2, pdfString(fmt.Sprintf("%s - page %d of %d", d.title, i+1, len(pages))))
+
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.0f %.0f] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+			pdfPageWidth, pdfPageHeight, 7+2*i))
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", content.Len(), content.String()))
+	}
+
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R /Info 5 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	_, err := w.Write(out.Bytes())
+	return err
+}
+
+// winAnsi maps the characters outside Latin-1 that WinAnsiEncoding has to their codes
+var winAnsi = map[rune]byte{
+	'€': 0x80, '‚': 0x82, '„': 0x84, '…': 0x85, '‘': 0x91, '’': 0x92, '“': 0x93, '”': 0x94,
+	'•': 0x95, '–': 0x96, '—': 0x97, '™': 0x99,
+}
+
+// pdfString encodes text as a PDF literal string in WinAnsiEncoding. Characters the encoding
+// lacks are replaced by a question mark.
+func pdfString(text string) string {
+	var b strings.Builder
+	b.WriteByte('(')
+	for _, r := range text {
+		var c byte
+		switch code, ok := winAnsi[r]; {
+		case ok:
+			c = code
+		case r < 0x20:
+			c = ' '
+		case r < 0x7f || (r >= 0xa0 && r <= 0xff):
+			c = byte(r)
+		default:
+			c = '?'
+		}
+		switch {
+		case c == '(' || c == ')' || c == '\\':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		case c >= 0x80:
+			fmt.Fprintf(&b, "\\%03o", c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	b.WriteByte(')')
+	return b.String()
+}
@@ -0,0 +1,181 @@
+// Package filestore provides repositories that keep their data in local files, so it survives
+// restarts without a database
+package filestore
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+	"github.com/iso38500/iso38500-governance-sdk/infrastructure/memory"
+)
+
+// kpiMeasurementRecord is the JSON form of a KPI measurement, one per line of the file
+type kpiMeasurementRecord struct {
+	KPIID      string    `json:"kpi_id"`
+	Value      float64   `json:"value"`
+	Target     float64   `json:"target"`
+	Achieved   bool      `json:"achieved"`
+	MeasuredAt time.Time `json:"measured_at"`
+	Notes      string    `json:"notes,omitempty"`
+}
+
+// KPIMeasurementRepository is a KPIMeasurementRepository that appends measurements to a JSON
+// Lines file and serves reads from memory. The file is loaded when the repository is opened.
+type KPIMeasurementRepository struct {
+	mu    sync.Mutex
+	path  string
+	index *memory.KPIMeasurementRepositoryMemory
+}
+
+// NewKPIMeasurementRepository opens the KPI measurement file at path, creating it when it does
+// not exist
+func NewKPIMeasurementRepository(path string) (*KPIMeasurementRepository, error) {
+	r := &KPIMeasurementRepository{
+		path:  path,
+		index: memory.NewKPIMeasurementRepositoryMemory(),
+	}
+
+	file, err := os.OpenFile(path, os.O_RDONLY|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open KPI measurement file: %w", err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var record kpiMeasurementRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return nil, fmt.Errorf("invalid KPI measurement on line %d of %s: %w", line, path, err)
+		}
+		if err := r.index.Save(context.Background(), record.measurement()); err != nil {
+			return nil, err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read KPI measurement file: %w", err)
+	}
+	return r, nil
+}
+
+// Save appends a measurement to the file and the KPI's history
+func (r *KPIMeasurementRepository) Save(ctx context.Context, measurement domain.KPIMeasurement) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	line, err := json.Marshal(newKPIMeasurementRecord(measurement))
+	if err != nil {
+		return fmt.Errorf("failed to encode KPI measurement: %w", err)
+	}
+
+	file, err := os.OpenFile(r.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open KPI measurement file: %w", err)
+	}
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write KPI measurement: %w", err)
+	}
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write KPI measurement: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to write KPI measurement: %w", err)
+	}
+
+	return r.index.Save(ctx, measurement)
+}
+
+// FindByKPIID returns a KPI's measurements, oldest first
+func (r *KPIMeasurementRepository) FindByKPIID(ctx context.Context, kpiID string) ([]domain.KPIMeasurement, error) {
+	return r.index.FindByKPIID(ctx, kpiID)
+}
+
+// FindByPeriod returns a KPI's measurements taken within the given time range, inclusive, oldest
+// first
+func (r *KPIMeasurementRepository) FindByPeriod(ctx context.Context, kpiID string, start, end time.Time) ([]domain.KPIMeasurement, error) {
+	return r.index.FindByPeriod(ctx, kpiID, start, end)
+}
+
+// FindLatest returns the most recent measurement of a KPI
+func (r *KPIMeasurementRepository) FindLatest(ctx context.Context, kpiID string) (domain.KPIMeasurement, error) {
+	return r.index.FindLatest(ctx, kpiID)
+}
+
+// Delete removes the KPI's measurements taken at the given time and rewrites the file without them
+func (r *KPIMeasurementRepository) Delete(ctx context.Context, kpiID string, measuredAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.index.Delete(ctx, kpiID, measuredAt); err != nil {
+		return err
+	}
+	return r.rewrite(ctx)
+}
+
+// rewrite replaces the file with the measurements held in memory. The new file is written next to
+// the old one and renamed over it, so a failed rewrite leaves the old file intact.
+func (r *KPIMeasurementRepository) rewrite(ctx context.Context) error {
+	temp, err := os.CreateTemp(filepath.Dir(r.path), filepath.Base(r.path)+".*")
+	if err != nil {
+		return fmt.Errorf("failed to rewrite KPI measurement file: %w", err)
+	}
+	defer os.Remove(temp.Name())
+
+	writer := bufio.NewWriter(temp)
+	encoder := json.NewEncoder(writer)
+	for _, kpiID := range r.index.KPIIDs() {
+		measurements, err := r.index.FindByKPIID(ctx, kpiID)
+		if err != nil {
+			temp.Close()
+			return err
+		}
+		for _, measurement := range measurements {
+			if err := encoder.Encode(newKPIMeasurementRecord(measurement)); err != nil {
+				temp.Close()
+				return fmt.Errorf("failed to encode KPI measurement: %w", err)
+			}
+		}
+	}
+	if err := errors.Join(writer.Flush(), temp.Sync(), temp.Close()); err != nil {
+		return fmt.Errorf("failed to rewrite KPI measurement file: %w", err)
+	}
+
+	if err := os.Rename(temp.Name(), r.path); err != nil {
+		return fmt.Errorf("failed to rewrite KPI measurement file: %w", err)
+	}
+	return nil
+}
+
+func newKPIMeasurementRecord(measurement domain.KPIMeasurement) kpiMeasurementRecord {
+	return kpiMeasurementRecord{
+		KPIID:      measurement.KPIID,
+		Value:      measurement.Value,
+		Target:     measurement.Target,
+		Achieved:   measurement.Achieved,
+		MeasuredAt: measurement.MeasuredAt,
+		Notes:      measurement.Notes,
+	}
+}
+
+func (r kpiMeasurementRecord) measurement() domain.KPIMeasurement {
+	return domain.KPIMeasurement{
+		KPIID:      r.KPIID,
+		Value:      r.Value,
+		Target:     r.Target,
+		Achieved:   r.Achieved,
+		MeasuredAt: r.MeasuredAt,
+		Notes:      r.Notes,
+	}
+}
@@ -0,0 +1,87 @@
+// Package itsm mirrors change requests and incidents to ServiceNow and Jira so the SDK can act as
+// the governance layer over existing ITSM tooling
+package itsm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// defaultTimeout bounds calls when no client is given
+const defaultTimeout = 30 * time.Second
+
+// httpClient returns the client, or one with the default timeout when it is nil
+func httpClient(client *http.Client) *http.Client {
+	if client != nil {
+		return client
+	}
+	return &http.Client{Timeout: defaultTimeout}
+}
+
+// call sends the payload, when not nil, as JSON and decodes the JSON response into out, when not
+// nil. It fails on a non-2xx response, quoting the start of its body.
+func call(ctx context.Context, client *http.Client, tool, method, endpoint string, authorize func(*http.Request), payload, out interface{}) error {
+	var body io.Reader
+	if payload != nil {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return fmt.Errorf("failed to encode %s request: %w", tool, err)
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, body)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	authorize(req)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call %s: %w", tool, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("%s rejected the request with status %d: %s", tool, resp.StatusCode, strings.TrimSpace(string(detail)))
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode %s response: %w", tool, err)
+	}
+	return nil
+}
+
+// text renders a JSON field value as text: strings as they are, numbers and booleans formatted,
+// and objects by the first of the keys holding a string, e.g. the name of a Jira status
+func text(value interface{}, keys ...string) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case map[string]interface{}:
+		for _, key := range keys {
+			if s, ok := v[key].(string); ok && s != "" {
+				return s
+			}
+		}
+	}
+	return ""
+}
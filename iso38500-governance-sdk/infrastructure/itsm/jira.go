@@ -0,0 +1,278 @@
+package itsm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// jiraTime is the layout of Jira date-time values
+const jiraTime = "2006-01-02T15:04:05.000-0700"
+
+// JiraConfig holds the site, credentials and project of a Jira connector
+type JiraConfig struct {
+	BaseURL      string // e.g. "https://acme.atlassian.net"
+	Email        string // basic authentication with an API token, as on Jira Cloud
+	APIToken     string
+	Token        string                           // personal access token, as on Jira Data Center, used instead of an email
+	ProjectKey   string                           // project issues are created in
+	IssueTypes   map[domain.ITSMRecordKind]string // "Change" and "Incident" when not set
+	NamedFields  []string                         // fields set by name, e.g. {"name": "High"}; "priority" and "assignee" when not set
+	OptionFields []string                         // select fields set by option value, e.g. custom fields
+}
+
+// JiraConnector mirrors records to issues of a Jira project with the REST API. The status field
+// is set by taking the workflow transition leading to it.
+type JiraConnector struct {
+	config JiraConfig
+	client *http.Client
+}
+
+// NewJiraConnector creates a Jira connector. A nil client uses a client with a 30 second timeout.
+func NewJiraConnector(config JiraConfig, client *http.Client) (*JiraConnector, error) {
+	if config.BaseURL == "" || config.ProjectKey == "" {
+		return nil, fmt.Errorf("jira requires a base URL and a project key")
+	}
+	if config.Token == "" && (config.Email == "" || config.APIToken == "") {
+		return nil, fmt.Errorf("jira requires an email and API token or a token")
+	}
+	config.BaseURL = strings.TrimRight(config.BaseURL, "/")
+	issueTypes := map[domain.ITSMRecordKind]string{
+		domain.ITSMChangeRequest: "Change",
+		domain.ITSMIncident:      "Incident",
+	}
+	for kind, issueType := range config.IssueTypes {
+		issueTypes[kind] = issueType
+	}
+	config.IssueTypes = issueTypes
+	if len(config.NamedFields) == 0 {
+		config.NamedFields = []string{"priority", "assignee"}
+	}
+	return &JiraConnector{config: config, client: httpClient(client)}, nil
+}
+
+// Name returns "jira"
+func (c *JiraConnector) Name() string {
+	return "jira"
+}
+
+// Create creates an issue of the kind's type, then moves it to its status
+func (c *JiraConnector) Create(ctx context.Context, kind domain.ITSMRecordKind, fields map[string]string) (domain.ITSMReference, error) {
+	payload := c.issueFields(fields)
+	payload["project"] = map[string]string{"key": c.config.ProjectKey}
+	payload["issuetype"] = map[string]string{"name": c.config.IssueTypes[kind]}
+
+	var created struct {
+		ID  string `json:"id"`
+		Key string `json:"key"`
+	}
+	err := c.call(ctx, http.MethodPost, c.config.BaseURL+"/rest/api/2/issue", map[string]interface{}{"fields": payload}, &created)
+	if err != nil {
+		return domain.ITSMReference{}, err
+	}
+	reference := domain.ITSMReference{
+		ExternalID: created.Key,
+		Key:        created.Key,
+		URL:        fmt.Sprintf("%s/browse/%s", c.config.BaseURL, created.Key),
+	}
+	if status := fields["status"]; status != "" {
+		if err := c.transition(ctx, created.Key, status); err != nil {
+			return reference, err
+		}
+	}
+	return reference, nil
+}
+
+// Update sets the fields of an issue and moves it to its status
+func (c *JiraConnector) Update(ctx context.Context, kind domain.ITSMRecordKind, externalID string, fields map[string]string) error {
+	payload := c.issueFields(fields)
+	if len(payload) > 0 {
+		err := c.call(ctx, http.MethodPut, c.issueURL(externalID), map[string]interface{}{"fields": payload}, nil)
+		if err != nil {
+			return err
+		}
+	}
+	if status := fields["status"]; status != "" {
+		return c.transition(ctx, externalID, status)
+	}
+	return nil
+}
+
+// AddComment comments on an issue, returning the comment's ID
+func (c *JiraConnector) AddComment(ctx context.Context, kind domain.ITSMRecordKind, externalID string, comment domain.ITSMComment) (string, error) {
+	var created struct {
+		ID string `json:"id"`
+	}
+	err := c.call(ctx, http.MethodPost, c.issueURL(externalID)+"/comment", map[string]string{"body": comment.Body}, &created)
+	return created.ID, err
+}
+
+// Fetch reads the fields of an issue, by field ID, and its comments. Fields holding objects are
+// read by their name, value or, for users, account name.
+func (c *JiraConnector) Fetch(ctx context.Context, kind domain.ITSMRecordKind, externalID string) (domain.ITSMRemoteRecord, error) {
+	var issue struct {
+		Key    string                 `json:"key"`
+		Fields map[string]interface{} `json:"fields"`
+	}
+	if err := c.call(ctx, http.MethodGet, c.issueURL(externalID)+"?fields=*all", nil, &issue); err != nil {
+		return domain.ITSMRemoteRecord{}, err
+	}
+
+	record := domain.ITSMRemoteRecord{ExternalID: externalID, Fields: make(map[string]string, len(issue.Fields))}
+	for field, value := range issue.Fields {
+		if field == "comment" {
+			record.Comments = jiraComments(value)
+			continue
+		}
+		record.Fields[field] = text(value, "name", "value", "emailAddress", "displayName")
+	}
+	record.UpdatedAt, _ = time.Parse(jiraTime, record.Fields["updated"])
+	return record, nil
+}
+
+// jiraComments reads the comments of the comment field of an issue, oldest first
+func jiraComments(value interface{}) []domain.ITSMComment {
+	field, _ := value.(map[string]interface{})
+	entries, _ := field["comments"].([]interface{})
+	comments := make([]domain.ITSMComment, 0, len(entries))
+	for _, entry := range entries {
+		comment, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		createdAt, _ := time.Parse(jiraTime, text(comment["created"]))
+		comments = append(comments, domain.ITSMComment{
+			ID:        text(comment["id"]),
+			Author:    text(comment["author"], "displayName", "name"),
+			Body:      text(comment["body"]),
+			CreatedAt: createdAt,
+		})
+	}
+	sort.SliceStable(comments, func(i, j int) bool { return comments[i].CreatedAt.Before(comments[j].CreatedAt) })
+	return comments
+}
+
+// issueFields builds the fields of an issue create or edit request. The status is left out, as
+// Jira only changes it through transitions.
+func (c *JiraConnector) issueFields(fields map[string]string) map[string]interface{} {
+	payload := make(map[string]interface{}, len(fields))
+	for field, value := range fields {
+		switch {
+		case field == "status":
+		case containsField(c.config.NamedFields, field):
+			payload[field] = map[string]string{"name": value}
+		case containsField(c.config.OptionFields, field):
+			payload[field] = map[string]string{"value": value}
+		default:
+			payload[field] = value
+		}
+	}
+	return payload
+}
+
+// transition moves an issue to the status by taking the transition leading to it. An issue
+// already in the status is left as it is.
+func (c *JiraConnector) transition(ctx context.Context, key, status string) error {
+	var current struct {
+		Fields struct {
+			Status struct {
+				Name string `json:"name"`
+			} `json:"status"`
+		} `json:"fields"`
+	}
+	if err := c.call(ctx, http.MethodGet, c.issueURL(key)+"?fields=status", nil, &current); err != nil {
+		return err
+	}
+	if strings.EqualFold(current.Fields.Status.Name, status) {
+		return nil
+	}
+
+	var available struct {
+		Transitions []struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+			To   struct {
+				Name string `json:"name"`
+			} `json:"to"`
+		} `json:"transitions"`
+	}
+	if err := c.call(ctx, http.MethodGet, c.issueURL(key)+"/transitions", nil, &available); err != nil {
+		return err
+	}
+	for _, transition := range available.Transitions {
+		if strings.EqualFold(transition.To.Name, status) || strings.EqualFold(transition.Name, status) {
+			payload := map[string]interface{}{"transition": map[string]string{"id": transition.ID}}
+			return c.call(ctx, http.MethodPost, c.issueURL(key)+"/transitions", payload, nil)
+		}
+	}
+	return fmt.Errorf("jira issue %s has no transition from %s to %s", key, current.Fields.Status.Name, status)
+}
+
+func (c *JiraConnector) issueURL(key string) string {
+	return fmt.Sprintf("%s/rest/api/2/issue/%s", c.config.BaseURL, url.PathEscape(key))
+}
+
+func (c *JiraConnector) call(ctx context.Context, method, endpoint string, payload, out interface{}) error {
+	return call(ctx, c.client, "jira", method, endpoint, c.authorize, payload, out)
+}
+
+func (c *JiraConnector) authorize(req *http.Request) {
+	if c.config.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.config.Token)
+		return
+	}
+	req.SetBasicAuth(c.config.Email, c.config.APIToken)
+}
+
+// containsField reports whether the field is listed
+func containsField(fields []string, field string) bool {
+	for _, f := range fields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// DefaultJiraMapping maps change requests and incidents onto the summary, description, status
+// and priority of Jira issues. Statuses are mapped onto the Jira Service Management incident
+// workflow; change request statuses keep their names, so the workflow's statuses must be mapped
+// to match it.
+func DefaultJiraMapping(kind domain.ITSMRecordKind) domain.ITSMFieldMapping {
+	mapping := domain.ITSMFieldMapping{
+		Kind: kind,
+		Fields: map[string]string{
+			domain.ITSMFieldTitle:       "summary",
+			domain.ITSMFieldDescription: "description",
+			domain.ITSMFieldStatus:      "status",
+			domain.ITSMFieldPriority:    "priority",
+		},
+		Values: map[string]map[string]string{
+			domain.ITSMFieldPriority: {
+				string(domain.PriorityCritical): "Highest",
+				string(domain.PriorityHigh):     "High",
+				string(domain.PriorityMedium):   "Medium",
+				string(domain.PriorityLow):      "Low",
+			},
+		},
+	}
+	if kind == domain.ITSMIncident {
+		mapping.Fields[domain.ITSMFieldAssignee] = "assignee"
+		delete(mapping.Fields, domain.ITSMFieldPriority)
+		mapping.Values = map[string]map[string]string{
+			domain.ITSMFieldStatus: {
+				string(domain.IncidentStatusOpen):          "Open",
+				string(domain.IncidentStatusInvestigating): "Work in progress",
+				string(domain.IncidentStatusResolved):      "Resolved",
+				string(domain.IncidentStatusClosed):        "Closed",
+			},
+		}
+	}
+	return mapping
+}
@@ -0,0 +1,225 @@
+package itsm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// serviceNowTime is the layout of ServiceNow date-time values, in UTC
+const serviceNowTime = "2006-01-02 15:04:05"
+
+// ServiceNowConfig holds the instance and credentials of a ServiceNow connector
+type ServiceNowConfig struct {
+	InstanceURL string // e.g. "https://acme.service-now.com"
+	Username    string // basic authentication, with Password
+	Password    string
+	Token       string                           // OAuth access token, used instead of a username
+	Tables      map[domain.ITSMRecordKind]string // "change_request" and "incident" when not set
+}
+
+// ServiceNowConnector mirrors records to ServiceNow tables with the Table API. Comments are
+// written to and read from the record's customer-visible comments journal.
+type ServiceNowConnector struct {
+	config ServiceNowConfig
+	client *http.Client
+}
+
+// NewServiceNowConnector creates a ServiceNow connector. A nil client uses a client with a 30
+// second timeout.
+func NewServiceNowConnector(config ServiceNowConfig, client *http.Client) (*ServiceNowConnector, error) {
+	if config.InstanceURL == "" {
+		return nil, fmt.Errorf("servicenow requires an instance URL")
+	}
+	if config.Token == "" && (config.Username == "" || config.Password == "") {
+		return nil, fmt.Errorf("servicenow requires a username and password or a token")
+	}
+	config.InstanceURL = strings.TrimRight(config.InstanceURL, "/")
+	tables := map[domain.ITSMRecordKind]string{
+		domain.ITSMChangeRequest: "change_request",
+		domain.ITSMIncident:      "incident",
+	}
+	for kind, table := range config.Tables {
+		tables[kind] = table
+	}
+	config.Tables = tables
+	return &ServiceNowConnector{config: config, client: httpClient(client)}, nil
+}
+
+// Name returns "servicenow"
+func (c *ServiceNowConnector) Name() string {
+	return "servicenow"
+}
+
+// serviceNowRecordResponse is the response of the Table API for a single record
+type serviceNowRecordResponse struct {
+	Result map[string]interface{} `json:"result"`
+}
+
+// Create inserts a record into the kind's table
+func (c *ServiceNowConnector) Create(ctx context.Context, kind domain.ITSMRecordKind, fields map[string]string) (domain.ITSMReference, error) {
+	var resp serviceNowRecordResponse
+	err := c.call(ctx, http.MethodPost, c.tableURL(kind, ""), fields, &resp)
+	if err != nil {
+		return domain.ITSMReference{}, err
+	}
+	sysID := text(resp.Result["sys_id"])
+	if sysID == "" {
+		return domain.ITSMReference{}, fmt.Errorf("servicenow returned no sys_id for the new %s", kind)
+	}
+	return domain.ITSMReference{
+		ExternalID: sysID,
+		Key:        text(resp.Result["number"]),
+		URL:        fmt.Sprintf("%s/nav_to.do?uri=%s.do?sys_id=%s", c.config.InstanceURL, c.config.Tables[kind], sysID),
+	}, nil
+}
+
+// Update sets the fields of a record
+func (c *ServiceNowConnector) Update(ctx context.Context, kind domain.ITSMRecordKind, externalID string, fields map[string]string) error {
+	return c.call(ctx, http.MethodPatch, c.tableURL(kind, externalID), fields, nil)
+}
+
+// AddComment appends the comment to the record's comments journal. ServiceNow returns no ID for
+// journal entries, so none is returned.
+func (c *ServiceNowConnector) AddComment(ctx context.Context, kind domain.ITSMRecordKind, externalID string, comment domain.ITSMComment) (string, error) {
+	return "", c.call(ctx, http.MethodPatch, c.tableURL(kind, externalID), map[string]string{"comments": comment.Body}, nil)
+}
+
+// Fetch reads the raw values of a record's fields and its comments
+func (c *ServiceNowConnector) Fetch(ctx context.Context, kind domain.ITSMRecordKind, externalID string) (domain.ITSMRemoteRecord, error) {
+	var resp serviceNowRecordResponse
+	endpoint := c.tableURL(kind, externalID) + "?sysparm_exclude_reference_link=true"
+	if err := c.call(ctx, http.MethodGet, endpoint, nil, &resp); err != nil {
+		return domain.ITSMRemoteRecord{}, err
+	}
+
+	record := domain.ITSMRemoteRecord{ExternalID: externalID, Fields: make(map[string]string, len(resp.Result))}
+	for field, value := range resp.Result {
+		record.Fields[field] = text(value, "value")
+	}
+	record.UpdatedAt, _ = time.Parse(serviceNowTime, record.Fields["sys_updated_on"])
+
+	comments, err := c.comments(ctx, externalID)
+	if err != nil {
+		return domain.ITSMRemoteRecord{}, err
+	}
+	record.Comments = comments
+	return record, nil
+}
+
+// comments reads the entries of a record's comments journal, oldest first
+func (c *ServiceNowConnector) comments(ctx context.Context, externalID string) ([]domain.ITSMComment, error) {
+	params := url.Values{}
+	params.Set("sysparm_query", fmt.Sprintf("element_id=%s^element=comments^ORDERBYsys_created_on", externalID))
+	params.Set("sysparm_fields", "sys_id,value,sys_created_by,sys_created_on")
+	endpoint := fmt.Sprintf("%s/api/now/table/sys_journal_field?%s", c.config.InstanceURL, params.Encode())
+
+	var resp struct {
+		Result []map[string]interface{} `json:"result"`
+	}
+	if err := c.call(ctx, http.MethodGet, endpoint, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	comments := make([]domain.ITSMComment, 0, len(resp.Result))
+	for _, entry := range resp.Result {
+		createdAt, _ := time.Parse(serviceNowTime, text(entry["sys_created_on"]))
+		comments = append(comments, domain.ITSMComment{
+			ID:        text(entry["sys_id"]),
+			Author:    text(entry["sys_created_by"]),
+			Body:      text(entry["value"]),
+			CreatedAt: createdAt,
+		})
+	}
+	sort.SliceStable(comments, func(i, j int) bool { return comments[i].CreatedAt.Before(comments[j].CreatedAt) })
+	return comments, nil
+}
+
+// tableURL returns the Table API URL of the kind's table, or of one of its records
+func (c *ServiceNowConnector) tableURL(kind domain.ITSMRecordKind, sysID string) string {
+	endpoint := fmt.Sprintf("%s/api/now/table/%s", c.config.InstanceURL, c.config.Tables[kind])
+	if sysID != "" {
+		endpoint += "/" + url.PathEscape(sysID)
+	}
+	return endpoint
+}
+
+func (c *ServiceNowConnector) call(ctx context.Context, method, endpoint string, payload, out interface{}) error {
+	return call(ctx, c.client, "servicenow", method, endpoint, c.authorize, payload, out)
+}
+
+func (c *ServiceNowConnector) authorize(req *http.Request) {
+	if c.config.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.config.Token)
+		return
+	}
+	req.SetBasicAuth(c.config.Username, c.config.Password)
+}
+
+// DefaultServiceNowMapping maps change requests and incidents onto the fields, states and
+// priorities of the out-of-the-box change_request and incident tables
+func DefaultServiceNowMapping(kind domain.ITSMRecordKind) domain.ITSMFieldMapping {
+	if kind == domain.ITSMChangeRequest {
+		return domain.ITSMFieldMapping{
+			Kind: kind,
+			Fields: map[string]string{
+				domain.ITSMFieldTitle:       "short_description",
+				domain.ITSMFieldDescription: "description",
+				domain.ITSMFieldStatus:      "state",
+				domain.ITSMFieldPriority:    "priority",
+				domain.ITSMFieldType:        "type",
+				domain.ITSMFieldRisk:        "risk_impact_analysis",
+				domain.ITSMFieldImpact:      "justification",
+				domain.ITSMFieldResolution:  "close_notes",
+			},
+			Values: map[string]map[string]string{
+				domain.ITSMFieldStatus: {
+					string(domain.ChangeStatusDraft):           "-5", // New
+					string(domain.ChangeStatusSubmitted):       "-4", // Assess
+					string(domain.ChangeStatusPendingApproval): "-3", // Authorize
+					string(domain.ChangeStatusApproved):        "-2", // Scheduled
+					string(domain.ChangeStatusImplemented):     "0",  // Review
+					string(domain.ChangeStatusClosed):          "3",
+					string(domain.ChangeStatusRejected):        "4", // Canceled
+				},
+				domain.ITSMFieldPriority: {
+					string(domain.PriorityCritical): "1",
+					string(domain.PriorityHigh):     "2",
+					string(domain.PriorityMedium):   "3",
+					string(domain.PriorityLow):      "4",
+				},
+			},
+		}
+	}
+	return domain.ITSMFieldMapping{
+		Kind: kind,
+		Fields: map[string]string{
+			domain.ITSMFieldTitle:       "short_description",
+			domain.ITSMFieldDescription: "description",
+			domain.ITSMFieldStatus:      "state",
+			domain.ITSMFieldResolution:  "close_notes",
+			domain.ITSMFieldClosureCode: "close_code",
+		},
+		Values: map[string]map[string]string{
+			domain.ITSMFieldStatus: {
+				string(domain.IncidentStatusOpen):          "1", // New
+				string(domain.IncidentStatusInvestigating): "2", // In Progress
+				string(domain.IncidentStatusResolved):      "6",
+				string(domain.IncidentStatusClosed):        "7",
+			},
+			domain.ITSMFieldClosureCode: {
+				string(domain.ClosureFixed):           "Solved (Permanently)",
+				string(domain.ClosureWorkaround):      "Solved (Work Around)",
+				string(domain.ClosureDuplicate):       "Duplicate",
+				string(domain.ClosureNotReproducible): "Not Solved (Not Reproducible)",
+				string(domain.ClosureCancelled):       "Closed/Resolved by Caller",
+			},
+		},
+	}
+}
@@ -0,0 +1,98 @@
+// Package kafka publishes domain events to a Kafka topic so enterprise event backbones can
+// consume governance changes
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// Message is a record to produce to a Kafka topic
+type Message struct {
+	Topic   string
+	Key     []byte // nil lets the producer pick the partition
+	Value   []byte
+	Headers map[string]string
+}
+
+// Producer writes messages to Kafka. RESTProxyProducer produces through a Kafka REST Proxy; a
+// native client, e.g. a kafka-go Writer or a sarama SyncProducer, can be adapted in a few lines.
+type Producer interface {
+	Produce(ctx context.Context, messages ...Message) error
+}
+
+// Event headers set on every message, for consumers routing events without decoding them
+const (
+	HeaderEventID       = "event_id"
+	HeaderEventType     = "event_type"
+	HeaderAggregateType = "aggregate_type"
+)
+
+// Publisher publishes domain events to a topic as JSON domain.EventEnvelope values keyed by the
+// ID of the aggregate they were recorded against, so the events of an aggregate land on one
+// partition in the order they were published. Subscribed to an eventbus.Bus, asynchronous or not,
+// that is the order they were saved in, except for deliveries dead-lettered and redelivered later.
+// The envelopes carry no sequence, which only the event store can assign; the partition offset
+// orders an aggregate's events.
+type Publisher struct {
+	producer Producer
+	topic    string
+}
+
+// NewPublisher creates a publisher producing to the topic
+func NewPublisher(producer Producer, topic string) (*Publisher, error) {
+	if producer == nil || topic == "" {
+		return nil, fmt.Errorf("kafka publisher requires a producer and a topic")
+	}
+	return &Publisher{producer: producer, topic: topic}, nil
+}
+
+// Topic returns the topic events are published to
+func (p *Publisher) Topic() string {
+	return p.topic
+}
+
+// Handle publishes the event, e.g. as a handler subscribed to an event bus
+func (p *Publisher) Handle(ctx context.Context, event domain.DomainEvent) error {
+	return p.Publish(ctx, event)
+}
+
+// Publish publishes the events in one batch. Events recorded against no aggregate have no key.
+func (p *Publisher) Publish(ctx context.Context, events ...domain.DomainEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	messages := make([]Message, 0, len(events))
+	for _, event := range events {
+		envelope, err := domain.NewEventEnvelope(event, 0) // no sequence, see Publisher
+		if err != nil {
+			return err
+		}
+		value, err := json.Marshal(envelope)
+		if err != nil {
+			return fmt.Errorf("failed to encode %s event: %w", envelope.Type, err)
+		}
+		message := Message{
+			Topic: p.topic,
+			Value: value,
+			Headers: map[string]string{
+				HeaderEventID:   envelope.ID,
+				HeaderEventType: envelope.Type,
+			},
+		}
+		if envelope.AggregateID != "" {
+			message.Key = []byte(envelope.AggregateID)
+			message.Headers[HeaderAggregateType] = envelope.AggregateType
+		}
+		messages = append(messages, message)
+	}
+
+	if err := p.producer.Produce(ctx, messages...); err != nil {
+		return fmt.Errorf("failed to publish to kafka topic %s: %w", p.topic, err)
+	}
+	return nil
+}
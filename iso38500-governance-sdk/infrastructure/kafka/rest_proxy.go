@@ -0,0 +1,138 @@
+package kafka
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// restProxyContentType is the v2 REST Proxy format for records whose keys and values are bytes
+const restProxyContentType = "application/vnd.kafka.binary.v2+json"
+
+// RESTProxyConfig holds the address and credentials of a Kafka REST Proxy
+type RESTProxyConfig struct {
+	BaseURL  string // e.g. "https://kafka-rest.acme.example:8082"
+	Username string // basic authentication, when set
+	Password string
+	Headers  map[string]string // sent with every request, e.g. an API gateway key
+}
+
+// RESTProxyProducer produces messages through the v2 API of a Confluent-compatible Kafka REST
+// Proxy, so no Kafka client library or broker connection is needed. The v2 API does not carry
+// record headers; consumers read the event type and ID from the envelope.
+type RESTProxyProducer struct {
+	config RESTProxyConfig
+	client *http.Client
+}
+
+// NewRESTProxyProducer creates a REST Proxy producer. A nil client uses a client with a 30 second
+// timeout.
+func NewRESTProxyProducer(config RESTProxyConfig, client *http.Client) (*RESTProxyProducer, error) {
+	if config.BaseURL == "" {
+		return nil, fmt.Errorf("kafka REST proxy requires a base URL")
+	}
+	config.BaseURL = strings.TrimRight(config.BaseURL, "/")
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+	return &RESTProxyProducer{config: config, client: client}, nil
+}
+
+type restProxyRecord struct {
+	Key   *string `json:"key,omitempty"`
+	Value string  `json:"value"`
+}
+
+type restProxyResponse struct {
+	Offsets []struct {
+		Partition *int    `json:"partition"`
+		Offset    *int64  `json:"offset"`
+		ErrorCode *int    `json:"error_code"`
+		Error     *string `json:"error"`
+	} `json:"offsets"`
+}
+
+// Produce posts the messages to their topics, one request per topic in the order first seen.
+// It fails on the first request the proxy rejects or any record it could not produce.
+func (p *RESTProxyProducer) Produce(ctx context.Context, messages ...Message) error {
+	var topics []string
+	byTopic := make(map[string][]restProxyRecord)
+	for _, message := range messages {
+		if message.Topic == "" {
+			return fmt.Errorf("kafka message has no topic")
+		}
+		if _, ok := byTopic[message.Topic]; !ok {
+			topics = append(topics, message.Topic)
+		}
+		record := restProxyRecord{Value: base64.StdEncoding.EncodeToString(message.Value)}
+		if message.Key != nil {
+			key := base64.StdEncoding.EncodeToString(message.Key)
+			record.Key = &key
+		}
+		byTopic[message.Topic] = append(byTopic[message.Topic], record)
+	}
+
+	for _, topic := range topics {
+		if err := p.post(ctx, topic, byTopic[topic]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// post produces the records to the topic and checks each was produced
+func (p *RESTProxyProducer) post(ctx context.Context, topic string, records []restProxyRecord) error {
+	data, err := json.Marshal(map[string]interface{}{"records": records})
+	if err != nil {
+		return fmt.Errorf("failed to encode kafka records: %w", err)
+	}
+	endpoint := p.config.BaseURL + "/topics/" + url.PathEscape(topic)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", restProxyContentType)
+	req.Header.Set("Accept", "application/vnd.kafka.v2+json, application/json")
+	for name, value := range p.config.Headers {
+		req.Header.Set(name, value)
+	}
+	if p.config.Username != "" {
+		req.SetBasicAuth(p.config.Username, p.config.Password)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call kafka REST proxy: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("kafka REST proxy rejected records for topic %s with status %d: %s", topic, resp.StatusCode, strings.TrimSpace(string(detail)))
+	}
+	var produced restProxyResponse
+	if err := json.NewDecoder(resp.Body).Decode(&produced); err != nil {
+		return fmt.Errorf("failed to decode kafka REST proxy response: %w", err)
+	}
+	failed := 0
+	var first string
+	for _, offset := range produced.Offsets {
+		if offset.ErrorCode != nil || (offset.Error != nil && *offset.Error != "") {
+			failed++
+			if first == "" && offset.Error != nil {
+				first = *offset.Error
+			}
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("kafka REST proxy could not produce %d of %d records to topic %s: %s", failed, len(records), topic, first)
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// AssessmentRepositoryMemory is an in-memory implementation of AssessmentRepository
+type AssessmentRepositoryMemory struct {
+	mu          sync.RWMutex
+	assessments map[domain.ApplicationID][]domain.ApplicationAssessment
+}
+
+// NewAssessmentRepositoryMemory creates a new in-memory assessment repository
+func NewAssessmentRepositoryMemory() *AssessmentRepositoryMemory {
+	return &AssessmentRepositoryMemory{
+		assessments: make(map[domain.ApplicationID][]domain.ApplicationAssessment),
+	}
+}
+
+// Save appends an assessment to the application's history
+func (r *AssessmentRepositoryMemory) Save(ctx context.Context, assessment domain.ApplicationAssessment) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.assessments[assessment.ApplicationID] = append(r.assessments[assessment.ApplicationID], assessment)
+	return nil
+}
+
+// FindByApplicationID returns an application's assessments, oldest first
+func (r *AssessmentRepositoryMemory) FindByApplicationID(ctx context.Context, appID domain.ApplicationID) ([]domain.ApplicationAssessment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	history := r.assessments[appID]
+	assessments := make([]domain.ApplicationAssessment, len(history))
+	copy(assessments, history)
+	return assessments, nil
+}
+
+// FindLatest returns the most recent assessment of an application
+func (r *AssessmentRepositoryMemory) FindLatest(ctx context.Context, appID domain.ApplicationID) (domain.ApplicationAssessment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	history := r.assessments[appID]
+	if len(history) == 0 {
+		return domain.ApplicationAssessment{}, errors.New("assessment not found")
+	}
+
+	latest := history[0]
+	for _, assessment := range history[1:] {
+		if !assessment.AssessedAt.Before(latest.AssessedAt) {
+			latest = assessment
+		}
+	}
+	return latest, nil
+}
+
+// FindByPeriod returns an application's assessments made within the given time range
+func (r *AssessmentRepositoryMemory) FindByPeriod(ctx context.Context, appID domain.ApplicationID, start, end time.Time) ([]domain.ApplicationAssessment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	assessments := make([]domain.ApplicationAssessment, 0)
+	for _, assessment := range r.assessments[appID] {
+		if !assessment.AssessedAt.Before(start) && !assessment.AssessedAt.After(end) {
+			assessments = append(assessments, assessment)
+		}
+	}
+	return assessments, nil
+}
+
+// Update replaces a recorded assessment, e.g. once it has been reviewed or signed off
+func (r *AssessmentRepositoryMemory) Update(ctx context.Context, assessment domain.ApplicationAssessment) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	history := r.assessments[assessment.ApplicationID]
+	for i := range history {
+		if history[i].ID == assessment.ID {
+			history[i] = assessment
+			return nil
+		}
+	}
+	return errors.New("assessment not found")
+}
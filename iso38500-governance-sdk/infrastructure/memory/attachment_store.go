@@ -0,0 +1,135 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// AttachmentStoreMemory is an in-memory implementation of AttachmentStore
+type AttachmentStoreMemory struct {
+	mu       sync.RWMutex
+	evidence map[string]domain.Evidence
+}
+
+// NewAttachmentStoreMemory creates a new in-memory attachment store
+func NewAttachmentStoreMemory() *AttachmentStoreMemory {
+	return &AttachmentStoreMemory{
+		evidence: make(map[string]domain.Evidence),
+	}
+}
+
+// Save saves evidence. Evidence is immutable once attached, so an existing ID is rejected.
+func (r *AttachmentStoreMemory) Save(ctx context.Context, evidence domain.Evidence) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.evidence[evidence.ID]; exists {
+		return errors.New("evidence already exists")
+	}
+	r.evidence[evidence.ID] = evidence
+	return nil
+}
+
+// FindByID finds evidence by ID
+func (r *AttachmentStoreMemory) FindByID(ctx context.Context, id string) (domain.Evidence, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	evidence, exists := r.evidence[id]
+	if !exists {
+		return domain.Evidence{}, errors.New("evidence not found")
+	}
+	return evidence, nil
+}
+
+// FindBySubject returns the evidence attached to an assessment or audit finding, oldest first
+func (r *AttachmentStoreMemory) FindBySubject(ctx context.Context, subject domain.EvidenceSubject) ([]domain.Evidence, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	attached := make([]domain.Evidence, 0)
+	for _, evidence := range r.evidence {
+		if evidence.Subject == subject {
+			attached = append(attached, evidence)
+		}
+	}
+	sort.Slice(attached, func(i, j int) bool {
+		if attached[i].AttachedAt.Equal(attached[j].AttachedAt) {
+			return attached[i].ID < attached[j].ID
+		}
+		return attached[i].AttachedAt.Before(attached[j].AttachedAt)
+	})
+	return attached, nil
+}
+
+// FindByApplicationID returns the evidence attached for an application, oldest first
+func (r *AttachmentStoreMemory) FindByApplicationID(ctx context.Context, appID domain.ApplicationID) ([]domain.Evidence, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	attached := make([]domain.Evidence, 0)
+	for _, evidence := range r.evidence {
+		if evidence.ApplicationID == appID {
+			attached = append(attached, evidence)
+		}
+	}
+	sort.Slice(attached, func(i, j int) bool {
+		if attached[i].AttachedAt.Equal(attached[j].AttachedAt) {
+			return attached[i].ID < attached[j].ID
+		}
+		return attached[i].AttachedAt.Before(attached[j].AttachedAt)
+	})
+	return attached, nil
+}
+
+// FindExpired returns the evidence past its validity period at now whose expiry is not recorded
+// yet, in the order its validity ended
+func (r *AttachmentStoreMemory) FindExpired(ctx context.Context, now time.Time) ([]domain.Evidence, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	expired := make([]domain.Evidence, 0)
+	for _, evidence := range r.evidence {
+		if evidence.Expired(now) && evidence.ExpiredAt.IsZero() {
+			expired = append(expired, evidence)
+		}
+	}
+	sort.Slice(expired, func(i, j int) bool {
+		if expired[i].ValidUntil.Equal(expired[j].ValidUntil) {
+			return expired[i].ID < expired[j].ID
+		}
+		return expired[i].ValidUntil.Before(expired[j].ValidUntil)
+	})
+	return expired, nil
+}
+
+// MarkExpired records when the expiry of evidence was detected. Its content stays as attached.
+func (r *AttachmentStoreMemory) MarkExpired(ctx context.Context, id string, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	evidence, exists := r.evidence[id]
+	if !exists {
+		return errors.New("evidence not found")
+	}
+	evidence.ExpiredAt = at
+	r.evidence[id] = evidence
+	return nil
+}
+
+// Delete deletes evidence
+func (r *AttachmentStoreMemory) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.evidence[id]; !exists {
+		return errors.New("evidence not found")
+	}
+	delete(r.evidence, id)
+	return nil
+}
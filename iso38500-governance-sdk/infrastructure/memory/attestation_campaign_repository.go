@@ -0,0 +1,59 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// AttestationCampaignRepositoryMemory is an in-memory implementation of AttestationCampaignRepository
+type AttestationCampaignRepositoryMemory struct {
+	mu        sync.RWMutex
+	campaigns []domain.AttestationCampaign
+}
+
+// NewAttestationCampaignRepositoryMemory creates a new in-memory attestation campaign repository
+func NewAttestationCampaignRepositoryMemory() *AttestationCampaignRepositoryMemory {
+	return &AttestationCampaignRepositoryMemory{}
+}
+
+// Save saves a campaign round, replacing one with the same ID and keeping the rounds ordered by
+// when they opened
+func (r *AttestationCampaignRepositoryMemory) Save(ctx context.Context, campaign domain.AttestationCampaign) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, existing := range r.campaigns {
+		if existing.ID == campaign.ID {
+			r.campaigns[i] = campaign
+			return nil
+		}
+	}
+	r.campaigns = append(r.campaigns, campaign)
+	sort.SliceStable(r.campaigns, func(i, j int) bool { return r.campaigns[i].OpenedAt.Before(r.campaigns[j].OpenedAt) })
+	return nil
+}
+
+// FindByID finds a campaign round by ID
+func (r *AttestationCampaignRepositoryMemory) FindByID(ctx context.Context, id string) (domain.AttestationCampaign, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, campaign := range r.campaigns {
+		if campaign.ID == id {
+			return campaign, nil
+		}
+	}
+	return domain.AttestationCampaign{}, errors.New("attestation campaign not found")
+}
+
+// FindAll finds every campaign round, oldest first
+func (r *AttestationCampaignRepositoryMemory) FindAll(ctx context.Context) ([]domain.AttestationCampaign, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return append([]domain.AttestationCampaign{}, r.campaigns...), nil
+}
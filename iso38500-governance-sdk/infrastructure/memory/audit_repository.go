@@ -0,0 +1,121 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// AuditRepositoryMemory is an in-memory implementation of AuditRepository
+type AuditRepositoryMemory struct {
+	mu     sync.RWMutex
+	audits map[string]domain.Audit
+}
+
+// NewAuditRepositoryMemory creates a new in-memory audit repository
+func NewAuditRepositoryMemory() *AuditRepositoryMemory {
+	return &AuditRepositoryMemory{
+		audits: make(map[string]domain.Audit),
+	}
+}
+
+// Save saves an audit
+func (r *AuditRepositoryMemory) Save(ctx context.Context, audit domain.Audit) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.audits[audit.ID] = audit
+	return nil
+}
+
+// FindByID finds an audit by ID
+func (r *AuditRepositoryMemory) FindByID(ctx context.Context, id string) (domain.Audit, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	audit, exists := r.audits[id]
+	if !exists {
+		return domain.Audit{}, errors.New("audit not found")
+	}
+	return audit, nil
+}
+
+// FindByApplicationID finds audits by application ID
+func (r *AuditRepositoryMemory) FindByApplicationID(ctx context.Context, appID domain.ApplicationID) ([]domain.Audit, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	audits := make([]domain.Audit, 0)
+	for _, audit := range r.audits {
+		if audit.ApplicationID == appID {
+			audits = append(audits, audit)
+		}
+	}
+	return audits, nil
+}
+
+// FindByStatus finds audits by status
+func (r *AuditRepositoryMemory) FindByStatus(ctx context.Context, status domain.AuditStatus) ([]domain.Audit, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	audits := make([]domain.Audit, 0)
+	for _, audit := range r.audits {
+		if audit.Status == status {
+			audits = append(audits, audit)
+		}
+	}
+	return audits, nil
+}
+
+// FindByPeriod finds audits started, or planned to start, within the given period
+func (r *AuditRepositoryMemory) FindByPeriod(ctx context.Context, start, end time.Time) ([]domain.Audit, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	audits := make([]domain.Audit, 0)
+	for _, audit := range r.audits {
+		if !audit.Start().Before(start) && !audit.Start().After(end) {
+			audits = append(audits, audit)
+		}
+	}
+	return audits, nil
+}
+
+// Update updates an audit
+func (r *AuditRepositoryMemory) Update(ctx context.Context, audit domain.Audit) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.audits[audit.ID]; !exists {
+		return errors.New("audit not found")
+	}
+
+	r.audits[audit.ID] = audit
+	return nil
+}
+
+// Delete deletes an audit
+func (r *AuditRepositoryMemory) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.audits[id]; !exists {
+		return errors.New("audit not found")
+	}
+
+	delete(r.audits, id)
+	return nil
+}
+
+// Exists checks if an audit exists
+func (r *AuditRepositoryMemory) Exists(ctx context.Context, id string) (bool, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	_, exists := r.audits[id]
+	return exists, nil
+}
@@ -0,0 +1,57 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// AuditTrailRepositoryMemory is an in-memory implementation of AuditTrailRepository. Entries
+// can only be appended.
+type AuditTrailRepositoryMemory struct {
+	mu      sync.RWMutex
+	entries []domain.AuditTrailEntry
+}
+
+// NewAuditTrailRepositoryMemory creates a new in-memory audit trail
+func NewAuditTrailRepositoryMemory() *AuditTrailRepositoryMemory {
+	return &AuditTrailRepositoryMemory{}
+}
+
+// Append chains the entry after the last one and stores it
+func (r *AuditTrailRepositoryMemory) Append(ctx context.Context, entry domain.AuditTrailEntry) (domain.AuditTrailEntry, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var last *domain.AuditTrailEntry
+	if len(r.entries) > 0 {
+		last = &r.entries[len(r.entries)-1]
+	}
+	entry.Changes = append([]string{}, entry.Changes...)
+	entry = domain.ChainAuditTrailEntry(last, entry)
+	r.entries = append(r.entries, entry)
+	return entry, nil
+}
+
+// Find finds the entries the query selects, oldest first
+func (r *AuditTrailRepositoryMemory) Find(ctx context.Context, query domain.AuditTrailQuery) ([]domain.AuditTrailEntry, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var entries []domain.AuditTrailEntry
+	for _, entry := range r.entries {
+		if query.Matches(entry) {
+			entries = append(entries, entry)
+		}
+	}
+	return entries, nil
+}
+
+// FindAll finds every entry, oldest first
+func (r *AuditTrailRepositoryMemory) FindAll(ctx context.Context) ([]domain.AuditTrailEntry, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return append([]domain.AuditTrailEntry{}, r.entries...), nil
+}
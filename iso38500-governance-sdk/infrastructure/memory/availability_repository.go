@@ -0,0 +1,76 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// AvailabilityMeasurementRepositoryMemory is an in-memory implementation of AvailabilityMeasurementRepository
+type AvailabilityMeasurementRepositoryMemory struct {
+	mu           sync.RWMutex
+	measurements map[domain.ApplicationID][]domain.AvailabilityMeasurement
+}
+
+// NewAvailabilityMeasurementRepositoryMemory creates a new in-memory availability measurement repository
+func NewAvailabilityMeasurementRepositoryMemory() *AvailabilityMeasurementRepositoryMemory {
+	return &AvailabilityMeasurementRepositoryMemory{
+		measurements: make(map[domain.ApplicationID][]domain.AvailabilityMeasurement),
+	}
+}
+
+// Save appends a measurement to the application's history
+func (r *AvailabilityMeasurementRepositoryMemory) Save(ctx context.Context, measurement domain.AvailabilityMeasurement) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.measurements[measurement.ApplicationID] = append(r.measurements[measurement.ApplicationID], measurement)
+	return nil
+}
+
+// FindByApplicationID returns an application's measurements in the order they were recorded
+func (r *AvailabilityMeasurementRepositoryMemory) FindByApplicationID(ctx context.Context, appID domain.ApplicationID) ([]domain.AvailabilityMeasurement, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	history := r.measurements[appID]
+	measurements := make([]domain.AvailabilityMeasurement, len(history))
+	copy(measurements, history)
+	return measurements, nil
+}
+
+// FindLatest returns the most recent measurement of an application
+func (r *AvailabilityMeasurementRepositoryMemory) FindLatest(ctx context.Context, appID domain.ApplicationID) (domain.AvailabilityMeasurement, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	history := r.measurements[appID]
+	if len(history) == 0 {
+		return domain.AvailabilityMeasurement{}, errors.New("availability measurement not found")
+	}
+
+	latest := history[0]
+	for _, measurement := range history[1:] {
+		if !measurement.MeasuredAt.Before(latest.MeasuredAt) {
+			latest = measurement
+		}
+	}
+	return latest, nil
+}
+
+// FindByPeriod returns an application's measurements taken within the given time range
+func (r *AvailabilityMeasurementRepositoryMemory) FindByPeriod(ctx context.Context, appID domain.ApplicationID, start, end time.Time) ([]domain.AvailabilityMeasurement, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	measurements := make([]domain.AvailabilityMeasurement, 0)
+	for _, measurement := range r.measurements[appID] {
+		if !measurement.MeasuredAt.Before(start) && !measurement.MeasuredAt.After(end) {
+			measurements = append(measurements, measurement)
+		}
+	}
+	return measurements, nil
+}
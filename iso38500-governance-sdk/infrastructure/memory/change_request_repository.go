@@ -0,0 +1,120 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// ChangeRequestRepositoryMemory is an in-memory implementation of ChangeRequestRepository
+type ChangeRequestRepositoryMemory struct {
+	mu             sync.RWMutex
+	changeRequests map[string]domain.ChangeRequest
+}
+
+// NewChangeRequestRepositoryMemory creates a new in-memory change request repository
+func NewChangeRequestRepositoryMemory() *ChangeRequestRepositoryMemory {
+	return &ChangeRequestRepositoryMemory{
+		changeRequests: make(map[string]domain.ChangeRequest),
+	}
+}
+
+// Save saves a change request
+func (r *ChangeRequestRepositoryMemory) Save(ctx context.Context, cr domain.ChangeRequest) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.changeRequests[cr.ID] = cr
+	return nil
+}
+
+// FindByID finds a change request by ID
+func (r *ChangeRequestRepositoryMemory) FindByID(ctx context.Context, id string) (domain.ChangeRequest, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	cr, exists := r.changeRequests[id]
+	if !exists {
+		return domain.ChangeRequest{}, errors.New("change request not found")
+	}
+	return cr, nil
+}
+
+// FindByApplicationID finds change requests by application ID
+func (r *ChangeRequestRepositoryMemory) FindByApplicationID(ctx context.Context, appID domain.ApplicationID) ([]domain.ChangeRequest, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	changeRequests := make([]domain.ChangeRequest, 0)
+	for _, cr := range r.changeRequests {
+		if cr.ApplicationID == appID {
+			changeRequests = append(changeRequests, cr)
+		}
+	}
+	return changeRequests, nil
+}
+
+// FindByStatus finds change requests by status
+func (r *ChangeRequestRepositoryMemory) FindByStatus(ctx context.Context, status domain.ChangeRequestStatus) ([]domain.ChangeRequest, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	changeRequests := make([]domain.ChangeRequest, 0)
+	for _, cr := range r.changeRequests {
+		if cr.Status == status {
+			changeRequests = append(changeRequests, cr)
+		}
+	}
+	return changeRequests, nil
+}
+
+// FindByPriority finds change requests by priority
+func (r *ChangeRequestRepositoryMemory) FindByPriority(ctx context.Context, priority domain.Priority) ([]domain.ChangeRequest, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	changeRequests := make([]domain.ChangeRequest, 0)
+	for _, cr := range r.changeRequests {
+		if cr.Priority == priority {
+			changeRequests = append(changeRequests, cr)
+		}
+	}
+	return changeRequests, nil
+}
+
+// Update updates a change request
+func (r *ChangeRequestRepositoryMemory) Update(ctx context.Context, cr domain.ChangeRequest) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.changeRequests[cr.ID]; !exists {
+		return errors.New("change request not found")
+	}
+
+	r.changeRequests[cr.ID] = cr
+	return nil
+}
+
+// Delete deletes a change request
+func (r *ChangeRequestRepositoryMemory) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.changeRequests[id]; !exists {
+		return errors.New("change request not found")
+	}
+
+	delete(r.changeRequests, id)
+	return nil
+}
+
+// Exists checks if a change request exists
+func (r *ChangeRequestRepositoryMemory) Exists(ctx context.Context, id string) (bool, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	_, exists := r.changeRequests[id]
+	return exists, nil
+}
@@ -0,0 +1,65 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// ComplianceAssessmentRepositoryMemory is an in-memory implementation of ComplianceAssessmentRepository
+type ComplianceAssessmentRepositoryMemory struct {
+	mu          sync.RWMutex
+	assessments []domain.ComplianceAssessment
+}
+
+// NewComplianceAssessmentRepositoryMemory creates a new in-memory compliance assessment repository
+func NewComplianceAssessmentRepositoryMemory() *ComplianceAssessmentRepositoryMemory {
+	return &ComplianceAssessmentRepositoryMemory{}
+}
+
+// Save saves a compliance assessment, replacing one with the same ID and keeping the assessments
+// ordered by when they started
+func (r *ComplianceAssessmentRepositoryMemory) Save(ctx context.Context, assessment domain.ComplianceAssessment) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, existing := range r.assessments {
+		if existing.ID == assessment.ID {
+			r.assessments[i] = assessment
+			return nil
+		}
+	}
+	r.assessments = append(r.assessments, assessment)
+	sort.SliceStable(r.assessments, func(i, j int) bool { return r.assessments[i].StartedAt.Before(r.assessments[j].StartedAt) })
+	return nil
+}
+
+// FindByID finds a compliance assessment by ID
+func (r *ComplianceAssessmentRepositoryMemory) FindByID(ctx context.Context, id string) (domain.ComplianceAssessment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, assessment := range r.assessments {
+		if assessment.ID == id {
+			return assessment, nil
+		}
+	}
+	return domain.ComplianceAssessment{}, errors.New("compliance assessment not found")
+}
+
+// FindByAgreementID finds the compliance assessments of an agreement, oldest first
+func (r *ComplianceAssessmentRepositoryMemory) FindByAgreementID(ctx context.Context, agreementID domain.GovernanceAgreementID) ([]domain.ComplianceAssessment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var assessments []domain.ComplianceAssessment
+	for _, assessment := range r.assessments {
+		if assessment.AgreementID == agreementID {
+			assessments = append(assessments, assessment)
+		}
+	}
+	return assessments, nil
+}
@@ -0,0 +1,185 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// ComplianceRepositoryMemory is an in-memory implementation of ComplianceRepository
+type ComplianceRepositoryMemory struct {
+	mu           sync.RWMutex
+	requirements map[domain.ApplicationID]*applicationRequirements
+}
+
+// applicationRequirements holds the requirements of one application by name
+type applicationRequirements struct {
+	legal       map[string]domain.LegalRequirement
+	contractual map[string]domain.ContractualRequirement
+	standards   map[string]domain.IndustryStandard
+}
+
+// NewComplianceRepositoryMemory creates a new in-memory compliance register
+func NewComplianceRepositoryMemory() *ComplianceRepositoryMemory {
+	return &ComplianceRepositoryMemory{
+		requirements: make(map[domain.ApplicationID]*applicationRequirements),
+	}
+}
+
+// SaveLegalRequirement saves a legal requirement of an application
+func (r *ComplianceRepositoryMemory) SaveLegalRequirement(ctx context.Context, appID domain.ApplicationID, req domain.LegalRequirement) error {
+	if req.Name == "" {
+		return errors.New("requirement name cannot be empty")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.of(appID).legal[req.Name] = req
+	return nil
+}
+
+// SaveContractualRequirement saves a contractual requirement of an application
+func (r *ComplianceRepositoryMemory) SaveContractualRequirement(ctx context.Context, appID domain.ApplicationID, req domain.ContractualRequirement) error {
+	if req.Name == "" {
+		return errors.New("requirement name cannot be empty")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.of(appID).contractual[req.Name] = req
+	return nil
+}
+
+// SaveIndustryStandard saves an industry standard an application is held to
+func (r *ComplianceRepositoryMemory) SaveIndustryStandard(ctx context.Context, appID domain.ApplicationID, standard domain.IndustryStandard) error {
+	if standard.Name == "" {
+		return errors.New("requirement name cannot be empty")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.of(appID).standards[standard.Name] = standard
+	return nil
+}
+
+// FindLegalRequirements finds the legal requirements of an application, by name
+func (r *ComplianceRepositoryMemory) FindLegalRequirements(ctx context.Context, appID domain.ApplicationID) ([]domain.LegalRequirement, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	requirements := make([]domain.LegalRequirement, 0)
+	if registered, exists := r.requirements[appID]; exists {
+		for _, req := range registered.legal {
+			requirements = append(requirements, req)
+		}
+	}
+	sort.Slice(requirements, func(i, j int) bool { return requirements[i].Name < requirements[j].Name })
+	return requirements, nil
+}
+
+// FindContractualRequirements finds the contractual requirements of an application, by name
+func (r *ComplianceRepositoryMemory) FindContractualRequirements(ctx context.Context, appID domain.ApplicationID) ([]domain.ContractualRequirement, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	requirements := make([]domain.ContractualRequirement, 0)
+	if registered, exists := r.requirements[appID]; exists {
+		for _, req := range registered.contractual {
+			requirements = append(requirements, req)
+		}
+	}
+	sort.Slice(requirements, func(i, j int) bool { return requirements[i].Name < requirements[j].Name })
+	return requirements, nil
+}
+
+// FindIndustryStandards finds the industry standards an application is held to, by name
+func (r *ComplianceRepositoryMemory) FindIndustryStandards(ctx context.Context, appID domain.ApplicationID) ([]domain.IndustryStandard, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	standards := make([]domain.IndustryStandard, 0)
+	if registered, exists := r.requirements[appID]; exists {
+		for _, standard := range registered.standards {
+			standards = append(standards, standard)
+		}
+	}
+	sort.Slice(standards, func(i, j int) bool { return standards[i].Name < standards[j].Name })
+	return standards, nil
+}
+
+// UpdateComplianceStatus updates the compliance status of a requirement of an application
+func (r *ComplianceRepositoryMemory) UpdateComplianceStatus(ctx context.Context, appID domain.ApplicationID, ref domain.RequirementRef, status domain.ComplianceStatus) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	registered, exists := r.requirements[appID]
+	if !exists {
+		return errors.New("requirement not found")
+	}
+	switch ref.Kind {
+	case domain.RequirementLegal:
+		if req, exists := registered.legal[ref.Name]; exists {
+			req.Status = status
+			registered.legal[ref.Name] = req
+			return nil
+		}
+	case domain.RequirementContractual:
+		if req, exists := registered.contractual[ref.Name]; exists {
+			req.Status = status
+			registered.contractual[ref.Name] = req
+			return nil
+		}
+	case domain.RequirementIndustryStandard:
+		if standard, exists := registered.standards[ref.Name]; exists {
+			standard.Status = status
+			registered.standards[ref.Name] = standard
+			return nil
+		}
+	}
+	return errors.New("requirement not found")
+}
+
+// DeleteRequirement deletes a requirement of an application
+func (r *ComplianceRepositoryMemory) DeleteRequirement(ctx context.Context, appID domain.ApplicationID, ref domain.RequirementRef) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	registered, exists := r.requirements[appID]
+	if !exists {
+		return errors.New("requirement not found")
+	}
+	found := false
+	switch ref.Kind {
+	case domain.RequirementLegal:
+		_, found = registered.legal[ref.Name]
+		delete(registered.legal, ref.Name)
+	case domain.RequirementContractual:
+		_, found = registered.contractual[ref.Name]
+		delete(registered.contractual, ref.Name)
+	case domain.RequirementIndustryStandard:
+		_, found = registered.standards[ref.Name]
+		delete(registered.standards, ref.Name)
+	}
+	if !found {
+		return errors.New("requirement not found")
+	}
+	return nil
+}
+
+// of returns the requirements of an application, creating them on first use. The caller holds
+// the write lock.
+func (r *ComplianceRepositoryMemory) of(appID domain.ApplicationID) *applicationRequirements {
+	registered, exists := r.requirements[appID]
+	if !exists {
+		registered = &applicationRequirements{
+			legal:       make(map[string]domain.LegalRequirement),
+			contractual: make(map[string]domain.ContractualRequirement),
+			standards:   make(map[string]domain.IndustryStandard),
+		}
+		r.requirements[appID] = registered
+	}
+	return registered
+}
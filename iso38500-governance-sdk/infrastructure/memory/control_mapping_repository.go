@@ -0,0 +1,62 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// ControlMappingRepositoryMemory is an in-memory implementation of ControlMappingRepository
+type ControlMappingRepositoryMemory struct {
+	mu       sync.RWMutex
+	mappings map[string]domain.ControlMapping
+}
+
+// NewControlMappingRepositoryMemory creates a new in-memory control mapping repository holding
+// the mappings given
+func NewControlMappingRepositoryMemory(mappings ...domain.ControlMapping) *ControlMappingRepositoryMemory {
+	r := &ControlMappingRepositoryMemory{
+		mappings: make(map[string]domain.ControlMapping),
+	}
+	for _, mapping := range mappings {
+		r.mappings[mapping.ID] = mapping
+	}
+	return r
+}
+
+// Save adds a control mapping or replaces the one with its ID
+func (r *ControlMappingRepositoryMemory) Save(ctx context.Context, mapping domain.ControlMapping) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.mappings[mapping.ID] = mapping
+	return nil
+}
+
+// FindByID finds a control mapping by ID
+func (r *ControlMappingRepositoryMemory) FindByID(ctx context.Context, id string) (domain.ControlMapping, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	mapping, exists := r.mappings[id]
+	if !exists {
+		return domain.ControlMapping{}, errors.New("control mapping not found")
+	}
+	return mapping, nil
+}
+
+// FindAll finds all control mappings, ordered by ID
+func (r *ControlMappingRepositoryMemory) FindAll(ctx context.Context) ([]domain.ControlMapping, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	mappings := make([]domain.ControlMapping, 0, len(r.mappings))
+	for _, mapping := range r.mappings {
+		mappings = append(mappings, mapping)
+	}
+	sort.Slice(mappings, func(i, j int) bool { return mappings[i].ID < mappings[j].ID })
+	return mappings, nil
+}
@@ -0,0 +1,90 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// DecisionRepositoryMemory is an in-memory implementation of DecisionRepository
+type DecisionRepositoryMemory struct {
+	mu        sync.RWMutex
+	decisions map[string]domain.Decision
+}
+
+// NewDecisionRepositoryMemory creates a new in-memory decision repository
+func NewDecisionRepositoryMemory() *DecisionRepositoryMemory {
+	return &DecisionRepositoryMemory{
+		decisions: make(map[string]domain.Decision),
+	}
+}
+
+// Save saves a decision
+func (r *DecisionRepositoryMemory) Save(ctx context.Context, decision domain.Decision) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.decisions[decision.ID] = decision
+	return nil
+}
+
+// FindByID finds a decision by ID
+func (r *DecisionRepositoryMemory) FindByID(ctx context.Context, id string) (domain.Decision, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	decision, exists := r.decisions[id]
+	if !exists {
+		return domain.Decision{}, errors.New("decision not found")
+	}
+	return decision, nil
+}
+
+// FindByAgreementID finds the decisions linked to a governance agreement, oldest first
+func (r *DecisionRepositoryMemory) FindByAgreementID(ctx context.Context, agreementID domain.GovernanceAgreementID) ([]domain.Decision, error) {
+	return r.find(func(decision domain.Decision) bool { return decision.AgreementID == agreementID }), nil
+}
+
+// FindByApplicationID finds the decisions linked to an application, oldest first
+func (r *DecisionRepositoryMemory) FindByApplicationID(ctx context.Context, appID domain.ApplicationID) ([]domain.Decision, error) {
+	return r.find(func(decision domain.Decision) bool { return decision.ApplicationID == appID }), nil
+}
+
+// FindAll finds all decisions, oldest first
+func (r *DecisionRepositoryMemory) FindAll(ctx context.Context) ([]domain.Decision, error) {
+	return r.find(func(domain.Decision) bool { return true }), nil
+}
+
+// Update updates a decision
+func (r *DecisionRepositoryMemory) Update(ctx context.Context, decision domain.Decision) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.decisions[decision.ID]; !exists {
+		return errors.New("decision not found")
+	}
+	r.decisions[decision.ID] = decision
+	return nil
+}
+
+func (r *DecisionRepositoryMemory) find(match func(domain.Decision) bool) []domain.Decision {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	decisions := make([]domain.Decision, 0)
+	for _, decision := range r.decisions {
+		if match(decision) {
+			decisions = append(decisions, decision)
+		}
+	}
+	sort.Slice(decisions, func(i, j int) bool {
+		if decisions[i].DecidedAt.Equal(decisions[j].DecidedAt) {
+			return decisions[i].ID < decisions[j].ID
+		}
+		return decisions[i].DecidedAt.Before(decisions[j].DecidedAt)
+	})
+	return decisions
+}
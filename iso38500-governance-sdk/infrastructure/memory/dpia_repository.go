@@ -0,0 +1,97 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// DPIARepositoryMemory is an in-memory implementation of DPIARepository
+type DPIARepositoryMemory struct {
+	mu    sync.RWMutex
+	dpias map[string]domain.DPIA
+}
+
+// NewDPIARepositoryMemory creates a new in-memory DPIA repository
+func NewDPIARepositoryMemory() *DPIARepositoryMemory {
+	return &DPIARepositoryMemory{
+		dpias: make(map[string]domain.DPIA),
+	}
+}
+
+// Save saves a DPIA
+func (r *DPIARepositoryMemory) Save(ctx context.Context, dpia domain.DPIA) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.dpias[dpia.ID] = dpia
+	return nil
+}
+
+// FindByID finds a DPIA by ID
+func (r *DPIARepositoryMemory) FindByID(ctx context.Context, id string) (domain.DPIA, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	dpia, exists := r.dpias[id]
+	if !exists {
+		return domain.DPIA{}, errors.New("DPIA not found")
+	}
+	return dpia, nil
+}
+
+// FindByApplicationID finds the DPIAs of an application, oldest first
+func (r *DPIARepositoryMemory) FindByApplicationID(ctx context.Context, appID domain.ApplicationID) ([]domain.DPIA, error) {
+	return r.find(func(dpia domain.DPIA) bool { return dpia.ApplicationID == appID }), nil
+}
+
+// FindAll finds all DPIAs, oldest first
+func (r *DPIARepositoryMemory) FindAll(ctx context.Context) ([]domain.DPIA, error) {
+	return r.find(func(domain.DPIA) bool { return true }), nil
+}
+
+// Update updates a DPIA
+func (r *DPIARepositoryMemory) Update(ctx context.Context, dpia domain.DPIA) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.dpias[dpia.ID]; !exists {
+		return errors.New("DPIA not found")
+	}
+	r.dpias[dpia.ID] = dpia
+	return nil
+}
+
+// Delete deletes a DPIA
+func (r *DPIARepositoryMemory) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.dpias[id]; !exists {
+		return errors.New("DPIA not found")
+	}
+	delete(r.dpias, id)
+	return nil
+}
+
+func (r *DPIARepositoryMemory) find(match func(domain.DPIA) bool) []domain.DPIA {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	dpias := make([]domain.DPIA, 0)
+	for _, dpia := range r.dpias {
+		if match(dpia) {
+			dpias = append(dpias, dpia)
+		}
+	}
+	sort.Slice(dpias, func(i, j int) bool {
+		if !dpias[i].CreatedAt.Equal(dpias[j].CreatedAt) {
+			return dpias[i].CreatedAt.Before(dpias[j].CreatedAt)
+		}
+		return dpias[i].ID < dpias[j].ID
+	})
+	return dpias
+}
@@ -0,0 +1,58 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// EscalationRepositoryMemory is an in-memory implementation of EscalationRepository
+type EscalationRepositoryMemory struct {
+	mu    sync.RWMutex
+	steps []domain.EscalationStep
+}
+
+// NewEscalationRepositoryMemory creates a new in-memory escalation repository
+func NewEscalationRepositoryMemory() *EscalationRepositoryMemory {
+	return &EscalationRepositoryMemory{}
+}
+
+// Save saves an escalation step, keeping the steps ordered by time
+func (r *EscalationRepositoryMemory) Save(ctx context.Context, step domain.EscalationStep) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.steps = append(r.steps, step)
+	sort.SliceStable(r.steps, func(i, j int) bool { return r.steps[i].EscalatedAt.Before(r.steps[j].EscalatedAt) })
+	return nil
+}
+
+// FindBySubject finds the escalation steps of an alert, incident or change request, oldest first
+func (r *EscalationRepositoryMemory) FindBySubject(ctx context.Context, subject domain.EscalationSubject, subjectID string) ([]domain.EscalationStep, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var steps []domain.EscalationStep
+	for _, step := range r.steps {
+		if step.Subject == subject && step.SubjectID == subjectID {
+			steps = append(steps, step)
+		}
+	}
+	return steps, nil
+}
+
+// FindByApplicationID finds the escalation steps of an application, oldest first
+func (r *EscalationRepositoryMemory) FindByApplicationID(ctx context.Context, appID domain.ApplicationID) ([]domain.EscalationStep, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var steps []domain.EscalationStep
+	for _, step := range r.steps {
+		if step.ApplicationID == appID {
+			steps = append(steps, step)
+		}
+	}
+	return steps, nil
+}
@@ -0,0 +1,102 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// EvaluationScheduleRepositoryMemory is an in-memory implementation of EvaluationScheduleRepository
+type EvaluationScheduleRepositoryMemory struct {
+	mu        sync.RWMutex
+	schedules map[string]domain.EvaluationSchedule
+}
+
+// NewEvaluationScheduleRepositoryMemory creates a new in-memory evaluation schedule repository
+func NewEvaluationScheduleRepositoryMemory() *EvaluationScheduleRepositoryMemory {
+	return &EvaluationScheduleRepositoryMemory{
+		schedules: make(map[string]domain.EvaluationSchedule),
+	}
+}
+
+// Save saves an evaluation schedule
+func (r *EvaluationScheduleRepositoryMemory) Save(ctx context.Context, schedule domain.EvaluationSchedule) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.schedules[schedule.ID] = schedule
+	return nil
+}
+
+// FindByID finds an evaluation schedule by ID
+func (r *EvaluationScheduleRepositoryMemory) FindByID(ctx context.Context, id string) (domain.EvaluationSchedule, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	schedule, exists := r.schedules[id]
+	if !exists {
+		return domain.EvaluationSchedule{}, errors.New("evaluation schedule not found")
+	}
+	return schedule, nil
+}
+
+// FindAll returns every evaluation schedule ordered by ID
+func (r *EvaluationScheduleRepositoryMemory) FindAll(ctx context.Context) ([]domain.EvaluationSchedule, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	schedules := make([]domain.EvaluationSchedule, 0, len(r.schedules))
+	for _, schedule := range r.schedules {
+		schedules = append(schedules, schedule)
+	}
+	sort.Slice(schedules, func(i, j int) bool { return schedules[i].ID < schedules[j].ID })
+	return schedules, nil
+}
+
+// FindDue returns the enabled schedules whose next run is at or before now, earliest first
+func (r *EvaluationScheduleRepositoryMemory) FindDue(ctx context.Context, now time.Time) ([]domain.EvaluationSchedule, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	schedules := make([]domain.EvaluationSchedule, 0)
+	for _, schedule := range r.schedules {
+		if schedule.IsDue(now) {
+			schedules = append(schedules, schedule)
+		}
+	}
+	sort.Slice(schedules, func(i, j int) bool {
+		if schedules[i].NextRunAt.Equal(schedules[j].NextRunAt) {
+			return schedules[i].ID < schedules[j].ID
+		}
+		return schedules[i].NextRunAt.Before(schedules[j].NextRunAt)
+	})
+	return schedules, nil
+}
+
+// Update updates an evaluation schedule
+func (r *EvaluationScheduleRepositoryMemory) Update(ctx context.Context, schedule domain.EvaluationSchedule) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.schedules[schedule.ID]; !exists {
+		return errors.New("evaluation schedule not found")
+	}
+	r.schedules[schedule.ID] = schedule
+	return nil
+}
+
+// Delete deletes an evaluation schedule
+func (r *EvaluationScheduleRepositoryMemory) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.schedules[id]; !exists {
+		return errors.New("evaluation schedule not found")
+	}
+	delete(r.schedules, id)
+	return nil
+}
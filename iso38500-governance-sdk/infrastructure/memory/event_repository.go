@@ -2,6 +2,7 @@ package memory
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/iso38500/iso38500-governance-sdk/domain"
@@ -9,6 +10,7 @@ import (
 
 // DomainEventRepositoryMemory is an in-memory implementation of DomainEventRepository
 type DomainEventRepositoryMemory struct {
+	mu     sync.RWMutex
 	events []domain.DomainEvent
 }
 
@@ -21,22 +23,39 @@ func NewDomainEventRepositoryMemory() *DomainEventRepositoryMemory {
 
 // Save saves a domain event
 func (r *DomainEventRepositoryMemory) Save(ctx context.Context, event domain.DomainEvent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
 	r.events = append(r.events, event)
 	return nil
 }
 
-// FindByAggregateID finds events by aggregate ID
+// FindByAggregateID finds the events recorded against the aggregate, see domain.AggregateOf
 func (r *DomainEventRepositoryMemory) FindByAggregateID(ctx context.Context, aggregateID string) ([]domain.DomainEvent, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
 	var result []domain.DomainEvent
 	for _, event := range r.events {
-		// This is a simplified implementation - in practice, events would need to be associated with aggregates
-		result = append(result, event)
+		if _, id := domain.AggregateOf(event); id == aggregateID {
+			result = append(result, event)
+		}
 	}
 	return result, nil
 }
 
+// Envelopes returns the events recorded against the aggregate in envelopes, numbered from 1 in
+// the order they were saved
+func (r *DomainEventRepositoryMemory) Envelopes(ctx context.Context, aggregateID string) ([]domain.EventEnvelope, error) {
+	events, err := r.FindByAggregateID(ctx, aggregateID)
+	if err != nil {
+		return nil, err
+	}
+	return domain.EnvelopeEvents(events)
+}
+
 // FindByEventType finds events by event type
 func (r *DomainEventRepositoryMemory) FindByEventType(ctx context.Context, eventType string) ([]domain.DomainEvent, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
 	var result []domain.DomainEvent
 	for _, event := range r.events {
 		if event.EventType() == eventType {
@@ -46,11 +65,14 @@ func (r *DomainEventRepositoryMemory) FindByEventType(ctx context.Context, event
 	return result, nil
 }
 
-// FindByTimeRange finds events by time range
+// FindByTimeRange finds the events that occurred between start and end, inclusive, in the order
+// they were saved
 func (r *DomainEventRepositoryMemory) FindByTimeRange(ctx context.Context, start, end time.Time) ([]domain.DomainEvent, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
 	var result []domain.DomainEvent
 	for _, event := range r.events {
-		if event.Time().After(start) && event.Time().Before(end) {
+		if !event.Time().Before(start) && !event.Time().After(end) {
 			result = append(result, event)
 		}
 	}
@@ -0,0 +1,65 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// ExecutiveDigestRepositoryMemory is an in-memory implementation of ExecutiveDigestRepository
+type ExecutiveDigestRepositoryMemory struct {
+	mu      sync.RWMutex
+	digests []domain.ExecutiveDigest
+}
+
+// NewExecutiveDigestRepositoryMemory creates a new in-memory executive digest repository
+func NewExecutiveDigestRepositoryMemory() *ExecutiveDigestRepositoryMemory {
+	return &ExecutiveDigestRepositoryMemory{}
+}
+
+// Save saves an executive digest, replacing one with the same ID and keeping the digests ordered
+// by time
+func (r *ExecutiveDigestRepositoryMemory) Save(ctx context.Context, digest domain.ExecutiveDigest) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, existing := range r.digests {
+		if existing.ID == digest.ID {
+			r.digests[i] = digest
+			return nil
+		}
+	}
+	r.digests = append(r.digests, digest)
+	sort.SliceStable(r.digests, func(i, j int) bool { return r.digests[i].GeneratedAt.Before(r.digests[j].GeneratedAt) })
+	return nil
+}
+
+// FindByID finds an executive digest by ID
+func (r *ExecutiveDigestRepositoryMemory) FindByID(ctx context.Context, id string) (domain.ExecutiveDigest, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, digest := range r.digests {
+		if digest.ID == id {
+			return digest, nil
+		}
+	}
+	return domain.ExecutiveDigest{}, errors.New("executive digest not found")
+}
+
+// FindByPortfolioID finds the executive digests of a portfolio, oldest first
+func (r *ExecutiveDigestRepositoryMemory) FindByPortfolioID(ctx context.Context, portfolioID domain.PortfolioID) ([]domain.ExecutiveDigest, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var digests []domain.ExecutiveDigest
+	for _, digest := range r.digests {
+		if digest.PortfolioID == portfolioID {
+			digests = append(digests, digest)
+		}
+	}
+	return digests, nil
+}
@@ -0,0 +1,62 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// FrameworkRepositoryMemory is an in-memory implementation of FrameworkRepository
+type FrameworkRepositoryMemory struct {
+	mu         sync.RWMutex
+	frameworks map[string]domain.ComplianceFramework
+}
+
+// NewFrameworkRepositoryMemory creates a new in-memory framework repository holding the
+// frameworks given
+func NewFrameworkRepositoryMemory(frameworks ...domain.ComplianceFramework) *FrameworkRepositoryMemory {
+	r := &FrameworkRepositoryMemory{
+		frameworks: make(map[string]domain.ComplianceFramework),
+	}
+	for _, framework := range frameworks {
+		r.frameworks[framework.ID] = framework
+	}
+	return r
+}
+
+// Save adds a compliance framework or replaces the one with its ID
+func (r *FrameworkRepositoryMemory) Save(ctx context.Context, framework domain.ComplianceFramework) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.frameworks[framework.ID] = framework
+	return nil
+}
+
+// FindByID finds a compliance framework by ID
+func (r *FrameworkRepositoryMemory) FindByID(ctx context.Context, id string) (domain.ComplianceFramework, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	framework, exists := r.frameworks[id]
+	if !exists {
+		return domain.ComplianceFramework{}, errors.New("compliance framework not found")
+	}
+	return framework, nil
+}
+
+// FindAll finds all compliance frameworks, ordered by ID
+func (r *FrameworkRepositoryMemory) FindAll(ctx context.Context) ([]domain.ComplianceFramework, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	frameworks := make([]domain.ComplianceFramework, 0, len(r.frameworks))
+	for _, framework := range r.frameworks {
+		frameworks = append(frameworks, framework)
+	}
+	sort.Slice(frameworks, func(i, j int) bool { return frameworks[i].ID < frameworks[j].ID })
+	return frameworks, nil
+}
@@ -0,0 +1,134 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// IncidentRepositoryMemory is an in-memory implementation of IncidentRepository
+type IncidentRepositoryMemory struct {
+	mu        sync.RWMutex
+	incidents map[string]domain.Incident
+}
+
+// NewIncidentRepositoryMemory creates a new in-memory incident repository
+func NewIncidentRepositoryMemory() *IncidentRepositoryMemory {
+	return &IncidentRepositoryMemory{
+		incidents: make(map[string]domain.Incident),
+	}
+}
+
+// Save saves an incident
+func (r *IncidentRepositoryMemory) Save(ctx context.Context, incident domain.Incident) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.incidents[incident.ID] = incident
+	return nil
+}
+
+// FindByID finds an incident by ID
+func (r *IncidentRepositoryMemory) FindByID(ctx context.Context, id string) (domain.Incident, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	incident, exists := r.incidents[id]
+	if !exists {
+		return domain.Incident{}, errors.New("incident not found")
+	}
+	return incident, nil
+}
+
+// FindByApplicationID finds incidents by application ID
+func (r *IncidentRepositoryMemory) FindByApplicationID(ctx context.Context, appID domain.ApplicationID) ([]domain.Incident, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	incidents := make([]domain.Incident, 0)
+	for _, incident := range r.incidents {
+		if incident.ApplicationID == appID {
+			incidents = append(incidents, incident)
+		}
+	}
+	return incidents, nil
+}
+
+// FindByStatus finds incidents by status
+func (r *IncidentRepositoryMemory) FindByStatus(ctx context.Context, status domain.IncidentStatus) ([]domain.Incident, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	incidents := make([]domain.Incident, 0)
+	for _, incident := range r.incidents {
+		if incident.Status == status {
+			incidents = append(incidents, incident)
+		}
+	}
+	return incidents, nil
+}
+
+// FindBySeverity finds incidents by severity
+func (r *IncidentRepositoryMemory) FindBySeverity(ctx context.Context, severity int) ([]domain.Incident, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	incidents := make([]domain.Incident, 0)
+	for _, incident := range r.incidents {
+		if incident.Severity == severity {
+			incidents = append(incidents, incident)
+		}
+	}
+	return incidents, nil
+}
+
+// FindByAssignee finds incidents by assignee
+func (r *IncidentRepositoryMemory) FindByAssignee(ctx context.Context, assignee string) ([]domain.Incident, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	incidents := make([]domain.Incident, 0)
+	for _, incident := range r.incidents {
+		if incident.Assignee == assignee {
+			incidents = append(incidents, incident)
+		}
+	}
+	return incidents, nil
+}
+
+// Update updates an incident
+func (r *IncidentRepositoryMemory) Update(ctx context.Context, incident domain.Incident) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.incidents[incident.ID]; !exists {
+		return errors.New("incident not found")
+	}
+
+	r.incidents[incident.ID] = incident
+	return nil
+}
+
+// Delete deletes an incident
+func (r *IncidentRepositoryMemory) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.incidents[id]; !exists {
+		return errors.New("incident not found")
+	}
+
+	delete(r.incidents, id)
+	return nil
+}
+
+// Exists checks if an incident exists
+func (r *IncidentRepositoryMemory) Exists(ctx context.Context, id string) (bool, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	_, exists := r.incidents[id]
+	return exists, nil
+}
@@ -0,0 +1,95 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// itsmLinkKey identifies the record an ITSM link is for
+type itsmLinkKey struct {
+	kind    domain.ITSMRecordKind
+	localID string
+}
+
+// ITSMLinkRepositoryMemory is an in-memory implementation of ITSMLinkRepository
+type ITSMLinkRepositoryMemory struct {
+	mu    sync.RWMutex
+	links map[itsmLinkKey]domain.ITSMLink
+}
+
+// NewITSMLinkRepositoryMemory creates a new in-memory ITSM link repository
+func NewITSMLinkRepositoryMemory() *ITSMLinkRepositoryMemory {
+	return &ITSMLinkRepositoryMemory{
+		links: make(map[itsmLinkKey]domain.ITSMLink),
+	}
+}
+
+// Save saves a link, replacing the link of the same record
+func (r *ITSMLinkRepositoryMemory) Save(ctx context.Context, link domain.ITSMLink) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	link.Comments = append([]domain.ITSMComment{}, link.Comments...)
+	r.links[itsmLinkKey{link.Kind, link.LocalID}] = link
+	return nil
+}
+
+// Find finds the link of a change request or incident
+func (r *ITSMLinkRepositoryMemory) Find(ctx context.Context, kind domain.ITSMRecordKind, localID string) (domain.ITSMLink, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	link, exists := r.links[itsmLinkKey{kind, localID}]
+	if !exists {
+		return domain.ITSMLink{}, domain.ErrITSMLinkNotFound
+	}
+	link.Comments = append([]domain.ITSMComment{}, link.Comments...)
+	return link, nil
+}
+
+// FindByConnector finds the links to the records of an ITSM connector, by kind and local ID
+func (r *ITSMLinkRepositoryMemory) FindByConnector(ctx context.Context, connector string) ([]domain.ITSMLink, error) {
+	return r.find(func(link domain.ITSMLink) bool { return link.Connector == connector }), nil
+}
+
+// FindAll finds every link, by kind and local ID
+func (r *ITSMLinkRepositoryMemory) FindAll(ctx context.Context) ([]domain.ITSMLink, error) {
+	return r.find(func(domain.ITSMLink) bool { return true }), nil
+}
+
+// Delete deletes the link of a change request or incident
+func (r *ITSMLinkRepositoryMemory) Delete(ctx context.Context, kind domain.ITSMRecordKind, localID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := itsmLinkKey{kind, localID}
+	if _, exists := r.links[key]; !exists {
+		return domain.ErrITSMLinkNotFound
+	}
+	delete(r.links, key)
+	return nil
+}
+
+// find returns the links matching the filter, by kind and local ID
+func (r *ITSMLinkRepositoryMemory) find(match func(domain.ITSMLink) bool) []domain.ITSMLink {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	links := make([]domain.ITSMLink, 0)
+	for _, link := range r.links {
+		if match(link) {
+			link.Comments = append([]domain.ITSMComment{}, link.Comments...)
+			links = append(links, link)
+		}
+	}
+	sort.Slice(links, func(i, j int) bool {
+		if links[i].Kind != links[j].Kind {
+			return links[i].Kind < links[j].Kind
+		}
+		return links[i].LocalID < links[j].LocalID
+	})
+	return links
+}
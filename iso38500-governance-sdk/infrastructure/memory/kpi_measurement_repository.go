@@ -0,0 +1,121 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// KPIMeasurementRepositoryMemory is an in-memory implementation of KPIMeasurementRepository.
+// Each KPI's measurements are kept as a time series ordered by measurement time, so range
+// queries are binary searches.
+type KPIMeasurementRepositoryMemory struct {
+	mu           sync.RWMutex
+	measurements map[string][]domain.KPIMeasurement
+}
+
+// NewKPIMeasurementRepositoryMemory creates a new in-memory KPI measurement repository
+func NewKPIMeasurementRepositoryMemory() *KPIMeasurementRepositoryMemory {
+	return &KPIMeasurementRepositoryMemory{
+		measurements: make(map[string][]domain.KPIMeasurement),
+	}
+}
+
+// Save inserts a measurement into the KPI's time series, after any taken at the same time
+func (r *KPIMeasurementRepositoryMemory) Save(ctx context.Context, measurement domain.KPIMeasurement) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	history := r.measurements[measurement.KPIID]
+	i := sort.Search(len(history), func(i int) bool {
+		return history[i].MeasuredAt.After(measurement.MeasuredAt)
+	})
+	history = append(history, domain.KPIMeasurement{})
+	copy(history[i+1:], history[i:])
+	history[i] = measurement
+	r.measurements[measurement.KPIID] = history
+	return nil
+}
+
+// FindByKPIID returns a KPI's measurements, oldest first
+func (r *KPIMeasurementRepositoryMemory) FindByKPIID(ctx context.Context, kpiID string) ([]domain.KPIMeasurement, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	history := r.measurements[kpiID]
+	measurements := make([]domain.KPIMeasurement, len(history))
+	copy(measurements, history)
+	return measurements, nil
+}
+
+// KPIIDs returns the IDs of the KPIs with measurements, sorted
+func (r *KPIMeasurementRepositoryMemory) KPIIDs() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ids := make([]string, 0, len(r.measurements))
+	for kpiID, history := range r.measurements {
+		if len(history) > 0 {
+			ids = append(ids, kpiID)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// FindByPeriod returns a KPI's measurements taken within the given time range, inclusive, oldest
+// first
+func (r *KPIMeasurementRepositoryMemory) FindByPeriod(ctx context.Context, kpiID string, start, end time.Time) ([]domain.KPIMeasurement, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	history := r.measurements[kpiID]
+	first := sort.Search(len(history), func(i int) bool {
+		return !history[i].MeasuredAt.Before(start)
+	})
+	last := sort.Search(len(history), func(i int) bool {
+		return history[i].MeasuredAt.After(end)
+	})
+
+	measurements := make([]domain.KPIMeasurement, 0)
+	if first < last {
+		measurements = append(measurements, history[first:last]...)
+	}
+	return measurements, nil
+}
+
+// FindLatest returns the most recent measurement of a KPI
+func (r *KPIMeasurementRepositoryMemory) FindLatest(ctx context.Context, kpiID string) (domain.KPIMeasurement, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	history := r.measurements[kpiID]
+	if len(history) == 0 {
+		return domain.KPIMeasurement{}, errors.New("KPI measurement not found")
+	}
+
+	return history[len(history)-1], nil
+}
+
+// Delete removes the KPI's measurements taken at the given time
+func (r *KPIMeasurementRepositoryMemory) Delete(ctx context.Context, kpiID string, measuredAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	history := r.measurements[kpiID]
+	kept := make([]domain.KPIMeasurement, 0, len(history))
+	for _, measurement := range history {
+		if !measurement.MeasuredAt.Equal(measuredAt) {
+			kept = append(kept, measurement)
+		}
+	}
+	if len(kept) == len(history) {
+		return errors.New("KPI measurement not found")
+	}
+	r.measurements[kpiID] = kept
+	return nil
+}
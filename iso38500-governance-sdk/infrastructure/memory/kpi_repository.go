@@ -0,0 +1,101 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// KPIRepositoryMemory is an in-memory implementation of KPIRepository
+type KPIRepositoryMemory struct {
+	mu   sync.RWMutex
+	kpis map[string]domain.KPI
+}
+
+// NewKPIRepositoryMemory creates a new in-memory KPI repository
+func NewKPIRepositoryMemory() *KPIRepositoryMemory {
+	return &KPIRepositoryMemory{
+		kpis: make(map[string]domain.KPI),
+	}
+}
+
+// Save saves a KPI
+func (r *KPIRepositoryMemory) Save(ctx context.Context, kpi domain.KPI) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.kpis[kpi.ID] = kpi
+	return nil
+}
+
+// FindByID finds a KPI by ID
+func (r *KPIRepositoryMemory) FindByID(ctx context.Context, id string) (domain.KPI, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	kpi, exists := r.kpis[id]
+	if !exists {
+		return domain.KPI{}, errors.New("KPI not found")
+	}
+	return kpi, nil
+}
+
+// FindAll finds all KPIs, ordered by ID
+func (r *KPIRepositoryMemory) FindAll(ctx context.Context) ([]domain.KPI, error) {
+	return r.find(func(domain.KPI) bool { return true }), nil
+}
+
+// FindByCategory finds the KPIs of a category, ordered by ID
+func (r *KPIRepositoryMemory) FindByCategory(ctx context.Context, category string) ([]domain.KPI, error) {
+	return r.find(func(kpi domain.KPI) bool { return kpi.Category == category }), nil
+}
+
+// Update updates a KPI
+func (r *KPIRepositoryMemory) Update(ctx context.Context, kpi domain.KPI) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.kpis[kpi.ID]; !exists {
+		return errors.New("KPI not found")
+	}
+	r.kpis[kpi.ID] = kpi
+	return nil
+}
+
+// Delete deletes a KPI
+func (r *KPIRepositoryMemory) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.kpis[id]; !exists {
+		return errors.New("KPI not found")
+	}
+	delete(r.kpis, id)
+	return nil
+}
+
+// Exists checks if a KPI exists
+func (r *KPIRepositoryMemory) Exists(ctx context.Context, id string) (bool, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	_, exists := r.kpis[id]
+	return exists, nil
+}
+
+func (r *KPIRepositoryMemory) find(match func(domain.KPI) bool) []domain.KPI {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	kpis := make([]domain.KPI, 0)
+	for _, kpi := range r.kpis {
+		if match(kpi) {
+			kpis = append(kpis, kpi)
+		}
+	}
+	sort.Slice(kpis, func(i, j int) bool { return kpis[i].ID < kpis[j].ID })
+	return kpis
+}
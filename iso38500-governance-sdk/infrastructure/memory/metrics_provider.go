@@ -0,0 +1,44 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// MetricsProviderMemory is an in-memory MetricsProvider holding the latest metrics recorded for
+// each application
+type MetricsProviderMemory struct {
+	mu      sync.RWMutex
+	metrics map[domain.ApplicationID]domain.ApplicationMetrics
+}
+
+// NewMetricsProviderMemory creates a new in-memory metrics provider
+func NewMetricsProviderMemory() *MetricsProviderMemory {
+	return &MetricsProviderMemory{
+		metrics: make(map[domain.ApplicationID]domain.ApplicationMetrics),
+	}
+}
+
+// Save records an application's metrics, replacing any recorded before
+func (r *MetricsProviderMemory) Save(ctx context.Context, metrics domain.ApplicationMetrics) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.metrics[metrics.ApplicationID] = metrics
+	return nil
+}
+
+// ApplicationMetrics returns the metrics recorded for an application
+func (r *MetricsProviderMemory) ApplicationMetrics(ctx context.Context, appID domain.ApplicationID) (domain.ApplicationMetrics, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	metrics, exists := r.metrics[appID]
+	if !exists {
+		return domain.ApplicationMetrics{}, errors.New("application metrics not found")
+	}
+	return metrics, nil
+}
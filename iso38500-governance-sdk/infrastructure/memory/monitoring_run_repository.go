@@ -0,0 +1,54 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// MonitoringRunRepositoryMemory is an in-memory implementation of MonitoringRunRepository
+type MonitoringRunRepositoryMemory struct {
+	mu   sync.RWMutex
+	runs map[domain.GovernanceAgreementID][]domain.MonitoringRun
+}
+
+// NewMonitoringRunRepositoryMemory creates a new in-memory monitoring run repository
+func NewMonitoringRunRepositoryMemory() *MonitoringRunRepositoryMemory {
+	return &MonitoringRunRepositoryMemory{
+		runs: make(map[domain.GovernanceAgreementID][]domain.MonitoringRun),
+	}
+}
+
+// Save saves a monitoring run, keeping each agreement's runs ordered by time
+func (r *MonitoringRunRepositoryMemory) Save(ctx context.Context, run domain.MonitoringRun) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	runs := append(r.runs[run.AgreementID], run)
+	sort.SliceStable(runs, func(i, j int) bool { return runs[i].RanAt.Before(runs[j].RanAt) })
+	r.runs[run.AgreementID] = runs
+	return nil
+}
+
+// FindByAgreementID finds the monitoring runs of an agreement, oldest first
+func (r *MonitoringRunRepositoryMemory) FindByAgreementID(ctx context.Context, agreementID domain.GovernanceAgreementID) ([]domain.MonitoringRun, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return append([]domain.MonitoringRun{}, r.runs[agreementID]...), nil
+}
+
+// FindLatest finds the most recent monitoring run of an agreement
+func (r *MonitoringRunRepositoryMemory) FindLatest(ctx context.Context, agreementID domain.GovernanceAgreementID) (domain.MonitoringRun, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	runs := r.runs[agreementID]
+	if len(runs) == 0 {
+		return domain.MonitoringRun{}, errors.New("monitoring run not found")
+	}
+	return runs[len(runs)-1], nil
+}
@@ -0,0 +1,57 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// MonitoringSnapshotRepositoryMemory is an in-memory implementation of MonitoringSnapshotRepository
+type MonitoringSnapshotRepositoryMemory struct {
+	mu        sync.RWMutex
+	snapshots map[domain.GovernanceAgreementID][]domain.MonitoringSnapshot
+}
+
+// NewMonitoringSnapshotRepositoryMemory creates a new in-memory monitoring snapshot repository
+func NewMonitoringSnapshotRepositoryMemory() *MonitoringSnapshotRepositoryMemory {
+	return &MonitoringSnapshotRepositoryMemory{
+		snapshots: make(map[domain.GovernanceAgreementID][]domain.MonitoringSnapshot),
+	}
+}
+
+// Save saves a monitoring snapshot, keeping each agreement's snapshots ordered by time
+func (r *MonitoringSnapshotRepositoryMemory) Save(ctx context.Context, snapshot domain.MonitoringSnapshot) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	snapshots := append(r.snapshots[snapshot.AgreementID], snapshot)
+	sort.SliceStable(snapshots, func(i, j int) bool { return snapshots[i].TakenAt.Before(snapshots[j].TakenAt) })
+	r.snapshots[snapshot.AgreementID] = snapshots
+	return nil
+}
+
+// FindByAgreementID finds the monitoring snapshots of an agreement, oldest first
+func (r *MonitoringSnapshotRepositoryMemory) FindByAgreementID(ctx context.Context, agreementID domain.GovernanceAgreementID) ([]domain.MonitoringSnapshot, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return append([]domain.MonitoringSnapshot{}, r.snapshots[agreementID]...), nil
+}
+
+// FindByPeriod finds the monitoring snapshots of an agreement taken within the given time range,
+// oldest first
+func (r *MonitoringSnapshotRepositoryMemory) FindByPeriod(ctx context.Context, agreementID domain.GovernanceAgreementID, start, end time.Time) ([]domain.MonitoringSnapshot, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	snapshots := make([]domain.MonitoringSnapshot, 0)
+	for _, snapshot := range r.snapshots[agreementID] {
+		if !snapshot.TakenAt.Before(start) && !snapshot.TakenAt.After(end) {
+			snapshots = append(snapshots, snapshot)
+		}
+	}
+	return snapshots, nil
+}
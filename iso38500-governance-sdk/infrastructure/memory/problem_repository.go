@@ -0,0 +1,97 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// ProblemRepositoryMemory is an in-memory implementation of ProblemRepository
+type ProblemRepositoryMemory struct {
+	mu       sync.RWMutex
+	problems map[string]domain.Problem
+}
+
+// NewProblemRepositoryMemory creates a new in-memory problem repository
+func NewProblemRepositoryMemory() *ProblemRepositoryMemory {
+	return &ProblemRepositoryMemory{
+		problems: make(map[string]domain.Problem),
+	}
+}
+
+// Save saves a problem
+func (r *ProblemRepositoryMemory) Save(ctx context.Context, problem domain.Problem) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.problems[problem.ID] = problem
+	return nil
+}
+
+// FindByID finds a problem by ID
+func (r *ProblemRepositoryMemory) FindByID(ctx context.Context, id string) (domain.Problem, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	problem, exists := r.problems[id]
+	if !exists {
+		return domain.Problem{}, errors.New("problem not found")
+	}
+	return problem, nil
+}
+
+// FindByApplicationID finds the problems of an application, oldest first
+func (r *ProblemRepositoryMemory) FindByApplicationID(ctx context.Context, appID domain.ApplicationID) ([]domain.Problem, error) {
+	return r.find(func(problem domain.Problem) bool { return problem.ApplicationID == appID }), nil
+}
+
+// FindByStatus finds the problems with a status, oldest first
+func (r *ProblemRepositoryMemory) FindByStatus(ctx context.Context, status domain.ProblemStatus) ([]domain.Problem, error) {
+	return r.find(func(problem domain.Problem) bool { return problem.Status == status }), nil
+}
+
+// Update updates a problem
+func (r *ProblemRepositoryMemory) Update(ctx context.Context, problem domain.Problem) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.problems[problem.ID]; !exists {
+		return errors.New("problem not found")
+	}
+	r.problems[problem.ID] = problem
+	return nil
+}
+
+// Delete deletes a problem
+func (r *ProblemRepositoryMemory) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.problems[id]; !exists {
+		return errors.New("problem not found")
+	}
+	delete(r.problems, id)
+	return nil
+}
+
+func (r *ProblemRepositoryMemory) find(match func(domain.Problem) bool) []domain.Problem {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	problems := make([]domain.Problem, 0)
+	for _, problem := range r.problems {
+		if match(problem) {
+			problems = append(problems, problem)
+		}
+	}
+	sort.Slice(problems, func(i, j int) bool {
+		if !problems[i].CreatedAt.Equal(problems[j].CreatedAt) {
+			return problems[i].CreatedAt.Before(problems[j].CreatedAt)
+		}
+		return problems[i].ID < problems[j].ID
+	})
+	return problems
+}
@@ -0,0 +1,138 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// RetentionPolicyRepositoryMemory is an in-memory implementation of RetentionPolicyRepository
+type RetentionPolicyRepositoryMemory struct {
+	mu       sync.RWMutex
+	policies map[string]domain.RetentionPolicy
+}
+
+// NewRetentionPolicyRepositoryMemory creates a new in-memory retention policy repository
+func NewRetentionPolicyRepositoryMemory() *RetentionPolicyRepositoryMemory {
+	return &RetentionPolicyRepositoryMemory{
+		policies: make(map[string]domain.RetentionPolicy),
+	}
+}
+
+// Save saves a retention policy, replacing any with the same ID
+func (r *RetentionPolicyRepositoryMemory) Save(ctx context.Context, policy domain.RetentionPolicy) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.policies[policy.ID] = policy
+	return nil
+}
+
+// FindByID finds a retention policy by ID
+func (r *RetentionPolicyRepositoryMemory) FindByID(ctx context.Context, id string) (domain.RetentionPolicy, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	policy, exists := r.policies[id]
+	if !exists {
+		return domain.RetentionPolicy{}, errors.New("retention policy not found")
+	}
+	return policy, nil
+}
+
+// FindAll finds all retention policies, by ID
+func (r *RetentionPolicyRepositoryMemory) FindAll(ctx context.Context) ([]domain.RetentionPolicy, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	policies := make([]domain.RetentionPolicy, 0, len(r.policies))
+	for _, policy := range r.policies {
+		policies = append(policies, policy)
+	}
+	sort.Slice(policies, func(i, j int) bool { return policies[i].ID < policies[j].ID })
+	return policies, nil
+}
+
+// Delete deletes a retention policy
+func (r *RetentionPolicyRepositoryMemory) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.policies[id]; !exists {
+		return errors.New("retention policy not found")
+	}
+	delete(r.policies, id)
+	return nil
+}
+
+// DataHoldingRepositoryMemory is an in-memory implementation of DataHoldingRepository
+type DataHoldingRepositoryMemory struct {
+	mu       sync.RWMutex
+	holdings map[domain.ApplicationID]map[string]domain.DataHolding // by lower-cased category
+}
+
+// NewDataHoldingRepositoryMemory creates a new in-memory data holding repository
+func NewDataHoldingRepositoryMemory() *DataHoldingRepositoryMemory {
+	return &DataHoldingRepositoryMemory{
+		holdings: make(map[domain.ApplicationID]map[string]domain.DataHolding),
+	}
+}
+
+// Save saves a data holding, replacing the application's previous holding of the category
+func (r *DataHoldingRepositoryMemory) Save(ctx context.Context, holding domain.DataHolding) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.holdings[holding.ApplicationID] == nil {
+		r.holdings[holding.ApplicationID] = make(map[string]domain.DataHolding)
+	}
+	r.holdings[holding.ApplicationID][strings.ToLower(holding.Category)] = holding
+	return nil
+}
+
+// FindByApplicationID finds the data holdings of an application, by category
+func (r *DataHoldingRepositoryMemory) FindByApplicationID(ctx context.Context, appID domain.ApplicationID) ([]domain.DataHolding, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return sortedHoldings(r.holdings[appID]), nil
+}
+
+// FindAll finds all data holdings, by application and category
+func (r *DataHoldingRepositoryMemory) FindAll(ctx context.Context) ([]domain.DataHolding, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	holdings := make([]domain.DataHolding, 0)
+	for _, byCategory := range r.holdings {
+		holdings = append(holdings, sortedHoldings(byCategory)...)
+	}
+	sort.SliceStable(holdings, func(i, j int) bool { return holdings[i].ApplicationID < holdings[j].ApplicationID })
+	return holdings, nil
+}
+
+// Delete deletes the application's holding of a data category
+func (r *DataHoldingRepositoryMemory) Delete(ctx context.Context, appID domain.ApplicationID, category string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := strings.ToLower(category)
+	if _, exists := r.holdings[appID][key]; !exists {
+		return errors.New("data holding not found")
+	}
+	delete(r.holdings[appID], key)
+	return nil
+}
+
+func sortedHoldings(byCategory map[string]domain.DataHolding) []domain.DataHolding {
+	holdings := make([]domain.DataHolding, 0, len(byCategory))
+	for _, holding := range byCategory {
+		holdings = append(holdings, holding)
+	}
+	sort.Slice(holdings, func(i, j int) bool { return holdings[i].Category < holdings[j].Category })
+	return holdings
+}
@@ -0,0 +1,107 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// TechnicalDebtRepositoryMemory is an in-memory implementation of TechnicalDebtRepository
+type TechnicalDebtRepositoryMemory struct {
+	mu    sync.RWMutex
+	items map[string]domain.TechnicalDebtItem
+}
+
+// NewTechnicalDebtRepositoryMemory creates a new in-memory technical debt repository
+func NewTechnicalDebtRepositoryMemory() *TechnicalDebtRepositoryMemory {
+	return &TechnicalDebtRepositoryMemory{
+		items: make(map[string]domain.TechnicalDebtItem),
+	}
+}
+
+// Save saves a technical debt item
+func (r *TechnicalDebtRepositoryMemory) Save(ctx context.Context, item domain.TechnicalDebtItem) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.items[item.ID] = item
+	return nil
+}
+
+// FindByID finds a technical debt item by ID
+func (r *TechnicalDebtRepositoryMemory) FindByID(ctx context.Context, id string) (domain.TechnicalDebtItem, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	item, exists := r.items[id]
+	if !exists {
+		return domain.TechnicalDebtItem{}, errors.New("technical debt not found")
+	}
+	return item, nil
+}
+
+// FindByApplicationID finds an application's technical debt items, oldest first
+func (r *TechnicalDebtRepositoryMemory) FindByApplicationID(ctx context.Context, appID domain.ApplicationID) ([]domain.TechnicalDebtItem, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	items := make([]domain.TechnicalDebtItem, 0)
+	for _, item := range r.items {
+		if item.ApplicationID == appID {
+			items = append(items, item)
+		}
+	}
+	sortDebtItems(items)
+	return items, nil
+}
+
+// FindByStatus finds technical debt items by status, oldest first
+func (r *TechnicalDebtRepositoryMemory) FindByStatus(ctx context.Context, status domain.TechnicalDebtStatus) ([]domain.TechnicalDebtItem, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	items := make([]domain.TechnicalDebtItem, 0)
+	for _, item := range r.items {
+		if item.Status == status {
+			items = append(items, item)
+		}
+	}
+	sortDebtItems(items)
+	return items, nil
+}
+
+// Update updates a technical debt item
+func (r *TechnicalDebtRepositoryMemory) Update(ctx context.Context, item domain.TechnicalDebtItem) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.items[item.ID]; !exists {
+		return errors.New("technical debt not found")
+	}
+	r.items[item.ID] = item
+	return nil
+}
+
+// Delete deletes a technical debt item
+func (r *TechnicalDebtRepositoryMemory) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.items[id]; !exists {
+		return errors.New("technical debt not found")
+	}
+	delete(r.items, id)
+	return nil
+}
+
+func sortDebtItems(items []domain.TechnicalDebtItem) {
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].IdentifiedAt.Equal(items[j].IdentifiedAt) {
+			return items[i].ID < items[j].ID
+		}
+		return items[i].IdentifiedAt.Before(items[j].IdentifiedAt)
+	})
+}
@@ -0,0 +1,69 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// TelemetryBindingRepositoryMemory is an in-memory implementation of TelemetryBindingRepository
+type TelemetryBindingRepositoryMemory struct {
+	mu       sync.RWMutex
+	bindings map[string]domain.TelemetryBinding
+}
+
+// NewTelemetryBindingRepositoryMemory creates a new in-memory telemetry binding repository
+func NewTelemetryBindingRepositoryMemory() *TelemetryBindingRepositoryMemory {
+	return &TelemetryBindingRepositoryMemory{
+		bindings: make(map[string]domain.TelemetryBinding),
+	}
+}
+
+// Save adds a KPI's telemetry binding or replaces the one it had
+func (r *TelemetryBindingRepositoryMemory) Save(ctx context.Context, binding domain.TelemetryBinding) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.bindings[binding.KPIID] = binding
+	return nil
+}
+
+// FindByKPIID finds the telemetry binding of a KPI
+func (r *TelemetryBindingRepositoryMemory) FindByKPIID(ctx context.Context, kpiID string) (domain.TelemetryBinding, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	binding, exists := r.bindings[kpiID]
+	if !exists {
+		return domain.TelemetryBinding{}, errors.New("telemetry binding not found")
+	}
+	return binding, nil
+}
+
+// FindAll finds all telemetry bindings, ordered by KPI ID
+func (r *TelemetryBindingRepositoryMemory) FindAll(ctx context.Context) ([]domain.TelemetryBinding, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	bindings := make([]domain.TelemetryBinding, 0, len(r.bindings))
+	for _, binding := range r.bindings {
+		bindings = append(bindings, binding)
+	}
+	sort.Slice(bindings, func(i, j int) bool { return bindings[i].KPIID < bindings[j].KPIID })
+	return bindings, nil
+}
+
+// Delete removes the telemetry binding of a KPI
+func (r *TelemetryBindingRepositoryMemory) Delete(ctx context.Context, kpiID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.bindings[kpiID]; !exists {
+		return errors.New("telemetry binding not found")
+	}
+	delete(r.bindings, kpiID)
+	return nil
+}
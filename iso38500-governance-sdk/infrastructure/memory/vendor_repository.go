@@ -0,0 +1,92 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// VendorRepositoryMemory is an in-memory implementation of VendorRepository
+type VendorRepositoryMemory struct {
+	mu      sync.RWMutex
+	vendors map[domain.VendorID]domain.Vendor
+}
+
+// NewVendorRepositoryMemory creates a new in-memory vendor repository
+func NewVendorRepositoryMemory() *VendorRepositoryMemory {
+	return &VendorRepositoryMemory{
+		vendors: make(map[domain.VendorID]domain.Vendor),
+	}
+}
+
+// Save saves a vendor
+func (r *VendorRepositoryMemory) Save(ctx context.Context, vendor domain.Vendor) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.vendors[vendor.ID] = vendor
+	return nil
+}
+
+// FindByID finds a vendor by ID
+func (r *VendorRepositoryMemory) FindByID(ctx context.Context, id domain.VendorID) (domain.Vendor, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	vendor, exists := r.vendors[id]
+	if !exists {
+		return domain.Vendor{}, errors.New("vendor not found")
+	}
+	return vendor, nil
+}
+
+// FindByApplicationID finds the vendors supplying, hosting or operating an application, by ID
+func (r *VendorRepositoryMemory) FindByApplicationID(ctx context.Context, appID domain.ApplicationID) ([]domain.Vendor, error) {
+	return r.find(func(vendor domain.Vendor) bool { return vendor.Supplies(appID) }), nil
+}
+
+// FindAll finds all vendors, by ID
+func (r *VendorRepositoryMemory) FindAll(ctx context.Context) ([]domain.Vendor, error) {
+	return r.find(func(domain.Vendor) bool { return true }), nil
+}
+
+// Update updates a vendor
+func (r *VendorRepositoryMemory) Update(ctx context.Context, vendor domain.Vendor) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.vendors[vendor.ID]; !exists {
+		return errors.New("vendor not found")
+	}
+	r.vendors[vendor.ID] = vendor
+	return nil
+}
+
+// Delete deletes a vendor
+func (r *VendorRepositoryMemory) Delete(ctx context.Context, id domain.VendorID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.vendors[id]; !exists {
+		return errors.New("vendor not found")
+	}
+	delete(r.vendors, id)
+	return nil
+}
+
+func (r *VendorRepositoryMemory) find(match func(domain.Vendor) bool) []domain.Vendor {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	vendors := make([]domain.Vendor, 0)
+	for _, vendor := range r.vendors {
+		if match(vendor) {
+			vendors = append(vendors, vendor)
+		}
+	}
+	sort.Slice(vendors, func(i, j int) bool { return vendors[i].ID < vendors[j].ID })
+	return vendors
+}
@@ -0,0 +1,85 @@
+// Package notify delivers governance notifications by email, to Slack and to generic webhooks
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// defaultTimeout bounds HTTP deliveries when no client is given
+const defaultTimeout = 10 * time.Second
+
+// subject is the one-line summary of a notification, e.g. "[critical] Alert: erp-cloud-workloads"
+func subject(notification domain.Notification) string {
+	return fmt.Sprintf("[%s] %s", notification.Severity, notification.Title)
+}
+
+// body describes a notification in plain text
+func body(notification domain.Notification) string {
+	var b strings.Builder
+	if notification.Message != "" {
+		b.WriteString(notification.Message)
+		b.WriteString("\n\n")
+	}
+	if notification.AgreementID != "" {
+		fmt.Fprintf(&b, "Agreement: %s\n", notification.AgreementID)
+	}
+	if notification.ApplicationID != "" {
+		fmt.Fprintf(&b, "Application: %s\n", notification.ApplicationID)
+	}
+	if len(notification.Recipients) > 0 {
+		fmt.Fprintf(&b, "For: %s\n", strings.Join(notification.Recipients, ", "))
+	}
+	if len(notification.Roles) > 0 {
+		fmt.Fprintf(&b, "Roles: %s\n", strings.Join(notification.Roles, ", "))
+	}
+	if !notification.DueAt.IsZero() {
+		fmt.Fprintf(&b, "Due: %s\n", notification.DueAt.Format("2006-01-02"))
+	}
+	return b.String()
+}
+
+// postJSON posts the payload and fails on a non-2xx response
+func postJSON(ctx context.Context, client *http.Client, url string, headers map[string]string, payload interface{}) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode notification: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post notification: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("notification rejected with status %d: %s", resp.StatusCode, strings.TrimSpace(string(detail)))
+	}
+	return nil
+}
+
+// httpClient returns the client, or one with the default timeout when it is nil
+func httpClient(client *http.Client) *http.Client {
+	if client != nil {
+		return client
+	}
+	return &http.Client{Timeout: defaultTimeout}
+}
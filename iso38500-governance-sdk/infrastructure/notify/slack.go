@@ -0,0 +1,37 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// SlackNotifier posts notifications to a Slack incoming webhook
+type SlackNotifier struct {
+	webhookURL string
+	client     *http.Client
+}
+
+// NewSlackNotifier creates a notifier for a Slack incoming webhook URL. A nil client uses a
+// client with a 10 second timeout.
+func NewSlackNotifier(webhookURL string, client *http.Client) *SlackNotifier {
+	return &SlackNotifier{webhookURL: webhookURL, client: httpClient(client)}
+}
+
+// Notify posts the notification as a message with its severity as an emoji
+func (n *SlackNotifier) Notify(ctx context.Context, notification domain.Notification) error {
+	text := fmt.Sprintf("%s *%s*\n%s", slackEmoji(notification.Severity), subject(notification), body(notification))
+	return postJSON(ctx, n.client, n.webhookURL, nil, map[string]string{"text": text})
+}
+
+func slackEmoji(severity domain.NotificationSeverity) string {
+	switch severity {
+	case domain.NotificationCritical:
+		return ":rotating_light:"
+	case domain.NotificationWarning:
+		return ":warning:"
+	}
+	return ":information_source:"
+}
@@ -0,0 +1,98 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// SMTPConfig configures delivery of notifications by email
+type SMTPConfig struct {
+	Addr     string // host:port of the SMTP server
+	Username string // no authentication when empty
+	Password string
+	From     string
+	To       []string // addresses every notification is sent to
+}
+
+// SMTPNotifier emails notifications through an SMTP server. Notifications go to the configured
+// addresses and to any recipient of the notification that is an email address.
+type SMTPNotifier struct {
+	config SMTPConfig
+}
+
+// NewSMTPNotifier creates a notifier for the SMTP server
+func NewSMTPNotifier(config SMTPConfig) (*SMTPNotifier, error) {
+	if config.Addr == "" {
+		return nil, errors.New("SMTP server address cannot be empty")
+	}
+	if config.From == "" {
+		return nil, errors.New("SMTP sender cannot be empty")
+	}
+	return &SMTPNotifier{config: config}, nil
+}
+
+// Notify emails the notification. A notification with no email address to go to is skipped.
+func (n *SMTPNotifier) Notify(ctx context.Context, notification domain.Notification) error {
+	to := append([]string{}, n.config.To...)
+	for _, recipient := range notification.Recipients {
+		if strings.Contains(recipient, "@") && !containsAddress(to, recipient) {
+			to = append(to, recipient)
+		}
+	}
+	if len(to) == 0 {
+		return nil
+	}
+
+	var auth smtp.Auth
+	if n.config.Username != "" {
+		host, _, err := net.SplitHostPort(n.config.Addr)
+		if err != nil {
+			return fmt.Errorf("invalid SMTP server address: %w", err)
+		}
+		auth = smtp.PlainAuth("", n.config.Username, n.config.Password, host)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	err := smtp.SendMail(n.config.Addr, auth, n.config.From, to, n.message(notification, to))
+	if err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+	return nil
+}
+
+// message formats the notification as an RFC 5322 plain text email
+func (n *SMTPNotifier) message(notification domain.Notification, to []string) []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", n.config.From)
+	fmt.Fprintf(&b, "To: %s\r\n", strings.Join(to, ", "))
+	fmt.Fprintf(&b, "Subject: %s\r\n", headerValue(subject(notification)))
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(body(notification), "\n", "\r\n"))
+	return []byte(b.String())
+}
+
+// headerValue keeps a header on one line
+func headerValue(value string) string {
+	return strings.NewReplacer("\r", " ", "\n", " ").Replace(value)
+}
+
+func containsAddress(addresses []string, address string) bool {
+	for _, existing := range addresses {
+		if strings.EqualFold(existing, address) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,83 @@
+package notify
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// WebhookPayload is the JSON body a WebhookNotifier posts
+type WebhookPayload struct {
+	ID            string     `json:"id"`
+	Kind          string     `json:"kind"`
+	Severity      string     `json:"severity"`
+	Title         string     `json:"title"`
+	Message       string     `json:"message,omitempty"`
+	AgreementID   string     `json:"agreement_id,omitempty"`
+	ApplicationID string     `json:"application_id,omitempty"`
+	Portfolios    []string   `json:"portfolios,omitempty"`
+	Roles         []string   `json:"roles,omitempty"`
+	Recipients    []string   `json:"recipients,omitempty"`
+	DueAt         *time.Time `json:"due_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+// WebhookNotifier posts notifications as JSON to a URL
+type WebhookNotifier struct {
+	url     string
+	headers map[string]string
+	client  *http.Client
+}
+
+// NewWebhookNotifier creates a notifier posting to the URL with the headers, e.g. an
+// Authorization header. A nil client uses a client with a 10 second timeout.
+func NewWebhookNotifier(url string, headers map[string]string, client *http.Client) *WebhookNotifier {
+	return &WebhookNotifier{url: url, headers: headers, client: httpClient(client)}
+}
+
+// Notify posts the notification as a WebhookPayload
+func (n *WebhookNotifier) Notify(ctx context.Context, notification domain.Notification) error {
+	payload := WebhookPayload{
+		ID:            notification.ID,
+		Kind:          string(notification.Kind),
+		Severity:      string(notification.Severity),
+		Title:         notification.Title,
+		Message:       notification.Message,
+		AgreementID:   string(notification.AgreementID),
+		ApplicationID: string(notification.ApplicationID),
+		Roles:         notification.Roles,
+		Recipients:    notification.Recipients,
+		CreatedAt:     notification.CreatedAt,
+	}
+	if !notification.DueAt.IsZero() {
+		payload.DueAt = &notification.DueAt
+	}
+	for _, portfolio := range notification.Portfolios {
+		payload.Portfolios = append(payload.Portfolios, string(portfolio))
+	}
+	return postJSON(ctx, n.client, n.url, n.headers, payload)
+}
+
+// EventWebhook posts domain events as JSON to a URL, e.g. as a handler subscribed to an event bus
+type EventWebhook struct {
+	url     string
+	headers map[string]string
+	client  *http.Client
+}
+
+// NewEventWebhook creates a webhook posting events to the URL with the headers. A nil client
+// uses a client with a 10 second timeout.
+func NewEventWebhook(url string, headers map[string]string, client *http.Client) *EventWebhook {
+	return &EventWebhook{url: url, headers: headers, client: httpClient(client)}
+}
+
+// Handle posts the event in a domain.EventEnvelope. Redeliveries carry the same envelope ID.
+func (w *EventWebhook) Handle(ctx context.Context, event domain.DomainEvent) error {
+	envelope, err := domain.NewEventEnvelope(event, 0)
+	if err != nil {
+		return err
+	}
+	return postJSON(ctx, w.client, w.url, w.headers, envelope)
+}
@@ -0,0 +1,122 @@
+package projection
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// ApplicationRisk is the risk level an application was last evaluated at
+type ApplicationRisk struct {
+	ApplicationID domain.ApplicationID
+	AssessmentID  string
+	RiskLevel     domain.RiskLevel
+	TIMEQuadrant  domain.TIMEQuadrant
+	EvaluatedAt   time.Time
+}
+
+// ApplicationsByRisk is the read model of applications by the risk level of their latest
+// evaluation, folded from ApplicationEvaluated events
+type ApplicationsByRisk struct {
+	mu           sync.RWMutex
+	applications map[domain.ApplicationID]ApplicationRisk
+}
+
+// NewApplicationsByRisk creates an empty applications by risk level read model
+func NewApplicationsByRisk() *ApplicationsByRisk {
+	return &ApplicationsByRisk{applications: make(map[domain.ApplicationID]ApplicationRisk)}
+}
+
+// Name names the projection
+func (p *ApplicationsByRisk) Name() string {
+	return "applications_by_risk"
+}
+
+// Apply records the risk level of evaluated applications
+func (p *ApplicationsByRisk) Apply(ctx context.Context, event domain.DomainEvent) error {
+	evaluated, ok := event.(domain.ApplicationEvaluatedEvent)
+	if !ok {
+		return nil
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if current, ok := p.applications[evaluated.ApplicationID]; ok && current.EvaluatedAt.After(evaluated.OccurredAt) {
+		return nil
+	}
+	p.applications[evaluated.ApplicationID] = ApplicationRisk{
+		ApplicationID: evaluated.ApplicationID,
+		AssessmentID:  evaluated.AssessmentID,
+		RiskLevel:     evaluated.RiskLevel,
+		TIMEQuadrant:  evaluated.TIMEQuadrant,
+		EvaluatedAt:   evaluated.OccurredAt,
+	}
+	return nil
+}
+
+// Reset forgets every application
+func (p *ApplicationsByRisk) Reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.applications = make(map[domain.ApplicationID]ApplicationRisk)
+}
+
+// ByLevel returns the applications last evaluated at the risk level, by ID
+func (p *ApplicationsByRisk) ByLevel(level domain.RiskLevel) []ApplicationRisk {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	applications := []ApplicationRisk{}
+	for _, application := range p.applications {
+		if application.RiskLevel == level {
+			applications = append(applications, application)
+		}
+	}
+	sort.Slice(applications, func(i, j int) bool { return applications[i].ApplicationID < applications[j].ApplicationID })
+	return applications
+}
+
+// All returns every evaluated application, riskiest first and then by ID
+func (p *ApplicationsByRisk) All() []ApplicationRisk {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	applications := make([]ApplicationRisk, 0, len(p.applications))
+	for _, application := range p.applications {
+		applications = append(applications, application)
+	}
+	sort.Slice(applications, func(i, j int) bool {
+		a, b := riskRank(applications[i].RiskLevel), riskRank(applications[j].RiskLevel)
+		if a != b {
+			return a > b
+		}
+		return applications[i].ApplicationID < applications[j].ApplicationID
+	})
+	return applications
+}
+
+// Get returns the application's latest risk level, and false when it was never evaluated
+func (p *ApplicationsByRisk) Get(appID domain.ApplicationID) (ApplicationRisk, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	application, ok := p.applications[appID]
+	return application, ok
+}
+
+// riskRank orders risk levels from low to critical
+func riskRank(level domain.RiskLevel) int {
+	switch level {
+	case domain.RiskCritical:
+		return 4
+	case domain.RiskHigh:
+		return 3
+	case domain.RiskMedium:
+		return 2
+	case domain.RiskLow:
+		return 1
+	}
+	return 0
+}
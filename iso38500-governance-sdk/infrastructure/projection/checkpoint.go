@@ -0,0 +1,89 @@
+package projection
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// Checkpoint is how far a projection has folded the event history. It keeps the ID of every
+// event applied, see domain.EventID, so catching up applies exactly the events that were not,
+// whether they share a timestamp with applied ones or were recorded with an earlier one.
+type Checkpoint struct {
+	Projection string
+	Position   time.Time // when the latest event applied occurred; zero before any
+	EventIDs   []string  // IDs of the events applied, in the order applied
+	Events     int       // events applied since the projection was last built
+	UpdatedAt  time.Time
+	Error      string // why the projection stopped being updated; empty while it is current
+}
+
+// eventID identifies an event for the checkpoint. An event that cannot be identified cannot be
+// told apart from those applied, so it fails the projection rather than be skipped or applied
+// twice.
+func eventID(event domain.DomainEvent) (string, error) {
+	id, err := domain.EventID(event)
+	if err != nil {
+		return "", fmt.Errorf("cannot identify %s event of %s: %w", event.EventType(), event.Time().Format(time.RFC3339), err)
+	}
+	return id, nil
+}
+
+// advance records the event with the ID as applied
+func (c *Checkpoint) advance(event domain.DomainEvent, id string) {
+	c.Events++
+	c.EventIDs = append(c.EventIDs, id)
+	if event.Time().After(c.Position) {
+		c.Position = event.Time()
+	}
+}
+
+// applied returns the IDs of the events applied, to look them up while catching up
+func (c *Checkpoint) applied() map[string]bool {
+	applied := make(map[string]bool, len(c.EventIDs))
+	for _, id := range c.EventIDs {
+		applied[id] = true
+	}
+	return applied
+}
+
+// CheckpointStore keeps the checkpoints of projections
+type CheckpointStore interface {
+	// Load returns the projection's checkpoint, or nil when it has none
+	Load(ctx context.Context, projection string) (*Checkpoint, error)
+	Save(ctx context.Context, checkpoint Checkpoint) error
+}
+
+// MemoryCheckpointStore is an in-memory CheckpointStore, for projections whose read models are
+// kept in memory too
+type MemoryCheckpointStore struct {
+	mu          sync.RWMutex
+	checkpoints map[string]Checkpoint
+}
+
+// NewMemoryCheckpointStore creates an empty in-memory checkpoint store
+func NewMemoryCheckpointStore() *MemoryCheckpointStore {
+	return &MemoryCheckpointStore{checkpoints: make(map[string]Checkpoint)}
+}
+
+// Load returns the projection's checkpoint, or nil when it has none
+func (s *MemoryCheckpointStore) Load(ctx context.Context, projection string) (*Checkpoint, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	checkpoint, ok := s.checkpoints[projection]
+	if !ok {
+		return nil, nil
+	}
+	return &checkpoint, nil
+}
+
+// Save saves the checkpoint, replacing the projection's previous one
+func (s *MemoryCheckpointStore) Save(ctx context.Context, checkpoint Checkpoint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.checkpoints[checkpoint.Projection] = checkpoint
+	return nil
+}
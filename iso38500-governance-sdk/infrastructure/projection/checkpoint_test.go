@@ -0,0 +1,127 @@
+package projection
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+	"github.com/iso38500/iso38500-governance-sdk/infrastructure/memory"
+)
+
+// countingProjection counts how often each portfolio's creation was applied
+type countingProjection struct {
+	applied map[domain.PortfolioID]int
+}
+
+func (p *countingProjection) Name() string { return "counting" }
+
+func (p *countingProjection) Apply(ctx context.Context, event domain.DomainEvent) error {
+	if created, ok := event.(domain.PortfolioCreatedEvent); ok {
+		p.applied[created.PortfolioID]++
+	}
+	return nil
+}
+
+func (p *countingProjection) Reset() { p.applied = make(map[domain.PortfolioID]int) }
+
+// unidentifiableEvent cannot be encoded, so it has no event ID
+type unidentifiableEvent struct {
+	Updates    chan string
+	OccurredAt time.Time
+}
+
+func (e unidentifiableEvent) EventType() string { return "Unidentifiable" }
+func (e unidentifiableEvent) Time() time.Time   { return e.OccurredAt }
+
+func TestCatchUpAppliesMissedEventsOnce(t *testing.T) {
+	at := time.Now().Add(-time.Hour)
+	created := func(id string, occurredAt time.Time) domain.DomainEvent {
+		return domain.PortfolioCreatedEvent{PortfolioID: domain.PortfolioID(id), Name: id, OccurredAt: occurredAt}
+	}
+	tests := []struct {
+		name    string
+		missed  []domain.DomainEvent
+		wantErr string
+	}{
+		{"nothing missed", nil, ""},
+		{"later event", []domain.DomainEvent{created("later", at.Add(time.Minute))}, ""},
+		{"event sharing the latest timestamp", []domain.DomainEvent{created("tied", at)}, ""},
+		{"event recorded with an earlier timestamp", []domain.DomainEvent{created("backdated", at.Add(-time.Minute))}, ""},
+		{"event without an ID", []domain.DomainEvent{unidentifiableEvent{OccurredAt: at.Add(time.Minute)}}, "cannot identify Unidentifiable event"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			store := memory.NewDomainEventRepositoryMemory()
+			checkpoints := NewMemoryCheckpointStore()
+			projection := &countingProjection{}
+			projection.Reset()
+
+			projector := NewProjector(store, checkpoints)
+			if err := projector.Register(ctx, projection); err != nil {
+				t.Fatalf("Register() error = %v", err)
+			}
+			for _, id := range []string{"first", "second"} {
+				if err := projector.Save(ctx, created(id, at)); err != nil {
+					t.Fatalf("Save() error = %v", err)
+				}
+			}
+			// Recorded while the projector was not running
+			for _, event := range tt.missed {
+				if err := store.Save(ctx, event); err != nil {
+					t.Fatalf("Save() error = %v", err)
+				}
+			}
+
+			err := NewProjector(store, checkpoints).Register(ctx, projection)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Register() error = %v, want %q", err, tt.wantErr)
+				}
+				checkpoint, _ := checkpoints.Load(ctx, projection.Name())
+				if !strings.Contains(checkpoint.Error, tt.wantErr) {
+					t.Errorf("checkpoint error = %q, want %q", checkpoint.Error, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Register() error = %v", err)
+			}
+
+			want := len(tt.missed) + 2
+			if len(projection.applied) != want {
+				t.Errorf("applied %d portfolios, want %d", len(projection.applied), want)
+			}
+			for id, count := range projection.applied {
+				if count != 1 {
+					t.Errorf("%s applied %d times, want once", id, count)
+				}
+			}
+			checkpoint, _ := checkpoints.Load(ctx, projection.Name())
+			if checkpoint.Events != want || len(checkpoint.EventIDs) != want {
+				t.Errorf("checkpoint holds %d events and %d IDs, want %d", checkpoint.Events, len(checkpoint.EventIDs), want)
+			}
+		})
+	}
+}
+
+func TestSaveFailsProjectionOnEventWithoutID(t *testing.T) {
+	ctx := context.Background()
+	checkpoints := NewMemoryCheckpointStore()
+	projection := &countingProjection{}
+	projection.Reset()
+	projector := NewProjector(memory.NewDomainEventRepositoryMemory(), checkpoints)
+	if err := projector.Register(ctx, projection); err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+
+	if err := projector.Save(ctx, unidentifiableEvent{OccurredAt: time.Now()}); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	checkpoint, _ := checkpoints.Load(ctx, projection.Name())
+	if !strings.Contains(checkpoint.Error, "cannot identify") || checkpoint.Events != 0 {
+		t.Errorf("checkpoint = %+v, want the projection failed on the event", checkpoint)
+	}
+}
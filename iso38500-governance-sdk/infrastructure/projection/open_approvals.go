@@ -0,0 +1,108 @@
+package projection
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// OpenApproval is a change request awaiting approval
+type OpenApproval struct {
+	ChangeRequestID string
+	ApplicationID   domain.ApplicationID
+	Title           string
+	Priority        domain.Priority
+	AwaitedRoles    []string // roles whose approval it still awaits
+	Approvals       int      // approvals recorded so far
+	SubmittedAt     time.Time
+}
+
+// OpenApprovals is the read model of change requests awaiting approval, by the approver roles
+// they await, folded from change request submission, approval, rejection and withdrawal events
+type OpenApprovals struct {
+	mu      sync.RWMutex
+	changes map[string]OpenApproval
+}
+
+// NewOpenApprovals creates an empty open approvals read model
+func NewOpenApprovals() *OpenApprovals {
+	return &OpenApprovals{changes: make(map[string]OpenApproval)}
+}
+
+// Name names the projection
+func (p *OpenApprovals) Name() string {
+	return "open_approvals"
+}
+
+// Apply opens submitted change requests, updates the roles they await as approvals are recorded,
+// and closes them once approved, rejected or withdrawn
+func (p *OpenApprovals) Apply(ctx context.Context, event domain.DomainEvent) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	switch e := event.(type) {
+	case domain.ChangeRequestSubmittedEvent:
+		p.changes[e.ChangeRequestID] = OpenApproval{
+			ChangeRequestID: e.ChangeRequestID,
+			ApplicationID:   e.ApplicationID,
+			Title:           e.Title,
+			Priority:        e.Priority,
+			AwaitedRoles:    append([]string{}, e.AwaitedRoles...),
+			SubmittedAt:     e.OccurredAt,
+		}
+	case domain.ChangeApprovalRecordedEvent:
+		change, ok := p.changes[e.ChangeRequestID]
+		if !ok {
+			return nil
+		}
+		change.Approvals++
+		if e.AwaitedRoles != nil {
+			change.AwaitedRoles = append([]string{}, e.AwaitedRoles...)
+		}
+		p.changes[e.ChangeRequestID] = change
+	case domain.ChangeRequestApprovedEvent:
+		delete(p.changes, e.ChangeRequestID)
+	case domain.ChangeRequestRejectedEvent:
+		delete(p.changes, e.ChangeRequestID)
+	case domain.ChangeRequestWithdrawnEvent:
+		delete(p.changes, e.ChangeRequestID)
+	}
+	return nil
+}
+
+// Reset forgets every change request
+func (p *OpenApprovals) Reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.changes = make(map[string]OpenApproval)
+}
+
+// ForApprover returns the change requests awaiting approval by the role, oldest submission first
+func (p *OpenApprovals) ForApprover(role string) []OpenApproval {
+	return p.ByApprover()[role]
+}
+
+// ByApprover returns the change requests awaiting approval by each role, oldest submission first
+func (p *OpenApprovals) ByApprover() map[string][]OpenApproval {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	byRole := make(map[string][]OpenApproval)
+	for _, change := range p.changes {
+		for _, role := range change.AwaitedRoles {
+			byRole[role] = append(byRole[role], change)
+		}
+	}
+	for _, changes := range byRole {
+		sort.Slice(changes, func(i, j int) bool {
+			if !changes[i].SubmittedAt.Equal(changes[j].SubmittedAt) {
+				return changes[i].SubmittedAt.Before(changes[j].SubmittedAt)
+			}
+			return changes[i].ChangeRequestID < changes[j].ChangeRequestID
+		})
+	}
+	return byRole
+}
@@ -0,0 +1,217 @@
+// Package projection folds domain events into read models kept up to date as events are
+// recorded, so list and report queries read them instead of recomputing from aggregates
+package projection
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+	"github.com/iso38500/iso38500-governance-sdk/infrastructure/eventbus"
+)
+
+// Projection folds events into a read model. Apply is called with every recorded event, in the
+// order recorded, and ignores those the read model does not use. Reset empties the read model
+// before it is rebuilt from the whole history.
+type Projection interface {
+	Name() string
+	Apply(ctx context.Context, event domain.DomainEvent) error
+	Reset()
+}
+
+// Projector is a DomainEventRepository that saves events to the repository it wraps and then
+// applies them to its projections. A projection failing on an event stops being updated, and
+// its checkpoint records the error, until it is rebuilt; the failure never fails the save.
+type Projector struct {
+	next        domain.DomainEventRepository
+	checkpoints CheckpointStore
+	onError     func(error)
+
+	// mu serializes saves with catching up, so projections see every event exactly once
+	mu          sync.Mutex
+	projections []Projection
+}
+
+// ProjectorOption customizes a projector
+type ProjectorOption func(*Projector)
+
+// WithErrorHandler reports the checkpoints a save could not load or save to the handler instead
+// of the standard logger
+func WithErrorHandler(onError func(error)) ProjectorOption {
+	return func(p *Projector) {
+		p.onError = onError
+	}
+}
+
+// NewProjector creates a projector saving events to next and checkpointing its projections in
+// the store
+func NewProjector(next domain.DomainEventRepository, checkpoints CheckpointStore, opts ...ProjectorOption) *Projector {
+	projector := &Projector{
+		next:        next,
+		checkpoints: checkpoints,
+		onError:     func(err error) { log.Print(err) },
+	}
+	for _, opt := range opts {
+		opt(projector)
+	}
+	return projector
+}
+
+// Register adds a projection and catches it up on the events recorded after its checkpoint, or
+// on the whole history when it has none. Its read model must hold the events up to its
+// checkpoint, e.g. by being persisted alongside the checkpoint store.
+func (p *Projector) Register(ctx context.Context, projection Projection) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, registered := range p.projections {
+		if registered.Name() == projection.Name() {
+			return fmt.Errorf("projection %s is already registered", projection.Name())
+		}
+	}
+	checkpoint, err := p.checkpoints.Load(ctx, projection.Name())
+	if err != nil {
+		return fmt.Errorf("failed to load checkpoint of projection %s: %w", projection.Name(), err)
+	}
+	if checkpoint == nil {
+		checkpoint = &Checkpoint{Projection: projection.Name()}
+	}
+	// A failed projection stays stale until rebuilt
+	if checkpoint.Error == "" {
+		if err := p.catchUp(ctx, projection, checkpoint); err != nil {
+			return err
+		}
+	}
+	p.projections = append(p.projections, projection)
+	return nil
+}
+
+// Rebuild empties a projection's read model and folds the whole event history into it again,
+// e.g. after it failed or its logic changed
+func (p *Projector) Rebuild(ctx context.Context, name string) (*Checkpoint, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, projection := range p.projections {
+		if projection.Name() != name {
+			continue
+		}
+		projection.Reset()
+		checkpoint := &Checkpoint{Projection: name}
+		if err := p.catchUp(ctx, projection, checkpoint); err != nil {
+			return checkpoint, err
+		}
+		return checkpoint, nil
+	}
+	return nil, fmt.Errorf("projection %s is not registered", name)
+}
+
+// catchUp applies the events of the history the checkpoint does not hold and saves the checkpoint
+func (p *Projector) catchUp(ctx context.Context, projection Projection, checkpoint *Checkpoint) error {
+	applied := checkpoint.applied()
+	_, err := eventbus.Replay(ctx, p.next, time.Time{}, func(ctx context.Context, event domain.DomainEvent) error {
+		id, err := eventID(event)
+		if err != nil {
+			return err
+		}
+		if applied[id] {
+			return nil
+		}
+		if err := projection.Apply(ctx, event); err != nil {
+			return err
+		}
+		checkpoint.advance(event, id)
+		return nil
+	})
+	checkpoint.UpdatedAt = time.Now()
+	if err != nil {
+		checkpoint.Error = err.Error()
+	}
+	if saveErr := p.checkpoints.Save(ctx, *checkpoint); saveErr != nil {
+		return fmt.Errorf("failed to save checkpoint of projection %s: %w", projection.Name(), saveErr)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to catch up projection %s: %w", projection.Name(), err)
+	}
+	return nil
+}
+
+// Save saves the event and applies it to the projections that have not failed. Checkpoints it
+// cannot load or save are reported to the error handler, since the event itself was saved.
+func (p *Projector) Save(ctx context.Context, event domain.DomainEvent) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err := p.next.Save(ctx, event); err != nil {
+		return err
+	}
+	for _, projection := range p.projections {
+		checkpoint, err := p.checkpoints.Load(ctx, projection.Name())
+		if err != nil {
+			p.onError(fmt.Errorf("failed to load checkpoint of projection %s: %w", projection.Name(), err))
+			continue
+		}
+		if checkpoint == nil {
+			checkpoint = &Checkpoint{Projection: projection.Name()}
+		}
+		if checkpoint.Error != "" {
+			continue
+		}
+		if id, err := eventID(event); err != nil {
+			checkpoint.Error = err.Error()
+		} else if err := projection.Apply(ctx, event); err != nil {
+			checkpoint.Error = fmt.Sprintf("%s event of %s: %v", event.EventType(), event.Time().Format(time.RFC3339), err)
+		} else {
+			checkpoint.advance(event, id)
+		}
+		checkpoint.UpdatedAt = time.Now()
+		if err := p.checkpoints.Save(ctx, *checkpoint); err != nil {
+			p.onError(fmt.Errorf("failed to save checkpoint of projection %s: %w", projection.Name(), err))
+		}
+	}
+	return nil
+}
+
+// Checkpoints returns the checkpoints of the registered projections, by name
+func (p *Projector) Checkpoints(ctx context.Context) ([]Checkpoint, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	checkpoints := make([]Checkpoint, 0, len(p.projections))
+	for _, projection := range p.projections {
+		checkpoint, err := p.checkpoints.Load(ctx, projection.Name())
+		if err != nil {
+			return nil, fmt.Errorf("failed to load checkpoint of projection %s: %w", projection.Name(), err)
+		}
+		if checkpoint == nil {
+			checkpoint = &Checkpoint{Projection: projection.Name()}
+		}
+		checkpoints = append(checkpoints, *checkpoint)
+	}
+	sort.Slice(checkpoints, func(i, j int) bool { return checkpoints[i].Projection < checkpoints[j].Projection })
+	return checkpoints, nil
+}
+
+// FindByAggregateID finds events by aggregate ID
+func (p *Projector) FindByAggregateID(ctx context.Context, aggregateID string) ([]domain.DomainEvent, error) {
+	return p.next.FindByAggregateID(ctx, aggregateID)
+}
+
+// FindByEventType finds events by event type
+func (p *Projector) FindByEventType(ctx context.Context, eventType string) ([]domain.DomainEvent, error) {
+	return p.next.FindByEventType(ctx, eventType)
+}
+
+// FindByTimeRange finds events by time range
+func (p *Projector) FindByTimeRange(ctx context.Context, start, end time.Time) ([]domain.DomainEvent, error) {
+	return p.next.FindByTimeRange(ctx, start, end)
+}
+
+// Delete deletes an event
+func (p *Projector) Delete(ctx context.Context, eventID string) error {
+	return p.next.Delete(ctx, eventID)
+}
@@ -0,0 +1,248 @@
+package telemetry
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// maxCloudWatchDatapoints is the most datapoints GetMetricStatistics returns for one request
+const maxCloudWatchDatapoints = 1440
+
+// CloudWatchConfig holds the region and credentials CloudWatch is queried with
+type CloudWatchConfig struct {
+	Region          string
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string // for temporary credentials
+	Endpoint        string // e.g. for a VPC endpoint; the regional endpoint when empty
+}
+
+// CloudWatchSource queries metric statistics with the CloudWatch GetMetricStatistics API. A query
+// names the namespace, metric, dimensions and statistic, e.g.
+// "AWS/ApplicationELB/TargetResponseTime{LoadBalancer=app/erp/50dc6c495c0c9188}:Average". The
+// statistic is Average when omitted. The period is the shortest multiple of a minute keeping the
+// window within 1440 datapoints.
+type CloudWatchSource struct {
+	config CloudWatchConfig
+	client *http.Client
+	now    func() time.Time
+}
+
+// NewCloudWatchSource creates a CloudWatch telemetry source. A nil client uses a client with a 30
+// second timeout.
+func NewCloudWatchSource(config CloudWatchConfig, client *http.Client) (*CloudWatchSource, error) {
+	if config.Region == "" || config.AccessKeyID == "" || config.SecretAccessKey == "" {
+		return nil, fmt.Errorf("cloudwatch requires a region, an access key ID and a secret access key")
+	}
+	if config.Endpoint == "" {
+		config.Endpoint = fmt.Sprintf("https://monitoring.%s.amazonaws.com/", config.Region)
+	}
+	return &CloudWatchSource{config: config, client: httpClient(client), now: time.Now}, nil
+}
+
+// Name returns "cloudwatch"
+func (s *CloudWatchSource) Name() string {
+	return "cloudwatch"
+}
+
+// cloudWatchQuery is a parsed CloudWatch query
+type cloudWatchQuery struct {
+	namespace  string
+	metricName string
+	dimensions [][2]string
+	statistic  string
+}
+
+// parseCloudWatchQuery parses "<namespace>/<metric>{<name>=<value>,...}:<statistic>"
+func parseCloudWatchQuery(query string) (cloudWatchQuery, error) {
+	parsed := cloudWatchQuery{statistic: "Average"}
+
+	head := query
+	if open := strings.Index(query, "{"); open >= 0 {
+		end := strings.LastIndex(query, "}")
+		if end < open {
+			return parsed, fmt.Errorf("cloudwatch query %q has unbalanced braces", query)
+		}
+		head = query[:open]
+		if rest := query[end+1:]; rest != "" {
+			if !strings.HasPrefix(rest, ":") {
+				return parsed, fmt.Errorf("cloudwatch query %q must end with :<statistic>", query)
+			}
+			parsed.statistic = rest[1:]
+		}
+		for _, dimension := range strings.Split(query[open+1:end], ",") {
+			if strings.TrimSpace(dimension) == "" {
+				continue
+			}
+			name, value, ok := strings.Cut(dimension, "=")
+			if !ok {
+				return parsed, fmt.Errorf("cloudwatch dimension %q must be <name>=<value>", dimension)
+			}
+			parsed.dimensions = append(parsed.dimensions, [2]string{strings.TrimSpace(name), strings.TrimSpace(value)})
+		}
+	} else if colon := strings.LastIndex(query, ":"); colon >= 0 {
+		head, parsed.statistic = query[:colon], query[colon+1:]
+	}
+
+	slash := strings.LastIndex(head, "/")
+	if slash <= 0 || slash == len(head)-1 {
+		return parsed, fmt.Errorf("cloudwatch query %q must name <namespace>/<metric>", query)
+	}
+	parsed.namespace, parsed.metricName = head[:slash], head[slash+1:]
+
+	switch parsed.statistic {
+	case "Average", "Sum", "Minimum", "Maximum", "SampleCount":
+	default:
+		return parsed, fmt.Errorf("unknown cloudwatch statistic %q", parsed.statistic)
+	}
+	return parsed, nil
+}
+
+// cloudWatchResponse is the part of a GetMetricStatistics response the source reads
+type cloudWatchResponse struct {
+	Datapoints []struct {
+		Timestamp   time.Time `xml:"Timestamp"`
+		Average     float64   `xml:"Average"`
+		Sum         float64   `xml:"Sum"`
+		Minimum     float64   `xml:"Minimum"`
+		Maximum     float64   `xml:"Maximum"`
+		SampleCount float64   `xml:"SampleCount"`
+	} `xml:"GetMetricStatisticsResult>Datapoints>member"`
+}
+
+// Query returns the datapoints of the query's statistic between from and to
+func (s *CloudWatchSource) Query(ctx context.Context, query string, from, to time.Time) ([]domain.TelemetryPoint, error) {
+	parsed, err := parseCloudWatchQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	period := int64(60)
+	if minutes := int64(to.Sub(from).Minutes()); minutes > maxCloudWatchDatapoints {
+		period = (minutes + maxCloudWatchDatapoints - 1) / maxCloudWatchDatapoints * 60
+	}
+
+	form := url.Values{}
+	form.Set("Action", "GetMetricStatistics")
+	form.Set("Version", "2010-08-01")
+	form.Set("Namespace", parsed.namespace)
+	form.Set("MetricName", parsed.metricName)
+	form.Set("StartTime", from.UTC().Format(time.RFC3339))
+	form.Set("EndTime", to.UTC().Format(time.RFC3339))
+	form.Set("Period", strconv.FormatInt(period, 10))
+	form.Set("Statistics.member.1", parsed.statistic)
+	for i, dimension := range parsed.dimensions {
+		form.Set(fmt.Sprintf("Dimensions.member.%d.Name", i+1), dimension[0])
+		form.Set(fmt.Sprintf("Dimensions.member.%d.Value", i+1), dimension[1])
+	}
+	body := form.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.config.Endpoint, strings.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+	s.sign(req, body)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query cloudwatch: %w", err)
+	}
+	defer resp.Body.Close()
+	if err := checkStatus(resp, "cloudwatch"); err != nil {
+		return nil, err
+	}
+
+	var result cloudWatchResponse
+	if err := xml.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode cloudwatch response: %w", err)
+	}
+
+	points := make([]domain.TelemetryPoint, 0, len(result.Datapoints))
+	for _, datapoint := range result.Datapoints {
+		value := datapoint.Average
+		switch parsed.statistic {
+		case "Sum":
+			value = datapoint.Sum
+		case "Minimum":
+			value = datapoint.Minimum
+		case "Maximum":
+			value = datapoint.Maximum
+		case "SampleCount":
+			value = datapoint.SampleCount
+		}
+		points = append(points, domain.TelemetryPoint{At: datapoint.Timestamp, Value: value})
+	}
+	return points, nil
+}
+
+// sign signs the request with AWS Signature Version 4
+func (s *CloudWatchSource) sign(req *http.Request, body string) {
+	now := s.now().UTC()
+	stamp := now.Format("20060102T150405Z")
+	day := now.Format("20060102")
+
+	req.Header.Set("X-Amz-Date", stamp)
+	if s.config.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", s.config.SessionToken)
+	}
+
+	// Canonical headers in lowercase, sorted by name
+	headers := [][2]string{
+		{"content-type", req.Header.Get("Content-Type")},
+		{"host", req.URL.Host},
+		{"x-amz-date", stamp},
+	}
+	if s.config.SessionToken != "" {
+		headers = append(headers, [2]string{"x-amz-security-token", s.config.SessionToken})
+	}
+	var canonicalHeaders strings.Builder
+	names := make([]string, 0, len(headers))
+	for _, header := range headers {
+		fmt.Fprintf(&canonicalHeaders, "%s:%s\n", header[0], strings.TrimSpace(header[1]))
+		names = append(names, header[0])
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	path := req.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	canonicalRequest := strings.Join([]string{
+		req.Method, path, req.URL.RawQuery, canonicalHeaders.String(), signedHeaders, sha256Hex(body),
+	}, "\n")
+
+	scope := fmt.Sprintf("%s/%s/monitoring/aws4_request", day, s.config.Region)
+	stringToSign := strings.Join([]string{"AWS4-HMAC-SHA256", stamp, scope, sha256Hex(canonicalRequest)}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.config.SecretAccessKey), day)
+	key = hmacSHA256(key, s.config.Region)
+	key = hmacSHA256(key, "monitoring")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.config.AccessKeyID, scope, signedHeaders, signature))
+}
+
+func sha256Hex(data string) string {
+	sum := sha256.Sum256([]byte(data))
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,99 @@
+package telemetry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// DatadogConfig holds the keys and site of a Datadog organization
+type DatadogConfig struct {
+	APIKey         string
+	ApplicationKey string
+	Site           string // e.g. "datadoghq.eu"; "datadoghq.com" when empty
+}
+
+// DatadogSource queries timeseries with the Datadog metrics query API, e.g.
+// "avg:trace.http.request.duration{service:erp-core}". The points of every series the query
+// returns are pooled.
+type DatadogSource struct {
+	config DatadogConfig
+	client *http.Client
+}
+
+// NewDatadogSource creates a Datadog telemetry source. A nil client uses a client with a 30 second
+// timeout.
+func NewDatadogSource(config DatadogConfig, client *http.Client) (*DatadogSource, error) {
+	if config.APIKey == "" || config.ApplicationKey == "" {
+		return nil, fmt.Errorf("datadog requires an API key and an application key")
+	}
+	if config.Site == "" {
+		config.Site = "datadoghq.com"
+	}
+	return &DatadogSource{config: config, client: httpClient(client)}, nil
+}
+
+// Name returns "datadog"
+func (s *DatadogSource) Name() string {
+	return "datadog"
+}
+
+// datadogQueryResponse is the part of a metrics query response the source reads
+type datadogQueryResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+	Series []struct {
+		Pointlist [][2]*float64 `json:"pointlist"` // Unix milliseconds and value, which may be null
+	} `json:"series"`
+}
+
+// Query returns the points of the query's series between from and to
+func (s *DatadogSource) Query(ctx context.Context, query string, from, to time.Time) ([]domain.TelemetryPoint, error) {
+	params := url.Values{}
+	params.Set("query", query)
+	params.Set("from", strconv.FormatInt(from.Unix(), 10))
+	params.Set("to", strconv.FormatInt(to.Unix(), 10))
+	endpoint := fmt.Sprintf("https://api.%s/api/v1/query?%s", s.config.Site, params.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("DD-API-KEY", s.config.APIKey)
+	req.Header.Set("DD-APPLICATION-KEY", s.config.ApplicationKey)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query datadog: %w", err)
+	}
+	defer resp.Body.Close()
+	if err := checkStatus(resp, "datadog"); err != nil {
+		return nil, err
+	}
+
+	var result datadogQueryResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode datadog response: %w", err)
+	}
+	if result.Status == "error" {
+		return nil, fmt.Errorf("datadog query failed: %s", result.Error)
+	}
+
+	points := []domain.TelemetryPoint{}
+	for _, series := range result.Series {
+		for _, point := range series.Pointlist {
+			if point[0] == nil || point[1] == nil {
+				continue
+			}
+			points = append(points, domain.TelemetryPoint{At: time.UnixMilli(int64(*point[0])), Value: *point[1]})
+		}
+	}
+	return points, nil
+}
@@ -0,0 +1,31 @@
+// Package telemetry pulls production metrics from Datadog and CloudWatch so KPIs can be measured
+// from them
+package telemetry
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// defaultTimeout bounds queries when no client is given
+const defaultTimeout = 30 * time.Second
+
+// httpClient returns the client, or one with the default timeout when it is nil
+func httpClient(client *http.Client) *http.Client {
+	if client != nil {
+		return client
+	}
+	return &http.Client{Timeout: defaultTimeout}
+}
+
+// checkStatus fails on a non-2xx response, quoting the start of its body
+func checkStatus(resp *http.Response, source string) error {
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	return fmt.Errorf("%s query rejected with status %d: %s", source, resp.StatusCode, strings.TrimSpace(string(detail)))
+}
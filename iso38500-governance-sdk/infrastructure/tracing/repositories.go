@@ -0,0 +1,1492 @@
+package tracing
+
+import (
+	"context"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// applicationRepository is an ApplicationRepository whose calls are traced
+type applicationRepository struct {
+	next   domain.ApplicationRepository
+	tracer domain.Tracer
+}
+
+// NewApplicationRepository traces every call to an ApplicationRepository
+func NewApplicationRepository(next domain.ApplicationRepository, tracer domain.Tracer) domain.ApplicationRepository {
+	return &applicationRepository{next: next, tracer: tracer}
+}
+
+func (r *applicationRepository) Save(ctx context.Context, app domain.Application) error {
+	return traceErr(ctx, r.tracer, "ApplicationRepository.Save", func(ctx context.Context) error {
+		return r.next.Save(ctx, app)
+	}, domain.ApplicationAttribute(app.ID))
+}
+
+func (r *applicationRepository) FindByID(ctx context.Context, id domain.ApplicationID) (domain.Application, error) {
+	return trace(ctx, r.tracer, "ApplicationRepository.FindByID", func(ctx context.Context) (domain.Application, error) {
+		return r.next.FindByID(ctx, id)
+	}, domain.ApplicationAttribute(id))
+}
+
+func (r *applicationRepository) FindByName(ctx context.Context, name string) (domain.Application, error) {
+	return trace(ctx, r.tracer, "ApplicationRepository.FindByName", func(ctx context.Context) (domain.Application, error) {
+		return r.next.FindByName(ctx, name)
+	})
+}
+
+func (r *applicationRepository) FindAll(ctx context.Context) ([]domain.Application, error) {
+	return trace(ctx, r.tracer, "ApplicationRepository.FindAll", func(ctx context.Context) ([]domain.Application, error) {
+		return r.next.FindAll(ctx)
+	})
+}
+
+func (r *applicationRepository) FindByPortfolioID(ctx context.Context, portfolioID domain.PortfolioID) ([]domain.Application, error) {
+	return trace(ctx, r.tracer, "ApplicationRepository.FindByPortfolioID", func(ctx context.Context) ([]domain.Application, error) {
+		return r.next.FindByPortfolioID(ctx, portfolioID)
+	}, domain.PortfolioAttribute(portfolioID))
+}
+
+func (r *applicationRepository) Update(ctx context.Context, app domain.Application) error {
+	return traceErr(ctx, r.tracer, "ApplicationRepository.Update", func(ctx context.Context) error {
+		return r.next.Update(ctx, app)
+	}, domain.ApplicationAttribute(app.ID))
+}
+
+func (r *applicationRepository) Delete(ctx context.Context, id domain.ApplicationID) error {
+	return traceErr(ctx, r.tracer, "ApplicationRepository.Delete", func(ctx context.Context) error {
+		return r.next.Delete(ctx, id)
+	}, domain.ApplicationAttribute(id))
+}
+
+func (r *applicationRepository) Exists(ctx context.Context, id domain.ApplicationID) (bool, error) {
+	return trace(ctx, r.tracer, "ApplicationRepository.Exists", func(ctx context.Context) (bool, error) {
+		return r.next.Exists(ctx, id)
+	}, domain.ApplicationAttribute(id))
+}
+
+// governanceAgreementRepository is a GovernanceAgreementRepository whose calls are traced
+type governanceAgreementRepository struct {
+	next   domain.GovernanceAgreementRepository
+	tracer domain.Tracer
+}
+
+// NewGovernanceAgreementRepository traces every call to a GovernanceAgreementRepository
+func NewGovernanceAgreementRepository(next domain.GovernanceAgreementRepository, tracer domain.Tracer) domain.GovernanceAgreementRepository {
+	return &governanceAgreementRepository{next: next, tracer: tracer}
+}
+
+func (r *governanceAgreementRepository) Save(ctx context.Context, agreement domain.GovernanceAgreement) error {
+	return traceErr(ctx, r.tracer, "GovernanceAgreementRepository.Save", func(ctx context.Context) error {
+		return r.next.Save(ctx, agreement)
+	}, domain.AgreementAttribute(agreement.ID), domain.ApplicationAttribute(agreement.ApplicationID))
+}
+
+func (r *governanceAgreementRepository) FindByID(ctx context.Context, id domain.GovernanceAgreementID) (domain.GovernanceAgreement, error) {
+	return trace(ctx, r.tracer, "GovernanceAgreementRepository.FindByID", func(ctx context.Context) (domain.GovernanceAgreement, error) {
+		return r.next.FindByID(ctx, id)
+	}, domain.AgreementAttribute(id))
+}
+
+func (r *governanceAgreementRepository) FindByApplicationID(ctx context.Context, appID domain.ApplicationID) (domain.GovernanceAgreement, error) {
+	return trace(ctx, r.tracer, "GovernanceAgreementRepository.FindByApplicationID", func(ctx context.Context) (domain.GovernanceAgreement, error) {
+		return r.next.FindByApplicationID(ctx, appID)
+	}, domain.ApplicationAttribute(appID))
+}
+
+func (r *governanceAgreementRepository) FindAll(ctx context.Context) ([]domain.GovernanceAgreement, error) {
+	return trace(ctx, r.tracer, "GovernanceAgreementRepository.FindAll", func(ctx context.Context) ([]domain.GovernanceAgreement, error) {
+		return r.next.FindAll(ctx)
+	})
+}
+
+func (r *governanceAgreementRepository) FindByStatus(ctx context.Context, status domain.AgreementStatus) ([]domain.GovernanceAgreement, error) {
+	return trace(ctx, r.tracer, "GovernanceAgreementRepository.FindByStatus", func(ctx context.Context) ([]domain.GovernanceAgreement, error) {
+		return r.next.FindByStatus(ctx, status)
+	})
+}
+
+func (r *governanceAgreementRepository) Update(ctx context.Context, agreement domain.GovernanceAgreement) error {
+	return traceErr(ctx, r.tracer, "GovernanceAgreementRepository.Update", func(ctx context.Context) error {
+		return r.next.Update(ctx, agreement)
+	}, domain.AgreementAttribute(agreement.ID), domain.ApplicationAttribute(agreement.ApplicationID))
+}
+
+func (r *governanceAgreementRepository) Delete(ctx context.Context, id domain.GovernanceAgreementID) error {
+	return traceErr(ctx, r.tracer, "GovernanceAgreementRepository.Delete", func(ctx context.Context) error {
+		return r.next.Delete(ctx, id)
+	}, domain.AgreementAttribute(id))
+}
+
+func (r *governanceAgreementRepository) Exists(ctx context.Context, id domain.GovernanceAgreementID) (bool, error) {
+	return trace(ctx, r.tracer, "GovernanceAgreementRepository.Exists", func(ctx context.Context) (bool, error) {
+		return r.next.Exists(ctx, id)
+	}, domain.AgreementAttribute(id))
+}
+
+// applicationPortfolioRepository is an ApplicationPortfolioRepository whose calls are traced
+type applicationPortfolioRepository struct {
+	next   domain.ApplicationPortfolioRepository
+	tracer domain.Tracer
+}
+
+// NewApplicationPortfolioRepository traces every call to an ApplicationPortfolioRepository
+func NewApplicationPortfolioRepository(next domain.ApplicationPortfolioRepository, tracer domain.Tracer) domain.ApplicationPortfolioRepository {
+	return &applicationPortfolioRepository{next: next, tracer: tracer}
+}
+
+func (r *applicationPortfolioRepository) Save(ctx context.Context, portfolio domain.ApplicationPortfolio) error {
+	return traceErr(ctx, r.tracer, "ApplicationPortfolioRepository.Save", func(ctx context.Context) error {
+		return r.next.Save(ctx, portfolio)
+	}, domain.PortfolioAttribute(portfolio.ID))
+}
+
+func (r *applicationPortfolioRepository) FindByID(ctx context.Context, id domain.PortfolioID) (domain.ApplicationPortfolio, error) {
+	return trace(ctx, r.tracer, "ApplicationPortfolioRepository.FindByID", func(ctx context.Context) (domain.ApplicationPortfolio, error) {
+		return r.next.FindByID(ctx, id)
+	}, domain.PortfolioAttribute(id))
+}
+
+func (r *applicationPortfolioRepository) FindByOwner(ctx context.Context, owner string) ([]domain.ApplicationPortfolio, error) {
+	return trace(ctx, r.tracer, "ApplicationPortfolioRepository.FindByOwner", func(ctx context.Context) ([]domain.ApplicationPortfolio, error) {
+		return r.next.FindByOwner(ctx, owner)
+	})
+}
+
+func (r *applicationPortfolioRepository) FindAll(ctx context.Context) ([]domain.ApplicationPortfolio, error) {
+	return trace(ctx, r.tracer, "ApplicationPortfolioRepository.FindAll", func(ctx context.Context) ([]domain.ApplicationPortfolio, error) {
+		return r.next.FindAll(ctx)
+	})
+}
+
+func (r *applicationPortfolioRepository) Update(ctx context.Context, portfolio domain.ApplicationPortfolio) error {
+	return traceErr(ctx, r.tracer, "ApplicationPortfolioRepository.Update", func(ctx context.Context) error {
+		return r.next.Update(ctx, portfolio)
+	}, domain.PortfolioAttribute(portfolio.ID))
+}
+
+func (r *applicationPortfolioRepository) Delete(ctx context.Context, id domain.PortfolioID) error {
+	return traceErr(ctx, r.tracer, "ApplicationPortfolioRepository.Delete", func(ctx context.Context) error {
+		return r.next.Delete(ctx, id)
+	}, domain.PortfolioAttribute(id))
+}
+
+func (r *applicationPortfolioRepository) Exists(ctx context.Context, id domain.PortfolioID) (bool, error) {
+	return trace(ctx, r.tracer, "ApplicationPortfolioRepository.Exists", func(ctx context.Context) (bool, error) {
+		return r.next.Exists(ctx, id)
+	}, domain.PortfolioAttribute(id))
+}
+
+func (r *applicationPortfolioRepository) AddApplication(ctx context.Context, portfolioID domain.PortfolioID, appID domain.ApplicationID) error {
+	return traceErr(ctx, r.tracer, "ApplicationPortfolioRepository.AddApplication", func(ctx context.Context) error {
+		return r.next.AddApplication(ctx, portfolioID, appID)
+	}, domain.PortfolioAttribute(portfolioID), domain.ApplicationAttribute(appID))
+}
+
+func (r *applicationPortfolioRepository) RemoveApplication(ctx context.Context, portfolioID domain.PortfolioID, appID domain.ApplicationID) error {
+	return traceErr(ctx, r.tracer, "ApplicationPortfolioRepository.RemoveApplication", func(ctx context.Context) error {
+		return r.next.RemoveApplication(ctx, portfolioID, appID)
+	}, domain.PortfolioAttribute(portfolioID), domain.ApplicationAttribute(appID))
+}
+
+// changeRequestRepository is a ChangeRequestRepository whose calls are traced
+type changeRequestRepository struct {
+	next   domain.ChangeRequestRepository
+	tracer domain.Tracer
+}
+
+// NewChangeRequestRepository traces every call to a ChangeRequestRepository
+func NewChangeRequestRepository(next domain.ChangeRequestRepository, tracer domain.Tracer) domain.ChangeRequestRepository {
+	return &changeRequestRepository{next: next, tracer: tracer}
+}
+
+func (r *changeRequestRepository) Save(ctx context.Context, cr domain.ChangeRequest) error {
+	return traceErr(ctx, r.tracer, "ChangeRequestRepository.Save", func(ctx context.Context) error {
+		return r.next.Save(ctx, cr)
+	}, domain.ApplicationAttribute(cr.ApplicationID))
+}
+
+func (r *changeRequestRepository) FindByID(ctx context.Context, id string) (domain.ChangeRequest, error) {
+	return trace(ctx, r.tracer, "ChangeRequestRepository.FindByID", func(ctx context.Context) (domain.ChangeRequest, error) {
+		return r.next.FindByID(ctx, id)
+	})
+}
+
+func (r *changeRequestRepository) FindByApplicationID(ctx context.Context, appID domain.ApplicationID) ([]domain.ChangeRequest, error) {
+	return trace(ctx, r.tracer, "ChangeRequestRepository.FindByApplicationID", func(ctx context.Context) ([]domain.ChangeRequest, error) {
+		return r.next.FindByApplicationID(ctx, appID)
+	}, domain.ApplicationAttribute(appID))
+}
+
+func (r *changeRequestRepository) FindByStatus(ctx context.Context, status domain.ChangeRequestStatus) ([]domain.ChangeRequest, error) {
+	return trace(ctx, r.tracer, "ChangeRequestRepository.FindByStatus", func(ctx context.Context) ([]domain.ChangeRequest, error) {
+		return r.next.FindByStatus(ctx, status)
+	})
+}
+
+func (r *changeRequestRepository) FindByPriority(ctx context.Context, priority domain.Priority) ([]domain.ChangeRequest, error) {
+	return trace(ctx, r.tracer, "ChangeRequestRepository.FindByPriority", func(ctx context.Context) ([]domain.ChangeRequest, error) {
+		return r.next.FindByPriority(ctx, priority)
+	})
+}
+
+func (r *changeRequestRepository) Update(ctx context.Context, cr domain.ChangeRequest) error {
+	return traceErr(ctx, r.tracer, "ChangeRequestRepository.Update", func(ctx context.Context) error {
+		return r.next.Update(ctx, cr)
+	}, domain.ApplicationAttribute(cr.ApplicationID))
+}
+
+func (r *changeRequestRepository) Delete(ctx context.Context, id string) error {
+	return traceErr(ctx, r.tracer, "ChangeRequestRepository.Delete", func(ctx context.Context) error {
+		return r.next.Delete(ctx, id)
+	})
+}
+
+func (r *changeRequestRepository) Exists(ctx context.Context, id string) (bool, error) {
+	return trace(ctx, r.tracer, "ChangeRequestRepository.Exists", func(ctx context.Context) (bool, error) {
+		return r.next.Exists(ctx, id)
+	})
+}
+
+// incidentRepository is an IncidentRepository whose calls are traced
+type incidentRepository struct {
+	next   domain.IncidentRepository
+	tracer domain.Tracer
+}
+
+// NewIncidentRepository traces every call to an IncidentRepository
+func NewIncidentRepository(next domain.IncidentRepository, tracer domain.Tracer) domain.IncidentRepository {
+	return &incidentRepository{next: next, tracer: tracer}
+}
+
+func (r *incidentRepository) Save(ctx context.Context, incident domain.Incident) error {
+	return traceErr(ctx, r.tracer, "IncidentRepository.Save", func(ctx context.Context) error {
+		return r.next.Save(ctx, incident)
+	}, domain.ApplicationAttribute(incident.ApplicationID))
+}
+
+func (r *incidentRepository) FindByID(ctx context.Context, id string) (domain.Incident, error) {
+	return trace(ctx, r.tracer, "IncidentRepository.FindByID", func(ctx context.Context) (domain.Incident, error) {
+		return r.next.FindByID(ctx, id)
+	})
+}
+
+func (r *incidentRepository) FindByApplicationID(ctx context.Context, appID domain.ApplicationID) ([]domain.Incident, error) {
+	return trace(ctx, r.tracer, "IncidentRepository.FindByApplicationID", func(ctx context.Context) ([]domain.Incident, error) {
+		return r.next.FindByApplicationID(ctx, appID)
+	}, domain.ApplicationAttribute(appID))
+}
+
+func (r *incidentRepository) FindByStatus(ctx context.Context, status domain.IncidentStatus) ([]domain.Incident, error) {
+	return trace(ctx, r.tracer, "IncidentRepository.FindByStatus", func(ctx context.Context) ([]domain.Incident, error) {
+		return r.next.FindByStatus(ctx, status)
+	})
+}
+
+func (r *incidentRepository) FindBySeverity(ctx context.Context, severity int) ([]domain.Incident, error) {
+	return trace(ctx, r.tracer, "IncidentRepository.FindBySeverity", func(ctx context.Context) ([]domain.Incident, error) {
+		return r.next.FindBySeverity(ctx, severity)
+	})
+}
+
+func (r *incidentRepository) FindByAssignee(ctx context.Context, assignee string) ([]domain.Incident, error) {
+	return trace(ctx, r.tracer, "IncidentRepository.FindByAssignee", func(ctx context.Context) ([]domain.Incident, error) {
+		return r.next.FindByAssignee(ctx, assignee)
+	})
+}
+
+func (r *incidentRepository) Update(ctx context.Context, incident domain.Incident) error {
+	return traceErr(ctx, r.tracer, "IncidentRepository.Update", func(ctx context.Context) error {
+		return r.next.Update(ctx, incident)
+	}, domain.ApplicationAttribute(incident.ApplicationID))
+}
+
+func (r *incidentRepository) Delete(ctx context.Context, id string) error {
+	return traceErr(ctx, r.tracer, "IncidentRepository.Delete", func(ctx context.Context) error {
+		return r.next.Delete(ctx, id)
+	})
+}
+
+func (r *incidentRepository) Exists(ctx context.Context, id string) (bool, error) {
+	return trace(ctx, r.tracer, "IncidentRepository.Exists", func(ctx context.Context) (bool, error) {
+		return r.next.Exists(ctx, id)
+	})
+}
+
+// auditRepository is an AuditRepository whose calls are traced
+type auditRepository struct {
+	next   domain.AuditRepository
+	tracer domain.Tracer
+}
+
+// NewAuditRepository traces every call to an AuditRepository
+func NewAuditRepository(next domain.AuditRepository, tracer domain.Tracer) domain.AuditRepository {
+	return &auditRepository{next: next, tracer: tracer}
+}
+
+func (r *auditRepository) Save(ctx context.Context, audit domain.Audit) error {
+	return traceErr(ctx, r.tracer, "AuditRepository.Save", func(ctx context.Context) error {
+		return r.next.Save(ctx, audit)
+	}, domain.ApplicationAttribute(audit.ApplicationID))
+}
+
+func (r *auditRepository) FindByID(ctx context.Context, id string) (domain.Audit, error) {
+	return trace(ctx, r.tracer, "AuditRepository.FindByID", func(ctx context.Context) (domain.Audit, error) {
+		return r.next.FindByID(ctx, id)
+	})
+}
+
+func (r *auditRepository) FindByApplicationID(ctx context.Context, appID domain.ApplicationID) ([]domain.Audit, error) {
+	return trace(ctx, r.tracer, "AuditRepository.FindByApplicationID", func(ctx context.Context) ([]domain.Audit, error) {
+		return r.next.FindByApplicationID(ctx, appID)
+	}, domain.ApplicationAttribute(appID))
+}
+
+func (r *auditRepository) FindByStatus(ctx context.Context, status domain.AuditStatus) ([]domain.Audit, error) {
+	return trace(ctx, r.tracer, "AuditRepository.FindByStatus", func(ctx context.Context) ([]domain.Audit, error) {
+		return r.next.FindByStatus(ctx, status)
+	})
+}
+
+func (r *auditRepository) FindByPeriod(ctx context.Context, start, end time.Time) ([]domain.Audit, error) {
+	return trace(ctx, r.tracer, "AuditRepository.FindByPeriod", func(ctx context.Context) ([]domain.Audit, error) {
+		return r.next.FindByPeriod(ctx, start, end)
+	})
+}
+
+func (r *auditRepository) Update(ctx context.Context, audit domain.Audit) error {
+	return traceErr(ctx, r.tracer, "AuditRepository.Update", func(ctx context.Context) error {
+		return r.next.Update(ctx, audit)
+	}, domain.ApplicationAttribute(audit.ApplicationID))
+}
+
+func (r *auditRepository) Delete(ctx context.Context, id string) error {
+	return traceErr(ctx, r.tracer, "AuditRepository.Delete", func(ctx context.Context) error {
+		return r.next.Delete(ctx, id)
+	})
+}
+
+func (r *auditRepository) Exists(ctx context.Context, id string) (bool, error) {
+	return trace(ctx, r.tracer, "AuditRepository.Exists", func(ctx context.Context) (bool, error) {
+		return r.next.Exists(ctx, id)
+	})
+}
+
+// kPIRepository is a KPIRepository whose calls are traced
+type kPIRepository struct {
+	next   domain.KPIRepository
+	tracer domain.Tracer
+}
+
+// NewKPIRepository traces every call to a KPIRepository
+func NewKPIRepository(next domain.KPIRepository, tracer domain.Tracer) domain.KPIRepository {
+	return &kPIRepository{next: next, tracer: tracer}
+}
+
+func (r *kPIRepository) Save(ctx context.Context, kpi domain.KPI) error {
+	return traceErr(ctx, r.tracer, "KPIRepository.Save", func(ctx context.Context) error {
+		return r.next.Save(ctx, kpi)
+	})
+}
+
+func (r *kPIRepository) FindByID(ctx context.Context, id string) (domain.KPI, error) {
+	return trace(ctx, r.tracer, "KPIRepository.FindByID", func(ctx context.Context) (domain.KPI, error) {
+		return r.next.FindByID(ctx, id)
+	})
+}
+
+func (r *kPIRepository) FindAll(ctx context.Context) ([]domain.KPI, error) {
+	return trace(ctx, r.tracer, "KPIRepository.FindAll", func(ctx context.Context) ([]domain.KPI, error) {
+		return r.next.FindAll(ctx)
+	})
+}
+
+func (r *kPIRepository) FindByCategory(ctx context.Context, category string) ([]domain.KPI, error) {
+	return trace(ctx, r.tracer, "KPIRepository.FindByCategory", func(ctx context.Context) ([]domain.KPI, error) {
+		return r.next.FindByCategory(ctx, category)
+	})
+}
+
+func (r *kPIRepository) Update(ctx context.Context, kpi domain.KPI) error {
+	return traceErr(ctx, r.tracer, "KPIRepository.Update", func(ctx context.Context) error {
+		return r.next.Update(ctx, kpi)
+	})
+}
+
+func (r *kPIRepository) Delete(ctx context.Context, id string) error {
+	return traceErr(ctx, r.tracer, "KPIRepository.Delete", func(ctx context.Context) error {
+		return r.next.Delete(ctx, id)
+	})
+}
+
+func (r *kPIRepository) Exists(ctx context.Context, id string) (bool, error) {
+	return trace(ctx, r.tracer, "KPIRepository.Exists", func(ctx context.Context) (bool, error) {
+		return r.next.Exists(ctx, id)
+	})
+}
+
+// kPIMeasurementRepository is a KPIMeasurementRepository whose calls are traced
+type kPIMeasurementRepository struct {
+	next   domain.KPIMeasurementRepository
+	tracer domain.Tracer
+}
+
+// NewKPIMeasurementRepository traces every call to a KPIMeasurementRepository
+func NewKPIMeasurementRepository(next domain.KPIMeasurementRepository, tracer domain.Tracer) domain.KPIMeasurementRepository {
+	return &kPIMeasurementRepository{next: next, tracer: tracer}
+}
+
+func (r *kPIMeasurementRepository) Save(ctx context.Context, measurement domain.KPIMeasurement) error {
+	return traceErr(ctx, r.tracer, "KPIMeasurementRepository.Save", func(ctx context.Context) error {
+		return r.next.Save(ctx, measurement)
+	})
+}
+
+func (r *kPIMeasurementRepository) FindByKPIID(ctx context.Context, kpiID string) ([]domain.KPIMeasurement, error) {
+	return trace(ctx, r.tracer, "KPIMeasurementRepository.FindByKPIID", func(ctx context.Context) ([]domain.KPIMeasurement, error) {
+		return r.next.FindByKPIID(ctx, kpiID)
+	})
+}
+
+func (r *kPIMeasurementRepository) FindByPeriod(ctx context.Context, kpiID string, start, end time.Time) ([]domain.KPIMeasurement, error) {
+	return trace(ctx, r.tracer, "KPIMeasurementRepository.FindByPeriod", func(ctx context.Context) ([]domain.KPIMeasurement, error) {
+		return r.next.FindByPeriod(ctx, kpiID, start, end)
+	})
+}
+
+func (r *kPIMeasurementRepository) FindLatest(ctx context.Context, kpiID string) (domain.KPIMeasurement, error) {
+	return trace(ctx, r.tracer, "KPIMeasurementRepository.FindLatest", func(ctx context.Context) (domain.KPIMeasurement, error) {
+		return r.next.FindLatest(ctx, kpiID)
+	})
+}
+
+func (r *kPIMeasurementRepository) Delete(ctx context.Context, kpiID string, measuredAt time.Time) error {
+	return traceErr(ctx, r.tracer, "KPIMeasurementRepository.Delete", func(ctx context.Context) error {
+		return r.next.Delete(ctx, kpiID, measuredAt)
+	})
+}
+
+// assessmentRepository is an AssessmentRepository whose calls are traced
+type assessmentRepository struct {
+	next   domain.AssessmentRepository
+	tracer domain.Tracer
+}
+
+// NewAssessmentRepository traces every call to an AssessmentRepository
+func NewAssessmentRepository(next domain.AssessmentRepository, tracer domain.Tracer) domain.AssessmentRepository {
+	return &assessmentRepository{next: next, tracer: tracer}
+}
+
+func (r *assessmentRepository) Save(ctx context.Context, assessment domain.ApplicationAssessment) error {
+	return traceErr(ctx, r.tracer, "AssessmentRepository.Save", func(ctx context.Context) error {
+		return r.next.Save(ctx, assessment)
+	}, domain.ApplicationAttribute(assessment.ApplicationID))
+}
+
+func (r *assessmentRepository) FindByApplicationID(ctx context.Context, appID domain.ApplicationID) ([]domain.ApplicationAssessment, error) {
+	return trace(ctx, r.tracer, "AssessmentRepository.FindByApplicationID", func(ctx context.Context) ([]domain.ApplicationAssessment, error) {
+		return r.next.FindByApplicationID(ctx, appID)
+	}, domain.ApplicationAttribute(appID))
+}
+
+func (r *assessmentRepository) FindLatest(ctx context.Context, appID domain.ApplicationID) (domain.ApplicationAssessment, error) {
+	return trace(ctx, r.tracer, "AssessmentRepository.FindLatest", func(ctx context.Context) (domain.ApplicationAssessment, error) {
+		return r.next.FindLatest(ctx, appID)
+	}, domain.ApplicationAttribute(appID))
+}
+
+func (r *assessmentRepository) FindByPeriod(ctx context.Context, appID domain.ApplicationID, start, end time.Time) ([]domain.ApplicationAssessment, error) {
+	return trace(ctx, r.tracer, "AssessmentRepository.FindByPeriod", func(ctx context.Context) ([]domain.ApplicationAssessment, error) {
+		return r.next.FindByPeriod(ctx, appID, start, end)
+	}, domain.ApplicationAttribute(appID))
+}
+
+func (r *assessmentRepository) Update(ctx context.Context, assessment domain.ApplicationAssessment) error {
+	return traceErr(ctx, r.tracer, "AssessmentRepository.Update", func(ctx context.Context) error {
+		return r.next.Update(ctx, assessment)
+	}, domain.ApplicationAttribute(assessment.ApplicationID))
+}
+
+// technicalDebtRepository is a TechnicalDebtRepository whose calls are traced
+type technicalDebtRepository struct {
+	next   domain.TechnicalDebtRepository
+	tracer domain.Tracer
+}
+
+// NewTechnicalDebtRepository traces every call to a TechnicalDebtRepository
+func NewTechnicalDebtRepository(next domain.TechnicalDebtRepository, tracer domain.Tracer) domain.TechnicalDebtRepository {
+	return &technicalDebtRepository{next: next, tracer: tracer}
+}
+
+func (r *technicalDebtRepository) Save(ctx context.Context, item domain.TechnicalDebtItem) error {
+	return traceErr(ctx, r.tracer, "TechnicalDebtRepository.Save", func(ctx context.Context) error {
+		return r.next.Save(ctx, item)
+	}, domain.ApplicationAttribute(item.ApplicationID))
+}
+
+func (r *technicalDebtRepository) FindByID(ctx context.Context, id string) (domain.TechnicalDebtItem, error) {
+	return trace(ctx, r.tracer, "TechnicalDebtRepository.FindByID", func(ctx context.Context) (domain.TechnicalDebtItem, error) {
+		return r.next.FindByID(ctx, id)
+	})
+}
+
+func (r *technicalDebtRepository) FindByApplicationID(ctx context.Context, appID domain.ApplicationID) ([]domain.TechnicalDebtItem, error) {
+	return trace(ctx, r.tracer, "TechnicalDebtRepository.FindByApplicationID", func(ctx context.Context) ([]domain.TechnicalDebtItem, error) {
+		return r.next.FindByApplicationID(ctx, appID)
+	}, domain.ApplicationAttribute(appID))
+}
+
+func (r *technicalDebtRepository) FindByStatus(ctx context.Context, status domain.TechnicalDebtStatus) ([]domain.TechnicalDebtItem, error) {
+	return trace(ctx, r.tracer, "TechnicalDebtRepository.FindByStatus", func(ctx context.Context) ([]domain.TechnicalDebtItem, error) {
+		return r.next.FindByStatus(ctx, status)
+	})
+}
+
+func (r *technicalDebtRepository) Update(ctx context.Context, item domain.TechnicalDebtItem) error {
+	return traceErr(ctx, r.tracer, "TechnicalDebtRepository.Update", func(ctx context.Context) error {
+		return r.next.Update(ctx, item)
+	}, domain.ApplicationAttribute(item.ApplicationID))
+}
+
+func (r *technicalDebtRepository) Delete(ctx context.Context, id string) error {
+	return traceErr(ctx, r.tracer, "TechnicalDebtRepository.Delete", func(ctx context.Context) error {
+		return r.next.Delete(ctx, id)
+	})
+}
+
+// vendorRepository is a VendorRepository whose calls are traced
+type vendorRepository struct {
+	next   domain.VendorRepository
+	tracer domain.Tracer
+}
+
+// NewVendorRepository traces every call to a VendorRepository
+func NewVendorRepository(next domain.VendorRepository, tracer domain.Tracer) domain.VendorRepository {
+	return &vendorRepository{next: next, tracer: tracer}
+}
+
+func (r *vendorRepository) Save(ctx context.Context, vendor domain.Vendor) error {
+	return traceErr(ctx, r.tracer, "VendorRepository.Save", func(ctx context.Context) error {
+		return r.next.Save(ctx, vendor)
+	})
+}
+
+func (r *vendorRepository) FindByID(ctx context.Context, id domain.VendorID) (domain.Vendor, error) {
+	return trace(ctx, r.tracer, "VendorRepository.FindByID", func(ctx context.Context) (domain.Vendor, error) {
+		return r.next.FindByID(ctx, id)
+	})
+}
+
+func (r *vendorRepository) FindByApplicationID(ctx context.Context, appID domain.ApplicationID) ([]domain.Vendor, error) {
+	return trace(ctx, r.tracer, "VendorRepository.FindByApplicationID", func(ctx context.Context) ([]domain.Vendor, error) {
+		return r.next.FindByApplicationID(ctx, appID)
+	}, domain.ApplicationAttribute(appID))
+}
+
+func (r *vendorRepository) FindAll(ctx context.Context) ([]domain.Vendor, error) {
+	return trace(ctx, r.tracer, "VendorRepository.FindAll", func(ctx context.Context) ([]domain.Vendor, error) {
+		return r.next.FindAll(ctx)
+	})
+}
+
+func (r *vendorRepository) Update(ctx context.Context, vendor domain.Vendor) error {
+	return traceErr(ctx, r.tracer, "VendorRepository.Update", func(ctx context.Context) error {
+		return r.next.Update(ctx, vendor)
+	})
+}
+
+func (r *vendorRepository) Delete(ctx context.Context, id domain.VendorID) error {
+	return traceErr(ctx, r.tracer, "VendorRepository.Delete", func(ctx context.Context) error {
+		return r.next.Delete(ctx, id)
+	})
+}
+
+// dpiaRepository is a DPIARepository whose calls are traced
+type dpiaRepository struct {
+	next   domain.DPIARepository
+	tracer domain.Tracer
+}
+
+// NewDPIARepository traces every call to a DPIARepository
+func NewDPIARepository(next domain.DPIARepository, tracer domain.Tracer) domain.DPIARepository {
+	return &dpiaRepository{next: next, tracer: tracer}
+}
+
+func (r *dpiaRepository) Save(ctx context.Context, dpia domain.DPIA) error {
+	return traceErr(ctx, r.tracer, "DPIARepository.Save", func(ctx context.Context) error {
+		return r.next.Save(ctx, dpia)
+	}, domain.ApplicationAttribute(dpia.ApplicationID))
+}
+
+func (r *dpiaRepository) FindByID(ctx context.Context, id string) (domain.DPIA, error) {
+	return trace(ctx, r.tracer, "DPIARepository.FindByID", func(ctx context.Context) (domain.DPIA, error) {
+		return r.next.FindByID(ctx, id)
+	})
+}
+
+func (r *dpiaRepository) FindByApplicationID(ctx context.Context, appID domain.ApplicationID) ([]domain.DPIA, error) {
+	return trace(ctx, r.tracer, "DPIARepository.FindByApplicationID", func(ctx context.Context) ([]domain.DPIA, error) {
+		return r.next.FindByApplicationID(ctx, appID)
+	}, domain.ApplicationAttribute(appID))
+}
+
+func (r *dpiaRepository) FindAll(ctx context.Context) ([]domain.DPIA, error) {
+	return trace(ctx, r.tracer, "DPIARepository.FindAll", func(ctx context.Context) ([]domain.DPIA, error) {
+		return r.next.FindAll(ctx)
+	})
+}
+
+func (r *dpiaRepository) Update(ctx context.Context, dpia domain.DPIA) error {
+	return traceErr(ctx, r.tracer, "DPIARepository.Update", func(ctx context.Context) error {
+		return r.next.Update(ctx, dpia)
+	}, domain.ApplicationAttribute(dpia.ApplicationID))
+}
+
+func (r *dpiaRepository) Delete(ctx context.Context, id string) error {
+	return traceErr(ctx, r.tracer, "DPIARepository.Delete", func(ctx context.Context) error {
+		return r.next.Delete(ctx, id)
+	})
+}
+
+// problemRepository is a ProblemRepository whose calls are traced
+type problemRepository struct {
+	next   domain.ProblemRepository
+	tracer domain.Tracer
+}
+
+// NewProblemRepository traces every call to a ProblemRepository
+func NewProblemRepository(next domain.ProblemRepository, tracer domain.Tracer) domain.ProblemRepository {
+	return &problemRepository{next: next, tracer: tracer}
+}
+
+func (r *problemRepository) Save(ctx context.Context, problem domain.Problem) error {
+	return traceErr(ctx, r.tracer, "ProblemRepository.Save", func(ctx context.Context) error {
+		return r.next.Save(ctx, problem)
+	}, domain.ApplicationAttribute(problem.ApplicationID))
+}
+
+func (r *problemRepository) FindByID(ctx context.Context, id string) (domain.Problem, error) {
+	return trace(ctx, r.tracer, "ProblemRepository.FindByID", func(ctx context.Context) (domain.Problem, error) {
+		return r.next.FindByID(ctx, id)
+	})
+}
+
+func (r *problemRepository) FindByApplicationID(ctx context.Context, appID domain.ApplicationID) ([]domain.Problem, error) {
+	return trace(ctx, r.tracer, "ProblemRepository.FindByApplicationID", func(ctx context.Context) ([]domain.Problem, error) {
+		return r.next.FindByApplicationID(ctx, appID)
+	}, domain.ApplicationAttribute(appID))
+}
+
+func (r *problemRepository) FindByStatus(ctx context.Context, status domain.ProblemStatus) ([]domain.Problem, error) {
+	return trace(ctx, r.tracer, "ProblemRepository.FindByStatus", func(ctx context.Context) ([]domain.Problem, error) {
+		return r.next.FindByStatus(ctx, status)
+	})
+}
+
+func (r *problemRepository) Update(ctx context.Context, problem domain.Problem) error {
+	return traceErr(ctx, r.tracer, "ProblemRepository.Update", func(ctx context.Context) error {
+		return r.next.Update(ctx, problem)
+	}, domain.ApplicationAttribute(problem.ApplicationID))
+}
+
+func (r *problemRepository) Delete(ctx context.Context, id string) error {
+	return traceErr(ctx, r.tracer, "ProblemRepository.Delete", func(ctx context.Context) error {
+		return r.next.Delete(ctx, id)
+	})
+}
+
+// retentionPolicyRepository is a RetentionPolicyRepository whose calls are traced
+type retentionPolicyRepository struct {
+	next   domain.RetentionPolicyRepository
+	tracer domain.Tracer
+}
+
+// NewRetentionPolicyRepository traces every call to a RetentionPolicyRepository
+func NewRetentionPolicyRepository(next domain.RetentionPolicyRepository, tracer domain.Tracer) domain.RetentionPolicyRepository {
+	return &retentionPolicyRepository{next: next, tracer: tracer}
+}
+
+func (r *retentionPolicyRepository) Save(ctx context.Context, policy domain.RetentionPolicy) error {
+	return traceErr(ctx, r.tracer, "RetentionPolicyRepository.Save", func(ctx context.Context) error {
+		return r.next.Save(ctx, policy)
+	})
+}
+
+func (r *retentionPolicyRepository) FindByID(ctx context.Context, id string) (domain.RetentionPolicy, error) {
+	return trace(ctx, r.tracer, "RetentionPolicyRepository.FindByID", func(ctx context.Context) (domain.RetentionPolicy, error) {
+		return r.next.FindByID(ctx, id)
+	})
+}
+
+func (r *retentionPolicyRepository) FindAll(ctx context.Context) ([]domain.RetentionPolicy, error) {
+	return trace(ctx, r.tracer, "RetentionPolicyRepository.FindAll", func(ctx context.Context) ([]domain.RetentionPolicy, error) {
+		return r.next.FindAll(ctx)
+	})
+}
+
+func (r *retentionPolicyRepository) Delete(ctx context.Context, id string) error {
+	return traceErr(ctx, r.tracer, "RetentionPolicyRepository.Delete", func(ctx context.Context) error {
+		return r.next.Delete(ctx, id)
+	})
+}
+
+// dataHoldingRepository is a DataHoldingRepository whose calls are traced
+type dataHoldingRepository struct {
+	next   domain.DataHoldingRepository
+	tracer domain.Tracer
+}
+
+// NewDataHoldingRepository traces every call to a DataHoldingRepository
+func NewDataHoldingRepository(next domain.DataHoldingRepository, tracer domain.Tracer) domain.DataHoldingRepository {
+	return &dataHoldingRepository{next: next, tracer: tracer}
+}
+
+func (r *dataHoldingRepository) Save(ctx context.Context, holding domain.DataHolding) error {
+	return traceErr(ctx, r.tracer, "DataHoldingRepository.Save", func(ctx context.Context) error {
+		return r.next.Save(ctx, holding)
+	}, domain.ApplicationAttribute(holding.ApplicationID))
+}
+
+func (r *dataHoldingRepository) FindByApplicationID(ctx context.Context, appID domain.ApplicationID) ([]domain.DataHolding, error) {
+	return trace(ctx, r.tracer, "DataHoldingRepository.FindByApplicationID", func(ctx context.Context) ([]domain.DataHolding, error) {
+		return r.next.FindByApplicationID(ctx, appID)
+	}, domain.ApplicationAttribute(appID))
+}
+
+func (r *dataHoldingRepository) FindAll(ctx context.Context) ([]domain.DataHolding, error) {
+	return trace(ctx, r.tracer, "DataHoldingRepository.FindAll", func(ctx context.Context) ([]domain.DataHolding, error) {
+		return r.next.FindAll(ctx)
+	})
+}
+
+func (r *dataHoldingRepository) Delete(ctx context.Context, appID domain.ApplicationID, category string) error {
+	return traceErr(ctx, r.tracer, "DataHoldingRepository.Delete", func(ctx context.Context) error {
+		return r.next.Delete(ctx, appID, category)
+	}, domain.ApplicationAttribute(appID))
+}
+
+// decisionRepository is a DecisionRepository whose calls are traced
+type decisionRepository struct {
+	next   domain.DecisionRepository
+	tracer domain.Tracer
+}
+
+// NewDecisionRepository traces every call to a DecisionRepository
+func NewDecisionRepository(next domain.DecisionRepository, tracer domain.Tracer) domain.DecisionRepository {
+	return &decisionRepository{next: next, tracer: tracer}
+}
+
+func (r *decisionRepository) Save(ctx context.Context, decision domain.Decision) error {
+	return traceErr(ctx, r.tracer, "DecisionRepository.Save", func(ctx context.Context) error {
+		return r.next.Save(ctx, decision)
+	}, domain.AgreementAttribute(decision.AgreementID), domain.ApplicationAttribute(decision.ApplicationID))
+}
+
+func (r *decisionRepository) FindByID(ctx context.Context, id string) (domain.Decision, error) {
+	return trace(ctx, r.tracer, "DecisionRepository.FindByID", func(ctx context.Context) (domain.Decision, error) {
+		return r.next.FindByID(ctx, id)
+	})
+}
+
+func (r *decisionRepository) FindByAgreementID(ctx context.Context, agreementID domain.GovernanceAgreementID) ([]domain.Decision, error) {
+	return trace(ctx, r.tracer, "DecisionRepository.FindByAgreementID", func(ctx context.Context) ([]domain.Decision, error) {
+		return r.next.FindByAgreementID(ctx, agreementID)
+	}, domain.AgreementAttribute(agreementID))
+}
+
+func (r *decisionRepository) FindByApplicationID(ctx context.Context, appID domain.ApplicationID) ([]domain.Decision, error) {
+	return trace(ctx, r.tracer, "DecisionRepository.FindByApplicationID", func(ctx context.Context) ([]domain.Decision, error) {
+		return r.next.FindByApplicationID(ctx, appID)
+	}, domain.ApplicationAttribute(appID))
+}
+
+func (r *decisionRepository) FindAll(ctx context.Context) ([]domain.Decision, error) {
+	return trace(ctx, r.tracer, "DecisionRepository.FindAll", func(ctx context.Context) ([]domain.Decision, error) {
+		return r.next.FindAll(ctx)
+	})
+}
+
+func (r *decisionRepository) Update(ctx context.Context, decision domain.Decision) error {
+	return traceErr(ctx, r.tracer, "DecisionRepository.Update", func(ctx context.Context) error {
+		return r.next.Update(ctx, decision)
+	}, domain.AgreementAttribute(decision.AgreementID), domain.ApplicationAttribute(decision.ApplicationID))
+}
+
+// availabilityMeasurementRepository is an AvailabilityMeasurementRepository whose calls are traced
+type availabilityMeasurementRepository struct {
+	next   domain.AvailabilityMeasurementRepository
+	tracer domain.Tracer
+}
+
+// NewAvailabilityMeasurementRepository traces every call to an AvailabilityMeasurementRepository
+func NewAvailabilityMeasurementRepository(next domain.AvailabilityMeasurementRepository, tracer domain.Tracer) domain.AvailabilityMeasurementRepository {
+	return &availabilityMeasurementRepository{next: next, tracer: tracer}
+}
+
+func (r *availabilityMeasurementRepository) Save(ctx context.Context, measurement domain.AvailabilityMeasurement) error {
+	return traceErr(ctx, r.tracer, "AvailabilityMeasurementRepository.Save", func(ctx context.Context) error {
+		return r.next.Save(ctx, measurement)
+	}, domain.ApplicationAttribute(measurement.ApplicationID))
+}
+
+func (r *availabilityMeasurementRepository) FindByApplicationID(ctx context.Context, appID domain.ApplicationID) ([]domain.AvailabilityMeasurement, error) {
+	return trace(ctx, r.tracer, "AvailabilityMeasurementRepository.FindByApplicationID", func(ctx context.Context) ([]domain.AvailabilityMeasurement, error) {
+		return r.next.FindByApplicationID(ctx, appID)
+	}, domain.ApplicationAttribute(appID))
+}
+
+func (r *availabilityMeasurementRepository) FindLatest(ctx context.Context, appID domain.ApplicationID) (domain.AvailabilityMeasurement, error) {
+	return trace(ctx, r.tracer, "AvailabilityMeasurementRepository.FindLatest", func(ctx context.Context) (domain.AvailabilityMeasurement, error) {
+		return r.next.FindLatest(ctx, appID)
+	}, domain.ApplicationAttribute(appID))
+}
+
+func (r *availabilityMeasurementRepository) FindByPeriod(ctx context.Context, appID domain.ApplicationID, start, end time.Time) ([]domain.AvailabilityMeasurement, error) {
+	return trace(ctx, r.tracer, "AvailabilityMeasurementRepository.FindByPeriod", func(ctx context.Context) ([]domain.AvailabilityMeasurement, error) {
+		return r.next.FindByPeriod(ctx, appID, start, end)
+	}, domain.ApplicationAttribute(appID))
+}
+
+// evaluationScheduleRepository is an EvaluationScheduleRepository whose calls are traced
+type evaluationScheduleRepository struct {
+	next   domain.EvaluationScheduleRepository
+	tracer domain.Tracer
+}
+
+// NewEvaluationScheduleRepository traces every call to an EvaluationScheduleRepository
+func NewEvaluationScheduleRepository(next domain.EvaluationScheduleRepository, tracer domain.Tracer) domain.EvaluationScheduleRepository {
+	return &evaluationScheduleRepository{next: next, tracer: tracer}
+}
+
+func (r *evaluationScheduleRepository) Save(ctx context.Context, schedule domain.EvaluationSchedule) error {
+	return traceErr(ctx, r.tracer, "EvaluationScheduleRepository.Save", func(ctx context.Context) error {
+		return r.next.Save(ctx, schedule)
+	}, domain.AgreementAttribute(schedule.AgreementID), domain.PortfolioAttribute(schedule.PortfolioID))
+}
+
+func (r *evaluationScheduleRepository) FindByID(ctx context.Context, id string) (domain.EvaluationSchedule, error) {
+	return trace(ctx, r.tracer, "EvaluationScheduleRepository.FindByID", func(ctx context.Context) (domain.EvaluationSchedule, error) {
+		return r.next.FindByID(ctx, id)
+	})
+}
+
+func (r *evaluationScheduleRepository) FindAll(ctx context.Context) ([]domain.EvaluationSchedule, error) {
+	return trace(ctx, r.tracer, "EvaluationScheduleRepository.FindAll", func(ctx context.Context) ([]domain.EvaluationSchedule, error) {
+		return r.next.FindAll(ctx)
+	})
+}
+
+func (r *evaluationScheduleRepository) FindDue(ctx context.Context, now time.Time) ([]domain.EvaluationSchedule, error) {
+	return trace(ctx, r.tracer, "EvaluationScheduleRepository.FindDue", func(ctx context.Context) ([]domain.EvaluationSchedule, error) {
+		return r.next.FindDue(ctx, now)
+	})
+}
+
+func (r *evaluationScheduleRepository) Update(ctx context.Context, schedule domain.EvaluationSchedule) error {
+	return traceErr(ctx, r.tracer, "EvaluationScheduleRepository.Update", func(ctx context.Context) error {
+		return r.next.Update(ctx, schedule)
+	}, domain.AgreementAttribute(schedule.AgreementID), domain.PortfolioAttribute(schedule.PortfolioID))
+}
+
+func (r *evaluationScheduleRepository) Delete(ctx context.Context, id string) error {
+	return traceErr(ctx, r.tracer, "EvaluationScheduleRepository.Delete", func(ctx context.Context) error {
+		return r.next.Delete(ctx, id)
+	})
+}
+
+// monitoringRunRepository is a MonitoringRunRepository whose calls are traced
+type monitoringRunRepository struct {
+	next   domain.MonitoringRunRepository
+	tracer domain.Tracer
+}
+
+// NewMonitoringRunRepository traces every call to a MonitoringRunRepository
+func NewMonitoringRunRepository(next domain.MonitoringRunRepository, tracer domain.Tracer) domain.MonitoringRunRepository {
+	return &monitoringRunRepository{next: next, tracer: tracer}
+}
+
+func (r *monitoringRunRepository) Save(ctx context.Context, run domain.MonitoringRun) error {
+	return traceErr(ctx, r.tracer, "MonitoringRunRepository.Save", func(ctx context.Context) error {
+		return r.next.Save(ctx, run)
+	}, domain.AgreementAttribute(run.AgreementID))
+}
+
+func (r *monitoringRunRepository) FindByAgreementID(ctx context.Context, agreementID domain.GovernanceAgreementID) ([]domain.MonitoringRun, error) {
+	return trace(ctx, r.tracer, "MonitoringRunRepository.FindByAgreementID", func(ctx context.Context) ([]domain.MonitoringRun, error) {
+		return r.next.FindByAgreementID(ctx, agreementID)
+	}, domain.AgreementAttribute(agreementID))
+}
+
+func (r *monitoringRunRepository) FindLatest(ctx context.Context, agreementID domain.GovernanceAgreementID) (domain.MonitoringRun, error) {
+	return trace(ctx, r.tracer, "MonitoringRunRepository.FindLatest", func(ctx context.Context) (domain.MonitoringRun, error) {
+		return r.next.FindLatest(ctx, agreementID)
+	}, domain.AgreementAttribute(agreementID))
+}
+
+// escalationRepository is an EscalationRepository whose calls are traced
+type escalationRepository struct {
+	next   domain.EscalationRepository
+	tracer domain.Tracer
+}
+
+// NewEscalationRepository traces every call to an EscalationRepository
+func NewEscalationRepository(next domain.EscalationRepository, tracer domain.Tracer) domain.EscalationRepository {
+	return &escalationRepository{next: next, tracer: tracer}
+}
+
+func (r *escalationRepository) Save(ctx context.Context, step domain.EscalationStep) error {
+	return traceErr(ctx, r.tracer, "EscalationRepository.Save", func(ctx context.Context) error {
+		return r.next.Save(ctx, step)
+	}, domain.ApplicationAttribute(step.ApplicationID))
+}
+
+func (r *escalationRepository) FindBySubject(ctx context.Context, subject domain.EscalationSubject, subjectID string) ([]domain.EscalationStep, error) {
+	return trace(ctx, r.tracer, "EscalationRepository.FindBySubject", func(ctx context.Context) ([]domain.EscalationStep, error) {
+		return r.next.FindBySubject(ctx, subject, subjectID)
+	})
+}
+
+func (r *escalationRepository) FindByApplicationID(ctx context.Context, appID domain.ApplicationID) ([]domain.EscalationStep, error) {
+	return trace(ctx, r.tracer, "EscalationRepository.FindByApplicationID", func(ctx context.Context) ([]domain.EscalationStep, error) {
+		return r.next.FindByApplicationID(ctx, appID)
+	}, domain.ApplicationAttribute(appID))
+}
+
+// executiveDigestRepository is an ExecutiveDigestRepository whose calls are traced
+type executiveDigestRepository struct {
+	next   domain.ExecutiveDigestRepository
+	tracer domain.Tracer
+}
+
+// NewExecutiveDigestRepository traces every call to an ExecutiveDigestRepository
+func NewExecutiveDigestRepository(next domain.ExecutiveDigestRepository, tracer domain.Tracer) domain.ExecutiveDigestRepository {
+	return &executiveDigestRepository{next: next, tracer: tracer}
+}
+
+func (r *executiveDigestRepository) Save(ctx context.Context, digest domain.ExecutiveDigest) error {
+	return traceErr(ctx, r.tracer, "ExecutiveDigestRepository.Save", func(ctx context.Context) error {
+		return r.next.Save(ctx, digest)
+	}, domain.PortfolioAttribute(digest.PortfolioID))
+}
+
+func (r *executiveDigestRepository) FindByID(ctx context.Context, id string) (domain.ExecutiveDigest, error) {
+	return trace(ctx, r.tracer, "ExecutiveDigestRepository.FindByID", func(ctx context.Context) (domain.ExecutiveDigest, error) {
+		return r.next.FindByID(ctx, id)
+	})
+}
+
+func (r *executiveDigestRepository) FindByPortfolioID(ctx context.Context, portfolioID domain.PortfolioID) ([]domain.ExecutiveDigest, error) {
+	return trace(ctx, r.tracer, "ExecutiveDigestRepository.FindByPortfolioID", func(ctx context.Context) ([]domain.ExecutiveDigest, error) {
+		return r.next.FindByPortfolioID(ctx, portfolioID)
+	}, domain.PortfolioAttribute(portfolioID))
+}
+
+// frameworkRepository is a FrameworkRepository whose calls are traced
+type frameworkRepository struct {
+	next   domain.FrameworkRepository
+	tracer domain.Tracer
+}
+
+// NewFrameworkRepository traces every call to a FrameworkRepository
+func NewFrameworkRepository(next domain.FrameworkRepository, tracer domain.Tracer) domain.FrameworkRepository {
+	return &frameworkRepository{next: next, tracer: tracer}
+}
+
+func (r *frameworkRepository) Save(ctx context.Context, framework domain.ComplianceFramework) error {
+	return traceErr(ctx, r.tracer, "FrameworkRepository.Save", func(ctx context.Context) error {
+		return r.next.Save(ctx, framework)
+	})
+}
+
+func (r *frameworkRepository) FindByID(ctx context.Context, id string) (domain.ComplianceFramework, error) {
+	return trace(ctx, r.tracer, "FrameworkRepository.FindByID", func(ctx context.Context) (domain.ComplianceFramework, error) {
+		return r.next.FindByID(ctx, id)
+	})
+}
+
+func (r *frameworkRepository) FindAll(ctx context.Context) ([]domain.ComplianceFramework, error) {
+	return trace(ctx, r.tracer, "FrameworkRepository.FindAll", func(ctx context.Context) ([]domain.ComplianceFramework, error) {
+		return r.next.FindAll(ctx)
+	})
+}
+
+// controlMappingRepository is a ControlMappingRepository whose calls are traced
+type controlMappingRepository struct {
+	next   domain.ControlMappingRepository
+	tracer domain.Tracer
+}
+
+// NewControlMappingRepository traces every call to a ControlMappingRepository
+func NewControlMappingRepository(next domain.ControlMappingRepository, tracer domain.Tracer) domain.ControlMappingRepository {
+	return &controlMappingRepository{next: next, tracer: tracer}
+}
+
+func (r *controlMappingRepository) Save(ctx context.Context, mapping domain.ControlMapping) error {
+	return traceErr(ctx, r.tracer, "ControlMappingRepository.Save", func(ctx context.Context) error {
+		return r.next.Save(ctx, mapping)
+	})
+}
+
+func (r *controlMappingRepository) FindByID(ctx context.Context, id string) (domain.ControlMapping, error) {
+	return trace(ctx, r.tracer, "ControlMappingRepository.FindByID", func(ctx context.Context) (domain.ControlMapping, error) {
+		return r.next.FindByID(ctx, id)
+	})
+}
+
+func (r *controlMappingRepository) FindAll(ctx context.Context) ([]domain.ControlMapping, error) {
+	return trace(ctx, r.tracer, "ControlMappingRepository.FindAll", func(ctx context.Context) ([]domain.ControlMapping, error) {
+		return r.next.FindAll(ctx)
+	})
+}
+
+// complianceAssessmentRepository is a ComplianceAssessmentRepository whose calls are traced
+type complianceAssessmentRepository struct {
+	next   domain.ComplianceAssessmentRepository
+	tracer domain.Tracer
+}
+
+// NewComplianceAssessmentRepository traces every call to a ComplianceAssessmentRepository
+func NewComplianceAssessmentRepository(next domain.ComplianceAssessmentRepository, tracer domain.Tracer) domain.ComplianceAssessmentRepository {
+	return &complianceAssessmentRepository{next: next, tracer: tracer}
+}
+
+func (r *complianceAssessmentRepository) Save(ctx context.Context, assessment domain.ComplianceAssessment) error {
+	return traceErr(ctx, r.tracer, "ComplianceAssessmentRepository.Save", func(ctx context.Context) error {
+		return r.next.Save(ctx, assessment)
+	}, domain.AgreementAttribute(assessment.AgreementID))
+}
+
+func (r *complianceAssessmentRepository) FindByID(ctx context.Context, id string) (domain.ComplianceAssessment, error) {
+	return trace(ctx, r.tracer, "ComplianceAssessmentRepository.FindByID", func(ctx context.Context) (domain.ComplianceAssessment, error) {
+		return r.next.FindByID(ctx, id)
+	})
+}
+
+func (r *complianceAssessmentRepository) FindByAgreementID(ctx context.Context, agreementID domain.GovernanceAgreementID) ([]domain.ComplianceAssessment, error) {
+	return trace(ctx, r.tracer, "ComplianceAssessmentRepository.FindByAgreementID", func(ctx context.Context) ([]domain.ComplianceAssessment, error) {
+		return r.next.FindByAgreementID(ctx, agreementID)
+	}, domain.AgreementAttribute(agreementID))
+}
+
+// attestationCampaignRepository is an AttestationCampaignRepository whose calls are traced
+type attestationCampaignRepository struct {
+	next   domain.AttestationCampaignRepository
+	tracer domain.Tracer
+}
+
+// NewAttestationCampaignRepository traces every call to an AttestationCampaignRepository
+func NewAttestationCampaignRepository(next domain.AttestationCampaignRepository, tracer domain.Tracer) domain.AttestationCampaignRepository {
+	return &attestationCampaignRepository{next: next, tracer: tracer}
+}
+
+func (r *attestationCampaignRepository) Save(ctx context.Context, campaign domain.AttestationCampaign) error {
+	return traceErr(ctx, r.tracer, "AttestationCampaignRepository.Save", func(ctx context.Context) error {
+		return r.next.Save(ctx, campaign)
+	}, domain.PortfolioAttribute(campaign.PortfolioID))
+}
+
+func (r *attestationCampaignRepository) FindByID(ctx context.Context, id string) (domain.AttestationCampaign, error) {
+	return trace(ctx, r.tracer, "AttestationCampaignRepository.FindByID", func(ctx context.Context) (domain.AttestationCampaign, error) {
+		return r.next.FindByID(ctx, id)
+	})
+}
+
+func (r *attestationCampaignRepository) FindAll(ctx context.Context) ([]domain.AttestationCampaign, error) {
+	return trace(ctx, r.tracer, "AttestationCampaignRepository.FindAll", func(ctx context.Context) ([]domain.AttestationCampaign, error) {
+		return r.next.FindAll(ctx)
+	})
+}
+
+// telemetryBindingRepository is a TelemetryBindingRepository whose calls are traced
+type telemetryBindingRepository struct {
+	next   domain.TelemetryBindingRepository
+	tracer domain.Tracer
+}
+
+// NewTelemetryBindingRepository traces every call to a TelemetryBindingRepository
+func NewTelemetryBindingRepository(next domain.TelemetryBindingRepository, tracer domain.Tracer) domain.TelemetryBindingRepository {
+	return &telemetryBindingRepository{next: next, tracer: tracer}
+}
+
+func (r *telemetryBindingRepository) Save(ctx context.Context, binding domain.TelemetryBinding) error {
+	return traceErr(ctx, r.tracer, "TelemetryBindingRepository.Save", func(ctx context.Context) error {
+		return r.next.Save(ctx, binding)
+	})
+}
+
+func (r *telemetryBindingRepository) FindByKPIID(ctx context.Context, kpiID string) (domain.TelemetryBinding, error) {
+	return trace(ctx, r.tracer, "TelemetryBindingRepository.FindByKPIID", func(ctx context.Context) (domain.TelemetryBinding, error) {
+		return r.next.FindByKPIID(ctx, kpiID)
+	})
+}
+
+func (r *telemetryBindingRepository) FindAll(ctx context.Context) ([]domain.TelemetryBinding, error) {
+	return trace(ctx, r.tracer, "TelemetryBindingRepository.FindAll", func(ctx context.Context) ([]domain.TelemetryBinding, error) {
+		return r.next.FindAll(ctx)
+	})
+}
+
+func (r *telemetryBindingRepository) Delete(ctx context.Context, kpiID string) error {
+	return traceErr(ctx, r.tracer, "TelemetryBindingRepository.Delete", func(ctx context.Context) error {
+		return r.next.Delete(ctx, kpiID)
+	})
+}
+
+// monitoringSnapshotRepository is a MonitoringSnapshotRepository whose calls are traced
+type monitoringSnapshotRepository struct {
+	next   domain.MonitoringSnapshotRepository
+	tracer domain.Tracer
+}
+
+// NewMonitoringSnapshotRepository traces every call to a MonitoringSnapshotRepository
+func NewMonitoringSnapshotRepository(next domain.MonitoringSnapshotRepository, tracer domain.Tracer) domain.MonitoringSnapshotRepository {
+	return &monitoringSnapshotRepository{next: next, tracer: tracer}
+}
+
+func (r *monitoringSnapshotRepository) Save(ctx context.Context, snapshot domain.MonitoringSnapshot) error {
+	return traceErr(ctx, r.tracer, "MonitoringSnapshotRepository.Save", func(ctx context.Context) error {
+		return r.next.Save(ctx, snapshot)
+	}, domain.AgreementAttribute(snapshot.AgreementID))
+}
+
+func (r *monitoringSnapshotRepository) FindByAgreementID(ctx context.Context, agreementID domain.GovernanceAgreementID) ([]domain.MonitoringSnapshot, error) {
+	return trace(ctx, r.tracer, "MonitoringSnapshotRepository.FindByAgreementID", func(ctx context.Context) ([]domain.MonitoringSnapshot, error) {
+		return r.next.FindByAgreementID(ctx, agreementID)
+	}, domain.AgreementAttribute(agreementID))
+}
+
+func (r *monitoringSnapshotRepository) FindByPeriod(ctx context.Context, agreementID domain.GovernanceAgreementID, start, end time.Time) ([]domain.MonitoringSnapshot, error) {
+	return trace(ctx, r.tracer, "MonitoringSnapshotRepository.FindByPeriod", func(ctx context.Context) ([]domain.MonitoringSnapshot, error) {
+		return r.next.FindByPeriod(ctx, agreementID, start, end)
+	}, domain.AgreementAttribute(agreementID))
+}
+
+// attachmentStore is an AttachmentStore whose calls are traced
+type attachmentStore struct {
+	next   domain.AttachmentStore
+	tracer domain.Tracer
+}
+
+// NewAttachmentStore traces every call to an AttachmentStore
+func NewAttachmentStore(next domain.AttachmentStore, tracer domain.Tracer) domain.AttachmentStore {
+	return &attachmentStore{next: next, tracer: tracer}
+}
+
+func (r *attachmentStore) Save(ctx context.Context, evidence domain.Evidence) error {
+	return traceErr(ctx, r.tracer, "AttachmentStore.Save", func(ctx context.Context) error {
+		return r.next.Save(ctx, evidence)
+	})
+}
+
+func (r *attachmentStore) FindByID(ctx context.Context, id string) (domain.Evidence, error) {
+	return trace(ctx, r.tracer, "AttachmentStore.FindByID", func(ctx context.Context) (domain.Evidence, error) {
+		return r.next.FindByID(ctx, id)
+	})
+}
+
+func (r *attachmentStore) FindBySubject(ctx context.Context, subject domain.EvidenceSubject) ([]domain.Evidence, error) {
+	return trace(ctx, r.tracer, "AttachmentStore.FindBySubject", func(ctx context.Context) ([]domain.Evidence, error) {
+		return r.next.FindBySubject(ctx, subject)
+	})
+}
+
+func (r *attachmentStore) FindByApplicationID(ctx context.Context, appID domain.ApplicationID) ([]domain.Evidence, error) {
+	return trace(ctx, r.tracer, "AttachmentStore.FindByApplicationID", func(ctx context.Context) ([]domain.Evidence, error) {
+		return r.next.FindByApplicationID(ctx, appID)
+	})
+}
+
+func (r *attachmentStore) FindExpired(ctx context.Context, now time.Time) ([]domain.Evidence, error) {
+	return trace(ctx, r.tracer, "AttachmentStore.FindExpired", func(ctx context.Context) ([]domain.Evidence, error) {
+		return r.next.FindExpired(ctx, now)
+	})
+}
+
+func (r *attachmentStore) MarkExpired(ctx context.Context, id string, at time.Time) error {
+	return traceErr(ctx, r.tracer, "AttachmentStore.MarkExpired", func(ctx context.Context) error {
+		return r.next.MarkExpired(ctx, id, at)
+	})
+}
+
+func (r *attachmentStore) Delete(ctx context.Context, id string) error {
+	return traceErr(ctx, r.tracer, "AttachmentStore.Delete", func(ctx context.Context) error {
+		return r.next.Delete(ctx, id)
+	})
+}
+
+// riskRepository is a RiskRepository whose calls are traced
+type riskRepository struct {
+	next   domain.RiskRepository
+	tracer domain.Tracer
+}
+
+// NewRiskRepository traces every call to a RiskRepository
+func NewRiskRepository(next domain.RiskRepository, tracer domain.Tracer) domain.RiskRepository {
+	return &riskRepository{next: next, tracer: tracer}
+}
+
+func (r *riskRepository) Save(ctx context.Context, risk domain.Risk) error {
+	return traceErr(ctx, r.tracer, "RiskRepository.Save", func(ctx context.Context) error {
+		return r.next.Save(ctx, risk)
+	})
+}
+
+func (r *riskRepository) FindByID(ctx context.Context, id string) (domain.Risk, error) {
+	return trace(ctx, r.tracer, "RiskRepository.FindByID", func(ctx context.Context) (domain.Risk, error) {
+		return r.next.FindByID(ctx, id)
+	})
+}
+
+func (r *riskRepository) FindAll(ctx context.Context) ([]domain.Risk, error) {
+	return trace(ctx, r.tracer, "RiskRepository.FindAll", func(ctx context.Context) ([]domain.Risk, error) {
+		return r.next.FindAll(ctx)
+	})
+}
+
+func (r *riskRepository) FindByLevel(ctx context.Context, level domain.RiskLevel) ([]domain.Risk, error) {
+	return trace(ctx, r.tracer, "RiskRepository.FindByLevel", func(ctx context.Context) ([]domain.Risk, error) {
+		return r.next.FindByLevel(ctx, level)
+	})
+}
+
+func (r *riskRepository) FindByCategory(ctx context.Context, category string) ([]domain.Risk, error) {
+	return trace(ctx, r.tracer, "RiskRepository.FindByCategory", func(ctx context.Context) ([]domain.Risk, error) {
+		return r.next.FindByCategory(ctx, category)
+	})
+}
+
+func (r *riskRepository) Update(ctx context.Context, risk domain.Risk) error {
+	return traceErr(ctx, r.tracer, "RiskRepository.Update", func(ctx context.Context) error {
+		return r.next.Update(ctx, risk)
+	})
+}
+
+func (r *riskRepository) Delete(ctx context.Context, id string) error {
+	return traceErr(ctx, r.tracer, "RiskRepository.Delete", func(ctx context.Context) error {
+		return r.next.Delete(ctx, id)
+	})
+}
+
+func (r *riskRepository) Exists(ctx context.Context, id string) (bool, error) {
+	return trace(ctx, r.tracer, "RiskRepository.Exists", func(ctx context.Context) (bool, error) {
+		return r.next.Exists(ctx, id)
+	})
+}
+
+// mitigationPlanRepository is a MitigationPlanRepository whose calls are traced
+type mitigationPlanRepository struct {
+	next   domain.MitigationPlanRepository
+	tracer domain.Tracer
+}
+
+// NewMitigationPlanRepository traces every call to a MitigationPlanRepository
+func NewMitigationPlanRepository(next domain.MitigationPlanRepository, tracer domain.Tracer) domain.MitigationPlanRepository {
+	return &mitigationPlanRepository{next: next, tracer: tracer}
+}
+
+func (r *mitigationPlanRepository) Save(ctx context.Context, plan domain.MitigationPlan) error {
+	return traceErr(ctx, r.tracer, "MitigationPlanRepository.Save", func(ctx context.Context) error {
+		return r.next.Save(ctx, plan)
+	})
+}
+
+func (r *mitigationPlanRepository) FindByRiskID(ctx context.Context, riskID string) (domain.MitigationPlan, error) {
+	return trace(ctx, r.tracer, "MitigationPlanRepository.FindByRiskID", func(ctx context.Context) (domain.MitigationPlan, error) {
+		return r.next.FindByRiskID(ctx, riskID)
+	})
+}
+
+func (r *mitigationPlanRepository) FindAll(ctx context.Context) ([]domain.MitigationPlan, error) {
+	return trace(ctx, r.tracer, "MitigationPlanRepository.FindAll", func(ctx context.Context) ([]domain.MitigationPlan, error) {
+		return r.next.FindAll(ctx)
+	})
+}
+
+func (r *mitigationPlanRepository) Update(ctx context.Context, plan domain.MitigationPlan) error {
+	return traceErr(ctx, r.tracer, "MitigationPlanRepository.Update", func(ctx context.Context) error {
+		return r.next.Update(ctx, plan)
+	})
+}
+
+func (r *mitigationPlanRepository) Delete(ctx context.Context, riskID string) error {
+	return traceErr(ctx, r.tracer, "MitigationPlanRepository.Delete", func(ctx context.Context) error {
+		return r.next.Delete(ctx, riskID)
+	})
+}
+
+func (r *mitigationPlanRepository) Exists(ctx context.Context, riskID string) (bool, error) {
+	return trace(ctx, r.tracer, "MitigationPlanRepository.Exists", func(ctx context.Context) (bool, error) {
+		return r.next.Exists(ctx, riskID)
+	})
+}
+
+// complianceRepository is a ComplianceRepository whose calls are traced
+type complianceRepository struct {
+	next   domain.ComplianceRepository
+	tracer domain.Tracer
+}
+
+// NewComplianceRepository traces every call to a ComplianceRepository
+func NewComplianceRepository(next domain.ComplianceRepository, tracer domain.Tracer) domain.ComplianceRepository {
+	return &complianceRepository{next: next, tracer: tracer}
+}
+
+func (r *complianceRepository) SaveLegalRequirement(ctx context.Context, appID domain.ApplicationID, req domain.LegalRequirement) error {
+	return traceErr(ctx, r.tracer, "ComplianceRepository.SaveLegalRequirement", func(ctx context.Context) error {
+		return r.next.SaveLegalRequirement(ctx, appID, req)
+	}, domain.ApplicationAttribute(appID))
+}
+
+func (r *complianceRepository) SaveContractualRequirement(ctx context.Context, appID domain.ApplicationID, req domain.ContractualRequirement) error {
+	return traceErr(ctx, r.tracer, "ComplianceRepository.SaveContractualRequirement", func(ctx context.Context) error {
+		return r.next.SaveContractualRequirement(ctx, appID, req)
+	}, domain.ApplicationAttribute(appID))
+}
+
+func (r *complianceRepository) SaveIndustryStandard(ctx context.Context, appID domain.ApplicationID, standard domain.IndustryStandard) error {
+	return traceErr(ctx, r.tracer, "ComplianceRepository.SaveIndustryStandard", func(ctx context.Context) error {
+		return r.next.SaveIndustryStandard(ctx, appID, standard)
+	}, domain.ApplicationAttribute(appID))
+}
+
+func (r *complianceRepository) FindLegalRequirements(ctx context.Context, appID domain.ApplicationID) ([]domain.LegalRequirement, error) {
+	return trace(ctx, r.tracer, "ComplianceRepository.FindLegalRequirements", func(ctx context.Context) ([]domain.LegalRequirement, error) {
+		return r.next.FindLegalRequirements(ctx, appID)
+	}, domain.ApplicationAttribute(appID))
+}
+
+func (r *complianceRepository) FindContractualRequirements(ctx context.Context, appID domain.ApplicationID) ([]domain.ContractualRequirement, error) {
+	return trace(ctx, r.tracer, "ComplianceRepository.FindContractualRequirements", func(ctx context.Context) ([]domain.ContractualRequirement, error) {
+		return r.next.FindContractualRequirements(ctx, appID)
+	}, domain.ApplicationAttribute(appID))
+}
+
+func (r *complianceRepository) FindIndustryStandards(ctx context.Context, appID domain.ApplicationID) ([]domain.IndustryStandard, error) {
+	return trace(ctx, r.tracer, "ComplianceRepository.FindIndustryStandards", func(ctx context.Context) ([]domain.IndustryStandard, error) {
+		return r.next.FindIndustryStandards(ctx, appID)
+	}, domain.ApplicationAttribute(appID))
+}
+
+func (r *complianceRepository) UpdateComplianceStatus(ctx context.Context, appID domain.ApplicationID, ref domain.RequirementRef, status domain.ComplianceStatus) error {
+	return traceErr(ctx, r.tracer, "ComplianceRepository.UpdateComplianceStatus", func(ctx context.Context) error {
+		return r.next.UpdateComplianceStatus(ctx, appID, ref, status)
+	}, domain.ApplicationAttribute(appID))
+}
+
+func (r *complianceRepository) DeleteRequirement(ctx context.Context, appID domain.ApplicationID, ref domain.RequirementRef) error {
+	return traceErr(ctx, r.tracer, "ComplianceRepository.DeleteRequirement", func(ctx context.Context) error {
+		return r.next.DeleteRequirement(ctx, appID, ref)
+	}, domain.ApplicationAttribute(appID))
+}
+
+// domainEventRepository is a DomainEventRepository whose calls are traced
+type domainEventRepository struct {
+	next   domain.DomainEventRepository
+	tracer domain.Tracer
+}
+
+// NewDomainEventRepository traces every call to a DomainEventRepository
+func NewDomainEventRepository(next domain.DomainEventRepository, tracer domain.Tracer) domain.DomainEventRepository {
+	return &domainEventRepository{next: next, tracer: tracer}
+}
+
+func (r *domainEventRepository) Save(ctx context.Context, event domain.DomainEvent) error {
+	return traceErr(ctx, r.tracer, "DomainEventRepository.Save", func(ctx context.Context) error {
+		return r.next.Save(ctx, event)
+	})
+}
+
+func (r *domainEventRepository) FindByAggregateID(ctx context.Context, aggregateID string) ([]domain.DomainEvent, error) {
+	return trace(ctx, r.tracer, "DomainEventRepository.FindByAggregateID", func(ctx context.Context) ([]domain.DomainEvent, error) {
+		return r.next.FindByAggregateID(ctx, aggregateID)
+	})
+}
+
+func (r *domainEventRepository) FindByEventType(ctx context.Context, eventType string) ([]domain.DomainEvent, error) {
+	return trace(ctx, r.tracer, "DomainEventRepository.FindByEventType", func(ctx context.Context) ([]domain.DomainEvent, error) {
+		return r.next.FindByEventType(ctx, eventType)
+	})
+}
+
+func (r *domainEventRepository) FindByTimeRange(ctx context.Context, start, end time.Time) ([]domain.DomainEvent, error) {
+	return trace(ctx, r.tracer, "DomainEventRepository.FindByTimeRange", func(ctx context.Context) ([]domain.DomainEvent, error) {
+		return r.next.FindByTimeRange(ctx, start, end)
+	})
+}
+
+func (r *domainEventRepository) Delete(ctx context.Context, eventID string) error {
+	return traceErr(ctx, r.tracer, "DomainEventRepository.Delete", func(ctx context.Context) error {
+		return r.next.Delete(ctx, eventID)
+	})
+}
+
+// auditTrailRepository is an AuditTrailRepository whose calls are traced
+type auditTrailRepository struct {
+	next   domain.AuditTrailRepository
+	tracer domain.Tracer
+}
+
+// NewAuditTrailRepository traces every call to an AuditTrailRepository
+func NewAuditTrailRepository(next domain.AuditTrailRepository, tracer domain.Tracer) domain.AuditTrailRepository {
+	return &auditTrailRepository{next: next, tracer: tracer}
+}
+
+func (r *auditTrailRepository) Append(ctx context.Context, entry domain.AuditTrailEntry) (domain.AuditTrailEntry, error) {
+	return trace(ctx, r.tracer, "AuditTrailRepository.Append", func(ctx context.Context) (domain.AuditTrailEntry, error) {
+		return r.next.Append(ctx, entry)
+	})
+}
+
+func (r *auditTrailRepository) Find(ctx context.Context, query domain.AuditTrailQuery) ([]domain.AuditTrailEntry, error) {
+	return trace(ctx, r.tracer, "AuditTrailRepository.Find", func(ctx context.Context) ([]domain.AuditTrailEntry, error) {
+		return r.next.Find(ctx, query)
+	})
+}
+
+func (r *auditTrailRepository) FindAll(ctx context.Context) ([]domain.AuditTrailEntry, error) {
+	return trace(ctx, r.tracer, "AuditTrailRepository.FindAll", func(ctx context.Context) ([]domain.AuditTrailEntry, error) {
+		return r.next.FindAll(ctx)
+	})
+}
+
+// itsmLinkRepository is an ITSMLinkRepository whose calls are traced
+type itsmLinkRepository struct {
+	next   domain.ITSMLinkRepository
+	tracer domain.Tracer
+}
+
+// NewITSMLinkRepository traces every call to an ITSMLinkRepository
+func NewITSMLinkRepository(next domain.ITSMLinkRepository, tracer domain.Tracer) domain.ITSMLinkRepository {
+	return &itsmLinkRepository{next: next, tracer: tracer}
+}
+
+func (r *itsmLinkRepository) Save(ctx context.Context, link domain.ITSMLink) error {
+	return traceErr(ctx, r.tracer, "ITSMLinkRepository.Save", func(ctx context.Context) error {
+		return r.next.Save(ctx, link)
+	}, domain.ApplicationAttribute(link.ApplicationID))
+}
+
+func (r *itsmLinkRepository) Find(ctx context.Context, kind domain.ITSMRecordKind, localID string) (domain.ITSMLink, error) {
+	return trace(ctx, r.tracer, "ITSMLinkRepository.Find", func(ctx context.Context) (domain.ITSMLink, error) {
+		return r.next.Find(ctx, kind, localID)
+	})
+}
+
+func (r *itsmLinkRepository) FindByConnector(ctx context.Context, connector string) ([]domain.ITSMLink, error) {
+	return trace(ctx, r.tracer, "ITSMLinkRepository.FindByConnector", func(ctx context.Context) ([]domain.ITSMLink, error) {
+		return r.next.FindByConnector(ctx, connector)
+	})
+}
+
+func (r *itsmLinkRepository) FindAll(ctx context.Context) ([]domain.ITSMLink, error) {
+	return trace(ctx, r.tracer, "ITSMLinkRepository.FindAll", func(ctx context.Context) ([]domain.ITSMLink, error) {
+		return r.next.FindAll(ctx)
+	})
+}
+
+func (r *itsmLinkRepository) Delete(ctx context.Context, kind domain.ITSMRecordKind, localID string) error {
+	return traceErr(ctx, r.tracer, "ITSMLinkRepository.Delete", func(ctx context.Context) error {
+		return r.next.Delete(ctx, kind, localID)
+	})
+}
@@ -0,0 +1,109 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// SlowCall is a traced call that took at least the tracer's threshold
+type SlowCall struct {
+	Name       string
+	Parent     string // the call it was made from, empty for a top-level call
+	Duration   time.Duration
+	Attributes []domain.SpanAttribute
+	Err        error
+}
+
+// String describes the call on one line, e.g. for a log
+func (c SlowCall) String() string {
+	description := fmt.Sprintf("%s took %s", c.Name, c.Duration.Round(time.Microsecond))
+	if c.Parent != "" {
+		description += fmt.Sprintf(" (in %s)", c.Parent)
+	}
+	for _, attribute := range c.Attributes {
+		description += fmt.Sprintf(" %s=%s", strings.TrimPrefix(attribute.Key, "iso38500."), attribute.Value)
+	}
+	if c.Err != nil {
+		description += fmt.Sprintf(" error=%q", c.Err.Error())
+	}
+	return description
+}
+
+// SlowCallTracer is a tracer that reports the calls taking at least a threshold, so slow
+// evaluations and storage calls show up without an OpenTelemetry collector
+type SlowCallTracer struct {
+	threshold time.Duration
+	report    func(SlowCall)
+	now       func() time.Time
+}
+
+// NewSlowCallTracer reports every call taking at least threshold to report
+func NewSlowCallTracer(threshold time.Duration, report func(SlowCall)) *SlowCallTracer {
+	return &SlowCallTracer{threshold: threshold, report: report, now: time.Now}
+}
+
+type slowCallSpanKey struct{}
+
+// Start times a call, remembering it in the context as the parent of the calls made from it
+func (t *SlowCallTracer) Start(ctx context.Context, name string, attributes ...domain.SpanAttribute) (context.Context, domain.Span) {
+	span := &slowCallSpan{
+		tracer:     t,
+		name:       name,
+		attributes: append([]domain.SpanAttribute{}, attributes...),
+		start:      t.now(),
+	}
+	if parent, ok := ctx.Value(slowCallSpanKey{}).(*slowCallSpan); ok {
+		span.parent = parent.name
+	}
+	return context.WithValue(ctx, slowCallSpanKey{}, span), span
+}
+
+type slowCallSpan struct {
+	mu         sync.Mutex
+	tracer     *SlowCallTracer
+	name       string
+	parent     string
+	attributes []domain.SpanAttribute
+	err        error
+	start      time.Time
+	ended      bool
+}
+
+func (s *slowCallSpan) SetAttributes(attributes ...domain.SpanAttribute) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.attributes = append(s.attributes, attributes...)
+}
+
+func (s *slowCallSpan) RecordError(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.err = err
+}
+
+// End reports the call when it took at least the threshold. Only the first End counts.
+func (s *slowCallSpan) End() {
+	s.mu.Lock()
+	if s.ended {
+		s.mu.Unlock()
+		return
+	}
+	s.ended = true
+	call := SlowCall{
+		Name:       s.name,
+		Parent:     s.parent,
+		Duration:   s.tracer.now().Sub(s.start),
+		Attributes: s.attributes,
+		Err:        s.err,
+	}
+	s.mu.Unlock()
+
+	if call.Duration >= s.tracer.threshold && s.tracer.report != nil {
+		s.tracer.report(call)
+	}
+}
@@ -0,0 +1,41 @@
+// Package tracing traces repository calls with a domain.Tracer and provides a tracer that logs
+// slow calls, for deployments without an OpenTelemetry collector
+package tracing
+
+import (
+	"context"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// trace runs a repository call in a span, recording the error it fails with
+func trace[T any](ctx context.Context, tracer domain.Tracer, name string, call func(context.Context) (T, error), attributes ...domain.SpanAttribute) (T, error) {
+	ctx, span := tracer.Start(ctx, name, nonEmpty(attributes)...)
+	defer span.End()
+
+	result, err := call(ctx)
+	if err != nil {
+		span.RecordError(err)
+	}
+	return result, err
+}
+
+// traceErr runs a repository call that only returns an error in a span
+func traceErr(ctx context.Context, tracer domain.Tracer, name string, call func(context.Context) error, attributes ...domain.SpanAttribute) error {
+	_, err := trace(ctx, tracer, name, func(ctx context.Context) (struct{}, error) {
+		return struct{}{}, call(ctx)
+	}, attributes...)
+	return err
+}
+
+// nonEmpty leaves out attributes without a value, such as the agreement of an application
+// schedule
+func nonEmpty(attributes []domain.SpanAttribute) []domain.SpanAttribute {
+	set := make([]domain.SpanAttribute, 0, len(attributes))
+	for _, attribute := range attributes {
+		if attribute.Value != "" {
+			set = append(set, attribute)
+		}
+	}
+	return set
+}
@@ -0,0 +1,236 @@
+package main
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrUnauthenticated is returned when a request carries no valid credentials
+var ErrUnauthenticated = errors.New("unauthenticated")
+
+// Principal identifies the user and, for OAuth tokens, the client application acting on a tool call
+type Principal struct {
+	Subject string `json:"subject"`
+	Client  string `json:"client,omitempty"`
+}
+
+// String renders the principal for logs and audit records
+func (p Principal) String() string {
+	if p.Client != "" {
+		return fmt.Sprintf("%s (via %s)", p.Subject, p.Client)
+	}
+	return p.Subject
+}
+
+type principalKey struct{}
+
+// withPrincipal attaches the authenticated principal to a request context
+func withPrincipal(ctx context.Context, principal Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// principalFromContext returns the principal attached to the context, if any
+func principalFromContext(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(Principal)
+	return principal, ok
+}
+
+type callerKey struct{}
+
+// withCaller attributes the tool calls made with a context to a caller that was not
+// authenticated, such as the local assistant over stdio, without binding their actors to it
+func withCaller(ctx context.Context, caller Principal) context.Context {
+	return context.WithValue(ctx, callerKey{}, caller)
+}
+
+// callerFromContext returns who tool calls made with the context are attributed to: the
+// authenticated principal, or else the caller the transport attached
+func callerFromContext(ctx context.Context) (Principal, bool) {
+	if principal, ok := principalFromContext(ctx); ok {
+		return principal, true
+	}
+	caller, ok := ctx.Value(callerKey{}).(Principal)
+	return caller, ok
+}
+
+// actorName resolves who performed a governance action. An authenticated principal always acts
+// as itself, so naming anyone else is refused. Calls without one, over stdio or anonymous HTTP,
+// act as the actor they name, or as the fallback when they name none.
+func actorName(ctx context.Context, explicit, fallback string) (string, error) {
+	if principal, ok := principalFromContext(ctx); ok {
+		if explicit != "" && explicit != principal.Subject {
+			return "", fmt.Errorf("authenticated as %s, cannot act as %s", principal.Subject, explicit)
+		}
+		return principal.Subject, nil
+	}
+	if explicit != "" {
+		return explicit, nil
+	}
+	return fallback, nil
+}
+
+// Authenticator validates a bearer token and resolves the principal behind it
+type Authenticator interface {
+	Authenticate(ctx context.Context, token string) (Principal, error)
+}
+
+// staticTokenAuthenticator accepts a fixed set of configured bearer tokens
+type staticTokenAuthenticator struct {
+	tokens []AuthToken
+}
+
+func (a *staticTokenAuthenticator) Authenticate(ctx context.Context, token string) (Principal, error) {
+	for _, candidate := range a.tokens {
+		if subtle.ConstantTimeCompare([]byte(candidate.Token), []byte(token)) == 1 {
+			return Principal{Subject: candidate.Subject}, nil
+		}
+	}
+	return Principal{}, ErrUnauthenticated
+}
+
+// introspectionAuthenticator validates OAuth 2.0 access tokens against an RFC 7662 introspection endpoint
+type introspectionAuthenticator struct {
+	endpoint     string
+	clientID     string
+	clientSecret string
+	client       *http.Client
+}
+
+// introspectionResponse holds the fields of an RFC 7662 response the server relies on
+type introspectionResponse struct {
+	Active   bool   `json:"active"`
+	Subject  string `json:"sub"`
+	Username string `json:"username"`
+	ClientID string `json:"client_id"`
+}
+
+func (a *introspectionAuthenticator) Authenticate(ctx context.Context, token string) (Principal, error) {
+	form := url.Values{"token": {token}, "token_type_hint": {"access_token"}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return Principal{}, fmt.Errorf("failed to build introspection request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if a.clientID != "" {
+		req.SetBasicAuth(a.clientID, a.clientSecret)
+	}
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return Principal{}, fmt.Errorf("token introspection failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return Principal{}, fmt.Errorf("token introspection returned status %d", resp.StatusCode)
+	}
+
+	var result introspectionResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return Principal{}, fmt.Errorf("failed to decode introspection response: %w", err)
+	}
+	if !result.Active {
+		return Principal{}, ErrUnauthenticated
+	}
+
+	subject := result.Username
+	if subject == "" {
+		subject = result.Subject
+	}
+	if subject == "" {
+		subject = result.ClientID
+	}
+	return Principal{Subject: subject, Client: result.ClientID}, nil
+}
+
+// chainAuthenticator tries each authenticator in turn until one accepts the token
+type chainAuthenticator []Authenticator
+
+func (c chainAuthenticator) Authenticate(ctx context.Context, token string) (Principal, error) {
+	for _, authenticator := range c {
+		principal, err := authenticator.Authenticate(ctx, token)
+		if err == nil {
+			return principal, nil
+		}
+		if !errors.Is(err, ErrUnauthenticated) {
+			return Principal{}, err
+		}
+	}
+	return Principal{}, ErrUnauthenticated
+}
+
+// newAuthenticator builds the authenticator chain from the configuration; nil means authentication is disabled
+func newAuthenticator(cfg Config) Authenticator {
+	var chain chainAuthenticator
+	if len(cfg.AuthTokens) > 0 {
+		chain = append(chain, &staticTokenAuthenticator{tokens: cfg.AuthTokens})
+	}
+	if cfg.OAuth.IntrospectionURL != "" {
+		chain = append(chain, &introspectionAuthenticator{
+			endpoint:     cfg.OAuth.IntrospectionURL,
+			clientID:     cfg.OAuth.ClientID,
+			clientSecret: cfg.OAuth.ClientSecret,
+			client:       &http.Client{Timeout: 10 * time.Second},
+		})
+	}
+	if len(chain) == 0 {
+		return nil
+	}
+	return chain
+}
+
+// bearerToken extracts the token from an "Authorization: Bearer" header
+func bearerToken(r *http.Request) (string, bool) {
+	header := r.Header.Get("Authorization")
+	scheme, token, found := strings.Cut(header, " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
+
+// ToolInvocation records which principal called a tool and whether it succeeded
+type ToolInvocation struct {
+	Principal Principal              `json:"principal"`
+	Tool      string                 `json:"tool"`
+	Arguments map[string]interface{} `json:"arguments,omitempty"`
+	Succeeded bool                   `json:"succeeded"`
+	Error     string                 `json:"error,omitempty"`
+	InvokedAt time.Time              `json:"invoked_at"`
+}
+
+// invocationLog keeps the attribution trail of tool calls
+type invocationLog struct {
+	mu      sync.RWMutex
+	entries []ToolInvocation
+}
+
+func (l *invocationLog) record(invocation ToolInvocation) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.entries = append(l.entries, invocation)
+}
+
+// list returns the recorded invocations, optionally restricted to one subject
+func (l *invocationLog) list(subject string) []ToolInvocation {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	var result []ToolInvocation
+	for _, invocation := range l.entries {
+		if subject == "" || invocation.Principal.Subject == subject {
+			result = append(result, invocation)
+		}
+	}
+	return result
+}
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// newTestServer creates a server over the demo data with change management configured and
+// JSON output, so tool results can be decoded
+func newTestServer(t *testing.T, configure func(*Config)) *MCPServer {
+	t.Helper()
+	cfg := DefaultConfig()
+	cfg.SeedDemoData = true
+	cfg.Toolsets = []string{toolsetCore, toolsetChangeManagement}
+	cfg.OutputFormat = outputJSON
+	if configure != nil {
+		configure(&cfg)
+	}
+	server, err := NewMCPServer(cfg)
+	if err != nil {
+		t.Fatalf("NewMCPServer() error = %v", err)
+	}
+	return server
+}
+
+// callToolJSON calls the tool and decodes its JSON result into result, when not nil
+func callToolJSON(t *testing.T, server *MCPServer, ctx context.Context, name string, args map[string]interface{}, result interface{}) {
+	t.Helper()
+	response, err := server.callTool(ctx, name, args)
+	if err != nil {
+		t.Fatalf("%s error = %v", name, err)
+	}
+	if result == nil {
+		return
+	}
+	if err := json.Unmarshal([]byte(response.(CallToolResult).Content[0].Text), result); err != nil {
+		t.Fatalf("%s returned %q: %v", name, response.(CallToolResult).Content[0].Text, err)
+	}
+}
+
+func TestActorName(t *testing.T) {
+	server := &MCPServer{ctx: context.Background()}
+	request := httptest.NewRequest("POST", "/mcp", nil)
+	stdio := server.stdioContext()
+	anonymous := server.requestContext(request, Principal{Subject: "anonymous"})
+	server.authenticator = &staticTokenAuthenticator{}
+	alice := server.requestContext(request, Principal{Subject: "alice", Client: "dashboard"})
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		explicit string
+		fallback string
+		want     string
+		wantErr  bool
+	}{
+		{"principal", alice, "", "MCP Assistant", "alice", false},
+		{"principal naming itself", alice, "alice", "", "alice", false},
+		{"principal naming someone else", alice, "bob", "", "", true},
+		{"stdio naming the actor", stdio, "bob", "MCP Assistant", "bob", false},
+		{"stdio falling back", stdio, "", "MCP Assistant", "MCP Assistant", false},
+		{"stdio with no actor", stdio, "", "", "", false},
+		{"anonymous HTTP naming the actor", anonymous, "bob", "", "bob", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := actorName(tt.ctx, tt.explicit, tt.fallback)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("actorName() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("actorName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if caller, _ := callerFromContext(stdio); caller != stdioCaller {
+		t.Errorf("stdio calls attributed to %v, want %v", caller, stdioCaller)
+	}
+	if caller, _ := callerFromContext(anonymous); caller.Subject != "anonymous" {
+		t.Errorf("anonymous calls attributed to %v, want anonymous", caller)
+	}
+}
+
+// TestChangeSelfApprovalThroughActorName checks a requester can neither approve their own change
+// request nor, once authenticated, get around the rule by naming someone else as the approver
+func TestChangeSelfApprovalThroughActorName(t *testing.T) {
+	server := newTestServer(t, nil)
+	stdio := server.stdioContext()
+	server.authenticator = &staticTokenAuthenticator{}
+	request := httptest.NewRequest("POST", "/mcp", nil)
+	alice := server.requestContext(request, Principal{Subject: "alice"})
+	bob := server.requestContext(request, Principal{Subject: "bob"})
+
+	create := func(ctx context.Context, id string, args map[string]interface{}) {
+		t.Helper()
+		args["id"] = id
+		args["application_id"] = "crm-global-001"
+		args["title"] = "Upgrade CRM database"
+		callToolJSON(t, server, ctx, "create_change_request", args, nil)
+		callToolJSON(t, server, ctx, "submit_change_request", map[string]interface{}{"change_request_id": id}, nil)
+	}
+	create(alice, "CR-ALICE", map[string]interface{}{})
+	create(alice, "CR-NAMED", map[string]interface{}{"requester": "alice"})
+	create(stdio, "CR-STDIO", map[string]interface{}{"requester": "carol"})
+	if _, err := server.callTool(alice, "create_change_request", map[string]interface{}{
+		"id": "CR-AS-BOB", "application_id": "crm-global-001", "title": "Upgrade CRM database", "requester": "bob",
+	}); err == nil {
+		t.Fatalf("alice created a change request as bob")
+	}
+
+	tests := []struct {
+		name          string
+		ctx           context.Context
+		changeRequest string
+		approver      string
+		wantViolation bool
+		wantErr       bool
+	}{
+		{"requester approving", alice, "CR-ALICE", "", true, true},
+		{"requester approving by name", alice, "CR-NAMED", "alice", true, true},
+		{"requester naming another approver", alice, "CR-ALICE", "bob", false, true},
+		{"stdio requester approving by name", stdio, "CR-STDIO", "carol", true, true},
+		{"another principal approving", bob, "CR-ALICE", "", false, false},
+		{"stdio approver named", stdio, "CR-STDIO", "dave", false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := map[string]interface{}{"change_request_id": tt.changeRequest}
+			if tt.approver != "" {
+				args["approver"] = tt.approver
+			}
+			_, err := server.callTool(tt.ctx, "approve_change_request", args)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("approve_change_request error = %v, wantErr %v", err, tt.wantErr)
+			}
+			var violation domain.SegregationOfDutiesViolation
+			if errors.As(err, &violation) != tt.wantViolation {
+				t.Errorf("approve_change_request error = %v, want violation %v", err, tt.wantViolation)
+			}
+		})
+	}
+}
+
+// TestAssessmentSelfSignOffOverStdio checks stdio calls naming the evaluator are refused the
+// sign-off, and a second named actor can review and sign off
+func TestAssessmentSelfSignOffOverStdio(t *testing.T) {
+	server := newTestServer(t, nil)
+	stdio := server.stdioContext()
+
+	var assessment domain.ApplicationAssessment
+	callToolJSON(t, server, stdio, "evaluate_application", map[string]interface{}{"application_id": "crm-global-001", "evaluator": "erin"}, &assessment)
+	if assessment.Evaluator != "erin" {
+		t.Fatalf("evaluator = %q, want erin", assessment.Evaluator)
+	}
+	args := func(actorArg, actor string) map[string]interface{} {
+		return map[string]interface{}{"application_id": "crm-global-001", "assessment_id": assessment.ID, actorArg: actor}
+	}
+
+	var violation domain.SegregationOfDutiesViolation
+	if _, err := server.callTool(stdio, "review_assessment", args("reviewer", "erin")); !errors.As(err, &violation) {
+		t.Errorf("evaluator reviewing error = %v, want a segregation of duties violation", err)
+	}
+	callToolJSON(t, server, stdio, "review_assessment", args("reviewer", "frank"), nil)
+	for _, kind := range []string{"document", "report_link"} {
+		evidence := args("attached_by", "frank")
+		evidence["evidence_id"] = "EV-" + kind
+		evidence["kind"] = kind
+		evidence["sha256"] = strings.Repeat("ab", 32)
+		evidence["title"] = "Evaluation " + kind
+		evidence["uri"] = "https://docs.example.com/crm/" + kind
+		callToolJSON(t, server, stdio, "attach_evidence", evidence, nil)
+	}
+	if _, err := server.callTool(stdio, "sign_off_assessment", args("approver", "erin")); !errors.As(err, &violation) {
+		t.Errorf("evaluator signing off error = %v, want a segregation of duties violation", err)
+	}
+	callToolJSON(t, server, stdio, "sign_off_assessment", args("approver", "carol"), &assessment)
+	if assessment.SignOff.Reviewer != "frank" || assessment.SignOff.Approver != "carol" {
+		t.Errorf("sign-off = %+v, want reviewed by frank and signed off by carol", assessment.SignOff)
+	}
+}
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// maxCompletionValues is the largest number of suggestions MCP allows in one completion result
+const maxCompletionValues = 100
+
+// CompletionResult is the payload of a completion/complete response
+type CompletionResult struct {
+	Completion Completion `json:"completion"`
+}
+
+// Completion lists suggested values for a partially typed argument
+type Completion struct {
+	Values  []string `json:"values"`
+	Total   int      `json:"total"`
+	HasMore bool     `json:"hasMore"`
+}
+
+// completionSources maps argument names to functions listing their live candidate values
+func (s *MCPServer) completionSources() map[string]func(ctx context.Context) ([]string, error) {
+	return map[string]func(ctx context.Context) ([]string, error){
+		"application_id": s.applicationIDs,
+		"portfolio_id":   s.portfolioIDs,
+		"agreement_id":   s.agreementIDs,
+	}
+}
+
+func (s *MCPServer) handleComplete(ctx context.Context, req MCPRequest) *MCPResponse {
+	params, ok := req.Params.(map[string]interface{})
+	if !ok {
+		return s.errorResponse(req, "Invalid parameters")
+	}
+
+	argument, ok := params["argument"].(map[string]interface{})
+	if !ok {
+		return s.errorResponse(req, "Completion argument not specified")
+	}
+	name, _ := argument["name"].(string)
+	value, _ := argument["value"].(string)
+
+	values, err := s.complete(ctx, name, value)
+	if err != nil {
+		return s.errorResponse(req, err.Error())
+	}
+
+	total := len(values)
+	if total > maxCompletionValues {
+		values = values[:maxCompletionValues]
+	}
+
+	return &MCPResponse{
+		JSONRPC: "2.0",
+		ID:      *req.ID,
+		Result: CompletionResult{
+			Completion: Completion{
+				Values:  values,
+				Total:   total,
+				HasMore: total > len(values),
+			},
+		},
+	}
+}
+
+// complete returns the known IDs for an argument that start with the typed prefix.
+// Arguments without a completion source yield no suggestions.
+func (s *MCPServer) complete(ctx context.Context, argument, prefix string) ([]string, error) {
+	source, ok := s.completionSources()[argument]
+	if !ok {
+		return []string{}, nil
+	}
+
+	candidates, err := source(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to complete %s: %w", argument, err)
+	}
+
+	prefix = strings.ToLower(prefix)
+	values := []string{}
+	for _, candidate := range candidates {
+		if strings.HasPrefix(strings.ToLower(candidate), prefix) {
+			values = append(values, candidate)
+		}
+	}
+	sort.Strings(values)
+	return values, nil
+}
+
+func (s *MCPServer) applicationIDs(ctx context.Context) ([]string, error) {
+	apps, err := s.appRepo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(apps))
+	for _, app := range apps {
+		ids = append(ids, string(app.ID))
+	}
+	return ids, nil
+}
+
+func (s *MCPServer) portfolioIDs(ctx context.Context) ([]string, error) {
+	portfolios, err := s.portfolioService.ListPortfolios(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(portfolios))
+	for _, portfolio := range portfolios {
+		ids = append(ids, string(portfolio.ID))
+	}
+	return ids, nil
+}
+
+func (s *MCPServer) agreementIDs(ctx context.Context) ([]string, error) {
+	agreements, err := s.govRepo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(agreements))
+	for _, agreement := range agreements {
+		ids = append(ids, string(agreement.ID))
+	}
+	return ids, nil
+}
@@ -0,0 +1,1035 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+	"github.com/iso38500/iso38500-governance-sdk/infrastructure/eventbus"
+	"github.com/iso38500/iso38500-governance-sdk/infrastructure/itsm"
+	"github.com/iso38500/iso38500-governance-sdk/infrastructure/kafka"
+	"github.com/iso38500/iso38500-governance-sdk/infrastructure/notify"
+	"github.com/iso38500/iso38500-governance-sdk/infrastructure/telemetry"
+	"gopkg.in/yaml.v3"
+)
+
+// Storage backends supported by the server
+const (
+	storageMemory = "memory"
+)
+
+// Transports the server can listen on
+const (
+	transportStdio = "stdio"
+	transportHTTP  = "http"
+)
+
+// Notification channel types
+const (
+	channelSMTP    = "smtp"
+	channelSlack   = "slack"
+	channelWebhook = "webhook"
+	channelLog     = "log"
+)
+
+// Output formats for tool results
+const (
+	outputText = "text"
+	outputJSON = "json"
+)
+
+// Config holds the server configuration assembled from defaults, an optional
+// YAML file, environment variables and command-line flags (in that order of precedence)
+type Config struct {
+	Storage             string                 `yaml:"storage"`
+	SeedDemoData        bool                   `yaml:"seed_demo_data"`
+	Toolsets            []string               `yaml:"toolsets"`
+	DisabledTools       []string               `yaml:"disabled_tools"`
+	OutputFormat        string                 `yaml:"output_format"`
+	LogLevel            string                 `yaml:"log_level"`
+	Transport           string                 `yaml:"transport"`
+	HTTPAddr            string                 `yaml:"http_addr"`
+	AuthTokens          []AuthToken            `yaml:"auth_tokens"`
+	OAuth               OAuthConfig            `yaml:"oauth"`
+	AllowAnonymous      bool                   `yaml:"allow_anonymous"`
+	BaselineFile        string                 `yaml:"baseline_file"`
+	TemplatesFile       string                 `yaml:"templates_file"`
+	FrameworksFile      string                 `yaml:"frameworks_file"`
+	ControlMappingsFile string                 `yaml:"control_mappings_file"`
+	KPIMeasurementsFile string                 `yaml:"kpi_measurements_file"`
+	SlowCallThreshold   string                 `yaml:"slow_call_threshold"`
+	SegregationOfDuties []string               `yaml:"segregation_of_duties"` // rules enforced, none when empty
+	ExpiryWarningDays   []int                  `yaml:"expiry_warning_days"`   // days before a certification or contract expires it is notified
+	ChangeApprovals     []ChangeApprovalConfig `yaml:"change_approvals"`      // approval matrix rules, a single approval when empty
+	Notifications       NotificationsConfig    `yaml:"notifications"`
+	Telemetry           TelemetryConfig        `yaml:"telemetry"`
+	ITSM                ITSMConfig             `yaml:"itsm"`
+	Events              EventsConfig           `yaml:"events"`
+}
+
+// ChangeApprovalConfig configures the approvals the change requests of a type and priority need
+type ChangeApprovalConfig struct {
+	Type     string   `yaml:"type"`     // standard, normal or emergency; any when empty
+	Priority string   `yaml:"priority"` // low, medium, high or critical; any when empty
+	Roles    []string `yaml:"roles"`    // roles whose approval counts; any when empty
+	Mode     string   `yaml:"mode"`     // quorum (default) or unanimous
+	Quorum   int      `yaml:"quorum"`   // approvals needed in quorum mode, 1 when not set
+}
+
+// NotificationsConfig configures the channels notifications are delivered over and the routes
+// deciding which notifications go to which channel
+type NotificationsConfig struct {
+	Channels    []NotificationChannelConfig `yaml:"channels"`
+	Routes      []NotificationRouteConfig   `yaml:"routes"`
+	Interval    string                      `yaml:"interval"`     // how often due notifications are sent, never when empty
+	RemindAfter string                      `yaml:"remind_after"` // resend notifications still pending after this long
+	Digests     []string                    `yaml:"digests"`      // executive digest frequencies sent in the background, weekly or monthly
+}
+
+// NotificationChannelConfig configures an email, Slack, webhook or log channel
+type NotificationChannelConfig struct {
+	Name     string            `yaml:"name"`
+	Type     string            `yaml:"type"`
+	URL      string            `yaml:"url"`     // Slack incoming webhook or webhook endpoint
+	Headers  map[string]string `yaml:"headers"` // webhook only
+	SMTPAddr string            `yaml:"smtp_addr"`
+	Username string            `yaml:"username"`
+	Password string            `yaml:"password"`
+	From     string            `yaml:"from"`
+	To       []string          `yaml:"to"`
+}
+
+// NotificationRouteConfig routes notifications by kind, severity, portfolio and role to a channel
+type NotificationRouteConfig struct {
+	Name        string   `yaml:"name"`
+	Channel     string   `yaml:"channel"`
+	Kinds       []string `yaml:"kinds"`
+	MinSeverity string   `yaml:"min_severity"`
+	Portfolios  []string `yaml:"portfolios"`
+	Roles       []string `yaml:"roles"`
+}
+
+// Enabled reports whether any channel is configured
+func (c NotificationsConfig) Enabled() bool {
+	return len(c.Channels) > 0
+}
+
+// Validate ensures every channel is complete and every route names a channel
+func (c NotificationsConfig) Validate() error {
+	channels := make(map[string]bool)
+	for _, channel := range c.Channels {
+		if channel.Name == "" {
+			return fmt.Errorf("notification channels require a name")
+		}
+		if channels[channel.Name] {
+			return fmt.Errorf("duplicate notification channel: %s", channel.Name)
+		}
+		channels[channel.Name] = true
+
+		switch channel.Type {
+		case channelSlack, channelWebhook:
+			if channel.URL == "" {
+				return fmt.Errorf("%s notification channel %s requires a url", channel.Type, channel.Name)
+			}
+		case channelSMTP:
+			if channel.SMTPAddr == "" || channel.From == "" {
+				return fmt.Errorf("smtp notification channel %s requires smtp_addr and from", channel.Name)
+			}
+		case channelLog:
+		default:
+			return fmt.Errorf("unknown notification channel type: %s", channel.Type)
+		}
+	}
+	for _, route := range c.Routes {
+		if !channels[route.Channel] {
+			return fmt.Errorf("notification route %s names unknown channel %s", route.Name, route.Channel)
+		}
+		if route.MinSeverity != "" {
+			if err := domain.NotificationSeverity(route.MinSeverity).Validate(); err != nil {
+				return fmt.Errorf("notification route %s: %w", route.Name, err)
+			}
+		}
+	}
+	for name, value := range map[string]string{"interval": c.Interval, "remind_after": c.RemindAfter} {
+		if value == "" {
+			continue
+		}
+		if duration, err := time.ParseDuration(value); err != nil || duration <= 0 {
+			return fmt.Errorf("invalid notification %s: %s", name, value)
+		}
+	}
+	for _, frequency := range c.Digests {
+		if err := domain.DigestFrequency(frequency).Validate(); err != nil {
+			return fmt.Errorf("notification digests: %w", err)
+		}
+	}
+	return nil
+}
+
+// TelemetryConfig configures the monitoring systems KPIs can be measured from
+type TelemetryConfig struct {
+	Interval   string                    `yaml:"interval"` // how often due bindings are pulled, never when empty
+	Datadog    DatadogTelemetryConfig    `yaml:"datadog"`
+	CloudWatch CloudWatchTelemetryConfig `yaml:"cloudwatch"`
+}
+
+// DatadogTelemetryConfig holds the keys and site of a Datadog organization
+type DatadogTelemetryConfig struct {
+	APIKey         string `yaml:"api_key"`
+	ApplicationKey string `yaml:"application_key"`
+	Site           string `yaml:"site"`
+}
+
+// CloudWatchTelemetryConfig holds the region and credentials CloudWatch is queried with
+type CloudWatchTelemetryConfig struct {
+	Region          string `yaml:"region"`
+	AccessKeyID     string `yaml:"access_key_id"`
+	SecretAccessKey string `yaml:"secret_access_key"`
+	SessionToken    string `yaml:"session_token"`
+	Endpoint        string `yaml:"endpoint"`
+}
+
+// Validate ensures the pull interval is a positive duration
+func (c TelemetryConfig) Validate() error {
+	if c.Interval == "" {
+		return nil
+	}
+	if interval, err := time.ParseDuration(c.Interval); err != nil || interval <= 0 {
+		return fmt.Errorf("invalid telemetry interval: %s", c.Interval)
+	}
+	return nil
+}
+
+// ITSMConfig configures the ITSM tools change requests and incidents are mirrored to
+type ITSMConfig struct {
+	Interval   string                 `yaml:"interval"` // how often targets are synced, never when empty
+	ServiceNow ServiceNowITSMConfig   `yaml:"servicenow"`
+	Jira       JiraITSMConfig         `yaml:"jira"`
+	Targets    []ITSMSyncTargetConfig `yaml:"targets"` // nothing is mirrored when empty
+}
+
+// ServiceNowITSMConfig holds the instance and credentials of a ServiceNow instance
+type ServiceNowITSMConfig struct {
+	InstanceURL string `yaml:"instance_url"`
+	Username    string `yaml:"username"`
+	Password    string `yaml:"password"`
+	Token       string `yaml:"token"`
+}
+
+// JiraITSMConfig holds the site, credentials and project of a Jira site
+type JiraITSMConfig struct {
+	BaseURL    string            `yaml:"base_url"`
+	Email      string            `yaml:"email"`
+	APIToken   string            `yaml:"api_token"`
+	Token      string            `yaml:"token"`
+	ProjectKey string            `yaml:"project_key"`
+	IssueTypes map[string]string `yaml:"issue_types"` // record kind → issue type
+}
+
+// ITSMSyncTargetConfig mirrors a kind of record to a connector. Fields and values override the
+// connector's default mapping; a field mapped onto an empty name is not mirrored.
+type ITSMSyncTargetConfig struct {
+	Kind         string                       `yaml:"kind"`         // change_request or incident
+	Connector    string                       `yaml:"connector"`    // servicenow or jira
+	Applications []string                     `yaml:"applications"` // every application when empty
+	Fields       map[string]string            `yaml:"fields"`       // local field → ITSM field
+	Values       map[string]map[string]string `yaml:"values"`       // local field → local value → ITSM value
+}
+
+// EventsConfig configures how domain events are delivered to the webhooks and Kafka topic
+// subscribed to them
+type EventsConfig struct {
+	Async          bool                 `yaml:"async"`            // deliver through a pool of background workers
+	Workers        int                  `yaml:"workers"`          // 4 when not set
+	QueueSize      int                  `yaml:"queue_size"`       // deliveries waiting for a worker, 100 when not set
+	RetryAttempts  int                  `yaml:"retry_attempts"`   // 3 when not set
+	RetryBackoff   string               `yaml:"retry_backoff"`    // wait after the first failure, doubled after each; 100ms when not set
+	MaxDeadLetters int                  `yaml:"max_dead_letters"` // undelivered events kept, oldest dropped first; 1000 when not set
+	Webhooks       []EventWebhookConfig `yaml:"webhooks"`
+	Kafka          EventKafkaConfig     `yaml:"kafka"`
+}
+
+// EventWebhookConfig subscribes a webhook to domain events
+type EventWebhookConfig struct {
+	Name       string            `yaml:"name"`
+	URL        string            `yaml:"url"`
+	Headers    map[string]string `yaml:"headers"`
+	EventTypes []string          `yaml:"event_types"` // e.g. IncidentReported; every event when empty
+}
+
+// EventKafkaConfig publishes domain events to a Kafka topic through a Kafka REST Proxy, keyed by
+// the ID of the aggregate they were recorded against
+type EventKafkaConfig struct {
+	RESTProxyURL string            `yaml:"rest_proxy_url"` // e.g. https://kafka-rest.acme.example:8082
+	Username     string            `yaml:"username"`
+	Password     string            `yaml:"password"`
+	Headers      map[string]string `yaml:"headers"`
+	Topic        string            `yaml:"topic"`
+	EventTypes   []string          `yaml:"event_types"` // every event when empty
+}
+
+// kafkaSubscriber names the Kafka publisher among the event subscribers
+const kafkaSubscriber = "kafka"
+
+// Validate ensures the pool and retries are not negative, every webhook is named once with a URL
+// and Kafka has a topic
+func (c EventsConfig) Validate() error {
+	if c.Workers < 0 || c.QueueSize < 0 || c.RetryAttempts < 0 || c.MaxDeadLetters < 0 {
+		return fmt.Errorf("events workers, queue_size, retry_attempts and max_dead_letters cannot be negative")
+	}
+	if c.RetryBackoff != "" {
+		if backoff, err := time.ParseDuration(c.RetryBackoff); err != nil || backoff <= 0 {
+			return fmt.Errorf("invalid events retry_backoff: %s", c.RetryBackoff)
+		}
+	}
+	names := make(map[string]bool, len(c.Webhooks))
+	for _, webhook := range c.Webhooks {
+		if webhook.Name == "" || webhook.URL == "" {
+			return fmt.Errorf("event webhooks require a name and a url")
+		}
+		if names[webhook.Name] {
+			return fmt.Errorf("duplicate event webhook: %s", webhook.Name)
+		}
+		names[webhook.Name] = true
+	}
+	if c.Kafka.RESTProxyURL != "" {
+		if c.Kafka.Topic == "" {
+			return fmt.Errorf("events kafka requires a topic")
+		}
+		if names[kafkaSubscriber] {
+			return fmt.Errorf("event webhook name is reserved for kafka: %s", kafkaSubscriber)
+		}
+	}
+	return nil
+}
+
+// Validate ensures the sync interval is a positive duration and the targets are valid
+func (c ITSMConfig) Validate() error {
+	if c.Interval != "" {
+		if interval, err := time.ParseDuration(c.Interval); err != nil || interval <= 0 {
+			return fmt.Errorf("invalid itsm interval: %s", c.Interval)
+		}
+	}
+	kinds := make(map[string]bool, len(c.Targets))
+	for _, target := range c.Targets {
+		if kinds[target.Kind] {
+			return fmt.Errorf("itsm targets: %s is mirrored more than once", target.Kind)
+		}
+		kinds[target.Kind] = true
+		if _, err := target.SyncTarget(); err != nil {
+			return fmt.Errorf("itsm targets: %w", err)
+		}
+	}
+	return nil
+}
+
+// SyncTarget builds the sync target, overriding the connector's default mapping
+func (c ITSMSyncTargetConfig) SyncTarget() (domain.ITSMSyncTarget, error) {
+	kind := domain.ITSMRecordKind(c.Kind)
+	if err := kind.Validate(); err != nil {
+		return domain.ITSMSyncTarget{}, err
+	}
+	var mapping domain.ITSMFieldMapping
+	switch c.Connector {
+	case "servicenow":
+		mapping = itsm.DefaultServiceNowMapping(kind)
+	case "jira":
+		mapping = itsm.DefaultJiraMapping(kind)
+	default:
+		return domain.ITSMSyncTarget{}, fmt.Errorf("unknown ITSM connector %q", c.Connector)
+	}
+	for local, remote := range c.Fields {
+		if remote == "" {
+			delete(mapping.Fields, local)
+			delete(mapping.Values, local)
+			continue
+		}
+		mapping.Fields[local] = remote
+	}
+	for field, values := range c.Values {
+		if mapping.Values == nil {
+			mapping.Values = make(map[string]map[string]string)
+		}
+		mapping.Values[field] = values
+	}
+
+	target := domain.ITSMSyncTarget{Kind: kind, Connector: c.Connector, Mapping: mapping}
+	for _, appID := range c.Applications {
+		target.Applications = append(target.Applications, domain.ApplicationID(appID))
+	}
+	return target, target.Validate()
+}
+
+// AuthToken maps a static bearer token to the subject it authenticates
+type AuthToken struct {
+	Subject string `yaml:"subject"`
+	Token   string `yaml:"token"`
+}
+
+// OAuthConfig configures validation of OAuth access tokens through token introspection
+type OAuthConfig struct {
+	IntrospectionURL string `yaml:"introspection_url"`
+	ClientID         string `yaml:"client_id"`
+	ClientSecret     string `yaml:"client_secret"`
+}
+
+// DefaultConfig returns the configuration used when nothing is overridden
+func DefaultConfig() Config {
+	return Config{
+		Storage:      storageMemory,
+		Toolsets:     []string{toolsetCore},
+		OutputFormat: outputText,
+		LogLevel:     "info",
+		Transport:    transportStdio,
+		HTTPAddr:     ":8080",
+		SegregationOfDuties: []string{
+			string(domain.SoDChangeSelfApproval),
+			string(domain.SoDAssessmentSelfSignOff),
+		},
+		ExpiryWarningDays: domain.DefaultExpiryWarningPolicy().LeadDays,
+	}
+}
+
+// SegregationOfDutiesPolicy returns the segregation of duties rules the services enforce
+func (c Config) SegregationOfDutiesPolicy() domain.SegregationOfDutiesPolicy {
+	var policy domain.SegregationOfDutiesPolicy
+	for _, rule := range c.SegregationOfDuties {
+		policy.Rules = append(policy.Rules, domain.SoDRule(rule))
+	}
+	return policy
+}
+
+// ChangeApprovalMatrix returns the approvals each change request needs before it is approved
+func (c Config) ChangeApprovalMatrix() domain.ApprovalMatrix {
+	matrix := domain.DefaultApprovalMatrix()
+	for _, rule := range c.ChangeApprovals {
+		matrix.Rules = append(matrix.Rules, domain.ApprovalRule{
+			Type:     domain.ChangeType(rule.Type),
+			Priority: domain.Priority(rule.Priority),
+			Roles:    rule.Roles,
+			Mode:     domain.ApprovalMode(rule.Mode),
+			Quorum:   rule.Quorum,
+		})
+	}
+	return matrix
+}
+
+// Validate ensures the configuration only references supported options
+func (c Config) Validate() error {
+	if c.Storage != storageMemory {
+		return fmt.Errorf("unsupported storage backend: %s", c.Storage)
+	}
+	if c.OutputFormat != outputText && c.OutputFormat != outputJSON {
+		return fmt.Errorf("unsupported output format: %s", c.OutputFormat)
+	}
+	if _, err := parseLogLevel(c.LogLevel); err != nil {
+		return err
+	}
+	for _, toolset := range c.Toolsets {
+		if toolset != toolsetCore && toolset != toolsetChangeManagement {
+			return fmt.Errorf("unknown toolset: %s", toolset)
+		}
+	}
+	if c.Transport != transportStdio && c.Transport != transportHTTP {
+		return fmt.Errorf("unsupported transport: %s", c.Transport)
+	}
+	for _, token := range c.AuthTokens {
+		if token.Subject == "" || token.Token == "" {
+			return fmt.Errorf("auth tokens require both a subject and a token")
+		}
+	}
+	if c.SlowCallThreshold != "" {
+		if threshold, err := time.ParseDuration(c.SlowCallThreshold); err != nil || threshold < 0 {
+			return fmt.Errorf("invalid slow call threshold: %s", c.SlowCallThreshold)
+		}
+	}
+	if err := c.SegregationOfDutiesPolicy().Validate(); err != nil {
+		return err
+	}
+	if err := (domain.ExpiryWarningPolicy{LeadDays: c.ExpiryWarningDays}).Validate(); err != nil {
+		return err
+	}
+	if err := c.ChangeApprovalMatrix().Validate(); err != nil {
+		return err
+	}
+	if err := c.Notifications.Validate(); err != nil {
+		return err
+	}
+	if err := c.Telemetry.Validate(); err != nil {
+		return err
+	}
+	if err := c.ITSM.Validate(); err != nil {
+		return err
+	}
+	if err := c.Events.Validate(); err != nil {
+		return err
+	}
+	if c.Transport == transportHTTP && len(c.AuthTokens) == 0 && c.OAuth.IntrospectionURL == "" && !c.AllowAnonymous {
+		return fmt.Errorf("http transport requires auth_tokens or oauth.introspection_url (or allow_anonymous)")
+	}
+	return nil
+}
+
+// LoadConfig builds the configuration from the YAML file, environment and arguments
+func LoadConfig(args []string) (Config, error) {
+	cfg := DefaultConfig()
+
+	fs := flag.NewFlagSet("mcp-server", flag.ContinueOnError)
+	configPath := fs.String("config", os.Getenv("ISO38500_CONFIG"), "path to a YAML configuration file")
+	storage := fs.String("storage", "", "storage backend (memory)")
+	seedDemoData := fs.Bool("seed-demo-data", false, "seed the repositories with enterprise demo data")
+	toolsets := fs.String("toolsets", "", "comma-separated toolsets to enable (core, change_management)")
+	disabledTools := fs.String("disable-tools", "", "comma-separated tool names to hide")
+	outputFormat := fs.String("output", "", "tool output format (text, json)")
+	logLevel := fs.String("log-level", "", "log level (debug, info, warn, error)")
+	transport := fs.String("transport", "", "transport to serve on (stdio, http)")
+	httpAddr := fs.String("http-addr", "", "listen address for the http transport")
+	introspectionURL := fs.String("oauth-introspection-url", "", "OAuth token introspection endpoint for the http transport")
+	baselineFile := fs.String("baseline-file", "", "JSON baseline profile to benchmark assessments against")
+	templatesFile := fs.String("templates-file", "", "JSON evaluation templates selected by application category")
+	frameworksFile := fs.String("frameworks-file", "", "JSON compliance frameworks added to the standard catalog")
+	controlMappingsFile := fs.String("control-mappings-file", "", "JSON control mappings added to the standard mappings between frameworks")
+	kpiMeasurementsFile := fs.String("kpi-measurements-file", "", "JSON Lines file KPI measurements are kept in across restarts")
+	slowCallThreshold := fs.String("slow-call-threshold", "", "log traced service and repository calls taking at least this long (e.g. 250ms)")
+	segregationOfDuties := fs.String("segregation-of-duties", "", "comma-separated segregation of duties rules to enforce (change_self_approval, assessment_self_sign_off, assessment_reviewer_sign_off)")
+	expiryWarningDays := fs.String("expiry-warning-days", "", "comma-separated days before a certification or contract expires it is notified (e.g. 90,30,7)")
+	allowAnonymous := fs.Bool("allow-anonymous", false, "serve the http transport without authentication")
+	if err := fs.Parse(args); err != nil {
+		return Config{}, err
+	}
+
+	if *configPath != "" {
+		data, err := os.ReadFile(*configPath)
+		if err != nil {
+			return Config{}, fmt.Errorf("failed to read config file: %w", err)
+		}
+		if err := yaml.Unmarshal(data, &cfg); err != nil {
+			return Config{}, fmt.Errorf("failed to parse config file: %w", err)
+		}
+	}
+
+	if err := applyEnv(&cfg); err != nil {
+		return Config{}, err
+	}
+
+	// Only flags that were explicitly set override file and environment values
+	var flagErr error
+	fs.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "storage":
+			cfg.Storage = *storage
+		case "seed-demo-data":
+			cfg.SeedDemoData = *seedDemoData
+		case "toolsets":
+			cfg.Toolsets = splitList(*toolsets)
+		case "disable-tools":
+			cfg.DisabledTools = splitList(*disabledTools)
+		case "segregation-of-duties":
+			cfg.SegregationOfDuties = splitList(*segregationOfDuties)
+		case "expiry-warning-days":
+			cfg.ExpiryWarningDays, flagErr = parseDays(*expiryWarningDays)
+		case "output":
+			cfg.OutputFormat = *outputFormat
+		case "log-level":
+			cfg.LogLevel = *logLevel
+		case "transport":
+			cfg.Transport = *transport
+		case "http-addr":
+			cfg.HTTPAddr = *httpAddr
+		case "oauth-introspection-url":
+			cfg.OAuth.IntrospectionURL = *introspectionURL
+		case "allow-anonymous":
+			cfg.AllowAnonymous = *allowAnonymous
+		case "baseline-file":
+			cfg.BaselineFile = *baselineFile
+		case "templates-file":
+			cfg.TemplatesFile = *templatesFile
+		case "frameworks-file":
+			cfg.FrameworksFile = *frameworksFile
+		case "control-mappings-file":
+			cfg.ControlMappingsFile = *controlMappingsFile
+		case "kpi-measurements-file":
+			cfg.KPIMeasurementsFile = *kpiMeasurementsFile
+		case "slow-call-threshold":
+			cfg.SlowCallThreshold = *slowCallThreshold
+		}
+	})
+	if flagErr != nil {
+		return Config{}, fmt.Errorf("invalid -expiry-warning-days: %w", flagErr)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return Config{}, err
+	}
+	return cfg, nil
+}
+
+// applyEnv overrides configuration values from ISO38500_* environment variables
+func applyEnv(cfg *Config) error {
+	if value, ok := os.LookupEnv("ISO38500_STORAGE"); ok {
+		cfg.Storage = value
+	}
+	if value, ok := os.LookupEnv("ISO38500_SEED_DEMO_DATA"); ok {
+		seed, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid ISO38500_SEED_DEMO_DATA: %w", err)
+		}
+		cfg.SeedDemoData = seed
+	}
+	if value, ok := os.LookupEnv("ISO38500_TOOLSETS"); ok {
+		cfg.Toolsets = splitList(value)
+	}
+	if value, ok := os.LookupEnv("ISO38500_DISABLED_TOOLS"); ok {
+		cfg.DisabledTools = splitList(value)
+	}
+	if value, ok := os.LookupEnv("ISO38500_SEGREGATION_OF_DUTIES"); ok {
+		cfg.SegregationOfDuties = splitList(value)
+	}
+	if value, ok := os.LookupEnv("ISO38500_EXPIRY_WARNING_DAYS"); ok {
+		days, err := parseDays(value)
+		if err != nil {
+			return fmt.Errorf("invalid ISO38500_EXPIRY_WARNING_DAYS: %w", err)
+		}
+		cfg.ExpiryWarningDays = days
+	}
+	if value, ok := os.LookupEnv("ISO38500_OUTPUT_FORMAT"); ok {
+		cfg.OutputFormat = value
+	}
+	if value, ok := os.LookupEnv("ISO38500_LOG_LEVEL"); ok {
+		cfg.LogLevel = value
+	}
+	if value, ok := os.LookupEnv("ISO38500_TRANSPORT"); ok {
+		cfg.Transport = value
+	}
+	if value, ok := os.LookupEnv("ISO38500_HTTP_ADDR"); ok {
+		cfg.HTTPAddr = value
+	}
+	if value, ok := os.LookupEnv("ISO38500_AUTH_TOKENS"); ok {
+		tokens, err := parseAuthTokens(value)
+		if err != nil {
+			return err
+		}
+		cfg.AuthTokens = tokens
+	}
+	if value, ok := os.LookupEnv("ISO38500_OAUTH_INTROSPECTION_URL"); ok {
+		cfg.OAuth.IntrospectionURL = value
+	}
+	if value, ok := os.LookupEnv("ISO38500_OAUTH_CLIENT_ID"); ok {
+		cfg.OAuth.ClientID = value
+	}
+	if value, ok := os.LookupEnv("ISO38500_OAUTH_CLIENT_SECRET"); ok {
+		cfg.OAuth.ClientSecret = value
+	}
+	if value, ok := os.LookupEnv("ISO38500_BASELINE_FILE"); ok {
+		cfg.BaselineFile = value
+	}
+	if value, ok := os.LookupEnv("ISO38500_TEMPLATES_FILE"); ok {
+		cfg.TemplatesFile = value
+	}
+	if value, ok := os.LookupEnv("ISO38500_FRAMEWORKS_FILE"); ok {
+		cfg.FrameworksFile = value
+	}
+	if value, ok := os.LookupEnv("ISO38500_CONTROL_MAPPINGS_FILE"); ok {
+		cfg.ControlMappingsFile = value
+	}
+	if value, ok := os.LookupEnv("ISO38500_KPI_MEASUREMENTS_FILE"); ok {
+		cfg.KPIMeasurementsFile = value
+	}
+	if value, ok := os.LookupEnv("ISO38500_SLOW_CALL_THRESHOLD"); ok {
+		cfg.SlowCallThreshold = value
+	}
+	if value, ok := os.LookupEnv("ISO38500_DATADOG_API_KEY"); ok {
+		cfg.Telemetry.Datadog.APIKey = value
+	}
+	if value, ok := os.LookupEnv("ISO38500_DATADOG_APPLICATION_KEY"); ok {
+		cfg.Telemetry.Datadog.ApplicationKey = value
+	}
+	if value, ok := os.LookupEnv("ISO38500_SERVICENOW_PASSWORD"); ok {
+		cfg.ITSM.ServiceNow.Password = value
+	}
+	if value, ok := os.LookupEnv("ISO38500_SERVICENOW_TOKEN"); ok {
+		cfg.ITSM.ServiceNow.Token = value
+	}
+	if value, ok := os.LookupEnv("ISO38500_JIRA_API_TOKEN"); ok {
+		cfg.ITSM.Jira.APIToken = value
+	}
+	if value, ok := os.LookupEnv("ISO38500_JIRA_TOKEN"); ok {
+		cfg.ITSM.Jira.Token = value
+	}
+	if value, ok := os.LookupEnv("AWS_ACCESS_KEY_ID"); ok && cfg.Telemetry.CloudWatch.AccessKeyID == "" {
+		cfg.Telemetry.CloudWatch.AccessKeyID = value
+		cfg.Telemetry.CloudWatch.SecretAccessKey = os.Getenv("AWS_SECRET_ACCESS_KEY")
+		cfg.Telemetry.CloudWatch.SessionToken = os.Getenv("AWS_SESSION_TOKEN")
+	}
+	if value, ok := os.LookupEnv("ISO38500_ALLOW_ANONYMOUS"); ok {
+		allow, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid ISO38500_ALLOW_ANONYMOUS: %w", err)
+		}
+		cfg.AllowAnonymous = allow
+	}
+	return nil
+}
+
+// parseAuthTokens parses a comma-separated list of subject=token pairs
+func parseAuthTokens(value string) ([]AuthToken, error) {
+	tokens := []AuthToken{}
+	for _, item := range splitList(value) {
+		subject, token, found := strings.Cut(item, "=")
+		if !found {
+			return nil, fmt.Errorf("invalid ISO38500_AUTH_TOKENS entry %q: expected subject=token", subject)
+		}
+		tokens = append(tokens, AuthToken{Subject: strings.TrimSpace(subject), Token: strings.TrimSpace(token)})
+	}
+	return tokens, nil
+}
+
+// loadBaselines reads the configured baseline profile, defaulting to the industry reference baselines
+func loadBaselines(path string) (domain.BaselineProfile, error) {
+	if path == "" {
+		return domain.IndustryBaselineProfile(), nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return domain.BaselineProfile{}, fmt.Errorf("failed to open baseline file: %w", err)
+	}
+	defer file.Close()
+
+	return domain.LoadBaselineProfile(file)
+}
+
+// loadTemplates reads the configured evaluation templates, defaulting to the standard templates
+func loadTemplates(path string) (domain.EvaluationTemplateSet, error) {
+	if path == "" {
+		return domain.StandardEvaluationTemplates(), nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return domain.EvaluationTemplateSet{}, fmt.Errorf("failed to open templates file: %w", err)
+	}
+	defer file.Close()
+
+	return domain.LoadEvaluationTemplates(file)
+}
+
+// loadFrameworks returns the standard compliance frameworks with those of the configured file,
+// which replace standard frameworks of the same ID
+func loadFrameworks(path string) ([]domain.ComplianceFramework, error) {
+	frameworks := domain.StandardComplianceFrameworks()
+	if path == "" {
+		return frameworks, nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open frameworks file: %w", err)
+	}
+	defer file.Close()
+
+	loaded, err := domain.LoadComplianceFrameworks(file)
+	if err != nil {
+		return nil, err
+	}
+	return append(frameworks, loaded...), nil
+}
+
+// loadControlMappings returns the standard control mappings with those of the configured file,
+// which replace standard mappings of the same ID
+func loadControlMappings(path string) ([]domain.ControlMapping, error) {
+	mappings := domain.StandardControlMappings()
+	if path == "" {
+		return mappings, nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open control mappings file: %w", err)
+	}
+	defer file.Close()
+
+	loaded, err := domain.LoadControlMappings(file)
+	if err != nil {
+		return nil, err
+	}
+	return append(mappings, loaded...), nil
+}
+
+// loadNotifier builds the configured channels and routes them, or returns nil when no channel is
+// configured. Without routes every notification goes to every channel.
+func loadNotifier(cfg NotificationsConfig, logger *leveledLogger) (domain.Notifier, error) {
+	if !cfg.Enabled() {
+		return nil, nil
+	}
+
+	channels := make(map[string]domain.Notifier)
+	for _, channel := range cfg.Channels {
+		switch channel.Type {
+		case channelSMTP:
+			notifier, err := notify.NewSMTPNotifier(notify.SMTPConfig{
+				Addr:     channel.SMTPAddr,
+				Username: channel.Username,
+				Password: channel.Password,
+				From:     channel.From,
+				To:       channel.To,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("notification channel %s: %w", channel.Name, err)
+			}
+			channels[channel.Name] = notifier
+		case channelSlack:
+			channels[channel.Name] = notify.NewSlackNotifier(channel.URL, nil)
+		case channelWebhook:
+			channels[channel.Name] = notify.NewWebhookNotifier(channel.URL, channel.Headers, nil)
+		case channelLog:
+			channels[channel.Name] = logNotifier{logger: logger}
+		}
+	}
+
+	var routes []domain.NotificationRoute
+	for _, route := range cfg.Routes {
+		r := domain.NotificationRoute{
+			Name:        route.Name,
+			Channel:     route.Channel,
+			MinSeverity: domain.NotificationSeverity(route.MinSeverity),
+			Roles:       route.Roles,
+		}
+		for _, kind := range route.Kinds {
+			r.Kinds = append(r.Kinds, domain.NotificationKind(kind))
+		}
+		for _, portfolio := range route.Portfolios {
+			r.Portfolios = append(r.Portfolios, domain.PortfolioID(portfolio))
+		}
+		routes = append(routes, r)
+	}
+	if len(routes) == 0 {
+		for _, channel := range cfg.Channels {
+			routes = append(routes, domain.NotificationRoute{Name: channel.Name, Channel: channel.Name})
+		}
+	}
+
+	return domain.NewNotificationRouter(routes, channels)
+}
+
+// loadTelemetrySources creates a telemetry source for Datadog when its keys are configured, and
+// for CloudWatch when its region is
+func loadTelemetrySources(cfg TelemetryConfig) ([]domain.TelemetrySource, error) {
+	var sources []domain.TelemetrySource
+	if cfg.Datadog.APIKey != "" || cfg.Datadog.ApplicationKey != "" {
+		source, err := telemetry.NewDatadogSource(telemetry.DatadogConfig{
+			APIKey:         cfg.Datadog.APIKey,
+			ApplicationKey: cfg.Datadog.ApplicationKey,
+			Site:           cfg.Datadog.Site,
+		}, nil)
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, source)
+	}
+	if cfg.CloudWatch.Region != "" {
+		source, err := telemetry.NewCloudWatchSource(telemetry.CloudWatchConfig{
+			Region:          cfg.CloudWatch.Region,
+			AccessKeyID:     cfg.CloudWatch.AccessKeyID,
+			SecretAccessKey: cfg.CloudWatch.SecretAccessKey,
+			SessionToken:    cfg.CloudWatch.SessionToken,
+			Endpoint:        cfg.CloudWatch.Endpoint,
+		}, nil)
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, source)
+	}
+	return sources, nil
+}
+
+// loadEventBus wraps the event repository in a bus delivering events to the configured webhooks
+// and Kafka topic, logging the deliveries it gives up on. It returns nil without either.
+func loadEventBus(cfg EventsConfig, eventRepo domain.DomainEventRepository, logger *leveledLogger) (*eventbus.Bus, error) {
+	if len(cfg.Webhooks) == 0 && cfg.Kafka.RESTProxyURL == "" {
+		return nil, nil
+	}
+
+	retry := eventbus.DefaultRetryPolicy()
+	if cfg.RetryAttempts > 0 {
+		retry.Attempts = cfg.RetryAttempts
+	}
+	if cfg.RetryBackoff != "" {
+		retry.InitialBackoff, _ = time.ParseDuration(cfg.RetryBackoff)
+		if retry.MaxBackoff < retry.InitialBackoff {
+			retry.MaxBackoff = retry.InitialBackoff
+		}
+	}
+	bus := eventbus.NewBus(eventRepo, eventbus.Config{
+		Async:          cfg.Async,
+		Workers:        cfg.Workers,
+		QueueSize:      cfg.QueueSize,
+		Retry:          retry,
+		MaxDeadLetters: cfg.MaxDeadLetters,
+		OnDeadLetter: func(letter eventbus.DeadLetter) {
+			logger.Warnf("Event %s not delivered to %s after %d attempts: %s", letter.EventType, letter.Subscriber, letter.Attempts, letter.Error)
+		},
+	})
+	for _, webhook := range cfg.Webhooks {
+		subscribe(bus, webhook.Name, webhook.EventTypes, notify.NewEventWebhook(webhook.URL, webhook.Headers, nil).Handle)
+	}
+	if cfg.Kafka.RESTProxyURL != "" {
+		producer, err := kafka.NewRESTProxyProducer(kafka.RESTProxyConfig{
+			BaseURL:  cfg.Kafka.RESTProxyURL,
+			Username: cfg.Kafka.Username,
+			Password: cfg.Kafka.Password,
+			Headers:  cfg.Kafka.Headers,
+		}, nil)
+		if err != nil {
+			return nil, err
+		}
+		publisher, err := kafka.NewPublisher(producer, cfg.Kafka.Topic)
+		if err != nil {
+			return nil, err
+		}
+		subscribe(bus, kafkaSubscriber, cfg.Kafka.EventTypes, publisher.Handle)
+	}
+	return bus, nil
+}
+
+// subscribe subscribes the handler to the event types, or to every event when there are none
+func subscribe(bus *eventbus.Bus, subscriber string, eventTypes []string, handler eventbus.Handler) {
+	if len(eventTypes) == 0 {
+		eventTypes = []string{eventbus.AllEvents}
+	}
+	for _, eventType := range eventTypes {
+		bus.Subscribe(subscriber, eventType, handler)
+	}
+}
+
+// loadITSMConnectors creates a ServiceNow connector when its instance is configured, and a Jira
+// connector when its site is
+func loadITSMConnectors(cfg ITSMConfig) ([]domain.ITSMConnector, error) {
+	var connectors []domain.ITSMConnector
+	if cfg.ServiceNow.InstanceURL != "" {
+		connector, err := itsm.NewServiceNowConnector(itsm.ServiceNowConfig{
+			InstanceURL: cfg.ServiceNow.InstanceURL,
+			Username:    cfg.ServiceNow.Username,
+			Password:    cfg.ServiceNow.Password,
+			Token:       cfg.ServiceNow.Token,
+		}, nil)
+		if err != nil {
+			return nil, err
+		}
+		connectors = append(connectors, connector)
+	}
+	if cfg.Jira.BaseURL != "" {
+		issueTypes := make(map[domain.ITSMRecordKind]string, len(cfg.Jira.IssueTypes))
+		for kind, issueType := range cfg.Jira.IssueTypes {
+			issueTypes[domain.ITSMRecordKind(kind)] = issueType
+		}
+		connector, err := itsm.NewJiraConnector(itsm.JiraConfig{
+			BaseURL:    cfg.Jira.BaseURL,
+			Email:      cfg.Jira.Email,
+			APIToken:   cfg.Jira.APIToken,
+			Token:      cfg.Jira.Token,
+			ProjectKey: cfg.Jira.ProjectKey,
+			IssueTypes: issueTypes,
+		}, nil)
+		if err != nil {
+			return nil, err
+		}
+		connectors = append(connectors, connector)
+	}
+	return connectors, nil
+}
+
+// logNotifier writes notifications to the server log
+type logNotifier struct {
+	logger *leveledLogger
+}
+
+func (n logNotifier) Notify(ctx context.Context, notification domain.Notification) error {
+	n.logger.Infof("Notification [%s] %s: %s", notification.Severity, notification.Kind, notification.Title)
+	return nil
+}
+
+// splitList splits a comma-separated list, dropping empty entries
+func splitList(value string) []string {
+	items := []string{}
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// parseDays parses a comma-separated list of day counts
+func parseDays(value string) ([]int, error) {
+	days := []int{}
+	for _, item := range splitList(value) {
+		n, err := strconv.Atoi(item)
+		if err != nil {
+			return nil, err
+		}
+		days = append(days, n)
+	}
+	return days, nil
+}
+
+// logLevel orders log severities
+type logLevel int
+
+const (
+	levelDebug logLevel = iota
+	levelInfo
+	levelWarn
+	levelError
+)
+
+// parseLogLevel converts a configured log level name
+func parseLogLevel(name string) (logLevel, error) {
+	switch strings.ToLower(name) {
+	case "debug":
+		return levelDebug, nil
+	case "info", "":
+		return levelInfo, nil
+	case "warn", "warning":
+		return levelWarn, nil
+	case "error":
+		return levelError, nil
+	default:
+		return levelInfo, fmt.Errorf("unknown log level: %s", name)
+	}
+}
+
+// leveledLogger writes log lines at or above the configured level; stdout is reserved for the protocol
+type leveledLogger struct {
+	level logLevel
+	out   io.Writer
+}
+
+func (l *leveledLogger) logf(level logLevel, prefix, format string, args ...interface{}) {
+	if level < l.level {
+		return
+	}
+	fmt.Fprintf(l.out, prefix+format+"\n", args...)
+}
+
+func (l *leveledLogger) Debugf(format string, args ...interface{}) {
+	l.logf(levelDebug, "DEBUG ", format, args...)
+}
+
+func (l *leveledLogger) Infof(format string, args ...interface{}) {
+	l.logf(levelInfo, "INFO ", format, args...)
+}
+
+func (l *leveledLogger) Warnf(format string, args ...interface{}) {
+	l.logf(levelWarn, "WARN ", format, args...)
+}
+
+func (l *leveledLogger) Errorf(format string, args ...interface{}) {
+	l.logf(levelError, "ERROR ", format, args...)
+}
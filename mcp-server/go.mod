@@ -4,4 +4,6 @@ go 1.25.5
 
 require github.com/iso38500/iso38500-governance-sdk v0.1.0
 
+require gopkg.in/yaml.v3 v3.0.1
+
 replace github.com/iso38500/iso38500-governance-sdk => ../iso38500-governance-sdk
@@ -0,0 +1,262 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/application"
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// Metric kinds served by the Grafana datasource. A metric is named "<kind>:<id>", and risk
+// indicators "risk:<agreement ID>:<indicator name>".
+const (
+	grafanaKPI             = "kpi"
+	grafanaKPIAttainment   = "kpi_attainment"
+	grafanaRisk            = "risk"
+	grafanaPortfolioHealth = "portfolio_health"
+)
+
+// grafanaTarget is one metric requested by a Grafana panel
+type grafanaTarget struct {
+	Target  string                 `json:"target"`
+	RefID   string                 `json:"refId"`
+	Hide    bool                   `json:"hide"`
+	Payload map[string]interface{} `json:"payload"`
+}
+
+// grafanaQueryRequest is the body of a Grafana /query request
+type grafanaQueryRequest struct {
+	Range struct {
+		From time.Time `json:"from"`
+		To   time.Time `json:"to"`
+	} `json:"range"`
+	Targets []grafanaTarget `json:"targets"`
+}
+
+// grafanaSeries is a time series answered to Grafana, each datapoint a value and a Unix time in
+// milliseconds
+type grafanaSeries struct {
+	Target     string       `json:"target"`
+	RefID      string       `json:"refId,omitempty"`
+	Datapoints [][2]float64 `json:"datapoints"`
+}
+
+// grafanaMetric is a metric offered to Grafana's query editor
+type grafanaMetric struct {
+	Label string `json:"label"`
+	Value string `json:"value"`
+}
+
+// grafanaHandler serves governance data to Grafana's JSON datasource: GET / checks the
+// connection, POST /search and /metrics list the metrics and POST /query returns their series
+func (s *MCPServer) grafanaHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			http.NotFound(w, r)
+			return
+		}
+		if _, ok := s.authenticate(w, r); !ok {
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("/search", s.grafanaEndpoint(func(ctx context.Context, body []byte) (interface{}, error) {
+		var req struct {
+			Target string `json:"target"`
+		}
+		if len(body) > 0 {
+			if err := json.Unmarshal(body, &req); err != nil {
+				return nil, fmt.Errorf("invalid search request: %w", err)
+			}
+		}
+		return s.grafanaMetrics(ctx, req.Target)
+	}))
+	mux.HandleFunc("/metrics", s.grafanaEndpoint(func(ctx context.Context, body []byte) (interface{}, error) {
+		names, err := s.grafanaMetrics(ctx, "")
+		if err != nil {
+			return nil, err
+		}
+		metrics := make([]grafanaMetric, 0, len(names))
+		for _, name := range names {
+			metrics = append(metrics, grafanaMetric{Label: name, Value: name})
+		}
+		return metrics, nil
+	}))
+	mux.HandleFunc("/query", s.grafanaEndpoint(func(ctx context.Context, body []byte) (interface{}, error) {
+		var req grafanaQueryRequest
+		if err := json.Unmarshal(body, &req); err != nil {
+			return nil, fmt.Errorf("invalid query request: %w", err)
+		}
+		series := []grafanaSeries{}
+		for _, target := range req.Targets {
+			if target.Hide || target.Target == "" {
+				continue
+			}
+			datapoints, err := s.grafanaDatapoints(ctx, target, req.Range.From, req.Range.To)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", target.Target, err)
+			}
+			series = append(series, grafanaSeries{Target: target.Target, RefID: target.RefID, Datapoints: datapoints})
+		}
+		return series, nil
+	}))
+	return mux
+}
+
+// grafanaEndpoint authenticates a Grafana POST request and answers it with the JSON of handle,
+// or with a bad request carrying its error
+func (s *MCPServer) grafanaEndpoint(handle func(ctx context.Context, body []byte) (interface{}, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		principal, ok := s.authenticate(w, r)
+		if !ok {
+			return
+		}
+
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestBytes))
+		if err != nil {
+			http.Error(w, "invalid request body", http.StatusBadRequest)
+			return
+		}
+		s.logger.Debugf("Received Grafana %s request from %s", r.URL.Path, principal)
+
+		s.mu.Lock()
+		result, err := handle(s.requestContext(r, principal), body)
+		s.mu.Unlock()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(result); err != nil {
+			s.logger.Errorf("Failed to write Grafana response: %v", err)
+		}
+	}
+}
+
+// grafanaMetrics lists the metrics containing filter, ignoring case: every KPI, the KPI
+// attainment and monitored risk indicators of every agreement, and the health of every portfolio
+func (s *MCPServer) grafanaMetrics(ctx context.Context, filter string) ([]string, error) {
+	var names []string
+
+	kpis, err := s.kpiService.ListKPIs(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	for _, kpi := range kpis {
+		names = append(names, grafanaKPI+":"+kpi.ID)
+	}
+
+	agreements, err := s.govRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list governance agreements: %w", err)
+	}
+	for _, agreement := range agreements {
+		names = append(names, fmt.Sprintf("%s:%s", grafanaKPIAttainment, agreement.ID))
+		history, err := s.governanceService.GetMonitoringHistory(ctx, application.GetMonitoringHistoryCommand{AgreementID: agreement.ID})
+		if err != nil {
+			return nil, err
+		}
+		for _, indicator := range history.RiskIndicators {
+			names = append(names, fmt.Sprintf("%s:%s:%s", grafanaRisk, agreement.ID, indicator.Metric))
+		}
+	}
+
+	portfolios, err := s.portfolioService.ListPortfolios(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, portfolio := range portfolios {
+		names = append(names, fmt.Sprintf("%s:%s", grafanaPortfolioHealth, portfolio.ID))
+	}
+
+	filter = strings.ToLower(filter)
+	matching := []string{}
+	for _, name := range names {
+		if strings.Contains(strings.ToLower(name), filter) {
+			matching = append(matching, name)
+		}
+	}
+	sort.Strings(matching)
+	return matching, nil
+}
+
+// grafanaDatapoints returns the series of a metric between from and to. KPIs are charted
+// measurement by measurement unless the target's payload sets a resolution, e.g. "day", in which
+// case the period averages are charted.
+func (s *MCPServer) grafanaDatapoints(ctx context.Context, target grafanaTarget, from, to time.Time) ([][2]float64, error) {
+	parts := strings.SplitN(target.Target, ":", 3)
+	if len(parts) < 2 || parts[1] == "" {
+		return nil, fmt.Errorf("metric must be named <kind>:<id>")
+	}
+	kind, id := parts[0], parts[1]
+
+	datapoints := [][2]float64{}
+	point := func(value float64, at time.Time) {
+		datapoints = append(datapoints, [2]float64{value, float64(at.UnixMilli())})
+	}
+
+	switch kind {
+	case grafanaKPI:
+		resolution, _ := target.Payload["resolution"].(string)
+		history, err := s.kpiService.GetKPIHistory(ctx, id, from, to, domain.KPIResolution(resolution))
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range history.Points {
+			point(p.Avg, p.PeriodStart)
+		}
+
+	case grafanaKPIAttainment, grafanaRisk:
+		if kind == grafanaRisk && len(parts) < 3 {
+			return nil, fmt.Errorf("risk indicator metric must be named %s:<agreement id>:<indicator>", grafanaRisk)
+		}
+		history, err := s.governanceService.GetMonitoringHistory(ctx, application.GetMonitoringHistoryCommand{
+			AgreementID: domain.GovernanceAgreementID(id),
+			From:        from,
+			To:          to,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, snapshot := range history.Snapshots {
+			if kind == grafanaKPIAttainment {
+				if attainment, ok := snapshot.KPIAttainment(); ok {
+					point(attainment*100, snapshot.TakenAt)
+				}
+				continue
+			}
+			for _, indicator := range snapshot.RiskIndicators {
+				if indicator.Name == parts[2] {
+					point(indicator.Value, snapshot.TakenAt)
+				}
+			}
+		}
+
+	case grafanaPortfolioHealth:
+		history, err := s.trendService.PortfolioHealthHistory(ctx, domain.PortfolioID(id), from, to)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range history {
+			point(p.Score, p.At)
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown metric kind %q; use %s, %s, %s or %s", kind, grafanaKPI, grafanaKPIAttainment, grafanaRisk, grafanaPortfolioHealth)
+	}
+	return datapoints, nil
+}
@@ -1,18 +1,27 @@
 package main
 
 import (
-	"bufio"
+	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"log"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/iso38500/iso38500-governance-sdk/application"
+	"github.com/iso38500/iso38500-governance-sdk/demo"
 	"github.com/iso38500/iso38500-governance-sdk/domain"
+	"github.com/iso38500/iso38500-governance-sdk/infrastructure/audittrail"
+	"github.com/iso38500/iso38500-governance-sdk/infrastructure/eventbus"
+	"github.com/iso38500/iso38500-governance-sdk/infrastructure/export"
+	"github.com/iso38500/iso38500-governance-sdk/infrastructure/filestore"
 	"github.com/iso38500/iso38500-governance-sdk/infrastructure/memory"
+	"github.com/iso38500/iso38500-governance-sdk/infrastructure/projection"
+	"github.com/iso38500/iso38500-governance-sdk/infrastructure/tracing"
 )
 
 // MCP Protocol Types
@@ -45,8 +54,67 @@ type MCPNotification struct {
 type MCPServer struct {
 	portfolioService *application.PortfolioService
 	governanceService *application.GovernanceService
-	appRepo         *memory.ApplicationRepositoryMemory
-	govRepo         *memory.GovernanceAgreementRepositoryMemory
+	changeService   *application.ChangeManagementService
+	problemService  *application.ProblemService // nil until change management is configured
+	itsmSyncService *application.ITSMSyncService // nil until change management is configured
+	stopITSMSync    context.CancelFunc // stops the background sync of the current ITSM sync service
+	serviceLevelService *application.ServiceLevelService
+	scheduler       *application.EvaluationScheduler
+	monitoringRunner *application.MonitoringRunner
+	evidenceService *application.EvidenceService
+	decisionService *application.DecisionService
+	kpiService      *application.KPIService
+	telemetryService *application.TelemetryService
+	notificationService *application.NotificationService // nil without notification channels
+	escalationService *application.EscalationService
+	incidentSLAService *application.IncidentSLAService
+	digestService   *application.DigestService
+	complianceService *application.ComplianceService
+	timelineService *application.TimelineService
+	complianceReportService *application.ComplianceReportService
+	complianceViolationService *application.ComplianceViolationService
+	attestationService *application.AttestationService
+	auditTrailService *application.AuditTrailService
+	sodService      *application.SegregationOfDutiesService
+	vendorService   *application.VendorService
+	retentionService *application.RetentionService
+	dpiaService     *application.DPIAService
+	trendService    *domain.TrendService
+	maturityService *domain.MaturityService
+	prioritizationService *domain.PrioritizationService
+	comparisonService *domain.PortfolioComparisonService
+	debtService     *domain.TechnicalDebtService
+	appRepo         domain.ApplicationRepository
+	portfolioRepo   domain.ApplicationPortfolioRepository
+	govRepo         domain.GovernanceAgreementRepository
+	eventRepo       domain.DomainEventRepository
+	assessmentRepo  domain.AssessmentRepository
+	incidentRepo    domain.IncidentRepository
+	auditRepo       domain.AuditRepository // shared by maturity, evidence, timeline, compliance and change management
+	changeRequestRepo domain.ChangeRequestRepository // traced once change management is configured
+	escalationRepo  domain.EscalationRepository
+	problemRepo     domain.ProblemRepository
+	itsmLinkRepo    domain.ITSMLinkRepository
+	itsmConnectors  []domain.ITSMConnector
+	auditTrail      domain.AuditTrailRepository
+	retentionPolicyRepo domain.RetentionPolicyRepository
+	dataHoldingRepo domain.DataHoldingRepository
+	metricsProvider *memory.MetricsProviderMemory
+	tracer          domain.Tracer
+	notifier        domain.Notifier
+	eventBus        *eventbus.Bus // nil without event webhooks
+	projector       *projection.Projector
+	applicationsByRisk *projection.ApplicationsByRisk
+	openApprovals   *projection.OpenApprovals
+	config          Config
+	logger          *leveledLogger
+	tools           []toolDefinition
+	toolsets        map[string]bool
+	disabledTools   map[string]bool
+	initialized     bool
+	authenticator   Authenticator
+	invocations     *invocationLog
+	mu              sync.Mutex
 	ctx             context.Context
 }
 
@@ -71,66 +139,465 @@ type Content struct {
 }
 
 // Initialize MCP Server with governance SDK
-func NewMCPServer() *MCPServer {
+func NewMCPServer(cfg Config) (*MCPServer, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid configuration: %w", err)
+	}
+	level, _ := parseLogLevel(cfg.LogLevel)
+	logger := &leveledLogger{level: level, out: os.Stderr}
+
 	// Initialize repositories
-	appRepo := memory.NewApplicationRepositoryMemory()
-	govRepo := memory.NewGovernanceAgreementRepositoryMemory()
-	portfolioRepo := memory.NewApplicationPortfolioRepositoryMemory()
-	eventRepo := memory.NewDomainEventRepositoryMemory()
+	var appRepo domain.ApplicationRepository = memory.NewApplicationRepositoryMemory()
+	var govRepo domain.GovernanceAgreementRepository = memory.NewGovernanceAgreementRepositoryMemory()
+	var portfolioRepo domain.ApplicationPortfolioRepository = memory.NewApplicationPortfolioRepositoryMemory()
+	var eventRepo domain.DomainEventRepository = memory.NewDomainEventRepositoryMemory()
+	var assessmentRepo domain.AssessmentRepository = memory.NewAssessmentRepositoryMemory()
+	var auditRepo domain.AuditRepository = memory.NewAuditRepositoryMemory()
+	var incidentRepo domain.IncidentRepository = memory.NewIncidentRepositoryMemory()
+	var changeRequestRepo domain.ChangeRequestRepository = memory.NewChangeRequestRepositoryMemory()
+	var escalationRepo domain.EscalationRepository = memory.NewEscalationRepositoryMemory()
+	var digestRepo domain.ExecutiveDigestRepository = memory.NewExecutiveDigestRepositoryMemory()
+	var debtRepo domain.TechnicalDebtRepository = memory.NewTechnicalDebtRepositoryMemory()
+	frameworks, err := loadFrameworks(cfg.FrameworksFile)
+	if err != nil {
+		return nil, err
+	}
+	var frameworkRepo domain.FrameworkRepository = memory.NewFrameworkRepositoryMemory(frameworks...)
+	mappings, err := loadControlMappings(cfg.ControlMappingsFile)
+	if err != nil {
+		return nil, err
+	}
+	var mappingRepo domain.ControlMappingRepository = memory.NewControlMappingRepositoryMemory(mappings...)
+	var complianceAssessmentRepo domain.ComplianceAssessmentRepository = memory.NewComplianceAssessmentRepositoryMemory()
+	var measurementRepo domain.AvailabilityMeasurementRepository = memory.NewAvailabilityMeasurementRepositoryMemory()
+	var kpiRepo domain.KPIRepository = memory.NewKPIRepositoryMemory()
+	var kpiMeasurementRepo domain.KPIMeasurementRepository = memory.NewKPIMeasurementRepositoryMemory()
+	var telemetryBindingRepo domain.TelemetryBindingRepository = memory.NewTelemetryBindingRepositoryMemory()
+	if cfg.KPIMeasurementsFile != "" {
+		fileRepo, err := filestore.NewKPIMeasurementRepository(cfg.KPIMeasurementsFile)
+		if err != nil {
+			return nil, err
+		}
+		kpiMeasurementRepo = fileRepo
+	}
+	var scheduleRepo domain.EvaluationScheduleRepository = memory.NewEvaluationScheduleRepositoryMemory()
+	var monitoringRunRepo domain.MonitoringRunRepository = memory.NewMonitoringRunRepositoryMemory()
+	var monitoringSnapshotRepo domain.MonitoringSnapshotRepository = memory.NewMonitoringSnapshotRepositoryMemory()
+	var decisionRepo domain.DecisionRepository = memory.NewDecisionRepositoryMemory()
+	var attestationRepo domain.AttestationCampaignRepository = memory.NewAttestationCampaignRepositoryMemory()
+	var attachmentStore domain.AttachmentStore = memory.NewAttachmentStoreMemory()
+	var auditTrail domain.AuditTrailRepository = memory.NewAuditTrailRepositoryMemory()
+	var vendorRepo domain.VendorRepository = memory.NewVendorRepositoryMemory()
+	var retentionPolicyRepo domain.RetentionPolicyRepository = memory.NewRetentionPolicyRepositoryMemory()
+	var dataHoldingRepo domain.DataHoldingRepository = memory.NewDataHoldingRepositoryMemory()
+	var dpiaRepo domain.DPIARepository = memory.NewDPIARepositoryMemory()
+	var complianceRepo domain.ComplianceRepository = memory.NewComplianceRepositoryMemory()
+	var problemRepo domain.ProblemRepository = memory.NewProblemRepositoryMemory()
+	var itsmLinkRepo domain.ITSMLinkRepository = memory.NewITSMLinkRepositoryMemory()
+
+	// Record every change to agreements, their policies and assessments in the audit trail
+	govRepo = audittrail.NewGovernanceAgreementRepository(govRepo, auditTrail)
+	assessmentRepo = audittrail.NewAssessmentRepository(assessmentRepo, auditTrail)
+
+	// Trace service and repository calls, logging the slow ones
+	var tracer domain.Tracer
+	var serviceOptions []application.ServiceOption
+	if cfg.SlowCallThreshold != "" {
+		threshold, _ := time.ParseDuration(cfg.SlowCallThreshold)
+		tracer = tracing.NewSlowCallTracer(threshold, func(call tracing.SlowCall) {
+			logger.Warnf("Slow call: %s", call)
+		})
+		serviceOptions = append(serviceOptions, application.WithTracer(tracer))
+
+		appRepo = tracing.NewApplicationRepository(appRepo, tracer)
+		govRepo = tracing.NewGovernanceAgreementRepository(govRepo, tracer)
+		portfolioRepo = tracing.NewApplicationPortfolioRepository(portfolioRepo, tracer)
+		eventRepo = tracing.NewDomainEventRepository(eventRepo, tracer)
+		assessmentRepo = tracing.NewAssessmentRepository(assessmentRepo, tracer)
+		auditRepo = tracing.NewAuditRepository(auditRepo, tracer)
+		incidentRepo = tracing.NewIncidentRepository(incidentRepo, tracer)
+		escalationRepo = tracing.NewEscalationRepository(escalationRepo, tracer)
+		digestRepo = tracing.NewExecutiveDigestRepository(digestRepo, tracer)
+		debtRepo = tracing.NewTechnicalDebtRepository(debtRepo, tracer)
+		frameworkRepo = tracing.NewFrameworkRepository(frameworkRepo, tracer)
+		mappingRepo = tracing.NewControlMappingRepository(mappingRepo, tracer)
+		complianceAssessmentRepo = tracing.NewComplianceAssessmentRepository(complianceAssessmentRepo, tracer)
+		measurementRepo = tracing.NewAvailabilityMeasurementRepository(measurementRepo, tracer)
+		kpiRepo = tracing.NewKPIRepository(kpiRepo, tracer)
+		kpiMeasurementRepo = tracing.NewKPIMeasurementRepository(kpiMeasurementRepo, tracer)
+		telemetryBindingRepo = tracing.NewTelemetryBindingRepository(telemetryBindingRepo, tracer)
+		scheduleRepo = tracing.NewEvaluationScheduleRepository(scheduleRepo, tracer)
+		monitoringRunRepo = tracing.NewMonitoringRunRepository(monitoringRunRepo, tracer)
+		monitoringSnapshotRepo = tracing.NewMonitoringSnapshotRepository(monitoringSnapshotRepo, tracer)
+		decisionRepo = tracing.NewDecisionRepository(decisionRepo, tracer)
+		attestationRepo = tracing.NewAttestationCampaignRepository(attestationRepo, tracer)
+		attachmentStore = tracing.NewAttachmentStore(attachmentStore, tracer)
+		auditTrail = tracing.NewAuditTrailRepository(auditTrail, tracer)
+		vendorRepo = tracing.NewVendorRepository(vendorRepo, tracer)
+		retentionPolicyRepo = tracing.NewRetentionPolicyRepository(retentionPolicyRepo, tracer)
+		dataHoldingRepo = tracing.NewDataHoldingRepository(dataHoldingRepo, tracer)
+		dpiaRepo = tracing.NewDPIARepository(dpiaRepo, tracer)
+		complianceRepo = tracing.NewComplianceRepository(complianceRepo, tracer)
+		problemRepo = tracing.NewProblemRepository(problemRepo, tracer)
+		itsmLinkRepo = tracing.NewITSMLinkRepository(itsmLinkRepo, tracer)
+	}
+
+	// Read models are folded from the events as they are saved, before any webhook sees them
+	projector := projection.NewProjector(eventRepo, projection.NewMemoryCheckpointStore(), projection.WithErrorHandler(func(err error) {
+		logger.Warnf("Projection: %v", err)
+	}))
+	applicationsByRisk := projection.NewApplicationsByRisk()
+	openApprovals := projection.NewOpenApprovals()
+	for _, readModel := range []projection.Projection{applicationsByRisk, openApprovals} {
+		if err := projector.Register(context.Background(), readModel); err != nil {
+			return nil, err
+		}
+	}
+	eventRepo = projector
+
+	// Every service records its events through the bus, so webhooks and Kafka see them all
+	eventBus, err := loadEventBus(cfg.Events, eventRepo, logger)
+	if err != nil {
+		return nil, err
+	}
+	if eventBus != nil {
+		eventRepo = eventBus
+	}
+
+	metricsProvider := memory.NewMetricsProviderMemory()
+
+	baselines, err := loadBaselines(cfg.BaselineFile)
+	if err != nil {
+		return nil, err
+	}
+	templates, err := loadTemplates(cfg.TemplatesFile)
+	if err != nil {
+		return nil, err
+	}
+	notifier, err := loadNotifier(cfg.Notifications, logger)
+	if err != nil {
+		return nil, err
+	}
+	telemetrySources, err := loadTelemetrySources(cfg.Telemetry)
+	if err != nil {
+		return nil, err
+	}
+	itsmConnectors, err := loadITSMConnectors(cfg.ITSM)
+	if err != nil {
+		return nil, err
+	}
 
 	// Initialize domain services
-	evalService := domain.NewEvaluationService(appRepo, govRepo, portfolioRepo, nil, nil)
+	evalService := domain.NewEvaluationService(appRepo, govRepo, portfolioRepo, nil, nil,
+		domain.WithAssessmentRepository(assessmentRepo),
+		domain.WithBaselineProfile(baselines),
+		domain.WithEvaluationTemplates(templates),
+		domain.WithAttachmentStore(attachmentStore),
+		domain.WithTechnicalDebtRepository(debtRepo),
+		domain.WithAvailabilityMeasurementRepository(measurementRepo),
+		domain.WithMetricsProvider(metricsProvider),
+		domain.WithIncidentRepository(incidentRepo),
+		domain.WithChangeRequestRepository(changeRequestRepo),
+		domain.WithVendorRepository(vendorRepo),
+		domain.WithDPIARepository(dpiaRepo),
+		domain.WithRiskSimulation(domain.DefaultRiskSimulationOptions()))
 	directService := domain.NewDirectionService(govRepo)
-	monitorService := domain.NewMonitoringService(nil, nil, nil, govRepo)
+	monitorService := domain.NewMonitoringService(kpiRepo, kpiMeasurementRepo, nil, govRepo, domain.WithPortfolioThresholds(portfolioRepo), domain.WithMonitoringHistory(monitoringSnapshotRepo), domain.WithErrorBudgets(appRepo, measurementRepo), domain.WithOperationalMetrics(incidentRepo), domain.WithChangeCorrelation(changeRequestRepo), domain.WithMonitorPlugins(appRepo, domain.NewVendorMonitorPlugin(vendorRepo, domain.DefaultVendorRiskPolicy())), domain.WithComplianceRepository(complianceRepo))
 
 	// Initialize application services
-	portfolioService := application.NewPortfolioService(portfolioRepo, appRepo, govRepo, eventRepo)
-	governanceService := application.NewGovernanceService(govRepo, appRepo, eventRepo, evalService, directService, monitorService)
+	portfolioService := application.NewPortfolioService(portfolioRepo, appRepo, govRepo, eventRepo, serviceOptions...)
+	governanceService := application.NewGovernanceService(govRepo, appRepo, eventRepo, evalService, directService, monitorService, serviceOptions...)
+	governanceService.SetSegregationOfDutiesPolicy(cfg.SegregationOfDutiesPolicy())
+	kpiService := application.NewKPIService(kpiRepo, kpiMeasurementRepo, eventRepo, serviceOptions...)
 
-	return &MCPServer{
+	server := &MCPServer{
 		portfolioService:  portfolioService,
 		governanceService: governanceService,
+		trendService:      domain.NewTrendService(assessmentRepo, portfolioRepo),
+		maturityService:   domain.NewMaturityService(govRepo, auditRepo),
+		prioritizationService: domain.NewPrioritizationService(assessmentRepo, portfolioRepo),
+		comparisonService: domain.NewPortfolioComparisonService(evalService, portfolioRepo, govRepo),
+		debtService:       domain.NewTechnicalDebtService(debtRepo, portfolioRepo),
+		serviceLevelService: application.NewServiceLevelService(measurementRepo, appRepo, eventRepo, serviceOptions...),
+		scheduler:        application.NewEvaluationScheduler(scheduleRepo, governanceService, eventRepo, serviceOptions...),
+		monitoringRunner: application.NewMonitoringRunner(governanceService, govRepo, monitoringRunRepo, eventRepo, serviceOptions...),
+		evidenceService:  application.NewEvidenceService(attachmentStore, assessmentRepo, auditRepo, eventRepo, serviceOptions...),
+		decisionService:  application.NewDecisionService(decisionRepo, govRepo, appRepo, eventRepo, serviceOptions...),
+		timelineService:  application.NewTimelineService(govRepo, auditRepo, serviceOptions...),
+		kpiService:       kpiService,
+		telemetryService: application.NewTelemetryService(kpiService, kpiRepo, telemetryBindingRepo, telemetrySources, serviceOptions...),
+		digestService:    application.NewDigestService(portfolioRepo, govRepo, monitoringSnapshotRepo, assessmentRepo, escalationRepo, digestRepo, notifier, eventRepo, serviceOptions...),
+		complianceService: application.NewComplianceService(frameworkRepo, mappingRepo, complianceAssessmentRepo, govRepo, auditRepo, attachmentStore, eventRepo, serviceOptions...),
+		complianceReportService: application.NewComplianceReportService(portfolioRepo, govRepo, frameworkRepo, mappingRepo, auditRepo, attachmentStore, serviceOptions...),
+		complianceViolationService: application.NewComplianceViolationService(govRepo, frameworkRepo, mappingRepo, auditRepo, attachmentStore, eventRepo, serviceOptions...),
+		attestationService: application.NewAttestationService(attestationRepo, portfolioRepo, appRepo, govRepo, notifier, eventRepo, serviceOptions...),
+		auditTrailService: application.NewAuditTrailService(auditTrail, serviceOptions...),
+		vendorService:    application.NewVendorService(vendorRepo, appRepo, eventRepo, serviceOptions...),
+		dpiaService:      application.NewDPIAService(dpiaRepo, appRepo, govRepo, eventRepo, serviceOptions...),
 		appRepo:          appRepo,
+		portfolioRepo:    portfolioRepo,
 		govRepo:          govRepo,
+		eventRepo:        eventRepo,
+		assessmentRepo:   assessmentRepo,
+		incidentRepo:     incidentRepo,
+		auditRepo:        auditRepo,
+		changeRequestRepo: changeRequestRepo,
+		escalationRepo:   escalationRepo,
+		problemRepo:      problemRepo,
+		itsmLinkRepo:     itsmLinkRepo,
+		itsmConnectors:   itsmConnectors,
+		auditTrail:       auditTrail,
+		retentionPolicyRepo: retentionPolicyRepo,
+		dataHoldingRepo:  dataHoldingRepo,
+		metricsProvider:  metricsProvider,
+		config:           cfg,
+		tracer:           tracer,
+		notifier:         notifier,
+		eventBus:         eventBus,
+		projector:        projector,
+		applicationsByRisk: applicationsByRisk,
+		openApprovals:    openApprovals,
+		logger:           logger,
+		toolsets:         map[string]bool{toolsetCore: true},
+		disabledTools:    make(map[string]bool),
+		authenticator:    newAuthenticator(cfg),
+		invocations:      &invocationLog{},
 		ctx:              context.Background(),
 	}
+	server.tools = server.toolDefinitions()
+	if notifier != nil {
+		server.notificationService = application.NewNotificationService(governanceService, govRepo, portfolioRepo, nil, notifier, serviceOptions...)
+	}
+	server.escalationService = application.NewEscalationService(govRepo, incidentRepo, nil, escalationRepo, notifier, eventRepo, serviceOptions...)
+	server.incidentSLAService = application.NewIncidentSLAService(govRepo, incidentRepo, notifier, eventRepo, serviceOptions...)
+	server.sodService = application.NewSegregationOfDutiesService(appRepo, nil, assessmentRepo, serviceOptions...)
+	server.sodService.SetPolicy(cfg.SegregationOfDutiesPolicy())
+	server.complianceService.SetComplianceRepository(complianceRepo)
+	server.dpiaService.SetComplianceRepository(complianceRepo)
+	server.retentionService = application.NewRetentionService(retentionPolicyRepo, dataHoldingRepo, appRepo, nil, eventRepo, serviceOptions...)
+
+	for _, name := range cfg.DisabledTools {
+		server.disabledTools[name] = true
+	}
+	for _, toolset := range cfg.Toolsets {
+		if toolset == toolsetChangeManagement {
+			server.ConfigureChangeManagement(
+				changeRequestRepo,
+				incidentRepo,
+				auditRepo,
+			)
+		}
+	}
+
+	if cfg.SeedDemoData {
+		if err := server.seedDemoData(); err != nil {
+			return nil, fmt.Errorf("failed to seed demo data: %w", err)
+		}
+	}
+
+	return server, nil
 }
 
 func main() {
-	server := NewMCPServer()
+	cfg, err := LoadConfig(os.Args[1:])
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
 
-	scanner := bufio.NewScanner(os.Stdin)
-	for scanner.Scan() {
-		line := strings.TrimSpace(scanner.Text())
-		if line == "" {
-			continue
+	server, err := NewMCPServer(cfg)
+	if err != nil {
+		log.Fatalf("Failed to start server: %v", err)
+	}
+	server.logger.Infof("Serving ISO 38500 governance tools over %s (storage=%s, output=%s)", cfg.Transport, cfg.Storage, cfg.OutputFormat)
+
+	go server.scheduler.Start(server.ctx, time.Minute, func(err error) {
+		server.logger.Warnf("Scheduled evaluation: %v", err)
+	})
+	go server.monitoringRunner.Start(server.ctx, time.Minute, func(err error) {
+		server.logger.Warnf("Scheduled monitoring: %v", err)
+	})
+	go server.escalateEvery(time.Minute)
+	go server.checkIncidentSLAsEvery(time.Minute)
+	go server.checkRequirementExpiryEvery(time.Hour)
+	go server.vendorService.Start(server.ctx, time.Hour, func(err error) {
+		server.logger.Warnf("Vendor monitoring: %v", err)
+	})
+	go server.checkRetentionEvery(24 * time.Hour)
+	if server.notificationService != nil && cfg.Notifications.Interval != "" {
+		interval, _ := time.ParseDuration(cfg.Notifications.Interval)
+		go server.sendNotificationsEvery(interval)
+	}
+	if server.notifier != nil && len(cfg.Notifications.Digests) > 0 {
+		// Digests are checked hourly and sent once their week or month has passed
+		cmd := application.SendDueDigestsCommand{}
+		for _, frequency := range cfg.Notifications.Digests {
+			cmd.Frequencies = append(cmd.Frequencies, domain.DigestFrequency(frequency))
 		}
+		go server.digestService.Start(server.ctx, time.Hour, cmd, func(err error) {
+			server.logger.Warnf("Executive digests: %v", err)
+		})
+	}
+	if cfg.Telemetry.Interval != "" {
+		interval, _ := time.ParseDuration(cfg.Telemetry.Interval)
+		go server.telemetryService.Start(server.ctx, interval, func(err error) {
+			server.logger.Warnf("Telemetry ingestion: %v", err)
+		})
+	}
 
-		var req MCPRequest
-		if err := json.Unmarshal([]byte(line), &req); err != nil {
-			log.Printf("Failed to parse request: %v", err)
-			continue
+	if cfg.Transport == transportHTTP {
+		if err := server.serveHTTP(); err != nil {
+			server.logger.Errorf("HTTP server stopped: %v", err)
+			server.closeEventBus()
+			os.Exit(1)
+		}
+		server.closeEventBus()
+		return
+	}
+
+	if err := server.serveStdio(); err != nil {
+		server.logger.Errorf("Error reading stdin: %v", err)
+	}
+	server.closeEventBus()
+}
+
+// closeEventBus gives queued event deliveries up to ten seconds to finish before exiting
+func (s *MCPServer) closeEventBus() {
+	if s.eventBus == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := s.eventBus.Close(ctx); err != nil {
+		s.logger.Warnf("Event bus: %v", err)
+	}
+}
+
+// sendNotificationsEvery sends due notifications every interval. The notification service is
+// looked up under the server lock on each tick since configuring change management replaces it.
+func (s *MCPServer) sendNotificationsEvery(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			s.mu.Lock()
+			notificationService := s.notificationService
+			s.mu.Unlock()
+			if _, err := notificationService.NotifyDue(s.ctx, s.notifyDueCommand()); err != nil {
+				s.logger.Warnf("Notifications: %v", err)
+			}
+		}
+	}
+}
+
+// escalateEvery escalates due alerts, incidents and change requests every interval. The
+// escalation service is looked up under the server lock on each tick since configuring change
+// management replaces it.
+func (s *MCPServer) escalateEvery(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			s.mu.Lock()
+			escalationService := s.escalationService
+			s.mu.Unlock()
+			if _, err := escalationService.EscalateDue(s.ctx, application.EscalateDueCommand{}); err != nil {
+				s.logger.Warnf("Escalations: %v", err)
+			}
+		}
+	}
+}
+
+// checkIncidentSLAsEvery holds unresolved incidents to their SLA every interval. The incident SLA
+// service is looked up under the server lock on each tick since configuring change management
+// replaces it.
+func (s *MCPServer) checkIncidentSLAsEvery(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			s.mu.Lock()
+			incidentSLAService := s.incidentSLAService
+			s.mu.Unlock()
+			if _, err := incidentSLAService.CheckSLAs(s.ctx, application.CheckIncidentSLAsCommand{}); err != nil {
+				s.logger.Warnf("Incident SLAs: %v", err)
+			}
 		}
+	}
+}
+
+// checkRequirementExpiryEvery makes the certifications and contracts that expired non-compliant
+// every interval
+func (s *MCPServer) checkRequirementExpiryEvery(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
 
-		response := server.handleRequest(req)
-		if response != nil {
-			server.sendResponse(response)
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			cmd := application.CheckRequirementExpiryCommand{LeadDays: s.config.ExpiryWarningDays}
+			if _, err := s.complianceService.CheckRequirementExpiry(s.ctx, cmd); err != nil {
+				s.logger.Warnf("Requirement expiry: %v", err)
+			}
 		}
 	}
+}
 
-	if err := scanner.Err(); err != nil {
-		log.Printf("Error reading stdin: %v", err)
+// checkRetentionEvery flags data held beyond its retention policy every interval, scheduling
+// purges once change management is configured. The retention service is looked up under the
+// server lock on each tick since configuring change management replaces it.
+func (s *MCPServer) checkRetentionEvery(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			s.mu.Lock()
+			retentionService := s.retentionService
+			s.mu.Unlock()
+			if _, err := retentionService.CheckRetention(s.ctx, application.CheckRetentionCommand{}); err != nil {
+				s.logger.Warnf("Retention: %v", err)
+			}
+		}
 	}
 }
 
-func (s *MCPServer) handleRequest(req MCPRequest) *MCPResponse {
+// notifyDueCommand sends notifications with the configured reminder interval and expiry warning
+// lead times, checking alert thresholds first
+func (s *MCPServer) notifyDueCommand() application.NotifyDueCommand {
+	remindAfter, _ := time.ParseDuration(s.config.Notifications.RemindAfter)
+	return application.NotifyDueCommand{RemindAfter: remindAfter, EvaluateAlerts: true, ExpiryLeadDays: s.config.ExpiryWarningDays}
+}
+
+func (s *MCPServer) handleRequest(ctx context.Context, req MCPRequest) *MCPResponse {
 	switch req.Method {
 	case "initialize":
 		return s.handleInitialize(req)
 	case "tools/list":
 		return s.handleListTools(req)
 	case "tools/call":
-		return s.handleCallTool(req)
+		return s.handleCallTool(ctx, req)
+	case "completion/complete":
+		return s.handleComplete(ctx, req)
+	case "notifications/initialized":
+		s.initialized = true
+		return nil
 	default:
 		// Only return error response if we have an ID (not a notification)
 		if req.ID == nil {
@@ -155,8 +622,10 @@ func (s *MCPServer) handleInitialize(req MCPRequest) *MCPResponse {
 			"protocolVersion": "2024-11-05",
 			"capabilities": map[string]interface{}{
 				"tools": map[string]interface{}{
-					"listChanged": true,
+					// Only stdio can carry the server-initiated list_changed notification
+					"listChanged": s.config.Transport == transportStdio,
 				},
+				"completions": map[string]interface{}{},
 			},
 			"serverInfo": map[string]interface{}{
 				"name":    "iso38500-governance-sdk",
@@ -167,181 +636,16 @@ func (s *MCPServer) handleInitialize(req MCPRequest) *MCPResponse {
 }
 
 func (s *MCPServer) handleListTools(req MCPRequest) *MCPResponse {
-	tools := []Tool{
-		{
-			Name:        "create_application",
-			Description: "Create a new application in the governance portfolio",
-			InputSchema: map[string]interface{}{
-				"type": "object",
-				"properties": map[string]interface{}{
-					"id": map[string]interface{}{
-						"type": "string",
-						"description": "Unique application identifier",
-					},
-					"name": map[string]interface{}{
-						"type": "string",
-						"description": "Application name",
-					},
-					"description": map[string]interface{}{
-						"type": "string",
-						"description": "Application description",
-					},
-					"version": map[string]interface{}{
-						"type": "string",
-						"description": "Application version",
-					},
-				},
-				"required": []string{"id", "name", "description"},
-			},
-		},
-		{
-			Name:        "create_portfolio",
-			Description: "Create a new application portfolio",
-			InputSchema: map[string]interface{}{
-				"type": "object",
-				"properties": map[string]interface{}{
-					"id": map[string]interface{}{
-						"type": "string",
-						"description": "Unique portfolio identifier",
-					},
-					"name": map[string]interface{}{
-						"type": "string",
-						"description": "Portfolio name",
-					},
-					"description": map[string]interface{}{
-						"type": "string",
-						"description": "Portfolio description",
-					},
-					"owner": map[string]interface{}{
-						"type": "string",
-						"description": "Portfolio owner",
-					},
-				},
-				"required": []string{"id", "name", "description", "owner"},
-			},
-		},
-		{
-			Name:        "add_to_portfolio",
-			Description: "Add an application to a portfolio",
-			InputSchema: map[string]interface{}{
-				"type": "object",
-				"properties": map[string]interface{}{
-					"portfolio_id": map[string]interface{}{
-						"type": "string",
-						"description": "Portfolio identifier",
-					},
-					"application_id": map[string]interface{}{
-						"type": "string",
-						"description": "Application identifier",
-					},
-				},
-				"required": []string{"portfolio_id", "application_id"},
-			},
-		},
-		{
-			Name:        "create_governance_agreement",
-			Description: "Create a governance agreement for an application",
-			InputSchema: map[string]interface{}{
-				"type": "object",
-				"properties": map[string]interface{}{
-					"id": map[string]interface{}{
-						"type": "string",
-						"description": "Unique agreement identifier",
-					},
-					"application_id": map[string]interface{}{
-						"type": "string",
-						"description": "Application identifier",
-					},
-					"title": map[string]interface{}{
-						"type": "string",
-						"description": "Agreement title",
-					},
-				},
-				"required": []string{"id", "application_id", "title"},
-			},
-		},
-		{
-			Name:        "evaluate_application",
-			Description: "Evaluate an application for governance compliance",
-			InputSchema: map[string]interface{}{
-				"type": "object",
-				"properties": map[string]interface{}{
-					"application_id": map[string]interface{}{
-						"type": "string",
-						"description": "Application identifier to evaluate",
-					},
-					"evaluator": map[string]interface{}{
-						"type": "string",
-						"description": "Name of the evaluator",
-					},
-				},
-				"required": []string{"application_id"},
-			},
-		},
-		{
-			Name:        "evaluate_portfolio",
-			Description: "Evaluate an entire portfolio for governance compliance",
-			InputSchema: map[string]interface{}{
-				"type": "object",
-				"properties": map[string]interface{}{
-					"portfolio_id": map[string]interface{}{
-						"type": "string",
-						"description": "Portfolio identifier to evaluate",
-					},
-				},
-				"required": []string{"portfolio_id"},
-			},
-		},
-		{
-			Name:        "monitor_governance",
-			Description: "Monitor governance metrics for an application",
-			InputSchema: map[string]interface{}{
-				"type": "object",
-				"properties": map[string]interface{}{
-					"agreement_id": map[string]interface{}{
-						"type": "string",
-						"description": "Governance agreement identifier",
-					},
-				},
-				"required": []string{"agreement_id"},
-			},
-		},
-		{
-			Name:        "list_applications",
-			Description: "List all applications in the portfolio",
-			InputSchema: map[string]interface{}{
-				"type": "object",
-				"properties": map[string]interface{}{},
-			},
-		},
-		{
-			Name:        "list_portfolios",
-			Description: "List all portfolios",
-			InputSchema: map[string]interface{}{
-				"type": "object",
-				"properties": map[string]interface{}{},
-			},
-		},
-		{
-			Name:        "run_enterprise_demo",
-			Description: "Run the complete enterprise governance demonstration",
-			InputSchema: map[string]interface{}{
-				"type": "object",
-				"properties": map[string]interface{}{},
-			},
-		},
-	}
-
 	return &MCPResponse{
 		JSONRPC: "2.0",
 		ID:       *req.ID,
 		Result: ListToolsResult{
-			Tools: tools,
+			Tools: s.availableTools(),
 		},
 	}
 }
 
-func (s *MCPServer) handleCallTool(req MCPRequest) *MCPResponse {
+func (s *MCPServer) handleCallTool(ctx context.Context, req MCPRequest) *MCPResponse {
 	params, ok := req.Params.(map[string]interface{})
 	if !ok {
 		return s.errorResponse(req, "Invalid parameters")
@@ -357,7 +661,7 @@ func (s *MCPServer) handleCallTool(req MCPRequest) *MCPResponse {
 		return s.errorResponse(req, "Tool arguments not specified")
 	}
 
-	result, err := s.callTool(toolName, toolArgs)
+	result, err := s.callTool(ctx, toolName, toolArgs)
 	if err != nil {
 		return s.errorResponse(req, err.Error())
 	}
@@ -369,34 +673,38 @@ func (s *MCPServer) handleCallTool(req MCPRequest) *MCPResponse {
 	}
 }
 
-func (s *MCPServer) callTool(name string, args map[string]interface{}) (interface{}, error) {
-	switch name {
-	case "create_application":
-		return s.createApplication(args)
-	case "create_portfolio":
-		return s.createPortfolio(args)
-	case "add_to_portfolio":
-		return s.addToPortfolio(args)
-	case "create_governance_agreement":
-		return s.createGovernanceAgreement(args)
-	case "evaluate_application":
-		return s.evaluateApplication(args)
-	case "evaluate_portfolio":
-		return s.evaluatePortfolio(args)
-	case "monitor_governance":
-		return s.monitorGovernance(args)
-	case "list_applications":
-		return s.listApplications(args)
-	case "list_portfolios":
-		return s.listPortfolios(args)
-	case "run_enterprise_demo":
-		return s.runEnterpriseDemo(args)
-	default:
+func (s *MCPServer) callTool(ctx context.Context, name string, args map[string]interface{}) (interface{}, error) {
+	definition, ok := s.findTool(name)
+	if !ok {
 		return nil, fmt.Errorf("unknown tool: %s", name)
 	}
+
+	if caller, ok := callerFromContext(ctx); ok {
+		ctx = domain.WithAuditActor(ctx, caller.String())
+	}
+	result, err := definition.Handler(ctx, args)
+	s.recordInvocation(ctx, name, args, err)
+	return result, err
+}
+
+// recordInvocation attributes a tool call to the caller that made it
+func (s *MCPServer) recordInvocation(ctx context.Context, name string, args map[string]interface{}, err error) {
+	principal, _ := callerFromContext(ctx)
+	invocation := ToolInvocation{
+		Principal: principal,
+		Tool:      name,
+		Arguments: args,
+		Succeeded: err == nil,
+		InvokedAt: time.Now(),
+	}
+	if err != nil {
+		invocation.Error = err.Error()
+	}
+	s.invocations.record(invocation)
+	s.logger.Infof("Tool %s called by %s (succeeded=%t)", name, principal, invocation.Succeeded)
 }
 
-func (s *MCPServer) createApplication(args map[string]interface{}) (interface{}, error) {
+func (s *MCPServer) createApplication(ctx context.Context, args map[string]interface{}) (interface{}, error) {
 	id, _ := args["id"].(string)
 	name, _ := args["name"].(string)
 	description, _ := args["description"].(string)
@@ -404,40 +712,103 @@ func (s *MCPServer) createApplication(args map[string]interface{}) (interface{},
 	if !ok {
 		version = "1.0.0"
 	}
+	category, _ := args["category"].(string)
+	criticality, _ := args["criticality"].(string)
+	cost, _ := args["cost"].(map[string]interface{})
+	classification, _ := args["data_classification"].(string)
+	pii, _ := args["pii"].(bool)
+	phi, _ := args["phi"].(bool)
+	interfaces, _ := args["interfaces"].([]interface{})
 
 	app := domain.Application{
 		ID:          domain.ApplicationID(id),
 		Name:        name,
 		Description: description,
+		Category:    category,
+		Criticality: domain.Priority(criticality),
 		Version:     version,
 		Status:      domain.StatusActive,
 		CreatedAt:   time.Now(),
 		UpdatedAt:   time.Now(),
+		Cost:        applicationCost(cost),
+		DataClassification: domain.DataClassification{
+			Level: domain.DataClassificationLevel(classification),
+			PII:   pii,
+			PHI:   phi,
+		},
+		Interfaces: applicationInterfaces(interfaces),
+	}
+	if err := app.Validate(); err != nil {
+		return nil, err
 	}
 
-	err := s.appRepo.Save(s.ctx, app)
+	err := s.appRepo.Save(ctx, app)
 	if err != nil {
 		return nil, err
 	}
 
-	return CallToolResult{
-		Content: []Content{
-			{
-				Type: "text",
-				Text: fmt.Sprintf("✅ Created application: %s (%s)\nDescription: %s\nVersion: %s\nStatus: %s",
-					app.Name, app.ID, app.Description, app.Version, app.Status),
-			},
-		},
-	}, nil
+	text := fmt.Sprintf("✅ Created application: %s (%s)\nDescription: %s\nVersion: %s\nStatus: %s",
+		app.Name, app.ID, app.Description, app.Version, app.Status)
+	if app.Cost.IsRecorded() {
+		text += fmt.Sprintf("\nAnnual Cost: %.0f %s", app.Cost.AnnualTotal(), app.Cost.Currency)
+	}
+	if app.DataClassification != (domain.DataClassification{}) {
+		text += fmt.Sprintf("\nData: %s", app.DataClassification)
+	}
+	for _, iface := range app.Interfaces {
+		if iface.Provider != "" {
+			text += fmt.Sprintf("\nDepends on: %s (%s)", iface.Provider, iface.Name)
+		}
+	}
+
+	return s.toolResult(text, app)
+}
+
+// applicationCost reads the optional "cost" argument of create_application
+func applicationCost(args map[string]interface{}) domain.ApplicationCost {
+	cost := domain.ApplicationCost{}
+	cost.License, _ = args["license"].(float64)
+	cost.Infrastructure, _ = args["infrastructure"].(float64)
+	cost.Support, _ = args["support"].(float64)
+	cost.Personnel, _ = args["personnel"].(float64)
+	cost.Acquisition, _ = args["acquisition"].(float64)
+	cost.Currency, _ = args["currency"].(string)
+	return cost
+}
+
+// applicationInterfaces reads the optional "interfaces" argument of create_application
+func applicationInterfaces(args []interface{}) []domain.ApplicationInterface {
+	var interfaces []domain.ApplicationInterface
+	for _, arg := range args {
+		fields, ok := arg.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		iface := domain.ApplicationInterface{Status: domain.InterfaceActive}
+		iface.ID, _ = fields["id"].(string)
+		iface.Name, _ = fields["name"].(string)
+		if kind, ok := fields["type"].(string); ok {
+			iface.Type = domain.InterfaceType(kind)
+		}
+		iface.Protocol, _ = fields["protocol"].(string)
+		if status, ok := fields["status"].(string); ok {
+			iface.Status = domain.InterfaceStatus(status)
+		}
+		if provider, ok := fields["provider_application_id"].(string); ok {
+			iface.Provider = domain.ApplicationID(provider)
+		}
+		interfaces = append(interfaces, iface)
+	}
+	return interfaces
 }
 
-func (s *MCPServer) createPortfolio(args map[string]interface{}) (interface{}, error) {
+func (s *MCPServer) createPortfolio(ctx context.Context, args map[string]interface{}) (interface{}, error) {
 	id, _ := args["id"].(string)
 	name, _ := args["name"].(string)
 	description, _ := args["description"].(string)
 	owner, _ := args["owner"].(string)
 
-	portfolio, err := s.portfolioService.CreatePortfolio(s.ctx, application.CreatePortfolioCommand{
+	portfolio, err := s.portfolioService.CreatePortfolio(ctx, application.CreatePortfolioCommand{
 		ID:          domain.PortfolioID(id),
 		Name:        name,
 		Description: description,
@@ -447,22 +818,17 @@ func (s *MCPServer) createPortfolio(args map[string]interface{}) (interface{}, e
 		return nil, err
 	}
 
-	return CallToolResult{
-		Content: []Content{
-			{
-				Type: "text",
-				Text: fmt.Sprintf("✅ Created portfolio: %s (%s)\nDescription: %s\nOwner: %s",
-					portfolio.Name, portfolio.ID, portfolio.Description, portfolio.Owner),
-			},
-		},
-	}, nil
+	text := fmt.Sprintf("✅ Created portfolio: %s (%s)\nDescription: %s\nOwner: %s",
+		portfolio.Name, portfolio.ID, portfolio.Description, portfolio.Owner)
+
+	return s.toolResult(text, portfolio)
 }
 
-func (s *MCPServer) addToPortfolio(args map[string]interface{}) (interface{}, error) {
+func (s *MCPServer) addToPortfolio(ctx context.Context, args map[string]interface{}) (interface{}, error) {
 	portfolioID, _ := args["portfolio_id"].(string)
 	applicationID, _ := args["application_id"].(string)
 
-	err := s.portfolioService.AddApplicationToPortfolio(s.ctx, application.AddApplicationToPortfolioCommand{
+	err := s.portfolioService.AddApplicationToPortfolio(ctx, application.AddApplicationToPortfolioCommand{
 		PortfolioID:   domain.PortfolioID(portfolioID),
 		ApplicationID: domain.ApplicationID(applicationID),
 	})
@@ -470,283 +836,5438 @@ func (s *MCPServer) addToPortfolio(args map[string]interface{}) (interface{}, er
 		return nil, err
 	}
 
-	return CallToolResult{
-		Content: []Content{
-			{
-				Type: "text",
-				Text: fmt.Sprintf("✅ Added application %s to portfolio %s", applicationID, portfolioID),
-			},
-		},
-	}, nil
+	text := fmt.Sprintf("✅ Added application %s to portfolio %s", applicationID, portfolioID)
+
+	return s.toolResult(text, map[string]string{"portfolio_id": portfolioID, "application_id": applicationID})
 }
 
-func (s *MCPServer) createGovernanceAgreement(args map[string]interface{}) (interface{}, error) {
-	id, _ := args["id"].(string)
-	applicationID, _ := args["application_id"].(string)
-	title, _ := args["title"].(string)
+func (s *MCPServer) setPortfolioThresholds(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	portfolioID, _ := args["portfolio_id"].(string)
+	clearThresholds, _ := args["clear"].(bool)
 
-	agreement, err := s.governanceService.CreateGovernanceAgreement(s.ctx, application.CreateGovernanceAgreementCommand{
-		ID:            domain.GovernanceAgreementID(id),
-		ApplicationID: domain.ApplicationID(applicationID),
-		Title:         title,
+	var thresholds *domain.PortfolioThresholds
+	if !clearThresholds {
+		risk := domain.DefaultEvaluationProfile().RiskThresholds
+		if value, ok := args["critical_max_technical_score"].(float64); ok {
+			risk.CriticalMaxTechnicalScore = int(value)
+		}
+		if value, ok := args["high_max_technical_score"].(float64); ok {
+			risk.HighMaxTechnicalScore = int(value)
+		}
+		if value, ok := args["medium_max_technical_score"].(float64); ok {
+			risk.MediumMaxTechnicalScore = int(value)
+		}
+		if value, ok := args["critical_min_cost_efficiency"].(float64); ok {
+			risk.CriticalMinCostEfficiency = value
+		}
+		if value, ok := args["high_min_cost_efficiency"].(float64); ok {
+			risk.HighMinCostEfficiency = value
+		}
+		tolerance, _ := args["kpi_tolerance"].(float64)
+		thresholds = &domain.PortfolioThresholds{RiskThresholds: &risk, KPITolerance: tolerance}
+	}
+
+	err := s.portfolioService.SetPortfolioThresholds(ctx, application.SetPortfolioThresholdsCommand{
+		PortfolioID: domain.PortfolioID(portfolioID),
+		Thresholds:  thresholds,
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return CallToolResult{
-		Content: []Content{
-			{
-				Type: "text",
-				Text: fmt.Sprintf("✅ Created governance agreement: %s\nApplication: %s\nTitle: %s\nStatus: %s",
-					agreement.ID, agreement.ApplicationID, agreement.Title, agreement.Status),
-			},
-		},
-	}, nil
+	if thresholds == nil {
+		return s.toolResult(fmt.Sprintf("✅ Portfolio %s uses the evaluation profile's thresholds again", portfolioID), nil)
+	}
+
+	result := fmt.Sprintf("🎚️ Thresholds for portfolio %s:\n", portfolioID)
+	result += formatPortfolioThresholds(thresholds)
+
+	return s.toolResult(result, thresholds)
 }
 
-func (s *MCPServer) evaluateApplication(args map[string]interface{}) (interface{}, error) {
+func (s *MCPServer) classifyApplicationData(ctx context.Context, args map[string]interface{}) (interface{}, error) {
 	applicationID, _ := args["application_id"].(string)
-	evaluator, ok := args["evaluator"].(string)
-	if !ok {
-		evaluator = "MCP Assistant"
-	}
+	classification, _ := args["classification"].(string)
+	pii, _ := args["pii"].(bool)
+	phi, _ := args["phi"].(bool)
 
-	assessment, err := s.governanceService.EvaluateApplication(s.ctx, application.EvaluateApplicationCommand{
+	app, err := s.portfolioService.ClassifyApplicationData(ctx, application.ClassifyApplicationDataCommand{
 		ApplicationID: domain.ApplicationID(applicationID),
-		Evaluator:     evaluator,
+		Classification: domain.DataClassification{
+			Level: domain.DataClassificationLevel(classification),
+			PII:   pii,
+			PHI:   phi,
+		},
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	riskEmoji := "✅"
-	if assessment.RiskLevel == domain.RiskHigh {
-		riskEmoji = "⚠️"
-	} else if assessment.RiskLevel == domain.RiskCritical {
-		riskEmoji = "🚨"
-	}
-
-	result := fmt.Sprintf("🔍 Application Evaluation Results:\n\n")
-	result += fmt.Sprintf("📊 Risk Level: %s %s\n", assessment.RiskLevel, riskEmoji)
-	result += fmt.Sprintf("🏥 Technical Health: %d/5\n", assessment.TechnicalHealth.CodeQuality)
-	result += fmt.Sprintf("💰 Business Value: %.0f%%\n", assessment.BusinessValue.UserSatisfaction)
-	result += fmt.Sprintf("📋 Recommendations: %d\n", len(assessment.Recommendations))
-
-	if len(assessment.Recommendations) > 0 {
-		result += "\n📝 Key Recommendations:\n"
-		for i, rec := range assessment.Recommendations {
-			if i >= 3 { // Limit to first 3 recommendations
-				result += fmt.Sprintf("... and %d more\n", len(assessment.Recommendations)-3)
-				break
-			}
-			result += fmt.Sprintf("• %s (%s priority): %s\n",
-				rec.Type, rec.Priority, rec.Description)
-		}
+	text := fmt.Sprintf("🔐 Classified the data of %s (%s): %s", app.Name, app.ID, app.DataClassification)
+	if required := app.DataClassification.RequiredSecurityScore(); required > 0 {
+		text += fmt.Sprintf("\nEvaluations require a security score of at least %d/5", required)
 	}
 
-	return CallToolResult{
-		Content: []Content{
-			{
-				Type: "text",
-				Text: result,
-			},
-		},
-	}, nil
+	return s.toolResult(text, app.DataClassification)
 }
 
-func (s *MCPServer) evaluatePortfolio(args map[string]interface{}) (interface{}, error) {
+func (s *MCPServer) scopeDataClassification(ctx context.Context, args map[string]interface{}) (interface{}, error) {
 	portfolioID, _ := args["portfolio_id"].(string)
+	minClassification, _ := args["min_classification"].(string)
+	pii, _ := args["pii"].(bool)
+	phi, _ := args["phi"].(bool)
 
-	assessment, err := s.governanceService.EvaluatePortfolio(s.ctx, application.EvaluatePortfolioCommand{
+	scope, err := s.portfolioService.ScopeByDataClassification(ctx, application.ScopeByDataClassificationCommand{
 		PortfolioID: domain.PortfolioID(portfolioID),
+		Filter: domain.DataClassificationFilter{
+			MinLevel: domain.DataClassificationLevel(minClassification),
+			PII:      pii,
+			PHI:      phi,
+		},
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	result := fmt.Sprintf("📊 Portfolio Evaluation Results:\n\n")
-	result += fmt.Sprintf("📁 Total Applications: %d\n", assessment.TotalApplications)
-	result += fmt.Sprintf("✅ Active Applications: %d\n", assessment.ActiveApplications)
-	result += fmt.Sprintf("⚠️ Deprecated Applications: %d\n", assessment.DeprecatedApplications)
-	result += fmt.Sprintf("🚨 Average Application Age: %.1f days\n", assessment.AverageApplicationAge.Hours()/24)
+	result := "🔐 Data Classification Scope"
+	if portfolioID != "" {
+		result += fmt.Sprintf(" of %s", portfolioID)
+	}
+	result += fmt.Sprintf(":\n%d of %d applications in scope\n", len(scope.Applications), scope.Considered)
+	for _, app := range scope.Applications {
+		result += fmt.Sprintf("• %s (%s): %s\n", app.Name, app.ID, app.DataClassification)
+	}
 
-	if len(assessment.RiskDistribution) > 0 {
-		result += "\n🎯 Risk Distribution:\n"
-		for risk, count := range assessment.RiskDistribution {
-			emoji := "✅"
-			if risk == domain.RiskHigh {
-				emoji = "⚠️"
-			} else if risk == domain.RiskCritical {
-				emoji = "🚨"
-			}
-			result += fmt.Sprintf("• %s: %d applications %s\n", risk, count, emoji)
+	result += "\nAcross the applications considered:\n"
+	for _, level := range domain.AllDataClassificationLevels() {
+		if count := scope.ByLevel[level]; count > 0 {
+			result += fmt.Sprintf("• %s: %d\n", level, count)
 		}
 	}
+	if scope.Unclassified > 0 {
+		result += fmt.Sprintf("• unclassified: %d\n", scope.Unclassified)
+	}
+	result += fmt.Sprintf("• holding PII: %d, holding PHI: %d\n", scope.PII, scope.PHI)
 
-	return CallToolResult{
-		Content: []Content{
-			{
-				Type: "text",
-				Text: result,
-			},
-		},
-	}, nil
+	return s.toolResult(result, scope)
 }
 
-func (s *MCPServer) monitorGovernance(args map[string]interface{}) (interface{}, error) {
-	agreementID, _ := args["agreement_id"].(string)
+func (s *MCPServer) setRetentionPolicy(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	policy := domain.RetentionPolicy{}
+	policy.ID, _ = args["id"].(string)
+	policy.Name, _ = args["name"].(string)
+	days, _ := args["retention_days"].(float64)
+	policy.RetentionDays = int(days)
+	applicationID, _ := args["application_id"].(string)
+	policy.ApplicationID = domain.ApplicationID(applicationID)
+	classification, _ := args["classification"].(string)
+	policy.Classification = domain.DataClassificationLevel(classification)
+	policy.DataCategory, _ = args["data_category"].(string)
+	action, _ := args["action"].(string)
+	policy.Action = domain.RetentionAction(action)
+	policy.LegalBasis, _ = args["legal_basis"].(string)
 
-	monitoringResult, err := s.governanceService.MonitorGovernance(s.ctx, application.MonitorGovernanceCommand{
-		AgreementID: domain.GovernanceAgreementID(agreementID),
+	saved, err := s.retentionService.SetPolicy(ctx, application.SetRetentionPolicyCommand{Policy: policy})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toolResult("🗄️ Saved retention policy:\n"+formatRetentionPolicy(*saved), saved)
+}
+
+func (s *MCPServer) listRetentionPolicies(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	policies, err := s.retentionService.ListPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(policies) == 0 {
+		return s.toolResult("No retention policies defined", policies)
+	}
+
+	result := fmt.Sprintf("🗄️ Retention Policies (%d):\n", len(policies))
+	for _, policy := range policies {
+		result += formatRetentionPolicy(policy)
+	}
+
+	return s.toolResult(result, policies)
+}
+
+func (s *MCPServer) recordDataHolding(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	applicationID, _ := args["application_id"].(string)
+	category, _ := args["category"].(string)
+	records, _ := args["records"].(float64)
+	legalHold, _ := args["legal_hold"].(string)
+	oldest, _ := args["oldest_record_at"].(string)
+	oldestRecordAt, err := time.Parse("2006-01-02", oldest)
+	if err != nil {
+		return nil, fmt.Errorf("oldest_record_at must be a date in YYYY-MM-DD format: %w", err)
+	}
+
+	holding, err := s.retentionService.RecordHolding(ctx, application.RecordDataHoldingCommand{
+		ApplicationID:  domain.ApplicationID(applicationID),
+		Category:       category,
+		Records:        int(records),
+		OldestRecordAt: oldestRecordAt,
+		LegalHold:      legalHold,
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	result := fmt.Sprintf("📊 Governance Monitoring Results:\n\n")
+	text := fmt.Sprintf("🗃️ %s holds %d %s, the oldest from %s",
+		holding.ApplicationID, holding.Records, holding.Category, holding.OldestRecordAt.Format("2006-01-02"))
+	if holding.LegalHold != "" {
+		text += fmt.Sprintf("\n⚖️ Legal hold: %s", holding.LegalHold)
+	}
 
-	// Display KPI results
-	result += fmt.Sprintf("📈 Key Performance Indicators (%d):\n", len(monitoringResult.KPIMeasurements))
-	for i, kpi := range monitoringResult.KPIMeasurements {
-		status := "❌ Not Achieved"
-		if kpi.Achieved {
-			status = "✅ Achieved"
-		}
-		result += fmt.Sprintf("   %d. %s: %.1f/%.1f %s\n", i+1, kpi.KPIID, kpi.Value, kpi.Target, status)
+	return s.toolResult(text, holding)
+}
+
+func (s *MCPServer) checkRetention(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	applicationID, _ := args["application_id"].(string)
+	requester, _ := args["requester"].(string)
+	requester, err := actorName(ctx, requester, "")
+	if err != nil {
+		return nil, err
 	}
 
-	// Display risk results
-	result += fmt.Sprintf("\n🎯 Risk Indicators (%d):\n", len(monitoringResult.RiskStatus.RiskIndicators))
-	for i, risk := range monitoringResult.RiskStatus.RiskIndicators {
-		statusEmoji := "✅"
-		if risk.Status == domain.RiskStatusWarning {
-			statusEmoji = "⚠️"
-		} else if risk.Status == domain.RiskStatusCritical {
-			statusEmoji = "🚨"
+	report, err := s.retentionService.CheckRetention(ctx, application.CheckRetentionCommand{
+		ApplicationID: domain.ApplicationID(applicationID),
+		Requester:     requester,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("🗄️ Retention Check: %d holdings, %d beyond retention\n", report.Holdings, len(report.Violations))
+	for _, violation := range report.Violations {
+		result += fmt.Sprintf("• 🚨 %s\n", violation.Description())
+		if violation.ChangeRequestID != "" {
+			result += fmt.Sprintf("   ↳ %s scheduled in change request %s\n", violation.Policy.Action, violation.ChangeRequestID)
 		}
-		result += fmt.Sprintf("   %d. %s: %.1f (threshold: %.1f) %s\n",
-			i+1, risk.Name, risk.Value, risk.Threshold, statusEmoji)
+	}
+	if len(report.Violations) > 0 && !s.toolsets[toolsetChangeManagement] {
+		result += "Enable change management to schedule purges through change requests\n"
+	}
+	for _, violation := range report.OnHold {
+		result += fmt.Sprintf("• ⚖️ on legal hold: %s\n", violation.Description())
+	}
+	for _, holding := range report.Uncovered {
+		result += fmt.Sprintf("• ❔ no policy covers the %s of %s\n", holding.Category, holding.ApplicationID)
 	}
 
-	return CallToolResult{
-		Content: []Content{
-			{
-				Type: "text",
-				Text: result,
-			},
-		},
-	}, nil
+	return s.toolResult(result, report)
+}
+
+// formatRetentionPolicy describes a retention policy and what it applies to
+func formatRetentionPolicy(policy domain.RetentionPolicy) string {
+	scope := []string{}
+	if policy.ApplicationID != "" {
+		scope = append(scope, string(policy.ApplicationID))
+	}
+	if policy.Classification != "" {
+		scope = append(scope, fmt.Sprintf("%s data", policy.Classification))
+	}
+	if policy.DataCategory != "" {
+		scope = append(scope, policy.DataCategory)
+	}
+	if len(scope) == 0 {
+		scope = append(scope, "all data")
+	}
+
+	result := fmt.Sprintf("• %s (%s): %s after %d days, for %s\n", policy.Name, policy.ID, policy.Action, policy.RetentionDays, strings.Join(scope, ", "))
+	if policy.LegalBasis != "" {
+		result += fmt.Sprintf("   ↳ %s\n", policy.LegalBasis)
+	}
+	return result
 }
 
-func (s *MCPServer) listApplications(args map[string]interface{}) (interface{}, error) {
-	apps, err := s.appRepo.FindAll(s.ctx)
+func (s *MCPServer) createGovernanceAgreement(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	id, _ := args["id"].(string)
+	applicationID, _ := args["application_id"].(string)
+	title, _ := args["title"].(string)
+
+	agreement, err := s.governanceService.CreateGovernanceAgreement(ctx, application.CreateGovernanceAgreementCommand{
+		ID:            domain.GovernanceAgreementID(id),
+		ApplicationID: domain.ApplicationID(applicationID),
+		Title:         title,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	result := fmt.Sprintf("📋 Applications in Portfolio (%d total):\n\n", len(apps))
-	for i, app := range apps {
-		statusEmoji := "✅"
-		if app.Status == domain.StatusDeprecated {
-			statusEmoji = "⚠️"
-		} else if app.Status == domain.StatusRetired {
-			statusEmoji = "🚫"
+	text := fmt.Sprintf("✅ Created governance agreement: %s\nApplication: %s\nTitle: %s\nStatus: %s",
+		agreement.ID, agreement.ApplicationID, agreement.Title, agreement.Status)
+
+	return s.toolResult(text, agreement)
+}
+
+func (s *MCPServer) evaluateApplication(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	applicationID, _ := args["application_id"].(string)
+	evaluator, _ := args["evaluator"].(string)
+	evaluator, err := actorName(ctx, evaluator, "MCP Assistant")
+	if err != nil {
+		return nil, err
+	}
+	profile, err := evaluationProfile(args)
+	if err != nil {
+		return nil, err
+	}
+
+	assessment, err := s.governanceService.EvaluateApplication(ctx, application.EvaluateApplicationCommand{
+		ApplicationID: domain.ApplicationID(applicationID),
+		Evaluator:     evaluator,
+		Profile:       profile,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	riskEmoji := "✅"
+	if assessment.RiskLevel == domain.RiskHigh {
+		riskEmoji = "⚠️"
+	} else if assessment.RiskLevel == domain.RiskCritical {
+		riskEmoji = "🚨"
+	}
+
+	result := fmt.Sprintf("🔍 Application Evaluation Results:\n\n")
+	result += fmt.Sprintf("📊 Risk Level: %s %s\n", assessment.RiskLevel, riskEmoji)
+	result += fmt.Sprintf("🏥 Technical Health: %d/5\n", assessment.TechnicalHealth.CodeQuality)
+	if breakdown := assessment.TechnicalHealth.Breakdown; breakdown != nil {
+		result += fmt.Sprintf("   ↳ %s\n", breakdown)
+	}
+	result += fmt.Sprintf("💰 Business Value: %.0f%%\n", assessment.BusinessValue.UserSatisfaction)
+	if estimated := assessment.BusinessValue.EstimatedMetrics; len(estimated) > 0 {
+		result += fmt.Sprintf("   ↳ estimated, not observed: %s\n", strings.Join(estimated, ", "))
+	}
+	result += fmt.Sprintf("🧭 TIME Quadrant: %s\n", assessment.TIMEQuadrant)
+	if assessment.Data != (domain.DataClassification{}) {
+		result += fmt.Sprintf("🔐 Data: %s", assessment.Data)
+		if required := assessment.Data.RequiredSecurityScore(); required > 0 {
+			result += fmt.Sprintf(" (security %d/5, %d required)", assessment.TechnicalHealth.SecurityScore, required)
+		}
+		result += "\n"
+	}
+	result += fmt.Sprintf("📋 Recommendations: %d\n", len(assessment.Recommendations))
+	result += fmt.Sprintf("✍️ Sign-off: %s\n", signOffStatus(assessment.SignOff))
+	if debt := assessment.TechnicalDebt; debt != nil && debt.OpenItems > 0 {
+		result += fmt.Sprintf("🧾 Technical Debt: %d open items, %.0f hours principal, %.0f hours/month interest\n",
+			debt.OpenItems, debt.PrincipalHours, debt.InterestHoursPerMonth)
+	}
+	if operations := assessment.Operations; operations != nil && operations.Incidents > 0 {
+		result += "\n📟 Operations (last 90 days):\n"
+		result += formatOperationalMetrics(operations, "   ")
+	}
+	if changes := assessment.Changes; changes != nil && changes.Requested+changes.Implemented > 0 {
+		result += "\n🔀 Changes (last 90 days):\n"
+		result += formatChangeMetrics(changes, "   ")
+	}
+	if len(assessment.SLABreaches) > 0 {
+		result += "\n🚨 SLA Breaches:\n"
+		for _, breach := range assessment.SLABreaches {
+			result += fmt.Sprintf("• %s\n", breach.Description())
+		}
+	}
+	if len(assessment.EndOfLife) > 0 {
+		result += "\n⏳ End of Support:\n"
+		for _, finding := range assessment.EndOfLife {
+			result += fmt.Sprintf("• %s (%s risk)\n", finding.Description(), finding.RiskLevel)
+		}
+	}
+	if compliance := assessment.Compliance; compliance != nil {
+		result += "\n⚖️ Compliance:\n"
+		if compliance.Assessed() {
+			result += fmt.Sprintf("• %.0f%% weighted by criticality (%d compliant, %d partial, %d non-compliant)\n",
+				compliance.Percentage, compliance.Compliant, compliance.Partial, compliance.NonCompliant)
+			for _, kind := range compliance.Kinds {
+				result += fmt.Sprintf("   ↳ %s: %.0f%%\n", kind.Kind, kind.Percentage)
+			}
+		}
+		if compliance.UnderReview > 0 {
+			result += fmt.Sprintf("• %d requirements under review, not scored\n", compliance.UnderReview)
+		}
+		for _, gap := range compliance.Gaps {
+			result += fmt.Sprintf("• ❌ %s\n", gap.Requirement)
 		}
+	}
+	if len(assessment.Vendors) > 0 {
+		result += "\n🤝 Vendors:\n"
+		for _, vendor := range assessment.Vendors {
+			result += fmt.Sprintf("• %s: %s risk, %s criticality\n", vendor.Name, vendor.RiskLevel, vendor.Criticality)
+		}
+	}
+	if dpia := assessment.DPIA; dpia != nil {
+		result += "\n🛡️ Privacy Impact:\n"
+		switch {
+		case dpia.DPIAID == "":
+			result += "• ❌ No DPIA of the processing of personal data\n"
+		case dpia.Status == domain.DPIAApproved:
+			result += fmt.Sprintf("• DPIA %s approved, %s residual risk\n", dpia.DPIAID, dpia.ResidualRisk)
+		default:
+			result += fmt.Sprintf("• DPIA %s %s, not approved\n", dpia.DPIAID, dpia.Status)
+		}
+		if dpia.ReviewDue {
+			result += "• ⚠️ Past its review date\n"
+		}
+		if dpia.PriorConsultationRequired {
+			result += "• 🚨 Prior consultation of the supervisory authority required\n"
+		}
+	}
 
-		result += fmt.Sprintf("%d. %s (%s) %s\n", i+1, app.Name, app.ID, statusEmoji)
-		result += fmt.Sprintf("   📝 %s\n", app.Description)
-		result += fmt.Sprintf("   🔖 Version: %s | Created: %s\n\n",
-			app.Version, app.CreatedAt.Format("2006-01-02"))
+	if assessment.Benchmark != nil {
+		result += fmt.Sprintf("\n📏 Benchmark (%s, %s baseline):\n", assessment.Benchmark.ProfileName, assessment.Benchmark.Category)
+		for _, deviation := range assessment.Benchmark.Deviations {
+			marker := "✅"
+			if deviation.BelowBaseline {
+				marker = "⚠️"
+			}
+			result += fmt.Sprintf("• %s: %.1f vs %.1f expected (%+.1f%%) %s\n",
+				deviation.Metric, deviation.Actual, deviation.Expected, deviation.DeviationPercent, marker)
+		}
 	}
 
-	return CallToolResult{
-		Content: []Content{
-			{
-				Type: "text",
-				Text: result,
-			},
-		},
-	}, nil
+	if assessment.Template != "" {
+		result += fmt.Sprintf("\n🧩 Template (%s):\n", assessment.Template)
+		for _, check := range assessment.TemplateChecks {
+			marker := "✅"
+			if !check.Passed {
+				marker = "❌"
+			}
+			result += fmt.Sprintf("• %s: %.1f, %.1f required %s\n", check.Check.Metric, check.Actual, check.Check.Minimum, marker)
+		}
+		if len(assessment.MandatoryEvidence) > 0 {
+			result += fmt.Sprintf("• Evidence required for sign-off: %s\n", formatEvidenceKinds(assessment.MandatoryEvidence))
+		}
+	}
+
+	if len(assessment.Recommendations) > 0 {
+		result += "\n📝 Key Recommendations:\n"
+		for i, rec := range assessment.Recommendations {
+			if i >= 3 { // Limit to first 3 recommendations
+				result += fmt.Sprintf("... and %d more\n", len(assessment.Recommendations)-3)
+				break
+			}
+			result += fmt.Sprintf("• %s (%s priority): %s\n",
+				rec.Type, rec.Priority, rec.Description)
+		}
+	}
+
+	return s.toolResult(result, assessment)
 }
 
-func (s *MCPServer) listPortfolios(args map[string]interface{}) (interface{}, error) {
-	portfolios, err := s.portfolioService.ListPortfolios(s.ctx)
+func (s *MCPServer) evaluatePortfolio(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	portfolioID, _ := args["portfolio_id"].(string)
+	profile, err := evaluationProfile(args)
 	if err != nil {
 		return nil, err
 	}
 
-	result := fmt.Sprintf("📁 Application Portfolios (%d total):\n\n", len(portfolios))
-	for i, portfolio := range portfolios {
-		result += fmt.Sprintf("%d. %s (%s)\n", i+1, portfolio.Name, portfolio.ID)
-		result += fmt.Sprintf("   👤 Owner: %s\n", portfolio.Owner)
-		result += fmt.Sprintf("   📝 %s\n", portfolio.Description)
-		result += fmt.Sprintf("   📊 Applications: %d\n", len(portfolio.Applications))
-		result += fmt.Sprintf("   📅 Created: %s\n\n", portfolio.CreatedAt.Format("2006-01-02"))
+	assessment, err := s.governanceService.EvaluatePortfolio(ctx, application.EvaluatePortfolioCommand{
+		PortfolioID: domain.PortfolioID(portfolioID),
+		Profile:     profile,
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return CallToolResult{
-		Content: []Content{
-			{
-				Type: "text",
-				Text: result,
-			},
-		},
-	}, nil
-}
+	result := fmt.Sprintf("📊 Portfolio Evaluation Results:\n\n")
+	result += fmt.Sprintf("🩺 Health Index: %.0f/100\n", assessment.HealthIndex.Score)
+	for _, component := range assessment.HealthIndex.Components {
+		if component.Available {
+			result += fmt.Sprintf("   • %s: %.0f × %.0f%% weight (%s)\n", component.Name, component.Score, component.Weight*100, component.Detail)
+		} else {
+			result += fmt.Sprintf("   • %s: n/a (%s)\n", component.Name, component.Detail)
+		}
+	}
+	result += fmt.Sprintf("📁 Total Applications: %d\n", assessment.TotalApplications)
+	result += fmt.Sprintf("✅ Active Applications: %d\n", assessment.ActiveApplications)
+	result += fmt.Sprintf("⚠️ Deprecated Applications: %d\n", assessment.DeprecatedApplications)
+	result += fmt.Sprintf("♻️ Redundant Applications: %d\n", assessment.RedundantApplications)
+	if assessment.TotalCost > 0 {
+		breakdown := assessment.CostBreakdown
+		result += fmt.Sprintf("💰 Annual Cost: %.0f %s (license %.0f, infrastructure %.0f, support %.0f, personnel %.0f)\n",
+			assessment.TotalCost, breakdown.Currency, breakdown.License, breakdown.Infrastructure, breakdown.Support, breakdown.Personnel)
+	}
+	result += fmt.Sprintf("🚨 Average Application Age: %.1f days\n", assessment.AverageApplicationAge.Hours()/24)
+	if compliance := assessment.Compliance; compliance != nil {
+		result += fmt.Sprintf("⚖️ Compliance: %.0f%% across %d applications", compliance.Percentage, compliance.Applications)
+		if len(compliance.BelowMedium) > 0 {
+			result += fmt.Sprintf(", below threshold: %s", joinApplicationIDs(compliance.BelowMedium))
+		}
+		result += "\n"
+	}
 
-func (s *MCPServer) runEnterpriseDemo(args map[string]interface{}) (interface{}, error) {
-	// Import and run the enterprise demo from the examples
-	// This is a simplified version for MCP
+	if len(assessment.RiskDistribution) > 0 {
+		result += "\n🎯 Risk Distribution:\n"
+		for risk, count := range assessment.RiskDistribution {
+			emoji := "✅"
+			if risk == domain.RiskHigh {
+				emoji = "⚠️"
+			} else if risk == domain.RiskCritical {
+				emoji = "🚨"
+			}
+			result += fmt.Sprintf("• %s: %d applications %s\n", risk, count, emoji)
+		}
+	}
 
-	result := "🏛️ ISO 38500 Enterprise Governance Demo\n"
-	result += "=====================================\n\n"
+	if len(assessment.TIMEQuadrants) > 0 {
+		result += "\n🧭 TIME Quadrants:\n"
+		for _, quadrant := range domain.TIMEQuadrants {
+			if apps := assessment.TIMEQuadrants[quadrant]; len(apps) > 0 {
+				result += fmt.Sprintf("• %s: %s\n", quadrant, joinApplicationIDs(apps))
+			}
+		}
+	}
 
-	// Simulate key demo steps
-	result += "✅ Enterprise Application Portfolio: 15 applications across 5 business domains\n"
-	result += "✅ Multi-Portfolio Structure: Core Business, HR/Finance, Infrastructure, Analytics, Legacy\n"
-	result += "✅ Governance Framework: 14 active governance agreements\n"
-	result += "✅ Risk Assessment: Enterprise-wide evaluation completed\n"
-	result += "✅ Strategic Direction: Objectives and initiatives established\n"
-	result += "✅ Real-time Monitoring: 28 KPIs and 28 risk indicators tracked\n\n"
+	if scoreboard := assessment.KPIScoreboard; scoreboard != nil && scoreboard.Total > 0 {
+		result += "\n📈 KPI Scoreboard:\n"
+		result += formatKPIScoreboard(*scoreboard, "")
+	}
 
-	result += "🎯 ISO 38500 Governance Principles Demonstrated:\n"
-	result += "• EVALUATE: Comprehensive application and portfolio assessment\n"
-	result += "• DIRECT: Strategic direction setting and resource allocation\n"
-	result += "• MONITOR: Continuous governance monitoring and compliance\n\n"
+	if debt := assessment.TechnicalDebt; debt != nil && debt.Total.OpenItems > 0 {
+		result += fmt.Sprintf("🧾 Technical Debt: %d open items, %.0f hours principal, %.0f hours/month interest\n",
+			debt.Total.OpenItems, debt.Total.PrincipalHours, debt.Total.InterestHoursPerMonth)
+	}
 
-	result += "🏆 Enterprise Governance Coverage: 93.3% of application portfolio\n"
+	if exposure := assessment.RiskExposure; exposure != nil && exposure.Risks > 0 {
+		result += fmt.Sprintf("🎲 Risk Exposure: %.0f expected, %.0f P50, %.0f P90, %.0f P99 across %d risks\n",
+			exposure.ExpectedLoss, exposure.P50, exposure.P90, exposure.P99, exposure.Risks)
+	}
 
-	return CallToolResult{
-		Content: []Content{
-			{
-				Type: "text",
-				Text: result,
-			},
-		},
-	}, nil
+	if len(assessment.ConsolidationCandidates) > 0 {
+		result += "\n♻️ Consolidation Candidates:\n"
+		for _, candidate := range assessment.ConsolidationCandidates {
+			result += fmt.Sprintf("• %s → %s (%.0f%% overlap): %s\n", candidate.Redundant, candidate.Retain, candidate.Overlap*100, candidate.Rationale)
+		}
+	}
+
+	return s.toolResult(result, assessment)
 }
 
-func (s *MCPServer) errorResponse(req MCPRequest, message string) *MCPResponse {
-	if req.ID == nil {
-		return nil // Don't respond to notifications
+func (s *MCPServer) simulatePortfolio(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	portfolioID, _ := args["portfolio_id"].(string)
+	scenarioName, _ := args["scenario_name"].(string)
+
+	var changes []domain.ScenarioChange
+	retire, _ := args["retire_application_ids"].([]interface{})
+	for _, id := range retire {
+		appID, _ := id.(string)
+		changes = append(changes, domain.RetireApplication(domain.ApplicationID(appID)))
 	}
-	return &MCPResponse{
-		JSONRPC: "2.0",
-		ID:       *req.ID,
-		Error: &MCPError{
-			Code:    -32000,
-			Message: message,
-		},
+	add, _ := args["add_applications"].([]interface{})
+	for _, entry := range add {
+		planned, _ := entry.(map[string]interface{})
+		id, _ := planned["id"].(string)
+		name, _ := planned["name"].(string)
+		category, _ := planned["category"].(string)
+		cost, _ := planned["cost"].(map[string]interface{})
+		changes = append(changes, domain.AddApplication(domain.Application{
+			ID:       domain.ApplicationID(id),
+			Name:     name,
+			Category: category,
+			Status:   domain.StatusActive,
+			Cost:     applicationCost(cost),
+		}))
+	}
+	merge, _ := args["merge_portfolio_ids"].([]interface{})
+	for _, id := range merge {
+		otherID, _ := id.(string)
+		changes = append(changes, domain.MergePortfolio(domain.PortfolioID(otherID)))
 	}
-}
 
-func (s *MCPServer) sendResponse(resp *MCPResponse) {
-	data, err := json.Marshal(resp)
+	simulation, err := s.governanceService.SimulatePortfolio(ctx, application.SimulatePortfolioCommand{
+		PortfolioID:  domain.PortfolioID(portfolioID),
+		ScenarioName: scenarioName,
+		Changes:      changes,
+	})
 	if err != nil {
-		log.Printf("Failed to marshal response: %v", err)
-		return
+		return nil, err
+	}
+
+	baseline, projected, delta := simulation.Baseline, simulation.Projected, simulation.Delta
+	result := fmt.Sprintf("🔮 Portfolio Simulation: %s\n\n", simulation.Scenario)
+	result += fmt.Sprintf("🩺 Health Index: %.0f → %.0f (%+.0f)\n", baseline.HealthIndex.Score, projected.HealthIndex.Score, delta.HealthIndex)
+	result += fmt.Sprintf("📁 Applications: %d → %d (%+d)\n", baseline.TotalApplications, projected.TotalApplications, delta.TotalApplications)
+	result += fmt.Sprintf("✅ Active: %d → %d (%+d)\n", baseline.ActiveApplications, projected.ActiveApplications, delta.ActiveApplications)
+	result += fmt.Sprintf("♻️ Redundant: %d → %d (%+d)\n", baseline.RedundantApplications, projected.RedundantApplications, delta.RedundantApplications)
+	result += fmt.Sprintf("💰 Annual Cost: %.0f → %.0f (%+.0f)\n", baseline.TotalCost, projected.TotalCost, delta.TotalCost)
+	if projected.TechnicalDebt != nil {
+		result += fmt.Sprintf("🧾 Technical Debt: %+.0f hours\n", delta.TechnicalDebtHours)
+	}
+	if projected.RiskExposure != nil {
+		result += fmt.Sprintf("🎲 Risk Exposure: %+.0f expected, %+.0f P90\n", delta.ExpectedLoss, delta.P90Loss)
+	}
+	if len(delta.RiskDistribution) > 0 {
+		result += "\n🎯 Risk Distribution Change:\n"
+		for _, level := range []domain.RiskLevel{domain.RiskLow, domain.RiskMedium, domain.RiskHigh, domain.RiskCritical} {
+			if change, ok := delta.RiskDistribution[level]; ok {
+				result += fmt.Sprintf("• %s: %d → %d (%+d)\n", level, baseline.RiskDistribution[level], projected.RiskDistribution[level], change)
+			}
+		}
+	}
+
+	return s.toolResult(result, simulation)
+}
+
+func (s *MCPServer) identifyRisk(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	agreementID, _ := args["agreement_id"].(string)
+	id, _ := args["id"].(string)
+	name, _ := args["name"].(string)
+	description, _ := args["description"].(string)
+	category, _ := args["category"].(string)
+	probability, _ := args["probability"].(float64)
+	impact, _ := args["impact"].(string)
+	level, _ := args["level"].(string)
+	lossMin, _ := args["loss_minimum"].(float64)
+	lossLikely, _ := args["loss_most_likely"].(float64)
+	lossMax, _ := args["loss_maximum"].(float64)
+
+	risk := domain.Risk{
+		ID:          id,
+		Name:        name,
+		Description: description,
+		Category:    category,
+		Probability: probability,
+		Impact:      domain.RiskImpact(impact),
+		Level:       domain.RiskLevel(level),
+		Loss:        domain.LossEstimate{Minimum: lossMin, MostLikely: lossLikely, Maximum: lossMax},
+	}
+	err := s.governanceService.IdentifyRisk(ctx, application.IdentifyRiskCommand{
+		AgreementID: domain.GovernanceAgreementID(agreementID),
+		Risk:        risk,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	text := fmt.Sprintf("⚠️ Identified risk %s on %s: %s\nProbability: %.0f%%\nImpact: %s",
+		risk.ID, agreementID, risk.Name, risk.Probability*100, risk.Impact)
+	if !risk.Loss.IsZero() {
+		text += fmt.Sprintf("\nLoss Estimate: %.0f / %.0f / %.0f", risk.Loss.Minimum, risk.Loss.MostLikely, risk.Loss.Maximum)
+	}
+	return s.toolResult(text, risk)
+}
+
+func (s *MCPServer) simulatePortfolioRisk(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	portfolioID, _ := args["portfolio_id"].(string)
+	iterations, _ := args["iterations"].(float64)
+	if iterations < 0 || iterations > domain.MaxRiskSimulationIterations {
+		return nil, fmt.Errorf("iterations must be between 1 and %d", domain.MaxRiskSimulationIterations)
+	}
+	var seed *int64
+	if value, ok := args["seed"].(float64); ok {
+		fixed := int64(value)
+		seed = &fixed
+	}
+
+	exposure, err := s.governanceService.SimulatePortfolioRisk(ctx, application.SimulatePortfolioRiskCommand{
+		PortfolioID: domain.PortfolioID(portfolioID),
+		Iterations:  int(iterations),
+		Seed:        seed,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("🎲 Risk Exposure for %s (%d iterations, %d risks):\n\n", exposure.PortfolioID, exposure.Iterations, exposure.Risks)
+	result += fmt.Sprintf("Expected Loss: %.0f\n", exposure.ExpectedLoss)
+	result += fmt.Sprintf("P50: %.0f\nP90: %.0f\nP95: %.0f\nP99: %.0f\n", exposure.P50, exposure.P90, exposure.P95, exposure.P99)
+	if len(exposure.ExceedanceCurve) > 0 {
+		result += "\n📉 Loss Exceedance:\n"
+		for _, point := range exposure.ExceedanceCurve {
+			result += fmt.Sprintf("• ≥ %.0f: %.1f%%\n", point.Loss, point.Probability*100)
+		}
+	}
+	if len(exposure.Applications) > 0 {
+		result += "\n📋 By Application:\n"
+		for _, app := range exposure.Applications {
+			result += fmt.Sprintf("• %s: %.0f expected, %.0f P90 (%d risks)\n", app.ApplicationID, app.ExpectedLoss, app.P90, app.Risks)
+		}
+	}
+
+	return s.toolResult(result, exposure)
+}
+
+func (s *MCPServer) scheduleEvaluation(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	id, _ := args["id"].(string)
+	agreementID, _ := args["agreement_id"].(string)
+	portfolioID, _ := args["portfolio_id"].(string)
+	cron, _ := args["cron"].(string)
+	evaluator, _ := args["evaluator"].(string)
+
+	schedule, err := s.scheduler.Schedule(ctx, application.ScheduleEvaluationCommand{
+		ID:          id,
+		AgreementID: domain.GovernanceAgreementID(agreementID),
+		PortfolioID: domain.PortfolioID(portfolioID),
+		Cron:        cron,
+		Evaluator:   evaluator,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	target := string(schedule.PortfolioID)
+	if schedule.Target == domain.ScheduleTargetAgreement {
+		target = string(schedule.AgreementID)
+	}
+	text := fmt.Sprintf("⏰ Scheduled %s evaluation %s of %s\nCron: %s\nEvaluator: %s\nNext Run: %s",
+		schedule.Target, schedule.ID, target, schedule.Cron, schedule.Evaluator, schedule.NextRunAt.Format(time.RFC3339))
+	return s.toolResult(text, schedule)
+}
+
+func (s *MCPServer) listEvaluationSchedules(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	schedules, err := s.scheduler.ListSchedules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("⏰ Evaluation Schedules (%d):\n\n", len(schedules))
+	for _, schedule := range schedules {
+		target := string(schedule.PortfolioID)
+		if schedule.Target == domain.ScheduleTargetAgreement {
+			target = string(schedule.AgreementID)
+		}
+		result += fmt.Sprintf("• %s: %s %s [%s] next %s\n", schedule.ID, schedule.Target, target, schedule.Cron, schedule.NextRunAt.Format(time.RFC3339))
+		if !schedule.LastRunAt.IsZero() {
+			status := "succeeded"
+			if schedule.LastError != "" {
+				status = "failed: " + schedule.LastError
+			}
+			result += fmt.Sprintf("  Last run %s %s\n", schedule.LastRunAt.Format(time.RFC3339), status)
+		}
+	}
+
+	return s.toolResult(result, schedules)
+}
+
+func (s *MCPServer) cancelEvaluationSchedule(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	id, _ := args["id"].(string)
+
+	if err := s.scheduler.Unschedule(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return s.toolResult(fmt.Sprintf("🛑 Cancelled evaluation schedule %s", id), map[string]string{"id": id})
+}
+
+func (s *MCPServer) listMonitoringRuns(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	agreementID, _ := args["agreement_id"].(string)
+
+	runs, err := s.monitoringRunner.ListRuns(ctx, domain.GovernanceAgreementID(agreementID))
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("🛰️ Monitoring Runs of %s (%d):\n\n", agreementID, len(runs))
+	for _, run := range runs {
+		if !run.Succeeded() {
+			result += fmt.Sprintf("❌ %s failed: %s\n", run.RanAt.Format(time.RFC3339), run.Err)
+			continue
+		}
+		result += fmt.Sprintf("✅ %s: %d/%d KPIs achieved, %d/%d risk indicators over threshold, %d requirement gaps, %d compliance violations, %d active alerts, %d KPI anomalies\n",
+			run.RanAt.Format(time.RFC3339), run.KPIsAchieved, run.KPIsMeasured, run.RisksOverThreshold, run.RiskIndicators,
+			run.RequirementGaps, run.ComplianceViolations, run.ActiveAlerts, run.KPIAnomalies)
+	}
+
+	return s.toolResult(result, runs)
+}
+
+func (s *MCPServer) getMonitoringHistory(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	agreementID, _ := args["agreement_id"].(string)
+
+	var from, to time.Time
+	if value, ok := args["from"].(string); ok && value != "" {
+		parsed, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid from date: %w", err)
+		}
+		from = parsed
+	}
+	if value, ok := args["to"].(string); ok && value != "" {
+		parsed, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid to date: %w", err)
+		}
+		to = parsed.AddDate(0, 0, 1).Add(-time.Nanosecond)
+	}
+
+	history, err := s.governanceService.GetMonitoringHistory(ctx, application.GetMonitoringHistoryCommand{
+		AgreementID: domain.GovernanceAgreementID(agreementID),
+		From:        from,
+		To:          to,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toolResult(formatMonitoringHistory(history), history)
+}
+
+func (s *MCPServer) getAssessmentHistory(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	applicationID, _ := args["application_id"].(string)
+
+	assessments, err := s.assessmentRepo.FindByApplicationID(ctx, domain.ApplicationID(applicationID))
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("🕒 Assessment History for %s (%d assessments):\n\n", applicationID, len(assessments))
+	for i, assessment := range assessments {
+		result += fmt.Sprintf("%d. %s by %s (profile: %s)\n   ID: %s\n   Risk: %s | Health: %d/5 | Cost Efficiency: %.0f%%\n   Sign-off: %s\n",
+			i+1, assessment.AssessedAt.Format(time.RFC3339), assessment.Evaluator, assessment.ProfileName, assessment.ID,
+			assessment.RiskLevel, assessment.TechnicalHealth.CodeQuality, assessment.BusinessValue.CostEfficiency,
+			signOffStatus(assessment.SignOff))
+	}
+
+	return s.toolResult(result, assessments)
+}
+
+func (s *MCPServer) compareAssessments(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	applicationID, _ := args["application_id"].(string)
+	fromID, _ := args["from_assessment_id"].(string)
+	toID, _ := args["to_assessment_id"].(string)
+
+	diff, err := s.governanceService.CompareAssessments(ctx, application.CompareAssessmentsCommand{
+		ApplicationID:    domain.ApplicationID(applicationID),
+		FromAssessmentID: fromID,
+		ToAssessmentID:   toID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("🔀 Assessment Diff for %s\n%s → %s\n\n", diff.ApplicationID, diff.FromAssessmentID, diff.ToAssessmentID)
+	if !diff.HasChanges() {
+		result += "No changes\n"
+	}
+	for _, change := range diff.Changes {
+		result += fmt.Sprintf("• %s (%s)\n", change.Description(), change.Direction)
+	}
+	for _, rec := range diff.NewRecommendations {
+		result += fmt.Sprintf("• New recommendation: %s\n", rec.Description)
+	}
+	for _, rec := range diff.ResolvedRecommendations {
+		result += fmt.Sprintf("• Resolved recommendation: %s\n", rec.Description)
+	}
+	for _, breach := range diff.NewSLABreaches {
+		result += fmt.Sprintf("• New SLA breach: %s\n", breach.Description())
+	}
+	for _, breach := range diff.ResolvedSLABreaches {
+		result += fmt.Sprintf("• Resolved SLA breach: %s\n", breach.Description())
+	}
+
+	return s.toolResult(result, diff)
+}
+
+func (s *MCPServer) reviewAssessment(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	applicationID, _ := args["application_id"].(string)
+	assessmentID, _ := args["assessment_id"].(string)
+	reviewer, _ := args["reviewer"].(string)
+	reviewer, err := actorName(ctx, reviewer, "")
+	if err != nil {
+		return nil, err
+	}
+	comments, _ := args["comments"].(string)
+
+	assessment, err := s.governanceService.ReviewAssessment(ctx, application.ReviewAssessmentCommand{
+		ApplicationID: domain.ApplicationID(applicationID),
+		AssessmentID:  assessmentID,
+		Reviewer:      reviewer,
+		Comments:      comments,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("🔎 Assessment %s reviewed by %s\nSign-off: %s\n", assessment.ID, assessment.SignOff.Reviewer, signOffStatus(assessment.SignOff))
+
+	return s.toolResult(result, assessment)
+}
+
+func (s *MCPServer) signOffAssessment(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	applicationID, _ := args["application_id"].(string)
+	assessmentID, _ := args["assessment_id"].(string)
+	approver, _ := args["approver"].(string)
+	approver, err := actorName(ctx, approver, "")
+	if err != nil {
+		return nil, err
+	}
+
+	assessment, err := s.governanceService.SignOffAssessment(ctx, application.SignOffAssessmentCommand{
+		ApplicationID: domain.ApplicationID(applicationID),
+		AssessmentID:  assessmentID,
+		Approver:      approver,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("✅ Assessment %s signed off by %s\nEvaluated by %s, reviewed by %s\n",
+		assessment.ID, assessment.SignOff.Approver, assessment.Evaluator, assessment.SignOff.Reviewer)
+
+	return s.toolResult(result, assessment)
+}
+
+func (s *MCPServer) attachEvidence(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	applicationID, _ := args["application_id"].(string)
+	assessmentID, _ := args["assessment_id"].(string)
+	auditID, _ := args["audit_id"].(string)
+	findingID, _ := args["finding_id"].(string)
+	evidenceID, _ := args["evidence_id"].(string)
+	kind, _ := args["kind"].(string)
+	uri, _ := args["uri"].(string)
+	hash, _ := args["sha256"].(string)
+	title, _ := args["title"].(string)
+	description, _ := args["description"].(string)
+	source, _ := args["source"].(string)
+	attachedBy, _ := args["attached_by"].(string)
+	controls, err := controlList(args["controls"])
+	if err != nil {
+		return nil, err
+	}
+
+	evidence := domain.Evidence{
+		ID:          evidenceID,
+		Kind:        domain.EvidenceKind(kind),
+		Title:       title,
+		URI:         uri,
+		SHA256:      hash,
+		Source:      source,
+		Description: description,
+		AttachedBy:  attachedBy,
+		Controls:    controls,
+	}
+	if value, ok := args["collected_at"].(string); ok && value != "" {
+		parsed, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid collected_at date: %w", err)
+		}
+		evidence.CollectedAt = parsed
+	}
+	if value, ok := args["valid_until"].(string); ok && value != "" {
+		parsed, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid valid_until date: %w", err)
+		}
+		evidence.ValidUntil = parsed
+	}
+
+	var attached *domain.Evidence
+	if auditID != "" {
+		attached, err = s.evidenceService.AttachAuditFindingEvidence(ctx, application.AttachAuditFindingEvidenceCommand{
+			AuditID:   auditID,
+			FindingID: findingID,
+			Evidence:  evidence,
+		})
+	} else {
+		attached, err = s.evidenceService.AttachAssessmentEvidence(ctx, application.AttachAssessmentEvidenceCommand{
+			ApplicationID: domain.ApplicationID(applicationID),
+			AssessmentID:  assessmentID,
+			Evidence:      evidence,
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("📎 Evidence %s attached to %s\n%s: %s\n", attached.ID, attached.Subject, attached.Kind, attached.URI)
+	if attached.SHA256 != "" {
+		result += fmt.Sprintf("SHA-256: %s\n", attached.SHA256)
+	}
+	if !attached.ValidUntil.IsZero() {
+		result += fmt.Sprintf("Valid until: %s\n", attached.ValidUntil.Format("2006-01-02"))
+	}
+	if len(attached.Controls) > 0 {
+		applied, err := s.complianceService.ApplyEvidence(ctx, application.ApplyEvidenceCommand{
+			ApplicationID: domain.ApplicationID(applicationID),
+			EvidenceID:    attached.ID,
+		})
+		if err != nil {
+			result += fmt.Sprintf("⚠️ Compliance status not applied: %v\n", err)
+		}
+		for _, control := range applied {
+			result += formatAppliedControlStatus(control)
+		}
+	}
+
+	return s.toolResult(result, attached)
+}
+
+func (s *MCPServer) expireStaleEvidence(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	cmd := application.ExpireEvidenceCommand{}
+	if value, ok := args["now"].(string); ok && value != "" {
+		parsed, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid now date: %w", err)
+		}
+		cmd.Now = parsed
+	}
+
+	expired, err := s.complianceService.ExpireEvidence(ctx, cmd)
+	if err != nil && len(expired) == 0 {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("⌛ Stale evidence: %d\n", len(expired))
+	for _, item := range expired {
+		result += fmt.Sprintf("\n• %s (%s) expired on %s\n", item.Evidence.ID, item.Evidence.URI, item.Evidence.ValidUntil.Format("2006-01-02"))
+		if len(item.Requirements) == 0 && len(item.Answers) == 0 {
+			result += "  No compliance status rested on it\n"
+		}
+		for _, requirement := range item.Requirements {
+			result += fmt.Sprintf("  🔍 %s of %s back under review\n", requirement, item.AgreementID)
+		}
+		for _, answer := range item.Answers {
+			result += fmt.Sprintf("  🔍 Assessment answer %s back under review\n", answer)
+		}
+	}
+	if err != nil {
+		result += fmt.Sprintf("\n⚠️ %v\n", err)
+	}
+	return s.toolResult(result, expired)
+}
+
+func (s *MCPServer) listEvidence(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	applicationID, _ := args["application_id"].(string)
+	assessmentID, _ := args["assessment_id"].(string)
+	auditID, _ := args["audit_id"].(string)
+
+	if auditID != "" {
+		audit, err := s.evidenceService.GetAuditWithEvidence(ctx, auditID)
+		if err != nil {
+			return nil, err
+		}
+
+		result := fmt.Sprintf("📎 Evidence for audit %s (%s)\n\n", audit.ID, audit.ApplicationID)
+		for _, finding := range audit.Findings {
+			result += fmt.Sprintf("Finding %s: %s\n", finding.ID, finding.Description)
+			result += formatEvidence(finding.Attachments)
+		}
+		return s.toolResult(result, audit)
+	}
+
+	assessment, err := s.evidenceService.GetAssessmentWithEvidence(ctx, domain.ApplicationID(applicationID), assessmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("📎 Evidence for assessment %s of %s\n\n", assessment.ID, assessment.ApplicationID)
+	result += formatEvidence(assessment.Attachments)
+
+	return s.toolResult(result, assessment)
+}
+
+func (s *MCPServer) defineKPI(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	cmd := application.DefineKPICommand{}
+	cmd.ID, _ = args["kpi_id"].(string)
+	cmd.Name, _ = args["name"].(string)
+	cmd.Description, _ = args["description"].(string)
+	cmd.Target, _ = args["target"].(float64)
+	cmd.Unit, _ = args["unit"].(string)
+	cmd.Category, _ = args["category"].(string)
+	cmd.Frequency, _ = args["frequency"].(string)
+	definedBy, _ := args["defined_by"].(string)
+	definedBy, err := actorName(ctx, definedBy, "")
+	if err != nil {
+		return nil, err
+	}
+	cmd.DefinedBy = definedBy
+
+	kpi, err := s.kpiService.DefineKPI(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("🎯 KPI %s defined: %s, target %.2f %s\n", kpi.ID, kpi.Name, kpi.Target, kpi.Unit)
+	return s.toolResult(result, kpi)
+}
+
+func (s *MCPServer) recordKPIMeasurement(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	kpiID, _ := args["kpi_id"].(string)
+	value, _ := args["value"].(float64)
+	notes, _ := args["notes"].(string)
+	recordedBy, _ := args["recorded_by"].(string)
+	recordedBy, err := actorName(ctx, recordedBy, "")
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := application.RecordKPIMeasurementCommand{
+		KPIID:      kpiID,
+		Value:      value,
+		Notes:      notes,
+		RecordedBy: recordedBy,
+	}
+	if measuredAt, ok := args["measured_at"].(string); ok && measuredAt != "" {
+		parsed, err := time.Parse("2006-01-02", measuredAt)
+		if err != nil {
+			return nil, fmt.Errorf("invalid measured_at: %w", err)
+		}
+		cmd.MeasuredAt = parsed
+	}
+
+	measurement, err := s.kpiService.RecordKPIMeasurement(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	status := "❌ Not Achieved"
+	if measurement.Achieved {
+		status = "✅ Achieved"
+	}
+	result := fmt.Sprintf("📏 %s measured %.2f against %.2f on %s %s\n",
+		measurement.KPIID, measurement.Value, measurement.Target, measurement.MeasuredAt.Format("2006-01-02"), status)
+	return s.toolResult(result, measurement)
+}
+
+func (s *MCPServer) listKPIs(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	category, _ := args["category"].(string)
+
+	kpis, err := s.kpiService.ListKPIs(ctx, category)
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("🎯 KPIs (%d):\n", len(kpis))
+	for _, kpi := range kpis {
+		result += fmt.Sprintf("• %s: %s, target %.2f %s [%s]", kpi.ID, kpi.Name, kpi.Target, kpi.Unit, kpi.Status)
+		if kpi.Category != "" {
+			result += fmt.Sprintf(" (%s)", kpi.Category)
+		}
+		result += "\n"
+	}
+	return s.toolResult(result, kpis)
+}
+
+func (s *MCPServer) getKPIHistory(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	kpiID, _ := args["kpi_id"].(string)
+	resolution, _ := args["resolution"].(string)
+
+	var from, to time.Time
+	if value, ok := args["from"].(string); ok && value != "" {
+		parsed, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid from date: %w", err)
+		}
+		from = parsed
+	}
+	if value, ok := args["to"].(string); ok && value != "" {
+		parsed, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid to date: %w", err)
+		}
+		to = parsed.AddDate(0, 0, 1).Add(-time.Nanosecond)
+	}
+
+	history, err := s.kpiService.GetKPIHistory(ctx, kpiID, from, to, domain.KPIResolution(resolution))
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toolResult(formatKPIHistory(history), history)
+}
+
+func (s *MCPServer) detectKPIAnomalies(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	kpiID, _ := args["kpi_id"].(string)
+
+	var from, to time.Time
+	if value, ok := args["from"].(string); ok && value != "" {
+		parsed, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid from date: %w", err)
+		}
+		from = parsed
+	}
+	if value, ok := args["to"].(string); ok && value != "" {
+		parsed, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid to date: %w", err)
+		}
+		to = parsed.AddDate(0, 0, 1).Add(-time.Nanosecond)
+	}
+
+	anomalies, err := s.kpiService.DetectKPIAnomalies(ctx, kpiID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("🔍 KPI Anomalies: %s (%d)\n", kpiID, len(anomalies))
+	if len(anomalies) == 0 {
+		result += "No unusual measurements\n"
+	}
+	result += formatKPIAnomalies(anomalies, "")
+	return s.toolResult(result, anomalies)
+}
+
+func (s *MCPServer) bindTelemetryMetric(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	cmd := application.BindTelemetryMetricCommand{}
+	cmd.KPIID, _ = args["kpi_id"].(string)
+	cmd.Source, _ = args["source"].(string)
+	cmd.Query, _ = args["query"].(string)
+	cmd.Scale, _ = args["scale"].(float64)
+	if value, ok := args["application_id"].(string); ok {
+		cmd.ApplicationID = domain.ApplicationID(value)
+	}
+	if value, ok := args["metric"].(string); ok {
+		cmd.Metric = domain.TelemetryMetric(value)
+	}
+	if value, ok := args["aggregation"].(string); ok {
+		cmd.Aggregation = domain.TelemetryAggregation(value)
+	}
+	if value, ok := args["interval"].(string); ok && value != "" {
+		interval, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid interval: %w", err)
+		}
+		cmd.Interval = interval
+	}
+
+	binding, err := s.telemetryService.BindMetric(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("📡 KPI %s measured from %s %s every %s\n", binding.KPIID, binding.Source, binding.Metric, binding.PullInterval())
+	result += formatTelemetryBindings([]domain.TelemetryBinding{*binding})
+	return s.toolResult(result, binding)
+}
+
+func (s *MCPServer) ingestTelemetry(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	cmd := application.IngestTelemetryCommand{}
+	cmd.KPIID, _ = args["kpi_id"].(string)
+	cmd.Force, _ = args["force"].(bool)
+
+	run, err := s.telemetryService.IngestDue(ctx, cmd)
+	if run == nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("📡 Telemetry: %d measurements, %d without data, %d failed\n", len(run.Measurements), len(run.NoData), len(run.Failed))
+	for _, measurement := range run.Measurements {
+		status := "✅ Achieved"
+		if !measurement.Achieved {
+			status = "❌ Not Achieved"
+		}
+		result += fmt.Sprintf("• %s: %.2f against %.2f %s\n", measurement.KPIID, measurement.Value, measurement.Target, status)
+	}
+	if len(run.NoData) > 0 {
+		result += fmt.Sprintf("No data: %s\n", strings.Join(run.NoData, ", "))
+	}
+	if err != nil {
+		result += fmt.Sprintf("⚠️ %v\n", err)
+	}
+	return s.toolResult(result, run)
+}
+
+func (s *MCPServer) listTelemetryBindings(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	bindings, err := s.telemetryService.ListBindings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sources := s.telemetryService.Sources()
+	result := fmt.Sprintf("📡 Telemetry Bindings (%d)\n", len(bindings))
+	if len(sources) == 0 {
+		result += "No telemetry sources configured: add datadog or cloudwatch under telemetry in the configuration file\n"
+	} else {
+		result += fmt.Sprintf("Sources: %s\n", strings.Join(sources, ", "))
+	}
+	result += formatTelemetryBindings(bindings)
+	return s.toolResult(result, bindings)
+}
+
+func (s *MCPServer) configureAlert(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	agreementID, _ := args["agreement_id"].(string)
+	source, _ := args["source"].(string)
+	subject, _ := args["subject"].(string)
+
+	var thresholds []domain.Threshold
+	warningCondition, _ := args["warning_condition"].(string)
+	if warningCondition == "" {
+		warningCondition = ">"
+	}
+	if value, ok := args["warning_value"].(float64); ok {
+		thresholds = append(thresholds, domain.Threshold{Level: string(domain.AlertWarning), Value: value, Condition: warningCondition})
+	}
+	criticalCondition, _ := args["critical_condition"].(string)
+	if criticalCondition == "" {
+		criticalCondition = warningCondition
+	}
+	if value, ok := args["critical_value"].(float64); ok {
+		thresholds = append(thresholds, domain.Threshold{Level: string(domain.AlertCritical), Value: value, Condition: criticalCondition})
+	}
+
+	var alerts []domain.Alert
+	if recipient, _ := args["recipient"].(string); recipient != "" {
+		alert := domain.Alert{Recipient: recipient}
+		alert.Type, _ = args["alert_type"].(string)
+		alert.Message, _ = args["message"].(string)
+		alert.Escalation, _ = args["escalation"].(string)
+		alerts = append(alerts, alert)
+	}
+
+	err := s.governanceService.ConfigureAlerts(ctx, application.ConfigureAlertsCommand{
+		AgreementID: domain.GovernanceAgreementID(agreementID),
+		Source:      domain.AlertSource(source),
+		Subject:     subject,
+		Thresholds:  thresholds,
+		Alerts:      alerts,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("🔔 Alerts configured on %s %s of %s\n", source, subject, agreementID)
+	for _, threshold := range thresholds {
+		result += fmt.Sprintf("• %s\n", threshold)
+	}
+	for _, alert := range alerts {
+		result += fmt.Sprintf("Notifies: %s\n", alert.Recipient)
+	}
+	return s.toolResult(result, map[string]interface{}{"agreement_id": agreementID, "source": source, "subject": subject, "thresholds": thresholds, "alerts": alerts})
+}
+
+func (s *MCPServer) evaluateAlerts(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	agreementID, _ := args["agreement_id"].(string)
+
+	evaluation, err := s.governanceService.EvaluateAlerts(ctx, application.EvaluateAlertsCommand{
+		AgreementID: domain.GovernanceAgreementID(agreementID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("🔔 Alert Evaluation for %s\n", agreementID)
+	result += formatAlertEvaluation(evaluation, "")
+	return s.toolResult(result, evaluation)
+}
+
+func (s *MCPServer) sendNotifications(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	if s.notificationService == nil {
+		return nil, fmt.Errorf("notifications are not configured: add channels under notifications in the configuration file")
+	}
+	kind, _ := args["kind"].(string)
+	skipEvaluation, _ := args["skip_alert_evaluation"].(bool)
+
+	cmd := s.notifyDueCommand()
+	cmd.EvaluateAlerts = !skipEvaluation
+	if days, ok := args["audit_window_days"].(float64); ok && days > 0 {
+		cmd.AuditWindow = time.Duration(days * 24 * float64(time.Hour))
+	}
+
+	var run *application.NotificationRun
+	var err error
+	switch kind {
+	case "", "all":
+		run, err = s.notificationService.NotifyDue(ctx, cmd)
+	case "alerts":
+		run, err = s.notificationService.NotifyAlerts(ctx, cmd)
+	case "approvals":
+		run, err = s.notificationService.NotifyPendingApprovals(ctx, cmd)
+	case "audits":
+		run, err = s.notificationService.NotifyAuditsDue(ctx, cmd)
+	default:
+		return nil, fmt.Errorf("unknown notification kind: %s", kind)
+	}
+	if run == nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("📣 Notifications: %d sent, %d already sent, %d failed\n", len(run.Sent), run.Skipped, run.Failed)
+	for _, notification := range run.Sent {
+		result += fmt.Sprintf("• [%s] %s: %s", notification.Severity, notification.Kind, notification.Title)
+		if len(notification.Recipients) > 0 {
+			result += fmt.Sprintf(" → %s", strings.Join(notification.Recipients, ", "))
+		}
+		result += "\n"
+	}
+	if err != nil {
+		result += fmt.Sprintf("\n⚠️ %v\n", err)
+	}
+	return s.toolResult(result, run)
+}
+
+func (s *MCPServer) listEventDeadLetters(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	if s.eventBus == nil {
+		return nil, fmt.Errorf("event delivery is not configured: add webhooks or kafka under events in the configuration file")
+	}
+	redeliver, _ := args["redeliver"].(bool)
+
+	result := ""
+	if redeliver {
+		delivered := s.eventBus.Redeliver(ctx)
+		result += fmt.Sprintf("🔁 Redelivered %d event(s)\n\n", delivered)
+	}
+	letters := s.eventBus.DeadLetters()
+	dropped := s.eventBus.DroppedDeadLetters()
+	if dropped > 0 {
+		result += fmt.Sprintf("🗑️ %d older undelivered event(s) dropped to stay within max_dead_letters\n\n", dropped)
+	}
+	if len(letters) == 0 {
+		return s.toolResult(result+"No undelivered events", letters)
+	}
+
+	result += fmt.Sprintf("📭 Undelivered Events (%d):\n", len(letters))
+	for _, letter := range letters {
+		result += fmt.Sprintf("• %s → %s after %d attempt(s) at %s: %s\n", letter.EventType, letter.Subscriber, letter.Attempts, letter.FailedAt.Format(time.RFC3339), letter.Error)
+	}
+	return s.toolResult(result, letters)
+}
+
+func (s *MCPServer) replayEvents(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	if s.eventBus == nil {
+		return nil, fmt.Errorf("event delivery is not configured: add webhooks or kafka under events in the configuration file")
+	}
+	webhook, _ := args["webhook"].(string)
+	since, _ := args["since"].(string)
+	from, err := time.Parse(time.RFC3339, since)
+	if err != nil {
+		return nil, fmt.Errorf("invalid since: %w", err)
+	}
+
+	delivered, err := s.eventBus.ReplayTo(ctx, from, webhook)
+	data := map[string]interface{}{"webhook": webhook, "since": from, "delivered": delivered}
+	if err != nil {
+		if delivered == 0 {
+			return nil, err
+		}
+		data["error"] = err.Error()
+		return s.toolResult(fmt.Sprintf("⚠️ Replayed %d event(s) to %s before failing: %v", delivered, webhook, err), data)
+	}
+	return s.toolResult(fmt.Sprintf("🔁 Replayed %d event(s) since %s to %s", delivered, from.Format(time.RFC3339), webhook), data)
+}
+
+func (s *MCPServer) getApplicationsByRisk(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	level, _ := args["risk_level"].(string)
+
+	applications := s.applicationsByRisk.All()
+	if level != "" {
+		applications = s.applicationsByRisk.ByLevel(domain.RiskLevel(level))
+	}
+	if len(applications) == 0 {
+		return s.toolResult("No evaluated applications", applications)
+	}
+
+	result := fmt.Sprintf("🎯 Applications by Risk Level (%d):\n", len(applications))
+	for _, app := range applications {
+		result += fmt.Sprintf("• [%s] %s: %s quadrant, evaluated %s\n", strings.ToUpper(string(app.RiskLevel)), app.ApplicationID, app.TIMEQuadrant, app.EvaluatedAt.Format("2006-01-02 15:04"))
+	}
+	return s.toolResult(result, applications)
+}
+
+func (s *MCPServer) listProjections(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	result := ""
+	if name, _ := args["rebuild"].(string); name != "" {
+		checkpoint, err := s.projector.Rebuild(ctx, name)
+		if checkpoint == nil {
+			return nil, err
+		}
+		if err != nil {
+			result += fmt.Sprintf("⚠️ Rebuilding %s failed: %v\n\n", name, err)
+		} else {
+			result += fmt.Sprintf("🔁 Rebuilt %s from %d event(s)\n\n", name, checkpoint.Events)
+		}
+	}
+
+	checkpoints, err := s.projector.Checkpoints(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result += fmt.Sprintf("🗂️ Projections (%d):\n", len(checkpoints))
+	for _, checkpoint := range checkpoints {
+		status := "current"
+		if checkpoint.Error != "" {
+			status = "stale: " + checkpoint.Error
+		}
+		position := "no events yet"
+		if !checkpoint.Position.IsZero() {
+			position = "up to " + checkpoint.Position.Format(time.RFC3339)
+		}
+		result += fmt.Sprintf("• %s: %d event(s), %s (%s)\n", checkpoint.Projection, checkpoint.Events, position, status)
+	}
+	return s.toolResult(result, checkpoints)
+}
+
+func (s *MCPServer) getAggregateEvents(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	aggregateID, _ := args["aggregate_id"].(string)
+	aggregateType, _ := args["aggregate_type"].(string)
+
+	events, err := s.eventRepo.FindByAggregateID(ctx, aggregateID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find events: %w", err)
+	}
+	envelopes, err := domain.EnvelopeEvents(events)
+	if err != nil {
+		return nil, err
+	}
+	if aggregateType != "" {
+		filtered := envelopes[:0]
+		for _, envelope := range envelopes {
+			if envelope.AggregateType == aggregateType {
+				filtered = append(filtered, envelope)
+			}
+		}
+		envelopes = filtered
+	}
+	if len(envelopes) == 0 {
+		return s.toolResult(fmt.Sprintf("No events recorded against %s", aggregateID), envelopes)
+	}
+
+	result := fmt.Sprintf("📜 Events of %s (%d):\n", aggregateID, len(envelopes))
+	for _, envelope := range envelopes {
+		result += fmt.Sprintf("%d. %s %s at %s (%s)\n", envelope.Sequence, envelope.AggregateType, envelope.Type, envelope.OccurredAt.Format(time.RFC3339), envelope.ID)
+	}
+	return s.toolResult(result, envelopes)
+}
+
+func (s *MCPServer) configureEscalation(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	agreementID, _ := args["agreement_id"].(string)
+	scope, _ := args["scope"].(string)
+
+	var levels []domain.EscalationLevel
+	entries, _ := args["levels"].([]interface{})
+	for _, entry := range entries {
+		level, _ := entry.(map[string]interface{})
+		number, _ := level["level"].(float64)
+		description, _ := level["description"].(string)
+		var responseTime time.Duration
+		if value, _ := level["response_time"].(string); value != "" {
+			parsed, err := time.ParseDuration(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid response_time of escalation level %d: %w", int(number), err)
+			}
+			responseTime = parsed
+		}
+		levels = append(levels, domain.EscalationLevel{
+			Level:        int(number),
+			Description:  description,
+			ResponseTime: responseTime,
+			Contacts:     stringList(level["contacts"]),
+		})
+	}
+
+	err := s.governanceService.ConfigureEscalation(ctx, application.ConfigureEscalationCommand{
+		AgreementID: domain.GovernanceAgreementID(agreementID),
+		Scope:       domain.EscalationScope(scope),
+		Levels:      levels,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("📶 %s escalation configured for %s\n", scope, agreementID)
+	for _, level := range levels {
+		result += fmt.Sprintf("• Level %d after %s: %s → %s\n", level.Level, level.ResponseTime, level.Description, strings.Join(level.Contacts, ", "))
+	}
+	return s.toolResult(result, map[string]interface{}{"agreement_id": agreementID, "scope": scope, "levels": levels})
+}
+
+func (s *MCPServer) configureIncidentSLAs(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	agreementID, _ := args["agreement_id"].(string)
+
+	var classes []domain.IncidentClass
+	var priorities []domain.IncidentPriority
+	entries, _ := args["severities"].([]interface{})
+	for _, entry := range entries {
+		severity, _ := entry.(map[string]interface{})
+		number, _ := severity["severity"].(float64)
+		name, _ := severity["name"].(string)
+		description, _ := severity["description"].(string)
+		var keywords []string
+		if values, ok := severity["keywords"].([]interface{}); ok {
+			for _, value := range values {
+				if keyword, ok := value.(string); ok && keyword != "" {
+					keywords = append(keywords, keyword)
+				}
+			}
+		}
+		responseTime, err := optionalDuration(severity["response_time"])
+		if err != nil {
+			return nil, fmt.Errorf("invalid response_time of severity %d: %w", int(number), err)
+		}
+		resolutionTime, err := optionalDuration(severity["resolution_time"])
+		if err != nil {
+			return nil, fmt.Errorf("invalid resolution_time of severity %d: %w", int(number), err)
+		}
+		classes = append(classes, domain.IncidentClass{Severity: int(number), Name: name, Description: description, ResponseTime: responseTime, Keywords: keywords})
+		priorities = append(priorities, domain.IncidentPriority{Priority: int(number), Name: name, Description: description, SLA: resolutionTime})
+	}
+
+	err := s.governanceService.ConfigureIncidentSLAs(ctx, application.ConfigureIncidentSLAsCommand{
+		AgreementID: domain.GovernanceAgreementID(agreementID),
+		Classes:     classes,
+		Priorities:  priorities,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("⏱️ Incident SLAs configured for %s\n", agreementID)
+	for i, class := range classes {
+		result += fmt.Sprintf("• Severity %d (%s): respond within %s, resolve within %s\n", class.Severity, class.Name, class.ResponseTime, priorities[i].SLA)
+		if len(class.Keywords) > 0 {
+			result += fmt.Sprintf("  Keywords: %s\n", strings.Join(class.Keywords, ", "))
+		}
+	}
+	return s.toolResult(result, map[string]interface{}{"agreement_id": agreementID, "classes": classes, "priorities": priorities})
+}
+
+// optionalDuration parses a duration argument such as 30m or 4h, zero when it is not given
+func optionalDuration(value interface{}) (time.Duration, error) {
+	text, _ := value.(string)
+	if text == "" {
+		return 0, nil
+	}
+	return time.ParseDuration(text)
+}
+
+func (s *MCPServer) acknowledgeAlert(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	agreementID, _ := args["agreement_id"].(string)
+	alertID, _ := args["alert_id"].(string)
+	acknowledgedBy, _ := args["acknowledged_by"].(string)
+	acknowledgedBy, err := actorName(ctx, acknowledgedBy, "MCP Assistant")
+	if err != nil {
+		return nil, err
+	}
+
+	alert, err := s.governanceService.AcknowledgeAlert(ctx, application.AcknowledgeAlertCommand{
+		AgreementID:    domain.GovernanceAgreementID(agreementID),
+		AlertID:        alertID,
+		AcknowledgedBy: acknowledgedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("✅ Acknowledged %s alert on %s %s\nAcknowledged by: %s\nRaised: %s\n",
+		alert.Severity, alert.Source, alert.Subject, alert.AcknowledgedBy, alert.RaisedAt.Format("2006-01-02 15:04"))
+	return s.toolResult(result, alert)
+}
+
+func (s *MCPServer) escalateDue(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	run, err := s.escalationService.EscalateDue(ctx, application.EscalateDueCommand{})
+	if run == nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("📶 Escalations: %d steps taken, %d notifications failed\n", len(run.Steps), run.Failed)
+	result += formatEscalationSteps(run.Steps, "")
+	if err != nil {
+		result += fmt.Sprintf("\n⚠️ %v\n", err)
+	}
+	return s.toolResult(result, run)
+}
+
+func (s *MCPServer) listEscalations(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	applicationID, _ := args["application_id"].(string)
+
+	steps, err := s.escalationService.ListEscalations(ctx, domain.ApplicationID(applicationID))
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("📶 Escalations of %s: %d\n", applicationID, len(steps))
+	result += formatEscalationSteps(steps, "")
+	return s.toolResult(result, steps)
+}
+
+func (s *MCPServer) generateExecutiveDigest(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	portfolioID, _ := args["portfolio_id"].(string)
+	frequency, _ := args["frequency"].(string)
+	if frequency == "" {
+		frequency = string(domain.DigestWeekly)
+	}
+	send, _ := args["send"].(bool)
+	if send && s.notifier == nil {
+		return nil, fmt.Errorf("notifications are not configured: add channels under notifications in the configuration file")
+	}
+
+	digest, err := s.digestService.GenerateDigest(ctx, application.GenerateDigestCommand{
+		PortfolioID: domain.PortfolioID(portfolioID),
+		Frequency:   domain.DigestFrequency(frequency),
+		Send:        send,
+	})
+	if digest == nil {
+		return nil, err
+	}
+
+	result := formatExecutiveDigest(*digest)
+	if err != nil {
+		result += fmt.Sprintf("\n⚠️ %v\n", err)
+	}
+	return s.toolResult(result, digest)
+}
+
+func (s *MCPServer) listExecutiveDigests(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	portfolioID, _ := args["portfolio_id"].(string)
+
+	digests, err := s.digestService.ListDigests(ctx, domain.PortfolioID(portfolioID))
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("📰 Executive digests of %s: %d\n", portfolioID, len(digests))
+	for _, digest := range digests {
+		sent := "not sent"
+		if !digest.SentAt.IsZero() {
+			sent = "sent to " + strings.Join(digest.Recipients, ", ")
+		}
+		result += fmt.Sprintf("• %s %s (%s), %d challenges, %d recommendations, %s\n", digest.Frequency, digest.Summary.Period, digest.ID,
+			len(digest.Summary.Challenges), len(digest.Summary.Recommendations), sent)
+	}
+	return s.toolResult(result, digests)
+}
+
+func (s *MCPServer) recordDecision(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	cmd := application.RecordDecisionCommand{}
+	cmd.ID, _ = args["decision_id"].(string)
+	cmd.Title, _ = args["title"].(string)
+	cmd.Context, _ = args["context"].(string)
+	cmd.Outcome, _ = args["decision"].(string)
+	cmd.Rationale, _ = args["rationale"].(string)
+	cmd.Consequences, _ = args["consequences"].(string)
+	cmd.Supersedes, _ = args["supersedes"].(string)
+	agreementID, _ := args["agreement_id"].(string)
+	applicationID, _ := args["application_id"].(string)
+	decidedBy, _ := args["decided_by"].(string)
+	decidedBy, err := actorName(ctx, decidedBy, "")
+	if err != nil {
+		return nil, err
+	}
+	cmd.AgreementID = domain.GovernanceAgreementID(agreementID)
+	cmd.ApplicationID = domain.ApplicationID(applicationID)
+	cmd.DecidedBy = decidedBy
+
+	if decidedAt, ok := args["decided_at"].(string); ok && decidedAt != "" {
+		parsed, err := time.Parse("2006-01-02", decidedAt)
+		if err != nil {
+			return nil, fmt.Errorf("invalid decided_at: %w", err)
+		}
+		cmd.DecidedAt = parsed
+	}
+	options, _ := args["options"].([]interface{})
+	for _, entry := range options {
+		option, _ := entry.(map[string]interface{})
+		name, _ := option["name"].(string)
+		description, _ := option["description"].(string)
+		cmd.Options = append(cmd.Options, domain.DecisionOption{
+			Name:        name,
+			Description: description,
+			Pros:        stringList(option["pros"]),
+			Cons:        stringList(option["cons"]),
+		})
+	}
+
+	decision, err := s.decisionService.RecordDecision(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	result := "📜 Decision recorded\n\n" + formatDecision(*decision)
+	return s.toolResult(result, decision)
+}
+
+func (s *MCPServer) listDecisions(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	agreementID, _ := args["agreement_id"].(string)
+	applicationID, _ := args["application_id"].(string)
+	currentOnly, _ := args["current_only"].(bool)
+
+	decisions, err := s.decisionService.ListDecisions(ctx, application.ListDecisionsCommand{
+		AgreementID:   domain.GovernanceAgreementID(agreementID),
+		ApplicationID: domain.ApplicationID(applicationID),
+		CurrentOnly:   currentOnly,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("📜 Decision Log (%d decisions):\n\n", len(decisions))
+	for _, decision := range decisions {
+		result += fmt.Sprintf("• %s %s: %s [%s] by %s", decision.DecidedAt.Format("2006-01-02"), decision.ID, decision.Title, decision.Status, decision.DecidedBy)
+		if decision.SupersededBy != "" {
+			result += fmt.Sprintf(", superseded by %s", decision.SupersededBy)
+		}
+		result += "\n"
+	}
+
+	return s.toolResult(result, decisions)
+}
+
+func (s *MCPServer) getDecision(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	decisionID, _ := args["decision_id"].(string)
+
+	decision, err := s.decisionService.GetDecision(ctx, decisionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toolResult(formatDecision(*decision), decision)
+}
+
+func (s *MCPServer) exportTimeline(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	agreementID, _ := args["agreement_id"].(string)
+	applicationID, _ := args["application_id"].(string)
+	format, _ := args["format"].(string)
+
+	write := map[string]func(*bytes.Buffer, domain.Timeline) error{
+		"ical":       func(out *bytes.Buffer, timeline domain.Timeline) error { return export.WriteICalendar(out, timeline) },
+		"gantt_json": func(out *bytes.Buffer, timeline domain.Timeline) error { return export.WriteGanttJSON(out, timeline) },
+		"gantt_csv":  func(out *bytes.Buffer, timeline domain.Timeline) error { return export.WriteGanttCSV(out, timeline) },
+	}
+	if format == "" {
+		format = "ical"
+	}
+	writer, ok := write[format]
+	if !ok {
+		return nil, fmt.Errorf("unknown format %q, expected ical, gantt_json or gantt_csv", format)
+	}
+
+	timeline, err := s.timelineService.GetTimeline(ctx, application.GetTimelineCommand{
+		AgreementID:   domain.GovernanceAgreementID(agreementID),
+		ApplicationID: domain.ApplicationID(applicationID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var out bytes.Buffer
+	if err := writer(&out, *timeline); err != nil {
+		return nil, fmt.Errorf("failed to export timeline: %w", err)
+	}
+
+	// The export is returned as is, whatever the output format
+	return s.toolResult(out.String(), nil)
+}
+
+func (s *MCPServer) generateComplianceReport(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	agreementID, _ := args["agreement_id"].(string)
+	portfolioID, _ := args["portfolio_id"].(string)
+	title, _ := args["title"].(string)
+	format, _ := args["format"].(string)
+	if format == "" {
+		format = "markdown"
+	}
+	if format != "markdown" && format != "pdf" {
+		return nil, fmt.Errorf("unknown format %q, expected markdown or pdf", format)
+	}
+
+	report, err := s.complianceReportService.GenerateReport(ctx, application.GenerateComplianceReportCommand{
+		AgreementID: domain.GovernanceAgreementID(agreementID),
+		PortfolioID: domain.PortfolioID(portfolioID),
+		Title:       title,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var out bytes.Buffer
+	if format == "pdf" {
+		if err := export.WriteComplianceReportPDF(&out, *report); err != nil {
+			return nil, fmt.Errorf("failed to write compliance report: %w", err)
+		}
+		// Tool results are text, so the PDF is returned base64 encoded
+		return s.toolResult(base64.StdEncoding.EncodeToString(out.Bytes()), nil)
+	}
+	if err := export.WriteComplianceReportMarkdown(&out, *report); err != nil {
+		return nil, fmt.Errorf("failed to write compliance report: %w", err)
+	}
+	return s.toolResult(out.String(), nil)
+}
+
+func (s *MCPServer) analyzeTrends(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	applicationID, _ := args["application_id"].(string)
+	portfolioID, _ := args["portfolio_id"].(string)
+
+	opts := domain.DefaultTrendOptions()
+	if days, ok := args["window_days"].(float64); ok {
+		opts.Window = time.Duration(days * float64(24*time.Hour))
+	}
+	if days, ok := args["projection_days"].(float64); ok {
+		opts.ProjectionHorizon = time.Duration(days * float64(24*time.Hour))
+	}
+
+	switch {
+	case applicationID != "":
+		trend, err := s.trendService.AnalyzeApplication(ctx, domain.ApplicationID(applicationID), opts)
+		if err != nil {
+			return nil, err
+		}
+
+		result := fmt.Sprintf("📈 Trend Analysis for %s:\n\n", applicationID)
+		result += formatMetricTrend(trend.TechnicalHealth)
+		result += formatMetricTrend(trend.BusinessValue)
+		result += formatMetricTrend(trend.Risk)
+		if trend.CurrentRisk != "" {
+			result += fmt.Sprintf("\n🎯 Risk: %s now, projected %s\n", trend.CurrentRisk, trend.ProjectedRisk)
+		}
+		return s.toolResult(result, trend)
+	case portfolioID != "":
+		trend, err := s.trendService.AnalyzePortfolio(ctx, domain.PortfolioID(portfolioID), opts)
+		if err != nil {
+			return nil, err
+		}
+
+		result := fmt.Sprintf("📈 Trend Analysis for portfolio %s:\n\n", portfolioID)
+		result += formatMetricTrend(trend.TechnicalHealth)
+		result += formatMetricTrend(trend.BusinessValue)
+		result += formatMetricTrend(trend.Risk)
+		result += fmt.Sprintf("\n🎯 Applications by risk trend: %d improving, %d degrading, %d stable\n", trend.Improving, trend.Degrading, trend.Stable)
+		return s.toolResult(result, trend)
+	default:
+		return nil, fmt.Errorf("application_id or portfolio_id is required")
+	}
+}
+
+// formatMetricTrend renders one metric line of a trend report
+func formatMetricTrend(trend domain.MetricTrend) string {
+	return fmt.Sprintf("• %s: %s (%d samples, %.1f → %.1f, projected %.1f)\n",
+		trend.Metric, trend.Direction, trend.SampleCount, trend.First, trend.Latest, trend.Projected)
+}
+
+func (s *MCPServer) prioritizeRecommendations(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	portfolioID, _ := args["portfolio_id"].(string)
+	limit, _ := args["limit"].(float64)
+
+	weights := domain.DefaultPrioritizationWeights()
+	if weight, ok := args["business_impact_weight"].(float64); ok {
+		weights.BusinessImpact = weight
+	}
+	if weight, ok := args["risk_reduction_weight"].(float64); ok {
+		weights.RiskReduction = weight
+	}
+	if weight, ok := args["effort_weight"].(float64); ok {
+		weights.Effort = weight
+	}
+
+	backlog, err := s.prioritizationService.PrioritizePortfolio(ctx, domain.PortfolioID(portfolioID), weights)
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("🗂️ Remediation Backlog for %s (%d items, %.0f hours):\n\n", backlog.PortfolioID, len(backlog.Items), backlog.TotalEffort.Hours())
+	for _, item := range backlog.Items {
+		if limit > 0 && item.Rank > int(limit) {
+			result += fmt.Sprintf("... and %d more\n", len(backlog.Items)-int(limit))
+			break
+		}
+		result += fmt.Sprintf("%d. [%.1f] %s: %s\n   %s priority, %.0fh, impact %.0f%%, risk reduction %.0f%%\n",
+			item.Rank, item.Score, item.ApplicationID, item.Recommendation.Description,
+			item.Recommendation.Priority, item.Effort.Hours(), item.BusinessImpact*100, item.RiskReduction*100)
+	}
+	if len(backlog.Unassessed) > 0 {
+		result += fmt.Sprintf("\n⚠️ Not yet assessed: %s\n", joinApplicationIDs(backlog.Unassessed))
+	}
+
+	return s.toolResult(result, backlog)
+}
+
+func (s *MCPServer) comparePortfolios(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	comparison, err := s.comparisonService.ComparePortfolios(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("🏁 Portfolio Comparison (%d portfolios, $%.0fk/year):\n", len(comparison.Portfolios), comparison.TotalCost/1000)
+	result += fmt.Sprintf("Average: health %.0f/100, %.0f%% high or critical risk, %.0f%% governed\n\n",
+		comparison.AverageHealthIndex, comparison.AverageHighRiskShare, comparison.AverageGovernanceCoverage)
+	for _, summary := range comparison.Portfolios {
+		result += fmt.Sprintf("%d. %s (%s): health %.0f/100, $%.0fk/year, %d/%d governed (%.0f%%)\n",
+			summary.Rank, summary.Name, summary.PortfolioID, summary.HealthIndex, summary.TotalCost/1000,
+			summary.GovernedApplications, summary.Applications, summary.GovernanceCoverage)
+		result += fmt.Sprintf("   Risk mix: %d critical, %d high, %d medium, %d low\n",
+			summary.RiskMix[domain.RiskCritical], summary.RiskMix[domain.RiskHigh], summary.RiskMix[domain.RiskMedium], summary.RiskMix[domain.RiskLow])
+		for _, reason := range summary.Lagging {
+			result += fmt.Sprintf("   ⚠️ %s\n", reason)
+		}
+	}
+	if lagging := comparison.Lagging(); len(lagging) > 0 {
+		result += fmt.Sprintf("\n📉 Lagging most: %s\n", lagging[0].Name)
+	}
+	if len(comparison.Unevaluated) > 0 {
+		ids := make([]string, len(comparison.Unevaluated))
+		for i, id := range comparison.Unevaluated {
+			ids[i] = string(id)
+		}
+		result += fmt.Sprintf("\n⚠️ Could not evaluate: %s\n", strings.Join(ids, ", "))
+	}
+
+	return s.toolResult(result, comparison)
+}
+
+func (s *MCPServer) assessGovernanceMaturity(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	agreementID, _ := args["agreement_id"].(string)
+	if agreementID == "" {
+		return nil, fmt.Errorf("agreement_id is required")
+	}
+
+	maturity, err := s.maturityService.AssessAgreement(ctx, domain.GovernanceAgreementID(agreementID))
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("🏛️ Governance Maturity for %s: level %d (%s)\n\n", agreementID, maturity.MaturityLevel, domain.MaturityLevelName(maturity.MaturityLevel))
+	for _, principle := range maturity.Principles {
+		result += fmt.Sprintf("• %s: level %d (%s)\n", principle.Principle, principle.Level, domain.MaturityLevelName(principle.Level))
+	}
+	if len(maturity.Strengths) > 0 {
+		result += "\n✅ Strengths:\n"
+		for _, strength := range maturity.Strengths {
+			result += fmt.Sprintf("• %s\n", strength)
+		}
+	}
+	if len(maturity.Weaknesses) > 0 {
+		result += "\n⚠️ Weaknesses:\n"
+		for _, weakness := range maturity.Weaknesses {
+			result += fmt.Sprintf("• %s\n", weakness)
+		}
+	}
+	if len(maturity.ImprovementAreas) > 0 {
+		result += "\n🎯 Next steps:\n"
+		for _, area := range maturity.ImprovementAreas {
+			result += fmt.Sprintf("• %s\n", area)
+		}
+	}
+	return s.toolResult(result, maturity)
+}
+
+func (s *MCPServer) getPrincipleScorecard(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	agreementID, _ := args["agreement_id"].(string)
+	if agreementID == "" {
+		return nil, fmt.Errorf("agreement_id is required")
+	}
+
+	agreement, err := s.govRepo.FindByID(ctx, domain.GovernanceAgreementID(agreementID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find agreement: %w", err)
+	}
+
+	scorecard := domain.ComputePrincipleScorecard(agreement)
+	result := fmt.Sprintf("📋 ISO 38500 Principle Scorecard for %s: %.0f/100\n\n", agreementID, scorecard.OverallScore)
+	for _, score := range scorecard.Scores {
+		result += fmt.Sprintf("• %s: %.0f/100\n", score.Principle, score.Score)
+		for _, gap := range score.Gaps {
+			result += fmt.Sprintf("    - %s\n", gap)
+		}
+	}
+	return s.toolResult(result, scorecard)
+}
+
+func (s *MCPServer) recordAvailabilityMeasurement(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	id, _ := args["id"].(string)
+	applicationID, _ := args["application_id"].(string)
+	uptime, _ := args["uptime_percentage"].(float64)
+	responseTimeMs, _ := args["response_time_ms"].(float64)
+	source, _ := args["source"].(string)
+
+	cmd := application.RecordAvailabilityMeasurementCommand{
+		ID:               id,
+		ApplicationID:    domain.ApplicationID(applicationID),
+		UptimePercentage: uptime,
+		ResponseTime:     time.Duration(responseTimeMs * float64(time.Millisecond)),
+		Source:           source,
+	}
+	if start, ok := args["period_start"].(string); ok && start != "" {
+		parsed, err := time.Parse(time.RFC3339, start)
+		if err != nil {
+			return nil, fmt.Errorf("invalid period_start: %w", err)
+		}
+		cmd.PeriodStart = parsed
+	}
+	if end, ok := args["period_end"].(string); ok && end != "" {
+		parsed, err := time.Parse(time.RFC3339, end)
+		if err != nil {
+			return nil, fmt.Errorf("invalid period_end: %w", err)
+		}
+		cmd.PeriodEnd = parsed
+	}
+
+	measurement, breaches, err := s.serviceLevelService.RecordMeasurement(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	text := fmt.Sprintf("📡 Recorded availability for %s: %.2f%% uptime, %s response time\n",
+		measurement.ApplicationID, measurement.UptimePercentage, measurement.ResponseTime)
+	if len(breaches) == 0 {
+		text += "✅ Within the declared SLA"
+	} else {
+		text += fmt.Sprintf("🚨 %d SLA breach(es):", len(breaches))
+		for _, breach := range breaches {
+			text += fmt.Sprintf("\n• %s", breach.Description())
+		}
+	}
+	return s.toolResult(text, map[string]interface{}{
+		"measurement": measurement,
+		"breaches":    breaches,
+	})
+}
+
+func (s *MCPServer) getErrorBudgets(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	applicationID, _ := args["application_id"].(string)
+
+	budgets, err := s.serviceLevelService.GetErrorBudgets(ctx, domain.ApplicationID(applicationID))
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("⏱️ Error Budgets for %s\n\n", applicationID)
+	if len(budgets) == 0 {
+		result += "No availability or response time SLA declared\n"
+	}
+	result += formatErrorBudgets(budgets, "")
+	return s.toolResult(result, budgets)
+}
+
+func (s *MCPServer) recordApplicationMetrics(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	applicationID, _ := args["application_id"].(string)
+	activeUsers, _ := args["active_users"].(float64)
+	transactionVolume, _ := args["transaction_volume"].(float64)
+	uptime, _ := args["uptime_percentage"].(float64)
+	satisfaction, _ := args["user_satisfaction"].(float64)
+	surveyResponses, _ := args["survey_responses"].(float64)
+	source, _ := args["source"].(string)
+
+	if _, err := s.appRepo.FindByID(ctx, domain.ApplicationID(applicationID)); err != nil {
+		return nil, fmt.Errorf("application not found: %w", err)
+	}
+
+	metrics := domain.ApplicationMetrics{
+		ApplicationID:     domain.ApplicationID(applicationID),
+		ActiveUsers:       int(activeUsers),
+		TransactionVolume: int(transactionVolume),
+		UptimePercentage:  uptime,
+		UserSatisfaction:  satisfaction,
+		SurveyResponses:   int(surveyResponses),
+		Source:            source,
+		ObservedAt:        time.Now(),
+	}
+	if err := metrics.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.metricsProvider.Save(ctx, metrics); err != nil {
+		return nil, fmt.Errorf("failed to save metrics: %w", err)
+	}
+
+	text := fmt.Sprintf("📈 Recorded metrics for %s", metrics.ApplicationID)
+	if metrics.Source != "" {
+		text += fmt.Sprintf(" from %s", metrics.Source)
+	}
+	text += fmt.Sprintf("\nActive users: %d, transactions: %d", metrics.ActiveUsers, metrics.TransactionVolume)
+	if metrics.UptimePercentage > 0 {
+		text += fmt.Sprintf(", uptime: %.2f%%", metrics.UptimePercentage)
+	}
+	if metrics.SurveyResponses > 0 {
+		text += fmt.Sprintf("\nUser satisfaction: %.0f%% from %d survey responses", metrics.UserSatisfaction, metrics.SurveyResponses)
+	}
+	return s.toolResult(text, metrics)
+}
+
+func (s *MCPServer) recordTechnicalDebt(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	id, _ := args["id"].(string)
+	applicationID, _ := args["application_id"].(string)
+	title, _ := args["title"].(string)
+	description, _ := args["description"].(string)
+	category, _ := args["category"].(string)
+	principal, _ := args["principal_hours"].(float64)
+	interest, _ := args["interest_hours_per_month"].(float64)
+	plan, _ := args["remediation_plan"].(string)
+
+	item, err := s.debtService.RecordDebt(ctx, domain.TechnicalDebtItem{
+		ID:                    id,
+		ApplicationID:         domain.ApplicationID(applicationID),
+		Title:                 title,
+		Description:           description,
+		Category:              domain.TechnicalDebtCategory(category),
+		PrincipalHours:        principal,
+		InterestHoursPerMonth: interest,
+		RemediationPlan:       plan,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	text := fmt.Sprintf("🧾 Recorded technical debt %s for %s: %s\nCategory: %s\nPrincipal: %.0f hours\nInterest: %.0f hours/month",
+		item.ID, item.ApplicationID, item.Title, item.Category, item.PrincipalHours, item.InterestHoursPerMonth)
+	return s.toolResult(text, item)
+}
+
+func (s *MCPServer) resolveTechnicalDebt(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	id, _ := args["id"].(string)
+
+	item, err := s.debtService.ResolveDebt(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	text := fmt.Sprintf("✅ Resolved technical debt %s for %s: %s", item.ID, item.ApplicationID, item.Title)
+	return s.toolResult(text, item)
+}
+
+func (s *MCPServer) registerTechnologyComponent(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	agreementID, _ := args["agreement_id"].(string)
+	kind, _ := args["kind"].(string)
+	name, _ := args["name"].(string)
+	version, _ := args["version"].(string)
+	vendor, _ := args["vendor"].(string)
+
+	component := domain.TechnologyComponent{
+		Kind:    domain.TechnologyKind(kind),
+		Name:    name,
+		Version: version,
+		Vendor:  vendor,
+	}
+	if date, ok := args["end_of_support"].(string); ok && date != "" {
+		parsed, err := time.Parse("2006-01-02", date)
+		if err != nil {
+			return nil, fmt.Errorf("invalid end_of_support: %w", err)
+		}
+		component.EndOfSupport = parsed
+	}
+
+	err := s.governanceService.RegisterTechnologyComponent(ctx, application.RegisterTechnologyComponentCommand{
+		AgreementID: domain.GovernanceAgreementID(agreementID),
+		Component:   component,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	text := fmt.Sprintf("🧩 Registered %s %s on %s", component.Kind, component.Label(), agreementID)
+	if !component.EndOfSupport.IsZero() {
+		text += fmt.Sprintf("\nEnd of Support: %s", component.EndOfSupport.Format("2006-01-02"))
+	}
+	return s.toolResult(text, component)
+}
+
+func (s *MCPServer) forecastEndOfLife(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	portfolioID, _ := args["portfolio_id"].(string)
+	horizonDays, _ := args["horizon_days"].(float64)
+
+	forecast, err := s.governanceService.ForecastObsolescence(ctx, application.ForecastObsolescenceCommand{
+		PortfolioID: domain.PortfolioID(portfolioID),
+		Horizon:     time.Duration(horizonDays * float64(24*time.Hour)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("⏳ End of Support Forecast for %s (next %.0f days, %d components):\n\n",
+		forecast.PortfolioID, forecast.Horizon.Hours()/24, len(forecast.Entries))
+	for _, entry := range forecast.Entries {
+		result += fmt.Sprintf("• %s: %s [%s, %s risk]\n", entry.ApplicationID, entry.Finding.Description(), entry.Finding.Status, entry.Finding.RiskLevel)
+	}
+
+	return s.toolResult(result, forecast)
+}
+
+func (s *MCPServer) getAlignmentMatrix(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	portfolioID, _ := args["portfolio_id"].(string)
+
+	matrix, err := s.governanceService.AssessStrategicAlignment(ctx, application.AssessStrategicAlignmentCommand{
+		PortfolioID: domain.PortfolioID(portfolioID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("🧭 Strategic Alignment for %s (%d applications, %d objectives):\n\n",
+		matrix.PortfolioID, len(matrix.Applications), len(matrix.Objectives))
+	for _, objective := range matrix.Objectives {
+		result += fmt.Sprintf("• %s (%s): %.0f%% delivered by %d applications\n",
+			objective.ObjectiveID, objective.AgreementID, objective.Weight*100, objective.Applications)
+	}
+	result += "\nBy application:\n"
+	for _, app := range matrix.Applications {
+		contributions := []string{}
+		for column, weight := range app.Weights {
+			if weight > 0 {
+				contributions = append(contributions, fmt.Sprintf("%s %.0f%%", matrix.Objectives[column].ObjectiveID, weight*100))
+			}
+		}
+		if len(contributions) == 0 {
+			result += fmt.Sprintf("• %s: ⚠️ supports no strategic objective\n", app.ApplicationID)
+			continue
+		}
+		result += fmt.Sprintf("• %s: %s\n", app.ApplicationID, strings.Join(contributions, ", "))
+	}
+
+	return s.toolResult(result, matrix)
+}
+
+func (s *MCPServer) getTechnicalDebt(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	applicationID, _ := args["application_id"].(string)
+	portfolioID, _ := args["portfolio_id"].(string)
+
+	switch {
+	case applicationID != "":
+		summary, err := s.debtService.SummarizeApplication(ctx, domain.ApplicationID(applicationID))
+		if err != nil {
+			return nil, err
+		}
+		result := fmt.Sprintf("🧾 Technical Debt for %s:\n\n", applicationID)
+		result += formatDebtSummary(*summary)
+		return s.toolResult(result, summary)
+	case portfolioID != "":
+		rollup, err := s.debtService.RollUpPortfolio(ctx, domain.PortfolioID(portfolioID))
+		if err != nil {
+			return nil, err
+		}
+		result := fmt.Sprintf("🧾 Technical Debt for portfolio %s:\n\n", portfolioID)
+		result += formatDebtSummary(rollup.Total)
+		result += "\nBy application:\n"
+		for _, app := range rollup.Applications {
+			result += fmt.Sprintf("• %s: %d open items, %.0f hours\n", app.ApplicationID, app.Summary.OpenItems, app.Summary.PrincipalHours)
+		}
+		return s.toolResult(result, rollup)
+	default:
+		return nil, fmt.Errorf("application_id or portfolio_id is required")
+	}
+}
+
+// formatDebtSummary renders the totals of a technical debt summary
+func formatDebtSummary(summary domain.TechnicalDebtSummary) string {
+	result := fmt.Sprintf("• Open items: %d (%d resolved)\n", summary.OpenItems, summary.ResolvedItems)
+	result += fmt.Sprintf("• Principal: %.0f hours\n", summary.PrincipalHours)
+	result += fmt.Sprintf("• Interest: %.0f hours/month\n", summary.InterestHoursPerMonth)
+	if summary.OpenItems > 0 {
+		result += fmt.Sprintf("• Largest category: %s\n", summary.LargestCategory())
+	}
+	return result
+}
+
+// evaluationProfile resolves the optional "profile" argument to a built-in evaluation profile
+func evaluationProfile(args map[string]interface{}) (*domain.EvaluationProfile, error) {
+	name, _ := args["profile"].(string)
+	switch name {
+	case "":
+		return nil, nil
+	case "default":
+		profile := domain.DefaultEvaluationProfile()
+		return &profile, nil
+	case "regulated":
+		profile := domain.RegulatedEvaluationProfile()
+		return &profile, nil
+	default:
+		return nil, fmt.Errorf("unknown evaluation profile: %s", name)
+	}
+}
+
+func (s *MCPServer) monitorGovernance(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	agreementID, _ := args["agreement_id"].(string)
+
+	monitoringResult, err := s.governanceService.MonitorGovernance(ctx, application.MonitorGovernanceCommand{
+		AgreementID: domain.GovernanceAgreementID(agreementID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("📊 Governance Monitoring Results:\n\n")
+
+	// Display KPI results
+	result += fmt.Sprintf("📈 Key Performance Indicators (%d):\n", len(monitoringResult.KPIMeasurements))
+	for i, kpi := range monitoringResult.KPIMeasurements {
+		status := "❌ Not Achieved"
+		if kpi.Achieved {
+			status = "✅ Achieved"
+		}
+		result += fmt.Sprintf("   %d. %s: %.1f/%.1f %s\n", i+1, kpi.KPIID, kpi.Value, kpi.Target, status)
+	}
+
+	// Display KPI anomalies
+	if len(monitoringResult.KPIAnomalies) > 0 {
+		result += fmt.Sprintf("\n🔍 KPI Anomalies (%d):\n", len(monitoringResult.KPIAnomalies))
+		result += formatKPIAnomalies(monitoringResult.KPIAnomalies, "   ")
+	}
+
+	// Display error budgets
+	if len(monitoringResult.ErrorBudgets) > 0 {
+		result += "\n⏱️ Error Budgets:\n"
+		result += formatErrorBudgets(monitoringResult.ErrorBudgets, "   ")
+	}
+
+	// Display operational metrics
+	if operations := monitoringResult.Operations; operations != nil && operations.Incidents > 0 {
+		result += "\n📟 Operations (last 90 days):\n"
+		result += formatOperationalMetrics(operations, "   ")
+	}
+
+	// Display the changes likely causing incidents
+	if changeFailures := monitoringResult.ChangeFailures; changeFailures != nil && changeFailures.Implemented > 0 {
+		result += "\n🔀 Change Failures (last 90 days):\n"
+		result += formatChangeFailureRate(changeFailures, "   ")
+	}
+
+	// Display the reviews of high-severity incidents
+	if reviews := monitoringResult.PostIncidentReviews; reviews != nil && reviews.Required > 0 {
+		result += "\n📝 Post-Incident Reviews (last 90 days):\n"
+		result += fmt.Sprintf("   • %d of %d completed (%.0f%%)\n", reviews.Completed, reviews.Required, reviews.Completion)
+		if len(reviews.Pending) > 0 {
+			result += fmt.Sprintf("   • Awaiting review: %s\n", strings.Join(reviews.Pending, ", "))
+		}
+		if reviews.OpenActions > 0 {
+			result += fmt.Sprintf("   • Open action items: %d (%d overdue)\n", reviews.OpenActions, reviews.OverdueActions)
+		}
+	}
+
+	// Display monitor plugin collections
+	if len(monitoringResult.Plugins) > 0 {
+		result += "\n🔌 Monitor Plugins:\n"
+		for _, plugin := range monitoringResult.Plugins {
+			result += fmt.Sprintf("   • %s: %d measurements, %d risk indicators\n", plugin.Plugin, len(plugin.Measurements), len(plugin.RiskIndicators))
+			if !plugin.Succeeded() {
+				result += fmt.Sprintf("     ⚠️ %s\n", plugin.Err)
+			}
+		}
+	}
+
+	// Display portfolio KPI scoreboards
+	for _, scoreboard := range monitoringResult.PortfolioKPIs {
+		result += fmt.Sprintf("\n📊 %s KPI Scoreboard:\n", scoreboard.Name)
+		result += formatKPIScoreboard(scoreboard, "   ")
+	}
+
+	// Display risk results
+	result += fmt.Sprintf("\n🎯 Risk Indicators (%d):\n", len(monitoringResult.RiskStatus.RiskIndicators))
+	for i, risk := range monitoringResult.RiskStatus.RiskIndicators {
+		statusEmoji := "✅"
+		if risk.Status == domain.RiskStatusWarning {
+			statusEmoji = "⚠️"
+		} else if risk.Status == domain.RiskStatusCritical {
+			statusEmoji = "🚨"
+		}
+		result += fmt.Sprintf("   %d. %s: %.1f (threshold: %.1f) %s\n",
+			i+1, risk.Name, risk.Value, risk.Threshold, statusEmoji)
+	}
+
+	// Display risk heat maps
+	for _, heatMap := range monitoringResult.RiskStatus.RiskHeatMaps {
+		result += fmt.Sprintf("\n🔥 %s (%d risks):\n", heatMap.Name, heatMap.Total)
+		result += formatRiskHeatMap(heatMap, "   ")
+	}
+
+	// Display compliance drift
+	if drift := monitoringResult.ComplianceDrift; drift.HasDrift() {
+		result += "\n🧭 Compliance Drift:\n"
+		result += formatComplianceDrift(*drift, "   ")
+	}
+
+	// Display alerts
+	if alerts := monitoringResult.Alerts; len(alerts.Active) > 0 || len(alerts.Resolved) > 0 {
+		result += "\n🔔 Alerts:\n"
+		result += formatAlertEvaluation(alerts, "   ")
+	}
+
+	// Display objective KPI coverage
+	coverage := monitoringResult.ObjectiveCoverage
+	result += fmt.Sprintf("\n🧭 Objective KPI Coverage: %d objectives, %d/%d linked KPIs measured\n",
+		coverage.Objectives, coverage.MeasuredKPIs(), coverage.LinkedKPIs)
+	for _, objectiveID := range coverage.UnlinkedObjectives {
+		result += fmt.Sprintf("   ⚠️ %s has no linked KPIs\n", objectiveID)
+	}
+	for _, ref := range coverage.UnknownKPIs {
+		result += fmt.Sprintf("   ⚠️ %s links unknown KPI %s\n", ref.ObjectiveID, ref.KPIID)
+	}
+	for _, ref := range coverage.UnmeasuredKPIs {
+		result += fmt.Sprintf("   ⚠️ %s: KPI %s has no measurements\n", ref.ObjectiveID, ref.KPIID)
+	}
+
+	// Display initiative progress
+	progress := monitoringResult.InitiativeProgress
+	result += fmt.Sprintf("\n🚧 Initiative Progress: %.0f%% overall, %d delayed or at risk\n", progress.PercentComplete, progress.Delayed)
+	for _, objective := range progress.Objectives {
+		result += fmt.Sprintf("   🎯 %s: %.0f%% (%d initiatives)\n", objective.Name, objective.PercentComplete, objective.Initiatives)
+	}
+	for _, initiative := range progress.Initiatives {
+		result += fmt.Sprintf("   • %s: %.0f%% %s, %d/%d milestones\n",
+			initiative.Name, initiative.PercentComplete, initiative.Status, initiative.MilestonesCompleted, initiative.Milestones)
+		if len(initiative.OverdueMilestones) > 0 {
+			result += fmt.Sprintf("     ⏰ Overdue: %s\n", strings.Join(initiative.OverdueMilestones, ", "))
+		}
+	}
+
+	// Display OKR progress
+	if len(monitoringResult.OKRs) > 0 {
+		result += "\n🎯 OKRs:\n"
+		for _, okr := range monitoringResult.OKRs {
+			result += formatOKRProgress(okr, "   ")
+		}
+	}
+
+	// Display budget consumption
+	budget := monitoringResult.BudgetStatus
+	result += fmt.Sprintf("\n💰 Budget: $%.0f of $%.0f spent\n", budget.Spent, budget.Allocated)
+	for _, allocation := range budget.Allocations {
+		result += fmt.Sprintf("   • %s\n", formatBudgetConsumption(allocation))
+	}
+
+	return s.toolResult(result, monitoringResult)
+}
+
+func (s *MCPServer) draftPolicy(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	agreementID, _ := args["agreement_id"].(string)
+	policyID, _ := args["policy_id"].(string)
+	name, _ := args["name"].(string)
+	description, _ := args["description"].(string)
+	scope, _ := args["scope"].(string)
+	owner, _ := args["owner"].(string)
+	draftedBy, _ := args["drafted_by"].(string)
+	draftedBy, err := actorName(ctx, draftedBy, "")
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := s.governanceService.DraftPolicy(ctx, application.DraftPolicyCommand{
+		AgreementID: domain.GovernanceAgreementID(agreementID),
+		Policy: domain.Policy{
+			ID:          policyID,
+			Name:        name,
+			Description: description,
+			Scope:       scope,
+			Owner:       owner,
+		},
+		DraftedBy: draftedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toolResult(formatPolicy(policy), policy)
+}
+
+func (s *MCPServer) submitPolicy(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	agreementID, _ := args["agreement_id"].(string)
+	policyID, _ := args["policy_id"].(string)
+	submittedBy, _ := args["submitted_by"].(string)
+	submittedBy, err := actorName(ctx, submittedBy, "")
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := s.governanceService.SubmitPolicy(ctx, application.SubmitPolicyCommand{
+		AgreementID: domain.GovernanceAgreementID(agreementID),
+		PolicyID:    policyID,
+		SubmittedBy: submittedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toolResult(formatPolicy(policy), policy)
+}
+
+func (s *MCPServer) approvePolicy(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	agreementID, _ := args["agreement_id"].(string)
+	policyID, _ := args["policy_id"].(string)
+	approvedBy, _ := args["approved_by"].(string)
+	approvedBy, err := actorName(ctx, approvedBy, "")
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := s.governanceService.ApprovePolicy(ctx, application.ApprovePolicyCommand{
+		AgreementID: domain.GovernanceAgreementID(agreementID),
+		PolicyID:    policyID,
+		ApprovedBy:  approvedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toolResult(formatPolicy(policy), policy)
+}
+
+func (s *MCPServer) publishPolicy(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	agreementID, _ := args["agreement_id"].(string)
+	policyID, _ := args["policy_id"].(string)
+
+	cmd := application.PublishPolicyCommand{
+		AgreementID: domain.GovernanceAgreementID(agreementID),
+		PolicyID:    policyID,
+	}
+	if date, ok := args["effective_from"].(string); ok && date != "" {
+		parsed, err := time.Parse("2006-01-02", date)
+		if err != nil {
+			return nil, fmt.Errorf("invalid effective_from: %w", err)
+		}
+		cmd.EffectiveFrom = parsed
+	}
+	if date, ok := args["effective_until"].(string); ok && date != "" {
+		parsed, err := time.Parse("2006-01-02", date)
+		if err != nil {
+			return nil, fmt.Errorf("invalid effective_until: %w", err)
+		}
+		cmd.EffectiveUntil = parsed
+	}
+
+	policy, err := s.governanceService.PublishPolicy(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toolResult(formatPolicy(policy), policy)
+}
+
+func (s *MCPServer) retirePolicy(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	agreementID, _ := args["agreement_id"].(string)
+	policyID, _ := args["policy_id"].(string)
+	reason, _ := args["reason"].(string)
+
+	policy, err := s.governanceService.RetirePolicy(ctx, application.RetirePolicyCommand{
+		AgreementID: domain.GovernanceAgreementID(agreementID),
+		PolicyID:    policyID,
+		Reason:      reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toolResult(formatPolicy(policy), policy)
+}
+
+func (s *MCPServer) revisePolicy(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	agreementID, _ := args["agreement_id"].(string)
+	policyID, _ := args["policy_id"].(string)
+	changedBy, _ := args["changed_by"].(string)
+	changedBy, err := actorName(ctx, changedBy, "")
+	if err != nil {
+		return nil, err
+	}
+	summary, _ := args["summary"].(string)
+
+	agreement, err := s.governanceService.GetGovernanceAgreement(ctx, domain.GovernanceAgreementID(agreementID))
+	if err != nil {
+		return nil, err
+	}
+	policy, ok := findPolicy(agreement.Direct.PolicyFramework.Policies, policyID)
+	if !ok {
+		return nil, fmt.Errorf("policy %s not found in agreement %s", policyID, agreementID)
+	}
+
+	// Fields left out keep their current value
+	if name, ok := args["name"].(string); ok {
+		policy.Name = name
+	}
+	if description, ok := args["description"].(string); ok {
+		policy.Description = description
+	}
+	if scope, ok := args["scope"].(string); ok {
+		policy.Scope = scope
+	}
+	if owner, ok := args["owner"].(string); ok {
+		policy.Owner = owner
+	}
+
+	version, err := s.governanceService.RevisePolicy(ctx, application.RevisePolicyCommand{
+		AgreementID: domain.GovernanceAgreementID(agreementID),
+		Policy:      policy,
+		ChangedBy:   changedBy,
+		Summary:     summary,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toolResult(formatDocumentVersion(*version), version)
+}
+
+func (s *MCPServer) getDocumentHistory(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	agreementID, _ := args["agreement_id"].(string)
+	kind, _ := args["kind"].(string)
+	documentID, _ := args["document_id"].(string)
+
+	history, err := s.governanceService.GetDocumentHistory(ctx, domain.GovernanceAgreementID(agreementID), domain.DocumentKind(kind), documentID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("🗂️ History of %s %s (%d versions):\n", kind, documentID, len(history))
+	agreement, err := s.governanceService.GetGovernanceAgreement(ctx, domain.GovernanceAgreementID(agreementID))
+	if err == nil {
+		if pinned, ok := agreement.Direct.PolicyFramework.PinnedVersion(domain.DocumentKind(kind), documentID); ok {
+			result += fmt.Sprintf("📌 Agreement pinned to v%d\n", pinned)
+		}
+	}
+	for _, version := range history {
+		result += "\n" + formatDocumentVersion(version)
+	}
+
+	return s.toolResult(result, history)
+}
+
+func (s *MCPServer) pinDocumentVersion(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	agreementID, _ := args["agreement_id"].(string)
+	kind, _ := args["kind"].(string)
+	documentID, _ := args["document_id"].(string)
+	version, _ := args["version"].(float64)
+	pinnedBy, err := actorName(ctx, "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.governanceService.PinDocumentVersion(ctx, application.PinDocumentVersionCommand{
+		AgreementID: domain.GovernanceAgreementID(agreementID),
+		Kind:        domain.DocumentKind(kind),
+		DocumentID:  documentID,
+		Version:     int(version),
+		PinnedBy:    pinnedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("📌 %s pinned to %s %s v%d\n", agreementID, kind, documentID, int(version))
+	if version == 0 {
+		result = fmt.Sprintf("📌 %s now follows the current version of %s %s\n", agreementID, kind, documentID)
+	}
+
+	return s.toolResult(result, map[string]interface{}{"agreement_id": agreementID, "kind": kind, "document_id": documentID, "version": int(version)})
+}
+
+func (s *MCPServer) mapRequirement(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	agreementID, _ := args["agreement_id"].(string)
+	requirementKind, _ := args["requirement_kind"].(string)
+	requirement, _ := args["requirement"].(string)
+	kind, _ := args["kind"].(string)
+	documentID, _ := args["document_id"].(string)
+	notes, _ := args["notes"].(string)
+	mappedBy, _ := args["mapped_by"].(string)
+	mappedBy, err := actorName(ctx, mappedBy, "")
+	if err != nil {
+		return nil, err
+	}
+
+	mapping, err := s.governanceService.MapRequirement(ctx, application.MapRequirementCommand{
+		AgreementID:  domain.GovernanceAgreementID(agreementID),
+		Requirement:  domain.RequirementRef{Kind: domain.RequirementKind(requirementKind), Name: requirement},
+		DocumentKind: domain.DocumentKind(kind),
+		DocumentID:   documentID,
+		Notes:        notes,
+		MappedBy:     mappedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("🔗 %s %s mapped to %s requirement\n", mapping.DocumentKind, mapping.DocumentID, mapping.Requirement)
+	return s.toolResult(result, mapping)
+}
+
+func (s *MCPServer) unmapRequirement(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	agreementID, _ := args["agreement_id"].(string)
+	requirementKind, _ := args["requirement_kind"].(string)
+	requirement, _ := args["requirement"].(string)
+	kind, _ := args["kind"].(string)
+	documentID, _ := args["document_id"].(string)
+
+	ref := domain.RequirementRef{Kind: domain.RequirementKind(requirementKind), Name: requirement}
+	err := s.governanceService.UnmapRequirement(ctx, application.UnmapRequirementCommand{
+		AgreementID:  domain.GovernanceAgreementID(agreementID),
+		Requirement:  ref,
+		DocumentKind: domain.DocumentKind(kind),
+		DocumentID:   documentID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("✂️ %s %s no longer mapped to %s requirement\n", kind, documentID, ref)
+	return s.toolResult(result, map[string]interface{}{"agreement_id": agreementID, "requirement": ref, "kind": kind, "document_id": documentID})
+}
+
+func (s *MCPServer) getRequirementCoverage(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	agreementID, _ := args["agreement_id"].(string)
+
+	coverage, err := s.governanceService.GetRequirementCoverage(ctx, domain.GovernanceAgreementID(agreementID))
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("📋 Requirement Coverage for %s\n\n", agreementID)
+	result += fmt.Sprintf("Implemented: %d of %d requirements (%.0f%%)\n", coverage.Implemented(), len(coverage.Requirements), coverage.CoverageRatio()*100)
+	for _, requirement := range coverage.Requirements {
+		icon := "✅"
+		switch {
+		case len(requirement.Mappings) == 0:
+			icon = "❌"
+		case !requirement.Implemented():
+			icon = "⏳"
+		}
+		result += fmt.Sprintf("\n%s %s [%s]\n", icon, requirement.Requirement, requirement.Status)
+		for _, mapping := range requirement.Mappings {
+			result += fmt.Sprintf("   • %s %s", mapping.DocumentKind, mapping.DocumentID)
+			if mapping.Notes != "" {
+				result += ": " + mapping.Notes
+			}
+			result += "\n"
+		}
+	}
+	if coverage.HasGaps() {
+		result += "\n"
+	}
+	if len(coverage.Unmapped) > 0 {
+		result += fmt.Sprintf("No implementing document: %d\n", len(coverage.Unmapped))
+	}
+	if len(coverage.NotInEffect) > 0 {
+		result += fmt.Sprintf("Implementing documents not in effect: %d\n", len(coverage.NotInEffect))
+	}
+
+	return s.toolResult(result, coverage)
+}
+
+func (s *MCPServer) recordComplianceStatus(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	agreementID, _ := args["agreement_id"].(string)
+	requirementKind, _ := args["requirement_kind"].(string)
+	requirement, _ := args["requirement"].(string)
+	status, _ := args["status"].(string)
+
+	ref := domain.RequirementRef{Kind: domain.RequirementKind(requirementKind), Name: requirement}
+	err := s.governanceService.RecordComplianceStatus(ctx, application.RecordComplianceStatusCommand{
+		AgreementID: domain.GovernanceAgreementID(agreementID),
+		Requirement: ref,
+		Status:      domain.ComplianceStatus(status),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("📋 %s requirement of %s is now %s\n", ref, agreementID, status)
+	return s.toolResult(result, map[string]string{"agreement_id": agreementID, "requirement_kind": requirementKind, "requirement": requirement, "status": status})
+}
+
+func (s *MCPServer) getApplicationCompliance(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	applicationID, _ := args["application_id"].(string)
+
+	score, err := s.governanceService.GetApplicationCompliance(ctx, domain.ApplicationID(applicationID))
+	if err != nil {
+		return nil, err
+	}
+	if score == nil {
+		return s.toolResult(fmt.Sprintf("⚖️ No requirements registered for %s\n", applicationID), nil)
+	}
+
+	result := fmt.Sprintf("⚖️ Compliance of %s: %d requirements registered\n", applicationID,
+		score.Compliant+score.Partial+score.NonCompliant+score.UnderReview)
+	if score.Assessed() {
+		result += fmt.Sprintf("• %.0f%% weighted by criticality (%d compliant, %d partial, %d non-compliant)\n",
+			score.Percentage, score.Compliant, score.Partial, score.NonCompliant)
+		for _, kind := range score.Kinds {
+			result += fmt.Sprintf("   ↳ %s: %.0f%%\n", kind.Kind, kind.Percentage)
+		}
+	}
+	if score.UnderReview > 0 {
+		result += fmt.Sprintf("• %d requirements under review, not scored\n", score.UnderReview)
+	}
+	for _, gap := range score.Gaps {
+		result += fmt.Sprintf("• ❌ %s\n", gap.Requirement)
+	}
+	return s.toolResult(result, score)
+}
+
+func (s *MCPServer) setRequirementExpiry(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	agreementID, _ := args["agreement_id"].(string)
+	requirementKind, _ := args["requirement_kind"].(string)
+	requirement, _ := args["requirement"].(string)
+	expiresAt, _ := args["expires_at"].(string)
+
+	cmd := application.SetRequirementExpiryCommand{
+		AgreementID: domain.GovernanceAgreementID(agreementID),
+		Requirement: domain.RequirementRef{Kind: domain.RequirementKind(requirementKind), Name: requirement},
+	}
+	if expiresAt != "" {
+		parsed, err := time.Parse("2006-01-02", expiresAt)
+		if err != nil {
+			return nil, fmt.Errorf("invalid expires_at date: %w", err)
+		}
+		cmd.ExpiresAt = parsed
+	}
+	if err := s.complianceService.SetRequirementExpiry(ctx, cmd); err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("📅 %s requirement of %s no longer expires\n", cmd.Requirement, agreementID)
+	if expiresAt != "" {
+		result = fmt.Sprintf("📅 %s requirement of %s expires on %s\n", cmd.Requirement, agreementID, expiresAt)
+	}
+	return s.toolResult(result, map[string]string{"agreement_id": agreementID, "requirement_kind": requirementKind, "requirement": requirement, "expires_at": expiresAt})
+}
+
+func (s *MCPServer) checkRequirementExpiry(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	agreementID, _ := args["agreement_id"].(string)
+
+	cmd := application.CheckRequirementExpiryCommand{
+		AgreementID: domain.GovernanceAgreementID(agreementID),
+		LeadDays:    s.config.ExpiryWarningDays,
+	}
+	if value, ok := args["now"].(string); ok && value != "" {
+		parsed, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid now date: %w", err)
+		}
+		cmd.Now = parsed
+	}
+
+	checks, err := s.complianceService.CheckRequirementExpiry(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("📅 Certification and contract expiry: %d agreements\n", len(checks))
+	for _, check := range checks {
+		result += fmt.Sprintf("\n%s (%s)\n", check.AgreementID, check.ApplicationID)
+		for _, expiry := range check.Requirements {
+			icon := "✅"
+			switch expiry.State {
+			case domain.ExpiryExpiring:
+				icon = "⚠️"
+			case domain.ExpiryExpired:
+				icon = "❌"
+			}
+			result += fmt.Sprintf("%s %s (%s, %s): %s on %s, %d days left, %s\n", icon, expiry.Requirement.Name, expiry.Requirement.Kind, expiry.Party,
+				expiry.State, expiry.ExpiresAt.Format("2006-01-02"), expiry.DaysToExpiry, expiry.Status)
+		}
+		for _, expiry := range check.Downgraded {
+			result += fmt.Sprintf("  ⬇️ %s was %s and is now non_compliant\n", expiry.Requirement, expiry.Status)
+		}
+	}
+	return s.toolResult(result, checks)
+}
+
+func (s *MCPServer) listComplianceFrameworks(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	frameworkID, _ := args["framework_id"].(string)
+
+	if frameworkID != "" {
+		framework, err := s.complianceService.GetFramework(ctx, frameworkID)
+		if err != nil {
+			return nil, err
+		}
+		return s.toolResult(formatComplianceFramework(*framework), framework)
+	}
+
+	frameworks, err := s.complianceService.ListFrameworks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("📚 Compliance frameworks: %d\n", len(frameworks))
+	for _, framework := range frameworks {
+		result += fmt.Sprintf("• %s (%s): %s %s, %d requirements\n", framework.Name, framework.ID, framework.Issuer, framework.Kind, len(framework.Requirements))
+	}
+	return s.toolResult(result, frameworks)
+}
+
+func (s *MCPServer) listControlMappings(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	mappings, err := s.complianceService.ListControlMappings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("🔗 Control mappings: %d\n", len(mappings))
+	for _, mapping := range mappings {
+		controls := make([]string, len(mapping.Controls))
+		for i, control := range mapping.Controls {
+			controls[i] = control.FrameworkID + ":" + control.RequirementID
+		}
+		result += fmt.Sprintf("• %s (%s): %s\n", mapping.Name, mapping.ID, strings.Join(controls, ", "))
+	}
+	return s.toolResult(result, mappings)
+}
+
+func (s *MCPServer) applyControlStatus(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	applicationID, _ := args["application_id"].(string)
+	value, _ := args["control"].(string)
+	status, _ := args["status"].(string)
+	source, _ := args["source"].(string)
+
+	control, err := domain.ParseControlRef(value)
+	if err != nil {
+		return nil, err
+	}
+	applied, err := s.complianceService.ApplyControlStatus(ctx, application.ApplyControlStatusCommand{
+		ApplicationID: domain.ApplicationID(applicationID),
+		Control:       control,
+		Status:        domain.ComplianceStatus(status),
+		Source:        source,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.toolResult(formatAppliedControlStatus(*applied), applied)
+}
+
+func (s *MCPServer) importOSCALCatalog(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	catalog, _ := args["catalog"].(string)
+	cmd := application.ImportOSCALCatalogCommand{Catalog: strings.NewReader(catalog)}
+	cmd.FrameworkID, _ = args["framework_id"].(string)
+	kind, _ := args["kind"].(string)
+	cmd.Kind = domain.RequirementKind(kind)
+
+	framework, err := s.complianceService.ImportOSCALCatalog(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make(map[string]bool)
+	for _, requirement := range framework.Requirements {
+		groups[requirement.Group] = true
+	}
+	result := fmt.Sprintf("📚 Imported %s (%s) with %d controls in %d groups\n", framework.Name, framework.ID, len(framework.Requirements), len(groups))
+	if framework.Version != "" {
+		result += fmt.Sprintf("   Version: %s\n", framework.Version)
+	}
+	return s.toolResult(result, framework)
+}
+
+func (s *MCPServer) importOSCALAssessmentResults(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	applicationID, _ := args["application_id"].(string)
+	frameworkID, _ := args["framework_id"].(string)
+	results, _ := args["results"].(string)
+
+	imported, err := s.complianceService.ImportOSCALAssessmentResults(ctx, application.ImportOSCALAssessmentResultsCommand{
+		ApplicationID: domain.ApplicationID(applicationID),
+		FrameworkID:   frameworkID,
+		Results:       strings.NewReader(results),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("📥 Imported %d control statuses into %s's agreement\n", len(imported.Applied), applicationID)
+	if imported.Title != "" {
+		result += fmt.Sprintf("   From: %s\n", imported.Title)
+	}
+	if len(imported.Attached) > 0 {
+		result += fmt.Sprintf("   Attached %d requirements the agreement did not have\n", len(imported.Attached))
+	}
+	for _, applied := range imported.Applied {
+		result += formatAppliedControlStatus(applied)
+	}
+	return s.toolResult(result, imported)
+}
+
+func (s *MCPServer) startComplianceAssessment(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	cmd := application.StartComplianceAssessmentCommand{Requirements: stringList(args["requirements"])}
+	cmd.ID, _ = args["assessment_id"].(string)
+	agreementID, _ := args["agreement_id"].(string)
+	cmd.AgreementID = domain.GovernanceAgreementID(agreementID)
+	cmd.FrameworkID, _ = args["framework_id"].(string)
+	cmd.Assessor, _ = args["assessor"].(string)
+
+	assessment, err := s.complianceService.StartAssessment(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+	return s.toolResult(formatComplianceAssessment(*assessment), assessment)
+}
+
+func (s *MCPServer) answerComplianceControl(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	cmd := application.AnswerComplianceControlCommand{EvidenceLinks: stringList(args["evidence"])}
+	cmd.AssessmentID, _ = args["assessment_id"].(string)
+	cmd.RequirementID, _ = args["requirement_id"].(string)
+	status, _ := args["status"].(string)
+	cmd.Status = domain.ComplianceStatus(status)
+	cmd.NotApplicable, _ = args["not_applicable"].(bool)
+	cmd.Notes, _ = args["notes"].(string)
+	cmd.AnsweredBy, _ = args["answered_by"].(string)
+
+	assessment, err := s.complianceService.AnswerControl(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	answer, _ := assessment.Answer(cmd.RequirementID)
+	found := string(answer.Status)
+	if answer.NotApplicable {
+		found = "not applicable"
+	}
+	result := fmt.Sprintf("✍️ %s %s: %s\n", assessment.FrameworkName, cmd.RequirementID, found)
+	result += fmt.Sprintf("📊 Compliance: %.1f%%, %d controls pending\n", assessment.Score.Percentage, len(assessment.Pending()))
+	return s.toolResult(result, assessment)
+}
+
+func (s *MCPServer) completeComplianceAssessment(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	assessmentID, _ := args["assessment_id"].(string)
+
+	assessment, err := s.complianceService.CompleteAssessment(ctx, assessmentID)
+	if err != nil {
+		return nil, err
+	}
+	result := formatComplianceAssessment(*assessment)
+	result += fmt.Sprintf("\n📋 Conformance of %s updated with the statuses found\n", assessment.AgreementID)
+	return s.toolResult(result, assessment)
+}
+
+func (s *MCPServer) getComplianceAssessment(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	assessmentID, _ := args["assessment_id"].(string)
+
+	assessment, err := s.complianceService.GetAssessment(ctx, assessmentID)
+	if err != nil {
+		return nil, err
+	}
+	return s.toolResult(formatComplianceAssessment(*assessment), assessment)
+}
+
+func (s *MCPServer) listComplianceAssessments(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	agreementID, _ := args["agreement_id"].(string)
+
+	assessments, err := s.complianceService.ListAssessments(ctx, domain.GovernanceAgreementID(agreementID))
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("🧾 Compliance assessments of %s: %d\n", agreementID, len(assessments))
+	for _, assessment := range assessments {
+		result += fmt.Sprintf("• %s (%s): %s, %.1f%% compliant, %d/%d controls answered\n", assessment.FrameworkName, assessment.ID,
+			assessment.Status, assessment.Score.Percentage, len(assessment.Controls)-assessment.Score.Unanswered, len(assessment.Controls))
+	}
+	return s.toolResult(result, assessments)
+}
+
+func (s *MCPServer) attachFrameworkRequirements(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	agreementID, _ := args["agreement_id"].(string)
+	frameworkID, _ := args["framework_id"].(string)
+
+	attached, err := s.complianceService.AttachFramework(ctx, application.AttachFrameworkCommand{
+		AgreementID:  domain.GovernanceAgreementID(agreementID),
+		FrameworkID:  frameworkID,
+		Requirements: stringList(args["requirements"]),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("📋 Attached %d %s requirements to %s\n", len(attached), frameworkID, agreementID)
+	if len(attached) == 0 {
+		result += "   The agreement already has every requirement selected\n"
+	}
+	for _, ref := range attached {
+		result += fmt.Sprintf("   • %s (%s)\n", ref.Name, domain.ComplianceUnderReview)
+	}
+	return s.toolResult(result, attached)
+}
+
+func (s *MCPServer) createSurvey(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	agreementID, _ := args["agreement_id"].(string)
+	survey := domain.Survey{Questions: stringList(args["questions"])}
+	survey.ID, _ = args["survey_id"].(string)
+	survey.Name, _ = args["name"].(string)
+	survey.Frequency, _ = args["frequency"].(string)
+	scale, _ := args["scale"].(float64)
+	survey.Scale = int(scale)
+	invited, _ := args["invited"].(float64)
+	survey.Invited = int(invited)
+
+	created, err := s.governanceService.CreateSurvey(ctx, application.CreateSurveyCommand{
+		AgreementID: domain.GovernanceAgreementID(agreementID),
+		Survey:      survey,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("📋 Opened survey %s (%s) for %s\n", created.Name, created.ID, agreementID)
+	for i, question := range created.Questions {
+		result += fmt.Sprintf("   %s. %s (1-%d)\n", domain.SurveyQuestionID(i), question, created.ScaleOrDefault())
+	}
+	return s.toolResult(result, created)
+}
+
+func (s *MCPServer) recordSurveyResponse(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	agreementID, _ := args["agreement_id"].(string)
+	surveyID, _ := args["survey_id"].(string)
+	respondent, _ := args["respondent"].(string)
+
+	var answers []domain.SurveyResponse
+	entries, _ := args["answers"].([]interface{})
+	for _, entry := range entries {
+		fields, _ := entry.(map[string]interface{})
+		answer := domain.SurveyResponse{}
+		answer.QuestionID, _ = fields["question_id"].(string)
+		answer.Response, _ = fields["comment"].(string)
+		score, _ := fields["score"].(float64)
+		answer.Score = int(score)
+		answers = append(answers, answer)
+	}
+
+	surveyResult, err := s.governanceService.RecordSurveyResponse(ctx, application.RecordSurveyResponseCommand{
+		AgreementID: domain.GovernanceAgreementID(agreementID),
+		SurveyID:    surveyID,
+		Respondent:  respondent,
+		Answers:     answers,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("📝 Recorded %d answers to survey %s\n", len(answers), surveyID)
+	result += formatSurveySummary(surveyResult.Summary, "   ")
+	return s.toolResult(result, surveyResult)
+}
+
+func (s *MCPServer) closeSurvey(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	agreementID, _ := args["agreement_id"].(string)
+	surveyID, _ := args["survey_id"].(string)
+
+	surveyResult, err := s.governanceService.CloseSurvey(ctx, application.CloseSurveyCommand{
+		AgreementID: domain.GovernanceAgreementID(agreementID),
+		SurveyID:    surveyID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("🔒 Closed survey %s\n", surveyID)
+	result += formatSurveySummary(surveyResult.Summary, "   ")
+	return s.toolResult(result, surveyResult)
+}
+
+func (s *MCPServer) getSurveyResults(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	agreementID, _ := args["agreement_id"].(string)
+
+	surveys, results, err := s.governanceService.GetSurveyResults(ctx, application.GetSurveyResultsCommand{
+		AgreementID: domain.GovernanceAgreementID(agreementID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("📋 Stakeholder Surveys of %s (%d):\n", agreementID, len(surveys))
+	for i, survey := range surveys {
+		status := survey.Status
+		if status == "" {
+			status = domain.SurveyOpen
+		}
+		result += fmt.Sprintf("\n• %s (%s, %s)\n", survey.Name, survey.ID, status)
+		result += formatSurveySummary(results[i].Summary, "   ")
+	}
+	return s.toolResult(result, map[string]interface{}{"surveys": surveys, "results": results})
+}
+
+func (s *MCPServer) detectComplianceDrift(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	agreementID, _ := args["agreement_id"].(string)
+
+	reports, err := s.governanceService.DetectComplianceDrift(ctx, application.DetectComplianceDriftCommand{
+		AgreementID: domain.GovernanceAgreementID(agreementID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("🧭 Compliance drift across %d agreements\n", len(reports))
+	for _, report := range reports {
+		if report.BaselineAt.IsZero() {
+			result += fmt.Sprintf("\n%s: baseline recorded\n", report.AgreementID)
+			continue
+		}
+		if !report.HasDrift() {
+			continue
+		}
+		result += fmt.Sprintf("\n%s (since %s):\n", report.AgreementID, report.BaselineAt.Format("2006-01-02 15:04"))
+		result += formatComplianceDrift(report, "   ")
+	}
+
+	return s.toolResult(result, reports)
+}
+
+func (s *MCPServer) detectComplianceViolations(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	agreementID, _ := args["agreement_id"].(string)
+
+	reports, err := s.complianceViolationService.DetectViolations(ctx, application.DetectComplianceViolationsCommand{
+		AgreementID: domain.GovernanceAgreementID(agreementID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("🚨 Compliance violations across %d agreements\n", len(reports))
+	for _, report := range reports {
+		if len(report.Violations) == 0 && len(report.Resolved) == 0 {
+			continue
+		}
+		result += fmt.Sprintf("\n%s: %d violations, %d new, %d resolved\n", report.AgreementID, len(report.Violations), len(report.New), len(report.Resolved))
+		isNew := make(map[string]bool, len(report.New))
+		for _, violation := range report.New {
+			isNew[violation.ID] = true
+		}
+		for _, violation := range report.Violations {
+			marker := ""
+			if isNew[violation.ID] {
+				marker = " (new)"
+			}
+			result += fmt.Sprintf("   • [%s] %s%s: %s\n", violation.Severity, violation.Rule, marker, violation.Description)
+			result += fmt.Sprintf("     ➜ %s\n", violation.Remediation)
+		}
+		for _, id := range report.Resolved {
+			result += fmt.Sprintf("   ✅ resolved %s\n", id)
+		}
+	}
+
+	return s.toolResult(result, reports)
+}
+
+func (s *MCPServer) launchAttestationCampaign(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	cmd := application.LaunchAttestationCampaignCommand{Owners: make(map[domain.ApplicationID]string)}
+	cmd.ID, _ = args["campaign_id"].(string)
+	cmd.Name, _ = args["name"].(string)
+	portfolioID, _ := args["portfolio_id"].(string)
+	cmd.PortfolioID = domain.PortfolioID(portfolioID)
+	for _, appID := range stringList(args["application_ids"]) {
+		cmd.ApplicationIDs = append(cmd.ApplicationIDs, domain.ApplicationID(appID))
+	}
+	for _, scope := range stringList(args["scopes"]) {
+		cmd.Scopes = append(cmd.Scopes, domain.AttestationScope(scope))
+	}
+	owners, _ := args["owners"].(map[string]interface{})
+	for appID, owner := range owners {
+		cmd.Owners[domain.ApplicationID(appID)], _ = owner.(string)
+	}
+	frequency, _ := args["frequency"].(string)
+	cmd.Frequency = domain.AttestationFrequency(frequency)
+	if days, ok := args["response_days"].(float64); ok {
+		cmd.ResponseWindow = time.Duration(days * float64(24*time.Hour))
+	}
+	launchedBy, _ := args["launched_by"].(string)
+	launchedBy, err := actorName(ctx, launchedBy, "")
+	if err != nil {
+		return nil, err
+	}
+	cmd.LaunchedBy = launchedBy
+
+	campaign, err := s.attestationService.LaunchCampaign(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("🖊️ Launched attestation campaign %s: %s\n", campaign.ID, campaign.Name)
+	result += fmt.Sprintf("   %d attestations due %s\n", len(campaign.Attestations), campaign.DueAt.Format("2006-01-02"))
+	if campaign.Frequency != "" {
+		result += fmt.Sprintf("   Repeats %s, next round from %s\n", campaign.Frequency, campaign.NextRoundAt().Format("2006-01-02"))
+	}
+	listed := make(map[domain.ApplicationID]bool)
+	for _, attestation := range campaign.Attestations {
+		if !listed[attestation.ApplicationID] {
+			listed[attestation.ApplicationID] = true
+			result += fmt.Sprintf("   • %s (%s): %s\n", attestation.ApplicationName, attestation.ApplicationID, attestation.Owner)
+		}
+	}
+	return s.toolResult(result, campaign)
+}
+
+func (s *MCPServer) signOffAttestation(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	campaignID, _ := args["campaign_id"].(string)
+	applicationID, _ := args["application_id"].(string)
+	scope, _ := args["scope"].(string)
+	attester, _ := args["attester"].(string)
+	attester, err := actorName(ctx, attester, "")
+	if err != nil {
+		return nil, err
+	}
+	comment, _ := args["comment"].(string)
+	accurate, ok := args["accurate"].(bool)
+	if !ok {
+		accurate = true
+	}
+
+	answered, err := s.attestationService.SignOff(ctx, application.SignOffAttestationCommand{
+		CampaignID:    campaignID,
+		ApplicationID: domain.ApplicationID(applicationID),
+		Scope:         domain.AttestationScope(scope),
+		Attester:      attester,
+		Confirmed:     accurate,
+		Comment:       comment,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("✅ %s signed off %d attestations of %s in %s\n", answered[0].Owner, len(answered), applicationID, campaignID)
+	for _, attestation := range answered {
+		result += fmt.Sprintf("   • %s: %s\n", attestation.Scope, attestation.Status)
+	}
+	if !accurate {
+		result += fmt.Sprintf("   Disputed: %s\n", comment)
+	}
+	return s.toolResult(result, answered)
+}
+
+func (s *MCPServer) closeAttestationCampaign(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	campaignID, _ := args["campaign_id"].(string)
+
+	campaign, err := s.attestationService.CloseCampaign(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+
+	dashboard := domain.BuildAttestationDashboard(*campaign, campaign.ClosedAt)
+	result := fmt.Sprintf("🔒 Closed attestation campaign %s\n", campaign.ID)
+	result += fmt.Sprintf("   %s\n", formatAttestationProgress(dashboard.Progress))
+	return s.toolResult(result, dashboard)
+}
+
+func (s *MCPServer) remindAttestationOwners(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	started, err := s.attestationService.StartDueRounds(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := ""
+	for _, campaign := range started {
+		result += fmt.Sprintf("🖊️ Started round %d of %s as %s, due %s\n", campaign.Round, campaign.Name, campaign.ID, campaign.DueAt.Format("2006-01-02"))
+	}
+
+	cmd := application.SendAttestationRemindersCommand{}
+	if hours, ok := args["remind_after_hours"].(float64); ok {
+		cmd.RemindAfter = time.Duration(hours * float64(time.Hour))
+	}
+	run, err := s.attestationService.SendReminders(ctx, cmd)
+	if run == nil {
+		return nil, err
+	}
+	result += fmt.Sprintf("📨 Reminded %d owners (%d reminded recently, %d failed)\n", len(run.Sent), run.Skipped, run.Failed)
+	for _, notification := range run.Sent {
+		result += fmt.Sprintf("   • [%s] %s: %s\n", notification.Severity, strings.Join(notification.Recipients, ", "), notification.Title)
+	}
+	if err != nil {
+		result += fmt.Sprintf("   ⚠️ %v\n", err)
+	}
+	return s.toolResult(result, run)
+}
+
+func (s *MCPServer) getAttestationDashboard(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	campaignID, _ := args["campaign_id"].(string)
+
+	dashboards, err := s.attestationService.GetDashboard(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("📊 Attestation dashboard: %d campaign rounds\n", len(dashboards))
+	for _, dashboard := range dashboards {
+		result += fmt.Sprintf("\n%s (%s, round %d) %s, due %s", dashboard.Name, dashboard.CampaignID, dashboard.Round, dashboard.Status, dashboard.DueAt.Format("2006-01-02"))
+		if dashboard.Overdue {
+			result += " ⚠️ overdue"
+		}
+		result += fmt.Sprintf("\n   %s\n", formatAttestationProgress(dashboard.Progress))
+		for _, scope := range dashboard.Scopes {
+			result += fmt.Sprintf("   %s: %.0f%%\n", scope.Scope, scope.Completion*100)
+		}
+		for _, owner := range dashboard.Owners {
+			result += fmt.Sprintf("   👤 %s: %d of %d signed off\n", owner.Owner, owner.Confirmed+owner.Disputed, owner.Total)
+		}
+		for _, attestation := range dashboard.Disputed {
+			result += fmt.Sprintf("   ❗ %s %s disputed by %s: %s\n", attestation.ApplicationID, attestation.Scope, attestation.Owner, attestation.Comment)
+		}
+		for _, attestation := range dashboard.Outstanding {
+			result += fmt.Sprintf("   ⏳ %s %s awaits %s\n", attestation.ApplicationID, attestation.Scope, attestation.Owner)
+		}
+	}
+	return s.toolResult(result, dashboards)
+}
+
+// formatAttestationProgress summarizes the answers to a set of attestations
+func formatAttestationProgress(progress domain.AttestationProgress) string {
+	return fmt.Sprintf("%.0f%% complete: %d confirmed, %d disputed, %d pending of %d",
+		progress.Completion*100, progress.Confirmed, progress.Disputed, progress.Pending, progress.Total)
+}
+
+func (s *MCPServer) queryAuditTrail(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	entityType, _ := args["entity_type"].(string)
+	entityID, _ := args["entity_id"].(string)
+	actor, _ := args["actor"].(string)
+	limit, _ := args["limit"].(float64)
+
+	cmd := application.QueryAuditTrailCommand{
+		EntityType: domain.AuditTrailEntityType(entityType),
+		EntityID:   entityID,
+		Actor:      actor,
+		Limit:      int(limit),
+	}
+	if value, ok := args["from"].(string); ok && value != "" {
+		parsed, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid from date: %w", err)
+		}
+		cmd.From = parsed
+	}
+	if value, ok := args["to"].(string); ok && value != "" {
+		parsed, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid to date: %w", err)
+		}
+		cmd.To = parsed.AddDate(0, 0, 1).Add(-time.Nanosecond)
+	}
+
+	entries, err := s.auditTrailService.Query(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("🧾 Audit trail: %d changes\n", len(entries))
+	for _, entry := range entries {
+		result += fmt.Sprintf("\n#%d %s %s %s %s by %s", entry.Sequence, entry.RecordedAt.Format(time.RFC3339), entry.EntityType, entry.EntityID, entry.Action, entry.Actor)
+		if len(entry.Changes) > 0 {
+			result += fmt.Sprintf("\n   changed: %s", strings.Join(entry.Changes, ", "))
+		}
+	}
+	return s.toolResult(result, entries)
+}
+
+func (s *MCPServer) verifyAuditTrail(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	verification, err := s.auditTrailService.Verify(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("✅ Audit trail verified: the hash chain of all %d entries is intact", verification.Entries)
+	if !verification.Valid {
+		result = fmt.Sprintf("❌ Audit trail tampered: entry #%d of %d does not verify (%s)", verification.BrokenAt, verification.Entries, verification.Reason)
+	}
+	return s.toolResult(result, verification)
+}
+
+func (s *MCPServer) getSegregationOfDutiesReport(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	applicationID, _ := args["application_id"].(string)
+
+	report, err := s.sodService.Report(ctx, application.SegregationOfDutiesReportCommand{
+		ApplicationID: domain.ApplicationID(applicationID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]string, len(report.Rules))
+	for i, rule := range report.Rules {
+		rules[i] = string(rule)
+	}
+	result := fmt.Sprintf("⚖️ Segregation of duties: %d change requests and %d assessments checked\n", report.ChangeRequests, report.Assessments)
+	if len(rules) == 0 {
+		result += "No rules are enforced\n"
+	} else {
+		result += fmt.Sprintf("Rules: %s\n", strings.Join(rules, ", "))
+	}
+	if len(report.Violations) == 0 {
+		result += "\n✅ No conflicting duties found"
+	}
+	for _, violation := range report.Violations {
+		result += fmt.Sprintf("\n❌ %s %s (%s): %s", violation.EntityType, violation.EntityID, violation.Rule, violation.Description)
+		if !violation.At.IsZero() {
+			result += fmt.Sprintf(" on %s", violation.At.Format("2006-01-02"))
+		}
+	}
+	return s.toolResult(result, report)
+}
+
+func (s *MCPServer) registerVendor(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	id, _ := args["id"].(string)
+	name, _ := args["name"].(string)
+	description, _ := args["description"].(string)
+	services, _ := args["services"].(string)
+	criticality, _ := args["criticality"].(string)
+	status, _ := args["status"].(string)
+
+	cmd := application.RegisterVendorCommand{
+		ID:          domain.VendorID(id),
+		Name:        name,
+		Description: description,
+		Services:    services,
+		Criticality: domain.Priority(criticality),
+		Status:      domain.VendorStatus(status),
+	}
+	for _, appID := range stringList(args["application_ids"]) {
+		cmd.Applications = append(cmd.Applications, domain.ApplicationID(appID))
+	}
+	vendor, err := s.vendorService.RegisterVendor(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("🤝 Registered vendor %s\n", vendor.ID)
+	result += formatVendor(*vendor)
+	return s.toolResult(result, vendor)
+}
+
+func (s *MCPServer) linkVendorApplication(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	vendorID, _ := args["vendor_id"].(string)
+	applicationID, _ := args["application_id"].(string)
+	unlink, _ := args["unlink"].(bool)
+
+	var vendor *domain.Vendor
+	var err error
+	if unlink {
+		vendor, err = s.vendorService.UnlinkApplication(ctx, application.UnlinkVendorApplicationCommand{
+			VendorID:      domain.VendorID(vendorID),
+			ApplicationID: domain.ApplicationID(applicationID),
+		})
+	} else {
+		vendor, err = s.vendorService.LinkApplication(ctx, application.LinkVendorApplicationCommand{
+			VendorID:      domain.VendorID(vendorID),
+			ApplicationID: domain.ApplicationID(applicationID),
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("🔗 %s now supplies %s\n", vendor.ID, applicationID)
+	if unlink {
+		result = fmt.Sprintf("🔗 %s no longer supplies %s\n", vendor.ID, applicationID)
+	}
+	return s.toolResult(result, vendor)
+}
+
+func (s *MCPServer) setVendorStatus(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	vendorID, _ := args["vendor_id"].(string)
+	status, _ := args["status"].(string)
+
+	vendor, err := s.vendorService.SetVendorStatus(ctx, application.SetVendorStatusCommand{
+		VendorID: domain.VendorID(vendorID),
+		Status:   domain.VendorStatus(status),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("🤝 Vendor %s is now %s\n", vendor.ID, vendor.Status)
+	return s.toolResult(result, vendor)
+}
+
+func (s *MCPServer) addVendorContract(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	vendorID, _ := args["vendor_id"].(string)
+	contractID, _ := args["contract_id"].(string)
+	title, _ := args["title"].(string)
+	annualValue, _ := args["annual_value"].(float64)
+	dataProcessing, _ := args["data_processing"].(bool)
+
+	contract := domain.VendorContract{ID: contractID, Title: title, AnnualValue: annualValue, DataProcessing: dataProcessing}
+	startsAt, _ := args["starts_at"].(string)
+	parsed, err := time.Parse("2006-01-02", startsAt)
+	if err != nil {
+		return nil, fmt.Errorf("invalid starts_at date: %w", err)
+	}
+	contract.StartsAt = parsed
+	if endsAt, ok := args["ends_at"].(string); ok && endsAt != "" {
+		parsed, err := time.Parse("2006-01-02", endsAt)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ends_at date: %w", err)
+		}
+		contract.EndsAt = parsed
+	}
+
+	vendor, err := s.vendorService.AddContract(ctx, application.AddVendorContractCommand{
+		VendorID: domain.VendorID(vendorID),
+		Contract: contract,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("📝 Contract %s added to vendor %s\n", contract.ID, vendor.ID)
+	result += formatVendor(*vendor)
+	return s.toolResult(result, vendor)
+}
+
+func (s *MCPServer) setVendorSLA(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	vendorID, _ := args["vendor_id"].(string)
+	name, _ := args["name"].(string)
+	target, _ := args["target"].(float64)
+	lowerIsBetter, _ := args["lower_is_better"].(bool)
+
+	vendor, err := s.vendorService.SetSLA(ctx, application.SetVendorSLACommand{
+		VendorID:      domain.VendorID(vendorID),
+		Name:          name,
+		Target:        target,
+		LowerIsBetter: lowerIsBetter,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	bound := "at least"
+	if lowerIsBetter {
+		bound = "at most"
+	}
+	result := fmt.Sprintf("📐 Vendor %s committed to %s of %s %g\n", vendor.ID, name, bound, target)
+	return s.toolResult(result, vendor)
+}
+
+func (s *MCPServer) recordVendorSLA(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	vendorID, _ := args["vendor_id"].(string)
+	name, _ := args["name"].(string)
+	observed, _ := args["observed"].(float64)
+
+	vendor, err := s.vendorService.RecordSLAObservation(ctx, application.RecordVendorSLAObservationCommand{
+		VendorID: domain.VendorID(vendorID),
+		SLA:      name,
+		Observed: observed,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("📐 %s of vendor %s observed at %g\n", name, vendor.ID, observed)
+	for _, sla := range vendor.SLAs {
+		if sla.Name == name && sla.Breached() {
+			result += fmt.Sprintf("🚨 Misses the target of %g\n", sla.Target)
+		}
+	}
+	result += fmt.Sprintf("Risk: %s (%.0f)\n", vendor.RiskRating.Level, vendor.RiskRating.Score)
+	return s.toolResult(result, vendor)
+}
+
+func (s *MCPServer) sendVendorQuestionnaire(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	vendorID, _ := args["vendor_id"].(string)
+	questionnaireID, _ := args["questionnaire_id"].(string)
+
+	questionnaire, err := s.vendorService.SendQuestionnaire(ctx, application.SendVendorQuestionnaireCommand{
+		VendorID:        domain.VendorID(vendorID),
+		QuestionnaireID: questionnaireID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("📨 Questionnaire %s sent to vendor %s: %d questions\n", questionnaire.ID, vendorID, len(questionnaire.Questions))
+	for _, question := range questionnaire.Questions {
+		result += fmt.Sprintf("• %s (%s): %s\n", question.ID, question.Area, question.Text)
+	}
+	return s.toolResult(result, questionnaire)
+}
+
+func (s *MCPServer) answerVendorQuestionnaire(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	vendorID, _ := args["vendor_id"].(string)
+	questionnaireID, _ := args["questionnaire_id"].(string)
+	complete, _ := args["complete"].(bool)
+
+	cmd := application.AnswerVendorQuestionnaireCommand{
+		VendorID:        domain.VendorID(vendorID),
+		QuestionnaireID: questionnaireID,
+		Answers:         make(map[string]domain.VendorAnswer),
+		Complete:        complete,
+	}
+	answers, _ := args["answers"].(map[string]interface{})
+	for questionID, value := range answers {
+		answer, _ := value.(string)
+		cmd.Answers[questionID] = domain.VendorAnswer(answer)
+	}
+	vendor, err := s.vendorService.AnswerQuestionnaire(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("📨 %d answers recorded on questionnaire %s of vendor %s\n", len(cmd.Answers), questionnaireID, vendor.ID)
+	if complete {
+		result += "Questionnaire completed\n"
+	}
+	result += formatVendor(*vendor)
+	return s.toolResult(result, vendor)
+}
+
+func (s *MCPServer) getVendor(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	vendorID, _ := args["vendor_id"].(string)
+
+	vendor, err := s.vendorService.GetVendor(ctx, domain.VendorID(vendorID))
+	if err != nil {
+		return nil, err
+	}
+
+	result := formatVendor(*vendor)
+	for _, questionnaire := range vendor.Questionnaires {
+		status := "awaiting answers"
+		if questionnaire.Completed() {
+			status = fmt.Sprintf("completed on %s, scored %.0f%%", questionnaire.CompletedAt.Format("2006-01-02"), questionnaire.Score())
+		}
+		result += fmt.Sprintf("\n📨 Questionnaire %s sent on %s, %s\n", questionnaire.ID, questionnaire.SentAt.Format("2006-01-02"), status)
+		for _, question := range questionnaire.Questions {
+			answer := string(question.Answer)
+			if answer == "" {
+				answer = "unanswered"
+			}
+			result += fmt.Sprintf("• %s: %s\n", question.ID, answer)
+		}
+	}
+	return s.toolResult(result, vendor)
+}
+
+func (s *MCPServer) listVendors(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	applicationID, _ := args["application_id"].(string)
+
+	vendors, err := s.vendorService.ListVendors(ctx, application.ListVendorsCommand{ApplicationID: domain.ApplicationID(applicationID)})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("🤝 Vendors: %d\n", len(vendors))
+	for _, vendor := range vendors {
+		result += fmt.Sprintf("• %s (%s): %s, %s risk (%.0f), supplies %d applications\n",
+			vendor.Name, vendor.ID, vendor.Status, vendor.RiskRating.Level, vendor.RiskRating.Score, len(vendor.Applications))
+	}
+	return s.toolResult(result, vendors)
+}
+
+func (s *MCPServer) monitorVendors(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	vendors, err := s.vendorService.MonitorVendors(ctx, application.MonitorVendorsCommand{})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("🔭 Vendors monitored: %d\n", len(vendors))
+	for _, vendor := range vendors {
+		result += fmt.Sprintf("\n%s (%s): %s risk (%.0f)\n", vendor.Name, vendor.ID, vendor.RiskRating.Level, vendor.RiskRating.Score)
+		for _, factor := range vendor.RiskRating.Factors {
+			result += fmt.Sprintf("   ↳ %s\n", factor)
+		}
+	}
+	return s.toolResult(result, vendors)
+}
+
+func (s *MCPServer) startDPIA(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	dpiaID, _ := args["dpia_id"].(string)
+	applicationID, _ := args["application_id"].(string)
+	title, _ := args["title"].(string)
+	assessor, _ := args["assessor"].(string)
+	assessor, err := actorName(ctx, assessor, "")
+	if err != nil {
+		return nil, err
+	}
+
+	dpia, err := s.dpiaService.StartDPIA(ctx, application.StartDPIACommand{
+		ID:            dpiaID,
+		ApplicationID: domain.ApplicationID(applicationID),
+		Title:         title,
+		Assessor:      assessor,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("🛡️ DPIA %s started: %s\n", dpia.ID, dpia.Title)
+	result += fmt.Sprintf("Screening questions: %d\n", len(dpia.Questions))
+	for _, question := range dpia.Questions {
+		result += fmt.Sprintf("• %s: %s\n", question.ID, question.Text)
+	}
+	return s.toolResult(result, dpia)
+}
+
+func (s *MCPServer) answerDPIAQuestions(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	dpiaID, _ := args["dpia_id"].(string)
+
+	cmd := application.AnswerDPIAQuestionsCommand{
+		DPIAID:  dpiaID,
+		Answers: make(map[string]domain.DPIAAnswer),
+		Notes:   make(map[string]string),
+	}
+	answers, _ := args["answers"].(map[string]interface{})
+	for questionID, value := range answers {
+		answer, _ := value.(string)
+		cmd.Answers[questionID] = domain.DPIAAnswer(answer)
+	}
+	notes, _ := args["notes"].(map[string]interface{})
+	for questionID, value := range notes {
+		cmd.Notes[questionID], _ = value.(string)
+	}
+	dpia, err := s.dpiaService.AnswerQuestions(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("🛡️ %d answers recorded on DPIA %s\n", len(cmd.Answers), dpia.ID)
+	result += formatDPIA(*dpia)
+	return s.toolResult(result, dpia)
+}
+
+func (s *MCPServer) addPrivacyRisk(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	dpiaID, _ := args["dpia_id"].(string)
+	riskID, _ := args["risk_id"].(string)
+	description, _ := args["description"].(string)
+	level, _ := args["level"].(string)
+
+	dpia, err := s.dpiaService.AddRisk(ctx, application.AddPrivacyRiskCommand{
+		DPIAID: dpiaID,
+		Risk: domain.PrivacyRisk{
+			ID:          riskID,
+			Description: description,
+			Level:       domain.RiskLevel(level),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("⚠️ Privacy risk %s added to DPIA %s\n", riskID, dpia.ID)
+	result += formatDPIA(*dpia)
+	return s.toolResult(result, dpia)
+}
+
+func (s *MCPServer) mitigatePrivacyRisk(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	dpiaID, _ := args["dpia_id"].(string)
+	riskID, _ := args["risk_id"].(string)
+	mitigation, _ := args["mitigation"].(string)
+	owner, _ := args["owner"].(string)
+	implemented, _ := args["implemented"].(bool)
+	residualLevel, _ := args["residual_level"].(string)
+
+	dpia, err := s.dpiaService.MitigateRisk(ctx, application.MitigatePrivacyRiskCommand{
+		DPIAID: dpiaID,
+		RiskID: riskID,
+		Mitigation: domain.PrivacyMitigation{
+			Description: mitigation,
+			Owner:       owner,
+			Implemented: implemented,
+		},
+		ResidualLevel: domain.RiskLevel(residualLevel),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("🧯 Mitigation added to privacy risk %s of DPIA %s\n", riskID, dpia.ID)
+	result += formatDPIA(*dpia)
+	return s.toolResult(result, dpia)
+}
+
+func (s *MCPServer) submitDPIA(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	dpiaID, _ := args["dpia_id"].(string)
+
+	dpia, err := s.dpiaService.Submit(ctx, application.SubmitDPIACommand{DPIAID: dpiaID})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("📤 DPIA %s submitted to the data protection officer\n", dpia.ID)
+	result += formatDPIA(*dpia)
+	return s.toolResult(result, dpia)
+}
+
+func (s *MCPServer) decideDPIA(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	dpiaID, _ := args["dpia_id"].(string)
+	dpo, _ := args["dpo"].(string)
+	dpo, err := actorName(ctx, dpo, "")
+	if err != nil {
+		return nil, err
+	}
+	approve, _ := args["approve"].(bool)
+	opinion, _ := args["opinion"].(string)
+
+	cmd := application.DecideDPIACommand{
+		DPIAID:  dpiaID,
+		DPO:     dpo,
+		Approve: approve,
+		Opinion: opinion,
+	}
+	if reviewBy, _ := args["review_by"].(string); reviewBy != "" {
+		parsed, err := time.Parse("2006-01-02", reviewBy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid review_by date: %w", err)
+		}
+		cmd.ReviewBy = parsed
+	}
+	dpia, err := s.dpiaService.Decide(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("✅ DPIA %s approved by %s, to be reviewed by %s\n", dpia.ID, dpia.DPO, dpia.ReviewBy.Format("2006-01-02"))
+	if dpia.Status == domain.DPIARejected {
+		result = fmt.Sprintf("↩️ DPIA %s returned for rework by %s\n", dpia.ID, dpia.DPO)
+	}
+	if dpia.DPOOpinion != "" {
+		result += fmt.Sprintf("Opinion: %s\n", dpia.DPOOpinion)
+	}
+	if dpia.Status == domain.DPIAApproved && dpia.PriorConsultationRequired() {
+		result += "🚨 High residual risk: consult the supervisory authority before processing (GDPR Art. 36)\n"
+	}
+	result += formatDPIA(*dpia)
+	return s.toolResult(result, dpia)
+}
+
+func (s *MCPServer) getDPIA(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	dpiaID, _ := args["dpia_id"].(string)
+
+	dpia, err := s.dpiaService.GetDPIA(ctx, dpiaID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := formatDPIA(*dpia)
+	result += "\nScreening questions:\n"
+	for _, question := range dpia.Questions {
+		answer := string(question.Answer)
+		if answer == "" {
+			answer = "unanswered"
+		}
+		result += fmt.Sprintf("• %s: %s\n", question.ID, answer)
+	}
+	return s.toolResult(result, dpia)
+}
+
+func (s *MCPServer) listDPIAs(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	applicationID, _ := args["application_id"].(string)
+
+	dpias, err := s.dpiaService.ListDPIAs(ctx, domain.ApplicationID(applicationID))
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("🛡️ DPIAs: %d\n", len(dpias))
+	for _, dpia := range dpias {
+		result += fmt.Sprintf("• %s (%s): %s, %d risks, %s residual risk\n",
+			dpia.ID, dpia.ApplicationID, dpia.Status, len(dpia.Risks), dpia.ResidualRisk())
+	}
+	return s.toolResult(result, dpias)
+}
+
+// formatDPIA describes a DPIA's status and the privacy risks it identified
+func formatDPIA(dpia domain.DPIA) string {
+	result := fmt.Sprintf("\n%s (%s) of %s, assessed by %s: %s\n", dpia.Title, dpia.ID, dpia.ApplicationID, dpia.Assessor, dpia.Status)
+	if dpia.Status == domain.DPIAApproved || dpia.Status == domain.DPIARejected {
+		result += fmt.Sprintf("Decided by %s on %s\n", dpia.DPO, dpia.DecidedAt.Format("2006-01-02"))
+	}
+	result += fmt.Sprintf("Privacy risks: %d, %s residual risk\n", len(dpia.Risks), dpia.ResidualRisk())
+	for _, risk := range dpia.Risks {
+		result += fmt.Sprintf("• %s (%s → %s): %s\n", risk.ID, risk.Level, risk.Residual(), risk.Description)
+		for _, mitigation := range risk.Mitigations {
+			status := "planned"
+			if mitigation.Implemented {
+				status = "implemented"
+			}
+			result += fmt.Sprintf("   ↳ %s (%s)\n", mitigation.Description, status)
+		}
+	}
+	return result
+}
+
+func (s *MCPServer) recordExpenditure(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	agreementID, _ := args["agreement_id"].(string)
+	category, _ := args["category"].(string)
+	amount, _ := args["amount"].(float64)
+	description, _ := args["description"].(string)
+	recordedBy, _ := args["recorded_by"].(string)
+	recordedBy, err := actorName(ctx, recordedBy, "")
+	if err != nil {
+		return nil, err
+	}
+
+	consumption, err := s.governanceService.RecordExpenditure(ctx, application.RecordExpenditureCommand{
+		AgreementID: domain.GovernanceAgreementID(agreementID),
+		Category:    category,
+		Amount:      amount,
+		Description: description,
+		RecordedBy:  recordedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("💸 Recorded $%.0f against %s\n", amount, consumption.Category)
+	result += fmt.Sprintf("   %s\n", formatBudgetConsumption(*consumption))
+
+	return s.toolResult(result, consumption)
+}
+
+func (s *MCPServer) getCapacityPlan(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	agreementID, _ := args["agreement_id"].(string)
+
+	plan, err := s.governanceService.GetCapacityPlan(ctx, application.GetCapacityPlanCommand{
+		AgreementID: domain.GovernanceAgreementID(agreementID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("👥 Resource Capacity for %s\n\n", agreementID)
+	if len(plan.Periods) == 0 {
+		result += "No personnel allocated yet.\n"
+	}
+	for _, period := range plan.Periods {
+		marker := "✓"
+		if period.OverAllocated() {
+			marker = "⚠️"
+		}
+		result += fmt.Sprintf("%s %s %s: %.1f FTE needed, %.1f allocated (%.0f%%)", marker, period.Start.Format("2006-01"), period.Role, period.Demand, period.Available, period.Utilization)
+		if len(period.Sources) > 0 {
+			result += fmt.Sprintf(" for %s", strings.Join(period.Sources, ", "))
+		}
+		result += "\n"
+	}
+	if plan.HasConflicts() {
+		result += fmt.Sprintf("\n%d over-allocated role months\n", len(plan.Conflicts))
+	}
+
+	return s.toolResult(result, plan)
+}
+
+func (s *MCPServer) defineOKR(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	agreementID, _ := args["agreement_id"].(string)
+	portfolioID, _ := args["portfolio_id"].(string)
+	okrID, _ := args["okr_id"].(string)
+	objective, _ := args["objective"].(string)
+	owner, _ := args["owner"].(string)
+	parentID, _ := args["parent_id"].(string)
+	objectiveID, _ := args["objective_id"].(string)
+
+	okr := domain.OKR{
+		ID:          okrID,
+		Objective:   objective,
+		Owner:       owner,
+		ParentID:    parentID,
+		ObjectiveID: objectiveID,
+	}
+	for name, date := range map[string]*time.Time{"period_start": &okr.PeriodStart, "period_end": &okr.PeriodEnd} {
+		if value, ok := args[name].(string); ok && value != "" {
+			parsed, err := time.Parse("2006-01-02", value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s: %w", name, err)
+			}
+			*date = parsed
+		}
+	}
+	keyResults, _ := args["key_results"].([]interface{})
+	for _, entry := range keyResults {
+		keyResult, _ := entry.(map[string]interface{})
+		id, _ := keyResult["id"].(string)
+		description, _ := keyResult["description"].(string)
+		kpiID, _ := keyResult["kpi_id"].(string)
+		baseline, _ := keyResult["baseline"].(float64)
+		target, _ := keyResult["target"].(float64)
+		unit, _ := keyResult["unit"].(string)
+		okr.KeyResults = append(okr.KeyResults, domain.KeyResult{
+			ID:          id,
+			Description: description,
+			KPIID:       kpiID,
+			Baseline:    baseline,
+			Target:      target,
+			Unit:        unit,
+		})
+	}
+
+	switch {
+	case agreementID != "":
+		defined, err := s.governanceService.DefineOKR(ctx, application.DefineOKRCommand{
+			AgreementID: domain.GovernanceAgreementID(agreementID),
+			OKR:         okr,
+		})
+		if err != nil {
+			return nil, err
+		}
+		result := fmt.Sprintf("🎯 OKR defined for %s:\n\n", agreementID)
+		result += formatOKRProgress(domain.OKRProgress{OKRID: defined.ID, Objective: defined.Objective, Owner: defined.Owner, KeyResults: defined.KeyResults}, "")
+		return s.toolResult(result, defined)
+	case portfolioID != "":
+		defined, err := s.portfolioService.DefinePortfolioOKR(ctx, application.DefinePortfolioOKRCommand{
+			PortfolioID: domain.PortfolioID(portfolioID),
+			OKR:         okr,
+		})
+		if err != nil {
+			return nil, err
+		}
+		result := fmt.Sprintf("🎯 OKR defined for portfolio %s:\n\n", portfolioID)
+		result += formatOKRProgress(domain.OKRProgress{OKRID: defined.ID, Objective: defined.Objective, Owner: defined.Owner, KeyResults: defined.KeyResults}, "")
+		return s.toolResult(result, defined)
+	default:
+		return nil, fmt.Errorf("agreement_id or portfolio_id is required")
+	}
+}
+
+func (s *MCPServer) recordKeyResult(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	agreementID, _ := args["agreement_id"].(string)
+	portfolioID, _ := args["portfolio_id"].(string)
+	okrID, _ := args["okr_id"].(string)
+	keyResultID, _ := args["key_result_id"].(string)
+	actual, _ := args["actual"].(float64)
+	recordedBy, _ := args["recorded_by"].(string)
+	recordedBy, err := actorName(ctx, recordedBy, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var okr *domain.OKR
+	switch {
+	case agreementID != "":
+		okr, err = s.governanceService.RecordKeyResult(ctx, application.RecordKeyResultCommand{
+			AgreementID: domain.GovernanceAgreementID(agreementID),
+			OKRID:       okrID,
+			KeyResultID: keyResultID,
+			Actual:      actual,
+			RecordedBy:  recordedBy,
+		})
+	case portfolioID != "":
+		okr, err = s.portfolioService.RecordPortfolioKeyResult(ctx, application.RecordPortfolioKeyResultCommand{
+			PortfolioID: domain.PortfolioID(portfolioID),
+			OKRID:       okrID,
+			KeyResultID: keyResultID,
+			Actual:      actual,
+			RecordedBy:  recordedBy,
+		})
+	default:
+		return nil, fmt.Errorf("agreement_id or portfolio_id is required")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("📏 Key result %s recorded at %.1f\n\n", keyResultID, actual)
+	result += formatOKRProgress(domain.OKRProgress{OKRID: okr.ID, Objective: okr.Objective, Owner: okr.Owner, Progress: okr.Progress(), KeyResults: okr.KeyResults}, "")
+	return s.toolResult(result, okr)
+}
+
+func (s *MCPServer) getOKRCascade(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	portfolioID, _ := args["portfolio_id"].(string)
+
+	cascade, err := s.governanceService.GetOKRCascade(ctx, application.GetOKRCascadeCommand{
+		PortfolioID: domain.PortfolioID(portfolioID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("🎯 OKRs for %s: %.0f%% overall\n", cascade.PortfolioID, cascade.Progress)
+	for _, okr := range cascade.OKRs {
+		result += "\n" + formatOKRProgress(okr, "")
+		if len(okr.Children) > 0 {
+			result += fmt.Sprintf("   Cascaded: %.0f%% across %d application OKRs\n", okr.CascadedProgress, len(okr.Children))
+		}
+		for _, child := range okr.Children {
+			result += formatOKRProgress(child, "   ")
+		}
+	}
+	if len(cascade.Unaligned) > 0 {
+		result += "\n⚠️ Application OKRs cascading from no portfolio OKR:\n"
+		for _, okr := range cascade.Unaligned {
+			result += formatOKRProgress(okr, "   ")
+		}
+	}
+
+	return s.toolResult(result, cascade)
+}
+
+func (s *MCPServer) getRiskHeatMap(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	portfolioID, _ := args["portfolio_id"].(string)
+
+	heatMap, err := s.governanceService.GetRiskHeatMap(ctx, application.GetRiskHeatMapCommand{
+		PortfolioID: domain.PortfolioID(portfolioID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("🔥 %s: %d risks by likelihood and impact\n\n", heatMap.Name, heatMap.Total)
+	result += formatRiskHeatMap(*heatMap, "")
+	return s.toolResult(result, heatMap)
+}
+
+func (s *MCPServer) updateInitiativeProgress(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	agreementID, _ := args["agreement_id"].(string)
+	initiativeID, _ := args["initiative_id"].(string)
+	percentComplete, _ := args["percent_complete"].(float64)
+	status, _ := args["status"].(string)
+	note, _ := args["note"].(string)
+	updatedBy, _ := args["updated_by"].(string)
+	updatedBy, err := actorName(ctx, updatedBy, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var completed []string
+	milestones, _ := args["completed_milestones"].([]interface{})
+	for _, id := range milestones {
+		milestoneID, _ := id.(string)
+		completed = append(completed, milestoneID)
+	}
+
+	initiative, err := s.governanceService.UpdateInitiativeProgress(ctx, application.UpdateInitiativeProgressCommand{
+		AgreementID:         domain.GovernanceAgreementID(agreementID),
+		InitiativeID:        initiativeID,
+		PercentComplete:     percentComplete,
+		Status:              domain.InitiativeStatus(status),
+		CompletedMilestones: completed,
+		Note:                note,
+		UpdatedBy:           updatedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("🚧 %s: %.0f%% complete, %s\n", initiative.Name, initiative.PercentComplete, initiative.Status)
+	for _, milestone := range initiative.Milestones {
+		mark := "⬜"
+		if milestone.Completed() {
+			mark = "✅"
+		} else if milestone.Overdue(time.Now()) {
+			mark = "⏰"
+		}
+		result += fmt.Sprintf("   %s %s (due %s)\n", mark, milestone.Name, milestone.DueDate.Format("2006-01-02"))
+	}
+
+	return s.toolResult(result, initiative)
+}
+
+func (s *MCPServer) proposeDirection(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	agreementID, _ := args["agreement_id"].(string)
+	proposalID, _ := args["proposal_id"].(string)
+	rationale, _ := args["rationale"].(string)
+	governingBody, _ := args["governing_body"].(string)
+	proposedBy, _ := args["proposed_by"].(string)
+	proposedBy, err := actorName(ctx, proposedBy, "")
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := application.ProposeStrategicDirectionCommand{
+		AgreementID:   domain.GovernanceAgreementID(agreementID),
+		ProposalID:    proposalID,
+		Rationale:     rationale,
+		GoverningBody: governingBody,
+		ProposedBy:    proposedBy,
+	}
+
+	objectives, _ := args["objectives"].([]interface{})
+	for _, entry := range objectives {
+		fields, _ := entry.(map[string]interface{})
+		var objective domain.StrategicObjective
+		objective.ID, _ = fields["id"].(string)
+		objective.Name, _ = fields["name"].(string)
+		objective.Description, _ = fields["description"].(string)
+		if deadline, ok := fields["deadline"].(string); ok && deadline != "" {
+			parsed, err := time.Parse("2006-01-02", deadline)
+			if err != nil {
+				return nil, fmt.Errorf("invalid deadline of objective %s: %w", objective.ID, err)
+			}
+			objective.Deadline = parsed
+		}
+		cmd.Objectives = append(cmd.Objectives, objective)
+	}
+
+	initiatives, _ := args["initiatives"].([]interface{})
+	for _, entry := range initiatives {
+		fields, _ := entry.(map[string]interface{})
+		initiative := domain.StrategicInitiative{ObjectiveIDs: stringList(fields["objective_ids"])}
+		initiative.ID, _ = fields["id"].(string)
+		initiative.Name, _ = fields["name"].(string)
+		initiative.Description, _ = fields["description"].(string)
+		initiative.Owner, _ = fields["owner"].(string)
+		initiative.Budget, _ = fields["budget"].(float64)
+		if deadline, ok := fields["deadline"].(string); ok && deadline != "" {
+			parsed, err := time.Parse("2006-01-02", deadline)
+			if err != nil {
+				return nil, fmt.Errorf("invalid deadline of initiative %s: %w", initiative.ID, err)
+			}
+			initiative.Deadline = parsed
+		}
+		cmd.Initiatives = append(cmd.Initiatives, initiative)
+	}
+
+	proposal, err := s.governanceService.ProposeStrategicDirection(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("📨 Direction %s proposed for %s\n\n", proposal.ID, agreementID) + formatDirectionProposal(*proposal)
+	return s.toolResult(result, proposal)
+}
+
+func (s *MCPServer) reviewDirection(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	agreementID, _ := args["agreement_id"].(string)
+	proposalID, _ := args["proposal_id"].(string)
+	reviewedBy, _ := args["reviewed_by"].(string)
+	reviewedBy, err := actorName(ctx, reviewedBy, "")
+	if err != nil {
+		return nil, err
+	}
+	comments, _ := args["comments"].(string)
+
+	proposal, err := s.governanceService.ReviewStrategicDirection(ctx, application.ReviewStrategicDirectionCommand{
+		AgreementID: domain.GovernanceAgreementID(agreementID),
+		ProposalID:  proposalID,
+		ReviewedBy:  reviewedBy,
+		Comments:    comments,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("🔎 Direction %s reviewed by %s\n\n", proposal.ID, proposal.ReviewedBy) + formatDirectionProposal(*proposal)
+	return s.toolResult(result, proposal)
+}
+
+func (s *MCPServer) approveDirection(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	agreementID, _ := args["agreement_id"].(string)
+	proposalID, _ := args["proposal_id"].(string)
+	approvedBy, _ := args["approved_by"].(string)
+
+	proposal, err := s.governanceService.ApproveStrategicDirection(ctx, application.ApproveStrategicDirectionCommand{
+		AgreementID: domain.GovernanceAgreementID(agreementID),
+		ProposalID:  proposalID,
+		ApprovedBy:  approvedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("✅ Direction %s approved by %s and in effect\n\n", proposal.ID, proposal.ApprovedBy) + formatDirectionProposal(*proposal)
+	return s.toolResult(result, proposal)
+}
+
+func (s *MCPServer) rejectDirection(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	agreementID, _ := args["agreement_id"].(string)
+	proposalID, _ := args["proposal_id"].(string)
+	rejectedBy, _ := args["rejected_by"].(string)
+	rejectedBy, err := actorName(ctx, rejectedBy, "")
+	if err != nil {
+		return nil, err
+	}
+	comments, _ := args["comments"].(string)
+
+	proposal, err := s.governanceService.RejectStrategicDirection(ctx, application.RejectStrategicDirectionCommand{
+		AgreementID: domain.GovernanceAgreementID(agreementID),
+		ProposalID:  proposalID,
+		RejectedBy:  rejectedBy,
+		Comments:    comments,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("❌ Direction %s rejected by %s\n\n", proposal.ID, proposal.RejectedBy) + formatDirectionProposal(*proposal)
+	return s.toolResult(result, proposal)
+}
+
+func (s *MCPServer) saveActionPlan(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	agreementID, _ := args["agreement_id"].(string)
+	plan := domain.ActionPlan{DependsOn: stringList(args["depends_on"])}
+	plan.ID, _ = args["plan_id"].(string)
+	plan.Name, _ = args["name"].(string)
+	plan.Description, _ = args["description"].(string)
+	plan.Owner, _ = args["owner"].(string)
+	plan.ObjectiveID, _ = args["objective_id"].(string)
+	if deadline, ok := args["deadline"].(string); ok && deadline != "" {
+		parsed, err := time.Parse("2006-01-02", deadline)
+		if err != nil {
+			return nil, fmt.Errorf("invalid deadline: %w", err)
+		}
+		plan.Deadline = parsed
+	}
+
+	actions, _ := args["actions"].([]interface{})
+	for _, entry := range actions {
+		fields, _ := entry.(map[string]interface{})
+		action := domain.Action{DependsOn: stringList(fields["depends_on"])}
+		action.ID, _ = fields["id"].(string)
+		action.Description, _ = fields["description"].(string)
+		action.Responsible, _ = fields["responsible"].(string)
+		durationDays, _ := fields["duration_days"].(float64)
+		action.Duration = time.Duration(durationDays * float64(24*time.Hour))
+		if deadline, ok := fields["deadline"].(string); ok && deadline != "" {
+			parsed, err := time.Parse("2006-01-02", deadline)
+			if err != nil {
+				return nil, fmt.Errorf("invalid deadline of action %s: %w", action.ID, err)
+			}
+			action.Deadline = parsed
+		}
+		plan.Actions = append(plan.Actions, action)
+	}
+
+	schedule, err := s.governanceService.SaveActionPlan(ctx, application.SaveActionPlanCommand{
+		AgreementID: domain.GovernanceAgreementID(agreementID),
+		ActionPlan:  plan,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("🗂️ Action plan %s saved\n\n", plan.ID) + formatActionSchedule(*schedule)
+	return s.toolResult(result, schedule)
+}
+
+func (s *MCPServer) updateAction(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	agreementID, _ := args["agreement_id"].(string)
+	planID, _ := args["plan_id"].(string)
+	actionID, _ := args["action_id"].(string)
+	status, _ := args["status"].(string)
+	durationDays, _ := args["duration_days"].(float64)
+
+	cmd := application.UpdateActionCommand{
+		AgreementID: domain.GovernanceAgreementID(agreementID),
+		PlanID:      planID,
+		ActionID:    actionID,
+		Status:      domain.ActionStatus(status),
+		Duration:    time.Duration(durationDays * float64(24*time.Hour)),
+	}
+	for name, date := range map[string]*time.Time{"deadline": &cmd.Deadline, "completed_at": &cmd.CompletedAt} {
+		if value, ok := args[name].(string); ok && value != "" {
+			parsed, err := time.Parse("2006-01-02", value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s: %w", name, err)
+			}
+			*date = parsed
+		}
+	}
+
+	schedule, err := s.governanceService.UpdateAction(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("🗂️ Action %s of %s updated\n\n", actionID, planID) + formatActionSchedule(*schedule)
+	return s.toolResult(result, schedule)
+}
+
+func (s *MCPServer) getActionSchedule(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	agreementID, _ := args["agreement_id"].(string)
+
+	schedule, err := s.governanceService.GetActionSchedule(ctx, application.GetActionScheduleCommand{
+		AgreementID: domain.GovernanceAgreementID(agreementID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("🗂️ Action Schedule for %s\n\n", agreementID) + formatActionSchedule(*schedule)
+	return s.toolResult(result, schedule)
+}
+
+func (s *MCPServer) listApplications(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	apps, err := s.appRepo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("📋 Applications in Portfolio (%d total):\n\n", len(apps))
+	for i, app := range apps {
+		statusEmoji := "✅"
+		if app.Status == domain.StatusDeprecated {
+			statusEmoji = "⚠️"
+		} else if app.Status == domain.StatusRetired {
+			statusEmoji = "🚫"
+		}
+
+		result += fmt.Sprintf("%d. %s (%s) %s\n", i+1, app.Name, app.ID, statusEmoji)
+		result += fmt.Sprintf("   📝 %s\n", app.Description)
+		result += fmt.Sprintf("   🔖 Version: %s | Created: %s\n\n",
+			app.Version, app.CreatedAt.Format("2006-01-02"))
+	}
+
+	return s.toolResult(result, apps)
+}
+
+func (s *MCPServer) listPortfolios(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	portfolios, err := s.portfolioService.ListPortfolios(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := fmt.Sprintf("📁 Application Portfolios (%d total):\n\n", len(portfolios))
+	for i, portfolio := range portfolios {
+		result += fmt.Sprintf("%d. %s (%s)\n", i+1, portfolio.Name, portfolio.ID)
+		result += fmt.Sprintf("   👤 Owner: %s\n", portfolio.Owner)
+		result += fmt.Sprintf("   📝 %s\n", portfolio.Description)
+		result += fmt.Sprintf("   📊 Applications: %d\n", len(portfolio.Applications))
+		if portfolio.Thresholds != nil {
+			result += "   🎚️ Own thresholds:\n" + formatPortfolioThresholds(portfolio.Thresholds)
+		}
+		result += fmt.Sprintf("   📅 Created: %s\n\n", portfolio.CreatedAt.Format("2006-01-02"))
+	}
+
+	return s.toolResult(result, portfolios)
+}
+
+func (s *MCPServer) runEnterpriseDemo(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	var progress strings.Builder
+	demoResult, err := demo.Run(ctx, s.demoEnvironment(), &progress)
+	if err != nil {
+		return nil, fmt.Errorf("enterprise demo failed: %w", err)
+	}
+	s.logger.Debugf("Enterprise demo output:%s", progress.String())
+
+	result := "🏛️ ISO 38500 Enterprise Governance Demo\n"
+	result += "=====================================\n\n"
+
+	result += fmt.Sprintf("✅ Enterprise Application Portfolio: %d applications\n", demoResult.Applications)
+	result += fmt.Sprintf("✅ Multi-Portfolio Structure: %d portfolios, %d governed assignments\n", demoResult.Portfolios, demoResult.PortfolioAssignments)
+	result += fmt.Sprintf("✅ Governance Framework: %d active governance agreements\n", demoResult.ActiveAgreements)
+	result += fmt.Sprintf("✅ Risk Assessment: %d applications evaluated (%d low, %d medium, %d high, %d critical)\n",
+		demoResult.Evaluations,
+		demoResult.RiskDistribution[domain.RiskLow], demoResult.RiskDistribution[domain.RiskMedium],
+		demoResult.RiskDistribution[domain.RiskHigh], demoResult.RiskDistribution[domain.RiskCritical])
+	result += fmt.Sprintf("✅ Strategic Direction: %d objectives and %d initiatives established\n", demoResult.Objectives, demoResult.Initiatives)
+	result += fmt.Sprintf("✅ Monitoring: %d KPIs and %d risk indicators tracked\n\n", demoResult.KPIs, demoResult.RiskIndicators)
+
+	result += "🎯 ISO 38500 Governance Principles Demonstrated:\n"
+	result += "• EVALUATE: Comprehensive application and portfolio assessment\n"
+	result += "• DIRECT: Strategic direction setting and resource allocation\n"
+	result += "• MONITOR: Continuous governance monitoring and compliance\n\n"
+
+	result += fmt.Sprintf("🏆 Enterprise Governance Coverage: %.1f%% of application portfolio\n", demoResult.Coverage)
+
+	return s.toolResult(result, demoResult)
+}
+
+func (s *MCPServer) listToolInvocations(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	subject, _ := args["subject"].(string)
+	invocations := s.invocations.list(subject)
+
+	text := fmt.Sprintf("🔐 Tool Invocations (%d total):\n\n", len(invocations))
+	for i, invocation := range invocations {
+		status := "✅"
+		if !invocation.Succeeded {
+			status = "❌ " + invocation.Error
+		}
+		text += fmt.Sprintf("%d. %s %s by %s %s\n", i+1, invocation.InvokedAt.Format(time.RFC3339), invocation.Tool, invocation.Principal, status)
+	}
+
+	return s.toolResult(text, invocations)
+}
+
+// toolResult renders a tool result as text, or as JSON of the underlying data when configured
+func (s *MCPServer) toolResult(text string, data interface{}) (interface{}, error) {
+	if s.config.OutputFormat == outputJSON && data != nil {
+		payload, err := json.MarshalIndent(data, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode result: %w", err)
+		}
+		text = string(payload)
+	}
+
+	return CallToolResult{
+		Content: []Content{
+			{
+				Type: "text",
+				Text: text,
+			},
+		},
+	}, nil
+}
+
+func (s *MCPServer) errorResponse(req MCPRequest, message string) *MCPResponse {
+	if req.ID == nil {
+		return nil // Don't respond to notifications
+	}
+	return &MCPResponse{
+		JSONRPC: "2.0",
+		ID:       *req.ID,
+		Error: &MCPError{
+			Code:    -32000,
+			Message: message,
+		},
+	}
+}
+
+func (s *MCPServer) sendResponse(resp *MCPResponse) {
+	data, err := json.Marshal(resp)
+	if err != nil {
+		s.logger.Errorf("Failed to marshal response: %v", err)
+		return
+	}
+
+	fmt.Println(string(data))
+}
+
+func (s *MCPServer) sendNotification(method string, params interface{}) {
+	if s.config.Transport != transportStdio {
+		// Plain HTTP requests have no channel for server-initiated messages
+		s.logger.Debugf("Dropping %s notification on %s transport", method, s.config.Transport)
+		return
+	}
+
+	data, err := json.Marshal(MCPNotification{
+		JSONRPC: "2.0",
+		Method:  method,
+		Params:  params,
+	})
+	if err != nil {
+		s.logger.Errorf("Failed to marshal notification: %v", err)
+		return
 	}
 
 	fmt.Println(string(data))
 }
+
+func joinApplicationIDs(ids []domain.ApplicationID) string {
+	names := make([]string, len(ids))
+	for i, id := range ids {
+		names[i] = string(id)
+	}
+	return strings.Join(names, ", ")
+}
+
+// formatEvidence lists evidence one item per line, or notes that there is none
+func formatEvidence(attachments []domain.Evidence) string {
+	if len(attachments) == 0 {
+		return "• No evidence attached\n"
+	}
+	result := ""
+	for _, evidence := range attachments {
+		result += fmt.Sprintf("• %s [%s] %s by %s on %s\n", evidence.ID, evidence.Kind, evidence.URI,
+			evidence.AttachedBy, evidence.AttachedAt.Format("2006-01-02"))
+		if evidence.SHA256 != "" {
+			result += fmt.Sprintf("  SHA-256: %s\n", evidence.SHA256)
+		}
+		if evidence.Source != "" {
+			result += fmt.Sprintf("  Source: %s, collected %s\n", evidence.Source, evidence.CollectedAt.Format("2006-01-02"))
+		}
+		switch {
+		case !evidence.ExpiredAt.IsZero():
+			result += fmt.Sprintf("  ⌛ Expired on %s\n", evidence.ValidUntil.Format("2006-01-02"))
+		case !evidence.ValidUntil.IsZero():
+			result += fmt.Sprintf("  Valid until: %s\n", evidence.ValidUntil.Format("2006-01-02"))
+		}
+	}
+	return result
+}
+
+// signOffStatus describes where an assessment is in review and sign-off
+func signOffStatus(signOff domain.AssessmentSignOff) string {
+	status := string(signOff.State)
+	switch signOff.State {
+	case domain.SignOffReviewed:
+		status += " by " + signOff.Reviewer
+	case domain.SignOffSignedOff:
+		status += " by " + signOff.Approver
+	}
+	if signOff.RequiresSecondPerson {
+		status += " (second-person review required)"
+	}
+	return status
+}
+
+// formatPortfolioThresholds describes a portfolio's risk thresholds and KPI tolerance
+func formatPortfolioThresholds(thresholds *domain.PortfolioThresholds) string {
+	result := ""
+	if risk := thresholds.RiskThresholds; risk != nil {
+		result += fmt.Sprintf("   • Critical: technical score <= %d or cost efficiency < %.0f%%\n", risk.CriticalMaxTechnicalScore, risk.CriticalMinCostEfficiency)
+		result += fmt.Sprintf("   • High: technical score <= %d or cost efficiency < %.0f%%\n", risk.HighMaxTechnicalScore, risk.HighMinCostEfficiency)
+		result += fmt.Sprintf("   • Medium: technical score <= %d\n", risk.MediumMaxTechnicalScore)
+	}
+	result += fmt.Sprintf("   • KPI tolerance: %.0f%% of target\n", thresholds.KPITolerance)
+	return result
+}
+
+// formatEvidenceKinds lists evidence kinds, e.g. "document, report_link"
+func formatEvidenceKinds(kinds []domain.EvidenceKind) string {
+	names := make([]string, len(kinds))
+	for i, kind := range kinds {
+		names[i] = string(kind)
+	}
+	return strings.Join(names, ", ")
+}
+
+// formatBudgetConsumption describes an allocation's spend, burn rate and alert on one line
+func formatBudgetConsumption(consumption domain.BudgetConsumption) string {
+	status := "✅"
+	if consumption.Alert != domain.BudgetAlertNone {
+		status = "⚠️ " + string(consumption.Alert)
+	}
+	return fmt.Sprintf("%s: $%.0f of $%.0f (%.0f%%), $%.0f/day, projected $%.0f (%+.0f) %s",
+		consumption.Category, consumption.Spent, consumption.Allocated, consumption.ConsumedPercent,
+		consumption.BurnRatePerDay, consumption.ProjectedSpend, consumption.ProjectedVariance, status)
+}
+
+// formatPolicy describes a policy and where it is in its lifecycle
+func formatPolicy(policy *domain.Policy) string {
+	result := fmt.Sprintf("📜 Policy %s: %s (%s)\n", policy.ID, policy.Name, policy.Status)
+	if policy.Owner != "" {
+		result += fmt.Sprintf("   Owner: %s\n", policy.Owner)
+	}
+	if policy.SubmittedBy != "" {
+		result += fmt.Sprintf("   Submitted by %s on %s\n", policy.SubmittedBy, policy.SubmittedAt.Format("2006-01-02"))
+	}
+	if policy.ApprovedBy != "" {
+		result += fmt.Sprintf("   Approved by %s on %s\n", policy.ApprovedBy, policy.ApprovedAt.Format("2006-01-02"))
+	}
+	if !policy.EffectiveFrom.IsZero() {
+		result += fmt.Sprintf("   Effective from %s", policy.EffectiveFrom.Format("2006-01-02"))
+		if !policy.EffectiveUntil.IsZero() {
+			result += fmt.Sprintf(" until %s", policy.EffectiveUntil.Format("2006-01-02"))
+		}
+		result += "\n"
+	}
+	if policy.Status == domain.PolicyRetired {
+		result += fmt.Sprintf("   Retired on %s: %s\n", policy.RetiredAt.Format("2006-01-02"), policy.RetirementReason)
+	}
+	return result
+}
+
+// findPolicy returns the policy with the given ID
+func findPolicy(policies []domain.Policy, policyID string) (domain.Policy, bool) {
+	for _, policy := range policies {
+		if policy.ID == policyID {
+			return policy, true
+		}
+	}
+	return domain.Policy{}, false
+}
+
+// formatDocumentVersion describes a document version, its field changes and the redline of its
+// description
+func formatDocumentVersion(version domain.DocumentVersion) string {
+	result := fmt.Sprintf("v%d by %s on %s", version.Version, version.ChangedBy, version.ChangedAt.Format("2006-01-02"))
+	if version.Summary != "" {
+		result += ": " + version.Summary
+	}
+	result += "\n"
+	for _, change := range version.Changes {
+		if change.Field == "description" {
+			continue
+		}
+		result += fmt.Sprintf("   • %s: %q → %q\n", change.Field, change.From, change.To)
+	}
+	if len(version.Redline) > 0 {
+		result += fmt.Sprintf("   • description: %s\n", domain.FormatRedline(version.Redline))
+	}
+	return result
+}
+
+// formatOKRProgress renders an OKR with the progress of each key result, indented by prefix
+func formatOKRProgress(okr domain.OKRProgress, prefix string) string {
+	result := fmt.Sprintf("%s• %s: %s", prefix, okr.OKRID, okr.Objective)
+	if okr.ApplicationID != "" {
+		result += fmt.Sprintf(" (%s)", okr.ApplicationID)
+	}
+	result += fmt.Sprintf(" — %.0f%%\n", okr.Progress)
+	for _, keyResult := range okr.KeyResults {
+		result += fmt.Sprintf("%s   ↳ %s: %.1f of %.1f %s from %.1f (%.0f%%)\n",
+			prefix, keyResult.Description, keyResult.Actual, keyResult.Target, keyResult.Unit, keyResult.Baseline, keyResult.Progress())
+	}
+	return result
+}
+
+// stringList converts a JSON array argument to strings, skipping entries that are not strings
+func stringList(value interface{}) []string {
+	entries, _ := value.([]interface{})
+	list := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if text, ok := entry.(string); ok {
+			list = append(list, text)
+		}
+	}
+	return list
+}
+
+// formatVendor renders a vendor with its applications, contracts, service levels and risk rating
+func formatVendor(vendor domain.Vendor) string {
+	result := fmt.Sprintf("%s (%s) [%s]\n", vendor.Name, vendor.ID, vendor.Status)
+	if vendor.Services != "" {
+		result += fmt.Sprintf("Services: %s\n", vendor.Services)
+	}
+	if vendor.Criticality != "" {
+		result += fmt.Sprintf("Criticality: %s\n", vendor.Criticality)
+	}
+	if len(vendor.Applications) > 0 {
+		ids := make([]string, len(vendor.Applications))
+		for i, id := range vendor.Applications {
+			ids[i] = string(id)
+		}
+		result += fmt.Sprintf("Applications: %s\n", strings.Join(ids, ", "))
+	}
+	for _, contract := range vendor.Contracts {
+		ends := "until terminated"
+		if !contract.EndsAt.IsZero() {
+			ends = "to " + contract.EndsAt.Format("2006-01-02")
+		}
+		result += fmt.Sprintf("📝 %s: %s, %s %s", contract.ID, contract.Title, contract.StartsAt.Format("2006-01-02"), ends)
+		if contract.AnnualValue > 0 {
+			result += fmt.Sprintf(", %.0f per year", contract.AnnualValue)
+		}
+		if contract.DataProcessing {
+			result += ", processes personal data"
+		}
+		result += "\n"
+	}
+	for _, sla := range vendor.SLAs {
+		status := "not yet observed"
+		if !sla.ObservedAt.IsZero() {
+			status = fmt.Sprintf("observed %g ✅", sla.Observed)
+			if sla.Breached() {
+				status = fmt.Sprintf("observed %g 🚨", sla.Observed)
+			}
+		}
+		result += fmt.Sprintf("📐 %s: target %g, %s\n", sla.Name, sla.Target, status)
+	}
+	result += fmt.Sprintf("⚠️ Risk: %s (%.0f)\n", vendor.RiskRating.Level, vendor.RiskRating.Score)
+	for _, factor := range vendor.RiskRating.Factors {
+		result += fmt.Sprintf("   ↳ %s\n", factor)
+	}
+	return result
+}
+
+// formatDecision renders a decision record with its context, options and outcome
+func formatDecision(decision domain.Decision) string {
+	result := fmt.Sprintf("%s: %s [%s]\n", decision.ID, decision.Title, decision.Status)
+	result += fmt.Sprintf("Decided by %s on %s", decision.DecidedBy, decision.DecidedAt.Format("2006-01-02"))
+	if decision.AgreementID != "" {
+		result += fmt.Sprintf(" for %s", decision.AgreementID)
+	}
+	if decision.ApplicationID != "" {
+		result += fmt.Sprintf(" (%s)", decision.ApplicationID)
+	}
+	result += "\n"
+	if decision.Supersedes != "" {
+		result += fmt.Sprintf("Supersedes: %s\n", decision.Supersedes)
+	}
+	if decision.SupersededBy != "" {
+		result += fmt.Sprintf("Superseded by: %s\n", decision.SupersededBy)
+	}
+	result += fmt.Sprintf("\nContext: %s\n", decision.Context)
+	if len(decision.Options) > 0 {
+		result += "\nOptions considered:\n"
+		for _, option := range decision.Options {
+			result += fmt.Sprintf("• %s", option.Name)
+			if option.Description != "" {
+				result += ": " + option.Description
+			}
+			result += "\n"
+			for _, pro := range option.Pros {
+				result += fmt.Sprintf("   + %s\n", pro)
+			}
+			for _, con := range option.Cons {
+				result += fmt.Sprintf("   - %s\n", con)
+			}
+		}
+	}
+	result += fmt.Sprintf("\nDecision: %s\n", decision.Outcome)
+	if decision.Rationale != "" {
+		result += fmt.Sprintf("Rationale: %s\n", decision.Rationale)
+	}
+	if decision.Consequences != "" {
+		result += fmt.Sprintf("Consequences: %s\n", decision.Consequences)
+	}
+	return result
+}
+
+// formatActionSchedule renders the projected completion of action plans and objectives with the
+// critical path
+func formatActionSchedule(schedule domain.ActionSchedule) string {
+	result := fmt.Sprintf("Projected completion: %s\n", schedule.ProjectedCompletion.Format("2006-01-02"))
+	if len(schedule.CriticalPath) > 0 {
+		path := make([]string, len(schedule.CriticalPath))
+		for i, action := range schedule.CriticalPath {
+			path[i] = action.PlanID + "/" + action.ActionID
+		}
+		result += fmt.Sprintf("Critical path: %s\n", strings.Join(path, " → "))
+	}
+
+	result += "\nActions:\n"
+	for _, action := range schedule.Actions {
+		mark := "⬜"
+		switch {
+		case action.Status == domain.ActionCompleted:
+			mark = "✅"
+		case action.Late():
+			mark = "⏰"
+		}
+		result += fmt.Sprintf("%s %s/%s: %s to %s", mark, action.PlanID, action.ActionID, action.Start.Format("2006-01-02"), action.Finish.Format("2006-01-02"))
+		if action.Critical {
+			result += " (critical)"
+		} else {
+			result += fmt.Sprintf(" (%.0f days slack)", action.Slack.Hours()/24)
+		}
+		result += "\n"
+	}
+
+	if len(schedule.Objectives) > 0 {
+		result += "\nObjectives:\n"
+		for _, objective := range schedule.Objectives {
+			mark := "✅"
+			if objective.Slipping() {
+				mark = "⚠️"
+			}
+			result += fmt.Sprintf("%s %s: projected %s, deadline %s\n", mark, objective.Name,
+				objective.ProjectedCompletion.Format("2006-01-02"), objective.Deadline.Format("2006-01-02"))
+		}
+	}
+	return result
+}
+
+func formatDirectionProposal(proposal domain.DirectionProposal) string {
+	result := fmt.Sprintf("%s [%s], proposed by %s on %s\n", proposal.ID, proposal.Status, proposal.ProposedBy, proposal.ProposedAt.Format("2006-01-02"))
+	if proposal.GoverningBody != "" {
+		result += fmt.Sprintf("To be approved by: %s\n", proposal.GoverningBody)
+	}
+	if proposal.Rationale != "" {
+		result += fmt.Sprintf("Rationale: %s\n", proposal.Rationale)
+	}
+	result += "\nObjectives:\n"
+	for _, objective := range proposal.Objectives {
+		result += fmt.Sprintf("• %s: %s\n", objective.ID, objective.Name)
+	}
+	if len(proposal.Initiatives) > 0 {
+		result += "\nInitiatives:\n"
+		for _, initiative := range proposal.Initiatives {
+			result += fmt.Sprintf("• %s: %s\n", initiative.ID, initiative.Name)
+		}
+	}
+	if proposal.ReviewedBy != "" {
+		result += fmt.Sprintf("\nReviewed by %s on %s", proposal.ReviewedBy, proposal.ReviewedAt.Format("2006-01-02"))
+		if proposal.ReviewComments != "" {
+			result += ": " + proposal.ReviewComments
+		}
+		result += "\n"
+	}
+	if proposal.ApprovedBy != "" {
+		result += fmt.Sprintf("Approved by %s on %s\n", proposal.ApprovedBy, proposal.ApprovedAt.Format("2006-01-02"))
+	}
+	if proposal.RejectedBy != "" {
+		result += fmt.Sprintf("\nRejected by %s on %s: %s\n", proposal.RejectedBy, proposal.RejectedAt.Format("2006-01-02"), proposal.RejectionComments)
+	}
+	return result
+}
+
+func formatKPIHistory(history *domain.KPIHistory) string {
+	result := fmt.Sprintf("📈 KPI History: %s", history.KPIID)
+	if history.Name != "" {
+		result += fmt.Sprintf(" (%s)", history.Name)
+	}
+	result += fmt.Sprintf("\nResolution: %s | Target: %.2f %s\n", history.Resolution, history.Target, history.Unit)
+	if history.Count == 0 {
+		return result + "No measurements in range\n"
+	}
+	result += fmt.Sprintf("Measurements: %d | Min: %.2f | Max: %.2f | Avg: %.2f\n", history.Count, history.Min, history.Max, history.Avg)
+	result += fmt.Sprintf("Trend: %s (%.3f per day)\n\n", history.Direction, history.SlopePerDay)
+
+	for _, point := range history.Points {
+		if history.Resolution == domain.KPIResolutionRaw {
+			result += fmt.Sprintf("• %s: %.2f", point.PeriodStart.Format("2006-01-02"), point.Last)
+		} else {
+			result += fmt.Sprintf("• %s: avg %.2f, min %.2f, max %.2f, last %.2f (%d measurements)",
+				point.PeriodStart.Format("2006-01-02"), point.Avg, point.Min, point.Max, point.Last, point.Count)
+		}
+		result += fmt.Sprintf(", %d/%d achieved\n", point.Achieved, point.Count)
+	}
+	return result
+}
+
+func formatAlertEvaluation(evaluation *domain.AlertEvaluation, indent string) string {
+	raised := make(map[string]bool)
+	for _, alert := range evaluation.Raised {
+		raised[alert.ID] = true
+	}
+
+	result := fmt.Sprintf("%sActive: %d | Raised: %d | Resolved: %d\n", indent, len(evaluation.Active), len(evaluation.Raised), len(evaluation.Resolved))
+	for _, alert := range evaluation.Active {
+		icon := "⚠️"
+		if alert.Severity == domain.AlertCritical {
+			icon = "🚨"
+		}
+		result += fmt.Sprintf("%s%s %s %s: %.2f (%s)", indent, icon, alert.Source, alert.Subject, alert.Value, alert.Threshold)
+		switch {
+		case raised[alert.ID] && alert.PreviousSeverity != "":
+			result += fmt.Sprintf(", was %s", alert.PreviousSeverity)
+		case raised[alert.ID]:
+			result += ", new"
+		default:
+			result += fmt.Sprintf(", since %s", alert.RaisedAt.Format("2006-01-02 15:04"))
+		}
+		result += "\n"
+	}
+	for _, alert := range evaluation.Resolved {
+		result += fmt.Sprintf("%s✅ %s %s resolved at %.2f\n", indent, alert.Source, alert.Subject, alert.Value)
+	}
+	return result
+}
+
+// formatRiskHeatMap renders a heat map as a likelihood-by-impact grid of risk counts, most likely
+// first, followed by the risks of each non-empty cell
+func formatRiskHeatMap(heatMap domain.RiskHeatMap, indent string) string {
+	result := fmt.Sprintf("%s%-15s", indent, "")
+	for _, impact := range domain.RiskImpacts() {
+		result += fmt.Sprintf("%9s", impact)
+	}
+	result += "\n"
+
+	likelihoods := domain.RiskLikelihoods()
+	for i := len(likelihoods) - 1; i >= 0; i-- {
+		result += fmt.Sprintf("%s%-15s", indent, likelihoods[i])
+		for _, impact := range domain.RiskImpacts() {
+			result += fmt.Sprintf("%9d", heatMap.Cell(likelihoods[i], impact).Count)
+		}
+		result += "\n"
+	}
+
+	for _, cell := range heatMap.Cells {
+		if cell.Count > 0 {
+			result += fmt.Sprintf("%s• %s/%s [%s]: %s\n", indent, cell.Likelihood, cell.Impact, cell.Rating, strings.Join(cell.RiskIDs, ", "))
+		}
+	}
+	return result
+}
+
+// formatComplianceDrift lists the requirements of a drift report whose status changed, flagging
+// violations, and the requirements added and removed
+func formatComplianceDrift(report domain.ComplianceDriftReport, indent string) string {
+	result := ""
+	for _, change := range report.Changes {
+		icon := "🔄"
+		if change.Violation() {
+			icon = "🚨"
+		}
+		result += fmt.Sprintf("%s%s %s: %s → %s\n", indent, icon, change.Requirement, change.Previous, change.Current)
+	}
+	for _, ref := range report.Added {
+		result += fmt.Sprintf("%s➕ %s\n", indent, ref)
+	}
+	for _, ref := range report.Removed {
+		result += fmt.Sprintf("%s➖ %s\n", indent, ref)
+	}
+	return result
+}
+
+func formatMonitoringHistory(history *domain.MonitoringHistory) string {
+	result := fmt.Sprintf("🗂️ Monitoring History of %s (%d snapshots)\n", history.AgreementID, len(history.Snapshots))
+	if len(history.Snapshots) == 0 {
+		return result + "No monitoring snapshots in range\n"
+	}
+
+	result += fmt.Sprintf("KPI attainment: %s (%.0f%% → %.0f%%)\n\n", history.KPIAttainment.Direction,
+		history.KPIAttainment.First*100, history.KPIAttainment.Latest*100)
+	for _, snapshot := range history.Snapshots {
+		achieved := 0
+		for _, kpi := range snapshot.KPIs {
+			if kpi.Achieved {
+				achieved++
+			}
+		}
+		result += fmt.Sprintf("• %s: %d/%d KPIs achieved, %d risk indicators, %d requirement gaps, %d compliance violations, %d active alerts, %d KPI anomalies\n",
+			snapshot.TakenAt.Format(time.RFC3339), achieved, len(snapshot.KPIs), len(snapshot.RiskIndicators),
+			snapshot.RequirementGaps, snapshot.ComplianceViolations, snapshot.ActiveAlerts, snapshot.KPIAnomalies)
+	}
+
+	if len(history.KPIs) > 0 {
+		result += "\nKPIs:\n"
+		for _, kpi := range history.KPIs {
+			result += fmt.Sprintf("• %s: achieved %d/%d (%.0f%%), %.2f → %.2f against %.2f, %s\n",
+				kpi.KPIID, kpi.Achieved, kpi.Snapshots, kpi.AttainmentRate*100, kpi.Value.First, kpi.Value.Latest, kpi.LatestTarget, kpi.Value.Direction)
+		}
+	}
+	if len(history.RiskIndicators) > 0 {
+		result += "\nRisk Indicators:\n"
+		for _, indicator := range history.RiskIndicators {
+			result += fmt.Sprintf("• %s: %.2f → %.2f, %s\n", indicator.Metric, indicator.First, indicator.Latest, indicator.Direction)
+		}
+	}
+	return result
+}
+
+// formatKPIScoreboard lists a portfolio's KPI attainment by application, most critical first, and
+// by KPI
+func formatKPIScoreboard(scoreboard domain.PortfolioKPIScoreboard, indent string) string {
+	result := fmt.Sprintf("%sWeighted attainment: %.0f%% (%d/%d KPIs on target)\n", indent, scoreboard.Attainment*100, scoreboard.Achieved, scoreboard.Total)
+	for _, app := range scoreboard.Applications {
+		result += fmt.Sprintf("%s• %s [%s, weight %.0f]: %d/%d on target (%.0f%%)\n",
+			indent, app.ApplicationID, app.Criticality, app.Weight, app.Achieved, app.Total, app.Attainment*100)
+	}
+	for _, kpi := range scoreboard.KPIs {
+		result += fmt.Sprintf("%s  ↳ %s: %d/%d applications on target, %.0f%% weighted\n",
+			indent, kpi.KPIID, kpi.Achieved, len(kpi.Applications), kpi.Attainment*100)
+	}
+	if len(scoreboard.Unmeasured) > 0 {
+		result += fmt.Sprintf("%sUnmeasured: %s\n", indent, joinApplicationIDs(scoreboard.Unmeasured))
+	}
+	return result
+}
+
+// formatSurveySummary renders a survey's respondents, scores and key insights
+func formatSurveySummary(summary domain.SurveySummary, indent string) string {
+	result := fmt.Sprintf("%sRespondents: %d", indent, summary.TotalResponses)
+	if summary.ResponseRate > 0 {
+		result += fmt.Sprintf(" (%.0f%% response rate)", summary.ResponseRate*100)
+	}
+	if summary.AverageScore > 0 {
+		result += fmt.Sprintf(" | Average: %.1f | Satisfaction: %.0f%%", summary.AverageScore, summary.Satisfaction)
+	}
+	result += "\n"
+	for _, question := range summary.Questions {
+		if question.Scored > 0 {
+			result += fmt.Sprintf("%s  %s. %s: %.1f (answers: %d)\n", indent, question.QuestionID, question.Question, question.AverageScore, question.Scored)
+		}
+	}
+	for _, insight := range summary.KeyInsights {
+		result += fmt.Sprintf("%s  ↳ %s\n", indent, insight)
+	}
+	return result
+}
+
+func formatKPIAnomalies(anomalies []domain.KPIAnomaly, indent string) string {
+	result := ""
+	for _, anomaly := range anomalies {
+		icon := "ℹ️"
+		switch {
+		case anomaly.EarlyWarning():
+			icon = "⚠️"
+		case anomaly.Adverse:
+			icon = "🚨"
+		}
+		result += fmt.Sprintf("%s%s %s on %s: %s", indent, icon, anomaly.KPIID, anomaly.MeasuredAt.Format("2006-01-02"), anomaly.Describe())
+		if anomaly.EarlyWarning() {
+			result += ", early warning: target still met"
+		}
+		result += "\n"
+	}
+	return result
+}
+
+func formatErrorBudgets(budgets []domain.ErrorBudget, indent string) string {
+	result := ""
+	for _, budget := range budgets {
+		icon := "🟢"
+		switch budget.Status {
+		case domain.ErrorBudgetAtRisk:
+			icon = "🟠"
+		case domain.ErrorBudgetExhausted:
+			icon = "🔴"
+		}
+		objective := fmt.Sprintf("%.2f%%", budget.SLO.Objective)
+		if budget.SLO.Metric == domain.SLAMetricResponseTime {
+			objective += fmt.Sprintf(" within %s", budget.SLO.ResponseTime)
+		}
+		result += fmt.Sprintf("%s%s %s %s (%s) [%s]\n", indent, icon, budget.SLO.ServiceName, budget.SLO.Metric, objective, budget.Status)
+		result += fmt.Sprintf("%s   %s to %s: %s of %s consumed, %s remaining\n", indent,
+			budget.PeriodStart.Format("2006-01-02"), budget.PeriodEnd.AddDate(0, 0, -1).Format("2006-01-02"),
+			budget.Consumed.Round(time.Second), budget.Budget.Round(time.Second), budget.Remaining.Round(time.Second))
+		if budget.Measurements == 0 {
+			result += fmt.Sprintf("%s   No measurements this period\n", indent)
+			continue
+		}
+		result += fmt.Sprintf("%s   Attainment %.3f%% over %s measured (%d measurements), burn rate %.2fx, %.0f%% projected by period end\n", indent,
+			budget.Attainment, budget.Measured.Round(time.Minute), budget.Measurements, budget.BurnRate, budget.ProjectedConsumption()*100)
+		if !budget.ProjectedExhaustion.IsZero() {
+			result += fmt.Sprintf("%s   ⚠️ Projected to run out on %s\n", indent, budget.ProjectedExhaustion.Format("2006-01-02 15:04"))
+		}
+	}
+	return result
+}
+
+func formatOperationalMetrics(metrics *domain.OperationalMetrics, indent string) string {
+	result := fmt.Sprintf("%sIncidents: %d (%.1f per month), %d open, %d resolved\n", indent,
+		metrics.Incidents, metrics.IncidentsPerMonth, metrics.Open, metrics.Resolved)
+	if metrics.Incidents == 0 {
+		return result
+	}
+	mtta, mttr := "n/a", "n/a"
+	if metrics.Acknowledged > 0 {
+		mtta = metrics.MTTA.Round(time.Second).String()
+	}
+	if metrics.Resolved > 0 {
+		mttr = metrics.MTTR.Round(time.Second).String()
+	}
+	result += fmt.Sprintf("%sMTTA: %s, MTTR: %s\n", indent, mtta, mttr)
+	severities := make([]string, len(metrics.Severities))
+	for i, severity := range metrics.Severities {
+		severities[i] = fmt.Sprintf("sev %d: %d", severity.Severity, severity.Count)
+	}
+	result += fmt.Sprintf("%sSeverity: %s\n", indent, strings.Join(severities, ", "))
+	return result
+}
+
+func formatChangeMetrics(metrics *domain.ChangeMetrics, indent string) string {
+	result := fmt.Sprintf("%sChanges: %d requested, %d rejected, %d withdrawn or cancelled, %d implemented (%.1f per month)\n", indent,
+		metrics.Requested, metrics.Rejected, metrics.Cancelled, metrics.Implemented, metrics.ChangesPerMonth)
+	if metrics.Implemented == 0 {
+		return result
+	}
+	result += fmt.Sprintf("%sSuccess rate: %.1f%% (%d of %d) [%s], emergency changes: %.1f%% (%d) [%s]\n", indent,
+		metrics.SuccessRate, metrics.Successful, metrics.Implemented, metrics.SuccessRateStatus(),
+		metrics.EmergencyRatio, metrics.Emergency, metrics.EmergencyRatioStatus())
+	result += fmt.Sprintf("%sLead time: %s from request to implementation\n", indent, metrics.LeadTime.Round(time.Minute))
+	return result
+}
+
+func formatChangeFailureRate(rate *domain.ChangeFailureRate, indent string) string {
+	result := fmt.Sprintf("%sChange failure rate: %.0f%% (%d of %d changes failed, rolled back or followed by incidents within %.0f hours)\n", indent,
+		rate.Rate, rate.Failed, rate.Implemented, rate.Window.Hours())
+	if len(rate.FailedChanges) > 0 {
+		result += fmt.Sprintf("%sFailed changes:\n", indent)
+	}
+	for _, change := range rate.FailedChanges {
+		result += fmt.Sprintf("%s• %s %s (implemented %s)", indent, change.ChangeRequestID, change.Title, change.ImplementedAt.Format("2006-01-02 15:04"))
+		if change.ImplementationFailed {
+			result += " failed or rolled back"
+		}
+		if len(change.Incidents) > 0 {
+			result += fmt.Sprintf(" → %s, highest severity %d", strings.Join(change.Incidents, ", "), change.HighestSeverity)
+		}
+		result += "\n"
+	}
+	return result
+}
+
+func formatEscalationSteps(steps []domain.EscalationStep, indent string) string {
+	result := ""
+	for _, step := range steps {
+		result += fmt.Sprintf("%s• %s %s: level %d after %s → %s (%s)\n", indent, step.Subject, step.SubjectID, step.Level,
+			step.Waiting.Round(time.Minute), strings.Join(step.Contacts, ", "), step.EscalatedAt.Format("2006-01-02 15:04"))
+	}
+	return result
+}
+
+// formatIncidentSLAChecks lists how incidents stand against their SLA, with the time left on
+// each target still outstanding
+func formatIncidentSLAChecks(checks []domain.IncidentSLACheck) string {
+	result := ""
+	for _, check := range checks {
+		result += fmt.Sprintf("• [%s] %s (severity %d): %s\n", check.Status, check.IncidentID, check.Severity, check.Title)
+		if check.ResponseRemaining != 0 {
+			result += fmt.Sprintf("  Response: %s\n", formatSLARemaining(check.ResponseRemaining))
+		}
+		if check.ResolutionRemaining != 0 {
+			result += fmt.Sprintf("  Resolution: %s\n", formatSLARemaining(check.ResolutionRemaining))
+		}
+		for _, target := range check.Breached {
+			result += fmt.Sprintf("  🚨 %s target breached\n", target)
+		}
+	}
+	return result
+}
+
+// formatSLARemaining describes the time left on an SLA target, e.g. "45m0s left" or "overdue by 2h0m0s"
+func formatSLARemaining(remaining time.Duration) string {
+	if remaining < 0 {
+		return fmt.Sprintf("overdue by %s", (-remaining).Round(time.Minute))
+	}
+	return fmt.Sprintf("%s left", remaining.Round(time.Minute))
+}
+
+// formatTelemetryBindings lists telemetry bindings with when they were last pulled
+func formatTelemetryBindings(bindings []domain.TelemetryBinding) string {
+	result := ""
+	for _, binding := range bindings {
+		result += fmt.Sprintf("• %s ← %s %s: %s (%s", binding.KPIID, binding.Source, binding.Metric, binding.Query, binding.Aggregation)
+		if binding.Scale > 0 && binding.Scale != 1 {
+			result += fmt.Sprintf(" × %g", binding.Scale)
+		}
+		result += fmt.Sprintf(", every %s)\n", binding.PullInterval())
+		if binding.ApplicationID != "" {
+			result += fmt.Sprintf("  Application: %s\n", binding.ApplicationID)
+		}
+		if !binding.LastIngestedAt.IsZero() {
+			result += fmt.Sprintf("  Pulled up to: %s\n", binding.LastIngestedAt.Format("2006-01-02 15:04"))
+		}
+		if binding.LastError != "" {
+			result += fmt.Sprintf("  ⚠️ Last pull failed: %s\n", binding.LastError)
+		}
+	}
+	return result
+}
+
+// formatExecutiveDigest renders a digest's executive summary with who it was sent to
+func formatExecutiveDigest(digest domain.ExecutiveDigest) string {
+	name := digest.PortfolioName
+	if name == "" {
+		name = string(digest.PortfolioID)
+	}
+	summary := digest.Summary
+	result := fmt.Sprintf("📰 %s executive digest of %s\n", digest.Frequency, name)
+	result += fmt.Sprintf("Period: %s\n", summary.Period)
+	if !digest.SentAt.IsZero() {
+		result += fmt.Sprintf("Sent to: %s\n", strings.Join(digest.Recipients, ", "))
+	}
+
+	result += "\n📊 Key Metrics:\n"
+	for _, metric := range summary.KeyMetrics {
+		statusEmoji := "✅"
+		switch metric.Status {
+		case string(domain.RiskStatusWarning):
+			statusEmoji = "⚠️"
+		case string(domain.RiskStatusCritical):
+			statusEmoji = "🚨"
+		}
+		result += fmt.Sprintf("   %s %s: %g %s", statusEmoji, metric.Name, metric.Value, metric.Unit)
+		if metric.Trend != string(domain.TrendInsufficientData) {
+			result += fmt.Sprintf(" (%s)", metric.Trend)
+		}
+		result += "\n"
+	}
+	for _, section := range []struct {
+		title string
+		items []string
+	}{
+		{"\n🏆 Achievements:\n", summary.Achievements},
+		{"\n🚧 Challenges:\n", summary.Challenges},
+		{"\n💡 Open Recommendations:\n", summary.Recommendations},
+	} {
+		if len(section.items) == 0 {
+			continue
+		}
+		result += section.title
+		for _, item := range section.items {
+			result += fmt.Sprintf("   • %s\n", item)
+		}
+	}
+	return result
+}
+
+// formatComplianceFramework renders a framework with its requirements by group
+func formatComplianceFramework(framework domain.ComplianceFramework) string {
+	result := fmt.Sprintf("📚 %s (%s)\n", framework.Name, framework.ID)
+	if framework.Description != "" {
+		result += framework.Description + "\n"
+	}
+	result += fmt.Sprintf("Issuer: %s, version %s, attached as %s requirements\n", framework.Issuer, framework.Version, framework.Kind)
+
+	for i, requirement := range framework.Requirements {
+		if requirement.Group != "" && (i == 0 || requirement.Group != framework.Requirements[i-1].Group) {
+			result += fmt.Sprintf("\n%s:\n", requirement.Group)
+		}
+		result += fmt.Sprintf("   • %s %s\n", requirement.ID, requirement.Title)
+	}
+	return result
+}
+
+// formatAppliedControlStatus renders the requirements a control's status was applied to
+func formatAppliedControlStatus(applied application.AppliedControlStatus) string {
+	if len(applied.Requirements) == 0 {
+		return fmt.Sprintf("🔗 %s: the agreement has no requirement for it or a control mapped to it\n", applied.Control)
+	}
+	result := fmt.Sprintf("🔗 %s is %s, applied to %d requirements:\n", applied.Control, applied.Status, len(applied.Requirements))
+	for _, ref := range applied.Requirements {
+		result += fmt.Sprintf("   • %s\n", ref)
+	}
+	return result
+}
+
+// controlList parses framework:requirement controls from a tool argument
+func controlList(value interface{}) ([]domain.ControlRef, error) {
+	var controls []domain.ControlRef
+	for _, item := range stringList(value) {
+		control, err := domain.ParseControlRef(item)
+		if err != nil {
+			return nil, err
+		}
+		controls = append(controls, control)
+	}
+	return controls, nil
+}
+
+// formatComplianceAssessment renders a compliance assessment with its score and the answer of
+// every control
+func formatComplianceAssessment(assessment domain.ComplianceAssessment) string {
+	score := assessment.Score
+	result := fmt.Sprintf("🧾 %s compliance assessment %s of %s (%s)\n", assessment.FrameworkName, assessment.ID, assessment.AgreementID, assessment.Status)
+	if assessment.Assessor != "" {
+		result += fmt.Sprintf("Assessor: %s\n", assessment.Assessor)
+	}
+	result += fmt.Sprintf("📊 Weighted compliance: %.1f%%\n", score.Percentage)
+	result += fmt.Sprintf("   ✅ %d compliant, 🟡 %d partial, ❌ %d non-compliant, 🔍 %d under review, ➖ %d not applicable, ⏳ %d unanswered\n",
+		score.Compliant, score.Partial, score.NonCompliant, score.UnderReview, score.NotApplicable, score.Unanswered)
+	if len(score.Groups) > 1 {
+		for _, group := range score.Groups {
+			result += fmt.Sprintf("   • %s: %.1f%%\n", group.Group, group.Percentage)
+		}
+	}
+
+	result += "\nControls:\n"
+	for _, control := range assessment.Controls {
+		answer, ok := assessment.Answer(control.RequirementID)
+		switch {
+		case !ok:
+			result += fmt.Sprintf("   ⏳ %s %s\n", control.RequirementID, control.Title)
+		case answer.NotApplicable:
+			result += fmt.Sprintf("   ➖ %s %s: not applicable\n", control.RequirementID, control.Title)
+		default:
+			result += fmt.Sprintf("   • %s %s: %s", control.RequirementID, control.Title, answer.Status)
+			if len(answer.EvidenceLinks) > 0 {
+				result += fmt.Sprintf(" (evidence: %s)", strings.Join(answer.EvidenceLinks, ", "))
+			}
+			result += "\n"
+		}
+	}
+	return result
+}
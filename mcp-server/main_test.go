@@ -0,0 +1,82 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+// stdioCall sends a tools/call message through the stdio request path, as serveStdio does
+func stdioCall(t *testing.T, server *MCPServer, id int, name string, args map[string]interface{}) *MCPResponse {
+	t.Helper()
+	line, err := json.Marshal(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      id,
+		"method":  "tools/call",
+		"params":  map[string]interface{}{"name": name, "arguments": args},
+	})
+	if err != nil {
+		t.Fatalf("failed to encode request: %v", err)
+	}
+	var req MCPRequest
+	if err := json.Unmarshal(line, &req); err != nil {
+		t.Fatalf("failed to decode request: %v", err)
+	}
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	return server.handleRequest(server.stdioContext(), req)
+}
+
+// TestAssessmentReviewAndSignOffOverStdio evaluates an application with the default evaluator
+// over stdio and has it reviewed and signed off by two other named actors
+func TestAssessmentReviewAndSignOffOverStdio(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.SeedDemoData = true
+	server, err := NewMCPServer(cfg)
+	if err != nil {
+		t.Fatalf("NewMCPServer() error = %v", err)
+	}
+
+	if response := stdioCall(t, server, 1, "evaluate_application", map[string]interface{}{"application_id": "crm-global-001"}); response.Error != nil {
+		t.Fatalf("evaluate_application error = %s", response.Error.Message)
+	}
+	assessment, err := server.assessmentRepo.FindLatest(server.ctx, "crm-global-001")
+	if err != nil {
+		t.Fatalf("FindLatest() error = %v", err)
+	}
+	if assessment.Evaluator != stdioCaller.Subject {
+		t.Fatalf("evaluator = %q, want %q", assessment.Evaluator, stdioCaller.Subject)
+	}
+
+	steps := []struct {
+		tool      string
+		args      map[string]interface{}
+		wantError string
+		wantText  string
+	}{
+		{"review_assessment", map[string]interface{}{"reviewer": "MCP Assistant"}, "segregation of duties", ""},
+		{"review_assessment", map[string]interface{}{"reviewer": "bob"}, "", "reviewed by bob"},
+		{"attach_evidence", map[string]interface{}{"evidence_id": "EV-1", "attached_by": "bob", "kind": "document", "title": "Evaluation report", "uri": "https://docs.example.com/crm/report.pdf", "sha256": strings.Repeat("ab", 32)}, "", ""},
+		{"attach_evidence", map[string]interface{}{"evidence_id": "EV-2", "attached_by": "bob", "kind": "report_link", "title": "Evaluation dashboard", "uri": "https://bi.example.com/crm"}, "", ""},
+		{"sign_off_assessment", map[string]interface{}{"approver": "MCP Assistant"}, "segregation of duties", ""},
+		{"sign_off_assessment", map[string]interface{}{"approver": "carol"}, "", "signed off by carol\nEvaluated by MCP Assistant, reviewed by bob"},
+	}
+	for i, step := range steps {
+		step.args["application_id"] = "crm-global-001"
+		step.args["assessment_id"] = assessment.ID
+		response := stdioCall(t, server, i+2, step.tool, step.args)
+		if step.wantError != "" {
+			if response.Error == nil || !strings.Contains(response.Error.Message, step.wantError) {
+				t.Errorf("step %d %s: error %+v, want one containing %q", i+1, step.tool, response.Error, step.wantError)
+			}
+			continue
+		}
+		if response.Error != nil {
+			t.Fatalf("step %d %s error = %s", i+1, step.tool, response.Error.Message)
+		}
+		text := response.Result.(CallToolResult).Content[0].Text
+		if !strings.Contains(text, step.wantText) {
+			t.Errorf("step %d %s returned %q, want it to contain %q", i+1, step.tool, text, step.wantText)
+		}
+	}
+}
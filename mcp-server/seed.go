@@ -0,0 +1,32 @@
+package main
+
+import (
+	"io"
+
+	"github.com/iso38500/iso38500-governance-sdk/demo"
+)
+
+// demoEnvironment exposes the server repositories and services to the demo package
+func (s *MCPServer) demoEnvironment() demo.Environment {
+	return demo.Environment{
+		AppRepo:             s.appRepo,
+		PortfolioService:    s.portfolioService,
+		GovernanceService:   s.governanceService,
+		DecisionService:     s.decisionService,
+		KPIService:          s.kpiService,
+		NotificationService: s.notificationService,
+		MonitoringRunner:    s.monitoringRunner,
+		ServiceLevelService: s.serviceLevelService,
+	}
+}
+
+// seedDemoData populates the repositories with the enterprise demo landscape
+func (s *MCPServer) seedDemoData() error {
+	result, err := demo.Seed(s.ctx, s.demoEnvironment(), io.Discard)
+	if err != nil {
+		return err
+	}
+
+	s.logger.Infof("Seeded %d demo applications into %d portfolios", result.Applications, result.Portfolios)
+	return nil
+}
@@ -0,0 +1,6215 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/iso38500/iso38500-governance-sdk/application"
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+	"github.com/iso38500/iso38500-governance-sdk/infrastructure/audittrail"
+	"github.com/iso38500/iso38500-governance-sdk/infrastructure/projection"
+	"github.com/iso38500/iso38500-governance-sdk/infrastructure/tracing"
+)
+
+// Toolsets group tools that become available together
+const (
+	toolsetCore             = "core"
+	toolsetChangeManagement = "change_management"
+)
+
+// toolHandler executes a tool call with the supplied arguments
+type toolHandler func(ctx context.Context, args map[string]interface{}) (interface{}, error)
+
+// toolDefinition pairs an MCP tool with its handler and owning toolset
+type toolDefinition struct {
+	Toolset string
+	Handler toolHandler
+	Tool    Tool
+}
+
+// availableTools returns the tools of all currently enabled toolsets
+func (s *MCPServer) availableTools() []Tool {
+	tools := make([]Tool, 0, len(s.tools))
+	for _, definition := range s.tools {
+		if s.isToolEnabled(definition) {
+			tools = append(tools, definition.Tool)
+		}
+	}
+	return tools
+}
+
+// findTool looks up an enabled tool by name
+func (s *MCPServer) findTool(name string) (toolDefinition, bool) {
+	for _, definition := range s.tools {
+		if definition.Tool.Name == name && s.isToolEnabled(definition) {
+			return definition, true
+		}
+	}
+	return toolDefinition{}, false
+}
+
+// isToolEnabled reports whether a tool's toolset is enabled and the tool is not disabled by configuration
+func (s *MCPServer) isToolEnabled(definition toolDefinition) bool {
+	return s.toolsets[definition.Toolset] && !s.disabledTools[definition.Tool.Name]
+}
+
+// setToolsetEnabled enables or disables a toolset and notifies the client when the tool list changes
+func (s *MCPServer) setToolsetEnabled(toolset string, enabled bool) {
+	if s.toolsets[toolset] == enabled {
+		return
+	}
+	s.toolsets[toolset] = enabled
+
+	s.logger.Debugf("Toolset %s enabled=%t", toolset, enabled)
+	if s.initialized {
+		s.sendNotification("notifications/tools/list_changed", nil)
+	}
+}
+
+// ConfigureChangeManagement wires change, incident and audit repositories and exposes their tools.
+// It replaces services the background jobs use, so once the server is serving it must be called
+// with the server lock held, as tool calls are.
+func (s *MCPServer) ConfigureChangeManagement(changeRepo domain.ChangeRequestRepository, incidentRepo domain.IncidentRepository, auditRepo domain.AuditRepository) {
+	if s.auditTrail != nil {
+		changeRepo = audittrail.NewChangeRequestRepository(changeRepo, s.auditTrail)
+	}
+	var opts []application.ServiceOption
+	if s.tracer != nil {
+		changeRepo = tracing.NewChangeRequestRepository(changeRepo, s.tracer)
+		incidentRepo = tracing.NewIncidentRepository(incidentRepo, s.tracer)
+		auditRepo = tracing.NewAuditRepository(auditRepo, s.tracer)
+		opts = append(opts, application.WithTracer(s.tracer))
+	}
+	s.changeService = application.NewChangeManagementService(changeRepo, incidentRepo, auditRepo, s.appRepo, s.eventRepo, opts...)
+	s.changeService.SetSegregationOfDutiesPolicy(s.config.SegregationOfDutiesPolicy())
+	s.changeService.SetApprovalMatrix(s.config.ChangeApprovalMatrix())
+	s.changeService.SetGovernanceAgreementRepository(s.govRepo)
+	s.changeService.SetPortfolioRepository(s.portfolioRepo)
+	s.digestService.SetChangeRepositories(changeRepo, incidentRepo)
+	s.sodService = application.NewSegregationOfDutiesService(s.appRepo, changeRepo, s.assessmentRepo, opts...)
+	s.sodService.SetPolicy(s.config.SegregationOfDutiesPolicy())
+	if s.notifier != nil {
+		s.notificationService = application.NewNotificationService(s.governanceService, s.govRepo, s.portfolioRepo, changeRepo, s.notifier, opts...)
+	}
+	s.escalationService = application.NewEscalationService(s.govRepo, incidentRepo, changeRepo, s.escalationRepo, s.notifier, s.eventRepo, opts...)
+	s.incidentSLAService = application.NewIncidentSLAService(s.govRepo, incidentRepo, s.notifier, s.eventRepo, opts...)
+	s.problemService = application.NewProblemService(s.problemRepo, incidentRepo, changeRepo, s.appRepo, s.eventRepo, opts...)
+	s.retentionService = application.NewRetentionService(s.retentionPolicyRepo, s.dataHoldingRepo, s.appRepo, changeRepo, s.eventRepo, opts...)
+	s.itsmSyncService = application.NewITSMSyncService(s.changeService, changeRepo, incidentRepo, s.appRepo, s.itsmLinkRepo, s.itsmConnectors, opts...)
+	for _, targetConfig := range s.config.ITSM.Targets {
+		target, err := targetConfig.SyncTarget()
+		if err == nil {
+			err = s.itsmSyncService.SetTarget(target)
+		}
+		if err != nil {
+			s.logger.Warnf("ITSM target %s not mirrored: %v", targetConfig.Kind, err)
+		}
+	}
+	s.startITSMSync()
+	s.setToolsetEnabled(toolsetChangeManagement, true)
+}
+
+// startITSMSync syncs the ITSM records in the background every configured interval, stopping the
+// sync of the service it replaces
+func (s *MCPServer) startITSMSync() {
+	if s.stopITSMSync != nil {
+		s.stopITSMSync()
+		s.stopITSMSync = nil
+	}
+	if s.config.ITSM.Interval == "" {
+		return
+	}
+	interval, _ := time.ParseDuration(s.config.ITSM.Interval)
+	ctx, cancel := context.WithCancel(s.ctx)
+	s.stopITSMSync = cancel
+	go s.itsmSyncService.Start(ctx, interval, func(err error) {
+		s.logger.Warnf("ITSM sync: %v", err)
+	})
+}
+
+// toolDefinitions returns every tool the server knows about, regardless of toolset state
+func (s *MCPServer) toolDefinitions() []toolDefinition {
+	return append(s.coreTools(), s.changeManagementTools()...)
+}
+
+// coreTools returns the portfolio and governance tools that are always available
+func (s *MCPServer) coreTools() []toolDefinition {
+	return []toolDefinition{
+		{
+			Toolset: toolsetCore,
+			Handler: s.createApplication,
+			Tool: Tool{
+				Name:        "create_application",
+				Description: "Create a new application in the governance portfolio",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"id": map[string]interface{}{
+							"type":        "string",
+							"description": "Unique application identifier",
+						},
+						"name": map[string]interface{}{
+							"type":        "string",
+							"description": "Application name",
+						},
+						"description": map[string]interface{}{
+							"type":        "string",
+							"description": "Application description",
+						},
+						"version": map[string]interface{}{
+							"type":        "string",
+							"description": "Application version",
+						},
+						"category": map[string]interface{}{
+							"type":        "string",
+							"description": "Business category used for benchmarking (e.g. Core Business, Operational, Infrastructure, Analytics, Legacy)",
+						},
+						"criticality": map[string]interface{}{
+							"type":        "string",
+							"description": "Business criticality weighting the application in portfolio KPI roll-ups (default: the highest priority of its functionality, or medium)",
+							"enum":        []string{"critical", "high", "medium", "low"},
+						},
+						"cost": map[string]interface{}{
+							"type":        "object",
+							"description": "Annual running costs and one-off acquisition cost",
+							"properties": map[string]interface{}{
+								"license":        map[string]interface{}{"type": "number"},
+								"infrastructure": map[string]interface{}{"type": "number"},
+								"support":        map[string]interface{}{"type": "number"},
+								"personnel":      map[string]interface{}{"type": "number"},
+								"acquisition":    map[string]interface{}{"type": "number"},
+								"currency":       map[string]interface{}{"type": "string"},
+							},
+						},
+						"data_classification": map[string]interface{}{
+							"type":        "string",
+							"description": "Sensitivity of the data the application holds",
+							"enum":        []string{"public", "internal", "confidential", "restricted"},
+						},
+						"pii": map[string]interface{}{
+							"type":        "boolean",
+							"description": "The application holds personally identifiable information",
+						},
+						"phi": map[string]interface{}{
+							"type":        "boolean",
+							"description": "The application holds protected health information",
+						},
+						"interfaces": map[string]interface{}{
+							"type":        "array",
+							"description": "Technical interfaces of the application; those naming a provider make it depend on that application in the dependency graph",
+							"items": map[string]interface{}{
+								"type": "object",
+								"properties": map[string]interface{}{
+									"id":                      map[string]interface{}{"type": "string"},
+									"name":                    map[string]interface{}{"type": "string"},
+									"type":                    map[string]interface{}{"type": "string", "enum": []string{"api", "database", "file", "message", "ui"}},
+									"protocol":                map[string]interface{}{"type": "string"},
+									"status":                  map[string]interface{}{"type": "string", "enum": []string{"active", "inactive", "testing", "failed"}},
+									"provider_application_id": map[string]interface{}{"type": "string", "description": "Application serving the interface this application consumes"},
+								},
+							},
+						},
+					},
+					"required": []string{"id", "name", "description"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.createPortfolio,
+			Tool: Tool{
+				Name:        "create_portfolio",
+				Description: "Create a new application portfolio",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"id": map[string]interface{}{
+							"type":        "string",
+							"description": "Unique portfolio identifier",
+						},
+						"name": map[string]interface{}{
+							"type":        "string",
+							"description": "Portfolio name",
+						},
+						"description": map[string]interface{}{
+							"type":        "string",
+							"description": "Portfolio description",
+						},
+						"owner": map[string]interface{}{
+							"type":        "string",
+							"description": "Portfolio owner",
+						},
+					},
+					"required": []string{"id", "name", "description", "owner"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.addToPortfolio,
+			Tool: Tool{
+				Name:        "add_to_portfolio",
+				Description: "Add an application to a portfolio",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"portfolio_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Portfolio identifier",
+						},
+						"application_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Application identifier",
+						},
+					},
+					"required": []string{"portfolio_id", "application_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.setPortfolioThresholds,
+			Tool: Tool{
+				Name:        "set_portfolio_thresholds",
+				Description: "Set a portfolio's own risk level thresholds and KPI tolerance, overriding the evaluation profile",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"portfolio_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Portfolio identifier",
+						},
+						"critical_max_technical_score": map[string]interface{}{
+							"type":        "number",
+							"description": "Average technical score (1-5) at or below which risk is critical (default: 2)",
+						},
+						"high_max_technical_score": map[string]interface{}{
+							"type":        "number",
+							"description": "Average technical score at or below which risk is high (default: 3)",
+						},
+						"medium_max_technical_score": map[string]interface{}{
+							"type":        "number",
+							"description": "Average technical score at or below which risk is medium (default: 4)",
+						},
+						"critical_min_cost_efficiency": map[string]interface{}{
+							"type":        "number",
+							"description": "Cost efficiency percentage below which risk is critical (default: 50)",
+						},
+						"high_min_cost_efficiency": map[string]interface{}{
+							"type":        "number",
+							"description": "Cost efficiency percentage below which risk is high (default: 70)",
+						},
+						"kpi_tolerance": map[string]interface{}{
+							"type":        "number",
+							"description": "Percentage of its target a KPI may miss by and still count as achieved (default: 0)",
+						},
+						"clear": map[string]interface{}{
+							"type":        "boolean",
+							"description": "Remove the portfolio's thresholds and use the evaluation profile again",
+						},
+					},
+					"required": []string{"portfolio_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.classifyApplicationData,
+			Tool: Tool{
+				Name:        "classify_application_data",
+				Description: "Classify the data an application holds as public, internal, confidential or restricted and flag personal (PII) or health (PHI) information; evaluations escalate risk and recommend controls when security falls short of what the data requires",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"application_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Application identifier",
+						},
+						"classification": map[string]interface{}{
+							"type":        "string",
+							"description": "Sensitivity of the data the application holds",
+							"enum":        []string{"public", "internal", "confidential", "restricted"},
+						},
+						"pii": map[string]interface{}{
+							"type":        "boolean",
+							"description": "The application holds personally identifiable information (default: false)",
+						},
+						"phi": map[string]interface{}{
+							"type":        "boolean",
+							"description": "The application holds protected health information (default: false)",
+						},
+					},
+					"required": []string{"application_id", "classification"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.scopeDataClassification,
+			Tool: Tool{
+				Name:        "scope_data_classification",
+				Description: "Find the applications of a portfolio, or of every portfolio, holding data of a classification, e.g. to scope an audit against GDPR or HIPAA",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"portfolio_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Portfolio identifier (default: every application)",
+						},
+						"min_classification": map[string]interface{}{
+							"type":        "string",
+							"description": "Only applications whose data is classified at least this sensitive",
+							"enum":        []string{"public", "internal", "confidential", "restricted"},
+						},
+						"pii": map[string]interface{}{
+							"type":        "boolean",
+							"description": "Only applications holding personally identifiable information",
+						},
+						"phi": map[string]interface{}{
+							"type":        "boolean",
+							"description": "Only applications holding protected health information",
+						},
+					},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.setRetentionPolicy,
+			Tool: Tool{
+				Name:        "set_retention_policy",
+				Description: "Add or replace a data retention policy for an application, a data classification or every application, and one data category or all of them; the most specific policy applies",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"id": map[string]interface{}{
+							"type":        "string",
+							"description": "Unique policy identifier",
+						},
+						"name": map[string]interface{}{
+							"type":        "string",
+							"description": "Policy name",
+						},
+						"retention_days": map[string]interface{}{
+							"type":        "number",
+							"description": "Days data may be held after it was created",
+						},
+						"application_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Application the policy applies to (default: any)",
+						},
+						"classification": map[string]interface{}{
+							"type":        "string",
+							"description": "Data classification the policy applies to (default: any)",
+							"enum":        []string{"public", "internal", "confidential", "restricted"},
+						},
+						"data_category": map[string]interface{}{
+							"type":        "string",
+							"description": "Category of data the policy applies to, e.g. customer records (default: any)",
+						},
+						"action": map[string]interface{}{
+							"type":        "string",
+							"description": "What to do with data held beyond retention (default: purge)",
+							"enum":        []string{"purge", "anonymize", "archive"},
+						},
+						"legal_basis": map[string]interface{}{
+							"type":        "string",
+							"description": "Why the data is kept that long, e.g. a statute or contract clause",
+						},
+					},
+					"required": []string{"id", "name", "retention_days"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.listRetentionPolicies,
+			Tool: Tool{
+				Name:        "list_retention_policies",
+				Description: "List the data retention policies",
+				InputSchema: map[string]interface{}{
+					"type":       "object",
+					"properties": map[string]interface{}{},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.recordDataHolding,
+			Tool: Tool{
+				Name:        "record_data_holding",
+				Description: "Record how many records of a data category an application holds and when the oldest was created, replacing what was last recorded",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"application_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Application identifier",
+						},
+						"category": map[string]interface{}{
+							"type":        "string",
+							"description": "Category of data, e.g. customer records",
+						},
+						"records": map[string]interface{}{
+							"type":        "number",
+							"description": "Number of records held",
+						},
+						"oldest_record_at": map[string]interface{}{
+							"type":        "string",
+							"description": "Creation date of the oldest record held, YYYY-MM-DD",
+						},
+						"legal_hold": map[string]interface{}{
+							"type":        "string",
+							"description": "Why the data must be kept regardless of policy, e.g. pending litigation (default: no hold)",
+						},
+					},
+					"required": []string{"application_id", "category", "records", "oldest_record_at"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.checkRetention,
+			Tool: Tool{
+				Name:        "check_retention",
+				Description: "Flag data held beyond its retention policy and, with change management, schedule a purge change request for each",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"application_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Application identifier (default: every application)",
+						},
+						"requester": map[string]interface{}{
+							"type":        "string",
+							"description": "Requester of the purge change requests (default: retention engine)",
+						},
+					},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.createGovernanceAgreement,
+			Tool: Tool{
+				Name:        "create_governance_agreement",
+				Description: "Create a governance agreement for an application",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"id": map[string]interface{}{
+							"type":        "string",
+							"description": "Unique agreement identifier",
+						},
+						"application_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Application identifier",
+						},
+						"title": map[string]interface{}{
+							"type":        "string",
+							"description": "Agreement title",
+						},
+					},
+					"required": []string{"id", "application_id", "title"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.evaluateApplication,
+			Tool: Tool{
+				Name:        "evaluate_application",
+				Description: "Evaluate an application for governance compliance",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"application_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Application identifier to evaluate",
+						},
+						"evaluator": map[string]interface{}{
+							"type":        "string",
+							"description": "Name of the evaluator",
+						},
+						"profile": map[string]interface{}{
+							"type":        "string",
+							"description": "Evaluation profile to score with",
+							"enum":        []string{"default", "regulated"},
+						},
+					},
+					"required": []string{"application_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.evaluatePortfolio,
+			Tool: Tool{
+				Name:        "evaluate_portfolio",
+				Description: "Evaluate an entire portfolio for governance compliance",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"portfolio_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Portfolio identifier to evaluate",
+						},
+						"profile": map[string]interface{}{
+							"type":        "string",
+							"description": "Evaluation profile to score with",
+							"enum":        []string{"default", "regulated"},
+						},
+					},
+					"required": []string{"portfolio_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.comparePortfolios,
+			Tool: Tool{
+				Name:        "compare_portfolios",
+				Description: "Evaluate all portfolios and compare their health index, risk mix, cost and governance coverage to show which business domain is lagging",
+				InputSchema: map[string]interface{}{
+					"type":       "object",
+					"properties": map[string]interface{}{},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.simulatePortfolio,
+			Tool: Tool{
+				Name:        "simulate_portfolio",
+				Description: "Project the portfolio health and risk distribution of hypothetical changes without applying them",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"portfolio_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Portfolio to simulate",
+						},
+						"scenario_name": map[string]interface{}{
+							"type":        "string",
+							"description": "Label for the scenario",
+						},
+						"retire_application_ids": map[string]interface{}{
+							"type":        "array",
+							"description": "Applications to retire",
+							"items":       map[string]interface{}{"type": "string"},
+						},
+						"add_applications": map[string]interface{}{
+							"type":        "array",
+							"description": "Planned applications to add, each with id, name, category and optional cost",
+							"items":       map[string]interface{}{"type": "object"},
+						},
+						"merge_portfolio_ids": map[string]interface{}{
+							"type":        "array",
+							"description": "Portfolios whose applications are merged in",
+							"items":       map[string]interface{}{"type": "string"},
+						},
+					},
+					"required": []string{"portfolio_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.identifyRisk,
+			Tool: Tool{
+				Name:        "identify_risk",
+				Description: "Record a risk with its probability, impact and optional loss estimate in a governance agreement",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement identifier",
+						},
+						"id": map[string]interface{}{
+							"type":        "string",
+							"description": "Unique risk identifier; an existing risk with this ID is replaced",
+						},
+						"name": map[string]interface{}{
+							"type":        "string",
+							"description": "Risk name",
+						},
+						"description": map[string]interface{}{
+							"type":        "string",
+							"description": "Risk description",
+						},
+						"category": map[string]interface{}{
+							"type":        "string",
+							"description": "Risk category",
+						},
+						"probability": map[string]interface{}{
+							"type":        "number",
+							"description": "Annual probability of the risk materializing (0-1)",
+						},
+						"impact": map[string]interface{}{
+							"type":        "string",
+							"description": "Impact if the risk materializes",
+							"enum":        []string{"low", "medium", "high", "critical"},
+						},
+						"level": map[string]interface{}{
+							"type":        "string",
+							"description": "Assessed risk level",
+							"enum":        []string{"low", "medium", "high", "critical"},
+						},
+						"loss_minimum": map[string]interface{}{
+							"type":        "number",
+							"description": "Smallest plausible loss",
+						},
+						"loss_most_likely": map[string]interface{}{
+							"type":        "number",
+							"description": "Most likely loss",
+						},
+						"loss_maximum": map[string]interface{}{
+							"type":        "number",
+							"description": "Largest plausible loss",
+						},
+					},
+					"required": []string{"agreement_id", "id", "name", "probability", "impact"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.simulatePortfolioRisk,
+			Tool: Tool{
+				Name:        "simulate_portfolio_risk",
+				Description: "Run a Monte Carlo simulation of a portfolio's identified risks and report P50/P90 loss exposure",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"portfolio_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Portfolio identifier",
+						},
+						"iterations": map[string]interface{}{
+							"type":        "number",
+							"description": "Number of simulated years (default 10000, at most 100000)",
+						},
+						"seed": map[string]interface{}{
+							"type":        "number",
+							"description": "Random seed for repeatable results, including 0 (default 38500)",
+						},
+					},
+					"required": []string{"portfolio_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.scheduleEvaluation,
+			Tool: Tool{
+				Name:        "schedule_evaluation",
+				Description: "Schedule a recurring evaluation of an agreement's application or of a portfolio using a cron expression",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"id": map[string]interface{}{
+							"type":        "string",
+							"description": "Schedule identifier",
+						},
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement whose application is evaluated",
+						},
+						"portfolio_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Portfolio to evaluate when no agreement is given",
+						},
+						"cron": map[string]interface{}{
+							"type":        "string",
+							"description": "Five-field cron expression or @hourly, @daily, @weekly, @monthly",
+						},
+						"evaluator": map[string]interface{}{
+							"type":        "string",
+							"description": "Evaluator recorded on the assessments (default scheduler)",
+						},
+					},
+					"required": []string{"id", "cron"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.listEvaluationSchedules,
+			Tool: Tool{
+				Name:        "list_evaluation_schedules",
+				Description: "List recurring evaluation schedules with their next and last runs",
+				InputSchema: map[string]interface{}{
+					"type":       "object",
+					"properties": map[string]interface{}{},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.cancelEvaluationSchedule,
+			Tool: Tool{
+				Name:        "cancel_evaluation_schedule",
+				Description: "Cancel a recurring evaluation schedule",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"id": map[string]interface{}{
+							"type":        "string",
+							"description": "Schedule identifier",
+						},
+					},
+					"required": []string{"id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.listMonitoringRuns,
+			Tool: Tool{
+				Name:        "list_monitoring_runs",
+				Description: "List the scheduled monitoring runs of a governance agreement, oldest first",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement identifier",
+						},
+					},
+					"required": []string{"agreement_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.getMonitoringHistory,
+			Tool: Tool{
+				Name:        "get_monitoring_history",
+				Description: "Review the monitoring snapshots of a governance agreement over a date range with the trends of KPI attainment and risk indicators",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement identifier",
+						},
+						"from": map[string]interface{}{
+							"type":        "string",
+							"description": "Start of the range (YYYY-MM-DD, default: the first snapshot)",
+						},
+						"to": map[string]interface{}{
+							"type":        "string",
+							"description": "End of the range, inclusive (YYYY-MM-DD, default: the latest snapshot)",
+						},
+					},
+					"required": []string{"agreement_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.getAssessmentHistory,
+			Tool: Tool{
+				Name:        "get_assessment_history",
+				Description: "List past evaluations of an application, oldest first",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"application_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Application identifier",
+						},
+					},
+					"required": []string{"application_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.compareAssessments,
+			Tool: Tool{
+				Name:        "compare_assessments",
+				Description: "Diff two evaluations of an application: risk level, score and recommendation changes",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"application_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Application identifier",
+						},
+						"from_assessment_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Earlier assessment (default: the one before to_assessment_id)",
+						},
+						"to_assessment_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Later assessment (default: the latest)",
+						},
+					},
+					"required": []string{"application_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.reviewAssessment,
+			Tool: Tool{
+				Name:        "review_assessment",
+				Description: "Review a draft assessment; critical-risk assessments need a reviewer other than the evaluator",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"application_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Application identifier",
+						},
+						"assessment_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Assessment identifier (default: the latest)",
+						},
+						"reviewer": map[string]interface{}{
+							"type":        "string",
+							"description": "Reviewer name (default: the authenticated principal)",
+						},
+						"comments": map[string]interface{}{
+							"type":        "string",
+							"description": "Review comments",
+						},
+					},
+					"required": []string{"application_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.signOffAssessment,
+			Tool: Tool{
+				Name:        "sign_off_assessment",
+				Description: "Sign off a reviewed assessment",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"application_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Application identifier",
+						},
+						"assessment_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Assessment identifier (default: the latest)",
+						},
+						"approver": map[string]interface{}{
+							"type":        "string",
+							"description": "Approver name (default: the authenticated principal)",
+						},
+					},
+					"required": []string{"application_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.attachEvidence,
+			Tool: Tool{
+				Name:        "attach_evidence",
+				Description: "Attach a document, screenshot or report link with its SHA-256 hash to an assessment or audit finding",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"application_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Application identifier (for assessment evidence)",
+						},
+						"assessment_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Assessment identifier (default: the latest)",
+						},
+						"audit_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Audit identifier (attaches to an audit finding instead of an assessment)",
+						},
+						"finding_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Audit finding identifier",
+						},
+						"evidence_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Evidence identifier",
+						},
+						"kind": map[string]interface{}{
+							"type":        "string",
+							"description": "Evidence kind",
+							"enum":        []string{"document", "screenshot", "report_link"},
+						},
+						"uri": map[string]interface{}{
+							"type":        "string",
+							"description": "Document location or report link",
+						},
+						"sha256": map[string]interface{}{
+							"type":        "string",
+							"description": "Hex encoded SHA-256 hash of the content (required for documents and screenshots)",
+						},
+						"title": map[string]interface{}{
+							"type":        "string",
+							"description": "Evidence title",
+						},
+						"description": map[string]interface{}{
+							"type":        "string",
+							"description": "What the evidence shows",
+						},
+						"source": map[string]interface{}{
+							"type":        "string",
+							"description": "System or person the content was collected from",
+						},
+						"collected_at": map[string]interface{}{
+							"type":        "string",
+							"description": "Date the content was collected, YYYY-MM-DD (default: today)",
+						},
+						"valid_until": map[string]interface{}{
+							"type":        "string",
+							"description": "Date the evidence goes stale, YYYY-MM-DD (default: it does not expire)",
+						},
+						"attached_by": map[string]interface{}{
+							"type":        "string",
+							"description": "Person attaching the evidence",
+						},
+						"controls": map[string]interface{}{
+							"type":        "array",
+							"items":       map[string]interface{}{"type": "string"},
+							"description": "Framework controls the evidence shows are implemented, as framework:requirement (e.g. iso27001-2022:A.8.13); they and the controls mapped to them become compliant",
+						},
+					},
+					"required": []string{"evidence_id", "kind", "uri", "attached_by"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.expireStaleEvidence,
+			Tool: Tool{
+				Name:        "expire_stale_evidence",
+				Description: "Detect evidence past its validity and put the compliance statuses and assessment answers resting on it back under review",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"now": map[string]interface{}{
+							"type":        "string",
+							"description": "Date to detect stale evidence at, YYYY-MM-DD (default: now)",
+						},
+					},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.listEvidence,
+			Tool: Tool{
+				Name:        "list_evidence",
+				Description: "List the evidence attached to an assessment or to the findings of an audit",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"application_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Application identifier (for assessment evidence)",
+						},
+						"assessment_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Assessment identifier (default: the latest)",
+						},
+						"audit_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Audit identifier (lists finding evidence instead of assessment evidence)",
+						},
+					},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.defineKPI,
+			Tool: Tool{
+				Name:        "define_kpi",
+				Description: "Define a KPI, or redefine an existing one such as to change its target",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"kpi_id": map[string]interface{}{
+							"type":        "string",
+							"description": "KPI identifier",
+						},
+						"name": map[string]interface{}{
+							"type":        "string",
+							"description": "KPI name",
+						},
+						"description": map[string]interface{}{
+							"type":        "string",
+							"description": "What the KPI measures",
+						},
+						"target": map[string]interface{}{
+							"type":        "number",
+							"description": "Target value",
+						},
+						"unit": map[string]interface{}{
+							"type":        "string",
+							"description": "Unit of measure",
+						},
+						"category": map[string]interface{}{
+							"type":        "string",
+							"description": "KPI category; lower values are better for efficiency KPIs",
+						},
+						"frequency": map[string]interface{}{
+							"type":        "string",
+							"description": "How often the KPI is measured",
+							"enum":        []string{"daily", "weekly", "monthly", "quarterly"},
+						},
+						"defined_by": map[string]interface{}{
+							"type":        "string",
+							"description": "Who defines the KPI (default: the authenticated principal)",
+						},
+					},
+					"required": []string{"kpi_id", "name", "target"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.recordKPIMeasurement,
+			Tool: Tool{
+				Name:        "record_kpi_measurement",
+				Description: "Record a measurement of a defined KPI against its target",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"kpi_id": map[string]interface{}{
+							"type":        "string",
+							"description": "KPI identifier",
+						},
+						"value": map[string]interface{}{
+							"type":        "number",
+							"description": "Measured value",
+						},
+						"measured_at": map[string]interface{}{
+							"type":        "string",
+							"description": "When the value was measured (YYYY-MM-DD, default: now)",
+						},
+						"notes": map[string]interface{}{
+							"type":        "string",
+							"description": "Measurement notes",
+						},
+						"recorded_by": map[string]interface{}{
+							"type":        "string",
+							"description": "Who records the measurement (default: the authenticated principal)",
+						},
+					},
+					"required": []string{"kpi_id", "value"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.listKPIs,
+			Tool: Tool{
+				Name:        "list_kpis",
+				Description: "List the defined KPIs with their status",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"category": map[string]interface{}{
+							"type":        "string",
+							"description": "Only KPIs of this category",
+						},
+					},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.getKPIHistory,
+			Tool: Tool{
+				Name:        "get_kpi_history",
+				Description: "Chart a KPI's measurements over a time range, downsampled to a resolution with the minimum, maximum and average of each period, and its trend",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"kpi_id": map[string]interface{}{
+							"type":        "string",
+							"description": "KPI identifier",
+						},
+						"from": map[string]interface{}{
+							"type":        "string",
+							"description": "Start of the range (YYYY-MM-DD, default: the first measurement)",
+						},
+						"to": map[string]interface{}{
+							"type":        "string",
+							"description": "End of the range, inclusive (YYYY-MM-DD, default: today)",
+						},
+						"resolution": map[string]interface{}{
+							"type":        "string",
+							"description": "Period to downsample to (default: raw)",
+							"enum":        []string{"raw", "day", "week", "month", "quarter", "year"},
+						},
+					},
+					"required": []string{"kpi_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.detectKPIAnomalies,
+			Tool: Tool{
+				Name:        "detect_kpi_anomalies",
+				Description: "Flag a KPI's measurements that deviate unusually from the measurements before them, by z-score and EWMA, even when the target is still met",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"kpi_id": map[string]interface{}{
+							"type":        "string",
+							"description": "KPI identifier",
+						},
+						"from": map[string]interface{}{
+							"type":        "string",
+							"description": "Start of the range (YYYY-MM-DD, default: the first measurement); earlier measurements still form the baseline",
+						},
+						"to": map[string]interface{}{
+							"type":        "string",
+							"description": "End of the range, inclusive (YYYY-MM-DD, default: today)",
+						},
+					},
+					"required": []string{"kpi_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.bindTelemetryMetric,
+			Tool: Tool{
+				Name:        "bind_telemetry_metric",
+				Description: "Measure a defined KPI from an uptime, latency or error rate metric pulled from Datadog or CloudWatch on a schedule",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"kpi_id": map[string]interface{}{
+							"type":        "string",
+							"description": "KPI identifier",
+						},
+						"source": map[string]interface{}{
+							"type":        "string",
+							"description": "Telemetry source",
+							"enum":        []string{"datadog", "cloudwatch"},
+						},
+						"metric": map[string]interface{}{
+							"type":        "string",
+							"description": "Kind of telemetry",
+							"enum":        []string{"uptime", "latency", "error_rate"},
+						},
+						"query": map[string]interface{}{
+							"type":        "string",
+							"description": "Datadog metrics query, e.g. avg:trace.http.request.duration{service:erp}, or CloudWatch <namespace>/<metric>{<dimension>=<value>}:<statistic>",
+						},
+						"aggregation": map[string]interface{}{
+							"type":        "string",
+							"description": "How the points of a pull become one measurement (default: avg)",
+							"enum":        []string{"avg", "min", "max", "last", "sum"},
+						},
+						"scale": map[string]interface{}{
+							"type":        "number",
+							"description": "Multiplier applied to values, e.g. 100 to turn a fraction into a percentage (default: 1)",
+						},
+						"interval": map[string]interface{}{
+							"type":        "string",
+							"description": "How often the metric is pulled, e.g. 15m (default: 1h)",
+						},
+						"application_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Application the metric observes",
+						},
+					},
+					"required": []string{"kpi_id", "source", "metric", "query"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.ingestTelemetry,
+			Tool: Tool{
+				Name:        "ingest_telemetry",
+				Description: "Pull the metrics of KPIs bound to telemetry whose interval has passed and record their measurements",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"kpi_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Only pull this KPI's metric",
+						},
+						"force": map[string]interface{}{
+							"type":        "boolean",
+							"description": "Pull even when the interval has not passed",
+						},
+					},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.listTelemetryBindings,
+			Tool: Tool{
+				Name:        "list_telemetry_bindings",
+				Description: "Show the KPIs measured from telemetry, their metrics and when they were last pulled",
+				InputSchema: map[string]interface{}{
+					"type":       "object",
+					"properties": map[string]interface{}{},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.configureAlert,
+			Tool: Tool{
+				Name:        "configure_alert",
+				Description: "Set the warning and critical thresholds of a KPI or risk indicator of a governance agreement and who is alerted when they are breached",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement identifier",
+						},
+						"source": map[string]interface{}{
+							"type":        "string",
+							"description": "What the alert watches",
+							"enum":        []string{"kpi", "risk_indicator"},
+						},
+						"subject": map[string]interface{}{
+							"type":        "string",
+							"description": "KPI identifier or risk indicator name",
+						},
+						"warning_condition": map[string]interface{}{
+							"type":        "string",
+							"description": "Condition of the warning threshold (default: >)",
+							"enum":        []string{">", ">=", "<", "<=", "=", "!="},
+						},
+						"warning_value": map[string]interface{}{
+							"type":        "number",
+							"description": "Value of the warning threshold",
+						},
+						"critical_condition": map[string]interface{}{
+							"type":        "string",
+							"description": "Condition of the critical threshold (default: the warning condition, or >)",
+							"enum":        []string{">", ">=", "<", "<=", "=", "!="},
+						},
+						"critical_value": map[string]interface{}{
+							"type":        "number",
+							"description": "Value of the critical threshold",
+						},
+						"recipient": map[string]interface{}{
+							"type":        "string",
+							"description": "Who is alerted when a threshold is breached",
+						},
+						"alert_type": map[string]interface{}{
+							"type":        "string",
+							"description": "How the recipient is alerted, e.g. email",
+						},
+						"message": map[string]interface{}{
+							"type":        "string",
+							"description": "Alert message",
+						},
+						"escalation": map[string]interface{}{
+							"type":        "string",
+							"description": "Who the alert escalates to",
+						},
+					},
+					"required": []string{"agreement_id", "source", "subject"},
+				},
+			},
+		},
+
+		{
+			Toolset: toolsetCore,
+			Handler: s.evaluateAlerts,
+			Tool: Tool{
+				Name:        "evaluate_alerts",
+				Description: "Check a governance agreement's KPIs and risk indicators against their alert thresholds, raising new alerts and resolving those no longer breached",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement identifier",
+						},
+					},
+					"required": []string{"agreement_id"},
+				},
+			},
+		},
+
+		{
+			Toolset: toolsetCore,
+			Handler: s.sendNotifications,
+			Tool: Tool{
+				Name:        "send_notifications",
+				Description: "Send the due notifications for raised and resolved alerts, pending approvals and audits coming due over the configured channels",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"kind": map[string]interface{}{
+							"type":        "string",
+							"description": "What to notify (default: all)",
+							"enum":        []string{"all", "alerts", "approvals", "audits"},
+						},
+						"audit_window_days": map[string]interface{}{
+							"type":        "number",
+							"description": "How many days ahead audits are notified (default: 14)",
+						},
+						"skip_alert_evaluation": map[string]interface{}{
+							"type":        "boolean",
+							"description": "Notify the active alerts without checking alert thresholds first",
+						},
+					},
+					"required": []string{},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.listEventDeadLetters,
+			Tool: Tool{
+				Name:        "list_event_dead_letters",
+				Description: "List the domain events that could not be delivered to their webhooks or Kafka after every retry, optionally delivering them again first",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"redeliver": map[string]interface{}{
+							"type":        "boolean",
+							"description": "Deliver the undelivered events again before listing those still failing",
+						},
+					},
+					"required": []string{},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.replayEvents,
+			Tool: Tool{
+				Name:        "replay_events",
+				Description: "Deliver the domain events recorded since a time to a configured webhook again, oldest first, e.g. to backfill a newly added downstream system",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"webhook": map[string]interface{}{
+							"type":        "string",
+							"description": "Name of the webhook under events to replay to, or kafka; only the event types it subscribes to are replayed",
+						},
+						"since": map[string]interface{}{
+							"type":        "string",
+							"description": "Replay the events that occurred from this time (RFC 3339)",
+						},
+					},
+					"required": []string{"webhook", "since"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.getAggregateEvents,
+			Tool: Tool{
+				Name:        "get_aggregate_events",
+				Description: "List the domain events recorded against an application, portfolio, agreement, change request, incident or other aggregate, oldest first, as envelopes with IDs, sequence numbers and JSON payloads",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"aggregate_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Identifier of the aggregate, e.g. an application, agreement or change request ID",
+						},
+						"aggregate_type": map[string]interface{}{
+							"type":        "string",
+							"description": "Only list events of this aggregate type, when IDs are shared across types, e.g. Application or ChangeRequest",
+						},
+					},
+					"required": []string{"aggregate_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.getApplicationsByRisk,
+			Tool: Tool{
+				Name:        "get_applications_by_risk",
+				Description: "List applications by the risk level of their latest evaluation, riskiest first, without re-evaluating them",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"risk_level": map[string]interface{}{
+							"type":        "string",
+							"description": "Only list applications at this risk level",
+							"enum":        []string{"low", "medium", "high", "critical"},
+						},
+					},
+					"required": []string{},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.listProjections,
+			Tool: Tool{
+				Name:        "list_projections",
+				Description: "List the read models kept up to date from domain events with how far each has got, optionally rebuilding one from the whole event history",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"rebuild": map[string]interface{}{
+							"type":        "string",
+							"description": "Name of a projection to rebuild first, e.g. applications_by_risk or open_approvals",
+						},
+					},
+					"required": []string{},
+				},
+			},
+		},
+
+		{
+			Toolset: toolsetCore,
+			Handler: s.configureEscalation,
+			Tool: Tool{
+				Name:        "configure_escalation",
+				Description: "Set the escalation matrix a governance agreement follows for unacknowledged alerts and unresolved incidents, or for change requests awaiting approval",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement identifier",
+						},
+						"scope": map[string]interface{}{
+							"type":        "string",
+							"description": "Which matrix to set: operations for alerts and incidents, change for change requests",
+							"enum":        []string{"operations", "change"},
+						},
+						"levels": map[string]interface{}{
+							"type":        "array",
+							"description": "Escalation levels, each with level (1 is the first), description, response_time (how long after being raised the level is engaged, e.g. 30m or 4h) and contacts as an array of strings",
+							"items":       map[string]interface{}{"type": "object"},
+						},
+					},
+					"required": []string{"agreement_id", "scope", "levels"},
+				},
+			},
+		},
+
+		{
+			Toolset: toolsetCore,
+			Handler: s.configureIncidentSLAs,
+			Tool: Tool{
+				Name:        "configure_incident_slas",
+				Description: "Set how quickly a governance agreement's incidents of each severity must be acknowledged and resolved, replacing its incident classification and prioritization matrices",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement identifier",
+						},
+						"severities": map[string]interface{}{
+							"type":        "array",
+							"description": "Incident severities, each with severity (1 is the highest), name, description, response_time (how long until it must be acknowledged, e.g. 15m), resolution_time (how long until it must be resolved, e.g. 4h) and keywords (impact keywords classifying reported incidents into it, e.g. [\"outage\", \"data loss\"])",
+							"items":       map[string]interface{}{"type": "object"},
+						},
+					},
+					"required": []string{"agreement_id", "severities"},
+				},
+			},
+		},
+
+		{
+			Toolset: toolsetCore,
+			Handler: s.acknowledgeAlert,
+			Tool: Tool{
+				Name:        "acknowledge_alert",
+				Description: "Acknowledge an active alert of a governance agreement, which stops its escalation until its severity changes",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement identifier",
+						},
+						"alert_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Alert identifier: agreement, source and subject, e.g. gov-erp-core-001/kpi/erp-uptime",
+						},
+						"acknowledged_by": map[string]interface{}{
+							"type":        "string",
+							"description": "Person taking the alert on",
+						},
+					},
+					"required": []string{"agreement_id", "alert_id"},
+				},
+			},
+		},
+
+		{
+			Toolset: toolsetCore,
+			Handler: s.escalateDue,
+			Tool: Tool{
+				Name:        "escalate_due",
+				Description: "Escalate unacknowledged alerts, unresolved incidents and change requests awaiting approval through the levels of their escalation matrix whose response time has passed, notifying each level's contacts",
+				InputSchema: map[string]interface{}{
+					"type":       "object",
+					"properties": map[string]interface{}{},
+					"required":   []string{},
+				},
+			},
+		},
+
+		{
+			Toolset: toolsetCore,
+			Handler: s.listEscalations,
+			Tool: Tool{
+				Name:        "list_escalations",
+				Description: "List the escalation steps taken on an application's alerts, incidents and change requests",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"application_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Application identifier",
+						},
+					},
+					"required": []string{"application_id"},
+				},
+			},
+		},
+
+		{
+			Toolset: toolsetCore,
+			Handler: s.generateExecutiveDigest,
+			Tool: Tool{
+				Name:        "generate_executive_digest",
+				Description: "Generate the executive digest of a portfolio for the last week or month: KPI movement, new and escalated risks, compliance changes and open recommendations, optionally sending it over the configured notification channels",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"portfolio_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Portfolio identifier",
+						},
+						"frequency": map[string]interface{}{
+							"type":        "string",
+							"description": "Period the digest covers (default: weekly)",
+							"enum":        []string{"weekly", "monthly"},
+						},
+						"send": map[string]interface{}{
+							"type":        "boolean",
+							"description": "Send the digest as a digest notification",
+						},
+					},
+					"required": []string{"portfolio_id"},
+				},
+			},
+		},
+
+		{
+			Toolset: toolsetCore,
+			Handler: s.listExecutiveDigests,
+			Tool: Tool{
+				Name:        "list_executive_digests",
+				Description: "List the executive digests generated of a portfolio",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"portfolio_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Portfolio identifier",
+						},
+					},
+					"required": []string{"portfolio_id"},
+				},
+			},
+		},
+
+		{
+			Toolset: toolsetCore,
+			Handler: s.recordDecision,
+			Tool: Tool{
+				Name:        "record_decision",
+				Description: "Record a governance decision with its context, the options considered, the outcome and who decided it, linked to a governance agreement or application",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"decision_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Unique decision identifier",
+						},
+						"title": map[string]interface{}{
+							"type":        "string",
+							"description": "Short title of the decision",
+						},
+						"context": map[string]interface{}{
+							"type":        "string",
+							"description": "The situation and forces that called for a decision",
+						},
+						"options": map[string]interface{}{
+							"type":        "array",
+							"description": "Options considered, each with name, description and pros and cons as arrays of strings",
+							"items":       map[string]interface{}{"type": "object"},
+						},
+						"decision": map[string]interface{}{
+							"type":        "string",
+							"description": "What was decided",
+						},
+						"rationale": map[string]interface{}{
+							"type":        "string",
+							"description": "Why the decision was chosen over the other options",
+						},
+						"consequences": map[string]interface{}{
+							"type":        "string",
+							"description": "What follows from the decision",
+						},
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement the decision directs",
+						},
+						"application_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Application the decision applies to (taken from the agreement when omitted)",
+						},
+						"decided_by": map[string]interface{}{
+							"type":        "string",
+							"description": "Who took the decision (default: the authenticated principal)",
+						},
+						"decided_at": map[string]interface{}{
+							"type":        "string",
+							"description": "Date of the decision (YYYY-MM-DD, default: today)",
+						},
+						"supersedes": map[string]interface{}{
+							"type":        "string",
+							"description": "Earlier decision this one replaces",
+						},
+					},
+					"required": []string{"decision_id", "title", "context", "decision"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.listDecisions,
+			Tool: Tool{
+				Name:        "list_decisions",
+				Description: "List the governance decision log for an agreement, an application or everything",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement identifier",
+						},
+						"application_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Application identifier (used when agreement_id is omitted)",
+						},
+						"current_only": map[string]interface{}{
+							"type":        "boolean",
+							"description": "Leave out superseded decisions",
+						},
+					},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.getDecision,
+			Tool: Tool{
+				Name:        "get_decision",
+				Description: "Show a governance decision record in full",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"decision_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Decision identifier",
+						},
+					},
+					"required": []string{"decision_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.exportTimeline,
+			Tool: Tool{
+				Name:        "export_timeline",
+				Description: "Export objectives, action plans, initiative deadlines and audit schedules as an iCalendar feed or Gantt JSON/CSV for calendars and project tooling",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement to export",
+						},
+						"application_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Application whose agreement to export when agreement_id is omitted; every agreement is exported when both are omitted",
+						},
+						"format": map[string]interface{}{
+							"type":        "string",
+							"description": "ical (default), gantt_json or gantt_csv",
+							"enum":        []string{"ical", "gantt_json", "gantt_csv"},
+						},
+					},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.analyzeTrends,
+			Tool: Tool{
+				Name:        "analyze_trends",
+				Description: "Report whether an application's or portfolio's assessments are improving or degrading",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"application_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Application identifier",
+						},
+						"portfolio_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Portfolio identifier (used when application_id is omitted)",
+						},
+						"window_days": map[string]interface{}{
+							"type":        "number",
+							"description": "Days of history to analyze (default: 90, 0 for all)",
+						},
+						"projection_days": map[string]interface{}{
+							"type":        "number",
+							"description": "Days ahead to project (default: 30)",
+						},
+					},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.prioritizeRecommendations,
+			Tool: Tool{
+				Name:        "prioritize_recommendations",
+				Description: "Rank the open recommendations across a portfolio by business impact, risk reduction and effort into a remediation backlog",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"portfolio_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Portfolio identifier",
+						},
+						"limit": map[string]interface{}{
+							"type":        "number",
+							"description": "Number of backlog items to show (default: all)",
+						},
+						"business_impact_weight": map[string]interface{}{
+							"type":        "number",
+							"description": "Weight of business impact (default 0.5)",
+						},
+						"risk_reduction_weight": map[string]interface{}{
+							"type":        "number",
+							"description": "Weight of risk reduction (default 0.5)",
+						},
+						"effort_weight": map[string]interface{}{
+							"type":        "number",
+							"description": "How strongly effort lowers the score, 0-1 (default 0.5)",
+						},
+					},
+					"required": []string{"portfolio_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.assessGovernanceMaturity,
+			Tool: Tool{
+				Name:        "assess_governance_maturity",
+				Description: "Grade an agreement's governance maturity (levels 1-5) for each ISO 38500 principle",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement identifier",
+						},
+					},
+					"required": []string{"agreement_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.getPrincipleScorecard,
+			Tool: Tool{
+				Name:        "get_principle_scorecard",
+				Description: "Score an agreement against all six ISO 38500 principles",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement identifier",
+						},
+					},
+					"required": []string{"agreement_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.recordAvailabilityMeasurement,
+			Tool: Tool{
+				Name:        "record_availability_measurement",
+				Description: "Ingest observed uptime and response time for an application and check them against its declared SLA",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"id": map[string]interface{}{
+							"type":        "string",
+							"description": "Unique measurement identifier",
+						},
+						"application_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Application that was measured",
+						},
+						"uptime_percentage": map[string]interface{}{
+							"type":        "number",
+							"description": "Observed availability over the period (e.g. 99.72)",
+						},
+						"response_time_ms": map[string]interface{}{
+							"type":        "number",
+							"description": "Observed response time in milliseconds",
+						},
+						"period_start": map[string]interface{}{
+							"type":        "string",
+							"description": "Start of the measured period (RFC 3339)",
+						},
+						"period_end": map[string]interface{}{
+							"type":        "string",
+							"description": "End of the measured period (RFC 3339)",
+						},
+						"source": map[string]interface{}{
+							"type":        "string",
+							"description": "Monitoring system the reading came from",
+						},
+					},
+					"required": []string{"id", "application_id", "uptime_percentage"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.getErrorBudgets,
+			Tool: Tool{
+				Name:        "get_error_budgets",
+				Description: "Track the error budgets of the SLOs derived from an application's declared SLA for the current month, consumed by its ingested availability and latency measurements, and whether they are projected to run out before the month ends",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"application_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Application identifier",
+						},
+					},
+					"required": []string{"application_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.recordApplicationMetrics,
+			Tool: Tool{
+				Name:        "record_application_metrics",
+				Description: "Ingest observed active users, transaction volume, uptime and survey satisfaction for an application, used by later evaluations in place of estimates",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"application_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Application the metrics were observed for",
+						},
+						"active_users": map[string]interface{}{
+							"type":        "number",
+							"description": "Observed active users",
+						},
+						"transaction_volume": map[string]interface{}{
+							"type":        "number",
+							"description": "Observed transaction volume",
+						},
+						"uptime_percentage": map[string]interface{}{
+							"type":        "number",
+							"description": "Observed availability (e.g. 99.72)",
+						},
+						"user_satisfaction": map[string]interface{}{
+							"type":        "number",
+							"description": "Satisfaction survey score (0-100)",
+						},
+						"survey_responses": map[string]interface{}{
+							"type":        "number",
+							"description": "Number of survey responses behind the satisfaction score",
+						},
+						"source": map[string]interface{}{
+							"type":        "string",
+							"description": "System the metrics came from",
+						},
+					},
+					"required": []string{"application_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.registerVendor,
+			Tool: Tool{
+				Name:        "register_vendor",
+				Description: "Register a third party supplying, hosting or operating applications, linked to those applications, and rate its risk",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"id": map[string]interface{}{
+							"type":        "string",
+							"description": "Unique vendor identifier",
+						},
+						"name": map[string]interface{}{
+							"type":        "string",
+							"description": "Vendor name",
+						},
+						"description": map[string]interface{}{
+							"type":        "string",
+							"description": "Vendor description",
+						},
+						"services": map[string]interface{}{
+							"type":        "string",
+							"description": "What the vendor provides, e.g. SaaS ERP hosting",
+						},
+						"criticality": map[string]interface{}{
+							"type":        "string",
+							"description": "How much the organization depends on the vendor (default: medium)",
+							"enum":        []string{"low", "medium", "high", "critical"},
+						},
+						"status": map[string]interface{}{
+							"type":        "string",
+							"description": "Relationship status (default: onboarding)",
+							"enum":        []string{"onboarding", "active", "offboarded"},
+						},
+						"application_ids": map[string]interface{}{
+							"type":        "array",
+							"description": "Applications the vendor supplies, hosts or operates",
+							"items":       map[string]interface{}{"type": "string"},
+						},
+					},
+					"required": []string{"id", "name"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.linkVendorApplication,
+			Tool: Tool{
+				Name:        "link_vendor_application",
+				Description: "Link a vendor to an application it supplies, hosts or operates, or unlink it",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"vendor_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Vendor identifier",
+						},
+						"application_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Application identifier",
+						},
+						"unlink": map[string]interface{}{
+							"type":        "boolean",
+							"description": "Unlink the application instead (default: false)",
+						},
+					},
+					"required": []string{"vendor_id", "application_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.setVendorStatus,
+			Tool: Tool{
+				Name:        "set_vendor_status",
+				Description: "Move a vendor through onboarding, active and offboarded; offboarded vendors no longer count towards application risk",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"vendor_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Vendor identifier",
+						},
+						"status": map[string]interface{}{
+							"type":        "string",
+							"description": "Relationship status",
+							"enum":        []string{"onboarding", "active", "offboarded"},
+						},
+					},
+					"required": []string{"vendor_id", "status"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.addVendorContract,
+			Tool: Tool{
+				Name:        "add_vendor_contract",
+				Description: "Record a contract with a vendor",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"vendor_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Vendor identifier",
+						},
+						"contract_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Unique contract identifier",
+						},
+						"title": map[string]interface{}{
+							"type":        "string",
+							"description": "Contract title",
+						},
+						"starts_at": map[string]interface{}{
+							"type":        "string",
+							"description": "Start date, YYYY-MM-DD",
+						},
+						"ends_at": map[string]interface{}{
+							"type":        "string",
+							"description": "End date, YYYY-MM-DD (default: runs until terminated)",
+						},
+						"annual_value": map[string]interface{}{
+							"type":        "number",
+							"description": "Annual contract value",
+						},
+						"data_processing": map[string]interface{}{
+							"type":        "boolean",
+							"description": "The vendor processes personal data under the contract",
+						},
+					},
+					"required": []string{"vendor_id", "contract_id", "title", "starts_at"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.setVendorSLA,
+			Tool: Tool{
+				Name:        "set_vendor_sla",
+				Description: "Add a service level a vendor committed to, or change its target",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"vendor_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Vendor identifier",
+						},
+						"name": map[string]interface{}{
+							"type":        "string",
+							"description": "Service level name, e.g. availability",
+						},
+						"target": map[string]interface{}{
+							"type":        "number",
+							"description": "Committed value",
+						},
+						"lower_is_better": map[string]interface{}{
+							"type":        "boolean",
+							"description": "The target is a maximum, such as a response time (default: false, a minimum)",
+						},
+					},
+					"required": []string{"vendor_id", "name", "target"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.recordVendorSLA,
+			Tool: Tool{
+				Name:        "record_vendor_sla",
+				Description: "Record the value a vendor's service level was observed at, emitting a breach event when it misses the target",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"vendor_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Vendor identifier",
+						},
+						"name": map[string]interface{}{
+							"type":        "string",
+							"description": "Service level name",
+						},
+						"observed": map[string]interface{}{
+							"type":        "number",
+							"description": "Observed value",
+						},
+					},
+					"required": []string{"vendor_id", "name", "observed"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.sendVendorQuestionnaire,
+			Tool: Tool{
+				Name:        "send_vendor_questionnaire",
+				Description: "Send a vendor the standard due diligence questionnaire on security, privacy, continuity, compliance and financial standing",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"vendor_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Vendor identifier",
+						},
+						"questionnaire_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Unique questionnaire identifier",
+						},
+					},
+					"required": []string{"vendor_id", "questionnaire_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.answerVendorQuestionnaire,
+			Tool: Tool{
+				Name:        "answer_vendor_questionnaire",
+				Description: "Record a vendor's answers to a due diligence questionnaire; once completed it counts towards the vendor's risk rating",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"vendor_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Vendor identifier",
+						},
+						"questionnaire_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Questionnaire identifier",
+						},
+						"answers": map[string]interface{}{
+							"type":        "object",
+							"description": "Answers by question ID: yes, partial, no or not_applicable",
+						},
+						"complete": map[string]interface{}{
+							"type":        "boolean",
+							"description": "Complete the questionnaire; every question must then be answered",
+						},
+					},
+					"required": []string{"vendor_id", "questionnaire_id", "answers"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.getVendor,
+			Tool: Tool{
+				Name:        "get_vendor",
+				Description: "Show a vendor with its applications, contracts, service levels, questionnaires and risk rating",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"vendor_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Vendor identifier",
+						},
+					},
+					"required": []string{"vendor_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.listVendors,
+			Tool: Tool{
+				Name:        "list_vendors",
+				Description: "List the vendors with their risk rating, or those of an application",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"application_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Application identifier (default: every vendor)",
+						},
+					},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.monitorVendors,
+			Tool: Tool{
+				Name:        "monitor_vendors",
+				Description: "Rate every vendor not offboarded again, flagging out of date due diligence, contracts ending and missed service levels",
+				InputSchema: map[string]interface{}{
+					"type":       "object",
+					"properties": map[string]interface{}{},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.startDPIA,
+			Tool: Tool{
+				Name:        "start_dpia",
+				Description: "Start a data protection impact assessment (DPIA) of an application processing personal or health information, with the standard screening questions",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"dpia_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Unique DPIA identifier",
+						},
+						"application_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Application identifier",
+						},
+						"title": map[string]interface{}{
+							"type":        "string",
+							"description": "DPIA title (default: DPIA of the application)",
+						},
+						"assessor": map[string]interface{}{
+							"type":        "string",
+							"description": "Who carries out the DPIA (default: the caller)",
+						},
+					},
+					"required": []string{"dpia_id", "application_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.answerDPIAQuestions,
+			Tool: Tool{
+				Name:        "answer_dpia_questions",
+				Description: "Answer screening questions of a DPIA; each answered yes identifies a privacy risk",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"dpia_id": map[string]interface{}{
+							"type":        "string",
+							"description": "DPIA identifier",
+						},
+						"answers": map[string]interface{}{
+							"type":        "object",
+							"description": "Answers by question ID: yes or no",
+						},
+						"notes": map[string]interface{}{
+							"type":        "object",
+							"description": "Notes by question ID",
+						},
+					},
+					"required": []string{"dpia_id", "answers"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.addPrivacyRisk,
+			Tool: Tool{
+				Name:        "add_privacy_risk",
+				Description: "Add a privacy risk to a DPIA that its screening questions did not identify",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"dpia_id": map[string]interface{}{
+							"type":        "string",
+							"description": "DPIA identifier",
+						},
+						"risk_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Unique risk identifier within the DPIA",
+						},
+						"description": map[string]interface{}{
+							"type":        "string",
+							"description": "The risk the processing carries for individuals",
+						},
+						"level": map[string]interface{}{
+							"type":        "string",
+							"description": "Risk level before mitigation: low, medium, high or critical",
+						},
+					},
+					"required": []string{"dpia_id", "risk_id", "description", "level"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.mitigatePrivacyRisk,
+			Tool: Tool{
+				Name:        "mitigate_privacy_risk",
+				Description: "Add a measure mitigating a privacy risk of a DPIA and the level the risk is left at",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"dpia_id": map[string]interface{}{
+							"type":        "string",
+							"description": "DPIA identifier",
+						},
+						"risk_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Privacy risk identifier",
+						},
+						"mitigation": map[string]interface{}{
+							"type":        "string",
+							"description": "The mitigating measure",
+						},
+						"owner": map[string]interface{}{
+							"type":        "string",
+							"description": "Who implements the measure",
+						},
+						"implemented": map[string]interface{}{
+							"type":        "boolean",
+							"description": "Whether the measure is implemented already (default: planned)",
+						},
+						"residual_level": map[string]interface{}{
+							"type":        "string",
+							"description": "Risk level after mitigation: low, medium, high or critical",
+						},
+					},
+					"required": []string{"dpia_id", "risk_id", "mitigation"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.submitDPIA,
+			Tool: Tool{
+				Name:        "submit_dpia",
+				Description: "Submit a DPIA to the data protection officer once its questions are answered and its high and critical risks mitigated",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"dpia_id": map[string]interface{}{
+							"type":        "string",
+							"description": "DPIA identifier",
+						},
+					},
+					"required": []string{"dpia_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.decideDPIA,
+			Tool: Tool{
+				Name:        "decide_dpia",
+				Description: "Record the data protection officer approving a submitted DPIA or returning it for rework",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"dpia_id": map[string]interface{}{
+							"type":        "string",
+							"description": "DPIA identifier",
+						},
+						"approve": map[string]interface{}{
+							"type":        "boolean",
+							"description": "Approve the DPIA; false returns it to its assessor for rework",
+						},
+						"dpo": map[string]interface{}{
+							"type":        "string",
+							"description": "The data protection officer deciding (default: the caller), who cannot be the assessor",
+						},
+						"opinion": map[string]interface{}{
+							"type":        "string",
+							"description": "The officer's opinion",
+						},
+						"review_by": map[string]interface{}{
+							"type":        "string",
+							"description": "When an approved DPIA must be reviewed again, YYYY-MM-DD (default: in a year)",
+						},
+					},
+					"required": []string{"dpia_id", "approve"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.getDPIA,
+			Tool: Tool{
+				Name:        "get_dpia",
+				Description: "Show a DPIA with its answers, privacy risks, mitigations and decision",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"dpia_id": map[string]interface{}{
+							"type":        "string",
+							"description": "DPIA identifier",
+						},
+					},
+					"required": []string{"dpia_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.listDPIAs,
+			Tool: Tool{
+				Name:        "list_dpias",
+				Description: "List the DPIAs, or those of an application, with their status and residual risk",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"application_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Application identifier (default: every application)",
+						},
+					},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.recordTechnicalDebt,
+			Tool: Tool{
+				Name:        "record_technical_debt",
+				Description: "Add an item to an application's technical debt register",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"id": map[string]interface{}{
+							"type":        "string",
+							"description": "Unique debt item identifier",
+						},
+						"application_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Application the debt belongs to",
+						},
+						"title": map[string]interface{}{
+							"type":        "string",
+							"description": "Short description of the debt",
+						},
+						"description": map[string]interface{}{
+							"type":        "string",
+							"description": "Detailed description",
+						},
+						"category": map[string]interface{}{
+							"type":        "string",
+							"description": "Debt category",
+							"enum":        []string{"code", "architecture", "infrastructure", "security", "dependency", "testing", "documentation"},
+						},
+						"principal_hours": map[string]interface{}{
+							"type":        "number",
+							"description": "Effort in hours to remediate",
+						},
+						"interest_hours_per_month": map[string]interface{}{
+							"type":        "number",
+							"description": "Extra effort in hours the debt costs each month",
+						},
+						"remediation_plan": map[string]interface{}{
+							"type":        "string",
+							"description": "How the debt will be paid down",
+						},
+					},
+					"required": []string{"id", "application_id", "title", "category", "principal_hours"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.resolveTechnicalDebt,
+			Tool: Tool{
+				Name:        "resolve_technical_debt",
+				Description: "Mark a technical debt item as remediated",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"id": map[string]interface{}{
+							"type":        "string",
+							"description": "Debt item identifier",
+						},
+					},
+					"required": []string{"id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.getTechnicalDebt,
+			Tool: Tool{
+				Name:        "get_technical_debt",
+				Description: "Summarize technical debt for an application or roll it up across a portfolio",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"application_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Application identifier",
+						},
+						"portfolio_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Portfolio identifier (used when application_id is omitted)",
+						},
+					},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.registerTechnologyComponent,
+			Tool: Tool{
+				Name:        "register_technology_component",
+				Description: "Record an operating system, language, framework, runtime or database an application runs on and its vendor end of support date",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement of the application",
+						},
+						"kind": map[string]interface{}{
+							"type":        "string",
+							"description": "Layer of the stack",
+							"enum":        []string{"operating_system", "language", "framework", "runtime", "database"},
+						},
+						"name": map[string]interface{}{
+							"type":        "string",
+							"description": "Product name, e.g. Ubuntu",
+						},
+						"version": map[string]interface{}{
+							"type":        "string",
+							"description": "Product version, e.g. 18.04",
+						},
+						"vendor": map[string]interface{}{
+							"type":        "string",
+							"description": "Vendor or community supporting the product",
+						},
+						"end_of_support": map[string]interface{}{
+							"type":        "string",
+							"description": "End of support date (YYYY-MM-DD)",
+						},
+					},
+					"required": []string{"agreement_id", "kind", "name"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.forecastEndOfLife,
+			Tool: Tool{
+				Name:        "forecast_end_of_life",
+				Description: "List a portfolio's technology components whose vendor support has ended or ends within the horizon",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"portfolio_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Portfolio identifier",
+						},
+						"horizon_days": map[string]interface{}{
+							"type":        "number",
+							"description": "How far ahead to look (default 730)",
+						},
+					},
+					"required": []string{"portfolio_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.getAlignmentMatrix,
+			Tool: Tool{
+				Name:        "get_alignment_matrix",
+				Description: "Map a portfolio's applications against the strategic objectives they contribute to, with contribution weights, and flag applications supporting no objective",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"portfolio_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Portfolio identifier",
+						},
+					},
+					"required": []string{"portfolio_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.defineOKR,
+			Tool: Tool{
+				Name:        "define_okr",
+				Description: "Define an objective with its key results for a portfolio or, cascading from a portfolio OKR, for an application's governance agreement; an OKR with the same ID is replaced",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement identifier, for an application-level OKR",
+						},
+						"portfolio_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Portfolio identifier, for a portfolio-level OKR (used when agreement_id is omitted)",
+						},
+						"okr_id": map[string]interface{}{
+							"type":        "string",
+							"description": "OKR identifier",
+						},
+						"objective": map[string]interface{}{
+							"type":        "string",
+							"description": "What the OKR sets out to achieve",
+						},
+						"owner": map[string]interface{}{
+							"type":        "string",
+							"description": "OKR owner",
+						},
+						"parent_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Portfolio OKR an application-level OKR cascades from",
+						},
+						"objective_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Strategic objective of the agreement the OKR supports",
+						},
+						"period_start": map[string]interface{}{
+							"type":        "string",
+							"description": "Start of the OKR period (YYYY-MM-DD)",
+						},
+						"period_end": map[string]interface{}{
+							"type":        "string",
+							"description": "End of the OKR period (YYYY-MM-DD)",
+						},
+						"key_results": map[string]interface{}{
+							"type":        "array",
+							"description": "Key results, each with id, description, optional kpi_id, baseline, target and unit",
+							"items":       map[string]interface{}{"type": "object"},
+						},
+					},
+					"required": []string{"okr_id", "objective", "key_results"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.recordKeyResult,
+			Tool: Tool{
+				Name:        "record_key_result",
+				Description: "Record the actual value of a key result; for an agreement's OKR, a key result linked to a KPI is also recorded as a KPI measurement",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement identifier, for an application-level OKR",
+						},
+						"portfolio_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Portfolio identifier, for a portfolio-level OKR (used when agreement_id is omitted)",
+						},
+						"okr_id": map[string]interface{}{
+							"type":        "string",
+							"description": "OKR identifier",
+						},
+						"key_result_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Key result identifier",
+						},
+						"actual": map[string]interface{}{
+							"type":        "number",
+							"description": "Actual value",
+						},
+						"recorded_by": map[string]interface{}{
+							"type":        "string",
+							"description": "Who records the value (default: the authenticated principal)",
+						},
+					},
+					"required": []string{"okr_id", "key_result_id", "actual"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.getOKRCascade,
+			Tool: Tool{
+				Name:        "get_okr_cascade",
+				Description: "Report a portfolio's OKRs with the application-level OKRs cascading from them and their progress",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"portfolio_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Portfolio identifier",
+						},
+					},
+					"required": []string{"portfolio_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.getRiskHeatMap,
+			Tool: Tool{
+				Name:        "get_risk_heatmap",
+				Description: "Place the identified risks of a portfolio's applications on a probability-versus-impact heat map with the risks of each cell",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"portfolio_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Portfolio identifier",
+						},
+					},
+					"required": []string{"portfolio_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.updateInitiativeProgress,
+			Tool: Tool{
+				Name:        "update_initiative_progress",
+				Description: "Record a progress update for a strategic initiative of a governance agreement",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement identifier",
+						},
+						"initiative_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Strategic initiative identifier",
+						},
+						"percent_complete": map[string]interface{}{
+							"type":        "number",
+							"description": "Percentage of the initiative completed, 0-100",
+						},
+						"status": map[string]interface{}{
+							"type":        "string",
+							"description": "Initiative status (derived from the progress when omitted)",
+							"enum":        []string{"not_started", "on_track", "at_risk", "delayed", "completed"},
+						},
+						"completed_milestones": map[string]interface{}{
+							"type":        "array",
+							"description": "Milestones completed with this update",
+							"items":       map[string]interface{}{"type": "string"},
+						},
+						"note": map[string]interface{}{
+							"type":        "string",
+							"description": "Status note",
+						},
+						"updated_by": map[string]interface{}{
+							"type":        "string",
+							"description": "Who reports the progress (default: the authenticated principal)",
+						},
+					},
+					"required": []string{"agreement_id", "initiative_id", "percent_complete"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.revisePolicy,
+			Tool: Tool{
+				Name:        "revise_policy",
+				Description: "Record a new version of a policy; a policy in approval or in effect returns to draft",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement identifier",
+						},
+						"policy_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Policy identifier",
+						},
+						"name": map[string]interface{}{
+							"type":        "string",
+							"description": "Revised name",
+						},
+						"description": map[string]interface{}{
+							"type":        "string",
+							"description": "Revised description",
+						},
+						"scope": map[string]interface{}{
+							"type":        "string",
+							"description": "Revised scope",
+						},
+						"owner": map[string]interface{}{
+							"type":        "string",
+							"description": "Revised owner",
+						},
+						"summary": map[string]interface{}{
+							"type":        "string",
+							"description": "What changed and why",
+						},
+						"changed_by": map[string]interface{}{
+							"type":        "string",
+							"description": "Author of the revision (default: the authenticated principal)",
+						},
+					},
+					"required": []string{"agreement_id", "policy_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.getDocumentHistory,
+			Tool: Tool{
+				Name:        "get_document_history",
+				Description: "Show every version of a policy, standard or procedure with who changed what and a redline of the description",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement identifier",
+						},
+						"kind": map[string]interface{}{
+							"type":        "string",
+							"description": "Document kind",
+							"enum":        []string{"policy", "standard", "procedure"},
+						},
+						"document_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Policy, standard or procedure identifier",
+						},
+					},
+					"required": []string{"agreement_id", "kind", "document_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.pinDocumentVersion,
+			Tool: Tool{
+				Name:        "pin_document_version",
+				Description: "Pin an agreement to a version of a policy, standard or procedure",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement identifier",
+						},
+						"kind": map[string]interface{}{
+							"type":        "string",
+							"description": "Document kind",
+							"enum":        []string{"policy", "standard", "procedure"},
+						},
+						"document_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Policy, standard or procedure identifier",
+						},
+						"version": map[string]interface{}{
+							"type":        "number",
+							"description": "Version to pin, 0 to follow the current version again",
+						},
+					},
+					"required": []string{"agreement_id", "kind", "document_id", "version"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.mapRequirement,
+			Tool: Tool{
+				Name:        "map_requirement",
+				Description: "Map a policy, standard or procedure to a legal, contractual or industry standard requirement it implements",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement identifier",
+						},
+						"requirement_kind": map[string]interface{}{
+							"type":        "string",
+							"description": "Requirement kind",
+							"enum":        []string{"legal", "contractual", "industry_standard"},
+						},
+						"requirement": map[string]interface{}{
+							"type":        "string",
+							"description": "Requirement name",
+						},
+						"kind": map[string]interface{}{
+							"type":        "string",
+							"description": "Document kind",
+							"enum":        []string{"policy", "standard", "procedure"},
+						},
+						"document_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Policy, standard or procedure identifier",
+						},
+						"notes": map[string]interface{}{
+							"type":        "string",
+							"description": "How the document satisfies the requirement",
+						},
+						"mapped_by": map[string]interface{}{
+							"type":        "string",
+							"description": "Who maps the requirement (default: the authenticated principal)",
+						},
+					},
+					"required": []string{"agreement_id", "requirement_kind", "requirement", "kind", "document_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.unmapRequirement,
+			Tool: Tool{
+				Name:        "unmap_requirement",
+				Description: "Remove the mapping of a document to a conformance requirement",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement identifier",
+						},
+						"requirement_kind": map[string]interface{}{
+							"type":        "string",
+							"description": "Requirement kind",
+							"enum":        []string{"legal", "contractual", "industry_standard"},
+						},
+						"requirement": map[string]interface{}{
+							"type":        "string",
+							"description": "Requirement name",
+						},
+						"kind": map[string]interface{}{
+							"type":        "string",
+							"description": "Document kind",
+							"enum":        []string{"policy", "standard", "procedure"},
+						},
+						"document_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Policy, standard or procedure identifier",
+						},
+					},
+					"required": []string{"agreement_id", "requirement_kind", "requirement", "kind", "document_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.getRequirementCoverage,
+			Tool: Tool{
+				Name:        "get_requirement_coverage",
+				Description: "Report which conformance requirements of an agreement are implemented by a policy, standard or procedure in effect, and the gaps",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement identifier",
+						},
+					},
+					"required": []string{"agreement_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.recordComplianceStatus,
+			Tool: Tool{
+				Name:        "record_compliance_status",
+				Description: "Record the compliance status of a legal, contractual or industry standard requirement of an agreement",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement identifier",
+						},
+						"requirement_kind": map[string]interface{}{
+							"type":        "string",
+							"description": "Requirement kind",
+							"enum":        []string{"legal", "contractual", "industry_standard"},
+						},
+						"requirement": map[string]interface{}{
+							"type":        "string",
+							"description": "Requirement name",
+						},
+						"status": map[string]interface{}{
+							"type":        "string",
+							"description": "Compliance status",
+							"enum":        []string{"compliant", "non_compliant", "partial", "under_review"},
+						},
+					},
+					"required": []string{"agreement_id", "requirement_kind", "requirement", "status"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.getApplicationCompliance,
+			Tool: Tool{
+				Name:        "get_application_compliance",
+				Description: "Score the compliance of the legal, contractual and industry standard requirements registered for an application",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"application_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Application identifier",
+						},
+					},
+					"required": []string{"application_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.setRequirementExpiry,
+			Tool: Tool{
+				Name:        "set_requirement_expiry",
+				Description: "Record when the contract behind a contractual requirement ends or an industry standard certification lapses, such as after renewing it",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement identifier",
+						},
+						"requirement_kind": map[string]interface{}{
+							"type":        "string",
+							"description": "Requirement kind",
+							"enum":        []string{"contractual", "industry_standard"},
+						},
+						"requirement": map[string]interface{}{
+							"type":        "string",
+							"description": "Requirement name",
+						},
+						"expires_at": map[string]interface{}{
+							"type":        "string",
+							"description": "Expiry date, YYYY-MM-DD (empty when it no longer expires)",
+						},
+					},
+					"required": []string{"agreement_id", "requirement_kind", "requirement"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.checkRequirementExpiry,
+			Tool: Tool{
+				Name:        "check_requirement_expiry",
+				Description: "Show the days left until certifications and contracts expire and the warning lead times reached, making those that expired non-compliant",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement identifier (default: every agreement)",
+						},
+						"now": map[string]interface{}{
+							"type":        "string",
+							"description": "Date to check expiry at, YYYY-MM-DD (default: now)",
+						},
+					},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.listComplianceFrameworks,
+			Tool: Tool{
+				Name:        "list_compliance_frameworks",
+				Description: "List the compliance frameworks of the catalog (GDPR, ISO/IEC 27001:2022, SOC 2, NIST CSF 2.0 and any configured), or the requirements of one framework",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"framework_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Framework identifier, e.g. iso27001-2022 (default: list the frameworks)",
+						},
+					},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.listControlMappings,
+			Tool: Tool{
+				Name:        "list_control_mappings",
+				Description: "List the mappings between controls of different compliance frameworks that are satisfied by the same implementation",
+				InputSchema: map[string]interface{}{
+					"type":       "object",
+					"properties": map[string]interface{}{},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.applyControlStatus,
+			Tool: Tool{
+				Name:        "apply_control_status",
+				Description: "Set the compliance status of a framework control on an application's agreement, and of every control of other frameworks mapped to it",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"application_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Application identifier",
+						},
+						"control": map[string]interface{}{
+							"type":        "string",
+							"description": "Control as framework:requirement, e.g. iso27001-2022:A.8.13",
+						},
+						"status": map[string]interface{}{
+							"type":        "string",
+							"description": "Compliance status",
+							"enum":        []string{"compliant", "non_compliant", "partial", "under_review"},
+						},
+						"source": map[string]interface{}{
+							"type":        "string",
+							"description": "What the status was found by, e.g. an audit finding",
+						},
+					},
+					"required": []string{"application_id", "control", "status"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.attachFrameworkRequirements,
+			Tool: Tool{
+				Name:        "attach_framework_requirements",
+				Description: "Attach requirements of a compliance framework to an agreement as legal requirements or industry standards under review, skipping those it already has",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement identifier",
+						},
+						"framework_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Framework identifier, e.g. gdpr, iso27001-2022, soc2 or nist-csf-2.0",
+						},
+						"requirements": map[string]interface{}{
+							"type":        "array",
+							"items":       map[string]interface{}{"type": "string"},
+							"description": "Requirement IDs or families, e.g. A.8.x, CC6 or Art.32 (default: every requirement)",
+						},
+					},
+					"required": []string{"agreement_id", "framework_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.importOSCALCatalog,
+			Tool: Tool{
+				Name:        "import_oscal_catalog",
+				Description: "Add an OSCAL control catalog, such as NIST SP 800-53, to the compliance frameworks, replacing the framework with its ID",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"catalog": map[string]interface{}{
+							"type":        "string",
+							"description": "OSCAL catalog JSON",
+						},
+						"framework_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Framework identifier, e.g. nist-800-53-rev5 (default: the catalog's UUID)",
+						},
+						"kind": map[string]interface{}{
+							"type":        "string",
+							"description": "Whether the catalog is attached as legal requirements or industry standards",
+							"enum":        []string{"legal", "industry_standard"},
+						},
+					},
+					"required": []string{"catalog"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.importOSCALAssessmentResults,
+			Tool: Tool{
+				Name:        "import_oscal_assessment_results",
+				Description: "Load the control statuses found by OSCAL assessment results into an application's agreement, attaching the controls it does not have yet",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"application_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Application identifier",
+						},
+						"framework_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Framework of the catalog the results assess",
+						},
+						"results": map[string]interface{}{
+							"type":        "string",
+							"description": "OSCAL assessment results JSON",
+						},
+					},
+					"required": []string{"application_id", "framework_id", "results"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.generateComplianceReport,
+			Tool: Tool{
+				Name:        "generate_compliance_report",
+				Description: "Generate a compliance report of an agreement or a portfolio for an external auditor: requirements with their status, evidence, open gaps and remediation plan",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement to report",
+						},
+						"portfolio_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Portfolio whose agreements to report when agreement_id is omitted",
+						},
+						"title": map[string]interface{}{
+							"type":        "string",
+							"description": "Report title (default: the agreement title or portfolio name)",
+						},
+						"format": map[string]interface{}{
+							"type":        "string",
+							"description": "markdown (default) or pdf, returned base64 encoded",
+							"enum":        []string{"markdown", "pdf"},
+						},
+					},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.startComplianceAssessment,
+			Tool: Tool{
+				Name:        "start_compliance_assessment",
+				Description: "Start assessing an agreement control by control against a compliance framework, or selected requirements of it",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"assessment_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Compliance assessment identifier",
+						},
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement identifier",
+						},
+						"framework_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Framework identifier, e.g. gdpr, iso27001-2022, soc2 or nist-csf-2.0",
+						},
+						"requirements": map[string]interface{}{
+							"type":        "array",
+							"items":       map[string]interface{}{"type": "string"},
+							"description": "Requirement IDs or families in scope, e.g. A.8.x, CC6 or Art.32 (default: every requirement)",
+						},
+						"assessor": map[string]interface{}{
+							"type":        "string",
+							"description": "Who performs the assessment",
+						},
+					},
+					"required": []string{"assessment_id", "agreement_id", "framework_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.answerComplianceControl,
+			Tool: Tool{
+				Name:        "answer_compliance_control",
+				Description: "Record the compliance status found for a control of an assessment in progress, with links to the evidence supporting it, and rescore the assessment",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"assessment_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Compliance assessment identifier",
+						},
+						"requirement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Requirement ID of the control, e.g. A.8.13",
+						},
+						"status": map[string]interface{}{
+							"type":        "string",
+							"description": "Compliance status found",
+							"enum":        []string{"compliant", "non_compliant", "partial", "under_review"},
+						},
+						"not_applicable": map[string]interface{}{
+							"type":        "boolean",
+							"description": "The control does not apply and is left out of the score",
+						},
+						"evidence": map[string]interface{}{
+							"type":        "array",
+							"items":       map[string]interface{}{"type": "string"},
+							"description": "Evidence IDs, documents or report links supporting the answer",
+						},
+						"notes": map[string]interface{}{
+							"type":        "string",
+							"description": "Notes on the answer",
+						},
+						"answered_by": map[string]interface{}{
+							"type":        "string",
+							"description": "Who answered",
+						},
+					},
+					"required": []string{"assessment_id", "requirement_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.completeComplianceAssessment,
+			Tool: Tool{
+				Name:        "complete_compliance_assessment",
+				Description: "Complete an assessment whose controls all have a final answer and apply the statuses found to the conformance of its agreement",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"assessment_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Compliance assessment identifier",
+						},
+					},
+					"required": []string{"assessment_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.getComplianceAssessment,
+			Tool: Tool{
+				Name:        "get_compliance_assessment",
+				Description: "Get a compliance assessment with its weighted score and the answer of every control",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"assessment_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Compliance assessment identifier",
+						},
+					},
+					"required": []string{"assessment_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.listComplianceAssessments,
+			Tool: Tool{
+				Name:        "list_compliance_assessments",
+				Description: "List the compliance assessments of an agreement with their scores, oldest first",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement identifier",
+						},
+					},
+					"required": []string{"agreement_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.detectComplianceDrift,
+			Tool: Tool{
+				Name:        "detect_compliance_drift",
+				Description: "Compare the conformance requirements of an agreement, or of every agreement, with their status when compliance was last monitored and report requirements that went from compliant to non-compliant",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement identifier (default: every agreement)",
+						},
+					},
+					"required": []string{},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.detectComplianceViolations,
+			Tool: Tool{
+				Name:        "detect_compliance_violations",
+				Description: "Check the conformance requirements of an agreement, or of every agreement, against expired evidence, unremediated audit findings and missing policies, and publish new violations with remediation hints",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement identifier (default: every agreement)",
+						},
+					},
+					"required": []string{},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.launchAttestationCampaign,
+			Tool: Tool{
+				Name:        "launch_attestation_campaign",
+				Description: "Ask the owners of a portfolio's applications, or of listed applications, to sign off that their governance data, security provisions and continuity plans are accurate, once or periodically",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"campaign_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Campaign identifier; later rounds of a periodic campaign are numbered after it",
+						},
+						"name": map[string]interface{}{
+							"type":        "string",
+							"description": "Campaign name",
+						},
+						"portfolio_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Portfolio whose applications are attested",
+						},
+						"application_ids": map[string]interface{}{
+							"type":        "array",
+							"items":       map[string]interface{}{"type": "string"},
+							"description": "Applications attested instead of a portfolio's",
+						},
+						"scopes": map[string]interface{}{
+							"type":        "array",
+							"items":       map[string]interface{}{"type": "string", "enum": []string{"governance_data", "security_provisions", "continuity_plans"}},
+							"description": "What owners attest (default: every scope)",
+						},
+						"owners": map[string]interface{}{
+							"type":                 "object",
+							"additionalProperties": map[string]interface{}{"type": "string"},
+							"description":          "Owner by application ID; others default to the party accountable in the agreement's RACI matrix, then the portfolio owner",
+						},
+						"frequency": map[string]interface{}{
+							"type":        "string",
+							"description": "quarterly, semiannual or annual for a periodic campaign (default: one-off)",
+							"enum":        []string{"quarterly", "semiannual", "annual"},
+						},
+						"response_days": map[string]interface{}{
+							"type":        "number",
+							"description": "Days owners have to sign off each round (default: 14)",
+						},
+						"launched_by": map[string]interface{}{
+							"type":        "string",
+							"description": "Who launches the campaign",
+						},
+					},
+					"required": []string{"campaign_id", "name"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.signOffAttestation,
+			Tool: Tool{
+				Name:        "sign_off_attestation",
+				Description: "Record an application owner confirming that its data is accurate, or disputing it with a comment",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"campaign_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Campaign round identifier",
+						},
+						"application_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Application identifier",
+						},
+						"scope": map[string]interface{}{
+							"type":        "string",
+							"description": "Scope signed off (default: every pending scope of the application)",
+							"enum":        []string{"governance_data", "security_provisions", "continuity_plans"},
+						},
+						"attester": map[string]interface{}{
+							"type":        "string",
+							"description": "Owner signing off",
+						},
+						"accurate": map[string]interface{}{
+							"type":        "boolean",
+							"description": "Whether the data is accurate; false disputes it (default: true)",
+						},
+						"comment": map[string]interface{}{
+							"type":        "string",
+							"description": "What is inaccurate, required when disputing",
+						},
+					},
+					"required": []string{"campaign_id", "application_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.closeAttestationCampaign,
+			Tool: Tool{
+				Name:        "close_attestation_campaign",
+				Description: "Close a round of an attestation campaign, leaving unanswered attestations pending",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"campaign_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Campaign round identifier",
+						},
+					},
+					"required": []string{"campaign_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.remindAttestationOwners,
+			Tool: Tool{
+				Name:        "remind_attestation_owners",
+				Description: "Start the rounds of periodic attestation campaigns that are due, then remind owners of the attestations they have not signed off",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"remind_after_hours": map[string]interface{}{
+							"type":        "number",
+							"description": "Hours before an owner is reminded again (default: 72)",
+						},
+					},
+					"required": []string{},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.getAttestationDashboard,
+			Tool: Tool{
+				Name:        "get_attestation_dashboard",
+				Description: "Show the completion of an attestation campaign round, or of every open round, by scope and owner, with disputed and outstanding attestations",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"campaign_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Campaign round identifier (default: every open round)",
+						},
+					},
+					"required": []string{},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.queryAuditTrail,
+			Tool: Tool{
+				Name:        "query_audit_trail",
+				Description: "List the recorded changes to agreements, policies, change requests and assessments, by entity or by actor",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"entity_type": map[string]interface{}{
+							"type":        "string",
+							"description": "Kind of entity changed",
+							"enum":        []string{"agreement", "policy", "change_request", "assessment"},
+						},
+						"entity_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Entity identifier; policies are identified as <agreement ID>/<policy ID>",
+						},
+						"actor": map[string]interface{}{
+							"type":        "string",
+							"description": "Who made the changes",
+						},
+						"from": map[string]interface{}{
+							"type":        "string",
+							"description": "First day of changes to list (YYYY-MM-DD)",
+						},
+						"to": map[string]interface{}{
+							"type":        "string",
+							"description": "Last day of changes to list (YYYY-MM-DD)",
+						},
+						"limit": map[string]interface{}{
+							"type":        "number",
+							"description": "Most recent changes to list (default: all)",
+						},
+					},
+					"required": []string{},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.verifyAuditTrail,
+			Tool: Tool{
+				Name:        "verify_audit_trail",
+				Description: "Check that no entry of the audit trail has been changed or removed since it was recorded",
+				InputSchema: map[string]interface{}{
+					"type":       "object",
+					"properties": map[string]interface{}{},
+					"required":   []string{},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.getSegregationOfDutiesReport,
+			Tool: Tool{
+				Name:        "get_segregation_of_duties_report",
+				Description: "Report change requests decided by their requester and assessments reviewed or signed off by their evaluator, against the enforced segregation of duties rules",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"application_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Application identifier (default: every application)",
+						},
+					},
+					"required": []string{},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.createSurvey,
+			Tool: Tool{
+				Name:        "create_survey",
+				Description: "Open a stakeholder survey of a governance agreement",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement identifier",
+						},
+						"survey_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Survey identifier",
+						},
+						"name": map[string]interface{}{
+							"type":        "string",
+							"description": "Survey name",
+						},
+						"questions": map[string]interface{}{
+							"type":        "array",
+							"description": "Questions, answered as q1, q2 and so on in order",
+							"items":       map[string]interface{}{"type": "string"},
+						},
+						"scale": map[string]interface{}{
+							"type":        "integer",
+							"description": "Highest score of an answer, scored from 1 (default: 5)",
+						},
+						"invited": map[string]interface{}{
+							"type":        "integer",
+							"description": "Stakeholders invited to respond, for the response rate",
+						},
+						"frequency": map[string]interface{}{
+							"type":        "string",
+							"description": "How often the survey is run, e.g. quarterly",
+						},
+					},
+					"required": []string{"agreement_id", "survey_id", "name", "questions"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.recordSurveyResponse,
+			Tool: Tool{
+				Name:        "record_survey_response",
+				Description: "Record one stakeholder's answers to an open survey",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement identifier",
+						},
+						"survey_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Survey identifier",
+						},
+						"respondent": map[string]interface{}{
+							"type":        "string",
+							"description": "Who responds (default: anonymous)",
+						},
+						"answers": map[string]interface{}{
+							"type":        "array",
+							"description": "Answers, each with question_id (q1, q2, ...), score (1 up to the survey's scale, 0 when unscored) and comment",
+							"items":       map[string]interface{}{"type": "object"},
+						},
+					},
+					"required": []string{"agreement_id", "survey_id", "answers"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.closeSurvey,
+			Tool: Tool{
+				Name:        "close_survey",
+				Description: "Close a survey and record its satisfaction score",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement identifier",
+						},
+						"survey_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Survey identifier",
+						},
+					},
+					"required": []string{"agreement_id", "survey_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.getSurveyResults,
+			Tool: Tool{
+				Name:        "get_survey_results",
+				Description: "Show the stakeholder surveys of a governance agreement with response rates, average scores, satisfaction and key insights",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement identifier",
+						},
+					},
+					"required": []string{"agreement_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.recordExpenditure,
+			Tool: Tool{
+				Name:        "record_expenditure",
+				Description: "Record spend against a budget allocation of a governance agreement",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement identifier",
+						},
+						"category": map[string]interface{}{
+							"type":        "string",
+							"description": "Budget allocation category the money was spent from",
+						},
+						"amount": map[string]interface{}{
+							"type":        "number",
+							"description": "Amount spent",
+						},
+						"description": map[string]interface{}{
+							"type":        "string",
+							"description": "What the money was spent on",
+						},
+						"recorded_by": map[string]interface{}{
+							"type":        "string",
+							"description": "Who records the spend (default: the authenticated principal)",
+						},
+					},
+					"required": []string{"agreement_id", "category", "amount"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.getCapacityPlan,
+			Tool: Tool{
+				Name:        "get_capacity_plan",
+				Description: "Compare the personnel allocated to a governance agreement against the demand of its initiatives and action plans, per role and month, flagging over-allocation",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement identifier",
+						},
+					},
+					"required": []string{"agreement_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.proposeDirection,
+			Tool: Tool{
+				Name:        "propose_direction",
+				Description: "Propose a strategic direction for a governance body to review and approve; it only takes effect once approved",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement identifier",
+						},
+						"proposal_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Direction proposal identifier",
+						},
+						"objectives": map[string]interface{}{
+							"type":        "array",
+							"description": "Strategic objectives, each with id, name, description and deadline (YYYY-MM-DD)",
+							"items":       map[string]interface{}{"type": "object"},
+						},
+						"initiatives": map[string]interface{}{
+							"type":        "array",
+							"description": "Strategic initiatives, each with id, name, description, owner, budget, deadline (YYYY-MM-DD) and objective_ids",
+							"items":       map[string]interface{}{"type": "object"},
+						},
+						"rationale": map[string]interface{}{
+							"type":        "string",
+							"description": "Why the direction is proposed",
+						},
+						"governing_body": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance body that must approve the direction (default: any)",
+						},
+						"proposed_by": map[string]interface{}{
+							"type":        "string",
+							"description": "Proposer name (default: the authenticated principal)",
+						},
+					},
+					"required": []string{"agreement_id", "proposal_id", "objectives"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.reviewDirection,
+			Tool: Tool{
+				Name:        "review_direction",
+				Description: "Review a proposed strategic direction; the reviewer must differ from the proposer",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement identifier",
+						},
+						"proposal_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Direction proposal identifier",
+						},
+						"reviewed_by": map[string]interface{}{
+							"type":        "string",
+							"description": "Reviewer name (default: the authenticated principal)",
+						},
+						"comments": map[string]interface{}{
+							"type":        "string",
+							"description": "Review comments",
+						},
+					},
+					"required": []string{"agreement_id", "proposal_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.approveDirection,
+			Tool: Tool{
+				Name:        "approve_direction",
+				Description: "Approve a reviewed strategic direction on behalf of a governance body and put it into effect",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement identifier",
+						},
+						"proposal_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Direction proposal identifier",
+						},
+						"approved_by": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance body ratifying the direction",
+						},
+					},
+					"required": []string{"agreement_id", "proposal_id", "approved_by"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.rejectDirection,
+			Tool: Tool{
+				Name:        "reject_direction",
+				Description: "Reject an open strategic direction proposal with comments",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement identifier",
+						},
+						"proposal_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Direction proposal identifier",
+						},
+						"rejected_by": map[string]interface{}{
+							"type":        "string",
+							"description": "Name of whoever rejects the direction (default: the authenticated principal)",
+						},
+						"comments": map[string]interface{}{
+							"type":        "string",
+							"description": "Why the direction is rejected",
+						},
+					},
+					"required": []string{"agreement_id", "proposal_id", "comments"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.saveActionPlan,
+			Tool: Tool{
+				Name:        "save_action_plan",
+				Description: "Add or replace an action plan of a governance agreement, with dependencies between its actions and on other plans, and reschedule the agreement's action plans",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement identifier",
+						},
+						"plan_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Action plan identifier",
+						},
+						"name": map[string]interface{}{
+							"type":        "string",
+							"description": "Action plan name",
+						},
+						"description": map[string]interface{}{
+							"type":        "string",
+							"description": "What the plan delivers",
+						},
+						"owner": map[string]interface{}{
+							"type":        "string",
+							"description": "Owner of the plan",
+						},
+						"objective_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Strategic objective the plan implements",
+						},
+						"deadline": map[string]interface{}{
+							"type":        "string",
+							"description": "Plan deadline (YYYY-MM-DD)",
+						},
+						"depends_on": map[string]interface{}{
+							"type":        "array",
+							"description": "Action plans that must complete before this one starts",
+							"items":       map[string]interface{}{"type": "string"},
+						},
+						"actions": map[string]interface{}{
+							"type":        "array",
+							"description": "Actions, each with id, description, responsible, deadline (YYYY-MM-DD), duration_days and depends_on (actions of the same plan)",
+							"items":       map[string]interface{}{"type": "object"},
+						},
+					},
+					"required": []string{"agreement_id", "plan_id", "actions"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.updateAction,
+			Tool: Tool{
+				Name:        "update_action",
+				Description: "Record progress on an action and reschedule, showing how a slip moves the plans and objectives depending on it",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement identifier",
+						},
+						"plan_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Action plan identifier",
+						},
+						"action_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Action identifier",
+						},
+						"status": map[string]interface{}{
+							"type":        "string",
+							"description": "New status: pending, in_progress, completed or cancelled",
+						},
+						"duration_days": map[string]interface{}{
+							"type":        "number",
+							"description": "Revised estimate of the action's effort in days",
+						},
+						"deadline": map[string]interface{}{
+							"type":        "string",
+							"description": "Revised deadline (YYYY-MM-DD)",
+						},
+						"completed_at": map[string]interface{}{
+							"type":        "string",
+							"description": "When a completed action finished (YYYY-MM-DD, default: today)",
+						},
+					},
+					"required": []string{"agreement_id", "plan_id", "action_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.getActionSchedule,
+			Tool: Tool{
+				Name:        "get_action_schedule",
+				Description: "Project the completion of a governance agreement's action plans and objectives with the critical path",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement identifier",
+						},
+					},
+					"required": []string{"agreement_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.draftPolicy,
+			Tool: Tool{
+				Name:        "draft_policy",
+				Description: "Add a draft policy to a governance agreement",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement identifier",
+						},
+						"policy_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Policy identifier",
+						},
+						"name": map[string]interface{}{
+							"type":        "string",
+							"description": "Policy name",
+						},
+						"description": map[string]interface{}{
+							"type":        "string",
+							"description": "Policy description",
+						},
+						"scope": map[string]interface{}{
+							"type":        "string",
+							"description": "What the policy applies to",
+						},
+						"owner": map[string]interface{}{
+							"type":        "string",
+							"description": "Policy owner, required before the policy is submitted",
+						},
+						"drafted_by": map[string]interface{}{
+							"type":        "string",
+							"description": "Author of the draft (default: the authenticated principal)",
+						},
+					},
+					"required": []string{"agreement_id", "policy_id", "name"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.submitPolicy,
+			Tool: Tool{
+				Name:        "submit_policy",
+				Description: "Submit a draft policy for approval",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement identifier",
+						},
+						"policy_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Policy identifier",
+						},
+						"submitted_by": map[string]interface{}{
+							"type":        "string",
+							"description": "Submitter name (default: the authenticated principal)",
+						},
+					},
+					"required": []string{"agreement_id", "policy_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.approvePolicy,
+			Tool: Tool{
+				Name:        "approve_policy",
+				Description: "Approve a submitted policy; the approver must differ from the submitter",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement identifier",
+						},
+						"policy_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Policy identifier",
+						},
+						"approved_by": map[string]interface{}{
+							"type":        "string",
+							"description": "Approver name (default: the authenticated principal)",
+						},
+					},
+					"required": []string{"agreement_id", "policy_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.publishPolicy,
+			Tool: Tool{
+				Name:        "publish_policy",
+				Description: "Publish an approved policy so it takes effect",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement identifier",
+						},
+						"policy_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Policy identifier",
+						},
+						"effective_from": map[string]interface{}{
+							"type":        "string",
+							"description": "Date the policy takes effect (YYYY-MM-DD, default: today)",
+						},
+						"effective_until": map[string]interface{}{
+							"type":        "string",
+							"description": "Date the policy ends (YYYY-MM-DD, optional)",
+						},
+					},
+					"required": []string{"agreement_id", "policy_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.retirePolicy,
+			Tool: Tool{
+				Name:        "retire_policy",
+				Description: "Retire an approved or published policy",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement identifier",
+						},
+						"policy_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Policy identifier",
+						},
+						"reason": map[string]interface{}{
+							"type":        "string",
+							"description": "Why the policy is retired",
+						},
+					},
+					"required": []string{"agreement_id", "policy_id", "reason"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.monitorGovernance,
+			Tool: Tool{
+				Name:        "monitor_governance",
+				Description: "Monitor governance metrics for an application",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"agreement_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Governance agreement identifier",
+						},
+					},
+					"required": []string{"agreement_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.listApplications,
+			Tool: Tool{
+				Name:        "list_applications",
+				Description: "List all applications in the portfolio",
+				InputSchema: map[string]interface{}{
+					"type":       "object",
+					"properties": map[string]interface{}{},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.listPortfolios,
+			Tool: Tool{
+				Name:        "list_portfolios",
+				Description: "List all portfolios",
+				InputSchema: map[string]interface{}{
+					"type":       "object",
+					"properties": map[string]interface{}{},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.runEnterpriseDemo,
+			Tool: Tool{
+				Name:        "run_enterprise_demo",
+				Description: "Run the complete enterprise governance demonstration",
+				InputSchema: map[string]interface{}{
+					"type":       "object",
+					"properties": map[string]interface{}{},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.listToolInvocations,
+			Tool: Tool{
+				Name:        "list_tool_invocations",
+				Description: "List which principals called which tools, for governance attribution",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"subject": map[string]interface{}{
+							"type":        "string",
+							"description": "Only show calls made by this principal",
+						},
+					},
+				},
+			},
+		},
+		{
+			Toolset: toolsetCore,
+			Handler: s.configureChangeManagement,
+			Tool: Tool{
+				Name:        "configure_change_management",
+				Description: "Enable change request and incident management tools backed by in-memory repositories",
+				InputSchema: map[string]interface{}{
+					"type":       "object",
+					"properties": map[string]interface{}{},
+				},
+			},
+		},
+	}
+}
+
+// changeManagementTools returns the tools that require change management repositories
+func (s *MCPServer) changeManagementTools() []toolDefinition {
+	return []toolDefinition{
+		{
+			Toolset: toolsetChangeManagement,
+			Handler: s.createChangeRequest,
+			Tool: Tool{
+				Name:        "create_change_request",
+				Description: "Create a change request for an application",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"id": map[string]interface{}{
+							"type":        "string",
+							"description": "Unique change request identifier",
+						},
+						"application_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Application identifier",
+						},
+						"requester": map[string]interface{}{
+							"type":        "string",
+							"description": "Person requesting the change",
+						},
+						"title": map[string]interface{}{
+							"type":        "string",
+							"description": "Change request title",
+						},
+						"description": map[string]interface{}{
+							"type":        "string",
+							"description": "Change request description",
+						},
+						"type": map[string]interface{}{
+							"type":        "string",
+							"description": "Change type (standard, normal, emergency)",
+						},
+						"priority": map[string]interface{}{
+							"type":        "string",
+							"description": "Priority (critical, high, medium, low)",
+						},
+					},
+					"required": []string{"id", "application_id", "requester", "title"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetChangeManagement,
+			Handler: s.analyzeChangeImpact,
+			Tool: Tool{
+				Name:        "analyze_change_impact",
+				Description: "Recompute the downstream applications and governance agreements a change request affects from the current dependency graph",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"change_request_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Change request identifier",
+						},
+					},
+					"required": []string{"change_request_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetChangeManagement,
+			Handler: s.submitChangeRequest,
+			Tool: Tool{
+				Name:        "submit_change_request",
+				Description: "Submit a draft change request for approval",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"change_request_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Change request identifier",
+						},
+					},
+					"required": []string{"change_request_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetChangeManagement,
+			Handler: s.approveChangeRequest,
+			Tool: Tool{
+				Name:        "approve_change_request",
+				Description: "Record an approval of a change request; it is approved once the approval matrix for its type and priority is satisfied",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"change_request_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Change request identifier",
+						},
+						"approver": map[string]interface{}{
+							"type":        "string",
+							"description": "Name of the approver",
+						},
+						"role": map[string]interface{}{
+							"type":        "string",
+							"description": "Role of the approver",
+						},
+						"comments": map[string]interface{}{
+							"type":        "string",
+							"description": "Approval comments",
+						},
+					},
+					"required": []string{"change_request_id", "approver"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetChangeManagement,
+			Handler: s.getChangeApprovals,
+			Tool: Tool{
+				Name:        "get_change_approvals",
+				Description: "Show the approvals a change request has and still needs under the approval matrix",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"change_request_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Change request identifier",
+						},
+					},
+					"required": []string{"change_request_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetChangeManagement,
+			Handler: s.listOpenApprovals,
+			Tool: Tool{
+				Name:        "list_open_approvals",
+				Description: "List the change requests awaiting approval, grouped by the approver roles they await",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"approver_role": map[string]interface{}{
+							"type":        "string",
+							"description": "Only list the change requests awaiting approval by this role, e.g. change_approver or cab",
+						},
+					},
+					"required": []string{},
+				},
+			},
+		},
+		{
+			Toolset: toolsetChangeManagement,
+			Handler: s.implementChangeRequest,
+			Tool: Tool{
+				Name:        "implement_change_request",
+				Description: "Record the implementation of an approved change request with its outcome and whether it was rolled back; incidents on its application in the next 72 hours are linked to it",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"change_request_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Change request identifier",
+						},
+						"implementer": map[string]interface{}{
+							"type":        "string",
+							"description": "Person implementing the change",
+						},
+						"outcome": map[string]interface{}{
+							"type":        "string",
+							"description": "How the implementation went (default successful)",
+							"enum":        []string{"successful", "partial", "failed"},
+						},
+						"rolled_back": map[string]interface{}{
+							"type":        "boolean",
+							"description": "Whether the change was rolled back",
+						},
+						"notes": map[string]interface{}{
+							"type":        "string",
+							"description": "Implementation notes",
+						},
+					},
+					"required": []string{"change_request_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetChangeManagement,
+			Handler: s.closeChangeRequest,
+			Tool: Tool{
+				Name:        "close_change_request",
+				Description: "Close an implemented change request after verifying whether it achieved its objectives",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"change_request_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Change request identifier",
+						},
+						"verified_by": map[string]interface{}{
+							"type":        "string",
+							"description": "Person who verified the change",
+						},
+						"successful": map[string]interface{}{
+							"type":        "boolean",
+							"description": "Whether the change achieved its objectives",
+						},
+						"notes": map[string]interface{}{
+							"type":        "string",
+							"description": "What was checked to verify the change",
+						},
+					},
+					"required": []string{"change_request_id", "successful", "notes"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetChangeManagement,
+			Handler: s.withdrawChangeRequest,
+			Tool: Tool{
+				Name:        "withdraw_change_request",
+				Description: "Withdraw a draft or submitted change request at its requester's initiative",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"change_request_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Change request identifier",
+						},
+						"requester": map[string]interface{}{
+							"type":        "string",
+							"description": "Requester of the change request (default: the calling principal)",
+						},
+						"reason": map[string]interface{}{
+							"type":        "string",
+							"description": "Why the change request is withdrawn",
+						},
+					},
+					"required": []string{"change_request_id", "reason"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetChangeManagement,
+			Handler: s.cancelChangeRequest,
+			Tool: Tool{
+				Name:        "cancel_change_request",
+				Description: "Cancel an approved change request that will not be implemented; only one of its approvers may cancel it",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"change_request_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Change request identifier",
+						},
+						"cancelled_by": map[string]interface{}{
+							"type":        "string",
+							"description": "Approver cancelling the change request (default: the calling principal)",
+						},
+						"reason": map[string]interface{}{
+							"type":        "string",
+							"description": "Why the change request is cancelled",
+						},
+					},
+					"required": []string{"change_request_id", "reason"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetChangeManagement,
+			Handler: s.getChangeFailureRate,
+			Tool: Tool{
+				Name:        "get_change_failure_rate",
+				Description: "Get the share of an application's changes of the last 90 days that failed, were rolled back or were followed by incidents, and the changes likely causing them",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"application_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Application identifier",
+						},
+					},
+					"required": []string{"application_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetChangeManagement,
+			Handler: s.getChangeMetrics,
+			Tool: Tool{
+				Name:        "get_change_metrics",
+				Description: "Get the change volume, lead time, success rate and emergency change ratio of an application or portfolio, broken down by period",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"application_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Application identifier (or portfolio_id)",
+						},
+						"portfolio_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Portfolio identifier, covering all of its applications (or application_id)",
+						},
+						"days": map[string]interface{}{
+							"type":        "number",
+							"description": "Window of days ending now (default: 90)",
+						},
+						"period_days": map[string]interface{}{
+							"type":        "number",
+							"description": "Length of the periods the window is broken down into, in days (default: 30)",
+						},
+					},
+				},
+			},
+		},
+		{
+			Toolset: toolsetChangeManagement,
+			Handler: s.reportIncident,
+			Tool: Tool{
+				Name:        "report_incident",
+				Description: "Report an incident affecting an application",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"id": map[string]interface{}{
+							"type":        "string",
+							"description": "Unique incident identifier",
+						},
+						"application_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Application identifier",
+						},
+						"reporter": map[string]interface{}{
+							"type":        "string",
+							"description": "Person reporting the incident",
+						},
+						"severity": map[string]interface{}{
+							"type":        "integer",
+							"description": "Incident severity (1 = highest), one the agreement classifies (default: suggested from the impact keywords of its classification matrix)",
+						},
+						"priority": map[string]interface{}{
+							"type":        "integer",
+							"description": "Incident priority (1 = most urgent), one the agreement's prioritization matrix defines (default: suggested from the severity and application criticality)",
+						},
+						"title": map[string]interface{}{
+							"type":        "string",
+							"description": "Incident title",
+						},
+						"description": map[string]interface{}{
+							"type":        "string",
+							"description": "Incident description",
+						},
+						"impact": map[string]interface{}{
+							"type":        "string",
+							"description": "Business impact of the incident",
+						},
+					},
+					"required": []string{"id", "application_id", "reporter", "title"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetChangeManagement,
+			Handler: s.classifyIncident,
+			Tool: Tool{
+				Name:        "classify_incident",
+				Description: "Suggest the severity and priority of an incident before reporting it, from the impact keywords of the application's agreement and the application's criticality",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"application_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Application identifier",
+						},
+						"title": map[string]interface{}{
+							"type":        "string",
+							"description": "Incident title",
+						},
+						"description": map[string]interface{}{
+							"type":        "string",
+							"description": "Incident description",
+						},
+						"impact": map[string]interface{}{
+							"type":        "string",
+							"description": "Business impact of the incident",
+						},
+					},
+					"required": []string{"application_id", "title"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetChangeManagement,
+			Handler: s.checkIncidentSLAs,
+			Tool: Tool{
+				Name:        "check_incident_slas",
+				Description: "Check unresolved incidents against the response and resolution SLAs of their governance agreement, showing the time left and marking those past a target as breached",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"application_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Application identifier; every application when omitted",
+						},
+					},
+					"required": []string{},
+				},
+			},
+		},
+		{
+			Toolset: toolsetChangeManagement,
+			Handler: s.acknowledgeIncident,
+			Tool: Tool{
+				Name:        "acknowledge_incident",
+				Description: "Acknowledge an open incident and start investigating it; the time to acknowledge feeds the application's MTTA",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"incident_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Incident identifier",
+						},
+						"acknowledger": map[string]interface{}{
+							"type":        "string",
+							"description": "Person acknowledging the incident",
+						},
+					},
+					"required": []string{"incident_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetChangeManagement,
+			Handler: s.assignIncident,
+			Tool: Tool{
+				Name:        "assign_incident",
+				Description: "Assign an incident that is not closed to the person working on it, or reassign it",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"incident_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Incident identifier",
+						},
+						"assignee": map[string]interface{}{
+							"type":        "string",
+							"description": "Person the incident is assigned to",
+						},
+						"assigned_by": map[string]interface{}{
+							"type":        "string",
+							"description": "Person making the assignment",
+						},
+					},
+					"required": []string{"incident_id", "assignee"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetChangeManagement,
+			Handler: s.listIncidentsByAssignee,
+			Tool: Tool{
+				Name:        "list_incidents_by_assignee",
+				Description: "List the incidents assigned to someone, most recently reported first",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"assignee": map[string]interface{}{
+							"type":        "string",
+							"description": "Person the incidents are assigned to",
+						},
+						"include_closed": map[string]interface{}{
+							"type":        "boolean",
+							"description": "Include closed incidents (default false)",
+						},
+					},
+					"required": []string{"assignee"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetChangeManagement,
+			Handler: s.resolveIncident,
+			Tool: Tool{
+				Name:        "resolve_incident",
+				Description: "Resolve an open incident",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"incident_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Incident identifier",
+						},
+						"resolver": map[string]interface{}{
+							"type":        "string",
+							"description": "Person resolving the incident",
+						},
+						"resolution": map[string]interface{}{
+							"type":        "string",
+							"description": "Resolution summary",
+						},
+						"root_cause": map[string]interface{}{
+							"type":        "string",
+							"description": "Identified root cause",
+						},
+					},
+					"required": []string{"incident_id", "resolution"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetChangeManagement,
+			Handler: s.reopenIncident,
+			Tool: Tool{
+				Name:        "reopen_incident",
+				Description: "Reopen a resolved incident whose issue came back; it returns to investigating if it was acknowledged, or to open otherwise",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"incident_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Incident identifier",
+						},
+						"reopened_by": map[string]interface{}{
+							"type":        "string",
+							"description": "Person reopening the incident",
+						},
+						"reason": map[string]interface{}{
+							"type":        "string",
+							"description": "Why the incident is reopened",
+						},
+					},
+					"required": []string{"incident_id", "reason"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetChangeManagement,
+			Handler: s.recordPostIncidentReview,
+			Tool: Tool{
+				Name:        "record_post_incident_review",
+				Description: "Record the post-incident review of a resolved incident: its timeline, contributing factors and action items with owners. Severity 1 and 2 incidents need a completed review before they can be closed.",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"incident_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Incident identifier",
+						},
+						"facilitator": map[string]interface{}{
+							"type":        "string",
+							"description": "Person leading the review",
+						},
+						"summary": map[string]interface{}{
+							"type":        "string",
+							"description": "What happened and its impact",
+						},
+						"timeline": map[string]interface{}{
+							"type":        "array",
+							"description": "Timeline entries, each with at (RFC 3339 time) and description",
+							"items":       map[string]interface{}{"type": "object"},
+						},
+						"contributing_factors": map[string]interface{}{
+							"type":        "array",
+							"description": "Factors that contributed to the incident",
+							"items":       map[string]interface{}{"type": "string"},
+						},
+						"action_items": map[string]interface{}{
+							"type":        "array",
+							"description": "Action items, each with id, description, owner and optional due_date (YYYY-MM-DD)",
+							"items":       map[string]interface{}{"type": "object"},
+						},
+					},
+					"required": []string{"incident_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetChangeManagement,
+			Handler: s.updateReviewAction,
+			Tool: Tool{
+				Name:        "update_review_action",
+				Description: "Record the progress of an action item of a post-incident review",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"incident_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Incident identifier",
+						},
+						"action_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Action item identifier",
+						},
+						"status": map[string]interface{}{
+							"type":        "string",
+							"description": "New status of the action item",
+							"enum":        []string{"pending", "in_progress", "completed", "cancelled"},
+						},
+					},
+					"required": []string{"incident_id", "action_id", "status"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetChangeManagement,
+			Handler: s.completePostIncidentReview,
+			Tool: Tool{
+				Name:        "complete_post_incident_review",
+				Description: "Complete the post-incident review of a resolved incident once it has a timeline, contributing factors and action items",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"incident_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Incident identifier",
+						},
+						"completed_by": map[string]interface{}{
+							"type":        "string",
+							"description": "Person completing the review",
+						},
+					},
+					"required": []string{"incident_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetChangeManagement,
+			Handler: s.closeIncident,
+			Tool: Tool{
+				Name:        "close_incident",
+				Description: "Close a resolved incident with a closure code; severity 1 and 2 incidents need a completed post-incident review first",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"incident_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Incident identifier",
+						},
+						"closer": map[string]interface{}{
+							"type":        "string",
+							"description": "Person closing the incident",
+						},
+						"closure_code": map[string]interface{}{
+							"type":        "string",
+							"description": "Why the incident is closed",
+							"enum":        []string{"fixed", "workaround", "duplicate", "not_reproducible", "cancelled"},
+						},
+					},
+					"required": []string{"incident_id", "closure_code"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetChangeManagement,
+			Handler: s.logProblem,
+			Tool: Tool{
+				Name:        "log_problem",
+				Description: "Log a problem underlying recurring incidents of an application, grouping those incidents under it",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"id": map[string]interface{}{
+							"type":        "string",
+							"description": "Unique problem identifier",
+						},
+						"application_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Application identifier",
+						},
+						"title": map[string]interface{}{
+							"type":        "string",
+							"description": "Problem title",
+						},
+						"description": map[string]interface{}{
+							"type":        "string",
+							"description": "Problem description",
+						},
+						"owner": map[string]interface{}{
+							"type":        "string",
+							"description": "Person analyzing and fixing the problem",
+						},
+						"priority": map[string]interface{}{
+							"type":        "string",
+							"description": "Problem priority (default medium)",
+							"enum":        []string{"low", "medium", "high", "critical"},
+						},
+						"incident_ids": map[string]interface{}{
+							"type":        "array",
+							"description": "Incidents caused by the problem",
+							"items":       map[string]interface{}{"type": "string"},
+						},
+					},
+					"required": []string{"id", "application_id", "title"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetChangeManagement,
+			Handler: s.linkProblemIncidents,
+			Tool: Tool{
+				Name:        "link_problem_incidents",
+				Description: "Group further incidents under a problem",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"problem_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Problem identifier",
+						},
+						"incident_ids": map[string]interface{}{
+							"type":        "array",
+							"description": "Incidents caused by the problem",
+							"items":       map[string]interface{}{"type": "string"},
+						},
+					},
+					"required": []string{"problem_id", "incident_ids"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetChangeManagement,
+			Handler: s.recordKnownError,
+			Tool: Tool{
+				Name:        "record_known_error",
+				Description: "Record the root cause of a problem found by analysis, and the workaround for its incidents until it is fixed, making it a known error",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"problem_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Problem identifier",
+						},
+						"root_cause": map[string]interface{}{
+							"type":        "string",
+							"description": "Root cause found by analysis",
+						},
+						"workaround": map[string]interface{}{
+							"type":        "string",
+							"description": "How to work around the problem until it is fixed",
+						},
+						"analyzed_by": map[string]interface{}{
+							"type":        "string",
+							"description": "Person who analyzed the root cause",
+						},
+					},
+					"required": []string{"problem_id", "root_cause"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetChangeManagement,
+			Handler: s.linkProblemChange,
+			Tool: Tool{
+				Name:        "link_problem_change",
+				Description: "Link a change request raised to fix a problem",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"problem_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Problem identifier",
+						},
+						"change_request_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Change request identifier",
+						},
+					},
+					"required": []string{"problem_id", "change_request_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetChangeManagement,
+			Handler: s.resolveProblem,
+			Tool: Tool{
+				Name:        "resolve_problem",
+				Description: "Resolve a known error once a change request linked to it has been implemented",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"problem_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Problem identifier",
+						},
+						"resolver": map[string]interface{}{
+							"type":        "string",
+							"description": "Person resolving the problem",
+						},
+						"resolution": map[string]interface{}{
+							"type":        "string",
+							"description": "Resolution summary",
+						},
+					},
+					"required": []string{"problem_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetChangeManagement,
+			Handler: s.closeProblem,
+			Tool: Tool{
+				Name:        "close_problem",
+				Description: "Close a resolved problem whose incidents are confirmed not to recur",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"problem_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Problem identifier",
+						},
+					},
+					"required": []string{"problem_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetChangeManagement,
+			Handler: s.listProblems,
+			Tool: Tool{
+				Name:        "list_problems",
+				Description: "List the problems of an application, and its recurring incidents not grouped under a problem yet",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"application_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Application identifier",
+						},
+					},
+					"required": []string{"application_id"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetChangeManagement,
+			Handler: s.syncITSM,
+			Tool: Tool{
+				Name:        "sync_itsm",
+				Description: "Mirror change requests and incidents to the configured ServiceNow or Jira targets: create missing records, push local changes and comments, and pull status changes and comments made there",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"kind": map[string]interface{}{
+							"type":        "string",
+							"description": "Kind of record to sync (default: every configured target)",
+							"enum":        []string{"change_request", "incident"},
+						},
+						"application_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Only sync the records of this application",
+						},
+					},
+				},
+			},
+		},
+		{
+			Toolset: toolsetChangeManagement,
+			Handler: s.addITSMComment,
+			Tool: Tool{
+				Name:        "add_itsm_comment",
+				Description: "Comment on a change request or incident mirrored to an ITSM tool and push the comment there",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"kind": map[string]interface{}{
+							"type":        "string",
+							"description": "Kind of record",
+							"enum":        []string{"change_request", "incident"},
+						},
+						"id": map[string]interface{}{
+							"type":        "string",
+							"description": "Change request or incident identifier",
+						},
+						"author": map[string]interface{}{
+							"type":        "string",
+							"description": "Comment author",
+						},
+						"body": map[string]interface{}{
+							"type":        "string",
+							"description": "Comment text",
+						},
+					},
+					"required": []string{"kind", "id", "body"},
+				},
+			},
+		},
+		{
+			Toolset: toolsetChangeManagement,
+			Handler: s.listITSMLinks,
+			Tool: Tool{
+				Name:        "list_itsm_links",
+				Description: "List the sync targets and the change requests and incidents mirrored to ITSM tools, with their external keys and last sync",
+				InputSchema: map[string]interface{}{
+					"type":       "object",
+					"properties": map[string]interface{}{},
+				},
+			},
+		},
+		{
+			Toolset: toolsetChangeManagement,
+			Handler: s.getOperationalMetrics,
+			Tool: Tool{
+				Name:        "get_operational_metrics",
+				Description: "Get an application's MTTA, MTTR, incident frequency and severity distribution over the incidents of the last 90 days",
+				InputSchema: map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"application_id": map[string]interface{}{
+							"type":        "string",
+							"description": "Application identifier",
+						},
+					},
+					"required": []string{"application_id"},
+				},
+			},
+		},
+	}
+}
+
+func (s *MCPServer) configureChangeManagement(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	if s.changeService != nil {
+		return s.toolResult("ℹ️ Change management is already configured", nil)
+	}
+
+	s.ConfigureChangeManagement(
+		s.changeRequestRepo,
+		s.incidentRepo,
+		s.auditRepo,
+	)
+
+	return s.toolResult("✅ Change management configured\nNew tools: create_change_request, analyze_change_impact, submit_change_request, approve_change_request, get_change_approvals, implement_change_request, close_change_request, list_open_approvals, withdraw_change_request, cancel_change_request, get_change_failure_rate, get_change_metrics, report_incident, classify_incident, check_incident_slas, acknowledge_incident, assign_incident, list_incidents_by_assignee, resolve_incident, reopen_incident, record_post_incident_review, update_review_action, complete_post_incident_review, close_incident, get_operational_metrics, log_problem, link_problem_incidents, record_known_error, link_problem_change, resolve_problem, close_problem, list_problems, sync_itsm, add_itsm_comment, list_itsm_links", nil)
+}
+
+func (s *MCPServer) createChangeRequest(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	id, _ := args["id"].(string)
+	applicationID, _ := args["application_id"].(string)
+	requester, _ := args["requester"].(string)
+	requester, err := actorName(ctx, requester, "")
+	if err != nil {
+		return nil, err
+	}
+	title, _ := args["title"].(string)
+	description, _ := args["description"].(string)
+	changeType, ok := args["type"].(string)
+	if !ok {
+		changeType = string(domain.ChangeNormal)
+	}
+	priority, ok := args["priority"].(string)
+	if !ok {
+		priority = string(domain.PriorityMedium)
+	}
+
+	changeRequest, err := s.changeService.CreateChangeRequest(ctx, application.CreateChangeRequestCommand{
+		ID:            id,
+		ApplicationID: domain.ApplicationID(applicationID),
+		Requester:     requester,
+		Type:          domain.ChangeType(changeType),
+		Priority:      domain.Priority(priority),
+		Title:         title,
+		Description:   description,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	text := fmt.Sprintf("✅ Created change request: %s\nApplication: %s\nTitle: %s\nType: %s | Priority: %s\nStatus: %s",
+		changeRequest.ID, changeRequest.ApplicationID, changeRequest.Title, changeRequest.Type, changeRequest.Priority, changeRequest.Status)
+	if changeRequest.ImpactAnalysis != nil {
+		text += "\n\n" + formatChangeImpact(*changeRequest.ImpactAnalysis)
+	}
+
+	return s.toolResult(text, changeRequest)
+}
+
+func (s *MCPServer) analyzeChangeImpact(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	changeRequestID, _ := args["change_request_id"].(string)
+
+	impact, err := s.changeService.AnalyzeChangeImpact(ctx, changeRequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	text := fmt.Sprintf("Change request %s\n%s", changeRequestID, formatChangeImpact(*impact))
+
+	return s.toolResult(text, impact)
+}
+
+// formatChangeImpact lists the downstream applications and agreements a change affects
+func formatChangeImpact(impact domain.ChangeImpact) string {
+	text := fmt.Sprintf("🔗 Impact: %s", impact.Summary())
+	for _, app := range impact.Applications {
+		text += fmt.Sprintf("\n   • %s (%s criticality), depth %d via %s", app.ApplicationID, app.Criticality, app.Depth, app.Via)
+	}
+	if len(impact.Agreements) > 0 {
+		agreements := make([]string, 0, len(impact.Agreements))
+		for _, id := range impact.Agreements {
+			agreements = append(agreements, string(id))
+		}
+		text += fmt.Sprintf("\n   Agreements: %s", strings.Join(agreements, ", "))
+	}
+	return text
+}
+
+func (s *MCPServer) submitChangeRequest(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	changeRequestID, _ := args["change_request_id"].(string)
+
+	err := s.changeService.SubmitChangeRequest(ctx, changeRequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	text := fmt.Sprintf("✅ Submitted change request %s for approval", changeRequestID)
+
+	return s.toolResult(text, map[string]string{"change_request_id": changeRequestID, "status": string(domain.ChangeStatusSubmitted)})
+}
+
+func (s *MCPServer) approveChangeRequest(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	changeRequestID, _ := args["change_request_id"].(string)
+	approver, _ := args["approver"].(string)
+	approver, err := actorName(ctx, approver, "")
+	if err != nil {
+		return nil, err
+	}
+	role, _ := args["role"].(string)
+	comments, _ := args["comments"].(string)
+
+	progress, err := s.changeService.ApproveChangeRequest(ctx, application.ApproveChangeRequestCommand{
+		ChangeRequestID: changeRequestID,
+		Approver:        approver,
+		Role:            role,
+		Comments:        comments,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	text := fmt.Sprintf("✅ Change request %s approved by %s", changeRequestID, approver)
+	if !progress.Satisfied {
+		text = fmt.Sprintf("⏳ Approval by %s recorded on change request %s\nStill needed: %s", approver, changeRequestID, progress.Outstanding())
+	}
+
+	return s.toolResult(text, progress)
+}
+
+func (s *MCPServer) getChangeApprovals(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	changeRequestID, _ := args["change_request_id"].(string)
+
+	progress, err := s.changeService.GetApprovalProgress(ctx, changeRequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	status := "⏳ Awaiting approval"
+	if progress.Satisfied {
+		status = "✅ Approval matrix satisfied"
+	}
+	text := fmt.Sprintf("%s: change request %s\nRule: %s\n", status, changeRequestID, progress.Rule.Description())
+	if len(progress.Approvers) > 0 {
+		text += fmt.Sprintf("Approved by: %s\n", strings.Join(progress.Approvers, ", "))
+	}
+	text += fmt.Sprintf("Still needed: %s", progress.Outstanding())
+
+	return s.toolResult(text, progress)
+}
+
+func (s *MCPServer) listOpenApprovals(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	role, _ := args["approver_role"].(string)
+
+	byRole := s.openApprovals.ByApprover()
+	if role != "" {
+		byRole = map[string][]projection.OpenApproval{role: s.openApprovals.ForApprover(role)}
+	}
+	roles := make([]string, 0, len(byRole))
+	for r, changes := range byRole {
+		if len(changes) > 0 {
+			roles = append(roles, r)
+		}
+	}
+	if len(roles) == 0 {
+		return s.toolResult("No change requests awaiting approval", byRole)
+	}
+	sort.Strings(roles)
+
+	text := "⏳ Open Approvals by Approver:\n"
+	for _, r := range roles {
+		text += fmt.Sprintf("\n%s (%d):\n", r, len(byRole[r]))
+		for _, change := range byRole[r] {
+			text += fmt.Sprintf("• %s: %s [%s priority, %s], submitted %s\n", change.ChangeRequestID, change.Title, change.Priority, change.ApplicationID, change.SubmittedAt.Format("2006-01-02 15:04"))
+		}
+	}
+	return s.toolResult(text, byRole)
+}
+
+func (s *MCPServer) implementChangeRequest(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	changeRequestID, _ := args["change_request_id"].(string)
+	implementer, _ := args["implementer"].(string)
+	implementer, err := actorName(ctx, implementer, "MCP Assistant")
+	if err != nil {
+		return nil, err
+	}
+	outcome, _ := args["outcome"].(string)
+	rolledBack, _ := args["rolled_back"].(bool)
+	notes, _ := args["notes"].(string)
+
+	changeRequest, err := s.changeService.ImplementChangeRequest(ctx, application.ImplementChangeRequestCommand{
+		ChangeRequestID: changeRequestID,
+		Implementer:     implementer,
+		Outcome:         domain.ImplementationOutcome(outcome),
+		RolledBack:      rolledBack,
+		Notes:           notes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	text := fmt.Sprintf("🚀 Change request %s implemented on %s by %s at %s\nOutcome: %s", changeRequest.ID, changeRequest.ApplicationID, implementer,
+		changeRequest.ImplementedAt.Format(time.RFC3339), changeRequest.Implementation.Outcome)
+	if changeRequest.Implementation.RolledBack {
+		text += " (rolled back)"
+	}
+	if changeRequest.ImplementationFailed() {
+		text += "\n⚠️ Counts as a failed change in the change failure rate"
+	}
+
+	return s.toolResult(text, changeRequest)
+}
+
+func (s *MCPServer) closeChangeRequest(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	changeRequestID, _ := args["change_request_id"].(string)
+	verifiedBy, _ := args["verified_by"].(string)
+	verifiedBy, err := actorName(ctx, verifiedBy, "MCP Assistant")
+	if err != nil {
+		return nil, err
+	}
+	successful, _ := args["successful"].(bool)
+	notes, _ := args["notes"].(string)
+
+	changeRequest, err := s.changeService.CloseChangeRequest(ctx, application.CloseChangeRequestCommand{
+		ChangeRequestID: changeRequestID,
+		VerifiedBy:      verifiedBy,
+		Successful:      successful,
+		Notes:           notes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	verdict := "achieved its objectives"
+	if !successful {
+		verdict = "fell short of its objectives"
+	}
+	text := fmt.Sprintf("✅ Closed change request %s\nVerified by %s: %s\nNotes: %s", changeRequest.ID, verifiedBy, verdict, notes)
+
+	return s.toolResult(text, changeRequest)
+}
+
+func (s *MCPServer) withdrawChangeRequest(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	changeRequestID, _ := args["change_request_id"].(string)
+	requester, _ := args["requester"].(string)
+	requester, err := actorName(ctx, requester, "")
+	if err != nil {
+		return nil, err
+	}
+	reason, _ := args["reason"].(string)
+
+	changeRequest, err := s.changeService.WithdrawChangeRequest(ctx, application.WithdrawChangeRequestCommand{
+		ChangeRequestID: changeRequestID,
+		Requester:       requester,
+		Reason:          reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	text := fmt.Sprintf("↩️ Withdrew change request %s\nWithdrawn by %s: %s", changeRequest.ID, requester, reason)
+
+	return s.toolResult(text, changeRequest)
+}
+
+func (s *MCPServer) cancelChangeRequest(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	changeRequestID, _ := args["change_request_id"].(string)
+	cancelledBy, _ := args["cancelled_by"].(string)
+	cancelledBy, err := actorName(ctx, cancelledBy, "")
+	if err != nil {
+		return nil, err
+	}
+	reason, _ := args["reason"].(string)
+
+	changeRequest, err := s.changeService.CancelChangeRequest(ctx, application.CancelChangeRequestCommand{
+		ChangeRequestID: changeRequestID,
+		CancelledBy:     cancelledBy,
+		Reason:          reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	text := fmt.Sprintf("🚫 Cancelled change request %s\nCancelled by %s: %s", changeRequest.ID, cancelledBy, reason)
+
+	return s.toolResult(text, changeRequest)
+}
+
+func (s *MCPServer) getChangeFailureRate(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	applicationID, _ := args["application_id"].(string)
+
+	rate, err := s.changeService.GetChangeFailureRate(ctx, domain.ApplicationID(applicationID))
+	if err != nil {
+		return nil, err
+	}
+
+	text := fmt.Sprintf("🔀 Change failures for %s (last 90 days)\n", applicationID)
+	text += formatChangeFailureRate(rate, "")
+
+	return s.toolResult(text, rate)
+}
+
+func (s *MCPServer) getChangeMetrics(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	applicationID, _ := args["application_id"].(string)
+	portfolioID, _ := args["portfolio_id"].(string)
+	days, _ := args["days"].(float64)
+	periodDays, _ := args["period_days"].(float64)
+
+	metrics, err := s.changeService.GetChangeMetrics(ctx, application.GetChangeMetricsCommand{
+		ApplicationID: domain.ApplicationID(applicationID),
+		PortfolioID:   domain.PortfolioID(portfolioID),
+		Window:        time.Duration(days * float64(24*time.Hour)),
+		Period:        time.Duration(periodDays * float64(24*time.Hour)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	subject := applicationID
+	if portfolioID != "" {
+		subject = "portfolio " + portfolioID
+	}
+	text := fmt.Sprintf("🔀 Change metrics for %s (%s to %s)\n", subject, metrics.From.Format("2006-01-02"), metrics.To.Format("2006-01-02"))
+	text += formatChangeMetrics(metrics, "")
+	if len(metrics.Periods) > 1 {
+		text += "\nBy period:\n"
+		for _, period := range metrics.Periods {
+			text += fmt.Sprintf("• %s to %s: %d requested, %d implemented", period.From.Format("2006-01-02"), period.To.Format("2006-01-02"), period.Requested, period.Implemented)
+			if period.Implemented > 0 {
+				text += fmt.Sprintf(", %.1f%% successful, %.1f%% emergency, lead time %s", period.SuccessRate, period.EmergencyRatio, period.LeadTime.Round(time.Minute))
+			}
+			text += "\n"
+		}
+	}
+
+	return s.toolResult(text, metrics)
+}
+
+func (s *MCPServer) reportIncident(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	id, _ := args["id"].(string)
+	applicationID, _ := args["application_id"].(string)
+	reporter, _ := args["reporter"].(string)
+	reporter, err := actorName(ctx, reporter, "")
+	if err != nil {
+		return nil, err
+	}
+	title, _ := args["title"].(string)
+	description, _ := args["description"].(string)
+	impact, _ := args["impact"].(string)
+	severity, _ := args["severity"].(float64)
+	priority, _ := args["priority"].(float64)
+
+	incident, err := s.changeService.ReportIncident(ctx, application.ReportIncidentCommand{
+		ID:            id,
+		ApplicationID: domain.ApplicationID(applicationID),
+		Reporter:      reporter,
+		Severity:      int(severity),
+		Priority:      int(priority),
+		Title:         title,
+		Description:   description,
+		Impact:        impact,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	text := fmt.Sprintf("🚨 Reported incident: %s\nApplication: %s\nTitle: %s\nSeverity: %d\n",
+		incident.ID, incident.ApplicationID, incident.Title, incident.Severity)
+	if incident.Priority != 0 {
+		text += fmt.Sprintf("Priority: %d\n", incident.Priority)
+	}
+	if classification := incident.Classification; classification != nil && (classification.Severity != incident.Severity || classification.Priority != incident.Priority) {
+		text += fmt.Sprintf("🏷️ Suggested: %s\n", formatIncidentClassification(*classification))
+	}
+	text += fmt.Sprintf("Reported: %s", incident.CreatedAt.Format(time.RFC3339))
+	if len(incident.SuspectedChanges) > 0 {
+		text += fmt.Sprintf("\n🔀 Likely caused by recently implemented change(s): %s", strings.Join(incident.SuspectedChanges, ", "))
+	}
+	if !incident.ResponseDue.IsZero() {
+		text += fmt.Sprintf("\n⏱️ Acknowledge by: %s", incident.ResponseDue.Format(time.RFC3339))
+	}
+	if !incident.ResolutionDue.IsZero() {
+		text += fmt.Sprintf("\n⏱️ Resolve by: %s", incident.ResolutionDue.Format(time.RFC3339))
+	}
+
+	return s.toolResult(text, incident)
+}
+
+func (s *MCPServer) classifyIncident(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	applicationID, _ := args["application_id"].(string)
+	title, _ := args["title"].(string)
+	description, _ := args["description"].(string)
+	impact, _ := args["impact"].(string)
+
+	classification, err := s.changeService.ClassifyIncident(ctx, application.ClassifyIncidentCommand{
+		ApplicationID: domain.ApplicationID(applicationID),
+		Title:         title,
+		Description:   description,
+		Impact:        impact,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	text := fmt.Sprintf("🏷️ Incident classification for %s\n%s\n", applicationID, formatIncidentClassification(*classification))
+	if len(classification.Keywords) > 0 {
+		text += fmt.Sprintf("Matched keywords: %s\n", strings.Join(classification.Keywords, ", "))
+	} else {
+		text += "No impact keywords matched; the least severe class applies\n"
+	}
+	if classification.Default {
+		text += "The agreement defines no classification matrix; the default four severities apply\n"
+	}
+	return s.toolResult(text, classification)
+}
+
+// formatIncidentClassification describes a suggested severity and priority, e.g. "severity 2
+// (High), priority 1 on a critical application"
+func formatIncidentClassification(classification domain.IncidentClassification) string {
+	text := fmt.Sprintf("severity %d (%s)", classification.Severity, classification.Class)
+	if classification.Priority != 0 {
+		text += fmt.Sprintf(", priority %d", classification.Priority)
+	}
+	return text + fmt.Sprintf(" on a %s application", classification.Criticality)
+}
+
+func (s *MCPServer) checkIncidentSLAs(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	applicationID, _ := args["application_id"].(string)
+
+	run, err := s.incidentSLAService.CheckSLAs(ctx, application.CheckIncidentSLAsCommand{
+		ApplicationID: domain.ApplicationID(applicationID),
+	})
+	if run == nil {
+		return nil, err
+	}
+
+	text := fmt.Sprintf("⏱️ Incident SLAs: %d incident(s) checked, %d new breach(es), %d notification(s) failed\n", len(run.Checks), run.Breaches, run.Failed)
+	text += formatIncidentSLAChecks(run.Checks)
+	if err != nil {
+		text += fmt.Sprintf("\n⚠️ %v\n", err)
+	}
+	return s.toolResult(text, run)
+}
+
+func (s *MCPServer) acknowledgeIncident(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	incidentID, _ := args["incident_id"].(string)
+	acknowledger, _ := args["acknowledger"].(string)
+	acknowledger, err := actorName(ctx, acknowledger, "MCP Assistant")
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.changeService.AcknowledgeIncident(ctx, application.AcknowledgeIncidentCommand{
+		IncidentID:   incidentID,
+		Acknowledger: acknowledger,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	text := fmt.Sprintf("✅ Acknowledged incident %s\nAcknowledged by: %s", incidentID, acknowledger)
+
+	return s.toolResult(text, map[string]string{"incident_id": incidentID, "acknowledger": acknowledger})
+}
+
+func (s *MCPServer) assignIncident(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	incidentID, _ := args["incident_id"].(string)
+	assignee, _ := args["assignee"].(string)
+	assignedBy, _ := args["assigned_by"].(string)
+	assignedBy, err := actorName(ctx, assignedBy, "MCP Assistant")
+	if err != nil {
+		return nil, err
+	}
+
+	incident, err := s.changeService.AssignIncident(ctx, application.AssignIncidentCommand{
+		IncidentID: incidentID,
+		Assignee:   assignee,
+		AssignedBy: assignedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	text := fmt.Sprintf("✅ Assigned incident %s to %s\nStatus: %s | Assigned by: %s", incident.ID, incident.Assignee, incident.Status, assignedBy)
+	return s.toolResult(text, incident)
+}
+
+func (s *MCPServer) listIncidentsByAssignee(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	assignee, _ := args["assignee"].(string)
+	includeClosed, _ := args["include_closed"].(bool)
+
+	incidents, err := s.changeService.GetIncidentsByAssignee(ctx, assignee)
+	if err != nil {
+		return nil, err
+	}
+
+	listed := make([]domain.Incident, 0, len(incidents))
+	for _, incident := range incidents {
+		if includeClosed || incident.Status != domain.IncidentStatusClosed {
+			listed = append(listed, incident)
+		}
+	}
+
+	if len(listed) == 0 {
+		return s.toolResult(fmt.Sprintf("No incidents assigned to %s", assignee), listed)
+	}
+	text := fmt.Sprintf("🚨 Incidents assigned to %s (%d)\n", assignee, len(listed))
+	for _, incident := range listed {
+		text += fmt.Sprintf("   • %s [%s] severity %d: %s (%s)", incident.ID, incident.Status, incident.Severity, incident.Title, incident.ApplicationID)
+		if incident.ReopenCount > 0 {
+			text += fmt.Sprintf(", reopened %d×", incident.ReopenCount)
+		}
+		text += "\n"
+	}
+	return s.toolResult(text, listed)
+}
+
+func (s *MCPServer) reopenIncident(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	incidentID, _ := args["incident_id"].(string)
+	reopenedBy, _ := args["reopened_by"].(string)
+	reopenedBy, err := actorName(ctx, reopenedBy, "MCP Assistant")
+	if err != nil {
+		return nil, err
+	}
+	reason, _ := args["reason"].(string)
+
+	incident, err := s.changeService.ReopenIncident(ctx, application.ReopenIncidentCommand{
+		IncidentID: incidentID,
+		ReopenedBy: reopenedBy,
+		Reason:     reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	text := fmt.Sprintf("🔁 Reopened incident %s\nStatus: %s | Reopened %d× | Reason: %s", incident.ID, incident.Status, incident.ReopenCount, reason)
+	if incident.Assignee != "" {
+		text += fmt.Sprintf("\nAssignee: %s", incident.Assignee)
+	}
+	return s.toolResult(text, incident)
+}
+
+func (s *MCPServer) resolveIncident(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	incidentID, _ := args["incident_id"].(string)
+	resolver, _ := args["resolver"].(string)
+	resolver, err := actorName(ctx, resolver, "MCP Assistant")
+	if err != nil {
+		return nil, err
+	}
+	resolution, _ := args["resolution"].(string)
+	rootCause, _ := args["root_cause"].(string)
+
+	err = s.changeService.ResolveIncident(ctx, application.ResolveIncidentCommand{
+		IncidentID: incidentID,
+		Resolver:   resolver,
+		Resolution: resolution,
+		RootCause:  rootCause,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	text := fmt.Sprintf("✅ Resolved incident %s\nResolution: %s", incidentID, resolution)
+	if incident, err := s.incidentRepo.FindByID(ctx, incidentID); err == nil && incident.RequiresReview() {
+		text += "\n📝 A post-incident review must be completed before the incident can be closed"
+	}
+
+	return s.toolResult(text, map[string]string{"incident_id": incidentID, "resolution": resolution})
+}
+
+func (s *MCPServer) recordPostIncidentReview(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	incidentID, _ := args["incident_id"].(string)
+	facilitator, _ := args["facilitator"].(string)
+	facilitator, err := actorName(ctx, facilitator, "MCP Assistant")
+	if err != nil {
+		return nil, err
+	}
+	summary, _ := args["summary"].(string)
+
+	cmd := application.RecordPostIncidentReviewCommand{
+		IncidentID:          incidentID,
+		Facilitator:         facilitator,
+		Summary:             summary,
+		ContributingFactors: stringList(args["contributing_factors"]),
+	}
+	entries, _ := args["timeline"].([]interface{})
+	for _, entry := range entries {
+		fields, _ := entry.(map[string]interface{})
+		at, _ := fields["at"].(string)
+		description, _ := fields["description"].(string)
+		parsed, err := time.Parse(time.RFC3339, at)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timeline time %q, expected RFC 3339: %w", at, err)
+		}
+		cmd.Timeline = append(cmd.Timeline, domain.TimelineEntry{At: parsed, Description: description})
+	}
+	items, _ := args["action_items"].([]interface{})
+	for _, item := range items {
+		fields, _ := item.(map[string]interface{})
+		action := domain.RemediationAction{}
+		action.ID, _ = fields["id"].(string)
+		action.Description, _ = fields["description"].(string)
+		action.Owner, _ = fields["owner"].(string)
+		if value, _ := fields["due_date"].(string); value != "" {
+			parsed, err := time.Parse("2006-01-02", value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid due_date of action item %s, expected YYYY-MM-DD: %w", action.ID, err)
+			}
+			action.DueDate = parsed
+		}
+		cmd.ActionItems = append(cmd.ActionItems, action)
+	}
+
+	incident, err := s.changeService.RecordPostIncidentReview(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	text := fmt.Sprintf("📝 Post-incident review of %s recorded\n", incident.ID)
+	text += formatPostIncidentReview(*incident.Review)
+	if err := incident.Review.ReadyToComplete(); err != nil {
+		text += fmt.Sprintf("⚠️ Not ready to complete: %v\n", err)
+	}
+	return s.toolResult(text, incident.Review)
+}
+
+func (s *MCPServer) updateReviewAction(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	incidentID, _ := args["incident_id"].(string)
+	actionID, _ := args["action_id"].(string)
+	status, _ := args["status"].(string)
+
+	action, err := s.changeService.UpdateReviewAction(ctx, application.UpdateReviewActionCommand{
+		IncidentID: incidentID,
+		ActionID:   actionID,
+		Status:     domain.ActionStatus(status),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	text := fmt.Sprintf("📝 Action item %s of the review of %s is %s\nOwner: %s", action.ID, incidentID, action.Status, action.Owner)
+	return s.toolResult(text, action)
+}
+
+func (s *MCPServer) completePostIncidentReview(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	incidentID, _ := args["incident_id"].(string)
+	completedBy, _ := args["completed_by"].(string)
+	completedBy, err := actorName(ctx, completedBy, "MCP Assistant")
+	if err != nil {
+		return nil, err
+	}
+
+	incident, err := s.changeService.CompletePostIncidentReview(ctx, application.CompletePostIncidentReviewCommand{
+		IncidentID:  incidentID,
+		CompletedBy: completedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	text := fmt.Sprintf("✅ Post-incident review of %s completed by %s\n", incident.ID, completedBy)
+	text += formatPostIncidentReview(*incident.Review)
+	return s.toolResult(text, incident.Review)
+}
+
+func (s *MCPServer) closeIncident(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	incidentID, _ := args["incident_id"].(string)
+	closer, _ := args["closer"].(string)
+	closer, err := actorName(ctx, closer, "MCP Assistant")
+	if err != nil {
+		return nil, err
+	}
+	closureCode, _ := args["closure_code"].(string)
+
+	err = s.changeService.CloseIncident(ctx, application.CloseIncidentCommand{
+		IncidentID:  incidentID,
+		Closer:      closer,
+		ClosureCode: domain.IncidentClosureCode(closureCode),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	text := fmt.Sprintf("✅ Closed incident %s\nClosed by: %s | Closure code: %s", incidentID, closer, closureCode)
+	return s.toolResult(text, map[string]string{"incident_id": incidentID, "closer": closer, "closure_code": closureCode})
+}
+
+// formatPostIncidentReview describes a post-incident review with its timeline, contributing
+// factors and action items
+func formatPostIncidentReview(review domain.PostIncidentReview) string {
+	text := fmt.Sprintf("Status: %s | Facilitator: %s\n", review.Status, review.Facilitator)
+	if review.Summary != "" {
+		text += fmt.Sprintf("Summary: %s\n", review.Summary)
+	}
+	if len(review.Timeline) > 0 {
+		text += "Timeline:\n"
+		for _, entry := range review.Timeline {
+			text += fmt.Sprintf("   • %s %s\n", entry.At.Format("2006-01-02 15:04"), entry.Description)
+		}
+	}
+	if len(review.ContributingFactors) > 0 {
+		text += fmt.Sprintf("Contributing factors: %s\n", strings.Join(review.ContributingFactors, "; "))
+	}
+	if len(review.ActionItems) > 0 {
+		text += "Action items:\n"
+		for _, action := range review.ActionItems {
+			text += fmt.Sprintf("   • %s [%s] %s → %s", action.ID, action.Status, action.Description, action.Owner)
+			if !action.DueDate.IsZero() {
+				text += fmt.Sprintf(" (due %s)", action.DueDate.Format("2006-01-02"))
+			}
+			text += "\n"
+		}
+	}
+	return text
+}
+
+func (s *MCPServer) logProblem(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	id, _ := args["id"].(string)
+	applicationID, _ := args["application_id"].(string)
+	title, _ := args["title"].(string)
+	description, _ := args["description"].(string)
+	owner, _ := args["owner"].(string)
+	owner, err := actorName(ctx, owner, "MCP Assistant")
+	if err != nil {
+		return nil, err
+	}
+	priority, _ := args["priority"].(string)
+
+	problem, err := s.problemService.LogProblem(ctx, application.LogProblemCommand{
+		ID:            id,
+		ApplicationID: domain.ApplicationID(applicationID),
+		Title:         title,
+		Description:   description,
+		Owner:         owner,
+		Priority:      domain.Priority(priority),
+		IncidentIDs:   stringList(args["incident_ids"]),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toolResult("🧩 Logged problem\n"+formatProblem(*problem), problem)
+}
+
+func (s *MCPServer) linkProblemIncidents(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	problemID, _ := args["problem_id"].(string)
+
+	problem, err := s.problemService.LinkIncidents(ctx, application.LinkProblemIncidentsCommand{
+		ProblemID:   problemID,
+		IncidentIDs: stringList(args["incident_ids"]),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toolResult("🧩 Linked incidents\n"+formatProblem(*problem), problem)
+}
+
+func (s *MCPServer) recordKnownError(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	problemID, _ := args["problem_id"].(string)
+	rootCause, _ := args["root_cause"].(string)
+	workaround, _ := args["workaround"].(string)
+	analyzedBy, _ := args["analyzed_by"].(string)
+	analyzedBy, err := actorName(ctx, analyzedBy, "MCP Assistant")
+	if err != nil {
+		return nil, err
+	}
+
+	problem, err := s.problemService.RecordKnownError(ctx, application.RecordKnownErrorCommand{
+		ProblemID:  problemID,
+		RootCause:  rootCause,
+		Workaround: workaround,
+		AnalyzedBy: analyzedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toolResult("🧩 Recorded known error\n"+formatProblem(*problem), problem)
+}
+
+func (s *MCPServer) linkProblemChange(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	problemID, _ := args["problem_id"].(string)
+	changeRequestID, _ := args["change_request_id"].(string)
+
+	problem, err := s.problemService.LinkChangeRequest(ctx, application.LinkProblemChangeRequestCommand{
+		ProblemID:       problemID,
+		ChangeRequestID: changeRequestID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toolResult("🧩 Linked change request\n"+formatProblem(*problem), problem)
+}
+
+func (s *MCPServer) resolveProblem(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	problemID, _ := args["problem_id"].(string)
+	resolver, _ := args["resolver"].(string)
+	resolver, err := actorName(ctx, resolver, "MCP Assistant")
+	if err != nil {
+		return nil, err
+	}
+	resolution, _ := args["resolution"].(string)
+
+	problem, err := s.problemService.ResolveProblem(ctx, application.ResolveProblemCommand{
+		ProblemID:  problemID,
+		Resolver:   resolver,
+		Resolution: resolution,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toolResult("✅ Resolved problem\n"+formatProblem(*problem), problem)
+}
+
+func (s *MCPServer) closeProblem(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	problemID, _ := args["problem_id"].(string)
+
+	problem, err := s.problemService.CloseProblem(ctx, problemID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toolResult("✅ Closed problem\n"+formatProblem(*problem), problem)
+}
+
+func (s *MCPServer) listProblems(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	applicationID, _ := args["application_id"].(string)
+
+	problems, err := s.problemService.GetProblemsByApplication(ctx, domain.ApplicationID(applicationID))
+	if err != nil {
+		return nil, err
+	}
+	recurring, err := s.problemService.FindRecurringIncidents(ctx, application.FindRecurringIncidentsCommand{
+		ApplicationID: domain.ApplicationID(applicationID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	text := fmt.Sprintf("🧩 Problems of %s: %d\n", applicationID, len(problems))
+	for _, problem := range problems {
+		text += "\n" + formatProblem(problem)
+	}
+	if len(recurring) > 0 {
+		text += "\n🔁 Recurring incidents not grouped under a problem (last 30 days):\n"
+		for _, group := range recurring {
+			text += fmt.Sprintf("• %q: %s\n", group.Key, strings.Join(group.Incidents, ", "))
+		}
+	}
+	return s.toolResult(text, map[string]interface{}{"problems": problems, "recurring_incidents": recurring})
+}
+
+// formatProblem describes a problem with its incidents, known error and fixing changes
+func formatProblem(problem domain.Problem) string {
+	text := fmt.Sprintf("%s [%s, %s priority]: %s\nOwner: %s\n", problem.ID, problem.Status, problem.Priority, problem.Title, problem.Owner)
+	if len(problem.Incidents) > 0 {
+		text += fmt.Sprintf("Incidents: %s\n", strings.Join(problem.Incidents, ", "))
+	}
+	if problem.KnownError != nil {
+		text += fmt.Sprintf("Root cause: %s\n", problem.KnownError.RootCause)
+		if problem.KnownError.Workaround != "" {
+			text += fmt.Sprintf("Workaround: %s\n", problem.KnownError.Workaround)
+		}
+	}
+	if len(problem.ChangeRequests) > 0 {
+		text += fmt.Sprintf("Fixing changes: %s\n", strings.Join(problem.ChangeRequests, ", "))
+	}
+	if problem.Resolution != "" {
+		text += fmt.Sprintf("Resolution: %s\n", problem.Resolution)
+	}
+	return text
+}
+
+func (s *MCPServer) syncITSM(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	kind, _ := args["kind"].(string)
+	applicationID, _ := args["application_id"].(string)
+
+	run, err := s.itsmSyncService.Sync(ctx, application.SyncITSMCommand{
+		Kind:          domain.ITSMRecordKind(kind),
+		ApplicationID: domain.ApplicationID(applicationID),
+	})
+	if run == nil {
+		return nil, err
+	}
+
+	text := fmt.Sprintf("🔄 ITSM sync: %d created, %d pushed, %d applied, %d conflicts, %d failed\n", run.Created, run.Pushed, run.Applied, run.Conflicts, run.Failed)
+	for _, outcome := range run.Outcomes {
+		text += fmt.Sprintf("• %s %s", outcome.Kind, outcome.LocalID)
+		if outcome.Reference.Key != "" {
+			text += fmt.Sprintf(" → %s", outcome.Reference.Key)
+		}
+		switch {
+		case outcome.Error != "":
+			text += fmt.Sprintf(": failed: %s", outcome.Error)
+		case outcome.Created:
+			text += ": created"
+		case outcome.Pushed:
+			text += ": pushed"
+		}
+		if len(outcome.Applied) > 0 {
+			text += fmt.Sprintf("; applied %s", strings.Join(outcome.Applied, ", "))
+		}
+		if outcome.CommentsPulled > 0 || outcome.CommentsPushed > 0 {
+			text += fmt.Sprintf("; comments %d pulled, %d pushed", outcome.CommentsPulled, outcome.CommentsPushed)
+		}
+		if outcome.Conflict != "" {
+			text += fmt.Sprintf("; conflict: %s", outcome.Conflict)
+		}
+		text += "\n"
+	}
+	if err != nil {
+		text += fmt.Sprintf("\n⚠️ %v\n", err)
+	}
+	return s.toolResult(text, run)
+}
+
+func (s *MCPServer) addITSMComment(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	kind, _ := args["kind"].(string)
+	id, _ := args["id"].(string)
+	author, _ := args["author"].(string)
+	author, err := actorName(ctx, author, "MCP Assistant")
+	if err != nil {
+		return nil, err
+	}
+	body, _ := args["body"].(string)
+
+	link, err := s.itsmSyncService.AddComment(ctx, application.AddITSMCommentCommand{
+		Kind:    domain.ITSMRecordKind(kind),
+		LocalID: id,
+		Author:  author,
+		Body:    body,
+	})
+	if link == nil {
+		return nil, err
+	}
+
+	text := fmt.Sprintf("💬 Commented on %s %s (%s %s)\n", kind, id, link.Connector, link.Reference.Key)
+	if err != nil {
+		text += fmt.Sprintf("⚠️ %v\n", err)
+	}
+	return s.toolResult(text, link)
+}
+
+func (s *MCPServer) listITSMLinks(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	links, err := s.itsmSyncService.ListLinks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	targets := s.itsmSyncService.Targets()
+	text := fmt.Sprintf("🔗 ITSM Links (%d)\n", len(links))
+	if len(targets) == 0 {
+		text += "No ITSM targets configured: add servicenow or jira and targets under itsm in the configuration file\n"
+	}
+	for _, target := range targets {
+		scope := "every application"
+		if len(target.Applications) > 0 {
+			scope = fmt.Sprintf("%d applications", len(target.Applications))
+		}
+		text += fmt.Sprintf("Target: %s → %s (%s)\n", target.Kind, target.Connector, scope)
+	}
+	for _, link := range links {
+		text += fmt.Sprintf("\n%s %s → %s %s [%s]\n", link.Kind, link.LocalID, link.Connector, link.Reference.Key, link.RemoteStatus)
+		if link.Reference.URL != "" {
+			text += fmt.Sprintf("URL: %s\n", link.Reference.URL)
+		}
+		text += fmt.Sprintf("Comments: %d, pending push: %d\n", len(link.Comments), len(link.PendingComments()))
+		if !link.LastSyncedAt.IsZero() {
+			text += fmt.Sprintf("Last synced: %s\n", link.LastSyncedAt.Format(time.RFC3339))
+		}
+		if link.LastError != "" {
+			text += fmt.Sprintf("Last error: %s\n", link.LastError)
+		}
+	}
+	return s.toolResult(text, map[string]interface{}{"targets": targets, "links": links})
+}
+
+func (s *MCPServer) getOperationalMetrics(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+	applicationID, _ := args["application_id"].(string)
+
+	metrics, err := s.changeService.GetOperationalMetrics(ctx, domain.ApplicationID(applicationID))
+	if err != nil {
+		return nil, err
+	}
+
+	text := fmt.Sprintf("📟 Operational Metrics: %s\n\n", applicationID)
+	text += formatOperationalMetrics(metrics, "")
+
+	return s.toolResult(text, metrics)
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/iso38500/iso38500-governance-sdk/domain"
+)
+
+// TestChangeApprovalMatrixOverStdio requests changes as one named actor over stdio and approves
+// them as others until the approval matrix is satisfied
+func TestChangeApprovalMatrixOverStdio(t *testing.T) {
+	server := newTestServer(t, func(cfg *Config) {
+		cfg.ChangeApprovals = []ChangeApprovalConfig{
+			{Priority: "high", Roles: []string{"cab", "security"}, Quorum: 2},
+			{Priority: "critical", Roles: []string{"cab", "security"}, Mode: "unanimous"},
+		}
+	})
+	stdio := server.stdioContext()
+
+	type approval struct {
+		approver      string
+		role          string
+		wantRemaining int
+		wantSatisfied bool
+	}
+	tests := []struct {
+		name      string
+		priority  string
+		approvals []approval
+	}{
+		{"single approval", "medium", []approval{{"bob", "", 0, true}}},
+		{"quorum", "high", []approval{{"bob", "cab", 1, false}, {"carol", "cab", 0, true}}},
+		{"unanimous", "critical", []approval{{"bob", "cab", 1, false}, {"carol", "security", 0, true}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id := "CR-" + tt.priority
+			var created domain.ChangeRequest
+			callToolJSON(t, server, stdio, "create_change_request", map[string]interface{}{
+				"id": id, "application_id": "crm-global-001", "title": "Upgrade CRM database",
+				"priority": tt.priority, "requester": "alice",
+			}, &created)
+			if created.Requester != "alice" {
+				t.Fatalf("requester = %q, want alice", created.Requester)
+			}
+			callToolJSON(t, server, stdio, "submit_change_request", map[string]interface{}{"change_request_id": id}, nil)
+
+			var violation domain.SegregationOfDutiesViolation
+			if _, err := server.callTool(stdio, "approve_change_request", map[string]interface{}{
+				"change_request_id": id, "approver": "alice", "role": "cab",
+			}); !errors.As(err, &violation) {
+				t.Errorf("requester approving error = %v, want a segregation of duties violation", err)
+			}
+
+			for _, step := range tt.approvals {
+				var progress domain.ApprovalProgress
+				callToolJSON(t, server, stdio, "approve_change_request", map[string]interface{}{
+					"change_request_id": id, "approver": step.approver, "role": step.role,
+				}, &progress)
+				if progress.Remaining != step.wantRemaining || progress.Satisfied != step.wantSatisfied {
+					t.Errorf("after %s: remaining %d, satisfied %v, want %d and %v",
+						step.approver, progress.Remaining, progress.Satisfied, step.wantRemaining, step.wantSatisfied)
+				}
+			}
+
+			changeRequest, err := server.changeRequestRepo.FindByID(stdio, id)
+			if err != nil {
+				t.Fatalf("FindByID() error = %v", err)
+			}
+			if changeRequest.Status != domain.ChangeStatusApproved {
+				t.Errorf("status = %s, want %s", changeRequest.Status, domain.ChangeStatusApproved)
+			}
+		})
+	}
+}
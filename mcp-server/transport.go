@@ -0,0 +1,141 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// stdioCaller attributes tool calls made over stdio, where the local assistant is the only client.
+// It is not authenticated, so the calls may still name their actors.
+var stdioCaller = Principal{Subject: "MCP Assistant"}
+
+// maxRequestBytes bounds the size of a single JSON-RPC message accepted over HTTP
+const maxRequestBytes = 1 << 20
+
+// serveStdio reads newline-delimited JSON-RPC messages from stdin and answers on stdout
+func (s *MCPServer) serveStdio() error {
+	ctx := s.stdioContext()
+
+	scanner := bufio.NewScanner(os.Stdin)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		var req MCPRequest
+		if err := json.Unmarshal([]byte(line), &req); err != nil {
+			s.logger.Warnf("Failed to parse request: %v", err)
+			continue
+		}
+		s.logger.Debugf("Received %s request", req.Method)
+
+		// Background jobs read the services a tool call can replace, so calls hold the lock too
+		s.mu.Lock()
+		response := s.handleRequest(ctx, req)
+		s.mu.Unlock()
+		if response != nil {
+			s.sendResponse(response)
+		}
+	}
+
+	return scanner.Err()
+}
+
+// stdioContext is the context tool calls made over stdio are handled with
+func (s *MCPServer) stdioContext() context.Context {
+	return withCaller(s.ctx, stdioCaller)
+}
+
+// requestContext is the context an HTTP request is handled with. Its principal is bound as the
+// actor of its tool calls only when it was authenticated; anonymous requests are only attributed.
+func (s *MCPServer) requestContext(r *http.Request, principal Principal) context.Context {
+	if s.authenticator == nil {
+		return withCaller(r.Context(), principal)
+	}
+	return withPrincipal(r.Context(), principal)
+}
+
+// serveHTTP accepts JSON-RPC messages as POST requests on /mcp and serves the Grafana JSON
+// datasource under /grafana/, authenticating each request with a bearer token
+func (s *MCPServer) serveHTTP() error {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/mcp", s.handleHTTP)
+	mux.Handle("/grafana/", http.StripPrefix("/grafana", s.grafanaHandler()))
+
+	s.logger.Infof("Listening on %s (authentication=%t)", s.config.HTTPAddr, s.authenticator != nil)
+	return http.ListenAndServe(s.config.HTTPAddr, mux)
+}
+
+func (s *MCPServer) handleHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	principal, ok := s.authenticate(w, r)
+	if !ok {
+		return
+	}
+
+	var req MCPRequest
+	if err := json.NewDecoder(io.LimitReader(r.Body, maxRequestBytes)).Decode(&req); err != nil {
+		http.Error(w, "invalid JSON-RPC message", http.StatusBadRequest)
+		return
+	}
+	s.logger.Debugf("Received %s request from %s", req.Method, principal)
+
+	// Tool state is shared between clients, so requests are handled one at a time
+	s.mu.Lock()
+	response := s.handleRequest(s.requestContext(r, principal), req)
+	s.mu.Unlock()
+
+	if response == nil {
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		s.logger.Errorf("Failed to write response: %v", err)
+	}
+}
+
+// authenticate identifies the caller from the request's bearer token, answering the request
+// itself when the caller cannot be identified. Without an authenticator every caller is anonymous.
+func (s *MCPServer) authenticate(w http.ResponseWriter, r *http.Request) (Principal, bool) {
+	if s.authenticator == nil {
+		return Principal{Subject: "anonymous"}, true
+	}
+
+	token, ok := bearerToken(r)
+	if !ok {
+		s.unauthorized(w, "missing bearer token")
+		return Principal{}, false
+	}
+
+	principal, err := s.authenticator.Authenticate(r.Context(), token)
+	if errors.Is(err, ErrUnauthenticated) {
+		s.unauthorized(w, "invalid bearer token")
+		return Principal{}, false
+	}
+	if err != nil {
+		s.logger.Errorf("Authentication failed: %v", err)
+		http.Error(w, "authentication unavailable", http.StatusServiceUnavailable)
+		return Principal{}, false
+	}
+	return principal, true
+}
+
+// unauthorized rejects a request with a bearer challenge
+func (s *MCPServer) unauthorized(w http.ResponseWriter, reason string) {
+	w.Header().Set("WWW-Authenticate", `Bearer realm="iso38500-mcp"`)
+	http.Error(w, reason, http.StatusUnauthorized)
+}